@@ -0,0 +1,9 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for absolute difference of float8(s), values are computed in float64
+// and rounded to nearest float32
+//
+
+var absdiff = [0x10000]float32{0,0.0087890625,0.009765625,0.0107421875,0.01171875,0.0126953125,0.013671875,0.0146484375,0.015625,0.017578125,0.01953125,0.021484375,0.0234375,0.025390625,0.02734375,0.029296875,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.875,0.9375,1,1.125,1.25,1.375,1.5,1.625,1.75,1.875,2,2.25,2.5,2.75,3,3.25,3.5,3.75,4,4.5,5,5.5,6,6.5,7,7.5,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,0.0078125,0.0087890625,0.009765625,0.0107421875,0.01171875,0.0126953125,0.013671875,0.0146484375,0.015625,0.017578125,0.01953125,0.021484375,0.0234375,0.025390625,0.02734375,0.029296875,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.875,0.9375,1,1.125,1.25,1.375,1.5,1.625,1.75,1.875,2,2.25,2.5,2.75,3,3.25,3.5,3.75,4,4.5,5,5.5,6,6.5,7,7.5,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,0.0087890625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.0048828125,0.005859375,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.018554688,0.020507812,0.022460938,0.026367188,0.030273438,0.034179688,0.038085938,0.041992188,0.045898438,0.049804688,0.053710938,0.061523438,0.06933594,0.07714844,0.08496094,0.09277344,0.10058594,0.10839844,0.11621094,0.13183594,0.14746094,0.16308594,0.17871094,0.19433594,0.20996094,0.22558594,0.24121094,0.27246094,0.30371094,0.33496094,0.36621094,0.39746094,0.42871094,0.45996094,0.49121094,0.55371094,0.61621094,0.67871094,0.74121094,0.80371094,0.86621094,0.92871094,0.99121094,1.1162109,1.2412109,1.3662109,1.4912109,1.6162109,1.7412109,1.8662109,1.9912109,2.241211,2.491211,2.741211,2.991211,3.241211,3.491211,3.741211,3.991211,4.491211,4.991211,5.491211,5.991211,6.491211,6.991211,7.491211,7.991211,8.991211,9.991211,10.991211,11.991211,12.991211,13.991211,14.991211,15.991211,17.99121,19.99121,21.99121,23.99121,25.99121,27.99121,29.99121,31.99121,35.99121,39.99121,43.99121,47.99121,51.99121,55.99121,59.99121,63.99121,71.99121,79.99121,87.99121,95.99121,103.99121,111.99121,119.99121,127.99121,143.99121,159.99121,175.99121,191.99121,207.99121,223.99121,239.99121,255.99121,287.9912,319.9912,351.9912,383.9912,415.9912,447.9912,479.9912,0.016601562,0.017578125,0.018554688,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.026367188,0.028320312,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.043945312,0.047851562,0.051757812,0.055664062,0.059570312,0.06347656,0.06738281,0.07128906,0.07910156,0.08691406,0.09472656,0.10253906,0.11035156,0.11816406,0.12597656,0.13378906,0.14941406,0.16503906,0.18066406,0.19628906,0.21191406,0.22753906,0.24316406,0.25878906,0.29003906,0.32128906,0.35253906,0.38378906,0.41503906,0.44628906,0.47753906,0.50878906,0.57128906,0.63378906,0.69628906,0.75878906,0.82128906,0.88378906,0.94628906,1.0087891,1.1337891,1.2587891,1.3837891,1.5087891,1.6337891,1.7587891,1.8837891,2.008789,2.258789,2.508789,2.758789,3.008789,3.258789,3.508789,3.758789,4.008789,4.508789,5.008789,5.508789,6.008789,6.508789,7.008789,7.508789,8.008789,9.008789,10.008789,11.008789,12.008789,13.008789,14.008789,15.008789,16.00879,18.00879,20.00879,22.00879,24.00879,26.00879,28.00879,30.00879,32.00879,36.00879,40.00879,44.00879,48.00879,52.00879,56.00879,60.00879,64.00879,72.00879,80.00879,88.00879,96.00879,104.00879,112.00879,120.00879,128.00879,144.00879,160.00879,176.00879,192.00879,208.00879,224.00879,240.00879,256.0088,288.0088,320.0088,352.0088,384.0088,416.0088,448.0088,480.0088,0.009765625,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.0048828125,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.017578125,0.01953125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.044921875,0.048828125,0.052734375,0.060546875,0.068359375,0.076171875,0.083984375,0.091796875,0.099609375,0.107421875,0.115234375,0.13085938,0.14648438,0.16210938,0.17773438,0.19335938,0.20898438,0.22460938,0.24023438,0.27148438,0.30273438,0.33398438,0.36523438,0.39648438,0.42773438,0.45898438,0.49023438,0.5527344,0.6152344,0.6777344,0.7402344,0.8027344,0.8652344,0.9277344,0.9902344,1.1152344,1.2402344,1.3652344,1.4902344,1.6152344,1.7402344,1.8652344,1.9902344,2.2402344,2.4902344,2.7402344,2.9902344,3.2402344,3.4902344,3.7402344,3.9902344,4.4902344,4.9902344,5.4902344,5.9902344,6.4902344,6.9902344,7.4902344,7.9902344,8.990234,9.990234,10.990234,11.990234,12.990234,13.990234,14.990234,15.990234,17.990234,19.990234,21.990234,23.990234,25.990234,27.990234,29.990234,31.990234,35.990234,39.990234,43.990234,47.990234,51.990234,55.990234,59.990234,63.990234,71.990234,79.990234,87.990234,95.990234,103.990234,111.990234,119.990234,127.990234,143.99023,159.99023,175.99023,191.99023,207.99023,223.99023,239.99023,255.99023,287.99023,319.99023,351.99023,383.99023,415.99023,447.99023,479.99023,0.017578125,0.018554688,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.02734375,0.029296875,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.044921875,0.048828125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.080078125,0.087890625,0.095703125,0.103515625,0.111328125,0.119140625,0.12695312,0.13476562,0.15039062,0.16601562,0.18164062,0.19726562,0.21289062,0.22851562,0.24414062,0.25976562,0.29101562,0.32226562,0.35351562,0.38476562,0.41601562,0.44726562,0.47851562,0.5097656,0.5722656,0.6347656,0.6972656,0.7597656,0.8222656,0.8847656,0.9472656,1.0097656,1.1347656,1.2597656,1.3847656,1.5097656,1.6347656,1.7597656,1.8847656,2.0097656,2.2597656,2.5097656,2.7597656,3.0097656,3.2597656,3.5097656,3.7597656,4.0097656,4.5097656,5.0097656,5.5097656,6.0097656,6.5097656,7.0097656,7.5097656,8.009766,9.009766,10.009766,11.009766,12.009766,13.009766,14.009766,15.009766,16.009766,18.009766,20.009766,22.009766,24.009766,26.009766,28.009766,30.009766,32.009766,36.009766,40.009766,44.009766,48.009766,52.009766,56.009766,60.009766,64.009766,72.009766,80.009766,88.009766,96.009766,104.009766,112.009766,120.009766,128.00977,144.00977,160.00977,176.00977,192.00977,208.00977,224.00977,240.00977,256.00977,288.00977,320.00977,352.00977,384.00977,416.00977,448.00977,480.00977,0.0107421875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.0048828125,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.018554688,0.020507812,0.024414062,0.028320312,0.032226562,0.036132812,0.040039062,0.043945312,0.047851562,0.051757812,0.059570312,0.06738281,0.07519531,0.08300781,0.09082031,0.09863281,0.10644531,0.11425781,0.12988281,0.14550781,0.16113281,0.17675781,0.19238281,0.20800781,0.22363281,0.23925781,0.2705078,0.3017578,0.3330078,0.3642578,0.3955078,0.4267578,0.4580078,0.4892578,0.5517578,0.6142578,0.6767578,0.7392578,0.8017578,0.8642578,0.9267578,0.9892578,1.1142578,1.2392578,1.3642578,1.4892578,1.6142578,1.7392578,1.8642578,1.9892578,2.2392578,2.4892578,2.7392578,2.9892578,3.2392578,3.4892578,3.7392578,3.9892578,4.489258,4.989258,5.489258,5.989258,6.489258,6.989258,7.489258,7.989258,8.989258,9.989258,10.989258,11.989258,12.989258,13.989258,14.989258,15.989258,17.989258,19.989258,21.989258,23.989258,25.989258,27.989258,29.989258,31.989258,35.989258,39.989258,43.989258,47.989258,51.989258,55.989258,59.989258,63.989258,71.98926,79.98926,87.98926,95.98926,103.98926,111.98926,119.98926,127.98926,143.98926,159.98926,175.98926,191.98926,207.98926,223.98926,239.98926,255.98926,287.98926,319.98926,351.98926,383.98926,415.98926,447.98926,479.98926,0.018554688,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.028320312,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.041992188,0.045898438,0.049804688,0.053710938,0.057617188,0.061523438,0.06542969,0.06933594,0.07324219,0.08105469,0.08886719,0.09667969,0.10449219,0.11230469,0.12011719,0.12792969,0.13574219,0.15136719,0.16699219,0.18261719,0.19824219,0.21386719,0.22949219,0.24511719,0.2607422,0.2919922,0.3232422,0.3544922,0.3857422,0.4169922,0.4482422,0.4794922,0.5107422,0.5732422,0.6357422,0.6982422,0.7607422,0.8232422,0.8857422,0.9482422,1.0107422,1.1357422,1.2607422,1.3857422,1.5107422,1.6357422,1.7607422,1.8857422,2.0107422,2.2607422,2.5107422,2.7607422,3.0107422,3.2607422,3.5107422,3.7607422,4.010742,4.510742,5.010742,5.510742,6.010742,6.510742,7.010742,7.510742,8.010742,9.010742,10.010742,11.010742,12.010742,13.010742,14.010742,15.010742,16.010742,18.010742,20.010742,22.010742,24.010742,26.010742,28.010742,30.010742,32.010742,36.010742,40.010742,44.010742,48.010742,52.010742,56.010742,60.010742,64.01074,72.01074,80.01074,88.01074,96.01074,104.01074,112.01074,120.01074,128.01074,144.01074,160.01074,176.01074,192.01074,208.01074,224.01074,240.01074,256.01074,288.01074,320.01074,352.01074,384.01074,416.01074,448.01074,480.01074,0.01171875,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.017578125,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.09765625,0.10546875,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.26953125,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.48828125,0.55078125,0.61328125,0.67578125,0.73828125,0.80078125,0.86328125,0.92578125,0.98828125,1.1132812,1.2382812,1.3632812,1.4882812,1.6132812,1.7382812,1.8632812,1.9882812,2.2382812,2.4882812,2.7382812,2.9882812,3.2382812,3.4882812,3.7382812,3.9882812,4.4882812,4.9882812,5.4882812,5.9882812,6.4882812,6.9882812,7.4882812,7.9882812,8.988281,9.988281,10.988281,11.988281,12.988281,13.988281,14.988281,15.988281,17.988281,19.988281,21.988281,23.988281,25.988281,27.988281,29.988281,31.988281,35.98828,39.98828,43.98828,47.98828,51.98828,55.98828,59.98828,63.98828,71.98828,79.98828,87.98828,95.98828,103.98828,111.98828,119.98828,127.98828,143.98828,159.98828,175.98828,191.98828,207.98828,223.98828,239.98828,255.98828,287.98828,319.98828,351.98828,383.98828,415.98828,447.98828,479.98828,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.029296875,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.08203125,0.08984375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.48046875,0.51171875,0.57421875,0.63671875,0.69921875,0.76171875,0.82421875,0.88671875,0.94921875,1.0117188,1.1367188,1.2617188,1.3867188,1.5117188,1.6367188,1.7617188,1.8867188,2.0117188,2.2617188,2.5117188,2.7617188,3.0117188,3.2617188,3.5117188,3.7617188,4.0117188,4.5117188,5.0117188,5.5117188,6.0117188,6.5117188,7.0117188,7.5117188,8.011719,9.011719,10.011719,11.011719,12.011719,13.011719,14.011719,15.011719,16.011719,18.011719,20.011719,22.011719,24.011719,26.011719,28.011719,30.011719,32.01172,36.01172,40.01172,44.01172,48.01172,52.01172,56.01172,60.01172,64.01172,72.01172,80.01172,88.01172,96.01172,104.01172,112.01172,120.01172,128.01172,144.01172,160.01172,176.01172,192.01172,208.01172,224.01172,240.01172,256.01172,288.01172,320.01172,352.01172,384.01172,416.01172,448.01172,480.01172,0.0126953125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.0048828125,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.018554688,0.022460938,0.026367188,0.030273438,0.034179688,0.038085938,0.041992188,0.045898438,0.049804688,0.057617188,0.06542969,0.07324219,0.08105469,0.08886719,0.09667969,0.10449219,0.11230469,0.12792969,0.14355469,0.15917969,0.17480469,0.19042969,0.20605469,0.22167969,0.23730469,0.2685547,0.2998047,0.3310547,0.3623047,0.3935547,0.4248047,0.4560547,0.4873047,0.5498047,0.6123047,0.6748047,0.7373047,0.7998047,0.8623047,0.9248047,0.9873047,1.1123047,1.2373047,1.3623047,1.4873047,1.6123047,1.7373047,1.8623047,1.9873047,2.2373047,2.4873047,2.7373047,2.9873047,3.2373047,3.4873047,3.7373047,3.9873047,4.4873047,4.9873047,5.4873047,5.9873047,6.4873047,6.9873047,7.4873047,7.9873047,8.987305,9.987305,10.987305,11.987305,12.987305,13.987305,14.987305,15.987305,17.987305,19.987305,21.987305,23.987305,25.987305,27.987305,29.987305,31.987305,35.987305,39.987305,43.987305,47.987305,51.987305,55.987305,59.987305,63.987305,71.987305,79.987305,87.987305,95.987305,103.987305,111.987305,119.987305,127.987305,143.9873,159.9873,175.9873,191.9873,207.9873,223.9873,239.9873,255.9873,287.9873,319.9873,351.9873,383.9873,415.9873,447.9873,479.9873,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.041992188,0.043945312,0.047851562,0.051757812,0.055664062,0.059570312,0.06347656,0.06738281,0.07128906,0.07519531,0.08300781,0.09082031,0.09863281,0.10644531,0.11425781,0.12207031,0.12988281,0.13769531,0.15332031,0.16894531,0.18457031,0.20019531,0.21582031,0.23144531,0.24707031,0.2626953,0.2939453,0.3251953,0.3564453,0.3876953,0.4189453,0.4501953,0.4814453,0.5126953,0.5751953,0.6376953,0.7001953,0.7626953,0.8251953,0.8876953,0.9501953,1.0126953,1.1376953,1.2626953,1.3876953,1.5126953,1.6376953,1.7626953,1.8876953,2.0126953,2.2626953,2.5126953,2.7626953,3.0126953,3.2626953,3.5126953,3.7626953,4.0126953,4.5126953,5.0126953,5.5126953,6.0126953,6.5126953,7.0126953,7.5126953,8.012695,9.012695,10.012695,11.012695,12.012695,13.012695,14.012695,15.012695,16.012695,18.012695,20.012695,22.012695,24.012695,26.012695,28.012695,30.012695,32.012695,36.012695,40.012695,44.012695,48.012695,52.012695,56.012695,60.012695,64.012695,72.012695,80.012695,88.012695,96.012695,104.012695,112.012695,120.012695,128.0127,144.0127,160.0127,176.0127,192.0127,208.0127,224.0127,240.0127,256.0127,288.0127,320.0127,352.0127,384.0127,416.0127,448.0127,480.0127,0.013671875,0.0048828125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.044921875,0.048828125,0.056640625,0.064453125,0.072265625,0.080078125,0.087890625,0.095703125,0.103515625,0.111328125,0.12695312,0.14257812,0.15820312,0.17382812,0.18945312,0.20507812,0.22070312,0.23632812,0.26757812,0.29882812,0.33007812,0.36132812,0.39257812,0.42382812,0.45507812,0.48632812,0.5488281,0.6113281,0.6738281,0.7363281,0.7988281,0.8613281,0.9238281,0.9863281,1.1113281,1.2363281,1.3613281,1.4863281,1.6113281,1.7363281,1.8613281,1.9863281,2.2363281,2.4863281,2.7363281,2.9863281,3.2363281,3.4863281,3.7363281,3.9863281,4.486328,4.986328,5.486328,5.986328,6.486328,6.986328,7.486328,7.986328,8.986328,9.986328,10.986328,11.986328,12.986328,13.986328,14.986328,15.986328,17.986328,19.986328,21.986328,23.986328,25.986328,27.986328,29.986328,31.986328,35.98633,39.98633,43.98633,47.98633,51.98633,55.98633,59.98633,63.98633,71.98633,79.98633,87.98633,95.98633,103.98633,111.98633,119.98633,127.98633,143.98633,159.98633,175.98633,191.98633,207.98633,223.98633,239.98633,255.98633,287.98633,319.98633,351.98633,383.98633,415.98633,447.98633,479.98633,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.029296875,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.048828125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.083984375,0.091796875,0.099609375,0.107421875,0.115234375,0.123046875,0.13085938,0.13867188,0.15429688,0.16992188,0.18554688,0.20117188,0.21679688,0.23242188,0.24804688,0.26367188,0.29492188,0.32617188,0.35742188,0.38867188,0.41992188,0.45117188,0.48242188,0.5136719,0.5761719,0.6386719,0.7011719,0.7636719,0.8261719,0.8886719,0.9511719,1.0136719,1.1386719,1.2636719,1.3886719,1.5136719,1.6386719,1.7636719,1.8886719,2.0136719,2.2636719,2.5136719,2.7636719,3.0136719,3.2636719,3.5136719,3.7636719,4.013672,4.513672,5.013672,5.513672,6.013672,6.513672,7.013672,7.513672,8.013672,9.013672,10.013672,11.013672,12.013672,13.013672,14.013672,15.013672,16.013672,18.013672,20.013672,22.013672,24.013672,26.013672,28.013672,30.013672,32.01367,36.01367,40.01367,44.01367,48.01367,52.01367,56.01367,60.01367,64.01367,72.01367,80.01367,88.01367,96.01367,104.01367,112.01367,120.01367,128.01367,144.01367,160.01367,176.01367,192.01367,208.01367,224.01367,240.01367,256.01367,288.01367,320.01367,352.01367,384.01367,416.01367,448.01367,480.01367,0.0146484375,0.005859375,0.0048828125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.0029296875,0.0048828125,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.020507812,0.024414062,0.028320312,0.032226562,0.036132812,0.040039062,0.043945312,0.047851562,0.055664062,0.06347656,0.07128906,0.07910156,0.08691406,0.09472656,0.10253906,0.11035156,0.12597656,0.14160156,0.15722656,0.17285156,0.18847656,0.20410156,0.21972656,0.23535156,0.26660156,0.29785156,0.32910156,0.36035156,0.39160156,0.42285156,0.45410156,0.48535156,0.54785156,0.61035156,0.67285156,0.73535156,0.79785156,0.86035156,0.92285156,0.98535156,1.1103516,1.2353516,1.3603516,1.4853516,1.6103516,1.7353516,1.8603516,1.9853516,2.2353516,2.4853516,2.7353516,2.9853516,3.2353516,3.4853516,3.7353516,3.9853516,4.4853516,4.9853516,5.4853516,5.9853516,6.4853516,6.9853516,7.4853516,7.9853516,8.985352,9.985352,10.985352,11.985352,12.985352,13.985352,14.985352,15.985352,17.985352,19.985352,21.985352,23.985352,25.985352,27.985352,29.985352,31.985352,35.98535,39.98535,43.98535,47.98535,51.98535,55.98535,59.98535,63.98535,71.98535,79.98535,87.98535,95.98535,103.98535,111.98535,119.98535,127.98535,143.98535,159.98535,175.98535,191.98535,207.98535,223.98535,239.98535,255.98535,287.98535,319.98535,351.98535,383.98535,415.98535,447.98535,479.98535,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.029296875,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.041992188,0.043945312,0.045898438,0.049804688,0.053710938,0.057617188,0.061523438,0.06542969,0.06933594,0.07324219,0.07714844,0.08496094,0.09277344,0.10058594,0.10839844,0.11621094,0.12402344,0.13183594,0.13964844,0.15527344,0.17089844,0.18652344,0.20214844,0.21777344,0.23339844,0.24902344,0.26464844,0.29589844,0.32714844,0.35839844,0.38964844,0.42089844,0.45214844,0.48339844,0.51464844,0.57714844,0.63964844,0.70214844,0.76464844,0.82714844,0.88964844,0.95214844,1.0146484,1.1396484,1.2646484,1.3896484,1.5146484,1.6396484,1.7646484,1.8896484,2.0146484,2.2646484,2.5146484,2.7646484,3.0146484,3.2646484,3.5146484,3.7646484,4.0146484,4.5146484,5.0146484,5.5146484,6.0146484,6.5146484,7.0146484,7.5146484,8.014648,9.014648,10.014648,11.014648,12.014648,13.014648,14.014648,15.014648,16.014648,18.014648,20.014648,22.014648,24.014648,26.014648,28.014648,30.014648,32.01465,36.01465,40.01465,44.01465,48.01465,52.01465,56.01465,60.01465,64.01465,72.01465,80.01465,88.01465,96.01465,104.01465,112.01465,120.01465,128.01465,144.01465,160.01465,176.01465,192.01465,208.01465,224.01465,240.01465,256.01465,288.01465,320.01465,352.01465,384.01465,416.01465,448.01465,480.01465,0.015625,0.0068359375,0.005859375,0.0048828125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.109375,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,1.984375,2.234375,2.484375,2.734375,2.984375,3.234375,3.484375,3.734375,3.984375,4.484375,4.984375,5.484375,5.984375,6.484375,6.984375,7.484375,7.984375,8.984375,9.984375,10.984375,11.984375,12.984375,13.984375,14.984375,15.984375,17.984375,19.984375,21.984375,23.984375,25.984375,27.984375,29.984375,31.984375,35.984375,39.984375,43.984375,47.984375,51.984375,55.984375,59.984375,63.984375,71.984375,79.984375,87.984375,95.984375,103.984375,111.984375,119.984375,127.984375,143.98438,159.98438,175.98438,191.98438,207.98438,223.98438,239.98438,255.98438,287.98438,319.98438,351.98438,383.98438,415.98438,447.98438,479.98438,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.029296875,0.030273438,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,1.890625,2.015625,2.265625,2.515625,2.765625,3.015625,3.265625,3.515625,3.765625,4.015625,4.515625,5.015625,5.515625,6.015625,6.515625,7.015625,7.515625,8.015625,9.015625,10.015625,11.015625,12.015625,13.015625,14.015625,15.015625,16.015625,18.015625,20.015625,22.015625,24.015625,26.015625,28.015625,30.015625,32.015625,36.015625,40.015625,44.015625,48.015625,52.015625,56.015625,60.015625,64.015625,72.015625,80.015625,88.015625,96.015625,104.015625,112.015625,120.015625,128.01562,144.01562,160.01562,176.01562,192.01562,208.01562,224.01562,240.01562,256.01562,288.01562,320.01562,352.01562,384.01562,416.01562,448.01562,480.01562,0.017578125,0.0087890625,0.0078125,0.0068359375,0.005859375,0.0048828125,0.00390625,0.0029296875,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.044921875,0.052734375,0.060546875,0.068359375,0.076171875,0.083984375,0.091796875,0.099609375,0.107421875,0.123046875,0.13867188,0.15429688,0.16992188,0.18554688,0.20117188,0.21679688,0.23242188,0.26367188,0.29492188,0.32617188,0.35742188,0.38867188,0.41992188,0.45117188,0.48242188,0.5449219,0.6074219,0.6699219,0.7324219,0.7949219,0.8574219,0.9199219,0.9824219,1.1074219,1.2324219,1.3574219,1.4824219,1.6074219,1.7324219,1.8574219,1.9824219,2.2324219,2.4824219,2.7324219,2.9824219,3.2324219,3.4824219,3.7324219,3.9824219,4.482422,4.982422,5.482422,5.982422,6.482422,6.982422,7.482422,7.982422,8.982422,9.982422,10.982422,11.982422,12.982422,13.982422,14.982422,15.982422,17.982422,19.982422,21.982422,23.982422,25.982422,27.982422,29.982422,31.982422,35.98242,39.98242,43.98242,47.98242,51.98242,55.98242,59.98242,63.98242,71.98242,79.98242,87.98242,95.98242,103.98242,111.98242,119.98242,127.98242,143.98242,159.98242,175.98242,191.98242,207.98242,223.98242,239.98242,255.98242,287.98242,319.98242,351.98242,383.98242,415.98242,447.98242,479.98242,0.025390625,0.026367188,0.02734375,0.028320312,0.029296875,0.030273438,0.03125,0.032226562,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.087890625,0.095703125,0.103515625,0.111328125,0.119140625,0.12695312,0.13476562,0.14257812,0.15820312,0.17382812,0.18945312,0.20507812,0.22070312,0.23632812,0.25195312,0.26757812,0.29882812,0.33007812,0.36132812,0.39257812,0.42382812,0.45507812,0.48632812,0.5175781,0.5800781,0.6425781,0.7050781,0.7675781,0.8300781,0.8925781,0.9550781,1.0175781,1.1425781,1.2675781,1.3925781,1.5175781,1.6425781,1.7675781,1.8925781,2.0175781,2.2675781,2.5175781,2.7675781,3.0175781,3.2675781,3.5175781,3.7675781,4.017578,4.517578,5.017578,5.517578,6.017578,6.517578,7.017578,7.517578,8.017578,9.017578,10.017578,11.017578,12.017578,13.017578,14.017578,15.017578,16.017578,18.017578,20.017578,22.017578,24.017578,26.017578,28.017578,30.017578,32.01758,36.01758,40.01758,44.01758,48.01758,52.01758,56.01758,60.01758,64.01758,72.01758,80.01758,88.01758,96.01758,104.01758,112.01758,120.01758,128.01758,144.01758,160.01758,176.01758,192.01758,208.01758,224.01758,240.01758,256.01758,288.01758,320.01758,352.01758,384.01758,416.01758,448.01758,480.01758,0.01953125,0.0107421875,0.009765625,0.0087890625,0.0078125,0.0068359375,0.005859375,0.0048828125,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.09765625,0.10546875,0.12109375,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.26171875,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.48046875,0.54296875,0.60546875,0.66796875,0.73046875,0.79296875,0.85546875,0.91796875,0.98046875,1.1054688,1.2304688,1.3554688,1.4804688,1.6054688,1.7304688,1.8554688,1.9804688,2.2304688,2.4804688,2.7304688,2.9804688,3.2304688,3.4804688,3.7304688,3.9804688,4.4804688,4.9804688,5.4804688,5.9804688,6.4804688,6.9804688,7.4804688,7.9804688,8.980469,9.980469,10.980469,11.980469,12.980469,13.980469,14.980469,15.980469,17.980469,19.980469,21.980469,23.980469,25.980469,27.980469,29.980469,31.980469,35.98047,39.98047,43.98047,47.98047,51.98047,55.98047,59.98047,63.98047,71.98047,79.98047,87.98047,95.98047,103.98047,111.98047,119.98047,127.98047,143.98047,159.98047,175.98047,191.98047,207.98047,223.98047,239.98047,255.98047,287.98047,319.98047,351.98047,383.98047,415.98047,447.98047,479.98047,0.02734375,0.028320312,0.029296875,0.030273438,0.03125,0.032226562,0.033203125,0.034179688,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.08984375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.25390625,0.26953125,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.48828125,0.51953125,0.58203125,0.64453125,0.70703125,0.76953125,0.83203125,0.89453125,0.95703125,1.0195312,1.1445312,1.2695312,1.3945312,1.5195312,1.6445312,1.7695312,1.8945312,2.0195312,2.2695312,2.5195312,2.7695312,3.0195312,3.2695312,3.5195312,3.7695312,4.0195312,4.5195312,5.0195312,5.5195312,6.0195312,6.5195312,7.0195312,7.5195312,8.019531,9.019531,10.019531,11.019531,12.019531,13.019531,14.019531,15.019531,16.019531,18.019531,20.019531,22.019531,24.019531,26.019531,28.019531,30.019531,32.01953,36.01953,40.01953,44.01953,48.01953,52.01953,56.01953,60.01953,64.01953,72.01953,80.01953,88.01953,96.01953,104.01953,112.01953,120.01953,128.01953,144.01953,160.01953,176.01953,192.01953,208.01953,224.01953,240.01953,256.01953,288.01953,320.01953,352.01953,384.01953,416.01953,448.01953,480.01953,0.021484375,0.0126953125,0.01171875,0.0107421875,0.009765625,0.0087890625,0.0078125,0.0068359375,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.048828125,0.056640625,0.064453125,0.072265625,0.080078125,0.087890625,0.095703125,0.103515625,0.119140625,0.13476562,0.15039062,0.16601562,0.18164062,0.19726562,0.21289062,0.22851562,0.25976562,0.29101562,0.32226562,0.35351562,0.38476562,0.41601562,0.44726562,0.47851562,0.5410156,0.6035156,0.6660156,0.7285156,0.7910156,0.8535156,0.9160156,0.9785156,1.1035156,1.2285156,1.3535156,1.4785156,1.6035156,1.7285156,1.8535156,1.9785156,2.2285156,2.4785156,2.7285156,2.9785156,3.2285156,3.4785156,3.7285156,3.9785156,4.4785156,4.9785156,5.4785156,5.9785156,6.4785156,6.9785156,7.4785156,7.9785156,8.978516,9.978516,10.978516,11.978516,12.978516,13.978516,14.978516,15.978516,17.978516,19.978516,21.978516,23.978516,25.978516,27.978516,29.978516,31.978516,35.978516,39.978516,43.978516,47.978516,51.978516,55.978516,59.978516,63.978516,71.978516,79.978516,87.978516,95.978516,103.978516,111.978516,119.978516,127.978516,143.97852,159.97852,175.97852,191.97852,207.97852,223.97852,239.97852,255.97852,287.97852,319.97852,351.97852,383.97852,415.97852,447.97852,479.97852,0.029296875,0.030273438,0.03125,0.032226562,0.033203125,0.034179688,0.03515625,0.036132812,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.083984375,0.091796875,0.099609375,0.107421875,0.115234375,0.123046875,0.13085938,0.13867188,0.14648438,0.16210938,0.17773438,0.19335938,0.20898438,0.22460938,0.24023438,0.25585938,0.27148438,0.30273438,0.33398438,0.36523438,0.39648438,0.42773438,0.45898438,0.49023438,0.5214844,0.5839844,0.6464844,0.7089844,0.7714844,0.8339844,0.8964844,0.9589844,1.0214844,1.1464844,1.2714844,1.3964844,1.5214844,1.6464844,1.7714844,1.8964844,2.0214844,2.2714844,2.5214844,2.7714844,3.0214844,3.2714844,3.5214844,3.7714844,4.0214844,4.5214844,5.0214844,5.5214844,6.0214844,6.5214844,7.0214844,7.5214844,8.021484,9.021484,10.021484,11.021484,12.021484,13.021484,14.021484,15.021484,16.021484,18.021484,20.021484,22.021484,24.021484,26.021484,28.021484,30.021484,32.021484,36.021484,40.021484,44.021484,48.021484,52.021484,56.021484,60.021484,64.021484,72.021484,80.021484,88.021484,96.021484,104.021484,112.021484,120.021484,128.02148,144.02148,160.02148,176.02148,192.02148,208.02148,224.02148,240.02148,256.02148,288.02148,320.02148,352.02148,384.02148,416.02148,448.02148,480.02148,0.0234375,0.0146484375,0.013671875,0.0126953125,0.01171875,0.0107421875,0.009765625,0.0087890625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5390625,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,0.9765625,1.1015625,1.2265625,1.3515625,1.4765625,1.6015625,1.7265625,1.8515625,1.9765625,2.2265625,2.4765625,2.7265625,2.9765625,3.2265625,3.4765625,3.7265625,3.9765625,4.4765625,4.9765625,5.4765625,5.9765625,6.4765625,6.9765625,7.4765625,7.9765625,8.9765625,9.9765625,10.9765625,11.9765625,12.9765625,13.9765625,14.9765625,15.9765625,17.976562,19.976562,21.976562,23.976562,25.976562,27.976562,29.976562,31.976562,35.976562,39.976562,43.976562,47.976562,51.976562,55.976562,59.976562,63.976562,71.97656,79.97656,87.97656,95.97656,103.97656,111.97656,119.97656,127.97656,143.97656,159.97656,175.97656,191.97656,207.97656,223.97656,239.97656,255.97656,287.97656,319.97656,351.97656,383.97656,415.97656,447.97656,479.97656,0.03125,0.032226562,0.033203125,0.034179688,0.03515625,0.036132812,0.037109375,0.038085938,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,0.9609375,1.0234375,1.1484375,1.2734375,1.3984375,1.5234375,1.6484375,1.7734375,1.8984375,2.0234375,2.2734375,2.5234375,2.7734375,3.0234375,3.2734375,3.5234375,3.7734375,4.0234375,4.5234375,5.0234375,5.5234375,6.0234375,6.5234375,7.0234375,7.5234375,8.0234375,9.0234375,10.0234375,11.0234375,12.0234375,13.0234375,14.0234375,15.0234375,16.023438,18.023438,20.023438,22.023438,24.023438,26.023438,28.023438,30.023438,32.023438,36.023438,40.023438,44.023438,48.023438,52.023438,56.023438,60.023438,64.02344,72.02344,80.02344,88.02344,96.02344,104.02344,112.02344,120.02344,128.02344,144.02344,160.02344,176.02344,192.02344,208.02344,224.02344,240.02344,256.02344,288.02344,320.02344,352.02344,384.02344,416.02344,448.02344,480.02344,0.025390625,0.016601562,0.015625,0.0146484375,0.013671875,0.0126953125,0.01171875,0.0107421875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.009765625,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.044921875,0.052734375,0.060546875,0.068359375,0.076171875,0.083984375,0.091796875,0.099609375,0.115234375,0.13085938,0.14648438,0.16210938,0.17773438,0.19335938,0.20898438,0.22460938,0.25585938,0.28710938,0.31835938,0.34960938,0.38085938,0.41210938,0.44335938,0.47460938,0.5371094,0.5996094,0.6621094,0.7246094,0.7871094,0.8496094,0.9121094,0.9746094,1.0996094,1.2246094,1.3496094,1.4746094,1.5996094,1.7246094,1.8496094,1.9746094,2.2246094,2.4746094,2.7246094,2.9746094,3.2246094,3.4746094,3.7246094,3.9746094,4.4746094,4.9746094,5.4746094,5.9746094,6.4746094,6.9746094,7.4746094,7.9746094,8.974609,9.974609,10.974609,11.974609,12.974609,13.974609,14.974609,15.974609,17.97461,19.97461,21.97461,23.97461,25.97461,27.97461,29.97461,31.97461,35.97461,39.97461,43.97461,47.97461,51.97461,55.97461,59.97461,63.97461,71.97461,79.97461,87.97461,95.97461,103.97461,111.97461,119.97461,127.97461,143.97461,159.97461,175.97461,191.97461,207.97461,223.97461,239.97461,255.97461,287.9746,319.9746,351.9746,383.9746,415.9746,447.9746,479.9746,0.033203125,0.034179688,0.03515625,0.036132812,0.037109375,0.038085938,0.0390625,0.040039062,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.083984375,0.087890625,0.095703125,0.103515625,0.111328125,0.119140625,0.12695312,0.13476562,0.14257812,0.15039062,0.16601562,0.18164062,0.19726562,0.21289062,0.22851562,0.24414062,0.25976562,0.27539062,0.30664062,0.33789062,0.36914062,0.40039062,0.43164062,0.46289062,0.49414062,0.5253906,0.5878906,0.6503906,0.7128906,0.7753906,0.8378906,0.9003906,0.9628906,1.0253906,1.1503906,1.2753906,1.4003906,1.5253906,1.6503906,1.7753906,1.9003906,2.0253906,2.2753906,2.5253906,2.7753906,3.0253906,3.2753906,3.5253906,3.7753906,4.0253906,4.5253906,5.0253906,5.5253906,6.0253906,6.5253906,7.0253906,7.5253906,8.025391,9.025391,10.025391,11.025391,12.025391,13.025391,14.025391,15.025391,16.02539,18.02539,20.02539,22.02539,24.02539,26.02539,28.02539,30.02539,32.02539,36.02539,40.02539,44.02539,48.02539,52.02539,56.02539,60.02539,64.02539,72.02539,80.02539,88.02539,96.02539,104.02539,112.02539,120.02539,128.02539,144.02539,160.02539,176.02539,192.02539,208.02539,224.02539,240.02539,256.0254,288.0254,320.0254,352.0254,384.0254,416.0254,448.0254,480.0254,0.02734375,0.018554688,0.017578125,0.016601562,0.015625,0.0146484375,0.013671875,0.0126953125,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.09765625,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.25390625,0.28515625,0.31640625,0.34765625,0.37890625,0.41015625,0.44140625,0.47265625,0.53515625,0.59765625,0.66015625,0.72265625,0.78515625,0.84765625,0.91015625,0.97265625,1.0976562,1.2226562,1.3476562,1.4726562,1.5976562,1.7226562,1.8476562,1.9726562,2.2226562,2.4726562,2.7226562,2.9726562,3.2226562,3.4726562,3.7226562,3.9726562,4.4726562,4.9726562,5.4726562,5.9726562,6.4726562,6.9726562,7.4726562,7.9726562,8.972656,9.972656,10.972656,11.972656,12.972656,13.972656,14.972656,15.972656,17.972656,19.972656,21.972656,23.972656,25.972656,27.972656,29.972656,31.972656,35.972656,39.972656,43.972656,47.972656,51.972656,55.972656,59.972656,63.972656,71.97266,79.97266,87.97266,95.97266,103.97266,111.97266,119.97266,127.97266,143.97266,159.97266,175.97266,191.97266,207.97266,223.97266,239.97266,255.97266,287.97266,319.97266,351.97266,383.97266,415.97266,447.97266,479.97266,0.03515625,0.036132812,0.037109375,0.038085938,0.0390625,0.040039062,0.041015625,0.041992188,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.27734375,0.30859375,0.33984375,0.37109375,0.40234375,0.43359375,0.46484375,0.49609375,0.52734375,0.58984375,0.65234375,0.71484375,0.77734375,0.83984375,0.90234375,0.96484375,1.0273438,1.1523438,1.2773438,1.4023438,1.5273438,1.6523438,1.7773438,1.9023438,2.0273438,2.2773438,2.5273438,2.7773438,3.0273438,3.2773438,3.5273438,3.7773438,4.0273438,4.5273438,5.0273438,5.5273438,6.0273438,6.5273438,7.0273438,7.5273438,8.027344,9.027344,10.027344,11.027344,12.027344,13.027344,14.027344,15.027344,16.027344,18.027344,20.027344,22.027344,24.027344,26.027344,28.027344,30.027344,32.027344,36.027344,40.027344,44.027344,48.027344,52.027344,56.027344,60.027344,64.02734,72.02734,80.02734,88.02734,96.02734,104.02734,112.02734,120.02734,128.02734,144.02734,160.02734,176.02734,192.02734,208.02734,224.02734,240.02734,256.02734,288.02734,320.02734,352.02734,384.02734,416.02734,448.02734,480.02734,0.029296875,0.020507812,0.01953125,0.018554688,0.017578125,0.016601562,0.015625,0.0146484375,0.013671875,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.005859375,0.009765625,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.041015625,0.048828125,0.056640625,0.064453125,0.072265625,0.080078125,0.087890625,0.095703125,0.111328125,0.12695312,0.14257812,0.15820312,0.17382812,0.18945312,0.20507812,0.22070312,0.25195312,0.28320312,0.31445312,0.34570312,0.37695312,0.40820312,0.43945312,0.47070312,0.5332031,0.5957031,0.6582031,0.7207031,0.7832031,0.8457031,0.9082031,0.9707031,1.0957031,1.2207031,1.3457031,1.4707031,1.5957031,1.7207031,1.8457031,1.9707031,2.2207031,2.4707031,2.7207031,2.9707031,3.2207031,3.4707031,3.7207031,3.9707031,4.470703,4.970703,5.470703,5.970703,6.470703,6.970703,7.470703,7.970703,8.970703,9.970703,10.970703,11.970703,12.970703,13.970703,14.970703,15.970703,17.970703,19.970703,21.970703,23.970703,25.970703,27.970703,29.970703,31.970703,35.970703,39.970703,43.970703,47.970703,51.970703,55.970703,59.970703,63.970703,71.9707,79.9707,87.9707,95.9707,103.9707,111.9707,119.9707,127.9707,143.9707,159.9707,175.9707,191.9707,207.9707,223.9707,239.9707,255.9707,287.9707,319.9707,351.9707,383.9707,415.9707,447.9707,479.9707,0.037109375,0.038085938,0.0390625,0.040039062,0.041015625,0.041992188,0.04296875,0.043945312,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.083984375,0.087890625,0.091796875,0.099609375,0.107421875,0.115234375,0.123046875,0.13085938,0.13867188,0.14648438,0.15429688,0.16992188,0.18554688,0.20117188,0.21679688,0.23242188,0.24804688,0.26367188,0.27929688,0.31054688,0.34179688,0.37304688,0.40429688,0.43554688,0.46679688,0.49804688,0.5292969,0.5917969,0.6542969,0.7167969,0.7792969,0.8417969,0.9042969,0.9667969,1.0292969,1.1542969,1.2792969,1.4042969,1.5292969,1.6542969,1.7792969,1.9042969,2.0292969,2.2792969,2.5292969,2.7792969,3.0292969,3.2792969,3.5292969,3.7792969,4.029297,4.529297,5.029297,5.529297,6.029297,6.529297,7.029297,7.529297,8.029297,9.029297,10.029297,11.029297,12.029297,13.029297,14.029297,15.029297,16.029297,18.029297,20.029297,22.029297,24.029297,26.029297,28.029297,30.029297,32.029297,36.029297,40.029297,44.029297,48.029297,52.029297,56.029297,60.029297,64.0293,72.0293,80.0293,88.0293,96.0293,104.0293,112.0293,120.0293,128.0293,144.0293,160.0293,176.0293,192.0293,208.0293,224.0293,240.0293,256.0293,288.0293,320.0293,352.0293,384.0293,416.0293,448.0293,480.0293,0.03125,0.022460938,0.021484375,0.020507812,0.01953125,0.018554688,0.017578125,0.016601562,0.015625,0.013671875,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.21875,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,3.96875,4.46875,4.96875,5.46875,5.96875,6.46875,6.96875,7.46875,7.96875,8.96875,9.96875,10.96875,11.96875,12.96875,13.96875,14.96875,15.96875,17.96875,19.96875,21.96875,23.96875,25.96875,27.96875,29.96875,31.96875,35.96875,39.96875,43.96875,47.96875,51.96875,55.96875,59.96875,63.96875,71.96875,79.96875,87.96875,95.96875,103.96875,111.96875,119.96875,127.96875,143.96875,159.96875,175.96875,191.96875,207.96875,223.96875,239.96875,255.96875,287.96875,319.96875,351.96875,383.96875,415.96875,447.96875,479.96875,0.0390625,0.040039062,0.041015625,0.041992188,0.04296875,0.043945312,0.044921875,0.045898438,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.060546875,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,3.78125,4.03125,4.53125,5.03125,5.53125,6.03125,6.53125,7.03125,7.53125,8.03125,9.03125,10.03125,11.03125,12.03125,13.03125,14.03125,15.03125,16.03125,18.03125,20.03125,22.03125,24.03125,26.03125,28.03125,30.03125,32.03125,36.03125,40.03125,44.03125,48.03125,52.03125,56.03125,60.03125,64.03125,72.03125,80.03125,88.03125,96.03125,104.03125,112.03125,120.03125,128.03125,144.03125,160.03125,176.03125,192.03125,208.03125,224.03125,240.03125,256.03125,288.03125,320.03125,352.03125,384.03125,416.03125,448.03125,480.03125,0.03515625,0.026367188,0.025390625,0.024414062,0.0234375,0.022460938,0.021484375,0.020507812,0.01953125,0.017578125,0.015625,0.013671875,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.10546875,0.12109375,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.24609375,0.27734375,0.30859375,0.33984375,0.37109375,0.40234375,0.43359375,0.46484375,0.52734375,0.58984375,0.65234375,0.71484375,0.77734375,0.83984375,0.90234375,0.96484375,1.0898438,1.2148438,1.3398438,1.4648438,1.5898438,1.7148438,1.8398438,1.9648438,2.2148438,2.4648438,2.7148438,2.9648438,3.2148438,3.4648438,3.7148438,3.9648438,4.4648438,4.9648438,5.4648438,5.9648438,6.4648438,6.9648438,7.4648438,7.9648438,8.964844,9.964844,10.964844,11.964844,12.964844,13.964844,14.964844,15.964844,17.964844,19.964844,21.964844,23.964844,25.964844,27.964844,29.964844,31.964844,35.964844,39.964844,43.964844,47.964844,51.964844,55.964844,59.964844,63.964844,71.96484,79.96484,87.96484,95.96484,103.96484,111.96484,119.96484,127.96484,143.96484,159.96484,175.96484,191.96484,207.96484,223.96484,239.96484,255.96484,287.96484,319.96484,351.96484,383.96484,415.96484,447.96484,479.96484,0.04296875,0.043945312,0.044921875,0.045898438,0.046875,0.047851562,0.048828125,0.049804688,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.060546875,0.0625,0.064453125,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.25390625,0.26953125,0.28515625,0.31640625,0.34765625,0.37890625,0.41015625,0.44140625,0.47265625,0.50390625,0.53515625,0.59765625,0.66015625,0.72265625,0.78515625,0.84765625,0.91015625,0.97265625,1.0351562,1.1601562,1.2851562,1.4101562,1.5351562,1.6601562,1.7851562,1.9101562,2.0351562,2.2851562,2.5351562,2.7851562,3.0351562,3.2851562,3.5351562,3.7851562,4.0351562,4.5351562,5.0351562,5.5351562,6.0351562,6.5351562,7.0351562,7.5351562,8.035156,9.035156,10.035156,11.035156,12.035156,13.035156,14.035156,15.035156,16.035156,18.035156,20.035156,22.035156,24.035156,26.035156,28.035156,30.035156,32.035156,36.035156,40.035156,44.035156,48.035156,52.035156,56.035156,60.035156,64.03516,72.03516,80.03516,88.03516,96.03516,104.03516,112.03516,120.03516,128.03516,144.03516,160.03516,176.03516,192.03516,208.03516,224.03516,240.03516,256.03516,288.03516,320.03516,352.03516,384.03516,416.03516,448.03516,480.03516,0.0390625,0.030273438,0.029296875,0.028320312,0.02734375,0.026367188,0.025390625,0.024414062,0.0234375,0.021484375,0.01953125,0.017578125,0.015625,0.013671875,0.01171875,0.009765625,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2421875,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.5234375,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,0.9609375,1.0859375,1.2109375,1.3359375,1.4609375,1.5859375,1.7109375,1.8359375,1.9609375,2.2109375,2.4609375,2.7109375,2.9609375,3.2109375,3.4609375,3.7109375,3.9609375,4.4609375,4.9609375,5.4609375,5.9609375,6.4609375,6.9609375,7.4609375,7.9609375,8.9609375,9.9609375,10.9609375,11.9609375,12.9609375,13.9609375,14.9609375,15.9609375,17.960938,19.960938,21.960938,23.960938,25.960938,27.960938,29.960938,31.960938,35.960938,39.960938,43.960938,47.960938,51.960938,55.960938,59.960938,63.960938,71.96094,79.96094,87.96094,95.96094,103.96094,111.96094,119.96094,127.96094,143.96094,159.96094,175.96094,191.96094,207.96094,223.96094,239.96094,255.96094,287.96094,319.96094,351.96094,383.96094,415.96094,447.96094,479.96094,0.046875,0.047851562,0.048828125,0.049804688,0.05078125,0.051757812,0.052734375,0.053710938,0.0546875,0.056640625,0.05859375,0.060546875,0.0625,0.064453125,0.06640625,0.068359375,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5078125,0.5390625,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,0.9765625,1.0390625,1.1640625,1.2890625,1.4140625,1.5390625,1.6640625,1.7890625,1.9140625,2.0390625,2.2890625,2.5390625,2.7890625,3.0390625,3.2890625,3.5390625,3.7890625,4.0390625,4.5390625,5.0390625,5.5390625,6.0390625,6.5390625,7.0390625,7.5390625,8.0390625,9.0390625,10.0390625,11.0390625,12.0390625,13.0390625,14.0390625,15.0390625,16.039062,18.039062,20.039062,22.039062,24.039062,26.039062,28.039062,30.039062,32.039062,36.039062,40.039062,44.039062,48.039062,52.039062,56.039062,60.039062,64.03906,72.03906,80.03906,88.03906,96.03906,104.03906,112.03906,120.03906,128.03906,144.03906,160.03906,176.03906,192.03906,208.03906,224.03906,240.03906,256.03906,288.03906,320.03906,352.03906,384.03906,416.03906,448.03906,480.03906,0.04296875,0.034179688,0.033203125,0.032226562,0.03125,0.030273438,0.029296875,0.028320312,0.02734375,0.025390625,0.0234375,0.021484375,0.01953125,0.017578125,0.015625,0.013671875,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.09765625,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.23828125,0.26953125,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.51953125,0.58203125,0.64453125,0.70703125,0.76953125,0.83203125,0.89453125,0.95703125,1.0820312,1.2070312,1.3320312,1.4570312,1.5820312,1.7070312,1.8320312,1.9570312,2.2070312,2.4570312,2.7070312,2.9570312,3.2070312,3.4570312,3.7070312,3.9570312,4.4570312,4.9570312,5.4570312,5.9570312,6.4570312,6.9570312,7.4570312,7.9570312,8.957031,9.957031,10.957031,11.957031,12.957031,13.957031,14.957031,15.957031,17.957031,19.957031,21.957031,23.957031,25.957031,27.957031,29.957031,31.957031,35.95703,39.95703,43.95703,47.95703,51.95703,55.95703,59.95703,63.95703,71.95703,79.95703,87.95703,95.95703,103.95703,111.95703,119.95703,127.95703,143.95703,159.95703,175.95703,191.95703,207.95703,223.95703,239.95703,255.95703,287.95703,319.95703,351.95703,383.95703,415.95703,447.95703,479.95703,0.05078125,0.051757812,0.052734375,0.053710938,0.0546875,0.055664062,0.056640625,0.057617188,0.05859375,0.060546875,0.0625,0.064453125,0.06640625,0.068359375,0.0703125,0.072265625,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.27734375,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.48046875,0.51171875,0.54296875,0.60546875,0.66796875,0.73046875,0.79296875,0.85546875,0.91796875,0.98046875,1.0429688,1.1679688,1.2929688,1.4179688,1.5429688,1.6679688,1.7929688,1.9179688,2.0429688,2.2929688,2.5429688,2.7929688,3.0429688,3.2929688,3.5429688,3.7929688,4.0429688,4.5429688,5.0429688,5.5429688,6.0429688,6.5429688,7.0429688,7.5429688,8.042969,9.042969,10.042969,11.042969,12.042969,13.042969,14.042969,15.042969,16.042969,18.042969,20.042969,22.042969,24.042969,26.042969,28.042969,30.042969,32.04297,36.04297,40.04297,44.04297,48.04297,52.04297,56.04297,60.04297,64.04297,72.04297,80.04297,88.04297,96.04297,104.04297,112.04297,120.04297,128.04297,144.04297,160.04297,176.04297,192.04297,208.04297,224.04297,240.04297,256.04297,288.04297,320.04297,352.04297,384.04297,416.04297,448.04297,480.04297,0.046875,0.038085938,0.037109375,0.036132812,0.03515625,0.034179688,0.033203125,0.032226562,0.03125,0.029296875,0.02734375,0.025390625,0.0234375,0.021484375,0.01953125,0.017578125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.078125,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,1.953125,2.203125,2.453125,2.703125,2.953125,3.203125,3.453125,3.703125,3.953125,4.453125,4.953125,5.453125,5.953125,6.453125,6.953125,7.453125,7.953125,8.953125,9.953125,10.953125,11.953125,12.953125,13.953125,14.953125,15.953125,17.953125,19.953125,21.953125,23.953125,25.953125,27.953125,29.953125,31.953125,35.953125,39.953125,43.953125,47.953125,51.953125,55.953125,59.953125,63.953125,71.953125,79.953125,87.953125,95.953125,103.953125,111.953125,119.953125,127.953125,143.95312,159.95312,175.95312,191.95312,207.95312,223.95312,239.95312,255.95312,287.95312,319.95312,351.95312,383.95312,415.95312,447.95312,479.95312,0.0546875,0.055664062,0.056640625,0.057617188,0.05859375,0.059570312,0.060546875,0.061523438,0.0625,0.064453125,0.06640625,0.068359375,0.0703125,0.072265625,0.07421875,0.076171875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,1.921875,2.046875,2.296875,2.546875,2.796875,3.046875,3.296875,3.546875,3.796875,4.046875,4.546875,5.046875,5.546875,6.046875,6.546875,7.046875,7.546875,8.046875,9.046875,10.046875,11.046875,12.046875,13.046875,14.046875,15.046875,16.046875,18.046875,20.046875,22.046875,24.046875,26.046875,28.046875,30.046875,32.046875,36.046875,40.046875,44.046875,48.046875,52.046875,56.046875,60.046875,64.046875,72.046875,80.046875,88.046875,96.046875,104.046875,112.046875,120.046875,128.04688,144.04688,160.04688,176.04688,192.04688,208.04688,224.04688,240.04688,256.04688,288.04688,320.04688,352.04688,384.04688,416.04688,448.04688,480.04688,0.05078125,0.041992188,0.041015625,0.040039062,0.0390625,0.038085938,0.037109375,0.036132812,0.03515625,0.033203125,0.03125,0.029296875,0.02734375,0.025390625,0.0234375,0.021484375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.01953125,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08984375,0.10546875,0.12109375,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.23046875,0.26171875,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.51171875,0.57421875,0.63671875,0.69921875,0.76171875,0.82421875,0.88671875,0.94921875,1.0742188,1.1992188,1.3242188,1.4492188,1.5742188,1.6992188,1.8242188,1.9492188,2.1992188,2.4492188,2.6992188,2.9492188,3.1992188,3.4492188,3.6992188,3.9492188,4.4492188,4.9492188,5.4492188,5.9492188,6.4492188,6.9492188,7.4492188,7.9492188,8.949219,9.949219,10.949219,11.949219,12.949219,13.949219,14.949219,15.949219,17.949219,19.949219,21.949219,23.949219,25.949219,27.949219,29.949219,31.949219,35.94922,39.94922,43.94922,47.94922,51.94922,55.94922,59.94922,63.94922,71.94922,79.94922,87.94922,95.94922,103.94922,111.94922,119.94922,127.94922,143.94922,159.94922,175.94922,191.94922,207.94922,223.94922,239.94922,255.94922,287.94922,319.94922,351.94922,383.94922,415.94922,447.94922,479.94922,0.05859375,0.059570312,0.060546875,0.061523438,0.0625,0.06347656,0.064453125,0.06542969,0.06640625,0.068359375,0.0703125,0.072265625,0.07421875,0.076171875,0.078125,0.080078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.16796875,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.25390625,0.26953125,0.28515625,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.48828125,0.51953125,0.55078125,0.61328125,0.67578125,0.73828125,0.80078125,0.86328125,0.92578125,0.98828125,1.0507812,1.1757812,1.3007812,1.4257812,1.5507812,1.6757812,1.8007812,1.9257812,2.0507812,2.3007812,2.5507812,2.8007812,3.0507812,3.3007812,3.5507812,3.8007812,4.0507812,4.5507812,5.0507812,5.5507812,6.0507812,6.5507812,7.0507812,7.5507812,8.050781,9.050781,10.050781,11.050781,12.050781,13.050781,14.050781,15.050781,16.050781,18.050781,20.050781,22.050781,24.050781,26.050781,28.050781,30.050781,32.05078,36.05078,40.05078,44.05078,48.05078,52.05078,56.05078,60.05078,64.05078,72.05078,80.05078,88.05078,96.05078,104.05078,112.05078,120.05078,128.05078,144.05078,160.05078,176.05078,192.05078,208.05078,224.05078,240.05078,256.05078,288.05078,320.05078,352.05078,384.05078,416.05078,448.05078,480.05078,0.0546875,0.045898438,0.044921875,0.043945312,0.04296875,0.041992188,0.041015625,0.040039062,0.0390625,0.037109375,0.03515625,0.033203125,0.03125,0.029296875,0.02734375,0.025390625,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.5078125,0.5703125,0.6328125,0.6953125,0.7578125,0.8203125,0.8828125,0.9453125,1.0703125,1.1953125,1.3203125,1.4453125,1.5703125,1.6953125,1.8203125,1.9453125,2.1953125,2.4453125,2.6953125,2.9453125,3.1953125,3.4453125,3.6953125,3.9453125,4.4453125,4.9453125,5.4453125,5.9453125,6.4453125,6.9453125,7.4453125,7.9453125,8.9453125,9.9453125,10.9453125,11.9453125,12.9453125,13.9453125,14.9453125,15.9453125,17.945312,19.945312,21.945312,23.945312,25.945312,27.945312,29.945312,31.945312,35.945312,39.945312,43.945312,47.945312,51.945312,55.945312,59.945312,63.945312,71.94531,79.94531,87.94531,95.94531,103.94531,111.94531,119.94531,127.94531,143.94531,159.94531,175.94531,191.94531,207.94531,223.94531,239.94531,255.94531,287.9453,319.9453,351.9453,383.9453,415.9453,447.9453,479.9453,0.0625,0.06347656,0.064453125,0.06542969,0.06640625,0.06738281,0.068359375,0.06933594,0.0703125,0.072265625,0.07421875,0.076171875,0.078125,0.080078125,0.08203125,0.083984375,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5546875,0.6171875,0.6796875,0.7421875,0.8046875,0.8671875,0.9296875,0.9921875,1.0546875,1.1796875,1.3046875,1.4296875,1.5546875,1.6796875,1.8046875,1.9296875,2.0546875,2.3046875,2.5546875,2.8046875,3.0546875,3.3046875,3.5546875,3.8046875,4.0546875,4.5546875,5.0546875,5.5546875,6.0546875,6.5546875,7.0546875,7.5546875,8.0546875,9.0546875,10.0546875,11.0546875,12.0546875,13.0546875,14.0546875,15.0546875,16.054688,18.054688,20.054688,22.054688,24.054688,26.054688,28.054688,30.054688,32.054688,36.054688,40.054688,44.054688,48.054688,52.054688,56.054688,60.054688,64.05469,72.05469,80.05469,88.05469,96.05469,104.05469,112.05469,120.05469,128.05469,144.05469,160.05469,176.05469,192.05469,208.05469,224.05469,240.05469,256.0547,288.0547,320.0547,352.0547,384.0547,416.0547,448.0547,480.0547,0.05859375,0.049804688,0.048828125,0.047851562,0.046875,0.045898438,0.044921875,0.043945312,0.04296875,0.041015625,0.0390625,0.037109375,0.03515625,0.033203125,0.03125,0.029296875,0.02734375,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.01171875,0.01953125,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.08203125,0.09765625,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.22265625,0.25390625,0.28515625,0.31640625,0.34765625,0.37890625,0.41015625,0.44140625,0.50390625,0.56640625,0.62890625,0.69140625,0.75390625,0.81640625,0.87890625,0.94140625,1.0664062,1.1914062,1.3164062,1.4414062,1.5664062,1.6914062,1.8164062,1.9414062,2.1914062,2.4414062,2.6914062,2.9414062,3.1914062,3.4414062,3.6914062,3.9414062,4.4414062,4.9414062,5.4414062,5.9414062,6.4414062,6.9414062,7.4414062,7.9414062,8.941406,9.941406,10.941406,11.941406,12.941406,13.941406,14.941406,15.941406,17.941406,19.941406,21.941406,23.941406,25.941406,27.941406,29.941406,31.941406,35.941406,39.941406,43.941406,47.941406,51.941406,55.941406,59.941406,63.941406,71.94141,79.94141,87.94141,95.94141,103.94141,111.94141,119.94141,127.94141,143.9414,159.9414,175.9414,191.9414,207.9414,223.9414,239.9414,255.9414,287.9414,319.9414,351.9414,383.9414,415.9414,447.9414,479.9414,0.06640625,0.06738281,0.068359375,0.06933594,0.0703125,0.07128906,0.072265625,0.07324219,0.07421875,0.076171875,0.078125,0.080078125,0.08203125,0.083984375,0.0859375,0.087890625,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.16796875,0.17578125,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.27734375,0.29296875,0.30859375,0.33984375,0.37109375,0.40234375,0.43359375,0.46484375,0.49609375,0.52734375,0.55859375,0.62109375,0.68359375,0.74609375,0.80859375,0.87109375,0.93359375,0.99609375,1.0585938,1.1835938,1.3085938,1.4335938,1.5585938,1.6835938,1.8085938,1.9335938,2.0585938,2.3085938,2.5585938,2.8085938,3.0585938,3.3085938,3.5585938,3.8085938,4.0585938,4.5585938,5.0585938,5.5585938,6.0585938,6.5585938,7.0585938,7.5585938,8.058594,9.058594,10.058594,11.058594,12.058594,13.058594,14.058594,15.058594,16.058594,18.058594,20.058594,22.058594,24.058594,26.058594,28.058594,30.058594,32.058594,36.058594,40.058594,44.058594,48.058594,52.058594,56.058594,60.058594,64.05859,72.05859,80.05859,88.05859,96.05859,104.05859,112.05859,120.05859,128.0586,144.0586,160.0586,176.0586,192.0586,208.0586,224.0586,240.0586,256.0586,288.0586,320.0586,352.0586,384.0586,416.0586,448.0586,480.0586,0.0625,0.053710938,0.052734375,0.051757812,0.05078125,0.049804688,0.048828125,0.047851562,0.046875,0.044921875,0.04296875,0.041015625,0.0390625,0.037109375,0.03515625,0.033203125,0.03125,0.02734375,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.875,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.4375,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,7.9375,8.9375,9.9375,10.9375,11.9375,12.9375,13.9375,14.9375,15.9375,17.9375,19.9375,21.9375,23.9375,25.9375,27.9375,29.9375,31.9375,35.9375,39.9375,43.9375,47.9375,51.9375,55.9375,59.9375,63.9375,71.9375,79.9375,87.9375,95.9375,103.9375,111.9375,119.9375,127.9375,143.9375,159.9375,175.9375,191.9375,207.9375,223.9375,239.9375,255.9375,287.9375,319.9375,351.9375,383.9375,415.9375,447.9375,479.9375,0.0703125,0.07128906,0.072265625,0.07324219,0.07421875,0.07519531,0.076171875,0.07714844,0.078125,0.080078125,0.08203125,0.083984375,0.0859375,0.087890625,0.08984375,0.091796875,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.12109375,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.625,0.6875,0.75,0.8125,0.875,0.9375,1,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,7.5625,8.0625,9.0625,10.0625,11.0625,12.0625,13.0625,14.0625,15.0625,16.0625,18.0625,20.0625,22.0625,24.0625,26.0625,28.0625,30.0625,32.0625,36.0625,40.0625,44.0625,48.0625,52.0625,56.0625,60.0625,64.0625,72.0625,80.0625,88.0625,96.0625,104.0625,112.0625,120.0625,128.0625,144.0625,160.0625,176.0625,192.0625,208.0625,224.0625,240.0625,256.0625,288.0625,320.0625,352.0625,384.0625,416.0625,448.0625,480.0625,0.0703125,0.061523438,0.060546875,0.059570312,0.05859375,0.057617188,0.056640625,0.055664062,0.0546875,0.052734375,0.05078125,0.048828125,0.046875,0.044921875,0.04296875,0.041015625,0.0390625,0.03515625,0.03125,0.02734375,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.2109375,0.2421875,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4921875,0.5546875,0.6171875,0.6796875,0.7421875,0.8046875,0.8671875,0.9296875,1.0546875,1.1796875,1.3046875,1.4296875,1.5546875,1.6796875,1.8046875,1.9296875,2.1796875,2.4296875,2.6796875,2.9296875,3.1796875,3.4296875,3.6796875,3.9296875,4.4296875,4.9296875,5.4296875,5.9296875,6.4296875,6.9296875,7.4296875,7.9296875,8.9296875,9.9296875,10.9296875,11.9296875,12.9296875,13.9296875,14.9296875,15.9296875,17.929688,19.929688,21.929688,23.929688,25.929688,27.929688,29.929688,31.929688,35.929688,39.929688,43.929688,47.929688,51.929688,55.929688,59.929688,63.929688,71.92969,79.92969,87.92969,95.92969,103.92969,111.92969,119.92969,127.92969,143.92969,159.92969,175.92969,191.92969,207.92969,223.92969,239.92969,255.92969,287.9297,319.9297,351.9297,383.9297,415.9297,447.9297,479.9297,0.078125,0.07910156,0.080078125,0.08105469,0.08203125,0.08300781,0.083984375,0.08496094,0.0859375,0.087890625,0.08984375,0.091796875,0.09375,0.095703125,0.09765625,0.099609375,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.12109375,0.125,0.12890625,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5078125,0.5390625,0.5703125,0.6328125,0.6953125,0.7578125,0.8203125,0.8828125,0.9453125,1.0078125,1.0703125,1.1953125,1.3203125,1.4453125,1.5703125,1.6953125,1.8203125,1.9453125,2.0703125,2.3203125,2.5703125,2.8203125,3.0703125,3.3203125,3.5703125,3.8203125,4.0703125,4.5703125,5.0703125,5.5703125,6.0703125,6.5703125,7.0703125,7.5703125,8.0703125,9.0703125,10.0703125,11.0703125,12.0703125,13.0703125,14.0703125,15.0703125,16.070312,18.070312,20.070312,22.070312,24.070312,26.070312,28.070312,30.070312,32.070312,36.070312,40.070312,44.070312,48.070312,52.070312,56.070312,60.070312,64.07031,72.07031,80.07031,88.07031,96.07031,104.07031,112.07031,120.07031,128.07031,144.07031,160.07031,176.07031,192.07031,208.07031,224.07031,240.07031,256.0703,288.0703,320.0703,352.0703,384.0703,416.0703,448.0703,480.0703,0.078125,0.06933594,0.068359375,0.06738281,0.06640625,0.06542969,0.064453125,0.06347656,0.0625,0.060546875,0.05859375,0.056640625,0.0546875,0.052734375,0.05078125,0.048828125,0.046875,0.04296875,0.0390625,0.03515625,0.03125,0.02734375,0.0234375,0.01953125,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,1.046875,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,1.921875,2.171875,2.421875,2.671875,2.921875,3.171875,3.421875,3.671875,3.921875,4.421875,4.921875,5.421875,5.921875,6.421875,6.921875,7.421875,7.921875,8.921875,9.921875,10.921875,11.921875,12.921875,13.921875,14.921875,15.921875,17.921875,19.921875,21.921875,23.921875,25.921875,27.921875,29.921875,31.921875,35.921875,39.921875,43.921875,47.921875,51.921875,55.921875,59.921875,63.921875,71.921875,79.921875,87.921875,95.921875,103.921875,111.921875,119.921875,127.921875,143.92188,159.92188,175.92188,191.92188,207.92188,223.92188,239.92188,255.92188,287.92188,319.92188,351.92188,383.92188,415.92188,447.92188,479.92188,0.0859375,0.08691406,0.087890625,0.08886719,0.08984375,0.09082031,0.091796875,0.09277344,0.09375,0.095703125,0.09765625,0.099609375,0.1015625,0.103515625,0.10546875,0.107421875,0.109375,0.11328125,0.1171875,0.12109375,0.125,0.12890625,0.1328125,0.13671875,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.078125,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,1.953125,2.078125,2.328125,2.578125,2.828125,3.078125,3.328125,3.578125,3.828125,4.078125,4.578125,5.078125,5.578125,6.078125,6.578125,7.078125,7.578125,8.078125,9.078125,10.078125,11.078125,12.078125,13.078125,14.078125,15.078125,16.078125,18.078125,20.078125,22.078125,24.078125,26.078125,28.078125,30.078125,32.078125,36.078125,40.078125,44.078125,48.078125,52.078125,56.078125,60.078125,64.078125,72.078125,80.078125,88.078125,96.078125,104.078125,112.078125,120.078125,128.07812,144.07812,160.07812,176.07812,192.07812,208.07812,224.07812,240.07812,256.07812,288.07812,320.07812,352.07812,384.07812,416.07812,448.07812,480.07812,0.0859375,0.07714844,0.076171875,0.07519531,0.07421875,0.07324219,0.072265625,0.07128906,0.0703125,0.068359375,0.06640625,0.064453125,0.0625,0.060546875,0.05859375,0.056640625,0.0546875,0.05078125,0.046875,0.04296875,0.0390625,0.03515625,0.03125,0.02734375,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1953125,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4765625,0.5390625,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,1.0390625,1.1640625,1.2890625,1.4140625,1.5390625,1.6640625,1.7890625,1.9140625,2.1640625,2.4140625,2.6640625,2.9140625,3.1640625,3.4140625,3.6640625,3.9140625,4.4140625,4.9140625,5.4140625,5.9140625,6.4140625,6.9140625,7.4140625,7.9140625,8.9140625,9.9140625,10.9140625,11.9140625,12.9140625,13.9140625,14.9140625,15.9140625,17.914062,19.914062,21.914062,23.914062,25.914062,27.914062,29.914062,31.914062,35.914062,39.914062,43.914062,47.914062,51.914062,55.914062,59.914062,63.914062,71.91406,79.91406,87.91406,95.91406,103.91406,111.91406,119.91406,127.91406,143.91406,159.91406,175.91406,191.91406,207.91406,223.91406,239.91406,255.91406,287.91406,319.91406,351.91406,383.91406,415.91406,447.91406,479.91406,0.09375,0.09472656,0.095703125,0.09667969,0.09765625,0.09863281,0.099609375,0.10058594,0.1015625,0.103515625,0.10546875,0.107421875,0.109375,0.111328125,0.11328125,0.115234375,0.1171875,0.12109375,0.125,0.12890625,0.1328125,0.13671875,0.140625,0.14453125,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5546875,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,0.9609375,1.0234375,1.0859375,1.2109375,1.3359375,1.4609375,1.5859375,1.7109375,1.8359375,1.9609375,2.0859375,2.3359375,2.5859375,2.8359375,3.0859375,3.3359375,3.5859375,3.8359375,4.0859375,4.5859375,5.0859375,5.5859375,6.0859375,6.5859375,7.0859375,7.5859375,8.0859375,9.0859375,10.0859375,11.0859375,12.0859375,13.0859375,14.0859375,15.0859375,16.085938,18.085938,20.085938,22.085938,24.085938,26.085938,28.085938,30.085938,32.085938,36.085938,40.085938,44.085938,48.085938,52.085938,56.085938,60.085938,64.08594,72.08594,80.08594,88.08594,96.08594,104.08594,112.08594,120.08594,128.08594,144.08594,160.08594,176.08594,192.08594,208.08594,224.08594,240.08594,256.08594,288.08594,320.08594,352.08594,384.08594,416.08594,448.08594,480.08594,0.09375,0.08496094,0.083984375,0.08300781,0.08203125,0.08105469,0.080078125,0.07910156,0.078125,0.076171875,0.07421875,0.072265625,0.0703125,0.068359375,0.06640625,0.064453125,0.0625,0.05859375,0.0546875,0.05078125,0.046875,0.04296875,0.0390625,0.03515625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.15625,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,3.90625,4.40625,4.90625,5.40625,5.90625,6.40625,6.90625,7.40625,7.90625,8.90625,9.90625,10.90625,11.90625,12.90625,13.90625,14.90625,15.90625,17.90625,19.90625,21.90625,23.90625,25.90625,27.90625,29.90625,31.90625,35.90625,39.90625,43.90625,47.90625,51.90625,55.90625,59.90625,63.90625,71.90625,79.90625,87.90625,95.90625,103.90625,111.90625,119.90625,127.90625,143.90625,159.90625,175.90625,191.90625,207.90625,223.90625,239.90625,255.90625,287.90625,319.90625,351.90625,383.90625,415.90625,447.90625,479.90625,0.1015625,0.10253906,0.103515625,0.10449219,0.10546875,0.10644531,0.107421875,0.10839844,0.109375,0.111328125,0.11328125,0.115234375,0.1171875,0.119140625,0.12109375,0.123046875,0.125,0.12890625,0.1328125,0.13671875,0.140625,0.14453125,0.1484375,0.15234375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,3.84375,4.09375,4.59375,5.09375,5.59375,6.09375,6.59375,7.09375,7.59375,8.09375,9.09375,10.09375,11.09375,12.09375,13.09375,14.09375,15.09375,16.09375,18.09375,20.09375,22.09375,24.09375,26.09375,28.09375,30.09375,32.09375,36.09375,40.09375,44.09375,48.09375,52.09375,56.09375,60.09375,64.09375,72.09375,80.09375,88.09375,96.09375,104.09375,112.09375,120.09375,128.09375,144.09375,160.09375,176.09375,192.09375,208.09375,224.09375,240.09375,256.09375,288.09375,320.09375,352.09375,384.09375,416.09375,448.09375,480.09375,0.1015625,0.09277344,0.091796875,0.09082031,0.08984375,0.08886719,0.087890625,0.08691406,0.0859375,0.083984375,0.08203125,0.080078125,0.078125,0.076171875,0.07421875,0.072265625,0.0703125,0.06640625,0.0625,0.05859375,0.0546875,0.05078125,0.046875,0.04296875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.0390625,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1796875,0.2109375,0.2421875,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4609375,0.5234375,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,1.0234375,1.1484375,1.2734375,1.3984375,1.5234375,1.6484375,1.7734375,1.8984375,2.1484375,2.3984375,2.6484375,2.8984375,3.1484375,3.3984375,3.6484375,3.8984375,4.3984375,4.8984375,5.3984375,5.8984375,6.3984375,6.8984375,7.3984375,7.8984375,8.8984375,9.8984375,10.8984375,11.8984375,12.8984375,13.8984375,14.8984375,15.8984375,17.898438,19.898438,21.898438,23.898438,25.898438,27.898438,29.898438,31.898438,35.898438,39.898438,43.898438,47.898438,51.898438,55.898438,59.898438,63.898438,71.89844,79.89844,87.89844,95.89844,103.89844,111.89844,119.89844,127.89844,143.89844,159.89844,175.89844,191.89844,207.89844,223.89844,239.89844,255.89844,287.89844,319.89844,351.89844,383.89844,415.89844,447.89844,479.89844,0.109375,0.11035156,0.111328125,0.11230469,0.11328125,0.11425781,0.115234375,0.11621094,0.1171875,0.119140625,0.12109375,0.123046875,0.125,0.12695312,0.12890625,0.13085938,0.1328125,0.13671875,0.140625,0.14453125,0.1484375,0.15234375,0.15625,0.16015625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3359375,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5078125,0.5390625,0.5703125,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,0.9765625,1.0390625,1.1015625,1.2265625,1.3515625,1.4765625,1.6015625,1.7265625,1.8515625,1.9765625,2.1015625,2.3515625,2.6015625,2.8515625,3.1015625,3.3515625,3.6015625,3.8515625,4.1015625,4.6015625,5.1015625,5.6015625,6.1015625,6.6015625,7.1015625,7.6015625,8.1015625,9.1015625,10.1015625,11.1015625,12.1015625,13.1015625,14.1015625,15.1015625,16.101562,18.101562,20.101562,22.101562,24.101562,26.101562,28.101562,30.101562,32.101562,36.101562,40.101562,44.101562,48.101562,52.101562,56.101562,60.101562,64.10156,72.10156,80.10156,88.10156,96.10156,104.10156,112.10156,120.10156,128.10156,144.10156,160.10156,176.10156,192.10156,208.10156,224.10156,240.10156,256.10156,288.10156,320.10156,352.10156,384.10156,416.10156,448.10156,480.10156,0.109375,0.10058594,0.099609375,0.09863281,0.09765625,0.09667969,0.095703125,0.09472656,0.09375,0.091796875,0.08984375,0.087890625,0.0859375,0.083984375,0.08203125,0.080078125,0.078125,0.07421875,0.0703125,0.06640625,0.0625,0.05859375,0.0546875,0.05078125,0.046875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,1.015625,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,1.890625,2.140625,2.390625,2.640625,2.890625,3.140625,3.390625,3.640625,3.890625,4.390625,4.890625,5.390625,5.890625,6.390625,6.890625,7.390625,7.890625,8.890625,9.890625,10.890625,11.890625,12.890625,13.890625,14.890625,15.890625,17.890625,19.890625,21.890625,23.890625,25.890625,27.890625,29.890625,31.890625,35.890625,39.890625,43.890625,47.890625,51.890625,55.890625,59.890625,63.890625,71.890625,79.890625,87.890625,95.890625,103.890625,111.890625,119.890625,127.890625,143.89062,159.89062,175.89062,191.89062,207.89062,223.89062,239.89062,255.89062,287.89062,319.89062,351.89062,383.89062,415.89062,447.89062,479.89062,0.1171875,0.11816406,0.119140625,0.12011719,0.12109375,0.12207031,0.123046875,0.12402344,0.125,0.12695312,0.12890625,0.13085938,0.1328125,0.13476562,0.13671875,0.13867188,0.140625,0.14453125,0.1484375,0.15234375,0.15625,0.16015625,0.1640625,0.16796875,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.109375,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,1.984375,2.109375,2.359375,2.609375,2.859375,3.109375,3.359375,3.609375,3.859375,4.109375,4.609375,5.109375,5.609375,6.109375,6.609375,7.109375,7.609375,8.109375,9.109375,10.109375,11.109375,12.109375,13.109375,14.109375,15.109375,16.109375,18.109375,20.109375,22.109375,24.109375,26.109375,28.109375,30.109375,32.109375,36.109375,40.109375,44.109375,48.109375,52.109375,56.109375,60.109375,64.109375,72.109375,80.109375,88.109375,96.109375,104.109375,112.109375,120.109375,128.10938,144.10938,160.10938,176.10938,192.10938,208.10938,224.10938,240.10938,256.10938,288.10938,320.10938,352.10938,384.10938,416.10938,448.10938,480.10938,0.1171875,0.10839844,0.107421875,0.10644531,0.10546875,0.10449219,0.103515625,0.10253906,0.1015625,0.099609375,0.09765625,0.095703125,0.09375,0.091796875,0.08984375,0.087890625,0.0859375,0.08203125,0.078125,0.07421875,0.0703125,0.06640625,0.0625,0.05859375,0.0546875,0.046875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.0234375,0.0390625,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1640625,0.1953125,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4453125,0.5078125,0.5703125,0.6328125,0.6953125,0.7578125,0.8203125,0.8828125,1.0078125,1.1328125,1.2578125,1.3828125,1.5078125,1.6328125,1.7578125,1.8828125,2.1328125,2.3828125,2.6328125,2.8828125,3.1328125,3.3828125,3.6328125,3.8828125,4.3828125,4.8828125,5.3828125,5.8828125,6.3828125,6.8828125,7.3828125,7.8828125,8.8828125,9.8828125,10.8828125,11.8828125,12.8828125,13.8828125,14.8828125,15.8828125,17.882812,19.882812,21.882812,23.882812,25.882812,27.882812,29.882812,31.882812,35.882812,39.882812,43.882812,47.882812,51.882812,55.882812,59.882812,63.882812,71.88281,79.88281,87.88281,95.88281,103.88281,111.88281,119.88281,127.88281,143.88281,159.88281,175.88281,191.88281,207.88281,223.88281,239.88281,255.88281,287.8828,319.8828,351.8828,383.8828,415.8828,447.8828,479.8828,0.125,0.12597656,0.12695312,0.12792969,0.12890625,0.12988281,0.13085938,0.13183594,0.1328125,0.13476562,0.13671875,0.13867188,0.140625,0.14257812,0.14453125,0.14648438,0.1484375,0.15234375,0.15625,0.16015625,0.1640625,0.16796875,0.171875,0.17578125,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3359375,0.3515625,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5546875,0.5859375,0.6171875,0.6796875,0.7421875,0.8046875,0.8671875,0.9296875,0.9921875,1.0546875,1.1171875,1.2421875,1.3671875,1.4921875,1.6171875,1.7421875,1.8671875,1.9921875,2.1171875,2.3671875,2.6171875,2.8671875,3.1171875,3.3671875,3.6171875,3.8671875,4.1171875,4.6171875,5.1171875,5.6171875,6.1171875,6.6171875,7.1171875,7.6171875,8.1171875,9.1171875,10.1171875,11.1171875,12.1171875,13.1171875,14.1171875,15.1171875,16.117188,18.117188,20.117188,22.117188,24.117188,26.117188,28.117188,30.117188,32.117188,36.117188,40.117188,44.117188,48.117188,52.117188,56.117188,60.117188,64.11719,72.11719,80.11719,88.11719,96.11719,104.11719,112.11719,120.11719,128.11719,144.11719,160.11719,176.11719,192.11719,208.11719,224.11719,240.11719,256.1172,288.1172,320.1172,352.1172,384.1172,416.1172,448.1172,480.1172,0.125,0.11621094,0.115234375,0.11425781,0.11328125,0.11230469,0.111328125,0.11035156,0.109375,0.107421875,0.10546875,0.103515625,0.1015625,0.099609375,0.09765625,0.095703125,0.09375,0.08984375,0.0859375,0.08203125,0.078125,0.07421875,0.0703125,0.06640625,0.0625,0.0546875,0.046875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.875,1,1.125,1.25,1.375,1.5,1.625,1.75,1.875,2.125,2.375,2.625,2.875,3.125,3.375,3.625,3.875,4.375,4.875,5.375,5.875,6.375,6.875,7.375,7.875,8.875,9.875,10.875,11.875,12.875,13.875,14.875,15.875,17.875,19.875,21.875,23.875,25.875,27.875,29.875,31.875,35.875,39.875,43.875,47.875,51.875,55.875,59.875,63.875,71.875,79.875,87.875,95.875,103.875,111.875,119.875,127.875,143.875,159.875,175.875,191.875,207.875,223.875,239.875,255.875,287.875,319.875,351.875,383.875,415.875,447.875,479.875,0.1328125,0.13378906,0.13476562,0.13574219,0.13671875,0.13769531,0.13867188,0.13964844,0.140625,0.14257812,0.14453125,0.14648438,0.1484375,0.15039062,0.15234375,0.15429688,0.15625,0.16015625,0.1640625,0.16796875,0.171875,0.17578125,0.1796875,0.18359375,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.2421875,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.6875,0.75,0.8125,0.875,0.9375,1,1.0625,1.125,1.25,1.375,1.5,1.625,1.75,1.875,2,2.125,2.375,2.625,2.875,3.125,3.375,3.625,3.875,4.125,4.625,5.125,5.625,6.125,6.625,7.125,7.625,8.125,9.125,10.125,11.125,12.125,13.125,14.125,15.125,16.125,18.125,20.125,22.125,24.125,26.125,28.125,30.125,32.125,36.125,40.125,44.125,48.125,52.125,56.125,60.125,64.125,72.125,80.125,88.125,96.125,104.125,112.125,120.125,128.125,144.125,160.125,176.125,192.125,208.125,224.125,240.125,256.125,288.125,320.125,352.125,384.125,416.125,448.125,480.125,0.140625,0.13183594,0.13085938,0.12988281,0.12890625,0.12792969,0.12695312,0.12597656,0.125,0.123046875,0.12109375,0.119140625,0.1171875,0.115234375,0.11328125,0.111328125,0.109375,0.10546875,0.1015625,0.09765625,0.09375,0.08984375,0.0859375,0.08203125,0.078125,0.0703125,0.0625,0.0546875,0.046875,0.0390625,0.03125,0.0234375,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.421875,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.984375,1.109375,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,2.109375,2.359375,2.609375,2.859375,3.109375,3.359375,3.609375,3.859375,4.359375,4.859375,5.359375,5.859375,6.359375,6.859375,7.359375,7.859375,8.859375,9.859375,10.859375,11.859375,12.859375,13.859375,14.859375,15.859375,17.859375,19.859375,21.859375,23.859375,25.859375,27.859375,29.859375,31.859375,35.859375,39.859375,43.859375,47.859375,51.859375,55.859375,59.859375,63.859375,71.859375,79.859375,87.859375,95.859375,103.859375,111.859375,119.859375,127.859375,143.85938,159.85938,175.85938,191.85938,207.85938,223.85938,239.85938,255.85938,287.85938,319.85938,351.85938,383.85938,415.85938,447.85938,479.85938,0.1484375,0.14941406,0.15039062,0.15136719,0.15234375,0.15332031,0.15429688,0.15527344,0.15625,0.15820312,0.16015625,0.16210938,0.1640625,0.16601562,0.16796875,0.16992188,0.171875,0.17578125,0.1796875,0.18359375,0.1875,0.19140625,0.1953125,0.19921875,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.2421875,0.25,0.2578125,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.078125,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,1.890625,2.015625,2.140625,2.390625,2.640625,2.890625,3.140625,3.390625,3.640625,3.890625,4.140625,4.640625,5.140625,5.640625,6.140625,6.640625,7.140625,7.640625,8.140625,9.140625,10.140625,11.140625,12.140625,13.140625,14.140625,15.140625,16.140625,18.140625,20.140625,22.140625,24.140625,26.140625,28.140625,30.140625,32.140625,36.140625,40.140625,44.140625,48.140625,52.140625,56.140625,60.140625,64.140625,72.140625,80.140625,88.140625,96.140625,104.140625,112.140625,120.140625,128.14062,144.14062,160.14062,176.14062,192.14062,208.14062,224.14062,240.14062,256.14062,288.14062,320.14062,352.14062,384.14062,416.14062,448.14062,480.14062,0.15625,0.14746094,0.14648438,0.14550781,0.14453125,0.14355469,0.14257812,0.14160156,0.140625,0.13867188,0.13671875,0.13476562,0.1328125,0.13085938,0.12890625,0.12695312,0.125,0.12109375,0.1171875,0.11328125,0.109375,0.10546875,0.1015625,0.09765625,0.09375,0.0859375,0.078125,0.0703125,0.0625,0.0546875,0.046875,0.0390625,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,2.09375,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,3.84375,4.34375,4.84375,5.34375,5.84375,6.34375,6.84375,7.34375,7.84375,8.84375,9.84375,10.84375,11.84375,12.84375,13.84375,14.84375,15.84375,17.84375,19.84375,21.84375,23.84375,25.84375,27.84375,29.84375,31.84375,35.84375,39.84375,43.84375,47.84375,51.84375,55.84375,59.84375,63.84375,71.84375,79.84375,87.84375,95.84375,103.84375,111.84375,119.84375,127.84375,143.84375,159.84375,175.84375,191.84375,207.84375,223.84375,239.84375,255.84375,287.84375,319.84375,351.84375,383.84375,415.84375,447.84375,479.84375,0.1640625,0.16503906,0.16601562,0.16699219,0.16796875,0.16894531,0.16992188,0.17089844,0.171875,0.17382812,0.17578125,0.17773438,0.1796875,0.18164062,0.18359375,0.18554688,0.1875,0.19140625,0.1953125,0.19921875,0.203125,0.20703125,0.2109375,0.21484375,0.21875,0.2265625,0.234375,0.2421875,0.25,0.2578125,0.265625,0.2734375,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.15625,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,3.90625,4.15625,4.65625,5.15625,5.65625,6.15625,6.65625,7.15625,7.65625,8.15625,9.15625,10.15625,11.15625,12.15625,13.15625,14.15625,15.15625,16.15625,18.15625,20.15625,22.15625,24.15625,26.15625,28.15625,30.15625,32.15625,36.15625,40.15625,44.15625,48.15625,52.15625,56.15625,60.15625,64.15625,72.15625,80.15625,88.15625,96.15625,104.15625,112.15625,120.15625,128.15625,144.15625,160.15625,176.15625,192.15625,208.15625,224.15625,240.15625,256.15625,288.15625,320.15625,352.15625,384.15625,416.15625,448.15625,480.15625,0.171875,0.16308594,0.16210938,0.16113281,0.16015625,0.15917969,0.15820312,0.15722656,0.15625,0.15429688,0.15234375,0.15039062,0.1484375,0.14648438,0.14453125,0.14257812,0.140625,0.13671875,0.1328125,0.12890625,0.125,0.12109375,0.1171875,0.11328125,0.109375,0.1015625,0.09375,0.0859375,0.078125,0.0703125,0.0625,0.0546875,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.390625,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.953125,1.078125,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,2.078125,2.328125,2.578125,2.828125,3.078125,3.328125,3.578125,3.828125,4.328125,4.828125,5.328125,5.828125,6.328125,6.828125,7.328125,7.828125,8.828125,9.828125,10.828125,11.828125,12.828125,13.828125,14.828125,15.828125,17.828125,19.828125,21.828125,23.828125,25.828125,27.828125,29.828125,31.828125,35.828125,39.828125,43.828125,47.828125,51.828125,55.828125,59.828125,63.828125,71.828125,79.828125,87.828125,95.828125,103.828125,111.828125,119.828125,127.828125,143.82812,159.82812,175.82812,191.82812,207.82812,223.82812,239.82812,255.82812,287.82812,319.82812,351.82812,383.82812,415.82812,447.82812,479.82812,0.1796875,0.18066406,0.18164062,0.18261719,0.18359375,0.18457031,0.18554688,0.18652344,0.1875,0.18945312,0.19140625,0.19335938,0.1953125,0.19726562,0.19921875,0.20117188,0.203125,0.20703125,0.2109375,0.21484375,0.21875,0.22265625,0.2265625,0.23046875,0.234375,0.2421875,0.25,0.2578125,0.265625,0.2734375,0.28125,0.2890625,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.109375,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,1.921875,2.046875,2.171875,2.421875,2.671875,2.921875,3.171875,3.421875,3.671875,3.921875,4.171875,4.671875,5.171875,5.671875,6.171875,6.671875,7.171875,7.671875,8.171875,9.171875,10.171875,11.171875,12.171875,13.171875,14.171875,15.171875,16.171875,18.171875,20.171875,22.171875,24.171875,26.171875,28.171875,30.171875,32.171875,36.171875,40.171875,44.171875,48.171875,52.171875,56.171875,60.171875,64.171875,72.171875,80.171875,88.171875,96.171875,104.171875,112.171875,120.171875,128.17188,144.17188,160.17188,176.17188,192.17188,208.17188,224.17188,240.17188,256.17188,288.17188,320.17188,352.17188,384.17188,416.17188,448.17188,480.17188,0.1875,0.17871094,0.17773438,0.17675781,0.17578125,0.17480469,0.17382812,0.17285156,0.171875,0.16992188,0.16796875,0.16601562,0.1640625,0.16210938,0.16015625,0.15820312,0.15625,0.15234375,0.1484375,0.14453125,0.140625,0.13671875,0.1328125,0.12890625,0.125,0.1171875,0.109375,0.1015625,0.09375,0.0859375,0.078125,0.0703125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.3125,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,7.8125,8.8125,9.8125,10.8125,11.8125,12.8125,13.8125,14.8125,15.8125,17.8125,19.8125,21.8125,23.8125,25.8125,27.8125,29.8125,31.8125,35.8125,39.8125,43.8125,47.8125,51.8125,55.8125,59.8125,63.8125,71.8125,79.8125,87.8125,95.8125,103.8125,111.8125,119.8125,127.8125,143.8125,159.8125,175.8125,191.8125,207.8125,223.8125,239.8125,255.8125,287.8125,319.8125,351.8125,383.8125,415.8125,447.8125,479.8125,0.1953125,0.19628906,0.19726562,0.19824219,0.19921875,0.20019531,0.20117188,0.20214844,0.203125,0.20507812,0.20703125,0.20898438,0.2109375,0.21289062,0.21484375,0.21679688,0.21875,0.22265625,0.2265625,0.23046875,0.234375,0.23828125,0.2421875,0.24609375,0.25,0.2578125,0.265625,0.2734375,0.28125,0.2890625,0.296875,0.3046875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.75,0.8125,0.875,0.9375,1,1.0625,1.125,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,7.6875,8.1875,9.1875,10.1875,11.1875,12.1875,13.1875,14.1875,15.1875,16.1875,18.1875,20.1875,22.1875,24.1875,26.1875,28.1875,30.1875,32.1875,36.1875,40.1875,44.1875,48.1875,52.1875,56.1875,60.1875,64.1875,72.1875,80.1875,88.1875,96.1875,104.1875,112.1875,120.1875,128.1875,144.1875,160.1875,176.1875,192.1875,208.1875,224.1875,240.1875,256.1875,288.1875,320.1875,352.1875,384.1875,416.1875,448.1875,480.1875,0.203125,0.19433594,0.19335938,0.19238281,0.19140625,0.19042969,0.18945312,0.18847656,0.1875,0.18554688,0.18359375,0.18164062,0.1796875,0.17773438,0.17578125,0.17382812,0.171875,0.16796875,0.1640625,0.16015625,0.15625,0.15234375,0.1484375,0.14453125,0.140625,0.1328125,0.125,0.1171875,0.109375,0.1015625,0.09375,0.0859375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.078125,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.359375,0.421875,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.921875,1.046875,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,2.046875,2.296875,2.546875,2.796875,3.046875,3.296875,3.546875,3.796875,4.296875,4.796875,5.296875,5.796875,6.296875,6.796875,7.296875,7.796875,8.796875,9.796875,10.796875,11.796875,12.796875,13.796875,14.796875,15.796875,17.796875,19.796875,21.796875,23.796875,25.796875,27.796875,29.796875,31.796875,35.796875,39.796875,43.796875,47.796875,51.796875,55.796875,59.796875,63.796875,71.796875,79.796875,87.796875,95.796875,103.796875,111.796875,119.796875,127.796875,143.79688,159.79688,175.79688,191.79688,207.79688,223.79688,239.79688,255.79688,287.79688,319.79688,351.79688,383.79688,415.79688,447.79688,479.79688,0.2109375,0.21191406,0.21289062,0.21386719,0.21484375,0.21582031,0.21679688,0.21777344,0.21875,0.22070312,0.22265625,0.22460938,0.2265625,0.22851562,0.23046875,0.23242188,0.234375,0.23828125,0.2421875,0.24609375,0.25,0.25390625,0.2578125,0.26171875,0.265625,0.2734375,0.28125,0.2890625,0.296875,0.3046875,0.3125,0.3203125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.671875,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.078125,1.140625,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,1.953125,2.078125,2.203125,2.453125,2.703125,2.953125,3.203125,3.453125,3.703125,3.953125,4.203125,4.703125,5.203125,5.703125,6.203125,6.703125,7.203125,7.703125,8.203125,9.203125,10.203125,11.203125,12.203125,13.203125,14.203125,15.203125,16.203125,18.203125,20.203125,22.203125,24.203125,26.203125,28.203125,30.203125,32.203125,36.203125,40.203125,44.203125,48.203125,52.203125,56.203125,60.203125,64.203125,72.203125,80.203125,88.203125,96.203125,104.203125,112.203125,120.203125,128.20312,144.20312,160.20312,176.20312,192.20312,208.20312,224.20312,240.20312,256.20312,288.20312,320.20312,352.20312,384.20312,416.20312,448.20312,480.20312,0.21875,0.20996094,0.20898438,0.20800781,0.20703125,0.20605469,0.20507812,0.20410156,0.203125,0.20117188,0.19921875,0.19726562,0.1953125,0.19335938,0.19140625,0.18945312,0.1875,0.18359375,0.1796875,0.17578125,0.171875,0.16796875,0.1640625,0.16015625,0.15625,0.1484375,0.140625,0.1328125,0.125,0.1171875,0.109375,0.1015625,0.09375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,2.03125,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,3.78125,4.28125,4.78125,5.28125,5.78125,6.28125,6.78125,7.28125,7.78125,8.78125,9.78125,10.78125,11.78125,12.78125,13.78125,14.78125,15.78125,17.78125,19.78125,21.78125,23.78125,25.78125,27.78125,29.78125,31.78125,35.78125,39.78125,43.78125,47.78125,51.78125,55.78125,59.78125,63.78125,71.78125,79.78125,87.78125,95.78125,103.78125,111.78125,119.78125,127.78125,143.78125,159.78125,175.78125,191.78125,207.78125,223.78125,239.78125,255.78125,287.78125,319.78125,351.78125,383.78125,415.78125,447.78125,479.78125,0.2265625,0.22753906,0.22851562,0.22949219,0.23046875,0.23144531,0.23242188,0.23339844,0.234375,0.23632812,0.23828125,0.24023438,0.2421875,0.24414062,0.24609375,0.24804688,0.25,0.25390625,0.2578125,0.26171875,0.265625,0.26953125,0.2734375,0.27734375,0.28125,0.2890625,0.296875,0.3046875,0.3125,0.3203125,0.328125,0.3359375,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.21875,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,3.96875,4.21875,4.71875,5.21875,5.71875,6.21875,6.71875,7.21875,7.71875,8.21875,9.21875,10.21875,11.21875,12.21875,13.21875,14.21875,15.21875,16.21875,18.21875,20.21875,22.21875,24.21875,26.21875,28.21875,30.21875,32.21875,36.21875,40.21875,44.21875,48.21875,52.21875,56.21875,60.21875,64.21875,72.21875,80.21875,88.21875,96.21875,104.21875,112.21875,120.21875,128.21875,144.21875,160.21875,176.21875,192.21875,208.21875,224.21875,240.21875,256.21875,288.21875,320.21875,352.21875,384.21875,416.21875,448.21875,480.21875,0.234375,0.22558594,0.22460938,0.22363281,0.22265625,0.22167969,0.22070312,0.21972656,0.21875,0.21679688,0.21484375,0.21289062,0.2109375,0.20898438,0.20703125,0.20507812,0.203125,0.19921875,0.1953125,0.19140625,0.1875,0.18359375,0.1796875,0.17578125,0.171875,0.1640625,0.15625,0.1484375,0.140625,0.1328125,0.125,0.1171875,0.109375,0.09375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.046875,0.078125,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.328125,0.390625,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.890625,1.015625,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,2.015625,2.265625,2.515625,2.765625,3.015625,3.265625,3.515625,3.765625,4.265625,4.765625,5.265625,5.765625,6.265625,6.765625,7.265625,7.765625,8.765625,9.765625,10.765625,11.765625,12.765625,13.765625,14.765625,15.765625,17.765625,19.765625,21.765625,23.765625,25.765625,27.765625,29.765625,31.765625,35.765625,39.765625,43.765625,47.765625,51.765625,55.765625,59.765625,63.765625,71.765625,79.765625,87.765625,95.765625,103.765625,111.765625,119.765625,127.765625,143.76562,159.76562,175.76562,191.76562,207.76562,223.76562,239.76562,255.76562,287.76562,319.76562,351.76562,383.76562,415.76562,447.76562,479.76562,0.2421875,0.24316406,0.24414062,0.24511719,0.24609375,0.24707031,0.24804688,0.24902344,0.25,0.25195312,0.25390625,0.25585938,0.2578125,0.25976562,0.26171875,0.26367188,0.265625,0.26953125,0.2734375,0.27734375,0.28125,0.28515625,0.2890625,0.29296875,0.296875,0.3046875,0.3125,0.3203125,0.328125,0.3359375,0.34375,0.3515625,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.46875,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.671875,0.703125,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.109375,1.171875,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,1.984375,2.109375,2.234375,2.484375,2.734375,2.984375,3.234375,3.484375,3.734375,3.984375,4.234375,4.734375,5.234375,5.734375,6.234375,6.734375,7.234375,7.734375,8.234375,9.234375,10.234375,11.234375,12.234375,13.234375,14.234375,15.234375,16.234375,18.234375,20.234375,22.234375,24.234375,26.234375,28.234375,30.234375,32.234375,36.234375,40.234375,44.234375,48.234375,52.234375,56.234375,60.234375,64.234375,72.234375,80.234375,88.234375,96.234375,104.234375,112.234375,120.234375,128.23438,144.23438,160.23438,176.23438,192.23438,208.23438,224.23438,240.23438,256.23438,288.23438,320.23438,352.23438,384.23438,416.23438,448.23438,480.23438,0.25,0.24121094,0.24023438,0.23925781,0.23828125,0.23730469,0.23632812,0.23535156,0.234375,0.23242188,0.23046875,0.22851562,0.2265625,0.22460938,0.22265625,0.22070312,0.21875,0.21484375,0.2109375,0.20703125,0.203125,0.19921875,0.1953125,0.19140625,0.1875,0.1796875,0.171875,0.1640625,0.15625,0.1484375,0.140625,0.1328125,0.125,0.109375,0.09375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.875,1,1.125,1.25,1.375,1.5,1.625,1.75,2,2.25,2.5,2.75,3,3.25,3.5,3.75,4.25,4.75,5.25,5.75,6.25,6.75,7.25,7.75,8.75,9.75,10.75,11.75,12.75,13.75,14.75,15.75,17.75,19.75,21.75,23.75,25.75,27.75,29.75,31.75,35.75,39.75,43.75,47.75,51.75,55.75,59.75,63.75,71.75,79.75,87.75,95.75,103.75,111.75,119.75,127.75,143.75,159.75,175.75,191.75,207.75,223.75,239.75,255.75,287.75,319.75,351.75,383.75,415.75,447.75,479.75,0.2578125,0.25878906,0.25976562,0.2607422,0.26171875,0.2626953,0.26367188,0.26464844,0.265625,0.26757812,0.26953125,0.27148438,0.2734375,0.27539062,0.27734375,0.27929688,0.28125,0.28515625,0.2890625,0.29296875,0.296875,0.30078125,0.3046875,0.30859375,0.3125,0.3203125,0.328125,0.3359375,0.34375,0.3515625,0.359375,0.3671875,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.46875,0.484375,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.8125,0.875,0.9375,1,1.0625,1.125,1.1875,1.25,1.375,1.5,1.625,1.75,1.875,2,2.125,2.25,2.5,2.75,3,3.25,3.5,3.75,4,4.25,4.75,5.25,5.75,6.25,6.75,7.25,7.75,8.25,9.25,10.25,11.25,12.25,13.25,14.25,15.25,16.25,18.25,20.25,22.25,24.25,26.25,28.25,30.25,32.25,36.25,40.25,44.25,48.25,52.25,56.25,60.25,64.25,72.25,80.25,88.25,96.25,104.25,112.25,120.25,128.25,144.25,160.25,176.25,192.25,208.25,224.25,240.25,256.25,288.25,320.25,352.25,384.25,416.25,448.25,480.25,0.28125,0.27246094,0.27148438,0.2705078,0.26953125,0.2685547,0.26757812,0.26660156,0.265625,0.26367188,0.26171875,0.25976562,0.2578125,0.25585938,0.25390625,0.25195312,0.25,0.24609375,0.2421875,0.23828125,0.234375,0.23046875,0.2265625,0.22265625,0.21875,0.2109375,0.203125,0.1953125,0.1875,0.1796875,0.171875,0.1640625,0.15625,0.140625,0.125,0.109375,0.09375,0.078125,0.0625,0.046875,0.03125,0,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.84375,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.96875,2.21875,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,4.21875,4.71875,5.21875,5.71875,6.21875,6.71875,7.21875,7.71875,8.71875,9.71875,10.71875,11.71875,12.71875,13.71875,14.71875,15.71875,17.71875,19.71875,21.71875,23.71875,25.71875,27.71875,29.71875,31.71875,35.71875,39.71875,43.71875,47.71875,51.71875,55.71875,59.71875,63.71875,71.71875,79.71875,87.71875,95.71875,103.71875,111.71875,119.71875,127.71875,143.71875,159.71875,175.71875,191.71875,207.71875,223.71875,239.71875,255.71875,287.71875,319.71875,351.71875,383.71875,415.71875,447.71875,479.71875,0.2890625,0.29003906,0.29101562,0.2919922,0.29296875,0.2939453,0.29492188,0.29589844,0.296875,0.29882812,0.30078125,0.30273438,0.3046875,0.30664062,0.30859375,0.31054688,0.3125,0.31640625,0.3203125,0.32421875,0.328125,0.33203125,0.3359375,0.33984375,0.34375,0.3515625,0.359375,0.3671875,0.375,0.3828125,0.390625,0.3984375,0.40625,0.421875,0.4375,0.453125,0.46875,0.484375,0.5,0.515625,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.15625,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,3.78125,4.03125,4.28125,4.78125,5.28125,5.78125,6.28125,6.78125,7.28125,7.78125,8.28125,9.28125,10.28125,11.28125,12.28125,13.28125,14.28125,15.28125,16.28125,18.28125,20.28125,22.28125,24.28125,26.28125,28.28125,30.28125,32.28125,36.28125,40.28125,44.28125,48.28125,52.28125,56.28125,60.28125,64.28125,72.28125,80.28125,88.28125,96.28125,104.28125,112.28125,120.28125,128.28125,144.28125,160.28125,176.28125,192.28125,208.28125,224.28125,240.28125,256.28125,288.28125,320.28125,352.28125,384.28125,416.28125,448.28125,480.28125,0.3125,0.30371094,0.30273438,0.3017578,0.30078125,0.2998047,0.29882812,0.29785156,0.296875,0.29492188,0.29296875,0.29101562,0.2890625,0.28710938,0.28515625,0.28320312,0.28125,0.27734375,0.2734375,0.26953125,0.265625,0.26171875,0.2578125,0.25390625,0.25,0.2421875,0.234375,0.2265625,0.21875,0.2109375,0.203125,0.1953125,0.1875,0.171875,0.15625,0.140625,0.125,0.109375,0.09375,0.078125,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,4.1875,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,7.6875,8.6875,9.6875,10.6875,11.6875,12.6875,13.6875,14.6875,15.6875,17.6875,19.6875,21.6875,23.6875,25.6875,27.6875,29.6875,31.6875,35.6875,39.6875,43.6875,47.6875,51.6875,55.6875,59.6875,63.6875,71.6875,79.6875,87.6875,95.6875,103.6875,111.6875,119.6875,127.6875,143.6875,159.6875,175.6875,191.6875,207.6875,223.6875,239.6875,255.6875,287.6875,319.6875,351.6875,383.6875,415.6875,447.6875,479.6875,0.3203125,0.32128906,0.32226562,0.3232422,0.32421875,0.3251953,0.32617188,0.32714844,0.328125,0.33007812,0.33203125,0.33398438,0.3359375,0.33789062,0.33984375,0.34179688,0.34375,0.34765625,0.3515625,0.35546875,0.359375,0.36328125,0.3671875,0.37109375,0.375,0.3828125,0.390625,0.3984375,0.40625,0.4140625,0.421875,0.4296875,0.4375,0.453125,0.46875,0.484375,0.5,0.515625,0.53125,0.546875,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.875,0.9375,1,1.0625,1.125,1.1875,1.25,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.3125,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,7.8125,8.3125,9.3125,10.3125,11.3125,12.3125,13.3125,14.3125,15.3125,16.3125,18.3125,20.3125,22.3125,24.3125,26.3125,28.3125,30.3125,32.3125,36.3125,40.3125,44.3125,48.3125,52.3125,56.3125,60.3125,64.3125,72.3125,80.3125,88.3125,96.3125,104.3125,112.3125,120.3125,128.3125,144.3125,160.3125,176.3125,192.3125,208.3125,224.3125,240.3125,256.3125,288.3125,320.3125,352.3125,384.3125,416.3125,448.3125,480.3125,0.34375,0.33496094,0.33398438,0.3330078,0.33203125,0.3310547,0.33007812,0.32910156,0.328125,0.32617188,0.32421875,0.32226562,0.3203125,0.31835938,0.31640625,0.31445312,0.3125,0.30859375,0.3046875,0.30078125,0.296875,0.29296875,0.2890625,0.28515625,0.28125,0.2734375,0.265625,0.2578125,0.25,0.2421875,0.234375,0.2265625,0.21875,0.203125,0.1875,0.171875,0.15625,0.140625,0.125,0.109375,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.125,0.15625,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.78125,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.90625,2.15625,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,4.15625,4.65625,5.15625,5.65625,6.15625,6.65625,7.15625,7.65625,8.65625,9.65625,10.65625,11.65625,12.65625,13.65625,14.65625,15.65625,17.65625,19.65625,21.65625,23.65625,25.65625,27.65625,29.65625,31.65625,35.65625,39.65625,43.65625,47.65625,51.65625,55.65625,59.65625,63.65625,71.65625,79.65625,87.65625,95.65625,103.65625,111.65625,119.65625,127.65625,143.65625,159.65625,175.65625,191.65625,207.65625,223.65625,239.65625,255.65625,287.65625,319.65625,351.65625,383.65625,415.65625,447.65625,479.65625,0.3515625,0.35253906,0.35351562,0.3544922,0.35546875,0.3564453,0.35742188,0.35839844,0.359375,0.36132812,0.36328125,0.36523438,0.3671875,0.36914062,0.37109375,0.37304688,0.375,0.37890625,0.3828125,0.38671875,0.390625,0.39453125,0.3984375,0.40234375,0.40625,0.4140625,0.421875,0.4296875,0.4375,0.4453125,0.453125,0.4609375,0.46875,0.484375,0.5,0.515625,0.53125,0.546875,0.5625,0.578125,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.21875,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,3.84375,4.09375,4.34375,4.84375,5.34375,5.84375,6.34375,6.84375,7.34375,7.84375,8.34375,9.34375,10.34375,11.34375,12.34375,13.34375,14.34375,15.34375,16.34375,18.34375,20.34375,22.34375,24.34375,26.34375,28.34375,30.34375,32.34375,36.34375,40.34375,44.34375,48.34375,52.34375,56.34375,60.34375,64.34375,72.34375,80.34375,88.34375,96.34375,104.34375,112.34375,120.34375,128.34375,144.34375,160.34375,176.34375,192.34375,208.34375,224.34375,240.34375,256.34375,288.34375,320.34375,352.34375,384.34375,416.34375,448.34375,480.34375,0.375,0.36621094,0.36523438,0.3642578,0.36328125,0.3623047,0.36132812,0.36035156,0.359375,0.35742188,0.35546875,0.35351562,0.3515625,0.34960938,0.34765625,0.34570312,0.34375,0.33984375,0.3359375,0.33203125,0.328125,0.32421875,0.3203125,0.31640625,0.3125,0.3046875,0.296875,0.2890625,0.28125,0.2734375,0.265625,0.2578125,0.25,0.234375,0.21875,0.203125,0.1875,0.171875,0.15625,0.140625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.75,0.875,1,1.125,1.25,1.375,1.5,1.625,1.875,2.125,2.375,2.625,2.875,3.125,3.375,3.625,4.125,4.625,5.125,5.625,6.125,6.625,7.125,7.625,8.625,9.625,10.625,11.625,12.625,13.625,14.625,15.625,17.625,19.625,21.625,23.625,25.625,27.625,29.625,31.625,35.625,39.625,43.625,47.625,51.625,55.625,59.625,63.625,71.625,79.625,87.625,95.625,103.625,111.625,119.625,127.625,143.625,159.625,175.625,191.625,207.625,223.625,239.625,255.625,287.625,319.625,351.625,383.625,415.625,447.625,479.625,0.3828125,0.38378906,0.38476562,0.3857422,0.38671875,0.3876953,0.38867188,0.38964844,0.390625,0.39257812,0.39453125,0.39648438,0.3984375,0.40039062,0.40234375,0.40429688,0.40625,0.41015625,0.4140625,0.41796875,0.421875,0.42578125,0.4296875,0.43359375,0.4375,0.4453125,0.453125,0.4609375,0.46875,0.4765625,0.484375,0.4921875,0.5,0.515625,0.53125,0.546875,0.5625,0.578125,0.59375,0.609375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.9375,1,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.5,1.625,1.75,1.875,2,2.125,2.25,2.375,2.625,2.875,3.125,3.375,3.625,3.875,4.125,4.375,4.875,5.375,5.875,6.375,6.875,7.375,7.875,8.375,9.375,10.375,11.375,12.375,13.375,14.375,15.375,16.375,18.375,20.375,22.375,24.375,26.375,28.375,30.375,32.375,36.375,40.375,44.375,48.375,52.375,56.375,60.375,64.375,72.375,80.375,88.375,96.375,104.375,112.375,120.375,128.375,144.375,160.375,176.375,192.375,208.375,224.375,240.375,256.375,288.375,320.375,352.375,384.375,416.375,448.375,480.375,0.40625,0.39746094,0.39648438,0.3955078,0.39453125,0.3935547,0.39257812,0.39160156,0.390625,0.38867188,0.38671875,0.38476562,0.3828125,0.38085938,0.37890625,0.37695312,0.375,0.37109375,0.3671875,0.36328125,0.359375,0.35546875,0.3515625,0.34765625,0.34375,0.3359375,0.328125,0.3203125,0.3125,0.3046875,0.296875,0.2890625,0.28125,0.265625,0.25,0.234375,0.21875,0.203125,0.1875,0.171875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.15625,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.71875,0.84375,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.84375,2.09375,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,4.09375,4.59375,5.09375,5.59375,6.09375,6.59375,7.09375,7.59375,8.59375,9.59375,10.59375,11.59375,12.59375,13.59375,14.59375,15.59375,17.59375,19.59375,21.59375,23.59375,25.59375,27.59375,29.59375,31.59375,35.59375,39.59375,43.59375,47.59375,51.59375,55.59375,59.59375,63.59375,71.59375,79.59375,87.59375,95.59375,103.59375,111.59375,119.59375,127.59375,143.59375,159.59375,175.59375,191.59375,207.59375,223.59375,239.59375,255.59375,287.59375,319.59375,351.59375,383.59375,415.59375,447.59375,479.59375,0.4140625,0.41503906,0.41601562,0.4169922,0.41796875,0.4189453,0.41992188,0.42089844,0.421875,0.42382812,0.42578125,0.42773438,0.4296875,0.43164062,0.43359375,0.43554688,0.4375,0.44140625,0.4453125,0.44921875,0.453125,0.45703125,0.4609375,0.46484375,0.46875,0.4765625,0.484375,0.4921875,0.5,0.5078125,0.515625,0.5234375,0.53125,0.546875,0.5625,0.578125,0.59375,0.609375,0.625,0.640625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.34375,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.15625,2.28125,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,3.90625,4.15625,4.40625,4.90625,5.40625,5.90625,6.40625,6.90625,7.40625,7.90625,8.40625,9.40625,10.40625,11.40625,12.40625,13.40625,14.40625,15.40625,16.40625,18.40625,20.40625,22.40625,24.40625,26.40625,28.40625,30.40625,32.40625,36.40625,40.40625,44.40625,48.40625,52.40625,56.40625,60.40625,64.40625,72.40625,80.40625,88.40625,96.40625,104.40625,112.40625,120.40625,128.40625,144.40625,160.40625,176.40625,192.40625,208.40625,224.40625,240.40625,256.40625,288.40625,320.40625,352.40625,384.40625,416.40625,448.40625,480.40625,0.4375,0.42871094,0.42773438,0.4267578,0.42578125,0.4248047,0.42382812,0.42285156,0.421875,0.41992188,0.41796875,0.41601562,0.4140625,0.41210938,0.41015625,0.40820312,0.40625,0.40234375,0.3984375,0.39453125,0.390625,0.38671875,0.3828125,0.37890625,0.375,0.3671875,0.359375,0.3515625,0.34375,0.3359375,0.328125,0.3203125,0.3125,0.296875,0.28125,0.265625,0.25,0.234375,0.21875,0.203125,0.1875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,4.0625,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,7.5625,8.5625,9.5625,10.5625,11.5625,12.5625,13.5625,14.5625,15.5625,17.5625,19.5625,21.5625,23.5625,25.5625,27.5625,29.5625,31.5625,35.5625,39.5625,43.5625,47.5625,51.5625,55.5625,59.5625,63.5625,71.5625,79.5625,87.5625,95.5625,103.5625,111.5625,119.5625,127.5625,143.5625,159.5625,175.5625,191.5625,207.5625,223.5625,239.5625,255.5625,287.5625,319.5625,351.5625,383.5625,415.5625,447.5625,479.5625,0.4453125,0.44628906,0.44726562,0.4482422,0.44921875,0.4501953,0.45117188,0.45214844,0.453125,0.45507812,0.45703125,0.45898438,0.4609375,0.46289062,0.46484375,0.46679688,0.46875,0.47265625,0.4765625,0.48046875,0.484375,0.48828125,0.4921875,0.49609375,0.5,0.5078125,0.515625,0.5234375,0.53125,0.5390625,0.546875,0.5546875,0.5625,0.578125,0.59375,0.609375,0.625,0.640625,0.65625,0.671875,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.9375,1,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.4375,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,7.9375,8.4375,9.4375,10.4375,11.4375,12.4375,13.4375,14.4375,15.4375,16.4375,18.4375,20.4375,22.4375,24.4375,26.4375,28.4375,30.4375,32.4375,36.4375,40.4375,44.4375,48.4375,52.4375,56.4375,60.4375,64.4375,72.4375,80.4375,88.4375,96.4375,104.4375,112.4375,120.4375,128.4375,144.4375,160.4375,176.4375,192.4375,208.4375,224.4375,240.4375,256.4375,288.4375,320.4375,352.4375,384.4375,416.4375,448.4375,480.4375,0.46875,0.45996094,0.45898438,0.4580078,0.45703125,0.4560547,0.45507812,0.45410156,0.453125,0.45117188,0.44921875,0.44726562,0.4453125,0.44335938,0.44140625,0.43945312,0.4375,0.43359375,0.4296875,0.42578125,0.421875,0.41796875,0.4140625,0.41015625,0.40625,0.3984375,0.390625,0.3828125,0.375,0.3671875,0.359375,0.3515625,0.34375,0.328125,0.3125,0.296875,0.28125,0.265625,0.25,0.234375,0.21875,0.1875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.09375,0.15625,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.65625,0.78125,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.78125,2.03125,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,4.03125,4.53125,5.03125,5.53125,6.03125,6.53125,7.03125,7.53125,8.53125,9.53125,10.53125,11.53125,12.53125,13.53125,14.53125,15.53125,17.53125,19.53125,21.53125,23.53125,25.53125,27.53125,29.53125,31.53125,35.53125,39.53125,43.53125,47.53125,51.53125,55.53125,59.53125,63.53125,71.53125,79.53125,87.53125,95.53125,103.53125,111.53125,119.53125,127.53125,143.53125,159.53125,175.53125,191.53125,207.53125,223.53125,239.53125,255.53125,287.53125,319.53125,351.53125,383.53125,415.53125,447.53125,479.53125,0.4765625,0.47753906,0.47851562,0.4794922,0.48046875,0.4814453,0.48242188,0.48339844,0.484375,0.48632812,0.48828125,0.49023438,0.4921875,0.49414062,0.49609375,0.49804688,0.5,0.50390625,0.5078125,0.51171875,0.515625,0.51953125,0.5234375,0.52734375,0.53125,0.5390625,0.546875,0.5546875,0.5625,0.5703125,0.578125,0.5859375,0.59375,0.609375,0.625,0.640625,0.65625,0.671875,0.6875,0.703125,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.9375,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.34375,1.40625,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.21875,2.34375,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,3.96875,4.21875,4.46875,4.96875,5.46875,5.96875,6.46875,6.96875,7.46875,7.96875,8.46875,9.46875,10.46875,11.46875,12.46875,13.46875,14.46875,15.46875,16.46875,18.46875,20.46875,22.46875,24.46875,26.46875,28.46875,30.46875,32.46875,36.46875,40.46875,44.46875,48.46875,52.46875,56.46875,60.46875,64.46875,72.46875,80.46875,88.46875,96.46875,104.46875,112.46875,120.46875,128.46875,144.46875,160.46875,176.46875,192.46875,208.46875,224.46875,240.46875,256.46875,288.46875,320.46875,352.46875,384.46875,416.46875,448.46875,480.46875,0.5,0.49121094,0.49023438,0.4892578,0.48828125,0.4873047,0.48632812,0.48535156,0.484375,0.48242188,0.48046875,0.47851562,0.4765625,0.47460938,0.47265625,0.47070312,0.46875,0.46484375,0.4609375,0.45703125,0.453125,0.44921875,0.4453125,0.44140625,0.4375,0.4296875,0.421875,0.4140625,0.40625,0.3984375,0.390625,0.3828125,0.375,0.359375,0.34375,0.328125,0.3125,0.296875,0.28125,0.265625,0.25,0.21875,0.1875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.625,0.75,0.875,1,1.125,1.25,1.375,1.5,1.75,2,2.25,2.5,2.75,3,3.25,3.5,4,4.5,5,5.5,6,6.5,7,7.5,8.5,9.5,10.5,11.5,12.5,13.5,14.5,15.5,17.5,19.5,21.5,23.5,25.5,27.5,29.5,31.5,35.5,39.5,43.5,47.5,51.5,55.5,59.5,63.5,71.5,79.5,87.5,95.5,103.5,111.5,119.5,127.5,143.5,159.5,175.5,191.5,207.5,223.5,239.5,255.5,287.5,319.5,351.5,383.5,415.5,447.5,479.5,0.5078125,0.50878906,0.5097656,0.5107422,0.51171875,0.5126953,0.5136719,0.51464844,0.515625,0.5175781,0.51953125,0.5214844,0.5234375,0.5253906,0.52734375,0.5292969,0.53125,0.53515625,0.5390625,0.54296875,0.546875,0.55078125,0.5546875,0.55859375,0.5625,0.5703125,0.578125,0.5859375,0.59375,0.6015625,0.609375,0.6171875,0.625,0.640625,0.65625,0.671875,0.6875,0.703125,0.71875,0.734375,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.9375,0.96875,1,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.625,1.75,1.875,2,2.125,2.25,2.375,2.5,2.75,3,3.25,3.5,3.75,4,4.25,4.5,5,5.5,6,6.5,7,7.5,8,8.5,9.5,10.5,11.5,12.5,13.5,14.5,15.5,16.5,18.5,20.5,22.5,24.5,26.5,28.5,30.5,32.5,36.5,40.5,44.5,48.5,52.5,56.5,60.5,64.5,72.5,80.5,88.5,96.5,104.5,112.5,120.5,128.5,144.5,160.5,176.5,192.5,208.5,224.5,240.5,256.5,288.5,320.5,352.5,384.5,416.5,448.5,480.5,0.5625,0.55371094,0.5527344,0.5517578,0.55078125,0.5498047,0.5488281,0.54785156,0.546875,0.5449219,0.54296875,0.5410156,0.5390625,0.5371094,0.53515625,0.5332031,0.53125,0.52734375,0.5234375,0.51953125,0.515625,0.51171875,0.5078125,0.50390625,0.5,0.4921875,0.484375,0.4765625,0.46875,0.4609375,0.453125,0.4453125,0.4375,0.421875,0.40625,0.390625,0.375,0.359375,0.34375,0.328125,0.3125,0.28125,0.25,0.21875,0.1875,0.15625,0.125,0.09375,0.0625,0,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.6875,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.9375,4.4375,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,8.4375,9.4375,10.4375,11.4375,12.4375,13.4375,14.4375,15.4375,17.4375,19.4375,21.4375,23.4375,25.4375,27.4375,29.4375,31.4375,35.4375,39.4375,43.4375,47.4375,51.4375,55.4375,59.4375,63.4375,71.4375,79.4375,87.4375,95.4375,103.4375,111.4375,119.4375,127.4375,143.4375,159.4375,175.4375,191.4375,207.4375,223.4375,239.4375,255.4375,287.4375,319.4375,351.4375,383.4375,415.4375,447.4375,479.4375,0.5703125,0.57128906,0.5722656,0.5732422,0.57421875,0.5751953,0.5761719,0.57714844,0.578125,0.5800781,0.58203125,0.5839844,0.5859375,0.5878906,0.58984375,0.5917969,0.59375,0.59765625,0.6015625,0.60546875,0.609375,0.61328125,0.6171875,0.62109375,0.625,0.6328125,0.640625,0.6484375,0.65625,0.6640625,0.671875,0.6796875,0.6875,0.703125,0.71875,0.734375,0.75,0.765625,0.78125,0.796875,0.8125,0.84375,0.875,0.90625,0.9375,0.96875,1,1.03125,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.3125,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,7.5625,8.0625,8.5625,9.5625,10.5625,11.5625,12.5625,13.5625,14.5625,15.5625,16.5625,18.5625,20.5625,22.5625,24.5625,26.5625,28.5625,30.5625,32.5625,36.5625,40.5625,44.5625,48.5625,52.5625,56.5625,60.5625,64.5625,72.5625,80.5625,88.5625,96.5625,104.5625,112.5625,120.5625,128.5625,144.5625,160.5625,176.5625,192.5625,208.5625,224.5625,240.5625,256.5625,288.5625,320.5625,352.5625,384.5625,416.5625,448.5625,480.5625,0.625,0.61621094,0.6152344,0.6142578,0.61328125,0.6123047,0.6113281,0.61035156,0.609375,0.6074219,0.60546875,0.6035156,0.6015625,0.5996094,0.59765625,0.5957031,0.59375,0.58984375,0.5859375,0.58203125,0.578125,0.57421875,0.5703125,0.56640625,0.5625,0.5546875,0.546875,0.5390625,0.53125,0.5234375,0.515625,0.5078125,0.5,0.484375,0.46875,0.453125,0.4375,0.421875,0.40625,0.390625,0.375,0.34375,0.3125,0.28125,0.25,0.21875,0.1875,0.15625,0.125,0.0625,0,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.5,0.625,0.75,0.875,1,1.125,1.25,1.375,1.625,1.875,2.125,2.375,2.625,2.875,3.125,3.375,3.875,4.375,4.875,5.375,5.875,6.375,6.875,7.375,8.375,9.375,10.375,11.375,12.375,13.375,14.375,15.375,17.375,19.375,21.375,23.375,25.375,27.375,29.375,31.375,35.375,39.375,43.375,47.375,51.375,55.375,59.375,63.375,71.375,79.375,87.375,95.375,103.375,111.375,119.375,127.375,143.375,159.375,175.375,191.375,207.375,223.375,239.375,255.375,287.375,319.375,351.375,383.375,415.375,447.375,479.375,0.6328125,0.63378906,0.6347656,0.6357422,0.63671875,0.6376953,0.6386719,0.63964844,0.640625,0.6425781,0.64453125,0.6464844,0.6484375,0.6503906,0.65234375,0.6542969,0.65625,0.66015625,0.6640625,0.66796875,0.671875,0.67578125,0.6796875,0.68359375,0.6875,0.6953125,0.703125,0.7109375,0.71875,0.7265625,0.734375,0.7421875,0.75,0.765625,0.78125,0.796875,0.8125,0.828125,0.84375,0.859375,0.875,0.90625,0.9375,0.96875,1,1.03125,1.0625,1.09375,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.75,1.875,2,2.125,2.25,2.375,2.5,2.625,2.875,3.125,3.375,3.625,3.875,4.125,4.375,4.625,5.125,5.625,6.125,6.625,7.125,7.625,8.125,8.625,9.625,10.625,11.625,12.625,13.625,14.625,15.625,16.625,18.625,20.625,22.625,24.625,26.625,28.625,30.625,32.625,36.625,40.625,44.625,48.625,52.625,56.625,60.625,64.625,72.625,80.625,88.625,96.625,104.625,112.625,120.625,128.625,144.625,160.625,176.625,192.625,208.625,224.625,240.625,256.625,288.625,320.625,352.625,384.625,416.625,448.625,480.625,0.6875,0.67871094,0.6777344,0.6767578,0.67578125,0.6748047,0.6738281,0.67285156,0.671875,0.6699219,0.66796875,0.6660156,0.6640625,0.6621094,0.66015625,0.6582031,0.65625,0.65234375,0.6484375,0.64453125,0.640625,0.63671875,0.6328125,0.62890625,0.625,0.6171875,0.609375,0.6015625,0.59375,0.5859375,0.578125,0.5703125,0.5625,0.546875,0.53125,0.515625,0.5,0.484375,0.46875,0.453125,0.4375,0.40625,0.375,0.34375,0.3125,0.28125,0.25,0.21875,0.1875,0.125,0.0625,0,0.0625,0.125,0.1875,0.25,0.3125,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.5625,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.8125,4.3125,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,8.3125,9.3125,10.3125,11.3125,12.3125,13.3125,14.3125,15.3125,17.3125,19.3125,21.3125,23.3125,25.3125,27.3125,29.3125,31.3125,35.3125,39.3125,43.3125,47.3125,51.3125,55.3125,59.3125,63.3125,71.3125,79.3125,87.3125,95.3125,103.3125,111.3125,119.3125,127.3125,143.3125,159.3125,175.3125,191.3125,207.3125,223.3125,239.3125,255.3125,287.3125,319.3125,351.3125,383.3125,415.3125,447.3125,479.3125,0.6953125,0.69628906,0.6972656,0.6982422,0.69921875,0.7001953,0.7011719,0.70214844,0.703125,0.7050781,0.70703125,0.7089844,0.7109375,0.7128906,0.71484375,0.7167969,0.71875,0.72265625,0.7265625,0.73046875,0.734375,0.73828125,0.7421875,0.74609375,0.75,0.7578125,0.765625,0.7734375,0.78125,0.7890625,0.796875,0.8046875,0.8125,0.828125,0.84375,0.859375,0.875,0.890625,0.90625,0.921875,0.9375,0.96875,1,1.03125,1.0625,1.09375,1.125,1.15625,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.4375,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,7.6875,8.1875,8.6875,9.6875,10.6875,11.6875,12.6875,13.6875,14.6875,15.6875,16.6875,18.6875,20.6875,22.6875,24.6875,26.6875,28.6875,30.6875,32.6875,36.6875,40.6875,44.6875,48.6875,52.6875,56.6875,60.6875,64.6875,72.6875,80.6875,88.6875,96.6875,104.6875,112.6875,120.6875,128.6875,144.6875,160.6875,176.6875,192.6875,208.6875,224.6875,240.6875,256.6875,288.6875,320.6875,352.6875,384.6875,416.6875,448.6875,480.6875,0.75,0.74121094,0.7402344,0.7392578,0.73828125,0.7373047,0.7363281,0.73535156,0.734375,0.7324219,0.73046875,0.7285156,0.7265625,0.7246094,0.72265625,0.7207031,0.71875,0.71484375,0.7109375,0.70703125,0.703125,0.69921875,0.6953125,0.69140625,0.6875,0.6796875,0.671875,0.6640625,0.65625,0.6484375,0.640625,0.6328125,0.625,0.609375,0.59375,0.578125,0.5625,0.546875,0.53125,0.515625,0.5,0.46875,0.4375,0.40625,0.375,0.34375,0.3125,0.28125,0.25,0.1875,0.125,0.0625,0,0.0625,0.125,0.1875,0.25,0.375,0.5,0.625,0.75,0.875,1,1.125,1.25,1.5,1.75,2,2.25,2.5,2.75,3,3.25,3.75,4.25,4.75,5.25,5.75,6.25,6.75,7.25,8.25,9.25,10.25,11.25,12.25,13.25,14.25,15.25,17.25,19.25,21.25,23.25,25.25,27.25,29.25,31.25,35.25,39.25,43.25,47.25,51.25,55.25,59.25,63.25,71.25,79.25,87.25,95.25,103.25,111.25,119.25,127.25,143.25,159.25,175.25,191.25,207.25,223.25,239.25,255.25,287.25,319.25,351.25,383.25,415.25,447.25,479.25,0.7578125,0.75878906,0.7597656,0.7607422,0.76171875,0.7626953,0.7636719,0.76464844,0.765625,0.7675781,0.76953125,0.7714844,0.7734375,0.7753906,0.77734375,0.7792969,0.78125,0.78515625,0.7890625,0.79296875,0.796875,0.80078125,0.8046875,0.80859375,0.8125,0.8203125,0.828125,0.8359375,0.84375,0.8515625,0.859375,0.8671875,0.875,0.890625,0.90625,0.921875,0.9375,0.953125,0.96875,0.984375,1,1.03125,1.0625,1.09375,1.125,1.15625,1.1875,1.21875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.875,2,2.125,2.25,2.375,2.5,2.625,2.75,3,3.25,3.5,3.75,4,4.25,4.5,4.75,5.25,5.75,6.25,6.75,7.25,7.75,8.25,8.75,9.75,10.75,11.75,12.75,13.75,14.75,15.75,16.75,18.75,20.75,22.75,24.75,26.75,28.75,30.75,32.75,36.75,40.75,44.75,48.75,52.75,56.75,60.75,64.75,72.75,80.75,88.75,96.75,104.75,112.75,120.75,128.75,144.75,160.75,176.75,192.75,208.75,224.75,240.75,256.75,288.75,320.75,352.75,384.75,416.75,448.75,480.75,0.8125,0.80371094,0.8027344,0.8017578,0.80078125,0.7998047,0.7988281,0.79785156,0.796875,0.7949219,0.79296875,0.7910156,0.7890625,0.7871094,0.78515625,0.7832031,0.78125,0.77734375,0.7734375,0.76953125,0.765625,0.76171875,0.7578125,0.75390625,0.75,0.7421875,0.734375,0.7265625,0.71875,0.7109375,0.703125,0.6953125,0.6875,0.671875,0.65625,0.640625,0.625,0.609375,0.59375,0.578125,0.5625,0.53125,0.5,0.46875,0.4375,0.40625,0.375,0.34375,0.3125,0.25,0.1875,0.125,0.0625,0,0.0625,0.125,0.1875,0.3125,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.4375,1.6875,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.6875,4.1875,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,8.1875,9.1875,10.1875,11.1875,12.1875,13.1875,14.1875,15.1875,17.1875,19.1875,21.1875,23.1875,25.1875,27.1875,29.1875,31.1875,35.1875,39.1875,43.1875,47.1875,51.1875,55.1875,59.1875,63.1875,71.1875,79.1875,87.1875,95.1875,103.1875,111.1875,119.1875,127.1875,143.1875,159.1875,175.1875,191.1875,207.1875,223.1875,239.1875,255.1875,287.1875,319.1875,351.1875,383.1875,415.1875,447.1875,479.1875,0.8203125,0.82128906,0.8222656,0.8232422,0.82421875,0.8251953,0.8261719,0.82714844,0.828125,0.8300781,0.83203125,0.8339844,0.8359375,0.8378906,0.83984375,0.8417969,0.84375,0.84765625,0.8515625,0.85546875,0.859375,0.86328125,0.8671875,0.87109375,0.875,0.8828125,0.890625,0.8984375,0.90625,0.9140625,0.921875,0.9296875,0.9375,0.953125,0.96875,0.984375,1,1.015625,1.03125,1.046875,1.0625,1.09375,1.125,1.15625,1.1875,1.21875,1.25,1.28125,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.6875,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.3125,4.5625,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,7.8125,8.3125,8.8125,9.8125,10.8125,11.8125,12.8125,13.8125,14.8125,15.8125,16.8125,18.8125,20.8125,22.8125,24.8125,26.8125,28.8125,30.8125,32.8125,36.8125,40.8125,44.8125,48.8125,52.8125,56.8125,60.8125,64.8125,72.8125,80.8125,88.8125,96.8125,104.8125,112.8125,120.8125,128.8125,144.8125,160.8125,176.8125,192.8125,208.8125,224.8125,240.8125,256.8125,288.8125,320.8125,352.8125,384.8125,416.8125,448.8125,480.8125,0.875,0.86621094,0.8652344,0.8642578,0.86328125,0.8623047,0.8613281,0.86035156,0.859375,0.8574219,0.85546875,0.8535156,0.8515625,0.8496094,0.84765625,0.8457031,0.84375,0.83984375,0.8359375,0.83203125,0.828125,0.82421875,0.8203125,0.81640625,0.8125,0.8046875,0.796875,0.7890625,0.78125,0.7734375,0.765625,0.7578125,0.75,0.734375,0.71875,0.703125,0.6875,0.671875,0.65625,0.640625,0.625,0.59375,0.5625,0.53125,0.5,0.46875,0.4375,0.40625,0.375,0.3125,0.25,0.1875,0.125,0.0625,0,0.0625,0.125,0.25,0.375,0.5,0.625,0.75,0.875,1,1.125,1.375,1.625,1.875,2.125,2.375,2.625,2.875,3.125,3.625,4.125,4.625,5.125,5.625,6.125,6.625,7.125,8.125,9.125,10.125,11.125,12.125,13.125,14.125,15.125,17.125,19.125,21.125,23.125,25.125,27.125,29.125,31.125,35.125,39.125,43.125,47.125,51.125,55.125,59.125,63.125,71.125,79.125,87.125,95.125,103.125,111.125,119.125,127.125,143.125,159.125,175.125,191.125,207.125,223.125,239.125,255.125,287.125,319.125,351.125,383.125,415.125,447.125,479.125,0.8828125,0.88378906,0.8847656,0.8857422,0.88671875,0.8876953,0.8886719,0.88964844,0.890625,0.8925781,0.89453125,0.8964844,0.8984375,0.9003906,0.90234375,0.9042969,0.90625,0.91015625,0.9140625,0.91796875,0.921875,0.92578125,0.9296875,0.93359375,0.9375,0.9453125,0.953125,0.9609375,0.96875,0.9765625,0.984375,0.9921875,1,1.015625,1.03125,1.046875,1.0625,1.078125,1.09375,1.109375,1.125,1.15625,1.1875,1.21875,1.25,1.28125,1.3125,1.34375,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.875,2,2.125,2.25,2.375,2.5,2.625,2.75,2.875,3.125,3.375,3.625,3.875,4.125,4.375,4.625,4.875,5.375,5.875,6.375,6.875,7.375,7.875,8.375,8.875,9.875,10.875,11.875,12.875,13.875,14.875,15.875,16.875,18.875,20.875,22.875,24.875,26.875,28.875,30.875,32.875,36.875,40.875,44.875,48.875,52.875,56.875,60.875,64.875,72.875,80.875,88.875,96.875,104.875,112.875,120.875,128.875,144.875,160.875,176.875,192.875,208.875,224.875,240.875,256.875,288.875,320.875,352.875,384.875,416.875,448.875,480.875,0.9375,0.92871094,0.9277344,0.9267578,0.92578125,0.9248047,0.9238281,0.92285156,0.921875,0.9199219,0.91796875,0.9160156,0.9140625,0.9121094,0.91015625,0.9082031,0.90625,0.90234375,0.8984375,0.89453125,0.890625,0.88671875,0.8828125,0.87890625,0.875,0.8671875,0.859375,0.8515625,0.84375,0.8359375,0.828125,0.8203125,0.8125,0.796875,0.78125,0.765625,0.75,0.734375,0.71875,0.703125,0.6875,0.65625,0.625,0.59375,0.5625,0.53125,0.5,0.46875,0.4375,0.375,0.3125,0.25,0.1875,0.125,0.0625,0,0.0625,0.1875,0.3125,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.3125,1.5625,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.5625,4.0625,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,8.0625,9.0625,10.0625,11.0625,12.0625,13.0625,14.0625,15.0625,17.0625,19.0625,21.0625,23.0625,25.0625,27.0625,29.0625,31.0625,35.0625,39.0625,43.0625,47.0625,51.0625,55.0625,59.0625,63.0625,71.0625,79.0625,87.0625,95.0625,103.0625,111.0625,119.0625,127.0625,143.0625,159.0625,175.0625,191.0625,207.0625,223.0625,239.0625,255.0625,287.0625,319.0625,351.0625,383.0625,415.0625,447.0625,479.0625,0.9453125,0.94628906,0.9472656,0.9482422,0.94921875,0.9501953,0.9511719,0.95214844,0.953125,0.9550781,0.95703125,0.9589844,0.9609375,0.9628906,0.96484375,0.9667969,0.96875,0.97265625,0.9765625,0.98046875,0.984375,0.98828125,0.9921875,0.99609375,1,1.0078125,1.015625,1.0234375,1.03125,1.0390625,1.046875,1.0546875,1.0625,1.078125,1.09375,1.109375,1.125,1.140625,1.15625,1.171875,1.1875,1.21875,1.25,1.28125,1.3125,1.34375,1.375,1.40625,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.875,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.6875,2.8125,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.4375,4.6875,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,7.9375,8.4375,8.9375,9.9375,10.9375,11.9375,12.9375,13.9375,14.9375,15.9375,16.9375,18.9375,20.9375,22.9375,24.9375,26.9375,28.9375,30.9375,32.9375,36.9375,40.9375,44.9375,48.9375,52.9375,56.9375,60.9375,64.9375,72.9375,80.9375,88.9375,96.9375,104.9375,112.9375,120.9375,128.9375,144.9375,160.9375,176.9375,192.9375,208.9375,224.9375,240.9375,256.9375,288.9375,320.9375,352.9375,384.9375,416.9375,448.9375,480.9375,1,0.99121094,0.9902344,0.9892578,0.98828125,0.9873047,0.9863281,0.98535156,0.984375,0.9824219,0.98046875,0.9785156,0.9765625,0.9746094,0.97265625,0.9707031,0.96875,0.96484375,0.9609375,0.95703125,0.953125,0.94921875,0.9453125,0.94140625,0.9375,0.9296875,0.921875,0.9140625,0.90625,0.8984375,0.890625,0.8828125,0.875,0.859375,0.84375,0.828125,0.8125,0.796875,0.78125,0.765625,0.75,0.71875,0.6875,0.65625,0.625,0.59375,0.5625,0.53125,0.5,0.4375,0.375,0.3125,0.25,0.1875,0.125,0.0625,0,0.125,0.25,0.375,0.5,0.625,0.75,0.875,1,1.25,1.5,1.75,2,2.25,2.5,2.75,3,3.5,4,4.5,5,5.5,6,6.5,7,8,9,10,11,12,13,14,15,17,19,21,23,25,27,29,31,35,39,43,47,51,55,59,63,71,79,87,95,103,111,119,127,143,159,175,191,207,223,239,255,287,319,351,383,415,447,479,1.0078125,1.0087891,1.0097656,1.0107422,1.0117188,1.0126953,1.0136719,1.0146484,1.015625,1.0175781,1.0195312,1.0214844,1.0234375,1.0253906,1.0273438,1.0292969,1.03125,1.0351562,1.0390625,1.0429688,1.046875,1.0507812,1.0546875,1.0585938,1.0625,1.0703125,1.078125,1.0859375,1.09375,1.1015625,1.109375,1.1171875,1.125,1.140625,1.15625,1.171875,1.1875,1.203125,1.21875,1.234375,1.25,1.28125,1.3125,1.34375,1.375,1.40625,1.4375,1.46875,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.875,1.9375,2,2.125,2.25,2.375,2.5,2.625,2.75,2.875,3,3.25,3.5,3.75,4,4.25,4.5,4.75,5,5.5,6,6.5,7,7.5,8,8.5,9,10,11,12,13,14,15,16,17,19,21,23,25,27,29,31,33,37,41,45,49,53,57,61,65,73,81,89,97,105,113,121,129,145,161,177,193,209,225,241,257,289,321,353,385,417,449,481,1.125,1.1162109,1.1152344,1.1142578,1.1132812,1.1123047,1.1113281,1.1103516,1.109375,1.1074219,1.1054688,1.1035156,1.1015625,1.0996094,1.0976562,1.0957031,1.09375,1.0898438,1.0859375,1.0820312,1.078125,1.0742188,1.0703125,1.0664062,1.0625,1.0546875,1.046875,1.0390625,1.03125,1.0234375,1.015625,1.0078125,1,0.984375,0.96875,0.953125,0.9375,0.921875,0.90625,0.890625,0.875,0.84375,0.8125,0.78125,0.75,0.71875,0.6875,0.65625,0.625,0.5625,0.5,0.4375,0.375,0.3125,0.25,0.1875,0.125,0,0.125,0.25,0.375,0.5,0.625,0.75,0.875,1.125,1.375,1.625,1.875,2.125,2.375,2.625,2.875,3.375,3.875,4.375,4.875,5.375,5.875,6.375,6.875,7.875,8.875,9.875,10.875,11.875,12.875,13.875,14.875,16.875,18.875,20.875,22.875,24.875,26.875,28.875,30.875,34.875,38.875,42.875,46.875,50.875,54.875,58.875,62.875,70.875,78.875,86.875,94.875,102.875,110.875,118.875,126.875,142.875,158.875,174.875,190.875,206.875,222.875,238.875,254.875,286.875,318.875,350.875,382.875,414.875,446.875,478.875,1.1328125,1.1337891,1.1347656,1.1357422,1.1367188,1.1376953,1.1386719,1.1396484,1.140625,1.1425781,1.1445312,1.1464844,1.1484375,1.1503906,1.1523438,1.1542969,1.15625,1.1601562,1.1640625,1.1679688,1.171875,1.1757812,1.1796875,1.1835938,1.1875,1.1953125,1.203125,1.2109375,1.21875,1.2265625,1.234375,1.2421875,1.25,1.265625,1.28125,1.296875,1.3125,1.328125,1.34375,1.359375,1.375,1.40625,1.4375,1.46875,1.5,1.53125,1.5625,1.59375,1.625,1.6875,1.75,1.8125,1.875,1.9375,2,2.0625,2.125,2.25,2.375,2.5,2.625,2.75,2.875,3,3.125,3.375,3.625,3.875,4.125,4.375,4.625,4.875,5.125,5.625,6.125,6.625,7.125,7.625,8.125,8.625,9.125,10.125,11.125,12.125,13.125,14.125,15.125,16.125,17.125,19.125,21.125,23.125,25.125,27.125,29.125,31.125,33.125,37.125,41.125,45.125,49.125,53.125,57.125,61.125,65.125,73.125,81.125,89.125,97.125,105.125,113.125,121.125,129.125,145.125,161.125,177.125,193.125,209.125,225.125,241.125,257.125,289.125,321.125,353.125,385.125,417.125,449.125,481.125,1.25,1.2412109,1.2402344,1.2392578,1.2382812,1.2373047,1.2363281,1.2353516,1.234375,1.2324219,1.2304688,1.2285156,1.2265625,1.2246094,1.2226562,1.2207031,1.21875,1.2148438,1.2109375,1.2070312,1.203125,1.1992188,1.1953125,1.1914062,1.1875,1.1796875,1.171875,1.1640625,1.15625,1.1484375,1.140625,1.1328125,1.125,1.109375,1.09375,1.078125,1.0625,1.046875,1.03125,1.015625,1,0.96875,0.9375,0.90625,0.875,0.84375,0.8125,0.78125,0.75,0.6875,0.625,0.5625,0.5,0.4375,0.375,0.3125,0.25,0.125,0,0.125,0.25,0.375,0.5,0.625,0.75,1,1.25,1.5,1.75,2,2.25,2.5,2.75,3.25,3.75,4.25,4.75,5.25,5.75,6.25,6.75,7.75,8.75,9.75,10.75,11.75,12.75,13.75,14.75,16.75,18.75,20.75,22.75,24.75,26.75,28.75,30.75,34.75,38.75,42.75,46.75,50.75,54.75,58.75,62.75,70.75,78.75,86.75,94.75,102.75,110.75,118.75,126.75,142.75,158.75,174.75,190.75,206.75,222.75,238.75,254.75,286.75,318.75,350.75,382.75,414.75,446.75,478.75,1.2578125,1.2587891,1.2597656,1.2607422,1.2617188,1.2626953,1.2636719,1.2646484,1.265625,1.2675781,1.2695312,1.2714844,1.2734375,1.2753906,1.2773438,1.2792969,1.28125,1.2851562,1.2890625,1.2929688,1.296875,1.3007812,1.3046875,1.3085938,1.3125,1.3203125,1.328125,1.3359375,1.34375,1.3515625,1.359375,1.3671875,1.375,1.390625,1.40625,1.421875,1.4375,1.453125,1.46875,1.484375,1.5,1.53125,1.5625,1.59375,1.625,1.65625,1.6875,1.71875,1.75,1.8125,1.875,1.9375,2,2.0625,2.125,2.1875,2.25,2.375,2.5,2.625,2.75,2.875,3,3.125,3.25,3.5,3.75,4,4.25,4.5,4.75,5,5.25,5.75,6.25,6.75,7.25,7.75,8.25,8.75,9.25,10.25,11.25,12.25,13.25,14.25,15.25,16.25,17.25,19.25,21.25,23.25,25.25,27.25,29.25,31.25,33.25,37.25,41.25,45.25,49.25,53.25,57.25,61.25,65.25,73.25,81.25,89.25,97.25,105.25,113.25,121.25,129.25,145.25,161.25,177.25,193.25,209.25,225.25,241.25,257.25,289.25,321.25,353.25,385.25,417.25,449.25,481.25,1.375,1.3662109,1.3652344,1.3642578,1.3632812,1.3623047,1.3613281,1.3603516,1.359375,1.3574219,1.3554688,1.3535156,1.3515625,1.3496094,1.3476562,1.3457031,1.34375,1.3398438,1.3359375,1.3320312,1.328125,1.3242188,1.3203125,1.3164062,1.3125,1.3046875,1.296875,1.2890625,1.28125,1.2734375,1.265625,1.2578125,1.25,1.234375,1.21875,1.203125,1.1875,1.171875,1.15625,1.140625,1.125,1.09375,1.0625,1.03125,1,0.96875,0.9375,0.90625,0.875,0.8125,0.75,0.6875,0.625,0.5625,0.5,0.4375,0.375,0.25,0.125,0,0.125,0.25,0.375,0.5,0.625,0.875,1.125,1.375,1.625,1.875,2.125,2.375,2.625,3.125,3.625,4.125,4.625,5.125,5.625,6.125,6.625,7.625,8.625,9.625,10.625,11.625,12.625,13.625,14.625,16.625,18.625,20.625,22.625,24.625,26.625,28.625,30.625,34.625,38.625,42.625,46.625,50.625,54.625,58.625,62.625,70.625,78.625,86.625,94.625,102.625,110.625,118.625,126.625,142.625,158.625,174.625,190.625,206.625,222.625,238.625,254.625,286.625,318.625,350.625,382.625,414.625,446.625,478.625,1.3828125,1.3837891,1.3847656,1.3857422,1.3867188,1.3876953,1.3886719,1.3896484,1.390625,1.3925781,1.3945312,1.3964844,1.3984375,1.4003906,1.4023438,1.4042969,1.40625,1.4101562,1.4140625,1.4179688,1.421875,1.4257812,1.4296875,1.4335938,1.4375,1.4453125,1.453125,1.4609375,1.46875,1.4765625,1.484375,1.4921875,1.5,1.515625,1.53125,1.546875,1.5625,1.578125,1.59375,1.609375,1.625,1.65625,1.6875,1.71875,1.75,1.78125,1.8125,1.84375,1.875,1.9375,2,2.0625,2.125,2.1875,2.25,2.3125,2.375,2.5,2.625,2.75,2.875,3,3.125,3.25,3.375,3.625,3.875,4.125,4.375,4.625,4.875,5.125,5.375,5.875,6.375,6.875,7.375,7.875,8.375,8.875,9.375,10.375,11.375,12.375,13.375,14.375,15.375,16.375,17.375,19.375,21.375,23.375,25.375,27.375,29.375,31.375,33.375,37.375,41.375,45.375,49.375,53.375,57.375,61.375,65.375,73.375,81.375,89.375,97.375,105.375,113.375,121.375,129.375,145.375,161.375,177.375,193.375,209.375,225.375,241.375,257.375,289.375,321.375,353.375,385.375,417.375,449.375,481.375,1.5,1.4912109,1.4902344,1.4892578,1.4882812,1.4873047,1.4863281,1.4853516,1.484375,1.4824219,1.4804688,1.4785156,1.4765625,1.4746094,1.4726562,1.4707031,1.46875,1.4648438,1.4609375,1.4570312,1.453125,1.4492188,1.4453125,1.4414062,1.4375,1.4296875,1.421875,1.4140625,1.40625,1.3984375,1.390625,1.3828125,1.375,1.359375,1.34375,1.328125,1.3125,1.296875,1.28125,1.265625,1.25,1.21875,1.1875,1.15625,1.125,1.09375,1.0625,1.03125,1,0.9375,0.875,0.8125,0.75,0.6875,0.625,0.5625,0.5,0.375,0.25,0.125,0,0.125,0.25,0.375,0.5,0.75,1,1.25,1.5,1.75,2,2.25,2.5,3,3.5,4,4.5,5,5.5,6,6.5,7.5,8.5,9.5,10.5,11.5,12.5,13.5,14.5,16.5,18.5,20.5,22.5,24.5,26.5,28.5,30.5,34.5,38.5,42.5,46.5,50.5,54.5,58.5,62.5,70.5,78.5,86.5,94.5,102.5,110.5,118.5,126.5,142.5,158.5,174.5,190.5,206.5,222.5,238.5,254.5,286.5,318.5,350.5,382.5,414.5,446.5,478.5,1.5078125,1.5087891,1.5097656,1.5107422,1.5117188,1.5126953,1.5136719,1.5146484,1.515625,1.5175781,1.5195312,1.5214844,1.5234375,1.5253906,1.5273438,1.5292969,1.53125,1.5351562,1.5390625,1.5429688,1.546875,1.5507812,1.5546875,1.5585938,1.5625,1.5703125,1.578125,1.5859375,1.59375,1.6015625,1.609375,1.6171875,1.625,1.640625,1.65625,1.671875,1.6875,1.703125,1.71875,1.734375,1.75,1.78125,1.8125,1.84375,1.875,1.90625,1.9375,1.96875,2,2.0625,2.125,2.1875,2.25,2.3125,2.375,2.4375,2.5,2.625,2.75,2.875,3,3.125,3.25,3.375,3.5,3.75,4,4.25,4.5,4.75,5,5.25,5.5,6,6.5,7,7.5,8,8.5,9,9.5,10.5,11.5,12.5,13.5,14.5,15.5,16.5,17.5,19.5,21.5,23.5,25.5,27.5,29.5,31.5,33.5,37.5,41.5,45.5,49.5,53.5,57.5,61.5,65.5,73.5,81.5,89.5,97.5,105.5,113.5,121.5,129.5,145.5,161.5,177.5,193.5,209.5,225.5,241.5,257.5,289.5,321.5,353.5,385.5,417.5,449.5,481.5,1.625,1.6162109,1.6152344,1.6142578,1.6132812,1.6123047,1.6113281,1.6103516,1.609375,1.6074219,1.6054688,1.6035156,1.6015625,1.5996094,1.5976562,1.5957031,1.59375,1.5898438,1.5859375,1.5820312,1.578125,1.5742188,1.5703125,1.5664062,1.5625,1.5546875,1.546875,1.5390625,1.53125,1.5234375,1.515625,1.5078125,1.5,1.484375,1.46875,1.453125,1.4375,1.421875,1.40625,1.390625,1.375,1.34375,1.3125,1.28125,1.25,1.21875,1.1875,1.15625,1.125,1.0625,1,0.9375,0.875,0.8125,0.75,0.6875,0.625,0.5,0.375,0.25,0.125,0,0.125,0.25,0.375,0.625,0.875,1.125,1.375,1.625,1.875,2.125,2.375,2.875,3.375,3.875,4.375,4.875,5.375,5.875,6.375,7.375,8.375,9.375,10.375,11.375,12.375,13.375,14.375,16.375,18.375,20.375,22.375,24.375,26.375,28.375,30.375,34.375,38.375,42.375,46.375,50.375,54.375,58.375,62.375,70.375,78.375,86.375,94.375,102.375,110.375,118.375,126.375,142.375,158.375,174.375,190.375,206.375,222.375,238.375,254.375,286.375,318.375,350.375,382.375,414.375,446.375,478.375,1.6328125,1.6337891,1.6347656,1.6357422,1.6367188,1.6376953,1.6386719,1.6396484,1.640625,1.6425781,1.6445312,1.6464844,1.6484375,1.6503906,1.6523438,1.6542969,1.65625,1.6601562,1.6640625,1.6679688,1.671875,1.6757812,1.6796875,1.6835938,1.6875,1.6953125,1.703125,1.7109375,1.71875,1.7265625,1.734375,1.7421875,1.75,1.765625,1.78125,1.796875,1.8125,1.828125,1.84375,1.859375,1.875,1.90625,1.9375,1.96875,2,2.03125,2.0625,2.09375,2.125,2.1875,2.25,2.3125,2.375,2.4375,2.5,2.5625,2.625,2.75,2.875,3,3.125,3.25,3.375,3.5,3.625,3.875,4.125,4.375,4.625,4.875,5.125,5.375,5.625,6.125,6.625,7.125,7.625,8.125,8.625,9.125,9.625,10.625,11.625,12.625,13.625,14.625,15.625,16.625,17.625,19.625,21.625,23.625,25.625,27.625,29.625,31.625,33.625,37.625,41.625,45.625,49.625,53.625,57.625,61.625,65.625,73.625,81.625,89.625,97.625,105.625,113.625,121.625,129.625,145.625,161.625,177.625,193.625,209.625,225.625,241.625,257.625,289.625,321.625,353.625,385.625,417.625,449.625,481.625,1.75,1.7412109,1.7402344,1.7392578,1.7382812,1.7373047,1.7363281,1.7353516,1.734375,1.7324219,1.7304688,1.7285156,1.7265625,1.7246094,1.7226562,1.7207031,1.71875,1.7148438,1.7109375,1.7070312,1.703125,1.6992188,1.6953125,1.6914062,1.6875,1.6796875,1.671875,1.6640625,1.65625,1.6484375,1.640625,1.6328125,1.625,1.609375,1.59375,1.578125,1.5625,1.546875,1.53125,1.515625,1.5,1.46875,1.4375,1.40625,1.375,1.34375,1.3125,1.28125,1.25,1.1875,1.125,1.0625,1,0.9375,0.875,0.8125,0.75,0.625,0.5,0.375,0.25,0.125,0,0.125,0.25,0.5,0.75,1,1.25,1.5,1.75,2,2.25,2.75,3.25,3.75,4.25,4.75,5.25,5.75,6.25,7.25,8.25,9.25,10.25,11.25,12.25,13.25,14.25,16.25,18.25,20.25,22.25,24.25,26.25,28.25,30.25,34.25,38.25,42.25,46.25,50.25,54.25,58.25,62.25,70.25,78.25,86.25,94.25,102.25,110.25,118.25,126.25,142.25,158.25,174.25,190.25,206.25,222.25,238.25,254.25,286.25,318.25,350.25,382.25,414.25,446.25,478.25,1.7578125,1.7587891,1.7597656,1.7607422,1.7617188,1.7626953,1.7636719,1.7646484,1.765625,1.7675781,1.7695312,1.7714844,1.7734375,1.7753906,1.7773438,1.7792969,1.78125,1.7851562,1.7890625,1.7929688,1.796875,1.8007812,1.8046875,1.8085938,1.8125,1.8203125,1.828125,1.8359375,1.84375,1.8515625,1.859375,1.8671875,1.875,1.890625,1.90625,1.921875,1.9375,1.953125,1.96875,1.984375,2,2.03125,2.0625,2.09375,2.125,2.15625,2.1875,2.21875,2.25,2.3125,2.375,2.4375,2.5,2.5625,2.625,2.6875,2.75,2.875,3,3.125,3.25,3.375,3.5,3.625,3.75,4,4.25,4.5,4.75,5,5.25,5.5,5.75,6.25,6.75,7.25,7.75,8.25,8.75,9.25,9.75,10.75,11.75,12.75,13.75,14.75,15.75,16.75,17.75,19.75,21.75,23.75,25.75,27.75,29.75,31.75,33.75,37.75,41.75,45.75,49.75,53.75,57.75,61.75,65.75,73.75,81.75,89.75,97.75,105.75,113.75,121.75,129.75,145.75,161.75,177.75,193.75,209.75,225.75,241.75,257.75,289.75,321.75,353.75,385.75,417.75,449.75,481.75,1.875,1.8662109,1.8652344,1.8642578,1.8632812,1.8623047,1.8613281,1.8603516,1.859375,1.8574219,1.8554688,1.8535156,1.8515625,1.8496094,1.8476562,1.8457031,1.84375,1.8398438,1.8359375,1.8320312,1.828125,1.8242188,1.8203125,1.8164062,1.8125,1.8046875,1.796875,1.7890625,1.78125,1.7734375,1.765625,1.7578125,1.75,1.734375,1.71875,1.703125,1.6875,1.671875,1.65625,1.640625,1.625,1.59375,1.5625,1.53125,1.5,1.46875,1.4375,1.40625,1.375,1.3125,1.25,1.1875,1.125,1.0625,1,0.9375,0.875,0.75,0.625,0.5,0.375,0.25,0.125,0,0.125,0.375,0.625,0.875,1.125,1.375,1.625,1.875,2.125,2.625,3.125,3.625,4.125,4.625,5.125,5.625,6.125,7.125,8.125,9.125,10.125,11.125,12.125,13.125,14.125,16.125,18.125,20.125,22.125,24.125,26.125,28.125,30.125,34.125,38.125,42.125,46.125,50.125,54.125,58.125,62.125,70.125,78.125,86.125,94.125,102.125,110.125,118.125,126.125,142.125,158.125,174.125,190.125,206.125,222.125,238.125,254.125,286.125,318.125,350.125,382.125,414.125,446.125,478.125,1.8828125,1.8837891,1.8847656,1.8857422,1.8867188,1.8876953,1.8886719,1.8896484,1.890625,1.8925781,1.8945312,1.8964844,1.8984375,1.9003906,1.9023438,1.9042969,1.90625,1.9101562,1.9140625,1.9179688,1.921875,1.9257812,1.9296875,1.9335938,1.9375,1.9453125,1.953125,1.9609375,1.96875,1.9765625,1.984375,1.9921875,2,2.015625,2.03125,2.046875,2.0625,2.078125,2.09375,2.109375,2.125,2.15625,2.1875,2.21875,2.25,2.28125,2.3125,2.34375,2.375,2.4375,2.5,2.5625,2.625,2.6875,2.75,2.8125,2.875,3,3.125,3.25,3.375,3.5,3.625,3.75,3.875,4.125,4.375,4.625,4.875,5.125,5.375,5.625,5.875,6.375,6.875,7.375,7.875,8.375,8.875,9.375,9.875,10.875,11.875,12.875,13.875,14.875,15.875,16.875,17.875,19.875,21.875,23.875,25.875,27.875,29.875,31.875,33.875,37.875,41.875,45.875,49.875,53.875,57.875,61.875,65.875,73.875,81.875,89.875,97.875,105.875,113.875,121.875,129.875,145.875,161.875,177.875,193.875,209.875,225.875,241.875,257.875,289.875,321.875,353.875,385.875,417.875,449.875,481.875,2,1.9912109,1.9902344,1.9892578,1.9882812,1.9873047,1.9863281,1.9853516,1.984375,1.9824219,1.9804688,1.9785156,1.9765625,1.9746094,1.9726562,1.9707031,1.96875,1.9648438,1.9609375,1.9570312,1.953125,1.9492188,1.9453125,1.9414062,1.9375,1.9296875,1.921875,1.9140625,1.90625,1.8984375,1.890625,1.8828125,1.875,1.859375,1.84375,1.828125,1.8125,1.796875,1.78125,1.765625,1.75,1.71875,1.6875,1.65625,1.625,1.59375,1.5625,1.53125,1.5,1.4375,1.375,1.3125,1.25,1.1875,1.125,1.0625,1,0.875,0.75,0.625,0.5,0.375,0.25,0.125,0,0.25,0.5,0.75,1,1.25,1.5,1.75,2,2.5,3,3.5,4,4.5,5,5.5,6,7,8,9,10,11,12,13,14,16,18,20,22,24,26,28,30,34,38,42,46,50,54,58,62,70,78,86,94,102,110,118,126,142,158,174,190,206,222,238,254,286,318,350,382,414,446,478,2.0078125,2.008789,2.0097656,2.0107422,2.0117188,2.0126953,2.0136719,2.0146484,2.015625,2.0175781,2.0195312,2.0214844,2.0234375,2.0253906,2.0273438,2.0292969,2.03125,2.0351562,2.0390625,2.0429688,2.046875,2.0507812,2.0546875,2.0585938,2.0625,2.0703125,2.078125,2.0859375,2.09375,2.1015625,2.109375,2.1171875,2.125,2.140625,2.15625,2.171875,2.1875,2.203125,2.21875,2.234375,2.25,2.28125,2.3125,2.34375,2.375,2.40625,2.4375,2.46875,2.5,2.5625,2.625,2.6875,2.75,2.8125,2.875,2.9375,3,3.125,3.25,3.375,3.5,3.625,3.75,3.875,4,4.25,4.5,4.75,5,5.25,5.5,5.75,6,6.5,7,7.5,8,8.5,9,9.5,10,11,12,13,14,15,16,17,18,20,22,24,26,28,30,32,34,38,42,46,50,54,58,62,66,74,82,90,98,106,114,122,130,146,162,178,194,210,226,242,258,290,322,354,386,418,450,482,2.25,2.241211,2.2402344,2.2392578,2.2382812,2.2373047,2.2363281,2.2353516,2.234375,2.2324219,2.2304688,2.2285156,2.2265625,2.2246094,2.2226562,2.2207031,2.21875,2.2148438,2.2109375,2.2070312,2.203125,2.1992188,2.1953125,2.1914062,2.1875,2.1796875,2.171875,2.1640625,2.15625,2.1484375,2.140625,2.1328125,2.125,2.109375,2.09375,2.078125,2.0625,2.046875,2.03125,2.015625,2,1.96875,1.9375,1.90625,1.875,1.84375,1.8125,1.78125,1.75,1.6875,1.625,1.5625,1.5,1.4375,1.375,1.3125,1.25,1.125,1,0.875,0.75,0.625,0.5,0.375,0.25,0,0.25,0.5,0.75,1,1.25,1.5,1.75,2.25,2.75,3.25,3.75,4.25,4.75,5.25,5.75,6.75,7.75,8.75,9.75,10.75,11.75,12.75,13.75,15.75,17.75,19.75,21.75,23.75,25.75,27.75,29.75,33.75,37.75,41.75,45.75,49.75,53.75,57.75,61.75,69.75,77.75,85.75,93.75,101.75,109.75,117.75,125.75,141.75,157.75,173.75,189.75,205.75,221.75,237.75,253.75,285.75,317.75,349.75,381.75,413.75,445.75,477.75,2.2578125,2.258789,2.2597656,2.2607422,2.2617188,2.2626953,2.2636719,2.2646484,2.265625,2.2675781,2.2695312,2.2714844,2.2734375,2.2753906,2.2773438,2.2792969,2.28125,2.2851562,2.2890625,2.2929688,2.296875,2.3007812,2.3046875,2.3085938,2.3125,2.3203125,2.328125,2.3359375,2.34375,2.3515625,2.359375,2.3671875,2.375,2.390625,2.40625,2.421875,2.4375,2.453125,2.46875,2.484375,2.5,2.53125,2.5625,2.59375,2.625,2.65625,2.6875,2.71875,2.75,2.8125,2.875,2.9375,3,3.0625,3.125,3.1875,3.25,3.375,3.5,3.625,3.75,3.875,4,4.125,4.25,4.5,4.75,5,5.25,5.5,5.75,6,6.25,6.75,7.25,7.75,8.25,8.75,9.25,9.75,10.25,11.25,12.25,13.25,14.25,15.25,16.25,17.25,18.25,20.25,22.25,24.25,26.25,28.25,30.25,32.25,34.25,38.25,42.25,46.25,50.25,54.25,58.25,62.25,66.25,74.25,82.25,90.25,98.25,106.25,114.25,122.25,130.25,146.25,162.25,178.25,194.25,210.25,226.25,242.25,258.25,290.25,322.25,354.25,386.25,418.25,450.25,482.25,2.5,2.491211,2.4902344,2.4892578,2.4882812,2.4873047,2.4863281,2.4853516,2.484375,2.4824219,2.4804688,2.4785156,2.4765625,2.4746094,2.4726562,2.4707031,2.46875,2.4648438,2.4609375,2.4570312,2.453125,2.4492188,2.4453125,2.4414062,2.4375,2.4296875,2.421875,2.4140625,2.40625,2.3984375,2.390625,2.3828125,2.375,2.359375,2.34375,2.328125,2.3125,2.296875,2.28125,2.265625,2.25,2.21875,2.1875,2.15625,2.125,2.09375,2.0625,2.03125,2,1.9375,1.875,1.8125,1.75,1.6875,1.625,1.5625,1.5,1.375,1.25,1.125,1,0.875,0.75,0.625,0.5,0.25,0,0.25,0.5,0.75,1,1.25,1.5,2,2.5,3,3.5,4,4.5,5,5.5,6.5,7.5,8.5,9.5,10.5,11.5,12.5,13.5,15.5,17.5,19.5,21.5,23.5,25.5,27.5,29.5,33.5,37.5,41.5,45.5,49.5,53.5,57.5,61.5,69.5,77.5,85.5,93.5,101.5,109.5,117.5,125.5,141.5,157.5,173.5,189.5,205.5,221.5,237.5,253.5,285.5,317.5,349.5,381.5,413.5,445.5,477.5,2.5078125,2.508789,2.5097656,2.5107422,2.5117188,2.5126953,2.5136719,2.5146484,2.515625,2.5175781,2.5195312,2.5214844,2.5234375,2.5253906,2.5273438,2.5292969,2.53125,2.5351562,2.5390625,2.5429688,2.546875,2.5507812,2.5546875,2.5585938,2.5625,2.5703125,2.578125,2.5859375,2.59375,2.6015625,2.609375,2.6171875,2.625,2.640625,2.65625,2.671875,2.6875,2.703125,2.71875,2.734375,2.75,2.78125,2.8125,2.84375,2.875,2.90625,2.9375,2.96875,3,3.0625,3.125,3.1875,3.25,3.3125,3.375,3.4375,3.5,3.625,3.75,3.875,4,4.125,4.25,4.375,4.5,4.75,5,5.25,5.5,5.75,6,6.25,6.5,7,7.5,8,8.5,9,9.5,10,10.5,11.5,12.5,13.5,14.5,15.5,16.5,17.5,18.5,20.5,22.5,24.5,26.5,28.5,30.5,32.5,34.5,38.5,42.5,46.5,50.5,54.5,58.5,62.5,66.5,74.5,82.5,90.5,98.5,106.5,114.5,122.5,130.5,146.5,162.5,178.5,194.5,210.5,226.5,242.5,258.5,290.5,322.5,354.5,386.5,418.5,450.5,482.5,2.75,2.741211,2.7402344,2.7392578,2.7382812,2.7373047,2.7363281,2.7353516,2.734375,2.7324219,2.7304688,2.7285156,2.7265625,2.7246094,2.7226562,2.7207031,2.71875,2.7148438,2.7109375,2.7070312,2.703125,2.6992188,2.6953125,2.6914062,2.6875,2.6796875,2.671875,2.6640625,2.65625,2.6484375,2.640625,2.6328125,2.625,2.609375,2.59375,2.578125,2.5625,2.546875,2.53125,2.515625,2.5,2.46875,2.4375,2.40625,2.375,2.34375,2.3125,2.28125,2.25,2.1875,2.125,2.0625,2,1.9375,1.875,1.8125,1.75,1.625,1.5,1.375,1.25,1.125,1,0.875,0.75,0.5,0.25,0,0.25,0.5,0.75,1,1.25,1.75,2.25,2.75,3.25,3.75,4.25,4.75,5.25,6.25,7.25,8.25,9.25,10.25,11.25,12.25,13.25,15.25,17.25,19.25,21.25,23.25,25.25,27.25,29.25,33.25,37.25,41.25,45.25,49.25,53.25,57.25,61.25,69.25,77.25,85.25,93.25,101.25,109.25,117.25,125.25,141.25,157.25,173.25,189.25,205.25,221.25,237.25,253.25,285.25,317.25,349.25,381.25,413.25,445.25,477.25,2.7578125,2.758789,2.7597656,2.7607422,2.7617188,2.7626953,2.7636719,2.7646484,2.765625,2.7675781,2.7695312,2.7714844,2.7734375,2.7753906,2.7773438,2.7792969,2.78125,2.7851562,2.7890625,2.7929688,2.796875,2.8007812,2.8046875,2.8085938,2.8125,2.8203125,2.828125,2.8359375,2.84375,2.8515625,2.859375,2.8671875,2.875,2.890625,2.90625,2.921875,2.9375,2.953125,2.96875,2.984375,3,3.03125,3.0625,3.09375,3.125,3.15625,3.1875,3.21875,3.25,3.3125,3.375,3.4375,3.5,3.5625,3.625,3.6875,3.75,3.875,4,4.125,4.25,4.375,4.5,4.625,4.75,5,5.25,5.5,5.75,6,6.25,6.5,6.75,7.25,7.75,8.25,8.75,9.25,9.75,10.25,10.75,11.75,12.75,13.75,14.75,15.75,16.75,17.75,18.75,20.75,22.75,24.75,26.75,28.75,30.75,32.75,34.75,38.75,42.75,46.75,50.75,54.75,58.75,62.75,66.75,74.75,82.75,90.75,98.75,106.75,114.75,122.75,130.75,146.75,162.75,178.75,194.75,210.75,226.75,242.75,258.75,290.75,322.75,354.75,386.75,418.75,450.75,482.75,3,2.991211,2.9902344,2.9892578,2.9882812,2.9873047,2.9863281,2.9853516,2.984375,2.9824219,2.9804688,2.9785156,2.9765625,2.9746094,2.9726562,2.9707031,2.96875,2.9648438,2.9609375,2.9570312,2.953125,2.9492188,2.9453125,2.9414062,2.9375,2.9296875,2.921875,2.9140625,2.90625,2.8984375,2.890625,2.8828125,2.875,2.859375,2.84375,2.828125,2.8125,2.796875,2.78125,2.765625,2.75,2.71875,2.6875,2.65625,2.625,2.59375,2.5625,2.53125,2.5,2.4375,2.375,2.3125,2.25,2.1875,2.125,2.0625,2,1.875,1.75,1.625,1.5,1.375,1.25,1.125,1,0.75,0.5,0.25,0,0.25,0.5,0.75,1,1.5,2,2.5,3,3.5,4,4.5,5,6,7,8,9,10,11,12,13,15,17,19,21,23,25,27,29,33,37,41,45,49,53,57,61,69,77,85,93,101,109,117,125,141,157,173,189,205,221,237,253,285,317,349,381,413,445,477,3.0078125,3.008789,3.0097656,3.0107422,3.0117188,3.0126953,3.0136719,3.0146484,3.015625,3.0175781,3.0195312,3.0214844,3.0234375,3.0253906,3.0273438,3.0292969,3.03125,3.0351562,3.0390625,3.0429688,3.046875,3.0507812,3.0546875,3.0585938,3.0625,3.0703125,3.078125,3.0859375,3.09375,3.1015625,3.109375,3.1171875,3.125,3.140625,3.15625,3.171875,3.1875,3.203125,3.21875,3.234375,3.25,3.28125,3.3125,3.34375,3.375,3.40625,3.4375,3.46875,3.5,3.5625,3.625,3.6875,3.75,3.8125,3.875,3.9375,4,4.125,4.25,4.375,4.5,4.625,4.75,4.875,5,5.25,5.5,5.75,6,6.25,6.5,6.75,7,7.5,8,8.5,9,9.5,10,10.5,11,12,13,14,15,16,17,18,19,21,23,25,27,29,31,33,35,39,43,47,51,55,59,63,67,75,83,91,99,107,115,123,131,147,163,179,195,211,227,243,259,291,323,355,387,419,451,483,3.25,3.241211,3.2402344,3.2392578,3.2382812,3.2373047,3.2363281,3.2353516,3.234375,3.2324219,3.2304688,3.2285156,3.2265625,3.2246094,3.2226562,3.2207031,3.21875,3.2148438,3.2109375,3.2070312,3.203125,3.1992188,3.1953125,3.1914062,3.1875,3.1796875,3.171875,3.1640625,3.15625,3.1484375,3.140625,3.1328125,3.125,3.109375,3.09375,3.078125,3.0625,3.046875,3.03125,3.015625,3,2.96875,2.9375,2.90625,2.875,2.84375,2.8125,2.78125,2.75,2.6875,2.625,2.5625,2.5,2.4375,2.375,2.3125,2.25,2.125,2,1.875,1.75,1.625,1.5,1.375,1.25,1,0.75,0.5,0.25,0,0.25,0.5,0.75,1.25,1.75,2.25,2.75,3.25,3.75,4.25,4.75,5.75,6.75,7.75,8.75,9.75,10.75,11.75,12.75,14.75,16.75,18.75,20.75,22.75,24.75,26.75,28.75,32.75,36.75,40.75,44.75,48.75,52.75,56.75,60.75,68.75,76.75,84.75,92.75,100.75,108.75,116.75,124.75,140.75,156.75,172.75,188.75,204.75,220.75,236.75,252.75,284.75,316.75,348.75,380.75,412.75,444.75,476.75,3.2578125,3.258789,3.2597656,3.2607422,3.2617188,3.2626953,3.2636719,3.2646484,3.265625,3.2675781,3.2695312,3.2714844,3.2734375,3.2753906,3.2773438,3.2792969,3.28125,3.2851562,3.2890625,3.2929688,3.296875,3.3007812,3.3046875,3.3085938,3.3125,3.3203125,3.328125,3.3359375,3.34375,3.3515625,3.359375,3.3671875,3.375,3.390625,3.40625,3.421875,3.4375,3.453125,3.46875,3.484375,3.5,3.53125,3.5625,3.59375,3.625,3.65625,3.6875,3.71875,3.75,3.8125,3.875,3.9375,4,4.0625,4.125,4.1875,4.25,4.375,4.5,4.625,4.75,4.875,5,5.125,5.25,5.5,5.75,6,6.25,6.5,6.75,7,7.25,7.75,8.25,8.75,9.25,9.75,10.25,10.75,11.25,12.25,13.25,14.25,15.25,16.25,17.25,18.25,19.25,21.25,23.25,25.25,27.25,29.25,31.25,33.25,35.25,39.25,43.25,47.25,51.25,55.25,59.25,63.25,67.25,75.25,83.25,91.25,99.25,107.25,115.25,123.25,131.25,147.25,163.25,179.25,195.25,211.25,227.25,243.25,259.25,291.25,323.25,355.25,387.25,419.25,451.25,483.25,3.5,3.491211,3.4902344,3.4892578,3.4882812,3.4873047,3.4863281,3.4853516,3.484375,3.4824219,3.4804688,3.4785156,3.4765625,3.4746094,3.4726562,3.4707031,3.46875,3.4648438,3.4609375,3.4570312,3.453125,3.4492188,3.4453125,3.4414062,3.4375,3.4296875,3.421875,3.4140625,3.40625,3.3984375,3.390625,3.3828125,3.375,3.359375,3.34375,3.328125,3.3125,3.296875,3.28125,3.265625,3.25,3.21875,3.1875,3.15625,3.125,3.09375,3.0625,3.03125,3,2.9375,2.875,2.8125,2.75,2.6875,2.625,2.5625,2.5,2.375,2.25,2.125,2,1.875,1.75,1.625,1.5,1.25,1,0.75,0.5,0.25,0,0.25,0.5,1,1.5,2,2.5,3,3.5,4,4.5,5.5,6.5,7.5,8.5,9.5,10.5,11.5,12.5,14.5,16.5,18.5,20.5,22.5,24.5,26.5,28.5,32.5,36.5,40.5,44.5,48.5,52.5,56.5,60.5,68.5,76.5,84.5,92.5,100.5,108.5,116.5,124.5,140.5,156.5,172.5,188.5,204.5,220.5,236.5,252.5,284.5,316.5,348.5,380.5,412.5,444.5,476.5,3.5078125,3.508789,3.5097656,3.5107422,3.5117188,3.5126953,3.5136719,3.5146484,3.515625,3.5175781,3.5195312,3.5214844,3.5234375,3.5253906,3.5273438,3.5292969,3.53125,3.5351562,3.5390625,3.5429688,3.546875,3.5507812,3.5546875,3.5585938,3.5625,3.5703125,3.578125,3.5859375,3.59375,3.6015625,3.609375,3.6171875,3.625,3.640625,3.65625,3.671875,3.6875,3.703125,3.71875,3.734375,3.75,3.78125,3.8125,3.84375,3.875,3.90625,3.9375,3.96875,4,4.0625,4.125,4.1875,4.25,4.3125,4.375,4.4375,4.5,4.625,4.75,4.875,5,5.125,5.25,5.375,5.5,5.75,6,6.25,6.5,6.75,7,7.25,7.5,8,8.5,9,9.5,10,10.5,11,11.5,12.5,13.5,14.5,15.5,16.5,17.5,18.5,19.5,21.5,23.5,25.5,27.5,29.5,31.5,33.5,35.5,39.5,43.5,47.5,51.5,55.5,59.5,63.5,67.5,75.5,83.5,91.5,99.5,107.5,115.5,123.5,131.5,147.5,163.5,179.5,195.5,211.5,227.5,243.5,259.5,291.5,323.5,355.5,387.5,419.5,451.5,483.5,3.75,3.741211,3.7402344,3.7392578,3.7382812,3.7373047,3.7363281,3.7353516,3.734375,3.7324219,3.7304688,3.7285156,3.7265625,3.7246094,3.7226562,3.7207031,3.71875,3.7148438,3.7109375,3.7070312,3.703125,3.6992188,3.6953125,3.6914062,3.6875,3.6796875,3.671875,3.6640625,3.65625,3.6484375,3.640625,3.6328125,3.625,3.609375,3.59375,3.578125,3.5625,3.546875,3.53125,3.515625,3.5,3.46875,3.4375,3.40625,3.375,3.34375,3.3125,3.28125,3.25,3.1875,3.125,3.0625,3,2.9375,2.875,2.8125,2.75,2.625,2.5,2.375,2.25,2.125,2,1.875,1.75,1.5,1.25,1,0.75,0.5,0.25,0,0.25,0.75,1.25,1.75,2.25,2.75,3.25,3.75,4.25,5.25,6.25,7.25,8.25,9.25,10.25,11.25,12.25,14.25,16.25,18.25,20.25,22.25,24.25,26.25,28.25,32.25,36.25,40.25,44.25,48.25,52.25,56.25,60.25,68.25,76.25,84.25,92.25,100.25,108.25,116.25,124.25,140.25,156.25,172.25,188.25,204.25,220.25,236.25,252.25,284.25,316.25,348.25,380.25,412.25,444.25,476.25,3.7578125,3.758789,3.7597656,3.7607422,3.7617188,3.7626953,3.7636719,3.7646484,3.765625,3.7675781,3.7695312,3.7714844,3.7734375,3.7753906,3.7773438,3.7792969,3.78125,3.7851562,3.7890625,3.7929688,3.796875,3.8007812,3.8046875,3.8085938,3.8125,3.8203125,3.828125,3.8359375,3.84375,3.8515625,3.859375,3.8671875,3.875,3.890625,3.90625,3.921875,3.9375,3.953125,3.96875,3.984375,4,4.03125,4.0625,4.09375,4.125,4.15625,4.1875,4.21875,4.25,4.3125,4.375,4.4375,4.5,4.5625,4.625,4.6875,4.75,4.875,5,5.125,5.25,5.375,5.5,5.625,5.75,6,6.25,6.5,6.75,7,7.25,7.5,7.75,8.25,8.75,9.25,9.75,10.25,10.75,11.25,11.75,12.75,13.75,14.75,15.75,16.75,17.75,18.75,19.75,21.75,23.75,25.75,27.75,29.75,31.75,33.75,35.75,39.75,43.75,47.75,51.75,55.75,59.75,63.75,67.75,75.75,83.75,91.75,99.75,107.75,115.75,123.75,131.75,147.75,163.75,179.75,195.75,211.75,227.75,243.75,259.75,291.75,323.75,355.75,387.75,419.75,451.75,483.75,4,3.991211,3.9902344,3.9892578,3.9882812,3.9873047,3.9863281,3.9853516,3.984375,3.9824219,3.9804688,3.9785156,3.9765625,3.9746094,3.9726562,3.9707031,3.96875,3.9648438,3.9609375,3.9570312,3.953125,3.9492188,3.9453125,3.9414062,3.9375,3.9296875,3.921875,3.9140625,3.90625,3.8984375,3.890625,3.8828125,3.875,3.859375,3.84375,3.828125,3.8125,3.796875,3.78125,3.765625,3.75,3.71875,3.6875,3.65625,3.625,3.59375,3.5625,3.53125,3.5,3.4375,3.375,3.3125,3.25,3.1875,3.125,3.0625,3,2.875,2.75,2.625,2.5,2.375,2.25,2.125,2,1.75,1.5,1.25,1,0.75,0.5,0.25,0,0.5,1,1.5,2,2.5,3,3.5,4,5,6,7,8,9,10,11,12,14,16,18,20,22,24,26,28,32,36,40,44,48,52,56,60,68,76,84,92,100,108,116,124,140,156,172,188,204,220,236,252,284,316,348,380,412,444,476,4.0078125,4.008789,4.0097656,4.010742,4.0117188,4.0126953,4.013672,4.0146484,4.015625,4.017578,4.0195312,4.0214844,4.0234375,4.0253906,4.0273438,4.029297,4.03125,4.0351562,4.0390625,4.0429688,4.046875,4.0507812,4.0546875,4.0585938,4.0625,4.0703125,4.078125,4.0859375,4.09375,4.1015625,4.109375,4.1171875,4.125,4.140625,4.15625,4.171875,4.1875,4.203125,4.21875,4.234375,4.25,4.28125,4.3125,4.34375,4.375,4.40625,4.4375,4.46875,4.5,4.5625,4.625,4.6875,4.75,4.8125,4.875,4.9375,5,5.125,5.25,5.375,5.5,5.625,5.75,5.875,6,6.25,6.5,6.75,7,7.25,7.5,7.75,8,8.5,9,9.5,10,10.5,11,11.5,12,13,14,15,16,17,18,19,20,22,24,26,28,30,32,34,36,40,44,48,52,56,60,64,68,76,84,92,100,108,116,124,132,148,164,180,196,212,228,244,260,292,324,356,388,420,452,484,4.5,4.491211,4.4902344,4.489258,4.4882812,4.4873047,4.486328,4.4853516,4.484375,4.482422,4.4804688,4.4785156,4.4765625,4.4746094,4.4726562,4.470703,4.46875,4.4648438,4.4609375,4.4570312,4.453125,4.4492188,4.4453125,4.4414062,4.4375,4.4296875,4.421875,4.4140625,4.40625,4.3984375,4.390625,4.3828125,4.375,4.359375,4.34375,4.328125,4.3125,4.296875,4.28125,4.265625,4.25,4.21875,4.1875,4.15625,4.125,4.09375,4.0625,4.03125,4,3.9375,3.875,3.8125,3.75,3.6875,3.625,3.5625,3.5,3.375,3.25,3.125,3,2.875,2.75,2.625,2.5,2.25,2,1.75,1.5,1.25,1,0.75,0.5,0,0.5,1,1.5,2,2.5,3,3.5,4.5,5.5,6.5,7.5,8.5,9.5,10.5,11.5,13.5,15.5,17.5,19.5,21.5,23.5,25.5,27.5,31.5,35.5,39.5,43.5,47.5,51.5,55.5,59.5,67.5,75.5,83.5,91.5,99.5,107.5,115.5,123.5,139.5,155.5,171.5,187.5,203.5,219.5,235.5,251.5,283.5,315.5,347.5,379.5,411.5,443.5,475.5,4.5078125,4.508789,4.5097656,4.510742,4.5117188,4.5126953,4.513672,4.5146484,4.515625,4.517578,4.5195312,4.5214844,4.5234375,4.5253906,4.5273438,4.529297,4.53125,4.5351562,4.5390625,4.5429688,4.546875,4.5507812,4.5546875,4.5585938,4.5625,4.5703125,4.578125,4.5859375,4.59375,4.6015625,4.609375,4.6171875,4.625,4.640625,4.65625,4.671875,4.6875,4.703125,4.71875,4.734375,4.75,4.78125,4.8125,4.84375,4.875,4.90625,4.9375,4.96875,5,5.0625,5.125,5.1875,5.25,5.3125,5.375,5.4375,5.5,5.625,5.75,5.875,6,6.125,6.25,6.375,6.5,6.75,7,7.25,7.5,7.75,8,8.25,8.5,9,9.5,10,10.5,11,11.5,12,12.5,13.5,14.5,15.5,16.5,17.5,18.5,19.5,20.5,22.5,24.5,26.5,28.5,30.5,32.5,34.5,36.5,40.5,44.5,48.5,52.5,56.5,60.5,64.5,68.5,76.5,84.5,92.5,100.5,108.5,116.5,124.5,132.5,148.5,164.5,180.5,196.5,212.5,228.5,244.5,260.5,292.5,324.5,356.5,388.5,420.5,452.5,484.5,5,4.991211,4.9902344,4.989258,4.9882812,4.9873047,4.986328,4.9853516,4.984375,4.982422,4.9804688,4.9785156,4.9765625,4.9746094,4.9726562,4.970703,4.96875,4.9648438,4.9609375,4.9570312,4.953125,4.9492188,4.9453125,4.9414062,4.9375,4.9296875,4.921875,4.9140625,4.90625,4.8984375,4.890625,4.8828125,4.875,4.859375,4.84375,4.828125,4.8125,4.796875,4.78125,4.765625,4.75,4.71875,4.6875,4.65625,4.625,4.59375,4.5625,4.53125,4.5,4.4375,4.375,4.3125,4.25,4.1875,4.125,4.0625,4,3.875,3.75,3.625,3.5,3.375,3.25,3.125,3,2.75,2.5,2.25,2,1.75,1.5,1.25,1,0.5,0,0.5,1,1.5,2,2.5,3,4,5,6,7,8,9,10,11,13,15,17,19,21,23,25,27,31,35,39,43,47,51,55,59,67,75,83,91,99,107,115,123,139,155,171,187,203,219,235,251,283,315,347,379,411,443,475,5.0078125,5.008789,5.0097656,5.010742,5.0117188,5.0126953,5.013672,5.0146484,5.015625,5.017578,5.0195312,5.0214844,5.0234375,5.0253906,5.0273438,5.029297,5.03125,5.0351562,5.0390625,5.0429688,5.046875,5.0507812,5.0546875,5.0585938,5.0625,5.0703125,5.078125,5.0859375,5.09375,5.1015625,5.109375,5.1171875,5.125,5.140625,5.15625,5.171875,5.1875,5.203125,5.21875,5.234375,5.25,5.28125,5.3125,5.34375,5.375,5.40625,5.4375,5.46875,5.5,5.5625,5.625,5.6875,5.75,5.8125,5.875,5.9375,6,6.125,6.25,6.375,6.5,6.625,6.75,6.875,7,7.25,7.5,7.75,8,8.25,8.5,8.75,9,9.5,10,10.5,11,11.5,12,12.5,13,14,15,16,17,18,19,20,21,23,25,27,29,31,33,35,37,41,45,49,53,57,61,65,69,77,85,93,101,109,117,125,133,149,165,181,197,213,229,245,261,293,325,357,389,421,453,485,5.5,5.491211,5.4902344,5.489258,5.4882812,5.4873047,5.486328,5.4853516,5.484375,5.482422,5.4804688,5.4785156,5.4765625,5.4746094,5.4726562,5.470703,5.46875,5.4648438,5.4609375,5.4570312,5.453125,5.4492188,5.4453125,5.4414062,5.4375,5.4296875,5.421875,5.4140625,5.40625,5.3984375,5.390625,5.3828125,5.375,5.359375,5.34375,5.328125,5.3125,5.296875,5.28125,5.265625,5.25,5.21875,5.1875,5.15625,5.125,5.09375,5.0625,5.03125,5,4.9375,4.875,4.8125,4.75,4.6875,4.625,4.5625,4.5,4.375,4.25,4.125,4,3.875,3.75,3.625,3.5,3.25,3,2.75,2.5,2.25,2,1.75,1.5,1,0.5,0,0.5,1,1.5,2,2.5,3.5,4.5,5.5,6.5,7.5,8.5,9.5,10.5,12.5,14.5,16.5,18.5,20.5,22.5,24.5,26.5,30.5,34.5,38.5,42.5,46.5,50.5,54.5,58.5,66.5,74.5,82.5,90.5,98.5,106.5,114.5,122.5,138.5,154.5,170.5,186.5,202.5,218.5,234.5,250.5,282.5,314.5,346.5,378.5,410.5,442.5,474.5,5.5078125,5.508789,5.5097656,5.510742,5.5117188,5.5126953,5.513672,5.5146484,5.515625,5.517578,5.5195312,5.5214844,5.5234375,5.5253906,5.5273438,5.529297,5.53125,5.5351562,5.5390625,5.5429688,5.546875,5.5507812,5.5546875,5.5585938,5.5625,5.5703125,5.578125,5.5859375,5.59375,5.6015625,5.609375,5.6171875,5.625,5.640625,5.65625,5.671875,5.6875,5.703125,5.71875,5.734375,5.75,5.78125,5.8125,5.84375,5.875,5.90625,5.9375,5.96875,6,6.0625,6.125,6.1875,6.25,6.3125,6.375,6.4375,6.5,6.625,6.75,6.875,7,7.125,7.25,7.375,7.5,7.75,8,8.25,8.5,8.75,9,9.25,9.5,10,10.5,11,11.5,12,12.5,13,13.5,14.5,15.5,16.5,17.5,18.5,19.5,20.5,21.5,23.5,25.5,27.5,29.5,31.5,33.5,35.5,37.5,41.5,45.5,49.5,53.5,57.5,61.5,65.5,69.5,77.5,85.5,93.5,101.5,109.5,117.5,125.5,133.5,149.5,165.5,181.5,197.5,213.5,229.5,245.5,261.5,293.5,325.5,357.5,389.5,421.5,453.5,485.5,6,5.991211,5.9902344,5.989258,5.9882812,5.9873047,5.986328,5.9853516,5.984375,5.982422,5.9804688,5.9785156,5.9765625,5.9746094,5.9726562,5.970703,5.96875,5.9648438,5.9609375,5.9570312,5.953125,5.9492188,5.9453125,5.9414062,5.9375,5.9296875,5.921875,5.9140625,5.90625,5.8984375,5.890625,5.8828125,5.875,5.859375,5.84375,5.828125,5.8125,5.796875,5.78125,5.765625,5.75,5.71875,5.6875,5.65625,5.625,5.59375,5.5625,5.53125,5.5,5.4375,5.375,5.3125,5.25,5.1875,5.125,5.0625,5,4.875,4.75,4.625,4.5,4.375,4.25,4.125,4,3.75,3.5,3.25,3,2.75,2.5,2.25,2,1.5,1,0.5,0,0.5,1,1.5,2,3,4,5,6,7,8,9,10,12,14,16,18,20,22,24,26,30,34,38,42,46,50,54,58,66,74,82,90,98,106,114,122,138,154,170,186,202,218,234,250,282,314,346,378,410,442,474,6.0078125,6.008789,6.0097656,6.010742,6.0117188,6.0126953,6.013672,6.0146484,6.015625,6.017578,6.0195312,6.0214844,6.0234375,6.0253906,6.0273438,6.029297,6.03125,6.0351562,6.0390625,6.0429688,6.046875,6.0507812,6.0546875,6.0585938,6.0625,6.0703125,6.078125,6.0859375,6.09375,6.1015625,6.109375,6.1171875,6.125,6.140625,6.15625,6.171875,6.1875,6.203125,6.21875,6.234375,6.25,6.28125,6.3125,6.34375,6.375,6.40625,6.4375,6.46875,6.5,6.5625,6.625,6.6875,6.75,6.8125,6.875,6.9375,7,7.125,7.25,7.375,7.5,7.625,7.75,7.875,8,8.25,8.5,8.75,9,9.25,9.5,9.75,10,10.5,11,11.5,12,12.5,13,13.5,14,15,16,17,18,19,20,21,22,24,26,28,30,32,34,36,38,42,46,50,54,58,62,66,70,78,86,94,102,110,118,126,134,150,166,182,198,214,230,246,262,294,326,358,390,422,454,486,6.5,6.491211,6.4902344,6.489258,6.4882812,6.4873047,6.486328,6.4853516,6.484375,6.482422,6.4804688,6.4785156,6.4765625,6.4746094,6.4726562,6.470703,6.46875,6.4648438,6.4609375,6.4570312,6.453125,6.4492188,6.4453125,6.4414062,6.4375,6.4296875,6.421875,6.4140625,6.40625,6.3984375,6.390625,6.3828125,6.375,6.359375,6.34375,6.328125,6.3125,6.296875,6.28125,6.265625,6.25,6.21875,6.1875,6.15625,6.125,6.09375,6.0625,6.03125,6,5.9375,5.875,5.8125,5.75,5.6875,5.625,5.5625,5.5,5.375,5.25,5.125,5,4.875,4.75,4.625,4.5,4.25,4,3.75,3.5,3.25,3,2.75,2.5,2,1.5,1,0.5,0,0.5,1,1.5,2.5,3.5,4.5,5.5,6.5,7.5,8.5,9.5,11.5,13.5,15.5,17.5,19.5,21.5,23.5,25.5,29.5,33.5,37.5,41.5,45.5,49.5,53.5,57.5,65.5,73.5,81.5,89.5,97.5,105.5,113.5,121.5,137.5,153.5,169.5,185.5,201.5,217.5,233.5,249.5,281.5,313.5,345.5,377.5,409.5,441.5,473.5,6.5078125,6.508789,6.5097656,6.510742,6.5117188,6.5126953,6.513672,6.5146484,6.515625,6.517578,6.5195312,6.5214844,6.5234375,6.5253906,6.5273438,6.529297,6.53125,6.5351562,6.5390625,6.5429688,6.546875,6.5507812,6.5546875,6.5585938,6.5625,6.5703125,6.578125,6.5859375,6.59375,6.6015625,6.609375,6.6171875,6.625,6.640625,6.65625,6.671875,6.6875,6.703125,6.71875,6.734375,6.75,6.78125,6.8125,6.84375,6.875,6.90625,6.9375,6.96875,7,7.0625,7.125,7.1875,7.25,7.3125,7.375,7.4375,7.5,7.625,7.75,7.875,8,8.125,8.25,8.375,8.5,8.75,9,9.25,9.5,9.75,10,10.25,10.5,11,11.5,12,12.5,13,13.5,14,14.5,15.5,16.5,17.5,18.5,19.5,20.5,21.5,22.5,24.5,26.5,28.5,30.5,32.5,34.5,36.5,38.5,42.5,46.5,50.5,54.5,58.5,62.5,66.5,70.5,78.5,86.5,94.5,102.5,110.5,118.5,126.5,134.5,150.5,166.5,182.5,198.5,214.5,230.5,246.5,262.5,294.5,326.5,358.5,390.5,422.5,454.5,486.5,7,6.991211,6.9902344,6.989258,6.9882812,6.9873047,6.986328,6.9853516,6.984375,6.982422,6.9804688,6.9785156,6.9765625,6.9746094,6.9726562,6.970703,6.96875,6.9648438,6.9609375,6.9570312,6.953125,6.9492188,6.9453125,6.9414062,6.9375,6.9296875,6.921875,6.9140625,6.90625,6.8984375,6.890625,6.8828125,6.875,6.859375,6.84375,6.828125,6.8125,6.796875,6.78125,6.765625,6.75,6.71875,6.6875,6.65625,6.625,6.59375,6.5625,6.53125,6.5,6.4375,6.375,6.3125,6.25,6.1875,6.125,6.0625,6,5.875,5.75,5.625,5.5,5.375,5.25,5.125,5,4.75,4.5,4.25,4,3.75,3.5,3.25,3,2.5,2,1.5,1,0.5,0,0.5,1,2,3,4,5,6,7,8,9,11,13,15,17,19,21,23,25,29,33,37,41,45,49,53,57,65,73,81,89,97,105,113,121,137,153,169,185,201,217,233,249,281,313,345,377,409,441,473,7.0078125,7.008789,7.0097656,7.010742,7.0117188,7.0126953,7.013672,7.0146484,7.015625,7.017578,7.0195312,7.0214844,7.0234375,7.0253906,7.0273438,7.029297,7.03125,7.0351562,7.0390625,7.0429688,7.046875,7.0507812,7.0546875,7.0585938,7.0625,7.0703125,7.078125,7.0859375,7.09375,7.1015625,7.109375,7.1171875,7.125,7.140625,7.15625,7.171875,7.1875,7.203125,7.21875,7.234375,7.25,7.28125,7.3125,7.34375,7.375,7.40625,7.4375,7.46875,7.5,7.5625,7.625,7.6875,7.75,7.8125,7.875,7.9375,8,8.125,8.25,8.375,8.5,8.625,8.75,8.875,9,9.25,9.5,9.75,10,10.25,10.5,10.75,11,11.5,12,12.5,13,13.5,14,14.5,15,16,17,18,19,20,21,22,23,25,27,29,31,33,35,37,39,43,47,51,55,59,63,67,71,79,87,95,103,111,119,127,135,151,167,183,199,215,231,247,263,295,327,359,391,423,455,487,7.5,7.491211,7.4902344,7.489258,7.4882812,7.4873047,7.486328,7.4853516,7.484375,7.482422,7.4804688,7.4785156,7.4765625,7.4746094,7.4726562,7.470703,7.46875,7.4648438,7.4609375,7.4570312,7.453125,7.4492188,7.4453125,7.4414062,7.4375,7.4296875,7.421875,7.4140625,7.40625,7.3984375,7.390625,7.3828125,7.375,7.359375,7.34375,7.328125,7.3125,7.296875,7.28125,7.265625,7.25,7.21875,7.1875,7.15625,7.125,7.09375,7.0625,7.03125,7,6.9375,6.875,6.8125,6.75,6.6875,6.625,6.5625,6.5,6.375,6.25,6.125,6,5.875,5.75,5.625,5.5,5.25,5,4.75,4.5,4.25,4,3.75,3.5,3,2.5,2,1.5,1,0.5,0,0.5,1.5,2.5,3.5,4.5,5.5,6.5,7.5,8.5,10.5,12.5,14.5,16.5,18.5,20.5,22.5,24.5,28.5,32.5,36.5,40.5,44.5,48.5,52.5,56.5,64.5,72.5,80.5,88.5,96.5,104.5,112.5,120.5,136.5,152.5,168.5,184.5,200.5,216.5,232.5,248.5,280.5,312.5,344.5,376.5,408.5,440.5,472.5,7.5078125,7.508789,7.5097656,7.510742,7.5117188,7.5126953,7.513672,7.5146484,7.515625,7.517578,7.5195312,7.5214844,7.5234375,7.5253906,7.5273438,7.529297,7.53125,7.5351562,7.5390625,7.5429688,7.546875,7.5507812,7.5546875,7.5585938,7.5625,7.5703125,7.578125,7.5859375,7.59375,7.6015625,7.609375,7.6171875,7.625,7.640625,7.65625,7.671875,7.6875,7.703125,7.71875,7.734375,7.75,7.78125,7.8125,7.84375,7.875,7.90625,7.9375,7.96875,8,8.0625,8.125,8.1875,8.25,8.3125,8.375,8.4375,8.5,8.625,8.75,8.875,9,9.125,9.25,9.375,9.5,9.75,10,10.25,10.5,10.75,11,11.25,11.5,12,12.5,13,13.5,14,14.5,15,15.5,16.5,17.5,18.5,19.5,20.5,21.5,22.5,23.5,25.5,27.5,29.5,31.5,33.5,35.5,37.5,39.5,43.5,47.5,51.5,55.5,59.5,63.5,67.5,71.5,79.5,87.5,95.5,103.5,111.5,119.5,127.5,135.5,151.5,167.5,183.5,199.5,215.5,231.5,247.5,263.5,295.5,327.5,359.5,391.5,423.5,455.5,487.5,8,7.991211,7.9902344,7.989258,7.9882812,7.9873047,7.986328,7.9853516,7.984375,7.982422,7.9804688,7.9785156,7.9765625,7.9746094,7.9726562,7.970703,7.96875,7.9648438,7.9609375,7.9570312,7.953125,7.9492188,7.9453125,7.9414062,7.9375,7.9296875,7.921875,7.9140625,7.90625,7.8984375,7.890625,7.8828125,7.875,7.859375,7.84375,7.828125,7.8125,7.796875,7.78125,7.765625,7.75,7.71875,7.6875,7.65625,7.625,7.59375,7.5625,7.53125,7.5,7.4375,7.375,7.3125,7.25,7.1875,7.125,7.0625,7,6.875,6.75,6.625,6.5,6.375,6.25,6.125,6,5.75,5.5,5.25,5,4.75,4.5,4.25,4,3.5,3,2.5,2,1.5,1,0.5,0,1,2,3,4,5,6,7,8,10,12,14,16,18,20,22,24,28,32,36,40,44,48,52,56,64,72,80,88,96,104,112,120,136,152,168,184,200,216,232,248,280,312,344,376,408,440,472,8.0078125,8.008789,8.009766,8.010742,8.011719,8.012695,8.013672,8.014648,8.015625,8.017578,8.019531,8.021484,8.0234375,8.025391,8.027344,8.029297,8.03125,8.035156,8.0390625,8.042969,8.046875,8.050781,8.0546875,8.058594,8.0625,8.0703125,8.078125,8.0859375,8.09375,8.1015625,8.109375,8.1171875,8.125,8.140625,8.15625,8.171875,8.1875,8.203125,8.21875,8.234375,8.25,8.28125,8.3125,8.34375,8.375,8.40625,8.4375,8.46875,8.5,8.5625,8.625,8.6875,8.75,8.8125,8.875,8.9375,9,9.125,9.25,9.375,9.5,9.625,9.75,9.875,10,10.25,10.5,10.75,11,11.25,11.5,11.75,12,12.5,13,13.5,14,14.5,15,15.5,16,17,18,19,20,21,22,23,24,26,28,30,32,34,36,38,40,44,48,52,56,60,64,68,72,80,88,96,104,112,120,128,136,152,168,184,200,216,232,248,264,296,328,360,392,424,456,488,9,8.991211,8.990234,8.989258,8.988281,8.987305,8.986328,8.985352,8.984375,8.982422,8.980469,8.978516,8.9765625,8.974609,8.972656,8.970703,8.96875,8.964844,8.9609375,8.957031,8.953125,8.949219,8.9453125,8.941406,8.9375,8.9296875,8.921875,8.9140625,8.90625,8.8984375,8.890625,8.8828125,8.875,8.859375,8.84375,8.828125,8.8125,8.796875,8.78125,8.765625,8.75,8.71875,8.6875,8.65625,8.625,8.59375,8.5625,8.53125,8.5,8.4375,8.375,8.3125,8.25,8.1875,8.125,8.0625,8,7.875,7.75,7.625,7.5,7.375,7.25,7.125,7,6.75,6.5,6.25,6,5.75,5.5,5.25,5,4.5,4,3.5,3,2.5,2,1.5,1,0,1,2,3,4,5,6,7,9,11,13,15,17,19,21,23,27,31,35,39,43,47,51,55,63,71,79,87,95,103,111,119,135,151,167,183,199,215,231,247,279,311,343,375,407,439,471,9.0078125,9.008789,9.009766,9.010742,9.011719,9.012695,9.013672,9.014648,9.015625,9.017578,9.019531,9.021484,9.0234375,9.025391,9.027344,9.029297,9.03125,9.035156,9.0390625,9.042969,9.046875,9.050781,9.0546875,9.058594,9.0625,9.0703125,9.078125,9.0859375,9.09375,9.1015625,9.109375,9.1171875,9.125,9.140625,9.15625,9.171875,9.1875,9.203125,9.21875,9.234375,9.25,9.28125,9.3125,9.34375,9.375,9.40625,9.4375,9.46875,9.5,9.5625,9.625,9.6875,9.75,9.8125,9.875,9.9375,10,10.125,10.25,10.375,10.5,10.625,10.75,10.875,11,11.25,11.5,11.75,12,12.25,12.5,12.75,13,13.5,14,14.5,15,15.5,16,16.5,17,18,19,20,21,22,23,24,25,27,29,31,33,35,37,39,41,45,49,53,57,61,65,69,73,81,89,97,105,113,121,129,137,153,169,185,201,217,233,249,265,297,329,361,393,425,457,489,10,9.991211,9.990234,9.989258,9.988281,9.987305,9.986328,9.985352,9.984375,9.982422,9.980469,9.978516,9.9765625,9.974609,9.972656,9.970703,9.96875,9.964844,9.9609375,9.957031,9.953125,9.949219,9.9453125,9.941406,9.9375,9.9296875,9.921875,9.9140625,9.90625,9.8984375,9.890625,9.8828125,9.875,9.859375,9.84375,9.828125,9.8125,9.796875,9.78125,9.765625,9.75,9.71875,9.6875,9.65625,9.625,9.59375,9.5625,9.53125,9.5,9.4375,9.375,9.3125,9.25,9.1875,9.125,9.0625,9,8.875,8.75,8.625,8.5,8.375,8.25,8.125,8,7.75,7.5,7.25,7,6.75,6.5,6.25,6,5.5,5,4.5,4,3.5,3,2.5,2,1,0,1,2,3,4,5,6,8,10,12,14,16,18,20,22,26,30,34,38,42,46,50,54,62,70,78,86,94,102,110,118,134,150,166,182,198,214,230,246,278,310,342,374,406,438,470,10.0078125,10.008789,10.009766,10.010742,10.011719,10.012695,10.013672,10.014648,10.015625,10.017578,10.019531,10.021484,10.0234375,10.025391,10.027344,10.029297,10.03125,10.035156,10.0390625,10.042969,10.046875,10.050781,10.0546875,10.058594,10.0625,10.0703125,10.078125,10.0859375,10.09375,10.1015625,10.109375,10.1171875,10.125,10.140625,10.15625,10.171875,10.1875,10.203125,10.21875,10.234375,10.25,10.28125,10.3125,10.34375,10.375,10.40625,10.4375,10.46875,10.5,10.5625,10.625,10.6875,10.75,10.8125,10.875,10.9375,11,11.125,11.25,11.375,11.5,11.625,11.75,11.875,12,12.25,12.5,12.75,13,13.25,13.5,13.75,14,14.5,15,15.5,16,16.5,17,17.5,18,19,20,21,22,23,24,25,26,28,30,32,34,36,38,40,42,46,50,54,58,62,66,70,74,82,90,98,106,114,122,130,138,154,170,186,202,218,234,250,266,298,330,362,394,426,458,490,11,10.991211,10.990234,10.989258,10.988281,10.987305,10.986328,10.985352,10.984375,10.982422,10.980469,10.978516,10.9765625,10.974609,10.972656,10.970703,10.96875,10.964844,10.9609375,10.957031,10.953125,10.949219,10.9453125,10.941406,10.9375,10.9296875,10.921875,10.9140625,10.90625,10.8984375,10.890625,10.8828125,10.875,10.859375,10.84375,10.828125,10.8125,10.796875,10.78125,10.765625,10.75,10.71875,10.6875,10.65625,10.625,10.59375,10.5625,10.53125,10.5,10.4375,10.375,10.3125,10.25,10.1875,10.125,10.0625,10,9.875,9.75,9.625,9.5,9.375,9.25,9.125,9,8.75,8.5,8.25,8,7.75,7.5,7.25,7,6.5,6,5.5,5,4.5,4,3.5,3,2,1,0,1,2,3,4,5,7,9,11,13,15,17,19,21,25,29,33,37,41,45,49,53,61,69,77,85,93,101,109,117,133,149,165,181,197,213,229,245,277,309,341,373,405,437,469,11.0078125,11.008789,11.009766,11.010742,11.011719,11.012695,11.013672,11.014648,11.015625,11.017578,11.019531,11.021484,11.0234375,11.025391,11.027344,11.029297,11.03125,11.035156,11.0390625,11.042969,11.046875,11.050781,11.0546875,11.058594,11.0625,11.0703125,11.078125,11.0859375,11.09375,11.1015625,11.109375,11.1171875,11.125,11.140625,11.15625,11.171875,11.1875,11.203125,11.21875,11.234375,11.25,11.28125,11.3125,11.34375,11.375,11.40625,11.4375,11.46875,11.5,11.5625,11.625,11.6875,11.75,11.8125,11.875,11.9375,12,12.125,12.25,12.375,12.5,12.625,12.75,12.875,13,13.25,13.5,13.75,14,14.25,14.5,14.75,15,15.5,16,16.5,17,17.5,18,18.5,19,20,21,22,23,24,25,26,27,29,31,33,35,37,39,41,43,47,51,55,59,63,67,71,75,83,91,99,107,115,123,131,139,155,171,187,203,219,235,251,267,299,331,363,395,427,459,491,12,11.991211,11.990234,11.989258,11.988281,11.987305,11.986328,11.985352,11.984375,11.982422,11.980469,11.978516,11.9765625,11.974609,11.972656,11.970703,11.96875,11.964844,11.9609375,11.957031,11.953125,11.949219,11.9453125,11.941406,11.9375,11.9296875,11.921875,11.9140625,11.90625,11.8984375,11.890625,11.8828125,11.875,11.859375,11.84375,11.828125,11.8125,11.796875,11.78125,11.765625,11.75,11.71875,11.6875,11.65625,11.625,11.59375,11.5625,11.53125,11.5,11.4375,11.375,11.3125,11.25,11.1875,11.125,11.0625,11,10.875,10.75,10.625,10.5,10.375,10.25,10.125,10,9.75,9.5,9.25,9,8.75,8.5,8.25,8,7.5,7,6.5,6,5.5,5,4.5,4,3,2,1,0,1,2,3,4,6,8,10,12,14,16,18,20,24,28,32,36,40,44,48,52,60,68,76,84,92,100,108,116,132,148,164,180,196,212,228,244,276,308,340,372,404,436,468,12.0078125,12.008789,12.009766,12.010742,12.011719,12.012695,12.013672,12.014648,12.015625,12.017578,12.019531,12.021484,12.0234375,12.025391,12.027344,12.029297,12.03125,12.035156,12.0390625,12.042969,12.046875,12.050781,12.0546875,12.058594,12.0625,12.0703125,12.078125,12.0859375,12.09375,12.1015625,12.109375,12.1171875,12.125,12.140625,12.15625,12.171875,12.1875,12.203125,12.21875,12.234375,12.25,12.28125,12.3125,12.34375,12.375,12.40625,12.4375,12.46875,12.5,12.5625,12.625,12.6875,12.75,12.8125,12.875,12.9375,13,13.125,13.25,13.375,13.5,13.625,13.75,13.875,14,14.25,14.5,14.75,15,15.25,15.5,15.75,16,16.5,17,17.5,18,18.5,19,19.5,20,21,22,23,24,25,26,27,28,30,32,34,36,38,40,42,44,48,52,56,60,64,68,72,76,84,92,100,108,116,124,132,140,156,172,188,204,220,236,252,268,300,332,364,396,428,460,492,13,12.991211,12.990234,12.989258,12.988281,12.987305,12.986328,12.985352,12.984375,12.982422,12.980469,12.978516,12.9765625,12.974609,12.972656,12.970703,12.96875,12.964844,12.9609375,12.957031,12.953125,12.949219,12.9453125,12.941406,12.9375,12.9296875,12.921875,12.9140625,12.90625,12.8984375,12.890625,12.8828125,12.875,12.859375,12.84375,12.828125,12.8125,12.796875,12.78125,12.765625,12.75,12.71875,12.6875,12.65625,12.625,12.59375,12.5625,12.53125,12.5,12.4375,12.375,12.3125,12.25,12.1875,12.125,12.0625,12,11.875,11.75,11.625,11.5,11.375,11.25,11.125,11,10.75,10.5,10.25,10,9.75,9.5,9.25,9,8.5,8,7.5,7,6.5,6,5.5,5,4,3,2,1,0,1,2,3,5,7,9,11,13,15,17,19,23,27,31,35,39,43,47,51,59,67,75,83,91,99,107,115,131,147,163,179,195,211,227,243,275,307,339,371,403,435,467,13.0078125,13.008789,13.009766,13.010742,13.011719,13.012695,13.013672,13.014648,13.015625,13.017578,13.019531,13.021484,13.0234375,13.025391,13.027344,13.029297,13.03125,13.035156,13.0390625,13.042969,13.046875,13.050781,13.0546875,13.058594,13.0625,13.0703125,13.078125,13.0859375,13.09375,13.1015625,13.109375,13.1171875,13.125,13.140625,13.15625,13.171875,13.1875,13.203125,13.21875,13.234375,13.25,13.28125,13.3125,13.34375,13.375,13.40625,13.4375,13.46875,13.5,13.5625,13.625,13.6875,13.75,13.8125,13.875,13.9375,14,14.125,14.25,14.375,14.5,14.625,14.75,14.875,15,15.25,15.5,15.75,16,16.25,16.5,16.75,17,17.5,18,18.5,19,19.5,20,20.5,21,22,23,24,25,26,27,28,29,31,33,35,37,39,41,43,45,49,53,57,61,65,69,73,77,85,93,101,109,117,125,133,141,157,173,189,205,221,237,253,269,301,333,365,397,429,461,493,14,13.991211,13.990234,13.989258,13.988281,13.987305,13.986328,13.985352,13.984375,13.982422,13.980469,13.978516,13.9765625,13.974609,13.972656,13.970703,13.96875,13.964844,13.9609375,13.957031,13.953125,13.949219,13.9453125,13.941406,13.9375,13.9296875,13.921875,13.9140625,13.90625,13.8984375,13.890625,13.8828125,13.875,13.859375,13.84375,13.828125,13.8125,13.796875,13.78125,13.765625,13.75,13.71875,13.6875,13.65625,13.625,13.59375,13.5625,13.53125,13.5,13.4375,13.375,13.3125,13.25,13.1875,13.125,13.0625,13,12.875,12.75,12.625,12.5,12.375,12.25,12.125,12,11.75,11.5,11.25,11,10.75,10.5,10.25,10,9.5,9,8.5,8,7.5,7,6.5,6,5,4,3,2,1,0,1,2,4,6,8,10,12,14,16,18,22,26,30,34,38,42,46,50,58,66,74,82,90,98,106,114,130,146,162,178,194,210,226,242,274,306,338,370,402,434,466,14.0078125,14.008789,14.009766,14.010742,14.011719,14.012695,14.013672,14.014648,14.015625,14.017578,14.019531,14.021484,14.0234375,14.025391,14.027344,14.029297,14.03125,14.035156,14.0390625,14.042969,14.046875,14.050781,14.0546875,14.058594,14.0625,14.0703125,14.078125,14.0859375,14.09375,14.1015625,14.109375,14.1171875,14.125,14.140625,14.15625,14.171875,14.1875,14.203125,14.21875,14.234375,14.25,14.28125,14.3125,14.34375,14.375,14.40625,14.4375,14.46875,14.5,14.5625,14.625,14.6875,14.75,14.8125,14.875,14.9375,15,15.125,15.25,15.375,15.5,15.625,15.75,15.875,16,16.25,16.5,16.75,17,17.25,17.5,17.75,18,18.5,19,19.5,20,20.5,21,21.5,22,23,24,25,26,27,28,29,30,32,34,36,38,40,42,44,46,50,54,58,62,66,70,74,78,86,94,102,110,118,126,134,142,158,174,190,206,222,238,254,270,302,334,366,398,430,462,494,15,14.991211,14.990234,14.989258,14.988281,14.987305,14.986328,14.985352,14.984375,14.982422,14.980469,14.978516,14.9765625,14.974609,14.972656,14.970703,14.96875,14.964844,14.9609375,14.957031,14.953125,14.949219,14.9453125,14.941406,14.9375,14.9296875,14.921875,14.9140625,14.90625,14.8984375,14.890625,14.8828125,14.875,14.859375,14.84375,14.828125,14.8125,14.796875,14.78125,14.765625,14.75,14.71875,14.6875,14.65625,14.625,14.59375,14.5625,14.53125,14.5,14.4375,14.375,14.3125,14.25,14.1875,14.125,14.0625,14,13.875,13.75,13.625,13.5,13.375,13.25,13.125,13,12.75,12.5,12.25,12,11.75,11.5,11.25,11,10.5,10,9.5,9,8.5,8,7.5,7,6,5,4,3,2,1,0,1,3,5,7,9,11,13,15,17,21,25,29,33,37,41,45,49,57,65,73,81,89,97,105,113,129,145,161,177,193,209,225,241,273,305,337,369,401,433,465,15.0078125,15.008789,15.009766,15.010742,15.011719,15.012695,15.013672,15.014648,15.015625,15.017578,15.019531,15.021484,15.0234375,15.025391,15.027344,15.029297,15.03125,15.035156,15.0390625,15.042969,15.046875,15.050781,15.0546875,15.058594,15.0625,15.0703125,15.078125,15.0859375,15.09375,15.1015625,15.109375,15.1171875,15.125,15.140625,15.15625,15.171875,15.1875,15.203125,15.21875,15.234375,15.25,15.28125,15.3125,15.34375,15.375,15.40625,15.4375,15.46875,15.5,15.5625,15.625,15.6875,15.75,15.8125,15.875,15.9375,16,16.125,16.25,16.375,16.5,16.625,16.75,16.875,17,17.25,17.5,17.75,18,18.25,18.5,18.75,19,19.5,20,20.5,21,21.5,22,22.5,23,24,25,26,27,28,29,30,31,33,35,37,39,41,43,45,47,51,55,59,63,67,71,75,79,87,95,103,111,119,127,135,143,159,175,191,207,223,239,255,271,303,335,367,399,431,463,495,16,15.991211,15.990234,15.989258,15.988281,15.987305,15.986328,15.985352,15.984375,15.982422,15.980469,15.978516,15.9765625,15.974609,15.972656,15.970703,15.96875,15.964844,15.9609375,15.957031,15.953125,15.949219,15.9453125,15.941406,15.9375,15.9296875,15.921875,15.9140625,15.90625,15.8984375,15.890625,15.8828125,15.875,15.859375,15.84375,15.828125,15.8125,15.796875,15.78125,15.765625,15.75,15.71875,15.6875,15.65625,15.625,15.59375,15.5625,15.53125,15.5,15.4375,15.375,15.3125,15.25,15.1875,15.125,15.0625,15,14.875,14.75,14.625,14.5,14.375,14.25,14.125,14,13.75,13.5,13.25,13,12.75,12.5,12.25,12,11.5,11,10.5,10,9.5,9,8.5,8,7,6,5,4,3,2,1,0,2,4,6,8,10,12,14,16,20,24,28,32,36,40,44,48,56,64,72,80,88,96,104,112,128,144,160,176,192,208,224,240,272,304,336,368,400,432,464,16.007812,16.00879,16.009766,16.010742,16.011719,16.012695,16.013672,16.014648,16.015625,16.017578,16.019531,16.021484,16.023438,16.02539,16.027344,16.029297,16.03125,16.035156,16.039062,16.042969,16.046875,16.050781,16.054688,16.058594,16.0625,16.070312,16.078125,16.085938,16.09375,16.101562,16.109375,16.117188,16.125,16.140625,16.15625,16.171875,16.1875,16.203125,16.21875,16.234375,16.25,16.28125,16.3125,16.34375,16.375,16.40625,16.4375,16.46875,16.5,16.5625,16.625,16.6875,16.75,16.8125,16.875,16.9375,17,17.125,17.25,17.375,17.5,17.625,17.75,17.875,18,18.25,18.5,18.75,19,19.25,19.5,19.75,20,20.5,21,21.5,22,22.5,23,23.5,24,25,26,27,28,29,30,31,32,34,36,38,40,42,44,46,48,52,56,60,64,68,72,76,80,88,96,104,112,120,128,136,144,160,176,192,208,224,240,256,272,304,336,368,400,432,464,496,18,17.99121,17.990234,17.989258,17.988281,17.987305,17.986328,17.985352,17.984375,17.982422,17.980469,17.978516,17.976562,17.97461,17.972656,17.970703,17.96875,17.964844,17.960938,17.957031,17.953125,17.949219,17.945312,17.941406,17.9375,17.929688,17.921875,17.914062,17.90625,17.898438,17.890625,17.882812,17.875,17.859375,17.84375,17.828125,17.8125,17.796875,17.78125,17.765625,17.75,17.71875,17.6875,17.65625,17.625,17.59375,17.5625,17.53125,17.5,17.4375,17.375,17.3125,17.25,17.1875,17.125,17.0625,17,16.875,16.75,16.625,16.5,16.375,16.25,16.125,16,15.75,15.5,15.25,15,14.75,14.5,14.25,14,13.5,13,12.5,12,11.5,11,10.5,10,9,8,7,6,5,4,3,2,0,2,4,6,8,10,12,14,18,22,26,30,34,38,42,46,54,62,70,78,86,94,102,110,126,142,158,174,190,206,222,238,270,302,334,366,398,430,462,18.007812,18.00879,18.009766,18.010742,18.011719,18.012695,18.013672,18.014648,18.015625,18.017578,18.019531,18.021484,18.023438,18.02539,18.027344,18.029297,18.03125,18.035156,18.039062,18.042969,18.046875,18.050781,18.054688,18.058594,18.0625,18.070312,18.078125,18.085938,18.09375,18.101562,18.109375,18.117188,18.125,18.140625,18.15625,18.171875,18.1875,18.203125,18.21875,18.234375,18.25,18.28125,18.3125,18.34375,18.375,18.40625,18.4375,18.46875,18.5,18.5625,18.625,18.6875,18.75,18.8125,18.875,18.9375,19,19.125,19.25,19.375,19.5,19.625,19.75,19.875,20,20.25,20.5,20.75,21,21.25,21.5,21.75,22,22.5,23,23.5,24,24.5,25,25.5,26,27,28,29,30,31,32,33,34,36,38,40,42,44,46,48,50,54,58,62,66,70,74,78,82,90,98,106,114,122,130,138,146,162,178,194,210,226,242,258,274,306,338,370,402,434,466,498,20,19.99121,19.990234,19.989258,19.988281,19.987305,19.986328,19.985352,19.984375,19.982422,19.980469,19.978516,19.976562,19.97461,19.972656,19.970703,19.96875,19.964844,19.960938,19.957031,19.953125,19.949219,19.945312,19.941406,19.9375,19.929688,19.921875,19.914062,19.90625,19.898438,19.890625,19.882812,19.875,19.859375,19.84375,19.828125,19.8125,19.796875,19.78125,19.765625,19.75,19.71875,19.6875,19.65625,19.625,19.59375,19.5625,19.53125,19.5,19.4375,19.375,19.3125,19.25,19.1875,19.125,19.0625,19,18.875,18.75,18.625,18.5,18.375,18.25,18.125,18,17.75,17.5,17.25,17,16.75,16.5,16.25,16,15.5,15,14.5,14,13.5,13,12.5,12,11,10,9,8,7,6,5,4,2,0,2,4,6,8,10,12,16,20,24,28,32,36,40,44,52,60,68,76,84,92,100,108,124,140,156,172,188,204,220,236,268,300,332,364,396,428,460,20.007812,20.00879,20.009766,20.010742,20.011719,20.012695,20.013672,20.014648,20.015625,20.017578,20.019531,20.021484,20.023438,20.02539,20.027344,20.029297,20.03125,20.035156,20.039062,20.042969,20.046875,20.050781,20.054688,20.058594,20.0625,20.070312,20.078125,20.085938,20.09375,20.101562,20.109375,20.117188,20.125,20.140625,20.15625,20.171875,20.1875,20.203125,20.21875,20.234375,20.25,20.28125,20.3125,20.34375,20.375,20.40625,20.4375,20.46875,20.5,20.5625,20.625,20.6875,20.75,20.8125,20.875,20.9375,21,21.125,21.25,21.375,21.5,21.625,21.75,21.875,22,22.25,22.5,22.75,23,23.25,23.5,23.75,24,24.5,25,25.5,26,26.5,27,27.5,28,29,30,31,32,33,34,35,36,38,40,42,44,46,48,50,52,56,60,64,68,72,76,80,84,92,100,108,116,124,132,140,148,164,180,196,212,228,244,260,276,308,340,372,404,436,468,500,22,21.99121,21.990234,21.989258,21.988281,21.987305,21.986328,21.985352,21.984375,21.982422,21.980469,21.978516,21.976562,21.97461,21.972656,21.970703,21.96875,21.964844,21.960938,21.957031,21.953125,21.949219,21.945312,21.941406,21.9375,21.929688,21.921875,21.914062,21.90625,21.898438,21.890625,21.882812,21.875,21.859375,21.84375,21.828125,21.8125,21.796875,21.78125,21.765625,21.75,21.71875,21.6875,21.65625,21.625,21.59375,21.5625,21.53125,21.5,21.4375,21.375,21.3125,21.25,21.1875,21.125,21.0625,21,20.875,20.75,20.625,20.5,20.375,20.25,20.125,20,19.75,19.5,19.25,19,18.75,18.5,18.25,18,17.5,17,16.5,16,15.5,15,14.5,14,13,12,11,10,9,8,7,6,4,2,0,2,4,6,8,10,14,18,22,26,30,34,38,42,50,58,66,74,82,90,98,106,122,138,154,170,186,202,218,234,266,298,330,362,394,426,458,22.007812,22.00879,22.009766,22.010742,22.011719,22.012695,22.013672,22.014648,22.015625,22.017578,22.019531,22.021484,22.023438,22.02539,22.027344,22.029297,22.03125,22.035156,22.039062,22.042969,22.046875,22.050781,22.054688,22.058594,22.0625,22.070312,22.078125,22.085938,22.09375,22.101562,22.109375,22.117188,22.125,22.140625,22.15625,22.171875,22.1875,22.203125,22.21875,22.234375,22.25,22.28125,22.3125,22.34375,22.375,22.40625,22.4375,22.46875,22.5,22.5625,22.625,22.6875,22.75,22.8125,22.875,22.9375,23,23.125,23.25,23.375,23.5,23.625,23.75,23.875,24,24.25,24.5,24.75,25,25.25,25.5,25.75,26,26.5,27,27.5,28,28.5,29,29.5,30,31,32,33,34,35,36,37,38,40,42,44,46,48,50,52,54,58,62,66,70,74,78,82,86,94,102,110,118,126,134,142,150,166,182,198,214,230,246,262,278,310,342,374,406,438,470,502,24,23.99121,23.990234,23.989258,23.988281,23.987305,23.986328,23.985352,23.984375,23.982422,23.980469,23.978516,23.976562,23.97461,23.972656,23.970703,23.96875,23.964844,23.960938,23.957031,23.953125,23.949219,23.945312,23.941406,23.9375,23.929688,23.921875,23.914062,23.90625,23.898438,23.890625,23.882812,23.875,23.859375,23.84375,23.828125,23.8125,23.796875,23.78125,23.765625,23.75,23.71875,23.6875,23.65625,23.625,23.59375,23.5625,23.53125,23.5,23.4375,23.375,23.3125,23.25,23.1875,23.125,23.0625,23,22.875,22.75,22.625,22.5,22.375,22.25,22.125,22,21.75,21.5,21.25,21,20.75,20.5,20.25,20,19.5,19,18.5,18,17.5,17,16.5,16,15,14,13,12,11,10,9,8,6,4,2,0,2,4,6,8,12,16,20,24,28,32,36,40,48,56,64,72,80,88,96,104,120,136,152,168,184,200,216,232,264,296,328,360,392,424,456,24.007812,24.00879,24.009766,24.010742,24.011719,24.012695,24.013672,24.014648,24.015625,24.017578,24.019531,24.021484,24.023438,24.02539,24.027344,24.029297,24.03125,24.035156,24.039062,24.042969,24.046875,24.050781,24.054688,24.058594,24.0625,24.070312,24.078125,24.085938,24.09375,24.101562,24.109375,24.117188,24.125,24.140625,24.15625,24.171875,24.1875,24.203125,24.21875,24.234375,24.25,24.28125,24.3125,24.34375,24.375,24.40625,24.4375,24.46875,24.5,24.5625,24.625,24.6875,24.75,24.8125,24.875,24.9375,25,25.125,25.25,25.375,25.5,25.625,25.75,25.875,26,26.25,26.5,26.75,27,27.25,27.5,27.75,28,28.5,29,29.5,30,30.5,31,31.5,32,33,34,35,36,37,38,39,40,42,44,46,48,50,52,54,56,60,64,68,72,76,80,84,88,96,104,112,120,128,136,144,152,168,184,200,216,232,248,264,280,312,344,376,408,440,472,504,26,25.99121,25.990234,25.989258,25.988281,25.987305,25.986328,25.985352,25.984375,25.982422,25.980469,25.978516,25.976562,25.97461,25.972656,25.970703,25.96875,25.964844,25.960938,25.957031,25.953125,25.949219,25.945312,25.941406,25.9375,25.929688,25.921875,25.914062,25.90625,25.898438,25.890625,25.882812,25.875,25.859375,25.84375,25.828125,25.8125,25.796875,25.78125,25.765625,25.75,25.71875,25.6875,25.65625,25.625,25.59375,25.5625,25.53125,25.5,25.4375,25.375,25.3125,25.25,25.1875,25.125,25.0625,25,24.875,24.75,24.625,24.5,24.375,24.25,24.125,24,23.75,23.5,23.25,23,22.75,22.5,22.25,22,21.5,21,20.5,20,19.5,19,18.5,18,17,16,15,14,13,12,11,10,8,6,4,2,0,2,4,6,10,14,18,22,26,30,34,38,46,54,62,70,78,86,94,102,118,134,150,166,182,198,214,230,262,294,326,358,390,422,454,26.007812,26.00879,26.009766,26.010742,26.011719,26.012695,26.013672,26.014648,26.015625,26.017578,26.019531,26.021484,26.023438,26.02539,26.027344,26.029297,26.03125,26.035156,26.039062,26.042969,26.046875,26.050781,26.054688,26.058594,26.0625,26.070312,26.078125,26.085938,26.09375,26.101562,26.109375,26.117188,26.125,26.140625,26.15625,26.171875,26.1875,26.203125,26.21875,26.234375,26.25,26.28125,26.3125,26.34375,26.375,26.40625,26.4375,26.46875,26.5,26.5625,26.625,26.6875,26.75,26.8125,26.875,26.9375,27,27.125,27.25,27.375,27.5,27.625,27.75,27.875,28,28.25,28.5,28.75,29,29.25,29.5,29.75,30,30.5,31,31.5,32,32.5,33,33.5,34,35,36,37,38,39,40,41,42,44,46,48,50,52,54,56,58,62,66,70,74,78,82,86,90,98,106,114,122,130,138,146,154,170,186,202,218,234,250,266,282,314,346,378,410,442,474,506,28,27.99121,27.990234,27.989258,27.988281,27.987305,27.986328,27.985352,27.984375,27.982422,27.980469,27.978516,27.976562,27.97461,27.972656,27.970703,27.96875,27.964844,27.960938,27.957031,27.953125,27.949219,27.945312,27.941406,27.9375,27.929688,27.921875,27.914062,27.90625,27.898438,27.890625,27.882812,27.875,27.859375,27.84375,27.828125,27.8125,27.796875,27.78125,27.765625,27.75,27.71875,27.6875,27.65625,27.625,27.59375,27.5625,27.53125,27.5,27.4375,27.375,27.3125,27.25,27.1875,27.125,27.0625,27,26.875,26.75,26.625,26.5,26.375,26.25,26.125,26,25.75,25.5,25.25,25,24.75,24.5,24.25,24,23.5,23,22.5,22,21.5,21,20.5,20,19,18,17,16,15,14,13,12,10,8,6,4,2,0,2,4,8,12,16,20,24,28,32,36,44,52,60,68,76,84,92,100,116,132,148,164,180,196,212,228,260,292,324,356,388,420,452,28.007812,28.00879,28.009766,28.010742,28.011719,28.012695,28.013672,28.014648,28.015625,28.017578,28.019531,28.021484,28.023438,28.02539,28.027344,28.029297,28.03125,28.035156,28.039062,28.042969,28.046875,28.050781,28.054688,28.058594,28.0625,28.070312,28.078125,28.085938,28.09375,28.101562,28.109375,28.117188,28.125,28.140625,28.15625,28.171875,28.1875,28.203125,28.21875,28.234375,28.25,28.28125,28.3125,28.34375,28.375,28.40625,28.4375,28.46875,28.5,28.5625,28.625,28.6875,28.75,28.8125,28.875,28.9375,29,29.125,29.25,29.375,29.5,29.625,29.75,29.875,30,30.25,30.5,30.75,31,31.25,31.5,31.75,32,32.5,33,33.5,34,34.5,35,35.5,36,37,38,39,40,41,42,43,44,46,48,50,52,54,56,58,60,64,68,72,76,80,84,88,92,100,108,116,124,132,140,148,156,172,188,204,220,236,252,268,284,316,348,380,412,444,476,508,30,29.99121,29.990234,29.989258,29.988281,29.987305,29.986328,29.985352,29.984375,29.982422,29.980469,29.978516,29.976562,29.97461,29.972656,29.970703,29.96875,29.964844,29.960938,29.957031,29.953125,29.949219,29.945312,29.941406,29.9375,29.929688,29.921875,29.914062,29.90625,29.898438,29.890625,29.882812,29.875,29.859375,29.84375,29.828125,29.8125,29.796875,29.78125,29.765625,29.75,29.71875,29.6875,29.65625,29.625,29.59375,29.5625,29.53125,29.5,29.4375,29.375,29.3125,29.25,29.1875,29.125,29.0625,29,28.875,28.75,28.625,28.5,28.375,28.25,28.125,28,27.75,27.5,27.25,27,26.75,26.5,26.25,26,25.5,25,24.5,24,23.5,23,22.5,22,21,20,19,18,17,16,15,14,12,10,8,6,4,2,0,2,6,10,14,18,22,26,30,34,42,50,58,66,74,82,90,98,114,130,146,162,178,194,210,226,258,290,322,354,386,418,450,30.007812,30.00879,30.009766,30.010742,30.011719,30.012695,30.013672,30.014648,30.015625,30.017578,30.019531,30.021484,30.023438,30.02539,30.027344,30.029297,30.03125,30.035156,30.039062,30.042969,30.046875,30.050781,30.054688,30.058594,30.0625,30.070312,30.078125,30.085938,30.09375,30.101562,30.109375,30.117188,30.125,30.140625,30.15625,30.171875,30.1875,30.203125,30.21875,30.234375,30.25,30.28125,30.3125,30.34375,30.375,30.40625,30.4375,30.46875,30.5,30.5625,30.625,30.6875,30.75,30.8125,30.875,30.9375,31,31.125,31.25,31.375,31.5,31.625,31.75,31.875,32,32.25,32.5,32.75,33,33.25,33.5,33.75,34,34.5,35,35.5,36,36.5,37,37.5,38,39,40,41,42,43,44,45,46,48,50,52,54,56,58,60,62,66,70,74,78,82,86,90,94,102,110,118,126,134,142,150,158,174,190,206,222,238,254,270,286,318,350,382,414,446,478,510,32,31.99121,31.990234,31.989258,31.988281,31.987305,31.986328,31.985352,31.984375,31.982422,31.980469,31.978516,31.976562,31.97461,31.972656,31.970703,31.96875,31.964844,31.960938,31.957031,31.953125,31.949219,31.945312,31.941406,31.9375,31.929688,31.921875,31.914062,31.90625,31.898438,31.890625,31.882812,31.875,31.859375,31.84375,31.828125,31.8125,31.796875,31.78125,31.765625,31.75,31.71875,31.6875,31.65625,31.625,31.59375,31.5625,31.53125,31.5,31.4375,31.375,31.3125,31.25,31.1875,31.125,31.0625,31,30.875,30.75,30.625,30.5,30.375,30.25,30.125,30,29.75,29.5,29.25,29,28.75,28.5,28.25,28,27.5,27,26.5,26,25.5,25,24.5,24,23,22,21,20,19,18,17,16,14,12,10,8,6,4,2,0,4,8,12,16,20,24,28,32,40,48,56,64,72,80,88,96,112,128,144,160,176,192,208,224,256,288,320,352,384,416,448,32.007812,32.00879,32.009766,32.010742,32.01172,32.012695,32.01367,32.01465,32.015625,32.01758,32.01953,32.021484,32.023438,32.02539,32.027344,32.029297,32.03125,32.035156,32.039062,32.04297,32.046875,32.05078,32.054688,32.058594,32.0625,32.070312,32.078125,32.085938,32.09375,32.101562,32.109375,32.117188,32.125,32.140625,32.15625,32.171875,32.1875,32.203125,32.21875,32.234375,32.25,32.28125,32.3125,32.34375,32.375,32.40625,32.4375,32.46875,32.5,32.5625,32.625,32.6875,32.75,32.8125,32.875,32.9375,33,33.125,33.25,33.375,33.5,33.625,33.75,33.875,34,34.25,34.5,34.75,35,35.25,35.5,35.75,36,36.5,37,37.5,38,38.5,39,39.5,40,41,42,43,44,45,46,47,48,50,52,54,56,58,60,62,64,68,72,76,80,84,88,92,96,104,112,120,128,136,144,152,160,176,192,208,224,240,256,272,288,320,352,384,416,448,480,512,36,35.99121,35.990234,35.989258,35.98828,35.987305,35.98633,35.98535,35.984375,35.98242,35.98047,35.978516,35.976562,35.97461,35.972656,35.970703,35.96875,35.964844,35.960938,35.95703,35.953125,35.94922,35.945312,35.941406,35.9375,35.929688,35.921875,35.914062,35.90625,35.898438,35.890625,35.882812,35.875,35.859375,35.84375,35.828125,35.8125,35.796875,35.78125,35.765625,35.75,35.71875,35.6875,35.65625,35.625,35.59375,35.5625,35.53125,35.5,35.4375,35.375,35.3125,35.25,35.1875,35.125,35.0625,35,34.875,34.75,34.625,34.5,34.375,34.25,34.125,34,33.75,33.5,33.25,33,32.75,32.5,32.25,32,31.5,31,30.5,30,29.5,29,28.5,28,27,26,25,24,23,22,21,20,18,16,14,12,10,8,6,4,0,4,8,12,16,20,24,28,36,44,52,60,68,76,84,92,108,124,140,156,172,188,204,220,252,284,316,348,380,412,444,36.007812,36.00879,36.009766,36.010742,36.01172,36.012695,36.01367,36.01465,36.015625,36.01758,36.01953,36.021484,36.023438,36.02539,36.027344,36.029297,36.03125,36.035156,36.039062,36.04297,36.046875,36.05078,36.054688,36.058594,36.0625,36.070312,36.078125,36.085938,36.09375,36.101562,36.109375,36.117188,36.125,36.140625,36.15625,36.171875,36.1875,36.203125,36.21875,36.234375,36.25,36.28125,36.3125,36.34375,36.375,36.40625,36.4375,36.46875,36.5,36.5625,36.625,36.6875,36.75,36.8125,36.875,36.9375,37,37.125,37.25,37.375,37.5,37.625,37.75,37.875,38,38.25,38.5,38.75,39,39.25,39.5,39.75,40,40.5,41,41.5,42,42.5,43,43.5,44,45,46,47,48,49,50,51,52,54,56,58,60,62,64,66,68,72,76,80,84,88,92,96,100,108,116,124,132,140,148,156,164,180,196,212,228,244,260,276,292,324,356,388,420,452,484,516,40,39.99121,39.990234,39.989258,39.98828,39.987305,39.98633,39.98535,39.984375,39.98242,39.98047,39.978516,39.976562,39.97461,39.972656,39.970703,39.96875,39.964844,39.960938,39.95703,39.953125,39.94922,39.945312,39.941406,39.9375,39.929688,39.921875,39.914062,39.90625,39.898438,39.890625,39.882812,39.875,39.859375,39.84375,39.828125,39.8125,39.796875,39.78125,39.765625,39.75,39.71875,39.6875,39.65625,39.625,39.59375,39.5625,39.53125,39.5,39.4375,39.375,39.3125,39.25,39.1875,39.125,39.0625,39,38.875,38.75,38.625,38.5,38.375,38.25,38.125,38,37.75,37.5,37.25,37,36.75,36.5,36.25,36,35.5,35,34.5,34,33.5,33,32.5,32,31,30,29,28,27,26,25,24,22,20,18,16,14,12,10,8,4,0,4,8,12,16,20,24,32,40,48,56,64,72,80,88,104,120,136,152,168,184,200,216,248,280,312,344,376,408,440,40.007812,40.00879,40.009766,40.010742,40.01172,40.012695,40.01367,40.01465,40.015625,40.01758,40.01953,40.021484,40.023438,40.02539,40.027344,40.029297,40.03125,40.035156,40.039062,40.04297,40.046875,40.05078,40.054688,40.058594,40.0625,40.070312,40.078125,40.085938,40.09375,40.101562,40.109375,40.117188,40.125,40.140625,40.15625,40.171875,40.1875,40.203125,40.21875,40.234375,40.25,40.28125,40.3125,40.34375,40.375,40.40625,40.4375,40.46875,40.5,40.5625,40.625,40.6875,40.75,40.8125,40.875,40.9375,41,41.125,41.25,41.375,41.5,41.625,41.75,41.875,42,42.25,42.5,42.75,43,43.25,43.5,43.75,44,44.5,45,45.5,46,46.5,47,47.5,48,49,50,51,52,53,54,55,56,58,60,62,64,66,68,70,72,76,80,84,88,92,96,100,104,112,120,128,136,144,152,160,168,184,200,216,232,248,264,280,296,328,360,392,424,456,488,520,44,43.99121,43.990234,43.989258,43.98828,43.987305,43.98633,43.98535,43.984375,43.98242,43.98047,43.978516,43.976562,43.97461,43.972656,43.970703,43.96875,43.964844,43.960938,43.95703,43.953125,43.94922,43.945312,43.941406,43.9375,43.929688,43.921875,43.914062,43.90625,43.898438,43.890625,43.882812,43.875,43.859375,43.84375,43.828125,43.8125,43.796875,43.78125,43.765625,43.75,43.71875,43.6875,43.65625,43.625,43.59375,43.5625,43.53125,43.5,43.4375,43.375,43.3125,43.25,43.1875,43.125,43.0625,43,42.875,42.75,42.625,42.5,42.375,42.25,42.125,42,41.75,41.5,41.25,41,40.75,40.5,40.25,40,39.5,39,38.5,38,37.5,37,36.5,36,35,34,33,32,31,30,29,28,26,24,22,20,18,16,14,12,8,4,0,4,8,12,16,20,28,36,44,52,60,68,76,84,100,116,132,148,164,180,196,212,244,276,308,340,372,404,436,44.007812,44.00879,44.009766,44.010742,44.01172,44.012695,44.01367,44.01465,44.015625,44.01758,44.01953,44.021484,44.023438,44.02539,44.027344,44.029297,44.03125,44.035156,44.039062,44.04297,44.046875,44.05078,44.054688,44.058594,44.0625,44.070312,44.078125,44.085938,44.09375,44.101562,44.109375,44.117188,44.125,44.140625,44.15625,44.171875,44.1875,44.203125,44.21875,44.234375,44.25,44.28125,44.3125,44.34375,44.375,44.40625,44.4375,44.46875,44.5,44.5625,44.625,44.6875,44.75,44.8125,44.875,44.9375,45,45.125,45.25,45.375,45.5,45.625,45.75,45.875,46,46.25,46.5,46.75,47,47.25,47.5,47.75,48,48.5,49,49.5,50,50.5,51,51.5,52,53,54,55,56,57,58,59,60,62,64,66,68,70,72,74,76,80,84,88,92,96,100,104,108,116,124,132,140,148,156,164,172,188,204,220,236,252,268,284,300,332,364,396,428,460,492,524,48,47.99121,47.990234,47.989258,47.98828,47.987305,47.98633,47.98535,47.984375,47.98242,47.98047,47.978516,47.976562,47.97461,47.972656,47.970703,47.96875,47.964844,47.960938,47.95703,47.953125,47.94922,47.945312,47.941406,47.9375,47.929688,47.921875,47.914062,47.90625,47.898438,47.890625,47.882812,47.875,47.859375,47.84375,47.828125,47.8125,47.796875,47.78125,47.765625,47.75,47.71875,47.6875,47.65625,47.625,47.59375,47.5625,47.53125,47.5,47.4375,47.375,47.3125,47.25,47.1875,47.125,47.0625,47,46.875,46.75,46.625,46.5,46.375,46.25,46.125,46,45.75,45.5,45.25,45,44.75,44.5,44.25,44,43.5,43,42.5,42,41.5,41,40.5,40,39,38,37,36,35,34,33,32,30,28,26,24,22,20,18,16,12,8,4,0,4,8,12,16,24,32,40,48,56,64,72,80,96,112,128,144,160,176,192,208,240,272,304,336,368,400,432,48.007812,48.00879,48.009766,48.010742,48.01172,48.012695,48.01367,48.01465,48.015625,48.01758,48.01953,48.021484,48.023438,48.02539,48.027344,48.029297,48.03125,48.035156,48.039062,48.04297,48.046875,48.05078,48.054688,48.058594,48.0625,48.070312,48.078125,48.085938,48.09375,48.101562,48.109375,48.117188,48.125,48.140625,48.15625,48.171875,48.1875,48.203125,48.21875,48.234375,48.25,48.28125,48.3125,48.34375,48.375,48.40625,48.4375,48.46875,48.5,48.5625,48.625,48.6875,48.75,48.8125,48.875,48.9375,49,49.125,49.25,49.375,49.5,49.625,49.75,49.875,50,50.25,50.5,50.75,51,51.25,51.5,51.75,52,52.5,53,53.5,54,54.5,55,55.5,56,57,58,59,60,61,62,63,64,66,68,70,72,74,76,78,80,84,88,92,96,100,104,108,112,120,128,136,144,152,160,168,176,192,208,224,240,256,272,288,304,336,368,400,432,464,496,528,52,51.99121,51.990234,51.989258,51.98828,51.987305,51.98633,51.98535,51.984375,51.98242,51.98047,51.978516,51.976562,51.97461,51.972656,51.970703,51.96875,51.964844,51.960938,51.95703,51.953125,51.94922,51.945312,51.941406,51.9375,51.929688,51.921875,51.914062,51.90625,51.898438,51.890625,51.882812,51.875,51.859375,51.84375,51.828125,51.8125,51.796875,51.78125,51.765625,51.75,51.71875,51.6875,51.65625,51.625,51.59375,51.5625,51.53125,51.5,51.4375,51.375,51.3125,51.25,51.1875,51.125,51.0625,51,50.875,50.75,50.625,50.5,50.375,50.25,50.125,50,49.75,49.5,49.25,49,48.75,48.5,48.25,48,47.5,47,46.5,46,45.5,45,44.5,44,43,42,41,40,39,38,37,36,34,32,30,28,26,24,22,20,16,12,8,4,0,4,8,12,20,28,36,44,52,60,68,76,92,108,124,140,156,172,188,204,236,268,300,332,364,396,428,52.007812,52.00879,52.009766,52.010742,52.01172,52.012695,52.01367,52.01465,52.015625,52.01758,52.01953,52.021484,52.023438,52.02539,52.027344,52.029297,52.03125,52.035156,52.039062,52.04297,52.046875,52.05078,52.054688,52.058594,52.0625,52.070312,52.078125,52.085938,52.09375,52.101562,52.109375,52.117188,52.125,52.140625,52.15625,52.171875,52.1875,52.203125,52.21875,52.234375,52.25,52.28125,52.3125,52.34375,52.375,52.40625,52.4375,52.46875,52.5,52.5625,52.625,52.6875,52.75,52.8125,52.875,52.9375,53,53.125,53.25,53.375,53.5,53.625,53.75,53.875,54,54.25,54.5,54.75,55,55.25,55.5,55.75,56,56.5,57,57.5,58,58.5,59,59.5,60,61,62,63,64,65,66,67,68,70,72,74,76,78,80,82,84,88,92,96,100,104,108,112,116,124,132,140,148,156,164,172,180,196,212,228,244,260,276,292,308,340,372,404,436,468,500,532,56,55.99121,55.990234,55.989258,55.98828,55.987305,55.98633,55.98535,55.984375,55.98242,55.98047,55.978516,55.976562,55.97461,55.972656,55.970703,55.96875,55.964844,55.960938,55.95703,55.953125,55.94922,55.945312,55.941406,55.9375,55.929688,55.921875,55.914062,55.90625,55.898438,55.890625,55.882812,55.875,55.859375,55.84375,55.828125,55.8125,55.796875,55.78125,55.765625,55.75,55.71875,55.6875,55.65625,55.625,55.59375,55.5625,55.53125,55.5,55.4375,55.375,55.3125,55.25,55.1875,55.125,55.0625,55,54.875,54.75,54.625,54.5,54.375,54.25,54.125,54,53.75,53.5,53.25,53,52.75,52.5,52.25,52,51.5,51,50.5,50,49.5,49,48.5,48,47,46,45,44,43,42,41,40,38,36,34,32,30,28,26,24,20,16,12,8,4,0,4,8,16,24,32,40,48,56,64,72,88,104,120,136,152,168,184,200,232,264,296,328,360,392,424,56.007812,56.00879,56.009766,56.010742,56.01172,56.012695,56.01367,56.01465,56.015625,56.01758,56.01953,56.021484,56.023438,56.02539,56.027344,56.029297,56.03125,56.035156,56.039062,56.04297,56.046875,56.05078,56.054688,56.058594,56.0625,56.070312,56.078125,56.085938,56.09375,56.101562,56.109375,56.117188,56.125,56.140625,56.15625,56.171875,56.1875,56.203125,56.21875,56.234375,56.25,56.28125,56.3125,56.34375,56.375,56.40625,56.4375,56.46875,56.5,56.5625,56.625,56.6875,56.75,56.8125,56.875,56.9375,57,57.125,57.25,57.375,57.5,57.625,57.75,57.875,58,58.25,58.5,58.75,59,59.25,59.5,59.75,60,60.5,61,61.5,62,62.5,63,63.5,64,65,66,67,68,69,70,71,72,74,76,78,80,82,84,86,88,92,96,100,104,108,112,116,120,128,136,144,152,160,168,176,184,200,216,232,248,264,280,296,312,344,376,408,440,472,504,536,60,59.99121,59.990234,59.989258,59.98828,59.987305,59.98633,59.98535,59.984375,59.98242,59.98047,59.978516,59.976562,59.97461,59.972656,59.970703,59.96875,59.964844,59.960938,59.95703,59.953125,59.94922,59.945312,59.941406,59.9375,59.929688,59.921875,59.914062,59.90625,59.898438,59.890625,59.882812,59.875,59.859375,59.84375,59.828125,59.8125,59.796875,59.78125,59.765625,59.75,59.71875,59.6875,59.65625,59.625,59.59375,59.5625,59.53125,59.5,59.4375,59.375,59.3125,59.25,59.1875,59.125,59.0625,59,58.875,58.75,58.625,58.5,58.375,58.25,58.125,58,57.75,57.5,57.25,57,56.75,56.5,56.25,56,55.5,55,54.5,54,53.5,53,52.5,52,51,50,49,48,47,46,45,44,42,40,38,36,34,32,30,28,24,20,16,12,8,4,0,4,12,20,28,36,44,52,60,68,84,100,116,132,148,164,180,196,228,260,292,324,356,388,420,60.007812,60.00879,60.009766,60.010742,60.01172,60.012695,60.01367,60.01465,60.015625,60.01758,60.01953,60.021484,60.023438,60.02539,60.027344,60.029297,60.03125,60.035156,60.039062,60.04297,60.046875,60.05078,60.054688,60.058594,60.0625,60.070312,60.078125,60.085938,60.09375,60.101562,60.109375,60.117188,60.125,60.140625,60.15625,60.171875,60.1875,60.203125,60.21875,60.234375,60.25,60.28125,60.3125,60.34375,60.375,60.40625,60.4375,60.46875,60.5,60.5625,60.625,60.6875,60.75,60.8125,60.875,60.9375,61,61.125,61.25,61.375,61.5,61.625,61.75,61.875,62,62.25,62.5,62.75,63,63.25,63.5,63.75,64,64.5,65,65.5,66,66.5,67,67.5,68,69,70,71,72,73,74,75,76,78,80,82,84,86,88,90,92,96,100,104,108,112,116,120,124,132,140,148,156,164,172,180,188,204,220,236,252,268,284,300,316,348,380,412,444,476,508,540,64,63.99121,63.990234,63.989258,63.98828,63.987305,63.98633,63.98535,63.984375,63.98242,63.98047,63.978516,63.976562,63.97461,63.972656,63.970703,63.96875,63.964844,63.960938,63.95703,63.953125,63.94922,63.945312,63.941406,63.9375,63.929688,63.921875,63.914062,63.90625,63.898438,63.890625,63.882812,63.875,63.859375,63.84375,63.828125,63.8125,63.796875,63.78125,63.765625,63.75,63.71875,63.6875,63.65625,63.625,63.59375,63.5625,63.53125,63.5,63.4375,63.375,63.3125,63.25,63.1875,63.125,63.0625,63,62.875,62.75,62.625,62.5,62.375,62.25,62.125,62,61.75,61.5,61.25,61,60.75,60.5,60.25,60,59.5,59,58.5,58,57.5,57,56.5,56,55,54,53,52,51,50,49,48,46,44,42,40,38,36,34,32,28,24,20,16,12,8,4,0,8,16,24,32,40,48,56,64,80,96,112,128,144,160,176,192,224,256,288,320,352,384,416,64.00781,64.00879,64.009766,64.01074,64.01172,64.012695,64.01367,64.01465,64.015625,64.01758,64.01953,64.021484,64.02344,64.02539,64.02734,64.0293,64.03125,64.03516,64.03906,64.04297,64.046875,64.05078,64.05469,64.05859,64.0625,64.07031,64.078125,64.08594,64.09375,64.10156,64.109375,64.11719,64.125,64.140625,64.15625,64.171875,64.1875,64.203125,64.21875,64.234375,64.25,64.28125,64.3125,64.34375,64.375,64.40625,64.4375,64.46875,64.5,64.5625,64.625,64.6875,64.75,64.8125,64.875,64.9375,65,65.125,65.25,65.375,65.5,65.625,65.75,65.875,66,66.25,66.5,66.75,67,67.25,67.5,67.75,68,68.5,69,69.5,70,70.5,71,71.5,72,73,74,75,76,77,78,79,80,82,84,86,88,90,92,94,96,100,104,108,112,116,120,124,128,136,144,152,160,168,176,184,192,208,224,240,256,272,288,304,320,352,384,416,448,480,512,544,72,71.99121,71.990234,71.98926,71.98828,71.987305,71.98633,71.98535,71.984375,71.98242,71.98047,71.978516,71.97656,71.97461,71.97266,71.9707,71.96875,71.96484,71.96094,71.95703,71.953125,71.94922,71.94531,71.94141,71.9375,71.92969,71.921875,71.91406,71.90625,71.89844,71.890625,71.88281,71.875,71.859375,71.84375,71.828125,71.8125,71.796875,71.78125,71.765625,71.75,71.71875,71.6875,71.65625,71.625,71.59375,71.5625,71.53125,71.5,71.4375,71.375,71.3125,71.25,71.1875,71.125,71.0625,71,70.875,70.75,70.625,70.5,70.375,70.25,70.125,70,69.75,69.5,69.25,69,68.75,68.5,68.25,68,67.5,67,66.5,66,65.5,65,64.5,64,63,62,61,60,59,58,57,56,54,52,50,48,46,44,42,40,36,32,28,24,20,16,12,8,0,8,16,24,32,40,48,56,72,88,104,120,136,152,168,184,216,248,280,312,344,376,408,72.00781,72.00879,72.009766,72.01074,72.01172,72.012695,72.01367,72.01465,72.015625,72.01758,72.01953,72.021484,72.02344,72.02539,72.02734,72.0293,72.03125,72.03516,72.03906,72.04297,72.046875,72.05078,72.05469,72.05859,72.0625,72.07031,72.078125,72.08594,72.09375,72.10156,72.109375,72.11719,72.125,72.140625,72.15625,72.171875,72.1875,72.203125,72.21875,72.234375,72.25,72.28125,72.3125,72.34375,72.375,72.40625,72.4375,72.46875,72.5,72.5625,72.625,72.6875,72.75,72.8125,72.875,72.9375,73,73.125,73.25,73.375,73.5,73.625,73.75,73.875,74,74.25,74.5,74.75,75,75.25,75.5,75.75,76,76.5,77,77.5,78,78.5,79,79.5,80,81,82,83,84,85,86,87,88,90,92,94,96,98,100,102,104,108,112,116,120,124,128,132,136,144,152,160,168,176,184,192,200,216,232,248,264,280,296,312,328,360,392,424,456,488,520,552,80,79.99121,79.990234,79.98926,79.98828,79.987305,79.98633,79.98535,79.984375,79.98242,79.98047,79.978516,79.97656,79.97461,79.97266,79.9707,79.96875,79.96484,79.96094,79.95703,79.953125,79.94922,79.94531,79.94141,79.9375,79.92969,79.921875,79.91406,79.90625,79.89844,79.890625,79.88281,79.875,79.859375,79.84375,79.828125,79.8125,79.796875,79.78125,79.765625,79.75,79.71875,79.6875,79.65625,79.625,79.59375,79.5625,79.53125,79.5,79.4375,79.375,79.3125,79.25,79.1875,79.125,79.0625,79,78.875,78.75,78.625,78.5,78.375,78.25,78.125,78,77.75,77.5,77.25,77,76.75,76.5,76.25,76,75.5,75,74.5,74,73.5,73,72.5,72,71,70,69,68,67,66,65,64,62,60,58,56,54,52,50,48,44,40,36,32,28,24,20,16,8,0,8,16,24,32,40,48,64,80,96,112,128,144,160,176,208,240,272,304,336,368,400,80.00781,80.00879,80.009766,80.01074,80.01172,80.012695,80.01367,80.01465,80.015625,80.01758,80.01953,80.021484,80.02344,80.02539,80.02734,80.0293,80.03125,80.03516,80.03906,80.04297,80.046875,80.05078,80.05469,80.05859,80.0625,80.07031,80.078125,80.08594,80.09375,80.10156,80.109375,80.11719,80.125,80.140625,80.15625,80.171875,80.1875,80.203125,80.21875,80.234375,80.25,80.28125,80.3125,80.34375,80.375,80.40625,80.4375,80.46875,80.5,80.5625,80.625,80.6875,80.75,80.8125,80.875,80.9375,81,81.125,81.25,81.375,81.5,81.625,81.75,81.875,82,82.25,82.5,82.75,83,83.25,83.5,83.75,84,84.5,85,85.5,86,86.5,87,87.5,88,89,90,91,92,93,94,95,96,98,100,102,104,106,108,110,112,116,120,124,128,132,136,140,144,152,160,168,176,184,192,200,208,224,240,256,272,288,304,320,336,368,400,432,464,496,528,560,88,87.99121,87.990234,87.98926,87.98828,87.987305,87.98633,87.98535,87.984375,87.98242,87.98047,87.978516,87.97656,87.97461,87.97266,87.9707,87.96875,87.96484,87.96094,87.95703,87.953125,87.94922,87.94531,87.94141,87.9375,87.92969,87.921875,87.91406,87.90625,87.89844,87.890625,87.88281,87.875,87.859375,87.84375,87.828125,87.8125,87.796875,87.78125,87.765625,87.75,87.71875,87.6875,87.65625,87.625,87.59375,87.5625,87.53125,87.5,87.4375,87.375,87.3125,87.25,87.1875,87.125,87.0625,87,86.875,86.75,86.625,86.5,86.375,86.25,86.125,86,85.75,85.5,85.25,85,84.75,84.5,84.25,84,83.5,83,82.5,82,81.5,81,80.5,80,79,78,77,76,75,74,73,72,70,68,66,64,62,60,58,56,52,48,44,40,36,32,28,24,16,8,0,8,16,24,32,40,56,72,88,104,120,136,152,168,200,232,264,296,328,360,392,88.00781,88.00879,88.009766,88.01074,88.01172,88.012695,88.01367,88.01465,88.015625,88.01758,88.01953,88.021484,88.02344,88.02539,88.02734,88.0293,88.03125,88.03516,88.03906,88.04297,88.046875,88.05078,88.05469,88.05859,88.0625,88.07031,88.078125,88.08594,88.09375,88.10156,88.109375,88.11719,88.125,88.140625,88.15625,88.171875,88.1875,88.203125,88.21875,88.234375,88.25,88.28125,88.3125,88.34375,88.375,88.40625,88.4375,88.46875,88.5,88.5625,88.625,88.6875,88.75,88.8125,88.875,88.9375,89,89.125,89.25,89.375,89.5,89.625,89.75,89.875,90,90.25,90.5,90.75,91,91.25,91.5,91.75,92,92.5,93,93.5,94,94.5,95,95.5,96,97,98,99,100,101,102,103,104,106,108,110,112,114,116,118,120,124,128,132,136,140,144,148,152,160,168,176,184,192,200,208,216,232,248,264,280,296,312,328,344,376,408,440,472,504,536,568,96,95.99121,95.990234,95.98926,95.98828,95.987305,95.98633,95.98535,95.984375,95.98242,95.98047,95.978516,95.97656,95.97461,95.97266,95.9707,95.96875,95.96484,95.96094,95.95703,95.953125,95.94922,95.94531,95.94141,95.9375,95.92969,95.921875,95.91406,95.90625,95.89844,95.890625,95.88281,95.875,95.859375,95.84375,95.828125,95.8125,95.796875,95.78125,95.765625,95.75,95.71875,95.6875,95.65625,95.625,95.59375,95.5625,95.53125,95.5,95.4375,95.375,95.3125,95.25,95.1875,95.125,95.0625,95,94.875,94.75,94.625,94.5,94.375,94.25,94.125,94,93.75,93.5,93.25,93,92.75,92.5,92.25,92,91.5,91,90.5,90,89.5,89,88.5,88,87,86,85,84,83,82,81,80,78,76,74,72,70,68,66,64,60,56,52,48,44,40,36,32,24,16,8,0,8,16,24,32,48,64,80,96,112,128,144,160,192,224,256,288,320,352,384,96.00781,96.00879,96.009766,96.01074,96.01172,96.012695,96.01367,96.01465,96.015625,96.01758,96.01953,96.021484,96.02344,96.02539,96.02734,96.0293,96.03125,96.03516,96.03906,96.04297,96.046875,96.05078,96.05469,96.05859,96.0625,96.07031,96.078125,96.08594,96.09375,96.10156,96.109375,96.11719,96.125,96.140625,96.15625,96.171875,96.1875,96.203125,96.21875,96.234375,96.25,96.28125,96.3125,96.34375,96.375,96.40625,96.4375,96.46875,96.5,96.5625,96.625,96.6875,96.75,96.8125,96.875,96.9375,97,97.125,97.25,97.375,97.5,97.625,97.75,97.875,98,98.25,98.5,98.75,99,99.25,99.5,99.75,100,100.5,101,101.5,102,102.5,103,103.5,104,105,106,107,108,109,110,111,112,114,116,118,120,122,124,126,128,132,136,140,144,148,152,156,160,168,176,184,192,200,208,216,224,240,256,272,288,304,320,336,352,384,416,448,480,512,544,576,104,103.99121,103.990234,103.98926,103.98828,103.987305,103.98633,103.98535,103.984375,103.98242,103.98047,103.978516,103.97656,103.97461,103.97266,103.9707,103.96875,103.96484,103.96094,103.95703,103.953125,103.94922,103.94531,103.94141,103.9375,103.92969,103.921875,103.91406,103.90625,103.89844,103.890625,103.88281,103.875,103.859375,103.84375,103.828125,103.8125,103.796875,103.78125,103.765625,103.75,103.71875,103.6875,103.65625,103.625,103.59375,103.5625,103.53125,103.5,103.4375,103.375,103.3125,103.25,103.1875,103.125,103.0625,103,102.875,102.75,102.625,102.5,102.375,102.25,102.125,102,101.75,101.5,101.25,101,100.75,100.5,100.25,100,99.5,99,98.5,98,97.5,97,96.5,96,95,94,93,92,91,90,89,88,86,84,82,80,78,76,74,72,68,64,60,56,52,48,44,40,32,24,16,8,0,8,16,24,40,56,72,88,104,120,136,152,184,216,248,280,312,344,376,104.00781,104.00879,104.009766,104.01074,104.01172,104.012695,104.01367,104.01465,104.015625,104.01758,104.01953,104.021484,104.02344,104.02539,104.02734,104.0293,104.03125,104.03516,104.03906,104.04297,104.046875,104.05078,104.05469,104.05859,104.0625,104.07031,104.078125,104.08594,104.09375,104.10156,104.109375,104.11719,104.125,104.140625,104.15625,104.171875,104.1875,104.203125,104.21875,104.234375,104.25,104.28125,104.3125,104.34375,104.375,104.40625,104.4375,104.46875,104.5,104.5625,104.625,104.6875,104.75,104.8125,104.875,104.9375,105,105.125,105.25,105.375,105.5,105.625,105.75,105.875,106,106.25,106.5,106.75,107,107.25,107.5,107.75,108,108.5,109,109.5,110,110.5,111,111.5,112,113,114,115,116,117,118,119,120,122,124,126,128,130,132,134,136,140,144,148,152,156,160,164,168,176,184,192,200,208,216,224,232,248,264,280,296,312,328,344,360,392,424,456,488,520,552,584,112,111.99121,111.990234,111.98926,111.98828,111.987305,111.98633,111.98535,111.984375,111.98242,111.98047,111.978516,111.97656,111.97461,111.97266,111.9707,111.96875,111.96484,111.96094,111.95703,111.953125,111.94922,111.94531,111.94141,111.9375,111.92969,111.921875,111.91406,111.90625,111.89844,111.890625,111.88281,111.875,111.859375,111.84375,111.828125,111.8125,111.796875,111.78125,111.765625,111.75,111.71875,111.6875,111.65625,111.625,111.59375,111.5625,111.53125,111.5,111.4375,111.375,111.3125,111.25,111.1875,111.125,111.0625,111,110.875,110.75,110.625,110.5,110.375,110.25,110.125,110,109.75,109.5,109.25,109,108.75,108.5,108.25,108,107.5,107,106.5,106,105.5,105,104.5,104,103,102,101,100,99,98,97,96,94,92,90,88,86,84,82,80,76,72,68,64,60,56,52,48,40,32,24,16,8,0,8,16,32,48,64,80,96,112,128,144,176,208,240,272,304,336,368,112.00781,112.00879,112.009766,112.01074,112.01172,112.012695,112.01367,112.01465,112.015625,112.01758,112.01953,112.021484,112.02344,112.02539,112.02734,112.0293,112.03125,112.03516,112.03906,112.04297,112.046875,112.05078,112.05469,112.05859,112.0625,112.07031,112.078125,112.08594,112.09375,112.10156,112.109375,112.11719,112.125,112.140625,112.15625,112.171875,112.1875,112.203125,112.21875,112.234375,112.25,112.28125,112.3125,112.34375,112.375,112.40625,112.4375,112.46875,112.5,112.5625,112.625,112.6875,112.75,112.8125,112.875,112.9375,113,113.125,113.25,113.375,113.5,113.625,113.75,113.875,114,114.25,114.5,114.75,115,115.25,115.5,115.75,116,116.5,117,117.5,118,118.5,119,119.5,120,121,122,123,124,125,126,127,128,130,132,134,136,138,140,142,144,148,152,156,160,164,168,172,176,184,192,200,208,216,224,232,240,256,272,288,304,320,336,352,368,400,432,464,496,528,560,592,120,119.99121,119.990234,119.98926,119.98828,119.987305,119.98633,119.98535,119.984375,119.98242,119.98047,119.978516,119.97656,119.97461,119.97266,119.9707,119.96875,119.96484,119.96094,119.95703,119.953125,119.94922,119.94531,119.94141,119.9375,119.92969,119.921875,119.91406,119.90625,119.89844,119.890625,119.88281,119.875,119.859375,119.84375,119.828125,119.8125,119.796875,119.78125,119.765625,119.75,119.71875,119.6875,119.65625,119.625,119.59375,119.5625,119.53125,119.5,119.4375,119.375,119.3125,119.25,119.1875,119.125,119.0625,119,118.875,118.75,118.625,118.5,118.375,118.25,118.125,118,117.75,117.5,117.25,117,116.75,116.5,116.25,116,115.5,115,114.5,114,113.5,113,112.5,112,111,110,109,108,107,106,105,104,102,100,98,96,94,92,90,88,84,80,76,72,68,64,60,56,48,40,32,24,16,8,0,8,24,40,56,72,88,104,120,136,168,200,232,264,296,328,360,120.00781,120.00879,120.009766,120.01074,120.01172,120.012695,120.01367,120.01465,120.015625,120.01758,120.01953,120.021484,120.02344,120.02539,120.02734,120.0293,120.03125,120.03516,120.03906,120.04297,120.046875,120.05078,120.05469,120.05859,120.0625,120.07031,120.078125,120.08594,120.09375,120.10156,120.109375,120.11719,120.125,120.140625,120.15625,120.171875,120.1875,120.203125,120.21875,120.234375,120.25,120.28125,120.3125,120.34375,120.375,120.40625,120.4375,120.46875,120.5,120.5625,120.625,120.6875,120.75,120.8125,120.875,120.9375,121,121.125,121.25,121.375,121.5,121.625,121.75,121.875,122,122.25,122.5,122.75,123,123.25,123.5,123.75,124,124.5,125,125.5,126,126.5,127,127.5,128,129,130,131,132,133,134,135,136,138,140,142,144,146,148,150,152,156,160,164,168,172,176,180,184,192,200,208,216,224,232,240,248,264,280,296,312,328,344,360,376,408,440,472,504,536,568,600,128,127.99121,127.990234,127.98926,127.98828,127.987305,127.98633,127.98535,127.984375,127.98242,127.98047,127.978516,127.97656,127.97461,127.97266,127.9707,127.96875,127.96484,127.96094,127.95703,127.953125,127.94922,127.94531,127.94141,127.9375,127.92969,127.921875,127.91406,127.90625,127.89844,127.890625,127.88281,127.875,127.859375,127.84375,127.828125,127.8125,127.796875,127.78125,127.765625,127.75,127.71875,127.6875,127.65625,127.625,127.59375,127.5625,127.53125,127.5,127.4375,127.375,127.3125,127.25,127.1875,127.125,127.0625,127,126.875,126.75,126.625,126.5,126.375,126.25,126.125,126,125.75,125.5,125.25,125,124.75,124.5,124.25,124,123.5,123,122.5,122,121.5,121,120.5,120,119,118,117,116,115,114,113,112,110,108,106,104,102,100,98,96,92,88,84,80,76,72,68,64,56,48,40,32,24,16,8,0,16,32,48,64,80,96,112,128,160,192,224,256,288,320,352,128.00781,128.00879,128.00977,128.01074,128.01172,128.0127,128.01367,128.01465,128.01562,128.01758,128.01953,128.02148,128.02344,128.02539,128.02734,128.0293,128.03125,128.03516,128.03906,128.04297,128.04688,128.05078,128.05469,128.0586,128.0625,128.07031,128.07812,128.08594,128.09375,128.10156,128.10938,128.11719,128.125,128.14062,128.15625,128.17188,128.1875,128.20312,128.21875,128.23438,128.25,128.28125,128.3125,128.34375,128.375,128.40625,128.4375,128.46875,128.5,128.5625,128.625,128.6875,128.75,128.8125,128.875,128.9375,129,129.125,129.25,129.375,129.5,129.625,129.75,129.875,130,130.25,130.5,130.75,131,131.25,131.5,131.75,132,132.5,133,133.5,134,134.5,135,135.5,136,137,138,139,140,141,142,143,144,146,148,150,152,154,156,158,160,164,168,172,176,180,184,188,192,200,208,216,224,232,240,248,256,272,288,304,320,336,352,368,384,416,448,480,512,544,576,608,144,143.99121,143.99023,143.98926,143.98828,143.9873,143.98633,143.98535,143.98438,143.98242,143.98047,143.97852,143.97656,143.97461,143.97266,143.9707,143.96875,143.96484,143.96094,143.95703,143.95312,143.94922,143.94531,143.9414,143.9375,143.92969,143.92188,143.91406,143.90625,143.89844,143.89062,143.88281,143.875,143.85938,143.84375,143.82812,143.8125,143.79688,143.78125,143.76562,143.75,143.71875,143.6875,143.65625,143.625,143.59375,143.5625,143.53125,143.5,143.4375,143.375,143.3125,143.25,143.1875,143.125,143.0625,143,142.875,142.75,142.625,142.5,142.375,142.25,142.125,142,141.75,141.5,141.25,141,140.75,140.5,140.25,140,139.5,139,138.5,138,137.5,137,136.5,136,135,134,133,132,131,130,129,128,126,124,122,120,118,116,114,112,108,104,100,96,92,88,84,80,72,64,56,48,40,32,24,16,0,16,32,48,64,80,96,112,144,176,208,240,272,304,336,144.00781,144.00879,144.00977,144.01074,144.01172,144.0127,144.01367,144.01465,144.01562,144.01758,144.01953,144.02148,144.02344,144.02539,144.02734,144.0293,144.03125,144.03516,144.03906,144.04297,144.04688,144.05078,144.05469,144.0586,144.0625,144.07031,144.07812,144.08594,144.09375,144.10156,144.10938,144.11719,144.125,144.14062,144.15625,144.17188,144.1875,144.20312,144.21875,144.23438,144.25,144.28125,144.3125,144.34375,144.375,144.40625,144.4375,144.46875,144.5,144.5625,144.625,144.6875,144.75,144.8125,144.875,144.9375,145,145.125,145.25,145.375,145.5,145.625,145.75,145.875,146,146.25,146.5,146.75,147,147.25,147.5,147.75,148,148.5,149,149.5,150,150.5,151,151.5,152,153,154,155,156,157,158,159,160,162,164,166,168,170,172,174,176,180,184,188,192,196,200,204,208,216,224,232,240,248,256,264,272,288,304,320,336,352,368,384,400,432,464,496,528,560,592,624,160,159.99121,159.99023,159.98926,159.98828,159.9873,159.98633,159.98535,159.98438,159.98242,159.98047,159.97852,159.97656,159.97461,159.97266,159.9707,159.96875,159.96484,159.96094,159.95703,159.95312,159.94922,159.94531,159.9414,159.9375,159.92969,159.92188,159.91406,159.90625,159.89844,159.89062,159.88281,159.875,159.85938,159.84375,159.82812,159.8125,159.79688,159.78125,159.76562,159.75,159.71875,159.6875,159.65625,159.625,159.59375,159.5625,159.53125,159.5,159.4375,159.375,159.3125,159.25,159.1875,159.125,159.0625,159,158.875,158.75,158.625,158.5,158.375,158.25,158.125,158,157.75,157.5,157.25,157,156.75,156.5,156.25,156,155.5,155,154.5,154,153.5,153,152.5,152,151,150,149,148,147,146,145,144,142,140,138,136,134,132,130,128,124,120,116,112,108,104,100,96,88,80,72,64,56,48,40,32,16,0,16,32,48,64,80,96,128,160,192,224,256,288,320,160.00781,160.00879,160.00977,160.01074,160.01172,160.0127,160.01367,160.01465,160.01562,160.01758,160.01953,160.02148,160.02344,160.02539,160.02734,160.0293,160.03125,160.03516,160.03906,160.04297,160.04688,160.05078,160.05469,160.0586,160.0625,160.07031,160.07812,160.08594,160.09375,160.10156,160.10938,160.11719,160.125,160.14062,160.15625,160.17188,160.1875,160.20312,160.21875,160.23438,160.25,160.28125,160.3125,160.34375,160.375,160.40625,160.4375,160.46875,160.5,160.5625,160.625,160.6875,160.75,160.8125,160.875,160.9375,161,161.125,161.25,161.375,161.5,161.625,161.75,161.875,162,162.25,162.5,162.75,163,163.25,163.5,163.75,164,164.5,165,165.5,166,166.5,167,167.5,168,169,170,171,172,173,174,175,176,178,180,182,184,186,188,190,192,196,200,204,208,212,216,220,224,232,240,248,256,264,272,280,288,304,320,336,352,368,384,400,416,448,480,512,544,576,608,640,176,175.99121,175.99023,175.98926,175.98828,175.9873,175.98633,175.98535,175.98438,175.98242,175.98047,175.97852,175.97656,175.97461,175.97266,175.9707,175.96875,175.96484,175.96094,175.95703,175.95312,175.94922,175.94531,175.9414,175.9375,175.92969,175.92188,175.91406,175.90625,175.89844,175.89062,175.88281,175.875,175.85938,175.84375,175.82812,175.8125,175.79688,175.78125,175.76562,175.75,175.71875,175.6875,175.65625,175.625,175.59375,175.5625,175.53125,175.5,175.4375,175.375,175.3125,175.25,175.1875,175.125,175.0625,175,174.875,174.75,174.625,174.5,174.375,174.25,174.125,174,173.75,173.5,173.25,173,172.75,172.5,172.25,172,171.5,171,170.5,170,169.5,169,168.5,168,167,166,165,164,163,162,161,160,158,156,154,152,150,148,146,144,140,136,132,128,124,120,116,112,104,96,88,80,72,64,56,48,32,16,0,16,32,48,64,80,112,144,176,208,240,272,304,176.00781,176.00879,176.00977,176.01074,176.01172,176.0127,176.01367,176.01465,176.01562,176.01758,176.01953,176.02148,176.02344,176.02539,176.02734,176.0293,176.03125,176.03516,176.03906,176.04297,176.04688,176.05078,176.05469,176.0586,176.0625,176.07031,176.07812,176.08594,176.09375,176.10156,176.10938,176.11719,176.125,176.14062,176.15625,176.17188,176.1875,176.20312,176.21875,176.23438,176.25,176.28125,176.3125,176.34375,176.375,176.40625,176.4375,176.46875,176.5,176.5625,176.625,176.6875,176.75,176.8125,176.875,176.9375,177,177.125,177.25,177.375,177.5,177.625,177.75,177.875,178,178.25,178.5,178.75,179,179.25,179.5,179.75,180,180.5,181,181.5,182,182.5,183,183.5,184,185,186,187,188,189,190,191,192,194,196,198,200,202,204,206,208,212,216,220,224,228,232,236,240,248,256,264,272,280,288,296,304,320,336,352,368,384,400,416,432,464,496,528,560,592,624,656,192,191.99121,191.99023,191.98926,191.98828,191.9873,191.98633,191.98535,191.98438,191.98242,191.98047,191.97852,191.97656,191.97461,191.97266,191.9707,191.96875,191.96484,191.96094,191.95703,191.95312,191.94922,191.94531,191.9414,191.9375,191.92969,191.92188,191.91406,191.90625,191.89844,191.89062,191.88281,191.875,191.85938,191.84375,191.82812,191.8125,191.79688,191.78125,191.76562,191.75,191.71875,191.6875,191.65625,191.625,191.59375,191.5625,191.53125,191.5,191.4375,191.375,191.3125,191.25,191.1875,191.125,191.0625,191,190.875,190.75,190.625,190.5,190.375,190.25,190.125,190,189.75,189.5,189.25,189,188.75,188.5,188.25,188,187.5,187,186.5,186,185.5,185,184.5,184,183,182,181,180,179,178,177,176,174,172,170,168,166,164,162,160,156,152,148,144,140,136,132,128,120,112,104,96,88,80,72,64,48,32,16,0,16,32,48,64,96,128,160,192,224,256,288,192.00781,192.00879,192.00977,192.01074,192.01172,192.0127,192.01367,192.01465,192.01562,192.01758,192.01953,192.02148,192.02344,192.02539,192.02734,192.0293,192.03125,192.03516,192.03906,192.04297,192.04688,192.05078,192.05469,192.0586,192.0625,192.07031,192.07812,192.08594,192.09375,192.10156,192.10938,192.11719,192.125,192.14062,192.15625,192.17188,192.1875,192.20312,192.21875,192.23438,192.25,192.28125,192.3125,192.34375,192.375,192.40625,192.4375,192.46875,192.5,192.5625,192.625,192.6875,192.75,192.8125,192.875,192.9375,193,193.125,193.25,193.375,193.5,193.625,193.75,193.875,194,194.25,194.5,194.75,195,195.25,195.5,195.75,196,196.5,197,197.5,198,198.5,199,199.5,200,201,202,203,204,205,206,207,208,210,212,214,216,218,220,222,224,228,232,236,240,244,248,252,256,264,272,280,288,296,304,312,320,336,352,368,384,400,416,432,448,480,512,544,576,608,640,672,208,207.99121,207.99023,207.98926,207.98828,207.9873,207.98633,207.98535,207.98438,207.98242,207.98047,207.97852,207.97656,207.97461,207.97266,207.9707,207.96875,207.96484,207.96094,207.95703,207.95312,207.94922,207.94531,207.9414,207.9375,207.92969,207.92188,207.91406,207.90625,207.89844,207.89062,207.88281,207.875,207.85938,207.84375,207.82812,207.8125,207.79688,207.78125,207.76562,207.75,207.71875,207.6875,207.65625,207.625,207.59375,207.5625,207.53125,207.5,207.4375,207.375,207.3125,207.25,207.1875,207.125,207.0625,207,206.875,206.75,206.625,206.5,206.375,206.25,206.125,206,205.75,205.5,205.25,205,204.75,204.5,204.25,204,203.5,203,202.5,202,201.5,201,200.5,200,199,198,197,196,195,194,193,192,190,188,186,184,182,180,178,176,172,168,164,160,156,152,148,144,136,128,120,112,104,96,88,80,64,48,32,16,0,16,32,48,80,112,144,176,208,240,272,208.00781,208.00879,208.00977,208.01074,208.01172,208.0127,208.01367,208.01465,208.01562,208.01758,208.01953,208.02148,208.02344,208.02539,208.02734,208.0293,208.03125,208.03516,208.03906,208.04297,208.04688,208.05078,208.05469,208.0586,208.0625,208.07031,208.07812,208.08594,208.09375,208.10156,208.10938,208.11719,208.125,208.14062,208.15625,208.17188,208.1875,208.20312,208.21875,208.23438,208.25,208.28125,208.3125,208.34375,208.375,208.40625,208.4375,208.46875,208.5,208.5625,208.625,208.6875,208.75,208.8125,208.875,208.9375,209,209.125,209.25,209.375,209.5,209.625,209.75,209.875,210,210.25,210.5,210.75,211,211.25,211.5,211.75,212,212.5,213,213.5,214,214.5,215,215.5,216,217,218,219,220,221,222,223,224,226,228,230,232,234,236,238,240,244,248,252,256,260,264,268,272,280,288,296,304,312,320,328,336,352,368,384,400,416,432,448,464,496,528,560,592,624,656,688,224,223.99121,223.99023,223.98926,223.98828,223.9873,223.98633,223.98535,223.98438,223.98242,223.98047,223.97852,223.97656,223.97461,223.97266,223.9707,223.96875,223.96484,223.96094,223.95703,223.95312,223.94922,223.94531,223.9414,223.9375,223.92969,223.92188,223.91406,223.90625,223.89844,223.89062,223.88281,223.875,223.85938,223.84375,223.82812,223.8125,223.79688,223.78125,223.76562,223.75,223.71875,223.6875,223.65625,223.625,223.59375,223.5625,223.53125,223.5,223.4375,223.375,223.3125,223.25,223.1875,223.125,223.0625,223,222.875,222.75,222.625,222.5,222.375,222.25,222.125,222,221.75,221.5,221.25,221,220.75,220.5,220.25,220,219.5,219,218.5,218,217.5,217,216.5,216,215,214,213,212,211,210,209,208,206,204,202,200,198,196,194,192,188,184,180,176,172,168,164,160,152,144,136,128,120,112,104,96,80,64,48,32,16,0,16,32,64,96,128,160,192,224,256,224.00781,224.00879,224.00977,224.01074,224.01172,224.0127,224.01367,224.01465,224.01562,224.01758,224.01953,224.02148,224.02344,224.02539,224.02734,224.0293,224.03125,224.03516,224.03906,224.04297,224.04688,224.05078,224.05469,224.0586,224.0625,224.07031,224.07812,224.08594,224.09375,224.10156,224.10938,224.11719,224.125,224.14062,224.15625,224.17188,224.1875,224.20312,224.21875,224.23438,224.25,224.28125,224.3125,224.34375,224.375,224.40625,224.4375,224.46875,224.5,224.5625,224.625,224.6875,224.75,224.8125,224.875,224.9375,225,225.125,225.25,225.375,225.5,225.625,225.75,225.875,226,226.25,226.5,226.75,227,227.25,227.5,227.75,228,228.5,229,229.5,230,230.5,231,231.5,232,233,234,235,236,237,238,239,240,242,244,246,248,250,252,254,256,260,264,268,272,276,280,284,288,296,304,312,320,328,336,344,352,368,384,400,416,432,448,464,480,512,544,576,608,640,672,704,240,239.99121,239.99023,239.98926,239.98828,239.9873,239.98633,239.98535,239.98438,239.98242,239.98047,239.97852,239.97656,239.97461,239.97266,239.9707,239.96875,239.96484,239.96094,239.95703,239.95312,239.94922,239.94531,239.9414,239.9375,239.92969,239.92188,239.91406,239.90625,239.89844,239.89062,239.88281,239.875,239.85938,239.84375,239.82812,239.8125,239.79688,239.78125,239.76562,239.75,239.71875,239.6875,239.65625,239.625,239.59375,239.5625,239.53125,239.5,239.4375,239.375,239.3125,239.25,239.1875,239.125,239.0625,239,238.875,238.75,238.625,238.5,238.375,238.25,238.125,238,237.75,237.5,237.25,237,236.75,236.5,236.25,236,235.5,235,234.5,234,233.5,233,232.5,232,231,230,229,228,227,226,225,224,222,220,218,216,214,212,210,208,204,200,196,192,188,184,180,176,168,160,152,144,136,128,120,112,96,80,64,48,32,16,0,16,48,80,112,144,176,208,240,240.00781,240.00879,240.00977,240.01074,240.01172,240.0127,240.01367,240.01465,240.01562,240.01758,240.01953,240.02148,240.02344,240.02539,240.02734,240.0293,240.03125,240.03516,240.03906,240.04297,240.04688,240.05078,240.05469,240.0586,240.0625,240.07031,240.07812,240.08594,240.09375,240.10156,240.10938,240.11719,240.125,240.14062,240.15625,240.17188,240.1875,240.20312,240.21875,240.23438,240.25,240.28125,240.3125,240.34375,240.375,240.40625,240.4375,240.46875,240.5,240.5625,240.625,240.6875,240.75,240.8125,240.875,240.9375,241,241.125,241.25,241.375,241.5,241.625,241.75,241.875,242,242.25,242.5,242.75,243,243.25,243.5,243.75,244,244.5,245,245.5,246,246.5,247,247.5,248,249,250,251,252,253,254,255,256,258,260,262,264,266,268,270,272,276,280,284,288,292,296,300,304,312,320,328,336,344,352,360,368,384,400,416,432,448,464,480,496,528,560,592,624,656,688,720,256,255.99121,255.99023,255.98926,255.98828,255.9873,255.98633,255.98535,255.98438,255.98242,255.98047,255.97852,255.97656,255.97461,255.97266,255.9707,255.96875,255.96484,255.96094,255.95703,255.95312,255.94922,255.94531,255.9414,255.9375,255.92969,255.92188,255.91406,255.90625,255.89844,255.89062,255.88281,255.875,255.85938,255.84375,255.82812,255.8125,255.79688,255.78125,255.76562,255.75,255.71875,255.6875,255.65625,255.625,255.59375,255.5625,255.53125,255.5,255.4375,255.375,255.3125,255.25,255.1875,255.125,255.0625,255,254.875,254.75,254.625,254.5,254.375,254.25,254.125,254,253.75,253.5,253.25,253,252.75,252.5,252.25,252,251.5,251,250.5,250,249.5,249,248.5,248,247,246,245,244,243,242,241,240,238,236,234,232,230,228,226,224,220,216,212,208,204,200,196,192,184,176,168,160,152,144,136,128,112,96,80,64,48,32,16,0,32,64,96,128,160,192,224,256.0078,256.0088,256.00977,256.01074,256.01172,256.0127,256.01367,256.01465,256.01562,256.01758,256.01953,256.02148,256.02344,256.0254,256.02734,256.0293,256.03125,256.03516,256.03906,256.04297,256.04688,256.05078,256.0547,256.0586,256.0625,256.0703,256.07812,256.08594,256.09375,256.10156,256.10938,256.1172,256.125,256.14062,256.15625,256.17188,256.1875,256.20312,256.21875,256.23438,256.25,256.28125,256.3125,256.34375,256.375,256.40625,256.4375,256.46875,256.5,256.5625,256.625,256.6875,256.75,256.8125,256.875,256.9375,257,257.125,257.25,257.375,257.5,257.625,257.75,257.875,258,258.25,258.5,258.75,259,259.25,259.5,259.75,260,260.5,261,261.5,262,262.5,263,263.5,264,265,266,267,268,269,270,271,272,274,276,278,280,282,284,286,288,292,296,300,304,308,312,316,320,328,336,344,352,360,368,376,384,400,416,432,448,464,480,496,512,544,576,608,640,672,704,736,288,287.9912,287.99023,287.98926,287.98828,287.9873,287.98633,287.98535,287.98438,287.98242,287.98047,287.97852,287.97656,287.9746,287.97266,287.9707,287.96875,287.96484,287.96094,287.95703,287.95312,287.94922,287.9453,287.9414,287.9375,287.9297,287.92188,287.91406,287.90625,287.89844,287.89062,287.8828,287.875,287.85938,287.84375,287.82812,287.8125,287.79688,287.78125,287.76562,287.75,287.71875,287.6875,287.65625,287.625,287.59375,287.5625,287.53125,287.5,287.4375,287.375,287.3125,287.25,287.1875,287.125,287.0625,287,286.875,286.75,286.625,286.5,286.375,286.25,286.125,286,285.75,285.5,285.25,285,284.75,284.5,284.25,284,283.5,283,282.5,282,281.5,281,280.5,280,279,278,277,276,275,274,273,272,270,268,266,264,262,260,258,256,252,248,244,240,236,232,228,224,216,208,200,192,184,176,168,160,144,128,112,96,80,64,48,32,0,32,64,96,128,160,192,288.0078,288.0088,288.00977,288.01074,288.01172,288.0127,288.01367,288.01465,288.01562,288.01758,288.01953,288.02148,288.02344,288.0254,288.02734,288.0293,288.03125,288.03516,288.03906,288.04297,288.04688,288.05078,288.0547,288.0586,288.0625,288.0703,288.07812,288.08594,288.09375,288.10156,288.10938,288.1172,288.125,288.14062,288.15625,288.17188,288.1875,288.20312,288.21875,288.23438,288.25,288.28125,288.3125,288.34375,288.375,288.40625,288.4375,288.46875,288.5,288.5625,288.625,288.6875,288.75,288.8125,288.875,288.9375,289,289.125,289.25,289.375,289.5,289.625,289.75,289.875,290,290.25,290.5,290.75,291,291.25,291.5,291.75,292,292.5,293,293.5,294,294.5,295,295.5,296,297,298,299,300,301,302,303,304,306,308,310,312,314,316,318,320,324,328,332,336,340,344,348,352,360,368,376,384,392,400,408,416,432,448,464,480,496,512,528,544,576,608,640,672,704,736,768,320,319.9912,319.99023,319.98926,319.98828,319.9873,319.98633,319.98535,319.98438,319.98242,319.98047,319.97852,319.97656,319.9746,319.97266,319.9707,319.96875,319.96484,319.96094,319.95703,319.95312,319.94922,319.9453,319.9414,319.9375,319.9297,319.92188,319.91406,319.90625,319.89844,319.89062,319.8828,319.875,319.85938,319.84375,319.82812,319.8125,319.79688,319.78125,319.76562,319.75,319.71875,319.6875,319.65625,319.625,319.59375,319.5625,319.53125,319.5,319.4375,319.375,319.3125,319.25,319.1875,319.125,319.0625,319,318.875,318.75,318.625,318.5,318.375,318.25,318.125,318,317.75,317.5,317.25,317,316.75,316.5,316.25,316,315.5,315,314.5,314,313.5,313,312.5,312,311,310,309,308,307,306,305,304,302,300,298,296,294,292,290,288,284,280,276,272,268,264,260,256,248,240,232,224,216,208,200,192,176,160,144,128,112,96,80,64,32,0,32,64,96,128,160,320.0078,320.0088,320.00977,320.01074,320.01172,320.0127,320.01367,320.01465,320.01562,320.01758,320.01953,320.02148,320.02344,320.0254,320.02734,320.0293,320.03125,320.03516,320.03906,320.04297,320.04688,320.05078,320.0547,320.0586,320.0625,320.0703,320.07812,320.08594,320.09375,320.10156,320.10938,320.1172,320.125,320.14062,320.15625,320.17188,320.1875,320.20312,320.21875,320.23438,320.25,320.28125,320.3125,320.34375,320.375,320.40625,320.4375,320.46875,320.5,320.5625,320.625,320.6875,320.75,320.8125,320.875,320.9375,321,321.125,321.25,321.375,321.5,321.625,321.75,321.875,322,322.25,322.5,322.75,323,323.25,323.5,323.75,324,324.5,325,325.5,326,326.5,327,327.5,328,329,330,331,332,333,334,335,336,338,340,342,344,346,348,350,352,356,360,364,368,372,376,380,384,392,400,408,416,424,432,440,448,464,480,496,512,528,544,560,576,608,640,672,704,736,768,800,352,351.9912,351.99023,351.98926,351.98828,351.9873,351.98633,351.98535,351.98438,351.98242,351.98047,351.97852,351.97656,351.9746,351.97266,351.9707,351.96875,351.96484,351.96094,351.95703,351.95312,351.94922,351.9453,351.9414,351.9375,351.9297,351.92188,351.91406,351.90625,351.89844,351.89062,351.8828,351.875,351.85938,351.84375,351.82812,351.8125,351.79688,351.78125,351.76562,351.75,351.71875,351.6875,351.65625,351.625,351.59375,351.5625,351.53125,351.5,351.4375,351.375,351.3125,351.25,351.1875,351.125,351.0625,351,350.875,350.75,350.625,350.5,350.375,350.25,350.125,350,349.75,349.5,349.25,349,348.75,348.5,348.25,348,347.5,347,346.5,346,345.5,345,344.5,344,343,342,341,340,339,338,337,336,334,332,330,328,326,324,322,320,316,312,308,304,300,296,292,288,280,272,264,256,248,240,232,224,208,192,176,160,144,128,112,96,64,32,0,32,64,96,128,352.0078,352.0088,352.00977,352.01074,352.01172,352.0127,352.01367,352.01465,352.01562,352.01758,352.01953,352.02148,352.02344,352.0254,352.02734,352.0293,352.03125,352.03516,352.03906,352.04297,352.04688,352.05078,352.0547,352.0586,352.0625,352.0703,352.07812,352.08594,352.09375,352.10156,352.10938,352.1172,352.125,352.14062,352.15625,352.17188,352.1875,352.20312,352.21875,352.23438,352.25,352.28125,352.3125,352.34375,352.375,352.40625,352.4375,352.46875,352.5,352.5625,352.625,352.6875,352.75,352.8125,352.875,352.9375,353,353.125,353.25,353.375,353.5,353.625,353.75,353.875,354,354.25,354.5,354.75,355,355.25,355.5,355.75,356,356.5,357,357.5,358,358.5,359,359.5,360,361,362,363,364,365,366,367,368,370,372,374,376,378,380,382,384,388,392,396,400,404,408,412,416,424,432,440,448,456,464,472,480,496,512,528,544,560,576,592,608,640,672,704,736,768,800,832,384,383.9912,383.99023,383.98926,383.98828,383.9873,383.98633,383.98535,383.98438,383.98242,383.98047,383.97852,383.97656,383.9746,383.97266,383.9707,383.96875,383.96484,383.96094,383.95703,383.95312,383.94922,383.9453,383.9414,383.9375,383.9297,383.92188,383.91406,383.90625,383.89844,383.89062,383.8828,383.875,383.85938,383.84375,383.82812,383.8125,383.79688,383.78125,383.76562,383.75,383.71875,383.6875,383.65625,383.625,383.59375,383.5625,383.53125,383.5,383.4375,383.375,383.3125,383.25,383.1875,383.125,383.0625,383,382.875,382.75,382.625,382.5,382.375,382.25,382.125,382,381.75,381.5,381.25,381,380.75,380.5,380.25,380,379.5,379,378.5,378,377.5,377,376.5,376,375,374,373,372,371,370,369,368,366,364,362,360,358,356,354,352,348,344,340,336,332,328,324,320,312,304,296,288,280,272,264,256,240,224,208,192,176,160,144,128,96,64,32,0,32,64,96,384.0078,384.0088,384.00977,384.01074,384.01172,384.0127,384.01367,384.01465,384.01562,384.01758,384.01953,384.02148,384.02344,384.0254,384.02734,384.0293,384.03125,384.03516,384.03906,384.04297,384.04688,384.05078,384.0547,384.0586,384.0625,384.0703,384.07812,384.08594,384.09375,384.10156,384.10938,384.1172,384.125,384.14062,384.15625,384.17188,384.1875,384.20312,384.21875,384.23438,384.25,384.28125,384.3125,384.34375,384.375,384.40625,384.4375,384.46875,384.5,384.5625,384.625,384.6875,384.75,384.8125,384.875,384.9375,385,385.125,385.25,385.375,385.5,385.625,385.75,385.875,386,386.25,386.5,386.75,387,387.25,387.5,387.75,388,388.5,389,389.5,390,390.5,391,391.5,392,393,394,395,396,397,398,399,400,402,404,406,408,410,412,414,416,420,424,428,432,436,440,444,448,456,464,472,480,488,496,504,512,528,544,560,576,592,608,624,640,672,704,736,768,800,832,864,416,415.9912,415.99023,415.98926,415.98828,415.9873,415.98633,415.98535,415.98438,415.98242,415.98047,415.97852,415.97656,415.9746,415.97266,415.9707,415.96875,415.96484,415.96094,415.95703,415.95312,415.94922,415.9453,415.9414,415.9375,415.9297,415.92188,415.91406,415.90625,415.89844,415.89062,415.8828,415.875,415.85938,415.84375,415.82812,415.8125,415.79688,415.78125,415.76562,415.75,415.71875,415.6875,415.65625,415.625,415.59375,415.5625,415.53125,415.5,415.4375,415.375,415.3125,415.25,415.1875,415.125,415.0625,415,414.875,414.75,414.625,414.5,414.375,414.25,414.125,414,413.75,413.5,413.25,413,412.75,412.5,412.25,412,411.5,411,410.5,410,409.5,409,408.5,408,407,406,405,404,403,402,401,400,398,396,394,392,390,388,386,384,380,376,372,368,364,360,356,352,344,336,328,320,312,304,296,288,272,256,240,224,208,192,176,160,128,96,64,32,0,32,64,416.0078,416.0088,416.00977,416.01074,416.01172,416.0127,416.01367,416.01465,416.01562,416.01758,416.01953,416.02148,416.02344,416.0254,416.02734,416.0293,416.03125,416.03516,416.03906,416.04297,416.04688,416.05078,416.0547,416.0586,416.0625,416.0703,416.07812,416.08594,416.09375,416.10156,416.10938,416.1172,416.125,416.14062,416.15625,416.17188,416.1875,416.20312,416.21875,416.23438,416.25,416.28125,416.3125,416.34375,416.375,416.40625,416.4375,416.46875,416.5,416.5625,416.625,416.6875,416.75,416.8125,416.875,416.9375,417,417.125,417.25,417.375,417.5,417.625,417.75,417.875,418,418.25,418.5,418.75,419,419.25,419.5,419.75,420,420.5,421,421.5,422,422.5,423,423.5,424,425,426,427,428,429,430,431,432,434,436,438,440,442,444,446,448,452,456,460,464,468,472,476,480,488,496,504,512,520,528,536,544,560,576,592,608,624,640,656,672,704,736,768,800,832,864,896,448,447.9912,447.99023,447.98926,447.98828,447.9873,447.98633,447.98535,447.98438,447.98242,447.98047,447.97852,447.97656,447.9746,447.97266,447.9707,447.96875,447.96484,447.96094,447.95703,447.95312,447.94922,447.9453,447.9414,447.9375,447.9297,447.92188,447.91406,447.90625,447.89844,447.89062,447.8828,447.875,447.85938,447.84375,447.82812,447.8125,447.79688,447.78125,447.76562,447.75,447.71875,447.6875,447.65625,447.625,447.59375,447.5625,447.53125,447.5,447.4375,447.375,447.3125,447.25,447.1875,447.125,447.0625,447,446.875,446.75,446.625,446.5,446.375,446.25,446.125,446,445.75,445.5,445.25,445,444.75,444.5,444.25,444,443.5,443,442.5,442,441.5,441,440.5,440,439,438,437,436,435,434,433,432,430,428,426,424,422,420,418,416,412,408,404,400,396,392,388,384,376,368,360,352,344,336,328,320,304,288,272,256,240,224,208,192,160,128,96,64,32,0,32,448.0078,448.0088,448.00977,448.01074,448.01172,448.0127,448.01367,448.01465,448.01562,448.01758,448.01953,448.02148,448.02344,448.0254,448.02734,448.0293,448.03125,448.03516,448.03906,448.04297,448.04688,448.05078,448.0547,448.0586,448.0625,448.0703,448.07812,448.08594,448.09375,448.10156,448.10938,448.1172,448.125,448.14062,448.15625,448.17188,448.1875,448.20312,448.21875,448.23438,448.25,448.28125,448.3125,448.34375,448.375,448.40625,448.4375,448.46875,448.5,448.5625,448.625,448.6875,448.75,448.8125,448.875,448.9375,449,449.125,449.25,449.375,449.5,449.625,449.75,449.875,450,450.25,450.5,450.75,451,451.25,451.5,451.75,452,452.5,453,453.5,454,454.5,455,455.5,456,457,458,459,460,461,462,463,464,466,468,470,472,474,476,478,480,484,488,492,496,500,504,508,512,520,528,536,544,552,560,568,576,592,608,624,640,656,672,688,704,736,768,800,832,864,896,928,480,479.9912,479.99023,479.98926,479.98828,479.9873,479.98633,479.98535,479.98438,479.98242,479.98047,479.97852,479.97656,479.9746,479.97266,479.9707,479.96875,479.96484,479.96094,479.95703,479.95312,479.94922,479.9453,479.9414,479.9375,479.9297,479.92188,479.91406,479.90625,479.89844,479.89062,479.8828,479.875,479.85938,479.84375,479.82812,479.8125,479.79688,479.78125,479.76562,479.75,479.71875,479.6875,479.65625,479.625,479.59375,479.5625,479.53125,479.5,479.4375,479.375,479.3125,479.25,479.1875,479.125,479.0625,479,478.875,478.75,478.625,478.5,478.375,478.25,478.125,478,477.75,477.5,477.25,477,476.75,476.5,476.25,476,475.5,475,474.5,474,473.5,473,472.5,472,471,470,469,468,467,466,465,464,462,460,458,456,454,452,450,448,444,440,436,432,428,424,420,416,408,400,392,384,376,368,360,352,336,320,304,288,272,256,240,224,192,160,128,96,64,32,0,480.0078,480.0088,480.00977,480.01074,480.01172,480.0127,480.01367,480.01465,480.01562,480.01758,480.01953,480.02148,480.02344,480.0254,480.02734,480.0293,480.03125,480.03516,480.03906,480.04297,480.04688,480.05078,480.0547,480.0586,480.0625,480.0703,480.07812,480.08594,480.09375,480.10156,480.10938,480.1172,480.125,480.14062,480.15625,480.17188,480.1875,480.20312,480.21875,480.23438,480.25,480.28125,480.3125,480.34375,480.375,480.40625,480.4375,480.46875,480.5,480.5625,480.625,480.6875,480.75,480.8125,480.875,480.9375,481,481.125,481.25,481.375,481.5,481.625,481.75,481.875,482,482.25,482.5,482.75,483,483.25,483.5,483.75,484,484.5,485,485.5,486,486.5,487,487.5,488,489,490,491,492,493,494,495,496,498,500,502,504,506,508,510,512,516,520,524,528,532,536,540,544,552,560,568,576,584,592,600,608,624,640,656,672,688,704,720,736,768,800,832,864,896,928,960,0.0078125,0.016601562,0.017578125,0.018554688,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.025390625,0.02734375,0.029296875,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.04296875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5078125,0.5703125,0.6328125,0.6953125,0.7578125,0.8203125,0.8828125,0.9453125,1.0078125,1.1328125,1.2578125,1.3828125,1.5078125,1.6328125,1.7578125,1.8828125,2.0078125,2.2578125,2.5078125,2.7578125,3.0078125,3.2578125,3.5078125,3.7578125,4.0078125,4.5078125,5.0078125,5.5078125,6.0078125,6.5078125,7.0078125,7.5078125,8.0078125,9.0078125,10.0078125,11.0078125,12.0078125,13.0078125,14.0078125,15.0078125,16.007812,18.007812,20.007812,22.007812,24.007812,26.007812,28.007812,30.007812,32.007812,36.007812,40.007812,44.007812,48.007812,52.007812,56.007812,60.007812,64.00781,72.00781,80.00781,88.00781,96.00781,104.00781,112.00781,120.00781,128.00781,144.00781,160.00781,176.00781,192.00781,208.00781,224.00781,240.00781,256.0078,288.0078,320.0078,352.0078,384.0078,416.0078,448.0078,480.0078,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.0048828125,0.005859375,0.0068359375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.017578125,0.01953125,0.021484375,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.05078125,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5546875,0.6171875,0.6796875,0.7421875,0.8046875,0.8671875,0.9296875,0.9921875,1.1171875,1.2421875,1.3671875,1.4921875,1.6171875,1.7421875,1.8671875,1.9921875,2.2421875,2.4921875,2.7421875,2.9921875,3.2421875,3.4921875,3.7421875,3.9921875,4.4921875,4.9921875,5.4921875,5.9921875,6.4921875,6.9921875,7.4921875,7.9921875,8.9921875,9.9921875,10.9921875,11.9921875,12.9921875,13.9921875,14.9921875,15.9921875,17.992188,19.992188,21.992188,23.992188,25.992188,27.992188,29.992188,31.992188,35.992188,39.992188,43.992188,47.992188,51.992188,55.992188,59.992188,63.992188,71.99219,79.99219,87.99219,95.99219,103.99219,111.99219,119.99219,127.99219,143.99219,159.99219,175.99219,191.99219,207.99219,223.99219,239.99219,255.99219,287.9922,319.9922,351.9922,383.9922,415.9922,447.9922,479.9922,0.0087890625,0.017578125,0.018554688,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.026367188,0.028320312,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.043945312,0.047851562,0.051757812,0.055664062,0.059570312,0.06347656,0.06738281,0.07128906,0.07910156,0.08691406,0.09472656,0.10253906,0.11035156,0.11816406,0.12597656,0.13378906,0.14941406,0.16503906,0.18066406,0.19628906,0.21191406,0.22753906,0.24316406,0.25878906,0.29003906,0.32128906,0.35253906,0.38378906,0.41503906,0.44628906,0.47753906,0.50878906,0.57128906,0.63378906,0.69628906,0.75878906,0.82128906,0.88378906,0.94628906,1.0087891,1.1337891,1.2587891,1.3837891,1.5087891,1.6337891,1.7587891,1.8837891,2.008789,2.258789,2.508789,2.758789,3.008789,3.258789,3.508789,3.758789,4.008789,4.508789,5.008789,5.508789,6.008789,6.508789,7.008789,7.508789,8.008789,9.008789,10.008789,11.008789,12.008789,13.008789,14.008789,15.008789,16.00879,18.00879,20.00879,22.00879,24.00879,26.00879,28.00879,30.00879,32.00879,36.00879,40.00879,44.00879,48.00879,52.00879,56.00879,60.00879,64.00879,72.00879,80.00879,88.00879,96.00879,104.00879,112.00879,120.00879,128.00879,144.00879,160.00879,176.00879,192.00879,208.00879,224.00879,240.00879,256.0088,288.0088,320.0088,352.0088,384.0088,416.0088,448.0088,480.0088,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.0048828125,0.005859375,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.018554688,0.020507812,0.022460938,0.026367188,0.030273438,0.034179688,0.038085938,0.041992188,0.045898438,0.049804688,0.053710938,0.061523438,0.06933594,0.07714844,0.08496094,0.09277344,0.10058594,0.10839844,0.11621094,0.13183594,0.14746094,0.16308594,0.17871094,0.19433594,0.20996094,0.22558594,0.24121094,0.27246094,0.30371094,0.33496094,0.36621094,0.39746094,0.42871094,0.45996094,0.49121094,0.55371094,0.61621094,0.67871094,0.74121094,0.80371094,0.86621094,0.92871094,0.99121094,1.1162109,1.2412109,1.3662109,1.4912109,1.6162109,1.7412109,1.8662109,1.9912109,2.241211,2.491211,2.741211,2.991211,3.241211,3.491211,3.741211,3.991211,4.491211,4.991211,5.491211,5.991211,6.491211,6.991211,7.491211,7.991211,8.991211,9.991211,10.991211,11.991211,12.991211,13.991211,14.991211,15.991211,17.99121,19.99121,21.99121,23.99121,25.99121,27.99121,29.99121,31.99121,35.99121,39.99121,43.99121,47.99121,51.99121,55.99121,59.99121,63.99121,71.99121,79.99121,87.99121,95.99121,103.99121,111.99121,119.99121,127.99121,143.99121,159.99121,175.99121,191.99121,207.99121,223.99121,239.99121,255.99121,287.9912,319.9912,351.9912,383.9912,415.9912,447.9912,479.9912,0.009765625,0.018554688,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.02734375,0.029296875,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.044921875,0.048828125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.080078125,0.087890625,0.095703125,0.103515625,0.111328125,0.119140625,0.12695312,0.13476562,0.15039062,0.16601562,0.18164062,0.19726562,0.21289062,0.22851562,0.24414062,0.25976562,0.29101562,0.32226562,0.35351562,0.38476562,0.41601562,0.44726562,0.47851562,0.5097656,0.5722656,0.6347656,0.6972656,0.7597656,0.8222656,0.8847656,0.9472656,1.0097656,1.1347656,1.2597656,1.3847656,1.5097656,1.6347656,1.7597656,1.8847656,2.0097656,2.2597656,2.5097656,2.7597656,3.0097656,3.2597656,3.5097656,3.7597656,4.0097656,4.5097656,5.0097656,5.5097656,6.0097656,6.5097656,7.0097656,7.5097656,8.009766,9.009766,10.009766,11.009766,12.009766,13.009766,14.009766,15.009766,16.009766,18.009766,20.009766,22.009766,24.009766,26.009766,28.009766,30.009766,32.009766,36.009766,40.009766,44.009766,48.009766,52.009766,56.009766,60.009766,64.009766,72.009766,80.009766,88.009766,96.009766,104.009766,112.009766,120.009766,128.00977,144.00977,160.00977,176.00977,192.00977,208.00977,224.00977,240.00977,256.00977,288.00977,320.00977,352.00977,384.00977,416.00977,448.00977,480.00977,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.0048828125,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.017578125,0.01953125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.044921875,0.048828125,0.052734375,0.060546875,0.068359375,0.076171875,0.083984375,0.091796875,0.099609375,0.107421875,0.115234375,0.13085938,0.14648438,0.16210938,0.17773438,0.19335938,0.20898438,0.22460938,0.24023438,0.27148438,0.30273438,0.33398438,0.36523438,0.39648438,0.42773438,0.45898438,0.49023438,0.5527344,0.6152344,0.6777344,0.7402344,0.8027344,0.8652344,0.9277344,0.9902344,1.1152344,1.2402344,1.3652344,1.4902344,1.6152344,1.7402344,1.8652344,1.9902344,2.2402344,2.4902344,2.7402344,2.9902344,3.2402344,3.4902344,3.7402344,3.9902344,4.4902344,4.9902344,5.4902344,5.9902344,6.4902344,6.9902344,7.4902344,7.9902344,8.990234,9.990234,10.990234,11.990234,12.990234,13.990234,14.990234,15.990234,17.990234,19.990234,21.990234,23.990234,25.990234,27.990234,29.990234,31.990234,35.990234,39.990234,43.990234,47.990234,51.990234,55.990234,59.990234,63.990234,71.990234,79.990234,87.990234,95.990234,103.990234,111.990234,119.990234,127.990234,143.99023,159.99023,175.99023,191.99023,207.99023,223.99023,239.99023,255.99023,287.99023,319.99023,351.99023,383.99023,415.99023,447.99023,479.99023,0.0107421875,0.01953125,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.028320312,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.041992188,0.045898438,0.049804688,0.053710938,0.057617188,0.061523438,0.06542969,0.06933594,0.07324219,0.08105469,0.08886719,0.09667969,0.10449219,0.11230469,0.12011719,0.12792969,0.13574219,0.15136719,0.16699219,0.18261719,0.19824219,0.21386719,0.22949219,0.24511719,0.2607422,0.2919922,0.3232422,0.3544922,0.3857422,0.4169922,0.4482422,0.4794922,0.5107422,0.5732422,0.6357422,0.6982422,0.7607422,0.8232422,0.8857422,0.9482422,1.0107422,1.1357422,1.2607422,1.3857422,1.5107422,1.6357422,1.7607422,1.8857422,2.0107422,2.2607422,2.5107422,2.7607422,3.0107422,3.2607422,3.5107422,3.7607422,4.010742,4.510742,5.010742,5.510742,6.010742,6.510742,7.010742,7.510742,8.010742,9.010742,10.010742,11.010742,12.010742,13.010742,14.010742,15.010742,16.010742,18.010742,20.010742,22.010742,24.010742,26.010742,28.010742,30.010742,32.010742,36.010742,40.010742,44.010742,48.010742,52.010742,56.010742,60.010742,64.01074,72.01074,80.01074,88.01074,96.01074,104.01074,112.01074,120.01074,128.01074,144.01074,160.01074,176.01074,192.01074,208.01074,224.01074,240.01074,256.01074,288.01074,320.01074,352.01074,384.01074,416.01074,448.01074,480.01074,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.0048828125,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.018554688,0.020507812,0.024414062,0.028320312,0.032226562,0.036132812,0.040039062,0.043945312,0.047851562,0.051757812,0.059570312,0.06738281,0.07519531,0.08300781,0.09082031,0.09863281,0.10644531,0.11425781,0.12988281,0.14550781,0.16113281,0.17675781,0.19238281,0.20800781,0.22363281,0.23925781,0.2705078,0.3017578,0.3330078,0.3642578,0.3955078,0.4267578,0.4580078,0.4892578,0.5517578,0.6142578,0.6767578,0.7392578,0.8017578,0.8642578,0.9267578,0.9892578,1.1142578,1.2392578,1.3642578,1.4892578,1.6142578,1.7392578,1.8642578,1.9892578,2.2392578,2.4892578,2.7392578,2.9892578,3.2392578,3.4892578,3.7392578,3.9892578,4.489258,4.989258,5.489258,5.989258,6.489258,6.989258,7.489258,7.989258,8.989258,9.989258,10.989258,11.989258,12.989258,13.989258,14.989258,15.989258,17.989258,19.989258,21.989258,23.989258,25.989258,27.989258,29.989258,31.989258,35.989258,39.989258,43.989258,47.989258,51.989258,55.989258,59.989258,63.989258,71.98926,79.98926,87.98926,95.98926,103.98926,111.98926,119.98926,127.98926,143.98926,159.98926,175.98926,191.98926,207.98926,223.98926,239.98926,255.98926,287.98926,319.98926,351.98926,383.98926,415.98926,447.98926,479.98926,0.01171875,0.020507812,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.029296875,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.08203125,0.08984375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.48046875,0.51171875,0.57421875,0.63671875,0.69921875,0.76171875,0.82421875,0.88671875,0.94921875,1.0117188,1.1367188,1.2617188,1.3867188,1.5117188,1.6367188,1.7617188,1.8867188,2.0117188,2.2617188,2.5117188,2.7617188,3.0117188,3.2617188,3.5117188,3.7617188,4.0117188,4.5117188,5.0117188,5.5117188,6.0117188,6.5117188,7.0117188,7.5117188,8.011719,9.011719,10.011719,11.011719,12.011719,13.011719,14.011719,15.011719,16.011719,18.011719,20.011719,22.011719,24.011719,26.011719,28.011719,30.011719,32.01172,36.01172,40.01172,44.01172,48.01172,52.01172,56.01172,60.01172,64.01172,72.01172,80.01172,88.01172,96.01172,104.01172,112.01172,120.01172,128.01172,144.01172,160.01172,176.01172,192.01172,208.01172,224.01172,240.01172,256.01172,288.01172,320.01172,352.01172,384.01172,416.01172,448.01172,480.01172,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.017578125,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.09765625,0.10546875,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.26953125,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.48828125,0.55078125,0.61328125,0.67578125,0.73828125,0.80078125,0.86328125,0.92578125,0.98828125,1.1132812,1.2382812,1.3632812,1.4882812,1.6132812,1.7382812,1.8632812,1.9882812,2.2382812,2.4882812,2.7382812,2.9882812,3.2382812,3.4882812,3.7382812,3.9882812,4.4882812,4.9882812,5.4882812,5.9882812,6.4882812,6.9882812,7.4882812,7.9882812,8.988281,9.988281,10.988281,11.988281,12.988281,13.988281,14.988281,15.988281,17.988281,19.988281,21.988281,23.988281,25.988281,27.988281,29.988281,31.988281,35.98828,39.98828,43.98828,47.98828,51.98828,55.98828,59.98828,63.98828,71.98828,79.98828,87.98828,95.98828,103.98828,111.98828,119.98828,127.98828,143.98828,159.98828,175.98828,191.98828,207.98828,223.98828,239.98828,255.98828,287.98828,319.98828,351.98828,383.98828,415.98828,447.98828,479.98828,0.0126953125,0.021484375,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.041992188,0.043945312,0.047851562,0.051757812,0.055664062,0.059570312,0.06347656,0.06738281,0.07128906,0.07519531,0.08300781,0.09082031,0.09863281,0.10644531,0.11425781,0.12207031,0.12988281,0.13769531,0.15332031,0.16894531,0.18457031,0.20019531,0.21582031,0.23144531,0.24707031,0.2626953,0.2939453,0.3251953,0.3564453,0.3876953,0.4189453,0.4501953,0.4814453,0.5126953,0.5751953,0.6376953,0.7001953,0.7626953,0.8251953,0.8876953,0.9501953,1.0126953,1.1376953,1.2626953,1.3876953,1.5126953,1.6376953,1.7626953,1.8876953,2.0126953,2.2626953,2.5126953,2.7626953,3.0126953,3.2626953,3.5126953,3.7626953,4.0126953,4.5126953,5.0126953,5.5126953,6.0126953,6.5126953,7.0126953,7.5126953,8.012695,9.012695,10.012695,11.012695,12.012695,13.012695,14.012695,15.012695,16.012695,18.012695,20.012695,22.012695,24.012695,26.012695,28.012695,30.012695,32.012695,36.012695,40.012695,44.012695,48.012695,52.012695,56.012695,60.012695,64.012695,72.012695,80.012695,88.012695,96.012695,104.012695,112.012695,120.012695,128.0127,144.0127,160.0127,176.0127,192.0127,208.0127,224.0127,240.0127,256.0127,288.0127,320.0127,352.0127,384.0127,416.0127,448.0127,480.0127,0.0048828125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.0029296875,0.0048828125,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.018554688,0.022460938,0.026367188,0.030273438,0.034179688,0.038085938,0.041992188,0.045898438,0.049804688,0.057617188,0.06542969,0.07324219,0.08105469,0.08886719,0.09667969,0.10449219,0.11230469,0.12792969,0.14355469,0.15917969,0.17480469,0.19042969,0.20605469,0.22167969,0.23730469,0.2685547,0.2998047,0.3310547,0.3623047,0.3935547,0.4248047,0.4560547,0.4873047,0.5498047,0.6123047,0.6748047,0.7373047,0.7998047,0.8623047,0.9248047,0.9873047,1.1123047,1.2373047,1.3623047,1.4873047,1.6123047,1.7373047,1.8623047,1.9873047,2.2373047,2.4873047,2.7373047,2.9873047,3.2373047,3.4873047,3.7373047,3.9873047,4.4873047,4.9873047,5.4873047,5.9873047,6.4873047,6.9873047,7.4873047,7.9873047,8.987305,9.987305,10.987305,11.987305,12.987305,13.987305,14.987305,15.987305,17.987305,19.987305,21.987305,23.987305,25.987305,27.987305,29.987305,31.987305,35.987305,39.987305,43.987305,47.987305,51.987305,55.987305,59.987305,63.987305,71.987305,79.987305,87.987305,95.987305,103.987305,111.987305,119.987305,127.987305,143.9873,159.9873,175.9873,191.9873,207.9873,223.9873,239.9873,255.9873,287.9873,319.9873,351.9873,383.9873,415.9873,447.9873,479.9873,0.013671875,0.022460938,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.029296875,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.048828125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.083984375,0.091796875,0.099609375,0.107421875,0.115234375,0.123046875,0.13085938,0.13867188,0.15429688,0.16992188,0.18554688,0.20117188,0.21679688,0.23242188,0.24804688,0.26367188,0.29492188,0.32617188,0.35742188,0.38867188,0.41992188,0.45117188,0.48242188,0.5136719,0.5761719,0.6386719,0.7011719,0.7636719,0.8261719,0.8886719,0.9511719,1.0136719,1.1386719,1.2636719,1.3886719,1.5136719,1.6386719,1.7636719,1.8886719,2.0136719,2.2636719,2.5136719,2.7636719,3.0136719,3.2636719,3.5136719,3.7636719,4.013672,4.513672,5.013672,5.513672,6.013672,6.513672,7.013672,7.513672,8.013672,9.013672,10.013672,11.013672,12.013672,13.013672,14.013672,15.013672,16.013672,18.013672,20.013672,22.013672,24.013672,26.013672,28.013672,30.013672,32.01367,36.01367,40.01367,44.01367,48.01367,52.01367,56.01367,60.01367,64.01367,72.01367,80.01367,88.01367,96.01367,104.01367,112.01367,120.01367,128.01367,144.01367,160.01367,176.01367,192.01367,208.01367,224.01367,240.01367,256.01367,288.01367,320.01367,352.01367,384.01367,416.01367,448.01367,480.01367,0.005859375,0.0048828125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.044921875,0.048828125,0.056640625,0.064453125,0.072265625,0.080078125,0.087890625,0.095703125,0.103515625,0.111328125,0.12695312,0.14257812,0.15820312,0.17382812,0.18945312,0.20507812,0.22070312,0.23632812,0.26757812,0.29882812,0.33007812,0.36132812,0.39257812,0.42382812,0.45507812,0.48632812,0.5488281,0.6113281,0.6738281,0.7363281,0.7988281,0.8613281,0.9238281,0.9863281,1.1113281,1.2363281,1.3613281,1.4863281,1.6113281,1.7363281,1.8613281,1.9863281,2.2363281,2.4863281,2.7363281,2.9863281,3.2363281,3.4863281,3.7363281,3.9863281,4.486328,4.986328,5.486328,5.986328,6.486328,6.986328,7.486328,7.986328,8.986328,9.986328,10.986328,11.986328,12.986328,13.986328,14.986328,15.986328,17.986328,19.986328,21.986328,23.986328,25.986328,27.986328,29.986328,31.986328,35.98633,39.98633,43.98633,47.98633,51.98633,55.98633,59.98633,63.98633,71.98633,79.98633,87.98633,95.98633,103.98633,111.98633,119.98633,127.98633,143.98633,159.98633,175.98633,191.98633,207.98633,223.98633,239.98633,255.98633,287.98633,319.98633,351.98633,383.98633,415.98633,447.98633,479.98633,0.0146484375,0.0234375,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.029296875,0.030273438,0.032226562,0.034179688,0.036132812,0.038085938,0.040039062,0.041992188,0.043945312,0.045898438,0.049804688,0.053710938,0.057617188,0.061523438,0.06542969,0.06933594,0.07324219,0.07714844,0.08496094,0.09277344,0.10058594,0.10839844,0.11621094,0.12402344,0.13183594,0.13964844,0.15527344,0.17089844,0.18652344,0.20214844,0.21777344,0.23339844,0.24902344,0.26464844,0.29589844,0.32714844,0.35839844,0.38964844,0.42089844,0.45214844,0.48339844,0.51464844,0.57714844,0.63964844,0.70214844,0.76464844,0.82714844,0.88964844,0.95214844,1.0146484,1.1396484,1.2646484,1.3896484,1.5146484,1.6396484,1.7646484,1.8896484,2.0146484,2.2646484,2.5146484,2.7646484,3.0146484,3.2646484,3.5146484,3.7646484,4.0146484,4.5146484,5.0146484,5.5146484,6.0146484,6.5146484,7.0146484,7.5146484,8.014648,9.014648,10.014648,11.014648,12.014648,13.014648,14.014648,15.014648,16.014648,18.014648,20.014648,22.014648,24.014648,26.014648,28.014648,30.014648,32.01465,36.01465,40.01465,44.01465,48.01465,52.01465,56.01465,60.01465,64.01465,72.01465,80.01465,88.01465,96.01465,104.01465,112.01465,120.01465,128.01465,144.01465,160.01465,176.01465,192.01465,208.01465,224.01465,240.01465,256.01465,288.01465,320.01465,352.01465,384.01465,416.01465,448.01465,480.01465,0.0068359375,0.005859375,0.0048828125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.0009765625,0.0029296875,0.0048828125,0.0068359375,0.0087890625,0.0107421875,0.0126953125,0.0146484375,0.016601562,0.020507812,0.024414062,0.028320312,0.032226562,0.036132812,0.040039062,0.043945312,0.047851562,0.055664062,0.06347656,0.07128906,0.07910156,0.08691406,0.09472656,0.10253906,0.11035156,0.12597656,0.14160156,0.15722656,0.17285156,0.18847656,0.20410156,0.21972656,0.23535156,0.26660156,0.29785156,0.32910156,0.36035156,0.39160156,0.42285156,0.45410156,0.48535156,0.54785156,0.61035156,0.67285156,0.73535156,0.79785156,0.86035156,0.92285156,0.98535156,1.1103516,1.2353516,1.3603516,1.4853516,1.6103516,1.7353516,1.8603516,1.9853516,2.2353516,2.4853516,2.7353516,2.9853516,3.2353516,3.4853516,3.7353516,3.9853516,4.4853516,4.9853516,5.4853516,5.9853516,6.4853516,6.9853516,7.4853516,7.9853516,8.985352,9.985352,10.985352,11.985352,12.985352,13.985352,14.985352,15.985352,17.985352,19.985352,21.985352,23.985352,25.985352,27.985352,29.985352,31.985352,35.98535,39.98535,43.98535,47.98535,51.98535,55.98535,59.98535,63.98535,71.98535,79.98535,87.98535,95.98535,103.98535,111.98535,119.98535,127.98535,143.98535,159.98535,175.98535,191.98535,207.98535,223.98535,239.98535,255.98535,287.98535,319.98535,351.98535,383.98535,415.98535,447.98535,479.98535,0.015625,0.024414062,0.025390625,0.026367188,0.02734375,0.028320312,0.029296875,0.030273438,0.03125,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,1.890625,2.015625,2.265625,2.515625,2.765625,3.015625,3.265625,3.515625,3.765625,4.015625,4.515625,5.015625,5.515625,6.015625,6.515625,7.015625,7.515625,8.015625,9.015625,10.015625,11.015625,12.015625,13.015625,14.015625,15.015625,16.015625,18.015625,20.015625,22.015625,24.015625,26.015625,28.015625,30.015625,32.015625,36.015625,40.015625,44.015625,48.015625,52.015625,56.015625,60.015625,64.015625,72.015625,80.015625,88.015625,96.015625,104.015625,112.015625,120.015625,128.01562,144.01562,160.01562,176.01562,192.01562,208.01562,224.01562,240.01562,256.01562,288.01562,320.01562,352.01562,384.01562,416.01562,448.01562,480.01562,0.0078125,0.0068359375,0.005859375,0.0048828125,0.00390625,0.0029296875,0.001953125,0.0009765625,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.109375,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,1.984375,2.234375,2.484375,2.734375,2.984375,3.234375,3.484375,3.734375,3.984375,4.484375,4.984375,5.484375,5.984375,6.484375,6.984375,7.484375,7.984375,8.984375,9.984375,10.984375,11.984375,12.984375,13.984375,14.984375,15.984375,17.984375,19.984375,21.984375,23.984375,25.984375,27.984375,29.984375,31.984375,35.984375,39.984375,43.984375,47.984375,51.984375,55.984375,59.984375,63.984375,71.984375,79.984375,87.984375,95.984375,103.984375,111.984375,119.984375,127.984375,143.98438,159.98438,175.98438,191.98438,207.98438,223.98438,239.98438,255.98438,287.98438,319.98438,351.98438,383.98438,415.98438,447.98438,479.98438,0.017578125,0.026367188,0.02734375,0.028320312,0.029296875,0.030273438,0.03125,0.032226562,0.033203125,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.087890625,0.095703125,0.103515625,0.111328125,0.119140625,0.12695312,0.13476562,0.14257812,0.15820312,0.17382812,0.18945312,0.20507812,0.22070312,0.23632812,0.25195312,0.26757812,0.29882812,0.33007812,0.36132812,0.39257812,0.42382812,0.45507812,0.48632812,0.5175781,0.5800781,0.6425781,0.7050781,0.7675781,0.8300781,0.8925781,0.9550781,1.0175781,1.1425781,1.2675781,1.3925781,1.5175781,1.6425781,1.7675781,1.8925781,2.0175781,2.2675781,2.5175781,2.7675781,3.0175781,3.2675781,3.5175781,3.7675781,4.017578,4.517578,5.017578,5.517578,6.017578,6.517578,7.017578,7.517578,8.017578,9.017578,10.017578,11.017578,12.017578,13.017578,14.017578,15.017578,16.017578,18.017578,20.017578,22.017578,24.017578,26.017578,28.017578,30.017578,32.01758,36.01758,40.01758,44.01758,48.01758,52.01758,56.01758,60.01758,64.01758,72.01758,80.01758,88.01758,96.01758,104.01758,112.01758,120.01758,128.01758,144.01758,160.01758,176.01758,192.01758,208.01758,224.01758,240.01758,256.01758,288.01758,320.01758,352.01758,384.01758,416.01758,448.01758,480.01758,0.009765625,0.0087890625,0.0078125,0.0068359375,0.005859375,0.0048828125,0.00390625,0.0029296875,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.044921875,0.052734375,0.060546875,0.068359375,0.076171875,0.083984375,0.091796875,0.099609375,0.107421875,0.123046875,0.13867188,0.15429688,0.16992188,0.18554688,0.20117188,0.21679688,0.23242188,0.26367188,0.29492188,0.32617188,0.35742188,0.38867188,0.41992188,0.45117188,0.48242188,0.5449219,0.6074219,0.6699219,0.7324219,0.7949219,0.8574219,0.9199219,0.9824219,1.1074219,1.2324219,1.3574219,1.4824219,1.6074219,1.7324219,1.8574219,1.9824219,2.2324219,2.4824219,2.7324219,2.9824219,3.2324219,3.4824219,3.7324219,3.9824219,4.482422,4.982422,5.482422,5.982422,6.482422,6.982422,7.482422,7.982422,8.982422,9.982422,10.982422,11.982422,12.982422,13.982422,14.982422,15.982422,17.982422,19.982422,21.982422,23.982422,25.982422,27.982422,29.982422,31.982422,35.98242,39.98242,43.98242,47.98242,51.98242,55.98242,59.98242,63.98242,71.98242,79.98242,87.98242,95.98242,103.98242,111.98242,119.98242,127.98242,143.98242,159.98242,175.98242,191.98242,207.98242,223.98242,239.98242,255.98242,287.98242,319.98242,351.98242,383.98242,415.98242,447.98242,479.98242,0.01953125,0.028320312,0.029296875,0.030273438,0.03125,0.032226562,0.033203125,0.034179688,0.03515625,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.08984375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.25390625,0.26953125,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.48828125,0.51953125,0.58203125,0.64453125,0.70703125,0.76953125,0.83203125,0.89453125,0.95703125,1.0195312,1.1445312,1.2695312,1.3945312,1.5195312,1.6445312,1.7695312,1.8945312,2.0195312,2.2695312,2.5195312,2.7695312,3.0195312,3.2695312,3.5195312,3.7695312,4.0195312,4.5195312,5.0195312,5.5195312,6.0195312,6.5195312,7.0195312,7.5195312,8.019531,9.019531,10.019531,11.019531,12.019531,13.019531,14.019531,15.019531,16.019531,18.019531,20.019531,22.019531,24.019531,26.019531,28.019531,30.019531,32.01953,36.01953,40.01953,44.01953,48.01953,52.01953,56.01953,60.01953,64.01953,72.01953,80.01953,88.01953,96.01953,104.01953,112.01953,120.01953,128.01953,144.01953,160.01953,176.01953,192.01953,208.01953,224.01953,240.01953,256.01953,288.01953,320.01953,352.01953,384.01953,416.01953,448.01953,480.01953,0.01171875,0.0107421875,0.009765625,0.0087890625,0.0078125,0.0068359375,0.005859375,0.0048828125,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.09765625,0.10546875,0.12109375,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.26171875,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.48046875,0.54296875,0.60546875,0.66796875,0.73046875,0.79296875,0.85546875,0.91796875,0.98046875,1.1054688,1.2304688,1.3554688,1.4804688,1.6054688,1.7304688,1.8554688,1.9804688,2.2304688,2.4804688,2.7304688,2.9804688,3.2304688,3.4804688,3.7304688,3.9804688,4.4804688,4.9804688,5.4804688,5.9804688,6.4804688,6.9804688,7.4804688,7.9804688,8.980469,9.980469,10.980469,11.980469,12.980469,13.980469,14.980469,15.980469,17.980469,19.980469,21.980469,23.980469,25.980469,27.980469,29.980469,31.980469,35.98047,39.98047,43.98047,47.98047,51.98047,55.98047,59.98047,63.98047,71.98047,79.98047,87.98047,95.98047,103.98047,111.98047,119.98047,127.98047,143.98047,159.98047,175.98047,191.98047,207.98047,223.98047,239.98047,255.98047,287.98047,319.98047,351.98047,383.98047,415.98047,447.98047,479.98047,0.021484375,0.030273438,0.03125,0.032226562,0.033203125,0.034179688,0.03515625,0.036132812,0.037109375,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.083984375,0.091796875,0.099609375,0.107421875,0.115234375,0.123046875,0.13085938,0.13867188,0.14648438,0.16210938,0.17773438,0.19335938,0.20898438,0.22460938,0.24023438,0.25585938,0.27148438,0.30273438,0.33398438,0.36523438,0.39648438,0.42773438,0.45898438,0.49023438,0.5214844,0.5839844,0.6464844,0.7089844,0.7714844,0.8339844,0.8964844,0.9589844,1.0214844,1.1464844,1.2714844,1.3964844,1.5214844,1.6464844,1.7714844,1.8964844,2.0214844,2.2714844,2.5214844,2.7714844,3.0214844,3.2714844,3.5214844,3.7714844,4.0214844,4.5214844,5.0214844,5.5214844,6.0214844,6.5214844,7.0214844,7.5214844,8.021484,9.021484,10.021484,11.021484,12.021484,13.021484,14.021484,15.021484,16.021484,18.021484,20.021484,22.021484,24.021484,26.021484,28.021484,30.021484,32.021484,36.021484,40.021484,44.021484,48.021484,52.021484,56.021484,60.021484,64.021484,72.021484,80.021484,88.021484,96.021484,104.021484,112.021484,120.021484,128.02148,144.02148,160.02148,176.02148,192.02148,208.02148,224.02148,240.02148,256.02148,288.02148,320.02148,352.02148,384.02148,416.02148,448.02148,480.02148,0.013671875,0.0126953125,0.01171875,0.0107421875,0.009765625,0.0087890625,0.0078125,0.0068359375,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.009765625,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.041015625,0.048828125,0.056640625,0.064453125,0.072265625,0.080078125,0.087890625,0.095703125,0.103515625,0.119140625,0.13476562,0.15039062,0.16601562,0.18164062,0.19726562,0.21289062,0.22851562,0.25976562,0.29101562,0.32226562,0.35351562,0.38476562,0.41601562,0.44726562,0.47851562,0.5410156,0.6035156,0.6660156,0.7285156,0.7910156,0.8535156,0.9160156,0.9785156,1.1035156,1.2285156,1.3535156,1.4785156,1.6035156,1.7285156,1.8535156,1.9785156,2.2285156,2.4785156,2.7285156,2.9785156,3.2285156,3.4785156,3.7285156,3.9785156,4.4785156,4.9785156,5.4785156,5.9785156,6.4785156,6.9785156,7.4785156,7.9785156,8.978516,9.978516,10.978516,11.978516,12.978516,13.978516,14.978516,15.978516,17.978516,19.978516,21.978516,23.978516,25.978516,27.978516,29.978516,31.978516,35.978516,39.978516,43.978516,47.978516,51.978516,55.978516,59.978516,63.978516,71.978516,79.978516,87.978516,95.978516,103.978516,111.978516,119.978516,127.978516,143.97852,159.97852,175.97852,191.97852,207.97852,223.97852,239.97852,255.97852,287.97852,319.97852,351.97852,383.97852,415.97852,447.97852,479.97852,0.0234375,0.032226562,0.033203125,0.034179688,0.03515625,0.036132812,0.037109375,0.038085938,0.0390625,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,0.9609375,1.0234375,1.1484375,1.2734375,1.3984375,1.5234375,1.6484375,1.7734375,1.8984375,2.0234375,2.2734375,2.5234375,2.7734375,3.0234375,3.2734375,3.5234375,3.7734375,4.0234375,4.5234375,5.0234375,5.5234375,6.0234375,6.5234375,7.0234375,7.5234375,8.0234375,9.0234375,10.0234375,11.0234375,12.0234375,13.0234375,14.0234375,15.0234375,16.023438,18.023438,20.023438,22.023438,24.023438,26.023438,28.023438,30.023438,32.023438,36.023438,40.023438,44.023438,48.023438,52.023438,56.023438,60.023438,64.02344,72.02344,80.02344,88.02344,96.02344,104.02344,112.02344,120.02344,128.02344,144.02344,160.02344,176.02344,192.02344,208.02344,224.02344,240.02344,256.02344,288.02344,320.02344,352.02344,384.02344,416.02344,448.02344,480.02344,0.015625,0.0146484375,0.013671875,0.0126953125,0.01171875,0.0107421875,0.009765625,0.0087890625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5390625,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,0.9765625,1.1015625,1.2265625,1.3515625,1.4765625,1.6015625,1.7265625,1.8515625,1.9765625,2.2265625,2.4765625,2.7265625,2.9765625,3.2265625,3.4765625,3.7265625,3.9765625,4.4765625,4.9765625,5.4765625,5.9765625,6.4765625,6.9765625,7.4765625,7.9765625,8.9765625,9.9765625,10.9765625,11.9765625,12.9765625,13.9765625,14.9765625,15.9765625,17.976562,19.976562,21.976562,23.976562,25.976562,27.976562,29.976562,31.976562,35.976562,39.976562,43.976562,47.976562,51.976562,55.976562,59.976562,63.976562,71.97656,79.97656,87.97656,95.97656,103.97656,111.97656,119.97656,127.97656,143.97656,159.97656,175.97656,191.97656,207.97656,223.97656,239.97656,255.97656,287.97656,319.97656,351.97656,383.97656,415.97656,447.97656,479.97656,0.025390625,0.034179688,0.03515625,0.036132812,0.037109375,0.038085938,0.0390625,0.040039062,0.041015625,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.083984375,0.087890625,0.095703125,0.103515625,0.111328125,0.119140625,0.12695312,0.13476562,0.14257812,0.15039062,0.16601562,0.18164062,0.19726562,0.21289062,0.22851562,0.24414062,0.25976562,0.27539062,0.30664062,0.33789062,0.36914062,0.40039062,0.43164062,0.46289062,0.49414062,0.5253906,0.5878906,0.6503906,0.7128906,0.7753906,0.8378906,0.9003906,0.9628906,1.0253906,1.1503906,1.2753906,1.4003906,1.5253906,1.6503906,1.7753906,1.9003906,2.0253906,2.2753906,2.5253906,2.7753906,3.0253906,3.2753906,3.5253906,3.7753906,4.0253906,4.5253906,5.0253906,5.5253906,6.0253906,6.5253906,7.0253906,7.5253906,8.025391,9.025391,10.025391,11.025391,12.025391,13.025391,14.025391,15.025391,16.02539,18.02539,20.02539,22.02539,24.02539,26.02539,28.02539,30.02539,32.02539,36.02539,40.02539,44.02539,48.02539,52.02539,56.02539,60.02539,64.02539,72.02539,80.02539,88.02539,96.02539,104.02539,112.02539,120.02539,128.02539,144.02539,160.02539,176.02539,192.02539,208.02539,224.02539,240.02539,256.0254,288.0254,320.0254,352.0254,384.0254,416.0254,448.0254,480.0254,0.017578125,0.016601562,0.015625,0.0146484375,0.013671875,0.0126953125,0.01171875,0.0107421875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.005859375,0.009765625,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.037109375,0.044921875,0.052734375,0.060546875,0.068359375,0.076171875,0.083984375,0.091796875,0.099609375,0.115234375,0.13085938,0.14648438,0.16210938,0.17773438,0.19335938,0.20898438,0.22460938,0.25585938,0.28710938,0.31835938,0.34960938,0.38085938,0.41210938,0.44335938,0.47460938,0.5371094,0.5996094,0.6621094,0.7246094,0.7871094,0.8496094,0.9121094,0.9746094,1.0996094,1.2246094,1.3496094,1.4746094,1.5996094,1.7246094,1.8496094,1.9746094,2.2246094,2.4746094,2.7246094,2.9746094,3.2246094,3.4746094,3.7246094,3.9746094,4.4746094,4.9746094,5.4746094,5.9746094,6.4746094,6.9746094,7.4746094,7.9746094,8.974609,9.974609,10.974609,11.974609,12.974609,13.974609,14.974609,15.974609,17.97461,19.97461,21.97461,23.97461,25.97461,27.97461,29.97461,31.97461,35.97461,39.97461,43.97461,47.97461,51.97461,55.97461,59.97461,63.97461,71.97461,79.97461,87.97461,95.97461,103.97461,111.97461,119.97461,127.97461,143.97461,159.97461,175.97461,191.97461,207.97461,223.97461,239.97461,255.97461,287.9746,319.9746,351.9746,383.9746,415.9746,447.9746,479.9746,0.02734375,0.036132812,0.037109375,0.038085938,0.0390625,0.040039062,0.041015625,0.041992188,0.04296875,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.27734375,0.30859375,0.33984375,0.37109375,0.40234375,0.43359375,0.46484375,0.49609375,0.52734375,0.58984375,0.65234375,0.71484375,0.77734375,0.83984375,0.90234375,0.96484375,1.0273438,1.1523438,1.2773438,1.4023438,1.5273438,1.6523438,1.7773438,1.9023438,2.0273438,2.2773438,2.5273438,2.7773438,3.0273438,3.2773438,3.5273438,3.7773438,4.0273438,4.5273438,5.0273438,5.5273438,6.0273438,6.5273438,7.0273438,7.5273438,8.027344,9.027344,10.027344,11.027344,12.027344,13.027344,14.027344,15.027344,16.027344,18.027344,20.027344,22.027344,24.027344,26.027344,28.027344,30.027344,32.027344,36.027344,40.027344,44.027344,48.027344,52.027344,56.027344,60.027344,64.02734,72.02734,80.02734,88.02734,96.02734,104.02734,112.02734,120.02734,128.02734,144.02734,160.02734,176.02734,192.02734,208.02734,224.02734,240.02734,256.02734,288.02734,320.02734,352.02734,384.02734,416.02734,448.02734,480.02734,0.01953125,0.018554688,0.017578125,0.016601562,0.015625,0.0146484375,0.013671875,0.0126953125,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.09765625,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.25390625,0.28515625,0.31640625,0.34765625,0.37890625,0.41015625,0.44140625,0.47265625,0.53515625,0.59765625,0.66015625,0.72265625,0.78515625,0.84765625,0.91015625,0.97265625,1.0976562,1.2226562,1.3476562,1.4726562,1.5976562,1.7226562,1.8476562,1.9726562,2.2226562,2.4726562,2.7226562,2.9726562,3.2226562,3.4726562,3.7226562,3.9726562,4.4726562,4.9726562,5.4726562,5.9726562,6.4726562,6.9726562,7.4726562,7.9726562,8.972656,9.972656,10.972656,11.972656,12.972656,13.972656,14.972656,15.972656,17.972656,19.972656,21.972656,23.972656,25.972656,27.972656,29.972656,31.972656,35.972656,39.972656,43.972656,47.972656,51.972656,55.972656,59.972656,63.972656,71.97266,79.97266,87.97266,95.97266,103.97266,111.97266,119.97266,127.97266,143.97266,159.97266,175.97266,191.97266,207.97266,223.97266,239.97266,255.97266,287.97266,319.97266,351.97266,383.97266,415.97266,447.97266,479.97266,0.029296875,0.038085938,0.0390625,0.040039062,0.041015625,0.041992188,0.04296875,0.043945312,0.044921875,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.060546875,0.064453125,0.068359375,0.072265625,0.076171875,0.080078125,0.083984375,0.087890625,0.091796875,0.099609375,0.107421875,0.115234375,0.123046875,0.13085938,0.13867188,0.14648438,0.15429688,0.16992188,0.18554688,0.20117188,0.21679688,0.23242188,0.24804688,0.26367188,0.27929688,0.31054688,0.34179688,0.37304688,0.40429688,0.43554688,0.46679688,0.49804688,0.5292969,0.5917969,0.6542969,0.7167969,0.7792969,0.8417969,0.9042969,0.9667969,1.0292969,1.1542969,1.2792969,1.4042969,1.5292969,1.6542969,1.7792969,1.9042969,2.0292969,2.2792969,2.5292969,2.7792969,3.0292969,3.2792969,3.5292969,3.7792969,4.029297,4.529297,5.029297,5.529297,6.029297,6.529297,7.029297,7.529297,8.029297,9.029297,10.029297,11.029297,12.029297,13.029297,14.029297,15.029297,16.029297,18.029297,20.029297,22.029297,24.029297,26.029297,28.029297,30.029297,32.029297,36.029297,40.029297,44.029297,48.029297,52.029297,56.029297,60.029297,64.0293,72.0293,80.0293,88.0293,96.0293,104.0293,112.0293,120.0293,128.0293,144.0293,160.0293,176.0293,192.0293,208.0293,224.0293,240.0293,256.0293,288.0293,320.0293,352.0293,384.0293,416.0293,448.0293,480.0293,0.021484375,0.020507812,0.01953125,0.018554688,0.017578125,0.016601562,0.015625,0.0146484375,0.013671875,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.001953125,0.005859375,0.009765625,0.013671875,0.017578125,0.021484375,0.025390625,0.029296875,0.033203125,0.041015625,0.048828125,0.056640625,0.064453125,0.072265625,0.080078125,0.087890625,0.095703125,0.111328125,0.12695312,0.14257812,0.15820312,0.17382812,0.18945312,0.20507812,0.22070312,0.25195312,0.28320312,0.31445312,0.34570312,0.37695312,0.40820312,0.43945312,0.47070312,0.5332031,0.5957031,0.6582031,0.7207031,0.7832031,0.8457031,0.9082031,0.9707031,1.0957031,1.2207031,1.3457031,1.4707031,1.5957031,1.7207031,1.8457031,1.9707031,2.2207031,2.4707031,2.7207031,2.9707031,3.2207031,3.4707031,3.7207031,3.9707031,4.470703,4.970703,5.470703,5.970703,6.470703,6.970703,7.470703,7.970703,8.970703,9.970703,10.970703,11.970703,12.970703,13.970703,14.970703,15.970703,17.970703,19.970703,21.970703,23.970703,25.970703,27.970703,29.970703,31.970703,35.970703,39.970703,43.970703,47.970703,51.970703,55.970703,59.970703,63.970703,71.9707,79.9707,87.9707,95.9707,103.9707,111.9707,119.9707,127.9707,143.9707,159.9707,175.9707,191.9707,207.9707,223.9707,239.9707,255.9707,287.9707,319.9707,351.9707,383.9707,415.9707,447.9707,479.9707,0.03125,0.040039062,0.041015625,0.041992188,0.04296875,0.043945312,0.044921875,0.045898438,0.046875,0.048828125,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.060546875,0.0625,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,3.78125,4.03125,4.53125,5.03125,5.53125,6.03125,6.53125,7.03125,7.53125,8.03125,9.03125,10.03125,11.03125,12.03125,13.03125,14.03125,15.03125,16.03125,18.03125,20.03125,22.03125,24.03125,26.03125,28.03125,30.03125,32.03125,36.03125,40.03125,44.03125,48.03125,52.03125,56.03125,60.03125,64.03125,72.03125,80.03125,88.03125,96.03125,104.03125,112.03125,120.03125,128.03125,144.03125,160.03125,176.03125,192.03125,208.03125,224.03125,240.03125,256.03125,288.03125,320.03125,352.03125,384.03125,416.03125,448.03125,480.03125,0.0234375,0.022460938,0.021484375,0.020507812,0.01953125,0.018554688,0.017578125,0.016601562,0.015625,0.013671875,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0.001953125,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.21875,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,3.96875,4.46875,4.96875,5.46875,5.96875,6.46875,6.96875,7.46875,7.96875,8.96875,9.96875,10.96875,11.96875,12.96875,13.96875,14.96875,15.96875,17.96875,19.96875,21.96875,23.96875,25.96875,27.96875,29.96875,31.96875,35.96875,39.96875,43.96875,47.96875,51.96875,55.96875,59.96875,63.96875,71.96875,79.96875,87.96875,95.96875,103.96875,111.96875,119.96875,127.96875,143.96875,159.96875,175.96875,191.96875,207.96875,223.96875,239.96875,255.96875,287.96875,319.96875,351.96875,383.96875,415.96875,447.96875,479.96875,0.03515625,0.043945312,0.044921875,0.045898438,0.046875,0.047851562,0.048828125,0.049804688,0.05078125,0.052734375,0.0546875,0.056640625,0.05859375,0.060546875,0.0625,0.064453125,0.06640625,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.25390625,0.26953125,0.28515625,0.31640625,0.34765625,0.37890625,0.41015625,0.44140625,0.47265625,0.50390625,0.53515625,0.59765625,0.66015625,0.72265625,0.78515625,0.84765625,0.91015625,0.97265625,1.0351562,1.1601562,1.2851562,1.4101562,1.5351562,1.6601562,1.7851562,1.9101562,2.0351562,2.2851562,2.5351562,2.7851562,3.0351562,3.2851562,3.5351562,3.7851562,4.0351562,4.5351562,5.0351562,5.5351562,6.0351562,6.5351562,7.0351562,7.5351562,8.035156,9.035156,10.035156,11.035156,12.035156,13.035156,14.035156,15.035156,16.035156,18.035156,20.035156,22.035156,24.035156,26.035156,28.035156,30.035156,32.035156,36.035156,40.035156,44.035156,48.035156,52.035156,56.035156,60.035156,64.03516,72.03516,80.03516,88.03516,96.03516,104.03516,112.03516,120.03516,128.03516,144.03516,160.03516,176.03516,192.03516,208.03516,224.03516,240.03516,256.03516,288.03516,320.03516,352.03516,384.03516,416.03516,448.03516,480.03516,0.02734375,0.026367188,0.025390625,0.024414062,0.0234375,0.022460938,0.021484375,0.020507812,0.01953125,0.017578125,0.015625,0.013671875,0.01171875,0.009765625,0.0078125,0.005859375,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.08984375,0.10546875,0.12109375,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.21484375,0.24609375,0.27734375,0.30859375,0.33984375,0.37109375,0.40234375,0.43359375,0.46484375,0.52734375,0.58984375,0.65234375,0.71484375,0.77734375,0.83984375,0.90234375,0.96484375,1.0898438,1.2148438,1.3398438,1.4648438,1.5898438,1.7148438,1.8398438,1.9648438,2.2148438,2.4648438,2.7148438,2.9648438,3.2148438,3.4648438,3.7148438,3.9648438,4.4648438,4.9648438,5.4648438,5.9648438,6.4648438,6.9648438,7.4648438,7.9648438,8.964844,9.964844,10.964844,11.964844,12.964844,13.964844,14.964844,15.964844,17.964844,19.964844,21.964844,23.964844,25.964844,27.964844,29.964844,31.964844,35.964844,39.964844,43.964844,47.964844,51.964844,55.964844,59.964844,63.964844,71.96484,79.96484,87.96484,95.96484,103.96484,111.96484,119.96484,127.96484,143.96484,159.96484,175.96484,191.96484,207.96484,223.96484,239.96484,255.96484,287.96484,319.96484,351.96484,383.96484,415.96484,447.96484,479.96484,0.0390625,0.047851562,0.048828125,0.049804688,0.05078125,0.051757812,0.052734375,0.053710938,0.0546875,0.056640625,0.05859375,0.060546875,0.0625,0.064453125,0.06640625,0.068359375,0.0703125,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5078125,0.5390625,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,0.9765625,1.0390625,1.1640625,1.2890625,1.4140625,1.5390625,1.6640625,1.7890625,1.9140625,2.0390625,2.2890625,2.5390625,2.7890625,3.0390625,3.2890625,3.5390625,3.7890625,4.0390625,4.5390625,5.0390625,5.5390625,6.0390625,6.5390625,7.0390625,7.5390625,8.0390625,9.0390625,10.0390625,11.0390625,12.0390625,13.0390625,14.0390625,15.0390625,16.039062,18.039062,20.039062,22.039062,24.039062,26.039062,28.039062,30.039062,32.039062,36.039062,40.039062,44.039062,48.039062,52.039062,56.039062,60.039062,64.03906,72.03906,80.03906,88.03906,96.03906,104.03906,112.03906,120.03906,128.03906,144.03906,160.03906,176.03906,192.03906,208.03906,224.03906,240.03906,256.03906,288.03906,320.03906,352.03906,384.03906,416.03906,448.03906,480.03906,0.03125,0.030273438,0.029296875,0.028320312,0.02734375,0.026367188,0.025390625,0.024414062,0.0234375,0.021484375,0.01953125,0.017578125,0.015625,0.013671875,0.01171875,0.009765625,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2109375,0.2421875,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.5234375,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,0.9609375,1.0859375,1.2109375,1.3359375,1.4609375,1.5859375,1.7109375,1.8359375,1.9609375,2.2109375,2.4609375,2.7109375,2.9609375,3.2109375,3.4609375,3.7109375,3.9609375,4.4609375,4.9609375,5.4609375,5.9609375,6.4609375,6.9609375,7.4609375,7.9609375,8.9609375,9.9609375,10.9609375,11.9609375,12.9609375,13.9609375,14.9609375,15.9609375,17.960938,19.960938,21.960938,23.960938,25.960938,27.960938,29.960938,31.960938,35.960938,39.960938,43.960938,47.960938,51.960938,55.960938,59.960938,63.960938,71.96094,79.96094,87.96094,95.96094,103.96094,111.96094,119.96094,127.96094,143.96094,159.96094,175.96094,191.96094,207.96094,223.96094,239.96094,255.96094,287.96094,319.96094,351.96094,383.96094,415.96094,447.96094,479.96094,0.04296875,0.051757812,0.052734375,0.053710938,0.0546875,0.055664062,0.056640625,0.057617188,0.05859375,0.060546875,0.0625,0.064453125,0.06640625,0.068359375,0.0703125,0.072265625,0.07421875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.16796875,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.27734375,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.48046875,0.51171875,0.54296875,0.60546875,0.66796875,0.73046875,0.79296875,0.85546875,0.91796875,0.98046875,1.0429688,1.1679688,1.2929688,1.4179688,1.5429688,1.6679688,1.7929688,1.9179688,2.0429688,2.2929688,2.5429688,2.7929688,3.0429688,3.2929688,3.5429688,3.7929688,4.0429688,4.5429688,5.0429688,5.5429688,6.0429688,6.5429688,7.0429688,7.5429688,8.042969,9.042969,10.042969,11.042969,12.042969,13.042969,14.042969,15.042969,16.042969,18.042969,20.042969,22.042969,24.042969,26.042969,28.042969,30.042969,32.04297,36.04297,40.04297,44.04297,48.04297,52.04297,56.04297,60.04297,64.04297,72.04297,80.04297,88.04297,96.04297,104.04297,112.04297,120.04297,128.04297,144.04297,160.04297,176.04297,192.04297,208.04297,224.04297,240.04297,256.04297,288.04297,320.04297,352.04297,384.04297,416.04297,448.04297,480.04297,0.03515625,0.034179688,0.033203125,0.032226562,0.03125,0.030273438,0.029296875,0.028320312,0.02734375,0.025390625,0.0234375,0.021484375,0.01953125,0.017578125,0.015625,0.013671875,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.01953125,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08203125,0.09765625,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.20703125,0.23828125,0.26953125,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.51953125,0.58203125,0.64453125,0.70703125,0.76953125,0.83203125,0.89453125,0.95703125,1.0820312,1.2070312,1.3320312,1.4570312,1.5820312,1.7070312,1.8320312,1.9570312,2.2070312,2.4570312,2.7070312,2.9570312,3.2070312,3.4570312,3.7070312,3.9570312,4.4570312,4.9570312,5.4570312,5.9570312,6.4570312,6.9570312,7.4570312,7.9570312,8.957031,9.957031,10.957031,11.957031,12.957031,13.957031,14.957031,15.957031,17.957031,19.957031,21.957031,23.957031,25.957031,27.957031,29.957031,31.957031,35.95703,39.95703,43.95703,47.95703,51.95703,55.95703,59.95703,63.95703,71.95703,79.95703,87.95703,95.95703,103.95703,111.95703,119.95703,127.95703,143.95703,159.95703,175.95703,191.95703,207.95703,223.95703,239.95703,255.95703,287.95703,319.95703,351.95703,383.95703,415.95703,447.95703,479.95703,0.046875,0.055664062,0.056640625,0.057617188,0.05859375,0.059570312,0.060546875,0.061523438,0.0625,0.064453125,0.06640625,0.068359375,0.0703125,0.072265625,0.07421875,0.076171875,0.078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,1.921875,2.046875,2.296875,2.546875,2.796875,3.046875,3.296875,3.546875,3.796875,4.046875,4.546875,5.046875,5.546875,6.046875,6.546875,7.046875,7.546875,8.046875,9.046875,10.046875,11.046875,12.046875,13.046875,14.046875,15.046875,16.046875,18.046875,20.046875,22.046875,24.046875,26.046875,28.046875,30.046875,32.046875,36.046875,40.046875,44.046875,48.046875,52.046875,56.046875,60.046875,64.046875,72.046875,80.046875,88.046875,96.046875,104.046875,112.046875,120.046875,128.04688,144.04688,160.04688,176.04688,192.04688,208.04688,224.04688,240.04688,256.04688,288.04688,320.04688,352.04688,384.04688,416.04688,448.04688,480.04688,0.0390625,0.038085938,0.037109375,0.036132812,0.03515625,0.034179688,0.033203125,0.032226562,0.03125,0.029296875,0.02734375,0.025390625,0.0234375,0.021484375,0.01953125,0.017578125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.078125,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,1.953125,2.203125,2.453125,2.703125,2.953125,3.203125,3.453125,3.703125,3.953125,4.453125,4.953125,5.453125,5.953125,6.453125,6.953125,7.453125,7.953125,8.953125,9.953125,10.953125,11.953125,12.953125,13.953125,14.953125,15.953125,17.953125,19.953125,21.953125,23.953125,25.953125,27.953125,29.953125,31.953125,35.953125,39.953125,43.953125,47.953125,51.953125,55.953125,59.953125,63.953125,71.953125,79.953125,87.953125,95.953125,103.953125,111.953125,119.953125,127.953125,143.95312,159.95312,175.95312,191.95312,207.95312,223.95312,239.95312,255.95312,287.95312,319.95312,351.95312,383.95312,415.95312,447.95312,479.95312,0.05078125,0.059570312,0.060546875,0.061523438,0.0625,0.06347656,0.064453125,0.06542969,0.06640625,0.068359375,0.0703125,0.072265625,0.07421875,0.076171875,0.078125,0.080078125,0.08203125,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.16796875,0.17578125,0.19140625,0.20703125,0.22265625,0.23828125,0.25390625,0.26953125,0.28515625,0.30078125,0.33203125,0.36328125,0.39453125,0.42578125,0.45703125,0.48828125,0.51953125,0.55078125,0.61328125,0.67578125,0.73828125,0.80078125,0.86328125,0.92578125,0.98828125,1.0507812,1.1757812,1.3007812,1.4257812,1.5507812,1.6757812,1.8007812,1.9257812,2.0507812,2.3007812,2.5507812,2.8007812,3.0507812,3.3007812,3.5507812,3.8007812,4.0507812,4.5507812,5.0507812,5.5507812,6.0507812,6.5507812,7.0507812,7.5507812,8.050781,9.050781,10.050781,11.050781,12.050781,13.050781,14.050781,15.050781,16.050781,18.050781,20.050781,22.050781,24.050781,26.050781,28.050781,30.050781,32.05078,36.05078,40.05078,44.05078,48.05078,52.05078,56.05078,60.05078,64.05078,72.05078,80.05078,88.05078,96.05078,104.05078,112.05078,120.05078,128.05078,144.05078,160.05078,176.05078,192.05078,208.05078,224.05078,240.05078,256.05078,288.05078,320.05078,352.05078,384.05078,416.05078,448.05078,480.05078,0.04296875,0.041992188,0.041015625,0.040039062,0.0390625,0.038085938,0.037109375,0.036132812,0.03515625,0.033203125,0.03125,0.029296875,0.02734375,0.025390625,0.0234375,0.021484375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.01171875,0.01953125,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.07421875,0.08984375,0.10546875,0.12109375,0.13671875,0.15234375,0.16796875,0.18359375,0.19921875,0.23046875,0.26171875,0.29296875,0.32421875,0.35546875,0.38671875,0.41796875,0.44921875,0.51171875,0.57421875,0.63671875,0.69921875,0.76171875,0.82421875,0.88671875,0.94921875,1.0742188,1.1992188,1.3242188,1.4492188,1.5742188,1.6992188,1.8242188,1.9492188,2.1992188,2.4492188,2.6992188,2.9492188,3.1992188,3.4492188,3.6992188,3.9492188,4.4492188,4.9492188,5.4492188,5.9492188,6.4492188,6.9492188,7.4492188,7.9492188,8.949219,9.949219,10.949219,11.949219,12.949219,13.949219,14.949219,15.949219,17.949219,19.949219,21.949219,23.949219,25.949219,27.949219,29.949219,31.949219,35.94922,39.94922,43.94922,47.94922,51.94922,55.94922,59.94922,63.94922,71.94922,79.94922,87.94922,95.94922,103.94922,111.94922,119.94922,127.94922,143.94922,159.94922,175.94922,191.94922,207.94922,223.94922,239.94922,255.94922,287.94922,319.94922,351.94922,383.94922,415.94922,447.94922,479.94922,0.0546875,0.06347656,0.064453125,0.06542969,0.06640625,0.06738281,0.068359375,0.06933594,0.0703125,0.072265625,0.07421875,0.076171875,0.078125,0.080078125,0.08203125,0.083984375,0.0859375,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5546875,0.6171875,0.6796875,0.7421875,0.8046875,0.8671875,0.9296875,0.9921875,1.0546875,1.1796875,1.3046875,1.4296875,1.5546875,1.6796875,1.8046875,1.9296875,2.0546875,2.3046875,2.5546875,2.8046875,3.0546875,3.3046875,3.5546875,3.8046875,4.0546875,4.5546875,5.0546875,5.5546875,6.0546875,6.5546875,7.0546875,7.5546875,8.0546875,9.0546875,10.0546875,11.0546875,12.0546875,13.0546875,14.0546875,15.0546875,16.054688,18.054688,20.054688,22.054688,24.054688,26.054688,28.054688,30.054688,32.054688,36.054688,40.054688,44.054688,48.054688,52.054688,56.054688,60.054688,64.05469,72.05469,80.05469,88.05469,96.05469,104.05469,112.05469,120.05469,128.05469,144.05469,160.05469,176.05469,192.05469,208.05469,224.05469,240.05469,256.0547,288.0547,320.0547,352.0547,384.0547,416.0547,448.0547,480.0547,0.046875,0.045898438,0.044921875,0.043945312,0.04296875,0.041992188,0.041015625,0.040039062,0.0390625,0.037109375,0.03515625,0.033203125,0.03125,0.029296875,0.02734375,0.025390625,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.1953125,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.5078125,0.5703125,0.6328125,0.6953125,0.7578125,0.8203125,0.8828125,0.9453125,1.0703125,1.1953125,1.3203125,1.4453125,1.5703125,1.6953125,1.8203125,1.9453125,2.1953125,2.4453125,2.6953125,2.9453125,3.1953125,3.4453125,3.6953125,3.9453125,4.4453125,4.9453125,5.4453125,5.9453125,6.4453125,6.9453125,7.4453125,7.9453125,8.9453125,9.9453125,10.9453125,11.9453125,12.9453125,13.9453125,14.9453125,15.9453125,17.945312,19.945312,21.945312,23.945312,25.945312,27.945312,29.945312,31.945312,35.945312,39.945312,43.945312,47.945312,51.945312,55.945312,59.945312,63.945312,71.94531,79.94531,87.94531,95.94531,103.94531,111.94531,119.94531,127.94531,143.94531,159.94531,175.94531,191.94531,207.94531,223.94531,239.94531,255.94531,287.9453,319.9453,351.9453,383.9453,415.9453,447.9453,479.9453,0.05859375,0.06738281,0.068359375,0.06933594,0.0703125,0.07128906,0.072265625,0.07324219,0.07421875,0.076171875,0.078125,0.080078125,0.08203125,0.083984375,0.0859375,0.087890625,0.08984375,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.12109375,0.12890625,0.13671875,0.14453125,0.15234375,0.16015625,0.16796875,0.17578125,0.18359375,0.19921875,0.21484375,0.23046875,0.24609375,0.26171875,0.27734375,0.29296875,0.30859375,0.33984375,0.37109375,0.40234375,0.43359375,0.46484375,0.49609375,0.52734375,0.55859375,0.62109375,0.68359375,0.74609375,0.80859375,0.87109375,0.93359375,0.99609375,1.0585938,1.1835938,1.3085938,1.4335938,1.5585938,1.6835938,1.8085938,1.9335938,2.0585938,2.3085938,2.5585938,2.8085938,3.0585938,3.3085938,3.5585938,3.8085938,4.0585938,4.5585938,5.0585938,5.5585938,6.0585938,6.5585938,7.0585938,7.5585938,8.058594,9.058594,10.058594,11.058594,12.058594,13.058594,14.058594,15.058594,16.058594,18.058594,20.058594,22.058594,24.058594,26.058594,28.058594,30.058594,32.058594,36.058594,40.058594,44.058594,48.058594,52.058594,56.058594,60.058594,64.05859,72.05859,80.05859,88.05859,96.05859,104.05859,112.05859,120.05859,128.0586,144.0586,160.0586,176.0586,192.0586,208.0586,224.0586,240.0586,256.0586,288.0586,320.0586,352.0586,384.0586,416.0586,448.0586,480.0586,0.05078125,0.049804688,0.048828125,0.047851562,0.046875,0.045898438,0.044921875,0.043945312,0.04296875,0.041015625,0.0390625,0.037109375,0.03515625,0.033203125,0.03125,0.029296875,0.02734375,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.00390625,0.01171875,0.01953125,0.02734375,0.03515625,0.04296875,0.05078125,0.05859375,0.06640625,0.08203125,0.09765625,0.11328125,0.12890625,0.14453125,0.16015625,0.17578125,0.19140625,0.22265625,0.25390625,0.28515625,0.31640625,0.34765625,0.37890625,0.41015625,0.44140625,0.50390625,0.56640625,0.62890625,0.69140625,0.75390625,0.81640625,0.87890625,0.94140625,1.0664062,1.1914062,1.3164062,1.4414062,1.5664062,1.6914062,1.8164062,1.9414062,2.1914062,2.4414062,2.6914062,2.9414062,3.1914062,3.4414062,3.6914062,3.9414062,4.4414062,4.9414062,5.4414062,5.9414062,6.4414062,6.9414062,7.4414062,7.9414062,8.941406,9.941406,10.941406,11.941406,12.941406,13.941406,14.941406,15.941406,17.941406,19.941406,21.941406,23.941406,25.941406,27.941406,29.941406,31.941406,35.941406,39.941406,43.941406,47.941406,51.941406,55.941406,59.941406,63.941406,71.94141,79.94141,87.94141,95.94141,103.94141,111.94141,119.94141,127.94141,143.9414,159.9414,175.9414,191.9414,207.9414,223.9414,239.9414,255.9414,287.9414,319.9414,351.9414,383.9414,415.9414,447.9414,479.9414,0.0625,0.07128906,0.072265625,0.07324219,0.07421875,0.07519531,0.076171875,0.07714844,0.078125,0.080078125,0.08203125,0.083984375,0.0859375,0.087890625,0.08984375,0.091796875,0.09375,0.09765625,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.12109375,0.125,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.625,0.6875,0.75,0.8125,0.875,0.9375,1,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,7.5625,8.0625,9.0625,10.0625,11.0625,12.0625,13.0625,14.0625,15.0625,16.0625,18.0625,20.0625,22.0625,24.0625,26.0625,28.0625,30.0625,32.0625,36.0625,40.0625,44.0625,48.0625,52.0625,56.0625,60.0625,64.0625,72.0625,80.0625,88.0625,96.0625,104.0625,112.0625,120.0625,128.0625,144.0625,160.0625,176.0625,192.0625,208.0625,224.0625,240.0625,256.0625,288.0625,320.0625,352.0625,384.0625,416.0625,448.0625,480.0625,0.0546875,0.053710938,0.052734375,0.051757812,0.05078125,0.049804688,0.048828125,0.047851562,0.046875,0.044921875,0.04296875,0.041015625,0.0390625,0.037109375,0.03515625,0.033203125,0.03125,0.02734375,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0.00390625,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.875,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.4375,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,7.9375,8.9375,9.9375,10.9375,11.9375,12.9375,13.9375,14.9375,15.9375,17.9375,19.9375,21.9375,23.9375,25.9375,27.9375,29.9375,31.9375,35.9375,39.9375,43.9375,47.9375,51.9375,55.9375,59.9375,63.9375,71.9375,79.9375,87.9375,95.9375,103.9375,111.9375,119.9375,127.9375,143.9375,159.9375,175.9375,191.9375,207.9375,223.9375,239.9375,255.9375,287.9375,319.9375,351.9375,383.9375,415.9375,447.9375,479.9375,0.0703125,0.07910156,0.080078125,0.08105469,0.08203125,0.08300781,0.083984375,0.08496094,0.0859375,0.087890625,0.08984375,0.091796875,0.09375,0.095703125,0.09765625,0.099609375,0.1015625,0.10546875,0.109375,0.11328125,0.1171875,0.12109375,0.125,0.12890625,0.1328125,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5078125,0.5390625,0.5703125,0.6328125,0.6953125,0.7578125,0.8203125,0.8828125,0.9453125,1.0078125,1.0703125,1.1953125,1.3203125,1.4453125,1.5703125,1.6953125,1.8203125,1.9453125,2.0703125,2.3203125,2.5703125,2.8203125,3.0703125,3.3203125,3.5703125,3.8203125,4.0703125,4.5703125,5.0703125,5.5703125,6.0703125,6.5703125,7.0703125,7.5703125,8.0703125,9.0703125,10.0703125,11.0703125,12.0703125,13.0703125,14.0703125,15.0703125,16.070312,18.070312,20.070312,22.070312,24.070312,26.070312,28.070312,30.070312,32.070312,36.070312,40.070312,44.070312,48.070312,52.070312,56.070312,60.070312,64.07031,72.07031,80.07031,88.07031,96.07031,104.07031,112.07031,120.07031,128.07031,144.07031,160.07031,176.07031,192.07031,208.07031,224.07031,240.07031,256.0703,288.0703,320.0703,352.0703,384.0703,416.0703,448.0703,480.0703,0.0625,0.061523438,0.060546875,0.059570312,0.05859375,0.057617188,0.056640625,0.055664062,0.0546875,0.052734375,0.05078125,0.048828125,0.046875,0.044921875,0.04296875,0.041015625,0.0390625,0.03515625,0.03125,0.02734375,0.0234375,0.01953125,0.015625,0.01171875,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1796875,0.2109375,0.2421875,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4296875,0.4921875,0.5546875,0.6171875,0.6796875,0.7421875,0.8046875,0.8671875,0.9296875,1.0546875,1.1796875,1.3046875,1.4296875,1.5546875,1.6796875,1.8046875,1.9296875,2.1796875,2.4296875,2.6796875,2.9296875,3.1796875,3.4296875,3.6796875,3.9296875,4.4296875,4.9296875,5.4296875,5.9296875,6.4296875,6.9296875,7.4296875,7.9296875,8.9296875,9.9296875,10.9296875,11.9296875,12.9296875,13.9296875,14.9296875,15.9296875,17.929688,19.929688,21.929688,23.929688,25.929688,27.929688,29.929688,31.929688,35.929688,39.929688,43.929688,47.929688,51.929688,55.929688,59.929688,63.929688,71.92969,79.92969,87.92969,95.92969,103.92969,111.92969,119.92969,127.92969,143.92969,159.92969,175.92969,191.92969,207.92969,223.92969,239.92969,255.92969,287.9297,319.9297,351.9297,383.9297,415.9297,447.9297,479.9297,0.078125,0.08691406,0.087890625,0.08886719,0.08984375,0.09082031,0.091796875,0.09277344,0.09375,0.095703125,0.09765625,0.099609375,0.1015625,0.103515625,0.10546875,0.107421875,0.109375,0.11328125,0.1171875,0.12109375,0.125,0.12890625,0.1328125,0.13671875,0.140625,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.078125,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,1.953125,2.078125,2.328125,2.578125,2.828125,3.078125,3.328125,3.578125,3.828125,4.078125,4.578125,5.078125,5.578125,6.078125,6.578125,7.078125,7.578125,8.078125,9.078125,10.078125,11.078125,12.078125,13.078125,14.078125,15.078125,16.078125,18.078125,20.078125,22.078125,24.078125,26.078125,28.078125,30.078125,32.078125,36.078125,40.078125,44.078125,48.078125,52.078125,56.078125,60.078125,64.078125,72.078125,80.078125,88.078125,96.078125,104.078125,112.078125,120.078125,128.07812,144.07812,160.07812,176.07812,192.07812,208.07812,224.07812,240.07812,256.07812,288.07812,320.07812,352.07812,384.07812,416.07812,448.07812,480.07812,0.0703125,0.06933594,0.068359375,0.06738281,0.06640625,0.06542969,0.064453125,0.06347656,0.0625,0.060546875,0.05859375,0.056640625,0.0546875,0.052734375,0.05078125,0.048828125,0.046875,0.04296875,0.0390625,0.03515625,0.03125,0.02734375,0.0234375,0.01953125,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.421875,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,1.046875,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,1.921875,2.171875,2.421875,2.671875,2.921875,3.171875,3.421875,3.671875,3.921875,4.421875,4.921875,5.421875,5.921875,6.421875,6.921875,7.421875,7.921875,8.921875,9.921875,10.921875,11.921875,12.921875,13.921875,14.921875,15.921875,17.921875,19.921875,21.921875,23.921875,25.921875,27.921875,29.921875,31.921875,35.921875,39.921875,43.921875,47.921875,51.921875,55.921875,59.921875,63.921875,71.921875,79.921875,87.921875,95.921875,103.921875,111.921875,119.921875,127.921875,143.92188,159.92188,175.92188,191.92188,207.92188,223.92188,239.92188,255.92188,287.92188,319.92188,351.92188,383.92188,415.92188,447.92188,479.92188,0.0859375,0.09472656,0.095703125,0.09667969,0.09765625,0.09863281,0.099609375,0.10058594,0.1015625,0.103515625,0.10546875,0.107421875,0.109375,0.111328125,0.11328125,0.115234375,0.1171875,0.12109375,0.125,0.12890625,0.1328125,0.13671875,0.140625,0.14453125,0.1484375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3359375,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5546875,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,0.9609375,1.0234375,1.0859375,1.2109375,1.3359375,1.4609375,1.5859375,1.7109375,1.8359375,1.9609375,2.0859375,2.3359375,2.5859375,2.8359375,3.0859375,3.3359375,3.5859375,3.8359375,4.0859375,4.5859375,5.0859375,5.5859375,6.0859375,6.5859375,7.0859375,7.5859375,8.0859375,9.0859375,10.0859375,11.0859375,12.0859375,13.0859375,14.0859375,15.0859375,16.085938,18.085938,20.085938,22.085938,24.085938,26.085938,28.085938,30.085938,32.085938,36.085938,40.085938,44.085938,48.085938,52.085938,56.085938,60.085938,64.08594,72.08594,80.08594,88.08594,96.08594,104.08594,112.08594,120.08594,128.08594,144.08594,160.08594,176.08594,192.08594,208.08594,224.08594,240.08594,256.08594,288.08594,320.08594,352.08594,384.08594,416.08594,448.08594,480.08594,0.078125,0.07714844,0.076171875,0.07519531,0.07421875,0.07324219,0.072265625,0.07128906,0.0703125,0.068359375,0.06640625,0.064453125,0.0625,0.060546875,0.05859375,0.056640625,0.0546875,0.05078125,0.046875,0.04296875,0.0390625,0.03515625,0.03125,0.02734375,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.0390625,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1640625,0.1953125,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4140625,0.4765625,0.5390625,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,1.0390625,1.1640625,1.2890625,1.4140625,1.5390625,1.6640625,1.7890625,1.9140625,2.1640625,2.4140625,2.6640625,2.9140625,3.1640625,3.4140625,3.6640625,3.9140625,4.4140625,4.9140625,5.4140625,5.9140625,6.4140625,6.9140625,7.4140625,7.9140625,8.9140625,9.9140625,10.9140625,11.9140625,12.9140625,13.9140625,14.9140625,15.9140625,17.914062,19.914062,21.914062,23.914062,25.914062,27.914062,29.914062,31.914062,35.914062,39.914062,43.914062,47.914062,51.914062,55.914062,59.914062,63.914062,71.91406,79.91406,87.91406,95.91406,103.91406,111.91406,119.91406,127.91406,143.91406,159.91406,175.91406,191.91406,207.91406,223.91406,239.91406,255.91406,287.91406,319.91406,351.91406,383.91406,415.91406,447.91406,479.91406,0.09375,0.10253906,0.103515625,0.10449219,0.10546875,0.10644531,0.107421875,0.10839844,0.109375,0.111328125,0.11328125,0.115234375,0.1171875,0.119140625,0.12109375,0.123046875,0.125,0.12890625,0.1328125,0.13671875,0.140625,0.14453125,0.1484375,0.15234375,0.15625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,3.84375,4.09375,4.59375,5.09375,5.59375,6.09375,6.59375,7.09375,7.59375,8.09375,9.09375,10.09375,11.09375,12.09375,13.09375,14.09375,15.09375,16.09375,18.09375,20.09375,22.09375,24.09375,26.09375,28.09375,30.09375,32.09375,36.09375,40.09375,44.09375,48.09375,52.09375,56.09375,60.09375,64.09375,72.09375,80.09375,88.09375,96.09375,104.09375,112.09375,120.09375,128.09375,144.09375,160.09375,176.09375,192.09375,208.09375,224.09375,240.09375,256.09375,288.09375,320.09375,352.09375,384.09375,416.09375,448.09375,480.09375,0.0859375,0.08496094,0.083984375,0.08300781,0.08203125,0.08105469,0.080078125,0.07910156,0.078125,0.076171875,0.07421875,0.072265625,0.0703125,0.068359375,0.06640625,0.064453125,0.0625,0.05859375,0.0546875,0.05078125,0.046875,0.04296875,0.0390625,0.03515625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.15625,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,3.90625,4.40625,4.90625,5.40625,5.90625,6.40625,6.90625,7.40625,7.90625,8.90625,9.90625,10.90625,11.90625,12.90625,13.90625,14.90625,15.90625,17.90625,19.90625,21.90625,23.90625,25.90625,27.90625,29.90625,31.90625,35.90625,39.90625,43.90625,47.90625,51.90625,55.90625,59.90625,63.90625,71.90625,79.90625,87.90625,95.90625,103.90625,111.90625,119.90625,127.90625,143.90625,159.90625,175.90625,191.90625,207.90625,223.90625,239.90625,255.90625,287.90625,319.90625,351.90625,383.90625,415.90625,447.90625,479.90625,0.1015625,0.11035156,0.111328125,0.11230469,0.11328125,0.11425781,0.115234375,0.11621094,0.1171875,0.119140625,0.12109375,0.123046875,0.125,0.12695312,0.12890625,0.13085938,0.1328125,0.13671875,0.140625,0.14453125,0.1484375,0.15234375,0.15625,0.16015625,0.1640625,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3359375,0.3515625,0.3828125,0.4140625,0.4453125,0.4765625,0.5078125,0.5390625,0.5703125,0.6015625,0.6640625,0.7265625,0.7890625,0.8515625,0.9140625,0.9765625,1.0390625,1.1015625,1.2265625,1.3515625,1.4765625,1.6015625,1.7265625,1.8515625,1.9765625,2.1015625,2.3515625,2.6015625,2.8515625,3.1015625,3.3515625,3.6015625,3.8515625,4.1015625,4.6015625,5.1015625,5.6015625,6.1015625,6.6015625,7.1015625,7.6015625,8.1015625,9.1015625,10.1015625,11.1015625,12.1015625,13.1015625,14.1015625,15.1015625,16.101562,18.101562,20.101562,22.101562,24.101562,26.101562,28.101562,30.101562,32.101562,36.101562,40.101562,44.101562,48.101562,52.101562,56.101562,60.101562,64.10156,72.10156,80.10156,88.10156,96.10156,104.10156,112.10156,120.10156,128.10156,144.10156,160.10156,176.10156,192.10156,208.10156,224.10156,240.10156,256.10156,288.10156,320.10156,352.10156,384.10156,416.10156,448.10156,480.10156,0.09375,0.09277344,0.091796875,0.09082031,0.08984375,0.08886719,0.087890625,0.08691406,0.0859375,0.083984375,0.08203125,0.080078125,0.078125,0.076171875,0.07421875,0.072265625,0.0703125,0.06640625,0.0625,0.05859375,0.0546875,0.05078125,0.046875,0.04296875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.0234375,0.0390625,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1484375,0.1796875,0.2109375,0.2421875,0.2734375,0.3046875,0.3359375,0.3671875,0.3984375,0.4609375,0.5234375,0.5859375,0.6484375,0.7109375,0.7734375,0.8359375,0.8984375,1.0234375,1.1484375,1.2734375,1.3984375,1.5234375,1.6484375,1.7734375,1.8984375,2.1484375,2.3984375,2.6484375,2.8984375,3.1484375,3.3984375,3.6484375,3.8984375,4.3984375,4.8984375,5.3984375,5.8984375,6.3984375,6.8984375,7.3984375,7.8984375,8.8984375,9.8984375,10.8984375,11.8984375,12.8984375,13.8984375,14.8984375,15.8984375,17.898438,19.898438,21.898438,23.898438,25.898438,27.898438,29.898438,31.898438,35.898438,39.898438,43.898438,47.898438,51.898438,55.898438,59.898438,63.898438,71.89844,79.89844,87.89844,95.89844,103.89844,111.89844,119.89844,127.89844,143.89844,159.89844,175.89844,191.89844,207.89844,223.89844,239.89844,255.89844,287.89844,319.89844,351.89844,383.89844,415.89844,447.89844,479.89844,0.109375,0.11816406,0.119140625,0.12011719,0.12109375,0.12207031,0.123046875,0.12402344,0.125,0.12695312,0.12890625,0.13085938,0.1328125,0.13476562,0.13671875,0.13867188,0.140625,0.14453125,0.1484375,0.15234375,0.15625,0.16015625,0.1640625,0.16796875,0.171875,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.109375,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,1.984375,2.109375,2.359375,2.609375,2.859375,3.109375,3.359375,3.609375,3.859375,4.109375,4.609375,5.109375,5.609375,6.109375,6.609375,7.109375,7.609375,8.109375,9.109375,10.109375,11.109375,12.109375,13.109375,14.109375,15.109375,16.109375,18.109375,20.109375,22.109375,24.109375,26.109375,28.109375,30.109375,32.109375,36.109375,40.109375,44.109375,48.109375,52.109375,56.109375,60.109375,64.109375,72.109375,80.109375,88.109375,96.109375,104.109375,112.109375,120.109375,128.10938,144.10938,160.10938,176.10938,192.10938,208.10938,224.10938,240.10938,256.10938,288.10938,320.10938,352.10938,384.10938,416.10938,448.10938,480.10938,0.1015625,0.10058594,0.099609375,0.09863281,0.09765625,0.09667969,0.095703125,0.09472656,0.09375,0.091796875,0.08984375,0.087890625,0.0859375,0.083984375,0.08203125,0.080078125,0.078125,0.07421875,0.0703125,0.06640625,0.0625,0.05859375,0.0546875,0.05078125,0.046875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.390625,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.890625,1.015625,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,1.890625,2.140625,2.390625,2.640625,2.890625,3.140625,3.390625,3.640625,3.890625,4.390625,4.890625,5.390625,5.890625,6.390625,6.890625,7.390625,7.890625,8.890625,9.890625,10.890625,11.890625,12.890625,13.890625,14.890625,15.890625,17.890625,19.890625,21.890625,23.890625,25.890625,27.890625,29.890625,31.890625,35.890625,39.890625,43.890625,47.890625,51.890625,55.890625,59.890625,63.890625,71.890625,79.890625,87.890625,95.890625,103.890625,111.890625,119.890625,127.890625,143.89062,159.89062,175.89062,191.89062,207.89062,223.89062,239.89062,255.89062,287.89062,319.89062,351.89062,383.89062,415.89062,447.89062,479.89062,0.1171875,0.12597656,0.12695312,0.12792969,0.12890625,0.12988281,0.13085938,0.13183594,0.1328125,0.13476562,0.13671875,0.13867188,0.140625,0.14257812,0.14453125,0.14648438,0.1484375,0.15234375,0.15625,0.16015625,0.1640625,0.16796875,0.171875,0.17578125,0.1796875,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.2421875,0.2578125,0.2734375,0.2890625,0.3046875,0.3203125,0.3359375,0.3515625,0.3671875,0.3984375,0.4296875,0.4609375,0.4921875,0.5234375,0.5546875,0.5859375,0.6171875,0.6796875,0.7421875,0.8046875,0.8671875,0.9296875,0.9921875,1.0546875,1.1171875,1.2421875,1.3671875,1.4921875,1.6171875,1.7421875,1.8671875,1.9921875,2.1171875,2.3671875,2.6171875,2.8671875,3.1171875,3.3671875,3.6171875,3.8671875,4.1171875,4.6171875,5.1171875,5.6171875,6.1171875,6.6171875,7.1171875,7.6171875,8.1171875,9.1171875,10.1171875,11.1171875,12.1171875,13.1171875,14.1171875,15.1171875,16.117188,18.117188,20.117188,22.117188,24.117188,26.117188,28.117188,30.117188,32.117188,36.117188,40.117188,44.117188,48.117188,52.117188,56.117188,60.117188,64.11719,72.11719,80.11719,88.11719,96.11719,104.11719,112.11719,120.11719,128.11719,144.11719,160.11719,176.11719,192.11719,208.11719,224.11719,240.11719,256.1172,288.1172,320.1172,352.1172,384.1172,416.1172,448.1172,480.1172,0.109375,0.10839844,0.107421875,0.10644531,0.10546875,0.10449219,0.103515625,0.10253906,0.1015625,0.099609375,0.09765625,0.095703125,0.09375,0.091796875,0.08984375,0.087890625,0.0859375,0.08203125,0.078125,0.07421875,0.0703125,0.06640625,0.0625,0.05859375,0.0546875,0.046875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.0078125,0.0234375,0.0390625,0.0546875,0.0703125,0.0859375,0.1015625,0.1171875,0.1328125,0.1640625,0.1953125,0.2265625,0.2578125,0.2890625,0.3203125,0.3515625,0.3828125,0.4453125,0.5078125,0.5703125,0.6328125,0.6953125,0.7578125,0.8203125,0.8828125,1.0078125,1.1328125,1.2578125,1.3828125,1.5078125,1.6328125,1.7578125,1.8828125,2.1328125,2.3828125,2.6328125,2.8828125,3.1328125,3.3828125,3.6328125,3.8828125,4.3828125,4.8828125,5.3828125,5.8828125,6.3828125,6.8828125,7.3828125,7.8828125,8.8828125,9.8828125,10.8828125,11.8828125,12.8828125,13.8828125,14.8828125,15.8828125,17.882812,19.882812,21.882812,23.882812,25.882812,27.882812,29.882812,31.882812,35.882812,39.882812,43.882812,47.882812,51.882812,55.882812,59.882812,63.882812,71.88281,79.88281,87.88281,95.88281,103.88281,111.88281,119.88281,127.88281,143.88281,159.88281,175.88281,191.88281,207.88281,223.88281,239.88281,255.88281,287.8828,319.8828,351.8828,383.8828,415.8828,447.8828,479.8828,0.125,0.13378906,0.13476562,0.13574219,0.13671875,0.13769531,0.13867188,0.13964844,0.140625,0.14257812,0.14453125,0.14648438,0.1484375,0.15039062,0.15234375,0.15429688,0.15625,0.16015625,0.1640625,0.16796875,0.171875,0.17578125,0.1796875,0.18359375,0.1875,0.1953125,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.2421875,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.6875,0.75,0.8125,0.875,0.9375,1,1.0625,1.125,1.25,1.375,1.5,1.625,1.75,1.875,2,2.125,2.375,2.625,2.875,3.125,3.375,3.625,3.875,4.125,4.625,5.125,5.625,6.125,6.625,7.125,7.625,8.125,9.125,10.125,11.125,12.125,13.125,14.125,15.125,16.125,18.125,20.125,22.125,24.125,26.125,28.125,30.125,32.125,36.125,40.125,44.125,48.125,52.125,56.125,60.125,64.125,72.125,80.125,88.125,96.125,104.125,112.125,120.125,128.125,144.125,160.125,176.125,192.125,208.125,224.125,240.125,256.125,288.125,320.125,352.125,384.125,416.125,448.125,480.125,0.1171875,0.11621094,0.115234375,0.11425781,0.11328125,0.11230469,0.111328125,0.11035156,0.109375,0.107421875,0.10546875,0.103515625,0.1015625,0.099609375,0.09765625,0.095703125,0.09375,0.08984375,0.0859375,0.08203125,0.078125,0.07421875,0.0703125,0.06640625,0.0625,0.0546875,0.046875,0.0390625,0.03125,0.0234375,0.015625,0.0078125,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.875,1,1.125,1.25,1.375,1.5,1.625,1.75,1.875,2.125,2.375,2.625,2.875,3.125,3.375,3.625,3.875,4.375,4.875,5.375,5.875,6.375,6.875,7.375,7.875,8.875,9.875,10.875,11.875,12.875,13.875,14.875,15.875,17.875,19.875,21.875,23.875,25.875,27.875,29.875,31.875,35.875,39.875,43.875,47.875,51.875,55.875,59.875,63.875,71.875,79.875,87.875,95.875,103.875,111.875,119.875,127.875,143.875,159.875,175.875,191.875,207.875,223.875,239.875,255.875,287.875,319.875,351.875,383.875,415.875,447.875,479.875,0.140625,0.14941406,0.15039062,0.15136719,0.15234375,0.15332031,0.15429688,0.15527344,0.15625,0.15820312,0.16015625,0.16210938,0.1640625,0.16601562,0.16796875,0.16992188,0.171875,0.17578125,0.1796875,0.18359375,0.1875,0.19140625,0.1953125,0.19921875,0.203125,0.2109375,0.21875,0.2265625,0.234375,0.2421875,0.25,0.2578125,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.078125,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,1.890625,2.015625,2.140625,2.390625,2.640625,2.890625,3.140625,3.390625,3.640625,3.890625,4.140625,4.640625,5.140625,5.640625,6.140625,6.640625,7.140625,7.640625,8.140625,9.140625,10.140625,11.140625,12.140625,13.140625,14.140625,15.140625,16.140625,18.140625,20.140625,22.140625,24.140625,26.140625,28.140625,30.140625,32.140625,36.140625,40.140625,44.140625,48.140625,52.140625,56.140625,60.140625,64.140625,72.140625,80.140625,88.140625,96.140625,104.140625,112.140625,120.140625,128.14062,144.14062,160.14062,176.14062,192.14062,208.14062,224.14062,240.14062,256.14062,288.14062,320.14062,352.14062,384.14062,416.14062,448.14062,480.14062,0.1328125,0.13183594,0.13085938,0.12988281,0.12890625,0.12792969,0.12695312,0.12597656,0.125,0.123046875,0.12109375,0.119140625,0.1171875,0.115234375,0.11328125,0.111328125,0.109375,0.10546875,0.1015625,0.09765625,0.09375,0.08984375,0.0859375,0.08203125,0.078125,0.0703125,0.0625,0.0546875,0.046875,0.0390625,0.03125,0.0234375,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.359375,0.421875,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.859375,0.984375,1.109375,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,2.109375,2.359375,2.609375,2.859375,3.109375,3.359375,3.609375,3.859375,4.359375,4.859375,5.359375,5.859375,6.359375,6.859375,7.359375,7.859375,8.859375,9.859375,10.859375,11.859375,12.859375,13.859375,14.859375,15.859375,17.859375,19.859375,21.859375,23.859375,25.859375,27.859375,29.859375,31.859375,35.859375,39.859375,43.859375,47.859375,51.859375,55.859375,59.859375,63.859375,71.859375,79.859375,87.859375,95.859375,103.859375,111.859375,119.859375,127.859375,143.85938,159.85938,175.85938,191.85938,207.85938,223.85938,239.85938,255.85938,287.85938,319.85938,351.85938,383.85938,415.85938,447.85938,479.85938,0.15625,0.16503906,0.16601562,0.16699219,0.16796875,0.16894531,0.16992188,0.17089844,0.171875,0.17382812,0.17578125,0.17773438,0.1796875,0.18164062,0.18359375,0.18554688,0.1875,0.19140625,0.1953125,0.19921875,0.203125,0.20703125,0.2109375,0.21484375,0.21875,0.2265625,0.234375,0.2421875,0.25,0.2578125,0.265625,0.2734375,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.15625,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,3.90625,4.15625,4.65625,5.15625,5.65625,6.15625,6.65625,7.15625,7.65625,8.15625,9.15625,10.15625,11.15625,12.15625,13.15625,14.15625,15.15625,16.15625,18.15625,20.15625,22.15625,24.15625,26.15625,28.15625,30.15625,32.15625,36.15625,40.15625,44.15625,48.15625,52.15625,56.15625,60.15625,64.15625,72.15625,80.15625,88.15625,96.15625,104.15625,112.15625,120.15625,128.15625,144.15625,160.15625,176.15625,192.15625,208.15625,224.15625,240.15625,256.15625,288.15625,320.15625,352.15625,384.15625,416.15625,448.15625,480.15625,0.1484375,0.14746094,0.14648438,0.14550781,0.14453125,0.14355469,0.14257812,0.14160156,0.140625,0.13867188,0.13671875,0.13476562,0.1328125,0.13085938,0.12890625,0.12695312,0.125,0.12109375,0.1171875,0.11328125,0.109375,0.10546875,0.1015625,0.09765625,0.09375,0.0859375,0.078125,0.0703125,0.0625,0.0546875,0.046875,0.0390625,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.84375,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,2.09375,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,3.84375,4.34375,4.84375,5.34375,5.84375,6.34375,6.84375,7.34375,7.84375,8.84375,9.84375,10.84375,11.84375,12.84375,13.84375,14.84375,15.84375,17.84375,19.84375,21.84375,23.84375,25.84375,27.84375,29.84375,31.84375,35.84375,39.84375,43.84375,47.84375,51.84375,55.84375,59.84375,63.84375,71.84375,79.84375,87.84375,95.84375,103.84375,111.84375,119.84375,127.84375,143.84375,159.84375,175.84375,191.84375,207.84375,223.84375,239.84375,255.84375,287.84375,319.84375,351.84375,383.84375,415.84375,447.84375,479.84375,0.171875,0.18066406,0.18164062,0.18261719,0.18359375,0.18457031,0.18554688,0.18652344,0.1875,0.18945312,0.19140625,0.19335938,0.1953125,0.19726562,0.19921875,0.20117188,0.203125,0.20703125,0.2109375,0.21484375,0.21875,0.22265625,0.2265625,0.23046875,0.234375,0.2421875,0.25,0.2578125,0.265625,0.2734375,0.28125,0.2890625,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.671875,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.109375,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,1.921875,2.046875,2.171875,2.421875,2.671875,2.921875,3.171875,3.421875,3.671875,3.921875,4.171875,4.671875,5.171875,5.671875,6.171875,6.671875,7.171875,7.671875,8.171875,9.171875,10.171875,11.171875,12.171875,13.171875,14.171875,15.171875,16.171875,18.171875,20.171875,22.171875,24.171875,26.171875,28.171875,30.171875,32.171875,36.171875,40.171875,44.171875,48.171875,52.171875,56.171875,60.171875,64.171875,72.171875,80.171875,88.171875,96.171875,104.171875,112.171875,120.171875,128.17188,144.17188,160.17188,176.17188,192.17188,208.17188,224.17188,240.17188,256.17188,288.17188,320.17188,352.17188,384.17188,416.17188,448.17188,480.17188,0.1640625,0.16308594,0.16210938,0.16113281,0.16015625,0.15917969,0.15820312,0.15722656,0.15625,0.15429688,0.15234375,0.15039062,0.1484375,0.14648438,0.14453125,0.14257812,0.140625,0.13671875,0.1328125,0.12890625,0.125,0.12109375,0.1171875,0.11328125,0.109375,0.1015625,0.09375,0.0859375,0.078125,0.0703125,0.0625,0.0546875,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.078125,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.328125,0.390625,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.828125,0.953125,1.078125,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,2.078125,2.328125,2.578125,2.828125,3.078125,3.328125,3.578125,3.828125,4.328125,4.828125,5.328125,5.828125,6.328125,6.828125,7.328125,7.828125,8.828125,9.828125,10.828125,11.828125,12.828125,13.828125,14.828125,15.828125,17.828125,19.828125,21.828125,23.828125,25.828125,27.828125,29.828125,31.828125,35.828125,39.828125,43.828125,47.828125,51.828125,55.828125,59.828125,63.828125,71.828125,79.828125,87.828125,95.828125,103.828125,111.828125,119.828125,127.828125,143.82812,159.82812,175.82812,191.82812,207.82812,223.82812,239.82812,255.82812,287.82812,319.82812,351.82812,383.82812,415.82812,447.82812,479.82812,0.1875,0.19628906,0.19726562,0.19824219,0.19921875,0.20019531,0.20117188,0.20214844,0.203125,0.20507812,0.20703125,0.20898438,0.2109375,0.21289062,0.21484375,0.21679688,0.21875,0.22265625,0.2265625,0.23046875,0.234375,0.23828125,0.2421875,0.24609375,0.25,0.2578125,0.265625,0.2734375,0.28125,0.2890625,0.296875,0.3046875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.75,0.8125,0.875,0.9375,1,1.0625,1.125,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,7.6875,8.1875,9.1875,10.1875,11.1875,12.1875,13.1875,14.1875,15.1875,16.1875,18.1875,20.1875,22.1875,24.1875,26.1875,28.1875,30.1875,32.1875,36.1875,40.1875,44.1875,48.1875,52.1875,56.1875,60.1875,64.1875,72.1875,80.1875,88.1875,96.1875,104.1875,112.1875,120.1875,128.1875,144.1875,160.1875,176.1875,192.1875,208.1875,224.1875,240.1875,256.1875,288.1875,320.1875,352.1875,384.1875,416.1875,448.1875,480.1875,0.1796875,0.17871094,0.17773438,0.17675781,0.17578125,0.17480469,0.17382812,0.17285156,0.171875,0.16992188,0.16796875,0.16601562,0.1640625,0.16210938,0.16015625,0.15820312,0.15625,0.15234375,0.1484375,0.14453125,0.140625,0.13671875,0.1328125,0.12890625,0.125,0.1171875,0.109375,0.1015625,0.09375,0.0859375,0.078125,0.0703125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.3125,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,7.8125,8.8125,9.8125,10.8125,11.8125,12.8125,13.8125,14.8125,15.8125,17.8125,19.8125,21.8125,23.8125,25.8125,27.8125,29.8125,31.8125,35.8125,39.8125,43.8125,47.8125,51.8125,55.8125,59.8125,63.8125,71.8125,79.8125,87.8125,95.8125,103.8125,111.8125,119.8125,127.8125,143.8125,159.8125,175.8125,191.8125,207.8125,223.8125,239.8125,255.8125,287.8125,319.8125,351.8125,383.8125,415.8125,447.8125,479.8125,0.203125,0.21191406,0.21289062,0.21386719,0.21484375,0.21582031,0.21679688,0.21777344,0.21875,0.22070312,0.22265625,0.22460938,0.2265625,0.22851562,0.23046875,0.23242188,0.234375,0.23828125,0.2421875,0.24609375,0.25,0.25390625,0.2578125,0.26171875,0.265625,0.2734375,0.28125,0.2890625,0.296875,0.3046875,0.3125,0.3203125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.671875,0.703125,0.765625,0.828125,0.890625,0.953125,1.015625,1.078125,1.140625,1.203125,1.328125,1.453125,1.578125,1.703125,1.828125,1.953125,2.078125,2.203125,2.453125,2.703125,2.953125,3.203125,3.453125,3.703125,3.953125,4.203125,4.703125,5.203125,5.703125,6.203125,6.703125,7.203125,7.703125,8.203125,9.203125,10.203125,11.203125,12.203125,13.203125,14.203125,15.203125,16.203125,18.203125,20.203125,22.203125,24.203125,26.203125,28.203125,30.203125,32.203125,36.203125,40.203125,44.203125,48.203125,52.203125,56.203125,60.203125,64.203125,72.203125,80.203125,88.203125,96.203125,104.203125,112.203125,120.203125,128.20312,144.20312,160.20312,176.20312,192.20312,208.20312,224.20312,240.20312,256.20312,288.20312,320.20312,352.20312,384.20312,416.20312,448.20312,480.20312,0.1953125,0.19433594,0.19335938,0.19238281,0.19140625,0.19042969,0.18945312,0.18847656,0.1875,0.18554688,0.18359375,0.18164062,0.1796875,0.17773438,0.17578125,0.17382812,0.171875,0.16796875,0.1640625,0.16015625,0.15625,0.15234375,0.1484375,0.14453125,0.140625,0.1328125,0.125,0.1171875,0.109375,0.1015625,0.09375,0.0859375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.046875,0.078125,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.296875,0.359375,0.421875,0.484375,0.546875,0.609375,0.671875,0.734375,0.796875,0.921875,1.046875,1.171875,1.296875,1.421875,1.546875,1.671875,1.796875,2.046875,2.296875,2.546875,2.796875,3.046875,3.296875,3.546875,3.796875,4.296875,4.796875,5.296875,5.796875,6.296875,6.796875,7.296875,7.796875,8.796875,9.796875,10.796875,11.796875,12.796875,13.796875,14.796875,15.796875,17.796875,19.796875,21.796875,23.796875,25.796875,27.796875,29.796875,31.796875,35.796875,39.796875,43.796875,47.796875,51.796875,55.796875,59.796875,63.796875,71.796875,79.796875,87.796875,95.796875,103.796875,111.796875,119.796875,127.796875,143.79688,159.79688,175.79688,191.79688,207.79688,223.79688,239.79688,255.79688,287.79688,319.79688,351.79688,383.79688,415.79688,447.79688,479.79688,0.21875,0.22753906,0.22851562,0.22949219,0.23046875,0.23144531,0.23242188,0.23339844,0.234375,0.23632812,0.23828125,0.24023438,0.2421875,0.24414062,0.24609375,0.24804688,0.25,0.25390625,0.2578125,0.26171875,0.265625,0.26953125,0.2734375,0.27734375,0.28125,0.2890625,0.296875,0.3046875,0.3125,0.3203125,0.328125,0.3359375,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.46875,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.21875,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,3.96875,4.21875,4.71875,5.21875,5.71875,6.21875,6.71875,7.21875,7.71875,8.21875,9.21875,10.21875,11.21875,12.21875,13.21875,14.21875,15.21875,16.21875,18.21875,20.21875,22.21875,24.21875,26.21875,28.21875,30.21875,32.21875,36.21875,40.21875,44.21875,48.21875,52.21875,56.21875,60.21875,64.21875,72.21875,80.21875,88.21875,96.21875,104.21875,112.21875,120.21875,128.21875,144.21875,160.21875,176.21875,192.21875,208.21875,224.21875,240.21875,256.21875,288.21875,320.21875,352.21875,384.21875,416.21875,448.21875,480.21875,0.2109375,0.20996094,0.20898438,0.20800781,0.20703125,0.20605469,0.20507812,0.20410156,0.203125,0.20117188,0.19921875,0.19726562,0.1953125,0.19335938,0.19140625,0.18945312,0.1875,0.18359375,0.1796875,0.17578125,0.171875,0.16796875,0.1640625,0.16015625,0.15625,0.1484375,0.140625,0.1328125,0.125,0.1171875,0.109375,0.1015625,0.09375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.78125,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.78125,2.03125,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,3.78125,4.28125,4.78125,5.28125,5.78125,6.28125,6.78125,7.28125,7.78125,8.78125,9.78125,10.78125,11.78125,12.78125,13.78125,14.78125,15.78125,17.78125,19.78125,21.78125,23.78125,25.78125,27.78125,29.78125,31.78125,35.78125,39.78125,43.78125,47.78125,51.78125,55.78125,59.78125,63.78125,71.78125,79.78125,87.78125,95.78125,103.78125,111.78125,119.78125,127.78125,143.78125,159.78125,175.78125,191.78125,207.78125,223.78125,239.78125,255.78125,287.78125,319.78125,351.78125,383.78125,415.78125,447.78125,479.78125,0.234375,0.24316406,0.24414062,0.24511719,0.24609375,0.24707031,0.24804688,0.24902344,0.25,0.25195312,0.25390625,0.25585938,0.2578125,0.25976562,0.26171875,0.26367188,0.265625,0.26953125,0.2734375,0.27734375,0.28125,0.28515625,0.2890625,0.29296875,0.296875,0.3046875,0.3125,0.3203125,0.328125,0.3359375,0.34375,0.3515625,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.46875,0.484375,0.515625,0.546875,0.578125,0.609375,0.640625,0.671875,0.703125,0.734375,0.796875,0.859375,0.921875,0.984375,1.046875,1.109375,1.171875,1.234375,1.359375,1.484375,1.609375,1.734375,1.859375,1.984375,2.109375,2.234375,2.484375,2.734375,2.984375,3.234375,3.484375,3.734375,3.984375,4.234375,4.734375,5.234375,5.734375,6.234375,6.734375,7.234375,7.734375,8.234375,9.234375,10.234375,11.234375,12.234375,13.234375,14.234375,15.234375,16.234375,18.234375,20.234375,22.234375,24.234375,26.234375,28.234375,30.234375,32.234375,36.234375,40.234375,44.234375,48.234375,52.234375,56.234375,60.234375,64.234375,72.234375,80.234375,88.234375,96.234375,104.234375,112.234375,120.234375,128.23438,144.23438,160.23438,176.23438,192.23438,208.23438,224.23438,240.23438,256.23438,288.23438,320.23438,352.23438,384.23438,416.23438,448.23438,480.23438,0.2265625,0.22558594,0.22460938,0.22363281,0.22265625,0.22167969,0.22070312,0.21972656,0.21875,0.21679688,0.21484375,0.21289062,0.2109375,0.20898438,0.20703125,0.20507812,0.203125,0.19921875,0.1953125,0.19140625,0.1875,0.18359375,0.1796875,0.17578125,0.171875,0.1640625,0.15625,0.1484375,0.140625,0.1328125,0.125,0.1171875,0.109375,0.09375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.015625,0.046875,0.078125,0.109375,0.140625,0.171875,0.203125,0.234375,0.265625,0.328125,0.390625,0.453125,0.515625,0.578125,0.640625,0.703125,0.765625,0.890625,1.015625,1.140625,1.265625,1.390625,1.515625,1.640625,1.765625,2.015625,2.265625,2.515625,2.765625,3.015625,3.265625,3.515625,3.765625,4.265625,4.765625,5.265625,5.765625,6.265625,6.765625,7.265625,7.765625,8.765625,9.765625,10.765625,11.765625,12.765625,13.765625,14.765625,15.765625,17.765625,19.765625,21.765625,23.765625,25.765625,27.765625,29.765625,31.765625,35.765625,39.765625,43.765625,47.765625,51.765625,55.765625,59.765625,63.765625,71.765625,79.765625,87.765625,95.765625,103.765625,111.765625,119.765625,127.765625,143.76562,159.76562,175.76562,191.76562,207.76562,223.76562,239.76562,255.76562,287.76562,319.76562,351.76562,383.76562,415.76562,447.76562,479.76562,0.25,0.25878906,0.25976562,0.2607422,0.26171875,0.2626953,0.26367188,0.26464844,0.265625,0.26757812,0.26953125,0.27148438,0.2734375,0.27539062,0.27734375,0.27929688,0.28125,0.28515625,0.2890625,0.29296875,0.296875,0.30078125,0.3046875,0.30859375,0.3125,0.3203125,0.328125,0.3359375,0.34375,0.3515625,0.359375,0.3671875,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.46875,0.484375,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.8125,0.875,0.9375,1,1.0625,1.125,1.1875,1.25,1.375,1.5,1.625,1.75,1.875,2,2.125,2.25,2.5,2.75,3,3.25,3.5,3.75,4,4.25,4.75,5.25,5.75,6.25,6.75,7.25,7.75,8.25,9.25,10.25,11.25,12.25,13.25,14.25,15.25,16.25,18.25,20.25,22.25,24.25,26.25,28.25,30.25,32.25,36.25,40.25,44.25,48.25,52.25,56.25,60.25,64.25,72.25,80.25,88.25,96.25,104.25,112.25,120.25,128.25,144.25,160.25,176.25,192.25,208.25,224.25,240.25,256.25,288.25,320.25,352.25,384.25,416.25,448.25,480.25,0.2421875,0.24121094,0.24023438,0.23925781,0.23828125,0.23730469,0.23632812,0.23535156,0.234375,0.23242188,0.23046875,0.22851562,0.2265625,0.22460938,0.22265625,0.22070312,0.21875,0.21484375,0.2109375,0.20703125,0.203125,0.19921875,0.1953125,0.19140625,0.1875,0.1796875,0.171875,0.1640625,0.15625,0.1484375,0.140625,0.1328125,0.125,0.109375,0.09375,0.078125,0.0625,0.046875,0.03125,0.015625,0,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.75,0.875,1,1.125,1.25,1.375,1.5,1.625,1.75,2,2.25,2.5,2.75,3,3.25,3.5,3.75,4.25,4.75,5.25,5.75,6.25,6.75,7.25,7.75,8.75,9.75,10.75,11.75,12.75,13.75,14.75,15.75,17.75,19.75,21.75,23.75,25.75,27.75,29.75,31.75,35.75,39.75,43.75,47.75,51.75,55.75,59.75,63.75,71.75,79.75,87.75,95.75,103.75,111.75,119.75,127.75,143.75,159.75,175.75,191.75,207.75,223.75,239.75,255.75,287.75,319.75,351.75,383.75,415.75,447.75,479.75,0.28125,0.29003906,0.29101562,0.2919922,0.29296875,0.2939453,0.29492188,0.29589844,0.296875,0.29882812,0.30078125,0.30273438,0.3046875,0.30664062,0.30859375,0.31054688,0.3125,0.31640625,0.3203125,0.32421875,0.328125,0.33203125,0.3359375,0.33984375,0.34375,0.3515625,0.359375,0.3671875,0.375,0.3828125,0.390625,0.3984375,0.40625,0.421875,0.4375,0.453125,0.46875,0.484375,0.5,0.515625,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.15625,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,3.78125,4.03125,4.28125,4.78125,5.28125,5.78125,6.28125,6.78125,7.28125,7.78125,8.28125,9.28125,10.28125,11.28125,12.28125,13.28125,14.28125,15.28125,16.28125,18.28125,20.28125,22.28125,24.28125,26.28125,28.28125,30.28125,32.28125,36.28125,40.28125,44.28125,48.28125,52.28125,56.28125,60.28125,64.28125,72.28125,80.28125,88.28125,96.28125,104.28125,112.28125,120.28125,128.28125,144.28125,160.28125,176.28125,192.28125,208.28125,224.28125,240.28125,256.28125,288.28125,320.28125,352.28125,384.28125,416.28125,448.28125,480.28125,0.2734375,0.27246094,0.27148438,0.2705078,0.26953125,0.2685547,0.26757812,0.26660156,0.265625,0.26367188,0.26171875,0.25976562,0.2578125,0.25585938,0.25390625,0.25195312,0.25,0.24609375,0.2421875,0.23828125,0.234375,0.23046875,0.2265625,0.22265625,0.21875,0.2109375,0.203125,0.1953125,0.1875,0.1796875,0.171875,0.1640625,0.15625,0.140625,0.125,0.109375,0.09375,0.078125,0.0625,0.046875,0.03125,0,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.71875,0.84375,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.71875,1.96875,2.21875,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,4.21875,4.71875,5.21875,5.71875,6.21875,6.71875,7.21875,7.71875,8.71875,9.71875,10.71875,11.71875,12.71875,13.71875,14.71875,15.71875,17.71875,19.71875,21.71875,23.71875,25.71875,27.71875,29.71875,31.71875,35.71875,39.71875,43.71875,47.71875,51.71875,55.71875,59.71875,63.71875,71.71875,79.71875,87.71875,95.71875,103.71875,111.71875,119.71875,127.71875,143.71875,159.71875,175.71875,191.71875,207.71875,223.71875,239.71875,255.71875,287.71875,319.71875,351.71875,383.71875,415.71875,447.71875,479.71875,0.3125,0.32128906,0.32226562,0.3232422,0.32421875,0.3251953,0.32617188,0.32714844,0.328125,0.33007812,0.33203125,0.33398438,0.3359375,0.33789062,0.33984375,0.34179688,0.34375,0.34765625,0.3515625,0.35546875,0.359375,0.36328125,0.3671875,0.37109375,0.375,0.3828125,0.390625,0.3984375,0.40625,0.4140625,0.421875,0.4296875,0.4375,0.453125,0.46875,0.484375,0.5,0.515625,0.53125,0.546875,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.875,0.9375,1,1.0625,1.125,1.1875,1.25,1.3125,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.3125,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,7.8125,8.3125,9.3125,10.3125,11.3125,12.3125,13.3125,14.3125,15.3125,16.3125,18.3125,20.3125,22.3125,24.3125,26.3125,28.3125,30.3125,32.3125,36.3125,40.3125,44.3125,48.3125,52.3125,56.3125,60.3125,64.3125,72.3125,80.3125,88.3125,96.3125,104.3125,112.3125,120.3125,128.3125,144.3125,160.3125,176.3125,192.3125,208.3125,224.3125,240.3125,256.3125,288.3125,320.3125,352.3125,384.3125,416.3125,448.3125,480.3125,0.3046875,0.30371094,0.30273438,0.3017578,0.30078125,0.2998047,0.29882812,0.29785156,0.296875,0.29492188,0.29296875,0.29101562,0.2890625,0.28710938,0.28515625,0.28320312,0.28125,0.27734375,0.2734375,0.26953125,0.265625,0.26171875,0.2578125,0.25390625,0.25,0.2421875,0.234375,0.2265625,0.21875,0.2109375,0.203125,0.1953125,0.1875,0.171875,0.15625,0.140625,0.125,0.109375,0.09375,0.078125,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.125,0.15625,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.6875,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,4.1875,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,7.6875,8.6875,9.6875,10.6875,11.6875,12.6875,13.6875,14.6875,15.6875,17.6875,19.6875,21.6875,23.6875,25.6875,27.6875,29.6875,31.6875,35.6875,39.6875,43.6875,47.6875,51.6875,55.6875,59.6875,63.6875,71.6875,79.6875,87.6875,95.6875,103.6875,111.6875,119.6875,127.6875,143.6875,159.6875,175.6875,191.6875,207.6875,223.6875,239.6875,255.6875,287.6875,319.6875,351.6875,383.6875,415.6875,447.6875,479.6875,0.34375,0.35253906,0.35351562,0.3544922,0.35546875,0.3564453,0.35742188,0.35839844,0.359375,0.36132812,0.36328125,0.36523438,0.3671875,0.36914062,0.37109375,0.37304688,0.375,0.37890625,0.3828125,0.38671875,0.390625,0.39453125,0.3984375,0.40234375,0.40625,0.4140625,0.421875,0.4296875,0.4375,0.4453125,0.453125,0.4609375,0.46875,0.484375,0.5,0.515625,0.53125,0.546875,0.5625,0.578125,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.34375,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.21875,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,3.84375,4.09375,4.34375,4.84375,5.34375,5.84375,6.34375,6.84375,7.34375,7.84375,8.34375,9.34375,10.34375,11.34375,12.34375,13.34375,14.34375,15.34375,16.34375,18.34375,20.34375,22.34375,24.34375,26.34375,28.34375,30.34375,32.34375,36.34375,40.34375,44.34375,48.34375,52.34375,56.34375,60.34375,64.34375,72.34375,80.34375,88.34375,96.34375,104.34375,112.34375,120.34375,128.34375,144.34375,160.34375,176.34375,192.34375,208.34375,224.34375,240.34375,256.34375,288.34375,320.34375,352.34375,384.34375,416.34375,448.34375,480.34375,0.3359375,0.33496094,0.33398438,0.3330078,0.33203125,0.3310547,0.33007812,0.32910156,0.328125,0.32617188,0.32421875,0.32226562,0.3203125,0.31835938,0.31640625,0.31445312,0.3125,0.30859375,0.3046875,0.30078125,0.296875,0.29296875,0.2890625,0.28515625,0.28125,0.2734375,0.265625,0.2578125,0.25,0.2421875,0.234375,0.2265625,0.21875,0.203125,0.1875,0.171875,0.15625,0.140625,0.125,0.109375,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.125,0.15625,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.65625,0.78125,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.65625,1.90625,2.15625,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,4.15625,4.65625,5.15625,5.65625,6.15625,6.65625,7.15625,7.65625,8.65625,9.65625,10.65625,11.65625,12.65625,13.65625,14.65625,15.65625,17.65625,19.65625,21.65625,23.65625,25.65625,27.65625,29.65625,31.65625,35.65625,39.65625,43.65625,47.65625,51.65625,55.65625,59.65625,63.65625,71.65625,79.65625,87.65625,95.65625,103.65625,111.65625,119.65625,127.65625,143.65625,159.65625,175.65625,191.65625,207.65625,223.65625,239.65625,255.65625,287.65625,319.65625,351.65625,383.65625,415.65625,447.65625,479.65625,0.375,0.38378906,0.38476562,0.3857422,0.38671875,0.3876953,0.38867188,0.38964844,0.390625,0.39257812,0.39453125,0.39648438,0.3984375,0.40039062,0.40234375,0.40429688,0.40625,0.41015625,0.4140625,0.41796875,0.421875,0.42578125,0.4296875,0.43359375,0.4375,0.4453125,0.453125,0.4609375,0.46875,0.4765625,0.484375,0.4921875,0.5,0.515625,0.53125,0.546875,0.5625,0.578125,0.59375,0.609375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.9375,1,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.5,1.625,1.75,1.875,2,2.125,2.25,2.375,2.625,2.875,3.125,3.375,3.625,3.875,4.125,4.375,4.875,5.375,5.875,6.375,6.875,7.375,7.875,8.375,9.375,10.375,11.375,12.375,13.375,14.375,15.375,16.375,18.375,20.375,22.375,24.375,26.375,28.375,30.375,32.375,36.375,40.375,44.375,48.375,52.375,56.375,60.375,64.375,72.375,80.375,88.375,96.375,104.375,112.375,120.375,128.375,144.375,160.375,176.375,192.375,208.375,224.375,240.375,256.375,288.375,320.375,352.375,384.375,416.375,448.375,480.375,0.3671875,0.36621094,0.36523438,0.3642578,0.36328125,0.3623047,0.36132812,0.36035156,0.359375,0.35742188,0.35546875,0.35351562,0.3515625,0.34960938,0.34765625,0.34570312,0.34375,0.33984375,0.3359375,0.33203125,0.328125,0.32421875,0.3203125,0.31640625,0.3125,0.3046875,0.296875,0.2890625,0.28125,0.2734375,0.265625,0.2578125,0.25,0.234375,0.21875,0.203125,0.1875,0.171875,0.15625,0.140625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.625,0.75,0.875,1,1.125,1.25,1.375,1.5,1.625,1.875,2.125,2.375,2.625,2.875,3.125,3.375,3.625,4.125,4.625,5.125,5.625,6.125,6.625,7.125,7.625,8.625,9.625,10.625,11.625,12.625,13.625,14.625,15.625,17.625,19.625,21.625,23.625,25.625,27.625,29.625,31.625,35.625,39.625,43.625,47.625,51.625,55.625,59.625,63.625,71.625,79.625,87.625,95.625,103.625,111.625,119.625,127.625,143.625,159.625,175.625,191.625,207.625,223.625,239.625,255.625,287.625,319.625,351.625,383.625,415.625,447.625,479.625,0.40625,0.41503906,0.41601562,0.4169922,0.41796875,0.4189453,0.41992188,0.42089844,0.421875,0.42382812,0.42578125,0.42773438,0.4296875,0.43164062,0.43359375,0.43554688,0.4375,0.44140625,0.4453125,0.44921875,0.453125,0.45703125,0.4609375,0.46484375,0.46875,0.4765625,0.484375,0.4921875,0.5,0.5078125,0.515625,0.5234375,0.53125,0.546875,0.5625,0.578125,0.59375,0.609375,0.625,0.640625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.34375,1.40625,1.53125,1.65625,1.78125,1.90625,2.03125,2.15625,2.28125,2.40625,2.65625,2.90625,3.15625,3.40625,3.65625,3.90625,4.15625,4.40625,4.90625,5.40625,5.90625,6.40625,6.90625,7.40625,7.90625,8.40625,9.40625,10.40625,11.40625,12.40625,13.40625,14.40625,15.40625,16.40625,18.40625,20.40625,22.40625,24.40625,26.40625,28.40625,30.40625,32.40625,36.40625,40.40625,44.40625,48.40625,52.40625,56.40625,60.40625,64.40625,72.40625,80.40625,88.40625,96.40625,104.40625,112.40625,120.40625,128.40625,144.40625,160.40625,176.40625,192.40625,208.40625,224.40625,240.40625,256.40625,288.40625,320.40625,352.40625,384.40625,416.40625,448.40625,480.40625,0.3984375,0.39746094,0.39648438,0.3955078,0.39453125,0.3935547,0.39257812,0.39160156,0.390625,0.38867188,0.38671875,0.38476562,0.3828125,0.38085938,0.37890625,0.37695312,0.375,0.37109375,0.3671875,0.36328125,0.359375,0.35546875,0.3515625,0.34765625,0.34375,0.3359375,0.328125,0.3203125,0.3125,0.3046875,0.296875,0.2890625,0.28125,0.265625,0.25,0.234375,0.21875,0.203125,0.1875,0.171875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.09375,0.15625,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.59375,0.71875,0.84375,0.96875,1.09375,1.21875,1.34375,1.46875,1.59375,1.84375,2.09375,2.34375,2.59375,2.84375,3.09375,3.34375,3.59375,4.09375,4.59375,5.09375,5.59375,6.09375,6.59375,7.09375,7.59375,8.59375,9.59375,10.59375,11.59375,12.59375,13.59375,14.59375,15.59375,17.59375,19.59375,21.59375,23.59375,25.59375,27.59375,29.59375,31.59375,35.59375,39.59375,43.59375,47.59375,51.59375,55.59375,59.59375,63.59375,71.59375,79.59375,87.59375,95.59375,103.59375,111.59375,119.59375,127.59375,143.59375,159.59375,175.59375,191.59375,207.59375,223.59375,239.59375,255.59375,287.59375,319.59375,351.59375,383.59375,415.59375,447.59375,479.59375,0.4375,0.44628906,0.44726562,0.4482422,0.44921875,0.4501953,0.45117188,0.45214844,0.453125,0.45507812,0.45703125,0.45898438,0.4609375,0.46289062,0.46484375,0.46679688,0.46875,0.47265625,0.4765625,0.48046875,0.484375,0.48828125,0.4921875,0.49609375,0.5,0.5078125,0.515625,0.5234375,0.53125,0.5390625,0.546875,0.5546875,0.5625,0.578125,0.59375,0.609375,0.625,0.640625,0.65625,0.671875,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.9375,1,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.4375,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,7.9375,8.4375,9.4375,10.4375,11.4375,12.4375,13.4375,14.4375,15.4375,16.4375,18.4375,20.4375,22.4375,24.4375,26.4375,28.4375,30.4375,32.4375,36.4375,40.4375,44.4375,48.4375,52.4375,56.4375,60.4375,64.4375,72.4375,80.4375,88.4375,96.4375,104.4375,112.4375,120.4375,128.4375,144.4375,160.4375,176.4375,192.4375,208.4375,224.4375,240.4375,256.4375,288.4375,320.4375,352.4375,384.4375,416.4375,448.4375,480.4375,0.4296875,0.42871094,0.42773438,0.4267578,0.42578125,0.4248047,0.42382812,0.42285156,0.421875,0.41992188,0.41796875,0.41601562,0.4140625,0.41210938,0.41015625,0.40820312,0.40625,0.40234375,0.3984375,0.39453125,0.390625,0.38671875,0.3828125,0.37890625,0.375,0.3671875,0.359375,0.3515625,0.34375,0.3359375,0.328125,0.3203125,0.3125,0.296875,0.28125,0.265625,0.25,0.234375,0.21875,0.203125,0.1875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.5625,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.5625,4.0625,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,7.5625,8.5625,9.5625,10.5625,11.5625,12.5625,13.5625,14.5625,15.5625,17.5625,19.5625,21.5625,23.5625,25.5625,27.5625,29.5625,31.5625,35.5625,39.5625,43.5625,47.5625,51.5625,55.5625,59.5625,63.5625,71.5625,79.5625,87.5625,95.5625,103.5625,111.5625,119.5625,127.5625,143.5625,159.5625,175.5625,191.5625,207.5625,223.5625,239.5625,255.5625,287.5625,319.5625,351.5625,383.5625,415.5625,447.5625,479.5625,0.46875,0.47753906,0.47851562,0.4794922,0.48046875,0.4814453,0.48242188,0.48339844,0.484375,0.48632812,0.48828125,0.49023438,0.4921875,0.49414062,0.49609375,0.49804688,0.5,0.50390625,0.5078125,0.51171875,0.515625,0.51953125,0.5234375,0.52734375,0.53125,0.5390625,0.546875,0.5546875,0.5625,0.5703125,0.578125,0.5859375,0.59375,0.609375,0.625,0.640625,0.65625,0.671875,0.6875,0.703125,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.9375,0.96875,1.03125,1.09375,1.15625,1.21875,1.28125,1.34375,1.40625,1.46875,1.59375,1.71875,1.84375,1.96875,2.09375,2.21875,2.34375,2.46875,2.71875,2.96875,3.21875,3.46875,3.71875,3.96875,4.21875,4.46875,4.96875,5.46875,5.96875,6.46875,6.96875,7.46875,7.96875,8.46875,9.46875,10.46875,11.46875,12.46875,13.46875,14.46875,15.46875,16.46875,18.46875,20.46875,22.46875,24.46875,26.46875,28.46875,30.46875,32.46875,36.46875,40.46875,44.46875,48.46875,52.46875,56.46875,60.46875,64.46875,72.46875,80.46875,88.46875,96.46875,104.46875,112.46875,120.46875,128.46875,144.46875,160.46875,176.46875,192.46875,208.46875,224.46875,240.46875,256.46875,288.46875,320.46875,352.46875,384.46875,416.46875,448.46875,480.46875,0.4609375,0.45996094,0.45898438,0.4580078,0.45703125,0.4560547,0.45507812,0.45410156,0.453125,0.45117188,0.44921875,0.44726562,0.4453125,0.44335938,0.44140625,0.43945312,0.4375,0.43359375,0.4296875,0.42578125,0.421875,0.41796875,0.4140625,0.41015625,0.40625,0.3984375,0.390625,0.3828125,0.375,0.3671875,0.359375,0.3515625,0.34375,0.328125,0.3125,0.296875,0.28125,0.265625,0.25,0.234375,0.21875,0.1875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.03125,0.09375,0.15625,0.21875,0.28125,0.34375,0.40625,0.46875,0.53125,0.65625,0.78125,0.90625,1.03125,1.15625,1.28125,1.40625,1.53125,1.78125,2.03125,2.28125,2.53125,2.78125,3.03125,3.28125,3.53125,4.03125,4.53125,5.03125,5.53125,6.03125,6.53125,7.03125,7.53125,8.53125,9.53125,10.53125,11.53125,12.53125,13.53125,14.53125,15.53125,17.53125,19.53125,21.53125,23.53125,25.53125,27.53125,29.53125,31.53125,35.53125,39.53125,43.53125,47.53125,51.53125,55.53125,59.53125,63.53125,71.53125,79.53125,87.53125,95.53125,103.53125,111.53125,119.53125,127.53125,143.53125,159.53125,175.53125,191.53125,207.53125,223.53125,239.53125,255.53125,287.53125,319.53125,351.53125,383.53125,415.53125,447.53125,479.53125,0.5,0.50878906,0.5097656,0.5107422,0.51171875,0.5126953,0.5136719,0.51464844,0.515625,0.5175781,0.51953125,0.5214844,0.5234375,0.5253906,0.52734375,0.5292969,0.53125,0.53515625,0.5390625,0.54296875,0.546875,0.55078125,0.5546875,0.55859375,0.5625,0.5703125,0.578125,0.5859375,0.59375,0.6015625,0.609375,0.6171875,0.625,0.640625,0.65625,0.671875,0.6875,0.703125,0.71875,0.734375,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.9375,0.96875,1,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.625,1.75,1.875,2,2.125,2.25,2.375,2.5,2.75,3,3.25,3.5,3.75,4,4.25,4.5,5,5.5,6,6.5,7,7.5,8,8.5,9.5,10.5,11.5,12.5,13.5,14.5,15.5,16.5,18.5,20.5,22.5,24.5,26.5,28.5,30.5,32.5,36.5,40.5,44.5,48.5,52.5,56.5,60.5,64.5,72.5,80.5,88.5,96.5,104.5,112.5,120.5,128.5,144.5,160.5,176.5,192.5,208.5,224.5,240.5,256.5,288.5,320.5,352.5,384.5,416.5,448.5,480.5,0.4921875,0.49121094,0.49023438,0.4892578,0.48828125,0.4873047,0.48632812,0.48535156,0.484375,0.48242188,0.48046875,0.47851562,0.4765625,0.47460938,0.47265625,0.47070312,0.46875,0.46484375,0.4609375,0.45703125,0.453125,0.44921875,0.4453125,0.44140625,0.4375,0.4296875,0.421875,0.4140625,0.40625,0.3984375,0.390625,0.3828125,0.375,0.359375,0.34375,0.328125,0.3125,0.296875,0.28125,0.265625,0.25,0.21875,0.1875,0.15625,0.125,0.09375,0.0625,0.03125,0,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5,0.625,0.75,0.875,1,1.125,1.25,1.375,1.5,1.75,2,2.25,2.5,2.75,3,3.25,3.5,4,4.5,5,5.5,6,6.5,7,7.5,8.5,9.5,10.5,11.5,12.5,13.5,14.5,15.5,17.5,19.5,21.5,23.5,25.5,27.5,29.5,31.5,35.5,39.5,43.5,47.5,51.5,55.5,59.5,63.5,71.5,79.5,87.5,95.5,103.5,111.5,119.5,127.5,143.5,159.5,175.5,191.5,207.5,223.5,239.5,255.5,287.5,319.5,351.5,383.5,415.5,447.5,479.5,0.5625,0.57128906,0.5722656,0.5732422,0.57421875,0.5751953,0.5761719,0.57714844,0.578125,0.5800781,0.58203125,0.5839844,0.5859375,0.5878906,0.58984375,0.5917969,0.59375,0.59765625,0.6015625,0.60546875,0.609375,0.61328125,0.6171875,0.62109375,0.625,0.6328125,0.640625,0.6484375,0.65625,0.6640625,0.671875,0.6796875,0.6875,0.703125,0.71875,0.734375,0.75,0.765625,0.78125,0.796875,0.8125,0.84375,0.875,0.90625,0.9375,0.96875,1,1.03125,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.3125,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,7.5625,8.0625,8.5625,9.5625,10.5625,11.5625,12.5625,13.5625,14.5625,15.5625,16.5625,18.5625,20.5625,22.5625,24.5625,26.5625,28.5625,30.5625,32.5625,36.5625,40.5625,44.5625,48.5625,52.5625,56.5625,60.5625,64.5625,72.5625,80.5625,88.5625,96.5625,104.5625,112.5625,120.5625,128.5625,144.5625,160.5625,176.5625,192.5625,208.5625,224.5625,240.5625,256.5625,288.5625,320.5625,352.5625,384.5625,416.5625,448.5625,480.5625,0.5546875,0.55371094,0.5527344,0.5517578,0.55078125,0.5498047,0.5488281,0.54785156,0.546875,0.5449219,0.54296875,0.5410156,0.5390625,0.5371094,0.53515625,0.5332031,0.53125,0.52734375,0.5234375,0.51953125,0.515625,0.51171875,0.5078125,0.50390625,0.5,0.4921875,0.484375,0.4765625,0.46875,0.4609375,0.453125,0.4453125,0.4375,0.421875,0.40625,0.390625,0.375,0.359375,0.34375,0.328125,0.3125,0.28125,0.25,0.21875,0.1875,0.15625,0.125,0.09375,0.0625,0,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.4375,1.6875,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.4375,3.9375,4.4375,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,8.4375,9.4375,10.4375,11.4375,12.4375,13.4375,14.4375,15.4375,17.4375,19.4375,21.4375,23.4375,25.4375,27.4375,29.4375,31.4375,35.4375,39.4375,43.4375,47.4375,51.4375,55.4375,59.4375,63.4375,71.4375,79.4375,87.4375,95.4375,103.4375,111.4375,119.4375,127.4375,143.4375,159.4375,175.4375,191.4375,207.4375,223.4375,239.4375,255.4375,287.4375,319.4375,351.4375,383.4375,415.4375,447.4375,479.4375,0.625,0.63378906,0.6347656,0.6357422,0.63671875,0.6376953,0.6386719,0.63964844,0.640625,0.6425781,0.64453125,0.6464844,0.6484375,0.6503906,0.65234375,0.6542969,0.65625,0.66015625,0.6640625,0.66796875,0.671875,0.67578125,0.6796875,0.68359375,0.6875,0.6953125,0.703125,0.7109375,0.71875,0.7265625,0.734375,0.7421875,0.75,0.765625,0.78125,0.796875,0.8125,0.828125,0.84375,0.859375,0.875,0.90625,0.9375,0.96875,1,1.03125,1.0625,1.09375,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.75,1.875,2,2.125,2.25,2.375,2.5,2.625,2.875,3.125,3.375,3.625,3.875,4.125,4.375,4.625,5.125,5.625,6.125,6.625,7.125,7.625,8.125,8.625,9.625,10.625,11.625,12.625,13.625,14.625,15.625,16.625,18.625,20.625,22.625,24.625,26.625,28.625,30.625,32.625,36.625,40.625,44.625,48.625,52.625,56.625,60.625,64.625,72.625,80.625,88.625,96.625,104.625,112.625,120.625,128.625,144.625,160.625,176.625,192.625,208.625,224.625,240.625,256.625,288.625,320.625,352.625,384.625,416.625,448.625,480.625,0.6171875,0.61621094,0.6152344,0.6142578,0.61328125,0.6123047,0.6113281,0.61035156,0.609375,0.6074219,0.60546875,0.6035156,0.6015625,0.5996094,0.59765625,0.5957031,0.59375,0.58984375,0.5859375,0.58203125,0.578125,0.57421875,0.5703125,0.56640625,0.5625,0.5546875,0.546875,0.5390625,0.53125,0.5234375,0.515625,0.5078125,0.5,0.484375,0.46875,0.453125,0.4375,0.421875,0.40625,0.390625,0.375,0.34375,0.3125,0.28125,0.25,0.21875,0.1875,0.15625,0.125,0.0625,0,0.0625,0.125,0.1875,0.25,0.3125,0.375,0.5,0.625,0.75,0.875,1,1.125,1.25,1.375,1.625,1.875,2.125,2.375,2.625,2.875,3.125,3.375,3.875,4.375,4.875,5.375,5.875,6.375,6.875,7.375,8.375,9.375,10.375,11.375,12.375,13.375,14.375,15.375,17.375,19.375,21.375,23.375,25.375,27.375,29.375,31.375,35.375,39.375,43.375,47.375,51.375,55.375,59.375,63.375,71.375,79.375,87.375,95.375,103.375,111.375,119.375,127.375,143.375,159.375,175.375,191.375,207.375,223.375,239.375,255.375,287.375,319.375,351.375,383.375,415.375,447.375,479.375,0.6875,0.69628906,0.6972656,0.6982422,0.69921875,0.7001953,0.7011719,0.70214844,0.703125,0.7050781,0.70703125,0.7089844,0.7109375,0.7128906,0.71484375,0.7167969,0.71875,0.72265625,0.7265625,0.73046875,0.734375,0.73828125,0.7421875,0.74609375,0.75,0.7578125,0.765625,0.7734375,0.78125,0.7890625,0.796875,0.8046875,0.8125,0.828125,0.84375,0.859375,0.875,0.890625,0.90625,0.921875,0.9375,0.96875,1,1.03125,1.0625,1.09375,1.125,1.15625,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.6875,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.4375,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,7.6875,8.1875,8.6875,9.6875,10.6875,11.6875,12.6875,13.6875,14.6875,15.6875,16.6875,18.6875,20.6875,22.6875,24.6875,26.6875,28.6875,30.6875,32.6875,36.6875,40.6875,44.6875,48.6875,52.6875,56.6875,60.6875,64.6875,72.6875,80.6875,88.6875,96.6875,104.6875,112.6875,120.6875,128.6875,144.6875,160.6875,176.6875,192.6875,208.6875,224.6875,240.6875,256.6875,288.6875,320.6875,352.6875,384.6875,416.6875,448.6875,480.6875,0.6796875,0.67871094,0.6777344,0.6767578,0.67578125,0.6748047,0.6738281,0.67285156,0.671875,0.6699219,0.66796875,0.6660156,0.6640625,0.6621094,0.66015625,0.6582031,0.65625,0.65234375,0.6484375,0.64453125,0.640625,0.63671875,0.6328125,0.62890625,0.625,0.6171875,0.609375,0.6015625,0.59375,0.5859375,0.578125,0.5703125,0.5625,0.546875,0.53125,0.515625,0.5,0.484375,0.46875,0.453125,0.4375,0.40625,0.375,0.34375,0.3125,0.28125,0.25,0.21875,0.1875,0.125,0.0625,0,0.0625,0.125,0.1875,0.25,0.3125,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.3125,1.5625,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.3125,3.8125,4.3125,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,8.3125,9.3125,10.3125,11.3125,12.3125,13.3125,14.3125,15.3125,17.3125,19.3125,21.3125,23.3125,25.3125,27.3125,29.3125,31.3125,35.3125,39.3125,43.3125,47.3125,51.3125,55.3125,59.3125,63.3125,71.3125,79.3125,87.3125,95.3125,103.3125,111.3125,119.3125,127.3125,143.3125,159.3125,175.3125,191.3125,207.3125,223.3125,239.3125,255.3125,287.3125,319.3125,351.3125,383.3125,415.3125,447.3125,479.3125,0.75,0.75878906,0.7597656,0.7607422,0.76171875,0.7626953,0.7636719,0.76464844,0.765625,0.7675781,0.76953125,0.7714844,0.7734375,0.7753906,0.77734375,0.7792969,0.78125,0.78515625,0.7890625,0.79296875,0.796875,0.80078125,0.8046875,0.80859375,0.8125,0.8203125,0.828125,0.8359375,0.84375,0.8515625,0.859375,0.8671875,0.875,0.890625,0.90625,0.921875,0.9375,0.953125,0.96875,0.984375,1,1.03125,1.0625,1.09375,1.125,1.15625,1.1875,1.21875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.875,2,2.125,2.25,2.375,2.5,2.625,2.75,3,3.25,3.5,3.75,4,4.25,4.5,4.75,5.25,5.75,6.25,6.75,7.25,7.75,8.25,8.75,9.75,10.75,11.75,12.75,13.75,14.75,15.75,16.75,18.75,20.75,22.75,24.75,26.75,28.75,30.75,32.75,36.75,40.75,44.75,48.75,52.75,56.75,60.75,64.75,72.75,80.75,88.75,96.75,104.75,112.75,120.75,128.75,144.75,160.75,176.75,192.75,208.75,224.75,240.75,256.75,288.75,320.75,352.75,384.75,416.75,448.75,480.75,0.7421875,0.74121094,0.7402344,0.7392578,0.73828125,0.7373047,0.7363281,0.73535156,0.734375,0.7324219,0.73046875,0.7285156,0.7265625,0.7246094,0.72265625,0.7207031,0.71875,0.71484375,0.7109375,0.70703125,0.703125,0.69921875,0.6953125,0.69140625,0.6875,0.6796875,0.671875,0.6640625,0.65625,0.6484375,0.640625,0.6328125,0.625,0.609375,0.59375,0.578125,0.5625,0.546875,0.53125,0.515625,0.5,0.46875,0.4375,0.40625,0.375,0.34375,0.3125,0.28125,0.25,0.1875,0.125,0.0625,0,0.0625,0.125,0.1875,0.25,0.375,0.5,0.625,0.75,0.875,1,1.125,1.25,1.5,1.75,2,2.25,2.5,2.75,3,3.25,3.75,4.25,4.75,5.25,5.75,6.25,6.75,7.25,8.25,9.25,10.25,11.25,12.25,13.25,14.25,15.25,17.25,19.25,21.25,23.25,25.25,27.25,29.25,31.25,35.25,39.25,43.25,47.25,51.25,55.25,59.25,63.25,71.25,79.25,87.25,95.25,103.25,111.25,119.25,127.25,143.25,159.25,175.25,191.25,207.25,223.25,239.25,255.25,287.25,319.25,351.25,383.25,415.25,447.25,479.25,0.8125,0.82128906,0.8222656,0.8232422,0.82421875,0.8251953,0.8261719,0.82714844,0.828125,0.8300781,0.83203125,0.8339844,0.8359375,0.8378906,0.83984375,0.8417969,0.84375,0.84765625,0.8515625,0.85546875,0.859375,0.86328125,0.8671875,0.87109375,0.875,0.8828125,0.890625,0.8984375,0.90625,0.9140625,0.921875,0.9296875,0.9375,0.953125,0.96875,0.984375,1,1.015625,1.03125,1.046875,1.0625,1.09375,1.125,1.15625,1.1875,1.21875,1.25,1.28125,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.6875,2.8125,3.0625,3.3125,3.5625,3.8125,4.0625,4.3125,4.5625,4.8125,5.3125,5.8125,6.3125,6.8125,7.3125,7.8125,8.3125,8.8125,9.8125,10.8125,11.8125,12.8125,13.8125,14.8125,15.8125,16.8125,18.8125,20.8125,22.8125,24.8125,26.8125,28.8125,30.8125,32.8125,36.8125,40.8125,44.8125,48.8125,52.8125,56.8125,60.8125,64.8125,72.8125,80.8125,88.8125,96.8125,104.8125,112.8125,120.8125,128.8125,144.8125,160.8125,176.8125,192.8125,208.8125,224.8125,240.8125,256.8125,288.8125,320.8125,352.8125,384.8125,416.8125,448.8125,480.8125,0.8046875,0.80371094,0.8027344,0.8017578,0.80078125,0.7998047,0.7988281,0.79785156,0.796875,0.7949219,0.79296875,0.7910156,0.7890625,0.7871094,0.78515625,0.7832031,0.78125,0.77734375,0.7734375,0.76953125,0.765625,0.76171875,0.7578125,0.75390625,0.75,0.7421875,0.734375,0.7265625,0.71875,0.7109375,0.703125,0.6953125,0.6875,0.671875,0.65625,0.640625,0.625,0.609375,0.59375,0.578125,0.5625,0.53125,0.5,0.46875,0.4375,0.40625,0.375,0.34375,0.3125,0.25,0.1875,0.125,0.0625,0,0.0625,0.125,0.1875,0.3125,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.1875,1.4375,1.6875,1.9375,2.1875,2.4375,2.6875,2.9375,3.1875,3.6875,4.1875,4.6875,5.1875,5.6875,6.1875,6.6875,7.1875,8.1875,9.1875,10.1875,11.1875,12.1875,13.1875,14.1875,15.1875,17.1875,19.1875,21.1875,23.1875,25.1875,27.1875,29.1875,31.1875,35.1875,39.1875,43.1875,47.1875,51.1875,55.1875,59.1875,63.1875,71.1875,79.1875,87.1875,95.1875,103.1875,111.1875,119.1875,127.1875,143.1875,159.1875,175.1875,191.1875,207.1875,223.1875,239.1875,255.1875,287.1875,319.1875,351.1875,383.1875,415.1875,447.1875,479.1875,0.875,0.88378906,0.8847656,0.8857422,0.88671875,0.8876953,0.8886719,0.88964844,0.890625,0.8925781,0.89453125,0.8964844,0.8984375,0.9003906,0.90234375,0.9042969,0.90625,0.91015625,0.9140625,0.91796875,0.921875,0.92578125,0.9296875,0.93359375,0.9375,0.9453125,0.953125,0.9609375,0.96875,0.9765625,0.984375,0.9921875,1,1.015625,1.03125,1.046875,1.0625,1.078125,1.09375,1.109375,1.125,1.15625,1.1875,1.21875,1.25,1.28125,1.3125,1.34375,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.875,2,2.125,2.25,2.375,2.5,2.625,2.75,2.875,3.125,3.375,3.625,3.875,4.125,4.375,4.625,4.875,5.375,5.875,6.375,6.875,7.375,7.875,8.375,8.875,9.875,10.875,11.875,12.875,13.875,14.875,15.875,16.875,18.875,20.875,22.875,24.875,26.875,28.875,30.875,32.875,36.875,40.875,44.875,48.875,52.875,56.875,60.875,64.875,72.875,80.875,88.875,96.875,104.875,112.875,120.875,128.875,144.875,160.875,176.875,192.875,208.875,224.875,240.875,256.875,288.875,320.875,352.875,384.875,416.875,448.875,480.875,0.8671875,0.86621094,0.8652344,0.8642578,0.86328125,0.8623047,0.8613281,0.86035156,0.859375,0.8574219,0.85546875,0.8535156,0.8515625,0.8496094,0.84765625,0.8457031,0.84375,0.83984375,0.8359375,0.83203125,0.828125,0.82421875,0.8203125,0.81640625,0.8125,0.8046875,0.796875,0.7890625,0.78125,0.7734375,0.765625,0.7578125,0.75,0.734375,0.71875,0.703125,0.6875,0.671875,0.65625,0.640625,0.625,0.59375,0.5625,0.53125,0.5,0.46875,0.4375,0.40625,0.375,0.3125,0.25,0.1875,0.125,0.0625,0,0.0625,0.125,0.25,0.375,0.5,0.625,0.75,0.875,1,1.125,1.375,1.625,1.875,2.125,2.375,2.625,2.875,3.125,3.625,4.125,4.625,5.125,5.625,6.125,6.625,7.125,8.125,9.125,10.125,11.125,12.125,13.125,14.125,15.125,17.125,19.125,21.125,23.125,25.125,27.125,29.125,31.125,35.125,39.125,43.125,47.125,51.125,55.125,59.125,63.125,71.125,79.125,87.125,95.125,103.125,111.125,119.125,127.125,143.125,159.125,175.125,191.125,207.125,223.125,239.125,255.125,287.125,319.125,351.125,383.125,415.125,447.125,479.125,0.9375,0.94628906,0.9472656,0.9482422,0.94921875,0.9501953,0.9511719,0.95214844,0.953125,0.9550781,0.95703125,0.9589844,0.9609375,0.9628906,0.96484375,0.9667969,0.96875,0.97265625,0.9765625,0.98046875,0.984375,0.98828125,0.9921875,0.99609375,1,1.0078125,1.015625,1.0234375,1.03125,1.0390625,1.046875,1.0546875,1.0625,1.078125,1.09375,1.109375,1.125,1.140625,1.15625,1.171875,1.1875,1.21875,1.25,1.28125,1.3125,1.34375,1.375,1.40625,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.875,1.9375,2.0625,2.1875,2.3125,2.4375,2.5625,2.6875,2.8125,2.9375,3.1875,3.4375,3.6875,3.9375,4.1875,4.4375,4.6875,4.9375,5.4375,5.9375,6.4375,6.9375,7.4375,7.9375,8.4375,8.9375,9.9375,10.9375,11.9375,12.9375,13.9375,14.9375,15.9375,16.9375,18.9375,20.9375,22.9375,24.9375,26.9375,28.9375,30.9375,32.9375,36.9375,40.9375,44.9375,48.9375,52.9375,56.9375,60.9375,64.9375,72.9375,80.9375,88.9375,96.9375,104.9375,112.9375,120.9375,128.9375,144.9375,160.9375,176.9375,192.9375,208.9375,224.9375,240.9375,256.9375,288.9375,320.9375,352.9375,384.9375,416.9375,448.9375,480.9375,0.9296875,0.92871094,0.9277344,0.9267578,0.92578125,0.9248047,0.9238281,0.92285156,0.921875,0.9199219,0.91796875,0.9160156,0.9140625,0.9121094,0.91015625,0.9082031,0.90625,0.90234375,0.8984375,0.89453125,0.890625,0.88671875,0.8828125,0.87890625,0.875,0.8671875,0.859375,0.8515625,0.84375,0.8359375,0.828125,0.8203125,0.8125,0.796875,0.78125,0.765625,0.75,0.734375,0.71875,0.703125,0.6875,0.65625,0.625,0.59375,0.5625,0.53125,0.5,0.46875,0.4375,0.375,0.3125,0.25,0.1875,0.125,0.0625,0,0.0625,0.1875,0.3125,0.4375,0.5625,0.6875,0.8125,0.9375,1.0625,1.3125,1.5625,1.8125,2.0625,2.3125,2.5625,2.8125,3.0625,3.5625,4.0625,4.5625,5.0625,5.5625,6.0625,6.5625,7.0625,8.0625,9.0625,10.0625,11.0625,12.0625,13.0625,14.0625,15.0625,17.0625,19.0625,21.0625,23.0625,25.0625,27.0625,29.0625,31.0625,35.0625,39.0625,43.0625,47.0625,51.0625,55.0625,59.0625,63.0625,71.0625,79.0625,87.0625,95.0625,103.0625,111.0625,119.0625,127.0625,143.0625,159.0625,175.0625,191.0625,207.0625,223.0625,239.0625,255.0625,287.0625,319.0625,351.0625,383.0625,415.0625,447.0625,479.0625,1,1.0087891,1.0097656,1.0107422,1.0117188,1.0126953,1.0136719,1.0146484,1.015625,1.0175781,1.0195312,1.0214844,1.0234375,1.0253906,1.0273438,1.0292969,1.03125,1.0351562,1.0390625,1.0429688,1.046875,1.0507812,1.0546875,1.0585938,1.0625,1.0703125,1.078125,1.0859375,1.09375,1.1015625,1.109375,1.1171875,1.125,1.140625,1.15625,1.171875,1.1875,1.203125,1.21875,1.234375,1.25,1.28125,1.3125,1.34375,1.375,1.40625,1.4375,1.46875,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.875,1.9375,2,2.125,2.25,2.375,2.5,2.625,2.75,2.875,3,3.25,3.5,3.75,4,4.25,4.5,4.75,5,5.5,6,6.5,7,7.5,8,8.5,9,10,11,12,13,14,15,16,17,19,21,23,25,27,29,31,33,37,41,45,49,53,57,61,65,73,81,89,97,105,113,121,129,145,161,177,193,209,225,241,257,289,321,353,385,417,449,481,0.9921875,0.99121094,0.9902344,0.9892578,0.98828125,0.9873047,0.9863281,0.98535156,0.984375,0.9824219,0.98046875,0.9785156,0.9765625,0.9746094,0.97265625,0.9707031,0.96875,0.96484375,0.9609375,0.95703125,0.953125,0.94921875,0.9453125,0.94140625,0.9375,0.9296875,0.921875,0.9140625,0.90625,0.8984375,0.890625,0.8828125,0.875,0.859375,0.84375,0.828125,0.8125,0.796875,0.78125,0.765625,0.75,0.71875,0.6875,0.65625,0.625,0.59375,0.5625,0.53125,0.5,0.4375,0.375,0.3125,0.25,0.1875,0.125,0.0625,0,0.125,0.25,0.375,0.5,0.625,0.75,0.875,1,1.25,1.5,1.75,2,2.25,2.5,2.75,3,3.5,4,4.5,5,5.5,6,6.5,7,8,9,10,11,12,13,14,15,17,19,21,23,25,27,29,31,35,39,43,47,51,55,59,63,71,79,87,95,103,111,119,127,143,159,175,191,207,223,239,255,287,319,351,383,415,447,479,1.125,1.1337891,1.1347656,1.1357422,1.1367188,1.1376953,1.1386719,1.1396484,1.140625,1.1425781,1.1445312,1.1464844,1.1484375,1.1503906,1.1523438,1.1542969,1.15625,1.1601562,1.1640625,1.1679688,1.171875,1.1757812,1.1796875,1.1835938,1.1875,1.1953125,1.203125,1.2109375,1.21875,1.2265625,1.234375,1.2421875,1.25,1.265625,1.28125,1.296875,1.3125,1.328125,1.34375,1.359375,1.375,1.40625,1.4375,1.46875,1.5,1.53125,1.5625,1.59375,1.625,1.6875,1.75,1.8125,1.875,1.9375,2,2.0625,2.125,2.25,2.375,2.5,2.625,2.75,2.875,3,3.125,3.375,3.625,3.875,4.125,4.375,4.625,4.875,5.125,5.625,6.125,6.625,7.125,7.625,8.125,8.625,9.125,10.125,11.125,12.125,13.125,14.125,15.125,16.125,17.125,19.125,21.125,23.125,25.125,27.125,29.125,31.125,33.125,37.125,41.125,45.125,49.125,53.125,57.125,61.125,65.125,73.125,81.125,89.125,97.125,105.125,113.125,121.125,129.125,145.125,161.125,177.125,193.125,209.125,225.125,241.125,257.125,289.125,321.125,353.125,385.125,417.125,449.125,481.125,1.1171875,1.1162109,1.1152344,1.1142578,1.1132812,1.1123047,1.1113281,1.1103516,1.109375,1.1074219,1.1054688,1.1035156,1.1015625,1.0996094,1.0976562,1.0957031,1.09375,1.0898438,1.0859375,1.0820312,1.078125,1.0742188,1.0703125,1.0664062,1.0625,1.0546875,1.046875,1.0390625,1.03125,1.0234375,1.015625,1.0078125,1,0.984375,0.96875,0.953125,0.9375,0.921875,0.90625,0.890625,0.875,0.84375,0.8125,0.78125,0.75,0.71875,0.6875,0.65625,0.625,0.5625,0.5,0.4375,0.375,0.3125,0.25,0.1875,0.125,0,0.125,0.25,0.375,0.5,0.625,0.75,0.875,1.125,1.375,1.625,1.875,2.125,2.375,2.625,2.875,3.375,3.875,4.375,4.875,5.375,5.875,6.375,6.875,7.875,8.875,9.875,10.875,11.875,12.875,13.875,14.875,16.875,18.875,20.875,22.875,24.875,26.875,28.875,30.875,34.875,38.875,42.875,46.875,50.875,54.875,58.875,62.875,70.875,78.875,86.875,94.875,102.875,110.875,118.875,126.875,142.875,158.875,174.875,190.875,206.875,222.875,238.875,254.875,286.875,318.875,350.875,382.875,414.875,446.875,478.875,1.25,1.2587891,1.2597656,1.2607422,1.2617188,1.2626953,1.2636719,1.2646484,1.265625,1.2675781,1.2695312,1.2714844,1.2734375,1.2753906,1.2773438,1.2792969,1.28125,1.2851562,1.2890625,1.2929688,1.296875,1.3007812,1.3046875,1.3085938,1.3125,1.3203125,1.328125,1.3359375,1.34375,1.3515625,1.359375,1.3671875,1.375,1.390625,1.40625,1.421875,1.4375,1.453125,1.46875,1.484375,1.5,1.53125,1.5625,1.59375,1.625,1.65625,1.6875,1.71875,1.75,1.8125,1.875,1.9375,2,2.0625,2.125,2.1875,2.25,2.375,2.5,2.625,2.75,2.875,3,3.125,3.25,3.5,3.75,4,4.25,4.5,4.75,5,5.25,5.75,6.25,6.75,7.25,7.75,8.25,8.75,9.25,10.25,11.25,12.25,13.25,14.25,15.25,16.25,17.25,19.25,21.25,23.25,25.25,27.25,29.25,31.25,33.25,37.25,41.25,45.25,49.25,53.25,57.25,61.25,65.25,73.25,81.25,89.25,97.25,105.25,113.25,121.25,129.25,145.25,161.25,177.25,193.25,209.25,225.25,241.25,257.25,289.25,321.25,353.25,385.25,417.25,449.25,481.25,1.2421875,1.2412109,1.2402344,1.2392578,1.2382812,1.2373047,1.2363281,1.2353516,1.234375,1.2324219,1.2304688,1.2285156,1.2265625,1.2246094,1.2226562,1.2207031,1.21875,1.2148438,1.2109375,1.2070312,1.203125,1.1992188,1.1953125,1.1914062,1.1875,1.1796875,1.171875,1.1640625,1.15625,1.1484375,1.140625,1.1328125,1.125,1.109375,1.09375,1.078125,1.0625,1.046875,1.03125,1.015625,1,0.96875,0.9375,0.90625,0.875,0.84375,0.8125,0.78125,0.75,0.6875,0.625,0.5625,0.5,0.4375,0.375,0.3125,0.25,0.125,0,0.125,0.25,0.375,0.5,0.625,0.75,1,1.25,1.5,1.75,2,2.25,2.5,2.75,3.25,3.75,4.25,4.75,5.25,5.75,6.25,6.75,7.75,8.75,9.75,10.75,11.75,12.75,13.75,14.75,16.75,18.75,20.75,22.75,24.75,26.75,28.75,30.75,34.75,38.75,42.75,46.75,50.75,54.75,58.75,62.75,70.75,78.75,86.75,94.75,102.75,110.75,118.75,126.75,142.75,158.75,174.75,190.75,206.75,222.75,238.75,254.75,286.75,318.75,350.75,382.75,414.75,446.75,478.75,1.375,1.3837891,1.3847656,1.3857422,1.3867188,1.3876953,1.3886719,1.3896484,1.390625,1.3925781,1.3945312,1.3964844,1.3984375,1.4003906,1.4023438,1.4042969,1.40625,1.4101562,1.4140625,1.4179688,1.421875,1.4257812,1.4296875,1.4335938,1.4375,1.4453125,1.453125,1.4609375,1.46875,1.4765625,1.484375,1.4921875,1.5,1.515625,1.53125,1.546875,1.5625,1.578125,1.59375,1.609375,1.625,1.65625,1.6875,1.71875,1.75,1.78125,1.8125,1.84375,1.875,1.9375,2,2.0625,2.125,2.1875,2.25,2.3125,2.375,2.5,2.625,2.75,2.875,3,3.125,3.25,3.375,3.625,3.875,4.125,4.375,4.625,4.875,5.125,5.375,5.875,6.375,6.875,7.375,7.875,8.375,8.875,9.375,10.375,11.375,12.375,13.375,14.375,15.375,16.375,17.375,19.375,21.375,23.375,25.375,27.375,29.375,31.375,33.375,37.375,41.375,45.375,49.375,53.375,57.375,61.375,65.375,73.375,81.375,89.375,97.375,105.375,113.375,121.375,129.375,145.375,161.375,177.375,193.375,209.375,225.375,241.375,257.375,289.375,321.375,353.375,385.375,417.375,449.375,481.375,1.3671875,1.3662109,1.3652344,1.3642578,1.3632812,1.3623047,1.3613281,1.3603516,1.359375,1.3574219,1.3554688,1.3535156,1.3515625,1.3496094,1.3476562,1.3457031,1.34375,1.3398438,1.3359375,1.3320312,1.328125,1.3242188,1.3203125,1.3164062,1.3125,1.3046875,1.296875,1.2890625,1.28125,1.2734375,1.265625,1.2578125,1.25,1.234375,1.21875,1.203125,1.1875,1.171875,1.15625,1.140625,1.125,1.09375,1.0625,1.03125,1,0.96875,0.9375,0.90625,0.875,0.8125,0.75,0.6875,0.625,0.5625,0.5,0.4375,0.375,0.25,0.125,0,0.125,0.25,0.375,0.5,0.625,0.875,1.125,1.375,1.625,1.875,2.125,2.375,2.625,3.125,3.625,4.125,4.625,5.125,5.625,6.125,6.625,7.625,8.625,9.625,10.625,11.625,12.625,13.625,14.625,16.625,18.625,20.625,22.625,24.625,26.625,28.625,30.625,34.625,38.625,42.625,46.625,50.625,54.625,58.625,62.625,70.625,78.625,86.625,94.625,102.625,110.625,118.625,126.625,142.625,158.625,174.625,190.625,206.625,222.625,238.625,254.625,286.625,318.625,350.625,382.625,414.625,446.625,478.625,1.5,1.5087891,1.5097656,1.5107422,1.5117188,1.5126953,1.5136719,1.5146484,1.515625,1.5175781,1.5195312,1.5214844,1.5234375,1.5253906,1.5273438,1.5292969,1.53125,1.5351562,1.5390625,1.5429688,1.546875,1.5507812,1.5546875,1.5585938,1.5625,1.5703125,1.578125,1.5859375,1.59375,1.6015625,1.609375,1.6171875,1.625,1.640625,1.65625,1.671875,1.6875,1.703125,1.71875,1.734375,1.75,1.78125,1.8125,1.84375,1.875,1.90625,1.9375,1.96875,2,2.0625,2.125,2.1875,2.25,2.3125,2.375,2.4375,2.5,2.625,2.75,2.875,3,3.125,3.25,3.375,3.5,3.75,4,4.25,4.5,4.75,5,5.25,5.5,6,6.5,7,7.5,8,8.5,9,9.5,10.5,11.5,12.5,13.5,14.5,15.5,16.5,17.5,19.5,21.5,23.5,25.5,27.5,29.5,31.5,33.5,37.5,41.5,45.5,49.5,53.5,57.5,61.5,65.5,73.5,81.5,89.5,97.5,105.5,113.5,121.5,129.5,145.5,161.5,177.5,193.5,209.5,225.5,241.5,257.5,289.5,321.5,353.5,385.5,417.5,449.5,481.5,1.4921875,1.4912109,1.4902344,1.4892578,1.4882812,1.4873047,1.4863281,1.4853516,1.484375,1.4824219,1.4804688,1.4785156,1.4765625,1.4746094,1.4726562,1.4707031,1.46875,1.4648438,1.4609375,1.4570312,1.453125,1.4492188,1.4453125,1.4414062,1.4375,1.4296875,1.421875,1.4140625,1.40625,1.3984375,1.390625,1.3828125,1.375,1.359375,1.34375,1.328125,1.3125,1.296875,1.28125,1.265625,1.25,1.21875,1.1875,1.15625,1.125,1.09375,1.0625,1.03125,1,0.9375,0.875,0.8125,0.75,0.6875,0.625,0.5625,0.5,0.375,0.25,0.125,0,0.125,0.25,0.375,0.5,0.75,1,1.25,1.5,1.75,2,2.25,2.5,3,3.5,4,4.5,5,5.5,6,6.5,7.5,8.5,9.5,10.5,11.5,12.5,13.5,14.5,16.5,18.5,20.5,22.5,24.5,26.5,28.5,30.5,34.5,38.5,42.5,46.5,50.5,54.5,58.5,62.5,70.5,78.5,86.5,94.5,102.5,110.5,118.5,126.5,142.5,158.5,174.5,190.5,206.5,222.5,238.5,254.5,286.5,318.5,350.5,382.5,414.5,446.5,478.5,1.625,1.6337891,1.6347656,1.6357422,1.6367188,1.6376953,1.6386719,1.6396484,1.640625,1.6425781,1.6445312,1.6464844,1.6484375,1.6503906,1.6523438,1.6542969,1.65625,1.6601562,1.6640625,1.6679688,1.671875,1.6757812,1.6796875,1.6835938,1.6875,1.6953125,1.703125,1.7109375,1.71875,1.7265625,1.734375,1.7421875,1.75,1.765625,1.78125,1.796875,1.8125,1.828125,1.84375,1.859375,1.875,1.90625,1.9375,1.96875,2,2.03125,2.0625,2.09375,2.125,2.1875,2.25,2.3125,2.375,2.4375,2.5,2.5625,2.625,2.75,2.875,3,3.125,3.25,3.375,3.5,3.625,3.875,4.125,4.375,4.625,4.875,5.125,5.375,5.625,6.125,6.625,7.125,7.625,8.125,8.625,9.125,9.625,10.625,11.625,12.625,13.625,14.625,15.625,16.625,17.625,19.625,21.625,23.625,25.625,27.625,29.625,31.625,33.625,37.625,41.625,45.625,49.625,53.625,57.625,61.625,65.625,73.625,81.625,89.625,97.625,105.625,113.625,121.625,129.625,145.625,161.625,177.625,193.625,209.625,225.625,241.625,257.625,289.625,321.625,353.625,385.625,417.625,449.625,481.625,1.6171875,1.6162109,1.6152344,1.6142578,1.6132812,1.6123047,1.6113281,1.6103516,1.609375,1.6074219,1.6054688,1.6035156,1.6015625,1.5996094,1.5976562,1.5957031,1.59375,1.5898438,1.5859375,1.5820312,1.578125,1.5742188,1.5703125,1.5664062,1.5625,1.5546875,1.546875,1.5390625,1.53125,1.5234375,1.515625,1.5078125,1.5,1.484375,1.46875,1.453125,1.4375,1.421875,1.40625,1.390625,1.375,1.34375,1.3125,1.28125,1.25,1.21875,1.1875,1.15625,1.125,1.0625,1,0.9375,0.875,0.8125,0.75,0.6875,0.625,0.5,0.375,0.25,0.125,0,0.125,0.25,0.375,0.625,0.875,1.125,1.375,1.625,1.875,2.125,2.375,2.875,3.375,3.875,4.375,4.875,5.375,5.875,6.375,7.375,8.375,9.375,10.375,11.375,12.375,13.375,14.375,16.375,18.375,20.375,22.375,24.375,26.375,28.375,30.375,34.375,38.375,42.375,46.375,50.375,54.375,58.375,62.375,70.375,78.375,86.375,94.375,102.375,110.375,118.375,126.375,142.375,158.375,174.375,190.375,206.375,222.375,238.375,254.375,286.375,318.375,350.375,382.375,414.375,446.375,478.375,1.75,1.7587891,1.7597656,1.7607422,1.7617188,1.7626953,1.7636719,1.7646484,1.765625,1.7675781,1.7695312,1.7714844,1.7734375,1.7753906,1.7773438,1.7792969,1.78125,1.7851562,1.7890625,1.7929688,1.796875,1.8007812,1.8046875,1.8085938,1.8125,1.8203125,1.828125,1.8359375,1.84375,1.8515625,1.859375,1.8671875,1.875,1.890625,1.90625,1.921875,1.9375,1.953125,1.96875,1.984375,2,2.03125,2.0625,2.09375,2.125,2.15625,2.1875,2.21875,2.25,2.3125,2.375,2.4375,2.5,2.5625,2.625,2.6875,2.75,2.875,3,3.125,3.25,3.375,3.5,3.625,3.75,4,4.25,4.5,4.75,5,5.25,5.5,5.75,6.25,6.75,7.25,7.75,8.25,8.75,9.25,9.75,10.75,11.75,12.75,13.75,14.75,15.75,16.75,17.75,19.75,21.75,23.75,25.75,27.75,29.75,31.75,33.75,37.75,41.75,45.75,49.75,53.75,57.75,61.75,65.75,73.75,81.75,89.75,97.75,105.75,113.75,121.75,129.75,145.75,161.75,177.75,193.75,209.75,225.75,241.75,257.75,289.75,321.75,353.75,385.75,417.75,449.75,481.75,1.7421875,1.7412109,1.7402344,1.7392578,1.7382812,1.7373047,1.7363281,1.7353516,1.734375,1.7324219,1.7304688,1.7285156,1.7265625,1.7246094,1.7226562,1.7207031,1.71875,1.7148438,1.7109375,1.7070312,1.703125,1.6992188,1.6953125,1.6914062,1.6875,1.6796875,1.671875,1.6640625,1.65625,1.6484375,1.640625,1.6328125,1.625,1.609375,1.59375,1.578125,1.5625,1.546875,1.53125,1.515625,1.5,1.46875,1.4375,1.40625,1.375,1.34375,1.3125,1.28125,1.25,1.1875,1.125,1.0625,1,0.9375,0.875,0.8125,0.75,0.625,0.5,0.375,0.25,0.125,0,0.125,0.25,0.5,0.75,1,1.25,1.5,1.75,2,2.25,2.75,3.25,3.75,4.25,4.75,5.25,5.75,6.25,7.25,8.25,9.25,10.25,11.25,12.25,13.25,14.25,16.25,18.25,20.25,22.25,24.25,26.25,28.25,30.25,34.25,38.25,42.25,46.25,50.25,54.25,58.25,62.25,70.25,78.25,86.25,94.25,102.25,110.25,118.25,126.25,142.25,158.25,174.25,190.25,206.25,222.25,238.25,254.25,286.25,318.25,350.25,382.25,414.25,446.25,478.25,1.875,1.8837891,1.8847656,1.8857422,1.8867188,1.8876953,1.8886719,1.8896484,1.890625,1.8925781,1.8945312,1.8964844,1.8984375,1.9003906,1.9023438,1.9042969,1.90625,1.9101562,1.9140625,1.9179688,1.921875,1.9257812,1.9296875,1.9335938,1.9375,1.9453125,1.953125,1.9609375,1.96875,1.9765625,1.984375,1.9921875,2,2.015625,2.03125,2.046875,2.0625,2.078125,2.09375,2.109375,2.125,2.15625,2.1875,2.21875,2.25,2.28125,2.3125,2.34375,2.375,2.4375,2.5,2.5625,2.625,2.6875,2.75,2.8125,2.875,3,3.125,3.25,3.375,3.5,3.625,3.75,3.875,4.125,4.375,4.625,4.875,5.125,5.375,5.625,5.875,6.375,6.875,7.375,7.875,8.375,8.875,9.375,9.875,10.875,11.875,12.875,13.875,14.875,15.875,16.875,17.875,19.875,21.875,23.875,25.875,27.875,29.875,31.875,33.875,37.875,41.875,45.875,49.875,53.875,57.875,61.875,65.875,73.875,81.875,89.875,97.875,105.875,113.875,121.875,129.875,145.875,161.875,177.875,193.875,209.875,225.875,241.875,257.875,289.875,321.875,353.875,385.875,417.875,449.875,481.875,1.8671875,1.8662109,1.8652344,1.8642578,1.8632812,1.8623047,1.8613281,1.8603516,1.859375,1.8574219,1.8554688,1.8535156,1.8515625,1.8496094,1.8476562,1.8457031,1.84375,1.8398438,1.8359375,1.8320312,1.828125,1.8242188,1.8203125,1.8164062,1.8125,1.8046875,1.796875,1.7890625,1.78125,1.7734375,1.765625,1.7578125,1.75,1.734375,1.71875,1.703125,1.6875,1.671875,1.65625,1.640625,1.625,1.59375,1.5625,1.53125,1.5,1.46875,1.4375,1.40625,1.375,1.3125,1.25,1.1875,1.125,1.0625,1,0.9375,0.875,0.75,0.625,0.5,0.375,0.25,0.125,0,0.125,0.375,0.625,0.875,1.125,1.375,1.625,1.875,2.125,2.625,3.125,3.625,4.125,4.625,5.125,5.625,6.125,7.125,8.125,9.125,10.125,11.125,12.125,13.125,14.125,16.125,18.125,20.125,22.125,24.125,26.125,28.125,30.125,34.125,38.125,42.125,46.125,50.125,54.125,58.125,62.125,70.125,78.125,86.125,94.125,102.125,110.125,118.125,126.125,142.125,158.125,174.125,190.125,206.125,222.125,238.125,254.125,286.125,318.125,350.125,382.125,414.125,446.125,478.125,2,2.008789,2.0097656,2.0107422,2.0117188,2.0126953,2.0136719,2.0146484,2.015625,2.0175781,2.0195312,2.0214844,2.0234375,2.0253906,2.0273438,2.0292969,2.03125,2.0351562,2.0390625,2.0429688,2.046875,2.0507812,2.0546875,2.0585938,2.0625,2.0703125,2.078125,2.0859375,2.09375,2.1015625,2.109375,2.1171875,2.125,2.140625,2.15625,2.171875,2.1875,2.203125,2.21875,2.234375,2.25,2.28125,2.3125,2.34375,2.375,2.40625,2.4375,2.46875,2.5,2.5625,2.625,2.6875,2.75,2.8125,2.875,2.9375,3,3.125,3.25,3.375,3.5,3.625,3.75,3.875,4,4.25,4.5,4.75,5,5.25,5.5,5.75,6,6.5,7,7.5,8,8.5,9,9.5,10,11,12,13,14,15,16,17,18,20,22,24,26,28,30,32,34,38,42,46,50,54,58,62,66,74,82,90,98,106,114,122,130,146,162,178,194,210,226,242,258,290,322,354,386,418,450,482,1.9921875,1.9912109,1.9902344,1.9892578,1.9882812,1.9873047,1.9863281,1.9853516,1.984375,1.9824219,1.9804688,1.9785156,1.9765625,1.9746094,1.9726562,1.9707031,1.96875,1.9648438,1.9609375,1.9570312,1.953125,1.9492188,1.9453125,1.9414062,1.9375,1.9296875,1.921875,1.9140625,1.90625,1.8984375,1.890625,1.8828125,1.875,1.859375,1.84375,1.828125,1.8125,1.796875,1.78125,1.765625,1.75,1.71875,1.6875,1.65625,1.625,1.59375,1.5625,1.53125,1.5,1.4375,1.375,1.3125,1.25,1.1875,1.125,1.0625,1,0.875,0.75,0.625,0.5,0.375,0.25,0.125,0,0.25,0.5,0.75,1,1.25,1.5,1.75,2,2.5,3,3.5,4,4.5,5,5.5,6,7,8,9,10,11,12,13,14,16,18,20,22,24,26,28,30,34,38,42,46,50,54,58,62,70,78,86,94,102,110,118,126,142,158,174,190,206,222,238,254,286,318,350,382,414,446,478,2.25,2.258789,2.2597656,2.2607422,2.2617188,2.2626953,2.2636719,2.2646484,2.265625,2.2675781,2.2695312,2.2714844,2.2734375,2.2753906,2.2773438,2.2792969,2.28125,2.2851562,2.2890625,2.2929688,2.296875,2.3007812,2.3046875,2.3085938,2.3125,2.3203125,2.328125,2.3359375,2.34375,2.3515625,2.359375,2.3671875,2.375,2.390625,2.40625,2.421875,2.4375,2.453125,2.46875,2.484375,2.5,2.53125,2.5625,2.59375,2.625,2.65625,2.6875,2.71875,2.75,2.8125,2.875,2.9375,3,3.0625,3.125,3.1875,3.25,3.375,3.5,3.625,3.75,3.875,4,4.125,4.25,4.5,4.75,5,5.25,5.5,5.75,6,6.25,6.75,7.25,7.75,8.25,8.75,9.25,9.75,10.25,11.25,12.25,13.25,14.25,15.25,16.25,17.25,18.25,20.25,22.25,24.25,26.25,28.25,30.25,32.25,34.25,38.25,42.25,46.25,50.25,54.25,58.25,62.25,66.25,74.25,82.25,90.25,98.25,106.25,114.25,122.25,130.25,146.25,162.25,178.25,194.25,210.25,226.25,242.25,258.25,290.25,322.25,354.25,386.25,418.25,450.25,482.25,2.2421875,2.241211,2.2402344,2.2392578,2.2382812,2.2373047,2.2363281,2.2353516,2.234375,2.2324219,2.2304688,2.2285156,2.2265625,2.2246094,2.2226562,2.2207031,2.21875,2.2148438,2.2109375,2.2070312,2.203125,2.1992188,2.1953125,2.1914062,2.1875,2.1796875,2.171875,2.1640625,2.15625,2.1484375,2.140625,2.1328125,2.125,2.109375,2.09375,2.078125,2.0625,2.046875,2.03125,2.015625,2,1.96875,1.9375,1.90625,1.875,1.84375,1.8125,1.78125,1.75,1.6875,1.625,1.5625,1.5,1.4375,1.375,1.3125,1.25,1.125,1,0.875,0.75,0.625,0.5,0.375,0.25,0,0.25,0.5,0.75,1,1.25,1.5,1.75,2.25,2.75,3.25,3.75,4.25,4.75,5.25,5.75,6.75,7.75,8.75,9.75,10.75,11.75,12.75,13.75,15.75,17.75,19.75,21.75,23.75,25.75,27.75,29.75,33.75,37.75,41.75,45.75,49.75,53.75,57.75,61.75,69.75,77.75,85.75,93.75,101.75,109.75,117.75,125.75,141.75,157.75,173.75,189.75,205.75,221.75,237.75,253.75,285.75,317.75,349.75,381.75,413.75,445.75,477.75,2.5,2.508789,2.5097656,2.5107422,2.5117188,2.5126953,2.5136719,2.5146484,2.515625,2.5175781,2.5195312,2.5214844,2.5234375,2.5253906,2.5273438,2.5292969,2.53125,2.5351562,2.5390625,2.5429688,2.546875,2.5507812,2.5546875,2.5585938,2.5625,2.5703125,2.578125,2.5859375,2.59375,2.6015625,2.609375,2.6171875,2.625,2.640625,2.65625,2.671875,2.6875,2.703125,2.71875,2.734375,2.75,2.78125,2.8125,2.84375,2.875,2.90625,2.9375,2.96875,3,3.0625,3.125,3.1875,3.25,3.3125,3.375,3.4375,3.5,3.625,3.75,3.875,4,4.125,4.25,4.375,4.5,4.75,5,5.25,5.5,5.75,6,6.25,6.5,7,7.5,8,8.5,9,9.5,10,10.5,11.5,12.5,13.5,14.5,15.5,16.5,17.5,18.5,20.5,22.5,24.5,26.5,28.5,30.5,32.5,34.5,38.5,42.5,46.5,50.5,54.5,58.5,62.5,66.5,74.5,82.5,90.5,98.5,106.5,114.5,122.5,130.5,146.5,162.5,178.5,194.5,210.5,226.5,242.5,258.5,290.5,322.5,354.5,386.5,418.5,450.5,482.5,2.4921875,2.491211,2.4902344,2.4892578,2.4882812,2.4873047,2.4863281,2.4853516,2.484375,2.4824219,2.4804688,2.4785156,2.4765625,2.4746094,2.4726562,2.4707031,2.46875,2.4648438,2.4609375,2.4570312,2.453125,2.4492188,2.4453125,2.4414062,2.4375,2.4296875,2.421875,2.4140625,2.40625,2.3984375,2.390625,2.3828125,2.375,2.359375,2.34375,2.328125,2.3125,2.296875,2.28125,2.265625,2.25,2.21875,2.1875,2.15625,2.125,2.09375,2.0625,2.03125,2,1.9375,1.875,1.8125,1.75,1.6875,1.625,1.5625,1.5,1.375,1.25,1.125,1,0.875,0.75,0.625,0.5,0.25,0,0.25,0.5,0.75,1,1.25,1.5,2,2.5,3,3.5,4,4.5,5,5.5,6.5,7.5,8.5,9.5,10.5,11.5,12.5,13.5,15.5,17.5,19.5,21.5,23.5,25.5,27.5,29.5,33.5,37.5,41.5,45.5,49.5,53.5,57.5,61.5,69.5,77.5,85.5,93.5,101.5,109.5,117.5,125.5,141.5,157.5,173.5,189.5,205.5,221.5,237.5,253.5,285.5,317.5,349.5,381.5,413.5,445.5,477.5,2.75,2.758789,2.7597656,2.7607422,2.7617188,2.7626953,2.7636719,2.7646484,2.765625,2.7675781,2.7695312,2.7714844,2.7734375,2.7753906,2.7773438,2.7792969,2.78125,2.7851562,2.7890625,2.7929688,2.796875,2.8007812,2.8046875,2.8085938,2.8125,2.8203125,2.828125,2.8359375,2.84375,2.8515625,2.859375,2.8671875,2.875,2.890625,2.90625,2.921875,2.9375,2.953125,2.96875,2.984375,3,3.03125,3.0625,3.09375,3.125,3.15625,3.1875,3.21875,3.25,3.3125,3.375,3.4375,3.5,3.5625,3.625,3.6875,3.75,3.875,4,4.125,4.25,4.375,4.5,4.625,4.75,5,5.25,5.5,5.75,6,6.25,6.5,6.75,7.25,7.75,8.25,8.75,9.25,9.75,10.25,10.75,11.75,12.75,13.75,14.75,15.75,16.75,17.75,18.75,20.75,22.75,24.75,26.75,28.75,30.75,32.75,34.75,38.75,42.75,46.75,50.75,54.75,58.75,62.75,66.75,74.75,82.75,90.75,98.75,106.75,114.75,122.75,130.75,146.75,162.75,178.75,194.75,210.75,226.75,242.75,258.75,290.75,322.75,354.75,386.75,418.75,450.75,482.75,2.7421875,2.741211,2.7402344,2.7392578,2.7382812,2.7373047,2.7363281,2.7353516,2.734375,2.7324219,2.7304688,2.7285156,2.7265625,2.7246094,2.7226562,2.7207031,2.71875,2.7148438,2.7109375,2.7070312,2.703125,2.6992188,2.6953125,2.6914062,2.6875,2.6796875,2.671875,2.6640625,2.65625,2.6484375,2.640625,2.6328125,2.625,2.609375,2.59375,2.578125,2.5625,2.546875,2.53125,2.515625,2.5,2.46875,2.4375,2.40625,2.375,2.34375,2.3125,2.28125,2.25,2.1875,2.125,2.0625,2,1.9375,1.875,1.8125,1.75,1.625,1.5,1.375,1.25,1.125,1,0.875,0.75,0.5,0.25,0,0.25,0.5,0.75,1,1.25,1.75,2.25,2.75,3.25,3.75,4.25,4.75,5.25,6.25,7.25,8.25,9.25,10.25,11.25,12.25,13.25,15.25,17.25,19.25,21.25,23.25,25.25,27.25,29.25,33.25,37.25,41.25,45.25,49.25,53.25,57.25,61.25,69.25,77.25,85.25,93.25,101.25,109.25,117.25,125.25,141.25,157.25,173.25,189.25,205.25,221.25,237.25,253.25,285.25,317.25,349.25,381.25,413.25,445.25,477.25,3,3.008789,3.0097656,3.0107422,3.0117188,3.0126953,3.0136719,3.0146484,3.015625,3.0175781,3.0195312,3.0214844,3.0234375,3.0253906,3.0273438,3.0292969,3.03125,3.0351562,3.0390625,3.0429688,3.046875,3.0507812,3.0546875,3.0585938,3.0625,3.0703125,3.078125,3.0859375,3.09375,3.1015625,3.109375,3.1171875,3.125,3.140625,3.15625,3.171875,3.1875,3.203125,3.21875,3.234375,3.25,3.28125,3.3125,3.34375,3.375,3.40625,3.4375,3.46875,3.5,3.5625,3.625,3.6875,3.75,3.8125,3.875,3.9375,4,4.125,4.25,4.375,4.5,4.625,4.75,4.875,5,5.25,5.5,5.75,6,6.25,6.5,6.75,7,7.5,8,8.5,9,9.5,10,10.5,11,12,13,14,15,16,17,18,19,21,23,25,27,29,31,33,35,39,43,47,51,55,59,63,67,75,83,91,99,107,115,123,131,147,163,179,195,211,227,243,259,291,323,355,387,419,451,483,2.9921875,2.991211,2.9902344,2.9892578,2.9882812,2.9873047,2.9863281,2.9853516,2.984375,2.9824219,2.9804688,2.9785156,2.9765625,2.9746094,2.9726562,2.9707031,2.96875,2.9648438,2.9609375,2.9570312,2.953125,2.9492188,2.9453125,2.9414062,2.9375,2.9296875,2.921875,2.9140625,2.90625,2.8984375,2.890625,2.8828125,2.875,2.859375,2.84375,2.828125,2.8125,2.796875,2.78125,2.765625,2.75,2.71875,2.6875,2.65625,2.625,2.59375,2.5625,2.53125,2.5,2.4375,2.375,2.3125,2.25,2.1875,2.125,2.0625,2,1.875,1.75,1.625,1.5,1.375,1.25,1.125,1,0.75,0.5,0.25,0,0.25,0.5,0.75,1,1.5,2,2.5,3,3.5,4,4.5,5,6,7,8,9,10,11,12,13,15,17,19,21,23,25,27,29,33,37,41,45,49,53,57,61,69,77,85,93,101,109,117,125,141,157,173,189,205,221,237,253,285,317,349,381,413,445,477,3.25,3.258789,3.2597656,3.2607422,3.2617188,3.2626953,3.2636719,3.2646484,3.265625,3.2675781,3.2695312,3.2714844,3.2734375,3.2753906,3.2773438,3.2792969,3.28125,3.2851562,3.2890625,3.2929688,3.296875,3.3007812,3.3046875,3.3085938,3.3125,3.3203125,3.328125,3.3359375,3.34375,3.3515625,3.359375,3.3671875,3.375,3.390625,3.40625,3.421875,3.4375,3.453125,3.46875,3.484375,3.5,3.53125,3.5625,3.59375,3.625,3.65625,3.6875,3.71875,3.75,3.8125,3.875,3.9375,4,4.0625,4.125,4.1875,4.25,4.375,4.5,4.625,4.75,4.875,5,5.125,5.25,5.5,5.75,6,6.25,6.5,6.75,7,7.25,7.75,8.25,8.75,9.25,9.75,10.25,10.75,11.25,12.25,13.25,14.25,15.25,16.25,17.25,18.25,19.25,21.25,23.25,25.25,27.25,29.25,31.25,33.25,35.25,39.25,43.25,47.25,51.25,55.25,59.25,63.25,67.25,75.25,83.25,91.25,99.25,107.25,115.25,123.25,131.25,147.25,163.25,179.25,195.25,211.25,227.25,243.25,259.25,291.25,323.25,355.25,387.25,419.25,451.25,483.25,3.2421875,3.241211,3.2402344,3.2392578,3.2382812,3.2373047,3.2363281,3.2353516,3.234375,3.2324219,3.2304688,3.2285156,3.2265625,3.2246094,3.2226562,3.2207031,3.21875,3.2148438,3.2109375,3.2070312,3.203125,3.1992188,3.1953125,3.1914062,3.1875,3.1796875,3.171875,3.1640625,3.15625,3.1484375,3.140625,3.1328125,3.125,3.109375,3.09375,3.078125,3.0625,3.046875,3.03125,3.015625,3,2.96875,2.9375,2.90625,2.875,2.84375,2.8125,2.78125,2.75,2.6875,2.625,2.5625,2.5,2.4375,2.375,2.3125,2.25,2.125,2,1.875,1.75,1.625,1.5,1.375,1.25,1,0.75,0.5,0.25,0,0.25,0.5,0.75,1.25,1.75,2.25,2.75,3.25,3.75,4.25,4.75,5.75,6.75,7.75,8.75,9.75,10.75,11.75,12.75,14.75,16.75,18.75,20.75,22.75,24.75,26.75,28.75,32.75,36.75,40.75,44.75,48.75,52.75,56.75,60.75,68.75,76.75,84.75,92.75,100.75,108.75,116.75,124.75,140.75,156.75,172.75,188.75,204.75,220.75,236.75,252.75,284.75,316.75,348.75,380.75,412.75,444.75,476.75,3.5,3.508789,3.5097656,3.5107422,3.5117188,3.5126953,3.5136719,3.5146484,3.515625,3.5175781,3.5195312,3.5214844,3.5234375,3.5253906,3.5273438,3.5292969,3.53125,3.5351562,3.5390625,3.5429688,3.546875,3.5507812,3.5546875,3.5585938,3.5625,3.5703125,3.578125,3.5859375,3.59375,3.6015625,3.609375,3.6171875,3.625,3.640625,3.65625,3.671875,3.6875,3.703125,3.71875,3.734375,3.75,3.78125,3.8125,3.84375,3.875,3.90625,3.9375,3.96875,4,4.0625,4.125,4.1875,4.25,4.3125,4.375,4.4375,4.5,4.625,4.75,4.875,5,5.125,5.25,5.375,5.5,5.75,6,6.25,6.5,6.75,7,7.25,7.5,8,8.5,9,9.5,10,10.5,11,11.5,12.5,13.5,14.5,15.5,16.5,17.5,18.5,19.5,21.5,23.5,25.5,27.5,29.5,31.5,33.5,35.5,39.5,43.5,47.5,51.5,55.5,59.5,63.5,67.5,75.5,83.5,91.5,99.5,107.5,115.5,123.5,131.5,147.5,163.5,179.5,195.5,211.5,227.5,243.5,259.5,291.5,323.5,355.5,387.5,419.5,451.5,483.5,3.4921875,3.491211,3.4902344,3.4892578,3.4882812,3.4873047,3.4863281,3.4853516,3.484375,3.4824219,3.4804688,3.4785156,3.4765625,3.4746094,3.4726562,3.4707031,3.46875,3.4648438,3.4609375,3.4570312,3.453125,3.4492188,3.4453125,3.4414062,3.4375,3.4296875,3.421875,3.4140625,3.40625,3.3984375,3.390625,3.3828125,3.375,3.359375,3.34375,3.328125,3.3125,3.296875,3.28125,3.265625,3.25,3.21875,3.1875,3.15625,3.125,3.09375,3.0625,3.03125,3,2.9375,2.875,2.8125,2.75,2.6875,2.625,2.5625,2.5,2.375,2.25,2.125,2,1.875,1.75,1.625,1.5,1.25,1,0.75,0.5,0.25,0,0.25,0.5,1,1.5,2,2.5,3,3.5,4,4.5,5.5,6.5,7.5,8.5,9.5,10.5,11.5,12.5,14.5,16.5,18.5,20.5,22.5,24.5,26.5,28.5,32.5,36.5,40.5,44.5,48.5,52.5,56.5,60.5,68.5,76.5,84.5,92.5,100.5,108.5,116.5,124.5,140.5,156.5,172.5,188.5,204.5,220.5,236.5,252.5,284.5,316.5,348.5,380.5,412.5,444.5,476.5,3.75,3.758789,3.7597656,3.7607422,3.7617188,3.7626953,3.7636719,3.7646484,3.765625,3.7675781,3.7695312,3.7714844,3.7734375,3.7753906,3.7773438,3.7792969,3.78125,3.7851562,3.7890625,3.7929688,3.796875,3.8007812,3.8046875,3.8085938,3.8125,3.8203125,3.828125,3.8359375,3.84375,3.8515625,3.859375,3.8671875,3.875,3.890625,3.90625,3.921875,3.9375,3.953125,3.96875,3.984375,4,4.03125,4.0625,4.09375,4.125,4.15625,4.1875,4.21875,4.25,4.3125,4.375,4.4375,4.5,4.5625,4.625,4.6875,4.75,4.875,5,5.125,5.25,5.375,5.5,5.625,5.75,6,6.25,6.5,6.75,7,7.25,7.5,7.75,8.25,8.75,9.25,9.75,10.25,10.75,11.25,11.75,12.75,13.75,14.75,15.75,16.75,17.75,18.75,19.75,21.75,23.75,25.75,27.75,29.75,31.75,33.75,35.75,39.75,43.75,47.75,51.75,55.75,59.75,63.75,67.75,75.75,83.75,91.75,99.75,107.75,115.75,123.75,131.75,147.75,163.75,179.75,195.75,211.75,227.75,243.75,259.75,291.75,323.75,355.75,387.75,419.75,451.75,483.75,3.7421875,3.741211,3.7402344,3.7392578,3.7382812,3.7373047,3.7363281,3.7353516,3.734375,3.7324219,3.7304688,3.7285156,3.7265625,3.7246094,3.7226562,3.7207031,3.71875,3.7148438,3.7109375,3.7070312,3.703125,3.6992188,3.6953125,3.6914062,3.6875,3.6796875,3.671875,3.6640625,3.65625,3.6484375,3.640625,3.6328125,3.625,3.609375,3.59375,3.578125,3.5625,3.546875,3.53125,3.515625,3.5,3.46875,3.4375,3.40625,3.375,3.34375,3.3125,3.28125,3.25,3.1875,3.125,3.0625,3,2.9375,2.875,2.8125,2.75,2.625,2.5,2.375,2.25,2.125,2,1.875,1.75,1.5,1.25,1,0.75,0.5,0.25,0,0.25,0.75,1.25,1.75,2.25,2.75,3.25,3.75,4.25,5.25,6.25,7.25,8.25,9.25,10.25,11.25,12.25,14.25,16.25,18.25,20.25,22.25,24.25,26.25,28.25,32.25,36.25,40.25,44.25,48.25,52.25,56.25,60.25,68.25,76.25,84.25,92.25,100.25,108.25,116.25,124.25,140.25,156.25,172.25,188.25,204.25,220.25,236.25,252.25,284.25,316.25,348.25,380.25,412.25,444.25,476.25,4,4.008789,4.0097656,4.010742,4.0117188,4.0126953,4.013672,4.0146484,4.015625,4.017578,4.0195312,4.0214844,4.0234375,4.0253906,4.0273438,4.029297,4.03125,4.0351562,4.0390625,4.0429688,4.046875,4.0507812,4.0546875,4.0585938,4.0625,4.0703125,4.078125,4.0859375,4.09375,4.1015625,4.109375,4.1171875,4.125,4.140625,4.15625,4.171875,4.1875,4.203125,4.21875,4.234375,4.25,4.28125,4.3125,4.34375,4.375,4.40625,4.4375,4.46875,4.5,4.5625,4.625,4.6875,4.75,4.8125,4.875,4.9375,5,5.125,5.25,5.375,5.5,5.625,5.75,5.875,6,6.25,6.5,6.75,7,7.25,7.5,7.75,8,8.5,9,9.5,10,10.5,11,11.5,12,13,14,15,16,17,18,19,20,22,24,26,28,30,32,34,36,40,44,48,52,56,60,64,68,76,84,92,100,108,116,124,132,148,164,180,196,212,228,244,260,292,324,356,388,420,452,484,3.9921875,3.991211,3.9902344,3.9892578,3.9882812,3.9873047,3.9863281,3.9853516,3.984375,3.9824219,3.9804688,3.9785156,3.9765625,3.9746094,3.9726562,3.9707031,3.96875,3.9648438,3.9609375,3.9570312,3.953125,3.9492188,3.9453125,3.9414062,3.9375,3.9296875,3.921875,3.9140625,3.90625,3.8984375,3.890625,3.8828125,3.875,3.859375,3.84375,3.828125,3.8125,3.796875,3.78125,3.765625,3.75,3.71875,3.6875,3.65625,3.625,3.59375,3.5625,3.53125,3.5,3.4375,3.375,3.3125,3.25,3.1875,3.125,3.0625,3,2.875,2.75,2.625,2.5,2.375,2.25,2.125,2,1.75,1.5,1.25,1,0.75,0.5,0.25,0,0.5,1,1.5,2,2.5,3,3.5,4,5,6,7,8,9,10,11,12,14,16,18,20,22,24,26,28,32,36,40,44,48,52,56,60,68,76,84,92,100,108,116,124,140,156,172,188,204,220,236,252,284,316,348,380,412,444,476,4.5,4.508789,4.5097656,4.510742,4.5117188,4.5126953,4.513672,4.5146484,4.515625,4.517578,4.5195312,4.5214844,4.5234375,4.5253906,4.5273438,4.529297,4.53125,4.5351562,4.5390625,4.5429688,4.546875,4.5507812,4.5546875,4.5585938,4.5625,4.5703125,4.578125,4.5859375,4.59375,4.6015625,4.609375,4.6171875,4.625,4.640625,4.65625,4.671875,4.6875,4.703125,4.71875,4.734375,4.75,4.78125,4.8125,4.84375,4.875,4.90625,4.9375,4.96875,5,5.0625,5.125,5.1875,5.25,5.3125,5.375,5.4375,5.5,5.625,5.75,5.875,6,6.125,6.25,6.375,6.5,6.75,7,7.25,7.5,7.75,8,8.25,8.5,9,9.5,10,10.5,11,11.5,12,12.5,13.5,14.5,15.5,16.5,17.5,18.5,19.5,20.5,22.5,24.5,26.5,28.5,30.5,32.5,34.5,36.5,40.5,44.5,48.5,52.5,56.5,60.5,64.5,68.5,76.5,84.5,92.5,100.5,108.5,116.5,124.5,132.5,148.5,164.5,180.5,196.5,212.5,228.5,244.5,260.5,292.5,324.5,356.5,388.5,420.5,452.5,484.5,4.4921875,4.491211,4.4902344,4.489258,4.4882812,4.4873047,4.486328,4.4853516,4.484375,4.482422,4.4804688,4.4785156,4.4765625,4.4746094,4.4726562,4.470703,4.46875,4.4648438,4.4609375,4.4570312,4.453125,4.4492188,4.4453125,4.4414062,4.4375,4.4296875,4.421875,4.4140625,4.40625,4.3984375,4.390625,4.3828125,4.375,4.359375,4.34375,4.328125,4.3125,4.296875,4.28125,4.265625,4.25,4.21875,4.1875,4.15625,4.125,4.09375,4.0625,4.03125,4,3.9375,3.875,3.8125,3.75,3.6875,3.625,3.5625,3.5,3.375,3.25,3.125,3,2.875,2.75,2.625,2.5,2.25,2,1.75,1.5,1.25,1,0.75,0.5,0,0.5,1,1.5,2,2.5,3,3.5,4.5,5.5,6.5,7.5,8.5,9.5,10.5,11.5,13.5,15.5,17.5,19.5,21.5,23.5,25.5,27.5,31.5,35.5,39.5,43.5,47.5,51.5,55.5,59.5,67.5,75.5,83.5,91.5,99.5,107.5,115.5,123.5,139.5,155.5,171.5,187.5,203.5,219.5,235.5,251.5,283.5,315.5,347.5,379.5,411.5,443.5,475.5,5,5.008789,5.0097656,5.010742,5.0117188,5.0126953,5.013672,5.0146484,5.015625,5.017578,5.0195312,5.0214844,5.0234375,5.0253906,5.0273438,5.029297,5.03125,5.0351562,5.0390625,5.0429688,5.046875,5.0507812,5.0546875,5.0585938,5.0625,5.0703125,5.078125,5.0859375,5.09375,5.1015625,5.109375,5.1171875,5.125,5.140625,5.15625,5.171875,5.1875,5.203125,5.21875,5.234375,5.25,5.28125,5.3125,5.34375,5.375,5.40625,5.4375,5.46875,5.5,5.5625,5.625,5.6875,5.75,5.8125,5.875,5.9375,6,6.125,6.25,6.375,6.5,6.625,6.75,6.875,7,7.25,7.5,7.75,8,8.25,8.5,8.75,9,9.5,10,10.5,11,11.5,12,12.5,13,14,15,16,17,18,19,20,21,23,25,27,29,31,33,35,37,41,45,49,53,57,61,65,69,77,85,93,101,109,117,125,133,149,165,181,197,213,229,245,261,293,325,357,389,421,453,485,4.9921875,4.991211,4.9902344,4.989258,4.9882812,4.9873047,4.986328,4.9853516,4.984375,4.982422,4.9804688,4.9785156,4.9765625,4.9746094,4.9726562,4.970703,4.96875,4.9648438,4.9609375,4.9570312,4.953125,4.9492188,4.9453125,4.9414062,4.9375,4.9296875,4.921875,4.9140625,4.90625,4.8984375,4.890625,4.8828125,4.875,4.859375,4.84375,4.828125,4.8125,4.796875,4.78125,4.765625,4.75,4.71875,4.6875,4.65625,4.625,4.59375,4.5625,4.53125,4.5,4.4375,4.375,4.3125,4.25,4.1875,4.125,4.0625,4,3.875,3.75,3.625,3.5,3.375,3.25,3.125,3,2.75,2.5,2.25,2,1.75,1.5,1.25,1,0.5,0,0.5,1,1.5,2,2.5,3,4,5,6,7,8,9,10,11,13,15,17,19,21,23,25,27,31,35,39,43,47,51,55,59,67,75,83,91,99,107,115,123,139,155,171,187,203,219,235,251,283,315,347,379,411,443,475,5.5,5.508789,5.5097656,5.510742,5.5117188,5.5126953,5.513672,5.5146484,5.515625,5.517578,5.5195312,5.5214844,5.5234375,5.5253906,5.5273438,5.529297,5.53125,5.5351562,5.5390625,5.5429688,5.546875,5.5507812,5.5546875,5.5585938,5.5625,5.5703125,5.578125,5.5859375,5.59375,5.6015625,5.609375,5.6171875,5.625,5.640625,5.65625,5.671875,5.6875,5.703125,5.71875,5.734375,5.75,5.78125,5.8125,5.84375,5.875,5.90625,5.9375,5.96875,6,6.0625,6.125,6.1875,6.25,6.3125,6.375,6.4375,6.5,6.625,6.75,6.875,7,7.125,7.25,7.375,7.5,7.75,8,8.25,8.5,8.75,9,9.25,9.5,10,10.5,11,11.5,12,12.5,13,13.5,14.5,15.5,16.5,17.5,18.5,19.5,20.5,21.5,23.5,25.5,27.5,29.5,31.5,33.5,35.5,37.5,41.5,45.5,49.5,53.5,57.5,61.5,65.5,69.5,77.5,85.5,93.5,101.5,109.5,117.5,125.5,133.5,149.5,165.5,181.5,197.5,213.5,229.5,245.5,261.5,293.5,325.5,357.5,389.5,421.5,453.5,485.5,5.4921875,5.491211,5.4902344,5.489258,5.4882812,5.4873047,5.486328,5.4853516,5.484375,5.482422,5.4804688,5.4785156,5.4765625,5.4746094,5.4726562,5.470703,5.46875,5.4648438,5.4609375,5.4570312,5.453125,5.4492188,5.4453125,5.4414062,5.4375,5.4296875,5.421875,5.4140625,5.40625,5.3984375,5.390625,5.3828125,5.375,5.359375,5.34375,5.328125,5.3125,5.296875,5.28125,5.265625,5.25,5.21875,5.1875,5.15625,5.125,5.09375,5.0625,5.03125,5,4.9375,4.875,4.8125,4.75,4.6875,4.625,4.5625,4.5,4.375,4.25,4.125,4,3.875,3.75,3.625,3.5,3.25,3,2.75,2.5,2.25,2,1.75,1.5,1,0.5,0,0.5,1,1.5,2,2.5,3.5,4.5,5.5,6.5,7.5,8.5,9.5,10.5,12.5,14.5,16.5,18.5,20.5,22.5,24.5,26.5,30.5,34.5,38.5,42.5,46.5,50.5,54.5,58.5,66.5,74.5,82.5,90.5,98.5,106.5,114.5,122.5,138.5,154.5,170.5,186.5,202.5,218.5,234.5,250.5,282.5,314.5,346.5,378.5,410.5,442.5,474.5,6,6.008789,6.0097656,6.010742,6.0117188,6.0126953,6.013672,6.0146484,6.015625,6.017578,6.0195312,6.0214844,6.0234375,6.0253906,6.0273438,6.029297,6.03125,6.0351562,6.0390625,6.0429688,6.046875,6.0507812,6.0546875,6.0585938,6.0625,6.0703125,6.078125,6.0859375,6.09375,6.1015625,6.109375,6.1171875,6.125,6.140625,6.15625,6.171875,6.1875,6.203125,6.21875,6.234375,6.25,6.28125,6.3125,6.34375,6.375,6.40625,6.4375,6.46875,6.5,6.5625,6.625,6.6875,6.75,6.8125,6.875,6.9375,7,7.125,7.25,7.375,7.5,7.625,7.75,7.875,8,8.25,8.5,8.75,9,9.25,9.5,9.75,10,10.5,11,11.5,12,12.5,13,13.5,14,15,16,17,18,19,20,21,22,24,26,28,30,32,34,36,38,42,46,50,54,58,62,66,70,78,86,94,102,110,118,126,134,150,166,182,198,214,230,246,262,294,326,358,390,422,454,486,5.9921875,5.991211,5.9902344,5.989258,5.9882812,5.9873047,5.986328,5.9853516,5.984375,5.982422,5.9804688,5.9785156,5.9765625,5.9746094,5.9726562,5.970703,5.96875,5.9648438,5.9609375,5.9570312,5.953125,5.9492188,5.9453125,5.9414062,5.9375,5.9296875,5.921875,5.9140625,5.90625,5.8984375,5.890625,5.8828125,5.875,5.859375,5.84375,5.828125,5.8125,5.796875,5.78125,5.765625,5.75,5.71875,5.6875,5.65625,5.625,5.59375,5.5625,5.53125,5.5,5.4375,5.375,5.3125,5.25,5.1875,5.125,5.0625,5,4.875,4.75,4.625,4.5,4.375,4.25,4.125,4,3.75,3.5,3.25,3,2.75,2.5,2.25,2,1.5,1,0.5,0,0.5,1,1.5,2,3,4,5,6,7,8,9,10,12,14,16,18,20,22,24,26,30,34,38,42,46,50,54,58,66,74,82,90,98,106,114,122,138,154,170,186,202,218,234,250,282,314,346,378,410,442,474,6.5,6.508789,6.5097656,6.510742,6.5117188,6.5126953,6.513672,6.5146484,6.515625,6.517578,6.5195312,6.5214844,6.5234375,6.5253906,6.5273438,6.529297,6.53125,6.5351562,6.5390625,6.5429688,6.546875,6.5507812,6.5546875,6.5585938,6.5625,6.5703125,6.578125,6.5859375,6.59375,6.6015625,6.609375,6.6171875,6.625,6.640625,6.65625,6.671875,6.6875,6.703125,6.71875,6.734375,6.75,6.78125,6.8125,6.84375,6.875,6.90625,6.9375,6.96875,7,7.0625,7.125,7.1875,7.25,7.3125,7.375,7.4375,7.5,7.625,7.75,7.875,8,8.125,8.25,8.375,8.5,8.75,9,9.25,9.5,9.75,10,10.25,10.5,11,11.5,12,12.5,13,13.5,14,14.5,15.5,16.5,17.5,18.5,19.5,20.5,21.5,22.5,24.5,26.5,28.5,30.5,32.5,34.5,36.5,38.5,42.5,46.5,50.5,54.5,58.5,62.5,66.5,70.5,78.5,86.5,94.5,102.5,110.5,118.5,126.5,134.5,150.5,166.5,182.5,198.5,214.5,230.5,246.5,262.5,294.5,326.5,358.5,390.5,422.5,454.5,486.5,6.4921875,6.491211,6.4902344,6.489258,6.4882812,6.4873047,6.486328,6.4853516,6.484375,6.482422,6.4804688,6.4785156,6.4765625,6.4746094,6.4726562,6.470703,6.46875,6.4648438,6.4609375,6.4570312,6.453125,6.4492188,6.4453125,6.4414062,6.4375,6.4296875,6.421875,6.4140625,6.40625,6.3984375,6.390625,6.3828125,6.375,6.359375,6.34375,6.328125,6.3125,6.296875,6.28125,6.265625,6.25,6.21875,6.1875,6.15625,6.125,6.09375,6.0625,6.03125,6,5.9375,5.875,5.8125,5.75,5.6875,5.625,5.5625,5.5,5.375,5.25,5.125,5,4.875,4.75,4.625,4.5,4.25,4,3.75,3.5,3.25,3,2.75,2.5,2,1.5,1,0.5,0,0.5,1,1.5,2.5,3.5,4.5,5.5,6.5,7.5,8.5,9.5,11.5,13.5,15.5,17.5,19.5,21.5,23.5,25.5,29.5,33.5,37.5,41.5,45.5,49.5,53.5,57.5,65.5,73.5,81.5,89.5,97.5,105.5,113.5,121.5,137.5,153.5,169.5,185.5,201.5,217.5,233.5,249.5,281.5,313.5,345.5,377.5,409.5,441.5,473.5,7,7.008789,7.0097656,7.010742,7.0117188,7.0126953,7.013672,7.0146484,7.015625,7.017578,7.0195312,7.0214844,7.0234375,7.0253906,7.0273438,7.029297,7.03125,7.0351562,7.0390625,7.0429688,7.046875,7.0507812,7.0546875,7.0585938,7.0625,7.0703125,7.078125,7.0859375,7.09375,7.1015625,7.109375,7.1171875,7.125,7.140625,7.15625,7.171875,7.1875,7.203125,7.21875,7.234375,7.25,7.28125,7.3125,7.34375,7.375,7.40625,7.4375,7.46875,7.5,7.5625,7.625,7.6875,7.75,7.8125,7.875,7.9375,8,8.125,8.25,8.375,8.5,8.625,8.75,8.875,9,9.25,9.5,9.75,10,10.25,10.5,10.75,11,11.5,12,12.5,13,13.5,14,14.5,15,16,17,18,19,20,21,22,23,25,27,29,31,33,35,37,39,43,47,51,55,59,63,67,71,79,87,95,103,111,119,127,135,151,167,183,199,215,231,247,263,295,327,359,391,423,455,487,6.9921875,6.991211,6.9902344,6.989258,6.9882812,6.9873047,6.986328,6.9853516,6.984375,6.982422,6.9804688,6.9785156,6.9765625,6.9746094,6.9726562,6.970703,6.96875,6.9648438,6.9609375,6.9570312,6.953125,6.9492188,6.9453125,6.9414062,6.9375,6.9296875,6.921875,6.9140625,6.90625,6.8984375,6.890625,6.8828125,6.875,6.859375,6.84375,6.828125,6.8125,6.796875,6.78125,6.765625,6.75,6.71875,6.6875,6.65625,6.625,6.59375,6.5625,6.53125,6.5,6.4375,6.375,6.3125,6.25,6.1875,6.125,6.0625,6,5.875,5.75,5.625,5.5,5.375,5.25,5.125,5,4.75,4.5,4.25,4,3.75,3.5,3.25,3,2.5,2,1.5,1,0.5,0,0.5,1,2,3,4,5,6,7,8,9,11,13,15,17,19,21,23,25,29,33,37,41,45,49,53,57,65,73,81,89,97,105,113,121,137,153,169,185,201,217,233,249,281,313,345,377,409,441,473,7.5,7.508789,7.5097656,7.510742,7.5117188,7.5126953,7.513672,7.5146484,7.515625,7.517578,7.5195312,7.5214844,7.5234375,7.5253906,7.5273438,7.529297,7.53125,7.5351562,7.5390625,7.5429688,7.546875,7.5507812,7.5546875,7.5585938,7.5625,7.5703125,7.578125,7.5859375,7.59375,7.6015625,7.609375,7.6171875,7.625,7.640625,7.65625,7.671875,7.6875,7.703125,7.71875,7.734375,7.75,7.78125,7.8125,7.84375,7.875,7.90625,7.9375,7.96875,8,8.0625,8.125,8.1875,8.25,8.3125,8.375,8.4375,8.5,8.625,8.75,8.875,9,9.125,9.25,9.375,9.5,9.75,10,10.25,10.5,10.75,11,11.25,11.5,12,12.5,13,13.5,14,14.5,15,15.5,16.5,17.5,18.5,19.5,20.5,21.5,22.5,23.5,25.5,27.5,29.5,31.5,33.5,35.5,37.5,39.5,43.5,47.5,51.5,55.5,59.5,63.5,67.5,71.5,79.5,87.5,95.5,103.5,111.5,119.5,127.5,135.5,151.5,167.5,183.5,199.5,215.5,231.5,247.5,263.5,295.5,327.5,359.5,391.5,423.5,455.5,487.5,7.4921875,7.491211,7.4902344,7.489258,7.4882812,7.4873047,7.486328,7.4853516,7.484375,7.482422,7.4804688,7.4785156,7.4765625,7.4746094,7.4726562,7.470703,7.46875,7.4648438,7.4609375,7.4570312,7.453125,7.4492188,7.4453125,7.4414062,7.4375,7.4296875,7.421875,7.4140625,7.40625,7.3984375,7.390625,7.3828125,7.375,7.359375,7.34375,7.328125,7.3125,7.296875,7.28125,7.265625,7.25,7.21875,7.1875,7.15625,7.125,7.09375,7.0625,7.03125,7,6.9375,6.875,6.8125,6.75,6.6875,6.625,6.5625,6.5,6.375,6.25,6.125,6,5.875,5.75,5.625,5.5,5.25,5,4.75,4.5,4.25,4,3.75,3.5,3,2.5,2,1.5,1,0.5,0,0.5,1.5,2.5,3.5,4.5,5.5,6.5,7.5,8.5,10.5,12.5,14.5,16.5,18.5,20.5,22.5,24.5,28.5,32.5,36.5,40.5,44.5,48.5,52.5,56.5,64.5,72.5,80.5,88.5,96.5,104.5,112.5,120.5,136.5,152.5,168.5,184.5,200.5,216.5,232.5,248.5,280.5,312.5,344.5,376.5,408.5,440.5,472.5,8,8.008789,8.009766,8.010742,8.011719,8.012695,8.013672,8.014648,8.015625,8.017578,8.019531,8.021484,8.0234375,8.025391,8.027344,8.029297,8.03125,8.035156,8.0390625,8.042969,8.046875,8.050781,8.0546875,8.058594,8.0625,8.0703125,8.078125,8.0859375,8.09375,8.1015625,8.109375,8.1171875,8.125,8.140625,8.15625,8.171875,8.1875,8.203125,8.21875,8.234375,8.25,8.28125,8.3125,8.34375,8.375,8.40625,8.4375,8.46875,8.5,8.5625,8.625,8.6875,8.75,8.8125,8.875,8.9375,9,9.125,9.25,9.375,9.5,9.625,9.75,9.875,10,10.25,10.5,10.75,11,11.25,11.5,11.75,12,12.5,13,13.5,14,14.5,15,15.5,16,17,18,19,20,21,22,23,24,26,28,30,32,34,36,38,40,44,48,52,56,60,64,68,72,80,88,96,104,112,120,128,136,152,168,184,200,216,232,248,264,296,328,360,392,424,456,488,7.9921875,7.991211,7.9902344,7.989258,7.9882812,7.9873047,7.986328,7.9853516,7.984375,7.982422,7.9804688,7.9785156,7.9765625,7.9746094,7.9726562,7.970703,7.96875,7.9648438,7.9609375,7.9570312,7.953125,7.9492188,7.9453125,7.9414062,7.9375,7.9296875,7.921875,7.9140625,7.90625,7.8984375,7.890625,7.8828125,7.875,7.859375,7.84375,7.828125,7.8125,7.796875,7.78125,7.765625,7.75,7.71875,7.6875,7.65625,7.625,7.59375,7.5625,7.53125,7.5,7.4375,7.375,7.3125,7.25,7.1875,7.125,7.0625,7,6.875,6.75,6.625,6.5,6.375,6.25,6.125,6,5.75,5.5,5.25,5,4.75,4.5,4.25,4,3.5,3,2.5,2,1.5,1,0.5,0,1,2,3,4,5,6,7,8,10,12,14,16,18,20,22,24,28,32,36,40,44,48,52,56,64,72,80,88,96,104,112,120,136,152,168,184,200,216,232,248,280,312,344,376,408,440,472,9,9.008789,9.009766,9.010742,9.011719,9.012695,9.013672,9.014648,9.015625,9.017578,9.019531,9.021484,9.0234375,9.025391,9.027344,9.029297,9.03125,9.035156,9.0390625,9.042969,9.046875,9.050781,9.0546875,9.058594,9.0625,9.0703125,9.078125,9.0859375,9.09375,9.1015625,9.109375,9.1171875,9.125,9.140625,9.15625,9.171875,9.1875,9.203125,9.21875,9.234375,9.25,9.28125,9.3125,9.34375,9.375,9.40625,9.4375,9.46875,9.5,9.5625,9.625,9.6875,9.75,9.8125,9.875,9.9375,10,10.125,10.25,10.375,10.5,10.625,10.75,10.875,11,11.25,11.5,11.75,12,12.25,12.5,12.75,13,13.5,14,14.5,15,15.5,16,16.5,17,18,19,20,21,22,23,24,25,27,29,31,33,35,37,39,41,45,49,53,57,61,65,69,73,81,89,97,105,113,121,129,137,153,169,185,201,217,233,249,265,297,329,361,393,425,457,489,8.9921875,8.991211,8.990234,8.989258,8.988281,8.987305,8.986328,8.985352,8.984375,8.982422,8.980469,8.978516,8.9765625,8.974609,8.972656,8.970703,8.96875,8.964844,8.9609375,8.957031,8.953125,8.949219,8.9453125,8.941406,8.9375,8.9296875,8.921875,8.9140625,8.90625,8.8984375,8.890625,8.8828125,8.875,8.859375,8.84375,8.828125,8.8125,8.796875,8.78125,8.765625,8.75,8.71875,8.6875,8.65625,8.625,8.59375,8.5625,8.53125,8.5,8.4375,8.375,8.3125,8.25,8.1875,8.125,8.0625,8,7.875,7.75,7.625,7.5,7.375,7.25,7.125,7,6.75,6.5,6.25,6,5.75,5.5,5.25,5,4.5,4,3.5,3,2.5,2,1.5,1,0,1,2,3,4,5,6,7,9,11,13,15,17,19,21,23,27,31,35,39,43,47,51,55,63,71,79,87,95,103,111,119,135,151,167,183,199,215,231,247,279,311,343,375,407,439,471,10,10.008789,10.009766,10.010742,10.011719,10.012695,10.013672,10.014648,10.015625,10.017578,10.019531,10.021484,10.0234375,10.025391,10.027344,10.029297,10.03125,10.035156,10.0390625,10.042969,10.046875,10.050781,10.0546875,10.058594,10.0625,10.0703125,10.078125,10.0859375,10.09375,10.1015625,10.109375,10.1171875,10.125,10.140625,10.15625,10.171875,10.1875,10.203125,10.21875,10.234375,10.25,10.28125,10.3125,10.34375,10.375,10.40625,10.4375,10.46875,10.5,10.5625,10.625,10.6875,10.75,10.8125,10.875,10.9375,11,11.125,11.25,11.375,11.5,11.625,11.75,11.875,12,12.25,12.5,12.75,13,13.25,13.5,13.75,14,14.5,15,15.5,16,16.5,17,17.5,18,19,20,21,22,23,24,25,26,28,30,32,34,36,38,40,42,46,50,54,58,62,66,70,74,82,90,98,106,114,122,130,138,154,170,186,202,218,234,250,266,298,330,362,394,426,458,490,9.9921875,9.991211,9.990234,9.989258,9.988281,9.987305,9.986328,9.985352,9.984375,9.982422,9.980469,9.978516,9.9765625,9.974609,9.972656,9.970703,9.96875,9.964844,9.9609375,9.957031,9.953125,9.949219,9.9453125,9.941406,9.9375,9.9296875,9.921875,9.9140625,9.90625,9.8984375,9.890625,9.8828125,9.875,9.859375,9.84375,9.828125,9.8125,9.796875,9.78125,9.765625,9.75,9.71875,9.6875,9.65625,9.625,9.59375,9.5625,9.53125,9.5,9.4375,9.375,9.3125,9.25,9.1875,9.125,9.0625,9,8.875,8.75,8.625,8.5,8.375,8.25,8.125,8,7.75,7.5,7.25,7,6.75,6.5,6.25,6,5.5,5,4.5,4,3.5,3,2.5,2,1,0,1,2,3,4,5,6,8,10,12,14,16,18,20,22,26,30,34,38,42,46,50,54,62,70,78,86,94,102,110,118,134,150,166,182,198,214,230,246,278,310,342,374,406,438,470,11,11.008789,11.009766,11.010742,11.011719,11.012695,11.013672,11.014648,11.015625,11.017578,11.019531,11.021484,11.0234375,11.025391,11.027344,11.029297,11.03125,11.035156,11.0390625,11.042969,11.046875,11.050781,11.0546875,11.058594,11.0625,11.0703125,11.078125,11.0859375,11.09375,11.1015625,11.109375,11.1171875,11.125,11.140625,11.15625,11.171875,11.1875,11.203125,11.21875,11.234375,11.25,11.28125,11.3125,11.34375,11.375,11.40625,11.4375,11.46875,11.5,11.5625,11.625,11.6875,11.75,11.8125,11.875,11.9375,12,12.125,12.25,12.375,12.5,12.625,12.75,12.875,13,13.25,13.5,13.75,14,14.25,14.5,14.75,15,15.5,16,16.5,17,17.5,18,18.5,19,20,21,22,23,24,25,26,27,29,31,33,35,37,39,41,43,47,51,55,59,63,67,71,75,83,91,99,107,115,123,131,139,155,171,187,203,219,235,251,267,299,331,363,395,427,459,491,10.9921875,10.991211,10.990234,10.989258,10.988281,10.987305,10.986328,10.985352,10.984375,10.982422,10.980469,10.978516,10.9765625,10.974609,10.972656,10.970703,10.96875,10.964844,10.9609375,10.957031,10.953125,10.949219,10.9453125,10.941406,10.9375,10.9296875,10.921875,10.9140625,10.90625,10.8984375,10.890625,10.8828125,10.875,10.859375,10.84375,10.828125,10.8125,10.796875,10.78125,10.765625,10.75,10.71875,10.6875,10.65625,10.625,10.59375,10.5625,10.53125,10.5,10.4375,10.375,10.3125,10.25,10.1875,10.125,10.0625,10,9.875,9.75,9.625,9.5,9.375,9.25,9.125,9,8.75,8.5,8.25,8,7.75,7.5,7.25,7,6.5,6,5.5,5,4.5,4,3.5,3,2,1,0,1,2,3,4,5,7,9,11,13,15,17,19,21,25,29,33,37,41,45,49,53,61,69,77,85,93,101,109,117,133,149,165,181,197,213,229,245,277,309,341,373,405,437,469,12,12.008789,12.009766,12.010742,12.011719,12.012695,12.013672,12.014648,12.015625,12.017578,12.019531,12.021484,12.0234375,12.025391,12.027344,12.029297,12.03125,12.035156,12.0390625,12.042969,12.046875,12.050781,12.0546875,12.058594,12.0625,12.0703125,12.078125,12.0859375,12.09375,12.1015625,12.109375,12.1171875,12.125,12.140625,12.15625,12.171875,12.1875,12.203125,12.21875,12.234375,12.25,12.28125,12.3125,12.34375,12.375,12.40625,12.4375,12.46875,12.5,12.5625,12.625,12.6875,12.75,12.8125,12.875,12.9375,13,13.125,13.25,13.375,13.5,13.625,13.75,13.875,14,14.25,14.5,14.75,15,15.25,15.5,15.75,16,16.5,17,17.5,18,18.5,19,19.5,20,21,22,23,24,25,26,27,28,30,32,34,36,38,40,42,44,48,52,56,60,64,68,72,76,84,92,100,108,116,124,132,140,156,172,188,204,220,236,252,268,300,332,364,396,428,460,492,11.9921875,11.991211,11.990234,11.989258,11.988281,11.987305,11.986328,11.985352,11.984375,11.982422,11.980469,11.978516,11.9765625,11.974609,11.972656,11.970703,11.96875,11.964844,11.9609375,11.957031,11.953125,11.949219,11.9453125,11.941406,11.9375,11.9296875,11.921875,11.9140625,11.90625,11.8984375,11.890625,11.8828125,11.875,11.859375,11.84375,11.828125,11.8125,11.796875,11.78125,11.765625,11.75,11.71875,11.6875,11.65625,11.625,11.59375,11.5625,11.53125,11.5,11.4375,11.375,11.3125,11.25,11.1875,11.125,11.0625,11,10.875,10.75,10.625,10.5,10.375,10.25,10.125,10,9.75,9.5,9.25,9,8.75,8.5,8.25,8,7.5,7,6.5,6,5.5,5,4.5,4,3,2,1,0,1,2,3,4,6,8,10,12,14,16,18,20,24,28,32,36,40,44,48,52,60,68,76,84,92,100,108,116,132,148,164,180,196,212,228,244,276,308,340,372,404,436,468,13,13.008789,13.009766,13.010742,13.011719,13.012695,13.013672,13.014648,13.015625,13.017578,13.019531,13.021484,13.0234375,13.025391,13.027344,13.029297,13.03125,13.035156,13.0390625,13.042969,13.046875,13.050781,13.0546875,13.058594,13.0625,13.0703125,13.078125,13.0859375,13.09375,13.1015625,13.109375,13.1171875,13.125,13.140625,13.15625,13.171875,13.1875,13.203125,13.21875,13.234375,13.25,13.28125,13.3125,13.34375,13.375,13.40625,13.4375,13.46875,13.5,13.5625,13.625,13.6875,13.75,13.8125,13.875,13.9375,14,14.125,14.25,14.375,14.5,14.625,14.75,14.875,15,15.25,15.5,15.75,16,16.25,16.5,16.75,17,17.5,18,18.5,19,19.5,20,20.5,21,22,23,24,25,26,27,28,29,31,33,35,37,39,41,43,45,49,53,57,61,65,69,73,77,85,93,101,109,117,125,133,141,157,173,189,205,221,237,253,269,301,333,365,397,429,461,493,12.9921875,12.991211,12.990234,12.989258,12.988281,12.987305,12.986328,12.985352,12.984375,12.982422,12.980469,12.978516,12.9765625,12.974609,12.972656,12.970703,12.96875,12.964844,12.9609375,12.957031,12.953125,12.949219,12.9453125,12.941406,12.9375,12.9296875,12.921875,12.9140625,12.90625,12.8984375,12.890625,12.8828125,12.875,12.859375,12.84375,12.828125,12.8125,12.796875,12.78125,12.765625,12.75,12.71875,12.6875,12.65625,12.625,12.59375,12.5625,12.53125,12.5,12.4375,12.375,12.3125,12.25,12.1875,12.125,12.0625,12,11.875,11.75,11.625,11.5,11.375,11.25,11.125,11,10.75,10.5,10.25,10,9.75,9.5,9.25,9,8.5,8,7.5,7,6.5,6,5.5,5,4,3,2,1,0,1,2,3,5,7,9,11,13,15,17,19,23,27,31,35,39,43,47,51,59,67,75,83,91,99,107,115,131,147,163,179,195,211,227,243,275,307,339,371,403,435,467,14,14.008789,14.009766,14.010742,14.011719,14.012695,14.013672,14.014648,14.015625,14.017578,14.019531,14.021484,14.0234375,14.025391,14.027344,14.029297,14.03125,14.035156,14.0390625,14.042969,14.046875,14.050781,14.0546875,14.058594,14.0625,14.0703125,14.078125,14.0859375,14.09375,14.1015625,14.109375,14.1171875,14.125,14.140625,14.15625,14.171875,14.1875,14.203125,14.21875,14.234375,14.25,14.28125,14.3125,14.34375,14.375,14.40625,14.4375,14.46875,14.5,14.5625,14.625,14.6875,14.75,14.8125,14.875,14.9375,15,15.125,15.25,15.375,15.5,15.625,15.75,15.875,16,16.25,16.5,16.75,17,17.25,17.5,17.75,18,18.5,19,19.5,20,20.5,21,21.5,22,23,24,25,26,27,28,29,30,32,34,36,38,40,42,44,46,50,54,58,62,66,70,74,78,86,94,102,110,118,126,134,142,158,174,190,206,222,238,254,270,302,334,366,398,430,462,494,13.9921875,13.991211,13.990234,13.989258,13.988281,13.987305,13.986328,13.985352,13.984375,13.982422,13.980469,13.978516,13.9765625,13.974609,13.972656,13.970703,13.96875,13.964844,13.9609375,13.957031,13.953125,13.949219,13.9453125,13.941406,13.9375,13.9296875,13.921875,13.9140625,13.90625,13.8984375,13.890625,13.8828125,13.875,13.859375,13.84375,13.828125,13.8125,13.796875,13.78125,13.765625,13.75,13.71875,13.6875,13.65625,13.625,13.59375,13.5625,13.53125,13.5,13.4375,13.375,13.3125,13.25,13.1875,13.125,13.0625,13,12.875,12.75,12.625,12.5,12.375,12.25,12.125,12,11.75,11.5,11.25,11,10.75,10.5,10.25,10,9.5,9,8.5,8,7.5,7,6.5,6,5,4,3,2,1,0,1,2,4,6,8,10,12,14,16,18,22,26,30,34,38,42,46,50,58,66,74,82,90,98,106,114,130,146,162,178,194,210,226,242,274,306,338,370,402,434,466,15,15.008789,15.009766,15.010742,15.011719,15.012695,15.013672,15.014648,15.015625,15.017578,15.019531,15.021484,15.0234375,15.025391,15.027344,15.029297,15.03125,15.035156,15.0390625,15.042969,15.046875,15.050781,15.0546875,15.058594,15.0625,15.0703125,15.078125,15.0859375,15.09375,15.1015625,15.109375,15.1171875,15.125,15.140625,15.15625,15.171875,15.1875,15.203125,15.21875,15.234375,15.25,15.28125,15.3125,15.34375,15.375,15.40625,15.4375,15.46875,15.5,15.5625,15.625,15.6875,15.75,15.8125,15.875,15.9375,16,16.125,16.25,16.375,16.5,16.625,16.75,16.875,17,17.25,17.5,17.75,18,18.25,18.5,18.75,19,19.5,20,20.5,21,21.5,22,22.5,23,24,25,26,27,28,29,30,31,33,35,37,39,41,43,45,47,51,55,59,63,67,71,75,79,87,95,103,111,119,127,135,143,159,175,191,207,223,239,255,271,303,335,367,399,431,463,495,14.9921875,14.991211,14.990234,14.989258,14.988281,14.987305,14.986328,14.985352,14.984375,14.982422,14.980469,14.978516,14.9765625,14.974609,14.972656,14.970703,14.96875,14.964844,14.9609375,14.957031,14.953125,14.949219,14.9453125,14.941406,14.9375,14.9296875,14.921875,14.9140625,14.90625,14.8984375,14.890625,14.8828125,14.875,14.859375,14.84375,14.828125,14.8125,14.796875,14.78125,14.765625,14.75,14.71875,14.6875,14.65625,14.625,14.59375,14.5625,14.53125,14.5,14.4375,14.375,14.3125,14.25,14.1875,14.125,14.0625,14,13.875,13.75,13.625,13.5,13.375,13.25,13.125,13,12.75,12.5,12.25,12,11.75,11.5,11.25,11,10.5,10,9.5,9,8.5,8,7.5,7,6,5,4,3,2,1,0,1,3,5,7,9,11,13,15,17,21,25,29,33,37,41,45,49,57,65,73,81,89,97,105,113,129,145,161,177,193,209,225,241,273,305,337,369,401,433,465,16,16.00879,16.009766,16.010742,16.011719,16.012695,16.013672,16.014648,16.015625,16.017578,16.019531,16.021484,16.023438,16.02539,16.027344,16.029297,16.03125,16.035156,16.039062,16.042969,16.046875,16.050781,16.054688,16.058594,16.0625,16.070312,16.078125,16.085938,16.09375,16.101562,16.109375,16.117188,16.125,16.140625,16.15625,16.171875,16.1875,16.203125,16.21875,16.234375,16.25,16.28125,16.3125,16.34375,16.375,16.40625,16.4375,16.46875,16.5,16.5625,16.625,16.6875,16.75,16.8125,16.875,16.9375,17,17.125,17.25,17.375,17.5,17.625,17.75,17.875,18,18.25,18.5,18.75,19,19.25,19.5,19.75,20,20.5,21,21.5,22,22.5,23,23.5,24,25,26,27,28,29,30,31,32,34,36,38,40,42,44,46,48,52,56,60,64,68,72,76,80,88,96,104,112,120,128,136,144,160,176,192,208,224,240,256,272,304,336,368,400,432,464,496,15.9921875,15.991211,15.990234,15.989258,15.988281,15.987305,15.986328,15.985352,15.984375,15.982422,15.980469,15.978516,15.9765625,15.974609,15.972656,15.970703,15.96875,15.964844,15.9609375,15.957031,15.953125,15.949219,15.9453125,15.941406,15.9375,15.9296875,15.921875,15.9140625,15.90625,15.8984375,15.890625,15.8828125,15.875,15.859375,15.84375,15.828125,15.8125,15.796875,15.78125,15.765625,15.75,15.71875,15.6875,15.65625,15.625,15.59375,15.5625,15.53125,15.5,15.4375,15.375,15.3125,15.25,15.1875,15.125,15.0625,15,14.875,14.75,14.625,14.5,14.375,14.25,14.125,14,13.75,13.5,13.25,13,12.75,12.5,12.25,12,11.5,11,10.5,10,9.5,9,8.5,8,7,6,5,4,3,2,1,0,2,4,6,8,10,12,14,16,20,24,28,32,36,40,44,48,56,64,72,80,88,96,104,112,128,144,160,176,192,208,224,240,272,304,336,368,400,432,464,18,18.00879,18.009766,18.010742,18.011719,18.012695,18.013672,18.014648,18.015625,18.017578,18.019531,18.021484,18.023438,18.02539,18.027344,18.029297,18.03125,18.035156,18.039062,18.042969,18.046875,18.050781,18.054688,18.058594,18.0625,18.070312,18.078125,18.085938,18.09375,18.101562,18.109375,18.117188,18.125,18.140625,18.15625,18.171875,18.1875,18.203125,18.21875,18.234375,18.25,18.28125,18.3125,18.34375,18.375,18.40625,18.4375,18.46875,18.5,18.5625,18.625,18.6875,18.75,18.8125,18.875,18.9375,19,19.125,19.25,19.375,19.5,19.625,19.75,19.875,20,20.25,20.5,20.75,21,21.25,21.5,21.75,22,22.5,23,23.5,24,24.5,25,25.5,26,27,28,29,30,31,32,33,34,36,38,40,42,44,46,48,50,54,58,62,66,70,74,78,82,90,98,106,114,122,130,138,146,162,178,194,210,226,242,258,274,306,338,370,402,434,466,498,17.992188,17.99121,17.990234,17.989258,17.988281,17.987305,17.986328,17.985352,17.984375,17.982422,17.980469,17.978516,17.976562,17.97461,17.972656,17.970703,17.96875,17.964844,17.960938,17.957031,17.953125,17.949219,17.945312,17.941406,17.9375,17.929688,17.921875,17.914062,17.90625,17.898438,17.890625,17.882812,17.875,17.859375,17.84375,17.828125,17.8125,17.796875,17.78125,17.765625,17.75,17.71875,17.6875,17.65625,17.625,17.59375,17.5625,17.53125,17.5,17.4375,17.375,17.3125,17.25,17.1875,17.125,17.0625,17,16.875,16.75,16.625,16.5,16.375,16.25,16.125,16,15.75,15.5,15.25,15,14.75,14.5,14.25,14,13.5,13,12.5,12,11.5,11,10.5,10,9,8,7,6,5,4,3,2,0,2,4,6,8,10,12,14,18,22,26,30,34,38,42,46,54,62,70,78,86,94,102,110,126,142,158,174,190,206,222,238,270,302,334,366,398,430,462,20,20.00879,20.009766,20.010742,20.011719,20.012695,20.013672,20.014648,20.015625,20.017578,20.019531,20.021484,20.023438,20.02539,20.027344,20.029297,20.03125,20.035156,20.039062,20.042969,20.046875,20.050781,20.054688,20.058594,20.0625,20.070312,20.078125,20.085938,20.09375,20.101562,20.109375,20.117188,20.125,20.140625,20.15625,20.171875,20.1875,20.203125,20.21875,20.234375,20.25,20.28125,20.3125,20.34375,20.375,20.40625,20.4375,20.46875,20.5,20.5625,20.625,20.6875,20.75,20.8125,20.875,20.9375,21,21.125,21.25,21.375,21.5,21.625,21.75,21.875,22,22.25,22.5,22.75,23,23.25,23.5,23.75,24,24.5,25,25.5,26,26.5,27,27.5,28,29,30,31,32,33,34,35,36,38,40,42,44,46,48,50,52,56,60,64,68,72,76,80,84,92,100,108,116,124,132,140,148,164,180,196,212,228,244,260,276,308,340,372,404,436,468,500,19.992188,19.99121,19.990234,19.989258,19.988281,19.987305,19.986328,19.985352,19.984375,19.982422,19.980469,19.978516,19.976562,19.97461,19.972656,19.970703,19.96875,19.964844,19.960938,19.957031,19.953125,19.949219,19.945312,19.941406,19.9375,19.929688,19.921875,19.914062,19.90625,19.898438,19.890625,19.882812,19.875,19.859375,19.84375,19.828125,19.8125,19.796875,19.78125,19.765625,19.75,19.71875,19.6875,19.65625,19.625,19.59375,19.5625,19.53125,19.5,19.4375,19.375,19.3125,19.25,19.1875,19.125,19.0625,19,18.875,18.75,18.625,18.5,18.375,18.25,18.125,18,17.75,17.5,17.25,17,16.75,16.5,16.25,16,15.5,15,14.5,14,13.5,13,12.5,12,11,10,9,8,7,6,5,4,2,0,2,4,6,8,10,12,16,20,24,28,32,36,40,44,52,60,68,76,84,92,100,108,124,140,156,172,188,204,220,236,268,300,332,364,396,428,460,22,22.00879,22.009766,22.010742,22.011719,22.012695,22.013672,22.014648,22.015625,22.017578,22.019531,22.021484,22.023438,22.02539,22.027344,22.029297,22.03125,22.035156,22.039062,22.042969,22.046875,22.050781,22.054688,22.058594,22.0625,22.070312,22.078125,22.085938,22.09375,22.101562,22.109375,22.117188,22.125,22.140625,22.15625,22.171875,22.1875,22.203125,22.21875,22.234375,22.25,22.28125,22.3125,22.34375,22.375,22.40625,22.4375,22.46875,22.5,22.5625,22.625,22.6875,22.75,22.8125,22.875,22.9375,23,23.125,23.25,23.375,23.5,23.625,23.75,23.875,24,24.25,24.5,24.75,25,25.25,25.5,25.75,26,26.5,27,27.5,28,28.5,29,29.5,30,31,32,33,34,35,36,37,38,40,42,44,46,48,50,52,54,58,62,66,70,74,78,82,86,94,102,110,118,126,134,142,150,166,182,198,214,230,246,262,278,310,342,374,406,438,470,502,21.992188,21.99121,21.990234,21.989258,21.988281,21.987305,21.986328,21.985352,21.984375,21.982422,21.980469,21.978516,21.976562,21.97461,21.972656,21.970703,21.96875,21.964844,21.960938,21.957031,21.953125,21.949219,21.945312,21.941406,21.9375,21.929688,21.921875,21.914062,21.90625,21.898438,21.890625,21.882812,21.875,21.859375,21.84375,21.828125,21.8125,21.796875,21.78125,21.765625,21.75,21.71875,21.6875,21.65625,21.625,21.59375,21.5625,21.53125,21.5,21.4375,21.375,21.3125,21.25,21.1875,21.125,21.0625,21,20.875,20.75,20.625,20.5,20.375,20.25,20.125,20,19.75,19.5,19.25,19,18.75,18.5,18.25,18,17.5,17,16.5,16,15.5,15,14.5,14,13,12,11,10,9,8,7,6,4,2,0,2,4,6,8,10,14,18,22,26,30,34,38,42,50,58,66,74,82,90,98,106,122,138,154,170,186,202,218,234,266,298,330,362,394,426,458,24,24.00879,24.009766,24.010742,24.011719,24.012695,24.013672,24.014648,24.015625,24.017578,24.019531,24.021484,24.023438,24.02539,24.027344,24.029297,24.03125,24.035156,24.039062,24.042969,24.046875,24.050781,24.054688,24.058594,24.0625,24.070312,24.078125,24.085938,24.09375,24.101562,24.109375,24.117188,24.125,24.140625,24.15625,24.171875,24.1875,24.203125,24.21875,24.234375,24.25,24.28125,24.3125,24.34375,24.375,24.40625,24.4375,24.46875,24.5,24.5625,24.625,24.6875,24.75,24.8125,24.875,24.9375,25,25.125,25.25,25.375,25.5,25.625,25.75,25.875,26,26.25,26.5,26.75,27,27.25,27.5,27.75,28,28.5,29,29.5,30,30.5,31,31.5,32,33,34,35,36,37,38,39,40,42,44,46,48,50,52,54,56,60,64,68,72,76,80,84,88,96,104,112,120,128,136,144,152,168,184,200,216,232,248,264,280,312,344,376,408,440,472,504,23.992188,23.99121,23.990234,23.989258,23.988281,23.987305,23.986328,23.985352,23.984375,23.982422,23.980469,23.978516,23.976562,23.97461,23.972656,23.970703,23.96875,23.964844,23.960938,23.957031,23.953125,23.949219,23.945312,23.941406,23.9375,23.929688,23.921875,23.914062,23.90625,23.898438,23.890625,23.882812,23.875,23.859375,23.84375,23.828125,23.8125,23.796875,23.78125,23.765625,23.75,23.71875,23.6875,23.65625,23.625,23.59375,23.5625,23.53125,23.5,23.4375,23.375,23.3125,23.25,23.1875,23.125,23.0625,23,22.875,22.75,22.625,22.5,22.375,22.25,22.125,22,21.75,21.5,21.25,21,20.75,20.5,20.25,20,19.5,19,18.5,18,17.5,17,16.5,16,15,14,13,12,11,10,9,8,6,4,2,0,2,4,6,8,12,16,20,24,28,32,36,40,48,56,64,72,80,88,96,104,120,136,152,168,184,200,216,232,264,296,328,360,392,424,456,26,26.00879,26.009766,26.010742,26.011719,26.012695,26.013672,26.014648,26.015625,26.017578,26.019531,26.021484,26.023438,26.02539,26.027344,26.029297,26.03125,26.035156,26.039062,26.042969,26.046875,26.050781,26.054688,26.058594,26.0625,26.070312,26.078125,26.085938,26.09375,26.101562,26.109375,26.117188,26.125,26.140625,26.15625,26.171875,26.1875,26.203125,26.21875,26.234375,26.25,26.28125,26.3125,26.34375,26.375,26.40625,26.4375,26.46875,26.5,26.5625,26.625,26.6875,26.75,26.8125,26.875,26.9375,27,27.125,27.25,27.375,27.5,27.625,27.75,27.875,28,28.25,28.5,28.75,29,29.25,29.5,29.75,30,30.5,31,31.5,32,32.5,33,33.5,34,35,36,37,38,39,40,41,42,44,46,48,50,52,54,56,58,62,66,70,74,78,82,86,90,98,106,114,122,130,138,146,154,170,186,202,218,234,250,266,282,314,346,378,410,442,474,506,25.992188,25.99121,25.990234,25.989258,25.988281,25.987305,25.986328,25.985352,25.984375,25.982422,25.980469,25.978516,25.976562,25.97461,25.972656,25.970703,25.96875,25.964844,25.960938,25.957031,25.953125,25.949219,25.945312,25.941406,25.9375,25.929688,25.921875,25.914062,25.90625,25.898438,25.890625,25.882812,25.875,25.859375,25.84375,25.828125,25.8125,25.796875,25.78125,25.765625,25.75,25.71875,25.6875,25.65625,25.625,25.59375,25.5625,25.53125,25.5,25.4375,25.375,25.3125,25.25,25.1875,25.125,25.0625,25,24.875,24.75,24.625,24.5,24.375,24.25,24.125,24,23.75,23.5,23.25,23,22.75,22.5,22.25,22,21.5,21,20.5,20,19.5,19,18.5,18,17,16,15,14,13,12,11,10,8,6,4,2,0,2,4,6,10,14,18,22,26,30,34,38,46,54,62,70,78,86,94,102,118,134,150,166,182,198,214,230,262,294,326,358,390,422,454,28,28.00879,28.009766,28.010742,28.011719,28.012695,28.013672,28.014648,28.015625,28.017578,28.019531,28.021484,28.023438,28.02539,28.027344,28.029297,28.03125,28.035156,28.039062,28.042969,28.046875,28.050781,28.054688,28.058594,28.0625,28.070312,28.078125,28.085938,28.09375,28.101562,28.109375,28.117188,28.125,28.140625,28.15625,28.171875,28.1875,28.203125,28.21875,28.234375,28.25,28.28125,28.3125,28.34375,28.375,28.40625,28.4375,28.46875,28.5,28.5625,28.625,28.6875,28.75,28.8125,28.875,28.9375,29,29.125,29.25,29.375,29.5,29.625,29.75,29.875,30,30.25,30.5,30.75,31,31.25,31.5,31.75,32,32.5,33,33.5,34,34.5,35,35.5,36,37,38,39,40,41,42,43,44,46,48,50,52,54,56,58,60,64,68,72,76,80,84,88,92,100,108,116,124,132,140,148,156,172,188,204,220,236,252,268,284,316,348,380,412,444,476,508,27.992188,27.99121,27.990234,27.989258,27.988281,27.987305,27.986328,27.985352,27.984375,27.982422,27.980469,27.978516,27.976562,27.97461,27.972656,27.970703,27.96875,27.964844,27.960938,27.957031,27.953125,27.949219,27.945312,27.941406,27.9375,27.929688,27.921875,27.914062,27.90625,27.898438,27.890625,27.882812,27.875,27.859375,27.84375,27.828125,27.8125,27.796875,27.78125,27.765625,27.75,27.71875,27.6875,27.65625,27.625,27.59375,27.5625,27.53125,27.5,27.4375,27.375,27.3125,27.25,27.1875,27.125,27.0625,27,26.875,26.75,26.625,26.5,26.375,26.25,26.125,26,25.75,25.5,25.25,25,24.75,24.5,24.25,24,23.5,23,22.5,22,21.5,21,20.5,20,19,18,17,16,15,14,13,12,10,8,6,4,2,0,2,4,8,12,16,20,24,28,32,36,44,52,60,68,76,84,92,100,116,132,148,164,180,196,212,228,260,292,324,356,388,420,452,30,30.00879,30.009766,30.010742,30.011719,30.012695,30.013672,30.014648,30.015625,30.017578,30.019531,30.021484,30.023438,30.02539,30.027344,30.029297,30.03125,30.035156,30.039062,30.042969,30.046875,30.050781,30.054688,30.058594,30.0625,30.070312,30.078125,30.085938,30.09375,30.101562,30.109375,30.117188,30.125,30.140625,30.15625,30.171875,30.1875,30.203125,30.21875,30.234375,30.25,30.28125,30.3125,30.34375,30.375,30.40625,30.4375,30.46875,30.5,30.5625,30.625,30.6875,30.75,30.8125,30.875,30.9375,31,31.125,31.25,31.375,31.5,31.625,31.75,31.875,32,32.25,32.5,32.75,33,33.25,33.5,33.75,34,34.5,35,35.5,36,36.5,37,37.5,38,39,40,41,42,43,44,45,46,48,50,52,54,56,58,60,62,66,70,74,78,82,86,90,94,102,110,118,126,134,142,150,158,174,190,206,222,238,254,270,286,318,350,382,414,446,478,510,29.992188,29.99121,29.990234,29.989258,29.988281,29.987305,29.986328,29.985352,29.984375,29.982422,29.980469,29.978516,29.976562,29.97461,29.972656,29.970703,29.96875,29.964844,29.960938,29.957031,29.953125,29.949219,29.945312,29.941406,29.9375,29.929688,29.921875,29.914062,29.90625,29.898438,29.890625,29.882812,29.875,29.859375,29.84375,29.828125,29.8125,29.796875,29.78125,29.765625,29.75,29.71875,29.6875,29.65625,29.625,29.59375,29.5625,29.53125,29.5,29.4375,29.375,29.3125,29.25,29.1875,29.125,29.0625,29,28.875,28.75,28.625,28.5,28.375,28.25,28.125,28,27.75,27.5,27.25,27,26.75,26.5,26.25,26,25.5,25,24.5,24,23.5,23,22.5,22,21,20,19,18,17,16,15,14,12,10,8,6,4,2,0,2,6,10,14,18,22,26,30,34,42,50,58,66,74,82,90,98,114,130,146,162,178,194,210,226,258,290,322,354,386,418,450,32,32.00879,32.009766,32.010742,32.01172,32.012695,32.01367,32.01465,32.015625,32.01758,32.01953,32.021484,32.023438,32.02539,32.027344,32.029297,32.03125,32.035156,32.039062,32.04297,32.046875,32.05078,32.054688,32.058594,32.0625,32.070312,32.078125,32.085938,32.09375,32.101562,32.109375,32.117188,32.125,32.140625,32.15625,32.171875,32.1875,32.203125,32.21875,32.234375,32.25,32.28125,32.3125,32.34375,32.375,32.40625,32.4375,32.46875,32.5,32.5625,32.625,32.6875,32.75,32.8125,32.875,32.9375,33,33.125,33.25,33.375,33.5,33.625,33.75,33.875,34,34.25,34.5,34.75,35,35.25,35.5,35.75,36,36.5,37,37.5,38,38.5,39,39.5,40,41,42,43,44,45,46,47,48,50,52,54,56,58,60,62,64,68,72,76,80,84,88,92,96,104,112,120,128,136,144,152,160,176,192,208,224,240,256,272,288,320,352,384,416,448,480,512,31.992188,31.99121,31.990234,31.989258,31.988281,31.987305,31.986328,31.985352,31.984375,31.982422,31.980469,31.978516,31.976562,31.97461,31.972656,31.970703,31.96875,31.964844,31.960938,31.957031,31.953125,31.949219,31.945312,31.941406,31.9375,31.929688,31.921875,31.914062,31.90625,31.898438,31.890625,31.882812,31.875,31.859375,31.84375,31.828125,31.8125,31.796875,31.78125,31.765625,31.75,31.71875,31.6875,31.65625,31.625,31.59375,31.5625,31.53125,31.5,31.4375,31.375,31.3125,31.25,31.1875,31.125,31.0625,31,30.875,30.75,30.625,30.5,30.375,30.25,30.125,30,29.75,29.5,29.25,29,28.75,28.5,28.25,28,27.5,27,26.5,26,25.5,25,24.5,24,23,22,21,20,19,18,17,16,14,12,10,8,6,4,2,0,4,8,12,16,20,24,28,32,40,48,56,64,72,80,88,96,112,128,144,160,176,192,208,224,256,288,320,352,384,416,448,36,36.00879,36.009766,36.010742,36.01172,36.012695,36.01367,36.01465,36.015625,36.01758,36.01953,36.021484,36.023438,36.02539,36.027344,36.029297,36.03125,36.035156,36.039062,36.04297,36.046875,36.05078,36.054688,36.058594,36.0625,36.070312,36.078125,36.085938,36.09375,36.101562,36.109375,36.117188,36.125,36.140625,36.15625,36.171875,36.1875,36.203125,36.21875,36.234375,36.25,36.28125,36.3125,36.34375,36.375,36.40625,36.4375,36.46875,36.5,36.5625,36.625,36.6875,36.75,36.8125,36.875,36.9375,37,37.125,37.25,37.375,37.5,37.625,37.75,37.875,38,38.25,38.5,38.75,39,39.25,39.5,39.75,40,40.5,41,41.5,42,42.5,43,43.5,44,45,46,47,48,49,50,51,52,54,56,58,60,62,64,66,68,72,76,80,84,88,92,96,100,108,116,124,132,140,148,156,164,180,196,212,228,244,260,276,292,324,356,388,420,452,484,516,35.992188,35.99121,35.990234,35.989258,35.98828,35.987305,35.98633,35.98535,35.984375,35.98242,35.98047,35.978516,35.976562,35.97461,35.972656,35.970703,35.96875,35.964844,35.960938,35.95703,35.953125,35.94922,35.945312,35.941406,35.9375,35.929688,35.921875,35.914062,35.90625,35.898438,35.890625,35.882812,35.875,35.859375,35.84375,35.828125,35.8125,35.796875,35.78125,35.765625,35.75,35.71875,35.6875,35.65625,35.625,35.59375,35.5625,35.53125,35.5,35.4375,35.375,35.3125,35.25,35.1875,35.125,35.0625,35,34.875,34.75,34.625,34.5,34.375,34.25,34.125,34,33.75,33.5,33.25,33,32.75,32.5,32.25,32,31.5,31,30.5,30,29.5,29,28.5,28,27,26,25,24,23,22,21,20,18,16,14,12,10,8,6,4,0,4,8,12,16,20,24,28,36,44,52,60,68,76,84,92,108,124,140,156,172,188,204,220,252,284,316,348,380,412,444,40,40.00879,40.009766,40.010742,40.01172,40.012695,40.01367,40.01465,40.015625,40.01758,40.01953,40.021484,40.023438,40.02539,40.027344,40.029297,40.03125,40.035156,40.039062,40.04297,40.046875,40.05078,40.054688,40.058594,40.0625,40.070312,40.078125,40.085938,40.09375,40.101562,40.109375,40.117188,40.125,40.140625,40.15625,40.171875,40.1875,40.203125,40.21875,40.234375,40.25,40.28125,40.3125,40.34375,40.375,40.40625,40.4375,40.46875,40.5,40.5625,40.625,40.6875,40.75,40.8125,40.875,40.9375,41,41.125,41.25,41.375,41.5,41.625,41.75,41.875,42,42.25,42.5,42.75,43,43.25,43.5,43.75,44,44.5,45,45.5,46,46.5,47,47.5,48,49,50,51,52,53,54,55,56,58,60,62,64,66,68,70,72,76,80,84,88,92,96,100,104,112,120,128,136,144,152,160,168,184,200,216,232,248,264,280,296,328,360,392,424,456,488,520,39.992188,39.99121,39.990234,39.989258,39.98828,39.987305,39.98633,39.98535,39.984375,39.98242,39.98047,39.978516,39.976562,39.97461,39.972656,39.970703,39.96875,39.964844,39.960938,39.95703,39.953125,39.94922,39.945312,39.941406,39.9375,39.929688,39.921875,39.914062,39.90625,39.898438,39.890625,39.882812,39.875,39.859375,39.84375,39.828125,39.8125,39.796875,39.78125,39.765625,39.75,39.71875,39.6875,39.65625,39.625,39.59375,39.5625,39.53125,39.5,39.4375,39.375,39.3125,39.25,39.1875,39.125,39.0625,39,38.875,38.75,38.625,38.5,38.375,38.25,38.125,38,37.75,37.5,37.25,37,36.75,36.5,36.25,36,35.5,35,34.5,34,33.5,33,32.5,32,31,30,29,28,27,26,25,24,22,20,18,16,14,12,10,8,4,0,4,8,12,16,20,24,32,40,48,56,64,72,80,88,104,120,136,152,168,184,200,216,248,280,312,344,376,408,440,44,44.00879,44.009766,44.010742,44.01172,44.012695,44.01367,44.01465,44.015625,44.01758,44.01953,44.021484,44.023438,44.02539,44.027344,44.029297,44.03125,44.035156,44.039062,44.04297,44.046875,44.05078,44.054688,44.058594,44.0625,44.070312,44.078125,44.085938,44.09375,44.101562,44.109375,44.117188,44.125,44.140625,44.15625,44.171875,44.1875,44.203125,44.21875,44.234375,44.25,44.28125,44.3125,44.34375,44.375,44.40625,44.4375,44.46875,44.5,44.5625,44.625,44.6875,44.75,44.8125,44.875,44.9375,45,45.125,45.25,45.375,45.5,45.625,45.75,45.875,46,46.25,46.5,46.75,47,47.25,47.5,47.75,48,48.5,49,49.5,50,50.5,51,51.5,52,53,54,55,56,57,58,59,60,62,64,66,68,70,72,74,76,80,84,88,92,96,100,104,108,116,124,132,140,148,156,164,172,188,204,220,236,252,268,284,300,332,364,396,428,460,492,524,43.992188,43.99121,43.990234,43.989258,43.98828,43.987305,43.98633,43.98535,43.984375,43.98242,43.98047,43.978516,43.976562,43.97461,43.972656,43.970703,43.96875,43.964844,43.960938,43.95703,43.953125,43.94922,43.945312,43.941406,43.9375,43.929688,43.921875,43.914062,43.90625,43.898438,43.890625,43.882812,43.875,43.859375,43.84375,43.828125,43.8125,43.796875,43.78125,43.765625,43.75,43.71875,43.6875,43.65625,43.625,43.59375,43.5625,43.53125,43.5,43.4375,43.375,43.3125,43.25,43.1875,43.125,43.0625,43,42.875,42.75,42.625,42.5,42.375,42.25,42.125,42,41.75,41.5,41.25,41,40.75,40.5,40.25,40,39.5,39,38.5,38,37.5,37,36.5,36,35,34,33,32,31,30,29,28,26,24,22,20,18,16,14,12,8,4,0,4,8,12,16,20,28,36,44,52,60,68,76,84,100,116,132,148,164,180,196,212,244,276,308,340,372,404,436,48,48.00879,48.009766,48.010742,48.01172,48.012695,48.01367,48.01465,48.015625,48.01758,48.01953,48.021484,48.023438,48.02539,48.027344,48.029297,48.03125,48.035156,48.039062,48.04297,48.046875,48.05078,48.054688,48.058594,48.0625,48.070312,48.078125,48.085938,48.09375,48.101562,48.109375,48.117188,48.125,48.140625,48.15625,48.171875,48.1875,48.203125,48.21875,48.234375,48.25,48.28125,48.3125,48.34375,48.375,48.40625,48.4375,48.46875,48.5,48.5625,48.625,48.6875,48.75,48.8125,48.875,48.9375,49,49.125,49.25,49.375,49.5,49.625,49.75,49.875,50,50.25,50.5,50.75,51,51.25,51.5,51.75,52,52.5,53,53.5,54,54.5,55,55.5,56,57,58,59,60,61,62,63,64,66,68,70,72,74,76,78,80,84,88,92,96,100,104,108,112,120,128,136,144,152,160,168,176,192,208,224,240,256,272,288,304,336,368,400,432,464,496,528,47.992188,47.99121,47.990234,47.989258,47.98828,47.987305,47.98633,47.98535,47.984375,47.98242,47.98047,47.978516,47.976562,47.97461,47.972656,47.970703,47.96875,47.964844,47.960938,47.95703,47.953125,47.94922,47.945312,47.941406,47.9375,47.929688,47.921875,47.914062,47.90625,47.898438,47.890625,47.882812,47.875,47.859375,47.84375,47.828125,47.8125,47.796875,47.78125,47.765625,47.75,47.71875,47.6875,47.65625,47.625,47.59375,47.5625,47.53125,47.5,47.4375,47.375,47.3125,47.25,47.1875,47.125,47.0625,47,46.875,46.75,46.625,46.5,46.375,46.25,46.125,46,45.75,45.5,45.25,45,44.75,44.5,44.25,44,43.5,43,42.5,42,41.5,41,40.5,40,39,38,37,36,35,34,33,32,30,28,26,24,22,20,18,16,12,8,4,0,4,8,12,16,24,32,40,48,56,64,72,80,96,112,128,144,160,176,192,208,240,272,304,336,368,400,432,52,52.00879,52.009766,52.010742,52.01172,52.012695,52.01367,52.01465,52.015625,52.01758,52.01953,52.021484,52.023438,52.02539,52.027344,52.029297,52.03125,52.035156,52.039062,52.04297,52.046875,52.05078,52.054688,52.058594,52.0625,52.070312,52.078125,52.085938,52.09375,52.101562,52.109375,52.117188,52.125,52.140625,52.15625,52.171875,52.1875,52.203125,52.21875,52.234375,52.25,52.28125,52.3125,52.34375,52.375,52.40625,52.4375,52.46875,52.5,52.5625,52.625,52.6875,52.75,52.8125,52.875,52.9375,53,53.125,53.25,53.375,53.5,53.625,53.75,53.875,54,54.25,54.5,54.75,55,55.25,55.5,55.75,56,56.5,57,57.5,58,58.5,59,59.5,60,61,62,63,64,65,66,67,68,70,72,74,76,78,80,82,84,88,92,96,100,104,108,112,116,124,132,140,148,156,164,172,180,196,212,228,244,260,276,292,308,340,372,404,436,468,500,532,51.992188,51.99121,51.990234,51.989258,51.98828,51.987305,51.98633,51.98535,51.984375,51.98242,51.98047,51.978516,51.976562,51.97461,51.972656,51.970703,51.96875,51.964844,51.960938,51.95703,51.953125,51.94922,51.945312,51.941406,51.9375,51.929688,51.921875,51.914062,51.90625,51.898438,51.890625,51.882812,51.875,51.859375,51.84375,51.828125,51.8125,51.796875,51.78125,51.765625,51.75,51.71875,51.6875,51.65625,51.625,51.59375,51.5625,51.53125,51.5,51.4375,51.375,51.3125,51.25,51.1875,51.125,51.0625,51,50.875,50.75,50.625,50.5,50.375,50.25,50.125,50,49.75,49.5,49.25,49,48.75,48.5,48.25,48,47.5,47,46.5,46,45.5,45,44.5,44,43,42,41,40,39,38,37,36,34,32,30,28,26,24,22,20,16,12,8,4,0,4,8,12,20,28,36,44,52,60,68,76,92,108,124,140,156,172,188,204,236,268,300,332,364,396,428,56,56.00879,56.009766,56.010742,56.01172,56.012695,56.01367,56.01465,56.015625,56.01758,56.01953,56.021484,56.023438,56.02539,56.027344,56.029297,56.03125,56.035156,56.039062,56.04297,56.046875,56.05078,56.054688,56.058594,56.0625,56.070312,56.078125,56.085938,56.09375,56.101562,56.109375,56.117188,56.125,56.140625,56.15625,56.171875,56.1875,56.203125,56.21875,56.234375,56.25,56.28125,56.3125,56.34375,56.375,56.40625,56.4375,56.46875,56.5,56.5625,56.625,56.6875,56.75,56.8125,56.875,56.9375,57,57.125,57.25,57.375,57.5,57.625,57.75,57.875,58,58.25,58.5,58.75,59,59.25,59.5,59.75,60,60.5,61,61.5,62,62.5,63,63.5,64,65,66,67,68,69,70,71,72,74,76,78,80,82,84,86,88,92,96,100,104,108,112,116,120,128,136,144,152,160,168,176,184,200,216,232,248,264,280,296,312,344,376,408,440,472,504,536,55.992188,55.99121,55.990234,55.989258,55.98828,55.987305,55.98633,55.98535,55.984375,55.98242,55.98047,55.978516,55.976562,55.97461,55.972656,55.970703,55.96875,55.964844,55.960938,55.95703,55.953125,55.94922,55.945312,55.941406,55.9375,55.929688,55.921875,55.914062,55.90625,55.898438,55.890625,55.882812,55.875,55.859375,55.84375,55.828125,55.8125,55.796875,55.78125,55.765625,55.75,55.71875,55.6875,55.65625,55.625,55.59375,55.5625,55.53125,55.5,55.4375,55.375,55.3125,55.25,55.1875,55.125,55.0625,55,54.875,54.75,54.625,54.5,54.375,54.25,54.125,54,53.75,53.5,53.25,53,52.75,52.5,52.25,52,51.5,51,50.5,50,49.5,49,48.5,48,47,46,45,44,43,42,41,40,38,36,34,32,30,28,26,24,20,16,12,8,4,0,4,8,16,24,32,40,48,56,64,72,88,104,120,136,152,168,184,200,232,264,296,328,360,392,424,60,60.00879,60.009766,60.010742,60.01172,60.012695,60.01367,60.01465,60.015625,60.01758,60.01953,60.021484,60.023438,60.02539,60.027344,60.029297,60.03125,60.035156,60.039062,60.04297,60.046875,60.05078,60.054688,60.058594,60.0625,60.070312,60.078125,60.085938,60.09375,60.101562,60.109375,60.117188,60.125,60.140625,60.15625,60.171875,60.1875,60.203125,60.21875,60.234375,60.25,60.28125,60.3125,60.34375,60.375,60.40625,60.4375,60.46875,60.5,60.5625,60.625,60.6875,60.75,60.8125,60.875,60.9375,61,61.125,61.25,61.375,61.5,61.625,61.75,61.875,62,62.25,62.5,62.75,63,63.25,63.5,63.75,64,64.5,65,65.5,66,66.5,67,67.5,68,69,70,71,72,73,74,75,76,78,80,82,84,86,88,90,92,96,100,104,108,112,116,120,124,132,140,148,156,164,172,180,188,204,220,236,252,268,284,300,316,348,380,412,444,476,508,540,59.992188,59.99121,59.990234,59.989258,59.98828,59.987305,59.98633,59.98535,59.984375,59.98242,59.98047,59.978516,59.976562,59.97461,59.972656,59.970703,59.96875,59.964844,59.960938,59.95703,59.953125,59.94922,59.945312,59.941406,59.9375,59.929688,59.921875,59.914062,59.90625,59.898438,59.890625,59.882812,59.875,59.859375,59.84375,59.828125,59.8125,59.796875,59.78125,59.765625,59.75,59.71875,59.6875,59.65625,59.625,59.59375,59.5625,59.53125,59.5,59.4375,59.375,59.3125,59.25,59.1875,59.125,59.0625,59,58.875,58.75,58.625,58.5,58.375,58.25,58.125,58,57.75,57.5,57.25,57,56.75,56.5,56.25,56,55.5,55,54.5,54,53.5,53,52.5,52,51,50,49,48,47,46,45,44,42,40,38,36,34,32,30,28,24,20,16,12,8,4,0,4,12,20,28,36,44,52,60,68,84,100,116,132,148,164,180,196,228,260,292,324,356,388,420,64,64.00879,64.009766,64.01074,64.01172,64.012695,64.01367,64.01465,64.015625,64.01758,64.01953,64.021484,64.02344,64.02539,64.02734,64.0293,64.03125,64.03516,64.03906,64.04297,64.046875,64.05078,64.05469,64.05859,64.0625,64.07031,64.078125,64.08594,64.09375,64.10156,64.109375,64.11719,64.125,64.140625,64.15625,64.171875,64.1875,64.203125,64.21875,64.234375,64.25,64.28125,64.3125,64.34375,64.375,64.40625,64.4375,64.46875,64.5,64.5625,64.625,64.6875,64.75,64.8125,64.875,64.9375,65,65.125,65.25,65.375,65.5,65.625,65.75,65.875,66,66.25,66.5,66.75,67,67.25,67.5,67.75,68,68.5,69,69.5,70,70.5,71,71.5,72,73,74,75,76,77,78,79,80,82,84,86,88,90,92,94,96,100,104,108,112,116,120,124,128,136,144,152,160,168,176,184,192,208,224,240,256,272,288,304,320,352,384,416,448,480,512,544,63.992188,63.99121,63.990234,63.989258,63.98828,63.987305,63.98633,63.98535,63.984375,63.98242,63.98047,63.978516,63.976562,63.97461,63.972656,63.970703,63.96875,63.964844,63.960938,63.95703,63.953125,63.94922,63.945312,63.941406,63.9375,63.929688,63.921875,63.914062,63.90625,63.898438,63.890625,63.882812,63.875,63.859375,63.84375,63.828125,63.8125,63.796875,63.78125,63.765625,63.75,63.71875,63.6875,63.65625,63.625,63.59375,63.5625,63.53125,63.5,63.4375,63.375,63.3125,63.25,63.1875,63.125,63.0625,63,62.875,62.75,62.625,62.5,62.375,62.25,62.125,62,61.75,61.5,61.25,61,60.75,60.5,60.25,60,59.5,59,58.5,58,57.5,57,56.5,56,55,54,53,52,51,50,49,48,46,44,42,40,38,36,34,32,28,24,20,16,12,8,4,0,8,16,24,32,40,48,56,64,80,96,112,128,144,160,176,192,224,256,288,320,352,384,416,72,72.00879,72.009766,72.01074,72.01172,72.012695,72.01367,72.01465,72.015625,72.01758,72.01953,72.021484,72.02344,72.02539,72.02734,72.0293,72.03125,72.03516,72.03906,72.04297,72.046875,72.05078,72.05469,72.05859,72.0625,72.07031,72.078125,72.08594,72.09375,72.10156,72.109375,72.11719,72.125,72.140625,72.15625,72.171875,72.1875,72.203125,72.21875,72.234375,72.25,72.28125,72.3125,72.34375,72.375,72.40625,72.4375,72.46875,72.5,72.5625,72.625,72.6875,72.75,72.8125,72.875,72.9375,73,73.125,73.25,73.375,73.5,73.625,73.75,73.875,74,74.25,74.5,74.75,75,75.25,75.5,75.75,76,76.5,77,77.5,78,78.5,79,79.5,80,81,82,83,84,85,86,87,88,90,92,94,96,98,100,102,104,108,112,116,120,124,128,132,136,144,152,160,168,176,184,192,200,216,232,248,264,280,296,312,328,360,392,424,456,488,520,552,71.99219,71.99121,71.990234,71.98926,71.98828,71.987305,71.98633,71.98535,71.984375,71.98242,71.98047,71.978516,71.97656,71.97461,71.97266,71.9707,71.96875,71.96484,71.96094,71.95703,71.953125,71.94922,71.94531,71.94141,71.9375,71.92969,71.921875,71.91406,71.90625,71.89844,71.890625,71.88281,71.875,71.859375,71.84375,71.828125,71.8125,71.796875,71.78125,71.765625,71.75,71.71875,71.6875,71.65625,71.625,71.59375,71.5625,71.53125,71.5,71.4375,71.375,71.3125,71.25,71.1875,71.125,71.0625,71,70.875,70.75,70.625,70.5,70.375,70.25,70.125,70,69.75,69.5,69.25,69,68.75,68.5,68.25,68,67.5,67,66.5,66,65.5,65,64.5,64,63,62,61,60,59,58,57,56,54,52,50,48,46,44,42,40,36,32,28,24,20,16,12,8,0,8,16,24,32,40,48,56,72,88,104,120,136,152,168,184,216,248,280,312,344,376,408,80,80.00879,80.009766,80.01074,80.01172,80.012695,80.01367,80.01465,80.015625,80.01758,80.01953,80.021484,80.02344,80.02539,80.02734,80.0293,80.03125,80.03516,80.03906,80.04297,80.046875,80.05078,80.05469,80.05859,80.0625,80.07031,80.078125,80.08594,80.09375,80.10156,80.109375,80.11719,80.125,80.140625,80.15625,80.171875,80.1875,80.203125,80.21875,80.234375,80.25,80.28125,80.3125,80.34375,80.375,80.40625,80.4375,80.46875,80.5,80.5625,80.625,80.6875,80.75,80.8125,80.875,80.9375,81,81.125,81.25,81.375,81.5,81.625,81.75,81.875,82,82.25,82.5,82.75,83,83.25,83.5,83.75,84,84.5,85,85.5,86,86.5,87,87.5,88,89,90,91,92,93,94,95,96,98,100,102,104,106,108,110,112,116,120,124,128,132,136,140,144,152,160,168,176,184,192,200,208,224,240,256,272,288,304,320,336,368,400,432,464,496,528,560,79.99219,79.99121,79.990234,79.98926,79.98828,79.987305,79.98633,79.98535,79.984375,79.98242,79.98047,79.978516,79.97656,79.97461,79.97266,79.9707,79.96875,79.96484,79.96094,79.95703,79.953125,79.94922,79.94531,79.94141,79.9375,79.92969,79.921875,79.91406,79.90625,79.89844,79.890625,79.88281,79.875,79.859375,79.84375,79.828125,79.8125,79.796875,79.78125,79.765625,79.75,79.71875,79.6875,79.65625,79.625,79.59375,79.5625,79.53125,79.5,79.4375,79.375,79.3125,79.25,79.1875,79.125,79.0625,79,78.875,78.75,78.625,78.5,78.375,78.25,78.125,78,77.75,77.5,77.25,77,76.75,76.5,76.25,76,75.5,75,74.5,74,73.5,73,72.5,72,71,70,69,68,67,66,65,64,62,60,58,56,54,52,50,48,44,40,36,32,28,24,20,16,8,0,8,16,24,32,40,48,64,80,96,112,128,144,160,176,208,240,272,304,336,368,400,88,88.00879,88.009766,88.01074,88.01172,88.012695,88.01367,88.01465,88.015625,88.01758,88.01953,88.021484,88.02344,88.02539,88.02734,88.0293,88.03125,88.03516,88.03906,88.04297,88.046875,88.05078,88.05469,88.05859,88.0625,88.07031,88.078125,88.08594,88.09375,88.10156,88.109375,88.11719,88.125,88.140625,88.15625,88.171875,88.1875,88.203125,88.21875,88.234375,88.25,88.28125,88.3125,88.34375,88.375,88.40625,88.4375,88.46875,88.5,88.5625,88.625,88.6875,88.75,88.8125,88.875,88.9375,89,89.125,89.25,89.375,89.5,89.625,89.75,89.875,90,90.25,90.5,90.75,91,91.25,91.5,91.75,92,92.5,93,93.5,94,94.5,95,95.5,96,97,98,99,100,101,102,103,104,106,108,110,112,114,116,118,120,124,128,132,136,140,144,148,152,160,168,176,184,192,200,208,216,232,248,264,280,296,312,328,344,376,408,440,472,504,536,568,87.99219,87.99121,87.990234,87.98926,87.98828,87.987305,87.98633,87.98535,87.984375,87.98242,87.98047,87.978516,87.97656,87.97461,87.97266,87.9707,87.96875,87.96484,87.96094,87.95703,87.953125,87.94922,87.94531,87.94141,87.9375,87.92969,87.921875,87.91406,87.90625,87.89844,87.890625,87.88281,87.875,87.859375,87.84375,87.828125,87.8125,87.796875,87.78125,87.765625,87.75,87.71875,87.6875,87.65625,87.625,87.59375,87.5625,87.53125,87.5,87.4375,87.375,87.3125,87.25,87.1875,87.125,87.0625,87,86.875,86.75,86.625,86.5,86.375,86.25,86.125,86,85.75,85.5,85.25,85,84.75,84.5,84.25,84,83.5,83,82.5,82,81.5,81,80.5,80,79,78,77,76,75,74,73,72,70,68,66,64,62,60,58,56,52,48,44,40,36,32,28,24,16,8,0,8,16,24,32,40,56,72,88,104,120,136,152,168,200,232,264,296,328,360,392,96,96.00879,96.009766,96.01074,96.01172,96.012695,96.01367,96.01465,96.015625,96.01758,96.01953,96.021484,96.02344,96.02539,96.02734,96.0293,96.03125,96.03516,96.03906,96.04297,96.046875,96.05078,96.05469,96.05859,96.0625,96.07031,96.078125,96.08594,96.09375,96.10156,96.109375,96.11719,96.125,96.140625,96.15625,96.171875,96.1875,96.203125,96.21875,96.234375,96.25,96.28125,96.3125,96.34375,96.375,96.40625,96.4375,96.46875,96.5,96.5625,96.625,96.6875,96.75,96.8125,96.875,96.9375,97,97.125,97.25,97.375,97.5,97.625,97.75,97.875,98,98.25,98.5,98.75,99,99.25,99.5,99.75,100,100.5,101,101.5,102,102.5,103,103.5,104,105,106,107,108,109,110,111,112,114,116,118,120,122,124,126,128,132,136,140,144,148,152,156,160,168,176,184,192,200,208,216,224,240,256,272,288,304,320,336,352,384,416,448,480,512,544,576,95.99219,95.99121,95.990234,95.98926,95.98828,95.987305,95.98633,95.98535,95.984375,95.98242,95.98047,95.978516,95.97656,95.97461,95.97266,95.9707,95.96875,95.96484,95.96094,95.95703,95.953125,95.94922,95.94531,95.94141,95.9375,95.92969,95.921875,95.91406,95.90625,95.89844,95.890625,95.88281,95.875,95.859375,95.84375,95.828125,95.8125,95.796875,95.78125,95.765625,95.75,95.71875,95.6875,95.65625,95.625,95.59375,95.5625,95.53125,95.5,95.4375,95.375,95.3125,95.25,95.1875,95.125,95.0625,95,94.875,94.75,94.625,94.5,94.375,94.25,94.125,94,93.75,93.5,93.25,93,92.75,92.5,92.25,92,91.5,91,90.5,90,89.5,89,88.5,88,87,86,85,84,83,82,81,80,78,76,74,72,70,68,66,64,60,56,52,48,44,40,36,32,24,16,8,0,8,16,24,32,48,64,80,96,112,128,144,160,192,224,256,288,320,352,384,104,104.00879,104.009766,104.01074,104.01172,104.012695,104.01367,104.01465,104.015625,104.01758,104.01953,104.021484,104.02344,104.02539,104.02734,104.0293,104.03125,104.03516,104.03906,104.04297,104.046875,104.05078,104.05469,104.05859,104.0625,104.07031,104.078125,104.08594,104.09375,104.10156,104.109375,104.11719,104.125,104.140625,104.15625,104.171875,104.1875,104.203125,104.21875,104.234375,104.25,104.28125,104.3125,104.34375,104.375,104.40625,104.4375,104.46875,104.5,104.5625,104.625,104.6875,104.75,104.8125,104.875,104.9375,105,105.125,105.25,105.375,105.5,105.625,105.75,105.875,106,106.25,106.5,106.75,107,107.25,107.5,107.75,108,108.5,109,109.5,110,110.5,111,111.5,112,113,114,115,116,117,118,119,120,122,124,126,128,130,132,134,136,140,144,148,152,156,160,164,168,176,184,192,200,208,216,224,232,248,264,280,296,312,328,344,360,392,424,456,488,520,552,584,103.99219,103.99121,103.990234,103.98926,103.98828,103.987305,103.98633,103.98535,103.984375,103.98242,103.98047,103.978516,103.97656,103.97461,103.97266,103.9707,103.96875,103.96484,103.96094,103.95703,103.953125,103.94922,103.94531,103.94141,103.9375,103.92969,103.921875,103.91406,103.90625,103.89844,103.890625,103.88281,103.875,103.859375,103.84375,103.828125,103.8125,103.796875,103.78125,103.765625,103.75,103.71875,103.6875,103.65625,103.625,103.59375,103.5625,103.53125,103.5,103.4375,103.375,103.3125,103.25,103.1875,103.125,103.0625,103,102.875,102.75,102.625,102.5,102.375,102.25,102.125,102,101.75,101.5,101.25,101,100.75,100.5,100.25,100,99.5,99,98.5,98,97.5,97,96.5,96,95,94,93,92,91,90,89,88,86,84,82,80,78,76,74,72,68,64,60,56,52,48,44,40,32,24,16,8,0,8,16,24,40,56,72,88,104,120,136,152,184,216,248,280,312,344,376,112,112.00879,112.009766,112.01074,112.01172,112.012695,112.01367,112.01465,112.015625,112.01758,112.01953,112.021484,112.02344,112.02539,112.02734,112.0293,112.03125,112.03516,112.03906,112.04297,112.046875,112.05078,112.05469,112.05859,112.0625,112.07031,112.078125,112.08594,112.09375,112.10156,112.109375,112.11719,112.125,112.140625,112.15625,112.171875,112.1875,112.203125,112.21875,112.234375,112.25,112.28125,112.3125,112.34375,112.375,112.40625,112.4375,112.46875,112.5,112.5625,112.625,112.6875,112.75,112.8125,112.875,112.9375,113,113.125,113.25,113.375,113.5,113.625,113.75,113.875,114,114.25,114.5,114.75,115,115.25,115.5,115.75,116,116.5,117,117.5,118,118.5,119,119.5,120,121,122,123,124,125,126,127,128,130,132,134,136,138,140,142,144,148,152,156,160,164,168,172,176,184,192,200,208,216,224,232,240,256,272,288,304,320,336,352,368,400,432,464,496,528,560,592,111.99219,111.99121,111.990234,111.98926,111.98828,111.987305,111.98633,111.98535,111.984375,111.98242,111.98047,111.978516,111.97656,111.97461,111.97266,111.9707,111.96875,111.96484,111.96094,111.95703,111.953125,111.94922,111.94531,111.94141,111.9375,111.92969,111.921875,111.91406,111.90625,111.89844,111.890625,111.88281,111.875,111.859375,111.84375,111.828125,111.8125,111.796875,111.78125,111.765625,111.75,111.71875,111.6875,111.65625,111.625,111.59375,111.5625,111.53125,111.5,111.4375,111.375,111.3125,111.25,111.1875,111.125,111.0625,111,110.875,110.75,110.625,110.5,110.375,110.25,110.125,110,109.75,109.5,109.25,109,108.75,108.5,108.25,108,107.5,107,106.5,106,105.5,105,104.5,104,103,102,101,100,99,98,97,96,94,92,90,88,86,84,82,80,76,72,68,64,60,56,52,48,40,32,24,16,8,0,8,16,32,48,64,80,96,112,128,144,176,208,240,272,304,336,368,120,120.00879,120.009766,120.01074,120.01172,120.012695,120.01367,120.01465,120.015625,120.01758,120.01953,120.021484,120.02344,120.02539,120.02734,120.0293,120.03125,120.03516,120.03906,120.04297,120.046875,120.05078,120.05469,120.05859,120.0625,120.07031,120.078125,120.08594,120.09375,120.10156,120.109375,120.11719,120.125,120.140625,120.15625,120.171875,120.1875,120.203125,120.21875,120.234375,120.25,120.28125,120.3125,120.34375,120.375,120.40625,120.4375,120.46875,120.5,120.5625,120.625,120.6875,120.75,120.8125,120.875,120.9375,121,121.125,121.25,121.375,121.5,121.625,121.75,121.875,122,122.25,122.5,122.75,123,123.25,123.5,123.75,124,124.5,125,125.5,126,126.5,127,127.5,128,129,130,131,132,133,134,135,136,138,140,142,144,146,148,150,152,156,160,164,168,172,176,180,184,192,200,208,216,224,232,240,248,264,280,296,312,328,344,360,376,408,440,472,504,536,568,600,119.99219,119.99121,119.990234,119.98926,119.98828,119.987305,119.98633,119.98535,119.984375,119.98242,119.98047,119.978516,119.97656,119.97461,119.97266,119.9707,119.96875,119.96484,119.96094,119.95703,119.953125,119.94922,119.94531,119.94141,119.9375,119.92969,119.921875,119.91406,119.90625,119.89844,119.890625,119.88281,119.875,119.859375,119.84375,119.828125,119.8125,119.796875,119.78125,119.765625,119.75,119.71875,119.6875,119.65625,119.625,119.59375,119.5625,119.53125,119.5,119.4375,119.375,119.3125,119.25,119.1875,119.125,119.0625,119,118.875,118.75,118.625,118.5,118.375,118.25,118.125,118,117.75,117.5,117.25,117,116.75,116.5,116.25,116,115.5,115,114.5,114,113.5,113,112.5,112,111,110,109,108,107,106,105,104,102,100,98,96,94,92,90,88,84,80,76,72,68,64,60,56,48,40,32,24,16,8,0,8,24,40,56,72,88,104,120,136,168,200,232,264,296,328,360,128,128.00879,128.00977,128.01074,128.01172,128.0127,128.01367,128.01465,128.01562,128.01758,128.01953,128.02148,128.02344,128.02539,128.02734,128.0293,128.03125,128.03516,128.03906,128.04297,128.04688,128.05078,128.05469,128.0586,128.0625,128.07031,128.07812,128.08594,128.09375,128.10156,128.10938,128.11719,128.125,128.14062,128.15625,128.17188,128.1875,128.20312,128.21875,128.23438,128.25,128.28125,128.3125,128.34375,128.375,128.40625,128.4375,128.46875,128.5,128.5625,128.625,128.6875,128.75,128.8125,128.875,128.9375,129,129.125,129.25,129.375,129.5,129.625,129.75,129.875,130,130.25,130.5,130.75,131,131.25,131.5,131.75,132,132.5,133,133.5,134,134.5,135,135.5,136,137,138,139,140,141,142,143,144,146,148,150,152,154,156,158,160,164,168,172,176,180,184,188,192,200,208,216,224,232,240,248,256,272,288,304,320,336,352,368,384,416,448,480,512,544,576,608,127.99219,127.99121,127.990234,127.98926,127.98828,127.987305,127.98633,127.98535,127.984375,127.98242,127.98047,127.978516,127.97656,127.97461,127.97266,127.9707,127.96875,127.96484,127.96094,127.95703,127.953125,127.94922,127.94531,127.94141,127.9375,127.92969,127.921875,127.91406,127.90625,127.89844,127.890625,127.88281,127.875,127.859375,127.84375,127.828125,127.8125,127.796875,127.78125,127.765625,127.75,127.71875,127.6875,127.65625,127.625,127.59375,127.5625,127.53125,127.5,127.4375,127.375,127.3125,127.25,127.1875,127.125,127.0625,127,126.875,126.75,126.625,126.5,126.375,126.25,126.125,126,125.75,125.5,125.25,125,124.75,124.5,124.25,124,123.5,123,122.5,122,121.5,121,120.5,120,119,118,117,116,115,114,113,112,110,108,106,104,102,100,98,96,92,88,84,80,76,72,68,64,56,48,40,32,24,16,8,0,16,32,48,64,80,96,112,128,160,192,224,256,288,320,352,144,144.00879,144.00977,144.01074,144.01172,144.0127,144.01367,144.01465,144.01562,144.01758,144.01953,144.02148,144.02344,144.02539,144.02734,144.0293,144.03125,144.03516,144.03906,144.04297,144.04688,144.05078,144.05469,144.0586,144.0625,144.07031,144.07812,144.08594,144.09375,144.10156,144.10938,144.11719,144.125,144.14062,144.15625,144.17188,144.1875,144.20312,144.21875,144.23438,144.25,144.28125,144.3125,144.34375,144.375,144.40625,144.4375,144.46875,144.5,144.5625,144.625,144.6875,144.75,144.8125,144.875,144.9375,145,145.125,145.25,145.375,145.5,145.625,145.75,145.875,146,146.25,146.5,146.75,147,147.25,147.5,147.75,148,148.5,149,149.5,150,150.5,151,151.5,152,153,154,155,156,157,158,159,160,162,164,166,168,170,172,174,176,180,184,188,192,196,200,204,208,216,224,232,240,248,256,264,272,288,304,320,336,352,368,384,400,432,464,496,528,560,592,624,143.99219,143.99121,143.99023,143.98926,143.98828,143.9873,143.98633,143.98535,143.98438,143.98242,143.98047,143.97852,143.97656,143.97461,143.97266,143.9707,143.96875,143.96484,143.96094,143.95703,143.95312,143.94922,143.94531,143.9414,143.9375,143.92969,143.92188,143.91406,143.90625,143.89844,143.89062,143.88281,143.875,143.85938,143.84375,143.82812,143.8125,143.79688,143.78125,143.76562,143.75,143.71875,143.6875,143.65625,143.625,143.59375,143.5625,143.53125,143.5,143.4375,143.375,143.3125,143.25,143.1875,143.125,143.0625,143,142.875,142.75,142.625,142.5,142.375,142.25,142.125,142,141.75,141.5,141.25,141,140.75,140.5,140.25,140,139.5,139,138.5,138,137.5,137,136.5,136,135,134,133,132,131,130,129,128,126,124,122,120,118,116,114,112,108,104,100,96,92,88,84,80,72,64,56,48,40,32,24,16,0,16,32,48,64,80,96,112,144,176,208,240,272,304,336,160,160.00879,160.00977,160.01074,160.01172,160.0127,160.01367,160.01465,160.01562,160.01758,160.01953,160.02148,160.02344,160.02539,160.02734,160.0293,160.03125,160.03516,160.03906,160.04297,160.04688,160.05078,160.05469,160.0586,160.0625,160.07031,160.07812,160.08594,160.09375,160.10156,160.10938,160.11719,160.125,160.14062,160.15625,160.17188,160.1875,160.20312,160.21875,160.23438,160.25,160.28125,160.3125,160.34375,160.375,160.40625,160.4375,160.46875,160.5,160.5625,160.625,160.6875,160.75,160.8125,160.875,160.9375,161,161.125,161.25,161.375,161.5,161.625,161.75,161.875,162,162.25,162.5,162.75,163,163.25,163.5,163.75,164,164.5,165,165.5,166,166.5,167,167.5,168,169,170,171,172,173,174,175,176,178,180,182,184,186,188,190,192,196,200,204,208,212,216,220,224,232,240,248,256,264,272,280,288,304,320,336,352,368,384,400,416,448,480,512,544,576,608,640,159.99219,159.99121,159.99023,159.98926,159.98828,159.9873,159.98633,159.98535,159.98438,159.98242,159.98047,159.97852,159.97656,159.97461,159.97266,159.9707,159.96875,159.96484,159.96094,159.95703,159.95312,159.94922,159.94531,159.9414,159.9375,159.92969,159.92188,159.91406,159.90625,159.89844,159.89062,159.88281,159.875,159.85938,159.84375,159.82812,159.8125,159.79688,159.78125,159.76562,159.75,159.71875,159.6875,159.65625,159.625,159.59375,159.5625,159.53125,159.5,159.4375,159.375,159.3125,159.25,159.1875,159.125,159.0625,159,158.875,158.75,158.625,158.5,158.375,158.25,158.125,158,157.75,157.5,157.25,157,156.75,156.5,156.25,156,155.5,155,154.5,154,153.5,153,152.5,152,151,150,149,148,147,146,145,144,142,140,138,136,134,132,130,128,124,120,116,112,108,104,100,96,88,80,72,64,56,48,40,32,16,0,16,32,48,64,80,96,128,160,192,224,256,288,320,176,176.00879,176.00977,176.01074,176.01172,176.0127,176.01367,176.01465,176.01562,176.01758,176.01953,176.02148,176.02344,176.02539,176.02734,176.0293,176.03125,176.03516,176.03906,176.04297,176.04688,176.05078,176.05469,176.0586,176.0625,176.07031,176.07812,176.08594,176.09375,176.10156,176.10938,176.11719,176.125,176.14062,176.15625,176.17188,176.1875,176.20312,176.21875,176.23438,176.25,176.28125,176.3125,176.34375,176.375,176.40625,176.4375,176.46875,176.5,176.5625,176.625,176.6875,176.75,176.8125,176.875,176.9375,177,177.125,177.25,177.375,177.5,177.625,177.75,177.875,178,178.25,178.5,178.75,179,179.25,179.5,179.75,180,180.5,181,181.5,182,182.5,183,183.5,184,185,186,187,188,189,190,191,192,194,196,198,200,202,204,206,208,212,216,220,224,228,232,236,240,248,256,264,272,280,288,296,304,320,336,352,368,384,400,416,432,464,496,528,560,592,624,656,175.99219,175.99121,175.99023,175.98926,175.98828,175.9873,175.98633,175.98535,175.98438,175.98242,175.98047,175.97852,175.97656,175.97461,175.97266,175.9707,175.96875,175.96484,175.96094,175.95703,175.95312,175.94922,175.94531,175.9414,175.9375,175.92969,175.92188,175.91406,175.90625,175.89844,175.89062,175.88281,175.875,175.85938,175.84375,175.82812,175.8125,175.79688,175.78125,175.76562,175.75,175.71875,175.6875,175.65625,175.625,175.59375,175.5625,175.53125,175.5,175.4375,175.375,175.3125,175.25,175.1875,175.125,175.0625,175,174.875,174.75,174.625,174.5,174.375,174.25,174.125,174,173.75,173.5,173.25,173,172.75,172.5,172.25,172,171.5,171,170.5,170,169.5,169,168.5,168,167,166,165,164,163,162,161,160,158,156,154,152,150,148,146,144,140,136,132,128,124,120,116,112,104,96,88,80,72,64,56,48,32,16,0,16,32,48,64,80,112,144,176,208,240,272,304,192,192.00879,192.00977,192.01074,192.01172,192.0127,192.01367,192.01465,192.01562,192.01758,192.01953,192.02148,192.02344,192.02539,192.02734,192.0293,192.03125,192.03516,192.03906,192.04297,192.04688,192.05078,192.05469,192.0586,192.0625,192.07031,192.07812,192.08594,192.09375,192.10156,192.10938,192.11719,192.125,192.14062,192.15625,192.17188,192.1875,192.20312,192.21875,192.23438,192.25,192.28125,192.3125,192.34375,192.375,192.40625,192.4375,192.46875,192.5,192.5625,192.625,192.6875,192.75,192.8125,192.875,192.9375,193,193.125,193.25,193.375,193.5,193.625,193.75,193.875,194,194.25,194.5,194.75,195,195.25,195.5,195.75,196,196.5,197,197.5,198,198.5,199,199.5,200,201,202,203,204,205,206,207,208,210,212,214,216,218,220,222,224,228,232,236,240,244,248,252,256,264,272,280,288,296,304,312,320,336,352,368,384,400,416,432,448,480,512,544,576,608,640,672,191.99219,191.99121,191.99023,191.98926,191.98828,191.9873,191.98633,191.98535,191.98438,191.98242,191.98047,191.97852,191.97656,191.97461,191.97266,191.9707,191.96875,191.96484,191.96094,191.95703,191.95312,191.94922,191.94531,191.9414,191.9375,191.92969,191.92188,191.91406,191.90625,191.89844,191.89062,191.88281,191.875,191.85938,191.84375,191.82812,191.8125,191.79688,191.78125,191.76562,191.75,191.71875,191.6875,191.65625,191.625,191.59375,191.5625,191.53125,191.5,191.4375,191.375,191.3125,191.25,191.1875,191.125,191.0625,191,190.875,190.75,190.625,190.5,190.375,190.25,190.125,190,189.75,189.5,189.25,189,188.75,188.5,188.25,188,187.5,187,186.5,186,185.5,185,184.5,184,183,182,181,180,179,178,177,176,174,172,170,168,166,164,162,160,156,152,148,144,140,136,132,128,120,112,104,96,88,80,72,64,48,32,16,0,16,32,48,64,96,128,160,192,224,256,288,208,208.00879,208.00977,208.01074,208.01172,208.0127,208.01367,208.01465,208.01562,208.01758,208.01953,208.02148,208.02344,208.02539,208.02734,208.0293,208.03125,208.03516,208.03906,208.04297,208.04688,208.05078,208.05469,208.0586,208.0625,208.07031,208.07812,208.08594,208.09375,208.10156,208.10938,208.11719,208.125,208.14062,208.15625,208.17188,208.1875,208.20312,208.21875,208.23438,208.25,208.28125,208.3125,208.34375,208.375,208.40625,208.4375,208.46875,208.5,208.5625,208.625,208.6875,208.75,208.8125,208.875,208.9375,209,209.125,209.25,209.375,209.5,209.625,209.75,209.875,210,210.25,210.5,210.75,211,211.25,211.5,211.75,212,212.5,213,213.5,214,214.5,215,215.5,216,217,218,219,220,221,222,223,224,226,228,230,232,234,236,238,240,244,248,252,256,260,264,268,272,280,288,296,304,312,320,328,336,352,368,384,400,416,432,448,464,496,528,560,592,624,656,688,207.99219,207.99121,207.99023,207.98926,207.98828,207.9873,207.98633,207.98535,207.98438,207.98242,207.98047,207.97852,207.97656,207.97461,207.97266,207.9707,207.96875,207.96484,207.96094,207.95703,207.95312,207.94922,207.94531,207.9414,207.9375,207.92969,207.92188,207.91406,207.90625,207.89844,207.89062,207.88281,207.875,207.85938,207.84375,207.82812,207.8125,207.79688,207.78125,207.76562,207.75,207.71875,207.6875,207.65625,207.625,207.59375,207.5625,207.53125,207.5,207.4375,207.375,207.3125,207.25,207.1875,207.125,207.0625,207,206.875,206.75,206.625,206.5,206.375,206.25,206.125,206,205.75,205.5,205.25,205,204.75,204.5,204.25,204,203.5,203,202.5,202,201.5,201,200.5,200,199,198,197,196,195,194,193,192,190,188,186,184,182,180,178,176,172,168,164,160,156,152,148,144,136,128,120,112,104,96,88,80,64,48,32,16,0,16,32,48,80,112,144,176,208,240,272,224,224.00879,224.00977,224.01074,224.01172,224.0127,224.01367,224.01465,224.01562,224.01758,224.01953,224.02148,224.02344,224.02539,224.02734,224.0293,224.03125,224.03516,224.03906,224.04297,224.04688,224.05078,224.05469,224.0586,224.0625,224.07031,224.07812,224.08594,224.09375,224.10156,224.10938,224.11719,224.125,224.14062,224.15625,224.17188,224.1875,224.20312,224.21875,224.23438,224.25,224.28125,224.3125,224.34375,224.375,224.40625,224.4375,224.46875,224.5,224.5625,224.625,224.6875,224.75,224.8125,224.875,224.9375,225,225.125,225.25,225.375,225.5,225.625,225.75,225.875,226,226.25,226.5,226.75,227,227.25,227.5,227.75,228,228.5,229,229.5,230,230.5,231,231.5,232,233,234,235,236,237,238,239,240,242,244,246,248,250,252,254,256,260,264,268,272,276,280,284,288,296,304,312,320,328,336,344,352,368,384,400,416,432,448,464,480,512,544,576,608,640,672,704,223.99219,223.99121,223.99023,223.98926,223.98828,223.9873,223.98633,223.98535,223.98438,223.98242,223.98047,223.97852,223.97656,223.97461,223.97266,223.9707,223.96875,223.96484,223.96094,223.95703,223.95312,223.94922,223.94531,223.9414,223.9375,223.92969,223.92188,223.91406,223.90625,223.89844,223.89062,223.88281,223.875,223.85938,223.84375,223.82812,223.8125,223.79688,223.78125,223.76562,223.75,223.71875,223.6875,223.65625,223.625,223.59375,223.5625,223.53125,223.5,223.4375,223.375,223.3125,223.25,223.1875,223.125,223.0625,223,222.875,222.75,222.625,222.5,222.375,222.25,222.125,222,221.75,221.5,221.25,221,220.75,220.5,220.25,220,219.5,219,218.5,218,217.5,217,216.5,216,215,214,213,212,211,210,209,208,206,204,202,200,198,196,194,192,188,184,180,176,172,168,164,160,152,144,136,128,120,112,104,96,80,64,48,32,16,0,16,32,64,96,128,160,192,224,256,240,240.00879,240.00977,240.01074,240.01172,240.0127,240.01367,240.01465,240.01562,240.01758,240.01953,240.02148,240.02344,240.02539,240.02734,240.0293,240.03125,240.03516,240.03906,240.04297,240.04688,240.05078,240.05469,240.0586,240.0625,240.07031,240.07812,240.08594,240.09375,240.10156,240.10938,240.11719,240.125,240.14062,240.15625,240.17188,240.1875,240.20312,240.21875,240.23438,240.25,240.28125,240.3125,240.34375,240.375,240.40625,240.4375,240.46875,240.5,240.5625,240.625,240.6875,240.75,240.8125,240.875,240.9375,241,241.125,241.25,241.375,241.5,241.625,241.75,241.875,242,242.25,242.5,242.75,243,243.25,243.5,243.75,244,244.5,245,245.5,246,246.5,247,247.5,248,249,250,251,252,253,254,255,256,258,260,262,264,266,268,270,272,276,280,284,288,292,296,300,304,312,320,328,336,344,352,360,368,384,400,416,432,448,464,480,496,528,560,592,624,656,688,720,239.99219,239.99121,239.99023,239.98926,239.98828,239.9873,239.98633,239.98535,239.98438,239.98242,239.98047,239.97852,239.97656,239.97461,239.97266,239.9707,239.96875,239.96484,239.96094,239.95703,239.95312,239.94922,239.94531,239.9414,239.9375,239.92969,239.92188,239.91406,239.90625,239.89844,239.89062,239.88281,239.875,239.85938,239.84375,239.82812,239.8125,239.79688,239.78125,239.76562,239.75,239.71875,239.6875,239.65625,239.625,239.59375,239.5625,239.53125,239.5,239.4375,239.375,239.3125,239.25,239.1875,239.125,239.0625,239,238.875,238.75,238.625,238.5,238.375,238.25,238.125,238,237.75,237.5,237.25,237,236.75,236.5,236.25,236,235.5,235,234.5,234,233.5,233,232.5,232,231,230,229,228,227,226,225,224,222,220,218,216,214,212,210,208,204,200,196,192,188,184,180,176,168,160,152,144,136,128,120,112,96,80,64,48,32,16,0,16,48,80,112,144,176,208,240,256,256.0088,256.00977,256.01074,256.01172,256.0127,256.01367,256.01465,256.01562,256.01758,256.01953,256.02148,256.02344,256.0254,256.02734,256.0293,256.03125,256.03516,256.03906,256.04297,256.04688,256.05078,256.0547,256.0586,256.0625,256.0703,256.07812,256.08594,256.09375,256.10156,256.10938,256.1172,256.125,256.14062,256.15625,256.17188,256.1875,256.20312,256.21875,256.23438,256.25,256.28125,256.3125,256.34375,256.375,256.40625,256.4375,256.46875,256.5,256.5625,256.625,256.6875,256.75,256.8125,256.875,256.9375,257,257.125,257.25,257.375,257.5,257.625,257.75,257.875,258,258.25,258.5,258.75,259,259.25,259.5,259.75,260,260.5,261,261.5,262,262.5,263,263.5,264,265,266,267,268,269,270,271,272,274,276,278,280,282,284,286,288,292,296,300,304,308,312,316,320,328,336,344,352,360,368,376,384,400,416,432,448,464,480,496,512,544,576,608,640,672,704,736,255.99219,255.99121,255.99023,255.98926,255.98828,255.9873,255.98633,255.98535,255.98438,255.98242,255.98047,255.97852,255.97656,255.97461,255.97266,255.9707,255.96875,255.96484,255.96094,255.95703,255.95312,255.94922,255.94531,255.9414,255.9375,255.92969,255.92188,255.91406,255.90625,255.89844,255.89062,255.88281,255.875,255.85938,255.84375,255.82812,255.8125,255.79688,255.78125,255.76562,255.75,255.71875,255.6875,255.65625,255.625,255.59375,255.5625,255.53125,255.5,255.4375,255.375,255.3125,255.25,255.1875,255.125,255.0625,255,254.875,254.75,254.625,254.5,254.375,254.25,254.125,254,253.75,253.5,253.25,253,252.75,252.5,252.25,252,251.5,251,250.5,250,249.5,249,248.5,248,247,246,245,244,243,242,241,240,238,236,234,232,230,228,226,224,220,216,212,208,204,200,196,192,184,176,168,160,152,144,136,128,112,96,80,64,48,32,16,0,32,64,96,128,160,192,224,288,288.0088,288.00977,288.01074,288.01172,288.0127,288.01367,288.01465,288.01562,288.01758,288.01953,288.02148,288.02344,288.0254,288.02734,288.0293,288.03125,288.03516,288.03906,288.04297,288.04688,288.05078,288.0547,288.0586,288.0625,288.0703,288.07812,288.08594,288.09375,288.10156,288.10938,288.1172,288.125,288.14062,288.15625,288.17188,288.1875,288.20312,288.21875,288.23438,288.25,288.28125,288.3125,288.34375,288.375,288.40625,288.4375,288.46875,288.5,288.5625,288.625,288.6875,288.75,288.8125,288.875,288.9375,289,289.125,289.25,289.375,289.5,289.625,289.75,289.875,290,290.25,290.5,290.75,291,291.25,291.5,291.75,292,292.5,293,293.5,294,294.5,295,295.5,296,297,298,299,300,301,302,303,304,306,308,310,312,314,316,318,320,324,328,332,336,340,344,348,352,360,368,376,384,392,400,408,416,432,448,464,480,496,512,528,544,576,608,640,672,704,736,768,287.9922,287.9912,287.99023,287.98926,287.98828,287.9873,287.98633,287.98535,287.98438,287.98242,287.98047,287.97852,287.97656,287.9746,287.97266,287.9707,287.96875,287.96484,287.96094,287.95703,287.95312,287.94922,287.9453,287.9414,287.9375,287.9297,287.92188,287.91406,287.90625,287.89844,287.89062,287.8828,287.875,287.85938,287.84375,287.82812,287.8125,287.79688,287.78125,287.76562,287.75,287.71875,287.6875,287.65625,287.625,287.59375,287.5625,287.53125,287.5,287.4375,287.375,287.3125,287.25,287.1875,287.125,287.0625,287,286.875,286.75,286.625,286.5,286.375,286.25,286.125,286,285.75,285.5,285.25,285,284.75,284.5,284.25,284,283.5,283,282.5,282,281.5,281,280.5,280,279,278,277,276,275,274,273,272,270,268,266,264,262,260,258,256,252,248,244,240,236,232,228,224,216,208,200,192,184,176,168,160,144,128,112,96,80,64,48,32,0,32,64,96,128,160,192,320,320.0088,320.00977,320.01074,320.01172,320.0127,320.01367,320.01465,320.01562,320.01758,320.01953,320.02148,320.02344,320.0254,320.02734,320.0293,320.03125,320.03516,320.03906,320.04297,320.04688,320.05078,320.0547,320.0586,320.0625,320.0703,320.07812,320.08594,320.09375,320.10156,320.10938,320.1172,320.125,320.14062,320.15625,320.17188,320.1875,320.20312,320.21875,320.23438,320.25,320.28125,320.3125,320.34375,320.375,320.40625,320.4375,320.46875,320.5,320.5625,320.625,320.6875,320.75,320.8125,320.875,320.9375,321,321.125,321.25,321.375,321.5,321.625,321.75,321.875,322,322.25,322.5,322.75,323,323.25,323.5,323.75,324,324.5,325,325.5,326,326.5,327,327.5,328,329,330,331,332,333,334,335,336,338,340,342,344,346,348,350,352,356,360,364,368,372,376,380,384,392,400,408,416,424,432,440,448,464,480,496,512,528,544,560,576,608,640,672,704,736,768,800,319.9922,319.9912,319.99023,319.98926,319.98828,319.9873,319.98633,319.98535,319.98438,319.98242,319.98047,319.97852,319.97656,319.9746,319.97266,319.9707,319.96875,319.96484,319.96094,319.95703,319.95312,319.94922,319.9453,319.9414,319.9375,319.9297,319.92188,319.91406,319.90625,319.89844,319.89062,319.8828,319.875,319.85938,319.84375,319.82812,319.8125,319.79688,319.78125,319.76562,319.75,319.71875,319.6875,319.65625,319.625,319.59375,319.5625,319.53125,319.5,319.4375,319.375,319.3125,319.25,319.1875,319.125,319.0625,319,318.875,318.75,318.625,318.5,318.375,318.25,318.125,318,317.75,317.5,317.25,317,316.75,316.5,316.25,316,315.5,315,314.5,314,313.5,313,312.5,312,311,310,309,308,307,306,305,304,302,300,298,296,294,292,290,288,284,280,276,272,268,264,260,256,248,240,232,224,216,208,200,192,176,160,144,128,112,96,80,64,32,0,32,64,96,128,160,352,352.0088,352.00977,352.01074,352.01172,352.0127,352.01367,352.01465,352.01562,352.01758,352.01953,352.02148,352.02344,352.0254,352.02734,352.0293,352.03125,352.03516,352.03906,352.04297,352.04688,352.05078,352.0547,352.0586,352.0625,352.0703,352.07812,352.08594,352.09375,352.10156,352.10938,352.1172,352.125,352.14062,352.15625,352.17188,352.1875,352.20312,352.21875,352.23438,352.25,352.28125,352.3125,352.34375,352.375,352.40625,352.4375,352.46875,352.5,352.5625,352.625,352.6875,352.75,352.8125,352.875,352.9375,353,353.125,353.25,353.375,353.5,353.625,353.75,353.875,354,354.25,354.5,354.75,355,355.25,355.5,355.75,356,356.5,357,357.5,358,358.5,359,359.5,360,361,362,363,364,365,366,367,368,370,372,374,376,378,380,382,384,388,392,396,400,404,408,412,416,424,432,440,448,456,464,472,480,496,512,528,544,560,576,592,608,640,672,704,736,768,800,832,351.9922,351.9912,351.99023,351.98926,351.98828,351.9873,351.98633,351.98535,351.98438,351.98242,351.98047,351.97852,351.97656,351.9746,351.97266,351.9707,351.96875,351.96484,351.96094,351.95703,351.95312,351.94922,351.9453,351.9414,351.9375,351.9297,351.92188,351.91406,351.90625,351.89844,351.89062,351.8828,351.875,351.85938,351.84375,351.82812,351.8125,351.79688,351.78125,351.76562,351.75,351.71875,351.6875,351.65625,351.625,351.59375,351.5625,351.53125,351.5,351.4375,351.375,351.3125,351.25,351.1875,351.125,351.0625,351,350.875,350.75,350.625,350.5,350.375,350.25,350.125,350,349.75,349.5,349.25,349,348.75,348.5,348.25,348,347.5,347,346.5,346,345.5,345,344.5,344,343,342,341,340,339,338,337,336,334,332,330,328,326,324,322,320,316,312,308,304,300,296,292,288,280,272,264,256,248,240,232,224,208,192,176,160,144,128,112,96,64,32,0,32,64,96,128,384,384.0088,384.00977,384.01074,384.01172,384.0127,384.01367,384.01465,384.01562,384.01758,384.01953,384.02148,384.02344,384.0254,384.02734,384.0293,384.03125,384.03516,384.03906,384.04297,384.04688,384.05078,384.0547,384.0586,384.0625,384.0703,384.07812,384.08594,384.09375,384.10156,384.10938,384.1172,384.125,384.14062,384.15625,384.17188,384.1875,384.20312,384.21875,384.23438,384.25,384.28125,384.3125,384.34375,384.375,384.40625,384.4375,384.46875,384.5,384.5625,384.625,384.6875,384.75,384.8125,384.875,384.9375,385,385.125,385.25,385.375,385.5,385.625,385.75,385.875,386,386.25,386.5,386.75,387,387.25,387.5,387.75,388,388.5,389,389.5,390,390.5,391,391.5,392,393,394,395,396,397,398,399,400,402,404,406,408,410,412,414,416,420,424,428,432,436,440,444,448,456,464,472,480,488,496,504,512,528,544,560,576,592,608,624,640,672,704,736,768,800,832,864,383.9922,383.9912,383.99023,383.98926,383.98828,383.9873,383.98633,383.98535,383.98438,383.98242,383.98047,383.97852,383.97656,383.9746,383.97266,383.9707,383.96875,383.96484,383.96094,383.95703,383.95312,383.94922,383.9453,383.9414,383.9375,383.9297,383.92188,383.91406,383.90625,383.89844,383.89062,383.8828,383.875,383.85938,383.84375,383.82812,383.8125,383.79688,383.78125,383.76562,383.75,383.71875,383.6875,383.65625,383.625,383.59375,383.5625,383.53125,383.5,383.4375,383.375,383.3125,383.25,383.1875,383.125,383.0625,383,382.875,382.75,382.625,382.5,382.375,382.25,382.125,382,381.75,381.5,381.25,381,380.75,380.5,380.25,380,379.5,379,378.5,378,377.5,377,376.5,376,375,374,373,372,371,370,369,368,366,364,362,360,358,356,354,352,348,344,340,336,332,328,324,320,312,304,296,288,280,272,264,256,240,224,208,192,176,160,144,128,96,64,32,0,32,64,96,416,416.0088,416.00977,416.01074,416.01172,416.0127,416.01367,416.01465,416.01562,416.01758,416.01953,416.02148,416.02344,416.0254,416.02734,416.0293,416.03125,416.03516,416.03906,416.04297,416.04688,416.05078,416.0547,416.0586,416.0625,416.0703,416.07812,416.08594,416.09375,416.10156,416.10938,416.1172,416.125,416.14062,416.15625,416.17188,416.1875,416.20312,416.21875,416.23438,416.25,416.28125,416.3125,416.34375,416.375,416.40625,416.4375,416.46875,416.5,416.5625,416.625,416.6875,416.75,416.8125,416.875,416.9375,417,417.125,417.25,417.375,417.5,417.625,417.75,417.875,418,418.25,418.5,418.75,419,419.25,419.5,419.75,420,420.5,421,421.5,422,422.5,423,423.5,424,425,426,427,428,429,430,431,432,434,436,438,440,442,444,446,448,452,456,460,464,468,472,476,480,488,496,504,512,520,528,536,544,560,576,592,608,624,640,656,672,704,736,768,800,832,864,896,415.9922,415.9912,415.99023,415.98926,415.98828,415.9873,415.98633,415.98535,415.98438,415.98242,415.98047,415.97852,415.97656,415.9746,415.97266,415.9707,415.96875,415.96484,415.96094,415.95703,415.95312,415.94922,415.9453,415.9414,415.9375,415.9297,415.92188,415.91406,415.90625,415.89844,415.89062,415.8828,415.875,415.85938,415.84375,415.82812,415.8125,415.79688,415.78125,415.76562,415.75,415.71875,415.6875,415.65625,415.625,415.59375,415.5625,415.53125,415.5,415.4375,415.375,415.3125,415.25,415.1875,415.125,415.0625,415,414.875,414.75,414.625,414.5,414.375,414.25,414.125,414,413.75,413.5,413.25,413,412.75,412.5,412.25,412,411.5,411,410.5,410,409.5,409,408.5,408,407,406,405,404,403,402,401,400,398,396,394,392,390,388,386,384,380,376,372,368,364,360,356,352,344,336,328,320,312,304,296,288,272,256,240,224,208,192,176,160,128,96,64,32,0,32,64,448,448.0088,448.00977,448.01074,448.01172,448.0127,448.01367,448.01465,448.01562,448.01758,448.01953,448.02148,448.02344,448.0254,448.02734,448.0293,448.03125,448.03516,448.03906,448.04297,448.04688,448.05078,448.0547,448.0586,448.0625,448.0703,448.07812,448.08594,448.09375,448.10156,448.10938,448.1172,448.125,448.14062,448.15625,448.17188,448.1875,448.20312,448.21875,448.23438,448.25,448.28125,448.3125,448.34375,448.375,448.40625,448.4375,448.46875,448.5,448.5625,448.625,448.6875,448.75,448.8125,448.875,448.9375,449,449.125,449.25,449.375,449.5,449.625,449.75,449.875,450,450.25,450.5,450.75,451,451.25,451.5,451.75,452,452.5,453,453.5,454,454.5,455,455.5,456,457,458,459,460,461,462,463,464,466,468,470,472,474,476,478,480,484,488,492,496,500,504,508,512,520,528,536,544,552,560,568,576,592,608,624,640,656,672,688,704,736,768,800,832,864,896,928,447.9922,447.9912,447.99023,447.98926,447.98828,447.9873,447.98633,447.98535,447.98438,447.98242,447.98047,447.97852,447.97656,447.9746,447.97266,447.9707,447.96875,447.96484,447.96094,447.95703,447.95312,447.94922,447.9453,447.9414,447.9375,447.9297,447.92188,447.91406,447.90625,447.89844,447.89062,447.8828,447.875,447.85938,447.84375,447.82812,447.8125,447.79688,447.78125,447.76562,447.75,447.71875,447.6875,447.65625,447.625,447.59375,447.5625,447.53125,447.5,447.4375,447.375,447.3125,447.25,447.1875,447.125,447.0625,447,446.875,446.75,446.625,446.5,446.375,446.25,446.125,446,445.75,445.5,445.25,445,444.75,444.5,444.25,444,443.5,443,442.5,442,441.5,441,440.5,440,439,438,437,436,435,434,433,432,430,428,426,424,422,420,418,416,412,408,404,400,396,392,388,384,376,368,360,352,344,336,328,320,304,288,272,256,240,224,208,192,160,128,96,64,32,0,32,480,480.0088,480.00977,480.01074,480.01172,480.0127,480.01367,480.01465,480.01562,480.01758,480.01953,480.02148,480.02344,480.0254,480.02734,480.0293,480.03125,480.03516,480.03906,480.04297,480.04688,480.05078,480.0547,480.0586,480.0625,480.0703,480.07812,480.08594,480.09375,480.10156,480.10938,480.1172,480.125,480.14062,480.15625,480.17188,480.1875,480.20312,480.21875,480.23438,480.25,480.28125,480.3125,480.34375,480.375,480.40625,480.4375,480.46875,480.5,480.5625,480.625,480.6875,480.75,480.8125,480.875,480.9375,481,481.125,481.25,481.375,481.5,481.625,481.75,481.875,482,482.25,482.5,482.75,483,483.25,483.5,483.75,484,484.5,485,485.5,486,486.5,487,487.5,488,489,490,491,492,493,494,495,496,498,500,502,504,506,508,510,512,516,520,524,528,532,536,540,544,552,560,568,576,584,592,600,608,624,640,656,672,688,704,720,736,768,800,832,864,896,928,960,479.9922,479.9912,479.99023,479.98926,479.98828,479.9873,479.98633,479.98535,479.98438,479.98242,479.98047,479.97852,479.97656,479.9746,479.97266,479.9707,479.96875,479.96484,479.96094,479.95703,479.95312,479.94922,479.9453,479.9414,479.9375,479.9297,479.92188,479.91406,479.90625,479.89844,479.89062,479.8828,479.875,479.85938,479.84375,479.82812,479.8125,479.79688,479.78125,479.76562,479.75,479.71875,479.6875,479.65625,479.625,479.59375,479.5625,479.53125,479.5,479.4375,479.375,479.3125,479.25,479.1875,479.125,479.0625,479,478.875,478.75,478.625,478.5,478.375,478.25,478.125,478,477.75,477.5,477.25,477,476.75,476.5,476.25,476,475.5,475,474.5,474,473.5,473,472.5,472,471,470,469,468,467,466,465,464,462,460,458,456,454,452,450,448,444,440,436,432,428,424,420,416,408,400,392,384,376,368,360,352,336,320,304,288,272,256,240,224,192,160,128,96,64,32,0}
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math/bits"
+
+// order key maps Float8 to unsigned byte so that byte order follows
+// numeric order: positive values get sign bit set, negative values are
+// inverted. It is rank (see equal.go) shifted by 128.
+func orderKey(a Float8) uint8 {
+	if a&signMask == 0 {
+		return a | signMask
+	}
+
+	return ^a
+}
+
+// ApproxDistance is a cheap pre-filter distance between vectors. It counts
+// bits that differ between order keys of elements (XOR/popcount) and
+// normalizes the count to [0, 1] by 8·len(a).
+//
+// The distance is not a metric in value space, use it only to discard
+// candidates before exact distance (e.g. Cosine) is computed. Bounds per
+// element, with k bits differing and h being highest differing bit of keys:
+//   - k = 0 if and only if elements are identical;
+//   - 1 ≤ k ≤ h+1 and elements are at most 2^(h+1)-1 steps apart
+//     (see Nextafter), so small k on high bits means large value gap;
+//   - neighbours may differ by up to 8 bits (e.g. 0x7f and 0x80 keys),
+//     ApproxDistance overestimates distance around powers of two.
+func ApproxDistance(a, b []Float8) float32 {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	if len(a) == 0 {
+		return 0
+	}
+
+	d := 0
+	for i := range a {
+		d += bits.OnesCount8(orderKey(a[i]) ^ orderKey(b[i]))
+	}
+
+	return float32(d) / float32(8*len(a))
+}
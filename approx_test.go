@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/bits"
+	"testing"
+)
+
+func TestOrderKey(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		if k := int(orderKey(uint8(a))); k != rank(uint8(a))+128 {
+			t.Errorf("key(0x%02x) = %d, wanted %d", a, k, rank(uint8(a))+128)
+		}
+	}
+}
+
+func TestApproxDistanceBounds(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			ka, kb := orderKey(uint8(a)), orderKey(uint8(b))
+			x := ka ^ kb
+			d := ApproxDistance([]Float8{uint8(a)}, []Float8{uint8(b)})
+
+			if (d == 0) != (a == b) {
+				t.Fatalf("0x%02x, 0x%02x: distance %f", a, b, d)
+			}
+
+			if x == 0 {
+				continue
+			}
+
+			h := bits.Len8(x) - 1
+			k := bits.OnesCount8(x)
+			gap := int(ka) - int(kb)
+			if gap < 0 {
+				gap = -gap
+			}
+
+			if k > h+1 || gap > 1<<(h+1)-1 {
+				t.Fatalf("0x%02x, 0x%02x: k=%d h=%d gap=%d", a, b, k, h, gap)
+			}
+		}
+	}
+}
+
+func TestApproxDistance(t *testing.T) {
+	a := []Float8{0x38, 0x40, 0xb8, 0x00}
+	b := []Float8{0x38, 0x40, 0xb8, 0x00}
+
+	if d := ApproxDistance(a, b); d != 0 {
+		t.Errorf("unexpected distance %f", d)
+	}
+
+	if d := ApproxDistance(a, []Float8{0xb8, 0xc0, 0x38, 0x80}); d != 1 {
+		t.Errorf("unexpected distance %f", d)
+	}
+
+	if d := ApproxDistance(nil, nil); d != 0 {
+		t.Errorf("unexpected distance %f", d)
+	}
+}
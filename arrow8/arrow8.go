@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package arrow8 converts float8 vectors from/to Apache Arrow arrays.
+// Vectors are stored either as FixedSizeBinary (byte width is the
+// dimension), FixedSizeList<uint8> or the registered extension type
+// "kshard.float8" that carries dimension and scale of vectors.
+//
+// The package is a standalone module so that float8 does not depend on Arrow.
+package arrow8
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/kshard/float8"
+)
+
+// FixedSizeBinary builds array of vectors with FixedSizeBinary storage
+func FixedSizeBinary(mem memory.Allocator, dim int, vectors [][]float8.Float8) (*array.FixedSizeBinary, error) {
+	b := array.NewFixedSizeBinaryBuilder(mem, &arrow.FixedSizeBinaryType{ByteWidth: dim})
+	defer b.Release()
+
+	b.Reserve(len(vectors))
+	for i, v := range vectors {
+		if v == nil {
+			b.AppendNull()
+			continue
+		}
+		if len(v) != dim {
+			return nil, fmt.Errorf("arrow8: vector %d has %d dimensions, expected %d", i, len(v), dim)
+		}
+		b.Append(v)
+	}
+
+	return b.NewFixedSizeBinaryArray(), nil
+}
+
+// FixedSizeList builds array of vectors with FixedSizeList<uint8> storage
+func FixedSizeList(mem memory.Allocator, dim int, vectors [][]float8.Float8) (*array.FixedSizeList, error) {
+	b := array.NewFixedSizeListBuilder(mem, int32(dim), arrow.PrimitiveTypes.Uint8)
+	defer b.Release()
+
+	vb := b.ValueBuilder().(*array.Uint8Builder)
+	b.Reserve(len(vectors))
+	for i, v := range vectors {
+		if v == nil {
+			b.AppendNull()
+			continue
+		}
+		if len(v) != dim {
+			return nil, fmt.Errorf("arrow8: vector %d has %d dimensions, expected %d", i, len(v), dim)
+		}
+		b.Append(true)
+		vb.AppendValues(v, nil)
+	}
+
+	return b.NewListArray(), nil
+}
+
+// Vectors returns float8 vectors from Arrow array. FixedSizeBinary,
+// FixedSizeList<uint8> and extension type Float8Type are supported.
+// Vectors reference memory of the array, null is returned as nil vector.
+func Vectors(arr arrow.Array) ([][]float8.Float8, error) {
+	switch a := arr.(type) {
+	case *Float8Array:
+		return Vectors(a.Storage())
+
+	case *array.FixedSizeBinary:
+		seq := make([][]float8.Float8, a.Len())
+		for i := range seq {
+			if a.IsValid(i) {
+				seq[i] = a.Value(i)
+			}
+		}
+		return seq, nil
+
+	case *array.FixedSizeList:
+		dim := int(a.DataType().(*arrow.FixedSizeListType).Len())
+		values, ok := a.ListValues().(*array.Uint8)
+		if !ok {
+			return nil, fmt.Errorf("arrow8: unsupported list of %s", a.ListValues().DataType())
+		}
+
+		raw := values.Uint8Values()
+		seq := make([][]float8.Float8, a.Len())
+		for i := range seq {
+			if a.IsValid(i) {
+				at := (a.Offset() + i) * dim
+				seq[i] = raw[at : at+dim : at+dim]
+			}
+		}
+		return seq, nil
+
+	default:
+		return nil, fmt.Errorf("arrow8: unsupported array of %s", arr.DataType())
+	}
+}
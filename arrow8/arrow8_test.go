@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package arrow8_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/arrow8"
+)
+
+var vectors = [][]float8.Float8{
+	{0x00, 0x38, 0xb8},
+	nil,
+	{0x46, 0x7f, 0xff},
+}
+
+func check(t *testing.T, seq [][]float8.Float8) {
+	t.Helper()
+
+	if len(seq) != len(vectors) {
+		t.Fatalf("got %d vectors", len(seq))
+	}
+
+	for i := range vectors {
+		if !bytes.Equal(seq[i], vectors[i]) || (seq[i] == nil) != (vectors[i] == nil) {
+			t.Errorf("vector %d got=%v expected=%v", i, seq[i], vectors[i])
+		}
+	}
+}
+
+func TestFixedSizeBinary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	arr, err := arrow8.FixedSizeBinary(mem, 3, vectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer arr.Release()
+
+	seq, err := arrow8.Vectors(arr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, seq)
+
+	if _, err := arrow8.FixedSizeBinary(mem, 4, vectors); err == nil {
+		t.Errorf("error is expected for invalid dimension")
+	}
+}
+
+func TestFixedSizeList(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	arr, err := arrow8.FixedSizeList(mem, 3, vectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer arr.Release()
+
+	seq, err := arrow8.Vectors(arr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, seq)
+}
+
+func TestRecord(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	typ := arrow8.NewFloat8Type(3, 0.5)
+	rec, err := arrow8.Record(mem, "embedding", typ, vectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Release()
+
+	if rec.NumRows() != int64(len(vectors)) {
+		t.Errorf("unexpected number of rows %d", rec.NumRows())
+	}
+
+	seq, err := arrow8.Vectors(rec.Column(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, seq)
+
+	ext, err := typ.Deserialize(typ.StorageType(), typ.Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !arrow.TypeEqual(ext, typ) {
+		t.Errorf("unexpected type %s", ext)
+	}
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package arrow8
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/kshard/float8"
+)
+
+// ExtensionName of float8 vectors
+const ExtensionName = "kshard.float8"
+
+// Float8Type is extension type of float8 vectors, stored as FixedSizeBinary.
+// Vector values are x = Scale × float8.
+type Float8Type struct {
+	arrow.ExtensionBase
+	Dim   int
+	Scale float32
+}
+
+type metadata struct {
+	Dim    int     `json:"dim"`
+	Scale  float32 `json:"scale"`
+	Format string  `json:"format"`
+}
+
+// NewFloat8Type creates extension type of vectors of dimension dim
+func NewFloat8Type(dim int, scale float32) *Float8Type {
+	return &Float8Type{
+		ExtensionBase: arrow.ExtensionBase{Storage: &arrow.FixedSizeBinaryType{ByteWidth: dim}},
+		Dim:           dim,
+		Scale:         scale,
+	}
+}
+
+func init() {
+	// the dimension is not known, the type is used as prototype for
+	// deserialization only.
+	if err := arrow.RegisterExtensionType(NewFloat8Type(0, 1)); err != nil {
+		panic(err)
+	}
+}
+
+func (*Float8Type) ArrayType() reflect.Type { return reflect.TypeOf(Float8Array{}) }
+
+func (*Float8Type) ExtensionName() string { return ExtensionName }
+
+func (t *Float8Type) String() string {
+	return fmt.Sprintf("extension<%s[dim=%d, scale=%g]>", ExtensionName, t.Dim, t.Scale)
+}
+
+func (t *Float8Type) Serialize() string {
+	b, _ := json.Marshal(metadata{Dim: t.Dim, Scale: t.Scale, Format: "e4m3"})
+	return string(b)
+}
+
+func (*Float8Type) Deserialize(storage arrow.DataType, data string) (arrow.ExtensionType, error) {
+	var m metadata
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return nil, fmt.Errorf("arrow8: invalid metadata: %w", err)
+	}
+
+	if m.Format != "e4m3" {
+		return nil, fmt.Errorf("arrow8: unsupported format %s", m.Format)
+	}
+
+	fsb, ok := storage.(*arrow.FixedSizeBinaryType)
+	if !ok || fsb.ByteWidth != m.Dim {
+		return nil, fmt.Errorf("arrow8: invalid storage %s for dim=%d", storage, m.Dim)
+	}
+
+	return NewFloat8Type(m.Dim, m.Scale), nil
+}
+
+func (t *Float8Type) ExtensionEquals(other arrow.ExtensionType) bool {
+	o, ok := other.(*Float8Type)
+	return ok && o.Dim == t.Dim && o.Scale == t.Scale
+}
+
+// Float8Array is array of extension type Float8Type
+type Float8Array struct {
+	array.ExtensionArrayBase
+}
+
+// Extension builds array of vectors of extension type
+func Extension(mem memory.Allocator, typ *Float8Type, vectors [][]float8.Float8) (*Float8Array, error) {
+	storage, err := FixedSizeBinary(mem, typ.Dim, vectors)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Release()
+
+	return array.NewExtensionArrayWithStorage(typ, storage).(*Float8Array), nil
+}
+
+// Record builds record batch with single column of vectors
+func Record(mem memory.Allocator, column string, typ *Float8Type, vectors [][]float8.Float8) (arrow.Record, error) {
+	arr, err := Extension(mem, typ, vectors)
+	if err != nil {
+		return nil, err
+	}
+	defer arr.Release()
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{{Name: column, Type: typ, Nullable: true}},
+		nil,
+	)
+
+	return array.NewRecord(schema, []arrow.Array{arr}, int64(arr.Len())), nil
+}
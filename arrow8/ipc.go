@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package arrow8
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/kshard/float8"
+)
+
+// Columns and schema metadata of Arrow IPC stream of embeddings
+const (
+	ColumnEmbedding = "embedding"
+	ColumnScale     = "scale"
+
+	MetadataFormat = "float8.format"
+	MetadataDim    = "float8.dim"
+
+	formatE4M3 = "e4m3"
+)
+
+// EmbeddingSchema is schema of Arrow IPC stream of embeddings: column
+// "embedding" FixedSizeBinary(dim) of float8 vectors and column "scale"
+// float32 of per vector scale, x = scale × float8. Number format and
+// dimension are annotated in schema metadata.
+func EmbeddingSchema(dim int) *arrow.Schema {
+	md := arrow.NewMetadata(
+		[]string{MetadataFormat, MetadataDim},
+		[]string{formatE4M3, strconv.Itoa(dim)},
+	)
+
+	return arrow.NewSchema([]arrow.Field{
+		{Name: ColumnEmbedding, Type: &arrow.FixedSizeBinaryType{ByteWidth: dim}, Nullable: true},
+		{Name: ColumnScale, Type: arrow.PrimitiveTypes.Float32},
+	}, &md)
+}
+
+// WriteIPC writes vectors as Arrow IPC stream of single record batch,
+// see EmbeddingSchema. Vector without data is written as null.
+func WriteIPC(w io.Writer, mem memory.Allocator, dim int, vectors []float8.Vector) error {
+	schema := EmbeddingSchema(dim)
+
+	data := make([][]float8.Float8, len(vectors))
+	scale := make([]float32, len(vectors))
+	for i, v := range vectors {
+		if v.Data != nil {
+			data[i] = v.Data[:v.Dim]
+		}
+		scale[i] = v.Scale
+	}
+
+	emb, err := FixedSizeBinary(mem, dim, data)
+	if err != nil {
+		return err
+	}
+	defer emb.Release()
+
+	sb := array.NewFloat32Builder(mem)
+	defer sb.Release()
+	sb.AppendValues(scale, nil)
+	scl := sb.NewFloat32Array()
+	defer scl.Release()
+
+	rec := array.NewRecord(schema, []arrow.Array{emb, scl}, int64(len(vectors)))
+	defer rec.Release()
+
+	iw := ipc.NewWriter(w, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err := iw.Write(rec); err != nil {
+		iw.Close()
+		return err
+	}
+
+	return iw.Close()
+}
+
+// ReadIPC reads vectors from Arrow IPC stream written by WriteIPC,
+// all record batches of the stream are concatenated. Vectors are copied
+// from Arrow memory, null is returned as vector without data.
+func ReadIPC(r io.Reader, mem memory.Allocator) ([]float8.Vector, error) {
+	rdr, err := ipc.NewReader(r, ipc.WithAllocator(mem))
+	if err != nil {
+		return nil, err
+	}
+	defer rdr.Release()
+
+	schema := rdr.Schema()
+	md := schema.Metadata()
+	if at := md.FindKey(MetadataFormat); at < 0 || md.Values()[at] != formatE4M3 {
+		return nil, fmt.Errorf("arrow8: stream is not %s float8 embeddings", formatE4M3)
+	}
+
+	emb, scl := schema.FieldIndices(ColumnEmbedding), schema.FieldIndices(ColumnScale)
+	if len(emb) != 1 || len(scl) != 1 {
+		return nil, fmt.Errorf("arrow8: stream has no %s and %s columns", ColumnEmbedding, ColumnScale)
+	}
+
+	var vectors []float8.Vector
+	for rdr.Next() {
+		rec := rdr.Record()
+
+		data, ok := rec.Column(emb[0]).(*array.FixedSizeBinary)
+		if !ok {
+			return nil, fmt.Errorf("arrow8: unsupported %s of %s", ColumnEmbedding, rec.Column(emb[0]).DataType())
+		}
+		scale, ok := rec.Column(scl[0]).(*array.Float32)
+		if !ok {
+			return nil, fmt.Errorf("arrow8: unsupported %s of %s", ColumnScale, rec.Column(scl[0]).DataType())
+		}
+
+		for i := 0; i < int(rec.NumRows()); i++ {
+			v := float8.Vector{Scale: scale.Value(i)}
+			if data.IsValid(i) {
+				v.Data = append([]float8.Float8{}, data.Value(i)...)
+				v.Dim = len(v.Data)
+			}
+			vectors = append(vectors, v)
+		}
+	}
+
+	if err := rdr.Err(); err != nil {
+		return nil, err
+	}
+
+	return vectors, nil
+}
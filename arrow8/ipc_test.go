@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package arrow8_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/arrow8"
+)
+
+func TestIPC(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	in := []float8.Vector{
+		float8.NewVector([]float32{1, -2, 3}),
+		{},
+		float8.NewVector([]float32{0.5, 0.25, 0}),
+	}
+
+	var buf bytes.Buffer
+	if err := arrow8.WriteIPC(&buf, mem, 3, in); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := arrow8.ReadIPC(&buf, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out) != len(in) {
+		t.Fatalf("got %d vectors", len(out))
+	}
+	for i := range in {
+		if out[i].Scale != in[i].Scale || out[i].Dim != in[i].Dim || !bytes.Equal(out[i].Data, in[i].Data) {
+			t.Errorf("vector %d got=%+v expected=%+v", i, out[i], in[i])
+		}
+	}
+
+	if err := arrow8.WriteIPC(&buf, mem, 4, in); err == nil {
+		t.Errorf("error is expected for invalid dimension")
+	}
+}
@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// DotAsymmetric is dot product of float32 query and float8 document,
+// the query is kept in full precision (asymmetric distance computation).
+func DotAsymmetric(query []float32, doc []Float8) float32 {
+	return DotF32(doc, query)
+}
+
+// CosineAsymmetric is cosine distance between float32 query and float8
+// document, 1 - q·d / (‖q‖·‖d‖). The distance is 1 if any of vectors is zero.
+func CosineAsymmetric(query []float32, doc []Float8) float32 {
+	if len(query) != len(doc) {
+		panic("vectors must have same length")
+	}
+
+	qd, qq, dd := float32(0), float32(0), float32(0)
+	for i, c := range doc {
+		x, y := query[i], f8tof32[c]
+		qd += x * y
+		qq += x * x
+		dd += y * y
+	}
+
+	return cosineDistance(qd, float64(qq), dd)
+}
+
+func cosineDistance(ab float32, aa float64, bb float32) float32 {
+	if aa == 0 || bb == 0 {
+		return 1
+	}
+
+	return 1 - float32(float64(ab)/math.Sqrt(aa*float64(bb)))
+}
+
+// LUT is per-query decode cache. For each dimension i it keeps 256
+// contributions query[i]·c of every float8 value c of document, so scoring
+// is table lookups and additions only (asymmetric distance computation).
+// The table takes 1 KiB per dimension.
+type LUT []float32
+
+// BuildLUT precomputes contributions of document bytes for the query
+func BuildLUT(query []float32) LUT {
+	lut := make(LUT, len(query)<<8)
+	for i, x := range query {
+		row := lut[i<<8 : (i+1)<<8]
+		for c, y := range f8tof32 {
+			row[c] = x * y
+		}
+	}
+	return lut
+}
+
+// Dim is dimension of query
+func (lut LUT) Dim() int { return len(lut) >> 8 }
+
+// ScoreWithLUT is dot product of query and document, see BuildLUT
+func ScoreWithLUT(lut LUT, doc []Float8) float32 {
+	if len(doc) != lut.Dim() {
+		panic("vectors must have same length")
+	}
+
+	// rows of table are walked by slicing, four independent sums hide
+	// latency of lookups
+	d0, d1, d2, d3 := float32(0), float32(0), float32(0), float32(0)
+	i := 0
+	for ; i+4 <= len(doc); i += 4 {
+		d0 += lut[int(doc[i])]
+		d1 += lut[0x100+int(doc[i+1])]
+		d2 += lut[0x200+int(doc[i+2])]
+		d3 += lut[0x300+int(doc[i+3])]
+		lut = lut[0x400:]
+	}
+	for ; i < len(doc); i++ {
+		d0 += lut[int(doc[i])]
+		lut = lut[0x100:]
+	}
+
+	return (d0 + d1) + (d2 + d3)
+}
+
+// AsymmetricQuery is float32 query prepared for scoring of many float8
+// documents, it keeps LUT of the query and its norm.
+type AsymmetricQuery struct {
+	lut    LUT
+	sqnorm float64
+}
+
+// NewAsymmetricQuery prepares query for asymmetric scoring
+func NewAsymmetricQuery(query []float32) *AsymmetricQuery {
+	qq := 0.0
+	for _, x := range query {
+		qq += float64(x) * float64(x)
+	}
+
+	return &AsymmetricQuery{lut: BuildLUT(query), sqnorm: qq}
+}
+
+// Dim is dimension of query
+func (q *AsymmetricQuery) Dim() int { return q.lut.Dim() }
+
+// Dot product of query and document
+func (q *AsymmetricQuery) Dot(doc []Float8) float32 { return ScoreWithLUT(q.lut, doc) }
+
+// Cosine distance between query and document, see CosineAsymmetric
+func (q *AsymmetricQuery) Cosine(doc []Float8) float32 {
+	if len(doc) != q.Dim() {
+		panic("vectors must have same length")
+	}
+
+	qd, dd := float32(0), float32(0)
+	for i, c := range doc {
+		qd += q.lut[i<<8|int(c)]
+		dd += sqdiff[int(c)<<8]
+	}
+
+	return cosineDistance(qd, q.sqnorm, dd)
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestAsymmetric(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for i := 0; i < 50; i++ {
+		query := randVector(r, 96, 1)
+		doc := randFloat8s(r, 96)
+
+		dot, qq, dd := 0.0, 0.0, 0.0
+		for i, x := range query {
+			y := float64(ToFloat32(doc[i]))
+			dot += float64(x) * y
+			qq += float64(x) * float64(x)
+			dd += y * y
+		}
+		cos := 1 - dot/math.Sqrt(qq*dd)
+
+		q := NewAsymmetricQuery(query)
+		if q.Dim() != 96 {
+			t.Fatalf("unexpected dim %d", q.Dim())
+		}
+
+		for name, d := range map[string]float32{
+			"dot":       DotAsymmetric(query, doc),
+			"query dot": q.Dot(doc),
+			"lut":       ScoreWithLUT(BuildLUT(query), doc),
+		} {
+			if math.Abs(float64(d)-dot) > 1e-4 {
+				t.Errorf("%s %g, expected %g", name, d, dot)
+			}
+		}
+
+		for name, d := range map[string]float32{
+			"cosine":       CosineAsymmetric(query, doc),
+			"query cosine": q.Cosine(doc),
+		} {
+			if math.Abs(float64(d)-cos) > 1e-5 {
+				t.Errorf("%s %g, expected %g", name, d, cos)
+			}
+		}
+	}
+}
+
+func TestAsymmetricZero(t *testing.T) {
+	if d := CosineAsymmetric([]float32{0, 0}, []Float8{0x38, 0x38}); d != 1 {
+		t.Errorf("cosine with zero query %g", d)
+	}
+
+	if d := NewAsymmetricQuery([]float32{1, 2}).Cosine([]Float8{0, 0}); d != 1 {
+		t.Errorf("cosine with zero document %g", d)
+	}
+}
+
+func BenchmarkAsymmetric(b *testing.B) {
+	r := rand.New(rand.NewPCG(3, 4))
+	query := randVector(r, 256, 1)
+	doc := randFloat8s(r, 256)
+	q := NewAsymmetricQuery(query)
+
+	b.Run("direct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f32 = DotAsymmetric(query, doc)
+		}
+	})
+
+	b.Run("lut", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f32 = q.Dot(doc)
+		}
+	})
+}
+
+func TestLUT(t *testing.T) {
+	lut := BuildLUT([]float32{1, -2, 0.5})
+	if lut.Dim() != 3 || len(lut) != 3*256 {
+		t.Fatalf("unexpected dim %d", lut.Dim())
+	}
+
+	if lut[0x38] != 1 || lut[0x100|0x38] != -2 || lut[0x200|0xb8] != -0.5 {
+		t.Errorf("unexpected contributions")
+	}
+
+	if d := ScoreWithLUT(lut, []Float8{0x40, 0x38, 0x48}); d != 2 {
+		t.Errorf("score %g", d)
+	}
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+)
+
+// SignQuantize packs sign bits of vector into 64-bit words, bit i of
+// the code is set if v[i] is positive. The code is ⌈len(v)/64⌉ words.
+func SignQuantize(v []Float8) []uint64 {
+	code := make([]uint64, (len(v)+63)/64)
+	for i, f8 := range v {
+		if f8&signMask == 0 && f8 != 0 {
+			code[i/64] |= 1 << (i % 64)
+		}
+	}
+
+	return code
+}
+
+// Hamming distance between binary codes
+func Hamming(a, b []uint64) int {
+	if len(a) != len(b) {
+		panic("codes must have same length")
+	}
+
+	d := 0
+	for i := range a {
+		d += bits.OnesCount64(a[i] ^ b[i])
+	}
+
+	return d
+}
+
+// Neighbor is a search result: index of vector in corpus and its distance
+type Neighbor struct {
+	Index    int
+	Distance float32
+}
+
+// HammingTopK is two-stage search. The first stage selects candidates
+// nearest to the query by Hamming distance of codes produced by SignQuantize,
+// the second stage reranks candidates with exact Cosine distance over float8
+// vectors. It returns up to k neighbors ordered by distance.
+func HammingTopK(query []Float8, corpus [][]Float8, codes [][]uint64, candidates, k int) []Neighbor {
+	if len(corpus) != len(codes) {
+		panic("corpus and codes must have same length")
+	}
+
+	candidates = min(max(candidates, k), len(corpus))
+	code := SignQuantize(query)
+
+	hits := make([]Neighbor, len(corpus))
+	for i := range codes {
+		hits[i] = Neighbor{Index: i, Distance: float32(Hamming(code, codes[i]))}
+	}
+	slices.SortFunc(hits, compareNeighbor)
+	hits = hits[:candidates]
+
+	for i := range hits {
+		hits[i].Distance = Cosine(query, corpus[hits[i].Index])
+	}
+	slices.SortFunc(hits, compareNeighbor)
+
+	return hits[:min(k, len(hits))]
+}
+
+func compareNeighbor(a, b Neighbor) int {
+	if c := cmp.Compare(a.Distance, b.Distance); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Index, b.Index)
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSignQuantize(t *testing.T) {
+	v := make([]Float8, 70)
+	v[0], v[1], v[2], v[65] = 0x38, 0xb8, 0x00, 0x40
+
+	code := SignQuantize(v)
+	if len(code) != 2 || code[0] != 0x01 || code[1] != 0x02 {
+		t.Errorf("unexpected code %x", code)
+	}
+
+	if d := Hamming(code, SignQuantize(make([]Float8, 70))); d != 2 {
+		t.Errorf("unexpected hamming distance %d", d)
+	}
+}
+
+func TestHammingTopK(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	corpus := make([][]Float8, 200)
+	codes := make([][]uint64, len(corpus))
+	for i := range corpus {
+		corpus[i] = make([]Float8, 128)
+		FillRandom(r, corpus[i])
+		Sanitize(corpus[i], corpus[i])
+		codes[i] = SignQuantize(corpus[i])
+	}
+
+	query := slices.Clone(corpus[42])
+	hits := HammingTopK(query, corpus, codes, 20, 5)
+	if len(hits) != 5 {
+		t.Fatalf("unexpected number of hits %d", len(hits))
+	}
+
+	if hits[0].Index != 42 || hits[0].Distance > 1e-6 {
+		t.Errorf("unexpected nearest neighbor %+v", hits[0])
+	}
+
+	if !slices.IsSortedFunc(hits, compareNeighbor) {
+		t.Errorf("hits are not sorted %v", hits)
+	}
+
+	if hits := HammingTopK(query, corpus[:3], codes[:3], 1, 10); len(hits) != 3 {
+		t.Errorf("unexpected number of hits %d", len(hits))
+	}
+}
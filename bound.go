@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// number of elements accumulated between checks of the bound
+const boundChunk = 16
+
+// magnitude of float8 follows the order of bit patterns with cleared sign.
+// The pattern 0x80 is -2^-7, its magnitude is accounted for in every chunk.
+func magnitude(m Float8) float32 {
+	return max(f8tof32[m], -f8tof32[signMask])
+}
+
+// DotAtLeast computes dot product of vectors if it is not less than
+// threshold. Every 16 elements the partial sum is checked against the
+// bound of remaining products, the sum of len·max|a|·max|b| over the
+// remaining chunks, and computation stops once threshold is not reachable.
+// It returns the dot product and true or the partial sum and false. The
+// bound is effective if threshold is close to the largest possible dot
+// product (e.g. top-k search of normalized vectors with high similarity
+// cutoff). Bounds of chunks are computed by one pass of byte comparisons
+// over both vectors before the first product.
+func DotAtLeast(a, b []Float8, threshold float32) (float32, bool) {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	// suffix sums of chunk bounds, rest[k] bounds products of chunks [k:]
+	chunks := (len(a) + boundChunk - 1) / boundChunk
+	var stack [65]float32
+	var rest []float32
+	if chunks < len(stack) {
+		rest = stack[:chunks+1]
+	} else {
+		rest = make([]float32, chunks+1)
+	}
+	for k := chunks - 1; k >= 0; k-- {
+		i, end := k*boundChunk, min((k+1)*boundChunk, len(a))
+		ma, mb := Float8(0), Float8(0)
+		for j := i; j < end; j++ {
+			ma, mb = max(ma, a[j]&^signMask), max(mb, b[j]&^signMask)
+		}
+		rest[k] = rest[k+1] + float32(end-i)*magnitude(ma)*magnitude(mb)
+	}
+
+	d := float32(0)
+	for k := 0; k < chunks; k++ {
+		i, end := k*boundChunk, min((k+1)*boundChunk, len(a))
+		for j := i; j < end; j++ {
+			d += f8tof32[a[j]] * f8tof32[b[j]]
+		}
+
+		if d+rest[k+1] < threshold {
+			return d, false
+		}
+	}
+
+	return d, d >= threshold
+}
+
+// EuclideanAtMost computes Euclidean distance between vectors if it does
+// not exceed threshold. Squared differences are non negative, the partial
+// sum is the lower bound of distance, so computation stops as soon as it
+// exceeds threshold². It returns the distance and true or the partial
+// distance and false.
+func EuclideanAtMost(a, b []Float8, threshold float32) (float32, bool) {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	t2 := threshold * threshold
+	if threshold < 0 {
+		t2 = -1
+	}
+
+	d := float32(0)
+	for i := 0; i < len(a); i += boundChunk {
+		end := min(i+boundChunk, len(a))
+		for j := i; j < end; j++ {
+			d += sqdiff[int(a[j])<<8|int(b[j])]
+		}
+
+		// threshold² is rounded, the distance is compared exactly
+		if d > t2 {
+			if dist := float32(math.Sqrt(float64(d))); dist > threshold {
+				return dist, false
+			}
+		}
+	}
+
+	dist := float32(math.Sqrt(float64(d)))
+	return dist, dist <= threshold
+}
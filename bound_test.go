@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestDotAtLeast(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for i := 0; i < 200; i++ {
+		a := randFloat8s(r, 1+r.IntN(300))
+		b := randFloat8s(r, len(a))
+
+		exact := Dot(a, b)
+		for _, threshold := range []float32{-1e9, exact - 1, exact, exact + 1, 1e9} {
+			d, ok := DotAtLeast(a, b, threshold)
+			if ok != (exact >= threshold) {
+				t.Fatalf("threshold %g: ok=%v, dot %g", threshold, ok, exact)
+			}
+			if ok && d != exact {
+				t.Fatalf("threshold %g: dot %g, expected %g", threshold, d, exact)
+			}
+		}
+	}
+}
+
+func TestDotAtLeastEarly(t *testing.T) {
+	a := make([]Float8, 256)
+	b := make([]Float8, 256)
+	for i := range a {
+		a[i], b[i] = 0x38, 0xb8
+	}
+
+	// the first chunk sums to -16, the rest can add at most 240
+	if d, ok := DotAtLeast(a, b, 225); ok || d != -16 {
+		t.Errorf("computation is not terminated, %g", d)
+	}
+
+	if d, ok := DotAtLeast([]Float8{0x80}, []Float8{0x80}, 0); !ok || d <= 0 {
+		t.Errorf("-2^-7 × -2^-7 = %g", d)
+	}
+
+	if d, ok := DotAtLeast(nil, nil, 5); ok || d != 0 {
+		t.Errorf("dot of empty vectors reaches threshold, %g", d)
+	}
+	if _, ok := DotAtLeast(nil, nil, 0); !ok {
+		t.Errorf("dot of empty vectors does not reach 0")
+	}
+}
+
+func TestEuclideanAtMost(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+
+	for i := 0; i < 200; i++ {
+		a := randFloat8s(r, 1+r.IntN(300))
+		b := randFloat8s(r, len(a))
+
+		exact := Euclidean(a, b)
+		for _, threshold := range []float32{-1, 0, exact / 2, exact, exact * 2} {
+			d, ok := EuclideanAtMost(a, b, threshold)
+			if ok != (exact <= threshold) {
+				t.Fatalf("threshold %g: ok=%v, distance %g", threshold, ok, exact)
+			}
+			if ok && d != exact {
+				t.Fatalf("threshold %g: distance %g, expected %g", threshold, d, exact)
+			}
+			if !ok && d > exact {
+				t.Fatalf("threshold %g: partial distance %g exceeds %g", threshold, d, exact)
+			}
+		}
+	}
+
+	if d, ok := EuclideanAtMost([]Float8{0x38}, []Float8{0x38}, 0); !ok || d != 0 {
+		t.Errorf("distance to itself %g", d)
+	}
+}
+
+func BenchmarkEuclideanAtMost(b *testing.B) {
+	r := rand.New(rand.NewPCG(5, 6))
+	q := randFloat8s(r, 256)
+	docs := make([][]Float8, 64)
+	for i := range docs {
+		docs[i] = randFloat8s(r, 256)
+	}
+
+	// cutoff of the nearest quarter of documents
+	dist := make([]float32, len(docs))
+	for i, doc := range docs {
+		dist[i] = Euclidean(q, doc)
+	}
+	slices.Sort(dist)
+	threshold := dist[len(dist)/4]
+
+	b.Run("full", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f32 = Euclidean(q, docs[i%len(docs)])
+		}
+	})
+
+	b.Run("bounded", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			f32, _ = EuclideanAtMost(q, docs[i%len(docs)], threshold)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "unsafe"
+
+// AsBytes reinterprets float8 slice as byte slice without copy, slices share memory.
+// Float8 is one byte value, the conversion is safe regardless of Float8 being
+// an alias or defined type.
+func AsBytes(f8s []Float8) []byte {
+	if f8s == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(f8s))), len(f8s))
+}
+
+// FromBytes reinterprets byte slice as float8 slice without copy, slices share memory.
+func FromBytes(b []byte) []Float8 {
+	if b == nil {
+		return nil
+	}
+	return unsafe.Slice((*Float8)(unsafe.Pointer(unsafe.SliceData(b))), len(b))
+}
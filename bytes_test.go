@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "testing"
+
+func TestAsBytes(t *testing.T) {
+	f8s := []Float8{0x38, 0x40}
+	b := AsBytes(f8s)
+
+	b[0] = 0xb8
+	if f8s[0] != 0xb8 || len(b) != 2 {
+		t.Errorf("memory is not shared")
+	}
+
+	if AsBytes(nil) != nil || len(AsBytes([]Float8{})) != 0 {
+		t.Errorf("unexpected conversion of empty slice")
+	}
+}
+
+func TestFromBytes(t *testing.T) {
+	b := []byte{0x38, 0x40}
+	f8s := FromBytes(b)
+
+	f8s[1] = 0xc0
+	if b[1] != 0xc0 || len(f8s) != 2 {
+		t.Errorf("memory is not shared")
+	}
+
+	if FromBytes(nil) != nil || len(FromBytes([]byte{})) != 0 {
+		t.Errorf("unexpected conversion of empty slice")
+	}
+}
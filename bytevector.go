@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// ByteVector converts vector to knn byte vector of OpenSearch and
+// Elasticsearch (knn_vector of data_type byte, element_type byte),
+// values are x ≈ scale × b. Elements are symmetrically quantized to
+// [-127, 127] (see Int8Quantizer). []int8 is marshaled to JSON as array
+// of numbers as required by the index.
+func ByteVector(v Vector) ([]int8, float32) {
+	maxAbs := float32(0)
+	for _, x := range v.Data[:v.Dim] {
+		maxAbs = max(maxAbs, float32(math.Abs(float64(f8tof32[x]))))
+	}
+
+	q := NewInt8Quantizer(-maxAbs*v.Scale, maxAbs*v.Scale, true)
+
+	b := make([]int8, v.Dim)
+	for i, x := range v.Data[:v.Dim] {
+		b[i] = q.Quantize(v.Scale * f8tof32[x])
+	}
+
+	return b, q.Scale
+}
+
+// FromByteVector quantizes knn byte vector of OpenSearch and Elasticsearch
+// with given scale into vector, see ByteVector.
+func FromByteVector(b []int8, scale float32) Vector {
+	q := Int8Quantizer{Scale: scale}
+
+	x := make([]float32, len(b))
+	q.DequantizeSlice(x, b)
+
+	return NewVector(x)
+}
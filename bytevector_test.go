@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestByteVector(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	v := NewVector(randVector(r, 64, 10))
+	b, scale := ByteVector(v)
+
+	x := v.Dequantize()
+	for i := range b {
+		if d := math.Abs(float64(scale*float32(b[i]) - x[i])); d > float64(scale)/2+1e-6 {
+			t.Errorf("%g is converted as %d", x[i], b[i])
+		}
+	}
+
+	w := FromByteVector(b, scale)
+	if d := v.Cosine(w); d > 0.01 {
+		t.Errorf("cosine distance of round trip %g", d)
+	}
+
+	raw, err := json.Marshal(b[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw[0] != '[' {
+		t.Errorf("byte vector is not json array %s", raw)
+	}
+}
+
+func TestByteVectorZero(t *testing.T) {
+	b, scale := ByteVector(NewVector(make([]float32, 4)))
+	for _, x := range b {
+		if x != 0 {
+			t.Errorf("unexpected %v", b)
+		}
+	}
+
+	if w := FromByteVector(b, scale); w.Dim != 4 {
+		t.Errorf("unexpected %+v", w)
+	}
+}
@@ -0,0 +1,222 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+
+	"github.com/kshard/float8"
+)
+
+// number of values processed per chunk
+const chunkSize = 64 * 1024
+
+func convert(args []string) error {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	in := fs.String("in", "", "input file")
+	out := fs.String("out", "", "output file")
+	scale := fs.String("scale", "1", "scale factor (x = scale * float8), use auto to derive it from input")
+	decode := fs.Bool("decode", false, "convert float8 to float32")
+	quiet := fs.Bool("quiet", false, "disable progress reporting")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *in == "" || *out == "" {
+		return errors.New("both -in and -out are required")
+	}
+
+	r, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	fi, err := r.Stat()
+	if err != nil {
+		return err
+	}
+
+	var s float32
+	switch {
+	case *scale == "auto" && *decode:
+		return errors.New("-scale auto is not supported by -decode")
+	case *scale == "auto":
+		if s, err = autoScale(r); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "scale: %g\n", s)
+	default:
+		v, err := strconv.ParseFloat(*scale, 32)
+		if err != nil || v == 0 {
+			return fmt.Errorf("invalid scale %q", *scale)
+		}
+		s = float32(v)
+	}
+
+	w, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	p := progress{total: fi.Size(), quiet: *quiet}
+	bw := bufio.NewWriter(w)
+	if *decode {
+		err = toFloat32(bufio.NewReader(r), bw, s, &p)
+	} else {
+		err = toFloat8(bufio.NewReader(r), bw, s, &p)
+	}
+	if err != nil {
+		return err
+	}
+	p.done()
+
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+// scale that maps max absolute value of input onto max float8 value
+func autoScale(r io.ReadSeeker) (float32, error) {
+	buf := make([]byte, 4*chunkSize)
+	max := float32(0)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n%4 != 0 {
+			return 0, errors.New("input is not a sequence of float32")
+		}
+		for i := 0; i < n; i += 4 {
+			v := math.Float32frombits(binary.LittleEndian.Uint32(buf[i:]))
+			if v < 0 {
+				v = -v
+			}
+			if v > max && !math.IsInf(float64(v), 0) {
+				max = v
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	if max == 0 {
+		return 1, nil
+	}
+
+	// ToFloat8 truncates, nudge scale down if rounding error of division
+	// lands the max value just below MaxValue
+	scale := max / float8.ToFloat32(float8.MaxValue)
+	if scale == 0 {
+		return 0, errors.New("input is too small to scale")
+	}
+	for float8.ToFloat8(max/scale) != float8.MaxValue {
+		scale = math.Nextafter32(scale, 0)
+	}
+
+	return scale, nil
+}
+
+func toFloat8(r io.Reader, w io.Writer, scale float32, p *progress) error {
+	src := make([]byte, 4*chunkSize)
+	dst := make([]byte, chunkSize)
+
+	for {
+		n, err := io.ReadFull(r, src)
+		if n%4 != 0 {
+			return errors.New("input is not a sequence of float32")
+		}
+		for i := 0; i < n/4; i++ {
+			v := math.Float32frombits(binary.LittleEndian.Uint32(src[4*i:]))
+			dst[i] = float8.ToFloat8(v / scale)
+		}
+
+		if _, err := w.Write(dst[:n/4]); err != nil {
+			return err
+		}
+		p.add(n)
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func toFloat32(r io.Reader, w io.Writer, scale float32, p *progress) error {
+	src := make([]byte, chunkSize)
+	dst := make([]byte, 4*chunkSize)
+
+	for {
+		n, err := io.ReadFull(r, src)
+		for i := 0; i < n; i++ {
+			v := scale * float8.ToFloat32(src[i])
+			binary.LittleEndian.PutUint32(dst[4*i:], math.Float32bits(v))
+		}
+
+		if _, err := w.Write(dst[:4*n]); err != nil {
+			return err
+		}
+		p.add(n)
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// progress reporter of streaming operations
+type progress struct {
+	total int64
+	seen  int64
+	quiet bool
+}
+
+func (p *progress) add(n int) {
+	p.seen += int64(n)
+	if p.quiet {
+		return
+	}
+
+	if p.total > 0 {
+		fmt.Fprintf(os.Stderr, "\r%d/%d bytes (%.1f%%)", p.seen, p.total, 100*float64(p.seen)/float64(p.total))
+	} else {
+		fmt.Fprintf(os.Stderr, "\r%d bytes", p.seen)
+	}
+}
+
+func (p *progress) done() {
+	if !p.quiet {
+		fmt.Fprintf(os.Stderr, "\n")
+	}
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+func TestAutoScale(t *testing.T) {
+	for _, seq := range [][]float32{
+		{1, -2, 3, 10},
+		{1, -20, 3, 10},
+		{0.001, 0.002, -0.0005},
+		{1e6, -3e5},
+	} {
+		buf := make([]byte, 4*len(seq))
+		for i, v := range seq {
+			binary.LittleEndian.PutUint32(buf[4*i:], math.Float32bits(v))
+		}
+
+		r := bytes.NewReader(buf)
+		scale, err := autoScale(r)
+		if err != nil {
+			t.Fatalf("autoScale(%v): %v", seq, err)
+		}
+
+		var w bytes.Buffer
+		if err := toFloat8(r, &w, scale, &progress{quiet: true}); err != nil {
+			t.Fatalf("toFloat8(%v): %v", seq, err)
+		}
+
+		peak := 0
+		for i, v := range seq {
+			if math.Abs(float64(v)) > math.Abs(float64(seq[peak])) {
+				peak = i
+			}
+		}
+
+		for i, x := range w.Bytes() {
+			if x&0x7f == float8.Infinity {
+				t.Errorf("%v: element %d encoded as Infinity (%#02x)", seq, i, x)
+			}
+		}
+		if x := w.Bytes()[peak]; x&0x7f != float8.MaxValue {
+			t.Errorf("%v: max element encoded as %#02x, expected ±MaxValue", seq, x)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kshard/float8"
+)
+
+func inspect(args []string) error {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		return errors.New("value is required, use 0x.. or 0b.. for bit pattern or decimal for real number")
+	}
+
+	for _, arg := range fs.Args() {
+		f8, err := parseValue(arg)
+		if err != nil {
+			return err
+		}
+
+		describe(os.Stdout, arg, f8)
+	}
+
+	return nil
+}
+
+// parse either bit pattern (0x5c, 0b01011100) or real value (3.5)
+func parseValue(s string) (float8.Float8, error) {
+	lower := strings.ToLower(s)
+	if strings.HasPrefix(lower, "0x") || strings.HasPrefix(lower, "0b") {
+		v, err := strconv.ParseUint(lower, 0, 8)
+		if err != nil {
+			return 0, fmt.Errorf("invalid bit pattern %q", s)
+		}
+		return float8.Float8(v), nil
+	}
+
+	v, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	return float8.ToFloat8(float32(v)), nil
+}
+
+func describe(w io.Writer, arg string, f8 float8.Float8) {
+	sign := f8 >> 7
+	exponent := (f8 >> 3) & 0x0f
+	mantissa := f8 & 0x07
+
+	fmt.Fprintf(w, "input    : %s\n", arg)
+	fmt.Fprintf(w, "float8   : 0x%02x (0b%08b)\n", f8, f8)
+	fmt.Fprintf(w, "sign     : %d\n", sign)
+	fmt.Fprintf(w, "exponent : 0b%04b (%d, unbiased %d)\n", exponent, exponent, int(exponent)-7)
+	fmt.Fprintf(w, "mantissa : 0b%03b (%d)\n", mantissa, mantissa)
+	fmt.Fprintf(w, "float32  : %g\n", float8.ToFloat32(f8))
+
+	if down := float8.Nextafter(f8, 0xff); down != f8 {
+		fmt.Fprintf(w, "down     : 0x%02x (%g)\n", down, float8.ToFloat32(down))
+	} else {
+		fmt.Fprintf(w, "down     : -\n")
+	}
+
+	if up := float8.Nextafter(f8, 0x7f); up != f8 {
+		fmt.Fprintf(w, "up       : 0x%02x (%g)\n", up, float8.ToFloat32(up))
+	} else {
+		fmt.Fprintf(w, "up       : -\n")
+	}
+
+	fmt.Fprintf(w, "ulp      : %g\n\n", float8.Ulp(f8))
+}
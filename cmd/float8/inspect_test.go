@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	for _, tc := range []struct {
+		arg, expected string
+	}{
+		{"0x5c", `input    : 0x5c
+float8   : 0x5c (0b01011100)
+sign     : 0
+exponent : 0b1011 (11, unbiased 4)
+mantissa : 0b100 (4)
+float32  : 24
+down     : 0x5b (22)
+up       : 0x5d (26)
+ulp      : 2
+
+`},
+		{"3.5", `input    : 3.5
+float8   : 0x46 (0b01000110)
+sign     : 0
+exponent : 0b1000 (8, unbiased 1)
+mantissa : 0b110 (6)
+float32  : 3.5
+down     : 0x45 (3.25)
+up       : 0x47 (3.75)
+ulp      : 0.25
+
+`},
+		{"0b00000000", `input    : 0b00000000
+float8   : 0x00 (0b00000000)
+sign     : 0
+exponent : 0b0000 (0, unbiased -7)
+mantissa : 0b000 (0)
+float32  : 0
+down     : 0x80 (-0.0078125)
+up       : 0x01 (0.0087890625)
+ulp      : 0.0087890625
+
+`},
+		{"0x7f", `input    : 0x7f
+float8   : 0x7f (0b01111111)
+sign     : 0
+exponent : 0b1111 (15, unbiased 8)
+mantissa : 0b111 (7)
+float32  : 480
+down     : 0x7e (448)
+up       : -
+ulp      : 32
+
+`},
+		{"-1000", `input    : -1000
+float8   : 0xff (0b11111111)
+sign     : 1
+exponent : 0b1111 (15, unbiased 8)
+mantissa : 0b111 (7)
+float32  : -480
+down     : -
+up       : 0xfe (-448)
+ulp      : 32
+
+`},
+	} {
+		f8, err := parseValue(tc.arg)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.arg, err)
+		}
+
+		var w bytes.Buffer
+		describe(&w, tc.arg, f8)
+		if w.String() != tc.expected {
+			t.Errorf("%s:\n%s\nexpected:\n%s", tc.arg, w.String(), tc.expected)
+		}
+	}
+}
+
+func TestInspectInvalid(t *testing.T) {
+	for _, arg := range []string{"0x100", "0b2", "abc", ""} {
+		if _, err := parseValue(arg); err == nil {
+			t.Errorf("%q: error is expected", arg)
+		}
+	}
+
+	if err := inspect(nil); err == nil {
+		t.Errorf("error is expected without value")
+	}
+}
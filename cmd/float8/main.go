@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Command float8 is a command line utility for float8 data.
+//
+//	float8 convert -in emb.f32 -out emb.f8 [-scale auto]
+//	float8 convert -decode -in emb.f8 -out emb.f32 [-scale 0.5]
+//	float8 inspect 0x5c 3.5
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+var commands = map[string]func(args []string) error{
+	"convert": convert,
+	"inspect": inspect,
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: float8 <command> [flags]\n\ncommands:\n")
+	fmt.Fprintf(os.Stderr, "  convert   convert raw little-endian float32 file to float8 and back\n")
+	fmt.Fprintf(os.Stderr, "  inspect   show decomposition of float8 value\n")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, has := commands[os.Args[1]]
+	if !has {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "float8 %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
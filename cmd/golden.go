@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/kshard/float8/conformance"
+)
+
+const (
+	goldenFile = "../conformance/testdata/math8.golden.gz"
+	goldenDir  = "../conformance/testdata"
+)
+
+// golden fixtures of reference implementation, see conformance.WriteGolden
+func golden() error {
+	fd, err := os.Create(goldenFile)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	w, err := gzip.NewWriterLevel(fd, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+
+	if err := conformance.WriteGolden(w, conformance.Reference()); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+// verify golden fixtures against math8
+func verifyGolden() bool {
+	fmt.Printf("==> verify golden fixtures\n")
+
+	fd, err := os.Open(goldenFile)
+	if err != nil {
+		fmt.Printf("    %v\n", err)
+		return false
+	}
+	defer fd.Close()
+
+	r, err := gzip.NewReader(fd)
+	if err != nil {
+		fmt.Printf("    %v\n", err)
+		return false
+	}
+
+	report, err := conformance.VerifyGolden("math8", conformance.Reference(), r)
+	if err != nil {
+		fmt.Printf("    %v\n", err)
+		return false
+	}
+
+	for _, x := range report.Results {
+		if x.Mismatches != 0 {
+			fmt.Printf("    %s: %d mismatches\n", x.Op, x.Mismatches)
+		}
+	}
+
+	return report.OK()
+}
+
+// differential fixtures of NumPy and ml_dtypes float8_e4m3, see ml_dtypes.py
+func mlDtypesFixtures() error {
+	fmt.Printf("==> ml_dtypes fixtures\n")
+
+	cmd := exec.Command("python3", "ml_dtypes.py", goldenDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
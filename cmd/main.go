@@ -9,30 +9,128 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/kshard/float8/internal/math8"
 )
 
+var (
+	verify   = flag.Bool("verify", false, "cross-check generated code books against math8 instead of writing them")
+	mlDtypes = flag.Bool("ml-dtypes", false, "regenerate differential fixtures using NumPy and ml_dtypes (requires python3)")
+)
+
+var binary = map[string]func(uint8, uint8) uint8{
+	"add":       math8.Add,
+	"sub":       math8.Sub,
+	"mul":       math8.Mul,
+	"div":       math8.Div,
+	"mod":       math8.Mod,
+	"remainder": math8.Remainder,
+	"hypot":     math8.Hypot,
+	"pow":       math8.Pow,
+	"atan2":     math8.Atan2,
+	"satadd":    math8.SatAdd,
+	"satsub":    math8.SatSub,
+	"satmul":    math8.SatMul,
+	"satdiv":    math8.SatDiv,
+}
+
+// code books replaced by computed arithmetic with build tag nolookup
+var lookupOnly = map[string]bool{
+	"add": true,
+	"mul": true,
+}
+
+// binary operations producing float32, used by distance kernels
+var binary32 = map[string]func(uint8, uint8) float32{
+	"sqdiff":  math8.SquaredDiff,
+	"absdiff": math8.AbsDiff,
+	"dot4":    math8.DotFP4,
+}
+
+// operands of binary32 code books, documented in generated header
+var binary32Doc = map[string]string{
+	"sqdiff":  "squared difference of float8(s)",
+	"absdiff": "absolute difference of float8(s)",
+	"dot4":    "dot product of bytes packing two FP4 values each",
+}
+
+// binary operations producing fixed point int32, used by integer kernels
+var binaryFixed = map[string]func(uint8, uint8) int32{
+	"dotfix": math8.ProductFixed,
+}
+
 func main() {
+	flag.Parse()
+
+	if *mlDtypes {
+		if err := mlDtypesFixtures(); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	if *verify {
+		if !verifyAll() {
+			os.Exit(1)
+		}
+		return
+	}
+
 	fmt.Printf("==> code book for float32\n")
 	if err := f8tof32(); err != nil {
 		panic(err)
 	}
 
-	for name, f := range map[string]func(uint8, uint8) uint8{
-		"add": math8.Add,
-		"sub": math8.Sub,
-		"mul": math8.Mul,
-		"div": math8.Div,
-	} {
+	for name, f := range binary {
 		fmt.Printf("==> code book for %s\n", name)
 		if err := codebook(name, f); err != nil {
 			panic(err)
 		}
 	}
+
+	for name, f := range binary32 {
+		fmt.Printf("==> code book for %s\n", name)
+		if err := codebook32(name, f); err != nil {
+			panic(err)
+		}
+	}
+
+	for name, f := range binaryFixed {
+		fmt.Printf("==> code book for %s\n", name)
+		if err := codebookFixed(name, f); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, op := range unaries {
+		fmt.Printf("==> code book for %s\n", op.name)
+		if err := unaryCodebook(op); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := positCodebooks(); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("==> golden fixtures\n")
+	if err := golden(); err != nil {
+		panic(err)
+	}
+
+}
+
+func f8tof32Seq() []string {
+	seq := make([]string, 0x100)
+	for f8 := 0; f8 < 0x100; f8++ {
+		seq[f8] = strconv.FormatFloat(float64(math8.ToFloat32(uint8(f8))), 'g', -1, 32)
+	}
+	return seq
 }
 
 func f8tof32() error {
@@ -42,11 +140,6 @@ func f8tof32() error {
 	}
 	defer fd.Close()
 
-	seq := make([]string, 0x100)
-	for f8 := 0; f8 < 0x100; f8++ {
-		seq[f8] = fmt.Sprintf("%f", math8.ToFloat32(uint8(f8)))
-	}
-
 	tpl := `// DO NOT EDIT! Use cmd to regenerate it.
 package float8
 
@@ -57,7 +150,7 @@ package float8
 var f8tof32 = [0x100]float32{%s}
 	`
 
-	_, err = fd.WriteString(fmt.Sprintf(tpl, strings.Join(seq, ",")))
+	_, err = fd.WriteString(fmt.Sprintf(tpl, strings.Join(f8tof32Seq(), ",")))
 	if err != nil {
 		return err
 	}
@@ -65,6 +158,16 @@ var f8tof32 = [0x100]float32{%s}
 	return nil
 }
 
+func codebookSeq(f func(uint8, uint8) uint8) []string {
+	seq := make([]string, 0x100*0x100)
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			seq[a<<8|b] = fmt.Sprintf("0x%x", f(uint8(a), uint8(b)))
+		}
+	}
+	return seq
+}
+
 func codebook(name string, f func(uint8, uint8) uint8) error {
 	fd, err := os.Create(fmt.Sprintf("../%s.go", name))
 	if err != nil {
@@ -72,24 +175,93 @@ func codebook(name string, f func(uint8, uint8) uint8) error {
 	}
 	defer fd.Close()
 
+	tpl := `// DO NOT EDIT! Use cmd to regenerate it.
+%spackage float8
+
+//
+// The code book for translating float8 to float32
+//
+
+var %s = [0x10000]uint8{%s}
+	`
+
+	tag := ""
+	if lookupOnly[name] {
+		tag = "\n//go:build !nolookup\n\n"
+	}
+
+	_, err = fd.WriteString(fmt.Sprintf(tpl, tag, name, strings.Join(codebookSeq(f), ",")))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func codebook32Seq(f func(uint8, uint8) float32) []string {
 	seq := make([]string, 0x100*0x100)
 	for a := 0; a < 0x100; a++ {
 		for b := 0; b < 0x100; b++ {
-			seq[a<<8|b] = fmt.Sprintf("0x%x", f(uint8(a), uint8(b)))
+			seq[a<<8|b] = strconv.FormatFloat(float64(f(uint8(a), uint8(b))), 'g', -1, 32)
 		}
 	}
+	return seq
+}
+
+func codebook32(name string, f func(uint8, uint8) float32) error {
+	fd, err := os.Create(fmt.Sprintf("../%s.go", name))
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
 
 	tpl := `// DO NOT EDIT! Use cmd to regenerate it.
 package float8
 
 //
-// The code book for translating float8 to float32
+// The code book for %s, values are computed in float64
+// and rounded to nearest float32
 //
 
-var %s = [0x10000]uint8{%s}
-	`
+var %s = [0x10000]float32{%s}
+`
+
+	_, err = fd.WriteString(fmt.Sprintf(tpl, binary32Doc[name], name, strings.Join(codebook32Seq(f), ",")))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func codebookFixedSeq(f func(uint8, uint8) int32) []string {
+	seq := make([]string, 0x100*0x100)
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			seq[a<<8|b] = strconv.Itoa(int(f(uint8(a), uint8(b))))
+		}
+	}
+	return seq
+}
+
+func codebookFixed(name string, f func(uint8, uint8) int32) error {
+	fd, err := os.Create(fmt.Sprintf("../%s.go", name))
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	tpl := `// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for %s of float8(s), values are fixed point with %d fractional bits
+//
+
+var %s = [0x10000]int32{%s}
+`
 
-	_, err = fd.WriteString(fmt.Sprintf(tpl, name, strings.Join(seq, ",")))
+	_, err = fd.WriteString(fmt.Sprintf(tpl, name, math8.ProductFixedBits, name, strings.Join(codebookFixedSeq(f), ",")))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,49 @@
+#
+# Copyright (C) 2024 Dmitry Kolesnikov
+#
+# This file may be modified and distributed under the terms
+# of the MIT license.  See the LICENSE file for details.
+# https://github.com/kshard/float8
+#
+# Differential fixtures of NumPy + ml_dtypes float8_e4m3, see `go run . -ml-dtypes`.
+# Divergences of float8 from fixtures are reviewed and listed in allowlists,
+# see mldtypes_test.go (`go test -run MLDtypes -update-allowlist`).
+#
+#   ml_dtypes_e4m3.golden.gz  arithmetic, records of conformance golden format
+#   ml_dtypes_e4m3.txt        conversions, "decode <code> <float32 bits>"
+#                             and "encode <float32 bits> <code>"
+#
+import gzip
+import os
+import sys
+
+import ml_dtypes
+import numpy as np
+
+f8 = ml_dtypes.float8_e4m3
+out = sys.argv[1] if len(sys.argv) > 1 else "."
+
+codes = np.arange(256, dtype=np.uint8).view(f8)
+a, b = np.repeat(codes, 256), np.tile(codes, 256)
+
+with gzip.open(os.path.join(out, "ml_dtypes_e4m3.golden.gz"), "wb") as fd:
+    for name, op in (("add", np.add), ("sub", np.subtract), ("mul", np.multiply), ("div", np.divide)):
+        with np.errstate(all="ignore"):
+            r = op(a, b).astype(f8)
+        fd.write(name.encode() + b"\n")
+        fd.write(r.view(np.uint8).tobytes())
+
+vals = codes.astype(np.float32)
+s = np.unique(vals[np.isfinite(vals)])
+mid = ((s[:-1].astype(np.float64) + s[1:]) / 2).astype(np.float32)
+inputs = np.concatenate([
+    s, mid,
+    np.nextafter(mid, np.float32(np.inf)), np.nextafter(mid, np.float32(-np.inf)),
+    np.array([1e-9, -1e-9, 1e9, -1e9, np.inf, -np.inf], dtype=np.float32),
+])
+
+with open(os.path.join(out, "ml_dtypes_e4m3.txt"), "w") as fd:
+    for c, v in zip(range(256), vals):
+        fd.write("decode 0x%02x 0x%08x\n" % (c, v.view(np.uint32)))
+    for x in inputs:
+        fd.write("encode 0x%08x 0x%02x\n" % (x.view(np.uint32), np.array([x]).astype(f8).view(np.uint8)[0]))
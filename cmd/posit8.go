@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+// binary operations of posit8 package
+var positBinary = map[string]func(uint8, uint8) uint8{
+	"add": math8.PositAdd,
+	"sub": math8.PositSub,
+	"mul": math8.PositMul,
+	"div": math8.PositDiv,
+}
+
+func positToFloat32Seq() []string {
+	seq := make([]string, 0x100)
+	for p := 0; p < 0x100; p++ {
+		if p == math8.PositNaR {
+			// NaN is not a constant expression, NaR is handled by package
+			seq[p] = "0"
+			continue
+		}
+		seq[p] = strconv.FormatFloat(math8.PositToFloat64(uint8(p)), 'g', -1, 32)
+	}
+	return seq
+}
+
+func positCodebooks() error {
+	fd, err := os.Create("../posit8/float32.go")
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	tpl := `// DO NOT EDIT! Use cmd to regenerate it.
+package posit8
+
+//
+// The code book for translating posit8 (es=%d) to float32
+//
+
+var p8tof32 = [0x100]float32{%s}
+`
+
+	_, err = fd.WriteString(fmt.Sprintf(tpl, math8.PositES, strings.Join(positToFloat32Seq(), ",")))
+	if err != nil {
+		return err
+	}
+
+	for name, f := range positBinary {
+		fmt.Printf("==> code book for posit8 %s\n", name)
+		if err := positCodebook(name, f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func positCodebook(name string, f func(uint8, uint8) uint8) error {
+	fd, err := os.Create(fmt.Sprintf("../posit8/%s.go", name))
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	tpl := `// DO NOT EDIT! Use cmd to regenerate it.
+package posit8
+
+//
+// The code book for %s of posit8 (es=%d)
+//
+
+var %s = [0x10000]uint8{%s}
+`
+
+	_, err = fd.WriteString(fmt.Sprintf(tpl, name, math8.PositES, name, strings.Join(codebookSeq(f), ",")))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// verify posit8 code books, returns true if generated code matches math8
+func verifyPosit() bool {
+	ok := true
+
+	fmt.Printf("==> verify code book for posit8 float32\n")
+	if err := verifyCodebook("../posit8/float32.go", "p8tof32", positToFloat32Seq(), 32); err != nil {
+		fmt.Printf("    %v\n", err)
+		ok = false
+	}
+
+	for name, f := range positBinary {
+		fmt.Printf("==> verify code book for posit8 %s\n", name)
+		if err := verifyCodebook(fmt.Sprintf("../posit8/%s.go", name), name, codebookSeq(f), 8); err != nil {
+			fmt.Printf("    %v\n", err)
+			ok = false
+		}
+	}
+
+	return ok
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+// unary operation emitted as 256-entry code book and exported wrapper
+type unary struct {
+	name string
+	doc  string
+	f    func(uint8) uint8
+}
+
+// registry of unary operations, the order defines the order of generation
+var unaries []unary
+
+// register unary operation defined over float8
+func registerUnary(name, doc string, f func(uint8) uint8) {
+	unaries = append(unaries, unary{name: name, doc: doc, f: f})
+}
+
+// register unary operation defined over real numbers, the result is
+// quantized using math8.
+func registerUnaryFloat(name, doc string, f func(float64) float64) {
+	registerUnary(name, doc, func(x uint8) uint8 { return math8.Apply(f, x) })
+}
+
+func init() {
+	registerUnaryFloat("sqrt", "Square root of float8", math.Sqrt)
+	registerUnaryFloat("exp", "Exponent (e**x) of float8", math.Exp)
+	registerUnaryFloat("exp2", "Base-2 exponent (2**x) of float8", math.Exp2)
+	registerUnaryFloat("expm1", "Expm1 returns e**x - 1 of float8, it is accurate for x near zero", math.Expm1)
+	registerUnaryFloat("log10", "Decimal logarithm of float8, it is 0 for negative values (no NaN)", math.Log10)
+	registerUnaryFloat("log1p", "Log1p returns natural logarithm of 1 + x of float8, it is accurate for x near zero", math.Log1p)
+	registerUnaryFloat("sigmoid", "Sigmoid (logistic function) of float8",
+		func(x float64) float64 { return 1.0 / (1.0 + math.Exp(-x)) },
+	)
+	registerUnaryFloat("recip", "Reciprocal (1/x) of float8",
+		func(x float64) float64 { return 1.0 / x },
+	)
+	registerUnaryFloat("sin", "Sine of float8, argument is in radians", math.Sin)
+	registerUnaryFloat("cos", "Cosine of float8, argument is in radians", math.Cos)
+	registerUnaryFloat("erf", "Error function of float8", math.Erf)
+	registerUnaryFloat("phi", "Phi is cumulative distribution function of standard normal distribution of float8",
+		func(x float64) float64 { return 0.5 * math.Erfc(-x/math.Sqrt2) },
+	)
+	registerUnary("sqr", "Square (x²) of float8, it is identical to Mul(x, x)",
+		func(x uint8) uint8 { return math8.Mul(x, x) },
+	)
+	registerUnaryFloat("cube", "Cube (x³) of float8",
+		func(x float64) float64 { return x * x * x },
+	)
+	registerUnaryFloat("floor", "Floor returns the greatest integer value less than or equal to float8", math.Floor)
+	registerUnaryFloat("ceil", "Ceil returns the least integer value greater than or equal to float8", math.Ceil)
+	registerUnaryFloat("round", "Round returns the nearest integer of float8, rounding half away from zero", math.Round)
+	registerUnaryFloat("trunc", "Trunc returns the integer value of float8", math.Trunc)
+}
+
+func unarySeq(f func(uint8) uint8) []string {
+	seq := make([]string, 0x100)
+	for a := 0; a < 0x100; a++ {
+		seq[a] = fmt.Sprintf("0x%x", f(uint8(a)))
+	}
+	return seq
+}
+
+func unaryCodebook(op unary) error {
+	fd, err := os.Create(fmt.Sprintf("../%s.go", op.name))
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	tpl := `// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for %s of float8
+//
+
+var %s = [0x100]uint8{%s}
+
+// %s
+func %s(a Float8) Float8 { return %s[a] }
+`
+
+	fn := strings.ToUpper(op.name[:1]) + op.name[1:]
+	_, err = fd.WriteString(fmt.Sprintf(tpl, op.name, op.name, strings.Join(unarySeq(op.f), ","), op.doc, fn, op.name))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+)
+
+// number of mismatches reported per code book, the rest is only counted
+const verifyReportLimit = 10
+
+// verify all code books, returns true if generated code matches math8
+func verifyAll() bool {
+	ok := true
+
+	fmt.Printf("==> verify code book for float32\n")
+	if err := verifyCodebook("../float32.go", "f8tof32", f8tof32Seq(), 64); err != nil {
+		fmt.Printf("    %v\n", err)
+		ok = false
+	}
+
+	for name, f := range binary {
+		fmt.Printf("==> verify code book for %s\n", name)
+		if err := verifyCodebook(fmt.Sprintf("../%s.go", name), name, codebookSeq(f), 8); err != nil {
+			fmt.Printf("    %v\n", err)
+			ok = false
+		}
+	}
+
+	for name, f := range binary32 {
+		fmt.Printf("==> verify code book for %s\n", name)
+		if err := verifyCodebook(fmt.Sprintf("../%s.go", name), name, codebook32Seq(f), 32); err != nil {
+			fmt.Printf("    %v\n", err)
+			ok = false
+		}
+	}
+
+	for name, f := range binaryFixed {
+		fmt.Printf("==> verify code book for %s\n", name)
+		if err := verifyCodebook(fmt.Sprintf("../%s.go", name), name, codebookFixedSeq(f), 64); err != nil {
+			fmt.Printf("    %v\n", err)
+			ok = false
+		}
+	}
+
+	for _, op := range unaries {
+		fmt.Printf("==> verify code book for %s\n", op.name)
+		if err := verifyCodebook(fmt.Sprintf("../%s.go", op.name), op.name, unarySeq(op.f), 8); err != nil {
+			fmt.Printf("    %v\n", err)
+			ok = false
+		}
+	}
+
+	if !verifyPosit() {
+		ok = false
+	}
+
+	if !verifyGolden() {
+		ok = false
+	}
+
+	return ok
+}
+
+// re-read code book from generated file and compare it with expected values,
+// literals are compared numerically so that formatting changes are tolerated.
+func verifyCodebook(file, name string, expected []string, bitSize int) error {
+	seq, err := readCodebook(file, name)
+	if err != nil {
+		return err
+	}
+
+	if len(seq) != len(expected) {
+		return fmt.Errorf("%s: %d entries, expected %d", name, len(seq), len(expected))
+	}
+
+	mismatch := 0
+	for i := range expected {
+		equal, err := equalLiteral(seq[i], expected[i], bitSize)
+		if err != nil {
+			return fmt.Errorf("%s[0x%x]: %w", name, i, err)
+		}
+
+		if !equal {
+			if mismatch < verifyReportLimit {
+				fmt.Printf("    %s[0x%x] got=%s wanted=%s\n", name, i, seq[i], expected[i])
+			}
+			mismatch++
+		}
+	}
+
+	if mismatch != 0 {
+		return fmt.Errorf("%s: %d mismatches", name, mismatch)
+	}
+
+	return nil
+}
+
+// read literals of composite value assigned to the variable
+func readCodebook(file, name string) ([]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var seq []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != name || len(spec.Values) != 1 {
+			return true
+		}
+
+		lit, ok := spec.Values[0].(*ast.CompositeLit)
+		if !ok {
+			return false
+		}
+
+		seq = make([]string, 0, len(lit.Elts))
+		for _, elt := range lit.Elts {
+			switch v := elt.(type) {
+			case *ast.BasicLit:
+				seq = append(seq, v.Value)
+			case *ast.UnaryExpr:
+				if x, ok := v.X.(*ast.BasicLit); ok {
+					seq = append(seq, v.Op.String()+x.Value)
+				}
+			}
+		}
+
+		return false
+	})
+
+	if seq == nil {
+		return nil, fmt.Errorf("%s: code book %s is not found", file, name)
+	}
+
+	return seq, nil
+}
+
+func equalLiteral(got, expected string, bitSize int) (bool, error) {
+	if bitSize == 8 {
+		a, err := strconv.ParseUint(got, 0, 8)
+		if err != nil {
+			return false, err
+		}
+		b, err := strconv.ParseUint(expected, 0, 8)
+		if err != nil {
+			return false, err
+		}
+		return a == b, nil
+	}
+
+	a, err := strconv.ParseFloat(got, bitSize)
+	if err != nil {
+		return false, err
+	}
+	b, err := strconv.ParseFloat(expected, bitSize)
+	if err != nil {
+		return false, err
+	}
+	if bitSize == 32 {
+		return float32(a) == float32(b), nil
+	}
+	return a == b, nil
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+)
+
+// Codec is non-uniform 8-bit quantizer with custom 256-entry codebook,
+// trained over data distribution. Levels are ascending, therefore order of
+// codes follows order of values.
+type Codec struct {
+	levels [256]float32
+
+	// decision boundaries, midpoints between adjacent levels
+	bounds [255]float32
+}
+
+// TrainCodec builds codebook from sample of values using Lloyd-Max
+// algorithm (1-dimensional k-means). Levels are initialized by quantiles
+// of sample and refined by given number of iterations.
+func TrainCodec(sample []float32, iterations int) (*Codec, error) {
+	if len(sample) == 0 {
+		return nil, errors.New("float8: sample is empty")
+	}
+
+	xs := slices.Clone(sample)
+	for _, x := range xs {
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return nil, errors.New("float8: sample contains non finite values")
+		}
+	}
+	slices.Sort(xs)
+
+	c := &Codec{}
+	for i := range c.levels {
+		c.levels[i] = xs[(2*i+1)*len(xs)/512]
+	}
+	c.boundaries()
+
+	// prefix sums make centroid of each cell O(1)
+	prefix := make([]float64, len(xs)+1)
+	for i, x := range xs {
+		prefix[i+1] = prefix[i] + float64(x)
+	}
+
+	for it := 0; it < iterations; it++ {
+		lo := 0
+		for i := range c.levels {
+			hi := len(xs)
+			if i < len(c.bounds) {
+				hi = sort.Search(len(xs), func(k int) bool { return xs[k] > c.bounds[i] })
+			}
+			if hi > lo {
+				c.levels[i] = float32((prefix[hi] - prefix[lo]) / float64(hi-lo))
+			}
+			lo = hi
+		}
+		c.boundaries()
+	}
+
+	return c, nil
+}
+
+func (c *Codec) boundaries() {
+	for i := range c.bounds {
+		c.bounds[i] = c.levels[i] + (c.levels[i+1]-c.levels[i])/2
+	}
+}
+
+// Quantize value to code of the nearest level
+func (c *Codec) Quantize(x float32) byte {
+	return byte(sort.Search(len(c.bounds), func(i int) bool { return x <= c.bounds[i] }))
+}
+
+// Dequantize code to value of the level
+func (c *Codec) Dequantize(code byte) float32 { return c.levels[code] }
+
+// QuantizeSlice quantizes src into dst
+func (c *Codec) QuantizeSlice(dst []byte, src []float32) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, x := range src {
+		dst[i] = c.Quantize(x)
+	}
+}
+
+// DequantizeSlice dequantizes src into dst
+func (c *Codec) DequantizeSlice(dst []float32, src []byte) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, code := range src {
+		dst[i] = c.levels[code]
+	}
+}
+
+// MarshalBinary encodes codebook as 256 little endian float32 levels
+func (c *Codec) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 4*len(c.levels))
+	for i, x := range c.levels {
+		binary.LittleEndian.PutUint32(buf[4*i:], math.Float32bits(x))
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes codebook encoded by MarshalBinary
+func (c *Codec) UnmarshalBinary(buf []byte) error {
+	if len(buf) != 4*len(c.levels) {
+		return errors.New("float8: invalid codebook length")
+	}
+
+	var levels [256]float32
+	for i := range levels {
+		levels[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:]))
+	}
+
+	if !slices.IsSorted(levels[:]) {
+		return errors.New("float8: codebook levels are not ascending")
+	}
+
+	c.levels = levels
+	c.boundaries()
+	return nil
+}
@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestCodec(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	// skewed distribution: most values are tiny, few are large
+	sample := make([]float32, 20000)
+	for i := range sample {
+		sample[i] = float32(r.ExpFloat64() * 0.01)
+		if r.IntN(2) == 0 {
+			sample[i] = -sample[i]
+		}
+	}
+
+	c, err := TrainCodec(sample, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mseCodec, mseF8 := 0.0, 0.0
+	codes := make([]byte, len(sample))
+	restored := make([]float32, len(sample))
+	c.QuantizeSlice(codes, sample)
+	c.DequantizeSlice(restored, codes)
+	for i, x := range sample {
+		d := float64(restored[i] - x)
+		mseCodec += d * d
+		d = float64(ToFloat32(ToFloat8(x)) - x)
+		mseF8 += d * d
+	}
+
+	if mseCodec >= mseF8 {
+		t.Errorf("codebook error %g is not better than E4M3 %g", mseCodec, mseF8)
+	}
+
+	for i := 1; i < 256; i++ {
+		if c.Dequantize(byte(i-1)) > c.Dequantize(byte(i)) {
+			t.Fatalf("levels are not ascending at %d", i)
+		}
+	}
+
+	for code := 0; code < 256; code++ {
+		x := c.Dequantize(byte(code))
+		if q := c.Dequantize(c.Quantize(x)); q != x {
+			t.Errorf("level %d is not fixed point %g, %g", code, x, q)
+		}
+	}
+}
+
+func TestCodecBinary(t *testing.T) {
+	c, err := TrainCodec([]float32{1, 2, 3, 4, 5}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf, _ := c.MarshalBinary()
+	var x Codec
+	if err := x.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []float32{0, 1.4, 2.6, 5, 100} {
+		if c.Quantize(v) != x.Quantize(v) {
+			t.Errorf("codecs are different at %g", v)
+		}
+	}
+
+	if err := x.UnmarshalBinary(buf[1:]); err == nil || !strings.HasPrefix(err.Error(), "float8: ") {
+		t.Errorf("error is expected, got %v", err)
+	}
+}
+
+func TestCodecInvalid(t *testing.T) {
+	for _, sample := range [][]float32{nil, {1, float32(math.NaN())}, {float32(math.Inf(1))}} {
+		if _, err := TrainCodec(sample, 1); err == nil || !strings.HasPrefix(err.Error(), "float8: ") {
+			t.Errorf("error is expected for %v, got %v", sample, err)
+		}
+	}
+}
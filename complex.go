@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// Complex8 is complex number of float8 real and imaginary parts
+// (e.g. IQ sample), it occupies 2 bytes.
+type Complex8 struct {
+	Re, Im Float8
+}
+
+// ToComplex8 converts complex64 to Complex8
+func ToComplex8(c complex64) Complex8 {
+	return Complex8{Re: ToFloat8(real(c)), Im: ToFloat8(imag(c))}
+}
+
+// Complex64 converts Complex8 to complex64
+func (c Complex8) Complex64() complex64 {
+	return complex(f8tof32[c.Re], f8tof32[c.Im])
+}
+
+// Add complex numbers
+func (c Complex8) Add(x Complex8) Complex8 {
+	return Complex8{Re: Add(c.Re, x.Re), Im: Add(c.Im, x.Im)}
+}
+
+// Sub complex numbers
+func (c Complex8) Sub(x Complex8) Complex8 {
+	return Complex8{Re: Sub(c.Re, x.Re), Im: Sub(c.Im, x.Im)}
+}
+
+// Mul complex numbers, (a + bi)(c + di) = (ac - bd) + (ad + bc)i.
+// Parts are computed in float32 and rounded once.
+func (c Complex8) Mul(x Complex8) Complex8 {
+	a, b := f8tof32[c.Re], f8tof32[c.Im]
+	p, q := f8tof32[x.Re], f8tof32[x.Im]
+	return Complex8{Re: ToFloat8(a*p - b*q), Im: ToFloat8(a*q + b*p)}
+}
+
+// Conj is complex conjugate. Note, the format has no positive 2^-7,
+// conjugate of imaginary part -2^-7 is 0.
+func (c Complex8) Conj() Complex8 {
+	return Complex8{Re: c.Re, Im: ModeDefault.Neg(c.Im)}
+}
+
+// Abs is magnitude √(re² + im²) looked up from hypot code book,
+// use Norm2 for float32 precision.
+func (c Complex8) Abs() Float8 { return Hypot(c.Re, c.Im) }
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/cmplx"
+	"testing"
+)
+
+func TestComplex8(t *testing.T) {
+	a, b := ToComplex8(complex(3, 4)), ToComplex8(complex(1, -2))
+
+	if c := a.Complex64(); c != complex(3, 4) {
+		t.Errorf("round trip %v", c)
+	}
+
+	for name, tc := range map[string]struct {
+		c Complex8
+		e complex64
+	}{
+		"add":  {a.Add(b), complex(4, 2)},
+		"sub":  {a.Sub(b), complex(2, 6)},
+		"mul":  {a.Mul(b), complex(11, -2)},
+		"conj": {a.Conj(), complex(3, -4)},
+		"zero": {Complex8{}.Conj(), 0},
+	} {
+		if c := tc.c.Complex64(); c != tc.e {
+			t.Errorf("%s = %v, expected %v", name, c, tc.e)
+		}
+	}
+
+	if m := a.Abs(); m != ToFloat8(5) {
+		t.Errorf("abs = %g", ToFloat32(m))
+	}
+	if m := (Complex8{Re: MaxValue, Im: MaxValue}).Abs(); m != Infinity {
+		t.Errorf("abs of overflow = %g", ToFloat32(m))
+	}
+
+	if m := (Complex8{Re: MaxValue}).Mul(Complex8{Re: signMask | MaxValue}); m.Re != signMask|Infinity {
+		t.Errorf("negative overflow of product = 0x%02x", m.Re)
+	}
+}
+
+func TestComplex8Mul(t *testing.T) {
+	for re := 0; re < 0x100; re += 3 {
+		for im := 0; im < 0x100; im += 5 {
+			a := Complex8{Re: uint8(re), Im: uint8(im)}
+			b := Complex8{Re: uint8(im), Im: uint8(re)}
+
+			e := complex128(a.Complex64()) * complex128(b.Complex64())
+			c := complex128(a.Mul(b).Complex64())
+			if cmplx.Abs(e) < 400 && cmplx.Abs(c-e) > cmplx.Abs(e)/4+0.01 {
+				t.Fatalf("%v × %v = %v, expected %v", a, b, c, e)
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// number of deltas packed with common bit width
+const packGroup = 32
+
+// inverse of orderKey
+func fromOrderKey(k uint8) Float8 {
+	if k&signMask != 0 {
+		return k &^ signMask
+	}
+
+	return ^k
+}
+
+// CompressBlock compresses block of vectors of dimension dim, stored one
+// after another, and appends it to dst. Vectors of nearby documents are
+// similar, so each vector is encoded as delta of order keys (see
+// ApproxDistance) against the previous one. Deltas are zigzag encoded and
+// bit-packed in groups of 32 with the smallest common width. The layout is
+//
+//	uvarint(dim) uvarint(n) first-vector ({width packed-deltas})...
+//
+// The compression is lossless.
+func CompressBlock(dst []byte, vectors []Float8, dim int) []byte {
+	if dim <= 0 || len(vectors)%dim != 0 {
+		panic("length of vectors must be multiple of dim")
+	}
+
+	n := len(vectors) / dim
+	dst = binary.AppendUvarint(dst, uint64(dim))
+	dst = binary.AppendUvarint(dst, uint64(n))
+	if n == 0 {
+		return dst
+	}
+	dst = append(dst, vectors[:dim]...)
+
+	deltas := make([]uint8, 0, packGroup)
+	for i := dim; i < len(vectors); i++ {
+		d := int8(orderKey(vectors[i]) - orderKey(vectors[i-dim]))
+		deltas = append(deltas, uint8(d<<1^d>>7))
+
+		if len(deltas) == packGroup || i == len(vectors)-1 {
+			dst = packDeltas(dst, deltas)
+			deltas = deltas[:0]
+		}
+	}
+
+	return dst
+}
+
+// append width of group followed by bit-packed deltas
+func packDeltas(dst []byte, deltas []uint8) []byte {
+	acc := uint8(0)
+	for _, d := range deltas {
+		acc |= d
+	}
+	width := bits.Len8(acc)
+	dst = append(dst, uint8(width))
+
+	buf, fill := uint32(0), 0
+	for _, d := range deltas {
+		buf |= uint32(d) << fill
+		fill += width
+		for fill >= 8 {
+			dst = append(dst, uint8(buf))
+			buf >>= 8
+			fill -= 8
+		}
+	}
+	if fill > 0 {
+		dst = append(dst, uint8(buf))
+	}
+
+	return dst
+}
+
+// DecompressBlock restores block of vectors encoded by CompressBlock,
+// it returns vectors and their dimension. Vectors are appended to dst.
+func DecompressBlock(dst []Float8, buf []byte) ([]Float8, int, error) {
+	dim, size := binary.Uvarint(buf)
+	if size <= 0 || dim == 0 {
+		return dst, 0, errors.New("float8: invalid block dimension")
+	}
+	buf = buf[size:]
+
+	n, size := binary.Uvarint(buf)
+	if size <= 0 || n > uint64(len(buf)) {
+		return dst, 0, errors.New("float8: invalid block length")
+	}
+	buf = buf[size:]
+	if n == 0 {
+		return dst, int(dim), nil
+	}
+
+	if uint64(len(buf)) < dim {
+		return dst, 0, errors.New("float8: block is truncated")
+	}
+
+	start := len(dst)
+	dst = append(dst, buf[:dim]...)
+	buf = buf[dim:]
+
+	total := int(n * dim)
+	for at := int(dim); at < total; {
+		if len(buf) == 0 {
+			return dst, 0, errors.New("float8: block is truncated")
+		}
+		width := int(buf[0])
+		if width > 8 {
+			return dst, 0, errors.New("float8: invalid delta width")
+		}
+
+		count := min(packGroup, total-at)
+		packed := (count*width + 7) / 8
+		if len(buf) < 1+packed {
+			return dst, 0, errors.New("float8: block is truncated")
+		}
+
+		acc, fill, p := uint32(0), 0, buf[1:1+packed]
+		for k := 0; k < count; k++ {
+			for fill < width {
+				acc |= uint32(p[0]) << fill
+				p = p[1:]
+				fill += 8
+			}
+			z := uint8(acc & (1<<width - 1))
+			acc >>= width
+			fill -= width
+
+			d := int8(z>>1) ^ -int8(z&1)
+			prev := dst[start+at-int(dim)]
+			dst = append(dst, fromOrderKey(orderKey(prev)+uint8(d)))
+			at++
+		}
+
+		buf = buf[1+packed:]
+	}
+
+	if len(buf) != 0 {
+		return dst, 0, errors.New("float8: trailing bytes after block")
+	}
+
+	return dst, int(dim), nil
+}
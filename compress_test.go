@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestOrderKeyInverse(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		if fromOrderKey(orderKey(uint8(a))) != uint8(a) {
+			t.Errorf("0x%02x is not restored", a)
+		}
+	}
+}
+
+// similar vectors, each one is random walk of the previous
+func similarVectors(r *rand.Rand, n, dim int) []Float8 {
+	vs := make([]Float8, n*dim)
+	for i := range vs {
+		if i < dim {
+			vs[i] = ToFloat8(float32(r.NormFloat64()))
+			continue
+		}
+
+		vs[i] = vs[i-dim]
+		if r.IntN(4) == 0 {
+			vs[i] = Nextafter(vs[i], Float8(r.IntN(0x100)))
+		}
+	}
+	return vs
+}
+
+func TestCompressBlock(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, tc := range []struct{ n, dim int }{{0, 4}, {1, 8}, {2, 3}, {100, 128}, {7, 33}} {
+		vs := similarVectors(r, tc.n, tc.dim)
+
+		buf := CompressBlock(nil, vs, tc.dim)
+		xs, dim, err := DecompressBlock(nil, buf)
+		if err != nil {
+			t.Fatalf("%d × %d: %v", tc.n, tc.dim, err)
+		}
+		if dim != tc.dim || !bytes.Equal(xs, vs) {
+			t.Errorf("%d × %d: block is not restored", tc.n, tc.dim)
+		}
+	}
+}
+
+func TestCompressBlockRatio(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	vs := similarVectors(r, 256, 128)
+
+	buf := CompressBlock(nil, vs, 128)
+	if ratio := float64(len(vs)) / float64(len(buf)); ratio < 2 {
+		t.Errorf("compression ratio %.2f", ratio)
+	}
+}
+
+func TestCompressBlockRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	vs := make([]Float8, 64*16)
+	for i := range vs {
+		vs[i] = Float8(r.IntN(0x100))
+	}
+
+	xs, _, err := DecompressBlock([]Float8{0x38}, CompressBlock(nil, vs, 16))
+	if err != nil || xs[0] != 0x38 || !bytes.Equal(xs[1:], vs) {
+		t.Errorf("block is not restored: %v", err)
+	}
+}
+
+func TestDecompressBlockInvalid(t *testing.T) {
+	buf := CompressBlock(nil, similarVectors(rand.New(rand.NewPCG(7, 8)), 10, 8), 8)
+
+	for name, b := range map[string][]byte{
+		"empty":     nil,
+		"dimension": {0x00},
+		"truncated": buf[:len(buf)-1],
+		"trailing":  append(append([]byte{}, buf...), 0x00),
+	} {
+		if _, _, err := DecompressBlock(nil, b); err == nil {
+			t.Errorf("%s block is accepted", name)
+		}
+	}
+}
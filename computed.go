@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math/bits"
+
+// Computed arithmetic is fallback of code books (see build tag nolookup).
+// It uses integer arithmetic over fixed point mantissas only, without loops
+// and floating point, results are identical to code books.
+
+// extra fractional bits of aligned mantissa, exponents differ by 15 at most
+const alignBits = 16
+
+// computed sum of float8(s)
+func addBits(a, b Float8) Float8 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+
+	ea, eb := int(a&exponentMask>>mantissaLen), int(b&exponentMask>>mantissaLen)
+	ma := int32(a&mantissaMask|1<<mantissaLen) << alignBits
+	mb := int32(b&mantissaMask|1<<mantissaLen) << alignBits
+
+	// align mantissa of smaller exponent, the shift is exact
+	e := max(ea, eb)
+	ma >>= e - ea
+	mb >>= e - eb
+
+	sign := a & signMask
+	var m int32
+	switch {
+	case a&signMask == b&signMask:
+		m = ma + mb
+	case ma > mb:
+		m = ma - mb
+	default:
+		m, sign = mb-ma, b&signMask
+	}
+
+	if m == 0 {
+		return 0
+	}
+
+	// normalize: leading bit of m defines exponent of the result
+	p := bits.Len32(uint32(m)) - 1
+	e += p - mantissaLen - alignBits
+
+	switch {
+	case e > exponentHi:
+		return sign | Infinity
+	case e < 0:
+		return 0
+	}
+
+	// truncate mantissa to 3 bits, p ≥ 3 for any representable result
+	return sign | Float8(e)<<mantissaLen | Float8(m>>(p-mantissaLen))&mantissaMask
+}
+
+// computed product of float8(s)
+func mulBits(a, b Float8) Float8 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	sign := (a ^ b) & signMask
+	p := int(a&mantissaMask|1<<mantissaLen) * int(b&mantissaMask|1<<mantissaLen)
+
+	// product of mantissas is in [1, 4), hi is 1 if it is ≥ 2
+	hi := p >> (2*mantissaLen + 1)
+	e := int(a&exponentMask>>mantissaLen) + int(b&exponentMask>>mantissaLen) - exponentBias + hi
+
+	switch {
+	case e > exponentHi:
+		return sign | Infinity
+	case e < 0:
+		return 0
+	}
+
+	return sign | Float8(e)<<mantissaLen | Float8(p>>(mantissaLen+hi))&mantissaMask
+}
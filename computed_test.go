@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"go/build"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+func TestAddBits(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			c, e := addBits(uint8(a), uint8(b)), math8.Add(uint8(a), uint8(b))
+			if c != e {
+				t.Errorf("0x%02x + 0x%02x wanted=0x%02x, got=0x%02x", a, b, e, c)
+			}
+		}
+	}
+}
+
+func TestMulBits(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			c, e := mulBits(uint8(a), uint8(b)), math8.Mul(uint8(a), uint8(b))
+			if c != e {
+				t.Errorf("0x%02x * 0x%02x wanted=0x%02x, got=0x%02x", a, b, e, c)
+			}
+		}
+	}
+}
+
+func BenchmarkAddBits(b *testing.B) {
+	for i := b.N; i > 0; i-- {
+		v := uint8(i % 0x100)
+		f8 = addBits(v, v^0x13)
+	}
+}
+
+func BenchmarkMulBits(b *testing.B) {
+	for i := b.N; i > 0; i-- {
+		v := uint8(i % 0x100)
+		f8 = mulBits(v, v^0x13)
+	}
+}
+
+func BenchmarkAddMath8(b *testing.B) {
+	for i := b.N; i > 0; i-- {
+		v := uint8(i % 0x100)
+		f8 = math8.Add(v, v^0x13)
+	}
+}
+
+func TestNoLookupFiles(t *testing.T) {
+	ctx := build.Default
+	ctx.BuildTags = []string{"nolookup"}
+
+	for file, expected := range map[string]bool{
+		"add.go":      false,
+		"mul.go":      false,
+		"lookup.go":   false,
+		"nolookup.go": true,
+		"computed.go": true,
+	} {
+		match, err := ctx.MatchFile(".", file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if match != expected {
+			t.Errorf("%s is built with tag nolookup: wanted=%v, got=%v", file, expected, match)
+		}
+	}
+}
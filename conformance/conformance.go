@@ -0,0 +1,258 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package conformance certifies implementations of float8 arithmetic.
+// An implementation (code books, SIMD kernels, reference) is verified
+// exhaustively over all 64K operand pairs against math8 and golden
+// fixtures, the outcome is reported as JSON serializable Report.
+//
+//	func TestKernels(t *testing.T) {
+//		conformance.Check(t, "simd", conformance.Ops{Add: simd.Add, Mul: simd.Mul})
+//	}
+package conformance
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+// BinaryOp over float8 codes
+type BinaryOp = func(a, b uint8) uint8
+
+// Ops is set of operations of implementation, nil operations are skipped
+type Ops struct {
+	Add       BinaryOp
+	Sub       BinaryOp
+	Mul       BinaryOp
+	Div       BinaryOp
+	Mod       BinaryOp
+	Remainder BinaryOp
+	Hypot     BinaryOp
+	Pow       BinaryOp
+	Atan2     BinaryOp
+}
+
+// Reference implementation (math8) which defines expected results
+func Reference() Ops {
+	return Ops{
+		Add:       math8.Add,
+		Sub:       math8.Sub,
+		Mul:       math8.Mul,
+		Div:       math8.Div,
+		Mod:       math8.Mod,
+		Remainder: math8.Remainder,
+		Hypot:     math8.Hypot,
+		Pow:       math8.Pow,
+		Atan2:     math8.Atan2,
+	}
+}
+
+type namedOp struct {
+	name string
+	op   BinaryOp
+}
+
+func (ops Ops) named() []namedOp {
+	return []namedOp{
+		{"add", ops.Add},
+		{"sub", ops.Sub},
+		{"mul", ops.Mul},
+		{"div", ops.Div},
+		{"mod", ops.Mod},
+		{"remainder", ops.Remainder},
+		{"hypot", ops.Hypot},
+		{"pow", ops.Pow},
+		{"atan2", ops.Atan2},
+	}
+}
+
+// number of mismatches recorded per operation, the rest is only counted
+const sampleLimit = 16
+
+// Mismatch of operation result
+type Mismatch struct {
+	A    uint8 `json:"a"`
+	B    uint8 `json:"b"`
+	Got  uint8 `json:"got"`
+	Want uint8 `json:"want"`
+}
+
+// Result of operation verification
+type Result struct {
+	Op         string     `json:"op"`
+	Checked    int        `json:"checked"`
+	Mismatches int        `json:"mismatches"`
+	Samples    []Mismatch `json:"samples,omitempty"`
+}
+
+// Report of implementation verification
+type Report struct {
+	Implementation string   `json:"implementation"`
+	Source         string   `json:"source"`
+	Results        []Result `json:"results"`
+}
+
+// OK reports whether all verified operations conform
+func (r Report) OK() bool {
+	for _, x := range r.Results {
+		if x.Mismatches != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Verify implementation against math8
+func Verify(name string, impl Ops) Report {
+	ref := Reference().named()
+
+	report := Report{Implementation: name, Source: "math8"}
+	for i, op := range impl.named() {
+		if op.op == nil {
+			continue
+		}
+
+		report.Results = append(report.Results, verify(op, func(a, b uint8) uint8 { return ref[i].op(a, b) }))
+	}
+
+	return report
+}
+
+// VerifyGolden verifies implementation against golden fixtures, see WriteGolden
+func VerifyGolden(name string, impl Ops, r io.Reader) (Report, error) {
+	golden, err := ReadGolden(r)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{Implementation: name, Source: "golden"}
+	for _, op := range impl.named() {
+		if op.op == nil {
+			continue
+		}
+
+		book, has := golden[op.name]
+		if !has {
+			return Report{}, fmt.Errorf("conformance: golden fixture of %s is not found", op.name)
+		}
+
+		report.Results = append(report.Results, verify(op, func(a, b uint8) uint8 { return book[int(a)<<8|int(b)] }))
+	}
+
+	return report, nil
+}
+
+func verify(op namedOp, want BinaryOp) Result {
+	result := Result{Op: op.name}
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			got, expected := op.op(uint8(a), uint8(b)), want(uint8(a), uint8(b))
+			result.Checked++
+			if got != expected {
+				if result.Mismatches < sampleLimit {
+					result.Samples = append(result.Samples, Mismatch{A: uint8(a), B: uint8(b), Got: got, Want: expected})
+				}
+				result.Mismatches++
+			}
+		}
+	}
+
+	return result
+}
+
+// Check verifies implementation against math8 within test,
+// each non conforming operation fails the test.
+func Check(t testing.TB, name string, impl Ops) {
+	t.Helper()
+	report(t, Verify(name, impl))
+}
+
+// CheckGolden verifies implementation against golden fixtures within test
+func CheckGolden(t testing.TB, name string, impl Ops, r io.Reader) {
+	t.Helper()
+
+	rep, err := VerifyGolden(name, impl, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report(t, rep)
+}
+
+func report(t testing.TB, r Report) {
+	t.Helper()
+
+	for _, x := range r.Results {
+		if x.Mismatches == 0 {
+			continue
+		}
+
+		t.Errorf("%s: %s does not conform to %s, %d of %d mismatches", r.Implementation, x.Op, r.Source, x.Mismatches, x.Checked)
+		for _, m := range x.Samples {
+			t.Logf("    %s(0x%02x, 0x%02x) got=0x%02x wanted=0x%02x", x.Op, m.A, m.B, m.Got, m.Want)
+		}
+	}
+}
+
+// Golden fixture is sequence of records, each record is name of operation
+// terminated by new line followed by 64K results indexed by a<<8|b.
+
+// WriteGolden writes golden fixtures of implementation
+func WriteGolden(w io.Writer, impl Ops) error {
+	book := make([]byte, 0x10000)
+	for _, op := range impl.named() {
+		if op.op == nil {
+			continue
+		}
+
+		for a := 0; a < 0x100; a++ {
+			for b := 0; b < 0x100; b++ {
+				book[a<<8|b] = op.op(uint8(a), uint8(b))
+			}
+		}
+
+		if _, err := io.WriteString(w, op.name+"\n"); err != nil {
+			return err
+		}
+		if _, err := w.Write(book); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadGolden reads golden fixtures, results are indexed by a<<8|b
+func ReadGolden(r io.Reader) (map[string][]byte, error) {
+	br := bufio.NewReader(r)
+
+	golden := map[string][]byte{}
+	for {
+		name, err := br.ReadString('\n')
+		if err == io.EOF && name == "" {
+			return golden, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("conformance: invalid golden fixture: %w", err)
+		}
+
+		book := make([]byte, 0x10000)
+		if _, err := io.ReadFull(br, book); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, fmt.Errorf("conformance: invalid golden fixture: %w", err)
+		}
+
+		golden[name[:len(name)-1]] = book
+	}
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package conformance
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+func golden(t *testing.T) io.Reader {
+	t.Helper()
+
+	fd, err := os.Open("testdata/math8.golden.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { fd.Close() })
+
+	r, err := gzip.NewReader(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestReference(t *testing.T) {
+	Check(t, "math8", Reference())
+	CheckGolden(t, "math8", Reference(), golden(t))
+}
+
+func TestMismatch(t *testing.T) {
+	broken := Ops{
+		Add: func(a, b uint8) uint8 {
+			if a == 0x38 && b == 0x38 {
+				return 0
+			}
+			return math8.Add(a, b)
+		},
+		Mul: math8.Mul,
+	}
+
+	r := Verify("broken", broken)
+	if r.OK() || len(r.Results) != 2 {
+		t.Fatalf("unexpected report %+v", r)
+	}
+
+	add := r.Results[0]
+	if add.Op != "add" || add.Checked != 0x10000 || add.Mismatches != 1 {
+		t.Errorf("unexpected result %+v", add)
+	}
+	if m := add.Samples[0]; m.A != 0x38 || m.B != 0x38 || m.Got != 0 || m.Want != math8.Add(0x38, 0x38) {
+		t.Errorf("unexpected mismatch %+v", m)
+	}
+	if r.Results[1].Mismatches != 0 {
+		t.Errorf("unexpected result %+v", r.Results[1])
+	}
+
+	if _, err := json.Marshal(r); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteGolden(&buf, Ops{Mul: math8.Mul}); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := VerifyGolden("math8", Ops{Mul: math8.Mul}, bytes.NewReader(buf.Bytes()))
+	if err != nil || !r.OK() {
+		t.Errorf("unexpected report %+v %v", r, err)
+	}
+
+	if _, err := VerifyGolden("math8", Ops{Add: math8.Add}, bytes.NewReader(buf.Bytes())); err == nil {
+		t.Errorf("missing fixture is not detected")
+	}
+
+	if _, err := ReadGolden(bytes.NewReader(buf.Bytes()[:100])); err == nil {
+		t.Errorf("truncated fixture is not detected")
+	}
+}
@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"testing"
+
+	"github.com/kshard/float8/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Check(t, "float8", conformance.Ops{
+		Add:       Add,
+		Sub:       Sub,
+		Mul:       Mul,
+		Div:       Div,
+		Mod:       Mod,
+		Remainder: Remainder,
+		Hypot:     Hypot,
+		Pow:       Pow,
+		Atan2:     Atan2,
+	})
+}
@@ -0,0 +1,11 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for cos of float8
+//
+
+var cos = [0x100]uint8{0x38,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x36,0x36,0x36,0x36,0x36,0x35,0x34,0x34,0x33,0x33,0x32,0x31,0x30,0x2d,0x2a,0x24,0x19,0x95,0xa3,0xa9,0xad,0xb2,0xb4,0xb6,0xb7,0xb7,0xb6,0xb5,0xb2,0xa5,0x29,0x33,0x37,0x37,0x34,0x2b,0xa1,0xb6,0xb5,0x0,0x35,0x36,0x20,0xb4,0xb7,0x32,0x2d,0xb7,0x2d,0x32,0xb7,0x21,0x35,0xa0,0xb2,0x37,0xb2,0xa2,0x35,0xb7,0x2c,0xb7,0x9e,0x37,0xa3,0xb7,0x2e,0x35,0xb3,0x35,0xb7,0x37,0xb6,0x34,0xb1,0x2a,0x92,0x30,0x36,0x37,0x33,0x28,0xaa,0xb4,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x36,0x36,0x36,0x36,0x36,0x35,0x34,0x34,0x33,0x33,0x32,0x31,0x30,0x2d,0x2a,0x24,0x19,0x95,0xa3,0xa9,0xad,0xb2,0xb4,0xb6,0xb7,0xb7,0xb6,0xb5,0xb2,0xa5,0x29,0x33,0x37,0x37,0x34,0x2b,0xa1,0xb6,0xb5,0x0,0x35,0x36,0x20,0xb4,0xb7,0x32,0x2d,0xb7,0x2d,0x32,0xb7,0x21,0x35,0xa0,0xb2,0x37,0xb2,0xa2,0x35,0xb7,0x2c,0xb7,0x9e,0x37,0xa3,0xb7,0x2e,0x35,0xb3,0x35,0xb7,0x37,0xb6,0x34,0xb1,0x2a,0x92,0x30,0x36,0x37,0x33,0x28,0xaa,0xb4}
+
+// Cosine of float8, argument is in radians
+func Cos(a Float8) Float8 { return cos[a] }
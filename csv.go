@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadCSV parses numeric columns of CSV records into float8 rows,
+// see ScanCSV.
+func ReadCSV(r io.Reader, cols []int) ([][]Float8, error) {
+	var rows [][]Float8
+	err := ScanCSV(r, cols, func(row []Float8) error {
+		rows = append(rows, append([]Float8{}, row...))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ScanCSV parses numeric columns of CSV records and quantizes them on the fly,
+// f is called for each record with values of columns cols (all columns if
+// cols is nil). The row is reused between calls. The first record is skipped
+// as a header if none of its columns is numeric. Scanning stops on the first
+// error returned by f.
+func ScanCSV(r io.Reader, cols []int, f func(row []Float8) error) error {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	reader.FieldsPerRecord = -1
+
+	var row []Float8
+	for n := 1; ; n++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		size := len(cols)
+		if cols == nil {
+			size = len(record)
+		}
+		if cap(row) < size {
+			row = make([]Float8, size)
+		}
+		row = row[:size]
+
+		failed := 0
+		var parseErr error
+		for i := range row {
+			col := i
+			if cols != nil {
+				col = cols[i]
+			}
+			if col < 0 || col >= len(record) {
+				return fmt.Errorf("float8: csv line %d has no column %d", n, col)
+			}
+
+			x, err := strconv.ParseFloat(strings.TrimSpace(record[col]), 32)
+			if err != nil && !errors.Is(err, strconv.ErrRange) {
+				if parseErr == nil {
+					parseErr = fmt.Errorf("float8: csv line %d column %d: %w", n, col, err)
+				}
+				failed++
+				continue
+			}
+			row[i] = ToFloat8(float32(x))
+		}
+
+		if parseErr != nil {
+			if n == 1 && failed == len(row) {
+				continue
+			}
+			return parseErr
+		}
+
+		if err := f(row); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	in := "id,name,x,y\n1,a,0.5,2\n2,b, -3 ,1e3\n"
+
+	rows, err := ReadCSV(strings.NewReader(in), []int{2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]Float8{
+		{ToFloat8(0.5), ToFloat8(2)},
+		{ToFloat8(-3), ToFloat8(1e3)},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("got=%v wanted=%v", rows, expected)
+	}
+
+	all, err := ReadCSV(strings.NewReader("1,2\n3,4\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || !reflect.DeepEqual(all[1], []Float8{ToFloat8(3), ToFloat8(4)}) {
+		t.Errorf("unexpected rows %v", all)
+	}
+}
+
+func TestReadCSVInvalid(t *testing.T) {
+	for in, cols := range map[string][]int{
+		"1,2\n3,x\n": {0, 1},
+		"1,2\n3\n":   {0, 1},
+		"x,1\n":      {0, 1},
+		"1,2\n":      {-1},
+	} {
+		if _, err := ReadCSV(strings.NewReader(in), cols); err == nil {
+			t.Errorf("%q is parsed", in)
+		}
+	}
+}
+
+func TestScanCSV(t *testing.T) {
+	stop := errors.New("stop")
+
+	n := 0
+	err := ScanCSV(strings.NewReader("1\n2\n3\n"), nil, func(row []Float8) error {
+		n++
+		if n == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || n != 2 {
+		t.Errorf("scan is not stopped, n=%d err=%v", n, err)
+	}
+}
@@ -0,0 +1,11 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for cube of float8
+//
+
+var cube = [0x100]uint8{0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x5,0x8,0xb,0xf,0x12,0x15,0x18,0x1a,0x1d,0x20,0x23,0x27,0x2a,0x2d,0x30,0x32,0x35,0x38,0x3b,0x3f,0x42,0x45,0x48,0x4a,0x4d,0x50,0x53,0x57,0x5a,0x5d,0x60,0x62,0x65,0x68,0x6b,0x6f,0x72,0x75,0x78,0x7a,0x7d,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x85,0x88,0x8b,0x8f,0x92,0x95,0x98,0x9a,0x9d,0xa0,0xa3,0xa7,0xaa,0xad,0xb0,0xb2,0xb5,0xb8,0xbb,0xbf,0xc2,0xc5,0xc8,0xca,0xcd,0xd0,0xd3,0xd7,0xda,0xdd,0xe0,0xe2,0xe5,0xe8,0xeb,0xef,0xf2,0xf5,0xf8,0xfa,0xfd,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff}
+
+// Cube (x³) of float8
+func Cube(a Float8) Float8 { return cube[a] }
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/kshard/float8/internal/cpu"
+)
+
+// Name of portable kernels, used unless architecture specific kernel
+// is installed for the operation.
+const pathGeneric = "generic"
+
+// kernel is dispatched implementation of vector operation. Architecture
+// specific files install kernels from init depending on detected features
+// (see internal/cpu), callers of public api are not affected.
+type kernel[F any] struct {
+	path string
+	fn   F
+}
+
+// install kernel for the operation
+func (k *kernel[F]) use(path string, fn F) {
+	k.path, k.fn = path, fn
+}
+
+var (
+	dotKernel       = &kernel[func(a, b []Float8) float32]{pathGeneric, dotGeneric}
+	sqdiffKernel    = &kernel[func(a, b []Float8) float32]{pathGeneric, squaredEuclideanGeneric}
+	absdiffKernel   = &kernel[func(a, b []Float8) float32]{pathGeneric, manhattanGeneric}
+	toSlice8Kernel  = &kernel[func(dst []Float8, src []float32)]{pathGeneric, toSlice8Generic}
+	toSlice32Kernel = &kernel[func(dst []float32, src []Float8)]{pathGeneric, toSlice32Generic}
+	kernelPathTable = map[string]*string{
+		"dot":       &dotKernel.path,
+		"sqdiff":    &sqdiffKernel.path,
+		"absdiff":   &absdiffKernel.path,
+		"toslice8":  &toSlice8Kernel.path,
+		"toslice32": &toSlice32Kernel.path,
+	}
+)
+
+// Diagnostics of dispatch layer
+type Diagnostics struct {
+	// Target architecture (GOARCH)
+	Arch string
+
+	// Detected CPU features (e.g. avx2, avx512, neon, sve)
+	Features []string
+
+	// Active path per operation (e.g. "dot": "generic")
+	Kernels map[string]string
+}
+
+// Diag reports detected CPU features and kernels active for vector operations
+func Diag() Diagnostics {
+	d := Diagnostics{
+		Arch:     runtime.GOARCH,
+		Features: cpu.Features(),
+		Kernels:  make(map[string]string, len(kernelPathTable)),
+	}
+
+	for op, path := range kernelPathTable {
+		d.Kernels[op] = *path
+	}
+
+	return d
+}
+
+func (d Diagnostics) String() string {
+	ops := make([]string, 0, len(d.Kernels))
+	for op := range d.Kernels {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "arch: %s, features: [%s]", d.Arch, strings.Join(d.Features, " "))
+	for _, op := range ops {
+		fmt.Fprintf(&sb, ", %s: %s", op, d.Kernels[op])
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestDiag(t *testing.T) {
+	d := Diag()
+
+	if d.Arch != runtime.GOARCH {
+		t.Errorf("arch %s, expected %s", d.Arch, runtime.GOARCH)
+	}
+
+	for _, op := range []string{"dot", "sqdiff", "absdiff", "toslice8", "toslice32"} {
+		if d.Kernels[op] == "" {
+			t.Errorf("kernel %s is not reported", op)
+		}
+		if !strings.Contains(d.String(), op+": "+d.Kernels[op]) {
+			t.Errorf("kernel %s is not formatted: %s", op, d)
+		}
+	}
+}
+
+func TestKernelUse(t *testing.T) {
+	path, fn := dotKernel.path, dotKernel.fn
+	defer dotKernel.use(path, fn)
+
+	dotKernel.use("test", func(a, b []Float8) float32 { return -1 })
+
+	if Diag().Kernels["dot"] != "test" {
+		t.Errorf("installed kernel is not reported: %s", Diag())
+	}
+
+	if d := Dot([]Float8{0x38}, []Float8{0x38}); d != -1 {
+		t.Errorf("installed kernel is not dispatched: %v", d)
+	}
+}
@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+//go:build wasm && wasmsimd
+
+package float8
+
+import "unsafe"
+
+// SIMD128 kernels are implemented by wasm/float8.wat, Go compiler does not
+// emit SIMD instructions. The embedder instantiates the kernel module over
+// memory of Go module and links its exports as import module "float8".
+// Kernels decode float8 exactly by bit manipulation, results of Dot might
+// differ from generic path in the last bits due to order of summation.
+
+//go:wasmimport float8 dot
+func wasmDot(a, b unsafe.Pointer, n int32) float32
+
+//go:wasmimport float8 toslice8
+func wasmToSlice8(dst, src unsafe.Pointer, n int32)
+
+//go:wasmimport float8 toslice32
+func wasmToSlice32(dst, src unsafe.Pointer, n int32)
+
+const pathSIMD128 = "simd128"
+
+func init() {
+	dotKernel.use(pathSIMD128, func(a, b []Float8) float32 {
+		return wasmDot(unsafe.Pointer(unsafe.SliceData(a)), unsafe.Pointer(unsafe.SliceData(b)), int32(len(a)))
+	})
+
+	toSlice8Kernel.use(pathSIMD128, func(dst []Float8, src []float32) {
+		wasmToSlice8(unsafe.Pointer(unsafe.SliceData(dst)), unsafe.Pointer(unsafe.SliceData(src)), int32(len(src)))
+	})
+
+	toSlice32Kernel.use(pathSIMD128, func(dst []float32, src []Float8) {
+		wasmToSlice32(unsafe.Pointer(unsafe.SliceData(dst)), unsafe.Pointer(unsafe.SliceData(src)), int32(len(src)))
+	})
+}
@@ -0,0 +1,175 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+func TestSqDiff(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			c := sqdiff[a<<8|b]
+			e := math8.SquaredDiff(uint8(a), uint8(b))
+			if c != e {
+				t.Errorf("(0x%02x - 0x%02x)² wanted=%g, got=%g", a, b, e, c)
+			}
+		}
+	}
+}
+
+func TestAbsDiff(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			c := absdiff[a<<8|b]
+			e := math8.AbsDiff(uint8(a), uint8(b))
+			if c != e {
+				t.Errorf("|0x%02x - 0x%02x| wanted=%g, got=%g", a, b, e, c)
+			}
+		}
+	}
+}
+
+func TestManhattan(t *testing.T) {
+	a := []Float8{0x38, 0x40, 0x00} // 1, 2, 0
+	b := []Float8{0x38, 0xb8, 0x48} // 1, -1, 4
+
+	if d := Manhattan(a, b); d != 7 {
+		t.Errorf("unexpected distance %f", d)
+	}
+}
+
+func TestEuclidean(t *testing.T) {
+	a := []Float8{0x38, 0x40, 0x00} // 1, 2, 0
+	b := []Float8{0x38, 0xb8, 0x48} // 1, -1, 4
+
+	if d := SquaredEuclidean(a, b); d != 25 {
+		t.Errorf("unexpected squared distance %f", d)
+	}
+
+	if d := Euclidean(a, b); d != 5 {
+		t.Errorf("unexpected distance %f", d)
+	}
+}
+
+func TestPairwiseEuclidean(t *testing.T) {
+	a := [][]Float8{{0x38, 0x40}, {0x00, 0x00}}
+	b := [][]Float8{{0x38, 0x40}, {0x44, 0x48}, {0x00, 0x00}}
+
+	d := PairwiseEuclidean(nil, a, b)
+	for i, x := range []float32{0, Euclidean(a[0], b[1]), Euclidean(a[0], b[2]), Euclidean(a[1], b[0]), 5, 0} {
+		if d[i] != x {
+			t.Errorf("%d wanted=%f, got=%f", i, x, d[i])
+		}
+	}
+}
+
+func BenchmarkSquaredEuclidean(b *testing.B) {
+	x, y := make([]Float8, 1024), make([]Float8, 1024)
+	FillRandom(nil, x)
+	FillRandom(nil, y)
+
+	for i := b.N; i > 0; i-- {
+		f32 = SquaredEuclidean(x, y)
+	}
+}
+
+func TestDot(t *testing.T) {
+	a := []Float8{0x38, 0x40, 0x00} // 1, 2, 0
+	b := []Float8{0x38, 0xb8, 0x48} // 1, -1, 4
+
+	if d := Dot(a, b); d != -1 {
+		t.Errorf("unexpected product %f", d)
+	}
+}
+
+func TestCosine(t *testing.T) {
+	a := []Float8{0x38, 0x00} // 1, 0
+	b := []Float8{0x00, 0x40} // 0, 2
+	c := []Float8{0xb8, 0x00} // -1, 0
+
+	for _, tc := range []struct {
+		a, b []Float8
+		d    float32
+	}{
+		{a, a, 0},
+		{a, b, 1},
+		{a, c, 2},
+		{a, []Float8{0x00, 0x00}, 1},
+	} {
+		if d := Cosine(tc.a, tc.b); d != tc.d {
+			t.Errorf("unexpected distance %f, wanted %f", d, tc.d)
+		}
+	}
+}
+
+func TestDotFixed(t *testing.T) {
+	if dotFixedBits != math8.ProductFixedBits {
+		t.Fatalf("fractional bits mismatch %d != %d", dotFixedBits, math8.ProductFixedBits)
+	}
+
+	largest := int64(0)
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			c, e := dotfix[a<<8|b], math8.ProductFixed(uint8(a), uint8(b))
+			if c != e {
+				t.Fatalf("0x%02x · 0x%02x wanted=%d, got=%d", a, b, e, c)
+			}
+			largest = max(largest, int64(c), -int64(c))
+		}
+	}
+
+	if largest*dotFixedChunk > math.MaxInt32 {
+		t.Errorf("chunk of %d products overflows int32", dotFixedChunk)
+	}
+}
+
+func TestDotFast(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, n := range []int{1, 7, 8, 9, 100, 4096} {
+		a, b := make([]Float8, n), make([]Float8, n)
+		FillRandom(r, a)
+		FillRandom(r, b)
+
+		exact := 0.0
+		for i := range a {
+			exact += float64(ToFloat32(a[i])) * float64(ToFloat32(b[i]))
+		}
+
+		bound := float64(n)*math.Ldexp(1, -dotFixedBits-1) + math.Abs(exact)*1e-7
+		if d := DotFast(a, b); math.Abs(float64(d)-exact) > bound {
+			t.Errorf("n=%d wanted=%g, got=%g", n, exact, d)
+		}
+	}
+}
+
+func BenchmarkDot(b *testing.B) {
+	x, y := make([]Float8, 1024), make([]Float8, 1024)
+	FillRandom(nil, x)
+	FillRandom(nil, y)
+
+	for i := b.N; i > 0; i-- {
+		f32 = Dot(x, y)
+	}
+}
+
+func BenchmarkDotFast(b *testing.B) {
+	x, y := make([]Float8, 1024), make([]Float8, 1024)
+	FillRandom(nil, x)
+	FillRandom(nil, y)
+
+	for i := b.N; i > 0; i-- {
+		f32 = DotFast(x, y)
+	}
+}
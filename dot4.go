@@ -0,0 +1,9 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for dot product of bytes packing two FP4 values each, values are computed in float64
+// and rounded to nearest float32
+//
+
+var dot4 = [0x10000]float32{0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0.25,0.5,0.75,1,1.25,1.75,2.25,3.25,0.25,0,-0.25,-0.5,-0.75,-1.25,-1.75,-2.75,0.5,0.75,1,1.25,1.5,2,2.5,3.5,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,1,1.25,1.5,1.75,2,2.5,3,4,1,0.75,0.5,0.25,0,-0.5,-1,-2,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-0.25,0,0.25,0.5,0.75,1.25,1.75,2.75,-0.25,-0.5,-0.75,-1,-1.25,-1.75,-2.25,-3.25,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0.25,0.75,1.25,1.75,2.25,3.25,4.25,6.25,0.25,-0.25,-0.75,-1.25,-1.75,-2.75,-3.75,-5.75,0.5,1,1.5,2,2.5,3.5,4.5,6.5,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,1,1.5,2,2.5,3,4,5,7,1,0.5,0,-0.5,-1,-2,-3,-5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-0.25,0.25,0.75,1.25,1.75,2.75,3.75,5.75,-0.25,-0.75,-1.25,-1.75,-2.25,-3.25,-4.25,-6.25,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-1,-0.5,0,0.5,1,2,3,5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0.25,1,1.75,2.5,3.25,4.75,6.25,9.25,0.25,-0.5,-1.25,-2,-2.75,-4.25,-5.75,-8.75,0.5,1.25,2,2.75,3.5,5,6.5,9.5,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,1,1.75,2.5,3.25,4,5.5,7,10,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-0.25,0.5,1.25,2,2.75,4.25,5.75,8.75,-0.25,-1,-1.75,-2.5,-3.25,-4.75,-6.25,-9.25,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-1,-0.25,0.5,1.25,2,3.5,5,8,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0.25,1.25,2.25,3.25,4.25,6.25,8.25,12.25,0.25,-0.75,-1.75,-2.75,-3.75,-5.75,-7.75,-11.75,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,1,2,3,4,5,7,9,13,1,0,-1,-2,-3,-5,-7,-11,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-0.25,0.75,1.75,2.75,3.75,5.75,7.75,11.75,-0.25,-1.25,-2.25,-3.25,-4.25,-6.25,-8.25,-12.25,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-1,0,1,2,3,5,7,11,-1,-2,-3,-4,-5,-7,-9,-13,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0.25,1.75,3.25,4.75,6.25,9.25,12.25,18.25,0.25,-1.25,-2.75,-4.25,-5.75,-8.75,-11.75,-17.75,0.5,2,3.5,5,6.5,9.5,12.5,18.5,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,1,2.5,4,5.5,7,10,13,19,1,-0.5,-2,-3.5,-5,-8,-11,-17,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-0.25,1.25,2.75,4.25,5.75,8.75,11.75,17.75,-0.25,-1.75,-3.25,-4.75,-6.25,-9.25,-12.25,-18.25,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-1,0.5,2,3.5,5,8,11,17,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0.25,2.25,4.25,6.25,8.25,12.25,16.25,24.25,0.25,-1.75,-3.75,-5.75,-7.75,-11.75,-15.75,-23.75,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,1,3,5,7,9,13,17,25,1,-1,-3,-5,-7,-11,-15,-23,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-0.25,1.75,3.75,5.75,7.75,11.75,15.75,23.75,-0.25,-2.25,-4.25,-6.25,-8.25,-12.25,-16.25,-24.25,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-1,1,3,5,7,11,15,23,-1,-3,-5,-7,-9,-13,-17,-25,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0.25,3.25,6.25,9.25,12.25,18.25,24.25,36.25,0.25,-2.75,-5.75,-8.75,-11.75,-17.75,-23.75,-35.75,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,1,4,7,10,13,19,25,37,1,-2,-5,-8,-11,-17,-23,-35,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-0.25,2.75,5.75,8.75,11.75,17.75,23.75,35.75,-0.25,-3.25,-6.25,-9.25,-12.25,-18.25,-24.25,-36.25,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-1,2,5,8,11,17,23,35,-1,-4,-7,-10,-13,-19,-25,-37,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0.25,0,-0.25,-0.5,-0.75,-1.25,-1.75,-2.75,0.25,0.5,0.75,1,1.25,1.75,2.25,3.25,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,0.5,0.75,1,1.25,1.5,2,2.5,3.5,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,1,0.75,0.5,0.25,0,-0.5,-1,-2,1,1.25,1.5,1.75,2,2.5,3,4,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0.25,-0.5,-0.75,-1,-1.25,-1.75,-2.25,-3.25,-0.25,0,0.25,0.5,0.75,1.25,1.75,2.75,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0.25,-0.25,-0.75,-1.25,-1.75,-2.75,-3.75,-5.75,0.25,0.75,1.25,1.75,2.25,3.25,4.25,6.25,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,0.5,1,1.5,2,2.5,3.5,4.5,6.5,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,1,0.5,0,-0.5,-1,-2,-3,-5,1,1.5,2,2.5,3,4,5,7,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0.25,-0.75,-1.25,-1.75,-2.25,-3.25,-4.25,-6.25,-0.25,0.25,0.75,1.25,1.75,2.75,3.75,5.75,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1,-0.5,0,0.5,1,2,3,5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0.25,-0.5,-1.25,-2,-2.75,-4.25,-5.75,-8.75,0.25,1,1.75,2.5,3.25,4.75,6.25,9.25,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,0.5,1.25,2,2.75,3.5,5,6.5,9.5,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1,1.75,2.5,3.25,4,5.5,7,10,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0.25,-1,-1.75,-2.5,-3.25,-4.75,-6.25,-9.25,-0.25,0.5,1.25,2,2.75,4.25,5.75,8.75,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1,-0.25,0.5,1.25,2,3.5,5,8,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0.25,-0.75,-1.75,-2.75,-3.75,-5.75,-7.75,-11.75,0.25,1.25,2.25,3.25,4.25,6.25,8.25,12.25,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,1,0,-1,-2,-3,-5,-7,-11,1,2,3,4,5,7,9,13,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0.25,-1.25,-2.25,-3.25,-4.25,-6.25,-8.25,-12.25,-0.25,0.75,1.75,2.75,3.75,5.75,7.75,11.75,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-1,-2,-3,-4,-5,-7,-9,-13,-1,0,1,2,3,5,7,11,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0.25,-1.25,-2.75,-4.25,-5.75,-8.75,-11.75,-17.75,0.25,1.75,3.25,4.75,6.25,9.25,12.25,18.25,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,0.5,2,3.5,5,6.5,9.5,12.5,18.5,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,1,-0.5,-2,-3.5,-5,-8,-11,-17,1,2.5,4,5.5,7,10,13,19,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0.25,-1.75,-3.25,-4.75,-6.25,-9.25,-12.25,-18.25,-0.25,1.25,2.75,4.25,5.75,8.75,11.75,17.75,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1,0.5,2,3.5,5,8,11,17,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0.25,-1.75,-3.75,-5.75,-7.75,-11.75,-15.75,-23.75,0.25,2.25,4.25,6.25,8.25,12.25,16.25,24.25,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,1,-1,-3,-5,-7,-11,-15,-23,1,3,5,7,9,13,17,25,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0.25,-2.25,-4.25,-6.25,-8.25,-12.25,-16.25,-24.25,-0.25,1.75,3.75,5.75,7.75,11.75,15.75,23.75,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-1,-3,-5,-7,-9,-13,-17,-25,-1,1,3,5,7,11,15,23,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0.25,-2.75,-5.75,-8.75,-11.75,-17.75,-23.75,-35.75,0.25,3.25,6.25,9.25,12.25,18.25,24.25,36.25,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,1,-2,-5,-8,-11,-17,-23,-35,1,4,7,10,13,19,25,37,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0.25,-3.25,-6.25,-9.25,-12.25,-18.25,-24.25,-36.25,-0.25,2.75,5.75,8.75,11.75,17.75,23.75,35.75,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-1,-4,-7,-10,-13,-19,-25,-37,-1,2,5,8,11,17,23,35,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0.5,0.75,1,1.25,1.5,2,2.5,3.5,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,1,1.25,1.5,1.75,2,2.5,3,4,1,0.75,0.5,0.25,0,-0.5,-1,-2,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4,4.25,4.5,4.75,5,5.5,6,7,4,3.75,3.5,3.25,3,2.5,2,1,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0.5,1,1.5,2,2.5,3.5,4.5,6.5,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,1,1.5,2,2.5,3,4,5,7,1,0.5,0,-0.5,-1,-2,-3,-5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4,4.5,5,5.5,6,7,8,10,4,3.5,3,2.5,2,1,0,-2,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-1,-0.5,0,0.5,1,2,3,5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4,-3.5,-3,-2.5,-2,-1,0,2,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0.5,1.25,2,2.75,3.5,5,6.5,9.5,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,1,1.75,2.5,3.25,4,5.5,7,10,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4,4.75,5.5,6.25,7,8.5,10,13,4,3.25,2.5,1.75,1,-0.5,-2,-5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-1,-0.25,0.5,1.25,2,3.5,5,8,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,1,2,3,4,5,7,9,13,1,0,-1,-2,-3,-5,-7,-11,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4,5,6,7,8,10,12,16,4,3,2,1,0,-2,-4,-8,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-1,0,1,2,3,5,7,11,-1,-2,-3,-4,-5,-7,-9,-13,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4,-3,-2,-1,0,2,4,8,-4,-5,-6,-7,-8,-10,-12,-16,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0.5,2,3.5,5,6.5,9.5,12.5,18.5,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,1,2.5,4,5.5,7,10,13,19,1,-0.5,-2,-3.5,-5,-8,-11,-17,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4,5.5,7,8.5,10,13,16,22,4,2.5,1,-0.5,-2,-5,-8,-14,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-1,0.5,2,3.5,5,8,11,17,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4,-2.5,-1,0.5,2,5,8,14,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,1,3,5,7,9,13,17,25,1,-1,-3,-5,-7,-11,-15,-23,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4,6,8,10,12,16,20,28,4,2,0,-2,-4,-8,-12,-20,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-1,1,3,5,7,11,15,23,-1,-3,-5,-7,-9,-13,-17,-25,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4,-2,0,2,4,8,12,20,-4,-6,-8,-10,-12,-16,-20,-28,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,1,4,7,10,13,19,25,37,1,-2,-5,-8,-11,-17,-23,-35,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4,7,10,13,16,22,28,40,4,1,-2,-5,-8,-14,-20,-32,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-1,2,5,8,11,17,23,35,-1,-4,-7,-10,-13,-19,-25,-37,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4,-1,2,5,8,14,20,32,-4,-7,-10,-13,-16,-22,-28,-40,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,0.5,0.75,1,1.25,1.5,2,2.5,3.5,1,0.75,0.5,0.25,0,-0.5,-1,-2,1,1.25,1.5,1.75,2,2.5,3,4,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4,3.75,3.5,3.25,3,2.5,2,1,4,4.25,4.5,4.75,5,5.5,6,7,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,0.5,1,1.5,2,2.5,3.5,4.5,6.5,1,0.5,0,-0.5,-1,-2,-3,-5,1,1.5,2,2.5,3,4,5,7,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4,3.5,3,2.5,2,1,0,-2,4,4.5,5,5.5,6,7,8,10,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1,-0.5,0,0.5,1,2,3,5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-4,-3.5,-3,-2.5,-2,-1,0,2,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,0.5,1.25,2,2.75,3.5,5,6.5,9.5,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1,1.75,2.5,3.25,4,5.5,7,10,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4,3.25,2.5,1.75,1,-0.5,-2,-5,4,4.75,5.5,6.25,7,8.5,10,13,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1,-0.25,0.5,1.25,2,3.5,5,8,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,1,0,-1,-2,-3,-5,-7,-11,1,2,3,4,5,7,9,13,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4,3,2,1,0,-2,-4,-8,4,5,6,7,8,10,12,16,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-1,-2,-3,-4,-5,-7,-9,-13,-1,0,1,2,3,5,7,11,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4,-5,-6,-7,-8,-10,-12,-16,-4,-3,-2,-1,0,2,4,8,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,0.5,2,3.5,5,6.5,9.5,12.5,18.5,1,-0.5,-2,-3.5,-5,-8,-11,-17,1,2.5,4,5.5,7,10,13,19,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4,2.5,1,-0.5,-2,-5,-8,-14,4,5.5,7,8.5,10,13,16,22,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1,0.5,2,3.5,5,8,11,17,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-4,-2.5,-1,0.5,2,5,8,14,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,1,-1,-3,-5,-7,-11,-15,-23,1,3,5,7,9,13,17,25,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4,2,0,-2,-4,-8,-12,-20,4,6,8,10,12,16,20,28,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-1,-3,-5,-7,-9,-13,-17,-25,-1,1,3,5,7,11,15,23,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4,-6,-8,-10,-12,-16,-20,-28,-4,-2,0,2,4,8,12,20,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,1,-2,-5,-8,-11,-17,-23,-35,1,4,7,10,13,19,25,37,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4,1,-2,-5,-8,-14,-20,-32,4,7,10,13,16,22,28,40,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-1,-4,-7,-10,-13,-19,-25,-37,-1,2,5,8,11,17,23,35,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4,-7,-10,-13,-16,-22,-28,-40,-4,-1,2,5,8,14,20,32,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,2.25,2.5,2.75,3,3.25,3.75,4.25,5.25,2.25,2,1.75,1.5,1.25,0.75,0.25,-0.75,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4.5,4.75,5,5.25,5.5,6,6.5,7.5,4.5,4.25,4,3.75,3.5,3,2.5,1.5,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,9,9.25,9.5,9.75,10,10.5,11,12,9,8.75,8.5,8.25,8,7.5,7,6,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-2.25,-2,-1.75,-1.5,-1.25,-0.75,-0.25,0.75,-2.25,-2.5,-2.75,-3,-3.25,-3.75,-4.25,-5.25,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,2.25,2.75,3.25,3.75,4.25,5.25,6.25,8.25,2.25,1.75,1.25,0.75,0.25,-0.75,-1.75,-3.75,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4.5,5,5.5,6,6.5,7.5,8.5,10.5,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,9,9.5,10,10.5,11,12,13,15,9,8.5,8,7.5,7,6,5,3,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-2.25,-1.75,-1.25,-0.75,-0.25,0.75,1.75,3.75,-2.25,-2.75,-3.25,-3.75,-4.25,-5.25,-6.25,-8.25,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-9,-9.5,-10,-10.5,-11,-12,-13,-15,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,2.25,3,3.75,4.5,5.25,6.75,8.25,11.25,2.25,1.5,0.75,0,-0.75,-2.25,-3.75,-6.75,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4.5,5.25,6,6.75,7.5,9,10.5,13.5,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,9,9.75,10.5,11.25,12,13.5,15,18,9,8.25,7.5,6.75,6,4.5,3,0,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-2.25,-1.5,-0.75,0,0.75,2.25,3.75,6.75,-2.25,-3,-3.75,-4.5,-5.25,-6.75,-8.25,-11.25,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,2.25,3.25,4.25,5.25,6.25,8.25,10.25,14.25,2.25,1.25,0.25,-0.75,-1.75,-3.75,-5.75,-9.75,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,9,10,11,12,13,15,17,21,9,8,7,6,5,3,1,-3,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-2.25,-1.25,-0.25,0.75,1.75,3.75,5.75,9.75,-2.25,-3.25,-4.25,-5.25,-6.25,-8.25,-10.25,-14.25,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-9,-8,-7,-6,-5,-3,-1,3,-9,-10,-11,-12,-13,-15,-17,-21,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,2.25,3.75,5.25,6.75,8.25,11.25,14.25,20.25,2.25,0.75,-0.75,-2.25,-3.75,-6.75,-9.75,-15.75,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4.5,6,7.5,9,10.5,13.5,16.5,22.5,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,9,10.5,12,13.5,15,18,21,27,9,7.5,6,4.5,3,0,-3,-9,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-2.25,-0.75,0.75,2.25,3.75,6.75,9.75,15.75,-2.25,-3.75,-5.25,-6.75,-8.25,-11.25,-14.25,-20.25,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-9,-7.5,-6,-4.5,-3,0,3,9,-9,-10.5,-12,-13.5,-15,-18,-21,-27,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,2.25,4.25,6.25,8.25,10.25,14.25,18.25,26.25,2.25,0.25,-1.75,-3.75,-5.75,-9.75,-13.75,-21.75,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,9,11,13,15,17,21,25,33,9,7,5,3,1,-3,-7,-15,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-2.25,-0.25,1.75,3.75,5.75,9.75,13.75,21.75,-2.25,-4.25,-6.25,-8.25,-10.25,-14.25,-18.25,-26.25,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-9,-7,-5,-3,-1,3,7,15,-9,-11,-13,-15,-17,-21,-25,-33,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,2.25,5.25,8.25,11.25,14.25,20.25,26.25,38.25,2.25,-0.75,-3.75,-6.75,-9.75,-15.75,-21.75,-33.75,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,9,12,15,18,21,27,33,45,9,6,3,0,-3,-9,-15,-27,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-2.25,0.75,3.75,6.75,9.75,15.75,21.75,33.75,-2.25,-5.25,-8.25,-11.25,-14.25,-20.25,-26.25,-38.25,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-9,-6,-3,0,3,9,15,27,-9,-12,-15,-18,-21,-27,-33,-45,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,2.25,2,1.75,1.5,1.25,0.75,0.25,-0.75,2.25,2.5,2.75,3,3.25,3.75,4.25,5.25,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4.5,4.25,4,3.75,3.5,3,2.5,1.5,4.5,4.75,5,5.25,5.5,6,6.5,7.5,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,9,8.75,8.5,8.25,8,7.5,7,6,9,9.25,9.5,9.75,10,10.5,11,12,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-2.25,-2.5,-2.75,-3,-3.25,-3.75,-4.25,-5.25,-2.25,-2,-1.75,-1.5,-1.25,-0.75,-0.25,0.75,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,2.25,1.75,1.25,0.75,0.25,-0.75,-1.75,-3.75,2.25,2.75,3.25,3.75,4.25,5.25,6.25,8.25,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,4.5,5,5.5,6,6.5,7.5,8.5,10.5,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,9,8.5,8,7.5,7,6,5,3,9,9.5,10,10.5,11,12,13,15,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-2.25,-2.75,-3.25,-3.75,-4.25,-5.25,-6.25,-8.25,-2.25,-1.75,-1.25,-0.75,-0.25,0.75,1.75,3.75,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-9,-8.5,-8,-7.5,-7,-6,-5,-3,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,2.25,1.5,0.75,0,-0.75,-2.25,-3.75,-6.75,2.25,3,3.75,4.5,5.25,6.75,8.25,11.25,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,4.5,5.25,6,6.75,7.5,9,10.5,13.5,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,9,8.25,7.5,6.75,6,4.5,3,0,9,9.75,10.5,11.25,12,13.5,15,18,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-2.25,-3,-3.75,-4.5,-5.25,-6.75,-8.25,-11.25,-2.25,-1.5,-0.75,0,0.75,2.25,3.75,6.75,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,2.25,1.25,0.25,-0.75,-1.75,-3.75,-5.75,-9.75,2.25,3.25,4.25,5.25,6.25,8.25,10.25,14.25,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,9,8,7,6,5,3,1,-3,9,10,11,12,13,15,17,21,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-2.25,-3.25,-4.25,-5.25,-6.25,-8.25,-10.25,-14.25,-2.25,-1.25,-0.25,0.75,1.75,3.75,5.75,9.75,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-9,-10,-11,-12,-13,-15,-17,-21,-9,-8,-7,-6,-5,-3,-1,3,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,2.25,0.75,-0.75,-2.25,-3.75,-6.75,-9.75,-15.75,2.25,3.75,5.25,6.75,8.25,11.25,14.25,20.25,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,4.5,6,7.5,9,10.5,13.5,16.5,22.5,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,9,7.5,6,4.5,3,0,-3,-9,9,10.5,12,13.5,15,18,21,27,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-2.25,-3.75,-5.25,-6.75,-8.25,-11.25,-14.25,-20.25,-2.25,-0.75,0.75,2.25,3.75,6.75,9.75,15.75,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-9,-7.5,-6,-4.5,-3,0,3,9,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,2.25,0.25,-1.75,-3.75,-5.75,-9.75,-13.75,-21.75,2.25,4.25,6.25,8.25,10.25,14.25,18.25,26.25,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,9,7,5,3,1,-3,-7,-15,9,11,13,15,17,21,25,33,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-2.25,-4.25,-6.25,-8.25,-10.25,-14.25,-18.25,-26.25,-2.25,-0.25,1.75,3.75,5.75,9.75,13.75,21.75,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-9,-11,-13,-15,-17,-21,-25,-33,-9,-7,-5,-3,-1,3,7,15,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,2.25,-0.75,-3.75,-6.75,-9.75,-15.75,-21.75,-33.75,2.25,5.25,8.25,11.25,14.25,20.25,26.25,38.25,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,9,6,3,0,-3,-9,-15,-27,9,12,15,18,21,27,33,45,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-2.25,-5.25,-8.25,-11.25,-14.25,-20.25,-26.25,-38.25,-2.25,0.75,3.75,6.75,9.75,15.75,21.75,33.75,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-9,-12,-15,-18,-21,-27,-33,-45,-9,-6,-3,0,3,9,15,27,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,1,1.25,1.5,1.75,2,2.5,3,4,1,0.75,0.5,0.25,0,-0.5,-1,-2,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4,4.25,4.5,4.75,5,5.5,6,7,4,3.75,3.5,3.25,3,2.5,2,1,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,8,8.25,8.5,8.75,9,9.5,10,11,8,7.75,7.5,7.25,7,6.5,6,5,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,1,1.5,2,2.5,3,4,5,7,1,0.5,0,-0.5,-1,-2,-3,-5,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4,4.5,5,5.5,6,7,8,10,4,3.5,3,2.5,2,1,0,-2,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,8,8.5,9,9.5,10,11,12,14,8,7.5,7,6.5,6,5,4,2,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-1,-0.5,0,0.5,1,2,3,5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4,-3.5,-3,-2.5,-2,-1,0,2,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,1,1.75,2.5,3.25,4,5.5,7,10,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4,4.75,5.5,6.25,7,8.5,10,13,4,3.25,2.5,1.75,1,-0.5,-2,-5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,8,8.75,9.5,10.25,11,12.5,14,17,8,7.25,6.5,5.75,5,3.5,2,-1,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-1,-0.25,0.5,1.25,2,3.5,5,8,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,1,2,3,4,5,7,9,13,1,0,-1,-2,-3,-5,-7,-11,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4,5,6,7,8,10,12,16,4,3,2,1,0,-2,-4,-8,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,8,9,10,11,12,14,16,20,8,7,6,5,4,2,0,-4,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-1,0,1,2,3,5,7,11,-1,-2,-3,-4,-5,-7,-9,-13,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4,-3,-2,-1,0,2,4,8,-4,-5,-6,-7,-8,-10,-12,-16,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-8,-7,-6,-5,-4,-2,0,4,-8,-9,-10,-11,-12,-14,-16,-20,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,1,2.5,4,5.5,7,10,13,19,1,-0.5,-2,-3.5,-5,-8,-11,-17,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4,5.5,7,8.5,10,13,16,22,4,2.5,1,-0.5,-2,-5,-8,-14,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,8,9.5,11,12.5,14,17,20,26,8,6.5,5,3.5,2,-1,-4,-10,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-1,0.5,2,3.5,5,8,11,17,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4,-2.5,-1,0.5,2,5,8,14,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-8,-6.5,-5,-3.5,-2,1,4,10,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,1,3,5,7,9,13,17,25,1,-1,-3,-5,-7,-11,-15,-23,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4,6,8,10,12,16,20,28,4,2,0,-2,-4,-8,-12,-20,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,8,10,12,14,16,20,24,32,8,6,4,2,0,-4,-8,-16,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-1,1,3,5,7,11,15,23,-1,-3,-5,-7,-9,-13,-17,-25,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4,-2,0,2,4,8,12,20,-4,-6,-8,-10,-12,-16,-20,-28,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-8,-6,-4,-2,0,4,8,16,-8,-10,-12,-14,-16,-20,-24,-32,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,1,4,7,10,13,19,25,37,1,-2,-5,-8,-11,-17,-23,-35,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4,7,10,13,16,22,28,40,4,1,-2,-5,-8,-14,-20,-32,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,8,11,14,17,20,26,32,44,8,5,2,-1,-4,-10,-16,-28,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-1,2,5,8,11,17,23,35,-1,-4,-7,-10,-13,-19,-25,-37,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4,-1,2,5,8,14,20,32,-4,-7,-10,-13,-16,-22,-28,-40,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-8,-5,-2,1,4,10,16,28,-8,-11,-14,-17,-20,-26,-32,-44,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,1,0.75,0.5,0.25,0,-0.5,-1,-2,1,1.25,1.5,1.75,2,2.5,3,4,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4,3.75,3.5,3.25,3,2.5,2,1,4,4.25,4.5,4.75,5,5.5,6,7,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,8,7.75,7.5,7.25,7,6.5,6,5,8,8.25,8.5,8.75,9,9.5,10,11,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,1,0.5,0,-0.5,-1,-2,-3,-5,1,1.5,2,2.5,3,4,5,7,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4,3.5,3,2.5,2,1,0,-2,4,4.5,5,5.5,6,7,8,10,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,8,7.5,7,6.5,6,5,4,2,8,8.5,9,9.5,10,11,12,14,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1,-0.5,0,0.5,1,2,3,5,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-4,-3.5,-3,-2.5,-2,-1,0,2,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1,1.75,2.5,3.25,4,5.5,7,10,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4,3.25,2.5,1.75,1,-0.5,-2,-5,4,4.75,5.5,6.25,7,8.5,10,13,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,8,7.25,6.5,5.75,5,3.5,2,-1,8,8.75,9.5,10.25,11,12.5,14,17,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1,-0.25,0.5,1.25,2,3.5,5,8,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,1,0,-1,-2,-3,-5,-7,-11,1,2,3,4,5,7,9,13,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4,3,2,1,0,-2,-4,-8,4,5,6,7,8,10,12,16,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,8,7,6,5,4,2,0,-4,8,9,10,11,12,14,16,20,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-1,-2,-3,-4,-5,-7,-9,-13,-1,0,1,2,3,5,7,11,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4,-5,-6,-7,-8,-10,-12,-16,-4,-3,-2,-1,0,2,4,8,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-8,-9,-10,-11,-12,-14,-16,-20,-8,-7,-6,-5,-4,-2,0,4,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,1,-0.5,-2,-3.5,-5,-8,-11,-17,1,2.5,4,5.5,7,10,13,19,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4,2.5,1,-0.5,-2,-5,-8,-14,4,5.5,7,8.5,10,13,16,22,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,8,6.5,5,3.5,2,-1,-4,-10,8,9.5,11,12.5,14,17,20,26,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1,0.5,2,3.5,5,8,11,17,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-4,-2.5,-1,0.5,2,5,8,14,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-8,-6.5,-5,-3.5,-2,1,4,10,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,1,-1,-3,-5,-7,-11,-15,-23,1,3,5,7,9,13,17,25,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4,2,0,-2,-4,-8,-12,-20,4,6,8,10,12,16,20,28,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,8,6,4,2,0,-4,-8,-16,8,10,12,14,16,20,24,32,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-1,-3,-5,-7,-9,-13,-17,-25,-1,1,3,5,7,11,15,23,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4,-6,-8,-10,-12,-16,-20,-28,-4,-2,0,2,4,8,12,20,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-8,-10,-12,-14,-16,-20,-24,-32,-8,-6,-4,-2,0,4,8,16,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,1,-2,-5,-8,-11,-17,-23,-35,1,4,7,10,13,19,25,37,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4,1,-2,-5,-8,-14,-20,-32,4,7,10,13,16,22,28,40,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,8,5,2,-1,-4,-10,-16,-28,8,11,14,17,20,26,32,44,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-1,-4,-7,-10,-13,-19,-25,-37,-1,2,5,8,11,17,23,35,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4,-7,-10,-13,-16,-22,-28,-40,-4,-1,2,5,8,14,20,32,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-8,-11,-14,-17,-20,-26,-32,-44,-8,-5,-2,1,4,10,16,28,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4.5,4.75,5,5.25,5.5,6,6.5,7.5,4.5,4.25,4,3.75,3.5,3,2.5,1.5,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,9,9.25,9.5,9.75,10,10.5,11,12,9,8.75,8.5,8.25,8,7.5,7,6,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,18,18.25,18.5,18.75,19,19.5,20,21,18,17.75,17.5,17.25,17,16.5,16,15,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4.5,5,5.5,6,6.5,7.5,8.5,10.5,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,9,9.5,10,10.5,11,12,13,15,9,8.5,8,7.5,7,6,5,3,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,18,18.5,19,19.5,20,21,22,24,18,17.5,17,16.5,16,15,14,12,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-18,-17.5,-17,-16.5,-16,-15,-14,-12,-18,-18.5,-19,-19.5,-20,-21,-22,-24,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4.5,5.25,6,6.75,7.5,9,10.5,13.5,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,9,9.75,10.5,11.25,12,13.5,15,18,9,8.25,7.5,6.75,6,4.5,3,0,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,18,18.75,19.5,20.25,21,22.5,24,27,18,17.25,16.5,15.75,15,13.5,12,9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,9,10,11,12,13,15,17,21,9,8,7,6,5,3,1,-3,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,18,19,20,21,22,24,26,30,18,17,16,15,14,12,10,6,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-9,-8,-7,-6,-5,-3,-1,3,-9,-10,-11,-12,-13,-15,-17,-21,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,-18,-17,-16,-15,-14,-12,-10,-6,-18,-19,-20,-21,-22,-24,-26,-30,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4.5,6,7.5,9,10.5,13.5,16.5,22.5,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,9,10.5,12,13.5,15,18,21,27,9,7.5,6,4.5,3,0,-3,-9,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,18,19.5,21,22.5,24,27,30,36,18,16.5,15,13.5,12,9,6,0,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-9,-7.5,-6,-4.5,-3,0,3,9,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-18,-16.5,-15,-13.5,-12,-9,-6,0,-18,-19.5,-21,-22.5,-24,-27,-30,-36,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,9,11,13,15,17,21,25,33,9,7,5,3,1,-3,-7,-15,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,18,20,22,24,26,30,34,42,18,16,14,12,10,6,2,-6,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-9,-7,-5,-3,-1,3,7,15,-9,-11,-13,-15,-17,-21,-25,-33,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,-18,-16,-14,-12,-10,-6,-2,6,-18,-20,-22,-24,-26,-30,-34,-42,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,9,12,15,18,21,27,33,45,9,6,3,0,-3,-9,-15,-27,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,18,21,24,27,30,36,42,54,18,15,12,9,6,0,-6,-18,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-9,-6,-3,0,3,9,15,27,-9,-12,-15,-18,-21,-27,-33,-45,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,-18,-15,-12,-9,-6,0,6,18,-18,-21,-24,-27,-30,-36,-42,-54,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4.5,4.25,4,3.75,3.5,3,2.5,1.5,4.5,4.75,5,5.25,5.5,6,6.5,7.5,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,9,8.75,8.5,8.25,8,7.5,7,6,9,9.25,9.5,9.75,10,10.5,11,12,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,18,17.75,17.5,17.25,17,16.5,16,15,18,18.25,18.5,18.75,19,19.5,20,21,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,4.5,5,5.5,6,6.5,7.5,8.5,10.5,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,9,8.5,8,7.5,7,6,5,3,9,9.5,10,10.5,11,12,13,15,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,18,17.5,17,16.5,16,15,14,12,18,18.5,19,19.5,20,21,22,24,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-18,-18.5,-19,-19.5,-20,-21,-22,-24,-18,-17.5,-17,-16.5,-16,-15,-14,-12,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,4.5,5.25,6,6.75,7.5,9,10.5,13.5,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,9,8.25,7.5,6.75,6,4.5,3,0,9,9.75,10.5,11.25,12,13.5,15,18,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,18,17.25,16.5,15.75,15,13.5,12,9,18,18.75,19.5,20.25,21,22.5,24,27,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,9,8,7,6,5,3,1,-3,9,10,11,12,13,15,17,21,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,18,17,16,15,14,12,10,6,18,19,20,21,22,24,26,30,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-9,-10,-11,-12,-13,-15,-17,-21,-9,-8,-7,-6,-5,-3,-1,3,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,-18,-19,-20,-21,-22,-24,-26,-30,-18,-17,-16,-15,-14,-12,-10,-6,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,4.5,6,7.5,9,10.5,13.5,16.5,22.5,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,9,7.5,6,4.5,3,0,-3,-9,9,10.5,12,13.5,15,18,21,27,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,18,16.5,15,13.5,12,9,6,0,18,19.5,21,22.5,24,27,30,36,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-9,-7.5,-6,-4.5,-3,0,3,9,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,-18,-19.5,-21,-22.5,-24,-27,-30,-36,-18,-16.5,-15,-13.5,-12,-9,-6,0,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,9,7,5,3,1,-3,-7,-15,9,11,13,15,17,21,25,33,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,18,16,14,12,10,6,2,-6,18,20,22,24,26,30,34,42,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-9,-11,-13,-15,-17,-21,-25,-33,-9,-7,-5,-3,-1,3,7,15,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,-18,-20,-22,-24,-26,-30,-34,-42,-18,-16,-14,-12,-10,-6,-2,6,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,9,6,3,0,-3,-9,-15,-27,9,12,15,18,21,27,33,45,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,18,15,12,9,6,0,-6,-18,18,21,24,27,30,36,42,54,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-9,-12,-15,-18,-21,-27,-33,-45,-9,-6,-3,0,3,9,15,27,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,-18,-21,-24,-27,-30,-36,-42,-54,-18,-15,-12,-9,-6,0,6,18,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,4,4.25,4.5,4.75,5,5.5,6,7,4,3.75,3.5,3.25,3,2.5,2,1,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,8,8.25,8.5,8.75,9,9.5,10,11,8,7.75,7.5,7.25,7,6.5,6,5,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,16,16.25,16.5,16.75,17,17.5,18,19,16,15.75,15.5,15.25,15,14.5,14,13,24,24.25,24.5,24.75,25,25.5,26,27,24,23.75,23.5,23.25,23,22.5,22,21,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-16,-15.75,-15.5,-15.25,-15,-14.5,-14,-13,-16,-16.25,-16.5,-16.75,-17,-17.5,-18,-19,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,4,4.5,5,5.5,6,7,8,10,4,3.5,3,2.5,2,1,0,-2,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,8,8.5,9,9.5,10,11,12,14,8,7.5,7,6.5,6,5,4,2,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,16,16.5,17,17.5,18,19,20,22,16,15.5,15,14.5,14,13,12,10,24,24.5,25,25.5,26,27,28,30,24,23.5,23,22.5,22,21,20,18,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-4,-3.5,-3,-2.5,-2,-1,0,2,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-16,-15.5,-15,-14.5,-14,-13,-12,-10,-16,-16.5,-17,-17.5,-18,-19,-20,-22,-24,-23.5,-23,-22.5,-22,-21,-20,-18,-24,-24.5,-25,-25.5,-26,-27,-28,-30,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,4,4.75,5.5,6.25,7,8.5,10,13,4,3.25,2.5,1.75,1,-0.5,-2,-5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,8,8.75,9.5,10.25,11,12.5,14,17,8,7.25,6.5,5.75,5,3.5,2,-1,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,16,16.75,17.5,18.25,19,20.5,22,25,16,15.25,14.5,13.75,13,11.5,10,7,24,24.75,25.5,26.25,27,28.5,30,33,24,23.25,22.5,21.75,21,19.5,18,15,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-16,-15.25,-14.5,-13.75,-13,-11.5,-10,-7,-16,-16.75,-17.5,-18.25,-19,-20.5,-22,-25,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,4,5,6,7,8,10,12,16,4,3,2,1,0,-2,-4,-8,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,8,9,10,11,12,14,16,20,8,7,6,5,4,2,0,-4,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,16,17,18,19,20,22,24,28,16,15,14,13,12,10,8,4,24,25,26,27,28,30,32,36,24,23,22,21,20,18,16,12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-4,-3,-2,-1,0,2,4,8,-4,-5,-6,-7,-8,-10,-12,-16,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-8,-7,-6,-5,-4,-2,0,4,-8,-9,-10,-11,-12,-14,-16,-20,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,-16,-15,-14,-13,-12,-10,-8,-4,-16,-17,-18,-19,-20,-22,-24,-28,-24,-23,-22,-21,-20,-18,-16,-12,-24,-25,-26,-27,-28,-30,-32,-36,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,4,5.5,7,8.5,10,13,16,22,4,2.5,1,-0.5,-2,-5,-8,-14,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,8,9.5,11,12.5,14,17,20,26,8,6.5,5,3.5,2,-1,-4,-10,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,16,17.5,19,20.5,22,25,28,34,16,14.5,13,11.5,10,7,4,-2,24,25.5,27,28.5,30,33,36,42,24,22.5,21,19.5,18,15,12,6,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-4,-2.5,-1,0.5,2,5,8,14,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-8,-6.5,-5,-3.5,-2,1,4,10,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-16,-14.5,-13,-11.5,-10,-7,-4,2,-16,-17.5,-19,-20.5,-22,-25,-28,-34,-24,-22.5,-21,-19.5,-18,-15,-12,-6,-24,-25.5,-27,-28.5,-30,-33,-36,-42,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,4,6,8,10,12,16,20,28,4,2,0,-2,-4,-8,-12,-20,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,8,10,12,14,16,20,24,32,8,6,4,2,0,-4,-8,-16,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,16,18,20,22,24,28,32,40,16,14,12,10,8,4,0,-8,24,26,28,30,32,36,40,48,24,22,20,18,16,12,8,0,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-4,-2,0,2,4,8,12,20,-4,-6,-8,-10,-12,-16,-20,-28,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-8,-6,-4,-2,0,4,8,16,-8,-10,-12,-14,-16,-20,-24,-32,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,-16,-14,-12,-10,-8,-4,0,8,-16,-18,-20,-22,-24,-28,-32,-40,-24,-22,-20,-18,-16,-12,-8,0,-24,-26,-28,-30,-32,-36,-40,-48,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,4,7,10,13,16,22,28,40,4,1,-2,-5,-8,-14,-20,-32,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,8,11,14,17,20,26,32,44,8,5,2,-1,-4,-10,-16,-28,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,16,19,22,25,28,34,40,52,16,13,10,7,4,-2,-8,-20,24,27,30,33,36,42,48,60,24,21,18,15,12,6,0,-12,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-4,-1,2,5,8,14,20,32,-4,-7,-10,-13,-16,-22,-28,-40,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-8,-5,-2,1,4,10,16,28,-8,-11,-14,-17,-20,-26,-32,-44,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,-16,-13,-10,-7,-4,2,8,20,-16,-19,-22,-25,-28,-34,-40,-52,-24,-21,-18,-15,-12,-6,0,12,-24,-27,-30,-33,-36,-42,-48,-60,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,4,3.75,3.5,3.25,3,2.5,2,1,4,4.25,4.5,4.75,5,5.5,6,7,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,8,7.75,7.5,7.25,7,6.5,6,5,8,8.25,8.5,8.75,9,9.5,10,11,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,16,15.75,15.5,15.25,15,14.5,14,13,16,16.25,16.5,16.75,17,17.5,18,19,24,23.75,23.5,23.25,23,22.5,22,21,24,24.25,24.5,24.75,25,25.5,26,27,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-16,-16.25,-16.5,-16.75,-17,-17.5,-18,-19,-16,-15.75,-15.5,-15.25,-15,-14.5,-14,-13,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,4,3.5,3,2.5,2,1,0,-2,4,4.5,5,5.5,6,7,8,10,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,8,7.5,7,6.5,6,5,4,2,8,8.5,9,9.5,10,11,12,14,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,16,15.5,15,14.5,14,13,12,10,16,16.5,17,17.5,18,19,20,22,24,23.5,23,22.5,22,21,20,18,24,24.5,25,25.5,26,27,28,30,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-4,-3.5,-3,-2.5,-2,-1,0,2,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-16,-16.5,-17,-17.5,-18,-19,-20,-22,-16,-15.5,-15,-14.5,-14,-13,-12,-10,-24,-24.5,-25,-25.5,-26,-27,-28,-30,-24,-23.5,-23,-22.5,-22,-21,-20,-18,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,4,3.25,2.5,1.75,1,-0.5,-2,-5,4,4.75,5.5,6.25,7,8.5,10,13,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,8,7.25,6.5,5.75,5,3.5,2,-1,8,8.75,9.5,10.25,11,12.5,14,17,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,16,15.25,14.5,13.75,13,11.5,10,7,16,16.75,17.5,18.25,19,20.5,22,25,24,23.25,22.5,21.75,21,19.5,18,15,24,24.75,25.5,26.25,27,28.5,30,33,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-16,-16.75,-17.5,-18.25,-19,-20.5,-22,-25,-16,-15.25,-14.5,-13.75,-13,-11.5,-10,-7,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,4,3,2,1,0,-2,-4,-8,4,5,6,7,8,10,12,16,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,8,7,6,5,4,2,0,-4,8,9,10,11,12,14,16,20,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,16,15,14,13,12,10,8,4,16,17,18,19,20,22,24,28,24,23,22,21,20,18,16,12,24,25,26,27,28,30,32,36,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-4,-5,-6,-7,-8,-10,-12,-16,-4,-3,-2,-1,0,2,4,8,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-8,-9,-10,-11,-12,-14,-16,-20,-8,-7,-6,-5,-4,-2,0,4,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,-16,-17,-18,-19,-20,-22,-24,-28,-16,-15,-14,-13,-12,-10,-8,-4,-24,-25,-26,-27,-28,-30,-32,-36,-24,-23,-22,-21,-20,-18,-16,-12,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,4,2.5,1,-0.5,-2,-5,-8,-14,4,5.5,7,8.5,10,13,16,22,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,8,6.5,5,3.5,2,-1,-4,-10,8,9.5,11,12.5,14,17,20,26,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,16,14.5,13,11.5,10,7,4,-2,16,17.5,19,20.5,22,25,28,34,24,22.5,21,19.5,18,15,12,6,24,25.5,27,28.5,30,33,36,42,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-4,-2.5,-1,0.5,2,5,8,14,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-8,-6.5,-5,-3.5,-2,1,4,10,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,-16,-17.5,-19,-20.5,-22,-25,-28,-34,-16,-14.5,-13,-11.5,-10,-7,-4,2,-24,-25.5,-27,-28.5,-30,-33,-36,-42,-24,-22.5,-21,-19.5,-18,-15,-12,-6,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,4,2,0,-2,-4,-8,-12,-20,4,6,8,10,12,16,20,28,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,8,6,4,2,0,-4,-8,-16,8,10,12,14,16,20,24,32,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,16,14,12,10,8,4,0,-8,16,18,20,22,24,28,32,40,24,22,20,18,16,12,8,0,24,26,28,30,32,36,40,48,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-4,-6,-8,-10,-12,-16,-20,-28,-4,-2,0,2,4,8,12,20,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-8,-10,-12,-14,-16,-20,-24,-32,-8,-6,-4,-2,0,4,8,16,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,-16,-18,-20,-22,-24,-28,-32,-40,-16,-14,-12,-10,-8,-4,0,8,-24,-26,-28,-30,-32,-36,-40,-48,-24,-22,-20,-18,-16,-12,-8,0,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,4,1,-2,-5,-8,-14,-20,-32,4,7,10,13,16,22,28,40,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,8,5,2,-1,-4,-10,-16,-28,8,11,14,17,20,26,32,44,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,16,13,10,7,4,-2,-8,-20,16,19,22,25,28,34,40,52,24,21,18,15,12,6,0,-12,24,27,30,33,36,42,48,60,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-4,-7,-10,-13,-16,-22,-28,-40,-4,-1,2,5,8,14,20,32,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-8,-11,-14,-17,-20,-26,-32,-44,-8,-5,-2,1,4,10,16,28,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,-16,-19,-22,-25,-28,-34,-40,-52,-16,-13,-10,-7,-4,2,8,20,-24,-27,-30,-33,-36,-42,-48,-60,-24,-21,-18,-15,-12,-6,0,12,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,9,9.25,9.5,9.75,10,10.5,11,12,9,8.75,8.5,8.25,8,7.5,7,6,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,18,18.25,18.5,18.75,19,19.5,20,21,18,17.75,17.5,17.25,17,16.5,16,15,24,24.25,24.5,24.75,25,25.5,26,27,24,23.75,23.5,23.25,23,22.5,22,21,36,36.25,36.5,36.75,37,37.5,38,39,36,35.75,35.5,35.25,35,34.5,34,33,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,-36,-35.75,-35.5,-35.25,-35,-34.5,-34,-33,-36,-36.25,-36.5,-36.75,-37,-37.5,-38,-39,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,9,9.5,10,10.5,11,12,13,15,9,8.5,8,7.5,7,6,5,3,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,18,18.5,19,19.5,20,21,22,24,18,17.5,17,16.5,16,15,14,12,24,24.5,25,25.5,26,27,28,30,24,23.5,23,22.5,22,21,20,18,36,36.5,37,37.5,38,39,40,42,36,35.5,35,34.5,34,33,32,30,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-18,-17.5,-17,-16.5,-16,-15,-14,-12,-18,-18.5,-19,-19.5,-20,-21,-22,-24,-24,-23.5,-23,-22.5,-22,-21,-20,-18,-24,-24.5,-25,-25.5,-26,-27,-28,-30,-36,-35.5,-35,-34.5,-34,-33,-32,-30,-36,-36.5,-37,-37.5,-38,-39,-40,-42,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,9,9.75,10.5,11.25,12,13.5,15,18,9,8.25,7.5,6.75,6,4.5,3,0,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,18,18.75,19.5,20.25,21,22.5,24,27,18,17.25,16.5,15.75,15,13.5,12,9,24,24.75,25.5,26.25,27,28.5,30,33,24,23.25,22.5,21.75,21,19.5,18,15,36,36.75,37.5,38.25,39,40.5,42,45,36,35.25,34.5,33.75,33,31.5,30,27,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,-36,-35.25,-34.5,-33.75,-33,-31.5,-30,-27,-36,-36.75,-37.5,-38.25,-39,-40.5,-42,-45,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,9,10,11,12,13,15,17,21,9,8,7,6,5,3,1,-3,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,18,19,20,21,22,24,26,30,18,17,16,15,14,12,10,6,24,25,26,27,28,30,32,36,24,23,22,21,20,18,16,12,36,37,38,39,40,42,44,48,36,35,34,33,32,30,28,24,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-9,-8,-7,-6,-5,-3,-1,3,-9,-10,-11,-12,-13,-15,-17,-21,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,-18,-17,-16,-15,-14,-12,-10,-6,-18,-19,-20,-21,-22,-24,-26,-30,-24,-23,-22,-21,-20,-18,-16,-12,-24,-25,-26,-27,-28,-30,-32,-36,-36,-35,-34,-33,-32,-30,-28,-24,-36,-37,-38,-39,-40,-42,-44,-48,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,9,10.5,12,13.5,15,18,21,27,9,7.5,6,4.5,3,0,-3,-9,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,18,19.5,21,22.5,24,27,30,36,18,16.5,15,13.5,12,9,6,0,24,25.5,27,28.5,30,33,36,42,24,22.5,21,19.5,18,15,12,6,36,37.5,39,40.5,42,45,48,54,36,34.5,33,31.5,30,27,24,18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-9,-7.5,-6,-4.5,-3,0,3,9,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-18,-16.5,-15,-13.5,-12,-9,-6,0,-18,-19.5,-21,-22.5,-24,-27,-30,-36,-24,-22.5,-21,-19.5,-18,-15,-12,-6,-24,-25.5,-27,-28.5,-30,-33,-36,-42,-36,-34.5,-33,-31.5,-30,-27,-24,-18,-36,-37.5,-39,-40.5,-42,-45,-48,-54,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,9,11,13,15,17,21,25,33,9,7,5,3,1,-3,-7,-15,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,18,20,22,24,26,30,34,42,18,16,14,12,10,6,2,-6,24,26,28,30,32,36,40,48,24,22,20,18,16,12,8,0,36,38,40,42,44,48,52,60,36,34,32,30,28,24,20,12,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-9,-7,-5,-3,-1,3,7,15,-9,-11,-13,-15,-17,-21,-25,-33,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,-18,-16,-14,-12,-10,-6,-2,6,-18,-20,-22,-24,-26,-30,-34,-42,-24,-22,-20,-18,-16,-12,-8,0,-24,-26,-28,-30,-32,-36,-40,-48,-36,-34,-32,-30,-28,-24,-20,-12,-36,-38,-40,-42,-44,-48,-52,-60,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,9,12,15,18,21,27,33,45,9,6,3,0,-3,-9,-15,-27,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,18,21,24,27,30,36,42,54,18,15,12,9,6,0,-6,-18,24,27,30,33,36,42,48,60,24,21,18,15,12,6,0,-12,36,39,42,45,48,54,60,72,36,33,30,27,24,18,12,0,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-9,-6,-3,0,3,9,15,27,-9,-12,-15,-18,-21,-27,-33,-45,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,-18,-15,-12,-9,-6,0,6,18,-18,-21,-24,-27,-30,-36,-42,-54,-24,-21,-18,-15,-12,-6,0,12,-24,-27,-30,-33,-36,-42,-48,-60,-36,-33,-30,-27,-24,-18,-12,0,-36,-39,-42,-45,-48,-54,-60,-72,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,9,8.75,8.5,8.25,8,7.5,7,6,9,9.25,9.5,9.75,10,10.5,11,12,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,18,17.75,17.5,17.25,17,16.5,16,15,18,18.25,18.5,18.75,19,19.5,20,21,24,23.75,23.5,23.25,23,22.5,22,21,24,24.25,24.5,24.75,25,25.5,26,27,36,35.75,35.5,35.25,35,34.5,34,33,36,36.25,36.5,36.75,37,37.5,38,39,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,-36,-36.25,-36.5,-36.75,-37,-37.5,-38,-39,-36,-35.75,-35.5,-35.25,-35,-34.5,-34,-33,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,9,8.5,8,7.5,7,6,5,3,9,9.5,10,10.5,11,12,13,15,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,18,17.5,17,16.5,16,15,14,12,18,18.5,19,19.5,20,21,22,24,24,23.5,23,22.5,22,21,20,18,24,24.5,25,25.5,26,27,28,30,36,35.5,35,34.5,34,33,32,30,36,36.5,37,37.5,38,39,40,42,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-18,-18.5,-19,-19.5,-20,-21,-22,-24,-18,-17.5,-17,-16.5,-16,-15,-14,-12,-24,-24.5,-25,-25.5,-26,-27,-28,-30,-24,-23.5,-23,-22.5,-22,-21,-20,-18,-36,-36.5,-37,-37.5,-38,-39,-40,-42,-36,-35.5,-35,-34.5,-34,-33,-32,-30,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,9,8.25,7.5,6.75,6,4.5,3,0,9,9.75,10.5,11.25,12,13.5,15,18,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,18,17.25,16.5,15.75,15,13.5,12,9,18,18.75,19.5,20.25,21,22.5,24,27,24,23.25,22.5,21.75,21,19.5,18,15,24,24.75,25.5,26.25,27,28.5,30,33,36,35.25,34.5,33.75,33,31.5,30,27,36,36.75,37.5,38.25,39,40.5,42,45,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,-36,-36.75,-37.5,-38.25,-39,-40.5,-42,-45,-36,-35.25,-34.5,-33.75,-33,-31.5,-30,-27,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,9,8,7,6,5,3,1,-3,9,10,11,12,13,15,17,21,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,18,17,16,15,14,12,10,6,18,19,20,21,22,24,26,30,24,23,22,21,20,18,16,12,24,25,26,27,28,30,32,36,36,35,34,33,32,30,28,24,36,37,38,39,40,42,44,48,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-9,-10,-11,-12,-13,-15,-17,-21,-9,-8,-7,-6,-5,-3,-1,3,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,-18,-19,-20,-21,-22,-24,-26,-30,-18,-17,-16,-15,-14,-12,-10,-6,-24,-25,-26,-27,-28,-30,-32,-36,-24,-23,-22,-21,-20,-18,-16,-12,-36,-37,-38,-39,-40,-42,-44,-48,-36,-35,-34,-33,-32,-30,-28,-24,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,9,7.5,6,4.5,3,0,-3,-9,9,10.5,12,13.5,15,18,21,27,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,18,16.5,15,13.5,12,9,6,0,18,19.5,21,22.5,24,27,30,36,24,22.5,21,19.5,18,15,12,6,24,25.5,27,28.5,30,33,36,42,36,34.5,33,31.5,30,27,24,18,36,37.5,39,40.5,42,45,48,54,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-9,-7.5,-6,-4.5,-3,0,3,9,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,-18,-19.5,-21,-22.5,-24,-27,-30,-36,-18,-16.5,-15,-13.5,-12,-9,-6,0,-24,-25.5,-27,-28.5,-30,-33,-36,-42,-24,-22.5,-21,-19.5,-18,-15,-12,-6,-36,-37.5,-39,-40.5,-42,-45,-48,-54,-36,-34.5,-33,-31.5,-30,-27,-24,-18,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,9,7,5,3,1,-3,-7,-15,9,11,13,15,17,21,25,33,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,18,16,14,12,10,6,2,-6,18,20,22,24,26,30,34,42,24,22,20,18,16,12,8,0,24,26,28,30,32,36,40,48,36,34,32,30,28,24,20,12,36,38,40,42,44,48,52,60,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-9,-11,-13,-15,-17,-21,-25,-33,-9,-7,-5,-3,-1,3,7,15,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,-18,-20,-22,-24,-26,-30,-34,-42,-18,-16,-14,-12,-10,-6,-2,6,-24,-26,-28,-30,-32,-36,-40,-48,-24,-22,-20,-18,-16,-12,-8,0,-36,-38,-40,-42,-44,-48,-52,-60,-36,-34,-32,-30,-28,-24,-20,-12,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,9,6,3,0,-3,-9,-15,-27,9,12,15,18,21,27,33,45,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,18,15,12,9,6,0,-6,-18,18,21,24,27,30,36,42,54,24,21,18,15,12,6,0,-12,24,27,30,33,36,42,48,60,36,33,30,27,24,18,12,0,36,39,42,45,48,54,60,72,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-9,-12,-15,-18,-21,-27,-33,-45,-9,-6,-3,0,3,9,15,27,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,-18,-21,-24,-27,-30,-36,-42,-54,-18,-15,-12,-9,-6,0,6,18,-24,-27,-30,-33,-36,-42,-48,-60,-24,-21,-18,-15,-12,-6,0,12,-36,-39,-42,-45,-48,-54,-60,-72,-36,-33,-30,-27,-24,-18,-12,0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-0.25,0,0.25,0.5,0.75,1.25,1.75,2.75,-0.25,-0.5,-0.75,-1,-1.25,-1.75,-2.25,-3.25,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0.25,0.5,0.75,1,1.25,1.75,2.25,3.25,0.25,0,-0.25,-0.5,-0.75,-1.25,-1.75,-2.75,0.5,0.75,1,1.25,1.5,2,2.5,3.5,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,1,1.25,1.5,1.75,2,2.5,3,4,1,0.75,0.5,0.25,0,-0.5,-1,-2,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-0.25,0.25,0.75,1.25,1.75,2.75,3.75,5.75,-0.25,-0.75,-1.25,-1.75,-2.25,-3.25,-4.25,-6.25,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-1,-0.5,0,0.5,1,2,3,5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0.25,0.75,1.25,1.75,2.25,3.25,4.25,6.25,0.25,-0.25,-0.75,-1.25,-1.75,-2.75,-3.75,-5.75,0.5,1,1.5,2,2.5,3.5,4.5,6.5,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,1,1.5,2,2.5,3,4,5,7,1,0.5,0,-0.5,-1,-2,-3,-5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-0.25,0.5,1.25,2,2.75,4.25,5.75,8.75,-0.25,-1,-1.75,-2.5,-3.25,-4.75,-6.25,-9.25,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-1,-0.25,0.5,1.25,2,3.5,5,8,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0.25,1,1.75,2.5,3.25,4.75,6.25,9.25,0.25,-0.5,-1.25,-2,-2.75,-4.25,-5.75,-8.75,0.5,1.25,2,2.75,3.5,5,6.5,9.5,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,1,1.75,2.5,3.25,4,5.5,7,10,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-0.25,0.75,1.75,2.75,3.75,5.75,7.75,11.75,-0.25,-1.25,-2.25,-3.25,-4.25,-6.25,-8.25,-12.25,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-1,0,1,2,3,5,7,11,-1,-2,-3,-4,-5,-7,-9,-13,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0.25,1.25,2.25,3.25,4.25,6.25,8.25,12.25,0.25,-0.75,-1.75,-2.75,-3.75,-5.75,-7.75,-11.75,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,1,2,3,4,5,7,9,13,1,0,-1,-2,-3,-5,-7,-11,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-0.25,1.25,2.75,4.25,5.75,8.75,11.75,17.75,-0.25,-1.75,-3.25,-4.75,-6.25,-9.25,-12.25,-18.25,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-1,0.5,2,3.5,5,8,11,17,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0.25,1.75,3.25,4.75,6.25,9.25,12.25,18.25,0.25,-1.25,-2.75,-4.25,-5.75,-8.75,-11.75,-17.75,0.5,2,3.5,5,6.5,9.5,12.5,18.5,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,1,2.5,4,5.5,7,10,13,19,1,-0.5,-2,-3.5,-5,-8,-11,-17,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-0.25,1.75,3.75,5.75,7.75,11.75,15.75,23.75,-0.25,-2.25,-4.25,-6.25,-8.25,-12.25,-16.25,-24.25,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-1,1,3,5,7,11,15,23,-1,-3,-5,-7,-9,-13,-17,-25,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0.25,2.25,4.25,6.25,8.25,12.25,16.25,24.25,0.25,-1.75,-3.75,-5.75,-7.75,-11.75,-15.75,-23.75,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,1,3,5,7,9,13,17,25,1,-1,-3,-5,-7,-11,-15,-23,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-0.25,2.75,5.75,8.75,11.75,17.75,23.75,35.75,-0.25,-3.25,-6.25,-9.25,-12.25,-18.25,-24.25,-36.25,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-1,2,5,8,11,17,23,35,-1,-4,-7,-10,-13,-19,-25,-37,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0.25,3.25,6.25,9.25,12.25,18.25,24.25,36.25,0.25,-2.75,-5.75,-8.75,-11.75,-17.75,-23.75,-35.75,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,1,4,7,10,13,19,25,37,1,-2,-5,-8,-11,-17,-23,-35,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.25,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.25,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0.25,-0.5,-0.75,-1,-1.25,-1.75,-2.25,-3.25,-0.25,0,0.25,0.5,0.75,1.25,1.75,2.75,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0.25,0,-0.25,-0.5,-0.75,-1.25,-1.75,-2.75,0.25,0.5,0.75,1,1.25,1.75,2.25,3.25,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,0.5,0.75,1,1.25,1.5,2,2.5,3.5,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,1,0.75,0.5,0.25,0,-0.5,-1,-2,1,1.25,1.5,1.75,2,2.5,3,4,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0.25,-0.75,-1.25,-1.75,-2.25,-3.25,-4.25,-6.25,-0.25,0.25,0.75,1.25,1.75,2.75,3.75,5.75,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1,-0.5,0,0.5,1,2,3,5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0.25,-0.25,-0.75,-1.25,-1.75,-2.75,-3.75,-5.75,0.25,0.75,1.25,1.75,2.25,3.25,4.25,6.25,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,0.5,1,1.5,2,2.5,3.5,4.5,6.5,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,1,0.5,0,-0.5,-1,-2,-3,-5,1,1.5,2,2.5,3,4,5,7,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0.25,-1,-1.75,-2.5,-3.25,-4.75,-6.25,-9.25,-0.25,0.5,1.25,2,2.75,4.25,5.75,8.75,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1,-0.25,0.5,1.25,2,3.5,5,8,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0.25,-0.5,-1.25,-2,-2.75,-4.25,-5.75,-8.75,0.25,1,1.75,2.5,3.25,4.75,6.25,9.25,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,0.5,1.25,2,2.75,3.5,5,6.5,9.5,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1,1.75,2.5,3.25,4,5.5,7,10,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0.25,-1.25,-2.25,-3.25,-4.25,-6.25,-8.25,-12.25,-0.25,0.75,1.75,2.75,3.75,5.75,7.75,11.75,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-1,-2,-3,-4,-5,-7,-9,-13,-1,0,1,2,3,5,7,11,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0.25,-0.75,-1.75,-2.75,-3.75,-5.75,-7.75,-11.75,0.25,1.25,2.25,3.25,4.25,6.25,8.25,12.25,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,1,0,-1,-2,-3,-5,-7,-11,1,2,3,4,5,7,9,13,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0.25,-1.75,-3.25,-4.75,-6.25,-9.25,-12.25,-18.25,-0.25,1.25,2.75,4.25,5.75,8.75,11.75,17.75,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1,0.5,2,3.5,5,8,11,17,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0.25,-1.25,-2.75,-4.25,-5.75,-8.75,-11.75,-17.75,0.25,1.75,3.25,4.75,6.25,9.25,12.25,18.25,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,0.5,2,3.5,5,6.5,9.5,12.5,18.5,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,1,-0.5,-2,-3.5,-5,-8,-11,-17,1,2.5,4,5.5,7,10,13,19,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0.25,-2.25,-4.25,-6.25,-8.25,-12.25,-16.25,-24.25,-0.25,1.75,3.75,5.75,7.75,11.75,15.75,23.75,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-1,-3,-5,-7,-9,-13,-17,-25,-1,1,3,5,7,11,15,23,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0.25,-1.75,-3.75,-5.75,-7.75,-11.75,-15.75,-23.75,0.25,2.25,4.25,6.25,8.25,12.25,16.25,24.25,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,1,-1,-3,-5,-7,-11,-15,-23,1,3,5,7,9,13,17,25,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0.25,-3.25,-6.25,-9.25,-12.25,-18.25,-24.25,-36.25,-0.25,2.75,5.75,8.75,11.75,17.75,23.75,35.75,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-1,-4,-7,-10,-13,-19,-25,-37,-1,2,5,8,11,17,23,35,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0.25,-2.75,-5.75,-8.75,-11.75,-17.75,-23.75,-35.75,0.25,3.25,6.25,9.25,12.25,18.25,24.25,36.25,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,1,-2,-5,-8,-11,-17,-23,-35,1,4,7,10,13,19,25,37,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0.5,0.75,1,1.25,1.5,2,2.5,3.5,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,1,1.25,1.5,1.75,2,2.5,3,4,1,0.75,0.5,0.25,0,-0.5,-1,-2,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4,4.25,4.5,4.75,5,5.5,6,7,4,3.75,3.5,3.25,3,2.5,2,1,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-1,-0.5,0,0.5,1,2,3,5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4,-3.5,-3,-2.5,-2,-1,0,2,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0.5,1,1.5,2,2.5,3.5,4.5,6.5,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,1,1.5,2,2.5,3,4,5,7,1,0.5,0,-0.5,-1,-2,-3,-5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4,4.5,5,5.5,6,7,8,10,4,3.5,3,2.5,2,1,0,-2,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-1,-0.25,0.5,1.25,2,3.5,5,8,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0.5,1.25,2,2.75,3.5,5,6.5,9.5,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,1,1.75,2.5,3.25,4,5.5,7,10,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4,4.75,5.5,6.25,7,8.5,10,13,4,3.25,2.5,1.75,1,-0.5,-2,-5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-1,0,1,2,3,5,7,11,-1,-2,-3,-4,-5,-7,-9,-13,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4,-3,-2,-1,0,2,4,8,-4,-5,-6,-7,-8,-10,-12,-16,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,1,2,3,4,5,7,9,13,1,0,-1,-2,-3,-5,-7,-11,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4,5,6,7,8,10,12,16,4,3,2,1,0,-2,-4,-8,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-1,0.5,2,3.5,5,8,11,17,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4,-2.5,-1,0.5,2,5,8,14,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0.5,2,3.5,5,6.5,9.5,12.5,18.5,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,1,2.5,4,5.5,7,10,13,19,1,-0.5,-2,-3.5,-5,-8,-11,-17,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4,5.5,7,8.5,10,13,16,22,4,2.5,1,-0.5,-2,-5,-8,-14,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-1,1,3,5,7,11,15,23,-1,-3,-5,-7,-9,-13,-17,-25,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4,-2,0,2,4,8,12,20,-4,-6,-8,-10,-12,-16,-20,-28,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,1,3,5,7,9,13,17,25,1,-1,-3,-5,-7,-11,-15,-23,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4,6,8,10,12,16,20,28,4,2,0,-2,-4,-8,-12,-20,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-1,2,5,8,11,17,23,35,-1,-4,-7,-10,-13,-19,-25,-37,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4,-1,2,5,8,14,20,32,-4,-7,-10,-13,-16,-22,-28,-40,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,1,4,7,10,13,19,25,37,1,-2,-5,-8,-11,-17,-23,-35,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4,7,10,13,16,22,28,40,4,1,-2,-5,-8,-14,-20,-32,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-0.5,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,0.5,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0.5,-0.75,-1,-1.25,-1.5,-2,-2.5,-3.5,-0.5,-0.25,0,0.25,0.5,1,1.5,2.5,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0.5,0.25,0,-0.25,-0.5,-1,-1.5,-2.5,0.5,0.75,1,1.25,1.5,2,2.5,3.5,1,0.75,0.5,0.25,0,-0.5,-1,-2,1,1.25,1.5,1.75,2,2.5,3,4,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4,3.75,3.5,3.25,3,2.5,2,1,4,4.25,4.5,4.75,5,5.5,6,7,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0.5,-1,-1.5,-2,-2.5,-3.5,-4.5,-6.5,-0.5,0,0.5,1,1.5,2.5,3.5,5.5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1,-0.5,0,0.5,1,2,3,5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-4,-3.5,-3,-2.5,-2,-1,0,2,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0.5,0,-0.5,-1,-1.5,-2.5,-3.5,-5.5,0.5,1,1.5,2,2.5,3.5,4.5,6.5,1,0.5,0,-0.5,-1,-2,-3,-5,1,1.5,2,2.5,3,4,5,7,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4,3.5,3,2.5,2,1,0,-2,4,4.5,5,5.5,6,7,8,10,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0.5,-1.25,-2,-2.75,-3.5,-5,-6.5,-9.5,-0.5,0.25,1,1.75,2.5,4,5.5,8.5,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1,-0.25,0.5,1.25,2,3.5,5,8,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0.5,-0.25,-1,-1.75,-2.5,-4,-5.5,-8.5,0.5,1.25,2,2.75,3.5,5,6.5,9.5,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1,1.75,2.5,3.25,4,5.5,7,10,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4,3.25,2.5,1.75,1,-0.5,-2,-5,4,4.75,5.5,6.25,7,8.5,10,13,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0.5,-1.5,-2.5,-3.5,-4.5,-6.5,-8.5,-12.5,-0.5,0.5,1.5,2.5,3.5,5.5,7.5,11.5,-1,-2,-3,-4,-5,-7,-9,-13,-1,0,1,2,3,5,7,11,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4,-5,-6,-7,-8,-10,-12,-16,-4,-3,-2,-1,0,2,4,8,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0.5,-0.5,-1.5,-2.5,-3.5,-5.5,-7.5,-11.5,0.5,1.5,2.5,3.5,4.5,6.5,8.5,12.5,1,0,-1,-2,-3,-5,-7,-11,1,2,3,4,5,7,9,13,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4,3,2,1,0,-2,-4,-8,4,5,6,7,8,10,12,16,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0.5,-2,-3.5,-5,-6.5,-9.5,-12.5,-18.5,-0.5,1,2.5,4,5.5,8.5,11.5,17.5,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1,0.5,2,3.5,5,8,11,17,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-4,-2.5,-1,0.5,2,5,8,14,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0.5,-1,-2.5,-4,-5.5,-8.5,-11.5,-17.5,0.5,2,3.5,5,6.5,9.5,12.5,18.5,1,-0.5,-2,-3.5,-5,-8,-11,-17,1,2.5,4,5.5,7,10,13,19,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4,2.5,1,-0.5,-2,-5,-8,-14,4,5.5,7,8.5,10,13,16,22,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0.5,-2.5,-4.5,-6.5,-8.5,-12.5,-16.5,-24.5,-0.5,1.5,3.5,5.5,7.5,11.5,15.5,23.5,-1,-3,-5,-7,-9,-13,-17,-25,-1,1,3,5,7,11,15,23,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4,-6,-8,-10,-12,-16,-20,-28,-4,-2,0,2,4,8,12,20,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0.5,-1.5,-3.5,-5.5,-7.5,-11.5,-15.5,-23.5,0.5,2.5,4.5,6.5,8.5,12.5,16.5,24.5,1,-1,-3,-5,-7,-11,-15,-23,1,3,5,7,9,13,17,25,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4,2,0,-2,-4,-8,-12,-20,4,6,8,10,12,16,20,28,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0.5,-3.5,-6.5,-9.5,-12.5,-18.5,-24.5,-36.5,-0.5,2.5,5.5,8.5,11.5,17.5,23.5,35.5,-1,-4,-7,-10,-13,-19,-25,-37,-1,2,5,8,11,17,23,35,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4,-7,-10,-13,-16,-22,-28,-40,-4,-1,2,5,8,14,20,32,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0.5,-2.5,-5.5,-8.5,-11.5,-17.5,-23.5,-35.5,0.5,3.5,6.5,9.5,12.5,18.5,24.5,36.5,1,-2,-5,-8,-11,-17,-23,-35,1,4,7,10,13,19,25,37,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4,1,-2,-5,-8,-14,-20,-32,4,7,10,13,16,22,28,40,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-2.25,-2,-1.75,-1.5,-1.25,-0.75,-0.25,0.75,-2.25,-2.5,-2.75,-3,-3.25,-3.75,-4.25,-5.25,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,2.25,2.5,2.75,3,3.25,3.75,4.25,5.25,2.25,2,1.75,1.5,1.25,0.75,0.25,-0.75,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4.5,4.75,5,5.25,5.5,6,6.5,7.5,4.5,4.25,4,3.75,3.5,3,2.5,1.5,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,9,9.25,9.5,9.75,10,10.5,11,12,9,8.75,8.5,8.25,8,7.5,7,6,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-2.25,-1.75,-1.25,-0.75,-0.25,0.75,1.75,3.75,-2.25,-2.75,-3.25,-3.75,-4.25,-5.25,-6.25,-8.25,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-9,-9.5,-10,-10.5,-11,-12,-13,-15,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,2.25,2.75,3.25,3.75,4.25,5.25,6.25,8.25,2.25,1.75,1.25,0.75,0.25,-0.75,-1.75,-3.75,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4.5,5,5.5,6,6.5,7.5,8.5,10.5,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,9,9.5,10,10.5,11,12,13,15,9,8.5,8,7.5,7,6,5,3,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-2.25,-1.5,-0.75,0,0.75,2.25,3.75,6.75,-2.25,-3,-3.75,-4.5,-5.25,-6.75,-8.25,-11.25,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,2.25,3,3.75,4.5,5.25,6.75,8.25,11.25,2.25,1.5,0.75,0,-0.75,-2.25,-3.75,-6.75,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4.5,5.25,6,6.75,7.5,9,10.5,13.5,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,9,9.75,10.5,11.25,12,13.5,15,18,9,8.25,7.5,6.75,6,4.5,3,0,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-2.25,-1.25,-0.25,0.75,1.75,3.75,5.75,9.75,-2.25,-3.25,-4.25,-5.25,-6.25,-8.25,-10.25,-14.25,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-9,-8,-7,-6,-5,-3,-1,3,-9,-10,-11,-12,-13,-15,-17,-21,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,2.25,3.25,4.25,5.25,6.25,8.25,10.25,14.25,2.25,1.25,0.25,-0.75,-1.75,-3.75,-5.75,-9.75,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,9,10,11,12,13,15,17,21,9,8,7,6,5,3,1,-3,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-2.25,-0.75,0.75,2.25,3.75,6.75,9.75,15.75,-2.25,-3.75,-5.25,-6.75,-8.25,-11.25,-14.25,-20.25,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-9,-7.5,-6,-4.5,-3,0,3,9,-9,-10.5,-12,-13.5,-15,-18,-21,-27,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,2.25,3.75,5.25,6.75,8.25,11.25,14.25,20.25,2.25,0.75,-0.75,-2.25,-3.75,-6.75,-9.75,-15.75,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4.5,6,7.5,9,10.5,13.5,16.5,22.5,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,9,10.5,12,13.5,15,18,21,27,9,7.5,6,4.5,3,0,-3,-9,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-2.25,-0.25,1.75,3.75,5.75,9.75,13.75,21.75,-2.25,-4.25,-6.25,-8.25,-10.25,-14.25,-18.25,-26.25,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-9,-7,-5,-3,-1,3,7,15,-9,-11,-13,-15,-17,-21,-25,-33,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,2.25,4.25,6.25,8.25,10.25,14.25,18.25,26.25,2.25,0.25,-1.75,-3.75,-5.75,-9.75,-13.75,-21.75,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,9,11,13,15,17,21,25,33,9,7,5,3,1,-3,-7,-15,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-2.25,0.75,3.75,6.75,9.75,15.75,21.75,33.75,-2.25,-5.25,-8.25,-11.25,-14.25,-20.25,-26.25,-38.25,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-9,-6,-3,0,3,9,15,27,-9,-12,-15,-18,-21,-27,-33,-45,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,2.25,5.25,8.25,11.25,14.25,20.25,26.25,38.25,2.25,-0.75,-3.75,-6.75,-9.75,-15.75,-21.75,-33.75,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,9,12,15,18,21,27,33,45,9,6,3,0,-3,-9,-15,-27,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-0.75,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-2.25,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,0.75,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,2.25,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-0.75,-1,-1.25,-1.5,-1.75,-2.25,-2.75,-3.75,-0.75,-0.5,-0.25,0,0.25,0.75,1.25,2.25,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-2.25,-2.5,-2.75,-3,-3.25,-3.75,-4.25,-5.25,-2.25,-2,-1.75,-1.5,-1.25,-0.75,-0.25,0.75,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,0.75,0.5,0.25,0,-0.25,-0.75,-1.25,-2.25,0.75,1,1.25,1.5,1.75,2.25,2.75,3.75,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,2.25,2,1.75,1.5,1.25,0.75,0.25,-0.75,2.25,2.5,2.75,3,3.25,3.75,4.25,5.25,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4.5,4.25,4,3.75,3.5,3,2.5,1.5,4.5,4.75,5,5.25,5.5,6,6.5,7.5,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,9,8.75,8.5,8.25,8,7.5,7,6,9,9.25,9.5,9.75,10,10.5,11,12,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-0.75,-1.25,-1.75,-2.25,-2.75,-3.75,-4.75,-6.75,-0.75,-0.25,0.25,0.75,1.25,2.25,3.25,5.25,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-2.25,-2.75,-3.25,-3.75,-4.25,-5.25,-6.25,-8.25,-2.25,-1.75,-1.25,-0.75,-0.25,0.75,1.75,3.75,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-9,-8.5,-8,-7.5,-7,-6,-5,-3,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,0.75,0.25,-0.25,-0.75,-1.25,-2.25,-3.25,-5.25,0.75,1.25,1.75,2.25,2.75,3.75,4.75,6.75,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,2.25,1.75,1.25,0.75,0.25,-0.75,-1.75,-3.75,2.25,2.75,3.25,3.75,4.25,5.25,6.25,8.25,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,4.5,5,5.5,6,6.5,7.5,8.5,10.5,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,9,8.5,8,7.5,7,6,5,3,9,9.5,10,10.5,11,12,13,15,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-0.75,-1.5,-2.25,-3,-3.75,-5.25,-6.75,-9.75,-0.75,0,0.75,1.5,2.25,3.75,5.25,8.25,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-2.25,-3,-3.75,-4.5,-5.25,-6.75,-8.25,-11.25,-2.25,-1.5,-0.75,0,0.75,2.25,3.75,6.75,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,0.75,0,-0.75,-1.5,-2.25,-3.75,-5.25,-8.25,0.75,1.5,2.25,3,3.75,5.25,6.75,9.75,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,2.25,1.5,0.75,0,-0.75,-2.25,-3.75,-6.75,2.25,3,3.75,4.5,5.25,6.75,8.25,11.25,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,4.5,5.25,6,6.75,7.5,9,10.5,13.5,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,9,8.25,7.5,6.75,6,4.5,3,0,9,9.75,10.5,11.25,12,13.5,15,18,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-0.75,-1.75,-2.75,-3.75,-4.75,-6.75,-8.75,-12.75,-0.75,0.25,1.25,2.25,3.25,5.25,7.25,11.25,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-2.25,-3.25,-4.25,-5.25,-6.25,-8.25,-10.25,-14.25,-2.25,-1.25,-0.25,0.75,1.75,3.75,5.75,9.75,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-9,-10,-11,-12,-13,-15,-17,-21,-9,-8,-7,-6,-5,-3,-1,3,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,0.75,-0.25,-1.25,-2.25,-3.25,-5.25,-7.25,-11.25,0.75,1.75,2.75,3.75,4.75,6.75,8.75,12.75,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,2.25,1.25,0.25,-0.75,-1.75,-3.75,-5.75,-9.75,2.25,3.25,4.25,5.25,6.25,8.25,10.25,14.25,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,9,8,7,6,5,3,1,-3,9,10,11,12,13,15,17,21,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-0.75,-2.25,-3.75,-5.25,-6.75,-9.75,-12.75,-18.75,-0.75,0.75,2.25,3.75,5.25,8.25,11.25,17.25,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-2.25,-3.75,-5.25,-6.75,-8.25,-11.25,-14.25,-20.25,-2.25,-0.75,0.75,2.25,3.75,6.75,9.75,15.75,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-9,-7.5,-6,-4.5,-3,0,3,9,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,0.75,-0.75,-2.25,-3.75,-5.25,-8.25,-11.25,-17.25,0.75,2.25,3.75,5.25,6.75,9.75,12.75,18.75,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,2.25,0.75,-0.75,-2.25,-3.75,-6.75,-9.75,-15.75,2.25,3.75,5.25,6.75,8.25,11.25,14.25,20.25,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,4.5,6,7.5,9,10.5,13.5,16.5,22.5,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,9,7.5,6,4.5,3,0,-3,-9,9,10.5,12,13.5,15,18,21,27,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-0.75,-2.75,-4.75,-6.75,-8.75,-12.75,-16.75,-24.75,-0.75,1.25,3.25,5.25,7.25,11.25,15.25,23.25,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-2.25,-4.25,-6.25,-8.25,-10.25,-14.25,-18.25,-26.25,-2.25,-0.25,1.75,3.75,5.75,9.75,13.75,21.75,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-9,-11,-13,-15,-17,-21,-25,-33,-9,-7,-5,-3,-1,3,7,15,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,0.75,-1.25,-3.25,-5.25,-7.25,-11.25,-15.25,-23.25,0.75,2.75,4.75,6.75,8.75,12.75,16.75,24.75,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,2.25,0.25,-1.75,-3.75,-5.75,-9.75,-13.75,-21.75,2.25,4.25,6.25,8.25,10.25,14.25,18.25,26.25,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,9,7,5,3,1,-3,-7,-15,9,11,13,15,17,21,25,33,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-0.75,-3.75,-6.75,-9.75,-12.75,-18.75,-24.75,-36.75,-0.75,2.25,5.25,8.25,11.25,17.25,23.25,35.25,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-2.25,-5.25,-8.25,-11.25,-14.25,-20.25,-26.25,-38.25,-2.25,0.75,3.75,6.75,9.75,15.75,21.75,33.75,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-9,-12,-15,-18,-21,-27,-33,-45,-9,-6,-3,0,3,9,15,27,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,0.75,-2.25,-5.25,-8.25,-11.25,-17.25,-23.25,-35.25,0.75,3.75,6.75,9.75,12.75,18.75,24.75,36.75,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,2.25,-0.75,-3.75,-6.75,-9.75,-15.75,-21.75,-33.75,2.25,5.25,8.25,11.25,14.25,20.25,26.25,38.25,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,9,6,3,0,-3,-9,-15,-27,9,12,15,18,21,27,33,45,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,1,1.25,1.5,1.75,2,2.5,3,4,1,0.75,0.5,0.25,0,-0.5,-1,-2,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4,4.25,4.5,4.75,5,5.5,6,7,4,3.75,3.5,3.25,3,2.5,2,1,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,8,8.25,8.5,8.75,9,9.5,10,11,8,7.75,7.5,7.25,7,6.5,6,5,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-1,-0.5,0,0.5,1,2,3,5,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4,-3.5,-3,-2.5,-2,-1,0,2,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,1,1.5,2,2.5,3,4,5,7,1,0.5,0,-0.5,-1,-2,-3,-5,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4,4.5,5,5.5,6,7,8,10,4,3.5,3,2.5,2,1,0,-2,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,8,8.5,9,9.5,10,11,12,14,8,7.5,7,6.5,6,5,4,2,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-1,-0.25,0.5,1.25,2,3.5,5,8,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,1,1.75,2.5,3.25,4,5.5,7,10,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4,4.75,5.5,6.25,7,8.5,10,13,4,3.25,2.5,1.75,1,-0.5,-2,-5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,8,8.75,9.5,10.25,11,12.5,14,17,8,7.25,6.5,5.75,5,3.5,2,-1,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-1,0,1,2,3,5,7,11,-1,-2,-3,-4,-5,-7,-9,-13,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4,-3,-2,-1,0,2,4,8,-4,-5,-6,-7,-8,-10,-12,-16,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-8,-7,-6,-5,-4,-2,0,4,-8,-9,-10,-11,-12,-14,-16,-20,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,1,2,3,4,5,7,9,13,1,0,-1,-2,-3,-5,-7,-11,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4,5,6,7,8,10,12,16,4,3,2,1,0,-2,-4,-8,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,8,9,10,11,12,14,16,20,8,7,6,5,4,2,0,-4,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-1,0.5,2,3.5,5,8,11,17,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4,-2.5,-1,0.5,2,5,8,14,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-8,-6.5,-5,-3.5,-2,1,4,10,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,1,2.5,4,5.5,7,10,13,19,1,-0.5,-2,-3.5,-5,-8,-11,-17,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4,5.5,7,8.5,10,13,16,22,4,2.5,1,-0.5,-2,-5,-8,-14,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,8,9.5,11,12.5,14,17,20,26,8,6.5,5,3.5,2,-1,-4,-10,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-1,1,3,5,7,11,15,23,-1,-3,-5,-7,-9,-13,-17,-25,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4,-2,0,2,4,8,12,20,-4,-6,-8,-10,-12,-16,-20,-28,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-8,-6,-4,-2,0,4,8,16,-8,-10,-12,-14,-16,-20,-24,-32,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,1,3,5,7,9,13,17,25,1,-1,-3,-5,-7,-11,-15,-23,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4,6,8,10,12,16,20,28,4,2,0,-2,-4,-8,-12,-20,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,8,10,12,14,16,20,24,32,8,6,4,2,0,-4,-8,-16,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-1,2,5,8,11,17,23,35,-1,-4,-7,-10,-13,-19,-25,-37,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4,-1,2,5,8,14,20,32,-4,-7,-10,-13,-16,-22,-28,-40,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-8,-5,-2,1,4,10,16,28,-8,-11,-14,-17,-20,-26,-32,-44,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,1,4,7,10,13,19,25,37,1,-2,-5,-8,-11,-17,-23,-35,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4,7,10,13,16,22,28,40,4,1,-2,-5,-8,-14,-20,-32,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,8,11,14,17,20,26,32,44,8,5,2,-1,-4,-10,-16,-28,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-1,-1.25,-1.5,-1.75,-2,-2.5,-3,-4,-1,-0.75,-0.5,-0.25,0,0.5,1,2,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,1,0.75,0.5,0.25,0,-0.5,-1,-2,1,1.25,1.5,1.75,2,2.5,3,4,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4,3.75,3.5,3.25,3,2.5,2,1,4,4.25,4.5,4.75,5,5.5,6,7,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,8,7.75,7.5,7.25,7,6.5,6,5,8,8.25,8.5,8.75,9,9.5,10,11,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-1,-1.5,-2,-2.5,-3,-4,-5,-7,-1,-0.5,0,0.5,1,2,3,5,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-4,-3.5,-3,-2.5,-2,-1,0,2,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,1,0.5,0,-0.5,-1,-2,-3,-5,1,1.5,2,2.5,3,4,5,7,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4,3.5,3,2.5,2,1,0,-2,4,4.5,5,5.5,6,7,8,10,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,8,7.5,7,6.5,6,5,4,2,8,8.5,9,9.5,10,11,12,14,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-1,-1.75,-2.5,-3.25,-4,-5.5,-7,-10,-1,-0.25,0.5,1.25,2,3.5,5,8,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,1,0.25,-0.5,-1.25,-2,-3.5,-5,-8,1,1.75,2.5,3.25,4,5.5,7,10,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4,3.25,2.5,1.75,1,-0.5,-2,-5,4,4.75,5.5,6.25,7,8.5,10,13,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,8,7.25,6.5,5.75,5,3.5,2,-1,8,8.75,9.5,10.25,11,12.5,14,17,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-1,-2,-3,-4,-5,-7,-9,-13,-1,0,1,2,3,5,7,11,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4,-5,-6,-7,-8,-10,-12,-16,-4,-3,-2,-1,0,2,4,8,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-8,-9,-10,-11,-12,-14,-16,-20,-8,-7,-6,-5,-4,-2,0,4,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,1,0,-1,-2,-3,-5,-7,-11,1,2,3,4,5,7,9,13,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4,3,2,1,0,-2,-4,-8,4,5,6,7,8,10,12,16,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,8,7,6,5,4,2,0,-4,8,9,10,11,12,14,16,20,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-1,-2.5,-4,-5.5,-7,-10,-13,-19,-1,0.5,2,3.5,5,8,11,17,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-4,-2.5,-1,0.5,2,5,8,14,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-8,-6.5,-5,-3.5,-2,1,4,10,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,1,-0.5,-2,-3.5,-5,-8,-11,-17,1,2.5,4,5.5,7,10,13,19,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4,2.5,1,-0.5,-2,-5,-8,-14,4,5.5,7,8.5,10,13,16,22,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,8,6.5,5,3.5,2,-1,-4,-10,8,9.5,11,12.5,14,17,20,26,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-1,-3,-5,-7,-9,-13,-17,-25,-1,1,3,5,7,11,15,23,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4,-6,-8,-10,-12,-16,-20,-28,-4,-2,0,2,4,8,12,20,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-8,-10,-12,-14,-16,-20,-24,-32,-8,-6,-4,-2,0,4,8,16,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,1,-1,-3,-5,-7,-11,-15,-23,1,3,5,7,9,13,17,25,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4,2,0,-2,-4,-8,-12,-20,4,6,8,10,12,16,20,28,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,8,6,4,2,0,-4,-8,-16,8,10,12,14,16,20,24,32,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-1,-4,-7,-10,-13,-19,-25,-37,-1,2,5,8,11,17,23,35,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4,-7,-10,-13,-16,-22,-28,-40,-4,-1,2,5,8,14,20,32,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-8,-11,-14,-17,-20,-26,-32,-44,-8,-5,-2,1,4,10,16,28,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,1,-2,-5,-8,-11,-17,-23,-35,1,4,7,10,13,19,25,37,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4,1,-2,-5,-8,-14,-20,-32,4,7,10,13,16,22,28,40,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,8,5,2,-1,-4,-10,-16,-28,8,11,14,17,20,26,32,44,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,1.5,1.75,2,2.25,2.5,3,3.5,4.5,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,4.5,4.75,5,5.25,5.5,6,6.5,7.5,4.5,4.25,4,3.75,3.5,3,2.5,1.5,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,9,9.25,9.5,9.75,10,10.5,11,12,9,8.75,8.5,8.25,8,7.5,7,6,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,18,18.25,18.5,18.75,19,19.5,20,21,18,17.75,17.5,17.25,17,16.5,16,15,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-18,-17.5,-17,-16.5,-16,-15,-14,-12,-18,-18.5,-19,-19.5,-20,-21,-22,-24,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,1.5,2,2.5,3,3.5,4.5,5.5,7.5,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,4.5,5,5.5,6,6.5,7.5,8.5,10.5,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,9,9.5,10,10.5,11,12,13,15,9,8.5,8,7.5,7,6,5,3,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,18,18.5,19,19.5,20,21,22,24,18,17.5,17,16.5,16,15,14,12,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,1.5,2.25,3,3.75,4.5,6,7.5,10.5,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,4.5,5.25,6,6.75,7.5,9,10.5,13.5,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,9,9.75,10.5,11.25,12,13.5,15,18,9,8.25,7.5,6.75,6,4.5,3,0,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,18,18.75,19.5,20.25,21,22.5,24,27,18,17.25,16.5,15.75,15,13.5,12,9,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-9,-8,-7,-6,-5,-3,-1,3,-9,-10,-11,-12,-13,-15,-17,-21,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,-18,-17,-16,-15,-14,-12,-10,-6,-18,-19,-20,-21,-22,-24,-26,-30,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,9,10,11,12,13,15,17,21,9,8,7,6,5,3,1,-3,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,18,19,20,21,22,24,26,30,18,17,16,15,14,12,10,6,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-9,-7.5,-6,-4.5,-3,0,3,9,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-18,-16.5,-15,-13.5,-12,-9,-6,0,-18,-19.5,-21,-22.5,-24,-27,-30,-36,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,1.5,3,4.5,6,7.5,10.5,13.5,19.5,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,4.5,6,7.5,9,10.5,13.5,16.5,22.5,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,9,10.5,12,13.5,15,18,21,27,9,7.5,6,4.5,3,0,-3,-9,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,18,19.5,21,22.5,24,27,30,36,18,16.5,15,13.5,12,9,6,0,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-9,-7,-5,-3,-1,3,7,15,-9,-11,-13,-15,-17,-21,-25,-33,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,-18,-16,-14,-12,-10,-6,-2,6,-18,-20,-22,-24,-26,-30,-34,-42,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,9,11,13,15,17,21,25,33,9,7,5,3,1,-3,-7,-15,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,18,20,22,24,26,30,34,42,18,16,14,12,10,6,2,-6,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-9,-6,-3,0,3,9,15,27,-9,-12,-15,-18,-21,-27,-33,-45,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,-18,-15,-12,-9,-6,0,6,18,-18,-21,-24,-27,-30,-36,-42,-54,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,9,12,15,18,21,27,33,45,9,6,3,0,-3,-9,-15,-27,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,18,21,24,27,30,36,42,54,18,15,12,9,6,0,-6,-18,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-1.5,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-4.5,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,1.5,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,4.5,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-1.5,-1.75,-2,-2.25,-2.5,-3,-3.5,-4.5,-1.5,-1.25,-1,-0.75,-0.5,0,0.5,1.5,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-4.5,-4.75,-5,-5.25,-5.5,-6,-6.5,-7.5,-4.5,-4.25,-4,-3.75,-3.5,-3,-2.5,-1.5,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,1.5,1.25,1,0.75,0.5,0,-0.5,-1.5,1.5,1.75,2,2.25,2.5,3,3.5,4.5,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,4.5,4.25,4,3.75,3.5,3,2.5,1.5,4.5,4.75,5,5.25,5.5,6,6.5,7.5,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,9,8.75,8.5,8.25,8,7.5,7,6,9,9.25,9.5,9.75,10,10.5,11,12,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,18,17.75,17.5,17.25,17,16.5,16,15,18,18.25,18.5,18.75,19,19.5,20,21,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-1.5,-2,-2.5,-3,-3.5,-4.5,-5.5,-7.5,-1.5,-1,-0.5,0,0.5,1.5,2.5,4.5,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-4.5,-5,-5.5,-6,-6.5,-7.5,-8.5,-10.5,-4.5,-4,-3.5,-3,-2.5,-1.5,-0.5,1.5,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-18,-18.5,-19,-19.5,-20,-21,-22,-24,-18,-17.5,-17,-16.5,-16,-15,-14,-12,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,1.5,1,0.5,0,-0.5,-1.5,-2.5,-4.5,1.5,2,2.5,3,3.5,4.5,5.5,7.5,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,4.5,4,3.5,3,2.5,1.5,0.5,-1.5,4.5,5,5.5,6,6.5,7.5,8.5,10.5,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,9,8.5,8,7.5,7,6,5,3,9,9.5,10,10.5,11,12,13,15,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,18,17.5,17,16.5,16,15,14,12,18,18.5,19,19.5,20,21,22,24,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-1.5,-2.25,-3,-3.75,-4.5,-6,-7.5,-10.5,-1.5,-0.75,0,0.75,1.5,3,4.5,7.5,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-4.5,-5.25,-6,-6.75,-7.5,-9,-10.5,-13.5,-4.5,-3.75,-3,-2.25,-1.5,0,1.5,4.5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,1.5,0.75,0,-0.75,-1.5,-3,-4.5,-7.5,1.5,2.25,3,3.75,4.5,6,7.5,10.5,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,4.5,3.75,3,2.25,1.5,0,-1.5,-4.5,4.5,5.25,6,6.75,7.5,9,10.5,13.5,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,9,8.25,7.5,6.75,6,4.5,3,0,9,9.75,10.5,11.25,12,13.5,15,18,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,18,17.25,16.5,15.75,15,13.5,12,9,18,18.75,19.5,20.25,21,22.5,24,27,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-1.5,-2.5,-3.5,-4.5,-5.5,-7.5,-9.5,-13.5,-1.5,-0.5,0.5,1.5,2.5,4.5,6.5,10.5,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-4.5,-5.5,-6.5,-7.5,-8.5,-10.5,-12.5,-16.5,-4.5,-3.5,-2.5,-1.5,-0.5,1.5,3.5,7.5,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-9,-10,-11,-12,-13,-15,-17,-21,-9,-8,-7,-6,-5,-3,-1,3,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,-18,-19,-20,-21,-22,-24,-26,-30,-18,-17,-16,-15,-14,-12,-10,-6,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,1.5,0.5,-0.5,-1.5,-2.5,-4.5,-6.5,-10.5,1.5,2.5,3.5,4.5,5.5,7.5,9.5,13.5,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,4.5,3.5,2.5,1.5,0.5,-1.5,-3.5,-7.5,4.5,5.5,6.5,7.5,8.5,10.5,12.5,16.5,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,9,8,7,6,5,3,1,-3,9,10,11,12,13,15,17,21,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,18,17,16,15,14,12,10,6,18,19,20,21,22,24,26,30,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-1.5,-3,-4.5,-6,-7.5,-10.5,-13.5,-19.5,-1.5,0,1.5,3,4.5,7.5,10.5,16.5,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-4.5,-6,-7.5,-9,-10.5,-13.5,-16.5,-22.5,-4.5,-3,-1.5,0,1.5,4.5,7.5,13.5,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-9,-7.5,-6,-4.5,-3,0,3,9,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,-18,-19.5,-21,-22.5,-24,-27,-30,-36,-18,-16.5,-15,-13.5,-12,-9,-6,0,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,1.5,0,-1.5,-3,-4.5,-7.5,-10.5,-16.5,1.5,3,4.5,6,7.5,10.5,13.5,19.5,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,4.5,3,1.5,0,-1.5,-4.5,-7.5,-13.5,4.5,6,7.5,9,10.5,13.5,16.5,22.5,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,9,7.5,6,4.5,3,0,-3,-9,9,10.5,12,13.5,15,18,21,27,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,18,16.5,15,13.5,12,9,6,0,18,19.5,21,22.5,24,27,30,36,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-1.5,-3.5,-5.5,-7.5,-9.5,-13.5,-17.5,-25.5,-1.5,0.5,2.5,4.5,6.5,10.5,14.5,22.5,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-4.5,-6.5,-8.5,-10.5,-12.5,-16.5,-20.5,-28.5,-4.5,-2.5,-0.5,1.5,3.5,7.5,11.5,19.5,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-9,-11,-13,-15,-17,-21,-25,-33,-9,-7,-5,-3,-1,3,7,15,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,-18,-20,-22,-24,-26,-30,-34,-42,-18,-16,-14,-12,-10,-6,-2,6,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,1.5,-0.5,-2.5,-4.5,-6.5,-10.5,-14.5,-22.5,1.5,3.5,5.5,7.5,9.5,13.5,17.5,25.5,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,4.5,2.5,0.5,-1.5,-3.5,-7.5,-11.5,-19.5,4.5,6.5,8.5,10.5,12.5,16.5,20.5,28.5,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,9,7,5,3,1,-3,-7,-15,9,11,13,15,17,21,25,33,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,18,16,14,12,10,6,2,-6,18,20,22,24,26,30,34,42,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-1.5,-4.5,-7.5,-10.5,-13.5,-19.5,-25.5,-37.5,-1.5,1.5,4.5,7.5,10.5,16.5,22.5,34.5,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-4.5,-7.5,-10.5,-13.5,-16.5,-22.5,-28.5,-40.5,-4.5,-1.5,1.5,4.5,7.5,13.5,19.5,31.5,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-9,-12,-15,-18,-21,-27,-33,-45,-9,-6,-3,0,3,9,15,27,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,-18,-21,-24,-27,-30,-36,-42,-54,-18,-15,-12,-9,-6,0,6,18,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,1.5,-1.5,-4.5,-7.5,-10.5,-16.5,-22.5,-34.5,1.5,4.5,7.5,10.5,13.5,19.5,25.5,37.5,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,4.5,1.5,-1.5,-4.5,-7.5,-13.5,-19.5,-31.5,4.5,7.5,10.5,13.5,16.5,22.5,28.5,40.5,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,9,6,3,0,-3,-9,-15,-27,9,12,15,18,21,27,33,45,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,18,15,12,9,6,0,-6,-18,18,21,24,27,30,36,42,54,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-16,-15.75,-15.5,-15.25,-15,-14.5,-14,-13,-16,-16.25,-16.5,-16.75,-17,-17.5,-18,-19,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,2,2.25,2.5,2.75,3,3.5,4,5,2,1.75,1.5,1.25,1,0.5,0,-1,4,4.25,4.5,4.75,5,5.5,6,7,4,3.75,3.5,3.25,3,2.5,2,1,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,8,8.25,8.5,8.75,9,9.5,10,11,8,7.75,7.5,7.25,7,6.5,6,5,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,16,16.25,16.5,16.75,17,17.5,18,19,16,15.75,15.5,15.25,15,14.5,14,13,24,24.25,24.5,24.75,25,25.5,26,27,24,23.75,23.5,23.25,23,22.5,22,21,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-2,-1.5,-1,-0.5,0,1,2,4,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-4,-3.5,-3,-2.5,-2,-1,0,2,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-16,-15.5,-15,-14.5,-14,-13,-12,-10,-16,-16.5,-17,-17.5,-18,-19,-20,-22,-24,-23.5,-23,-22.5,-22,-21,-20,-18,-24,-24.5,-25,-25.5,-26,-27,-28,-30,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,2,2.5,3,3.5,4,5,6,8,2,1.5,1,0.5,0,-1,-2,-4,4,4.5,5,5.5,6,7,8,10,4,3.5,3,2.5,2,1,0,-2,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,8,8.5,9,9.5,10,11,12,14,8,7.5,7,6.5,6,5,4,2,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,16,16.5,17,17.5,18,19,20,22,16,15.5,15,14.5,14,13,12,10,24,24.5,25,25.5,26,27,28,30,24,23.5,23,22.5,22,21,20,18,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-2,-1.25,-0.5,0.25,1,2.5,4,7,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-16,-15.25,-14.5,-13.75,-13,-11.5,-10,-7,-16,-16.75,-17.5,-18.25,-19,-20.5,-22,-25,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,2,2.75,3.5,4.25,5,6.5,8,11,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,4,4.75,5.5,6.25,7,8.5,10,13,4,3.25,2.5,1.75,1,-0.5,-2,-5,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,8,8.75,9.5,10.25,11,12.5,14,17,8,7.25,6.5,5.75,5,3.5,2,-1,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,16,16.75,17.5,18.25,19,20.5,22,25,16,15.25,14.5,13.75,13,11.5,10,7,24,24.75,25.5,26.25,27,28.5,30,33,24,23.25,22.5,21.75,21,19.5,18,15,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-2,-1,0,1,2,4,6,10,-2,-3,-4,-5,-6,-8,-10,-14,-4,-3,-2,-1,0,2,4,8,-4,-5,-6,-7,-8,-10,-12,-16,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-8,-7,-6,-5,-4,-2,0,4,-8,-9,-10,-11,-12,-14,-16,-20,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,-16,-15,-14,-13,-12,-10,-8,-4,-16,-17,-18,-19,-20,-22,-24,-28,-24,-23,-22,-21,-20,-18,-16,-12,-24,-25,-26,-27,-28,-30,-32,-36,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,2,3,4,5,6,8,10,14,2,1,0,-1,-2,-4,-6,-10,4,5,6,7,8,10,12,16,4,3,2,1,0,-2,-4,-8,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,8,9,10,11,12,14,16,20,8,7,6,5,4,2,0,-4,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,16,17,18,19,20,22,24,28,16,15,14,13,12,10,8,4,24,25,26,27,28,30,32,36,24,23,22,21,20,18,16,12,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-2,-0.5,1,2.5,4,7,10,16,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-4,-2.5,-1,0.5,2,5,8,14,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-8,-6.5,-5,-3.5,-2,1,4,10,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-16,-14.5,-13,-11.5,-10,-7,-4,2,-16,-17.5,-19,-20.5,-22,-25,-28,-34,-24,-22.5,-21,-19.5,-18,-15,-12,-6,-24,-25.5,-27,-28.5,-30,-33,-36,-42,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,2,3.5,5,6.5,8,11,14,20,2,0.5,-1,-2.5,-4,-7,-10,-16,4,5.5,7,8.5,10,13,16,22,4,2.5,1,-0.5,-2,-5,-8,-14,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,8,9.5,11,12.5,14,17,20,26,8,6.5,5,3.5,2,-1,-4,-10,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,16,17.5,19,20.5,22,25,28,34,16,14.5,13,11.5,10,7,4,-2,24,25.5,27,28.5,30,33,36,42,24,22.5,21,19.5,18,15,12,6,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-2,0,2,4,6,10,14,22,-2,-4,-6,-8,-10,-14,-18,-26,-4,-2,0,2,4,8,12,20,-4,-6,-8,-10,-12,-16,-20,-28,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-8,-6,-4,-2,0,4,8,16,-8,-10,-12,-14,-16,-20,-24,-32,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,-16,-14,-12,-10,-8,-4,0,8,-16,-18,-20,-22,-24,-28,-32,-40,-24,-22,-20,-18,-16,-12,-8,0,-24,-26,-28,-30,-32,-36,-40,-48,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,2,4,6,8,10,14,18,26,2,0,-2,-4,-6,-10,-14,-22,4,6,8,10,12,16,20,28,4,2,0,-2,-4,-8,-12,-20,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,8,10,12,14,16,20,24,32,8,6,4,2,0,-4,-8,-16,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,16,18,20,22,24,28,32,40,16,14,12,10,8,4,0,-8,24,26,28,30,32,36,40,48,24,22,20,18,16,12,8,0,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-2,1,4,7,10,16,22,34,-2,-5,-8,-11,-14,-20,-26,-38,-4,-1,2,5,8,14,20,32,-4,-7,-10,-13,-16,-22,-28,-40,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-8,-5,-2,1,4,10,16,28,-8,-11,-14,-17,-20,-26,-32,-44,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,-16,-13,-10,-7,-4,2,8,20,-16,-19,-22,-25,-28,-34,-40,-52,-24,-21,-18,-15,-12,-6,0,12,-24,-27,-30,-33,-36,-42,-48,-60,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,2,5,8,11,14,20,26,38,2,-1,-4,-7,-10,-16,-22,-34,4,7,10,13,16,22,28,40,4,1,-2,-5,-8,-14,-20,-32,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,8,11,14,17,20,26,32,44,8,5,2,-1,-4,-10,-16,-28,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,16,19,22,25,28,34,40,52,16,13,10,7,4,-2,-8,-20,24,27,30,33,36,42,48,60,24,21,18,15,12,6,0,-12,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-2,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-4,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-8,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-16,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,2,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,4,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,8,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,16,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-2,-2.25,-2.5,-2.75,-3,-3.5,-4,-5,-2,-1.75,-1.5,-1.25,-1,-0.5,0,1,-4,-4.25,-4.5,-4.75,-5,-5.5,-6,-7,-4,-3.75,-3.5,-3.25,-3,-2.5,-2,-1,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-8,-8.25,-8.5,-8.75,-9,-9.5,-10,-11,-8,-7.75,-7.5,-7.25,-7,-6.5,-6,-5,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-16,-16.25,-16.5,-16.75,-17,-17.5,-18,-19,-16,-15.75,-15.5,-15.25,-15,-14.5,-14,-13,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,2,1.75,1.5,1.25,1,0.5,0,-1,2,2.25,2.5,2.75,3,3.5,4,5,4,3.75,3.5,3.25,3,2.5,2,1,4,4.25,4.5,4.75,5,5.5,6,7,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,8,7.75,7.5,7.25,7,6.5,6,5,8,8.25,8.5,8.75,9,9.5,10,11,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,16,15.75,15.5,15.25,15,14.5,14,13,16,16.25,16.5,16.75,17,17.5,18,19,24,23.75,23.5,23.25,23,22.5,22,21,24,24.25,24.5,24.75,25,25.5,26,27,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-2,-2.5,-3,-3.5,-4,-5,-6,-8,-2,-1.5,-1,-0.5,0,1,2,4,-4,-4.5,-5,-5.5,-6,-7,-8,-10,-4,-3.5,-3,-2.5,-2,-1,0,2,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-8,-8.5,-9,-9.5,-10,-11,-12,-14,-8,-7.5,-7,-6.5,-6,-5,-4,-2,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-16,-16.5,-17,-17.5,-18,-19,-20,-22,-16,-15.5,-15,-14.5,-14,-13,-12,-10,-24,-24.5,-25,-25.5,-26,-27,-28,-30,-24,-23.5,-23,-22.5,-22,-21,-20,-18,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,2,1.5,1,0.5,0,-1,-2,-4,2,2.5,3,3.5,4,5,6,8,4,3.5,3,2.5,2,1,0,-2,4,4.5,5,5.5,6,7,8,10,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,8,7.5,7,6.5,6,5,4,2,8,8.5,9,9.5,10,11,12,14,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,16,15.5,15,14.5,14,13,12,10,16,16.5,17,17.5,18,19,20,22,24,23.5,23,22.5,22,21,20,18,24,24.5,25,25.5,26,27,28,30,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-2,-2.75,-3.5,-4.25,-5,-6.5,-8,-11,-2,-1.25,-0.5,0.25,1,2.5,4,7,-4,-4.75,-5.5,-6.25,-7,-8.5,-10,-13,-4,-3.25,-2.5,-1.75,-1,0.5,2,5,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-8,-8.75,-9.5,-10.25,-11,-12.5,-14,-17,-8,-7.25,-6.5,-5.75,-5,-3.5,-2,1,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-16,-16.75,-17.5,-18.25,-19,-20.5,-22,-25,-16,-15.25,-14.5,-13.75,-13,-11.5,-10,-7,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,2,1.25,0.5,-0.25,-1,-2.5,-4,-7,2,2.75,3.5,4.25,5,6.5,8,11,4,3.25,2.5,1.75,1,-0.5,-2,-5,4,4.75,5.5,6.25,7,8.5,10,13,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,8,7.25,6.5,5.75,5,3.5,2,-1,8,8.75,9.5,10.25,11,12.5,14,17,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,16,15.25,14.5,13.75,13,11.5,10,7,16,16.75,17.5,18.25,19,20.5,22,25,24,23.25,22.5,21.75,21,19.5,18,15,24,24.75,25.5,26.25,27,28.5,30,33,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-2,-3,-4,-5,-6,-8,-10,-14,-2,-1,0,1,2,4,6,10,-4,-5,-6,-7,-8,-10,-12,-16,-4,-3,-2,-1,0,2,4,8,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-8,-9,-10,-11,-12,-14,-16,-20,-8,-7,-6,-5,-4,-2,0,4,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,-16,-17,-18,-19,-20,-22,-24,-28,-16,-15,-14,-13,-12,-10,-8,-4,-24,-25,-26,-27,-28,-30,-32,-36,-24,-23,-22,-21,-20,-18,-16,-12,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,2,1,0,-1,-2,-4,-6,-10,2,3,4,5,6,8,10,14,4,3,2,1,0,-2,-4,-8,4,5,6,7,8,10,12,16,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,8,7,6,5,4,2,0,-4,8,9,10,11,12,14,16,20,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,16,15,14,13,12,10,8,4,16,17,18,19,20,22,24,28,24,23,22,21,20,18,16,12,24,25,26,27,28,30,32,36,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-2,-3.5,-5,-6.5,-8,-11,-14,-20,-2,-0.5,1,2.5,4,7,10,16,-4,-5.5,-7,-8.5,-10,-13,-16,-22,-4,-2.5,-1,0.5,2,5,8,14,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-8,-9.5,-11,-12.5,-14,-17,-20,-26,-8,-6.5,-5,-3.5,-2,1,4,10,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,-16,-17.5,-19,-20.5,-22,-25,-28,-34,-16,-14.5,-13,-11.5,-10,-7,-4,2,-24,-25.5,-27,-28.5,-30,-33,-36,-42,-24,-22.5,-21,-19.5,-18,-15,-12,-6,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,2,0.5,-1,-2.5,-4,-7,-10,-16,2,3.5,5,6.5,8,11,14,20,4,2.5,1,-0.5,-2,-5,-8,-14,4,5.5,7,8.5,10,13,16,22,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,8,6.5,5,3.5,2,-1,-4,-10,8,9.5,11,12.5,14,17,20,26,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,16,14.5,13,11.5,10,7,4,-2,16,17.5,19,20.5,22,25,28,34,24,22.5,21,19.5,18,15,12,6,24,25.5,27,28.5,30,33,36,42,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-2,-4,-6,-8,-10,-14,-18,-26,-2,0,2,4,6,10,14,22,-4,-6,-8,-10,-12,-16,-20,-28,-4,-2,0,2,4,8,12,20,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-8,-10,-12,-14,-16,-20,-24,-32,-8,-6,-4,-2,0,4,8,16,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,-16,-18,-20,-22,-24,-28,-32,-40,-16,-14,-12,-10,-8,-4,0,8,-24,-26,-28,-30,-32,-36,-40,-48,-24,-22,-20,-18,-16,-12,-8,0,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,2,0,-2,-4,-6,-10,-14,-22,2,4,6,8,10,14,18,26,4,2,0,-2,-4,-8,-12,-20,4,6,8,10,12,16,20,28,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,8,6,4,2,0,-4,-8,-16,8,10,12,14,16,20,24,32,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,16,14,12,10,8,4,0,-8,16,18,20,22,24,28,32,40,24,22,20,18,16,12,8,0,24,26,28,30,32,36,40,48,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-2,-5,-8,-11,-14,-20,-26,-38,-2,1,4,7,10,16,22,34,-4,-7,-10,-13,-16,-22,-28,-40,-4,-1,2,5,8,14,20,32,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-8,-11,-14,-17,-20,-26,-32,-44,-8,-5,-2,1,4,10,16,28,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,-16,-19,-22,-25,-28,-34,-40,-52,-16,-13,-10,-7,-4,2,8,20,-24,-27,-30,-33,-36,-42,-48,-60,-24,-21,-18,-15,-12,-6,0,12,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,2,-1,-4,-7,-10,-16,-22,-34,2,5,8,11,14,20,26,38,4,1,-2,-5,-8,-14,-20,-32,4,7,10,13,16,22,28,40,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,8,5,2,-1,-4,-10,-16,-28,8,11,14,17,20,26,32,44,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,16,13,10,7,4,-2,-8,-20,16,19,22,25,28,34,40,52,24,21,18,15,12,6,0,-12,24,27,30,33,36,42,48,60,0,0,0,0,0,0,0,0,-0,-0,-0,-0,-0,-0,-0,-0,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,0,0.25,0.5,0.75,1,1.5,2,3,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,-36,-35.75,-35.5,-35.25,-35,-34.5,-34,-33,-36,-36.25,-36.5,-36.75,-37,-37.5,-38,-39,0,0.25,0.5,0.75,1,1.5,2,3,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,3,3.25,3.5,3.75,4,4.5,5,6,3,2.75,2.5,2.25,2,1.5,1,0,6,6.25,6.5,6.75,7,7.5,8,9,6,5.75,5.5,5.25,5,4.5,4,3,9,9.25,9.5,9.75,10,10.5,11,12,9,8.75,8.5,8.25,8,7.5,7,6,12,12.25,12.5,12.75,13,13.5,14,15,12,11.75,11.5,11.25,11,10.5,10,9,18,18.25,18.5,18.75,19,19.5,20,21,18,17.75,17.5,17.25,17,16.5,16,15,24,24.25,24.5,24.75,25,25.5,26,27,24,23.75,23.5,23.25,23,22.5,22,21,36,36.25,36.5,36.75,37,37.5,38,39,36,35.75,35.5,35.25,35,34.5,34,33,0,0.5,1,1.5,2,3,4,6,-0,-0.5,-1,-1.5,-2,-3,-4,-6,-3,-2.5,-2,-1.5,-1,0,1,3,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-6,-5.5,-5,-4.5,-4,-3,-2,0,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-18,-17.5,-17,-16.5,-16,-15,-14,-12,-18,-18.5,-19,-19.5,-20,-21,-22,-24,-24,-23.5,-23,-22.5,-22,-21,-20,-18,-24,-24.5,-25,-25.5,-26,-27,-28,-30,-36,-35.5,-35,-34.5,-34,-33,-32,-30,-36,-36.5,-37,-37.5,-38,-39,-40,-42,0,0.5,1,1.5,2,3,4,6,0,-0.5,-1,-1.5,-2,-3,-4,-6,3,3.5,4,4.5,5,6,7,9,3,2.5,2,1.5,1,0,-1,-3,6,6.5,7,7.5,8,9,10,12,6,5.5,5,4.5,4,3,2,0,9,9.5,10,10.5,11,12,13,15,9,8.5,8,7.5,7,6,5,3,12,12.5,13,13.5,14,15,16,18,12,11.5,11,10.5,10,9,8,6,18,18.5,19,19.5,20,21,22,24,18,17.5,17,16.5,16,15,14,12,24,24.5,25,25.5,26,27,28,30,24,23.5,23,22.5,22,21,20,18,36,36.5,37,37.5,38,39,40,42,36,35.5,35,34.5,34,33,32,30,0,0.75,1.5,2.25,3,4.5,6,9,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,-36,-35.25,-34.5,-33.75,-33,-31.5,-30,-27,-36,-36.75,-37.5,-38.25,-39,-40.5,-42,-45,0,0.75,1.5,2.25,3,4.5,6,9,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,3,3.75,4.5,5.25,6,7.5,9,12,3,2.25,1.5,0.75,0,-1.5,-3,-6,6,6.75,7.5,8.25,9,10.5,12,15,6,5.25,4.5,3.75,3,1.5,0,-3,9,9.75,10.5,11.25,12,13.5,15,18,9,8.25,7.5,6.75,6,4.5,3,0,12,12.75,13.5,14.25,15,16.5,18,21,12,11.25,10.5,9.75,9,7.5,6,3,18,18.75,19.5,20.25,21,22.5,24,27,18,17.25,16.5,15.75,15,13.5,12,9,24,24.75,25.5,26.25,27,28.5,30,33,24,23.25,22.5,21.75,21,19.5,18,15,36,36.75,37.5,38.25,39,40.5,42,45,36,35.25,34.5,33.75,33,31.5,30,27,0,1,2,3,4,6,8,12,-0,-1,-2,-3,-4,-6,-8,-12,-3,-2,-1,0,1,3,5,9,-3,-4,-5,-6,-7,-9,-11,-15,-6,-5,-4,-3,-2,0,2,6,-6,-7,-8,-9,-10,-12,-14,-18,-9,-8,-7,-6,-5,-3,-1,3,-9,-10,-11,-12,-13,-15,-17,-21,-12,-11,-10,-9,-8,-6,-4,0,-12,-13,-14,-15,-16,-18,-20,-24,-18,-17,-16,-15,-14,-12,-10,-6,-18,-19,-20,-21,-22,-24,-26,-30,-24,-23,-22,-21,-20,-18,-16,-12,-24,-25,-26,-27,-28,-30,-32,-36,-36,-35,-34,-33,-32,-30,-28,-24,-36,-37,-38,-39,-40,-42,-44,-48,0,1,2,3,4,6,8,12,0,-1,-2,-3,-4,-6,-8,-12,3,4,5,6,7,9,11,15,3,2,1,0,-1,-3,-5,-9,6,7,8,9,10,12,14,18,6,5,4,3,2,0,-2,-6,9,10,11,12,13,15,17,21,9,8,7,6,5,3,1,-3,12,13,14,15,16,18,20,24,12,11,10,9,8,6,4,0,18,19,20,21,22,24,26,30,18,17,16,15,14,12,10,6,24,25,26,27,28,30,32,36,24,23,22,21,20,18,16,12,36,37,38,39,40,42,44,48,36,35,34,33,32,30,28,24,0,1.5,3,4.5,6,9,12,18,-0,-1.5,-3,-4.5,-6,-9,-12,-18,-3,-1.5,0,1.5,3,6,9,15,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-6,-4.5,-3,-1.5,0,3,6,12,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-9,-7.5,-6,-4.5,-3,0,3,9,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-12,-10.5,-9,-7.5,-6,-3,0,6,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-18,-16.5,-15,-13.5,-12,-9,-6,0,-18,-19.5,-21,-22.5,-24,-27,-30,-36,-24,-22.5,-21,-19.5,-18,-15,-12,-6,-24,-25.5,-27,-28.5,-30,-33,-36,-42,-36,-34.5,-33,-31.5,-30,-27,-24,-18,-36,-37.5,-39,-40.5,-42,-45,-48,-54,0,1.5,3,4.5,6,9,12,18,0,-1.5,-3,-4.5,-6,-9,-12,-18,3,4.5,6,7.5,9,12,15,21,3,1.5,0,-1.5,-3,-6,-9,-15,6,7.5,9,10.5,12,15,18,24,6,4.5,3,1.5,0,-3,-6,-12,9,10.5,12,13.5,15,18,21,27,9,7.5,6,4.5,3,0,-3,-9,12,13.5,15,16.5,18,21,24,30,12,10.5,9,7.5,6,3,0,-6,18,19.5,21,22.5,24,27,30,36,18,16.5,15,13.5,12,9,6,0,24,25.5,27,28.5,30,33,36,42,24,22.5,21,19.5,18,15,12,6,36,37.5,39,40.5,42,45,48,54,36,34.5,33,31.5,30,27,24,18,0,2,4,6,8,12,16,24,-0,-2,-4,-6,-8,-12,-16,-24,-3,-1,1,3,5,9,13,21,-3,-5,-7,-9,-11,-15,-19,-27,-6,-4,-2,0,2,6,10,18,-6,-8,-10,-12,-14,-18,-22,-30,-9,-7,-5,-3,-1,3,7,15,-9,-11,-13,-15,-17,-21,-25,-33,-12,-10,-8,-6,-4,0,4,12,-12,-14,-16,-18,-20,-24,-28,-36,-18,-16,-14,-12,-10,-6,-2,6,-18,-20,-22,-24,-26,-30,-34,-42,-24,-22,-20,-18,-16,-12,-8,0,-24,-26,-28,-30,-32,-36,-40,-48,-36,-34,-32,-30,-28,-24,-20,-12,-36,-38,-40,-42,-44,-48,-52,-60,0,2,4,6,8,12,16,24,0,-2,-4,-6,-8,-12,-16,-24,3,5,7,9,11,15,19,27,3,1,-1,-3,-5,-9,-13,-21,6,8,10,12,14,18,22,30,6,4,2,0,-2,-6,-10,-18,9,11,13,15,17,21,25,33,9,7,5,3,1,-3,-7,-15,12,14,16,18,20,24,28,36,12,10,8,6,4,0,-4,-12,18,20,22,24,26,30,34,42,18,16,14,12,10,6,2,-6,24,26,28,30,32,36,40,48,24,22,20,18,16,12,8,0,36,38,40,42,44,48,52,60,36,34,32,30,28,24,20,12,0,3,6,9,12,18,24,36,-0,-3,-6,-9,-12,-18,-24,-36,-3,0,3,6,9,15,21,33,-3,-6,-9,-12,-15,-21,-27,-39,-6,-3,0,3,6,12,18,30,-6,-9,-12,-15,-18,-24,-30,-42,-9,-6,-3,0,3,9,15,27,-9,-12,-15,-18,-21,-27,-33,-45,-12,-9,-6,-3,0,6,12,24,-12,-15,-18,-21,-24,-30,-36,-48,-18,-15,-12,-9,-6,0,6,18,-18,-21,-24,-27,-30,-36,-42,-54,-24,-21,-18,-15,-12,-6,0,12,-24,-27,-30,-33,-36,-42,-48,-60,-36,-33,-30,-27,-24,-18,-12,0,-36,-39,-42,-45,-48,-54,-60,-72,0,3,6,9,12,18,24,36,0,-3,-6,-9,-12,-18,-24,-36,3,6,9,12,15,21,27,39,3,0,-3,-6,-9,-15,-21,-33,6,9,12,15,18,24,30,42,6,3,0,-3,-6,-12,-18,-30,9,12,15,18,21,27,33,45,9,6,3,0,-3,-9,-15,-27,12,15,18,21,24,30,36,48,12,9,6,3,0,-6,-12,-24,18,21,24,27,30,36,42,54,18,15,12,9,6,0,-6,-18,24,27,30,33,36,42,48,60,24,21,18,15,12,6,0,-12,36,39,42,45,48,54,60,72,36,33,30,27,24,18,12,0,-0,-0,-0,-0,-0,-0,-0,-0,0,0,0,0,0,0,0,0,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-3,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-6,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-9,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-12,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-18,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-24,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,-36,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,3,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,6,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,9,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,12,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,18,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,36,-0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-6,-3,-2.75,-2.5,-2.25,-2,-1.5,-1,0,-6,-6.25,-6.5,-6.75,-7,-7.5,-8,-9,-6,-5.75,-5.5,-5.25,-5,-4.5,-4,-3,-9,-9.25,-9.5,-9.75,-10,-10.5,-11,-12,-9,-8.75,-8.5,-8.25,-8,-7.5,-7,-6,-12,-12.25,-12.5,-12.75,-13,-13.5,-14,-15,-12,-11.75,-11.5,-11.25,-11,-10.5,-10,-9,-18,-18.25,-18.5,-18.75,-19,-19.5,-20,-21,-18,-17.75,-17.5,-17.25,-17,-16.5,-16,-15,-24,-24.25,-24.5,-24.75,-25,-25.5,-26,-27,-24,-23.75,-23.5,-23.25,-23,-22.5,-22,-21,-36,-36.25,-36.5,-36.75,-37,-37.5,-38,-39,-36,-35.75,-35.5,-35.25,-35,-34.5,-34,-33,0,-0.25,-0.5,-0.75,-1,-1.5,-2,-3,0,0.25,0.5,0.75,1,1.5,2,3,3,2.75,2.5,2.25,2,1.5,1,0,3,3.25,3.5,3.75,4,4.5,5,6,6,5.75,5.5,5.25,5,4.5,4,3,6,6.25,6.5,6.75,7,7.5,8,9,9,8.75,8.5,8.25,8,7.5,7,6,9,9.25,9.5,9.75,10,10.5,11,12,12,11.75,11.5,11.25,11,10.5,10,9,12,12.25,12.5,12.75,13,13.5,14,15,18,17.75,17.5,17.25,17,16.5,16,15,18,18.25,18.5,18.75,19,19.5,20,21,24,23.75,23.5,23.25,23,22.5,22,21,24,24.25,24.5,24.75,25,25.5,26,27,36,35.75,35.5,35.25,35,34.5,34,33,36,36.25,36.5,36.75,37,37.5,38,39,-0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,-3,-3.5,-4,-4.5,-5,-6,-7,-9,-3,-2.5,-2,-1.5,-1,0,1,3,-6,-6.5,-7,-7.5,-8,-9,-10,-12,-6,-5.5,-5,-4.5,-4,-3,-2,0,-9,-9.5,-10,-10.5,-11,-12,-13,-15,-9,-8.5,-8,-7.5,-7,-6,-5,-3,-12,-12.5,-13,-13.5,-14,-15,-16,-18,-12,-11.5,-11,-10.5,-10,-9,-8,-6,-18,-18.5,-19,-19.5,-20,-21,-22,-24,-18,-17.5,-17,-16.5,-16,-15,-14,-12,-24,-24.5,-25,-25.5,-26,-27,-28,-30,-24,-23.5,-23,-22.5,-22,-21,-20,-18,-36,-36.5,-37,-37.5,-38,-39,-40,-42,-36,-35.5,-35,-34.5,-34,-33,-32,-30,0,-0.5,-1,-1.5,-2,-3,-4,-6,0,0.5,1,1.5,2,3,4,6,3,2.5,2,1.5,1,0,-1,-3,3,3.5,4,4.5,5,6,7,9,6,5.5,5,4.5,4,3,2,0,6,6.5,7,7.5,8,9,10,12,9,8.5,8,7.5,7,6,5,3,9,9.5,10,10.5,11,12,13,15,12,11.5,11,10.5,10,9,8,6,12,12.5,13,13.5,14,15,16,18,18,17.5,17,16.5,16,15,14,12,18,18.5,19,19.5,20,21,22,24,24,23.5,23,22.5,22,21,20,18,24,24.5,25,25.5,26,27,28,30,36,35.5,35,34.5,34,33,32,30,36,36.5,37,37.5,38,39,40,42,-0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,-3,-3.75,-4.5,-5.25,-6,-7.5,-9,-12,-3,-2.25,-1.5,-0.75,0,1.5,3,6,-6,-6.75,-7.5,-8.25,-9,-10.5,-12,-15,-6,-5.25,-4.5,-3.75,-3,-1.5,0,3,-9,-9.75,-10.5,-11.25,-12,-13.5,-15,-18,-9,-8.25,-7.5,-6.75,-6,-4.5,-3,0,-12,-12.75,-13.5,-14.25,-15,-16.5,-18,-21,-12,-11.25,-10.5,-9.75,-9,-7.5,-6,-3,-18,-18.75,-19.5,-20.25,-21,-22.5,-24,-27,-18,-17.25,-16.5,-15.75,-15,-13.5,-12,-9,-24,-24.75,-25.5,-26.25,-27,-28.5,-30,-33,-24,-23.25,-22.5,-21.75,-21,-19.5,-18,-15,-36,-36.75,-37.5,-38.25,-39,-40.5,-42,-45,-36,-35.25,-34.5,-33.75,-33,-31.5,-30,-27,0,-0.75,-1.5,-2.25,-3,-4.5,-6,-9,0,0.75,1.5,2.25,3,4.5,6,9,3,2.25,1.5,0.75,0,-1.5,-3,-6,3,3.75,4.5,5.25,6,7.5,9,12,6,5.25,4.5,3.75,3,1.5,0,-3,6,6.75,7.5,8.25,9,10.5,12,15,9,8.25,7.5,6.75,6,4.5,3,0,9,9.75,10.5,11.25,12,13.5,15,18,12,11.25,10.5,9.75,9,7.5,6,3,12,12.75,13.5,14.25,15,16.5,18,21,18,17.25,16.5,15.75,15,13.5,12,9,18,18.75,19.5,20.25,21,22.5,24,27,24,23.25,22.5,21.75,21,19.5,18,15,24,24.75,25.5,26.25,27,28.5,30,33,36,35.25,34.5,33.75,33,31.5,30,27,36,36.75,37.5,38.25,39,40.5,42,45,-0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,-3,-4,-5,-6,-7,-9,-11,-15,-3,-2,-1,0,1,3,5,9,-6,-7,-8,-9,-10,-12,-14,-18,-6,-5,-4,-3,-2,0,2,6,-9,-10,-11,-12,-13,-15,-17,-21,-9,-8,-7,-6,-5,-3,-1,3,-12,-13,-14,-15,-16,-18,-20,-24,-12,-11,-10,-9,-8,-6,-4,0,-18,-19,-20,-21,-22,-24,-26,-30,-18,-17,-16,-15,-14,-12,-10,-6,-24,-25,-26,-27,-28,-30,-32,-36,-24,-23,-22,-21,-20,-18,-16,-12,-36,-37,-38,-39,-40,-42,-44,-48,-36,-35,-34,-33,-32,-30,-28,-24,0,-1,-2,-3,-4,-6,-8,-12,0,1,2,3,4,6,8,12,3,2,1,0,-1,-3,-5,-9,3,4,5,6,7,9,11,15,6,5,4,3,2,0,-2,-6,6,7,8,9,10,12,14,18,9,8,7,6,5,3,1,-3,9,10,11,12,13,15,17,21,12,11,10,9,8,6,4,0,12,13,14,15,16,18,20,24,18,17,16,15,14,12,10,6,18,19,20,21,22,24,26,30,24,23,22,21,20,18,16,12,24,25,26,27,28,30,32,36,36,35,34,33,32,30,28,24,36,37,38,39,40,42,44,48,-0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,-3,-4.5,-6,-7.5,-9,-12,-15,-21,-3,-1.5,0,1.5,3,6,9,15,-6,-7.5,-9,-10.5,-12,-15,-18,-24,-6,-4.5,-3,-1.5,0,3,6,12,-9,-10.5,-12,-13.5,-15,-18,-21,-27,-9,-7.5,-6,-4.5,-3,0,3,9,-12,-13.5,-15,-16.5,-18,-21,-24,-30,-12,-10.5,-9,-7.5,-6,-3,0,6,-18,-19.5,-21,-22.5,-24,-27,-30,-36,-18,-16.5,-15,-13.5,-12,-9,-6,0,-24,-25.5,-27,-28.5,-30,-33,-36,-42,-24,-22.5,-21,-19.5,-18,-15,-12,-6,-36,-37.5,-39,-40.5,-42,-45,-48,-54,-36,-34.5,-33,-31.5,-30,-27,-24,-18,0,-1.5,-3,-4.5,-6,-9,-12,-18,0,1.5,3,4.5,6,9,12,18,3,1.5,0,-1.5,-3,-6,-9,-15,3,4.5,6,7.5,9,12,15,21,6,4.5,3,1.5,0,-3,-6,-12,6,7.5,9,10.5,12,15,18,24,9,7.5,6,4.5,3,0,-3,-9,9,10.5,12,13.5,15,18,21,27,12,10.5,9,7.5,6,3,0,-6,12,13.5,15,16.5,18,21,24,30,18,16.5,15,13.5,12,9,6,0,18,19.5,21,22.5,24,27,30,36,24,22.5,21,19.5,18,15,12,6,24,25.5,27,28.5,30,33,36,42,36,34.5,33,31.5,30,27,24,18,36,37.5,39,40.5,42,45,48,54,-0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,-3,-5,-7,-9,-11,-15,-19,-27,-3,-1,1,3,5,9,13,21,-6,-8,-10,-12,-14,-18,-22,-30,-6,-4,-2,0,2,6,10,18,-9,-11,-13,-15,-17,-21,-25,-33,-9,-7,-5,-3,-1,3,7,15,-12,-14,-16,-18,-20,-24,-28,-36,-12,-10,-8,-6,-4,0,4,12,-18,-20,-22,-24,-26,-30,-34,-42,-18,-16,-14,-12,-10,-6,-2,6,-24,-26,-28,-30,-32,-36,-40,-48,-24,-22,-20,-18,-16,-12,-8,0,-36,-38,-40,-42,-44,-48,-52,-60,-36,-34,-32,-30,-28,-24,-20,-12,0,-2,-4,-6,-8,-12,-16,-24,0,2,4,6,8,12,16,24,3,1,-1,-3,-5,-9,-13,-21,3,5,7,9,11,15,19,27,6,4,2,0,-2,-6,-10,-18,6,8,10,12,14,18,22,30,9,7,5,3,1,-3,-7,-15,9,11,13,15,17,21,25,33,12,10,8,6,4,0,-4,-12,12,14,16,18,20,24,28,36,18,16,14,12,10,6,2,-6,18,20,22,24,26,30,34,42,24,22,20,18,16,12,8,0,24,26,28,30,32,36,40,48,36,34,32,30,28,24,20,12,36,38,40,42,44,48,52,60,-0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,-3,-6,-9,-12,-15,-21,-27,-39,-3,0,3,6,9,15,21,33,-6,-9,-12,-15,-18,-24,-30,-42,-6,-3,0,3,6,12,18,30,-9,-12,-15,-18,-21,-27,-33,-45,-9,-6,-3,0,3,9,15,27,-12,-15,-18,-21,-24,-30,-36,-48,-12,-9,-6,-3,0,6,12,24,-18,-21,-24,-27,-30,-36,-42,-54,-18,-15,-12,-9,-6,0,6,18,-24,-27,-30,-33,-36,-42,-48,-60,-24,-21,-18,-15,-12,-6,0,12,-36,-39,-42,-45,-48,-54,-60,-72,-36,-33,-30,-27,-24,-18,-12,0,0,-3,-6,-9,-12,-18,-24,-36,0,3,6,9,12,18,24,36,3,0,-3,-6,-9,-15,-21,-33,3,6,9,12,15,21,27,39,6,3,0,-3,-6,-12,-18,-30,6,9,12,15,18,24,30,42,9,6,3,0,-3,-9,-15,-27,9,12,15,18,21,27,33,45,12,9,6,3,0,-6,-12,-24,12,15,18,21,24,30,36,48,18,15,12,9,6,0,-6,-18,18,21,24,27,30,36,42,54,24,21,18,15,12,6,0,-12,24,27,30,33,36,42,48,60,36,33,30,27,24,18,12,0,36,39,42,45,48,54,60,72}
@@ -0,0 +1,8 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for dotfix of float8(s), values are fixed point with 10 fractional bits
+//
+
+var dotfix = [0x10000]int32{0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,0,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,0,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,0,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,0,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,0,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,0,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,0,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,0,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,0,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,0,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,0,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,0,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,0,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,0,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,0,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,0,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,0,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,0,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,0,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,0,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,0,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,0,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,0,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,0,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,0,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,0,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,0,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,0,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,0,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,0,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,0,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,0,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,0,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,0,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,0,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,0,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,0,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,0,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,0,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,0,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,0,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,0,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,0,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,0,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,0,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,0,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,0,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,0,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,0,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,0,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,0,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,0,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,0,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,0,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,0,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,0,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,0,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,0,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,0,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,0,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,0,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,0,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,0,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,0,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,0,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,0,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,0,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,0,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,0,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,0,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,0,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,0,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,0,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,0,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,0,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,0,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,0,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,0,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,0,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,0,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,0,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,16777216,18874368,20971520,23068672,25165824,27262976,29360128,31457280,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,-16777216,-18874368,-20971520,-23068672,-25165824,-27262976,-29360128,-31457280,0,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,18874368,21233664,23592960,25952256,28311552,30670848,33030144,35389440,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,-18874368,-21233664,-23592960,-25952256,-28311552,-30670848,-33030144,-35389440,0,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,20971520,23592960,26214400,28835840,31457280,34078720,36700160,39321600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,-20971520,-23592960,-26214400,-28835840,-31457280,-34078720,-36700160,-39321600,0,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,23068672,25952256,28835840,31719424,34603008,37486592,40370176,43253760,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,-23068672,-25952256,-28835840,-31719424,-34603008,-37486592,-40370176,-43253760,0,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,25165824,28311552,31457280,34603008,37748736,40894464,44040192,47185920,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,-25165824,-28311552,-31457280,-34603008,-37748736,-40894464,-44040192,-47185920,0,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,27262976,30670848,34078720,37486592,40894464,44302336,47710208,51118080,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,-27262976,-30670848,-34078720,-37486592,-40894464,-44302336,-47710208,-51118080,0,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,29360128,33030144,36700160,40370176,44040192,47710208,51380224,55050240,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,-29360128,-33030144,-36700160,-40370176,-44040192,-47710208,-51380224,-55050240,0,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,31457280,35389440,39321600,43253760,47185920,51118080,55050240,58982400,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,-31457280,-35389440,-39321600,-43253760,-47185920,-51118080,-55050240,-58982400,0,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,16777216,18874368,20971520,23068672,25165824,27262976,29360128,31457280,33554432,37748736,41943040,46137344,50331648,54525952,58720256,62914560,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,-16777216,-18874368,-20971520,-23068672,-25165824,-27262976,-29360128,-31457280,-33554432,-37748736,-41943040,-46137344,-50331648,-54525952,-58720256,-62914560,0,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,18874368,21233664,23592960,25952256,28311552,30670848,33030144,35389440,37748736,42467328,47185920,51904512,56623104,61341696,66060288,70778880,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,-18874368,-21233664,-23592960,-25952256,-28311552,-30670848,-33030144,-35389440,-37748736,-42467328,-47185920,-51904512,-56623104,-61341696,-66060288,-70778880,0,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,20971520,23592960,26214400,28835840,31457280,34078720,36700160,39321600,41943040,47185920,52428800,57671680,62914560,68157440,73400320,78643200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,-20971520,-23592960,-26214400,-28835840,-31457280,-34078720,-36700160,-39321600,-41943040,-47185920,-52428800,-57671680,-62914560,-68157440,-73400320,-78643200,0,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,23068672,25952256,28835840,31719424,34603008,37486592,40370176,43253760,46137344,51904512,57671680,63438848,69206016,74973184,80740352,86507520,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,-23068672,-25952256,-28835840,-31719424,-34603008,-37486592,-40370176,-43253760,-46137344,-51904512,-57671680,-63438848,-69206016,-74973184,-80740352,-86507520,0,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,25165824,28311552,31457280,34603008,37748736,40894464,44040192,47185920,50331648,56623104,62914560,69206016,75497472,81788928,88080384,94371840,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,-25165824,-28311552,-31457280,-34603008,-37748736,-40894464,-44040192,-47185920,-50331648,-56623104,-62914560,-69206016,-75497472,-81788928,-88080384,-94371840,0,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,27262976,30670848,34078720,37486592,40894464,44302336,47710208,51118080,54525952,61341696,68157440,74973184,81788928,88604672,95420416,102236160,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,-27262976,-30670848,-34078720,-37486592,-40894464,-44302336,-47710208,-51118080,-54525952,-61341696,-68157440,-74973184,-81788928,-88604672,-95420416,-102236160,0,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,29360128,33030144,36700160,40370176,44040192,47710208,51380224,55050240,58720256,66060288,73400320,80740352,88080384,95420416,102760448,110100480,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,-29360128,-33030144,-36700160,-40370176,-44040192,-47710208,-51380224,-55050240,-58720256,-66060288,-73400320,-80740352,-88080384,-95420416,-102760448,-110100480,0,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,31457280,35389440,39321600,43253760,47185920,51118080,55050240,58982400,62914560,70778880,78643200,86507520,94371840,102236160,110100480,117964800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,-31457280,-35389440,-39321600,-43253760,-47185920,-51118080,-55050240,-58982400,-62914560,-70778880,-78643200,-86507520,-94371840,-102236160,-110100480,-117964800,0,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,16777216,18874368,20971520,23068672,25165824,27262976,29360128,31457280,33554432,37748736,41943040,46137344,50331648,54525952,58720256,62914560,67108864,75497472,83886080,92274688,100663296,109051904,117440512,125829120,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,-16777216,-18874368,-20971520,-23068672,-25165824,-27262976,-29360128,-31457280,-33554432,-37748736,-41943040,-46137344,-50331648,-54525952,-58720256,-62914560,-67108864,-75497472,-83886080,-92274688,-100663296,-109051904,-117440512,-125829120,0,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,18874368,21233664,23592960,25952256,28311552,30670848,33030144,35389440,37748736,42467328,47185920,51904512,56623104,61341696,66060288,70778880,75497472,84934656,94371840,103809024,113246208,122683392,132120576,141557760,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,-18874368,-21233664,-23592960,-25952256,-28311552,-30670848,-33030144,-35389440,-37748736,-42467328,-47185920,-51904512,-56623104,-61341696,-66060288,-70778880,-75497472,-84934656,-94371840,-103809024,-113246208,-122683392,-132120576,-141557760,0,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,20971520,23592960,26214400,28835840,31457280,34078720,36700160,39321600,41943040,47185920,52428800,57671680,62914560,68157440,73400320,78643200,83886080,94371840,104857600,115343360,125829120,136314880,146800640,157286400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,-20971520,-23592960,-26214400,-28835840,-31457280,-34078720,-36700160,-39321600,-41943040,-47185920,-52428800,-57671680,-62914560,-68157440,-73400320,-78643200,-83886080,-94371840,-104857600,-115343360,-125829120,-136314880,-146800640,-157286400,0,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,23068672,25952256,28835840,31719424,34603008,37486592,40370176,43253760,46137344,51904512,57671680,63438848,69206016,74973184,80740352,86507520,92274688,103809024,115343360,126877696,138412032,149946368,161480704,173015040,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,-23068672,-25952256,-28835840,-31719424,-34603008,-37486592,-40370176,-43253760,-46137344,-51904512,-57671680,-63438848,-69206016,-74973184,-80740352,-86507520,-92274688,-103809024,-115343360,-126877696,-138412032,-149946368,-161480704,-173015040,0,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,25165824,28311552,31457280,34603008,37748736,40894464,44040192,47185920,50331648,56623104,62914560,69206016,75497472,81788928,88080384,94371840,100663296,113246208,125829120,138412032,150994944,163577856,176160768,188743680,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,-25165824,-28311552,-31457280,-34603008,-37748736,-40894464,-44040192,-47185920,-50331648,-56623104,-62914560,-69206016,-75497472,-81788928,-88080384,-94371840,-100663296,-113246208,-125829120,-138412032,-150994944,-163577856,-176160768,-188743680,0,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,27262976,30670848,34078720,37486592,40894464,44302336,47710208,51118080,54525952,61341696,68157440,74973184,81788928,88604672,95420416,102236160,109051904,122683392,136314880,149946368,163577856,177209344,190840832,204472320,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,-27262976,-30670848,-34078720,-37486592,-40894464,-44302336,-47710208,-51118080,-54525952,-61341696,-68157440,-74973184,-81788928,-88604672,-95420416,-102236160,-109051904,-122683392,-136314880,-149946368,-163577856,-177209344,-190840832,-204472320,0,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,29360128,33030144,36700160,40370176,44040192,47710208,51380224,55050240,58720256,66060288,73400320,80740352,88080384,95420416,102760448,110100480,117440512,132120576,146800640,161480704,176160768,190840832,205520896,220200960,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,-29360128,-33030144,-36700160,-40370176,-44040192,-47710208,-51380224,-55050240,-58720256,-66060288,-73400320,-80740352,-88080384,-95420416,-102760448,-110100480,-117440512,-132120576,-146800640,-161480704,-176160768,-190840832,-205520896,-220200960,0,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,31457280,35389440,39321600,43253760,47185920,51118080,55050240,58982400,62914560,70778880,78643200,86507520,94371840,102236160,110100480,117964800,125829120,141557760,157286400,173015040,188743680,204472320,220200960,235929600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,-31457280,-35389440,-39321600,-43253760,-47185920,-51118080,-55050240,-58982400,-62914560,-70778880,-78643200,-86507520,-94371840,-102236160,-110100480,-117964800,-125829120,-141557760,-157286400,-173015040,-188743680,-204472320,-220200960,-235929600,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,0,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,0,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,0,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,0,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,0,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,0,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,0,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,0,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,0,0,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,0,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,0,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,0,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,0,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,0,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,0,0,1,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,0,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,0,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,0,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,1,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,1,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,0,-1,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,1,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,0,-1,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,1,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,0,-1,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,1,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,0,-1,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,1,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,0,-1,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,1,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,0,-1,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,1,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,0,-1,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,1,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,0,-1,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,1,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,0,-1,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,1,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,0,-2,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,1,2,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,0,-2,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,2,2,2,2,2,2,3,3,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,0,-2,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,2,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,0,-2,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,2,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,0,-2,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,2,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,0,-2,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,2,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,0,-3,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,2,3,3,3,3,4,4,4,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,0,-3,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,3,3,3,3,4,4,4,5,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,0,-3,-3,-4,-4,-4,-5,-5,-6,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,3,3,3,4,4,4,5,5,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,0,-3,-4,-4,-5,-5,-5,-6,-6,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,3,3,4,4,5,5,5,6,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,0,-4,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,3,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,0,-4,-4,-5,-5,-6,-6,-7,-7,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,4,4,4,5,5,6,6,7,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,0,-4,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,4,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,0,-5,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,4,5,5,6,6,7,7,8,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,0,-5,-6,-6,-7,-7,-8,-8,-9,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,5,5,6,6,7,7,8,8,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,0,-6,-6,-7,-8,-8,-9,-9,-10,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,5,6,6,7,8,8,9,9,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,0,-6,-7,-8,-8,-9,-10,-10,-11,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,6,6,7,8,8,9,10,10,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,0,-7,-8,-8,-9,-10,-11,-11,-12,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,6,7,8,8,9,10,11,11,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,0,-7,-8,-9,-10,-11,-11,-12,-13,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,7,7,8,9,10,11,11,12,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,0,-8,-9,-10,-11,-11,-12,-13,-14,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,7,8,9,10,11,11,12,13,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,0,-8,-9,-10,-11,-12,-13,-14,-15,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,8,8,9,10,11,12,13,14,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,0,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,0,-10,-11,-12,-14,-15,-16,-17,-18,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,9,10,11,12,14,15,16,17,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,0,-11,-13,-14,-15,-16,-18,-19,-20,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,10,11,13,14,15,16,18,19,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,0,-12,-14,-15,-17,-18,-19,-21,-22,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,11,12,14,15,17,18,19,21,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,0,-14,-15,-17,-18,-20,-21,-23,-24,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,12,14,15,17,18,20,21,23,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,0,-15,-16,-18,-20,-21,-23,-24,-26,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,13,15,16,18,20,21,23,24,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,0,-16,-18,-19,-21,-23,-25,-26,-28,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,14,16,18,19,21,23,25,26,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,0,-17,-19,-21,-23,-24,-26,-28,-30,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,15,17,19,21,23,24,26,28,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,0,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,0,-20,-23,-25,-27,-29,-32,-34,-36,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,18,20,23,25,27,29,32,34,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,0,-23,-25,-28,-30,-33,-35,-38,-40,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,20,23,25,28,30,33,35,38,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,0,-25,-28,-30,-33,-36,-39,-41,-44,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,22,25,28,30,33,36,39,41,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,0,-27,-30,-33,-36,-39,-42,-45,-48,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,24,27,30,33,36,39,42,45,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,0,-29,-33,-36,-39,-42,-46,-49,-52,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,26,29,33,36,39,42,46,49,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,0,-32,-35,-39,-42,-46,-49,-53,-56,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,28,32,35,39,42,46,49,53,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,0,-34,-38,-41,-45,-49,-53,-56,-60,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,30,34,38,41,45,49,53,56,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,0,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,0,-41,-45,-50,-54,-59,-63,-68,-72,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,36,41,45,50,54,59,63,68,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,0,-45,-50,-55,-60,-65,-70,-75,-80,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,40,45,50,55,60,65,70,75,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,0,-50,-55,-61,-66,-72,-77,-83,-88,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,44,50,55,61,66,72,77,83,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,0,-54,-60,-66,-72,-78,-84,-90,-96,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,48,54,60,66,72,78,84,90,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,0,-59,-65,-72,-78,-85,-91,-98,-104,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,52,59,65,72,78,85,91,98,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,0,-63,-70,-77,-84,-91,-98,-105,-112,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,56,63,70,77,84,91,98,105,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,0,-68,-75,-83,-90,-98,-105,-113,-120,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,60,68,75,83,90,98,105,113,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,0,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,0,-81,-90,-99,-108,-117,-126,-135,-144,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,72,81,90,99,108,117,126,135,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,0,-90,-100,-110,-120,-130,-140,-150,-160,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,80,90,100,110,120,130,140,150,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,0,-99,-110,-121,-132,-143,-154,-165,-176,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,88,99,110,121,132,143,154,165,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,0,-108,-120,-132,-144,-156,-168,-180,-192,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,96,108,120,132,144,156,168,180,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,0,-117,-130,-143,-156,-169,-182,-195,-208,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,104,117,130,143,156,169,182,195,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,0,-126,-140,-154,-168,-182,-196,-210,-224,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,112,126,140,154,168,182,196,210,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,0,-135,-150,-165,-180,-195,-210,-225,-240,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,120,135,150,165,180,195,210,225,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,0,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,0,-162,-180,-198,-216,-234,-252,-270,-288,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,144,162,180,198,216,234,252,270,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,0,-180,-200,-220,-240,-260,-280,-300,-320,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,160,180,200,220,240,260,280,300,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,0,-198,-220,-242,-264,-286,-308,-330,-352,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,176,198,220,242,264,286,308,330,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,0,-216,-240,-264,-288,-312,-336,-360,-384,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,192,216,240,264,288,312,336,360,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,0,-234,-260,-286,-312,-338,-364,-390,-416,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,208,234,260,286,312,338,364,390,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,0,-252,-280,-308,-336,-364,-392,-420,-448,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,224,252,280,308,336,364,392,420,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,0,-270,-300,-330,-360,-390,-420,-450,-480,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,240,270,300,330,360,390,420,450,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,0,-288,-320,-352,-384,-416,-448,-480,-512,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,256,288,320,352,384,416,448,480,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,0,-324,-360,-396,-432,-468,-504,-540,-576,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,288,324,360,396,432,468,504,540,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,0,-360,-400,-440,-480,-520,-560,-600,-640,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,320,360,400,440,480,520,560,600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,0,-396,-440,-484,-528,-572,-616,-660,-704,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,352,396,440,484,528,572,616,660,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,0,-432,-480,-528,-576,-624,-672,-720,-768,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,384,432,480,528,576,624,672,720,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,0,-468,-520,-572,-624,-676,-728,-780,-832,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,416,468,520,572,624,676,728,780,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,0,-504,-560,-616,-672,-728,-784,-840,-896,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,448,504,560,616,672,728,784,840,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,0,-540,-600,-660,-720,-780,-840,-900,-960,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,480,540,600,660,720,780,840,900,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,0,-576,-640,-704,-768,-832,-896,-960,-1024,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,-16777216,-18874368,-20971520,-23068672,-25165824,-27262976,-29360128,-31457280,512,576,640,704,768,832,896,960,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,16777216,18874368,20971520,23068672,25165824,27262976,29360128,31457280,0,-648,-720,-792,-864,-936,-1008,-1080,-1152,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,-18874368,-21233664,-23592960,-25952256,-28311552,-30670848,-33030144,-35389440,576,648,720,792,864,936,1008,1080,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,18874368,21233664,23592960,25952256,28311552,30670848,33030144,35389440,0,-720,-800,-880,-960,-1040,-1120,-1200,-1280,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,-20971520,-23592960,-26214400,-28835840,-31457280,-34078720,-36700160,-39321600,640,720,800,880,960,1040,1120,1200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,20971520,23592960,26214400,28835840,31457280,34078720,36700160,39321600,0,-792,-880,-968,-1056,-1144,-1232,-1320,-1408,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,-23068672,-25952256,-28835840,-31719424,-34603008,-37486592,-40370176,-43253760,704,792,880,968,1056,1144,1232,1320,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,23068672,25952256,28835840,31719424,34603008,37486592,40370176,43253760,0,-864,-960,-1056,-1152,-1248,-1344,-1440,-1536,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,-25165824,-28311552,-31457280,-34603008,-37748736,-40894464,-44040192,-47185920,768,864,960,1056,1152,1248,1344,1440,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,25165824,28311552,31457280,34603008,37748736,40894464,44040192,47185920,0,-936,-1040,-1144,-1248,-1352,-1456,-1560,-1664,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,-27262976,-30670848,-34078720,-37486592,-40894464,-44302336,-47710208,-51118080,832,936,1040,1144,1248,1352,1456,1560,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,27262976,30670848,34078720,37486592,40894464,44302336,47710208,51118080,0,-1008,-1120,-1232,-1344,-1456,-1568,-1680,-1792,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,-29360128,-33030144,-36700160,-40370176,-44040192,-47710208,-51380224,-55050240,896,1008,1120,1232,1344,1456,1568,1680,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,29360128,33030144,36700160,40370176,44040192,47710208,51380224,55050240,0,-1080,-1200,-1320,-1440,-1560,-1680,-1800,-1920,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,-31457280,-35389440,-39321600,-43253760,-47185920,-51118080,-55050240,-58982400,960,1080,1200,1320,1440,1560,1680,1800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,31457280,35389440,39321600,43253760,47185920,51118080,55050240,58982400,0,-1152,-1280,-1408,-1536,-1664,-1792,-1920,-2048,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,-16777216,-18874368,-20971520,-23068672,-25165824,-27262976,-29360128,-31457280,-33554432,-37748736,-41943040,-46137344,-50331648,-54525952,-58720256,-62914560,1024,1152,1280,1408,1536,1664,1792,1920,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,16777216,18874368,20971520,23068672,25165824,27262976,29360128,31457280,33554432,37748736,41943040,46137344,50331648,54525952,58720256,62914560,0,-1296,-1440,-1584,-1728,-1872,-2016,-2160,-2304,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,-18874368,-21233664,-23592960,-25952256,-28311552,-30670848,-33030144,-35389440,-37748736,-42467328,-47185920,-51904512,-56623104,-61341696,-66060288,-70778880,1152,1296,1440,1584,1728,1872,2016,2160,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,18874368,21233664,23592960,25952256,28311552,30670848,33030144,35389440,37748736,42467328,47185920,51904512,56623104,61341696,66060288,70778880,0,-1440,-1600,-1760,-1920,-2080,-2240,-2400,-2560,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,-20971520,-23592960,-26214400,-28835840,-31457280,-34078720,-36700160,-39321600,-41943040,-47185920,-52428800,-57671680,-62914560,-68157440,-73400320,-78643200,1280,1440,1600,1760,1920,2080,2240,2400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,20971520,23592960,26214400,28835840,31457280,34078720,36700160,39321600,41943040,47185920,52428800,57671680,62914560,68157440,73400320,78643200,0,-1584,-1760,-1936,-2112,-2288,-2464,-2640,-2816,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,-23068672,-25952256,-28835840,-31719424,-34603008,-37486592,-40370176,-43253760,-46137344,-51904512,-57671680,-63438848,-69206016,-74973184,-80740352,-86507520,1408,1584,1760,1936,2112,2288,2464,2640,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,23068672,25952256,28835840,31719424,34603008,37486592,40370176,43253760,46137344,51904512,57671680,63438848,69206016,74973184,80740352,86507520,0,-1728,-1920,-2112,-2304,-2496,-2688,-2880,-3072,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,-25165824,-28311552,-31457280,-34603008,-37748736,-40894464,-44040192,-47185920,-50331648,-56623104,-62914560,-69206016,-75497472,-81788928,-88080384,-94371840,1536,1728,1920,2112,2304,2496,2688,2880,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,25165824,28311552,31457280,34603008,37748736,40894464,44040192,47185920,50331648,56623104,62914560,69206016,75497472,81788928,88080384,94371840,0,-1872,-2080,-2288,-2496,-2704,-2912,-3120,-3328,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,-27262976,-30670848,-34078720,-37486592,-40894464,-44302336,-47710208,-51118080,-54525952,-61341696,-68157440,-74973184,-81788928,-88604672,-95420416,-102236160,1664,1872,2080,2288,2496,2704,2912,3120,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,27262976,30670848,34078720,37486592,40894464,44302336,47710208,51118080,54525952,61341696,68157440,74973184,81788928,88604672,95420416,102236160,0,-2016,-2240,-2464,-2688,-2912,-3136,-3360,-3584,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,-29360128,-33030144,-36700160,-40370176,-44040192,-47710208,-51380224,-55050240,-58720256,-66060288,-73400320,-80740352,-88080384,-95420416,-102760448,-110100480,1792,2016,2240,2464,2688,2912,3136,3360,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,29360128,33030144,36700160,40370176,44040192,47710208,51380224,55050240,58720256,66060288,73400320,80740352,88080384,95420416,102760448,110100480,0,-2160,-2400,-2640,-2880,-3120,-3360,-3600,-3840,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,-31457280,-35389440,-39321600,-43253760,-47185920,-51118080,-55050240,-58982400,-62914560,-70778880,-78643200,-86507520,-94371840,-102236160,-110100480,-117964800,1920,2160,2400,2640,2880,3120,3360,3600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,31457280,35389440,39321600,43253760,47185920,51118080,55050240,58982400,62914560,70778880,78643200,86507520,94371840,102236160,110100480,117964800,0,-2304,-2560,-2816,-3072,-3328,-3584,-3840,-4096,-4608,-5120,-5632,-6144,-6656,-7168,-7680,-8192,-9216,-10240,-11264,-12288,-13312,-14336,-15360,-16384,-18432,-20480,-22528,-24576,-26624,-28672,-30720,-32768,-36864,-40960,-45056,-49152,-53248,-57344,-61440,-65536,-73728,-81920,-90112,-98304,-106496,-114688,-122880,-131072,-147456,-163840,-180224,-196608,-212992,-229376,-245760,-262144,-294912,-327680,-360448,-393216,-425984,-458752,-491520,-524288,-589824,-655360,-720896,-786432,-851968,-917504,-983040,-1048576,-1179648,-1310720,-1441792,-1572864,-1703936,-1835008,-1966080,-2097152,-2359296,-2621440,-2883584,-3145728,-3407872,-3670016,-3932160,-4194304,-4718592,-5242880,-5767168,-6291456,-6815744,-7340032,-7864320,-8388608,-9437184,-10485760,-11534336,-12582912,-13631488,-14680064,-15728640,-16777216,-18874368,-20971520,-23068672,-25165824,-27262976,-29360128,-31457280,-33554432,-37748736,-41943040,-46137344,-50331648,-54525952,-58720256,-62914560,-67108864,-75497472,-83886080,-92274688,-100663296,-109051904,-117440512,-125829120,2048,2304,2560,2816,3072,3328,3584,3840,4096,4608,5120,5632,6144,6656,7168,7680,8192,9216,10240,11264,12288,13312,14336,15360,16384,18432,20480,22528,24576,26624,28672,30720,32768,36864,40960,45056,49152,53248,57344,61440,65536,73728,81920,90112,98304,106496,114688,122880,131072,147456,163840,180224,196608,212992,229376,245760,262144,294912,327680,360448,393216,425984,458752,491520,524288,589824,655360,720896,786432,851968,917504,983040,1048576,1179648,1310720,1441792,1572864,1703936,1835008,1966080,2097152,2359296,2621440,2883584,3145728,3407872,3670016,3932160,4194304,4718592,5242880,5767168,6291456,6815744,7340032,7864320,8388608,9437184,10485760,11534336,12582912,13631488,14680064,15728640,16777216,18874368,20971520,23068672,25165824,27262976,29360128,31457280,33554432,37748736,41943040,46137344,50331648,54525952,58720256,62914560,67108864,75497472,83886080,92274688,100663296,109051904,117440512,125829120,0,-2592,-2880,-3168,-3456,-3744,-4032,-4320,-4608,-5184,-5760,-6336,-6912,-7488,-8064,-8640,-9216,-10368,-11520,-12672,-13824,-14976,-16128,-17280,-18432,-20736,-23040,-25344,-27648,-29952,-32256,-34560,-36864,-41472,-46080,-50688,-55296,-59904,-64512,-69120,-73728,-82944,-92160,-101376,-110592,-119808,-129024,-138240,-147456,-165888,-184320,-202752,-221184,-239616,-258048,-276480,-294912,-331776,-368640,-405504,-442368,-479232,-516096,-552960,-589824,-663552,-737280,-811008,-884736,-958464,-1032192,-1105920,-1179648,-1327104,-1474560,-1622016,-1769472,-1916928,-2064384,-2211840,-2359296,-2654208,-2949120,-3244032,-3538944,-3833856,-4128768,-4423680,-4718592,-5308416,-5898240,-6488064,-7077888,-7667712,-8257536,-8847360,-9437184,-10616832,-11796480,-12976128,-14155776,-15335424,-16515072,-17694720,-18874368,-21233664,-23592960,-25952256,-28311552,-30670848,-33030144,-35389440,-37748736,-42467328,-47185920,-51904512,-56623104,-61341696,-66060288,-70778880,-75497472,-84934656,-94371840,-103809024,-113246208,-122683392,-132120576,-141557760,2304,2592,2880,3168,3456,3744,4032,4320,4608,5184,5760,6336,6912,7488,8064,8640,9216,10368,11520,12672,13824,14976,16128,17280,18432,20736,23040,25344,27648,29952,32256,34560,36864,41472,46080,50688,55296,59904,64512,69120,73728,82944,92160,101376,110592,119808,129024,138240,147456,165888,184320,202752,221184,239616,258048,276480,294912,331776,368640,405504,442368,479232,516096,552960,589824,663552,737280,811008,884736,958464,1032192,1105920,1179648,1327104,1474560,1622016,1769472,1916928,2064384,2211840,2359296,2654208,2949120,3244032,3538944,3833856,4128768,4423680,4718592,5308416,5898240,6488064,7077888,7667712,8257536,8847360,9437184,10616832,11796480,12976128,14155776,15335424,16515072,17694720,18874368,21233664,23592960,25952256,28311552,30670848,33030144,35389440,37748736,42467328,47185920,51904512,56623104,61341696,66060288,70778880,75497472,84934656,94371840,103809024,113246208,122683392,132120576,141557760,0,-2880,-3200,-3520,-3840,-4160,-4480,-4800,-5120,-5760,-6400,-7040,-7680,-8320,-8960,-9600,-10240,-11520,-12800,-14080,-15360,-16640,-17920,-19200,-20480,-23040,-25600,-28160,-30720,-33280,-35840,-38400,-40960,-46080,-51200,-56320,-61440,-66560,-71680,-76800,-81920,-92160,-102400,-112640,-122880,-133120,-143360,-153600,-163840,-184320,-204800,-225280,-245760,-266240,-286720,-307200,-327680,-368640,-409600,-450560,-491520,-532480,-573440,-614400,-655360,-737280,-819200,-901120,-983040,-1064960,-1146880,-1228800,-1310720,-1474560,-1638400,-1802240,-1966080,-2129920,-2293760,-2457600,-2621440,-2949120,-3276800,-3604480,-3932160,-4259840,-4587520,-4915200,-5242880,-5898240,-6553600,-7208960,-7864320,-8519680,-9175040,-9830400,-10485760,-11796480,-13107200,-14417920,-15728640,-17039360,-18350080,-19660800,-20971520,-23592960,-26214400,-28835840,-31457280,-34078720,-36700160,-39321600,-41943040,-47185920,-52428800,-57671680,-62914560,-68157440,-73400320,-78643200,-83886080,-94371840,-104857600,-115343360,-125829120,-136314880,-146800640,-157286400,2560,2880,3200,3520,3840,4160,4480,4800,5120,5760,6400,7040,7680,8320,8960,9600,10240,11520,12800,14080,15360,16640,17920,19200,20480,23040,25600,28160,30720,33280,35840,38400,40960,46080,51200,56320,61440,66560,71680,76800,81920,92160,102400,112640,122880,133120,143360,153600,163840,184320,204800,225280,245760,266240,286720,307200,327680,368640,409600,450560,491520,532480,573440,614400,655360,737280,819200,901120,983040,1064960,1146880,1228800,1310720,1474560,1638400,1802240,1966080,2129920,2293760,2457600,2621440,2949120,3276800,3604480,3932160,4259840,4587520,4915200,5242880,5898240,6553600,7208960,7864320,8519680,9175040,9830400,10485760,11796480,13107200,14417920,15728640,17039360,18350080,19660800,20971520,23592960,26214400,28835840,31457280,34078720,36700160,39321600,41943040,47185920,52428800,57671680,62914560,68157440,73400320,78643200,83886080,94371840,104857600,115343360,125829120,136314880,146800640,157286400,0,-3168,-3520,-3872,-4224,-4576,-4928,-5280,-5632,-6336,-7040,-7744,-8448,-9152,-9856,-10560,-11264,-12672,-14080,-15488,-16896,-18304,-19712,-21120,-22528,-25344,-28160,-30976,-33792,-36608,-39424,-42240,-45056,-50688,-56320,-61952,-67584,-73216,-78848,-84480,-90112,-101376,-112640,-123904,-135168,-146432,-157696,-168960,-180224,-202752,-225280,-247808,-270336,-292864,-315392,-337920,-360448,-405504,-450560,-495616,-540672,-585728,-630784,-675840,-720896,-811008,-901120,-991232,-1081344,-1171456,-1261568,-1351680,-1441792,-1622016,-1802240,-1982464,-2162688,-2342912,-2523136,-2703360,-2883584,-3244032,-3604480,-3964928,-4325376,-4685824,-5046272,-5406720,-5767168,-6488064,-7208960,-7929856,-8650752,-9371648,-10092544,-10813440,-11534336,-12976128,-14417920,-15859712,-17301504,-18743296,-20185088,-21626880,-23068672,-25952256,-28835840,-31719424,-34603008,-37486592,-40370176,-43253760,-46137344,-51904512,-57671680,-63438848,-69206016,-74973184,-80740352,-86507520,-92274688,-103809024,-115343360,-126877696,-138412032,-149946368,-161480704,-173015040,2816,3168,3520,3872,4224,4576,4928,5280,5632,6336,7040,7744,8448,9152,9856,10560,11264,12672,14080,15488,16896,18304,19712,21120,22528,25344,28160,30976,33792,36608,39424,42240,45056,50688,56320,61952,67584,73216,78848,84480,90112,101376,112640,123904,135168,146432,157696,168960,180224,202752,225280,247808,270336,292864,315392,337920,360448,405504,450560,495616,540672,585728,630784,675840,720896,811008,901120,991232,1081344,1171456,1261568,1351680,1441792,1622016,1802240,1982464,2162688,2342912,2523136,2703360,2883584,3244032,3604480,3964928,4325376,4685824,5046272,5406720,5767168,6488064,7208960,7929856,8650752,9371648,10092544,10813440,11534336,12976128,14417920,15859712,17301504,18743296,20185088,21626880,23068672,25952256,28835840,31719424,34603008,37486592,40370176,43253760,46137344,51904512,57671680,63438848,69206016,74973184,80740352,86507520,92274688,103809024,115343360,126877696,138412032,149946368,161480704,173015040,0,-3456,-3840,-4224,-4608,-4992,-5376,-5760,-6144,-6912,-7680,-8448,-9216,-9984,-10752,-11520,-12288,-13824,-15360,-16896,-18432,-19968,-21504,-23040,-24576,-27648,-30720,-33792,-36864,-39936,-43008,-46080,-49152,-55296,-61440,-67584,-73728,-79872,-86016,-92160,-98304,-110592,-122880,-135168,-147456,-159744,-172032,-184320,-196608,-221184,-245760,-270336,-294912,-319488,-344064,-368640,-393216,-442368,-491520,-540672,-589824,-638976,-688128,-737280,-786432,-884736,-983040,-1081344,-1179648,-1277952,-1376256,-1474560,-1572864,-1769472,-1966080,-2162688,-2359296,-2555904,-2752512,-2949120,-3145728,-3538944,-3932160,-4325376,-4718592,-5111808,-5505024,-5898240,-6291456,-7077888,-7864320,-8650752,-9437184,-10223616,-11010048,-11796480,-12582912,-14155776,-15728640,-17301504,-18874368,-20447232,-22020096,-23592960,-25165824,-28311552,-31457280,-34603008,-37748736,-40894464,-44040192,-47185920,-50331648,-56623104,-62914560,-69206016,-75497472,-81788928,-88080384,-94371840,-100663296,-113246208,-125829120,-138412032,-150994944,-163577856,-176160768,-188743680,3072,3456,3840,4224,4608,4992,5376,5760,6144,6912,7680,8448,9216,9984,10752,11520,12288,13824,15360,16896,18432,19968,21504,23040,24576,27648,30720,33792,36864,39936,43008,46080,49152,55296,61440,67584,73728,79872,86016,92160,98304,110592,122880,135168,147456,159744,172032,184320,196608,221184,245760,270336,294912,319488,344064,368640,393216,442368,491520,540672,589824,638976,688128,737280,786432,884736,983040,1081344,1179648,1277952,1376256,1474560,1572864,1769472,1966080,2162688,2359296,2555904,2752512,2949120,3145728,3538944,3932160,4325376,4718592,5111808,5505024,5898240,6291456,7077888,7864320,8650752,9437184,10223616,11010048,11796480,12582912,14155776,15728640,17301504,18874368,20447232,22020096,23592960,25165824,28311552,31457280,34603008,37748736,40894464,44040192,47185920,50331648,56623104,62914560,69206016,75497472,81788928,88080384,94371840,100663296,113246208,125829120,138412032,150994944,163577856,176160768,188743680,0,-3744,-4160,-4576,-4992,-5408,-5824,-6240,-6656,-7488,-8320,-9152,-9984,-10816,-11648,-12480,-13312,-14976,-16640,-18304,-19968,-21632,-23296,-24960,-26624,-29952,-33280,-36608,-39936,-43264,-46592,-49920,-53248,-59904,-66560,-73216,-79872,-86528,-93184,-99840,-106496,-119808,-133120,-146432,-159744,-173056,-186368,-199680,-212992,-239616,-266240,-292864,-319488,-346112,-372736,-399360,-425984,-479232,-532480,-585728,-638976,-692224,-745472,-798720,-851968,-958464,-1064960,-1171456,-1277952,-1384448,-1490944,-1597440,-1703936,-1916928,-2129920,-2342912,-2555904,-2768896,-2981888,-3194880,-3407872,-3833856,-4259840,-4685824,-5111808,-5537792,-5963776,-6389760,-6815744,-7667712,-8519680,-9371648,-10223616,-11075584,-11927552,-12779520,-13631488,-15335424,-17039360,-18743296,-20447232,-22151168,-23855104,-25559040,-27262976,-30670848,-34078720,-37486592,-40894464,-44302336,-47710208,-51118080,-54525952,-61341696,-68157440,-74973184,-81788928,-88604672,-95420416,-102236160,-109051904,-122683392,-136314880,-149946368,-163577856,-177209344,-190840832,-204472320,3328,3744,4160,4576,4992,5408,5824,6240,6656,7488,8320,9152,9984,10816,11648,12480,13312,14976,16640,18304,19968,21632,23296,24960,26624,29952,33280,36608,39936,43264,46592,49920,53248,59904,66560,73216,79872,86528,93184,99840,106496,119808,133120,146432,159744,173056,186368,199680,212992,239616,266240,292864,319488,346112,372736,399360,425984,479232,532480,585728,638976,692224,745472,798720,851968,958464,1064960,1171456,1277952,1384448,1490944,1597440,1703936,1916928,2129920,2342912,2555904,2768896,2981888,3194880,3407872,3833856,4259840,4685824,5111808,5537792,5963776,6389760,6815744,7667712,8519680,9371648,10223616,11075584,11927552,12779520,13631488,15335424,17039360,18743296,20447232,22151168,23855104,25559040,27262976,30670848,34078720,37486592,40894464,44302336,47710208,51118080,54525952,61341696,68157440,74973184,81788928,88604672,95420416,102236160,109051904,122683392,136314880,149946368,163577856,177209344,190840832,204472320,0,-4032,-4480,-4928,-5376,-5824,-6272,-6720,-7168,-8064,-8960,-9856,-10752,-11648,-12544,-13440,-14336,-16128,-17920,-19712,-21504,-23296,-25088,-26880,-28672,-32256,-35840,-39424,-43008,-46592,-50176,-53760,-57344,-64512,-71680,-78848,-86016,-93184,-100352,-107520,-114688,-129024,-143360,-157696,-172032,-186368,-200704,-215040,-229376,-258048,-286720,-315392,-344064,-372736,-401408,-430080,-458752,-516096,-573440,-630784,-688128,-745472,-802816,-860160,-917504,-1032192,-1146880,-1261568,-1376256,-1490944,-1605632,-1720320,-1835008,-2064384,-2293760,-2523136,-2752512,-2981888,-3211264,-3440640,-3670016,-4128768,-4587520,-5046272,-5505024,-5963776,-6422528,-6881280,-7340032,-8257536,-9175040,-10092544,-11010048,-11927552,-12845056,-13762560,-14680064,-16515072,-18350080,-20185088,-22020096,-23855104,-25690112,-27525120,-29360128,-33030144,-36700160,-40370176,-44040192,-47710208,-51380224,-55050240,-58720256,-66060288,-73400320,-80740352,-88080384,-95420416,-102760448,-110100480,-117440512,-132120576,-146800640,-161480704,-176160768,-190840832,-205520896,-220200960,3584,4032,4480,4928,5376,5824,6272,6720,7168,8064,8960,9856,10752,11648,12544,13440,14336,16128,17920,19712,21504,23296,25088,26880,28672,32256,35840,39424,43008,46592,50176,53760,57344,64512,71680,78848,86016,93184,100352,107520,114688,129024,143360,157696,172032,186368,200704,215040,229376,258048,286720,315392,344064,372736,401408,430080,458752,516096,573440,630784,688128,745472,802816,860160,917504,1032192,1146880,1261568,1376256,1490944,1605632,1720320,1835008,2064384,2293760,2523136,2752512,2981888,3211264,3440640,3670016,4128768,4587520,5046272,5505024,5963776,6422528,6881280,7340032,8257536,9175040,10092544,11010048,11927552,12845056,13762560,14680064,16515072,18350080,20185088,22020096,23855104,25690112,27525120,29360128,33030144,36700160,40370176,44040192,47710208,51380224,55050240,58720256,66060288,73400320,80740352,88080384,95420416,102760448,110100480,117440512,132120576,146800640,161480704,176160768,190840832,205520896,220200960,0,-4320,-4800,-5280,-5760,-6240,-6720,-7200,-7680,-8640,-9600,-10560,-11520,-12480,-13440,-14400,-15360,-17280,-19200,-21120,-23040,-24960,-26880,-28800,-30720,-34560,-38400,-42240,-46080,-49920,-53760,-57600,-61440,-69120,-76800,-84480,-92160,-99840,-107520,-115200,-122880,-138240,-153600,-168960,-184320,-199680,-215040,-230400,-245760,-276480,-307200,-337920,-368640,-399360,-430080,-460800,-491520,-552960,-614400,-675840,-737280,-798720,-860160,-921600,-983040,-1105920,-1228800,-1351680,-1474560,-1597440,-1720320,-1843200,-1966080,-2211840,-2457600,-2703360,-2949120,-3194880,-3440640,-3686400,-3932160,-4423680,-4915200,-5406720,-5898240,-6389760,-6881280,-7372800,-7864320,-8847360,-9830400,-10813440,-11796480,-12779520,-13762560,-14745600,-15728640,-17694720,-19660800,-21626880,-23592960,-25559040,-27525120,-29491200,-31457280,-35389440,-39321600,-43253760,-47185920,-51118080,-55050240,-58982400,-62914560,-70778880,-78643200,-86507520,-94371840,-102236160,-110100480,-117964800,-125829120,-141557760,-157286400,-173015040,-188743680,-204472320,-220200960,-235929600,3840,4320,4800,5280,5760,6240,6720,7200,7680,8640,9600,10560,11520,12480,13440,14400,15360,17280,19200,21120,23040,24960,26880,28800,30720,34560,38400,42240,46080,49920,53760,57600,61440,69120,76800,84480,92160,99840,107520,115200,122880,138240,153600,168960,184320,199680,215040,230400,245760,276480,307200,337920,368640,399360,430080,460800,491520,552960,614400,675840,737280,798720,860160,921600,983040,1105920,1228800,1351680,1474560,1597440,1720320,1843200,1966080,2211840,2457600,2703360,2949120,3194880,3440640,3686400,3932160,4423680,4915200,5406720,5898240,6389760,6881280,7372800,7864320,8847360,9830400,10813440,11796480,12779520,13762560,14745600,15728640,17694720,19660800,21626880,23592960,25559040,27525120,29491200,31457280,35389440,39321600,43253760,47185920,51118080,55050240,58982400,62914560,70778880,78643200,86507520,94371840,102236160,110100480,117964800,125829120,141557760,157286400,173015040,188743680,204472320,220200960,235929600}
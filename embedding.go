@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// size of per-vector scale header
+const scaleHeaderSize = 4
+
+// EncodeVector quantizes vector with per-vector scale. The encoding is
+// max-abs value of vector (little endian float32) followed by float8 bytes,
+// elements are scaled so that max-abs maps onto the largest finite float8.
+// Infinities and NaN are not supported.
+func EncodeVector(v []float32) []byte {
+	maxAbs := float32(0)
+	for _, x := range v {
+		if x < 0 {
+			x = -x
+		}
+		if x > maxAbs {
+			maxAbs = x
+		}
+	}
+
+	buf := make([]byte, scaleHeaderSize+len(v))
+	binary.LittleEndian.PutUint32(buf, math.Float32bits(maxAbs))
+
+	if maxAbs == 0 {
+		return buf
+	}
+
+	// scale is computed in float64, it overflows float32 for subnormal max-abs
+	s := float64(f8tof32[MaxValue]) / float64(maxAbs)
+	for i, x := range v {
+		buf[scaleHeaderSize+i] = ToFloat8(float32(s * float64(x)))
+	}
+
+	return buf
+}
+
+// DecodeVector restores vector encoded by EncodeVector,
+// it returns nil if buffer is shorter than the header.
+func DecodeVector(buf []byte) []float32 {
+	if len(buf) < scaleHeaderSize {
+		return nil
+	}
+
+	maxAbs := math.Float32frombits(binary.LittleEndian.Uint32(buf))
+	s := float64(maxAbs) / float64(f8tof32[MaxValue])
+
+	v := make([]float32, len(buf)-scaleHeaderSize)
+	for i, f8 := range buf[scaleHeaderSize:] {
+		v[i] = float32(s * float64(f8tof32[f8]))
+	}
+
+	return v
+}
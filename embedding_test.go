@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEncodeVector(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, magnitude := range []float32{1e-4, 1e-2, 1, 1e3, 1e6} {
+		v := make([]float32, 128)
+		for i := range v {
+			v[i] = magnitude * float32(r.NormFloat64())
+		}
+
+		buf := EncodeVector(v)
+		if len(buf) != len(v)+4 {
+			t.Fatalf("unexpected length %d", len(buf))
+		}
+
+		x := DecodeVector(buf)
+		if len(x) != len(v) {
+			t.Fatalf("unexpected length %d", len(x))
+		}
+
+		// ToFloat8 truncates mantissa, relative error is below 2^-3 for normal values
+		maxAbs := float32(0)
+		for i := range v {
+			maxAbs = max(maxAbs, float32(math.Abs(float64(v[i]))))
+		}
+		for i := range v {
+			if d := math.Abs(float64(x[i] - v[i])); d > math.Abs(float64(v[i]))/8+float64(maxAbs)/1e3 {
+				t.Errorf("magnitude %g: v[%d] = %g, decoded %g", magnitude, i, v[i], x[i])
+			}
+		}
+	}
+}
+
+func TestEncodeVectorSubnormal(t *testing.T) {
+	// max-abs is float32 subnormal, 448 / max-abs overflows float32
+	v := []float32{1e-40, 0, -1e-40, 5e-41}
+	x := DecodeVector(EncodeVector(v))
+	for i := range v {
+		if d := math.Abs(float64(x[i] - v[i])); d > math.Abs(float64(v[i]))/8 {
+			t.Errorf("v[%d] = %g, decoded %g", i, v[i], x[i])
+		}
+	}
+}
+
+func TestEncodeVectorZero(t *testing.T) {
+	x := DecodeVector(EncodeVector([]float32{0, 0, 0}))
+	if len(x) != 3 || x[0] != 0 || x[1] != 0 || x[2] != 0 {
+		t.Errorf("unexpected vector %v", x)
+	}
+
+	if x := DecodeVector([]byte{0x00}); x != nil {
+		t.Errorf("unexpected vector %v", x)
+	}
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// Equals compares float8 values using IEEE 754 semantics: values are compared
+// numerically, NaN is not equal to anything (including itself) and -0 == +0.
+// Equals implements ModeDefault, it has neither NaN nor signed zero,
+// therefore Equals is equivalent to Identical. Use Mode.Equals to compare
+// values of other modes.
+func Equals(a, b Float8) bool { return ToFloat32(a) == ToFloat32(b) }
+
+// Identical compares bit patterns of float8 values.
+func Identical(a, b Float8) bool { return a == b }
+
+// tolerance of approximate comparison
+type tolerance struct {
+	ulps    int
+	epsilon float32
+}
+
+// EqualOption of approximate comparison
+type EqualOption func(*tolerance)
+
+// WithUlps values are equal if they are within n representable steps
+func WithUlps(n int) EqualOption {
+	return func(t *tolerance) { t.ulps = n }
+}
+
+// WithEpsilon values are equal if absolute difference is within epsilon
+func WithEpsilon(eps float32) EqualOption {
+	return func(t *tolerance) { t.epsilon = eps }
+}
+
+// Equal compares float8 values within tolerance. Values are equal if either
+// ULP or absolute tolerance is satisfied. Without options, the comparison is exact.
+func Equal(a, b Float8, opts ...EqualOption) bool {
+	var t tolerance
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	return t.equal(a, b)
+}
+
+// SliceEqual compares float8 slices element wise within tolerance.
+func SliceEqual(a, b []Float8, opts ...EqualOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	var t tolerance
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	for i := range a {
+		if !t.equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t tolerance) equal(a, b Float8) bool {
+	if a == b {
+		return true
+	}
+
+	if UlpDiff(a, b) <= t.ulps {
+		return true
+	}
+
+	e := ToFloat32(a) - ToFloat32(b)
+	if e < 0 {
+		e = -e
+	}
+	return e <= t.epsilon
+}
+
+// position of value in the total order of float8 values,
+// 0xff (-480) is -128, 0x00 is 0 and 0x7f (480) is 127.
+func rank(a Float8) int {
+	if a&signMask == 0 {
+		return int(a)
+	}
+
+	return -int(a&^signMask) - 1
+}
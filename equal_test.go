@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	for _, tt := range []struct {
+		a, b  Float8
+		opts  []EqualOption
+		equal bool
+	}{
+		{0x38, 0x38, nil, true},
+		{0x38, 0x39, nil, false},
+		{0x38, 0x39, []EqualOption{WithUlps(1)}, true},
+		{0x38, 0x3a, []EqualOption{WithUlps(1)}, false},
+		{0x00, 0x80, []EqualOption{WithUlps(1)}, true},
+		{0x01, 0x80, []EqualOption{WithUlps(1)}, false},
+		{0x38, 0x3a, []EqualOption{WithEpsilon(0.25)}, true},
+		{0x38, 0x3b, []EqualOption{WithEpsilon(0.25)}, false},
+		{0x38, 0x3b, []EqualOption{WithEpsilon(0.25), WithUlps(3)}, true},
+	} {
+		if v := Equal(tt.a, tt.b, tt.opts...); v != tt.equal {
+			t.Errorf("0x%02x == 0x%02x wanted=%v, got=%v", tt.a, tt.b, tt.equal, v)
+		}
+	}
+}
+
+func TestSliceEqual(t *testing.T) {
+	a := []Float8{0x38, 0x40, 0xb8}
+	b := []Float8{0x39, 0x40, 0xb9}
+
+	if SliceEqual(a, b) {
+		t.Errorf("unexpected exact equality")
+	}
+
+	if !SliceEqual(a, b, WithUlps(1)) {
+		t.Errorf("unexpected inequality")
+	}
+
+	if SliceEqual(a, b[:2], WithUlps(1)) {
+		t.Errorf("unexpected equality of different length")
+	}
+}
+
+func TestEquals(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			if Equals(uint8(a), uint8(b)) != Identical(uint8(a), uint8(b)) {
+				t.Errorf("0x%02x == 0x%02x is not identical", a, b)
+			}
+		}
+	}
+}
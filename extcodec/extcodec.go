@@ -0,0 +1,253 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package extcodec encodes float8 vectors as MessagePack extension type and
+// CBOR tagged byte string so that vectors survive round trips through
+// schemaless formats without expansion to arrays of floats.
+//
+// Both encodings share the payload: format (1 byte), dim (uvarint), data.
+//
+// The package has no dependencies, Vector implements marshaler interfaces
+// of github.com/vmihailenco/msgpack (payload of extension registered with
+// msgpack.RegisterExt(MsgpackExtType, (*Vector)(nil))) and
+// github.com/fxamacker/cbor (complete tagged item).
+package extcodec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/kshard/float8"
+)
+
+var (
+	// MsgpackExtType is application specific extension type of float8 vectors
+	MsgpackExtType int8 = 8
+
+	// CBORTag is tag number of float8 vectors (unassigned by IANA)
+	CBORTag uint64 = 0xf8e4
+)
+
+const formatE4M3 = 1
+
+// Vector of float8 values
+type Vector []float8.Float8
+
+// MarshalPayload encodes vector as payload shared by extension types
+func MarshalPayload(v []float8.Float8) []byte {
+	buf := make([]byte, 0, 1+binary.MaxVarintLen32+len(v))
+	buf = append(buf, formatE4M3)
+	buf = binary.AppendUvarint(buf, uint64(len(v)))
+	return append(buf, v...)
+}
+
+// UnmarshalPayload decodes payload of extension types, the data is copied.
+func UnmarshalPayload(buf []byte) ([]float8.Float8, error) {
+	if len(buf) < 1 {
+		return nil, errors.New("extcodec: empty payload")
+	}
+	if buf[0] != formatE4M3 {
+		return nil, fmt.Errorf("extcodec: unsupported format %d", buf[0])
+	}
+
+	dim, n := binary.Uvarint(buf[1:])
+	if n <= 0 || dim != uint64(len(buf)-1-n) {
+		return nil, errors.New("extcodec: malformed payload")
+	}
+
+	return append([]float8.Float8{}, buf[1+n:]...), nil
+}
+
+// AppendMsgpack appends vector encoded as MessagePack extension to dst
+func AppendMsgpack(dst []byte, v []float8.Float8) []byte {
+	payload := MarshalPayload(v)
+
+	switch n := len(payload); {
+	case n == 1:
+		dst = append(dst, 0xd4)
+	case n == 2:
+		dst = append(dst, 0xd5)
+	case n == 4:
+		dst = append(dst, 0xd6)
+	case n == 8:
+		dst = append(dst, 0xd7)
+	case n == 16:
+		dst = append(dst, 0xd8)
+	case n <= 0xff:
+		dst = append(dst, 0xc7, byte(n))
+	case n <= 0xffff:
+		dst = append(dst, 0xc8)
+		dst = binary.BigEndian.AppendUint16(dst, uint16(n))
+	default:
+		dst = append(dst, 0xc9)
+		dst = binary.BigEndian.AppendUint32(dst, uint32(n))
+	}
+
+	dst = append(dst, byte(MsgpackExtType))
+	return append(dst, payload...)
+}
+
+// DecodeMsgpack decodes vector from MessagePack extension, returns remaining bytes
+func DecodeMsgpack(buf []byte) ([]float8.Float8, []byte, error) {
+	if len(buf) < 1 {
+		return nil, nil, errors.New("extcodec: empty msgpack")
+	}
+
+	var n uint64
+	var at int
+	switch buf[0] {
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		n, at = 1<<(buf[0]-0xd4), 1
+	case 0xc7:
+		if len(buf) < 2 {
+			return nil, nil, errors.New("extcodec: malformed msgpack")
+		}
+		n, at = uint64(buf[1]), 2
+	case 0xc8:
+		if len(buf) < 3 {
+			return nil, nil, errors.New("extcodec: malformed msgpack")
+		}
+		n, at = uint64(binary.BigEndian.Uint16(buf[1:])), 3
+	case 0xc9:
+		if len(buf) < 5 {
+			return nil, nil, errors.New("extcodec: malformed msgpack")
+		}
+		n, at = uint64(binary.BigEndian.Uint32(buf[1:])), 5
+	default:
+		return nil, nil, fmt.Errorf("extcodec: msgpack 0x%02x is not extension", buf[0])
+	}
+
+	// length is compared before conversion, it overflows int on 32-bit platforms
+	if uint64(len(buf)) < uint64(at)+1+n {
+		return nil, nil, errors.New("extcodec: malformed msgpack")
+	}
+	if int8(buf[at]) != MsgpackExtType {
+		return nil, nil, fmt.Errorf("extcodec: unexpected extension type %d", int8(buf[at]))
+	}
+
+	end := at + 1 + int(n)
+	v, err := UnmarshalPayload(buf[at+1 : end])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, buf[end:], nil
+}
+
+// AppendCBOR appends vector encoded as CBOR tagged byte string to dst
+func AppendCBOR(dst []byte, v []float8.Float8) []byte {
+	payload := MarshalPayload(v)
+	dst = cborHead(dst, 6, CBORTag)
+	dst = cborHead(dst, 2, uint64(len(payload)))
+	return append(dst, payload...)
+}
+
+// DecodeCBOR decodes vector from CBOR tagged byte string, returns remaining bytes
+func DecodeCBOR(buf []byte) ([]float8.Float8, []byte, error) {
+	major, tag, buf, err := cborReadHead(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	if major != 6 || tag != CBORTag {
+		return nil, nil, errors.New("extcodec: cbor item is not float8 vector")
+	}
+
+	major, n, buf, err := cborReadHead(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	if major != 2 || n > uint64(len(buf)) {
+		return nil, nil, errors.New("extcodec: malformed cbor byte string")
+	}
+
+	v, err := UnmarshalPayload(buf[:n])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return v, buf[n:], nil
+}
+
+func cborHead(dst []byte, major byte, x uint64) []byte {
+	major <<= 5
+	switch {
+	case x < 24:
+		return append(dst, major|byte(x))
+	case x <= 0xff:
+		return append(dst, major|24, byte(x))
+	case x <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(dst, major|25), uint16(x))
+	case x <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(dst, major|26), uint32(x))
+	default:
+		return binary.BigEndian.AppendUint64(append(dst, major|27), x)
+	}
+}
+
+func cborReadHead(buf []byte) (byte, uint64, []byte, error) {
+	if len(buf) < 1 {
+		return 0, 0, nil, errors.New("extcodec: empty cbor")
+	}
+
+	major, info := buf[0]>>5, buf[0]&0x1f
+	buf = buf[1:]
+
+	size := 0
+	switch {
+	case info < 24:
+		return major, uint64(info), buf, nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, nil, errors.New("extcodec: unsupported cbor head")
+	}
+
+	if len(buf) < size {
+		return 0, 0, nil, errors.New("extcodec: malformed cbor head")
+	}
+
+	x := uint64(0)
+	for _, b := range buf[:size] {
+		x = x<<8 | uint64(b)
+	}
+
+	return major, x, buf[size:], nil
+}
+
+// MarshalMsgpack returns extension payload (vmihailenco/msgpack Marshaler)
+func (v Vector) MarshalMsgpack() ([]byte, error) { return MarshalPayload(v), nil }
+
+// UnmarshalMsgpack decodes extension payload (vmihailenco/msgpack Unmarshaler)
+func (v *Vector) UnmarshalMsgpack(buf []byte) (err error) {
+	*v, err = UnmarshalPayload(buf)
+	return
+}
+
+// MarshalCBOR returns tagged byte string (fxamacker/cbor Marshaler)
+func (v Vector) MarshalCBOR() ([]byte, error) { return AppendCBOR(nil, v), nil }
+
+// UnmarshalCBOR decodes tagged byte string (fxamacker/cbor Unmarshaler)
+func (v *Vector) UnmarshalCBOR(buf []byte) error {
+	seq, rest, err := DecodeCBOR(buf)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return errors.New("extcodec: trailing bytes after cbor item")
+	}
+
+	*v = seq
+	return nil
+}
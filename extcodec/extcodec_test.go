@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package extcodec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+func vectorOf(n int) []float8.Float8 {
+	v := make([]float8.Float8, n)
+	for i := range v {
+		v[i] = float8.Float8(i)
+	}
+	return v
+}
+
+func TestMsgpack(t *testing.T) {
+	for _, n := range []int{0, 2, 6, 14, 100, 1000, 70000} {
+		v := vectorOf(n)
+		buf := AppendMsgpack(nil, v)
+		buf = append(buf, 0xc0) // nil follows the extension
+
+		x, rest, err := DecodeMsgpack(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(x, v) || !bytes.Equal(rest, []byte{0xc0}) {
+			t.Errorf("n=%d unexpected decoding", n)
+		}
+	}
+
+	// fixext 4: type 8, payload format=1 dim=2 data
+	if buf := AppendMsgpack(nil, []float8.Float8{0x38, 0xb8}); !bytes.Equal(buf, []byte{0xd6, 0x08, 0x01, 0x02, 0x38, 0xb8}) {
+		t.Errorf("unexpected encoding %x", buf)
+	}
+}
+
+func TestCBOR(t *testing.T) {
+	for _, n := range []int{0, 2, 30, 1000, 70000} {
+		v := vectorOf(n)
+		buf := AppendCBOR(nil, v)
+		buf = append(buf, 0xf6) // null follows the item
+
+		x, rest, err := DecodeCBOR(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(x, v) || !bytes.Equal(rest, []byte{0xf6}) {
+			t.Errorf("n=%d unexpected decoding", n)
+		}
+	}
+
+	// tag 0xf8e4, bytes(4): format=1 dim=2 data
+	expected := []byte{0xd9, 0xf8, 0xe4, 0x44, 0x01, 0x02, 0x38, 0xb8}
+	if buf := AppendCBOR(nil, []float8.Float8{0x38, 0xb8}); !bytes.Equal(buf, expected) {
+		t.Errorf("unexpected encoding %x", buf)
+	}
+}
+
+func TestVector(t *testing.T) {
+	v := Vector{0x38, 0xb8}
+
+	payload, _ := v.MarshalMsgpack()
+	var x Vector
+	if err := x.UnmarshalMsgpack(payload); err != nil || !bytes.Equal(x, v) {
+		t.Errorf("msgpack got=%v err=%v", x, err)
+	}
+
+	item, _ := v.MarshalCBOR()
+	var y Vector
+	if err := y.UnmarshalCBOR(item); err != nil || !bytes.Equal(y, v) {
+		t.Errorf("cbor got=%v err=%v", y, err)
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	for _, buf := range [][]byte{{}, {0x02, 0x00}, {0x01, 0x05, 0x38}} {
+		if _, err := UnmarshalPayload(buf); err == nil {
+			t.Errorf("error is expected for payload %x", buf)
+		}
+	}
+
+	for _, buf := range [][]byte{{}, {0xc0}, {0xd6, 0x09, 0x01, 0x02, 0x38, 0xb8}, {0xc7, 0x10, 0x08}, {0xc9, 0xff, 0xff, 0xff, 0xff, 0x09}} {
+		if _, _, err := DecodeMsgpack(buf); err == nil {
+			t.Errorf("error is expected for msgpack %x", buf)
+		}
+	}
+
+	for _, buf := range [][]byte{{}, {0xf6}, {0xd9, 0xf8, 0xe4, 0x45, 0x01}} {
+		if _, _, err := DecodeCBOR(buf); err == nil {
+			t.Errorf("error is expected for cbor %x", buf)
+		}
+	}
+}
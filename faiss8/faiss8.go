@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package faiss8 exports float8 vectors as codes of Faiss scalar quantizer
+// (https://github.com/facebookresearch/faiss) and imports them back.
+//
+// SQ8 (QT_8bit, QT_8bit_uniform) code is one byte per dimension, the value
+// is vmin + vdiff × (code + 0.5) / 255. Trained parameters use layout of
+// ScalarQuantizer.trained: [vmin, vdiff] for uniform quantizer and
+// [vmin[0..d), vdiff[0..d)] otherwise, the range is min-max (RS_minmax).
+// SQfp16 (QT_fp16) code is little endian IEEE 754 half per dimension.
+// Imported vectors are quantized with per vector scale (see float8.Vector).
+package faiss8
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/internal/half"
+)
+
+// SQ8 is trained 8-bit scalar quantizer
+type SQ8 struct {
+	dim   int
+	vmin  []float32
+	vdiff []float32
+}
+
+// TrainSQ8 trains quantizer on vectors using min-max range statistics,
+// range is shared by all dimensions if uniform.
+func TrainSQ8(vectors []float8.Vector, uniform bool) (*SQ8, error) {
+	if len(vectors) == 0 {
+		return nil, errors.New("faiss8: no training vectors")
+	}
+
+	dim := vectors[0].Dim
+	n := dim
+	if uniform {
+		n = 1
+	}
+
+	vmin, vmax := make([]float32, n), make([]float32, n)
+	for i := range vmin {
+		vmin[i], vmax[i] = float32(math.Inf(1)), float32(math.Inf(-1))
+	}
+
+	for k, v := range vectors {
+		if v.Dim != dim {
+			return nil, fmt.Errorf("faiss8: vector %d has %d dimensions, expected %d", k, v.Dim, dim)
+		}
+
+		for i, x := range v.Data[:v.Dim] {
+			j := i % n
+			e := v.Scale * float8.ToFloat32(x)
+			vmin[j], vmax[j] = min(vmin[j], e), max(vmax[j], e)
+		}
+	}
+
+	for i := range vmax {
+		vmax[i] -= vmin[i]
+	}
+
+	return &SQ8{dim: dim, vmin: vmin, vdiff: vmax}, nil
+}
+
+// FromTrained creates quantizer of dimension dim from trained parameters
+// of Faiss ScalarQuantizer, see Trained.
+func FromTrained(dim int, trained []float32) (*SQ8, error) {
+	switch len(trained) {
+	case 2:
+		return &SQ8{dim: dim, vmin: trained[:1:1], vdiff: trained[1:2:2]}, nil
+	case 2 * dim:
+		return &SQ8{dim: dim, vmin: trained[:dim:dim], vdiff: trained[dim:]}, nil
+	default:
+		return nil, fmt.Errorf("faiss8: %d trained parameters of dimension %d", len(trained), dim)
+	}
+}
+
+// Trained parameters of quantizer in layout of Faiss ScalarQuantizer.trained
+func (q *SQ8) Trained() []float32 {
+	return append(append([]float32{}, q.vmin...), q.vdiff...)
+}
+
+// CodeSize is size of vector code in bytes
+func (q *SQ8) CodeSize() int { return q.dim }
+
+// Export appends SQ8 code of vector to dst
+func (q *SQ8) Export(dst []byte, v float8.Vector) ([]byte, error) {
+	if v.Dim != q.dim {
+		return nil, fmt.Errorf("faiss8: vector has %d dimensions, expected %d", v.Dim, q.dim)
+	}
+
+	n := len(q.vmin)
+	for i, x := range v.Data[:v.Dim] {
+		j := i % n
+
+		xi := float32(0)
+		if q.vdiff[j] != 0 {
+			xi = (v.Scale*float8.ToFloat32(x) - q.vmin[j]) / q.vdiff[j]
+		}
+		xi = max(0, min(1, xi))
+
+		dst = append(dst, byte(255*xi))
+	}
+
+	return dst, nil
+}
+
+// Import vector from SQ8 code
+func (q *SQ8) Import(code []byte) (float8.Vector, error) {
+	if len(code) != q.dim {
+		return float8.Vector{}, fmt.Errorf("faiss8: code has %d bytes, expected %d", len(code), q.dim)
+	}
+
+	n := len(q.vmin)
+	x := make([]float32, q.dim)
+	for i, c := range code {
+		j := i % n
+		x[i] = q.vmin[j] + q.vdiff[j]*((float32(c)+0.5)/255)
+	}
+
+	return float8.NewVector(x), nil
+}
+
+// ExportFP16 appends SQfp16 code of vector to dst
+func ExportFP16(dst []byte, v float8.Vector) []byte {
+	for _, x := range v.Data[:v.Dim] {
+		dst = binary.LittleEndian.AppendUint16(dst, half.FromFloat32(v.Scale*float8.ToFloat32(x)))
+	}
+	return dst
+}
+
+// ImportFP16 imports vector from SQfp16 code
+func ImportFP16(code []byte) (float8.Vector, error) {
+	if len(code)%2 != 0 {
+		return float8.Vector{}, fmt.Errorf("faiss8: fp16 code has odd length %d", len(code))
+	}
+
+	x := make([]float32, len(code)/2)
+	for i := range x {
+		x[i] = half.ToFloat32(binary.LittleEndian.Uint16(code[2*i:]))
+	}
+
+	return float8.NewVector(x), nil
+}
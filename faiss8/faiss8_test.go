@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package faiss8
+
+import (
+	"bytes"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+func corpus(r *rand.Rand, n, dim int) []float8.Vector {
+	seq := make([]float8.Vector, n)
+	for i := range seq {
+		x := make([]float32, dim)
+		for j := range x {
+			x[j] = float32(j) + float32(r.NormFloat64())
+		}
+		seq[i] = float8.NewVector(x)
+	}
+	return seq
+}
+
+func TestSQ8(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	vectors := corpus(r, 100, 16)
+
+	for _, uniform := range []bool{true, false} {
+		q, err := TrainSQ8(vectors, uniform)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		trained := q.Trained()
+		if uniform && len(trained) != 2 || !uniform && len(trained) != 32 {
+			t.Fatalf("uniform %v: %d trained parameters", uniform, len(trained))
+		}
+
+		p, err := FromTrained(16, trained)
+		if err != nil || !reflect.DeepEqual(p, q) {
+			t.Errorf("uniform %v: trained parameters are not restored", uniform)
+		}
+
+		var codes []byte
+		for _, v := range vectors {
+			if codes, err = q.Export(codes, v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if len(codes) != len(vectors)*q.CodeSize() {
+			t.Fatalf("uniform %v: %d bytes of codes", uniform, len(codes))
+		}
+
+		for i, v := range vectors {
+			w, err := q.Import(codes[i*q.CodeSize() : (i+1)*q.CodeSize()])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			x, y := v.Dequantize(), w.Dequantize()
+			for j := range x {
+				step := trained[len(trained)/2+j%(len(trained)/2)] / 255
+				// quantization step, float8 of decoded value and of source
+				if d := math.Abs(float64(x[j] - y[j])); d > float64(step)+0.13*math.Abs(float64(x[j]))+1e-3 {
+					t.Errorf("uniform %v: %g is imported as %g", uniform, x[j], y[j])
+				}
+			}
+		}
+	}
+}
+
+func TestSQ8Invalid(t *testing.T) {
+	if _, err := TrainSQ8(nil, true); err == nil {
+		t.Errorf("error is expected for empty training set")
+	}
+
+	if _, err := FromTrained(4, make([]float32, 3)); err == nil {
+		t.Errorf("error is expected for invalid trained parameters")
+	}
+
+	q, _ := FromTrained(4, []float32{0, 1})
+	if _, err := q.Export(nil, float8.NewVector(make([]float32, 3))); err == nil {
+		t.Errorf("error is expected for invalid dimension")
+	}
+	if _, err := q.Import(make([]byte, 3)); err == nil {
+		t.Errorf("error is expected for invalid code")
+	}
+}
+
+func TestFP16(t *testing.T) {
+	v := float8.NewVector([]float32{1, -2, 0.5, 100})
+
+	code := ExportFP16(nil, v)
+	if len(code) != 8 {
+		t.Fatalf("code has %d bytes", len(code))
+	}
+
+	w, err := ImportFP16(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(w.Data, v.Data) {
+		t.Errorf("got=%v expected=%v", w.Data, v.Data)
+	}
+
+	if _, err := ImportFP16(code[:3]); err == nil {
+		t.Errorf("error is expected for odd code")
+	}
+}
@@ -5,5 +5,5 @@ package float8
 // The code book for translating float8 to float32
 //
 
-var f8tof32 = [0x100]float32{0.000000,0.008789,0.009766,0.010742,0.011719,0.012695,0.013672,0.014648,0.015625,0.017578,0.019531,0.021484,0.023438,0.025391,0.027344,0.029297,0.031250,0.035156,0.039062,0.042969,0.046875,0.050781,0.054688,0.058594,0.062500,0.070312,0.078125,0.085938,0.093750,0.101562,0.109375,0.117188,0.125000,0.140625,0.156250,0.171875,0.187500,0.203125,0.218750,0.234375,0.250000,0.281250,0.312500,0.343750,0.375000,0.406250,0.437500,0.468750,0.500000,0.562500,0.625000,0.687500,0.750000,0.812500,0.875000,0.937500,1.000000,1.125000,1.250000,1.375000,1.500000,1.625000,1.750000,1.875000,2.000000,2.250000,2.500000,2.750000,3.000000,3.250000,3.500000,3.750000,4.000000,4.500000,5.000000,5.500000,6.000000,6.500000,7.000000,7.500000,8.000000,9.000000,10.000000,11.000000,12.000000,13.000000,14.000000,15.000000,16.000000,18.000000,20.000000,22.000000,24.000000,26.000000,28.000000,30.000000,32.000000,36.000000,40.000000,44.000000,48.000000,52.000000,56.000000,60.000000,64.000000,72.000000,80.000000,88.000000,96.000000,104.000000,112.000000,120.000000,128.000000,144.000000,160.000000,176.000000,192.000000,208.000000,224.000000,240.000000,256.000000,288.000000,320.000000,352.000000,384.000000,416.000000,448.000000,480.000000,-0.007812,-0.008789,-0.009766,-0.010742,-0.011719,-0.012695,-0.013672,-0.014648,-0.015625,-0.017578,-0.019531,-0.021484,-0.023438,-0.025391,-0.027344,-0.029297,-0.031250,-0.035156,-0.039062,-0.042969,-0.046875,-0.050781,-0.054688,-0.058594,-0.062500,-0.070312,-0.078125,-0.085938,-0.093750,-0.101562,-0.109375,-0.117188,-0.125000,-0.140625,-0.156250,-0.171875,-0.187500,-0.203125,-0.218750,-0.234375,-0.250000,-0.281250,-0.312500,-0.343750,-0.375000,-0.406250,-0.437500,-0.468750,-0.500000,-0.562500,-0.625000,-0.687500,-0.750000,-0.812500,-0.875000,-0.937500,-1.000000,-1.125000,-1.250000,-1.375000,-1.500000,-1.625000,-1.750000,-1.875000,-2.000000,-2.250000,-2.500000,-2.750000,-3.000000,-3.250000,-3.500000,-3.750000,-4.000000,-4.500000,-5.000000,-5.500000,-6.000000,-6.500000,-7.000000,-7.500000,-8.000000,-9.000000,-10.000000,-11.000000,-12.000000,-13.000000,-14.000000,-15.000000,-16.000000,-18.000000,-20.000000,-22.000000,-24.000000,-26.000000,-28.000000,-30.000000,-32.000000,-36.000000,-40.000000,-44.000000,-48.000000,-52.000000,-56.000000,-60.000000,-64.000000,-72.000000,-80.000000,-88.000000,-96.000000,-104.000000,-112.000000,-120.000000,-128.000000,-144.000000,-160.000000,-176.000000,-192.000000,-208.000000,-224.000000,-240.000000,-256.000000,-288.000000,-320.000000,-352.000000,-384.000000,-416.000000,-448.000000,-480.000000}
+var f8tof32 = [0x100]float32{0,0.0087890625,0.009765625,0.0107421875,0.01171875,0.0126953125,0.013671875,0.0146484375,0.015625,0.017578125,0.01953125,0.021484375,0.0234375,0.025390625,0.02734375,0.029296875,0.03125,0.03515625,0.0390625,0.04296875,0.046875,0.05078125,0.0546875,0.05859375,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.28125,0.3125,0.34375,0.375,0.40625,0.4375,0.46875,0.5,0.5625,0.625,0.6875,0.75,0.8125,0.875,0.9375,1,1.125,1.25,1.375,1.5,1.625,1.75,1.875,2,2.25,2.5,2.75,3,3.25,3.5,3.75,4,4.5,5,5.5,6,6.5,7,7.5,8,9,10,11,12,13,14,15,16,18,20,22,24,26,28,30,32,36,40,44,48,52,56,60,64,72,80,88,96,104,112,120,128,144,160,176,192,208,224,240,256,288,320,352,384,416,448,480,-0.0078125,-0.0087890625,-0.009765625,-0.0107421875,-0.01171875,-0.0126953125,-0.013671875,-0.0146484375,-0.015625,-0.017578125,-0.01953125,-0.021484375,-0.0234375,-0.025390625,-0.02734375,-0.029296875,-0.03125,-0.03515625,-0.0390625,-0.04296875,-0.046875,-0.05078125,-0.0546875,-0.05859375,-0.0625,-0.0703125,-0.078125,-0.0859375,-0.09375,-0.1015625,-0.109375,-0.1171875,-0.125,-0.140625,-0.15625,-0.171875,-0.1875,-0.203125,-0.21875,-0.234375,-0.25,-0.28125,-0.3125,-0.34375,-0.375,-0.40625,-0.4375,-0.46875,-0.5,-0.5625,-0.625,-0.6875,-0.75,-0.8125,-0.875,-0.9375,-1,-1.125,-1.25,-1.375,-1.5,-1.625,-1.75,-1.875,-2,-2.25,-2.5,-2.75,-3,-3.25,-3.5,-3.75,-4,-4.5,-5,-5.5,-6,-6.5,-7,-7.5,-8,-9,-10,-11,-12,-13,-14,-15,-16,-18,-20,-22,-24,-26,-28,-30,-32,-36,-40,-44,-48,-52,-56,-60,-64,-72,-80,-88,-96,-104,-112,-120,-128,-144,-160,-176,-192,-208,-224,-240,-256,-288,-320,-352,-384,-416,-448,-480}
 	
\ No newline at end of file
@@ -47,11 +47,23 @@ const (
 
 const (
 	Infinity = 0x7f | mantissaMask
+
+	// MaxValue is the largest finite value 448, saturating conversions
+	// and arithmetic clamp results to ±MaxValue (see ModeSaturate).
+	MaxValue = Infinity - 1
 )
 
 // Float8 data type
 type Float8 = uint8
 
+// IsInf reports whether f is an infinity, according to sign.
+// If sign > 0, IsInf reports whether f is positive infinity.
+// If sign < 0, IsInf reports whether f is negative infinity.
+// If sign == 0, IsInf reports whether f is either infinity.
+func IsInf(f Float8, sign int) bool {
+	return sign >= 0 && f == Infinity || sign <= 0 && f == signMask|Infinity
+}
+
 // Convert float32 to float8
 func ToFloat8(f32 float32) Float8 {
 	if f32 == 0.0 {
@@ -73,7 +85,7 @@ func ToFloat8(f32 float32) Float8 {
 
 	// Handle overflow and underflow
 	if exponent > exponentHi {
-		return Infinity
+		return sign<<7 | Infinity
 	}
 	if exponent < 0 {
 		return 0x00
@@ -94,27 +106,69 @@ func ToSlice8(f32s []float32) (f8s []Float8) {
 	}
 
 	f8s = make([]uint8, len(f32s))
+	toSlice8Kernel.fn(f8s, f32s)
+
+	return
+}
+
+func toSlice8Generic(f8s []Float8, f32s []float32) {
 	for i := 0; i < len(f32s); i += 4 {
 		a := f32s[i : i+4 : i+4]
 		b := f8s[i : i+4 : i+4]
 
 		b[0], b[1], b[2], b[3] = ToFloat8(a[0]), ToFloat8(a[1]), ToFloat8(a[2]), ToFloat8(a[3])
 	}
-
-	return
 }
 
 // Convert float8 to float32
 func ToFloat32(f8 Float8) float32 { return f8tof32[f8] }
 
-// Add float8(s)
-func Add(a, b Float8) Float8 { return add[int(a)<<8|int(b)] }
+// Convert []float8 to []float32
+func ToSlice32(f8s []Float8) (f32s []float32) {
+	f32s = make([]float32, len(f8s))
+	toSlice32Kernel.fn(f32s, f8s)
+
+	return
+}
+
+func toSlice32Generic(f32s []float32, f8s []Float8) {
+	for i, x := range f8s {
+		f32s[i] = f8tof32[x]
+	}
+}
 
 // Subtract float8(s)
 func Sub(a, b Float8) Float8 { return sub[int(a)<<8|int(b)] }
 
-// Multiply float8(s)
-func Mul(a, b Float8) Float8 { return mul[int(a)<<8|int(b)] }
+// Floating-point remainder of a/b, sign of result matches a (see math.Mod).
+// The format has no NaN, Mod(a, 0) and Mod(±Infinity, b) are 0.
+func Mod(a, b Float8) Float8 { return mod[int(a)<<8|int(b)] }
+
+// IEEE 754 remainder of a/b (see math.Remainder), undefined results
+// including Remainder(±Infinity, b) are 0.
+func Remainder(a, b Float8) Float8 { return remainder[int(a)<<8|int(b)] }
+
+// Hypot returns √(a² + b²) without intermediate overflow, the result
+// saturates to Infinity only if it is not representable (≥ 480).
+func Hypot(a, b Float8) Float8 { return hypot[int(a)<<8|int(b)] }
+
+// Pow returns a**b, negative base is defined for integer exponent only,
+// undefined results are 0 (the format has no NaN), Pow(0, b) of negative
+// b saturates to Infinity of the code book.
+func Pow(a, b Float8) Float8 { return pow[int(a)<<8|int(b)] }
 
-// Divide float8(s)
-func Div(a, b Float8) Float8 { return div[int(a)<<8|int(b)] }
+// Atan2 returns angle of point (x, y) in radians within [-π, π]
+func Atan2(y, x Float8) Float8 { return atan2[int(y)<<8|int(x)] }
+
+// Norm2 is length of 2D vector (x, y) with float32 precision
+func Norm2(x, y Float8) float32 {
+	return float32(math.Hypot(float64(f8tof32[x]), float64(f8tof32[y])))
+}
+
+// Divide float8(s), see SetDivPolicy for division by zero
+func Div(a, b Float8) Float8 {
+	if b == 0 {
+		return divByZero(a)
+	}
+	return div[int(a)<<8|int(b)]
+}
@@ -10,30 +10,32 @@ package float8
 
 import (
 	"bytes"
+	"math"
 	"testing"
 
 	"github.com/chewxy/math32"
 	"github.com/kshard/float8/internal/math8"
 )
 
-func norm(x float32) float32 {
-	// Note: It would be expected that ToFloat8(ToFloat32(x)) = x
-	//       but due to noticeable error, it is not a case on small numbers
-	//       small epsilon makes number to be approximate
-	if x < 0 {
-		return x - 1e-6
-	}
-
-	return x + 1e-6
-}
-
 func TestToFloat8(t *testing.T) {
 	for expected, f32 := range f8tof32 {
-		val := ToFloat8(norm(f32))
+		val := ToFloat8(f32)
 		if val != uint8(expected) {
 			t.Errorf("0x%02x got=0x%02x f32=%f", expected, val, f32)
 		}
 	}
+
+	// overflow keeps sign
+	for f32, expected := range map[float32]Float8{
+		1000:                  Infinity,
+		-1000:                 signMask | Infinity,
+		float32(math.Inf(1)):  Infinity,
+		float32(math.Inf(-1)): signMask | Infinity,
+	} {
+		if val := ToFloat8(f32); val != expected {
+			t.Errorf("%g got=0x%02x expected=0x%02x", f32, val, expected)
+		}
+	}
 }
 
 func TestToSlice8(t *testing.T) {
@@ -42,7 +44,7 @@ func TestToSlice8(t *testing.T) {
 
 	for f8, f32 := range f8tof32 {
 		expected = append(expected, Float8(f8))
-		f32s = append(f32s, norm(f32))
+		f32s = append(f32s, f32)
 	}
 
 	f8s := ToSlice8(f32s)
@@ -51,6 +53,21 @@ func TestToSlice8(t *testing.T) {
 	}
 }
 
+func TestToSlice32(t *testing.T) {
+	f8s := make([]Float8, 0x100)
+	for a := range f8s {
+		f8s[a] = Float8(a)
+	}
+
+	f32s := ToSlice32(f8s)
+	for a, c := range f32s {
+		e := math8.ToFloat32(uint8(a))
+		if math32.Abs(c-e) > 1e-6 {
+			t.Errorf("0x%02x wanted=%f, got=%f", a, e, c)
+		}
+	}
+}
+
 func TestToFloat32(t *testing.T) {
 	for a := 0; a < 0x100; a++ {
 		c := ToFloat32(uint8(a))
@@ -109,6 +126,197 @@ func TestDiv(t *testing.T) {
 	}
 }
 
+func TestMod(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			if c, e := Mod(uint8(a), uint8(b)), math8.Mod(uint8(a), uint8(b)); c != e {
+				t.Errorf("0x%02x mod 0x%02x wanted=0x%02x, got=0x%02x", a, b, e, c)
+			}
+			if c, e := Remainder(uint8(a), uint8(b)), math8.Remainder(uint8(a), uint8(b)); c != e {
+				t.Errorf("0x%02x rem 0x%02x wanted=0x%02x, got=0x%02x", a, b, e, c)
+			}
+		}
+	}
+
+	// 7 mod 2 = 1, -7 mod 2 = -1, remainder(7, 2) = -1
+	if c := Mod(ToFloat8(7), ToFloat8(2)); c != 0x38 {
+		t.Errorf("7 mod 2 = 0x%02x", c)
+	}
+	if c := Mod(ToFloat8(-7), ToFloat8(2)); c != 0xb8 {
+		t.Errorf("-7 mod 2 = 0x%02x", c)
+	}
+	if c := Remainder(ToFloat8(7), ToFloat8(2)); c != 0xb8 {
+		t.Errorf("remainder(7, 2) = 0x%02x", c)
+	}
+
+	for _, inf := range []Float8{Infinity, signMask | Infinity} {
+		if c := Mod(inf, ToFloat8(7)); c != 0 {
+			t.Errorf("mod(0x%02x, 7) = 0x%02x", inf, c)
+		}
+		if c := Remainder(inf, ToFloat8(7)); c != 0 {
+			t.Errorf("remainder(0x%02x, 7) = 0x%02x", inf, c)
+		}
+	}
+}
+
+func TestHypot(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			if c, e := Hypot(uint8(a), uint8(b)), math8.Hypot(uint8(a), uint8(b)); c != e {
+				t.Errorf("hypot(0x%02x, 0x%02x) wanted=0x%02x, got=0x%02x", a, b, e, c)
+			}
+		}
+	}
+
+	// 288² overflows float8 but the hypotenuse is representable
+	x, y := ToFloat8(288), ToFloat8(-216)
+	if c := Hypot(x, y); c != ToFloat8(360) {
+		t.Errorf("hypot(288, -216) = %g", ToFloat32(c))
+	}
+
+	// hypotenuse is never shorter than legs, overflow saturates
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			c, x, y := Hypot(uint8(a), uint8(b)), uint8(a)&^signMask, uint8(b)&^signMask
+			if ToFloat32(c) < ToFloat32(max(x, y)) {
+				t.Errorf("hypot(0x%02x, 0x%02x) = %g", a, b, ToFloat32(c))
+			}
+		}
+	}
+	for _, c := range []struct{ a, b, expected Float8 }{
+		{MaxValue, MaxValue, Infinity},
+		{Infinity, Infinity, Infinity},
+		{signMask | Infinity, MaxValue, Infinity},
+		{MaxValue, 0, MaxValue},
+		{ToFloat8(320), ToFloat8(320), MaxValue}, // 452.5
+		{ToFloat8(352), ToFloat8(352), Infinity}, // 497.8
+	} {
+		if v := Hypot(c.a, c.b); v != c.expected {
+			t.Errorf("hypot(0x%02x, 0x%02x) = 0x%02x", c.a, c.b, v)
+		}
+	}
+
+	if n := Norm2(ToFloat8(3), ToFloat8(4)); n != 5 {
+		t.Errorf("norm2(3, 4) = %g", n)
+	}
+}
+
+func TestPow(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			if c, e := Pow(uint8(a), uint8(b)), math8.Pow(uint8(a), uint8(b)); c != e {
+				t.Errorf("pow(0x%02x, 0x%02x) wanted=0x%02x, got=0x%02x", a, b, e, c)
+			}
+		}
+	}
+
+	for name, c := range map[string]struct {
+		a, b     float32
+		expected float32
+	}{
+		"2³":     {2, 3, 8},
+		"(-2)³":  {-2, 3, -8},
+		"(-2)²":  {-2, 2, 4},
+		"(-8)^½": {-8, 0.5, 0},
+		"4^½":    {4, 0.5, 2},
+		"0⁰":     {0, 0, 1},
+		"2⁻¹":    {2, -1, 0.5},
+		"(-3)^0": {-3, 0, 1},
+		"0^(-1)": {0, -1, ToFloat32(Infinity)},
+		"2⁸":     {2, 8, 256},
+		"2⁹":     {2, 9, ToFloat32(Infinity)},
+		"(-2)⁹":  {-2, 9, ToFloat32(signMask | Infinity)},
+	} {
+		if v := ToFloat32(Pow(ToFloat8(c.a), ToFloat8(c.b))); v != c.expected {
+			t.Errorf("%s = %g, expected %g", name, v, c.expected)
+		}
+	}
+}
+
+func TestAtan2(t *testing.T) {
+	for y := 0; y < 0x100; y++ {
+		for x := 0; x < 0x100; x++ {
+			if c, e := Atan2(uint8(y), uint8(x)), math8.Atan2(uint8(y), uint8(x)); c != e {
+				t.Errorf("atan2(0x%02x, 0x%02x) wanted=0x%02x, got=0x%02x", y, x, e, c)
+			}
+		}
+	}
+
+	for name, c := range map[string]struct {
+		y, x     float32
+		expected float64
+	}{
+		"(1, 1)":   {1, 1, math.Pi / 4},
+		"(1, 0)":   {1, 0, math.Pi / 2},
+		"(0, -1)":  {0, -1, math.Pi},
+		"(-1, -1)": {-1, -1, -3 * math.Pi / 4},
+		"(0, 0)":   {0, 0, 0},
+	} {
+		if v, e := Atan2(ToFloat8(c.y), ToFloat8(c.x)), ToFloat8(float32(c.expected)); v != e {
+			t.Errorf("atan2%s = %g, expected %g", name, ToFloat32(v), ToFloat32(e))
+		}
+	}
+}
+
+func TestUnary(t *testing.T) {
+	for name, op := range map[string]struct {
+		f8  func(Float8) Float8
+		f64 func(float64) float64
+	}{
+		"sqrt":    {Sqrt, math.Sqrt},
+		"exp":     {Exp, math.Exp},
+		"exp2":    {Exp2, math.Exp2},
+		"expm1":   {Expm1, math.Expm1},
+		"log10":   {Log10, math.Log10},
+		"log1p":   {Log1p, math.Log1p},
+		"sin":     {Sin, math.Sin},
+		"cos":     {Cos, math.Cos},
+		"erf":     {Erf, math.Erf},
+		"phi":     {Phi, func(x float64) float64 { return 0.5 * math.Erfc(-x/math.Sqrt2) }},
+		"sigmoid": {Sigmoid, func(x float64) float64 { return 1.0 / (1.0 + math.Exp(-x)) }},
+		"recip":   {Recip, func(x float64) float64 { return 1.0 / x }},
+		"floor":   {Floor, math.Floor},
+		"ceil":    {Ceil, math.Ceil},
+		"round":   {Round, math.Round},
+		"trunc":   {Trunc, math.Trunc},
+		"cube":    {Cube, func(x float64) float64 { return x * x * x }},
+	} {
+		for a := 0; a < 0x100; a++ {
+			c := op.f8(uint8(a))
+			e := math8.Apply(op.f64, uint8(a))
+			if c != e {
+				t.Errorf("%s(0x%02x) wanted=0x%02x, got=0x%02x", name, a, e, c)
+			}
+		}
+	}
+}
+
+func TestSqr(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		if c, e := Sqr(uint8(a)), Mul(uint8(a), uint8(a)); c != e {
+			t.Errorf("0x%02x² wanted=0x%02x, got=0x%02x", a, e, c)
+		}
+	}
+}
+
+func TestRoundingExact(t *testing.T) {
+	for name, op := range map[string]struct {
+		f8  func(Float8) Float8
+		f64 func(float64) float64
+	}{
+		"floor": {Floor, math.Floor},
+		"ceil":  {Ceil, math.Ceil},
+		"round": {Round, math.Round},
+		"trunc": {Trunc, math.Trunc},
+	} {
+		for a := 0; a < 0x100; a++ {
+			if c, e := exact(op.f8(uint8(a))), op.f64(exact(uint8(a))); c != e && !(c == 0 && e == 0) {
+				t.Errorf("%s(0x%02x) wanted=%g, got=%g", name, a, e, c)
+			}
+		}
+	}
+}
+
 var (
 	f8   uint8
 	f32  float32
@@ -147,3 +355,45 @@ func BenchmarkToSlice8(b *testing.B) {
 		f8s = ToSlice8(f32s)
 	}
 }
+
+func BenchmarkSqr(b *testing.B) {
+	for i := b.N; i > 0; i-- {
+		f8 = Sqr(uint8(i % 0x100))
+	}
+}
+
+func TestSinCos(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		if s, c := SinCos(Float8(a)); s != Sin(Float8(a)) || c != Cos(Float8(a)) {
+			t.Errorf("sincos(0x%02x) got=(0x%02x, 0x%02x)", a, s, c)
+		}
+	}
+
+	if s, c := SinCos(0); s != 0 || c != ToFloat8(1) {
+		t.Errorf("sincos(0) got=(%g, %g)", ToFloat32(s), ToFloat32(c))
+	}
+}
+
+func TestTranscendental(t *testing.T) {
+	for name, c := range map[string]struct{ got, expected float32 }{
+		"exp2(3)":       {ToFloat32(Exp2(ToFloat8(3))), 8},
+		"exp2(8)":       {ToFloat32(Exp2(ToFloat8(8))), 256},
+		"exp2(9)":       {ToFloat32(Exp2(ToFloat8(9))), ToFloat32(Infinity)},
+		"exp2(10)":      {ToFloat32(Exp2(ToFloat8(10))), ToFloat32(Infinity)},
+		"exp(6)":        {ToFloat32(Exp(ToFloat8(6))), 384},
+		"exp(6.5)":      {ToFloat32(Exp(ToFloat8(6.5))), ToFloat32(Infinity)},
+		"exp(7)":        {ToFloat32(Exp(ToFloat8(7))), ToFloat32(Infinity)},
+		"log10(1)":      {ToFloat32(Log10(ToFloat8(1))), 0},
+		"log1p(0)":      {ToFloat32(Log1p(ToFloat8(0))), 0},
+		"expm1(0)":      {ToFloat32(Expm1(ToFloat8(0))), 0},
+		"log10(-1)":     {ToFloat32(Log10(ToFloat8(-1))), 0},
+		"erf(0)":        {ToFloat32(Erf(0)), 0},
+		"phi(0)":        {ToFloat32(Phi(0)), 0.5},
+		"phi(-8)":       {ToFloat32(Phi(ToFloat8(-8))), 0},
+		"log1p(0.0625)": {ToFloat32(Log1p(ToFloat8(0.0625))), ToFloat32(ToFloat8(float32(math.Log1p(0.0625))))},
+	} {
+		if c.got != c.expected {
+			t.Errorf("%s = %g, expected %g", name, c.got, c.expected)
+		}
+	}
+}
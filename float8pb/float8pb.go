@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package float8pb implements the canonical Protocol Buffers representation
+// of float8 vectors defined by float8.proto. The package encodes wire format
+// directly, it is compatible with code generated from float8.proto but does
+// not require protobuf runtime.
+package float8pb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/kshard/float8"
+)
+
+// Format of 8-bit floating point values
+type Format int32
+
+const (
+	FormatUnspecified Format = 0
+	FormatE4M3        Format = 1
+	FormatE5M2        Format = 2
+)
+
+// field numbers of message Vector
+const (
+	fieldData   = 1
+	fieldDim    = 2
+	fieldFormat = 3
+	fieldScale  = 4
+)
+
+// wire types
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// Vector of quantized values, x[i] = Scale × Data[i]
+type Vector struct {
+	Data  []float8.Float8
+	Scale float32
+}
+
+// Marshal encodes vector as message Vector
+func Marshal(v Vector) []byte {
+	buf := make([]byte, 0, len(v.Data)+24)
+
+	if len(v.Data) != 0 {
+		buf = binary.AppendUvarint(buf, fieldData<<3|wireBytes)
+		buf = binary.AppendUvarint(buf, uint64(len(v.Data)))
+		buf = append(buf, v.Data...)
+
+		buf = binary.AppendUvarint(buf, fieldDim<<3|wireVarint)
+		buf = binary.AppendUvarint(buf, uint64(len(v.Data)))
+	}
+
+	buf = binary.AppendUvarint(buf, fieldFormat<<3|wireVarint)
+	buf = binary.AppendUvarint(buf, uint64(FormatE4M3))
+
+	if v.Scale != 0 && v.Scale != 1 {
+		buf = binary.AppendUvarint(buf, fieldScale<<3|wireFixed32)
+		buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(v.Scale))
+	}
+
+	return buf
+}
+
+// Unmarshal decodes message Vector, unknown fields are skipped.
+// The data is copied from buffer.
+func Unmarshal(buf []byte) (Vector, error) {
+	var (
+		v      Vector
+		dim    uint64
+		format uint64
+	)
+
+	for len(buf) > 0 {
+		tag, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return Vector{}, errors.New("float8pb: malformed tag")
+		}
+		buf = buf[n:]
+
+		field, wire := tag>>3, tag&0x7
+		switch wire {
+		case wireVarint:
+			x, n := binary.Uvarint(buf)
+			if n <= 0 {
+				return Vector{}, errors.New("float8pb: malformed varint")
+			}
+			buf = buf[n:]
+
+			switch field {
+			case fieldDim:
+				dim = x
+			case fieldFormat:
+				format = x
+			}
+
+		case wireBytes:
+			size, n := binary.Uvarint(buf)
+			if n <= 0 || size > uint64(len(buf)-n) {
+				return Vector{}, errors.New("float8pb: malformed bytes")
+			}
+			buf = buf[n:]
+
+			if field == fieldData {
+				v.Data = append([]float8.Float8(nil), buf[:size]...)
+			}
+			buf = buf[size:]
+
+		case wireFixed32:
+			if len(buf) < 4 {
+				return Vector{}, errors.New("float8pb: malformed fixed32")
+			}
+			if field == fieldScale {
+				v.Scale = math.Float32frombits(binary.LittleEndian.Uint32(buf))
+			}
+			buf = buf[4:]
+
+		case wireFixed64:
+			if len(buf) < 8 {
+				return Vector{}, errors.New("float8pb: malformed fixed64")
+			}
+			buf = buf[8:]
+
+		default:
+			return Vector{}, fmt.Errorf("float8pb: unsupported wire type %d", wire)
+		}
+	}
+
+	switch Format(format) {
+	case FormatUnspecified, FormatE4M3:
+	default:
+		return Vector{}, fmt.Errorf("float8pb: unsupported format %d", format)
+	}
+
+	if dim != uint64(len(v.Data)) {
+		return Vector{}, fmt.Errorf("float8pb: dim %d does not match %d bytes", dim, len(v.Data))
+	}
+
+	if v.Scale == 0 {
+		v.Scale = 1
+	}
+
+	return v, nil
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8pb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+func TestMarshal(t *testing.T) {
+	for _, v := range []Vector{
+		{Data: []float8.Float8{0x00, 0x38, 0xb8}, Scale: 0.5},
+		{Data: []float8.Float8{0x7f}, Scale: 1},
+		{Data: nil, Scale: 1},
+	} {
+		x, err := Unmarshal(Marshal(v))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(x.Data, v.Data) || x.Scale != v.Scale {
+			t.Errorf("got=%v expected=%v", x, v)
+		}
+	}
+}
+
+func TestWireFormat(t *testing.T) {
+	// protoc --encode=kshard.float8.Vector float8.proto <<< 'data: "\x38\xb8" dim: 2 format: FORMAT_E4M3 scale: 2'
+	wire := []byte{0x0a, 0x02, 0x38, 0xb8, 0x10, 0x02, 0x18, 0x01, 0x25, 0x00, 0x00, 0x00, 0x40}
+
+	if buf := Marshal(Vector{Data: []float8.Float8{0x38, 0xb8}, Scale: 2}); !bytes.Equal(buf, wire) {
+		t.Errorf("got=%x expected=%x", buf, wire)
+	}
+
+	// unknown field 15 (varint) is skipped
+	v, err := Unmarshal(append(wire, 0x78, 0x01))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(v.Data, []float8.Float8{0x38, 0xb8}) || v.Scale != 2 {
+		t.Errorf("unexpected vector %v", v)
+	}
+}
+
+func TestUnmarshalMalformed(t *testing.T) {
+	for _, wire := range [][]byte{
+		{0x0a, 0x05, 0x38},             // truncated bytes
+		{0x0a, 0x01, 0x38, 0x10, 0x02}, // invalid dim
+		{0x18, 0x02},                   // unsupported format
+		{0x25, 0x00},                   // truncated fixed32
+	} {
+		if _, err := Unmarshal(wire); err == nil {
+			t.Errorf("error is expected for %x", wire)
+		}
+	}
+}
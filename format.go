@@ -0,0 +1,234 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Format describes 8-bit floating point format: sign bit followed by
+// exponent and mantissa bits, the behavior is defined by fields only.
+//
+// Format with both infinity and NaN reserves the all ones exponent as
+// IEEE 754 does. Format with one of them uses the all ones pattern S.1111111
+// for it, e.g. NaN of E4M3FN and Infinity of E4M3. Without subnormals the
+// zero exponent is normal and 0x00 is the only zero, e.g. 0x80 is -2^-7 in
+// E4M3. Format is comparable, so it is usable as map key or for negotiation
+// of formats between services. Validate formats received from peers,
+// invalid formats decode to NaN.
+type Format struct {
+	ExponentBits int
+	MantissaBits int
+	Bias         int
+	HasInf       bool
+	HasNaN       bool
+	Subnormals   bool
+}
+
+// Supported formats
+var (
+	// E4M3 is float8 of the package: bias 7, no subnormals, 0x7f is Infinity
+	E4M3 = Format{ExponentBits: 4, MantissaBits: 3, Bias: 7, HasInf: true}
+
+	// E4M3FN is OCP FP8 E4M3FN: bias 7, subnormals, no infinity,
+	// overflow saturates to ±448
+	E4M3FN = Format{ExponentBits: 4, MantissaBits: 3, Bias: 7, HasNaN: true, Subnormals: true}
+
+	// E5M2 is OCP FP8 E5M2: bias 15, subnormals, IEEE infinity and NaN
+	E5M2 = Format{ExponentBits: 5, MantissaBits: 2, Bias: 15, HasInf: true, HasNaN: true, Subnormals: true}
+)
+
+var formatNames = map[Format]string{
+	E4M3:   "e4m3",
+	E4M3FN: "e4m3fn",
+	E5M2:   "e5m2",
+}
+
+// Formats returns supported formats
+func Formats() []Format { return []Format{E4M3, E4M3FN, E5M2} }
+
+// ParseFormat returns supported format by name (see Format.String)
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats() {
+		if formatNames[f] == name {
+			return f, nil
+		}
+	}
+	return Format{}, fmt.Errorf("float8: unknown format %q", name)
+}
+
+func (f Format) String() string {
+	if name, has := formatNames[f]; has {
+		return name
+	}
+	return fmt.Sprintf("e%dm%d", f.ExponentBits, f.MantissaBits)
+}
+
+// Validate checks that layout of format fits into 8 bits
+func (f Format) Validate() error {
+	switch {
+	case f.ExponentBits < 1 || f.MantissaBits < 0 || f.ExponentBits+f.MantissaBits != 7:
+		return errors.New("float8: format must have 7 exponent and mantissa bits")
+	case f.HasInf && f.HasNaN && f.MantissaBits == 0:
+		return errors.New("float8: format has no mantissa bits for NaN")
+	}
+	return nil
+}
+
+// Max is the largest finite value of format
+func (f Format) Max() float64 { return f.decode(f.maxCode()) }
+
+// Min is the smallest positive value of format
+func (f Format) Min() float64 { return f.decode(0x01) }
+
+// Eps is difference between 1 and the next representable value
+func (f Format) Eps() float64 { return math.Ldexp(1, -f.MantissaBits) }
+
+// Decode value of format, it is NaN if format is not valid
+func (f Format) Decode(b byte) float64 { return f.decode(b) }
+
+// Encode value into format using round to nearest even,
+// it is 0 if format is not valid
+func (f Format) Encode(x float64) byte { return f.encode(x, RoundNearestEven) }
+
+// ConvertFormat re-quantizes value of format from into format to.
+// The value is decoded exactly and encoded using rounding mode.
+func ConvertFormat(to, from Format, v uint8, r Rounding) uint8 {
+	return to.encode(from.decode(v), r)
+}
+
+// ConvertFormatSlice re-quantizes values of format from into format to,
+// see ConvertFormat. dst is reused if it has enough capacity.
+func ConvertFormatSlice(to, from Format, dst, src []uint8, r Rounding) []uint8 {
+	if cap(dst) < len(src) {
+		dst = make([]uint8, len(src))
+	}
+	dst = dst[:len(src)]
+
+	for i, v := range src {
+		dst[i] = to.encode(from.decode(v), r)
+	}
+	return dst
+}
+
+func (f Format) decode(b uint8) float64 {
+	if f.Validate() != nil {
+		return math.NaN()
+	}
+
+	expMask, manMask := 1<<f.ExponentBits-1, 1<<f.MantissaBits-1
+	exp := int(b>>f.MantissaBits) & expMask
+	man := int(b) & manMask
+
+	var val float64
+	switch {
+	case f.HasInf && f.HasNaN && exp == expMask && man == 0:
+		val = math.Inf(1)
+	case f.HasInf && f.HasNaN && exp == expMask:
+		return math.NaN()
+	case f.HasInf && b&^signMask == 0x7f:
+		val = math.Inf(1)
+	case f.HasNaN && b&^signMask == 0x7f:
+		return math.NaN()
+	case b == 0:
+		return 0
+	case exp == 0 && f.Subnormals:
+		val = math.Ldexp(float64(man), 1-f.Bias-f.MantissaBits)
+	default:
+		val = math.Ldexp(float64(man|(manMask+1)), exp-f.Bias-f.MantissaBits)
+	}
+
+	if b&signMask != 0 {
+		return -val
+	}
+	return val
+}
+
+// the largest finite magnitude code of format
+func (f Format) maxCode() uint8 {
+	c := uint8(0x7f)
+	for ; c > 0; c-- {
+		if x := f.decode(c); !math.IsNaN(x) && !math.IsInf(x, 0) {
+			break
+		}
+	}
+	return c
+}
+
+func (f Format) encode(x float64, r Rounding) uint8 {
+	switch {
+	case f.Validate() != nil:
+		return 0
+	case f == E4M3:
+		return r.ToFloat8(float32(x))
+	}
+
+	return f.encodeIEEE(x, r)
+}
+
+// encodeIEEE encodes value into sign-magnitude format, which codes
+// [0, maxCode] of each sign are finite magnitudes in ascending order.
+// Overflow is infinity (maxCode + 1) if format has it, otherwise
+// it saturates. NaN is quiet NaN or 0 if format has no NaN.
+func (f Format) encodeIEEE(x float64, r Rounding) uint8 {
+	maxCode := f.maxCode()
+
+	if math.IsNaN(x) {
+		switch {
+		case f.HasInf && f.HasNaN:
+			return maxCode + 1 | 1<<(f.MantissaBits-1)
+		case f.HasNaN:
+			return 0x7f
+		default:
+			return 0
+		}
+	}
+
+	sign := uint8(0)
+	if math.Signbit(x) {
+		sign = signMask
+	}
+	x = math.Abs(x)
+	mag := func(c uint8) float64 { return math.Abs(f.decode(sign | c)) }
+
+	// the smallest magnitude not less than x
+	c := uint8(sort.Search(int(maxCode)+1, func(i int) bool { return mag(uint8(i)) >= x }))
+
+	switch {
+	case c > maxCode:
+		top, ulp := mag(maxCode), mag(maxCode)-mag(maxCode-1)
+		if f.HasInf && (math.IsInf(x, 1) || r == RoundNearestEven && x >= top+ulp/2) {
+			return sign | (maxCode + 1)
+		}
+		return sign | maxCode
+	case mag(c) == x:
+		return sign | c
+	}
+
+	// without subnormals the negative magnitude below 0x80 is 0x00
+	prev, below := sign|(c-1), 0.0
+	if c == 0 {
+		prev = 0
+	} else {
+		below = mag(c - 1)
+	}
+
+	if r == RoundTowardZero {
+		return prev
+	}
+
+	d0, d1 := x-below, mag(c)-x
+	if d0 < d1 || d0 == d1 && prev&1 == 0 {
+		return prev
+	}
+	return sign | c
+}
@@ -0,0 +1,190 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+	"github.com/kshard/float8/internal/ocp"
+)
+
+func TestConvertFormat(t *testing.T) {
+	for c := 0; c < 0x100; c++ {
+		if f8tof32[c] != math8.ToFloat32(Float8(c)) {
+			t.Errorf("%#x decoded to %g", c, f8tof32[c])
+		}
+	}
+
+	// identity and lossless round trips
+	for _, f := range []Format{E4M3, E4M3FN, E5M2} {
+		for c := 0; c < 0x100; c++ {
+			if math.IsNaN(float64(f.decode(uint8(c)))) {
+				continue
+			}
+			for _, r := range []Rounding{RoundTowardZero, RoundNearestEven} {
+				if v := ConvertFormat(f, f, uint8(c), r); v != uint8(c) {
+					t.Errorf("%s %s: %#x converted to %#x", f, r, c, v)
+				}
+			}
+		}
+	}
+
+	for _, tt := range []struct {
+		to, from Format
+		v        uint8
+		r        Rounding
+		want     uint8
+	}{
+		{E5M2, E4M3, 0x39, RoundTowardZero, 0x3c},  // 1.125 → 1
+		{E5M2, E4M3, 0x39, RoundNearestEven, 0x3c}, // tie → 1 (even)
+		{E5M2, E4M3, 0x3b, RoundNearestEven, 0x3e}, // 1.375 tie → 1.5 (even)
+		{E5M2, E4M3, 0x3a, RoundNearestEven, 0x3d}, // 1.25 is exact
+		{E5M2, E4M3, Infinity, RoundNearestEven, 0x7c},
+		{E5M2, E4M3, signMask | Infinity, RoundTowardZero, 0xfc},
+		{E4M3, E5M2, 0x7c, RoundNearestEven, Infinity},
+		{E4M3FN, E5M2, 0x7c, RoundNearestEven, 0x7e}, // saturates
+		{E4M3FN, E5M2, 0x7f, RoundNearestEven, 0x7f}, // NaN
+		{E5M2, E4M3FN, 0x7f, RoundNearestEven, 0x7e}, // NaN
+		{E4M3, E5M2, 0x80, RoundNearestEven, 0x00},   // -0
+		{E4M3, E5M2, 0xf8, RoundTowardZero, 0xff},    // -32768 overflows
+		{E5M2, E4M3, 0x80, RoundNearestEven, 0xa0},   // -2^-7
+	} {
+		if v := ConvertFormat(tt.to, tt.from, tt.v, tt.r); v != tt.want {
+			t.Errorf("%s → %s %s: %#x converted to %#x, want %#x", tt.from, tt.to, tt.r, tt.v, v, tt.want)
+		}
+	}
+
+	// nearest even encoder matches OCP codec
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 10000 {
+		x := float32(r.NormFloat64() * math.Pow(2, float64(r.IntN(20)-12)))
+		if a, b := E4M3FN.Encode(float64(x)), ocp.EncodeE4M3FN(x); a != b {
+			t.Errorf("%g encoded to %#x, want %#x", x, a, b)
+		}
+	}
+
+	src := []uint8{0x38, 0x39, 0x3a, 0x3b}
+	dst := ConvertFormatSlice(E5M2, E4M3, nil, src, RoundTowardZero)
+	if string(dst) != string([]uint8{0x3c, 0x3c, 0x3d, 0x3d}) {
+		t.Errorf("unexpected slice %#v", dst)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, tt := range []struct {
+		f             Format
+		max, min, eps float64
+	}{
+		{E4M3, 448, 0.0087890625, 0.125},
+		{E4M3FN, 448, math.Ldexp(1, -9), 0.125},
+		{E5M2, 57344, math.Ldexp(1, -16), 0.25},
+		{Format{ExponentBits: 3, MantissaBits: 4, Bias: 3, HasInf: true, HasNaN: true, Subnormals: true}, 15.5, math.Ldexp(1, -6), 0.0625},
+	} {
+		if tt.f.Max() != tt.max || tt.f.Min() != tt.min || tt.f.Eps() != tt.eps {
+			t.Errorf("%s: max=%g min=%g eps=%g", tt.f, tt.f.Max(), tt.f.Min(), tt.f.Eps())
+		}
+	}
+
+	// generic codec is bit compatible with OCP codec
+	for c := 0; c < 0x100; c++ {
+		for _, tt := range []struct {
+			f      Format
+			decode func(uint8) float32
+		}{
+			{E4M3FN, ocp.DecodeE4M3FN},
+			{E5M2, ocp.DecodeE5M2},
+		} {
+			a, b := tt.f.Decode(byte(c)), float64(tt.decode(byte(c)))
+			if a != b && !(math.IsNaN(a) && math.IsNaN(b)) {
+				t.Errorf("%s: %#x decoded to %g, want %g", tt.f, c, a, b)
+			}
+			if !math.IsNaN(a) && tt.f.Encode(a) != byte(c) {
+				t.Errorf("%s: %g encoded to %#x", tt.f, a, tt.f.Encode(a))
+			}
+		}
+	}
+	if !math.IsNaN(E5M2.Decode(E5M2.Encode(math.NaN()))) || !math.IsNaN(E4M3FN.Decode(E4M3FN.Encode(math.NaN()))) {
+		t.Errorf("NaN is not preserved")
+	}
+	if E4M3.Decode(E4M3.Encode(1.1)) != 1.125 {
+		t.Errorf("E4M3 does not round to nearest")
+	}
+
+	for _, f := range Formats() {
+		if g, err := ParseFormat(f.String()); err != nil || g != f {
+			t.Errorf("%s parsed to %s, %v", f, g, err)
+		}
+	}
+	if _, err := ParseFormat("e3m4"); err == nil {
+		t.Errorf("unknown format is parsed")
+	}
+	// invalid formats from wire do not panic
+	for _, f := range []Format{
+		{ExponentBits: 5, MantissaBits: 3},
+		{ExponentBits: 7, HasInf: true, HasNaN: true},
+		{},
+	} {
+		if err := f.Validate(); err == nil {
+			t.Errorf("invalid format %s is validated", f)
+		}
+		if !math.IsNaN(f.Decode(0x38)) || !math.IsNaN(f.Max()) || f.Encode(1) != 0 {
+			t.Errorf("invalid format %s is used", f)
+		}
+	}
+}
+
+func TestFormatFields(t *testing.T) {
+	// format rebuilt from wire behaves as the package format
+	wire := Format{ExponentBits: 4, MantissaBits: 3, Bias: 7, HasInf: true}
+	if wire != E4M3 || wire.String() != "e4m3" {
+		t.Errorf("unexpected format %s", wire)
+	}
+	for c := 0; c < 0x100; c++ {
+		x := wire.Decode(byte(c))
+		switch {
+		case c&0x7f == Infinity:
+			if !math.IsInf(x, 1-2*(c>>7)) {
+				t.Errorf("%#x decoded to %g", c, x)
+			}
+		case x != float64(math8.ToFloat32(Float8(c))):
+			t.Errorf("%#x decoded to %g", c, x)
+		}
+	}
+
+	// IEEE-like e4m3 (ml_dtypes.float8_e4m3): subnormals, 0x78 is infinity
+	ieee := Format{ExponentBits: 4, MantissaBits: 3, Bias: 7, HasInf: true, HasNaN: true, Subnormals: true}
+	if ieee.Decode(0x01) != math.Ldexp(1, -9) || !math.IsInf(ieee.Decode(0x78), 1) || !math.IsNaN(ieee.Decode(0x79)) || ieee.Max() != 240 {
+		t.Errorf("unexpected IEEE e4m3 %g %g %g", ieee.Decode(0x01), ieee.Decode(0x78), ieee.Max())
+	}
+
+	// custom formats round trip, including asymmetric zero without subnormals
+	for _, f := range []Format{
+		ieee,
+		{ExponentBits: 5, MantissaBits: 2, Bias: 15, HasInf: true, HasNaN: true},
+		{ExponentBits: 3, MantissaBits: 4, Bias: 3},
+	} {
+		for c := 0; c < 0x100; c++ {
+			if x := f.Decode(byte(c)); !math.IsNaN(x) && f.Encode(x) != byte(c) {
+				t.Errorf("%s: %#x decoded to %g, encoded to %#x", f, c, x, f.Encode(x))
+			}
+		}
+	}
+
+	// no subnormals: -2^-16 is tie between 0x00 and 0x80 (-2^-15)
+	e5 := Format{ExponentBits: 5, MantissaBits: 2, Bias: 15, HasInf: true, HasNaN: true}
+	if c := e5.Encode(-math.Ldexp(1, -16)); c != 0x00 {
+		t.Errorf("-2^-16 encoded to %#x", c)
+	}
+	if c := e5.Encode(-math.Ldexp(1.5, -16)); c != 0x80 {
+		t.Errorf("-1.5×2^-16 encoded to %#x", c)
+	}
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// The code book for translating FP4 (E2M1) to float32. FP4 is a sign bit,
+// 2 bits of exponent and 1 bit of mantissa, there are no Inf and NaN.
+var fp4tof32 = [0x10]float32{0, 0.5, 1, 1.5, 2, 3, 4, 6, 0, -0.5, -1, -1.5, -2, -3, -4, -6}
+
+// ToFP4 converts float32 to FP4 (E2M1) code in low nibble, rounding to
+// the nearest value with ties to even. Values outside of range saturate
+// to ±6, NaN is mapped to 0.
+func ToFP4(f32 float32) uint8 {
+	if f32 != f32 {
+		return 0
+	}
+
+	sign := uint8(0)
+	if f32 < 0 {
+		sign, f32 = 0x08, -f32
+	}
+
+	// distances of infinity are all infinite, saturate before the search
+	if f32 >= fp4tof32[0x07] {
+		return sign | 0x07
+	}
+
+	best, dist := uint8(0), float32(math.MaxFloat32)
+	for code := uint8(0); code < 0x08; code++ {
+		d := abs(fp4tof32[code] - f32)
+		if d < dist || d == dist && code&0x01 == 0 {
+			best, dist = code, d
+		}
+	}
+
+	return sign | best
+}
+
+// Pack4 converts float32 values to FP4 and packs two values per byte,
+// low nibble first. Odd length is padded with zero.
+func Pack4(f32s []float32) []byte {
+	packed := make([]byte, (len(f32s)+1)/2)
+	for i, x := range f32s {
+		packed[i/2] |= ToFP4(x) << (4 * (i % 2))
+	}
+	return packed
+}
+
+// Unpack4 converts n FP4 values packed by Pack4 to float32
+func Unpack4(packed []byte, n int) []float32 {
+	if n < 0 || (n+1)/2 > len(packed) {
+		panic("packed data is too short")
+	}
+
+	f32s := make([]float32, n)
+	for i := range f32s {
+		f32s[i] = fp4tof32[(packed[i/2]>>(4*(i%2)))&0x0f]
+	}
+	return f32s
+}
+
+// Dot4 is dot product of FP4 vectors packed by Pack4. It uses fused code
+// book of products, one lookup per pair of bytes (two pairs of elements).
+func Dot4(a, b []byte) float32 {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	d := float32(0)
+	for i := range a {
+		d += dot4[int(a[i])<<8|int(b[i])]
+	}
+
+	return d
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+func TestFP4ToFloat32(t *testing.T) {
+	for a := 0; a < 0x10; a++ {
+		if c, e := fp4tof32[a], math8.FP4ToFloat32(uint8(a)); c != e {
+			t.Errorf("0x%x wanted=%g, got=%g", a, e, c)
+		}
+	}
+}
+
+func TestToFP4(t *testing.T) {
+	for a := 0; a < 0x10; a++ {
+		if a == 0x08 {
+			continue // negative zero is not produced
+		}
+		if c := ToFP4(fp4tof32[a]); c != uint8(a) {
+			t.Errorf("0x%x got=0x%x", a, c)
+		}
+	}
+
+	for x, e := range map[float32]uint8{0.25: 0x0, 0.75: 0x2, 1.25: 0x2, 2.5: 0x4, 5: 0x6, 100: 0x7, -100: 0xf, -0.3: 0x9, float32(math.Inf(1)): 0x7, float32(math.Inf(-1)): 0xf} {
+		if c := ToFP4(x); c != e {
+			t.Errorf("%g wanted=0x%x, got=0x%x", x, e, c)
+		}
+	}
+}
+
+func TestPack4(t *testing.T) {
+	src := []float32{1, -2, 6, 0.5, 3}
+	packed := Pack4(src)
+	if len(packed) != 3 || packed[0] != 0xc2 {
+		t.Errorf("unexpected packing %x", packed)
+	}
+
+	if x := Unpack4(packed, len(src)); !slices.Equal(x, src) {
+		t.Errorf("got=%v expected=%v", x, src)
+	}
+}
+
+func TestDot4(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			if c, e := dot4[a<<8|b], math8.DotFP4(uint8(a), uint8(b)); c != e {
+				t.Fatalf("0x%02x · 0x%02x wanted=%g, got=%g", a, b, e, c)
+			}
+		}
+	}
+
+	a := Pack4([]float32{1, -2, 6})
+	b := Pack4([]float32{4, 1.5, 0.5})
+	if d := Dot4(a, b); d != 4-3+3 {
+		t.Errorf("unexpected product %g", d)
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// exponent field of the fraction in range [0.5, 1)
+const frexpExponent = exponentBias - 1
+
+// Frexp breaks f into normalized fraction and integral power of two,
+// f = frac × 2^exp, |frac| in [0.5, 1). Special cases are
+// Frexp(0) = 0, 0 and Frexp(±Infinity) = ±Infinity, 0.
+func Frexp(f Float8) (frac Float8, exp int) {
+	if f == 0 || IsInf(f, 0) {
+		return f, 0
+	}
+
+	e := int(f&exponentMask) >> mantissaLen
+	return f&^exponentMask | frexpExponent<<mantissaLen, e - frexpExponent
+}
+
+// Ldexp is inverse of Frexp, it returns frac × 2^exp using exponent field
+// arithmetic, the result is exact unless it overflows to ±Infinity or
+// underflows to 0 (the format has no subnormals).
+func Ldexp(frac Float8, exp int) Float8 {
+	if frac == 0 || IsInf(frac, 0) {
+		return frac
+	}
+
+	e := int(frac&exponentMask)>>mantissaLen + exp
+	switch {
+	case e > exponentHi:
+		return frac&signMask | Infinity
+	case e < 0:
+		return 0
+	}
+
+	return frac&^exponentMask | Float8(e)<<mantissaLen
+}
+
+// ScaleByPow2 returns f × 2^k using exponent field arithmetic. Unlike Ldexp,
+// the result saturates to the largest finite value of same sign on overflow,
+// underflow is 0 and ±Infinity is preserved. Note, the format has no
+// positive 2^-7, the pattern 0x00 is zero.
+func ScaleByPow2(f Float8, k int) Float8 {
+	if f == 0 || IsInf(f, 0) {
+		return f
+	}
+
+	e := int(f&exponentMask)>>mantissaLen + k
+	switch {
+	case e > exponentHi || e == exponentHi && f&mantissaMask == mantissaMask:
+		return f&signMask | (Infinity - 1)
+	case e < 0:
+		return 0
+	}
+
+	return f&^exponentMask | Float8(e)<<mantissaLen
+}
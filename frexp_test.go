@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"testing"
+)
+
+// exact value of float8 decoded from bit fields
+func exact(f Float8) float64 {
+	if f == 0 {
+		return 0
+	}
+
+	e := int(f&exponentMask) >> mantissaLen
+	v := math.Ldexp(1+float64(f&mantissaMask)/8, e-exponentBias)
+	if Signbit(f) {
+		return -v
+	}
+	return v
+}
+
+func TestFrexp(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		f := uint8(a)
+		if IsInf(f, 0) {
+			continue
+		}
+
+		frac, exp := Frexp(f)
+		efrac, eexp := math.Frexp(exact(f))
+		if exact(frac) != efrac || exp != eexp {
+			t.Errorf("0x%02x wanted=%g×2^%d, got=%g×2^%d", a, efrac, eexp, exact(frac), exp)
+		}
+
+		if x := Ldexp(frac, exp); x != f {
+			t.Errorf("0x%02x: Ldexp(Frexp) = 0x%02x", a, x)
+		}
+	}
+
+	if frac, exp := Frexp(Infinity); frac != Infinity || exp != 0 {
+		t.Errorf("Frexp(Infinity) = 0x%02x, %d", frac, exp)
+	}
+}
+
+func TestLdexp(t *testing.T) {
+	for _, tc := range []struct {
+		f   Float8
+		exp int
+		c   Float8
+	}{
+		{0x38, 1, 0x40},  // 1 × 2 = 2
+		{0xb8, -3, 0xa0}, // -1 / 8
+		{0x3c, 4, 0x5c},  // 1.5 × 16 = 24
+		{0x38, 9, Infinity},
+		{0xb8, 9, 0xff},
+		{0x38, -8, 0x00},
+		{0x00, 5, 0x00},
+		{Infinity, -5, Infinity},
+	} {
+		if c := Ldexp(tc.f, tc.exp); c != tc.c {
+			t.Errorf("Ldexp(0x%02x, %d) = 0x%02x, wanted 0x%02x", tc.f, tc.exp, c, tc.c)
+		}
+	}
+}
+
+func TestScaleByPow2(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		f := uint8(a)
+		for k := -16; k <= 16; k++ {
+			c := ScaleByPow2(f, k)
+			switch x := math.Ldexp(exact(f), k); {
+			case IsInf(f, 0):
+				if c != f {
+					t.Fatalf("0x%02x × 2^%d = 0x%02x", a, k, c)
+				}
+			case math.Abs(x) > exact(Infinity-1):
+				if c != f&signMask|(Infinity-1) {
+					t.Fatalf("0x%02x × 2^%d = 0x%02x, wanted saturation", a, k, c)
+				}
+			case c != 0 && exact(c) != x || c == 0 && f != 0 && math.Abs(x) > math.Ldexp(1, -exponentBias):
+				t.Fatalf("0x%02x × 2^%d = %g, wanted %g", a, k, exact(c), x)
+			}
+		}
+	}
+}
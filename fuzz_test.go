@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+// go test -run=^$ -fuzz=FuzzToFloat8RoundTrip
+func FuzzToFloat8RoundTrip(f *testing.F) {
+	for _, seed := range []uint8{0x00, 0x01, 0x38, 0x7f, 0x80, 0xb8, 0xff} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, x uint8) {
+		f32 := ToFloat32(x)
+		if val := ToFloat8(f32); val != x {
+			t.Errorf("0x%02x got=0x%02x f32=%f", x, val, f32)
+		}
+
+		if val := math8.ToFloat8(math8.ToFloat32(x)); val != x {
+			t.Errorf("math8 0x%02x got=0x%02x", x, val)
+		}
+	})
+}
+
+// go test -run=^$ -fuzz=FuzzAddConsistency
+func FuzzAddConsistency(f *testing.F) {
+	for _, seed := range [][2]uint8{{0x00, 0x00}, {0x38, 0x38}, {0x38, 0xb8}, {0x7f, 0x7f}, {0x01, 0x81}} {
+		f.Add(seed[0], seed[1])
+	}
+
+	f.Fuzz(func(t *testing.T, a, b uint8) {
+		if x, y := Add(a, b), Add(b, a); x != y {
+			t.Errorf("0x%02x + 0x%02x = 0x%02x, 0x%02x + 0x%02x = 0x%02x", a, b, x, b, a, y)
+		}
+
+		if x, y := Mul(a, b), Mul(b, a); x != y {
+			t.Errorf("0x%02x * 0x%02x = 0x%02x, 0x%02x * 0x%02x = 0x%02x", a, b, x, b, a, y)
+		}
+
+		for op, f := range map[string][2]func(Float8, Float8) Float8{
+			"+": {Add, math8.Add},
+			"-": {Sub, math8.Sub},
+			"*": {Mul, math8.Mul},
+			"/": {Div, math8.Div},
+		} {
+			if c, e := f[0](a, b), f[1](a, b); c != e {
+				t.Errorf("0x%02x %s 0x%02x wanted=0x%02x, got=0x%02x", a, op, b, e, c)
+			}
+		}
+	})
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package gguf implements llama.cpp (GGUF) Q8_0 block codec for float8 data.
+// The block is 32 elements sharing fp16 scale d, each element is int8 q,
+// the value is d × q. The layout of the block is 2 bytes of little-endian
+// fp16 scale followed by 32 bytes of quantized values.
+package gguf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/internal/half"
+)
+
+const (
+	// BlockSize is number of elements in Q8_0 block
+	BlockSize = 32
+
+	// BlockBytes is size of encoded Q8_0 block
+	BlockBytes = 2 + BlockSize
+)
+
+// EncodeQ8_0 appends Q8_0 blocks of float8 values to dst.
+// The length of src must be multiple of BlockSize.
+func EncodeQ8_0(dst []byte, src []float8.Float8) ([]byte, error) {
+	if len(src)%BlockSize != 0 {
+		return nil, fmt.Errorf("gguf: length %d is not multiple of %d", len(src), BlockSize)
+	}
+
+	var block [BlockSize]float32
+	for at := 0; at < len(src); at += BlockSize {
+		amax := float32(0)
+		for i, x := range src[at : at+BlockSize] {
+			block[i] = float8.ToFloat32(x)
+			if v := abs(block[i]); v > amax {
+				amax = v
+			}
+		}
+
+		d := amax / 127
+		h := half.FromFloat32(d)
+		// quantization uses scale as it is stored
+		id := float32(0)
+		if d = half.ToFloat32(h); d != 0 {
+			id = 1 / d
+		}
+
+		dst = binary.LittleEndian.AppendUint16(dst, h)
+		for _, v := range block {
+			q := math.Round(float64(v * id))
+			q = math.Max(-127, math.Min(127, q))
+			dst = append(dst, byte(int8(q)))
+		}
+	}
+
+	return dst, nil
+}
+
+// DecodeQ8_0 appends float8 values of Q8_0 blocks to dst.
+func DecodeQ8_0(dst []float8.Float8, src []byte) ([]float8.Float8, error) {
+	if len(src)%BlockBytes != 0 {
+		return nil, fmt.Errorf("gguf: length %d is not multiple of %d", len(src), BlockBytes)
+	}
+
+	for at := 0; at < len(src); at += BlockBytes {
+		d := half.ToFloat32(binary.LittleEndian.Uint16(src[at:]))
+		for _, q := range src[at+2 : at+BlockBytes] {
+			dst = append(dst, float8.ToFloat8(d*float32(int8(q))))
+		}
+	}
+
+	return dst, nil
+}
+
+func abs(x float32) float32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
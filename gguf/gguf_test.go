@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package gguf
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+func TestQ8_0(t *testing.T) {
+	src := make([]float8.Float8, 2*BlockSize)
+	for i := range src {
+		src[i] = float8.ToFloat8(float32(i-BlockSize) / 4)
+	}
+
+	buf, err := EncodeQ8_0(nil, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(buf) != 2*BlockBytes {
+		t.Fatalf("unexpected length %d", len(buf))
+	}
+
+	seq, err := DecodeQ8_0(nil, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range src {
+		a, b := float8.ToFloat32(src[i]), float8.ToFloat32(seq[i])
+		if d := math.Abs(float64(a - b)); d > float64(float8.Ulp(src[i])) {
+			t.Errorf("%d wanted=%g got=%g", i, a, b)
+		}
+	}
+
+	if _, err := EncodeQ8_0(nil, src[:3]); err == nil {
+		t.Errorf("error is expected for partial block")
+	}
+
+	if _, err := DecodeQ8_0(nil, buf[:3]); err == nil {
+		t.Errorf("error is expected for partial block")
+	}
+}
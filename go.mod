@@ -1,5 +1,8 @@
 module github.com/kshard/float8
 
-go 1.22.2
+go 1.23.0
 
-require github.com/chewxy/math32 v1.10.1
+require (
+	github.com/chewxy/math32 v1.10.1
+	golang.org/x/sys v0.35.0
+)
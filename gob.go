@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Gob encoding prefixes binary encoding of the type with version of
+// the encoding and number format of elements, so that snapshots are
+// rejected rather than misinterpreted if either changes.
+const (
+	gobVersion    = 1
+	gobFormatE4M3 = 0
+	gobHeaderSize = 2
+)
+
+func gobHeader(size int) []byte {
+	return append(make([]byte, 0, gobHeaderSize+size), gobVersion, gobFormatE4M3)
+}
+
+func gobPayload(buf []byte) ([]byte, error) {
+	if len(buf) < gobHeaderSize {
+		return nil, errors.New("float8: gob is shorter than header")
+	}
+	if buf[0] != gobVersion {
+		return nil, errors.New("float8: unsupported gob version")
+	}
+	if buf[1] != gobFormatE4M3 {
+		return nil, errors.New("float8: unsupported gob number format")
+	}
+	return buf[gobHeaderSize:], nil
+}
+
+// GobEncode encodes vector with its scale, see MarshalBinary
+func (v Vector) GobEncode() ([]byte, error) {
+	buf, err := v.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(gobHeader(len(buf)), buf...), nil
+}
+
+// GobDecode decodes vector encoded by GobEncode
+func (v *Vector) GobDecode(buf []byte) error {
+	payload, err := gobPayload(buf)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalBinary(payload)
+}
+
+// GobEncode encodes matrix as uvarint rows and columns followed by
+// elements in row-major order
+func (m *Matrix) GobEncode() ([]byte, error) {
+	buf := gobHeader(2*binary.MaxVarintLen64 + m.rows*m.cols)
+	buf = binary.AppendUvarint(buf, uint64(m.rows))
+	buf = binary.AppendUvarint(buf, uint64(m.cols))
+	for i := 0; i < m.rows; i++ {
+		buf = append(buf, m.RawRow(i)...)
+	}
+	return buf, nil
+}
+
+// GobDecode decodes matrix encoded by GobEncode
+func (m *Matrix) GobDecode(buf []byte) error {
+	payload, err := gobPayload(buf)
+	if err != nil {
+		return err
+	}
+
+	r, n := binary.Uvarint(payload)
+	if n <= 0 || r > 1<<31-1 {
+		return errors.New("float8: invalid matrix shape")
+	}
+	payload = payload[n:]
+
+	c, n := binary.Uvarint(payload)
+	if n <= 0 || c > 1<<31-1 {
+		return errors.New("float8: invalid matrix shape")
+	}
+	payload = payload[n:]
+
+	if r*c != uint64(len(payload)) {
+		return errors.New("float8: invalid matrix length")
+	}
+
+	*m = *MatrixOf(int(r), int(c), append([]Float8{}, payload...))
+	return nil
+}
+
+// GobEncode encodes tensor, see MarshalBinary
+func (t *Tensor) GobEncode() ([]byte, error) {
+	buf, err := t.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(gobHeader(len(buf)), buf...), nil
+}
+
+// GobDecode decodes tensor encoded by GobEncode
+func (t *Tensor) GobDecode(buf []byte) error {
+	payload, err := gobPayload(buf)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalBinary(payload)
+}
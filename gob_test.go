@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+type snapshot struct {
+	Vector Vector
+	Matrix *Matrix
+	Tensor *Tensor
+}
+
+func TestGob(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	m := MatrixOf(3, 4, randFloat8s(r, 12))
+	in := snapshot{
+		Vector: NewVector(randVector(r, 16, 10)),
+		Matrix: m.Col(1),
+		Tensor: tensor23().Transpose(1, 0),
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+
+	var out snapshot
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.Vector.Scale != in.Vector.Scale || out.Vector.Dim != in.Vector.Dim || !bytes.Equal(out.Vector.Data, in.Vector.Data) {
+		t.Errorf("vector got=%+v wanted=%+v", out.Vector, in.Vector)
+	}
+	if !reflect.DeepEqual(out.Matrix.ToFloat32(), in.Matrix.ToFloat32()) {
+		t.Errorf("matrix got=%v wanted=%v", out.Matrix.ToFloat32(), in.Matrix.ToFloat32())
+	}
+	if !reflect.DeepEqual(out.Tensor.Shape(), in.Tensor.Shape()) || !bytes.Equal(out.Tensor.Flat(), in.Tensor.Flat()) {
+		t.Errorf("tensor got=%v wanted=%v", out.Tensor.Flat(), in.Tensor.Flat())
+	}
+}
+
+func TestGobInvalid(t *testing.T) {
+	v := NewVector([]float32{1, 2, 3})
+	buf, err := v.GobEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, b := range [][]byte{nil, {gobVersion + 1, gobFormatE4M3}, {gobVersion, gobFormatE4M3 + 1}} {
+		if err := new(Vector).GobDecode(append(b, buf[gobHeaderSize:]...)); err == nil {
+			t.Errorf("%v is decoded", b)
+		}
+	}
+
+	m, _ := NewMatrix(2, 2).GobEncode()
+	if err := new(Matrix).GobDecode(m[:len(m)-1]); err == nil {
+		t.Errorf("truncated matrix is decoded")
+	}
+}
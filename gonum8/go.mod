@@ -0,0 +1,12 @@
+module github.com/kshard/float8/gonum8
+
+go 1.23.0
+
+require (
+	github.com/kshard/float8 v0.0.0-00010101000000-000000000000
+	gonum.org/v1/gonum v0.16.0
+)
+
+require golang.org/x/sys v0.35.0 // indirect
+
+replace github.com/kshard/float8 => ../
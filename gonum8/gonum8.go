@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package gonum8 adapts float8 matrices to gonum (https://www.gonum.org)
+// mat.Matrix interface. Elements are decoded on the fly, so quantized
+// matrices are analyzed by gonum routines without float64 copy.
+//
+// The package is a standalone module so that float8 does not depend on gonum.
+package gonum8
+
+import (
+	"github.com/kshard/float8"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Dense is row-major float8 matrix implementing mat.Matrix
+type Dense struct {
+	rows, cols int
+	stride     int
+	data       []float8.Float8
+}
+
+var _ mat.Matrix = (*Dense)(nil)
+
+// NewDense creates r × c matrix over data in row-major order
+func NewDense(r, c int, data []float8.Float8) *Dense {
+	if r < 0 || c < 0 {
+		panic(mat.ErrNegativeDimension)
+	}
+	if len(data) != r*c {
+		panic(mat.ErrShape)
+	}
+
+	return &Dense{rows: r, cols: c, stride: c, data: data}
+}
+
+// Dims returns dimensions of matrix
+func (m *Dense) Dims() (r, c int) { return m.rows, m.cols }
+
+// At returns decoded element at row i, column j
+func (m *Dense) At(i, j int) float64 {
+	if uint(i) >= uint(m.rows) {
+		panic(mat.ErrRowAccess)
+	}
+	if uint(j) >= uint(m.cols) {
+		panic(mat.ErrColAccess)
+	}
+
+	return float64(float8.ToFloat32(m.data[i*m.stride+j]))
+}
+
+// T returns implicit transpose of matrix
+func (m *Dense) T() mat.Matrix { return mat.Transpose{Matrix: m} }
+
+// RawRow returns float8 elements of row i, shares data with matrix
+func (m *Dense) RawRow(i int) []float8.Float8 {
+	if uint(i) >= uint(m.rows) {
+		panic(mat.ErrRowAccess)
+	}
+
+	return m.data[i*m.stride : i*m.stride+m.cols : i*m.stride+m.cols]
+}
+
+// FromTensor creates matrix view of rank-2 contiguous tensor
+func FromTensor(t *float8.Tensor) *Dense {
+	shape := t.Shape()
+	if len(shape) != 2 {
+		panic(mat.ErrShape)
+	}
+
+	return NewDense(shape[0], shape[1], t.Flat())
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package gonum8_test
+
+import (
+	"testing"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/gonum8"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestDense(t *testing.T) {
+	// 1 2 3
+	// 4 5 6
+	m := gonum8.NewDense(2, 3, []float8.Float8{0x38, 0x40, 0x44, 0x48, 0x4a, 0x4c})
+
+	if r, c := m.Dims(); r != 2 || c != 3 {
+		t.Errorf("unexpected dims %d × %d", r, c)
+	}
+
+	if m.At(1, 2) != 6 || m.T().At(2, 1) != 6 {
+		t.Errorf("unexpected element")
+	}
+
+	var p mat.Dense
+	p.Mul(m, m.T())
+
+	expected := mat.NewDense(2, 2, []float64{14, 32, 32, 77})
+	if !mat.Equal(&p, expected) {
+		t.Errorf("got=%v expected=%v", mat.Formatted(&p), mat.Formatted(expected))
+	}
+
+	if mat.Sum(m) != 21 {
+		t.Errorf("unexpected sum %f", mat.Sum(m))
+	}
+}
+
+func TestFromTensor(t *testing.T) {
+	x := float8.TensorOf([]float8.Float8{0x38, 0x40, 0x44, 0x48, 0x4a, 0x4c}, 2, 3)
+	m := gonum8.FromTensor(x.Transpose())
+
+	if r, c := m.Dims(); r != 3 || c != 2 || m.At(2, 1) != 6 {
+		t.Errorf("unexpected matrix")
+	}
+}
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"errors"
+	"math"
+)
+
+// Int8Quantizer is affine int8 quantizer, x = Scale·(q - ZeroPoint).
+// It is INT8 alternative to float8 storage with the same api as Codec,
+// so both formats can be evaluated over the same data. Symmetric
+// quantizer has zero ZeroPoint and maps [-max|x|, max|x|] onto [-127, 127],
+// asymmetric one maps [min x, max x] onto [-128, 127].
+type Int8Quantizer struct {
+	Scale     float32
+	ZeroPoint int8
+}
+
+// NewInt8Quantizer creates quantizer of range [lo, hi]
+func NewInt8Quantizer(lo, hi float32, symmetric bool) *Int8Quantizer {
+	lo, hi = min(lo, 0), max(hi, 0)
+
+	if symmetric {
+		m := max(-lo, hi)
+		if m == 0 {
+			return &Int8Quantizer{Scale: 1}
+		}
+		return &Int8Quantizer{Scale: m / 127}
+	}
+
+	if hi == lo {
+		return &Int8Quantizer{Scale: 1}
+	}
+
+	scale := (hi - lo) / 255
+	zp := math.Round(-128 - float64(lo/scale))
+	return &Int8Quantizer{Scale: scale, ZeroPoint: int8(max(-128, min(127, zp)))}
+}
+
+// CalibrateInt8 creates quantizer of the range of sample values
+func CalibrateInt8(sample []float32, symmetric bool) (*Int8Quantizer, error) {
+	if len(sample) == 0 {
+		return nil, errors.New("float8: sample is empty")
+	}
+
+	lo, hi := sample[0], sample[0]
+	for _, x := range sample {
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return nil, errors.New("float8: sample contains non finite values")
+		}
+		lo, hi = min(lo, x), max(hi, x)
+	}
+
+	return NewInt8Quantizer(lo, hi, symmetric), nil
+}
+
+// Quantize value, values outside of the range saturate
+func (q *Int8Quantizer) Quantize(x float32) int8 {
+	v := math.Round(float64(x/q.Scale)) + float64(q.ZeroPoint)
+	if math.IsNaN(v) {
+		return q.ZeroPoint
+	}
+	return int8(max(-128, min(127, v)))
+}
+
+// Dequantize code to value
+func (q *Int8Quantizer) Dequantize(code int8) float32 {
+	return q.Scale * float32(int32(code)-int32(q.ZeroPoint))
+}
+
+// QuantizeSlice quantizes src into dst
+func (q *Int8Quantizer) QuantizeSlice(dst []int8, src []float32) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, x := range src {
+		dst[i] = q.Quantize(x)
+	}
+}
+
+// DequantizeSlice dequantizes src into dst
+func (q *Int8Quantizer) DequantizeSlice(dst []float32, src []int8) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, code := range src {
+		dst[i] = q.Dequantize(code)
+	}
+}
+
+// Dot product of quantized vectors, Σ (a[i] - z)·(b[i] - z) is accumulated
+// in integers and scaled once.
+func (q *Int8Quantizer) Dot(a, b []int8) float32 {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	z := int64(q.ZeroPoint)
+	d := int64(0)
+	for i := range a {
+		d += (int64(a[i]) - z) * (int64(b[i]) - z)
+	}
+
+	return float32(float64(q.Scale) * float64(q.Scale) * float64(d))
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestInt8Quantizer(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, symmetric := range []bool{true, false} {
+		sample := make([]float32, 1000)
+		for i := range sample {
+			sample[i] = 2 + 3*float32(r.NormFloat64())
+		}
+
+		q, err := CalibrateInt8(sample, symmetric)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if symmetric && q.ZeroPoint != 0 {
+			t.Errorf("symmetric quantizer has zero point %d", q.ZeroPoint)
+		}
+
+		codes := make([]int8, len(sample))
+		q.QuantizeSlice(codes, sample)
+		x := make([]float32, len(sample))
+		q.DequantizeSlice(x, codes)
+
+		for i := range sample {
+			if d := math.Abs(float64(x[i] - sample[i])); d > float64(q.Scale)/2+1e-6 {
+				t.Errorf("symmetric %v: %g is dequantized as %g", symmetric, sample[i], x[i])
+			}
+		}
+
+		dot := 0.0
+		for i := range x {
+			dot += float64(x[i]) * float64(x[i])
+		}
+		if d := q.Dot(codes, codes); math.Abs(float64(d)-dot) > 1e-3*dot {
+			t.Errorf("symmetric %v: dot %g, expected %g", symmetric, d, dot)
+		}
+	}
+}
+
+func TestInt8QuantizerRange(t *testing.T) {
+	q := NewInt8Quantizer(0, 1, false)
+	if c := q.Quantize(0); c != -128 {
+		t.Errorf("0 = %d", c)
+	}
+	if c := q.Quantize(1); c != 127 {
+		t.Errorf("1 = %d", c)
+	}
+	if c := q.Quantize(10); c != 127 {
+		t.Errorf("10 = %d", c)
+	}
+	if x := q.Dequantize(q.Quantize(0)); x != 0 {
+		t.Errorf("zero is not exact %g", x)
+	}
+
+	s := NewInt8Quantizer(-2, 1, true)
+	if s.Quantize(-2) != -127 || s.Quantize(2) != 127 || s.Quantize(0) != 0 {
+		t.Errorf("unexpected symmetric range")
+	}
+
+	if z := NewInt8Quantizer(0, 0, false); z.Quantize(0) != 0 || z.Dequantize(0) != 0 {
+		t.Errorf("unexpected degenerated range")
+	}
+
+	if _, err := CalibrateInt8(nil, true); err == nil || !strings.HasPrefix(err.Error(), "float8: ") {
+		t.Errorf("empty sample is accepted, got %v", err)
+	}
+}
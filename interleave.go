@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// Interleaved is structure-of-arrays layout of vectors for batch scoring.
+// Vectors are grouped into blocks of Block vectors, each block is stored
+// dimension-major: Block values of dimension 0, then Block values of
+// dimension 1 and so on. The last block is padded with zeros. Kernels
+// process whole block per dimension, the inner loop is over contiguous
+// bytes, which is the layout required by SIMD registers of 16 or 32 lanes.
+type Interleaved struct {
+	Data  []Float8
+	N     int
+	Dim   int
+	Block int
+}
+
+// Interleave converts n vectors of dimension dim stored one after another
+// into blocked layout, block is 16 or 32.
+func Interleave(vectors []Float8, dim, block int) *Interleaved {
+	if block != 16 && block != 32 {
+		panic("block must be 16 or 32")
+	}
+	if dim <= 0 || len(vectors)%dim != 0 {
+		panic("length of vectors must be multiple of dim")
+	}
+
+	n := len(vectors) / dim
+	blocks := (n + block - 1) / block
+	x := &Interleaved{
+		Data:  make([]Float8, blocks*block*dim),
+		N:     n,
+		Dim:   dim,
+		Block: block,
+	}
+
+	for v := 0; v < n; v++ {
+		base := (v/block)*block*dim + v%block
+		for d, e := range vectors[v*dim : (v+1)*dim] {
+			x.Data[base+d*block] = e
+		}
+	}
+
+	return x
+}
+
+// Deinterleave restores vectors one after another, padding is dropped
+func (x *Interleaved) Deinterleave() []Float8 {
+	vectors := make([]Float8, x.N*x.Dim)
+	for v := 0; v < x.N; v++ {
+		base := (v/x.Block)*x.Block*x.Dim + v%x.Block
+		for d := range x.Dim {
+			vectors[v*x.Dim+d] = x.Data[base+d*x.Block]
+		}
+	}
+	return vectors
+}
+
+// Dot computes dot product of query with each vector. The result has
+// x.N elements, dst is reused if it has enough capacity.
+func (x *Interleaved) Dot(dst []float32, q []Float8) []float32 {
+	return x.score(dst, q, func(acc []float32, q Float8, col []Float8) {
+		qd := f8tof32[q]
+		for v, e := range col {
+			acc[v] += qd * f8tof32[e]
+		}
+	})
+}
+
+// SquaredEuclidean computes squared Euclidean distance between query and
+// each vector. The result has x.N elements, dst is reused if it has enough
+// capacity.
+func (x *Interleaved) SquaredEuclidean(dst []float32, q []Float8) []float32 {
+	return x.score(dst, q, func(acc []float32, q Float8, col []Float8) {
+		row := sqdiff[int(q)<<8 : int(q)<<8+0x100]
+		for v, e := range col {
+			acc[v] += row[e]
+		}
+	})
+}
+
+// score blocks, kernel accumulates contribution of query element
+// to all vectors of block
+func (x *Interleaved) score(dst []float32, q []Float8, kernel func(acc []float32, q Float8, col []Float8)) []float32 {
+	if len(q) != x.Dim {
+		panic("vectors must have same length")
+	}
+
+	if cap(dst) < x.N {
+		dst = make([]float32, x.N)
+	}
+	dst = dst[:x.N]
+
+	var buf [32]float32
+	size := x.Block * x.Dim
+	for b := 0; b*x.Block < x.N; b++ {
+		acc := buf[:x.Block]
+		clear(acc)
+
+		block := x.Data[b*size : (b+1)*size]
+		for d, e := range q {
+			kernel(acc, e, block[d*x.Block:(d+1)*x.Block])
+		}
+
+		copy(dst[b*x.Block:], acc)
+	}
+
+	return dst
+}
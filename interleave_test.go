@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"testing"
+)
+
+func randFloat8s(r *rand.Rand, n int) []Float8 {
+	x := make([]Float8, n)
+	for i := range x {
+		x[i] = ToFloat8(float32(r.NormFloat64()))
+	}
+	return x
+}
+
+func TestInterleave(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, block := range []int{16, 32} {
+		for _, n := range []int{0, 1, 16, 33, 100} {
+			vectors := randFloat8s(r, n*24)
+
+			x := Interleave(vectors, 24, block)
+			if len(x.Data)%(block*24) != 0 || len(x.Data) < len(vectors) {
+				t.Fatalf("unexpected layout length %d", len(x.Data))
+			}
+			if n > 1 && (x.Data[0] != vectors[0] || x.Data[1] != vectors[24] || x.Data[block] != vectors[1]) {
+				t.Errorf("unexpected layout")
+			}
+
+			if !bytes.Equal(x.Deinterleave(), vectors) {
+				t.Errorf("block %d, n %d: vectors are not restored", block, n)
+			}
+		}
+	}
+}
+
+func TestInterleavedScore(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	vectors := randFloat8s(r, 70*48)
+	q := randFloat8s(r, 48)
+
+	for _, block := range []int{16, 32} {
+		x := Interleave(vectors, 48, block)
+
+		dot := x.Dot(nil, q)
+		l2 := x.SquaredEuclidean(nil, q)
+		if len(dot) != 70 || len(l2) != 70 {
+			t.Fatalf("unexpected length %d", len(dot))
+		}
+
+		for v := range dot {
+			doc := vectors[v*48 : (v+1)*48]
+			if e := Dot(doc, q); dot[v] != e {
+				t.Errorf("dot[%d] = %g, expected %g", v, dot[v], e)
+			}
+			if e := SquaredEuclidean(doc, q); l2[v] != e {
+				t.Errorf("l2[%d] = %g, expected %g", v, l2[v], e)
+			}
+		}
+	}
+}
+
+func BenchmarkInterleavedDot(b *testing.B) {
+	r := rand.New(rand.NewPCG(5, 6))
+	vectors := randFloat8s(r, 1024*128)
+	q := randFloat8s(r, 128)
+	x := Interleave(vectors, 128, 32)
+	dst := make([]float32, 1024)
+
+	b.Run("interleaved", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			dst = x.Dot(dst, q)
+		}
+	})
+
+	b.Run("rows", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for v := range dst {
+				dst[v] = Dot(vectors[v*128:(v+1)*128], q)
+			}
+		}
+	})
+}
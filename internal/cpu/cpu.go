@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package cpu detects CPU features used to dispatch vector kernels.
+// Features are detected once at init.
+package cpu
+
+import "golang.org/x/sys/cpu"
+
+var (
+	// X86 AVX2
+	HasAVX2 = cpu.X86.HasAVX2
+
+	// X86 AVX-512 foundation and byte/word instructions
+	HasAVX512 = cpu.X86.HasAVX512F && cpu.X86.HasAVX512BW
+
+	// ARM64 Advanced SIMD (NEON)
+	HasNEON = cpu.ARM64.HasASIMD
+
+	// ARM64 Scalable Vector Extension
+	HasSVE = cpu.ARM64.HasSVE
+)
+
+// Features returns names of detected features
+func Features() []string {
+	var seq []string
+	for _, f := range []struct {
+		name string
+		has  bool
+	}{
+		{"avx2", HasAVX2},
+		{"avx512", HasAVX512},
+		{"neon", HasNEON},
+		{"sve", HasSVE},
+	} {
+		if f.has {
+			seq = append(seq, f.name)
+		}
+	}
+	return seq
+}
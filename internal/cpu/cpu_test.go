@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package cpu
+
+import (
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestFeatures(t *testing.T) {
+	f := Features()
+
+	// x/sys/cpu reports X86 features on both amd64 and 386
+	x86 := runtime.GOARCH == "amd64" || runtime.GOARCH == "386"
+	if !x86 && (slices.Contains(f, "avx2") || slices.Contains(f, "avx512")) {
+		t.Errorf("x86 features on %s: %v", runtime.GOARCH, f)
+	}
+
+	if runtime.GOARCH != "arm64" && (slices.Contains(f, "neon") || slices.Contains(f, "sve")) {
+		t.Errorf("arm64 features on %s: %v", runtime.GOARCH, f)
+	}
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package half implements IEEE 754 half precision (fp16) conversions
+// used by block and interchange formats.
+package half
+
+import "math"
+
+// FromFloat32 converts float32 to IEEE 754 half precision, round to nearest even
+func FromFloat32(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23&0xff) - 127 + 15
+	man := bits & 0x7fffff
+
+	switch {
+	case bits&0x7fffffff > 0x7f800000: // NaN
+		return sign | 0x7e00
+	case exp >= 0x1f: // overflow or infinity
+		return sign | 0x7c00
+	case exp <= 0: // subnormal or zero
+		if exp < -10 {
+			return sign
+		}
+		man |= 0x800000
+		shift := uint32(14 - exp)
+		half := uint32(1) << (shift - 1)
+		r := man & (half<<1 - 1)
+		h := man >> shift
+		if r > half || (r == half && h&1 == 1) {
+			h++
+		}
+		return sign | uint16(h)
+	}
+
+	h := uint32(exp)<<10 | man>>13
+	r := man & 0x1fff
+	if r > 0x1000 || (r == 0x1000 && h&1 == 1) {
+		h++ // carry into exponent is correct, including overflow to infinity
+	}
+	return sign | uint16(h)
+}
+
+// ToFloat32 converts IEEE 754 half precision to float32
+func ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := int(h>>10) & 0x1f
+	man := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | man<<13)
+	case exp == 0 && man == 0:
+		return math.Float32frombits(sign)
+	case exp == 0:
+		v := float32(math.Ldexp(float64(man), -24))
+		if sign != 0 {
+			v = -v
+		}
+		return v
+	}
+
+	return math.Float32frombits(sign | uint32(exp-15+127)<<23 | man<<13)
+}
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package half
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHalf(t *testing.T) {
+	for h := 0; h < 0x10000; h++ {
+		if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+			continue // NaN
+		}
+
+		f := ToFloat32(uint16(h))
+		if v := FromFloat32(f); v != uint16(h) {
+			t.Errorf("0x%04x got=0x%04x f32=%g", h, v, f)
+		}
+	}
+
+	for f, h := range map[float32]uint16{1.0: 0x3c00, 65504: 0x7bff, 1e6: 0x7c00, (1 + 1.0/2048) / 2: 0x3800, (1 + 3.0/2048) / 2: 0x3802} {
+		if v := FromFloat32(f); v != h {
+			t.Errorf("%g wanted=0x%04x got=0x%04x", f, h, v)
+		}
+	}
+
+	if v := FromFloat32(float32(math.NaN())); v&0x7c00 != 0x7c00 || v&0x3ff == 0 {
+		t.Errorf("NaN got=0x%04x", v)
+	}
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package kmeans implements k-means helpers shared by float8 clustering
+// and product quantization. Vectors of any element type are decoded to
+// float32 on the fly.
+package kmeans
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Seed chooses k centroids (k × dim row-major) among n vectors by k-means++,
+// next centroid is sampled with probability proportional to squared distance
+// to the nearest chosen centroid. vec returns i-th vector of dimension dim.
+func Seed[T any](r *rand.Rand, centroids []float32, n, dim, k int, vec func(int) []T, decode func(T) float32) {
+	copyDecoded(centroids[:dim], vec(r.IntN(n)), decode)
+
+	dist := make([]float64, n)
+	for i := range dist {
+		dist[i] = math.MaxFloat64
+	}
+
+	for c := 1; c < k; c++ {
+		prev := centroids[(c-1)*dim : c*dim]
+		total := 0.0
+		for i := range dist {
+			dist[i] = math.Min(dist[i], float64(SquaredDistance(prev, vec(i), decode)))
+			total += dist[i]
+		}
+
+		at := r.IntN(n)
+		if total > 0 {
+			x := r.Float64() * total
+			for i, d := range dist {
+				if x -= d; x <= 0 {
+					at = i
+					break
+				}
+			}
+		}
+
+		copyDecoded(centroids[c*dim:(c+1)*dim], vec(at), decode)
+	}
+}
+
+// Nearest returns index of the nearest centroid (k × dim row-major) to x
+func Nearest[T any](centroids []float32, dim int, x []T, decode func(T) float32) int {
+	best, dist := 0, float32(math.MaxFloat32)
+	for c := 0; c < len(centroids)/dim; c++ {
+		if d := SquaredDistance(centroids[c*dim:(c+1)*dim], x, decode); d < dist {
+			best, dist = c, d
+		}
+	}
+	return best
+}
+
+// SquaredDistance between float32 vector and vector of decoded elements
+func SquaredDistance[T any](a []float32, b []T, decode func(T) float32) float32 {
+	d := float32(0)
+	for i, x := range b {
+		y := a[i] - decode(x)
+		d += y * y
+	}
+	return d
+}
+
+// Identity decoder of float32 vectors
+func Identity(x float32) float32 { return x }
+
+func copyDecoded[T any](dst []float32, x []T, decode func(T) float32) {
+	for j, v := range x {
+		dst[j] = decode(v)
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package kmeans
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSeed(t *testing.T) {
+	// two tight groups, k-means++ must pick one centroid from each
+	data := [][]float32{{0, 0}, {0.1, 0}, {0, 0.1}, {10, 10}, {10.1, 10}, {10, 10.1}}
+	vec := func(i int) []float32 { return data[i] }
+
+	for seed := uint64(0); seed < 16; seed++ {
+		centroids := make([]float32, 2*2)
+		Seed(rand.New(rand.NewPCG(seed, 0)), centroids, len(data), 2, 2, vec, Identity)
+
+		a := Nearest(centroids, 2, data[0], Identity)
+		b := Nearest(centroids, 2, data[3], Identity)
+		if a == b {
+			t.Errorf("seed %d: centroids %v are in same group", seed, centroids)
+		}
+	}
+}
+
+func TestSquaredDistance(t *testing.T) {
+	decode := func(x int8) float32 { return float32(x) }
+	if d := SquaredDistance([]float32{1, 2, 3}, []int8{1, 0, -1}, decode); d != 20 {
+		t.Errorf("wanted=20, got=%g", d)
+	}
+}
@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package math8
+
+import "math"
+
+// FP4 (E2M1) number in low nibble: sign, 2 bits of exponent (bias 1) and
+// 1 bit of mantissa. Exponent 0 is subnormal, there are no Inf and NaN.
+func FP4ToFloat32(x uint8) float32 {
+	sign := (x >> 3) & 0x01
+	exponent := int((x >> 1) & 0x03)
+	mantissa := float64(x & 0x01)
+
+	var v float64
+	if exponent == 0 {
+		v = mantissa * 0.5
+	} else {
+		v = (1 + mantissa/2) * math.Pow(2, float64(exponent-1))
+	}
+
+	if sign == 1 {
+		v = -v
+	}
+
+	return float32(v)
+}
+
+// Dot product of two bytes, each packs two FP4 values (low nibble first)
+func DotFP4(a, b uint8) float32 {
+	lo := float64(FP4ToFloat32(a&0x0f)) * float64(FP4ToFloat32(b&0x0f))
+	hi := float64(FP4ToFloat32(a>>4)) * float64(FP4ToFloat32(b>>4))
+	return float32(lo + hi)
+}
@@ -42,9 +42,10 @@ const (
 	// exponent base
 	base = 2
 
-	//
-	positiveInf = 0x7f ^ mantissaMask
-	negativeInf = 0xff ^ mantissaMask
+	// Infinity is the top pattern 0x7f (see float8.Infinity), overflow of
+	// conversions and arithmetic produce ±Infinity
+	positiveInf = 0x7f
+	negativeInf = 0xff
 )
 
 type Float8 = uint8
@@ -62,26 +63,20 @@ func ToFloat8(f32 float32) Float8 {
 		f32 = -f32
 	}
 
-	// Handle special cases: infinity and NaN
+	// Handle special cases: infinity
 	if math32.IsInf(f32, 1) {
-		return positiveInf
-	}
-	if math32.IsInf(f32, -1) {
-		return negativeInf
+		return sign<<7 | positiveInf
 	}
 
 	expValue := math32.Floor(math32.Log2(f32))
-	if expValue > exponentHi {
-		return positiveInf
+	if expValue+exponentBias > exponentHi {
+		return sign<<7 | positiveInf
 	}
 	if expValue < exponentLo {
 		return 0
 	}
 
 	exponent := uint8(expValue + exponentBias)
-	if exponent > exponentHi {
-		exponent = exponentHi
-	}
 
 	mantissa := uint8((f32/math32.Pow(base, expValue) - 1.0) * mantissaBias)
 	if mantissa > mantissaMask {
@@ -161,13 +156,18 @@ func Add(a, b Float8) Float8 {
 		}
 	}
 
+	// Exact cancellation
+	if mantissa == 0 {
+		return 0
+	}
+
 	// Normalize result
 	exponent := int(aExponent)
 	if mantissa >= 2.0 {
 		mantissa /= 2.0
 		exponent++
 	}
-	for mantissa < 1.0 && mantissa != 0 {
+	for mantissa < 1.0 {
 		mantissa *= 2.0
 		exponent--
 	}
@@ -302,3 +302,118 @@ func Div(a, b Float8) Float8 {
 
 	return result
 }
+
+// Apply real function to Float8. The format has no NaN, undefined results
+// (e.g. square root of negative number) are mapped to 0. Results beyond
+// the range saturate to ±0x7f (Infinity).
+func Apply(f func(float64) float64, x Float8) Float8 {
+	return quantize(f(float64(ToFloat32(x))))
+}
+
+// quantize result of real function, see Apply
+func quantize(val float64) Float8 {
+	f32 := float32(val)
+	switch {
+	case math.IsNaN(val):
+		return 0
+	case f32 >= 1<<(exponentHi-exponentBias+1):
+		return positiveInf
+	case f32 <= -1<<(exponentHi-exponentBias+1):
+		return negativeInf
+	}
+
+	return ToFloat8(f32)
+}
+
+// Squared difference (a - b)² of Float8, computed exactly and rounded to float32
+func SquaredDiff(a, b Float8) float32 {
+	d := float64(ToFloat32(a)) - float64(ToFloat32(b))
+	return float32(d * d)
+}
+
+// Absolute difference |a - b| of Float8, computed exactly and rounded to float32
+func AbsDiff(a, b Float8) float32 {
+	return float32(math.Abs(float64(ToFloat32(a)) - float64(ToFloat32(b))))
+}
+
+// ApplyBinary applies real function of two arguments to Float8(s),
+// undefined results are mapped to 0 and results beyond the range
+// saturate to ±0x7f (see Apply).
+func ApplyBinary(f func(float64, float64) float64, a, b Float8) Float8 {
+	return quantize(f(float64(ToFloat32(a)), float64(ToFloat32(b))))
+}
+
+// Floating-point remainder of a/b, sign of result matches a (see math.Mod).
+// Pattern 0x7f decodes to 480 but it is Infinity, the remainder of it is
+// undefined and mapped to 0.
+func Mod(a, b Float8) Float8 {
+	if a&^signMask == 0x7f {
+		return 0
+	}
+	return ApplyBinary(math.Mod, a, b)
+}
+
+// IEEE 754 remainder of a/b (see math.Remainder), Remainder(±Infinity, b) is 0 (see Mod)
+func Remainder(a, b Float8) Float8 {
+	if a&^signMask == 0x7f {
+		return 0
+	}
+	return ApplyBinary(math.Remainder, a, b)
+}
+
+// Hypot √(a² + b²) of Float8(s), computed without intermediate overflow
+func Hypot(a, b Float8) Float8 { return ApplyBinary(math.Hypot, a, b) }
+
+// Pow a**b of Float8(s) (see math.Pow). Negative base is defined for
+// integer exponent only, other undefined results are mapped to 0.
+func Pow(a, b Float8) Float8 { return ApplyBinary(math.Pow, a, b) }
+
+// Atan2 of Float8(s), angle of point (x, y) in radians (see math.Atan2)
+func Atan2(y, x Float8) Float8 { return ApplyBinary(math.Atan2, y, x) }
+
+// Fractional bits of fixed point products, see ProductFixed
+const ProductFixedBits = 10
+
+// Product a·b of Float8(s) as fixed point integer with ProductFixedBits
+// fractional bits, rounded to the nearest integer
+func ProductFixed(a, b Float8) int32 {
+	p := float64(ToFloat32(a)) * float64(ToFloat32(b))
+	return int32(math.Round(math.Ldexp(p, ProductFixedBits)))
+}
+
+// Largest finite value, saturating operations clamp results to ±MaxValue
+const MaxValue = 0x7e
+
+// saturate result of binary operation, results which magnitude is not less
+// than MaxValue (including infinities and x/0) are clamped to ±MaxValue.
+func saturate(op func(a, b Float8) Float8, exact func(x, y float64) float64, a, b Float8) Float8 {
+	val := exact(float64(ToFloat32(a)), float64(ToFloat32(b)))
+	if math.Abs(val) >= float64(ToFloat32(MaxValue)) {
+		if val < 0 {
+			return signMask | MaxValue
+		}
+		return MaxValue
+	}
+
+	return op(a, b)
+}
+
+// Add two Float8 with saturation to ±MaxValue
+func SatAdd(a, b Float8) Float8 {
+	return saturate(Add, func(x, y float64) float64 { return x + y }, a, b)
+}
+
+// Subtract two Float8 with saturation to ±MaxValue
+func SatSub(a, b Float8) Float8 {
+	return saturate(Sub, func(x, y float64) float64 { return x - y }, a, b)
+}
+
+// Multiply Float8 with saturation to ±MaxValue
+func SatMul(a, b Float8) Float8 {
+	return saturate(Mul, func(x, y float64) float64 { return x * y }, a, b)
+}
+
+// Divide Float8 with saturation to ±MaxValue, 0/0 is 0
+func SatDiv(a, b Float8) Float8 {
+	return saturate(Div, func(x, y float64) float64 { return x / y }, a, b)
+}
@@ -707,3 +707,73 @@ func TestDiv(t *testing.T) {
 		}
 	}
 }
+
+func TestSaturating(t *testing.T) {
+	for name, op := range map[string]func(a, b uint8) uint8{
+		"add": math8.SatAdd,
+		"sub": math8.SatSub,
+		"mul": math8.SatMul,
+		"div": math8.SatDiv,
+	} {
+		for a := 0; a < 0x100; a++ {
+			for b := 0; b < 0x100; b++ {
+				if c := op(uint8(a), uint8(b)); c&0x7f == 0x7f {
+					t.Errorf("%s(0x%02x, 0x%02x) = 0x%02x, infinity is not saturated", name, a, b, c)
+				}
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		c, e uint8
+	}{
+		{math8.SatAdd(0x77, 0x77), math8.MaxValue},        // 240 + 240
+		{math8.SatMul(0xf0, 0x70), 0x80 | math8.MaxValue}, // -128 × 128
+		{math8.SatDiv(0x38, 0x00), math8.MaxValue},        // 1 / 0
+		{math8.SatDiv(0xb8, 0x00), 0x80 | math8.MaxValue},
+		{math8.SatDiv(0x00, 0x00), 0x00},
+		{math8.SatAdd(0x38, 0x38), math8.Add(0x38, 0x38)},
+	} {
+		if tc.c != tc.e {
+			t.Errorf("got 0x%02x, wanted 0x%02x", tc.c, tc.e)
+		}
+	}
+}
+
+func TestApplyOverflow(t *testing.T) {
+	for _, tc := range []struct {
+		c, e uint8
+	}{
+		{math8.Apply(math.Exp, math8.ToFloat8(6)), 0x7c},  // 403 → 384
+		{math8.Apply(math.Exp, math8.ToFloat8(7)), 0x7f},  // 1096
+		{math8.Apply(math.Exp2, math8.ToFloat8(9)), 0x7f}, // 512
+		{math8.Apply(func(x float64) float64 { return -math.Exp(x) }, math8.ToFloat8(7)), 0xff},
+		{math8.Apply(func(x float64) float64 { return 500 }, 0x38), 0x7f}, // 480 is Infinity
+		{math8.Pow(math8.ToFloat8(2), math8.ToFloat8(9)), 0x7f},
+		{math8.Hypot(0x7e, 0x7e), 0x7f},
+		{math8.Hypot(0x7f, 0x7f), 0x7f},
+		{math8.ToFloat8(1024), 0x7f},
+		{math8.ToFloat8(-1024), 0xff},
+		{math8.ToFloat8(float32(math.Inf(-1))), 0xff},
+		{math8.Add(0x7e, 0x7e), 0x7f}, // 448 + 448
+		{math8.Mul(0xf0, 0x70), 0xff}, // -128 × 128
+		{math8.Div(0x38, 0x00), 0x7f},
+		{math8.Div(0xb8, 0x00), 0xff},
+	} {
+		if tc.c != tc.e {
+			t.Errorf("got 0x%02x, wanted 0x%02x", tc.c, tc.e)
+		}
+	}
+
+	// monotone functions produce monotone code books up to the top of range
+	for _, f := range []func(float64) float64{math.Exp, math.Exp2, math.Sinh} {
+		prev := math8.Apply(f, 0x00)
+		for x := uint8(0x01); x < 0x80; x++ {
+			c := math8.Apply(f, x)
+			if math8.ToFloat32(c) < math8.ToFloat32(prev) {
+				t.Errorf("f(0x%02x) = 0x%02x is less than 0x%02x", x, c, prev)
+			}
+			prev = c
+		}
+	}
+}
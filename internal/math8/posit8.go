@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package math8
+
+import "math"
+
+// PositES is number of exponent bits of posit8
+const PositES = 1
+
+// PositNaR is "not a real" pattern of posit8
+const PositNaR = 0x80
+
+// PositToFloat64 decodes 8-bit posit with PositES exponent bits,
+// NaR is decoded as NaN.
+func PositToFloat64(p uint8) float64 {
+	switch p {
+	case 0:
+		return 0
+	case PositNaR:
+		return math.NaN()
+	}
+
+	sign := 1.0
+	if p&0x80 != 0 {
+		sign, p = -1, -p
+	}
+
+	// regime: run of identical bits after the sign
+	bits := uint(p) << 1
+	first := bits & 0x80
+	run := 0
+	for i := 0; i < 7 && bits&0x80 == first; i++ {
+		run++
+		bits <<= 1
+	}
+	bits <<= 1 // terminating bit of regime (if any)
+
+	k := -run
+	if first != 0 {
+		k = run - 1
+	}
+
+	// remaining bits: exponent, then fraction
+	left := max(7-run-1, 0)
+	rest := int(bits&0xff) >> (8 - left)
+
+	e := 0
+	if left >= PositES {
+		e = rest >> (left - PositES)
+		rest &= 1<<(left-PositES) - 1
+		left -= PositES
+	} else {
+		e = rest << (PositES - left)
+		rest, left = 0, 0
+	}
+
+	f := 1.0
+	if left > 0 {
+		f += float64(rest) / float64(int(1)<<left)
+	}
+
+	return sign * math.Ldexp(f, k*(1<<PositES)+e)
+}
+
+// PositFromFloat64 encodes real number to the nearest posit8 value, ties
+// are resolved to even bit pattern. Non zero values never round to zero,
+// values beyond range saturate to ±maxpos, NaN and Inf are NaR.
+func PositFromFloat64(x float64) uint8 {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return PositNaR
+	}
+
+	if x == 0 {
+		return 0
+	}
+
+	// posits are ordered as two's complement integers
+	best, dist := uint8(0), math.Inf(1)
+	for p := -127; p <= 127; p++ {
+		if p == 0 {
+			continue
+		}
+
+		code := uint8(int8(p))
+		d := math.Abs(PositToFloat64(code) - x)
+		if d < dist || d == dist && code&0x01 == 0 {
+			best, dist = code, d
+		}
+	}
+
+	return best
+}
+
+// PositApply applies binary real function to posit8 values
+func PositApply(f func(float64, float64) float64, a, b uint8) uint8 {
+	if a == PositNaR || b == PositNaR {
+		return PositNaR
+	}
+
+	return PositFromFloat64(f(PositToFloat64(a), PositToFloat64(b)))
+}
+
+func PositAdd(a, b uint8) uint8 {
+	return PositApply(func(x, y float64) float64 { return x + y }, a, b)
+}
+
+func PositSub(a, b uint8) uint8 {
+	return PositApply(func(x, y float64) float64 { return x - y }, a, b)
+}
+
+func PositMul(a, b uint8) uint8 {
+	return PositApply(func(x, y float64) float64 { return x * y }, a, b)
+}
+
+// PositDiv, division by zero is NaR
+func PositDiv(a, b uint8) uint8 {
+	if b == 0 {
+		return PositNaR
+	}
+
+	return PositApply(func(x, y float64) float64 { return x / y }, a, b)
+}
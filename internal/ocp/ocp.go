@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package ocp implements OCP 8-bit floating point formats (E4M3FN, E5M2)
+// used by interchange formats, which are not bit compatible with float8.
+package ocp
+
+import "math"
+
+// DecodeE4M3FN decodes OCP FP8 E4M3FN: bias 7, subnormals, no infinity, S.1111.111 is NaN
+func DecodeE4M3FN(x uint8) float32 {
+	exp := int(x>>3) & 0x0f
+	man := float64(x & 0x07)
+
+	var val float64
+	switch {
+	case exp == 0x0f && man == 0x07:
+		return float32(math.NaN())
+	case exp == 0:
+		val = math.Ldexp(man, -9)
+	default:
+		val = math.Ldexp(1+man/8, exp-7)
+	}
+
+	if x&0x80 != 0 {
+		val = -val
+	}
+	return float32(val)
+}
+
+// DecodeE5M2 decodes OCP FP8 E5M2: bias 15, subnormals, IEEE infinity and NaN
+func DecodeE5M2(x uint8) float32 {
+	exp := int(x>>2) & 0x1f
+	man := float64(x & 0x03)
+
+	var val float64
+	switch {
+	case exp == 0x1f && man == 0:
+		val = math.Inf(1)
+	case exp == 0x1f:
+		return float32(math.NaN())
+	case exp == 0:
+		val = math.Ldexp(man, -16)
+	default:
+		val = math.Ldexp(1+man/4, exp-15)
+	}
+
+	if x&0x80 != 0 {
+		val = -val
+	}
+	return float32(val)
+}
+
+// EncodeE4M3FN encodes OCP FP8 E4M3FN using round to nearest even, saturates to ±448
+func EncodeE4M3FN(f float32) uint8 {
+	v := float64(f)
+	if math.IsNaN(v) {
+		return 0x7f
+	}
+
+	sign := uint8(0)
+	if math.Signbit(v) {
+		sign = 0x80
+		v = -v
+	}
+
+	// values above 464 would round to NaN pattern
+	if v >= 464 {
+		return sign | 0x7e
+	}
+
+	frac, exp := math.Frexp(v)
+	exp-- // v = (2 × frac) × 2^exp
+
+	if v == 0 || exp < -6 {
+		// subnormal, quantum is 2^-9, the result 8 naturally becomes 2^-6
+		return sign | uint8(math.RoundToEven(math.Ldexp(v, 9)))
+	}
+
+	q := uint8(math.RoundToEven(frac * 16)) // [8, 16]
+	if q == 16 {
+		q = 8
+		exp++
+	}
+
+	return sign | uint8(exp+7)<<3 | (q - 8)
+}
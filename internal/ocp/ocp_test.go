@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package ocp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCodecE4M3FN(t *testing.T) {
+	for x := 0; x < 0x100; x++ {
+		f := DecodeE4M3FN(uint8(x))
+		if math.IsNaN(float64(f)) {
+			continue
+		}
+
+		if v := EncodeE4M3FN(f); v != uint8(x) {
+			t.Errorf("0x%02x got=0x%02x f32=%g", x, v, f)
+		}
+	}
+
+	for f, x := range map[float32]uint8{1.0: 0x38, 448: 0x7e, 1000: 0x7e, -1000: 0xfe, 0.0078125: 0x04} {
+		if v := EncodeE4M3FN(f); v != x {
+			t.Errorf("%g wanted=0x%02x got=0x%02x", f, x, v)
+		}
+	}
+}
+
+func TestCodecE5M2(t *testing.T) {
+	for x, f := range map[uint8]float32{0x3c: 1.0, 0x7b: 57344, 0x7c: float32(math.Inf(1)), 0x01: 1.52587890625e-05} {
+		if v := DecodeE5M2(x); v != f {
+			t.Errorf("0x%02x wanted=%g got=%g", x, f, v)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "iter"
+
+// AllValues iterates over all float8 values in order of bit patterns (0x00 ... 0xff)
+func AllValues() iter.Seq[Float8] {
+	return func(yield func(Float8) bool) {
+		for f8 := 0; f8 < 0x100; f8++ {
+			if !yield(Float8(f8)) {
+				return
+			}
+		}
+	}
+}
+
+// AllFinite iterates over finite float8 values in order of bit patterns,
+// infinities are skipped.
+func AllFinite() iter.Seq[Float8] {
+	return func(yield func(Float8) bool) {
+		for f8 := range AllValues() {
+			if IsInf(f8, 0) {
+				continue
+			}
+			if !yield(f8) {
+				return
+			}
+		}
+	}
+}
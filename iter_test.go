@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "testing"
+
+func TestAllValues(t *testing.T) {
+	n := 0
+	for f8 := range AllValues() {
+		if f8 != Float8(n) {
+			t.Errorf("unexpected order 0x%02x at %d", f8, n)
+		}
+		n++
+	}
+
+	if n != 0x100 {
+		t.Errorf("iterated %d values", n)
+	}
+}
+
+func TestAllFinite(t *testing.T) {
+	n := 0
+	for f8 := range AllFinite() {
+		if IsInf(f8, 0) {
+			t.Errorf("infinity 0x%02x", f8)
+		}
+		n++
+	}
+
+	if n != finiteCount {
+		t.Errorf("iterated %d values", n)
+	}
+
+	// early termination
+	for f8 := range AllFinite() {
+		if f8 == 0x10 {
+			break
+		}
+	}
+}
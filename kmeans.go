@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/rand/v2"
+
+	"github.com/kshard/float8/internal/kmeans"
+)
+
+// kmeans configuration
+type kmeansConfig struct {
+	iterations int
+	batch      int
+	seed       uint64
+}
+
+// KMeansOption of clustering
+type KMeansOption func(*kmeansConfig)
+
+// WithKMeansIterations defines number of iterations (default 25)
+func WithKMeansIterations(n int) KMeansOption {
+	return func(km *kmeansConfig) { km.iterations = n }
+}
+
+// WithKMeansBatch enables mini-batch k-means, each iteration updates
+// centroids using random sample of n vectors.
+func WithKMeansBatch(n int) KMeansOption {
+	return func(km *kmeansConfig) { km.batch = n }
+}
+
+// WithKMeansSeed defines seed of random initialization and sampling
+func WithKMeansSeed(seed uint64) KMeansOption {
+	return func(km *kmeansConfig) { km.seed = seed }
+}
+
+// KMeans clusters float8 vectors stored row-major in corpus (len(corpus)/dim
+// vectors of dimension dim) into k clusters. Vectors are decoded on the fly,
+// the corpus is never expanded to float32. It returns centroids, k × dim
+// row-major, and cluster index of each vector. Centroids are seeded by k-means++.
+func KMeans(corpus []Float8, dim, k int, opts ...KMeansOption) (centroids []float32, assign []int) {
+	if dim <= 0 || len(corpus)%dim != 0 {
+		panic("corpus length must be multiple of dimension")
+	}
+
+	n := len(corpus) / dim
+	if k <= 0 || k > n {
+		panic("number of clusters must be in range [1, number of vectors]")
+	}
+
+	km := kmeansConfig{iterations: 25}
+	for _, opt := range opts {
+		opt(&km)
+	}
+
+	r := rand.New(rand.NewPCG(km.seed, 0))
+	vec := func(i int) []Float8 { return corpus[i*dim : (i+1)*dim] }
+
+	centroids = make([]float32, k*dim)
+	kmeans.Seed(r, centroids, n, dim, k, vec, ToFloat32)
+
+	assign = make([]int, n)
+	if km.batch > 0 && km.batch < n {
+		// mini-batch update with per-centroid learning rate 1/count
+		counts := make([]int, k)
+		for it := 0; it < km.iterations; it++ {
+			for b := 0; b < km.batch; b++ {
+				x := vec(r.IntN(n))
+				c := nearestCentroid(centroids, dim, x)
+				counts[c]++
+				eta := 1 / float32(counts[c])
+				cent := centroids[c*dim : (c+1)*dim]
+				for j, f8 := range x {
+					cent[j] += eta * (f8tof32[f8] - cent[j])
+				}
+			}
+		}
+	} else {
+		sums := make([]float64, k*dim)
+		counts := make([]int, k)
+		for it := 0; it < km.iterations; it++ {
+			clear(sums)
+			clear(counts)
+
+			for i := 0; i < n; i++ {
+				x := vec(i)
+				c := nearestCentroid(centroids, dim, x)
+				counts[c]++
+				for j, f8 := range x {
+					sums[c*dim+j] += float64(f8tof32[f8])
+				}
+			}
+
+			for c := 0; c < k; c++ {
+				if counts[c] == 0 {
+					continue
+				}
+				for j := 0; j < dim; j++ {
+					centroids[c*dim+j] = float32(sums[c*dim+j] / float64(counts[c]))
+				}
+			}
+		}
+	}
+
+	for i := range assign {
+		assign[i] = nearestCentroid(centroids, dim, vec(i))
+	}
+
+	return centroids, assign
+}
+
+func nearestCentroid(centroids []float32, dim int, x []Float8) int {
+	return kmeans.Nearest(centroids, dim, x, ToFloat32)
+}
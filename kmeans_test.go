@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// corpus of n vectors around k well separated centers, vector i belongs to i % k
+func blobs(n, dim, k int) []Float8 {
+	r := rand.New(rand.NewPCG(1, 2))
+	corpus := make([]Float8, n*dim)
+	for i := 0; i < n; i++ {
+		for j := 0; j < dim; j++ {
+			corpus[i*dim+j] = ToFloat8(float32(i%k)*8 - 12 + 0.25*float32(r.NormFloat64()))
+		}
+	}
+	return corpus
+}
+
+func checkClusters(t *testing.T, assign []int, k int) {
+	t.Helper()
+
+	// all vectors of same center must share cluster, centers must not share clusters
+	seen := map[int]int{}
+	for i, c := range assign {
+		if at, ok := seen[c]; ok && at != i%k {
+			t.Fatalf("cluster %d mixes centers %d and %d", c, at, i%k)
+		}
+		seen[c] = i % k
+	}
+
+	if len(seen) != k {
+		t.Fatalf("unexpected number of clusters %d", len(seen))
+	}
+}
+
+func TestKMeans(t *testing.T) {
+	corpus := blobs(300, 4, 4)
+
+	centroids, assign := KMeans(corpus, 4, 4, WithKMeansSeed(3))
+	if len(centroids) != 16 || len(assign) != 300 {
+		t.Fatalf("unexpected shapes %d, %d", len(centroids), len(assign))
+	}
+	checkClusters(t, assign, 4)
+}
+
+func TestKMeansMiniBatch(t *testing.T) {
+	corpus := blobs(1000, 4, 4)
+
+	_, assign := KMeans(corpus, 4, 4, WithKMeansBatch(64), WithKMeansIterations(50), WithKMeansSeed(5))
+	checkClusters(t, assign, 4)
+}
+
+func TestKMeansInvalid(t *testing.T) {
+	for name, f := range map[string]func(){
+		"dim":      func() { KMeans(make([]Float8, 5), 2, 1) },
+		"clusters": func() { KMeans(make([]Float8, 4), 2, 3) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: panic is expected", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
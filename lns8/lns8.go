@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package lns8 implements experimental 8-bit logarithmic number system,
+// a sibling of E4M3 float8 for multiplication dominated workloads.
+// The number is sign bit followed by 7-bit fixed point log2 magnitude:
+//
+//	±2^((m - 64) / 8), m ∈ [1, 127]
+//
+// The code m = 0 is zero. The range is [2^-7.875, 2^7.875] with relative
+// step 2^(1/8), multiplication and division are addition and subtraction
+// of codes.
+package lns8
+
+import "math"
+
+// LNS8 data type
+type LNS8 = uint8
+
+const (
+	signMask = 0x80
+	codeMask = 0x7f
+	bias     = 64
+	frac     = 8
+)
+
+// code book of decoded values
+var lns8tof32 [256]float32
+
+func init() {
+	for l := 0; l < 256; l++ {
+		m := l & codeMask
+		if m == 0 {
+			continue
+		}
+
+		x := float32(math.Exp2(float64(m-bias) / frac))
+		if l&signMask != 0 {
+			x = -x
+		}
+		lns8tof32[l] = x
+	}
+}
+
+// FromFloat32 converts float32 to LNS8 rounding to the nearest code in
+// log domain, values outside of range saturate, NaN is mapped to zero.
+func FromFloat32(f32 float32) LNS8 {
+	if f32 == 0 || f32 != f32 {
+		return 0
+	}
+
+	sign := uint8(0)
+	if f32 < 0 {
+		sign, f32 = signMask, -f32
+	}
+
+	m := math.Round(math.Log2(float64(f32))*frac) + bias
+	switch {
+	case m < 1:
+		return 0
+	case m > codeMask:
+		m = codeMask
+	}
+
+	return sign | uint8(m)
+}
+
+// ToFloat32 converts LNS8 to float32
+func ToFloat32(l LNS8) float32 { return lns8tof32[l] }
+
+// ToSlice converts float32 slice to LNS8
+func ToSlice(f32s []float32) []LNS8 {
+	ls := make([]LNS8, len(f32s))
+	for i, x := range f32s {
+		ls[i] = FromFloat32(x)
+	}
+	return ls
+}
+
+// Mul multiplies numbers by adding log magnitudes
+func Mul(a, b LNS8) LNS8 {
+	ma, mb := int(a&codeMask), int(b&codeMask)
+	if ma == 0 || mb == 0 {
+		return 0
+	}
+
+	return join((a^b)&signMask, ma+mb-bias)
+}
+
+// Div divides numbers by subtracting log magnitudes, division by zero
+// saturates to the largest magnitude.
+func Div(a, b LNS8) LNS8 {
+	ma, mb := int(a&codeMask), int(b&codeMask)
+	switch {
+	case ma == 0:
+		return 0
+	case mb == 0:
+		return (a^b)&signMask | codeMask
+	}
+
+	return join((a^b)&signMask, ma-mb+bias)
+}
+
+func join(sign uint8, m int) LNS8 {
+	switch {
+	case m < 1:
+		return 0
+	case m > codeMask:
+		m = codeMask
+	}
+
+	return sign | uint8(m)
+}
+
+// Dot product of vectors, products are computed in log domain and
+// accumulated in float32.
+func Dot(a, b []LNS8) float32 {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	d := float32(0)
+	for i := range a {
+		d += lns8tof32[Mul(a[i], b[i])]
+	}
+
+	return d
+}
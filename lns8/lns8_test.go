@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package lns8
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFromFloat32(t *testing.T) {
+	for l := 0; l < 256; l++ {
+		if l == signMask {
+			continue // negative zero is not produced
+		}
+
+		if x := FromFloat32(ToFloat32(uint8(l))); x != uint8(l) {
+			t.Errorf("0x%02x got=0x%02x", l, x)
+		}
+	}
+
+	for f, l := range map[float32]uint8{0: 0x00, 1: 0x40, -1: 0xc0, 2: 0x48, 0.5: 0x38, 1e6: 0x7f, 1e-6: 0x00} {
+		if x := FromFloat32(f); x != l {
+			t.Errorf("%g wanted=0x%02x, got=0x%02x", f, l, x)
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			e := float64(ToFloat32(uint8(a))) * float64(ToFloat32(uint8(b)))
+			c := float64(ToFloat32(Mul(uint8(a), uint8(b))))
+
+			// products within range are exact in log domain
+			if math.Abs(e) >= float64(ToFloat32(0x01)) && math.Abs(e) <= float64(ToFloat32(0x7f)) {
+				if math.Abs(c-e) > 1e-5*math.Abs(e) {
+					t.Fatalf("0x%02x * 0x%02x wanted=%g, got=%g", a, b, e, c)
+				}
+			}
+		}
+	}
+}
+
+func TestDiv(t *testing.T) {
+	if x := ToFloat32(Div(FromFloat32(6), FromFloat32(-2))); math.Abs(float64(x)+3) > 0.3 {
+		t.Errorf("6 / -2 = %g", x)
+	}
+
+	if x := Div(FromFloat32(1), 0); x != 0x7f {
+		t.Errorf("1 / 0 = 0x%02x", x)
+	}
+
+	if x := Div(0, FromFloat32(1)); x != 0 {
+		t.Errorf("0 / 1 = 0x%02x", x)
+	}
+}
+
+func TestDot(t *testing.T) {
+	a := ToSlice([]float32{1, 2, 0.5})
+	b := ToSlice([]float32{4, -1, 2})
+
+	if d := Dot(a, b); d != 4-2+1 {
+		t.Errorf("unexpected product %g", d)
+	}
+}
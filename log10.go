@@ -0,0 +1,11 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for log10 of float8
+//
+
+var log10 = [0x100]uint8{0xff,0xc0,0xc0,0xbf,0xbf,0xbf,0xbe,0xbe,0xbe,0xbe,0xbd,0xbd,0xbd,0xbc,0xbc,0xbc,0xbc,0xbb,0xbb,0xba,0xba,0xba,0xba,0xb9,0xb9,0xb9,0xb8,0xb8,0xb8,0xb7,0xb7,0xb6,0xb6,0xb5,0xb4,0xb4,0xb3,0xb3,0xb2,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa7,0xa5,0xa2,0x9f,0x9b,0x96,0x8e,0x0,0x15,0x1c,0x20,0x23,0x25,0x27,0x28,0x29,0x2b,0x2c,0x2e,0x2f,0x30,0x30,0x31,0x31,0x32,0x33,0x33,0x34,0x35,0x35,0x36,0x36,0x37,0x38,0x38,0x38,0x38,0x39,0x39,0x39,0x3a,0x3a,0x3a,0x3b,0x3b,0x3b,0x3b,0x3c,0x3c,0x3c,0x3d,0x3d,0x3d,0x3d,0x3e,0x3e,0x3e,0x3f,0x3f,0x3f,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x41,0x41,0x41,0x41,0x41,0x41,0x42,0x42,0x42,0x42,0x42,0x42,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0}
+
+// Decimal logarithm of float8, it is 0 for negative values (no NaN)
+func Log10(a Float8) Float8 { return log10[a] }
@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// Matrix of float8 in row-major order. Rows are stride elements apart,
+// Row and Col create views sharing data with the original matrix.
+type Matrix struct {
+	rows, cols int
+	stride     int
+	data       []Float8
+}
+
+// NewMatrix allocates zero matrix r × c
+func NewMatrix(r, c int) *Matrix {
+	if r < 0 || c < 0 {
+		panic("matrix dimension must be non negative")
+	}
+
+	return MatrixOf(r, c, make([]Float8, r*c))
+}
+
+// MatrixOf creates matrix r × c over data in row-major order
+func MatrixOf(r, c int, data []Float8) *Matrix {
+	if r < 0 || c < 0 {
+		panic("matrix dimension must be non negative")
+	}
+	if r*c != len(data) {
+		panic("shape does not match length of data")
+	}
+
+	return &Matrix{rows: r, cols: c, stride: c, data: data}
+}
+
+// MatrixFromFloat32 quantizes rows of float32 into matrix
+func MatrixFromFloat32(x [][]float32) *Matrix {
+	if len(x) == 0 {
+		return NewMatrix(0, 0)
+	}
+
+	m := NewMatrix(len(x), len(x[0]))
+	for i, row := range x {
+		if len(row) != m.cols {
+			panic("rows must have same length")
+		}
+		for j, e := range row {
+			m.data[i*m.stride+j] = ToFloat8(e)
+		}
+	}
+	return m
+}
+
+// ToFloat32 decodes matrix into rows of float32
+func (m *Matrix) ToFloat32() [][]float32 {
+	x := make([][]float32, m.rows)
+	for i := range x {
+		x[i] = make([]float32, m.cols)
+		for j, e := range m.RawRow(i) {
+			x[i][j] = f8tof32[e]
+		}
+	}
+	return x
+}
+
+// Dims returns dimensions of matrix
+func (m *Matrix) Dims() (r, c int) { return m.rows, m.cols }
+
+// Stride is number of elements between rows
+func (m *Matrix) Stride() int { return m.stride }
+
+func (m *Matrix) index(i, j int) int {
+	if uint(i) >= uint(m.rows) || uint(j) >= uint(m.cols) {
+		panic("index out of range")
+	}
+	return i*m.stride + j
+}
+
+// At returns decoded element at row i, column j
+func (m *Matrix) At(i, j int) float32 { return f8tof32[m.data[m.index(i, j)]] }
+
+// Set quantizes and stores element at row i, column j
+func (m *Matrix) Set(i, j int, v float32) { m.data[m.index(i, j)] = ToFloat8(v) }
+
+// At8 returns float8 element at row i, column j
+func (m *Matrix) At8(i, j int) Float8 { return m.data[m.index(i, j)] }
+
+// Set8 stores float8 element at row i, column j
+func (m *Matrix) Set8(i, j int, v Float8) { m.data[m.index(i, j)] = v }
+
+// RawRow returns elements of row i, shares data with matrix
+func (m *Matrix) RawRow(i int) []Float8 {
+	if uint(i) >= uint(m.rows) {
+		panic("index out of range")
+	}
+
+	at := i * m.stride
+	return m.data[at : at+m.cols : at+m.cols]
+}
+
+// Row returns view 1 × c of row i
+func (m *Matrix) Row(i int) *Matrix {
+	return &Matrix{rows: 1, cols: m.cols, stride: m.stride, data: m.RawRow(i)}
+}
+
+// Col returns view r × 1 of column j
+func (m *Matrix) Col(j int) *Matrix {
+	if uint(j) >= uint(m.cols) {
+		panic("index out of range")
+	}
+	if m.rows == 0 {
+		return &Matrix{cols: 1, stride: m.stride}
+	}
+
+	return &Matrix{rows: m.rows, cols: 1, stride: m.stride, data: m.data[j : (m.rows-1)*m.stride+j+1]}
+}
+
+// T returns transposed copy of matrix
+func (m *Matrix) T() *Matrix {
+	t := NewMatrix(m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j, e := range m.RawRow(i) {
+			t.data[j*t.stride+i] = e
+		}
+	}
+	return t
+}
+
+// MulVec computes matrix-vector product y = m·x accumulated in float32.
+// The result has m.rows elements, dst is reused if it has enough capacity.
+func (m *Matrix) MulVec(dst []float32, x []Float8) []float32 {
+	if len(x) != m.cols {
+		panic("vectors must have same length")
+	}
+
+	if cap(dst) < m.rows {
+		dst = make([]float32, m.rows)
+	}
+	dst = dst[:m.rows]
+
+	for i := range dst {
+		dst[i] = Dot(m.RawRow(i), x)
+	}
+	return dst
+}
+
+// Mul computes matrix product m·b accumulated in float32. The result is
+// row-major matrix m.rows × b.cols, dst is reused if it has enough capacity.
+func (m *Matrix) Mul(dst []float32, b *Matrix) []float32 {
+	if m.cols != b.rows {
+		panic("matrix dimensions mismatch")
+	}
+
+	if cap(dst) < m.rows*b.cols {
+		dst = make([]float32, m.rows*b.cols)
+	}
+	dst = dst[:m.rows*b.cols]
+
+	bt := b.T()
+	for i := 0; i < m.rows; i++ {
+		row := m.RawRow(i)
+		for j := 0; j < b.cols; j++ {
+			dst[i*b.cols+j] = Dot(row, bt.RawRow(j))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestMatrix(t *testing.T) {
+	m := MatrixFromFloat32([][]float32{{1, 2, 3}, {4, 5, 6}})
+	if r, c := m.Dims(); r != 2 || c != 3 || m.Stride() != 3 {
+		t.Fatalf("unexpected dims %d × %d", r, c)
+	}
+
+	if m.At(1, 2) != 6 || m.At8(0, 1) != ToFloat8(2) {
+		t.Errorf("unexpected elements")
+	}
+
+	m.Set(0, 0, -1)
+	if m.At(0, 0) != -1 {
+		t.Errorf("unexpected element %g", m.At(0, 0))
+	}
+
+	x := m.ToFloat32()
+	if !slices.Equal(x[0], []float32{-1, 2, 3}) || !slices.Equal(x[1], []float32{4, 5, 6}) {
+		t.Errorf("unexpected rows %v", x)
+	}
+}
+
+func TestMatrixViews(t *testing.T) {
+	m := MatrixFromFloat32([][]float32{{1, 2, 3}, {4, 5, 6}})
+
+	row := m.Row(1)
+	if r, c := row.Dims(); r != 1 || c != 3 || row.At(0, 2) != 6 {
+		t.Errorf("unexpected row view")
+	}
+
+	col := m.Col(1)
+	if r, c := col.Dims(); r != 2 || c != 1 || col.At(0, 0) != 2 || col.At(1, 0) != 5 {
+		t.Errorf("unexpected column view")
+	}
+
+	col.Set(1, 0, 7)
+	if m.At(1, 1) != 7 {
+		t.Errorf("view does not share data")
+	}
+
+	tr := m.T()
+	if r, c := tr.Dims(); r != 3 || c != 2 || tr.At(2, 0) != 3 || tr.At(1, 1) != 7 {
+		t.Errorf("unexpected transpose %v", tr.ToFloat32())
+	}
+
+	if ct := col.T(); !bytes.Equal(ct.RawRow(0), []Float8{ToFloat8(2), ToFloat8(7)}) {
+		t.Errorf("unexpected transpose of view %v", ct.ToFloat32())
+	}
+}
+
+func TestMatrixMul(t *testing.T) {
+	a := MatrixFromFloat32([][]float32{{1, 2, 3}, {4, 5, 6}})
+	b := MatrixFromFloat32([][]float32{{1, 0}, {0, 1}, {2, -1}})
+
+	if y := a.MulVec(nil, []Float8{ToFloat8(1), ToFloat8(1), ToFloat8(2)}); !slices.Equal(y, []float32{9, 21}) {
+		t.Errorf("unexpected product %v", y)
+	}
+
+	if c := a.Mul(nil, b); !slices.Equal(c, []float32{7, -1, 16, -1}) {
+		t.Errorf("unexpected product %v", c)
+	}
+
+	if c := a.Mul(nil, a.T()); !slices.Equal(c, []float32{14, 32, 32, 77}) {
+		t.Errorf("unexpected product %v", c)
+	}
+}
+
+func TestMatrixMulMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+
+	NewMatrix(2, 3).Mul(nil, NewMatrix(2, 3))
+}
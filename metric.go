@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"sort"
+	"sync"
+)
+
+// Metric is a distance between vectors, smaller value means closer vectors.
+type Metric interface {
+	// Distance between vectors a and b
+	Distance(a, b []Float8) float32
+
+	// Distances from q to each of xs, dst is reused if it has enough capacity
+	Distances(dst []float32, q []Float8, xs [][]Float8) []float32
+}
+
+// MetricFunc adapts ordinary function to Metric interface
+type MetricFunc func(a, b []Float8) float32
+
+func (f MetricFunc) Distance(a, b []Float8) float32 { return f(a, b) }
+
+func (f MetricFunc) Distances(dst []float32, q []Float8, xs [][]Float8) []float32 {
+	if cap(dst) < len(xs) {
+		dst = make([]float32, len(xs))
+	}
+	dst = dst[:len(xs)]
+
+	for i, x := range xs {
+		dst[i] = f(q, x)
+	}
+
+	return dst
+}
+
+// Built-in metrics
+var (
+	// Cosine distance, see Cosine
+	CosineMetric Metric = MetricFunc(Cosine)
+
+	// Euclidean distance, see Euclidean
+	L2 Metric = MetricFunc(Euclidean)
+
+	// Manhattan distance, see Manhattan
+	L1 Metric = MetricFunc(Manhattan)
+
+	// Negative dot product, so that larger product means closer vectors
+	DotMetric Metric = MetricFunc(func(a, b []Float8) float32 { return -Dot(a, b) })
+)
+
+// Pairwise computes metric between each pair of vectors from a and b.
+// The result is row-major matrix len(a) × len(b),
+// dst is reused if it has enough capacity.
+func Pairwise(m Metric, dst []float32, a, b [][]Float8) []float32 {
+	if cap(dst) < len(a)*len(b) {
+		dst = make([]float32, len(a)*len(b))
+	}
+	dst = dst[:len(a)*len(b)]
+
+	for i := range a {
+		// metric may allocate instead of reusing dst
+		row := dst[i*len(b) : (i+1)*len(b)]
+		copy(row, m.Distances(row, a[i], b))
+	}
+
+	return dst
+}
+
+var (
+	metricsMu sync.RWMutex
+	metrics   = map[string]Metric{
+		"cosine": CosineMetric,
+		"l2":     L2,
+		"l1":     L1,
+		"dot":    DotMetric,
+	}
+)
+
+// RegisterMetric makes metric available by name, it replaces
+// previously registered metric with same name.
+func RegisterMetric(name string, m Metric) {
+	if m == nil {
+		panic("metric is nil")
+	}
+
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	metrics[name] = m
+}
+
+// LookupMetric returns metric registered by name
+func LookupMetric(name string) (Metric, bool) {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+	m, ok := metrics[name]
+	return m, ok
+}
+
+// Metrics returns sorted names of registered metrics
+func Metrics() []string {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+
+	names := make([]string, 0, len(metrics))
+	for name := range metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
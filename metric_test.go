@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMetricRegistry(t *testing.T) {
+	for _, name := range []string{"cosine", "l2", "l1", "dot"} {
+		if _, ok := LookupMetric(name); !ok {
+			t.Errorf("metric %s is not registered", name)
+		}
+	}
+
+	if _, ok := LookupMetric("unknown"); ok {
+		t.Errorf("unknown metric is registered")
+	}
+
+	RegisterMetric("approx", MetricFunc(ApproxDistance))
+	m, ok := LookupMetric("approx")
+	if !ok || m.Distance([]Float8{0x38}, []Float8{0x38}) != 0 {
+		t.Errorf("approx metric is not registered")
+	}
+
+	if names := Metrics(); !slices.Contains(names, "approx") || !slices.IsSorted(names) {
+		t.Errorf("unexpected metrics %v", names)
+	}
+}
+
+func TestMetricDistances(t *testing.T) {
+	q := []Float8{0x38, 0x40}
+	xs := [][]Float8{{0x38, 0x40}, {0x00, 0x00}, {0xb8, 0xc0}}
+
+	for name, m := range map[string]Metric{"cosine": CosineMetric, "l2": L2, "l1": L1, "dot": DotMetric} {
+		d := m.Distances(nil, q, xs)
+		if len(d) != len(xs) {
+			t.Fatalf("%s: unexpected length %d", name, len(d))
+		}
+
+		for i, x := range xs {
+			if d[i] != m.Distance(q, x) {
+				t.Errorf("%s: distance %d is %f, wanted %f", name, i, d[i], m.Distance(q, x))
+			}
+		}
+
+		if d[0] > d[2] {
+			t.Errorf("%s: same vector is farther than opposite one", name)
+		}
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	a := [][]Float8{{0x38, 0x40}, {0x00, 0x00}}
+	b := [][]Float8{{0x38, 0x40}, {0x00, 0x00}, {0xb8, 0xc0}}
+
+	d := Pairwise(L1, make([]float32, 1, 10), a, b)
+	expected := []float32{0, 3, 6, 3, 0, 3}
+	if !slices.Equal(d, expected) {
+		t.Errorf("got=%v expected=%v", d, expected)
+	}
+
+	// metric that never reuses dst
+	d = Pairwise(appendMetric{L1}, nil, a, b)
+	if !slices.Equal(d, expected) {
+		t.Errorf("got=%v expected=%v", d, expected)
+	}
+}
+
+type appendMetric struct{ Metric }
+
+func (m appendMetric) Distances(_ []float32, q []Float8, xs [][]Float8) []float32 {
+	var dst []float32
+	for _, x := range xs {
+		dst = append(dst, m.Distance(q, x))
+	}
+	return dst
+}
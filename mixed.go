@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// MulF32 multiplies float8 weight by float32 activation, the activation
+// is not quantized.
+func MulF32(w Float8, x float32) float32 { return f8tof32[w] * x }
+
+// DotF32 is dot product of float8 weights and float32 activations,
+// accumulated in float32 (weight-only quantization).
+func DotF32(weights []Float8, activations []float32) float32 {
+	if len(weights) != len(activations) {
+		panic("vectors must have same length")
+	}
+
+	d := float32(0)
+	for i, w := range weights {
+		d += f8tof32[w] * activations[i]
+	}
+
+	return d
+}
@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "testing"
+
+func TestMulF32(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		if c := MulF32(uint8(a), 0.1); c != ToFloat32(uint8(a))*0.1 {
+			t.Errorf("0x%02x * 0.1 got=%g", a, c)
+		}
+	}
+}
+
+func TestDotF32(t *testing.T) {
+	w := []Float8{0x38, 0x40, 0xb8} // 1, 2, -1
+	x := []float32{0.1, 0.25, 3}
+
+	if d := DotF32(w, x); d != 0.1+0.5-3 {
+		t.Errorf("unexpected product %g", d)
+	}
+}
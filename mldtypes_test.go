@@ -0,0 +1,293 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bufio"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/kshard/float8/conformance"
+)
+
+// Differential fixtures of ml_dtypes float8_e4m3 are produced by NumPy and
+// ml_dtypes, `go run . -ml-dtypes` within cmd (see ml_dtypes.py).
+//
+// ml_dtypes float8_e4m3 is IEEE 754 like: subnormals, 0x78 is infinity and
+// 0x79 - 0x7f are NaN, it rounds to nearest even. Codes of both formats have
+// same values within the common domain [2^-6, 240]. Every divergence from
+// fixtures is listed by code in the reviewed allowlist, the test fails on
+// divergences which are not listed and on listed ones which disappeared.
+// Entries are annotated by class for the review:
+//
+//	subnormal     exponent 0 is subnormal in ml_dtypes, normal here (0x80 is -2^-7)
+//	special       exponent 15 is infinity and NaN in ml_dtypes, 256 - 480 here
+//	range         value is out of common domain
+//	rounding      ToFloat8 and arithmetic truncate, ml_dtypes rounds to nearest
+//	              even, the result is one step towards zero
+//	unclassified  none of above, the entry requires careful review
+//
+// Allowlists are rewritten from fixtures by
+//
+//	go test -run MLDtypes -update-allowlist
+const mlDtypesFixtures = "conformance/testdata/ml_dtypes_e4m3"
+
+var updateAllowlist = flag.Bool("update-allowlist", false, "rewrite allowlists of ml_dtypes divergences")
+
+// ml_dtypes float8_e4m3 as Format
+var mlDtypesE4M3 = Format{ExponentBits: 4, MantissaBits: 3, Bias: 7, HasInf: true, HasNaN: true, Subnormals: true}
+
+// divergence of the package from fixture
+type divergence struct {
+	key, got, want, class string
+}
+
+func openFixture(t *testing.T, file string) *os.File {
+	t.Helper()
+
+	fd, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { fd.Close() })
+
+	return fd
+}
+
+// compare divergences with allowlist, or rewrite the allowlist
+func checkAllowlist(t *testing.T, file string, seq []divergence) {
+	t.Helper()
+
+	if *updateAllowlist {
+		writeAllowlist(t, file, seq)
+		return
+	}
+
+	var r io.Reader = openFixture(t, file)
+	if strings.HasSuffix(file, ".gz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r = gz
+	}
+
+	// key → "got want"
+	allowed := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			t.Fatalf("%s: malformed entry %q", file, line)
+		}
+		n := len(fields) - 2
+		allowed[strings.Join(fields[:n], " ")] = strings.Join(fields[n:], " ")
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := 0
+	for _, d := range seq {
+		entry, has := allowed[d.key]
+		delete(allowed, d.key)
+		if has && entry == d.got+" "+d.want {
+			continue
+		}
+
+		if failed++; failed <= 10 {
+			t.Errorf("%s got=%s wanted=%s (%s) is not allowed", d.key, d.got, d.want, d.class)
+		}
+	}
+	if failed > 0 {
+		t.Errorf("%s: %d divergences are not allowed", file, failed)
+	}
+
+	for key := range allowed {
+		t.Errorf("%s: %s does not diverge, remove the entry", file, key)
+	}
+}
+
+func writeAllowlist(t *testing.T, file string, seq []divergence) {
+	t.Helper()
+
+	fd, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	var w io.Writer = fd
+	var gz *gzip.Writer
+	if strings.HasSuffix(file, ".gz") {
+		if gz, err = gzip.NewWriterLevel(fd, gzip.BestCompression); err != nil {
+			t.Fatal(err)
+		}
+		w = gz
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# divergences from ml_dtypes float8_e4m3, see mldtypes_test.go\n")
+	fmt.Fprintf(bw, "# <operation> <operands> <got> <wanted> # <class>\n")
+	for _, d := range seq {
+		fmt.Fprintf(bw, "%s %s %s # %s\n", d.key, d.got, d.want, d.class)
+	}
+
+	if err := bw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// code with exponent of both formats
+func isCommonCode(c Float8) bool {
+	exp := c >> 3 & 0x0f
+	return exp != 0 && exp != 0x0f
+}
+
+func isCommonValue(x float32) bool {
+	x = float32(math.Abs(float64(x)))
+	return x >= 1.0/64 && x <= 240
+}
+
+func TestMLDtypesConversion(t *testing.T) {
+	fd := openFixture(t, mlDtypesFixtures+".txt")
+
+	var seq []divergence
+	scanner := bufio.NewScanner(fd)
+	for n := 1; scanner.Scan(); n++ {
+		var (
+			op   string
+			x, y uint32
+		)
+		if _, err := fmt.Sscanf(scanner.Text(), "%s 0x%x 0x%x", &op, &x, &y); err != nil {
+			t.Fatalf("line %d: %v", n, err)
+		}
+
+		switch op {
+		case "decode":
+			want := math.Float32frombits(y)
+			if m := mlDtypesE4M3.Decode(byte(x)); float32(m) != want && !(math.IsNaN(m) && math.IsNaN(float64(want))) {
+				t.Errorf("fixture decode 0x%02x = %g, model of format %g", x, want, m)
+			}
+
+			got := ToFloat32(Float8(x))
+			if math.Float32bits(got) == y {
+				continue
+			}
+
+			class := "unclassified"
+			switch x >> 3 & 0x0f {
+			case 0:
+				class = "subnormal"
+			case 0x0f:
+				class = "special"
+			}
+			seq = append(seq, divergence{
+				key:   fmt.Sprintf("decode 0x%02x", x),
+				got:   fmt.Sprintf("0x%08x", math.Float32bits(got)),
+				want:  fmt.Sprintf("0x%08x", y),
+				class: class,
+			})
+		case "encode":
+			in := math.Float32frombits(x)
+			got := ToFloat8(in)
+			if got == Float8(y) {
+				continue
+			}
+
+			class := "unclassified"
+			switch {
+			case !isCommonValue(in):
+				class = "range"
+			case RoundNearestEven.ToFloat8(in) == Float8(y):
+				class = "rounding"
+			}
+			seq = append(seq, divergence{
+				key:   fmt.Sprintf("encode 0x%08x", x),
+				got:   fmt.Sprintf("0x%02x", got),
+				want:  fmt.Sprintf("0x%02x", y),
+				class: class,
+			})
+		default:
+			t.Fatalf("line %d: unknown operation %s", n, op)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	checkAllowlist(t, mlDtypesFixtures+".allow.txt", seq)
+}
+
+func TestMLDtypesArithmetic(t *testing.T) {
+	r, err := gzip.NewReader(openFixture(t, mlDtypesFixtures+".golden.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden, err := conformance.ReadGolden(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var seq []divergence
+	for _, tc := range []struct {
+		name string
+		op   func(a, b Float8) Float8
+	}{
+		{"add", Add}, {"sub", Sub}, {"mul", Mul}, {"div", Div},
+	} {
+		book, has := golden[tc.name]
+		if !has {
+			t.Fatalf("fixture has no %s", tc.name)
+		}
+
+		for a := 0; a < 0x100; a++ {
+			for b := 0; b < 0x100; b++ {
+				got, want := tc.op(Float8(a), Float8(b)), book[a<<8|b]
+				if got == want {
+					continue
+				}
+
+				class := "unclassified"
+				switch {
+				case !isCommonCode(Float8(a)) || !isCommonCode(Float8(b)) || !isCommonCode(want):
+					class = "range"
+				case got&signMask == want&signMask && want&^signMask-got&^signMask == 1:
+					class = "rounding"
+				}
+				seq = append(seq, divergence{
+					key:   fmt.Sprintf("%s 0x%02x 0x%02x", tc.name, a, b),
+					got:   fmt.Sprintf("0x%02x", got),
+					want:  fmt.Sprintf("0x%02x", want),
+					class: class,
+				})
+			}
+		}
+	}
+
+	checkAllowlist(t, mlDtypesFixtures+".allow.gz", seq)
+}
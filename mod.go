@@ -0,0 +1,9 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for translating float8 to float32
+//
+
+var mod = [0x10000]uint8{0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0x0,0x0,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x0,0x0,0x0,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x0,0x0,0x0,0x0,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x0,0x0,0x0,0x0,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0x0,0x0,0x0,0x0,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0x0,0x0,0x0,0x0,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0x0,0x0,0x0,0x0,0x0,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0x0,0x0,0x0,0x0,0x0,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x3,0x2,0x0,0x0,0x0,0x0,0x0,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x3,0x2,0x0,0x0,0x0,0x0,0x0,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x4,0x2,0x0,0x0,0x0,0x0,0x0,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x4,0x2,0x0,0x0,0x0,0x0,0x0,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x2,0x0,0x0,0x0,0x0,0x0,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x2,0x0,0x0,0x0,0x0,0x0,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x2,0x0,0x0,0x0,0x0,0x0,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x2,0x0,0x0,0x0,0x0,0x0,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x6,0x4,0x2,0x0,0x0,0x0,0x0,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x6,0x4,0x2,0x0,0x0,0x0,0x0,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x2,0x0,0x0,0x0,0x9,0x8,0x6,0x4,0x2,0x0,0x0,0x0,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x2,0x0,0x0,0x0,0x9,0x8,0x6,0x4,0x2,0x0,0x0,0x0,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x0,0x0,0x0,0xa,0x9,0x8,0x6,0x4,0x0,0x0,0x0,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x0,0x0,0x0,0xa,0x9,0x8,0x6,0x4,0x0,0x0,0x0,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0xb,0xa,0x9,0x8,0x4,0x0,0x0,0x0,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0xb,0xa,0x9,0x8,0x4,0x0,0x0,0x0,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x8,0x4,0x0,0x0,0x0,0xc,0xb,0xa,0x8,0x4,0x0,0x0,0x0,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x8,0x4,0x0,0x0,0x0,0xc,0xb,0xa,0x8,0x4,0x0,0x0,0x0,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x8,0x4,0x0,0x0,0x0,0xd,0xc,0xa,0x8,0x4,0x0,0x0,0x0,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x8,0x4,0x0,0x0,0x0,0xd,0xc,0xa,0x8,0x4,0x0,0x0,0x0,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x8,0x4,0x0,0x0,0x0,0xe,0xc,0xa,0x8,0x4,0x0,0x0,0x0,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x8,0x4,0x0,0x0,0x0,0xe,0xc,0xa,0x8,0x4,0x0,0x0,0x0,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x0,0x0,0x0,0x1,0x0,0x4,0x0,0x0,0x0,0x2,0x0,0xa,0x8,0x4,0x0,0x0,0x0,0xe,0xc,0xa,0x8,0x4,0x0,0x0,0x0,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x0,0x0,0x0,0x1,0x0,0x4,0x0,0x0,0x0,0x2,0x0,0xa,0x8,0x4,0x0,0x0,0x0,0xe,0xc,0xa,0x8,0x4,0x0,0x0,0x0,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xe,0xc,0xa,0x8,0x4,0x0,0x0,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xe,0xc,0xa,0x8,0x4,0x0,0x0,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0xc,0xa,0x8,0x0,0x0,0x11,0x10,0xe,0xc,0xa,0x8,0x0,0x0,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0xc,0xa,0x8,0x0,0x0,0x11,0x10,0xe,0xc,0xa,0x8,0x0,0x0,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x5,0x0,0x8,0x0,0x0,0x8,0x2,0x0,0xe,0xc,0x8,0x0,0x0,0x12,0x11,0x10,0xe,0xc,0x8,0x0,0x0,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x5,0x0,0x8,0x0,0x0,0x8,0x2,0x0,0xe,0xc,0x8,0x0,0x0,0x12,0x11,0x10,0xe,0xc,0x8,0x0,0x0,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x0,0x0,0x9,0x4,0x0,0x0,0xc,0x8,0x0,0x0,0x13,0x12,0x11,0x10,0xc,0x8,0x0,0x0,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x0,0x0,0x9,0x4,0x0,0x0,0xc,0x8,0x0,0x0,0x13,0x12,0x11,0x10,0xc,0x8,0x0,0x0,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0x8,0x0,0x0,0xa,0x6,0x0,0x10,0xc,0x8,0x0,0x0,0x14,0x13,0x12,0x10,0xc,0x8,0x0,0x0,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0x8,0x0,0x0,0xa,0x6,0x0,0x10,0xc,0x8,0x0,0x0,0x14,0x13,0x12,0x10,0xc,0x8,0x0,0x0,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0x0,0x0,0xb,0x8,0x0,0x10,0xc,0x8,0x0,0x0,0x15,0x14,0x12,0x10,0xc,0x8,0x0,0x0,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0x0,0x0,0xb,0x8,0x0,0x10,0xc,0x8,0x0,0x0,0x15,0x14,0x12,0x10,0xc,0x8,0x0,0x0,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x2,0x0,0x8,0x0,0x0,0xc,0x4,0x0,0x10,0xc,0x8,0x0,0x0,0x16,0x14,0x12,0x10,0xc,0x8,0x0,0x0,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x2,0x0,0x8,0x0,0x0,0xc,0x4,0x0,0x10,0xc,0x8,0x0,0x0,0x16,0x14,0x12,0x10,0xc,0x8,0x0,0x0,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x0,0x0,0x0,0x9,0x0,0xc,0x8,0x0,0x0,0xa,0x0,0x12,0x10,0xc,0x8,0x0,0x0,0x16,0x14,0x12,0x10,0xc,0x8,0x0,0x0,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x0,0x0,0x0,0x9,0x0,0xc,0x8,0x0,0x0,0xa,0x0,0x12,0x10,0xc,0x8,0x0,0x0,0x16,0x14,0x12,0x10,0xc,0x8,0x0,0x0,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x6,0x0,0xc,0x8,0x0,0xc,0x4,0x0,0x12,0x10,0xc,0x8,0x0,0x18,0x16,0x14,0x12,0x10,0xc,0x8,0x0,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x6,0x0,0xc,0x8,0x0,0xc,0x4,0x0,0x12,0x10,0xc,0x8,0x0,0x18,0x16,0x14,0x12,0x10,0xc,0x8,0x0,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x8,0x0,0x0,0x8,0x0,0xa,0x2,0x0,0x8,0x0,0xe,0x8,0x0,0x14,0x12,0x10,0x8,0x0,0x19,0x18,0x16,0x14,0x12,0x10,0x8,0x0,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x8,0x0,0x0,0x8,0x0,0xa,0x2,0x0,0x8,0x0,0xe,0x8,0x0,0x14,0x12,0x10,0x8,0x0,0x19,0x18,0x16,0x14,0x12,0x10,0x8,0x0,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x6,0x8,0x0,0x0,0xa,0x0,0xd,0x8,0x10,0x8,0x0,0x10,0xa,0x0,0x16,0x14,0x10,0x8,0x0,0x1a,0x19,0x18,0x16,0x14,0x10,0x8,0x0,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x6,0x8,0x0,0x0,0xa,0x0,0xd,0x8,0x10,0x8,0x0,0x10,0xa,0x0,0x16,0x14,0x10,0x8,0x0,0x1a,0x19,0x18,0x16,0x14,0x10,0x8,0x0,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x0,0x0,0x0,0x0,0x0,0x2,0x2,0x4,0x0,0x4,0x4,0x8,0x0,0x2,0xc,0x4,0x0,0x4,0x10,0x8,0x0,0x11,0xc,0x4,0x0,0x14,0x10,0x8,0x0,0x1b,0x1a,0x19,0x18,0x14,0x10,0x8,0x0,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x0,0x0,0x0,0x0,0x0,0x2,0x2,0x4,0x0,0x4,0x4,0x8,0x0,0x2,0xc,0x4,0x0,0x4,0x10,0x8,0x0,0x11,0xc,0x4,0x0,0x14,0x10,0x8,0x0,0x1b,0x1a,0x19,0x18,0x14,0x10,0x8,0x0,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x0,0x0,0x0,0x2,0x0,0x0,0x4,0x5,0x0,0x2,0x0,0x2,0x8,0x0,0x4,0xe,0x8,0xe,0x0,0x10,0x8,0x0,0x12,0xe,0x8,0x18,0x14,0x10,0x8,0x0,0x1c,0x1b,0x1a,0x18,0x14,0x10,0x8,0x0,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x0,0x0,0x0,0x2,0x0,0x0,0x4,0x5,0x0,0x2,0x0,0x2,0x8,0x0,0x4,0xe,0x8,0xe,0x0,0x10,0x8,0x0,0x12,0xe,0x8,0x18,0x14,0x10,0x8,0x0,0x1c,0x1b,0x1a,0x18,0x14,0x10,0x8,0x0,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x6,0x0,0x0,0xc,0x0,0x10,0x8,0x0,0x13,0x10,0x0,0x18,0x14,0x10,0x8,0x0,0x1d,0x1c,0x1a,0x18,0x14,0x10,0x8,0x0,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x6,0x0,0x0,0xc,0x0,0x10,0x8,0x0,0x13,0x10,0x0,0x18,0x14,0x10,0x8,0x0,0x1d,0x1c,0x1a,0x18,0x14,0x10,0x8,0x0,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0x0,0x0,0x8,0x0,0x8,0xc,0x0,0xa,0x0,0x10,0x8,0x0,0x14,0xc,0x0,0x18,0x14,0x10,0x8,0x0,0x1e,0x1c,0x1a,0x18,0x14,0x10,0x8,0x0,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0x0,0x0,0x8,0x0,0x8,0xc,0x0,0xa,0x0,0x10,0x8,0x0,0x14,0xc,0x0,0x18,0x14,0x10,0x8,0x0,0x1e,0x1c,0x1a,0x18,0x14,0x10,0x8,0x0,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x8,0x6,0x8,0xb,0x0,0x8,0x0,0x0,0x8,0x11,0x8,0x14,0x10,0x8,0x0,0x12,0x8,0x1a,0x18,0x14,0x10,0x8,0x0,0x1e,0x1c,0x1a,0x18,0x14,0x10,0x8,0x0,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x8,0x6,0x8,0xb,0x0,0x8,0x0,0x0,0x8,0x11,0x8,0x14,0x10,0x8,0x0,0x12,0x8,0x1a,0x18,0x14,0x10,0x8,0x0,0x1e,0x1c,0x1a,0x18,0x14,0x10,0x8,0x0,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x9,0x0,0x0,0x0,0xc,0x0,0xe,0x0,0x14,0x10,0x0,0x14,0xc,0x0,0x1a,0x18,0x14,0x10,0x0,0x20,0x1e,0x1c,0x1a,0x18,0x14,0x10,0x0,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x9,0x0,0x0,0x0,0xc,0x0,0xe,0x0,0x14,0x10,0x0,0x14,0xc,0x0,0x1a,0x18,0x14,0x10,0x0,0x20,0x1e,0x1c,0x1a,0x18,0x14,0x10,0x0,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x6,0x0,0x4,0x0,0x0,0x4,0xa,0x0,0x10,0x0,0x4,0x10,0x0,0x12,0xa,0x0,0x10,0x0,0x16,0x10,0x0,0x1c,0x1a,0x18,0x10,0x0,0x21,0x20,0x1e,0x1c,0x1a,0x18,0x10,0x0,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x6,0x0,0x4,0x0,0x0,0x4,0xa,0x0,0x10,0x0,0x4,0x10,0x0,0x12,0xa,0x0,0x10,0x0,0x16,0x10,0x0,0x1c,0x1a,0x18,0x10,0x0,0x21,0x20,0x1e,0x1c,0x1a,0x18,0x10,0x0,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x0,0x8,0x6,0x8,0xb,0x0,0xe,0x10,0x0,0x8,0x12,0x8,0x15,0x10,0x18,0x10,0x0,0x18,0x12,0x8,0x1e,0x1c,0x18,0x10,0x0,0x22,0x21,0x20,0x1e,0x1c,0x18,0x10,0x0,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x0,0x8,0x6,0x8,0xb,0x0,0xe,0x10,0x0,0x8,0x12,0x8,0x15,0x10,0x18,0x10,0x0,0x18,0x12,0x8,0x1e,0x1c,0x18,0x10,0x0,0x22,0x21,0x20,0x1e,0x1c,0x18,0x10,0x0,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x2,0x0,0xa,0xa,0xc,0x0,0xc,0xc,0x10,0x0,0xa,0x14,0xc,0x0,0xc,0x18,0x10,0x0,0x19,0x14,0xc,0x0,0x1c,0x18,0x10,0x0,0x23,0x22,0x21,0x20,0x1c,0x18,0x10,0x0,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x2,0x0,0xa,0xa,0xc,0x0,0xc,0xc,0x10,0x0,0xa,0x14,0xc,0x0,0xc,0x18,0x10,0x0,0x19,0x14,0xc,0x0,0x1c,0x18,0x10,0x0,0x23,0x22,0x21,0x20,0x1c,0x18,0x10,0x0,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x0,0x0,0x0,0x1,0x0,0x0,0x2,0x3,0x0,0x0,0x8,0xa,0x0,0x0,0xc,0xd,0x0,0xa,0x8,0xa,0x10,0x0,0xc,0x16,0x10,0x16,0x8,0x18,0x10,0x0,0x1a,0x16,0x10,0x20,0x1c,0x18,0x10,0x0,0x24,0x23,0x22,0x20,0x1c,0x18,0x10,0x0,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x0,0x0,0x0,0x1,0x0,0x0,0x2,0x3,0x0,0x0,0x8,0xa,0x0,0x0,0xc,0xd,0x0,0xa,0x8,0xa,0x10,0x0,0xc,0x16,0x10,0x16,0x8,0x18,0x10,0x0,0x1a,0x16,0x10,0x20,0x1c,0x18,0x10,0x0,0x24,0x23,0x22,0x20,0x1c,0x18,0x10,0x0,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x8,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x8,0x0,0x0,0x8,0x10,0x0,0xe,0x0,0x8,0x14,0x0,0x18,0x10,0x0,0x1b,0x18,0x8,0x20,0x1c,0x18,0x10,0x0,0x25,0x24,0x22,0x20,0x1c,0x18,0x10,0x0,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x8,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x8,0x0,0x0,0x8,0x10,0x0,0xe,0x0,0x8,0x14,0x0,0x18,0x10,0x0,0x1b,0x18,0x8,0x20,0x1c,0x18,0x10,0x0,0x25,0x24,0x22,0x20,0x1c,0x18,0x10,0x0,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x4,0x0,0x9,0x0,0x4,0x0,0x0,0x0,0x4,0x0,0x12,0x0,0x4,0x10,0x0,0x10,0x14,0x0,0x12,0x0,0x18,0x10,0x0,0x1c,0x14,0x0,0x20,0x1c,0x18,0x10,0x0,0x26,0x24,0x22,0x20,0x1c,0x18,0x10,0x0,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x4,0x0,0x9,0x0,0x4,0x0,0x0,0x0,0x4,0x0,0x12,0x0,0x4,0x10,0x0,0x10,0x14,0x0,0x12,0x0,0x18,0x10,0x0,0x1c,0x14,0x0,0x20,0x1c,0x18,0x10,0x0,0x26,0x24,0x22,0x20,0x1c,0x18,0x10,0x0,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x9,0x0,0x0,0x0,0x0,0x10,0xe,0x10,0x13,0x0,0x10,0x0,0x0,0x10,0x19,0x10,0x1c,0x18,0x10,0x0,0x1a,0x10,0x22,0x20,0x1c,0x18,0x10,0x0,0x26,0x24,0x22,0x20,0x1c,0x18,0x10,0x0,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x9,0x0,0x0,0x0,0x0,0x10,0xe,0x10,0x13,0x0,0x10,0x0,0x0,0x10,0x19,0x10,0x1c,0x18,0x10,0x0,0x1a,0x10,0x22,0x20,0x1c,0x18,0x10,0x0,0x26,0x24,0x22,0x20,0x1c,0x18,0x10,0x0,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x11,0x0,0x0,0x8,0x14,0x0,0x16,0x8,0x1c,0x18,0x0,0x1c,0x14,0x0,0x22,0x20,0x1c,0x18,0x0,0x28,0x26,0x24,0x22,0x20,0x1c,0x18,0x0,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x11,0x0,0x0,0x8,0x14,0x0,0x16,0x8,0x1c,0x18,0x0,0x1c,0x14,0x0,0x22,0x20,0x1c,0x18,0x0,0x28,0x26,0x24,0x22,0x20,0x1c,0x18,0x0,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x2,0x0,0x2,0x0,0x0,0x8,0x8,0xc,0x2,0x0,0xe,0x0,0xc,0x8,0x8,0xc,0x12,0x0,0x18,0x0,0xc,0x18,0x8,0x1a,0x12,0x0,0x18,0x0,0x1e,0x18,0x8,0x24,0x22,0x20,0x18,0x0,0x29,0x28,0x26,0x24,0x22,0x20,0x18,0x0,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x2,0x0,0x2,0x0,0x0,0x8,0x8,0xc,0x2,0x0,0xe,0x0,0xc,0x8,0x8,0xc,0x12,0x0,0x18,0x0,0xc,0x18,0x8,0x1a,0x12,0x0,0x18,0x0,0x1e,0x18,0x8,0x24,0x22,0x20,0x18,0x0,0x29,0x28,0x26,0x24,0x22,0x20,0x18,0x0,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0xa,0xc,0x0,0x10,0xe,0x10,0x13,0x0,0x16,0x18,0x0,0x10,0x1a,0x10,0x1d,0x18,0x20,0x18,0x0,0x20,0x1a,0x10,0x26,0x24,0x20,0x18,0x0,0x2a,0x29,0x28,0x26,0x24,0x20,0x18,0x0,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0xa,0xc,0x0,0x10,0xe,0x10,0x13,0x0,0x16,0x18,0x0,0x10,0x1a,0x10,0x1d,0x18,0x20,0x18,0x0,0x20,0x1a,0x10,0x26,0x24,0x20,0x18,0x0,0x2a,0x29,0x28,0x26,0x24,0x20,0x18,0x0,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x0,0x0,0x0,0x1,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0xa,0x0,0x6,0x4,0x9,0x0,0x4,0x0,0xa,0x0,0x12,0x12,0x14,0x0,0x14,0x14,0x18,0x0,0x12,0x1c,0x14,0x0,0x14,0x20,0x18,0x0,0x21,0x1c,0x14,0x0,0x24,0x20,0x18,0x0,0x2b,0x2a,0x29,0x28,0x24,0x20,0x18,0x0,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x0,0x0,0x0,0x1,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0xa,0x0,0x6,0x4,0x9,0x0,0x4,0x0,0xa,0x0,0x12,0x12,0x14,0x0,0x14,0x14,0x18,0x0,0x12,0x1c,0x14,0x0,0x14,0x20,0x18,0x0,0x21,0x1c,0x14,0x0,0x24,0x20,0x18,0x0,0x2b,0x2a,0x29,0x28,0x24,0x20,0x18,0x0,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x9,0x8,0x0,0xa,0xb,0x0,0x0,0x10,0x12,0x8,0x0,0x14,0x15,0x0,0x12,0x10,0x12,0x18,0x0,0x14,0x1e,0x18,0x1e,0x10,0x20,0x18,0x0,0x22,0x1e,0x18,0x28,0x24,0x20,0x18,0x0,0x2c,0x2b,0x2a,0x28,0x24,0x20,0x18,0x0,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x9,0x8,0x0,0xa,0xb,0x0,0x0,0x10,0x12,0x8,0x0,0x14,0x15,0x0,0x12,0x10,0x12,0x18,0x0,0x14,0x1e,0x18,0x1e,0x10,0x20,0x18,0x0,0x22,0x1e,0x18,0x28,0x24,0x20,0x18,0x0,0x2c,0x2b,0x2a,0x28,0x24,0x20,0x18,0x0,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x3,0x0,0x6,0x8,0x8,0x0,0x4,0x0,0xd,0x0,0x10,0x8,0x8,0x10,0x4,0x0,0x16,0x0,0x10,0x8,0x8,0x10,0x18,0x0,0x16,0x0,0x10,0x1c,0x8,0x20,0x18,0x0,0x23,0x20,0x10,0x28,0x24,0x20,0x18,0x0,0x2d,0x2c,0x2a,0x28,0x24,0x20,0x18,0x0,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x3,0x0,0x6,0x8,0x8,0x0,0x4,0x0,0xd,0x0,0x10,0x8,0x8,0x10,0x4,0x0,0x16,0x0,0x10,0x8,0x8,0x10,0x18,0x0,0x16,0x0,0x10,0x1c,0x8,0x20,0x18,0x0,0x23,0x20,0x10,0x28,0x24,0x20,0x18,0x0,0x2d,0x2c,0x2a,0x28,0x24,0x20,0x18,0x0,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0xc,0x0,0x0,0x0,0xc,0x0,0x11,0x0,0xc,0x0,0x0,0x0,0xc,0x0,0x1a,0x0,0xc,0x18,0x0,0x18,0x1c,0x0,0x1a,0x0,0x20,0x18,0x0,0x24,0x1c,0x0,0x28,0x24,0x20,0x18,0x0,0x2e,0x2c,0x2a,0x28,0x24,0x20,0x18,0x0,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0xc,0x0,0x0,0x0,0xc,0x0,0x11,0x0,0xc,0x0,0x0,0x0,0xc,0x0,0x1a,0x0,0xc,0x18,0x0,0x18,0x1c,0x0,0x1a,0x0,0x20,0x18,0x0,0x24,0x1c,0x0,0x28,0x24,0x20,0x18,0x0,0x2e,0x2c,0x2a,0x28,0x24,0x20,0x18,0x0,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x8,0x0,0x4,0x8,0x2,0x8,0x0,0x0,0x8,0xc,0x4,0x8,0x11,0x8,0x0,0x0,0x8,0x18,0x16,0x18,0x1b,0x8,0x18,0x0,0x8,0x18,0x21,0x18,0x24,0x20,0x18,0x0,0x22,0x18,0x2a,0x28,0x24,0x20,0x18,0x0,0x2e,0x2c,0x2a,0x28,0x24,0x20,0x18,0x0,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x8,0x0,0x4,0x8,0x2,0x8,0x0,0x0,0x8,0xc,0x4,0x8,0x11,0x8,0x0,0x0,0x8,0x18,0x16,0x18,0x1b,0x8,0x18,0x0,0x8,0x18,0x21,0x18,0x24,0x20,0x18,0x0,0x22,0x18,0x2a,0x28,0x24,0x20,0x18,0x0,0x2e,0x2c,0x2a,0x28,0x24,0x20,0x18,0x0,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x10,0x0,0x0,0x0,0x10,0x4,0x0,0x0,0x10,0x0,0x0,0x0,0x10,0x19,0x0,0x0,0x10,0x1c,0x0,0x1e,0x10,0x24,0x20,0x0,0x24,0x1c,0x0,0x2a,0x28,0x24,0x20,0x0,0x30,0x2e,0x2c,0x2a,0x28,0x24,0x20,0x0,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x10,0x0,0x0,0x0,0x10,0x4,0x0,0x0,0x10,0x0,0x0,0x0,0x10,0x19,0x0,0x0,0x10,0x1c,0x0,0x1e,0x10,0x24,0x20,0x0,0x24,0x1c,0x0,0x2a,0x28,0x24,0x20,0x0,0x30,0x2e,0x2c,0x2a,0x28,0x24,0x20,0x0,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0xa,0x0,0xa,0x0,0x0,0x10,0x10,0x14,0xa,0x0,0x16,0x0,0x14,0x10,0x10,0x14,0x1a,0x0,0x20,0x0,0x14,0x20,0x10,0x22,0x1a,0x0,0x20,0x0,0x26,0x20,0x10,0x2c,0x2a,0x28,0x20,0x0,0x31,0x30,0x2e,0x2c,0x2a,0x28,0x20,0x0,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0xa,0x0,0xa,0x0,0x0,0x10,0x10,0x14,0xa,0x0,0x16,0x0,0x14,0x10,0x10,0x14,0x1a,0x0,0x20,0x0,0x14,0x20,0x10,0x22,0x1a,0x0,0x20,0x0,0x26,0x20,0x10,0x2c,0x2a,0x28,0x20,0x0,0x31,0x30,0x2e,0x2c,0x2a,0x28,0x20,0x0,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x12,0x14,0x0,0x18,0x16,0x18,0x1b,0x0,0x1e,0x20,0x0,0x18,0x22,0x18,0x25,0x20,0x28,0x20,0x0,0x28,0x22,0x18,0x2e,0x2c,0x28,0x20,0x0,0x32,0x31,0x30,0x2e,0x2c,0x28,0x20,0x0,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x12,0x14,0x0,0x18,0x16,0x18,0x1b,0x0,0x1e,0x20,0x0,0x18,0x22,0x18,0x25,0x20,0x28,0x20,0x0,0x28,0x22,0x18,0x2e,0x2c,0x28,0x20,0x0,0x32,0x31,0x30,0x2e,0x2c,0x28,0x20,0x0,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x8,0x9,0x0,0x0,0xc,0x0,0x0,0xc,0x8,0x12,0x0,0xe,0xc,0x11,0x0,0xc,0x8,0x12,0x0,0x1a,0x1a,0x1c,0x0,0x1c,0x1c,0x20,0x0,0x1a,0x24,0x1c,0x0,0x1c,0x28,0x20,0x0,0x29,0x24,0x1c,0x0,0x2c,0x28,0x20,0x0,0x33,0x32,0x31,0x30,0x2c,0x28,0x20,0x0,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x8,0x9,0x0,0x0,0xc,0x0,0x0,0xc,0x8,0x12,0x0,0xe,0xc,0x11,0x0,0xc,0x8,0x12,0x0,0x1a,0x1a,0x1c,0x0,0x1c,0x1c,0x20,0x0,0x1a,0x24,0x1c,0x0,0x1c,0x28,0x20,0x0,0x29,0x24,0x1c,0x0,0x2c,0x28,0x20,0x0,0x33,0x32,0x31,0x30,0x2c,0x28,0x20,0x0,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x6,0x0,0x0,0x0,0x6,0x0,0x0,0x4,0x6,0x0,0x0,0xc,0x11,0x10,0x0,0x12,0x13,0x0,0x0,0x18,0x1a,0x10,0x0,0x1c,0x1d,0x0,0x1a,0x18,0x1a,0x20,0x0,0x1c,0x26,0x20,0x26,0x18,0x28,0x20,0x0,0x2a,0x26,0x20,0x30,0x2c,0x28,0x20,0x0,0x34,0x33,0x32,0x30,0x2c,0x28,0x20,0x0,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x6,0x0,0x0,0x0,0x6,0x0,0x0,0x4,0x6,0x0,0x0,0xc,0x11,0x10,0x0,0x12,0x13,0x0,0x0,0x18,0x1a,0x10,0x0,0x1c,0x1d,0x0,0x1a,0x18,0x1a,0x20,0x0,0x1c,0x26,0x20,0x26,0x18,0x28,0x20,0x0,0x2a,0x26,0x20,0x30,0x2c,0x28,0x20,0x0,0x34,0x33,0x32,0x30,0x2c,0x28,0x20,0x0,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x0,0x0,0x0,0x2,0x0,0x3,0x0,0x0,0x0,0x2,0x4,0x2,0x8,0xc,0x0,0xb,0x0,0xe,0x10,0x10,0x8,0xc,0x0,0x15,0x0,0x18,0x10,0x10,0x18,0xc,0x0,0x1e,0x0,0x18,0x10,0x10,0x18,0x20,0x0,0x1e,0x0,0x18,0x24,0x10,0x28,0x20,0x0,0x2b,0x28,0x18,0x30,0x2c,0x28,0x20,0x0,0x35,0x34,0x32,0x30,0x2c,0x28,0x20,0x0,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x0,0x0,0x0,0x2,0x0,0x3,0x0,0x0,0x0,0x2,0x4,0x2,0x8,0xc,0x0,0xb,0x0,0xe,0x10,0x10,0x8,0xc,0x0,0x15,0x0,0x18,0x10,0x10,0x18,0xc,0x0,0x1e,0x0,0x18,0x10,0x10,0x18,0x20,0x0,0x1e,0x0,0x18,0x24,0x10,0x28,0x20,0x0,0x2b,0x28,0x18,0x30,0x2c,0x28,0x20,0x0,0x35,0x34,0x32,0x30,0x2c,0x28,0x20,0x0,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0xb,0x8,0x0,0x0,0x4,0x0,0xe,0x0,0x14,0x8,0x0,0x0,0x14,0x0,0x19,0x0,0x14,0x8,0x0,0x0,0x14,0x0,0x22,0x0,0x14,0x20,0x0,0x20,0x24,0x0,0x22,0x0,0x28,0x20,0x0,0x2c,0x24,0x0,0x30,0x2c,0x28,0x20,0x0,0x36,0x34,0x32,0x30,0x2c,0x28,0x20,0x0,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0xb,0x8,0x0,0x0,0x4,0x0,0xe,0x0,0x14,0x8,0x0,0x0,0x14,0x0,0x19,0x0,0x14,0x8,0x0,0x0,0x14,0x0,0x22,0x0,0x14,0x20,0x0,0x20,0x24,0x0,0x22,0x0,0x28,0x20,0x0,0x2c,0x24,0x0,0x30,0x2c,0x28,0x20,0x0,0x36,0x34,0x32,0x30,0x2c,0x28,0x20,0x0,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0xa,0x0,0x0,0x0,0x10,0x0,0xc,0x10,0xa,0x10,0x0,0x0,0x10,0x14,0xc,0x10,0x19,0x10,0x0,0x0,0x10,0x20,0x1e,0x20,0x23,0x10,0x20,0x0,0x10,0x20,0x29,0x20,0x2c,0x28,0x20,0x0,0x2a,0x20,0x32,0x30,0x2c,0x28,0x20,0x0,0x36,0x34,0x32,0x30,0x2c,0x28,0x20,0x0,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0xa,0x0,0x0,0x0,0x10,0x0,0xc,0x10,0xa,0x10,0x0,0x0,0x10,0x14,0xc,0x10,0x19,0x10,0x0,0x0,0x10,0x20,0x1e,0x20,0x23,0x10,0x20,0x0,0x10,0x20,0x29,0x20,0x2c,0x28,0x20,0x0,0x2a,0x20,0x32,0x30,0x2c,0x28,0x20,0x0,0x36,0x34,0x32,0x30,0x2c,0x28,0x20,0x0,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x8,0x0,0x8,0x0,0xc,0x0,0x0,0xc,0x8,0x0,0x8,0x0,0xc,0x0,0x0,0x18,0x8,0x0,0x8,0x18,0xc,0x0,0x0,0x18,0x8,0x0,0x8,0x18,0x21,0x0,0x0,0x18,0x24,0x0,0x26,0x18,0x2c,0x28,0x0,0x2c,0x24,0x0,0x32,0x30,0x2c,0x28,0x0,0x38,0x36,0x34,0x32,0x30,0x2c,0x28,0x0,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x8,0x0,0x8,0x0,0xc,0x0,0x0,0xc,0x8,0x0,0x8,0x0,0xc,0x0,0x0,0x18,0x8,0x0,0x8,0x18,0xc,0x0,0x0,0x18,0x8,0x0,0x8,0x18,0x21,0x0,0x0,0x18,0x24,0x0,0x26,0x18,0x2c,0x28,0x0,0x2c,0x24,0x0,0x32,0x30,0x2c,0x28,0x0,0x38,0x36,0x34,0x32,0x30,0x2c,0x28,0x0,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x12,0x12,0x0,0x12,0x0,0x0,0x18,0x18,0x1c,0x12,0x0,0x1e,0x0,0x1c,0x18,0x18,0x1c,0x22,0x0,0x28,0x0,0x1c,0x28,0x18,0x2a,0x22,0x0,0x28,0x0,0x2e,0x28,0x18,0x34,0x32,0x30,0x28,0x0,0x39,0x38,0x36,0x34,0x32,0x30,0x28,0x0,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x12,0x12,0x0,0x12,0x0,0x0,0x18,0x18,0x1c,0x12,0x0,0x1e,0x0,0x1c,0x18,0x18,0x1c,0x22,0x0,0x28,0x0,0x1c,0x28,0x18,0x2a,0x22,0x0,0x28,0x0,0x2e,0x28,0x18,0x34,0x32,0x30,0x28,0x0,0x39,0x38,0x36,0x34,0x32,0x30,0x28,0x0,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0xd,0x0,0x0,0x8,0x0,0x10,0x0,0x8,0x16,0x0,0x0,0x8,0x0,0x10,0x0,0x8,0x16,0x0,0x1a,0x1c,0x0,0x20,0x1e,0x20,0x23,0x0,0x26,0x28,0x0,0x20,0x2a,0x20,0x2d,0x28,0x30,0x28,0x0,0x30,0x2a,0x20,0x36,0x34,0x30,0x28,0x0,0x3a,0x39,0x38,0x36,0x34,0x30,0x28,0x0,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0xd,0x0,0x0,0x8,0x0,0x10,0x0,0x8,0x16,0x0,0x0,0x8,0x0,0x10,0x0,0x8,0x16,0x0,0x1a,0x1c,0x0,0x20,0x1e,0x20,0x23,0x0,0x26,0x28,0x0,0x20,0x2a,0x20,0x2d,0x28,0x30,0x28,0x0,0x30,0x2a,0x20,0x36,0x34,0x30,0x28,0x0,0x3a,0x39,0x38,0x36,0x34,0x30,0x28,0x0,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x4,0x4,0x6,0x0,0x0,0xa,0x4,0x0,0x4,0x10,0x11,0x0,0x0,0x14,0x4,0x0,0x14,0x10,0x1a,0x0,0x16,0x14,0x19,0x0,0x14,0x10,0x1a,0x0,0x22,0x22,0x24,0x0,0x24,0x24,0x28,0x0,0x22,0x2c,0x24,0x0,0x24,0x30,0x28,0x0,0x31,0x2c,0x24,0x0,0x34,0x30,0x28,0x0,0x3b,0x3a,0x39,0x38,0x34,0x30,0x28,0x0,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x4,0x4,0x6,0x0,0x0,0xa,0x4,0x0,0x4,0x10,0x11,0x0,0x0,0x14,0x4,0x0,0x14,0x10,0x1a,0x0,0x16,0x14,0x19,0x0,0x14,0x10,0x1a,0x0,0x22,0x22,0x24,0x0,0x24,0x24,0x28,0x0,0x22,0x2c,0x24,0x0,0x24,0x30,0x28,0x0,0x31,0x2c,0x24,0x0,0x34,0x30,0x28,0x0,0x3b,0x3a,0x39,0x38,0x34,0x30,0x28,0x0,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x5,0x0,0x8,0x0,0x0,0x8,0x0,0xc,0xe,0x0,0x8,0x0,0xe,0x8,0x0,0xc,0xe,0x0,0x8,0x14,0x19,0x18,0x0,0x1a,0x1b,0x0,0x8,0x20,0x22,0x18,0x0,0x24,0x25,0x0,0x22,0x20,0x22,0x28,0x0,0x24,0x2e,0x28,0x2e,0x20,0x30,0x28,0x0,0x32,0x2e,0x28,0x38,0x34,0x30,0x28,0x0,0x3c,0x3b,0x3a,0x38,0x34,0x30,0x28,0x0,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x5,0x0,0x8,0x0,0x0,0x8,0x0,0xc,0xe,0x0,0x8,0x0,0xe,0x8,0x0,0xc,0xe,0x0,0x8,0x14,0x19,0x18,0x0,0x1a,0x1b,0x0,0x8,0x20,0x22,0x18,0x0,0x24,0x25,0x0,0x22,0x20,0x22,0x28,0x0,0x24,0x2e,0x28,0x2e,0x20,0x30,0x28,0x0,0x32,0x2e,0x28,0x38,0x34,0x30,0x28,0x0,0x3c,0x3b,0x3a,0x38,0x34,0x30,0x28,0x0,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x0,0x0,0x0,0x1,0x0,0x1,0x0,0x6,0x0,0x0,0x0,0xa,0x0,0xb,0x0,0x6,0x0,0xa,0xc,0xa,0x10,0x14,0x0,0x13,0x0,0x16,0x18,0x18,0x10,0x14,0x0,0x1d,0x0,0x20,0x18,0x18,0x20,0x14,0x0,0x26,0x0,0x20,0x18,0x18,0x20,0x28,0x0,0x26,0x0,0x20,0x2c,0x18,0x30,0x28,0x0,0x33,0x30,0x20,0x38,0x34,0x30,0x28,0x0,0x3d,0x3c,0x3a,0x38,0x34,0x30,0x28,0x0,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x0,0x0,0x0,0x1,0x0,0x1,0x0,0x6,0x0,0x0,0x0,0xa,0x0,0xb,0x0,0x6,0x0,0xa,0xc,0xa,0x10,0x14,0x0,0x13,0x0,0x16,0x18,0x18,0x10,0x14,0x0,0x1d,0x0,0x20,0x18,0x18,0x20,0x14,0x0,0x26,0x0,0x20,0x18,0x18,0x20,0x28,0x0,0x26,0x0,0x20,0x2c,0x18,0x30,0x28,0x0,0x33,0x30,0x20,0x38,0x34,0x30,0x28,0x0,0x3d,0x3c,0x3a,0x38,0x34,0x30,0x28,0x0,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x9,0x0,0x0,0x0,0xc,0x0,0x4,0x0,0x13,0x10,0x0,0x0,0xc,0x0,0x16,0x0,0x1c,0x10,0x0,0x0,0x1c,0x0,0x21,0x0,0x1c,0x10,0x0,0x0,0x1c,0x0,0x2a,0x0,0x1c,0x28,0x0,0x28,0x2c,0x0,0x2a,0x0,0x30,0x28,0x0,0x34,0x2c,0x0,0x38,0x34,0x30,0x28,0x0,0x3e,0x3c,0x3a,0x38,0x34,0x30,0x28,0x0,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x9,0x0,0x0,0x0,0xc,0x0,0x4,0x0,0x13,0x10,0x0,0x0,0xc,0x0,0x16,0x0,0x1c,0x10,0x0,0x0,0x1c,0x0,0x21,0x0,0x1c,0x10,0x0,0x0,0x1c,0x0,0x2a,0x0,0x1c,0x28,0x0,0x28,0x2c,0x0,0x2a,0x0,0x30,0x28,0x0,0x34,0x2c,0x0,0x38,0x34,0x30,0x28,0x0,0x3e,0x3c,0x3a,0x38,0x34,0x30,0x28,0x0,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x8,0x0,0x8,0x6,0x0,0x8,0x0,0xe,0x8,0x0,0x8,0x12,0x0,0x8,0x0,0x18,0x8,0x14,0x18,0x12,0x18,0x8,0x0,0x18,0x1c,0x14,0x18,0x21,0x18,0x8,0x0,0x18,0x28,0x26,0x28,0x2b,0x18,0x28,0x0,0x18,0x28,0x31,0x28,0x34,0x30,0x28,0x0,0x32,0x28,0x3a,0x38,0x34,0x30,0x28,0x0,0x3e,0x3c,0x3a,0x38,0x34,0x30,0x28,0x0,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x8,0x0,0x8,0x6,0x0,0x8,0x0,0xe,0x8,0x0,0x8,0x12,0x0,0x8,0x0,0x18,0x8,0x14,0x18,0x12,0x18,0x8,0x0,0x18,0x1c,0x14,0x18,0x21,0x18,0x8,0x0,0x18,0x28,0x26,0x28,0x2b,0x18,0x28,0x0,0x18,0x28,0x31,0x28,0x34,0x30,0x28,0x0,0x32,0x28,0x3a,0x38,0x34,0x30,0x28,0x0,0x3e,0x3c,0x3a,0x38,0x34,0x30,0x28,0x0,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x8,0x9,0x0,0x0,0x0,0x10,0x0,0x10,0x8,0x14,0x0,0x0,0x14,0x10,0x0,0x10,0x8,0x14,0x0,0x0,0x20,0x10,0x0,0x10,0x20,0x14,0x0,0x0,0x20,0x10,0x0,0x10,0x20,0x29,0x0,0x0,0x20,0x2c,0x0,0x2e,0x20,0x34,0x30,0x0,0x34,0x2c,0x0,0x3a,0x38,0x34,0x30,0x0,0x40,0x3e,0x3c,0x3a,0x38,0x34,0x30,0x0,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x8,0x9,0x0,0x0,0x0,0x10,0x0,0x10,0x8,0x14,0x0,0x0,0x14,0x10,0x0,0x10,0x8,0x14,0x0,0x0,0x20,0x10,0x0,0x10,0x20,0x14,0x0,0x0,0x20,0x10,0x0,0x10,0x20,0x29,0x0,0x0,0x20,0x2c,0x0,0x2e,0x20,0x34,0x30,0x0,0x34,0x2c,0x0,0x3a,0x38,0x34,0x30,0x0,0x40,0x3e,0x3c,0x3a,0x38,0x34,0x30,0x0,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x0,0x0,0x0,0x0,0x0,0x3,0x2,0x0,0x0,0x0,0x0,0x8,0x0,0xc,0xc,0xa,0x0,0x0,0x0,0x8,0x10,0xc,0xc,0xa,0x0,0x0,0x0,0x8,0x10,0xc,0x1a,0x1a,0x0,0x1a,0x0,0x8,0x20,0x20,0x24,0x1a,0x0,0x26,0x0,0x24,0x20,0x20,0x24,0x2a,0x0,0x30,0x0,0x24,0x30,0x20,0x32,0x2a,0x0,0x30,0x0,0x36,0x30,0x20,0x3c,0x3a,0x38,0x30,0x0,0x41,0x40,0x3e,0x3c,0x3a,0x38,0x30,0x0,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x0,0x0,0x0,0x0,0x0,0x3,0x2,0x0,0x0,0x0,0x0,0x8,0x0,0xc,0xc,0xa,0x0,0x0,0x0,0x8,0x10,0xc,0xc,0xa,0x0,0x0,0x0,0x8,0x10,0xc,0x1a,0x1a,0x0,0x1a,0x0,0x8,0x20,0x20,0x24,0x1a,0x0,0x26,0x0,0x24,0x20,0x20,0x24,0x2a,0x0,0x30,0x0,0x24,0x30,0x20,0x32,0x2a,0x0,0x30,0x0,0x36,0x30,0x20,0x3c,0x3a,0x38,0x30,0x0,0x41,0x40,0x3e,0x3c,0x3a,0x38,0x30,0x0,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x8,0x8,0x0,0xb,0x0,0x8,0x10,0x0,0x8,0x8,0x10,0x15,0x0,0x8,0x10,0x0,0x18,0x8,0x10,0x1e,0x0,0x8,0x10,0x0,0x18,0x8,0x10,0x1e,0x0,0x22,0x24,0x0,0x28,0x26,0x28,0x2b,0x0,0x2e,0x30,0x0,0x28,0x32,0x28,0x35,0x30,0x38,0x30,0x0,0x38,0x32,0x28,0x3e,0x3c,0x38,0x30,0x0,0x42,0x41,0x40,0x3e,0x3c,0x38,0x30,0x0,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x8,0x8,0x0,0xb,0x0,0x8,0x10,0x0,0x8,0x8,0x10,0x15,0x0,0x8,0x10,0x0,0x18,0x8,0x10,0x1e,0x0,0x8,0x10,0x0,0x18,0x8,0x10,0x1e,0x0,0x22,0x24,0x0,0x28,0x26,0x28,0x2b,0x0,0x2e,0x30,0x0,0x28,0x32,0x28,0x35,0x30,0x38,0x30,0x0,0x38,0x32,0x28,0x3e,0x3c,0x38,0x30,0x0,0x42,0x41,0x40,0x3e,0x3c,0x38,0x30,0x0,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0xc,0x0,0xc,0xc,0xe,0x0,0x0,0x12,0xc,0x0,0xc,0x18,0x19,0x0,0x0,0x1c,0xc,0x0,0x1c,0x18,0x22,0x0,0x1e,0x1c,0x21,0x0,0x1c,0x18,0x22,0x0,0x2a,0x2a,0x2c,0x0,0x2c,0x2c,0x30,0x0,0x2a,0x34,0x2c,0x0,0x2c,0x38,0x30,0x0,0x39,0x34,0x2c,0x0,0x3c,0x38,0x30,0x0,0x43,0x42,0x41,0x40,0x3c,0x38,0x30,0x0,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0xc,0x0,0xc,0xc,0xe,0x0,0x0,0x12,0xc,0x0,0xc,0x18,0x19,0x0,0x0,0x1c,0xc,0x0,0x1c,0x18,0x22,0x0,0x1e,0x1c,0x21,0x0,0x1c,0x18,0x22,0x0,0x2a,0x2a,0x2c,0x0,0x2c,0x2c,0x30,0x0,0x2a,0x34,0x2c,0x0,0x2c,0x38,0x30,0x0,0x39,0x34,0x2c,0x0,0x3c,0x38,0x30,0x0,0x43,0x42,0x41,0x40,0x3c,0x38,0x30,0x0,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x6,0x8,0x4,0x0,0x0,0xa,0xd,0x0,0x10,0x8,0x4,0x10,0x0,0x14,0x16,0x0,0x10,0x8,0x16,0x10,0x0,0x14,0x16,0x0,0x10,0x1c,0x21,0x20,0x0,0x22,0x23,0x0,0x10,0x28,0x2a,0x20,0x0,0x2c,0x2d,0x0,0x2a,0x28,0x2a,0x30,0x0,0x2c,0x36,0x30,0x36,0x28,0x38,0x30,0x0,0x3a,0x36,0x30,0x40,0x3c,0x38,0x30,0x0,0x44,0x43,0x42,0x40,0x3c,0x38,0x30,0x0,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x6,0x8,0x4,0x0,0x0,0xa,0xd,0x0,0x10,0x8,0x4,0x10,0x0,0x14,0x16,0x0,0x10,0x8,0x16,0x10,0x0,0x14,0x16,0x0,0x10,0x1c,0x21,0x20,0x0,0x22,0x23,0x0,0x10,0x28,0x2a,0x20,0x0,0x2c,0x2d,0x0,0x2a,0x28,0x2a,0x30,0x0,0x2c,0x36,0x30,0x36,0x28,0x38,0x30,0x0,0x3a,0x36,0x30,0x40,0x3c,0x38,0x30,0x0,0x44,0x43,0x42,0x40,0x3c,0x38,0x30,0x0,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x0,0x0,0x9,0x8,0x9,0x0,0xe,0x0,0x0,0x0,0x12,0x8,0x13,0x0,0xe,0x0,0x12,0x14,0x12,0x18,0x1c,0x0,0x1b,0x0,0x1e,0x20,0x20,0x18,0x1c,0x0,0x25,0x0,0x28,0x20,0x20,0x28,0x1c,0x0,0x2e,0x0,0x28,0x20,0x20,0x28,0x30,0x0,0x2e,0x0,0x28,0x34,0x20,0x38,0x30,0x0,0x3b,0x38,0x28,0x40,0x3c,0x38,0x30,0x0,0x45,0x44,0x42,0x40,0x3c,0x38,0x30,0x0,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x0,0x0,0x9,0x8,0x9,0x0,0xe,0x0,0x0,0x0,0x12,0x8,0x13,0x0,0xe,0x0,0x12,0x14,0x12,0x18,0x1c,0x0,0x1b,0x0,0x1e,0x20,0x20,0x18,0x1c,0x0,0x25,0x0,0x28,0x20,0x20,0x28,0x1c,0x0,0x2e,0x0,0x28,0x20,0x20,0x28,0x30,0x0,0x2e,0x0,0x28,0x34,0x20,0x38,0x30,0x0,0x3b,0x38,0x28,0x40,0x3c,0x38,0x30,0x0,0x45,0x44,0x42,0x40,0x3c,0x38,0x30,0x0,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x4,0x0,0xc,0x0,0x11,0x0,0x0,0x0,0x14,0x0,0xc,0x0,0x1b,0x18,0x0,0x0,0x14,0x0,0x1e,0x0,0x24,0x18,0x0,0x0,0x24,0x0,0x29,0x0,0x24,0x18,0x0,0x0,0x24,0x0,0x32,0x0,0x24,0x30,0x0,0x30,0x34,0x0,0x32,0x0,0x38,0x30,0x0,0x3c,0x34,0x0,0x40,0x3c,0x38,0x30,0x0,0x46,0x44,0x42,0x40,0x3c,0x38,0x30,0x0,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x4,0x0,0xc,0x0,0x11,0x0,0x0,0x0,0x14,0x0,0xc,0x0,0x1b,0x18,0x0,0x0,0x14,0x0,0x1e,0x0,0x24,0x18,0x0,0x0,0x24,0x0,0x29,0x0,0x24,0x18,0x0,0x0,0x24,0x0,0x32,0x0,0x24,0x30,0x0,0x30,0x34,0x0,0x32,0x0,0x38,0x30,0x0,0x3c,0x34,0x0,0x40,0x3c,0x38,0x30,0x0,0x46,0x44,0x42,0x40,0x3c,0x38,0x30,0x0,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x0,0x0,0xa,0x10,0x0,0x10,0xe,0x8,0x10,0x0,0x16,0x10,0x0,0x10,0x1a,0x8,0x10,0x0,0x20,0x10,0x1c,0x20,0x1a,0x20,0x10,0x0,0x20,0x24,0x1c,0x20,0x29,0x20,0x10,0x0,0x20,0x30,0x2e,0x30,0x33,0x20,0x30,0x0,0x20,0x30,0x39,0x30,0x3c,0x38,0x30,0x0,0x3a,0x30,0x42,0x40,0x3c,0x38,0x30,0x0,0x46,0x44,0x42,0x40,0x3c,0x38,0x30,0x0,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x0,0x0,0xa,0x10,0x0,0x10,0xe,0x8,0x10,0x0,0x16,0x10,0x0,0x10,0x1a,0x8,0x10,0x0,0x20,0x10,0x1c,0x20,0x1a,0x20,0x10,0x0,0x20,0x24,0x1c,0x20,0x29,0x20,0x10,0x0,0x20,0x30,0x2e,0x30,0x33,0x20,0x30,0x0,0x20,0x30,0x39,0x30,0x3c,0x38,0x30,0x0,0x3a,0x30,0x42,0x40,0x3c,0x38,0x30,0x0,0x46,0x44,0x42,0x40,0x3c,0x38,0x30,0x0,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x0,0x0,0x0,0x1,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0xa,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0xa,0x0,0x4,0x10,0x11,0x0,0x0,0x8,0x18,0x0,0x18,0x10,0x1c,0x0,0x0,0x1c,0x18,0x0,0x18,0x10,0x1c,0x0,0x0,0x28,0x18,0x0,0x18,0x28,0x1c,0x0,0x0,0x28,0x18,0x0,0x18,0x28,0x31,0x0,0x0,0x28,0x34,0x0,0x36,0x28,0x3c,0x38,0x0,0x3c,0x34,0x0,0x42,0x40,0x3c,0x38,0x0,0x48,0x46,0x44,0x42,0x40,0x3c,0x38,0x0,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x0,0x0,0x0,0x1,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0xa,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0xa,0x0,0x4,0x10,0x11,0x0,0x0,0x8,0x18,0x0,0x18,0x10,0x1c,0x0,0x0,0x1c,0x18,0x0,0x18,0x10,0x1c,0x0,0x0,0x28,0x18,0x0,0x18,0x28,0x1c,0x0,0x0,0x28,0x18,0x0,0x18,0x28,0x31,0x0,0x0,0x28,0x34,0x0,0x36,0x28,0x3c,0x38,0x0,0x3c,0x34,0x0,0x42,0x40,0x3c,0x38,0x0,0x48,0x46,0x44,0x42,0x40,0x3c,0x38,0x0,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x0,0x0,0x0,0x2,0x0,0x1,0x0,0x2,0x0,0x8,0x0,0x2,0x8,0xb,0xa,0x2,0x0,0x8,0x0,0x10,0x8,0x14,0x14,0x12,0x0,0x8,0x0,0x10,0x18,0x14,0x14,0x12,0x0,0x8,0x0,0x10,0x18,0x14,0x22,0x22,0x0,0x22,0x0,0x10,0x28,0x28,0x2c,0x22,0x0,0x2e,0x0,0x2c,0x28,0x28,0x2c,0x32,0x0,0x38,0x0,0x2c,0x38,0x28,0x3a,0x32,0x0,0x38,0x0,0x3e,0x38,0x28,0x44,0x42,0x40,0x38,0x0,0x49,0x48,0x46,0x44,0x42,0x40,0x38,0x0,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x0,0x0,0x0,0x2,0x0,0x1,0x0,0x2,0x0,0x8,0x0,0x2,0x8,0xb,0xa,0x2,0x0,0x8,0x0,0x10,0x8,0x14,0x14,0x12,0x0,0x8,0x0,0x10,0x18,0x14,0x14,0x12,0x0,0x8,0x0,0x10,0x18,0x14,0x22,0x22,0x0,0x22,0x0,0x10,0x28,0x28,0x2c,0x22,0x0,0x2e,0x0,0x2c,0x28,0x28,0x2c,0x32,0x0,0x38,0x0,0x2c,0x38,0x28,0x3a,0x32,0x0,0x38,0x0,0x3e,0x38,0x28,0x44,0x42,0x40,0x38,0x0,0x49,0x48,0x46,0x44,0x42,0x40,0x38,0x0,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x10,0xc,0x0,0x10,0x10,0x0,0x13,0x0,0x10,0x18,0x0,0x10,0x10,0x18,0x1d,0x0,0x10,0x18,0x0,0x20,0x10,0x18,0x26,0x0,0x10,0x18,0x0,0x20,0x10,0x18,0x26,0x0,0x2a,0x2c,0x0,0x30,0x2e,0x30,0x33,0x0,0x36,0x38,0x0,0x30,0x3a,0x30,0x3d,0x38,0x40,0x38,0x0,0x40,0x3a,0x30,0x46,0x44,0x40,0x38,0x0,0x4a,0x49,0x48,0x46,0x44,0x40,0x38,0x0,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x10,0xc,0x0,0x10,0x10,0x0,0x13,0x0,0x10,0x18,0x0,0x10,0x10,0x18,0x1d,0x0,0x10,0x18,0x0,0x20,0x10,0x18,0x26,0x0,0x10,0x18,0x0,0x20,0x10,0x18,0x26,0x0,0x2a,0x2c,0x0,0x30,0x2e,0x30,0x33,0x0,0x36,0x38,0x0,0x30,0x3a,0x30,0x3d,0x38,0x40,0x38,0x0,0x40,0x3a,0x30,0x46,0x44,0x40,0x38,0x0,0x4a,0x49,0x48,0x46,0x44,0x40,0x38,0x0,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x4,0x0,0x4,0x0,0x8,0xc,0x9,0x0,0x4,0x0,0x4,0x0,0x8,0xc,0x14,0x0,0x14,0x14,0x16,0x0,0x8,0x1a,0x14,0x0,0x14,0x20,0x21,0x0,0x8,0x24,0x14,0x0,0x24,0x20,0x2a,0x0,0x26,0x24,0x29,0x0,0x24,0x20,0x2a,0x0,0x32,0x32,0x34,0x0,0x34,0x34,0x38,0x0,0x32,0x3c,0x34,0x0,0x34,0x40,0x38,0x0,0x41,0x3c,0x34,0x0,0x44,0x40,0x38,0x0,0x4b,0x4a,0x49,0x48,0x44,0x40,0x38,0x0,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x4,0x0,0x4,0x0,0x8,0xc,0x9,0x0,0x4,0x0,0x4,0x0,0x8,0xc,0x14,0x0,0x14,0x14,0x16,0x0,0x8,0x1a,0x14,0x0,0x14,0x20,0x21,0x0,0x8,0x24,0x14,0x0,0x24,0x20,0x2a,0x0,0x26,0x24,0x29,0x0,0x24,0x20,0x2a,0x0,0x32,0x32,0x34,0x0,0x34,0x34,0x38,0x0,0x32,0x3c,0x34,0x0,0x34,0x40,0x38,0x0,0x41,0x3c,0x34,0x0,0x44,0x40,0x38,0x0,0x4b,0x4a,0x49,0x48,0x44,0x40,0x38,0x0,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x2,0x4,0x0,0x8,0x0,0x4,0xb,0x0,0xe,0x10,0xc,0x8,0x0,0x12,0x15,0x0,0x18,0x10,0xc,0x18,0x0,0x1c,0x1e,0x0,0x18,0x10,0x1e,0x18,0x0,0x1c,0x1e,0x0,0x18,0x24,0x29,0x28,0x0,0x2a,0x2b,0x0,0x18,0x30,0x32,0x28,0x0,0x34,0x35,0x0,0x32,0x30,0x32,0x38,0x0,0x34,0x3e,0x38,0x3e,0x30,0x40,0x38,0x0,0x42,0x3e,0x38,0x48,0x44,0x40,0x38,0x0,0x4c,0x4b,0x4a,0x48,0x44,0x40,0x38,0x0,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x2,0x4,0x0,0x8,0x0,0x4,0xb,0x0,0xe,0x10,0xc,0x8,0x0,0x12,0x15,0x0,0x18,0x10,0xc,0x18,0x0,0x1c,0x1e,0x0,0x18,0x10,0x1e,0x18,0x0,0x1c,0x1e,0x0,0x18,0x24,0x29,0x28,0x0,0x2a,0x2b,0x0,0x18,0x30,0x32,0x28,0x0,0x34,0x35,0x0,0x32,0x30,0x32,0x38,0x0,0x34,0x3e,0x38,0x3e,0x30,0x40,0x38,0x0,0x42,0x3e,0x38,0x48,0x44,0x40,0x38,0x0,0x4c,0x4b,0x4a,0x48,0x44,0x40,0x38,0x0,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x3,0x0,0x0,0x8,0x6,0x0,0x2,0x0,0xd,0x0,0x0,0x8,0x11,0x10,0x11,0x0,0x16,0x0,0x0,0x8,0x1a,0x10,0x1b,0x0,0x16,0x0,0x1a,0x1c,0x1a,0x20,0x24,0x0,0x23,0x0,0x26,0x28,0x28,0x20,0x24,0x0,0x2d,0x0,0x30,0x28,0x28,0x30,0x24,0x0,0x36,0x0,0x30,0x28,0x28,0x30,0x38,0x0,0x36,0x0,0x30,0x3c,0x28,0x40,0x38,0x0,0x43,0x40,0x30,0x48,0x44,0x40,0x38,0x0,0x4d,0x4c,0x4a,0x48,0x44,0x40,0x38,0x0,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x3,0x0,0x0,0x8,0x6,0x0,0x2,0x0,0xd,0x0,0x0,0x8,0x11,0x10,0x11,0x0,0x16,0x0,0x0,0x8,0x1a,0x10,0x1b,0x0,0x16,0x0,0x1a,0x1c,0x1a,0x20,0x24,0x0,0x23,0x0,0x26,0x28,0x28,0x20,0x24,0x0,0x2d,0x0,0x30,0x28,0x28,0x30,0x24,0x0,0x36,0x0,0x30,0x28,0x28,0x30,0x38,0x0,0x36,0x0,0x30,0x3c,0x28,0x40,0x38,0x0,0x43,0x40,0x30,0x48,0x44,0x40,0x38,0x0,0x4d,0x4c,0x4a,0x48,0x44,0x40,0x38,0x0,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0x8,0x0,0x0,0xc,0x0,0x0,0x0,0xa,0x8,0x0,0x0,0xc,0x0,0x14,0x0,0x19,0x8,0x0,0x0,0x1c,0x0,0x14,0x0,0x23,0x20,0x0,0x0,0x1c,0x0,0x26,0x0,0x2c,0x20,0x0,0x0,0x2c,0x0,0x31,0x0,0x2c,0x20,0x0,0x0,0x2c,0x0,0x3a,0x0,0x2c,0x38,0x0,0x38,0x3c,0x0,0x3a,0x0,0x40,0x38,0x0,0x44,0x3c,0x0,0x48,0x44,0x40,0x38,0x0,0x4e,0x4c,0x4a,0x48,0x44,0x40,0x38,0x0,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0x8,0x0,0x0,0xc,0x0,0x0,0x0,0xa,0x8,0x0,0x0,0xc,0x0,0x14,0x0,0x19,0x8,0x0,0x0,0x1c,0x0,0x14,0x0,0x23,0x20,0x0,0x0,0x1c,0x0,0x26,0x0,0x2c,0x20,0x0,0x0,0x2c,0x0,0x31,0x0,0x2c,0x20,0x0,0x0,0x2c,0x0,0x3a,0x0,0x2c,0x38,0x0,0x38,0x3c,0x0,0x3a,0x0,0x40,0x38,0x0,0x44,0x3c,0x0,0x48,0x44,0x40,0x38,0x0,0x4e,0x4c,0x4a,0x48,0x44,0x40,0x38,0x0,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x8,0x0,0x0,0x0,0x0,0x0,0xc,0x8,0x8,0x0,0x10,0x0,0x0,0x12,0x18,0x8,0x18,0x16,0x10,0x18,0x0,0x1e,0x18,0x8,0x18,0x22,0x10,0x18,0x0,0x28,0x18,0x24,0x28,0x22,0x28,0x18,0x0,0x28,0x2c,0x24,0x28,0x31,0x28,0x18,0x0,0x28,0x38,0x36,0x38,0x3b,0x28,0x38,0x0,0x28,0x38,0x41,0x38,0x44,0x40,0x38,0x0,0x42,0x38,0x4a,0x48,0x44,0x40,0x38,0x0,0x4e,0x4c,0x4a,0x48,0x44,0x40,0x38,0x0,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x8,0x0,0x0,0x0,0x0,0x0,0xc,0x8,0x8,0x0,0x10,0x0,0x0,0x12,0x18,0x8,0x18,0x16,0x10,0x18,0x0,0x1e,0x18,0x8,0x18,0x22,0x10,0x18,0x0,0x28,0x18,0x24,0x28,0x22,0x28,0x18,0x0,0x28,0x2c,0x24,0x28,0x31,0x28,0x18,0x0,0x28,0x38,0x36,0x38,0x3b,0x28,0x38,0x0,0x28,0x38,0x41,0x38,0x44,0x40,0x38,0x0,0x42,0x38,0x4a,0x48,0x44,0x40,0x38,0x0,0x4e,0x4c,0x4a,0x48,0x44,0x40,0x38,0x0,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x4,0x0,0x0,0x4,0x9,0x0,0xc,0x0,0x4,0x0,0x0,0x10,0x12,0x0,0xc,0x0,0x4,0x0,0x0,0x10,0x12,0x0,0xc,0x18,0x19,0x0,0x0,0x10,0x20,0x0,0x20,0x18,0x24,0x0,0x0,0x24,0x20,0x0,0x20,0x18,0x24,0x0,0x0,0x30,0x20,0x0,0x20,0x30,0x24,0x0,0x0,0x30,0x20,0x0,0x20,0x30,0x39,0x0,0x0,0x30,0x3c,0x0,0x3e,0x30,0x44,0x40,0x0,0x44,0x3c,0x0,0x4a,0x48,0x44,0x40,0x0,0x50,0x4e,0x4c,0x4a,0x48,0x44,0x40,0x0,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x4,0x0,0x0,0x4,0x9,0x0,0xc,0x0,0x4,0x0,0x0,0x10,0x12,0x0,0xc,0x0,0x4,0x0,0x0,0x10,0x12,0x0,0xc,0x18,0x19,0x0,0x0,0x10,0x20,0x0,0x20,0x18,0x24,0x0,0x0,0x24,0x20,0x0,0x20,0x18,0x24,0x0,0x0,0x30,0x20,0x0,0x20,0x30,0x24,0x0,0x0,0x30,0x20,0x0,0x20,0x30,0x39,0x0,0x0,0x30,0x3c,0x0,0x3e,0x30,0x44,0x40,0x0,0x44,0x3c,0x0,0x4a,0x48,0x44,0x40,0x0,0x50,0x4e,0x4c,0x4a,0x48,0x44,0x40,0x0,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x0,0x0,0x0,0x1,0x0,0x0,0x4,0x0,0x0,0x6,0x0,0xa,0x0,0x9,0x4,0xa,0x0,0x10,0x0,0xa,0x10,0x13,0x12,0xa,0x0,0x10,0x0,0x18,0x10,0x1c,0x1c,0x1a,0x0,0x10,0x0,0x18,0x20,0x1c,0x1c,0x1a,0x0,0x10,0x0,0x18,0x20,0x1c,0x2a,0x2a,0x0,0x2a,0x0,0x18,0x30,0x30,0x34,0x2a,0x0,0x36,0x0,0x34,0x30,0x30,0x34,0x3a,0x0,0x40,0x0,0x34,0x40,0x30,0x42,0x3a,0x0,0x40,0x0,0x46,0x40,0x30,0x4c,0x4a,0x48,0x40,0x0,0x51,0x50,0x4e,0x4c,0x4a,0x48,0x40,0x0,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x0,0x0,0x0,0x1,0x0,0x0,0x4,0x0,0x0,0x6,0x0,0xa,0x0,0x9,0x4,0xa,0x0,0x10,0x0,0xa,0x10,0x13,0x12,0xa,0x0,0x10,0x0,0x18,0x10,0x1c,0x1c,0x1a,0x0,0x10,0x0,0x18,0x20,0x1c,0x1c,0x1a,0x0,0x10,0x0,0x18,0x20,0x1c,0x2a,0x2a,0x0,0x2a,0x0,0x18,0x30,0x30,0x34,0x2a,0x0,0x36,0x0,0x34,0x30,0x30,0x34,0x3a,0x0,0x40,0x0,0x34,0x40,0x30,0x42,0x3a,0x0,0x40,0x0,0x46,0x40,0x30,0x4c,0x4a,0x48,0x40,0x0,0x51,0x50,0x4e,0x4c,0x4a,0x48,0x40,0x0,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x5,0x0,0x2,0x0,0x0,0x8,0x4,0x8,0xe,0x0,0xe,0x0,0x0,0x8,0x4,0x8,0xe,0x0,0x18,0x14,0x0,0x18,0x18,0x8,0x1b,0x0,0x18,0x20,0x0,0x18,0x18,0x20,0x25,0x0,0x18,0x20,0x0,0x28,0x18,0x20,0x2e,0x0,0x18,0x20,0x0,0x28,0x18,0x20,0x2e,0x0,0x32,0x34,0x0,0x38,0x36,0x38,0x3b,0x0,0x3e,0x40,0x0,0x38,0x42,0x38,0x45,0x40,0x48,0x40,0x0,0x48,0x42,0x38,0x4e,0x4c,0x48,0x40,0x0,0x52,0x51,0x50,0x4e,0x4c,0x48,0x40,0x0,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x5,0x0,0x2,0x0,0x0,0x8,0x4,0x8,0xe,0x0,0xe,0x0,0x0,0x8,0x4,0x8,0xe,0x0,0x18,0x14,0x0,0x18,0x18,0x8,0x1b,0x0,0x18,0x20,0x0,0x18,0x18,0x20,0x25,0x0,0x18,0x20,0x0,0x28,0x18,0x20,0x2e,0x0,0x18,0x20,0x0,0x28,0x18,0x20,0x2e,0x0,0x32,0x34,0x0,0x38,0x36,0x38,0x3b,0x0,0x3e,0x40,0x0,0x38,0x42,0x38,0x45,0x40,0x48,0x40,0x0,0x48,0x42,0x38,0x4e,0x4c,0x48,0x40,0x0,0x52,0x51,0x50,0x4e,0x4c,0x48,0x40,0x0,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0xa,0x0,0x0,0xc,0x8,0xc,0x0,0x10,0x14,0x11,0x0,0xc,0x8,0xc,0x0,0x10,0x14,0x1c,0x0,0x1c,0x1c,0x1e,0x0,0x10,0x22,0x1c,0x0,0x1c,0x28,0x29,0x0,0x10,0x2c,0x1c,0x0,0x2c,0x28,0x32,0x0,0x2e,0x2c,0x31,0x0,0x2c,0x28,0x32,0x0,0x3a,0x3a,0x3c,0x0,0x3c,0x3c,0x40,0x0,0x3a,0x44,0x3c,0x0,0x3c,0x48,0x40,0x0,0x49,0x44,0x3c,0x0,0x4c,0x48,0x40,0x0,0x53,0x52,0x51,0x50,0x4c,0x48,0x40,0x0,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0xa,0x0,0x0,0xc,0x8,0xc,0x0,0x10,0x14,0x11,0x0,0xc,0x8,0xc,0x0,0x10,0x14,0x1c,0x0,0x1c,0x1c,0x1e,0x0,0x10,0x22,0x1c,0x0,0x1c,0x28,0x29,0x0,0x10,0x2c,0x1c,0x0,0x2c,0x28,0x32,0x0,0x2e,0x2c,0x31,0x0,0x2c,0x28,0x32,0x0,0x3a,0x3a,0x3c,0x0,0x3c,0x3c,0x40,0x0,0x3a,0x44,0x3c,0x0,0x3c,0x48,0x40,0x0,0x49,0x44,0x3c,0x0,0x4c,0x48,0x40,0x0,0x53,0x52,0x51,0x50,0x4c,0x48,0x40,0x0,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0xc,0x6,0x0,0xa,0xc,0x0,0x10,0x0,0xc,0x13,0x0,0x16,0x18,0x14,0x10,0x0,0x1a,0x1d,0x0,0x20,0x18,0x14,0x20,0x0,0x24,0x26,0x0,0x20,0x18,0x26,0x20,0x0,0x24,0x26,0x0,0x20,0x2c,0x31,0x30,0x0,0x32,0x33,0x0,0x20,0x38,0x3a,0x30,0x0,0x3c,0x3d,0x0,0x3a,0x38,0x3a,0x40,0x0,0x3c,0x46,0x40,0x46,0x38,0x48,0x40,0x0,0x4a,0x46,0x40,0x50,0x4c,0x48,0x40,0x0,0x54,0x53,0x52,0x50,0x4c,0x48,0x40,0x0,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0xc,0x6,0x0,0xa,0xc,0x0,0x10,0x0,0xc,0x13,0x0,0x16,0x18,0x14,0x10,0x0,0x1a,0x1d,0x0,0x20,0x18,0x14,0x20,0x0,0x24,0x26,0x0,0x20,0x18,0x26,0x20,0x0,0x24,0x26,0x0,0x20,0x2c,0x31,0x30,0x0,0x32,0x33,0x0,0x20,0x38,0x3a,0x30,0x0,0x3c,0x3d,0x0,0x3a,0x38,0x3a,0x40,0x0,0x3c,0x46,0x40,0x46,0x38,0x48,0x40,0x0,0x4a,0x46,0x40,0x50,0x4c,0x48,0x40,0x0,0x54,0x53,0x52,0x50,0x4c,0x48,0x40,0x0,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x8,0xa,0x0,0xb,0x0,0x8,0x10,0xe,0x8,0xa,0x0,0x15,0x0,0x8,0x10,0x19,0x18,0x19,0x0,0x1e,0x0,0x8,0x10,0x22,0x18,0x23,0x0,0x1e,0x0,0x22,0x24,0x22,0x28,0x2c,0x0,0x2b,0x0,0x2e,0x30,0x30,0x28,0x2c,0x0,0x35,0x0,0x38,0x30,0x30,0x38,0x2c,0x0,0x3e,0x0,0x38,0x30,0x30,0x38,0x40,0x0,0x3e,0x0,0x38,0x44,0x30,0x48,0x40,0x0,0x4b,0x48,0x38,0x50,0x4c,0x48,0x40,0x0,0x55,0x54,0x52,0x50,0x4c,0x48,0x40,0x0,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x8,0xa,0x0,0xb,0x0,0x8,0x10,0xe,0x8,0xa,0x0,0x15,0x0,0x8,0x10,0x19,0x18,0x19,0x0,0x1e,0x0,0x8,0x10,0x22,0x18,0x23,0x0,0x1e,0x0,0x22,0x24,0x22,0x28,0x2c,0x0,0x2b,0x0,0x2e,0x30,0x30,0x28,0x2c,0x0,0x35,0x0,0x38,0x30,0x30,0x38,0x2c,0x0,0x3e,0x0,0x38,0x30,0x30,0x38,0x40,0x0,0x3e,0x0,0x38,0x44,0x30,0x48,0x40,0x0,0x4b,0x48,0x38,0x50,0x4c,0x48,0x40,0x0,0x55,0x54,0x52,0x50,0x4c,0x48,0x40,0x0,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x12,0x10,0x0,0x0,0x14,0x0,0x0,0x0,0x12,0x10,0x0,0x0,0x14,0x0,0x1c,0x0,0x21,0x10,0x0,0x0,0x24,0x0,0x1c,0x0,0x2b,0x28,0x0,0x0,0x24,0x0,0x2e,0x0,0x34,0x28,0x0,0x0,0x34,0x0,0x39,0x0,0x34,0x28,0x0,0x0,0x34,0x0,0x42,0x0,0x34,0x40,0x0,0x40,0x44,0x0,0x42,0x0,0x48,0x40,0x0,0x4c,0x44,0x0,0x50,0x4c,0x48,0x40,0x0,0x56,0x54,0x52,0x50,0x4c,0x48,0x40,0x0,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x12,0x10,0x0,0x0,0x14,0x0,0x0,0x0,0x12,0x10,0x0,0x0,0x14,0x0,0x1c,0x0,0x21,0x10,0x0,0x0,0x24,0x0,0x1c,0x0,0x2b,0x28,0x0,0x0,0x24,0x0,0x2e,0x0,0x34,0x28,0x0,0x0,0x34,0x0,0x39,0x0,0x34,0x28,0x0,0x0,0x34,0x0,0x42,0x0,0x34,0x40,0x0,0x40,0x44,0x0,0x42,0x0,0x48,0x40,0x0,0x4c,0x44,0x0,0x50,0x4c,0x48,0x40,0x0,0x56,0x54,0x52,0x50,0x4c,0x48,0x40,0x0,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x10,0x10,0x0,0x0,0x0,0x0,0x0,0x14,0x10,0x10,0x0,0x18,0x0,0x0,0x1a,0x20,0x10,0x20,0x1e,0x18,0x20,0x0,0x26,0x20,0x10,0x20,0x2a,0x18,0x20,0x0,0x30,0x20,0x2c,0x30,0x2a,0x30,0x20,0x0,0x30,0x34,0x2c,0x30,0x39,0x30,0x20,0x0,0x30,0x40,0x3e,0x40,0x43,0x30,0x40,0x0,0x30,0x40,0x49,0x40,0x4c,0x48,0x40,0x0,0x4a,0x40,0x52,0x50,0x4c,0x48,0x40,0x0,0x56,0x54,0x52,0x50,0x4c,0x48,0x40,0x0,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x10,0x10,0x0,0x0,0x0,0x0,0x0,0x14,0x10,0x10,0x0,0x18,0x0,0x0,0x1a,0x20,0x10,0x20,0x1e,0x18,0x20,0x0,0x26,0x20,0x10,0x20,0x2a,0x18,0x20,0x0,0x30,0x20,0x2c,0x30,0x2a,0x30,0x20,0x0,0x30,0x34,0x2c,0x30,0x39,0x30,0x20,0x0,0x30,0x40,0x3e,0x40,0x43,0x30,0x40,0x0,0x30,0x40,0x49,0x40,0x4c,0x48,0x40,0x0,0x4a,0x40,0x52,0x50,0x4c,0x48,0x40,0x0,0x56,0x54,0x52,0x50,0x4c,0x48,0x40,0x0,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x1,0x0,0x0,0x0,0x6,0x0,0xb,0x8,0xc,0x0,0x0,0xc,0x11,0x0,0x14,0x8,0xc,0x0,0x0,0x18,0x1a,0x0,0x14,0x8,0xc,0x0,0x0,0x18,0x1a,0x0,0x14,0x20,0x21,0x0,0x0,0x18,0x28,0x0,0x28,0x20,0x2c,0x0,0x0,0x2c,0x28,0x0,0x28,0x20,0x2c,0x0,0x0,0x38,0x28,0x0,0x28,0x38,0x2c,0x0,0x0,0x38,0x28,0x0,0x28,0x38,0x41,0x0,0x0,0x38,0x44,0x0,0x46,0x38,0x4c,0x48,0x0,0x4c,0x44,0x0,0x52,0x50,0x4c,0x48,0x0,0x58,0x56,0x54,0x52,0x50,0x4c,0x48,0x0,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x1,0x0,0x0,0x0,0x6,0x0,0xb,0x8,0xc,0x0,0x0,0xc,0x11,0x0,0x14,0x8,0xc,0x0,0x0,0x18,0x1a,0x0,0x14,0x8,0xc,0x0,0x0,0x18,0x1a,0x0,0x14,0x20,0x21,0x0,0x0,0x18,0x28,0x0,0x28,0x20,0x2c,0x0,0x0,0x2c,0x28,0x0,0x28,0x20,0x2c,0x0,0x0,0x38,0x28,0x0,0x28,0x38,0x2c,0x0,0x0,0x38,0x28,0x0,0x28,0x38,0x41,0x0,0x0,0x38,0x44,0x0,0x46,0x38,0x4c,0x48,0x0,0x4c,0x44,0x0,0x52,0x50,0x4c,0x48,0x0,0x58,0x56,0x54,0x52,0x50,0x4c,0x48,0x0,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x0,0x0,0x0,0x0,0x0,0x2,0x2,0x2,0x0,0x2,0x0,0x9,0x8,0x2,0xc,0x2,0x0,0xe,0x0,0x12,0x8,0x11,0xc,0x12,0x0,0x18,0x0,0x12,0x18,0x1b,0x1a,0x12,0x0,0x18,0x0,0x20,0x18,0x24,0x24,0x22,0x0,0x18,0x0,0x20,0x28,0x24,0x24,0x22,0x0,0x18,0x0,0x20,0x28,0x24,0x32,0x32,0x0,0x32,0x0,0x20,0x38,0x38,0x3c,0x32,0x0,0x3e,0x0,0x3c,0x38,0x38,0x3c,0x42,0x0,0x48,0x0,0x3c,0x48,0x38,0x4a,0x42,0x0,0x48,0x0,0x4e,0x48,0x38,0x54,0x52,0x50,0x48,0x0,0x59,0x58,0x56,0x54,0x52,0x50,0x48,0x0,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x0,0x0,0x0,0x0,0x0,0x2,0x2,0x2,0x0,0x2,0x0,0x9,0x8,0x2,0xc,0x2,0x0,0xe,0x0,0x12,0x8,0x11,0xc,0x12,0x0,0x18,0x0,0x12,0x18,0x1b,0x1a,0x12,0x0,0x18,0x0,0x20,0x18,0x24,0x24,0x22,0x0,0x18,0x0,0x20,0x28,0x24,0x24,0x22,0x0,0x18,0x0,0x20,0x28,0x24,0x32,0x32,0x0,0x32,0x0,0x20,0x38,0x38,0x3c,0x32,0x0,0x3e,0x0,0x3c,0x38,0x38,0x3c,0x42,0x0,0x48,0x0,0x3c,0x48,0x38,0x4a,0x42,0x0,0x48,0x0,0x4e,0x48,0x38,0x54,0x52,0x50,0x48,0x0,0x59,0x58,0x56,0x54,0x52,0x50,0x48,0x0,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x3,0x0,0x0,0x8,0x0,0x0,0xc,0x0,0xd,0x0,0xa,0x8,0x0,0x10,0xc,0x10,0x16,0x0,0x16,0x8,0x0,0x10,0xc,0x10,0x16,0x0,0x20,0x1c,0x0,0x20,0x20,0x10,0x23,0x0,0x20,0x28,0x0,0x20,0x20,0x28,0x2d,0x0,0x20,0x28,0x0,0x30,0x20,0x28,0x36,0x0,0x20,0x28,0x0,0x30,0x20,0x28,0x36,0x0,0x3a,0x3c,0x0,0x40,0x3e,0x40,0x43,0x0,0x46,0x48,0x0,0x40,0x4a,0x40,0x4d,0x48,0x50,0x48,0x0,0x50,0x4a,0x40,0x56,0x54,0x50,0x48,0x0,0x5a,0x59,0x58,0x56,0x54,0x50,0x48,0x0,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x3,0x0,0x0,0x8,0x0,0x0,0xc,0x0,0xd,0x0,0xa,0x8,0x0,0x10,0xc,0x10,0x16,0x0,0x16,0x8,0x0,0x10,0xc,0x10,0x16,0x0,0x20,0x1c,0x0,0x20,0x20,0x10,0x23,0x0,0x20,0x28,0x0,0x20,0x20,0x28,0x2d,0x0,0x20,0x28,0x0,0x30,0x20,0x28,0x36,0x0,0x20,0x28,0x0,0x30,0x20,0x28,0x36,0x0,0x3a,0x3c,0x0,0x40,0x3e,0x40,0x43,0x0,0x46,0x48,0x0,0x40,0x4a,0x40,0x4d,0x48,0x50,0x48,0x0,0x50,0x4a,0x40,0x56,0x54,0x50,0x48,0x0,0x5a,0x59,0x58,0x56,0x54,0x50,0x48,0x0,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x4,0x0,0x4,0x4,0x0,0x0,0x4,0x4,0x4,0x0,0x4,0x10,0x0,0x0,0x4,0x12,0x4,0x0,0x14,0x10,0x14,0x0,0x18,0x1c,0x19,0x0,0x14,0x10,0x14,0x0,0x18,0x1c,0x24,0x0,0x24,0x24,0x26,0x0,0x18,0x2a,0x24,0x0,0x24,0x30,0x31,0x0,0x18,0x34,0x24,0x0,0x34,0x30,0x3a,0x0,0x36,0x34,0x39,0x0,0x34,0x30,0x3a,0x0,0x42,0x42,0x44,0x0,0x44,0x44,0x48,0x0,0x42,0x4c,0x44,0x0,0x44,0x50,0x48,0x0,0x51,0x4c,0x44,0x0,0x54,0x50,0x48,0x0,0x5b,0x5a,0x59,0x58,0x54,0x50,0x48,0x0,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x4,0x0,0x4,0x4,0x0,0x0,0x4,0x4,0x4,0x0,0x4,0x10,0x0,0x0,0x4,0x12,0x4,0x0,0x14,0x10,0x14,0x0,0x18,0x1c,0x19,0x0,0x14,0x10,0x14,0x0,0x18,0x1c,0x24,0x0,0x24,0x24,0x26,0x0,0x18,0x2a,0x24,0x0,0x24,0x30,0x31,0x0,0x18,0x34,0x24,0x0,0x34,0x30,0x3a,0x0,0x36,0x34,0x39,0x0,0x34,0x30,0x3a,0x0,0x42,0x42,0x44,0x0,0x44,0x44,0x48,0x0,0x42,0x4c,0x44,0x0,0x44,0x50,0x48,0x0,0x51,0x4c,0x44,0x0,0x54,0x50,0x48,0x0,0x5b,0x5a,0x59,0x58,0x54,0x50,0x48,0x0,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x0,0x0,0x0,0x8,0x0,0xa,0xe,0x0,0x0,0x0,0x0,0x8,0x0,0x14,0xe,0x0,0x12,0x14,0x0,0x18,0x0,0x14,0x1b,0x0,0x1e,0x20,0x1c,0x18,0x0,0x22,0x25,0x0,0x28,0x20,0x1c,0x28,0x0,0x2c,0x2e,0x0,0x28,0x20,0x2e,0x28,0x0,0x2c,0x2e,0x0,0x28,0x34,0x39,0x38,0x0,0x3a,0x3b,0x0,0x28,0x40,0x42,0x38,0x0,0x44,0x45,0x0,0x42,0x40,0x42,0x48,0x0,0x44,0x4e,0x48,0x4e,0x40,0x50,0x48,0x0,0x52,0x4e,0x48,0x58,0x54,0x50,0x48,0x0,0x5c,0x5b,0x5a,0x58,0x54,0x50,0x48,0x0,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x0,0x0,0x0,0x8,0x0,0xa,0xe,0x0,0x0,0x0,0x0,0x8,0x0,0x14,0xe,0x0,0x12,0x14,0x0,0x18,0x0,0x14,0x1b,0x0,0x1e,0x20,0x1c,0x18,0x0,0x22,0x25,0x0,0x28,0x20,0x1c,0x28,0x0,0x2c,0x2e,0x0,0x28,0x20,0x2e,0x28,0x0,0x2c,0x2e,0x0,0x28,0x34,0x39,0x38,0x0,0x3a,0x3b,0x0,0x28,0x40,0x42,0x38,0x0,0x44,0x45,0x0,0x42,0x40,0x42,0x48,0x0,0x44,0x4e,0x48,0x4e,0x40,0x50,0x48,0x0,0x52,0x4e,0x48,0x58,0x54,0x50,0x48,0x0,0x5c,0x5b,0x5a,0x58,0x54,0x50,0x48,0x0,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0x4,0x0,0x6,0x0,0x6,0x0,0x10,0xc,0x4,0x10,0x12,0x0,0x13,0x0,0x10,0x18,0x16,0x10,0x12,0x0,0x1d,0x0,0x10,0x18,0x21,0x20,0x21,0x0,0x26,0x0,0x10,0x18,0x2a,0x20,0x2b,0x0,0x26,0x0,0x2a,0x2c,0x2a,0x30,0x34,0x0,0x33,0x0,0x36,0x38,0x38,0x30,0x34,0x0,0x3d,0x0,0x40,0x38,0x38,0x40,0x34,0x0,0x46,0x0,0x40,0x38,0x38,0x40,0x48,0x0,0x46,0x0,0x40,0x4c,0x38,0x50,0x48,0x0,0x53,0x50,0x40,0x58,0x54,0x50,0x48,0x0,0x5d,0x5c,0x5a,0x58,0x54,0x50,0x48,0x0,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0x4,0x0,0x6,0x0,0x6,0x0,0x10,0xc,0x4,0x10,0x12,0x0,0x13,0x0,0x10,0x18,0x16,0x10,0x12,0x0,0x1d,0x0,0x10,0x18,0x21,0x20,0x21,0x0,0x26,0x0,0x10,0x18,0x2a,0x20,0x2b,0x0,0x26,0x0,0x2a,0x2c,0x2a,0x30,0x34,0x0,0x33,0x0,0x36,0x38,0x38,0x30,0x34,0x0,0x3d,0x0,0x40,0x38,0x38,0x40,0x34,0x0,0x46,0x0,0x40,0x38,0x38,0x40,0x48,0x0,0x46,0x0,0x40,0x4c,0x38,0x50,0x48,0x0,0x53,0x50,0x40,0x58,0x54,0x50,0x48,0x0,0x5d,0x5c,0x5a,0x58,0x54,0x50,0x48,0x0,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x0,0x0,0xc,0x0,0x8,0x0,0xe,0x0,0x0,0x0,0xc,0x0,0x8,0x0,0x1a,0x18,0x0,0x0,0x1c,0x0,0x8,0x0,0x1a,0x18,0x0,0x0,0x1c,0x0,0x24,0x0,0x29,0x18,0x0,0x0,0x2c,0x0,0x24,0x0,0x33,0x30,0x0,0x0,0x2c,0x0,0x36,0x0,0x3c,0x30,0x0,0x0,0x3c,0x0,0x41,0x0,0x3c,0x30,0x0,0x0,0x3c,0x0,0x4a,0x0,0x3c,0x48,0x0,0x48,0x4c,0x0,0x4a,0x0,0x50,0x48,0x0,0x54,0x4c,0x0,0x58,0x54,0x50,0x48,0x0,0x5e,0x5c,0x5a,0x58,0x54,0x50,0x48,0x0,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x0,0x0,0xc,0x0,0x8,0x0,0xe,0x0,0x0,0x0,0xc,0x0,0x8,0x0,0x1a,0x18,0x0,0x0,0x1c,0x0,0x8,0x0,0x1a,0x18,0x0,0x0,0x1c,0x0,0x24,0x0,0x29,0x18,0x0,0x0,0x2c,0x0,0x24,0x0,0x33,0x30,0x0,0x0,0x2c,0x0,0x36,0x0,0x3c,0x30,0x0,0x0,0x3c,0x0,0x41,0x0,0x3c,0x30,0x0,0x0,0x3c,0x0,0x4a,0x0,0x3c,0x48,0x0,0x48,0x4c,0x0,0x4a,0x0,0x50,0x48,0x0,0x54,0x4c,0x0,0x58,0x54,0x50,0x48,0x0,0x5e,0x5c,0x5a,0x58,0x54,0x50,0x48,0x0,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x8,0x8,0xa,0x8,0x8,0x8,0x8,0x0,0x8,0x8,0xa,0x8,0x8,0x8,0x8,0x0,0x8,0x8,0x18,0x18,0x8,0x8,0x8,0x0,0x8,0x1c,0x18,0x18,0x8,0x20,0x8,0x0,0x22,0x28,0x18,0x28,0x26,0x20,0x28,0x0,0x2e,0x28,0x18,0x28,0x32,0x20,0x28,0x0,0x38,0x28,0x34,0x38,0x32,0x38,0x28,0x0,0x38,0x3c,0x34,0x38,0x41,0x38,0x28,0x0,0x38,0x48,0x46,0x48,0x4b,0x38,0x48,0x0,0x38,0x48,0x51,0x48,0x54,0x50,0x48,0x0,0x52,0x48,0x5a,0x58,0x54,0x50,0x48,0x0,0x5e,0x5c,0x5a,0x58,0x54,0x50,0x48,0x0,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x8,0x8,0xa,0x8,0x8,0x8,0x8,0x0,0x8,0x8,0xa,0x8,0x8,0x8,0x8,0x0,0x8,0x8,0x18,0x18,0x8,0x8,0x8,0x0,0x8,0x1c,0x18,0x18,0x8,0x20,0x8,0x0,0x22,0x28,0x18,0x28,0x26,0x20,0x28,0x0,0x2e,0x28,0x18,0x28,0x32,0x20,0x28,0x0,0x38,0x28,0x34,0x38,0x32,0x38,0x28,0x0,0x38,0x3c,0x34,0x38,0x41,0x38,0x28,0x0,0x38,0x48,0x46,0x48,0x4b,0x38,0x48,0x0,0x38,0x48,0x51,0x48,0x54,0x50,0x48,0x0,0x52,0x48,0x5a,0x58,0x54,0x50,0x48,0x0,0x5e,0x5c,0x5a,0x58,0x54,0x50,0x48,0x0,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x9,0x0,0x9,0x0,0x0,0x0,0xe,0x0,0x13,0x10,0x14,0x0,0x0,0x14,0x19,0x0,0x1c,0x10,0x14,0x0,0x0,0x20,0x22,0x0,0x1c,0x10,0x14,0x0,0x0,0x20,0x22,0x0,0x1c,0x28,0x29,0x0,0x0,0x20,0x30,0x0,0x30,0x28,0x34,0x0,0x0,0x34,0x30,0x0,0x30,0x28,0x34,0x0,0x0,0x40,0x30,0x0,0x30,0x40,0x34,0x0,0x0,0x40,0x30,0x0,0x30,0x40,0x49,0x0,0x0,0x40,0x4c,0x0,0x4e,0x40,0x54,0x50,0x0,0x54,0x4c,0x0,0x5a,0x58,0x54,0x50,0x0,0x60,0x5e,0x5c,0x5a,0x58,0x54,0x50,0x0,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x9,0x0,0x9,0x0,0x0,0x0,0xe,0x0,0x13,0x10,0x14,0x0,0x0,0x14,0x19,0x0,0x1c,0x10,0x14,0x0,0x0,0x20,0x22,0x0,0x1c,0x10,0x14,0x0,0x0,0x20,0x22,0x0,0x1c,0x28,0x29,0x0,0x0,0x20,0x30,0x0,0x30,0x28,0x34,0x0,0x0,0x34,0x30,0x0,0x30,0x28,0x34,0x0,0x0,0x40,0x30,0x0,0x30,0x40,0x34,0x0,0x0,0x40,0x30,0x0,0x30,0x40,0x49,0x0,0x0,0x40,0x4c,0x0,0x4e,0x40,0x54,0x50,0x0,0x54,0x4c,0x0,0x5a,0x58,0x54,0x50,0x0,0x60,0x5e,0x5c,0x5a,0x58,0x54,0x50,0x0,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0xa,0xa,0xa,0x0,0xa,0x0,0x11,0x10,0xa,0x14,0xa,0x0,0x16,0x0,0x1a,0x10,0x19,0x14,0x1a,0x0,0x20,0x0,0x1a,0x20,0x23,0x22,0x1a,0x0,0x20,0x0,0x28,0x20,0x2c,0x2c,0x2a,0x0,0x20,0x0,0x28,0x30,0x2c,0x2c,0x2a,0x0,0x20,0x0,0x28,0x30,0x2c,0x3a,0x3a,0x0,0x3a,0x0,0x28,0x40,0x40,0x44,0x3a,0x0,0x46,0x0,0x44,0x40,0x40,0x44,0x4a,0x0,0x50,0x0,0x44,0x50,0x40,0x52,0x4a,0x0,0x50,0x0,0x56,0x50,0x40,0x5c,0x5a,0x58,0x50,0x0,0x61,0x60,0x5e,0x5c,0x5a,0x58,0x50,0x0,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0xa,0xa,0xa,0x0,0xa,0x0,0x11,0x10,0xa,0x14,0xa,0x0,0x16,0x0,0x1a,0x10,0x19,0x14,0x1a,0x0,0x20,0x0,0x1a,0x20,0x23,0x22,0x1a,0x0,0x20,0x0,0x28,0x20,0x2c,0x2c,0x2a,0x0,0x20,0x0,0x28,0x30,0x2c,0x2c,0x2a,0x0,0x20,0x0,0x28,0x30,0x2c,0x3a,0x3a,0x0,0x3a,0x0,0x28,0x40,0x40,0x44,0x3a,0x0,0x46,0x0,0x44,0x40,0x40,0x44,0x4a,0x0,0x50,0x0,0x44,0x50,0x40,0x52,0x4a,0x0,0x50,0x0,0x56,0x50,0x40,0x5c,0x5a,0x58,0x50,0x0,0x61,0x60,0x5e,0x5c,0x5a,0x58,0x50,0x0,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0xb,0x0,0xb,0x0,0x0,0x10,0x0,0x8,0x14,0x0,0x15,0x0,0x12,0x10,0x0,0x18,0x14,0x18,0x1e,0x0,0x1e,0x10,0x0,0x18,0x14,0x18,0x1e,0x0,0x28,0x24,0x0,0x28,0x28,0x18,0x2b,0x0,0x28,0x30,0x0,0x28,0x28,0x30,0x35,0x0,0x28,0x30,0x0,0x38,0x28,0x30,0x3e,0x0,0x28,0x30,0x0,0x38,0x28,0x30,0x3e,0x0,0x42,0x44,0x0,0x48,0x46,0x48,0x4b,0x0,0x4e,0x50,0x0,0x48,0x52,0x48,0x55,0x50,0x58,0x50,0x0,0x58,0x52,0x48,0x5e,0x5c,0x58,0x50,0x0,0x62,0x61,0x60,0x5e,0x5c,0x58,0x50,0x0,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0xb,0x0,0xb,0x0,0x0,0x10,0x0,0x8,0x14,0x0,0x15,0x0,0x12,0x10,0x0,0x18,0x14,0x18,0x1e,0x0,0x1e,0x10,0x0,0x18,0x14,0x18,0x1e,0x0,0x28,0x24,0x0,0x28,0x28,0x18,0x2b,0x0,0x28,0x30,0x0,0x28,0x28,0x30,0x35,0x0,0x28,0x30,0x0,0x38,0x28,0x30,0x3e,0x0,0x28,0x30,0x0,0x38,0x28,0x30,0x3e,0x0,0x42,0x44,0x0,0x48,0x46,0x48,0x4b,0x0,0x4e,0x50,0x0,0x48,0x52,0x48,0x55,0x50,0x58,0x50,0x0,0x58,0x52,0x48,0x5e,0x5c,0x58,0x50,0x0,0x62,0x61,0x60,0x5e,0x5c,0x58,0x50,0x0,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x0,0x0,0x0,0x0,0x0,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0xc,0xc,0xc,0x0,0xc,0xc,0x0,0x0,0xc,0xc,0xc,0x0,0xc,0x18,0x0,0x0,0xc,0x1a,0xc,0x0,0x1c,0x18,0x1c,0x0,0x20,0x24,0x21,0x0,0x1c,0x18,0x1c,0x0,0x20,0x24,0x2c,0x0,0x2c,0x2c,0x2e,0x0,0x20,0x32,0x2c,0x0,0x2c,0x38,0x39,0x0,0x20,0x3c,0x2c,0x0,0x3c,0x38,0x42,0x0,0x3e,0x3c,0x41,0x0,0x3c,0x38,0x42,0x0,0x4a,0x4a,0x4c,0x0,0x4c,0x4c,0x50,0x0,0x4a,0x54,0x4c,0x0,0x4c,0x58,0x50,0x0,0x59,0x54,0x4c,0x0,0x5c,0x58,0x50,0x0,0x63,0x62,0x61,0x60,0x5c,0x58,0x50,0x0,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x0,0x0,0x0,0x0,0x0,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0xc,0xc,0xc,0x0,0xc,0xc,0x0,0x0,0xc,0xc,0xc,0x0,0xc,0x18,0x0,0x0,0xc,0x1a,0xc,0x0,0x1c,0x18,0x1c,0x0,0x20,0x24,0x21,0x0,0x1c,0x18,0x1c,0x0,0x20,0x24,0x2c,0x0,0x2c,0x2c,0x2e,0x0,0x20,0x32,0x2c,0x0,0x2c,0x38,0x39,0x0,0x20,0x3c,0x2c,0x0,0x3c,0x38,0x42,0x0,0x3e,0x3c,0x41,0x0,0x3c,0x38,0x42,0x0,0x4a,0x4a,0x4c,0x0,0x4c,0x4c,0x50,0x0,0x4a,0x54,0x4c,0x0,0x4c,0x58,0x50,0x0,0x59,0x54,0x4c,0x0,0x5c,0x58,0x50,0x0,0x63,0x62,0x61,0x60,0x5c,0x58,0x50,0x0,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x3,0x0,0x0,0x8,0x8,0x0,0x0,0x4,0xd,0x0,0x0,0x8,0x8,0x10,0x0,0x12,0x16,0x0,0x0,0x8,0x8,0x10,0x0,0x1c,0x16,0x0,0x1a,0x1c,0x8,0x20,0x0,0x1c,0x23,0x0,0x26,0x28,0x24,0x20,0x0,0x2a,0x2d,0x0,0x30,0x28,0x24,0x30,0x0,0x34,0x36,0x0,0x30,0x28,0x36,0x30,0x0,0x34,0x36,0x0,0x30,0x3c,0x41,0x40,0x0,0x42,0x43,0x0,0x30,0x48,0x4a,0x40,0x0,0x4c,0x4d,0x0,0x4a,0x48,0x4a,0x50,0x0,0x4c,0x56,0x50,0x56,0x48,0x58,0x50,0x0,0x5a,0x56,0x50,0x60,0x5c,0x58,0x50,0x0,0x64,0x63,0x62,0x60,0x5c,0x58,0x50,0x0,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x3,0x0,0x0,0x8,0x8,0x0,0x0,0x4,0xd,0x0,0x0,0x8,0x8,0x10,0x0,0x12,0x16,0x0,0x0,0x8,0x8,0x10,0x0,0x1c,0x16,0x0,0x1a,0x1c,0x8,0x20,0x0,0x1c,0x23,0x0,0x26,0x28,0x24,0x20,0x0,0x2a,0x2d,0x0,0x30,0x28,0x24,0x30,0x0,0x34,0x36,0x0,0x30,0x28,0x36,0x30,0x0,0x34,0x36,0x0,0x30,0x3c,0x41,0x40,0x0,0x42,0x43,0x0,0x30,0x48,0x4a,0x40,0x0,0x4c,0x4d,0x0,0x4a,0x48,0x4a,0x50,0x0,0x4c,0x56,0x50,0x56,0x48,0x58,0x50,0x0,0x5a,0x56,0x50,0x60,0x5c,0x58,0x50,0x0,0x64,0x63,0x62,0x60,0x5c,0x58,0x50,0x0,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x2,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0xe,0x0,0xc,0x8,0xe,0x0,0xe,0x0,0x18,0x14,0xc,0x18,0x1a,0x0,0x1b,0x0,0x18,0x20,0x1e,0x18,0x1a,0x0,0x25,0x0,0x18,0x20,0x29,0x28,0x29,0x0,0x2e,0x0,0x18,0x20,0x32,0x28,0x33,0x0,0x2e,0x0,0x32,0x34,0x32,0x38,0x3c,0x0,0x3b,0x0,0x3e,0x40,0x40,0x38,0x3c,0x0,0x45,0x0,0x48,0x40,0x40,0x48,0x3c,0x0,0x4e,0x0,0x48,0x40,0x40,0x48,0x50,0x0,0x4e,0x0,0x48,0x54,0x40,0x58,0x50,0x0,0x5b,0x58,0x48,0x60,0x5c,0x58,0x50,0x0,0x65,0x64,0x62,0x60,0x5c,0x58,0x50,0x0,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5,0x0,0x2,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0xe,0x0,0xc,0x8,0xe,0x0,0xe,0x0,0x18,0x14,0xc,0x18,0x1a,0x0,0x1b,0x0,0x18,0x20,0x1e,0x18,0x1a,0x0,0x25,0x0,0x18,0x20,0x29,0x28,0x29,0x0,0x2e,0x0,0x18,0x20,0x32,0x28,0x33,0x0,0x2e,0x0,0x32,0x34,0x32,0x38,0x3c,0x0,0x3b,0x0,0x3e,0x40,0x40,0x38,0x3c,0x0,0x45,0x0,0x48,0x40,0x40,0x48,0x3c,0x0,0x4e,0x0,0x48,0x40,0x40,0x48,0x50,0x0,0x4e,0x0,0x48,0x54,0x40,0x58,0x50,0x0,0x5b,0x58,0x48,0x60,0x5c,0x58,0x50,0x0,0x65,0x64,0x62,0x60,0x5c,0x58,0x50,0x0,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x2,0x0,0x0,0x8,0x0,0x0,0x4,0x0,0x10,0x0,0x0,0x8,0x0,0x0,0x14,0x0,0x10,0x0,0x16,0x8,0x0,0x0,0x14,0x0,0x10,0x0,0x22,0x20,0x0,0x0,0x24,0x0,0x10,0x0,0x22,0x20,0x0,0x0,0x24,0x0,0x2c,0x0,0x31,0x20,0x0,0x0,0x34,0x0,0x2c,0x0,0x3b,0x38,0x0,0x0,0x34,0x0,0x3e,0x0,0x44,0x38,0x0,0x0,0x44,0x0,0x49,0x0,0x44,0x38,0x0,0x0,0x44,0x0,0x52,0x0,0x44,0x50,0x0,0x50,0x54,0x0,0x52,0x0,0x58,0x50,0x0,0x5c,0x54,0x0,0x60,0x5c,0x58,0x50,0x0,0x66,0x64,0x62,0x60,0x5c,0x58,0x50,0x0,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x2,0x0,0x0,0x8,0x0,0x0,0x4,0x0,0x10,0x0,0x0,0x8,0x0,0x0,0x14,0x0,0x10,0x0,0x16,0x8,0x0,0x0,0x14,0x0,0x10,0x0,0x22,0x20,0x0,0x0,0x24,0x0,0x10,0x0,0x22,0x20,0x0,0x0,0x24,0x0,0x2c,0x0,0x31,0x20,0x0,0x0,0x34,0x0,0x2c,0x0,0x3b,0x38,0x0,0x0,0x34,0x0,0x3e,0x0,0x44,0x38,0x0,0x0,0x44,0x0,0x49,0x0,0x44,0x38,0x0,0x0,0x44,0x0,0x52,0x0,0x44,0x50,0x0,0x50,0x54,0x0,0x52,0x0,0x58,0x50,0x0,0x5c,0x54,0x0,0x60,0x5c,0x58,0x50,0x0,0x66,0x64,0x62,0x60,0x5c,0x58,0x50,0x0,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x9,0x0,0x0,0x0,0x0,0x0,0x10,0x10,0x12,0x10,0x10,0x10,0x10,0x0,0x10,0x10,0x12,0x10,0x10,0x10,0x10,0x0,0x10,0x10,0x20,0x20,0x10,0x10,0x10,0x0,0x10,0x24,0x20,0x20,0x10,0x28,0x10,0x0,0x2a,0x30,0x20,0x30,0x2e,0x28,0x30,0x0,0x36,0x30,0x20,0x30,0x3a,0x28,0x30,0x0,0x40,0x30,0x3c,0x40,0x3a,0x40,0x30,0x0,0x40,0x44,0x3c,0x40,0x49,0x40,0x30,0x0,0x40,0x50,0x4e,0x50,0x53,0x40,0x50,0x0,0x40,0x50,0x59,0x50,0x5c,0x58,0x50,0x0,0x5a,0x50,0x62,0x60,0x5c,0x58,0x50,0x0,0x66,0x64,0x62,0x60,0x5c,0x58,0x50,0x0,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x4,0x9,0x0,0x0,0x0,0x0,0x0,0x10,0x10,0x12,0x10,0x10,0x10,0x10,0x0,0x10,0x10,0x12,0x10,0x10,0x10,0x10,0x0,0x10,0x10,0x20,0x20,0x10,0x10,0x10,0x0,0x10,0x24,0x20,0x20,0x10,0x28,0x10,0x0,0x2a,0x30,0x20,0x30,0x2e,0x28,0x30,0x0,0x36,0x30,0x20,0x30,0x3a,0x28,0x30,0x0,0x40,0x30,0x3c,0x40,0x3a,0x40,0x30,0x0,0x40,0x44,0x3c,0x40,0x49,0x40,0x30,0x0,0x40,0x50,0x4e,0x50,0x53,0x40,0x50,0x0,0x40,0x50,0x59,0x50,0x5c,0x58,0x50,0x0,0x5a,0x50,0x62,0x60,0x5c,0x58,0x50,0x0,0x66,0x64,0x62,0x60,0x5c,0x58,0x50,0x0,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x11,0x0,0x11,0x0,0x0,0x8,0x16,0x0,0x1b,0x18,0x1c,0x0,0x0,0x1c,0x21,0x0,0x24,0x18,0x1c,0x0,0x0,0x28,0x2a,0x0,0x24,0x18,0x1c,0x0,0x0,0x28,0x2a,0x0,0x24,0x30,0x31,0x0,0x0,0x28,0x38,0x0,0x38,0x30,0x3c,0x0,0x0,0x3c,0x38,0x0,0x38,0x30,0x3c,0x0,0x0,0x48,0x38,0x0,0x38,0x48,0x3c,0x0,0x0,0x48,0x38,0x0,0x38,0x48,0x51,0x0,0x0,0x48,0x54,0x0,0x56,0x48,0x5c,0x58,0x0,0x5c,0x54,0x0,0x62,0x60,0x5c,0x58,0x0,0x68,0x66,0x64,0x62,0x60,0x5c,0x58,0x0,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x11,0x0,0x11,0x0,0x0,0x8,0x16,0x0,0x1b,0x18,0x1c,0x0,0x0,0x1c,0x21,0x0,0x24,0x18,0x1c,0x0,0x0,0x28,0x2a,0x0,0x24,0x18,0x1c,0x0,0x0,0x28,0x2a,0x0,0x24,0x30,0x31,0x0,0x0,0x28,0x38,0x0,0x38,0x30,0x3c,0x0,0x0,0x3c,0x38,0x0,0x38,0x30,0x3c,0x0,0x0,0x48,0x38,0x0,0x38,0x48,0x3c,0x0,0x0,0x48,0x38,0x0,0x38,0x48,0x51,0x0,0x0,0x48,0x54,0x0,0x56,0x48,0x5c,0x58,0x0,0x5c,0x54,0x0,0x62,0x60,0x5c,0x58,0x0,0x68,0x66,0x64,0x62,0x60,0x5c,0x58,0x0,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x6,0x4,0x2,0x0,0x0,0x0,0xe,0x8,0x12,0x12,0x12,0x0,0x12,0x0,0x19,0x18,0x12,0x1c,0x12,0x0,0x1e,0x0,0x22,0x18,0x21,0x1c,0x22,0x0,0x28,0x0,0x22,0x28,0x2b,0x2a,0x22,0x0,0x28,0x0,0x30,0x28,0x34,0x34,0x32,0x0,0x28,0x0,0x30,0x38,0x34,0x34,0x32,0x0,0x28,0x0,0x30,0x38,0x34,0x42,0x42,0x0,0x42,0x0,0x30,0x48,0x48,0x4c,0x42,0x0,0x4e,0x0,0x4c,0x48,0x48,0x4c,0x52,0x0,0x58,0x0,0x4c,0x58,0x48,0x5a,0x52,0x0,0x58,0x0,0x5e,0x58,0x48,0x64,0x62,0x60,0x58,0x0,0x69,0x68,0x66,0x64,0x62,0x60,0x58,0x0,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x6,0x4,0x2,0x0,0x0,0x0,0xe,0x8,0x12,0x12,0x12,0x0,0x12,0x0,0x19,0x18,0x12,0x1c,0x12,0x0,0x1e,0x0,0x22,0x18,0x21,0x1c,0x22,0x0,0x28,0x0,0x22,0x28,0x2b,0x2a,0x22,0x0,0x28,0x0,0x30,0x28,0x34,0x34,0x32,0x0,0x28,0x0,0x30,0x38,0x34,0x34,0x32,0x0,0x28,0x0,0x30,0x38,0x34,0x42,0x42,0x0,0x42,0x0,0x30,0x48,0x48,0x4c,0x42,0x0,0x4e,0x0,0x4c,0x48,0x48,0x4c,0x52,0x0,0x58,0x0,0x4c,0x58,0x48,0x5a,0x52,0x0,0x58,0x0,0x5e,0x58,0x48,0x64,0x62,0x60,0x58,0x0,0x69,0x68,0x66,0x64,0x62,0x60,0x58,0x0,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x72,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x9,0x8,0x6,0x0,0x0,0xc,0x0,0x10,0x13,0x8,0x13,0x0,0x0,0x18,0x0,0x10,0x1c,0x8,0x1d,0x0,0x1a,0x18,0x0,0x20,0x1c,0x20,0x26,0x0,0x26,0x18,0x0,0x20,0x1c,0x20,0x26,0x0,0x30,0x2c,0x0,0x30,0x30,0x20,0x33,0x0,0x30,0x38,0x0,0x30,0x30,0x38,0x3d,0x0,0x30,0x38,0x0,0x40,0x30,0x38,0x46,0x0,0x30,0x38,0x0,0x40,0x30,0x38,0x46,0x0,0x4a,0x4c,0x0,0x50,0x4e,0x50,0x53,0x0,0x56,0x58,0x0,0x50,0x5a,0x50,0x5d,0x58,0x60,0x58,0x0,0x60,0x5a,0x50,0x66,0x64,0x60,0x58,0x0,0x6a,0x69,0x68,0x66,0x64,0x60,0x58,0x0,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x0,0x0,0x0,0x9,0x8,0x6,0x0,0x0,0xc,0x0,0x10,0x13,0x8,0x13,0x0,0x0,0x18,0x0,0x10,0x1c,0x8,0x1d,0x0,0x1a,0x18,0x0,0x20,0x1c,0x20,0x26,0x0,0x26,0x18,0x0,0x20,0x1c,0x20,0x26,0x0,0x30,0x2c,0x0,0x30,0x30,0x20,0x33,0x0,0x30,0x38,0x0,0x30,0x30,0x38,0x3d,0x0,0x30,0x38,0x0,0x40,0x30,0x38,0x46,0x0,0x30,0x38,0x0,0x40,0x30,0x38,0x46,0x0,0x4a,0x4c,0x0,0x50,0x4e,0x50,0x53,0x0,0x56,0x58,0x0,0x50,0x5a,0x50,0x5d,0x58,0x60,0x58,0x0,0x60,0x5a,0x50,0x66,0x64,0x60,0x58,0x0,0x6a,0x69,0x68,0x66,0x64,0x60,0x58,0x0,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x73,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x8,0x0,0xb,0xa,0x9,0x0,0x4,0x0,0x8,0x0,0x14,0x14,0x14,0x0,0x14,0x14,0x8,0x0,0x14,0x14,0x14,0x0,0x14,0x20,0x8,0x0,0x14,0x22,0x14,0x0,0x24,0x20,0x24,0x0,0x28,0x2c,0x29,0x0,0x24,0x20,0x24,0x0,0x28,0x2c,0x34,0x0,0x34,0x34,0x36,0x0,0x28,0x3a,0x34,0x0,0x34,0x40,0x41,0x0,0x28,0x44,0x34,0x0,0x44,0x40,0x4a,0x0,0x46,0x44,0x49,0x0,0x44,0x40,0x4a,0x0,0x52,0x52,0x54,0x0,0x54,0x54,0x58,0x0,0x52,0x5c,0x54,0x0,0x54,0x60,0x58,0x0,0x61,0x5c,0x54,0x0,0x64,0x60,0x58,0x0,0x6b,0x6a,0x69,0x68,0x64,0x60,0x58,0x0,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x0,0x0,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x4,0x0,0x8,0x0,0xb,0xa,0x9,0x0,0x4,0x0,0x8,0x0,0x14,0x14,0x14,0x0,0x14,0x14,0x8,0x0,0x14,0x14,0x14,0x0,0x14,0x20,0x8,0x0,0x14,0x22,0x14,0x0,0x24,0x20,0x24,0x0,0x28,0x2c,0x29,0x0,0x24,0x20,0x24,0x0,0x28,0x2c,0x34,0x0,0x34,0x34,0x36,0x0,0x28,0x3a,0x34,0x0,0x34,0x40,0x41,0x0,0x28,0x44,0x34,0x0,0x44,0x40,0x4a,0x0,0x46,0x44,0x49,0x0,0x44,0x40,0x4a,0x0,0x52,0x52,0x54,0x0,0x54,0x54,0x58,0x0,0x52,0x5c,0x54,0x0,0x54,0x60,0x58,0x0,0x61,0x5c,0x54,0x0,0x64,0x60,0x58,0x0,0x6b,0x6a,0x69,0x68,0x64,0x60,0x58,0x0,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x74,0x0,0x0,0x0,0x2,0x0,0x0,0x2,0x0,0x0,0x8,0x4,0x2,0x8,0x0,0xc,0xb,0x0,0x8,0x10,0x10,0x8,0x0,0xc,0x15,0x0,0x8,0x10,0x10,0x18,0x0,0x1a,0x1e,0x0,0x8,0x10,0x10,0x18,0x0,0x24,0x1e,0x0,0x22,0x24,0x10,0x28,0x0,0x24,0x2b,0x0,0x2e,0x30,0x2c,0x28,0x0,0x32,0x35,0x0,0x38,0x30,0x2c,0x38,0x0,0x3c,0x3e,0x0,0x38,0x30,0x3e,0x38,0x0,0x3c,0x3e,0x0,0x38,0x44,0x49,0x48,0x0,0x4a,0x4b,0x0,0x38,0x50,0x52,0x48,0x0,0x54,0x55,0x0,0x52,0x50,0x52,0x58,0x0,0x54,0x5e,0x58,0x5e,0x50,0x60,0x58,0x0,0x62,0x5e,0x58,0x68,0x64,0x60,0x58,0x0,0x6c,0x6b,0x6a,0x68,0x64,0x60,0x58,0x0,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x0,0x0,0x0,0x2,0x0,0x0,0x2,0x0,0x0,0x8,0x4,0x2,0x8,0x0,0xc,0xb,0x0,0x8,0x10,0x10,0x8,0x0,0xc,0x15,0x0,0x8,0x10,0x10,0x18,0x0,0x1a,0x1e,0x0,0x8,0x10,0x10,0x18,0x0,0x24,0x1e,0x0,0x22,0x24,0x10,0x28,0x0,0x24,0x2b,0x0,0x2e,0x30,0x2c,0x28,0x0,0x32,0x35,0x0,0x38,0x30,0x2c,0x38,0x0,0x3c,0x3e,0x0,0x38,0x30,0x3e,0x38,0x0,0x3c,0x3e,0x0,0x38,0x44,0x49,0x48,0x0,0x4a,0x4b,0x0,0x38,0x50,0x52,0x48,0x0,0x54,0x55,0x0,0x52,0x50,0x52,0x58,0x0,0x54,0x5e,0x58,0x5e,0x50,0x60,0x58,0x0,0x62,0x5e,0x58,0x68,0x64,0x60,0x58,0x0,0x6c,0x6b,0x6a,0x68,0x64,0x60,0x58,0x0,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x75,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x8,0x0,0x0,0x0,0x0,0xd,0x0,0xa,0x8,0x0,0x10,0x0,0x0,0x16,0x0,0x16,0x8,0x14,0x10,0x16,0x0,0x16,0x0,0x20,0x1c,0x14,0x20,0x22,0x0,0x23,0x0,0x20,0x28,0x26,0x20,0x22,0x0,0x2d,0x0,0x20,0x28,0x31,0x30,0x31,0x0,0x36,0x0,0x20,0x28,0x3a,0x30,0x3b,0x0,0x36,0x0,0x3a,0x3c,0x3a,0x40,0x44,0x0,0x43,0x0,0x46,0x48,0x48,0x40,0x44,0x0,0x4d,0x0,0x50,0x48,0x48,0x50,0x44,0x0,0x56,0x0,0x50,0x48,0x48,0x50,0x58,0x0,0x56,0x0,0x50,0x5c,0x48,0x60,0x58,0x0,0x63,0x60,0x50,0x68,0x64,0x60,0x58,0x0,0x6d,0x6c,0x6a,0x68,0x64,0x60,0x58,0x0,0x76,0x76,0x76,0x76,0x76,0x76,0x76,0x76,0x76,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3,0x0,0x0,0x8,0x0,0x0,0x0,0x0,0xd,0x0,0xa,0x8,0x0,0x10,0x0,0x0,0x16,0x0,0x16,0x8,0x14,0x10,0x16,0x0,0x16,0x0,0x20,0x1c,0x14,0x20,0x22,0x0,0x23,0x0,0x20,0x28,0x26,0x20,0x22,0x0,0x2d,0x0,0x20,0x28,0x31,0x30,0x31,0x0,0x36,0x0,0x20,0x28,0x3a,0x30,0x3b,0x0,0x36,0x0,0x3a,0x3c,0x3a,0x40,0x44,0x0,0x43,0x0,0x46,0x48,0x48,0x40,0x44,0x0,0x4d,0x0,0x50,0x48,0x48,0x50,0x44,0x0,0x56,0x0,0x50,0x48,0x48,0x50,0x58,0x0,0x56,0x0,0x50,0x5c,0x48,0x60,0x58,0x0,0x63,0x60,0x50,0x68,0x64,0x60,0x58,0x0,0x6d,0x6c,0x6a,0x68,0x64,0x60,0x58,0x0,0x76,0x76,0x76,0x76,0x76,0x76,0x76,0x76,0x76,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0x0,0x0,0x0,0x0,0x0,0xc,0x0,0xa,0x0,0x0,0x10,0x0,0x0,0xc,0x0,0x18,0x0,0x0,0x10,0x0,0x0,0x1c,0x0,0x18,0x0,0x1e,0x10,0x0,0x0,0x1c,0x0,0x18,0x0,0x2a,0x28,0x0,0x0,0x2c,0x0,0x18,0x0,0x2a,0x28,0x0,0x0,0x2c,0x0,0x34,0x0,0x39,0x28,0x0,0x0,0x3c,0x0,0x34,0x0,0x43,0x40,0x0,0x0,0x3c,0x0,0x46,0x0,0x4c,0x40,0x0,0x0,0x4c,0x0,0x51,0x0,0x4c,0x40,0x0,0x0,0x4c,0x0,0x5a,0x0,0x4c,0x58,0x0,0x58,0x5c,0x0,0x5a,0x0,0x60,0x58,0x0,0x64,0x5c,0x0,0x68,0x64,0x60,0x58,0x0,0x6e,0x6c,0x6a,0x68,0x64,0x60,0x58,0x0,0x77,0x77,0x77,0x77,0x77,0x77,0x77,0x77,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xa,0x0,0x0,0x0,0x0,0x0,0xc,0x0,0xa,0x0,0x0,0x10,0x0,0x0,0xc,0x0,0x18,0x0,0x0,0x10,0x0,0x0,0x1c,0x0,0x18,0x0,0x1e,0x10,0x0,0x0,0x1c,0x0,0x18,0x0,0x2a,0x28,0x0,0x0,0x2c,0x0,0x18,0x0,0x2a,0x28,0x0,0x0,0x2c,0x0,0x34,0x0,0x39,0x28,0x0,0x0,0x3c,0x0,0x34,0x0,0x43,0x40,0x0,0x0,0x3c,0x0,0x46,0x0,0x4c,0x40,0x0,0x0,0x4c,0x0,0x51,0x0,0x4c,0x40,0x0,0x0,0x4c,0x0,0x5a,0x0,0x4c,0x58,0x0,0x58,0x5c,0x0,0x5a,0x0,0x60,0x58,0x0,0x64,0x5c,0x0,0x68,0x64,0x60,0x58,0x0,0x6e,0x6c,0x6a,0x68,0x64,0x60,0x58,0x0,0x77,0x77,0x77,0x77,0x77,0x77,0x77,0x77,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x2,0x0,0x6,0x8,0x4,0x0,0x0,0x0,0xe,0xc,0x11,0x8,0x4,0x0,0x0,0x0,0x18,0x18,0x1a,0x18,0x18,0x18,0x18,0x0,0x18,0x18,0x1a,0x18,0x18,0x18,0x18,0x0,0x18,0x18,0x28,0x28,0x18,0x18,0x18,0x0,0x18,0x2c,0x28,0x28,0x18,0x30,0x18,0x0,0x32,0x38,0x28,0x38,0x36,0x30,0x38,0x0,0x3e,0x38,0x28,0x38,0x42,0x30,0x38,0x0,0x48,0x38,0x44,0x48,0x42,0x48,0x38,0x0,0x48,0x4c,0x44,0x48,0x51,0x48,0x38,0x0,0x48,0x58,0x56,0x58,0x5b,0x48,0x58,0x0,0x48,0x58,0x61,0x58,0x64,0x60,0x58,0x0,0x62,0x58,0x6a,0x68,0x64,0x60,0x58,0x0,0x6e,0x6c,0x6a,0x68,0x64,0x60,0x58,0x0,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x2,0x0,0x6,0x8,0x4,0x0,0x0,0x0,0xe,0xc,0x11,0x8,0x4,0x0,0x0,0x0,0x18,0x18,0x1a,0x18,0x18,0x18,0x18,0x0,0x18,0x18,0x1a,0x18,0x18,0x18,0x18,0x0,0x18,0x18,0x28,0x28,0x18,0x18,0x18,0x0,0x18,0x2c,0x28,0x28,0x18,0x30,0x18,0x0,0x32,0x38,0x28,0x38,0x36,0x30,0x38,0x0,0x3e,0x38,0x28,0x38,0x42,0x30,0x38,0x0,0x48,0x38,0x44,0x48,0x42,0x48,0x38,0x0,0x48,0x4c,0x44,0x48,0x51,0x48,0x38,0x0,0x48,0x58,0x56,0x58,0x5b,0x48,0x58,0x0,0x48,0x58,0x61,0x58,0x64,0x60,0x58,0x0,0x62,0x58,0x6a,0x68,0x64,0x60,0x58,0x0,0x6e,0x6c,0x6a,0x68,0x64,0x60,0x58,0x0,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xc,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xc,0x0,0x19,0x8,0x19,0x0,0x0,0x10,0x1e,0x0,0x23,0x20,0x24,0x0,0x0,0x24,0x29,0x0,0x2c,0x20,0x24,0x0,0x0,0x30,0x32,0x0,0x2c,0x20,0x24,0x0,0x0,0x30,0x32,0x0,0x2c,0x38,0x39,0x0,0x0,0x30,0x40,0x0,0x40,0x38,0x44,0x0,0x0,0x44,0x40,0x0,0x40,0x38,0x44,0x0,0x0,0x50,0x40,0x0,0x40,0x50,0x44,0x0,0x0,0x50,0x40,0x0,0x40,0x50,0x59,0x0,0x0,0x50,0x5c,0x0,0x5e,0x50,0x64,0x60,0x0,0x64,0x5c,0x0,0x6a,0x68,0x64,0x60,0x0,0x70,0x6e,0x6c,0x6a,0x68,0x64,0x60,0x0,0x79,0x79,0x79,0x79,0x79,0x79,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xc,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xc,0x0,0x19,0x8,0x19,0x0,0x0,0x10,0x1e,0x0,0x23,0x20,0x24,0x0,0x0,0x24,0x29,0x0,0x2c,0x20,0x24,0x0,0x0,0x30,0x32,0x0,0x2c,0x20,0x24,0x0,0x0,0x30,0x32,0x0,0x2c,0x38,0x39,0x0,0x0,0x30,0x40,0x0,0x40,0x38,0x44,0x0,0x0,0x44,0x40,0x0,0x40,0x38,0x44,0x0,0x0,0x50,0x40,0x0,0x40,0x50,0x44,0x0,0x0,0x50,0x40,0x0,0x40,0x50,0x59,0x0,0x0,0x50,0x5c,0x0,0x5e,0x50,0x64,0x60,0x0,0x64,0x5c,0x0,0x6a,0x68,0x64,0x60,0x0,0x70,0x6e,0x6c,0x6a,0x68,0x64,0x60,0x0,0x79,0x79,0x79,0x79,0x79,0x79,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0xc,0xa,0x0,0x0,0x0,0x4,0x10,0xe,0xc,0xa,0x0,0x0,0x0,0x16,0x10,0x1a,0x1a,0x1a,0x0,0x1a,0x0,0x21,0x20,0x1a,0x24,0x1a,0x0,0x26,0x0,0x2a,0x20,0x29,0x24,0x2a,0x0,0x30,0x0,0x2a,0x30,0x33,0x32,0x2a,0x0,0x30,0x0,0x38,0x30,0x3c,0x3c,0x3a,0x0,0x30,0x0,0x38,0x40,0x3c,0x3c,0x3a,0x0,0x30,0x0,0x38,0x40,0x3c,0x4a,0x4a,0x0,0x4a,0x0,0x38,0x50,0x50,0x54,0x4a,0x0,0x56,0x0,0x54,0x50,0x50,0x54,0x5a,0x0,0x60,0x0,0x54,0x60,0x50,0x62,0x5a,0x0,0x60,0x0,0x66,0x60,0x50,0x6c,0x6a,0x68,0x60,0x0,0x71,0x70,0x6e,0x6c,0x6a,0x68,0x60,0x0,0x7a,0x7a,0x7a,0x7a,0x7a,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0xc,0xa,0x0,0x0,0x0,0x4,0x10,0xe,0xc,0xa,0x0,0x0,0x0,0x16,0x10,0x1a,0x1a,0x1a,0x0,0x1a,0x0,0x21,0x20,0x1a,0x24,0x1a,0x0,0x26,0x0,0x2a,0x20,0x29,0x24,0x2a,0x0,0x30,0x0,0x2a,0x30,0x33,0x32,0x2a,0x0,0x30,0x0,0x38,0x30,0x3c,0x3c,0x3a,0x0,0x30,0x0,0x38,0x40,0x3c,0x3c,0x3a,0x0,0x30,0x0,0x38,0x40,0x3c,0x4a,0x4a,0x0,0x4a,0x0,0x38,0x50,0x50,0x54,0x4a,0x0,0x56,0x0,0x54,0x50,0x50,0x54,0x5a,0x0,0x60,0x0,0x54,0x60,0x50,0x62,0x5a,0x0,0x60,0x0,0x66,0x60,0x50,0x6c,0x6a,0x68,0x60,0x0,0x71,0x70,0x6e,0x6c,0x6a,0x68,0x60,0x0,0x7a,0x7a,0x7a,0x7a,0x7a,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x5,0x0,0x8,0x0,0x0,0x8,0x2,0x0,0xe,0x0,0x8,0x0,0x0,0x8,0x11,0x10,0xe,0x0,0x8,0x14,0x0,0x18,0x1b,0x10,0x1b,0x0,0x8,0x20,0x0,0x18,0x24,0x10,0x25,0x0,0x22,0x20,0x0,0x28,0x24,0x28,0x2e,0x0,0x2e,0x20,0x0,0x28,0x24,0x28,0x2e,0x0,0x38,0x34,0x0,0x38,0x38,0x28,0x3b,0x0,0x38,0x40,0x0,0x38,0x38,0x40,0x45,0x0,0x38,0x40,0x0,0x48,0x38,0x40,0x4e,0x0,0x38,0x40,0x0,0x48,0x38,0x40,0x4e,0x0,0x52,0x54,0x0,0x58,0x56,0x58,0x5b,0x0,0x5e,0x60,0x0,0x58,0x62,0x58,0x65,0x60,0x68,0x60,0x0,0x68,0x62,0x58,0x6e,0x6c,0x68,0x60,0x0,0x72,0x71,0x70,0x6e,0x6c,0x68,0x60,0x0,0x7b,0x7b,0x7b,0x7b,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x5,0x0,0x8,0x0,0x0,0x8,0x2,0x0,0xe,0x0,0x8,0x0,0x0,0x8,0x11,0x10,0xe,0x0,0x8,0x14,0x0,0x18,0x1b,0x10,0x1b,0x0,0x8,0x20,0x0,0x18,0x24,0x10,0x25,0x0,0x22,0x20,0x0,0x28,0x24,0x28,0x2e,0x0,0x2e,0x20,0x0,0x28,0x24,0x28,0x2e,0x0,0x38,0x34,0x0,0x38,0x38,0x28,0x3b,0x0,0x38,0x40,0x0,0x38,0x38,0x40,0x45,0x0,0x38,0x40,0x0,0x48,0x38,0x40,0x4e,0x0,0x38,0x40,0x0,0x48,0x38,0x40,0x4e,0x0,0x52,0x54,0x0,0x58,0x56,0x58,0x5b,0x0,0x5e,0x60,0x0,0x58,0x62,0x58,0x65,0x60,0x68,0x60,0x0,0x68,0x62,0x58,0x6e,0x6c,0x68,0x60,0x0,0x72,0x71,0x70,0x6e,0x6c,0x68,0x60,0x0,0x7b,0x7b,0x7b,0x7b,0x0,0x0,0x0,0x2,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x2,0x0,0x9,0x4,0x0,0x0,0xc,0x8,0x10,0x0,0x13,0x12,0x11,0x0,0xc,0x8,0x10,0x0,0x1c,0x1c,0x1c,0x0,0x1c,0x1c,0x10,0x0,0x1c,0x1c,0x1c,0x0,0x1c,0x28,0x10,0x0,0x1c,0x2a,0x1c,0x0,0x2c,0x28,0x2c,0x0,0x30,0x34,0x31,0x0,0x2c,0x28,0x2c,0x0,0x30,0x34,0x3c,0x0,0x3c,0x3c,0x3e,0x0,0x30,0x42,0x3c,0x0,0x3c,0x48,0x49,0x0,0x30,0x4c,0x3c,0x0,0x4c,0x48,0x52,0x0,0x4e,0x4c,0x51,0x0,0x4c,0x48,0x52,0x0,0x5a,0x5a,0x5c,0x0,0x5c,0x5c,0x60,0x0,0x5a,0x64,0x5c,0x0,0x5c,0x68,0x60,0x0,0x69,0x64,0x5c,0x0,0x6c,0x68,0x60,0x0,0x73,0x72,0x71,0x70,0x6c,0x68,0x60,0x0,0x7c,0x7c,0x7c,0x0,0x0,0x0,0x2,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x2,0x0,0x9,0x4,0x0,0x0,0xc,0x8,0x10,0x0,0x13,0x12,0x11,0x0,0xc,0x8,0x10,0x0,0x1c,0x1c,0x1c,0x0,0x1c,0x1c,0x10,0x0,0x1c,0x1c,0x1c,0x0,0x1c,0x28,0x10,0x0,0x1c,0x2a,0x1c,0x0,0x2c,0x28,0x2c,0x0,0x30,0x34,0x31,0x0,0x2c,0x28,0x2c,0x0,0x30,0x34,0x3c,0x0,0x3c,0x3c,0x3e,0x0,0x30,0x42,0x3c,0x0,0x3c,0x48,0x49,0x0,0x30,0x4c,0x3c,0x0,0x4c,0x48,0x52,0x0,0x4e,0x4c,0x51,0x0,0x4c,0x48,0x52,0x0,0x5a,0x5a,0x5c,0x0,0x5c,0x5c,0x60,0x0,0x5a,0x64,0x5c,0x0,0x5c,0x68,0x60,0x0,0x69,0x64,0x5c,0x0,0x6c,0x68,0x60,0x0,0x73,0x72,0x71,0x70,0x6c,0x68,0x60,0x0,0x7c,0x7c,0x7c,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0xa,0x0,0x0,0xa,0x6,0x0,0x10,0xc,0xa,0x10,0x0,0x14,0x13,0x0,0x10,0x18,0x18,0x10,0x0,0x14,0x1d,0x0,0x10,0x18,0x18,0x20,0x0,0x22,0x26,0x0,0x10,0x18,0x18,0x20,0x0,0x2c,0x26,0x0,0x2a,0x2c,0x18,0x30,0x0,0x2c,0x33,0x0,0x36,0x38,0x34,0x30,0x0,0x3a,0x3d,0x0,0x40,0x38,0x34,0x40,0x0,0x44,0x46,0x0,0x40,0x38,0x46,0x40,0x0,0x44,0x46,0x0,0x40,0x4c,0x51,0x50,0x0,0x52,0x53,0x0,0x40,0x58,0x5a,0x50,0x0,0x5c,0x5d,0x0,0x5a,0x58,0x5a,0x60,0x0,0x5c,0x66,0x60,0x66,0x58,0x68,0x60,0x0,0x6a,0x66,0x60,0x70,0x6c,0x68,0x60,0x0,0x74,0x73,0x72,0x70,0x6c,0x68,0x60,0x0,0x7d,0x7d,0x0,0x0,0x0,0x1,0x0,0x0,0x0,0x6,0x0,0x6,0x0,0xa,0x0,0x0,0xa,0x6,0x0,0x10,0xc,0xa,0x10,0x0,0x14,0x13,0x0,0x10,0x18,0x18,0x10,0x0,0x14,0x1d,0x0,0x10,0x18,0x18,0x20,0x0,0x22,0x26,0x0,0x10,0x18,0x18,0x20,0x0,0x2c,0x26,0x0,0x2a,0x2c,0x18,0x30,0x0,0x2c,0x33,0x0,0x36,0x38,0x34,0x30,0x0,0x3a,0x3d,0x0,0x40,0x38,0x34,0x40,0x0,0x44,0x46,0x0,0x40,0x38,0x46,0x40,0x0,0x44,0x46,0x0,0x40,0x4c,0x51,0x50,0x0,0x52,0x53,0x0,0x40,0x58,0x5a,0x50,0x0,0x5c,0x5d,0x0,0x5a,0x58,0x5a,0x60,0x0,0x5c,0x66,0x60,0x66,0x58,0x68,0x60,0x0,0x6a,0x66,0x60,0x70,0x6c,0x68,0x60,0x0,0x74,0x73,0x72,0x70,0x6c,0x68,0x60,0x0,0x7d,0x7d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0x0,0x0,0xb,0x0,0x0,0x10,0x0,0x8,0x0,0x0,0x15,0x0,0x12,0x10,0x0,0x18,0x0,0x0,0x1e,0x0,0x1e,0x10,0x1c,0x18,0x1e,0x0,0x1e,0x0,0x28,0x24,0x1c,0x28,0x2a,0x0,0x2b,0x0,0x28,0x30,0x2e,0x28,0x2a,0x0,0x35,0x0,0x28,0x30,0x39,0x38,0x39,0x0,0x3e,0x0,0x28,0x30,0x42,0x38,0x43,0x0,0x3e,0x0,0x42,0x44,0x42,0x48,0x4c,0x0,0x4b,0x0,0x4e,0x50,0x50,0x48,0x4c,0x0,0x55,0x0,0x58,0x50,0x50,0x58,0x4c,0x0,0x5e,0x0,0x58,0x50,0x50,0x58,0x60,0x0,0x5e,0x0,0x58,0x64,0x50,0x68,0x60,0x0,0x6b,0x68,0x58,0x70,0x6c,0x68,0x60,0x0,0x75,0x74,0x72,0x70,0x6c,0x68,0x60,0x0,0x7e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0x0,0x0,0xb,0x0,0x0,0x10,0x0,0x8,0x0,0x0,0x15,0x0,0x12,0x10,0x0,0x18,0x0,0x0,0x1e,0x0,0x1e,0x10,0x1c,0x18,0x1e,0x0,0x1e,0x0,0x28,0x24,0x1c,0x28,0x2a,0x0,0x2b,0x0,0x28,0x30,0x2e,0x28,0x2a,0x0,0x35,0x0,0x28,0x30,0x39,0x38,0x39,0x0,0x3e,0x0,0x28,0x30,0x42,0x38,0x43,0x0,0x3e,0x0,0x42,0x44,0x42,0x48,0x4c,0x0,0x4b,0x0,0x4e,0x50,0x50,0x48,0x4c,0x0,0x55,0x0,0x58,0x50,0x50,0x58,0x4c,0x0,0x5e,0x0,0x58,0x50,0x50,0x58,0x60,0x0,0x5e,0x0,0x58,0x64,0x50,0x68,0x60,0x0,0x6b,0x68,0x58,0x70,0x6c,0x68,0x60,0x0,0x75,0x74,0x72,0x70,0x6c,0x68,0x60,0x0,0x7e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x0,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x0,0x0,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x0,0x0,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x0,0x0,0x0,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x0,0x0,0x0,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x0,0x0,0x0,0x0,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x0,0x0,0x0,0x0,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x0,0x0,0x0,0x0,0x0,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x0,0x0,0x0,0x0,0x0,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x0,0x0,0x0,0x0,0x0,0x0,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x0,0x0,0x0,0x0,0x0,0x0,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x0,0x0,0x0,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x0,0x0,0x0,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x0,0x0,0x0,0x0,0x82,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x0,0x0,0x0,0x0,0x82,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x0,0x0,0x0,0x0,0x0,0x83,0x82,0x81,0x80,0x0,0x0,0x0,0x0,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x0,0x0,0x0,0x0,0x0,0x83,0x82,0x81,0x80,0x0,0x0,0x0,0x0,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x0,0x80,0x0,0x0,0x0,0x0,0x84,0x83,0x82,0x80,0x0,0x0,0x0,0x0,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x0,0x80,0x0,0x0,0x0,0x0,0x84,0x83,0x82,0x80,0x0,0x0,0x0,0x0,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x85,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x85,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x86,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x86,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x0,0x86,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x0,0x86,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x86,0x84,0x82,0x80,0x0,0x0,0x0,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x86,0x84,0x82,0x80,0x0,0x0,0x0,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x82,0x80,0x0,0x0,0x89,0x88,0x86,0x84,0x82,0x80,0x0,0x0,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x82,0x80,0x0,0x0,0x89,0x88,0x86,0x84,0x82,0x80,0x0,0x0,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x86,0x84,0x80,0x0,0x0,0x8a,0x89,0x88,0x86,0x84,0x80,0x0,0x0,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x86,0x84,0x80,0x0,0x0,0x8a,0x89,0x88,0x86,0x84,0x80,0x0,0x0,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x0,0x0,0x80,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x8b,0x8a,0x89,0x88,0x84,0x80,0x0,0x0,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x0,0x0,0x80,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x8b,0x8a,0x89,0x88,0x84,0x80,0x0,0x0,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x88,0x84,0x80,0x0,0x0,0x8c,0x8b,0x8a,0x88,0x84,0x80,0x0,0x0,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x88,0x84,0x80,0x0,0x0,0x8c,0x8b,0x8a,0x88,0x84,0x80,0x0,0x0,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x83,0x80,0x0,0x88,0x84,0x80,0x0,0x0,0x8d,0x8c,0x8a,0x88,0x84,0x80,0x0,0x0,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x83,0x80,0x0,0x88,0x84,0x80,0x0,0x0,0x8d,0x8c,0x8a,0x88,0x84,0x80,0x0,0x0,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x88,0x84,0x80,0x0,0x0,0x8e,0x8c,0x8a,0x88,0x84,0x80,0x0,0x0,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x88,0x84,0x80,0x0,0x0,0x8e,0x8c,0x8a,0x88,0x84,0x80,0x0,0x0,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x0,0x0,0x0,0x81,0x0,0x84,0x80,0x0,0x0,0x82,0x0,0x8a,0x88,0x84,0x80,0x0,0x0,0x8e,0x8c,0x8a,0x88,0x84,0x80,0x0,0x0,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x0,0x0,0x0,0x81,0x0,0x84,0x80,0x0,0x0,0x82,0x0,0x8a,0x88,0x84,0x80,0x0,0x0,0x8e,0x8c,0x8a,0x88,0x84,0x80,0x0,0x0,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x84,0x0,0x0,0x8a,0x88,0x84,0x80,0x0,0x90,0x8e,0x8c,0x8a,0x88,0x84,0x80,0x0,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x84,0x0,0x0,0x8a,0x88,0x84,0x80,0x0,0x90,0x8e,0x8c,0x8a,0x88,0x84,0x80,0x0,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x0,0x80,0x0,0x0,0x80,0x0,0x82,0x0,0x0,0x80,0x0,0x86,0x80,0x0,0x8c,0x8a,0x88,0x80,0x0,0x91,0x90,0x8e,0x8c,0x8a,0x88,0x80,0x0,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x0,0x80,0x0,0x0,0x80,0x0,0x82,0x0,0x0,0x80,0x0,0x86,0x80,0x0,0x8c,0x8a,0x88,0x80,0x0,0x91,0x90,0x8e,0x8c,0x8a,0x88,0x80,0x0,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x85,0x80,0x88,0x80,0x0,0x88,0x82,0x0,0x8e,0x8c,0x88,0x80,0x0,0x92,0x91,0x90,0x8e,0x8c,0x88,0x80,0x0,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x85,0x80,0x88,0x80,0x0,0x88,0x82,0x0,0x8e,0x8c,0x88,0x80,0x0,0x92,0x91,0x90,0x8e,0x8c,0x88,0x80,0x0,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x0,0x88,0x80,0x0,0x89,0x84,0x0,0x0,0x8c,0x88,0x80,0x0,0x93,0x92,0x91,0x90,0x8c,0x88,0x80,0x0,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x0,0x88,0x80,0x0,0x89,0x84,0x0,0x0,0x8c,0x88,0x80,0x0,0x93,0x92,0x91,0x90,0x8c,0x88,0x80,0x0,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x80,0x86,0x0,0x88,0x80,0x0,0x8a,0x86,0x80,0x90,0x8c,0x88,0x80,0x0,0x94,0x93,0x92,0x90,0x8c,0x88,0x80,0x0,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x80,0x86,0x0,0x88,0x80,0x0,0x8a,0x86,0x80,0x90,0x8c,0x88,0x80,0x0,0x94,0x93,0x92,0x90,0x8c,0x88,0x80,0x0,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x84,0x0,0x88,0x80,0x0,0x8b,0x88,0x0,0x90,0x8c,0x88,0x80,0x0,0x95,0x94,0x92,0x90,0x8c,0x88,0x80,0x0,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x84,0x0,0x88,0x80,0x0,0x8b,0x88,0x0,0x90,0x8c,0x88,0x80,0x0,0x95,0x94,0x92,0x90,0x8c,0x88,0x80,0x0,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x0,0x0,0x0,0x82,0x0,0x0,0x80,0x0,0x80,0x84,0x0,0x82,0x0,0x88,0x80,0x0,0x8c,0x84,0x0,0x90,0x8c,0x88,0x80,0x0,0x96,0x94,0x92,0x90,0x8c,0x88,0x80,0x0,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x0,0x0,0x0,0x82,0x0,0x0,0x80,0x0,0x80,0x84,0x0,0x82,0x0,0x88,0x80,0x0,0x8c,0x84,0x0,0x90,0x8c,0x88,0x80,0x0,0x96,0x94,0x92,0x90,0x8c,0x88,0x80,0x0,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x0,0x0,0x80,0x0,0x80,0x83,0x0,0x80,0x0,0x0,0x80,0x89,0x80,0x8c,0x88,0x80,0x0,0x8a,0x80,0x92,0x90,0x8c,0x88,0x80,0x0,0x96,0x94,0x92,0x90,0x8c,0x88,0x80,0x0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0x0,0x0,0x80,0x0,0x80,0x83,0x0,0x80,0x0,0x0,0x80,0x89,0x80,0x8c,0x88,0x80,0x0,0x8a,0x80,0x92,0x90,0x8c,0x88,0x80,0x0,0x96,0x94,0x92,0x90,0x8c,0x88,0x80,0x0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x0,0x86,0x0,0x8c,0x88,0x0,0x8c,0x84,0x0,0x92,0x90,0x8c,0x88,0x0,0x98,0x96,0x94,0x92,0x90,0x8c,0x88,0x0,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x0,0x86,0x0,0x8c,0x88,0x0,0x8c,0x84,0x0,0x92,0x90,0x8c,0x88,0x0,0x98,0x96,0x94,0x92,0x90,0x8c,0x88,0x0,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x88,0x0,0x0,0x88,0x0,0x8a,0x82,0x0,0x88,0x0,0x8e,0x88,0x0,0x94,0x92,0x90,0x88,0x0,0x99,0x98,0x96,0x94,0x92,0x90,0x88,0x0,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x88,0x0,0x0,0x88,0x0,0x8a,0x82,0x0,0x88,0x0,0x8e,0x88,0x0,0x94,0x92,0x90,0x88,0x0,0x99,0x98,0x96,0x94,0x92,0x90,0x88,0x0,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x83,0x0,0x86,0x88,0x0,0x80,0x8a,0x80,0x8d,0x88,0x90,0x88,0x0,0x90,0x8a,0x80,0x96,0x94,0x90,0x88,0x0,0x9a,0x99,0x98,0x96,0x94,0x90,0x88,0x0,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x83,0x0,0x86,0x88,0x0,0x80,0x8a,0x80,0x8d,0x88,0x90,0x88,0x0,0x90,0x8a,0x80,0x96,0x94,0x90,0x88,0x0,0x9a,0x99,0x98,0x96,0x94,0x90,0x88,0x0,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x84,0x0,0x84,0x84,0x88,0x0,0x82,0x8c,0x84,0x0,0x84,0x90,0x88,0x0,0x91,0x8c,0x84,0x0,0x94,0x90,0x88,0x0,0x9b,0x9a,0x99,0x98,0x94,0x90,0x88,0x0,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x84,0x0,0x84,0x84,0x88,0x0,0x82,0x8c,0x84,0x0,0x84,0x90,0x88,0x0,0x91,0x8c,0x84,0x0,0x94,0x90,0x88,0x0,0x9b,0x9a,0x99,0x98,0x94,0x90,0x88,0x0,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0x0,0x0,0x80,0x82,0x0,0x0,0x84,0x85,0x0,0x82,0x80,0x82,0x88,0x0,0x84,0x8e,0x88,0x8e,0x80,0x90,0x88,0x0,0x92,0x8e,0x88,0x98,0x94,0x90,0x88,0x0,0x9c,0x9b,0x9a,0x98,0x94,0x90,0x88,0x0,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0x0,0x0,0x80,0x82,0x0,0x0,0x84,0x85,0x0,0x82,0x80,0x82,0x88,0x0,0x84,0x8e,0x88,0x8e,0x80,0x90,0x88,0x0,0x92,0x8e,0x88,0x98,0x94,0x90,0x88,0x0,0x9c,0x9b,0x9a,0x98,0x94,0x90,0x88,0x0,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x80,0x0,0x0,0x80,0x88,0x0,0x86,0x0,0x80,0x8c,0x0,0x90,0x88,0x0,0x93,0x90,0x80,0x98,0x94,0x90,0x88,0x0,0x9d,0x9c,0x9a,0x98,0x94,0x90,0x88,0x0,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x80,0x0,0x0,0x80,0x88,0x0,0x86,0x0,0x80,0x8c,0x0,0x90,0x88,0x0,0x93,0x90,0x80,0x98,0x94,0x90,0x88,0x0,0x9d,0x9c,0x9a,0x98,0x94,0x90,0x88,0x0,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x0,0x0,0x88,0x0,0x88,0x8c,0x0,0x8a,0x0,0x90,0x88,0x0,0x94,0x8c,0x0,0x98,0x94,0x90,0x88,0x0,0x9e,0x9c,0x9a,0x98,0x94,0x90,0x88,0x0,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x0,0x0,0x88,0x0,0x88,0x8c,0x0,0x8a,0x0,0x90,0x88,0x0,0x94,0x8c,0x0,0x98,0x94,0x90,0x88,0x0,0x9e,0x9c,0x9a,0x98,0x94,0x90,0x88,0x0,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x0,0x88,0x86,0x88,0x8b,0x0,0x88,0x0,0x0,0x88,0x91,0x88,0x94,0x90,0x88,0x0,0x92,0x88,0x9a,0x98,0x94,0x90,0x88,0x0,0x9e,0x9c,0x9a,0x98,0x94,0x90,0x88,0x0,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x0,0x88,0x86,0x88,0x8b,0x0,0x88,0x0,0x0,0x88,0x91,0x88,0x94,0x90,0x88,0x0,0x92,0x88,0x9a,0x98,0x94,0x90,0x88,0x0,0x9e,0x9c,0x9a,0x98,0x94,0x90,0x88,0x0,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x89,0x0,0x0,0x80,0x8c,0x0,0x8e,0x80,0x94,0x90,0x0,0x94,0x8c,0x0,0x9a,0x98,0x94,0x90,0x0,0xa0,0x9e,0x9c,0x9a,0x98,0x94,0x90,0x0,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x89,0x0,0x0,0x80,0x8c,0x0,0x8e,0x80,0x94,0x90,0x0,0x94,0x8c,0x0,0x9a,0x98,0x94,0x90,0x0,0xa0,0x9e,0x9c,0x9a,0x98,0x94,0x90,0x0,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0x0,0x0,0x0,0x0,0x80,0x80,0x84,0x0,0x0,0x86,0x0,0x84,0x80,0x80,0x84,0x8a,0x0,0x90,0x0,0x84,0x90,0x80,0x92,0x8a,0x0,0x90,0x0,0x96,0x90,0x80,0x9c,0x9a,0x98,0x90,0x0,0xa1,0xa0,0x9e,0x9c,0x9a,0x98,0x90,0x0,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0x0,0x0,0x0,0x0,0x80,0x80,0x84,0x0,0x0,0x86,0x0,0x84,0x80,0x80,0x84,0x8a,0x0,0x90,0x0,0x84,0x90,0x80,0x92,0x8a,0x0,0x90,0x0,0x96,0x90,0x80,0x9c,0x9a,0x98,0x90,0x0,0xa1,0xa0,0x9e,0x9c,0x9a,0x98,0x90,0x0,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x84,0x0,0x88,0x86,0x88,0x8b,0x0,0x8e,0x90,0x0,0x88,0x92,0x88,0x95,0x90,0x98,0x90,0x0,0x98,0x92,0x88,0x9e,0x9c,0x98,0x90,0x0,0xa2,0xa1,0xa0,0x9e,0x9c,0x98,0x90,0x0,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x84,0x0,0x88,0x86,0x88,0x8b,0x0,0x8e,0x90,0x0,0x88,0x92,0x88,0x95,0x90,0x98,0x90,0x0,0x98,0x92,0x88,0x9e,0x9c,0x98,0x90,0x0,0xa2,0xa1,0xa0,0x9e,0x9c,0x98,0x90,0x0,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x82,0x0,0x8a,0x8a,0x8c,0x0,0x8c,0x8c,0x90,0x0,0x8a,0x94,0x8c,0x0,0x8c,0x98,0x90,0x0,0x99,0x94,0x8c,0x0,0x9c,0x98,0x90,0x0,0xa3,0xa2,0xa1,0xa0,0x9c,0x98,0x90,0x0,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x82,0x0,0x8a,0x8a,0x8c,0x0,0x8c,0x8c,0x90,0x0,0x8a,0x94,0x8c,0x0,0x8c,0x98,0x90,0x0,0x99,0x94,0x8c,0x0,0x9c,0x98,0x90,0x0,0xa3,0xa2,0xa1,0xa0,0x9c,0x98,0x90,0x0,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0x0,0x0,0x0,0x81,0x80,0x0,0x82,0x83,0x0,0x0,0x88,0x8a,0x80,0x0,0x8c,0x8d,0x0,0x8a,0x88,0x8a,0x90,0x0,0x8c,0x96,0x90,0x96,0x88,0x98,0x90,0x0,0x9a,0x96,0x90,0xa0,0x9c,0x98,0x90,0x0,0xa4,0xa3,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0x0,0x0,0x0,0x81,0x80,0x0,0x82,0x83,0x0,0x0,0x88,0x8a,0x80,0x0,0x8c,0x8d,0x0,0x8a,0x88,0x8a,0x90,0x0,0x8c,0x96,0x90,0x96,0x88,0x98,0x90,0x0,0x9a,0x96,0x90,0xa0,0x9c,0x98,0x90,0x0,0xa4,0xa3,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x85,0x0,0x88,0x80,0x80,0x88,0x0,0x0,0x8e,0x0,0x88,0x80,0x80,0x88,0x90,0x0,0x8e,0x0,0x88,0x94,0x80,0x98,0x90,0x0,0x9b,0x98,0x88,0xa0,0x9c,0x98,0x90,0x0,0xa5,0xa4,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x85,0x0,0x88,0x80,0x80,0x88,0x0,0x0,0x8e,0x0,0x88,0x80,0x80,0x88,0x90,0x0,0x8e,0x0,0x88,0x94,0x80,0x98,0x90,0x0,0x9b,0x98,0x88,0xa0,0x9c,0x98,0x90,0x0,0xa5,0xa4,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x84,0x0,0x89,0x0,0x84,0x0,0x0,0x0,0x84,0x0,0x92,0x0,0x84,0x90,0x0,0x90,0x94,0x0,0x92,0x0,0x98,0x90,0x0,0x9c,0x94,0x0,0xa0,0x9c,0x98,0x90,0x0,0xa6,0xa4,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x84,0x0,0x89,0x0,0x84,0x0,0x0,0x0,0x84,0x0,0x92,0x0,0x84,0x90,0x0,0x90,0x94,0x0,0x92,0x0,0x98,0x90,0x0,0x9c,0x94,0x0,0xa0,0x9c,0x98,0x90,0x0,0xa6,0xa4,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0x0,0x80,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x84,0x0,0x80,0x89,0x80,0x0,0x0,0x80,0x90,0x8e,0x90,0x93,0x80,0x90,0x0,0x80,0x90,0x99,0x90,0x9c,0x98,0x90,0x0,0x9a,0x90,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xa6,0xa4,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0x0,0x80,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x84,0x0,0x80,0x89,0x80,0x0,0x0,0x80,0x90,0x8e,0x90,0x93,0x80,0x90,0x0,0x80,0x90,0x99,0x90,0x9c,0x98,0x90,0x0,0x9a,0x90,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xa6,0xa4,0xa2,0xa0,0x9c,0x98,0x90,0x0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0x91,0x0,0x0,0x88,0x94,0x0,0x96,0x88,0x9c,0x98,0x0,0x9c,0x94,0x0,0xa2,0xa0,0x9c,0x98,0x0,0xa8,0xa6,0xa4,0xa2,0xa0,0x9c,0x98,0x0,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0x91,0x0,0x0,0x88,0x94,0x0,0x96,0x88,0x9c,0x98,0x0,0x9c,0x94,0x0,0xa2,0xa0,0x9c,0x98,0x0,0xa8,0xa6,0xa4,0xa2,0xa0,0x9c,0x98,0x0,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x0,0x82,0x0,0x0,0x88,0x88,0x8c,0x82,0x0,0x8e,0x0,0x8c,0x88,0x88,0x8c,0x92,0x0,0x98,0x0,0x8c,0x98,0x88,0x9a,0x92,0x0,0x98,0x0,0x9e,0x98,0x88,0xa4,0xa2,0xa0,0x98,0x0,0xa9,0xa8,0xa6,0xa4,0xa2,0xa0,0x98,0x0,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x0,0x82,0x0,0x0,0x88,0x88,0x8c,0x82,0x0,0x8e,0x0,0x8c,0x88,0x88,0x8c,0x92,0x0,0x98,0x0,0x8c,0x98,0x88,0x9a,0x92,0x0,0x98,0x0,0x9e,0x98,0x88,0xa4,0xa2,0xa0,0x98,0x0,0xa9,0xa8,0xa6,0xa4,0xa2,0xa0,0x98,0x0,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8a,0x8c,0x0,0x90,0x8e,0x90,0x93,0x0,0x96,0x98,0x0,0x90,0x9a,0x90,0x9d,0x98,0xa0,0x98,0x0,0xa0,0x9a,0x90,0xa6,0xa4,0xa0,0x98,0x0,0xaa,0xa9,0xa8,0xa6,0xa4,0xa0,0x98,0x0,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8a,0x8c,0x0,0x90,0x8e,0x90,0x93,0x0,0x96,0x98,0x0,0x90,0x9a,0x90,0x9d,0x98,0xa0,0x98,0x0,0xa0,0x9a,0x90,0xa6,0xa4,0xa0,0x98,0x0,0xaa,0xa9,0xa8,0xa6,0xa4,0xa0,0x98,0x0,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0x0,0x0,0x80,0x81,0x0,0x0,0x84,0x0,0x0,0x84,0x80,0x8a,0x0,0x86,0x84,0x89,0x0,0x84,0x80,0x8a,0x0,0x92,0x92,0x94,0x0,0x94,0x94,0x98,0x0,0x92,0x9c,0x94,0x0,0x94,0xa0,0x98,0x0,0xa1,0x9c,0x94,0x0,0xa4,0xa0,0x98,0x0,0xab,0xaa,0xa9,0xa8,0xa4,0xa0,0x98,0x0,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0x0,0x0,0x80,0x81,0x0,0x0,0x84,0x0,0x0,0x84,0x80,0x8a,0x0,0x86,0x84,0x89,0x0,0x84,0x80,0x8a,0x0,0x92,0x92,0x94,0x0,0x94,0x94,0x98,0x0,0x92,0x9c,0x94,0x0,0x94,0xa0,0x98,0x0,0xa1,0x9c,0x94,0x0,0xa4,0xa0,0x98,0x0,0xab,0xaa,0xa9,0xa8,0xa4,0xa0,0x98,0x0,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x89,0x88,0x0,0x8a,0x8b,0x0,0x0,0x90,0x92,0x88,0x0,0x94,0x95,0x0,0x92,0x90,0x92,0x98,0x0,0x94,0x9e,0x98,0x9e,0x90,0xa0,0x98,0x0,0xa2,0x9e,0x98,0xa8,0xa4,0xa0,0x98,0x0,0xac,0xab,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x89,0x88,0x0,0x8a,0x8b,0x0,0x0,0x90,0x92,0x88,0x0,0x94,0x95,0x0,0x92,0x90,0x92,0x98,0x0,0x94,0x9e,0x98,0x9e,0x90,0xa0,0x98,0x0,0xa2,0x9e,0x98,0xa8,0xa4,0xa0,0x98,0x0,0xac,0xab,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x83,0x0,0x86,0x88,0x88,0x80,0x84,0x0,0x8d,0x0,0x90,0x88,0x88,0x90,0x84,0x0,0x96,0x0,0x90,0x88,0x88,0x90,0x98,0x0,0x96,0x0,0x90,0x9c,0x88,0xa0,0x98,0x0,0xa3,0xa0,0x90,0xa8,0xa4,0xa0,0x98,0x0,0xad,0xac,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x83,0x0,0x86,0x88,0x88,0x80,0x84,0x0,0x8d,0x0,0x90,0x88,0x88,0x90,0x84,0x0,0x96,0x0,0x90,0x88,0x88,0x90,0x98,0x0,0x96,0x0,0x90,0x9c,0x88,0xa0,0x98,0x0,0xa3,0xa0,0x90,0xa8,0xa4,0xa0,0x98,0x0,0xad,0xac,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0x0,0x0,0x0,0x0,0x0,0x83,0x80,0x0,0x0,0x0,0x0,0x86,0x0,0x8c,0x80,0x0,0x0,0x8c,0x0,0x91,0x0,0x8c,0x80,0x0,0x0,0x8c,0x0,0x9a,0x0,0x8c,0x98,0x0,0x98,0x9c,0x0,0x9a,0x0,0xa0,0x98,0x0,0xa4,0x9c,0x0,0xa8,0xa4,0xa0,0x98,0x0,0xae,0xac,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0x0,0x0,0x0,0x0,0x0,0x83,0x80,0x0,0x0,0x0,0x0,0x86,0x0,0x8c,0x80,0x0,0x0,0x8c,0x0,0x91,0x0,0x8c,0x80,0x0,0x0,0x8c,0x0,0x9a,0x0,0x8c,0x98,0x0,0x98,0x9c,0x0,0x9a,0x0,0xa0,0x98,0x0,0xa4,0x9c,0x0,0xa8,0xa4,0xa0,0x98,0x0,0xae,0xac,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x0,0x84,0x88,0x82,0x88,0x0,0x0,0x88,0x8c,0x84,0x88,0x91,0x88,0x0,0x0,0x88,0x98,0x96,0x98,0x9b,0x88,0x98,0x0,0x88,0x98,0xa1,0x98,0xa4,0xa0,0x98,0x0,0xa2,0x98,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xae,0xac,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x0,0x84,0x88,0x82,0x88,0x0,0x0,0x88,0x8c,0x84,0x88,0x91,0x88,0x0,0x0,0x88,0x98,0x96,0x98,0x9b,0x88,0x98,0x0,0x88,0x98,0xa1,0x98,0xa4,0xa0,0x98,0x0,0xa2,0x98,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xae,0xac,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x84,0x0,0x0,0x84,0x80,0x0,0x80,0x0,0x84,0x0,0x0,0x90,0x80,0x0,0x80,0x90,0x84,0x0,0x0,0x90,0x80,0x0,0x80,0x90,0x99,0x0,0x0,0x90,0x9c,0x0,0x9e,0x90,0xa4,0xa0,0x0,0xa4,0x9c,0x0,0xaa,0xa8,0xa4,0xa0,0x0,0xb0,0xae,0xac,0xaa,0xa8,0xa4,0xa0,0x0,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x84,0x0,0x0,0x84,0x80,0x0,0x80,0x0,0x84,0x0,0x0,0x90,0x80,0x0,0x80,0x90,0x84,0x0,0x0,0x90,0x80,0x0,0x80,0x90,0x99,0x0,0x0,0x90,0x9c,0x0,0x9e,0x90,0xa4,0xa0,0x0,0xa4,0x9c,0x0,0xaa,0xa8,0xa4,0xa0,0x0,0xb0,0xae,0xac,0xaa,0xa8,0xa4,0xa0,0x0,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x8a,0x8a,0x0,0x8a,0x0,0x0,0x90,0x90,0x94,0x8a,0x0,0x96,0x0,0x94,0x90,0x90,0x94,0x9a,0x0,0xa0,0x0,0x94,0xa0,0x90,0xa2,0x9a,0x0,0xa0,0x0,0xa6,0xa0,0x90,0xac,0xaa,0xa8,0xa0,0x0,0xb1,0xb0,0xae,0xac,0xaa,0xa8,0xa0,0x0,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x8a,0x8a,0x0,0x8a,0x0,0x0,0x90,0x90,0x94,0x8a,0x0,0x96,0x0,0x94,0x90,0x90,0x94,0x9a,0x0,0xa0,0x0,0x94,0xa0,0x90,0xa2,0x9a,0x0,0xa0,0x0,0xa6,0xa0,0x90,0xac,0xaa,0xa8,0xa0,0x0,0xb1,0xb0,0xae,0xac,0xaa,0xa8,0xa0,0x0,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x85,0x0,0x0,0x80,0x0,0x88,0x0,0x80,0x8e,0x0,0x0,0x80,0x0,0x88,0x0,0x80,0x8e,0x0,0x92,0x94,0x0,0x98,0x96,0x98,0x9b,0x0,0x9e,0xa0,0x0,0x98,0xa2,0x98,0xa5,0xa0,0xa8,0xa0,0x0,0xa8,0xa2,0x98,0xae,0xac,0xa8,0xa0,0x0,0xb2,0xb1,0xb0,0xae,0xac,0xa8,0xa0,0x0,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x85,0x0,0x0,0x80,0x0,0x88,0x0,0x80,0x8e,0x0,0x0,0x80,0x0,0x88,0x0,0x80,0x8e,0x0,0x92,0x94,0x0,0x98,0x96,0x98,0x9b,0x0,0x9e,0xa0,0x0,0x98,0xa2,0x98,0xa5,0xa0,0xa8,0xa0,0x0,0xa8,0xa2,0x98,0xae,0xac,0xa8,0xa0,0x0,0xb2,0xb1,0xb0,0xae,0xac,0xa8,0xa0,0x0,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x89,0x0,0x0,0x8c,0x0,0x0,0x8c,0x88,0x92,0x0,0x8e,0x8c,0x91,0x0,0x8c,0x88,0x92,0x0,0x9a,0x9a,0x9c,0x0,0x9c,0x9c,0xa0,0x0,0x9a,0xa4,0x9c,0x0,0x9c,0xa8,0xa0,0x0,0xa9,0xa4,0x9c,0x0,0xac,0xa8,0xa0,0x0,0xb3,0xb2,0xb1,0xb0,0xac,0xa8,0xa0,0x0,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x89,0x0,0x0,0x8c,0x0,0x0,0x8c,0x88,0x92,0x0,0x8e,0x8c,0x91,0x0,0x8c,0x88,0x92,0x0,0x9a,0x9a,0x9c,0x0,0x9c,0x9c,0xa0,0x0,0x9a,0xa4,0x9c,0x0,0x9c,0xa8,0xa0,0x0,0xa9,0xa4,0x9c,0x0,0xac,0xa8,0xa0,0x0,0xb3,0xb2,0xb1,0xb0,0xac,0xa8,0xa0,0x0,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x86,0x0,0x80,0x0,0x86,0x80,0x0,0x84,0x86,0x0,0x80,0x8c,0x91,0x90,0x0,0x92,0x93,0x0,0x80,0x98,0x9a,0x90,0x0,0x9c,0x9d,0x0,0x9a,0x98,0x9a,0xa0,0x0,0x9c,0xa6,0xa0,0xa6,0x98,0xa8,0xa0,0x0,0xaa,0xa6,0xa0,0xb0,0xac,0xa8,0xa0,0x0,0xb4,0xb3,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x86,0x0,0x80,0x0,0x86,0x80,0x0,0x84,0x86,0x0,0x80,0x8c,0x91,0x90,0x0,0x92,0x93,0x0,0x80,0x98,0x9a,0x90,0x0,0x9c,0x9d,0x0,0x9a,0x98,0x9a,0xa0,0x0,0x9c,0xa6,0xa0,0xa6,0x98,0xa8,0xa0,0x0,0xaa,0xa6,0xa0,0xb0,0xac,0xa8,0xa0,0x0,0xb4,0xb3,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0x0,0x0,0x0,0x82,0x0,0x83,0x0,0x0,0x0,0x82,0x84,0x82,0x88,0x8c,0x0,0x8b,0x0,0x8e,0x90,0x90,0x88,0x8c,0x0,0x95,0x0,0x98,0x90,0x90,0x98,0x8c,0x0,0x9e,0x0,0x98,0x90,0x90,0x98,0xa0,0x0,0x9e,0x0,0x98,0xa4,0x90,0xa8,0xa0,0x0,0xab,0xa8,0x98,0xb0,0xac,0xa8,0xa0,0x0,0xb5,0xb4,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0x0,0x0,0x0,0x82,0x0,0x83,0x0,0x0,0x0,0x82,0x84,0x82,0x88,0x8c,0x0,0x8b,0x0,0x8e,0x90,0x90,0x88,0x8c,0x0,0x95,0x0,0x98,0x90,0x90,0x98,0x8c,0x0,0x9e,0x0,0x98,0x90,0x90,0x98,0xa0,0x0,0x9e,0x0,0x98,0xa4,0x90,0xa8,0xa0,0x0,0xab,0xa8,0x98,0xb0,0xac,0xa8,0xa0,0x0,0xb5,0xb4,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8b,0x88,0x0,0x0,0x84,0x0,0x8e,0x0,0x94,0x88,0x0,0x0,0x94,0x0,0x99,0x0,0x94,0x88,0x0,0x0,0x94,0x0,0xa2,0x0,0x94,0xa0,0x0,0xa0,0xa4,0x0,0xa2,0x0,0xa8,0xa0,0x0,0xac,0xa4,0x0,0xb0,0xac,0xa8,0xa0,0x0,0xb6,0xb4,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8b,0x88,0x0,0x0,0x84,0x0,0x8e,0x0,0x94,0x88,0x0,0x0,0x94,0x0,0x99,0x0,0x94,0x88,0x0,0x0,0x94,0x0,0xa2,0x0,0x94,0xa0,0x0,0xa0,0xa4,0x0,0xa2,0x0,0xa8,0xa0,0x0,0xac,0xa4,0x0,0xb0,0xac,0xa8,0xa0,0x0,0xb6,0xb4,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x86,0x80,0x0,0x80,0x8a,0x0,0x80,0x0,0x90,0x80,0x8c,0x90,0x8a,0x90,0x80,0x0,0x90,0x94,0x8c,0x90,0x99,0x90,0x80,0x0,0x90,0xa0,0x9e,0xa0,0xa3,0x90,0xa0,0x0,0x90,0xa0,0xa9,0xa0,0xac,0xa8,0xa0,0x0,0xaa,0xa0,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xb6,0xb4,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x86,0x80,0x0,0x80,0x8a,0x0,0x80,0x0,0x90,0x80,0x8c,0x90,0x8a,0x90,0x80,0x0,0x90,0x94,0x8c,0x90,0x99,0x90,0x80,0x0,0x90,0xa0,0x9e,0xa0,0xa3,0x90,0xa0,0x0,0x90,0xa0,0xa9,0xa0,0xac,0xa8,0xa0,0x0,0xaa,0xa0,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xb6,0xb4,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x81,0x0,0x0,0x0,0x88,0x0,0x88,0x80,0x8c,0x0,0x0,0x8c,0x88,0x0,0x88,0x80,0x8c,0x0,0x0,0x98,0x88,0x0,0x88,0x98,0x8c,0x0,0x0,0x98,0x88,0x0,0x88,0x98,0xa1,0x0,0x0,0x98,0xa4,0x0,0xa6,0x98,0xac,0xa8,0x0,0xac,0xa4,0x0,0xb2,0xb0,0xac,0xa8,0x0,0xb8,0xb6,0xb4,0xb2,0xb0,0xac,0xa8,0x0,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x81,0x0,0x0,0x0,0x88,0x0,0x88,0x80,0x8c,0x0,0x0,0x8c,0x88,0x0,0x88,0x80,0x8c,0x0,0x0,0x98,0x88,0x0,0x88,0x98,0x8c,0x0,0x0,0x98,0x88,0x0,0x88,0x98,0xa1,0x0,0x0,0x98,0xa4,0x0,0xa6,0x98,0xac,0xa8,0x0,0xac,0xa4,0x0,0xb2,0xb0,0xac,0xa8,0x0,0xb8,0xb6,0xb4,0xb2,0xb0,0xac,0xa8,0x0,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0x0,0x0,0x0,0x80,0x0,0x84,0x84,0x82,0x0,0x0,0x0,0x80,0x88,0x84,0x84,0x82,0x0,0x0,0x0,0x80,0x88,0x84,0x92,0x92,0x0,0x92,0x0,0x80,0x98,0x98,0x9c,0x92,0x0,0x9e,0x0,0x9c,0x98,0x98,0x9c,0xa2,0x0,0xa8,0x0,0x9c,0xa8,0x98,0xaa,0xa2,0x0,0xa8,0x0,0xae,0xa8,0x98,0xb4,0xb2,0xb0,0xa8,0x0,0xb9,0xb8,0xb6,0xb4,0xb2,0xb0,0xa8,0x0,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0x0,0x0,0x0,0x80,0x0,0x84,0x84,0x82,0x0,0x0,0x0,0x80,0x88,0x84,0x84,0x82,0x0,0x0,0x0,0x80,0x88,0x84,0x92,0x92,0x0,0x92,0x0,0x80,0x98,0x98,0x9c,0x92,0x0,0x9e,0x0,0x9c,0x98,0x98,0x9c,0xa2,0x0,0xa8,0x0,0x9c,0xa8,0x98,0xaa,0xa2,0x0,0xa8,0x0,0xae,0xa8,0x98,0xb4,0xb2,0xb0,0xa8,0x0,0xb9,0xb8,0xb6,0xb4,0xb2,0xb0,0xa8,0x0,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0x0,0x80,0x0,0x0,0x80,0x80,0x0,0x83,0x0,0x80,0x88,0x0,0x80,0x80,0x88,0x8d,0x0,0x80,0x88,0x0,0x90,0x80,0x88,0x96,0x0,0x80,0x88,0x0,0x90,0x80,0x88,0x96,0x0,0x9a,0x9c,0x0,0xa0,0x9e,0xa0,0xa3,0x0,0xa6,0xa8,0x0,0xa0,0xaa,0xa0,0xad,0xa8,0xb0,0xa8,0x0,0xb0,0xaa,0xa0,0xb6,0xb4,0xb0,0xa8,0x0,0xba,0xb9,0xb8,0xb6,0xb4,0xb0,0xa8,0x0,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0x0,0x80,0x0,0x0,0x80,0x80,0x0,0x83,0x0,0x80,0x88,0x0,0x80,0x80,0x88,0x8d,0x0,0x80,0x88,0x0,0x90,0x80,0x88,0x96,0x0,0x80,0x88,0x0,0x90,0x80,0x88,0x96,0x0,0x9a,0x9c,0x0,0xa0,0x9e,0xa0,0xa3,0x0,0xa6,0xa8,0x0,0xa0,0xaa,0xa0,0xad,0xa8,0xb0,0xa8,0x0,0xb0,0xaa,0xa0,0xb6,0xb4,0xb0,0xa8,0x0,0xba,0xb9,0xb8,0xb6,0xb4,0xb0,0xa8,0x0,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x84,0x84,0x86,0x0,0x0,0x8a,0x84,0x0,0x84,0x90,0x91,0x0,0x0,0x94,0x84,0x0,0x94,0x90,0x9a,0x0,0x96,0x94,0x99,0x0,0x94,0x90,0x9a,0x0,0xa2,0xa2,0xa4,0x0,0xa4,0xa4,0xa8,0x0,0xa2,0xac,0xa4,0x0,0xa4,0xb0,0xa8,0x0,0xb1,0xac,0xa4,0x0,0xb4,0xb0,0xa8,0x0,0xbb,0xba,0xb9,0xb8,0xb4,0xb0,0xa8,0x0,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x84,0x84,0x86,0x0,0x0,0x8a,0x84,0x0,0x84,0x90,0x91,0x0,0x0,0x94,0x84,0x0,0x94,0x90,0x9a,0x0,0x96,0x94,0x99,0x0,0x94,0x90,0x9a,0x0,0xa2,0xa2,0xa4,0x0,0xa4,0xa4,0xa8,0x0,0xa2,0xac,0xa4,0x0,0xa4,0xb0,0xa8,0x0,0xb1,0xac,0xa4,0x0,0xb4,0xb0,0xa8,0x0,0xbb,0xba,0xb9,0xb8,0xb4,0xb0,0xa8,0x0,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0x0,0x0,0x80,0x0,0x0,0x0,0x82,0x85,0x0,0x88,0x80,0x0,0x88,0x0,0x8c,0x8e,0x0,0x88,0x80,0x8e,0x88,0x0,0x8c,0x8e,0x0,0x88,0x94,0x99,0x98,0x0,0x9a,0x9b,0x0,0x88,0xa0,0xa2,0x98,0x0,0xa4,0xa5,0x0,0xa2,0xa0,0xa2,0xa8,0x0,0xa4,0xae,0xa8,0xae,0xa0,0xb0,0xa8,0x0,0xb2,0xae,0xa8,0xb8,0xb4,0xb0,0xa8,0x0,0xbc,0xbb,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0x0,0x0,0x80,0x0,0x0,0x0,0x82,0x85,0x0,0x88,0x80,0x0,0x88,0x0,0x8c,0x8e,0x0,0x88,0x80,0x8e,0x88,0x0,0x8c,0x8e,0x0,0x88,0x94,0x99,0x98,0x0,0x9a,0x9b,0x0,0x88,0xa0,0xa2,0x98,0x0,0xa4,0xa5,0x0,0xa2,0xa0,0xa2,0xa8,0x0,0xa4,0xae,0xa8,0xae,0xa0,0xb0,0xa8,0x0,0xb2,0xae,0xa8,0xb8,0xb4,0xb0,0xa8,0x0,0xbc,0xbb,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0x0,0x0,0x0,0x81,0x80,0x81,0x0,0x86,0x0,0x0,0x0,0x8a,0x80,0x8b,0x0,0x86,0x0,0x8a,0x8c,0x8a,0x90,0x94,0x0,0x93,0x0,0x96,0x98,0x98,0x90,0x94,0x0,0x9d,0x0,0xa0,0x98,0x98,0xa0,0x94,0x0,0xa6,0x0,0xa0,0x98,0x98,0xa0,0xa8,0x0,0xa6,0x0,0xa0,0xac,0x98,0xb0,0xa8,0x0,0xb3,0xb0,0xa0,0xb8,0xb4,0xb0,0xa8,0x0,0xbd,0xbc,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0x0,0x0,0x0,0x81,0x80,0x81,0x0,0x86,0x0,0x0,0x0,0x8a,0x80,0x8b,0x0,0x86,0x0,0x8a,0x8c,0x8a,0x90,0x94,0x0,0x93,0x0,0x96,0x98,0x98,0x90,0x94,0x0,0x9d,0x0,0xa0,0x98,0x98,0xa0,0x94,0x0,0xa6,0x0,0xa0,0x98,0x98,0xa0,0xa8,0x0,0xa6,0x0,0xa0,0xac,0x98,0xb0,0xa8,0x0,0xb3,0xb0,0xa0,0xb8,0xb4,0xb0,0xa8,0x0,0xbd,0xbc,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x89,0x0,0x0,0x0,0x8c,0x0,0x84,0x0,0x93,0x90,0x0,0x0,0x8c,0x0,0x96,0x0,0x9c,0x90,0x0,0x0,0x9c,0x0,0xa1,0x0,0x9c,0x90,0x0,0x0,0x9c,0x0,0xaa,0x0,0x9c,0xa8,0x0,0xa8,0xac,0x0,0xaa,0x0,0xb0,0xa8,0x0,0xb4,0xac,0x0,0xb8,0xb4,0xb0,0xa8,0x0,0xbe,0xbc,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x89,0x0,0x0,0x0,0x8c,0x0,0x84,0x0,0x93,0x90,0x0,0x0,0x8c,0x0,0x96,0x0,0x9c,0x90,0x0,0x0,0x9c,0x0,0xa1,0x0,0x9c,0x90,0x0,0x0,0x9c,0x0,0xaa,0x0,0x9c,0xa8,0x0,0xa8,0xac,0x0,0xaa,0x0,0xb0,0xa8,0x0,0xb4,0xac,0x0,0xb8,0xb4,0xb0,0xa8,0x0,0xbe,0xbc,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x88,0x0,0x88,0x86,0x80,0x88,0x0,0x8e,0x88,0x0,0x88,0x92,0x80,0x88,0x0,0x98,0x88,0x94,0x98,0x92,0x98,0x88,0x0,0x98,0x9c,0x94,0x98,0xa1,0x98,0x88,0x0,0x98,0xa8,0xa6,0xa8,0xab,0x98,0xa8,0x0,0x98,0xa8,0xb1,0xa8,0xb4,0xb0,0xa8,0x0,0xb2,0xa8,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xbe,0xbc,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x88,0x0,0x88,0x86,0x80,0x88,0x0,0x8e,0x88,0x0,0x88,0x92,0x80,0x88,0x0,0x98,0x88,0x94,0x98,0x92,0x98,0x88,0x0,0x98,0x9c,0x94,0x98,0xa1,0x98,0x88,0x0,0x98,0xa8,0xa6,0xa8,0xab,0x98,0xa8,0x0,0x98,0xa8,0xb1,0xa8,0xb4,0xb0,0xa8,0x0,0xb2,0xa8,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xbe,0xbc,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0x0,0x0,0x80,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x0,0x88,0x89,0x0,0x0,0x80,0x90,0x0,0x90,0x88,0x94,0x0,0x0,0x94,0x90,0x0,0x90,0x88,0x94,0x0,0x0,0xa0,0x90,0x0,0x90,0xa0,0x94,0x0,0x0,0xa0,0x90,0x0,0x90,0xa0,0xa9,0x0,0x0,0xa0,0xac,0x0,0xae,0xa0,0xb4,0xb0,0x0,0xb4,0xac,0x0,0xba,0xb8,0xb4,0xb0,0x0,0xc0,0xbe,0xbc,0xba,0xb8,0xb4,0xb0,0x0,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0x0,0x0,0x80,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x0,0x88,0x89,0x0,0x0,0x80,0x90,0x0,0x90,0x88,0x94,0x0,0x0,0x94,0x90,0x0,0x90,0x88,0x94,0x0,0x0,0xa0,0x90,0x0,0x90,0xa0,0x94,0x0,0x0,0xa0,0x90,0x0,0x90,0xa0,0xa9,0x0,0x0,0xa0,0xac,0x0,0xae,0xa0,0xb4,0xb0,0x0,0xb4,0xac,0x0,0xba,0xb8,0xb4,0xb0,0x0,0xc0,0xbe,0xbc,0xba,0xb8,0xb4,0xb0,0x0,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0x0,0x80,0x0,0x0,0x80,0x83,0x82,0x0,0x0,0x80,0x0,0x88,0x80,0x8c,0x8c,0x8a,0x0,0x80,0x0,0x88,0x90,0x8c,0x8c,0x8a,0x0,0x80,0x0,0x88,0x90,0x8c,0x9a,0x9a,0x0,0x9a,0x0,0x88,0xa0,0xa0,0xa4,0x9a,0x0,0xa6,0x0,0xa4,0xa0,0xa0,0xa4,0xaa,0x0,0xb0,0x0,0xa4,0xb0,0xa0,0xb2,0xaa,0x0,0xb0,0x0,0xb6,0xb0,0xa0,0xbc,0xba,0xb8,0xb0,0x0,0xc1,0xc0,0xbe,0xbc,0xba,0xb8,0xb0,0x0,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0x0,0x80,0x0,0x0,0x80,0x83,0x82,0x0,0x0,0x80,0x0,0x88,0x80,0x8c,0x8c,0x8a,0x0,0x80,0x0,0x88,0x90,0x8c,0x8c,0x8a,0x0,0x80,0x0,0x88,0x90,0x8c,0x9a,0x9a,0x0,0x9a,0x0,0x88,0xa0,0xa0,0xa4,0x9a,0x0,0xa6,0x0,0xa4,0xa0,0xa0,0xa4,0xaa,0x0,0xb0,0x0,0xa4,0xb0,0xa0,0xb2,0xaa,0x0,0xb0,0x0,0xb6,0xb0,0xa0,0xbc,0xba,0xb8,0xb0,0x0,0xc1,0xc0,0xbe,0xbc,0xba,0xb8,0xb0,0x0,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x84,0x0,0x88,0x88,0x0,0x8b,0x0,0x88,0x90,0x0,0x88,0x88,0x90,0x95,0x0,0x88,0x90,0x0,0x98,0x88,0x90,0x9e,0x0,0x88,0x90,0x0,0x98,0x88,0x90,0x9e,0x0,0xa2,0xa4,0x0,0xa8,0xa6,0xa8,0xab,0x0,0xae,0xb0,0x0,0xa8,0xb2,0xa8,0xb5,0xb0,0xb8,0xb0,0x0,0xb8,0xb2,0xa8,0xbe,0xbc,0xb8,0xb0,0x0,0xc2,0xc1,0xc0,0xbe,0xbc,0xb8,0xb0,0x0,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x84,0x0,0x88,0x88,0x0,0x8b,0x0,0x88,0x90,0x0,0x88,0x88,0x90,0x95,0x0,0x88,0x90,0x0,0x98,0x88,0x90,0x9e,0x0,0x88,0x90,0x0,0x98,0x88,0x90,0x9e,0x0,0xa2,0xa4,0x0,0xa8,0xa6,0xa8,0xab,0x0,0xae,0xb0,0x0,0xa8,0xb2,0xa8,0xb5,0xb0,0xb8,0xb0,0x0,0xb8,0xb2,0xa8,0xbe,0xbc,0xb8,0xb0,0x0,0xc2,0xc1,0xc0,0xbe,0xbc,0xb8,0xb0,0x0,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x81,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x8c,0x0,0x8c,0x8c,0x8e,0x0,0x80,0x92,0x8c,0x0,0x8c,0x98,0x99,0x0,0x80,0x9c,0x8c,0x0,0x9c,0x98,0xa2,0x0,0x9e,0x9c,0xa1,0x0,0x9c,0x98,0xa2,0x0,0xaa,0xaa,0xac,0x0,0xac,0xac,0xb0,0x0,0xaa,0xb4,0xac,0x0,0xac,0xb8,0xb0,0x0,0xb9,0xb4,0xac,0x0,0xbc,0xb8,0xb0,0x0,0xc3,0xc2,0xc1,0xc0,0xbc,0xb8,0xb0,0x0,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x81,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x8c,0x0,0x8c,0x8c,0x8e,0x0,0x80,0x92,0x8c,0x0,0x8c,0x98,0x99,0x0,0x80,0x9c,0x8c,0x0,0x9c,0x98,0xa2,0x0,0x9e,0x9c,0xa1,0x0,0x9c,0x98,0xa2,0x0,0xaa,0xaa,0xac,0x0,0xac,0xac,0xb0,0x0,0xaa,0xb4,0xac,0x0,0xac,0xb8,0xb0,0x0,0xb9,0xb4,0xac,0x0,0xbc,0xb8,0xb0,0x0,0xc3,0xc2,0xc1,0xc0,0xbc,0xb8,0xb0,0x0,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x83,0x0,0x86,0x88,0x84,0x80,0x0,0x8a,0x8d,0x0,0x90,0x88,0x84,0x90,0x0,0x94,0x96,0x0,0x90,0x88,0x96,0x90,0x0,0x94,0x96,0x0,0x90,0x9c,0xa1,0xa0,0x0,0xa2,0xa3,0x0,0x90,0xa8,0xaa,0xa0,0x0,0xac,0xad,0x0,0xaa,0xa8,0xaa,0xb0,0x0,0xac,0xb6,0xb0,0xb6,0xa8,0xb8,0xb0,0x0,0xba,0xb6,0xb0,0xc0,0xbc,0xb8,0xb0,0x0,0xc4,0xc3,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x83,0x0,0x86,0x88,0x84,0x80,0x0,0x8a,0x8d,0x0,0x90,0x88,0x84,0x90,0x0,0x94,0x96,0x0,0x90,0x88,0x96,0x90,0x0,0x94,0x96,0x0,0x90,0x9c,0xa1,0xa0,0x0,0xa2,0xa3,0x0,0x90,0xa8,0xaa,0xa0,0x0,0xac,0xad,0x0,0xaa,0xa8,0xaa,0xb0,0x0,0xac,0xb6,0xb0,0xb6,0xa8,0xb8,0xb0,0x0,0xba,0xb6,0xb0,0xc0,0xbc,0xb8,0xb0,0x0,0xc4,0xc3,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x85,0x0,0x0,0x80,0x89,0x88,0x89,0x0,0x8e,0x0,0x0,0x80,0x92,0x88,0x93,0x0,0x8e,0x0,0x92,0x94,0x92,0x98,0x9c,0x0,0x9b,0x0,0x9e,0xa0,0xa0,0x98,0x9c,0x0,0xa5,0x0,0xa8,0xa0,0xa0,0xa8,0x9c,0x0,0xae,0x0,0xa8,0xa0,0xa0,0xa8,0xb0,0x0,0xae,0x0,0xa8,0xb4,0xa0,0xb8,0xb0,0x0,0xbb,0xb8,0xa8,0xc0,0xbc,0xb8,0xb0,0x0,0xc5,0xc4,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x85,0x0,0x0,0x80,0x89,0x88,0x89,0x0,0x8e,0x0,0x0,0x80,0x92,0x88,0x93,0x0,0x8e,0x0,0x92,0x94,0x92,0x98,0x9c,0x0,0x9b,0x0,0x9e,0xa0,0xa0,0x98,0x9c,0x0,0xa5,0x0,0xa8,0xa0,0xa0,0xa8,0x9c,0x0,0xae,0x0,0xa8,0xa0,0xa0,0xa8,0xb0,0x0,0xae,0x0,0xa8,0xb4,0xa0,0xb8,0xb0,0x0,0xbb,0xb8,0xa8,0xc0,0xbc,0xb8,0xb0,0x0,0xc5,0xc4,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x84,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x84,0x0,0x8c,0x0,0x91,0x80,0x0,0x0,0x94,0x0,0x8c,0x0,0x9b,0x98,0x0,0x0,0x94,0x0,0x9e,0x0,0xa4,0x98,0x0,0x0,0xa4,0x0,0xa9,0x0,0xa4,0x98,0x0,0x0,0xa4,0x0,0xb2,0x0,0xa4,0xb0,0x0,0xb0,0xb4,0x0,0xb2,0x0,0xb8,0xb0,0x0,0xbc,0xb4,0x0,0xc0,0xbc,0xb8,0xb0,0x0,0xc6,0xc4,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x84,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x84,0x0,0x8c,0x0,0x91,0x80,0x0,0x0,0x94,0x0,0x8c,0x0,0x9b,0x98,0x0,0x0,0x94,0x0,0x9e,0x0,0xa4,0x98,0x0,0x0,0xa4,0x0,0xa9,0x0,0xa4,0x98,0x0,0x0,0xa4,0x0,0xb2,0x0,0xa4,0xb0,0x0,0xb0,0xb4,0x0,0xb2,0x0,0xb8,0xb0,0x0,0xbc,0xb4,0x0,0xc0,0xbc,0xb8,0xb0,0x0,0xc6,0xc4,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x80,0x0,0x88,0x0,0x0,0x8a,0x90,0x80,0x90,0x8e,0x88,0x90,0x0,0x96,0x90,0x80,0x90,0x9a,0x88,0x90,0x0,0xa0,0x90,0x9c,0xa0,0x9a,0xa0,0x90,0x0,0xa0,0xa4,0x9c,0xa0,0xa9,0xa0,0x90,0x0,0xa0,0xb0,0xae,0xb0,0xb3,0xa0,0xb0,0x0,0xa0,0xb0,0xb9,0xb0,0xbc,0xb8,0xb0,0x0,0xba,0xb0,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xc6,0xc4,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x80,0x0,0x88,0x0,0x0,0x8a,0x90,0x80,0x90,0x8e,0x88,0x90,0x0,0x96,0x90,0x80,0x90,0x9a,0x88,0x90,0x0,0xa0,0x90,0x9c,0xa0,0x9a,0xa0,0x90,0x0,0xa0,0xa4,0x9c,0xa0,0xa9,0xa0,0x90,0x0,0xa0,0xb0,0xae,0xb0,0xb3,0xa0,0xb0,0x0,0xa0,0xb0,0xb9,0xb0,0xbc,0xb8,0xb0,0x0,0xba,0xb0,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xc6,0xc4,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0x0,0x0,0x0,0x81,0x0,0x84,0x0,0x0,0x0,0x0,0x88,0x8a,0x0,0x84,0x0,0x0,0x0,0x0,0x88,0x8a,0x0,0x84,0x90,0x91,0x0,0x0,0x88,0x98,0x0,0x98,0x90,0x9c,0x0,0x0,0x9c,0x98,0x0,0x98,0x90,0x9c,0x0,0x0,0xa8,0x98,0x0,0x98,0xa8,0x9c,0x0,0x0,0xa8,0x98,0x0,0x98,0xa8,0xb1,0x0,0x0,0xa8,0xb4,0x0,0xb6,0xa8,0xbc,0xb8,0x0,0xbc,0xb4,0x0,0xc2,0xc0,0xbc,0xb8,0x0,0xc8,0xc6,0xc4,0xc2,0xc0,0xbc,0xb8,0x0,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0x0,0x0,0x0,0x81,0x0,0x84,0x0,0x0,0x0,0x0,0x88,0x8a,0x0,0x84,0x0,0x0,0x0,0x0,0x88,0x8a,0x0,0x84,0x90,0x91,0x0,0x0,0x88,0x98,0x0,0x98,0x90,0x9c,0x0,0x0,0x9c,0x98,0x0,0x98,0x90,0x9c,0x0,0x0,0xa8,0x98,0x0,0x98,0xa8,0x9c,0x0,0x0,0xa8,0x98,0x0,0x98,0xa8,0xb1,0x0,0x0,0xa8,0xb4,0x0,0xb6,0xa8,0xbc,0xb8,0x0,0xbc,0xb4,0x0,0xc2,0xc0,0xbc,0xb8,0x0,0xc8,0xc6,0xc4,0xc2,0xc0,0xbc,0xb8,0x0,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0x0,0x0,0x0,0x82,0x0,0x81,0x0,0x82,0x0,0x88,0x0,0x82,0x88,0x8b,0x8a,0x82,0x0,0x88,0x0,0x90,0x88,0x94,0x94,0x92,0x0,0x88,0x0,0x90,0x98,0x94,0x94,0x92,0x0,0x88,0x0,0x90,0x98,0x94,0xa2,0xa2,0x0,0xa2,0x0,0x90,0xa8,0xa8,0xac,0xa2,0x0,0xae,0x0,0xac,0xa8,0xa8,0xac,0xb2,0x0,0xb8,0x0,0xac,0xb8,0xa8,0xba,0xb2,0x0,0xb8,0x0,0xbe,0xb8,0xa8,0xc4,0xc2,0xc0,0xb8,0x0,0xc9,0xc8,0xc6,0xc4,0xc2,0xc0,0xb8,0x0,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0x0,0x0,0x0,0x82,0x0,0x81,0x0,0x82,0x0,0x88,0x0,0x82,0x88,0x8b,0x8a,0x82,0x0,0x88,0x0,0x90,0x88,0x94,0x94,0x92,0x0,0x88,0x0,0x90,0x98,0x94,0x94,0x92,0x0,0x88,0x0,0x90,0x98,0x94,0xa2,0xa2,0x0,0xa2,0x0,0x90,0xa8,0xa8,0xac,0xa2,0x0,0xae,0x0,0xac,0xa8,0xa8,0xac,0xb2,0x0,0xb8,0x0,0xac,0xb8,0xa8,0xba,0xb2,0x0,0xb8,0x0,0xbe,0xb8,0xa8,0xc4,0xc2,0xc0,0xb8,0x0,0xc9,0xc8,0xc6,0xc4,0xc2,0xc0,0xb8,0x0,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x86,0x0,0x86,0x0,0x0,0x80,0x0,0x80,0x86,0x0,0x90,0x8c,0x0,0x90,0x90,0x80,0x93,0x0,0x90,0x98,0x0,0x90,0x90,0x98,0x9d,0x0,0x90,0x98,0x0,0xa0,0x90,0x98,0xa6,0x0,0x90,0x98,0x0,0xa0,0x90,0x98,0xa6,0x0,0xaa,0xac,0x0,0xb0,0xae,0xb0,0xb3,0x0,0xb6,0xb8,0x0,0xb0,0xba,0xb0,0xbd,0xb8,0xc0,0xb8,0x0,0xc0,0xba,0xb0,0xc6,0xc4,0xc0,0xb8,0x0,0xca,0xc9,0xc8,0xc6,0xc4,0xc0,0xb8,0x0,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x86,0x0,0x86,0x0,0x0,0x80,0x0,0x80,0x86,0x0,0x90,0x8c,0x0,0x90,0x90,0x80,0x93,0x0,0x90,0x98,0x0,0x90,0x90,0x98,0x9d,0x0,0x90,0x98,0x0,0xa0,0x90,0x98,0xa6,0x0,0x90,0x98,0x0,0xa0,0x90,0x98,0xa6,0x0,0xaa,0xac,0x0,0xb0,0xae,0xb0,0xb3,0x0,0xb6,0xb8,0x0,0xb0,0xba,0xb0,0xbd,0xb8,0xc0,0xb8,0x0,0xc0,0xba,0xb0,0xc6,0xc4,0xc0,0xb8,0x0,0xca,0xc9,0xc8,0xc6,0xc4,0xc0,0xb8,0x0,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0x0,0x0,0x80,0x0,0x0,0x0,0x82,0x0,0x0,0x84,0x80,0x84,0x0,0x88,0x8c,0x89,0x0,0x84,0x80,0x84,0x0,0x88,0x8c,0x94,0x0,0x94,0x94,0x96,0x0,0x88,0x9a,0x94,0x0,0x94,0xa0,0xa1,0x0,0x88,0xa4,0x94,0x0,0xa4,0xa0,0xaa,0x0,0xa6,0xa4,0xa9,0x0,0xa4,0xa0,0xaa,0x0,0xb2,0xb2,0xb4,0x0,0xb4,0xb4,0xb8,0x0,0xb2,0xbc,0xb4,0x0,0xb4,0xc0,0xb8,0x0,0xc1,0xbc,0xb4,0x0,0xc4,0xc0,0xb8,0x0,0xcb,0xca,0xc9,0xc8,0xc4,0xc0,0xb8,0x0,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0x0,0x0,0x80,0x0,0x0,0x0,0x82,0x0,0x0,0x84,0x80,0x84,0x0,0x88,0x8c,0x89,0x0,0x84,0x80,0x84,0x0,0x88,0x8c,0x94,0x0,0x94,0x94,0x96,0x0,0x88,0x9a,0x94,0x0,0x94,0xa0,0xa1,0x0,0x88,0xa4,0x94,0x0,0xa4,0xa0,0xaa,0x0,0xa6,0xa4,0xa9,0x0,0xa4,0xa0,0xaa,0x0,0xb2,0xb2,0xb4,0x0,0xb4,0xb4,0xb8,0x0,0xb2,0xbc,0xb4,0x0,0xb4,0xc0,0xb8,0x0,0xc1,0xbc,0xb4,0x0,0xc4,0xc0,0xb8,0x0,0xcb,0xca,0xc9,0xc8,0xc4,0xc0,0xb8,0x0,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x82,0x84,0x0,0x88,0x0,0x84,0x8b,0x0,0x8e,0x90,0x8c,0x88,0x0,0x92,0x95,0x0,0x98,0x90,0x8c,0x98,0x0,0x9c,0x9e,0x0,0x98,0x90,0x9e,0x98,0x0,0x9c,0x9e,0x0,0x98,0xa4,0xa9,0xa8,0x0,0xaa,0xab,0x0,0x98,0xb0,0xb2,0xa8,0x0,0xb4,0xb5,0x0,0xb2,0xb0,0xb2,0xb8,0x0,0xb4,0xbe,0xb8,0xbe,0xb0,0xc0,0xb8,0x0,0xc2,0xbe,0xb8,0xc8,0xc4,0xc0,0xb8,0x0,0xcc,0xcb,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x82,0x84,0x0,0x88,0x0,0x84,0x8b,0x0,0x8e,0x90,0x8c,0x88,0x0,0x92,0x95,0x0,0x98,0x90,0x8c,0x98,0x0,0x9c,0x9e,0x0,0x98,0x90,0x9e,0x98,0x0,0x9c,0x9e,0x0,0x98,0xa4,0xa9,0xa8,0x0,0xaa,0xab,0x0,0x98,0xb0,0xb2,0xa8,0x0,0xb4,0xb5,0x0,0xb2,0xb0,0xb2,0xb8,0x0,0xb4,0xbe,0xb8,0xbe,0xb0,0xc0,0xb8,0x0,0xc2,0xbe,0xb8,0xc8,0xc4,0xc0,0xb8,0x0,0xcc,0xcb,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x83,0x0,0x80,0x88,0x86,0x80,0x82,0x0,0x8d,0x0,0x80,0x88,0x91,0x90,0x91,0x0,0x96,0x0,0x80,0x88,0x9a,0x90,0x9b,0x0,0x96,0x0,0x9a,0x9c,0x9a,0xa0,0xa4,0x0,0xa3,0x0,0xa6,0xa8,0xa8,0xa0,0xa4,0x0,0xad,0x0,0xb0,0xa8,0xa8,0xb0,0xa4,0x0,0xb6,0x0,0xb0,0xa8,0xa8,0xb0,0xb8,0x0,0xb6,0x0,0xb0,0xbc,0xa8,0xc0,0xb8,0x0,0xc3,0xc0,0xb0,0xc8,0xc4,0xc0,0xb8,0x0,0xcd,0xcc,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x83,0x0,0x80,0x88,0x86,0x80,0x82,0x0,0x8d,0x0,0x80,0x88,0x91,0x90,0x91,0x0,0x96,0x0,0x80,0x88,0x9a,0x90,0x9b,0x0,0x96,0x0,0x9a,0x9c,0x9a,0xa0,0xa4,0x0,0xa3,0x0,0xa6,0xa8,0xa8,0xa0,0xa4,0x0,0xad,0x0,0xb0,0xa8,0xa8,0xb0,0xa4,0x0,0xb6,0x0,0xb0,0xa8,0xa8,0xb0,0xb8,0x0,0xb6,0x0,0xb0,0xbc,0xa8,0xc0,0xb8,0x0,0xc3,0xc0,0xb0,0xc8,0xc4,0xc0,0xb8,0x0,0xcd,0xcc,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x88,0x0,0x0,0x8c,0x0,0x0,0x0,0x8a,0x88,0x0,0x0,0x8c,0x0,0x94,0x0,0x99,0x88,0x0,0x0,0x9c,0x0,0x94,0x0,0xa3,0xa0,0x0,0x0,0x9c,0x0,0xa6,0x0,0xac,0xa0,0x0,0x0,0xac,0x0,0xb1,0x0,0xac,0xa0,0x0,0x0,0xac,0x0,0xba,0x0,0xac,0xb8,0x0,0xb8,0xbc,0x0,0xba,0x0,0xc0,0xb8,0x0,0xc4,0xbc,0x0,0xc8,0xc4,0xc0,0xb8,0x0,0xce,0xcc,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x88,0x0,0x0,0x8c,0x0,0x0,0x0,0x8a,0x88,0x0,0x0,0x8c,0x0,0x94,0x0,0x99,0x88,0x0,0x0,0x9c,0x0,0x94,0x0,0xa3,0xa0,0x0,0x0,0x9c,0x0,0xa6,0x0,0xac,0xa0,0x0,0x0,0xac,0x0,0xb1,0x0,0xac,0xa0,0x0,0x0,0xac,0x0,0xba,0x0,0xac,0xb8,0x0,0xb8,0xbc,0x0,0xba,0x0,0xc0,0xb8,0x0,0xc4,0xbc,0x0,0xc8,0xc4,0xc0,0xb8,0x0,0xce,0xcc,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x88,0x0,0x0,0x0,0x0,0x0,0x8c,0x88,0x88,0x0,0x90,0x0,0x0,0x92,0x98,0x88,0x98,0x96,0x90,0x98,0x0,0x9e,0x98,0x88,0x98,0xa2,0x90,0x98,0x0,0xa8,0x98,0xa4,0xa8,0xa2,0xa8,0x98,0x0,0xa8,0xac,0xa4,0xa8,0xb1,0xa8,0x98,0x0,0xa8,0xb8,0xb6,0xb8,0xbb,0xa8,0xb8,0x0,0xa8,0xb8,0xc1,0xb8,0xc4,0xc0,0xb8,0x0,0xc2,0xb8,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xce,0xcc,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x88,0x0,0x0,0x0,0x0,0x0,0x8c,0x88,0x88,0x0,0x90,0x0,0x0,0x92,0x98,0x88,0x98,0x96,0x90,0x98,0x0,0x9e,0x98,0x88,0x98,0xa2,0x90,0x98,0x0,0xa8,0x98,0xa4,0xa8,0xa2,0xa8,0x98,0x0,0xa8,0xac,0xa4,0xa8,0xb1,0xa8,0x98,0x0,0xa8,0xb8,0xb6,0xb8,0xbb,0xa8,0xb8,0x0,0xa8,0xb8,0xc1,0xb8,0xc4,0xc0,0xb8,0x0,0xc2,0xb8,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xce,0xcc,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0x0,0x0,0x0,0x0,0x0,0x83,0x80,0x84,0x0,0x0,0x84,0x89,0x0,0x8c,0x80,0x84,0x0,0x0,0x90,0x92,0x0,0x8c,0x80,0x84,0x0,0x0,0x90,0x92,0x0,0x8c,0x98,0x99,0x0,0x0,0x90,0xa0,0x0,0xa0,0x98,0xa4,0x0,0x0,0xa4,0xa0,0x0,0xa0,0x98,0xa4,0x0,0x0,0xb0,0xa0,0x0,0xa0,0xb0,0xa4,0x0,0x0,0xb0,0xa0,0x0,0xa0,0xb0,0xb9,0x0,0x0,0xb0,0xbc,0x0,0xbe,0xb0,0xc4,0xc0,0x0,0xc4,0xbc,0x0,0xca,0xc8,0xc4,0xc0,0x0,0xd0,0xce,0xcc,0xca,0xc8,0xc4,0xc0,0x0,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0x0,0x0,0x0,0x0,0x0,0x83,0x80,0x84,0x0,0x0,0x84,0x89,0x0,0x8c,0x80,0x84,0x0,0x0,0x90,0x92,0x0,0x8c,0x80,0x84,0x0,0x0,0x90,0x92,0x0,0x8c,0x98,0x99,0x0,0x0,0x90,0xa0,0x0,0xa0,0x98,0xa4,0x0,0x0,0xa4,0xa0,0x0,0xa0,0x98,0xa4,0x0,0x0,0xb0,0xa0,0x0,0xa0,0xb0,0xa4,0x0,0x0,0xb0,0xa0,0x0,0xa0,0xb0,0xb9,0x0,0x0,0xb0,0xbc,0x0,0xbe,0xb0,0xc4,0xc0,0x0,0xc4,0xbc,0x0,0xca,0xc8,0xc4,0xc0,0x0,0xd0,0xce,0xcc,0xca,0xc8,0xc4,0xc0,0x0,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0x0,0x0,0x0,0x81,0x80,0x0,0x84,0x0,0x0,0x86,0x0,0x8a,0x80,0x89,0x84,0x8a,0x0,0x90,0x0,0x8a,0x90,0x93,0x92,0x8a,0x0,0x90,0x0,0x98,0x90,0x9c,0x9c,0x9a,0x0,0x90,0x0,0x98,0xa0,0x9c,0x9c,0x9a,0x0,0x90,0x0,0x98,0xa0,0x9c,0xaa,0xaa,0x0,0xaa,0x0,0x98,0xb0,0xb0,0xb4,0xaa,0x0,0xb6,0x0,0xb4,0xb0,0xb0,0xb4,0xba,0x0,0xc0,0x0,0xb4,0xc0,0xb0,0xc2,0xba,0x0,0xc0,0x0,0xc6,0xc0,0xb0,0xcc,0xca,0xc8,0xc0,0x0,0xd1,0xd0,0xce,0xcc,0xca,0xc8,0xc0,0x0,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0x0,0x0,0x0,0x81,0x80,0x0,0x84,0x0,0x0,0x86,0x0,0x8a,0x80,0x89,0x84,0x8a,0x0,0x90,0x0,0x8a,0x90,0x93,0x92,0x8a,0x0,0x90,0x0,0x98,0x90,0x9c,0x9c,0x9a,0x0,0x90,0x0,0x98,0xa0,0x9c,0x9c,0x9a,0x0,0x90,0x0,0x98,0xa0,0x9c,0xaa,0xaa,0x0,0xaa,0x0,0x98,0xb0,0xb0,0xb4,0xaa,0x0,0xb6,0x0,0xb4,0xb0,0xb0,0xb4,0xba,0x0,0xc0,0x0,0xb4,0xc0,0xb0,0xc2,0xba,0x0,0xc0,0x0,0xc6,0xc0,0xb0,0xcc,0xca,0xc8,0xc0,0x0,0xd1,0xd0,0xce,0xcc,0xca,0xc8,0xc0,0x0,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x85,0x0,0x82,0x80,0x0,0x88,0x84,0x88,0x8e,0x0,0x8e,0x80,0x0,0x88,0x84,0x88,0x8e,0x0,0x98,0x94,0x0,0x98,0x98,0x88,0x9b,0x0,0x98,0xa0,0x0,0x98,0x98,0xa0,0xa5,0x0,0x98,0xa0,0x0,0xa8,0x98,0xa0,0xae,0x0,0x98,0xa0,0x0,0xa8,0x98,0xa0,0xae,0x0,0xb2,0xb4,0x0,0xb8,0xb6,0xb8,0xbb,0x0,0xbe,0xc0,0x0,0xb8,0xc2,0xb8,0xc5,0xc0,0xc8,0xc0,0x0,0xc8,0xc2,0xb8,0xce,0xcc,0xc8,0xc0,0x0,0xd2,0xd1,0xd0,0xce,0xcc,0xc8,0xc0,0x0,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x85,0x0,0x82,0x80,0x0,0x88,0x84,0x88,0x8e,0x0,0x8e,0x80,0x0,0x88,0x84,0x88,0x8e,0x0,0x98,0x94,0x0,0x98,0x98,0x88,0x9b,0x0,0x98,0xa0,0x0,0x98,0x98,0xa0,0xa5,0x0,0x98,0xa0,0x0,0xa8,0x98,0xa0,0xae,0x0,0x98,0xa0,0x0,0xa8,0x98,0xa0,0xae,0x0,0xb2,0xb4,0x0,0xb8,0xb6,0xb8,0xbb,0x0,0xbe,0xc0,0x0,0xb8,0xc2,0xb8,0xc5,0xc0,0xc8,0xc0,0x0,0xc8,0xc2,0xb8,0xce,0xcc,0xc8,0xc0,0x0,0xd2,0xd1,0xd0,0xce,0xcc,0xc8,0xc0,0x0,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x8a,0x0,0x0,0x8c,0x88,0x8c,0x0,0x90,0x94,0x91,0x0,0x8c,0x88,0x8c,0x0,0x90,0x94,0x9c,0x0,0x9c,0x9c,0x9e,0x0,0x90,0xa2,0x9c,0x0,0x9c,0xa8,0xa9,0x0,0x90,0xac,0x9c,0x0,0xac,0xa8,0xb2,0x0,0xae,0xac,0xb1,0x0,0xac,0xa8,0xb2,0x0,0xba,0xba,0xbc,0x0,0xbc,0xbc,0xc0,0x0,0xba,0xc4,0xbc,0x0,0xbc,0xc8,0xc0,0x0,0xc9,0xc4,0xbc,0x0,0xcc,0xc8,0xc0,0x0,0xd3,0xd2,0xd1,0xd0,0xcc,0xc8,0xc0,0x0,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x8a,0x0,0x0,0x8c,0x88,0x8c,0x0,0x90,0x94,0x91,0x0,0x8c,0x88,0x8c,0x0,0x90,0x94,0x9c,0x0,0x9c,0x9c,0x9e,0x0,0x90,0xa2,0x9c,0x0,0x9c,0xa8,0xa9,0x0,0x90,0xac,0x9c,0x0,0xac,0xa8,0xb2,0x0,0xae,0xac,0xb1,0x0,0xac,0xa8,0xb2,0x0,0xba,0xba,0xbc,0x0,0xbc,0xbc,0xc0,0x0,0xba,0xc4,0xbc,0x0,0xbc,0xc8,0xc0,0x0,0xc9,0xc4,0xbc,0x0,0xcc,0xc8,0xc0,0x0,0xd3,0xd2,0xd1,0xd0,0xcc,0xc8,0xc0,0x0,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0x0,0x0,0x0,0x0,0x80,0x0,0x82,0x86,0x0,0x0,0x0,0x0,0x80,0x0,0x8c,0x86,0x0,0x8a,0x8c,0x0,0x90,0x0,0x8c,0x93,0x0,0x96,0x98,0x94,0x90,0x0,0x9a,0x9d,0x0,0xa0,0x98,0x94,0xa0,0x0,0xa4,0xa6,0x0,0xa0,0x98,0xa6,0xa0,0x0,0xa4,0xa6,0x0,0xa0,0xac,0xb1,0xb0,0x0,0xb2,0xb3,0x0,0xa0,0xb8,0xba,0xb0,0x0,0xbc,0xbd,0x0,0xba,0xb8,0xba,0xc0,0x0,0xbc,0xc6,0xc0,0xc6,0xb8,0xc8,0xc0,0x0,0xca,0xc6,0xc0,0xd0,0xcc,0xc8,0xc0,0x0,0xd4,0xd3,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0x0,0x0,0x0,0x0,0x80,0x0,0x82,0x86,0x0,0x0,0x0,0x0,0x80,0x0,0x8c,0x86,0x0,0x8a,0x8c,0x0,0x90,0x0,0x8c,0x93,0x0,0x96,0x98,0x94,0x90,0x0,0x9a,0x9d,0x0,0xa0,0x98,0x94,0xa0,0x0,0xa4,0xa6,0x0,0xa0,0x98,0xa6,0xa0,0x0,0xa4,0xa6,0x0,0xa0,0xac,0xb1,0xb0,0x0,0xb2,0xb3,0x0,0xa0,0xb8,0xba,0xb0,0x0,0xbc,0xbd,0x0,0xba,0xb8,0xba,0xc0,0x0,0xbc,0xc6,0xc0,0xc6,0xb8,0xc8,0xc0,0x0,0xca,0xc6,0xc0,0xd0,0xcc,0xc8,0xc0,0x0,0xd4,0xd3,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x84,0x0,0x88,0x8a,0x0,0x8b,0x0,0x88,0x90,0x8e,0x88,0x8a,0x0,0x95,0x0,0x88,0x90,0x99,0x98,0x99,0x0,0x9e,0x0,0x88,0x90,0xa2,0x98,0xa3,0x0,0x9e,0x0,0xa2,0xa4,0xa2,0xa8,0xac,0x0,0xab,0x0,0xae,0xb0,0xb0,0xa8,0xac,0x0,0xb5,0x0,0xb8,0xb0,0xb0,0xb8,0xac,0x0,0xbe,0x0,0xb8,0xb0,0xb0,0xb8,0xc0,0x0,0xbe,0x0,0xb8,0xc4,0xb0,0xc8,0xc0,0x0,0xcb,0xc8,0xb8,0xd0,0xcc,0xc8,0xc0,0x0,0xd5,0xd4,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x84,0x0,0x88,0x8a,0x0,0x8b,0x0,0x88,0x90,0x8e,0x88,0x8a,0x0,0x95,0x0,0x88,0x90,0x99,0x98,0x99,0x0,0x9e,0x0,0x88,0x90,0xa2,0x98,0xa3,0x0,0x9e,0x0,0xa2,0xa4,0xa2,0xa8,0xac,0x0,0xab,0x0,0xae,0xb0,0xb0,0xa8,0xac,0x0,0xb5,0x0,0xb8,0xb0,0xb0,0xb8,0xac,0x0,0xbe,0x0,0xb8,0xb0,0xb0,0xb8,0xc0,0x0,0xbe,0x0,0xb8,0xc4,0xb0,0xc8,0xc0,0x0,0xcb,0xc8,0xb8,0xd0,0xcc,0xc8,0xc0,0x0,0xd5,0xd4,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0x86,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0x92,0x90,0x0,0x0,0x94,0x0,0x80,0x0,0x92,0x90,0x0,0x0,0x94,0x0,0x9c,0x0,0xa1,0x90,0x0,0x0,0xa4,0x0,0x9c,0x0,0xab,0xa8,0x0,0x0,0xa4,0x0,0xae,0x0,0xb4,0xa8,0x0,0x0,0xb4,0x0,0xb9,0x0,0xb4,0xa8,0x0,0x0,0xb4,0x0,0xc2,0x0,0xb4,0xc0,0x0,0xc0,0xc4,0x0,0xc2,0x0,0xc8,0xc0,0x0,0xcc,0xc4,0x0,0xd0,0xcc,0xc8,0xc0,0x0,0xd6,0xd4,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0x86,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0x92,0x90,0x0,0x0,0x94,0x0,0x80,0x0,0x92,0x90,0x0,0x0,0x94,0x0,0x9c,0x0,0xa1,0x90,0x0,0x0,0xa4,0x0,0x9c,0x0,0xab,0xa8,0x0,0x0,0xa4,0x0,0xae,0x0,0xb4,0xa8,0x0,0x0,0xb4,0x0,0xb9,0x0,0xb4,0xa8,0x0,0x0,0xb4,0x0,0xc2,0x0,0xb4,0xc0,0x0,0xc0,0xc4,0x0,0xc2,0x0,0xc8,0xc0,0x0,0xcc,0xc4,0x0,0xd0,0xcc,0xc8,0xc0,0x0,0xd6,0xd4,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0x0,0x80,0x80,0x82,0x80,0x80,0x80,0x80,0x0,0x80,0x80,0x82,0x80,0x80,0x80,0x80,0x0,0x80,0x80,0x90,0x90,0x80,0x80,0x80,0x0,0x80,0x94,0x90,0x90,0x80,0x98,0x80,0x0,0x9a,0xa0,0x90,0xa0,0x9e,0x98,0xa0,0x0,0xa6,0xa0,0x90,0xa0,0xaa,0x98,0xa0,0x0,0xb0,0xa0,0xac,0xb0,0xaa,0xb0,0xa0,0x0,0xb0,0xb4,0xac,0xb0,0xb9,0xb0,0xa0,0x0,0xb0,0xc0,0xbe,0xc0,0xc3,0xb0,0xc0,0x0,0xb0,0xc0,0xc9,0xc0,0xcc,0xc8,0xc0,0x0,0xca,0xc0,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xd6,0xd4,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0x0,0x80,0x80,0x82,0x80,0x80,0x80,0x80,0x0,0x80,0x80,0x82,0x80,0x80,0x80,0x80,0x0,0x80,0x80,0x90,0x90,0x80,0x80,0x80,0x0,0x80,0x94,0x90,0x90,0x80,0x98,0x80,0x0,0x9a,0xa0,0x90,0xa0,0x9e,0x98,0xa0,0x0,0xa6,0xa0,0x90,0xa0,0xaa,0x98,0xa0,0x0,0xb0,0xa0,0xac,0xb0,0xaa,0xb0,0xa0,0x0,0xb0,0xb4,0xac,0xb0,0xb9,0xb0,0xa0,0x0,0xb0,0xc0,0xbe,0xc0,0xc3,0xb0,0xc0,0x0,0xb0,0xc0,0xc9,0xc0,0xcc,0xc8,0xc0,0x0,0xca,0xc0,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xd6,0xd4,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x81,0x0,0x0,0x0,0x86,0x0,0x8b,0x88,0x8c,0x0,0x0,0x8c,0x91,0x0,0x94,0x88,0x8c,0x0,0x0,0x98,0x9a,0x0,0x94,0x88,0x8c,0x0,0x0,0x98,0x9a,0x0,0x94,0xa0,0xa1,0x0,0x0,0x98,0xa8,0x0,0xa8,0xa0,0xac,0x0,0x0,0xac,0xa8,0x0,0xa8,0xa0,0xac,0x0,0x0,0xb8,0xa8,0x0,0xa8,0xb8,0xac,0x0,0x0,0xb8,0xa8,0x0,0xa8,0xb8,0xc1,0x0,0x0,0xb8,0xc4,0x0,0xc6,0xb8,0xcc,0xc8,0x0,0xcc,0xc4,0x0,0xd2,0xd0,0xcc,0xc8,0x0,0xd8,0xd6,0xd4,0xd2,0xd0,0xcc,0xc8,0x0,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0x0,0x0,0x0,0x0,0x0,0x81,0x0,0x81,0x0,0x0,0x0,0x86,0x0,0x8b,0x88,0x8c,0x0,0x0,0x8c,0x91,0x0,0x94,0x88,0x8c,0x0,0x0,0x98,0x9a,0x0,0x94,0x88,0x8c,0x0,0x0,0x98,0x9a,0x0,0x94,0xa0,0xa1,0x0,0x0,0x98,0xa8,0x0,0xa8,0xa0,0xac,0x0,0x0,0xac,0xa8,0x0,0xa8,0xa0,0xac,0x0,0x0,0xb8,0xa8,0x0,0xa8,0xb8,0xac,0x0,0x0,0xb8,0xa8,0x0,0xa8,0xb8,0xc1,0x0,0x0,0xb8,0xc4,0x0,0xc6,0xb8,0xcc,0xc8,0x0,0xcc,0xc4,0x0,0xd2,0xd0,0xcc,0xc8,0x0,0xd8,0xd6,0xd4,0xd2,0xd0,0xcc,0xc8,0x0,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x82,0x0,0x82,0x0,0x89,0x88,0x82,0x8c,0x82,0x0,0x8e,0x0,0x92,0x88,0x91,0x8c,0x92,0x0,0x98,0x0,0x92,0x98,0x9b,0x9a,0x92,0x0,0x98,0x0,0xa0,0x98,0xa4,0xa4,0xa2,0x0,0x98,0x0,0xa0,0xa8,0xa4,0xa4,0xa2,0x0,0x98,0x0,0xa0,0xa8,0xa4,0xb2,0xb2,0x0,0xb2,0x0,0xa0,0xb8,0xb8,0xbc,0xb2,0x0,0xbe,0x0,0xbc,0xb8,0xb8,0xbc,0xc2,0x0,0xc8,0x0,0xbc,0xc8,0xb8,0xca,0xc2,0x0,0xc8,0x0,0xce,0xc8,0xb8,0xd4,0xd2,0xd0,0xc8,0x0,0xd9,0xd8,0xd6,0xd4,0xd2,0xd0,0xc8,0x0,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x82,0x0,0x82,0x0,0x89,0x88,0x82,0x8c,0x82,0x0,0x8e,0x0,0x92,0x88,0x91,0x8c,0x92,0x0,0x98,0x0,0x92,0x98,0x9b,0x9a,0x92,0x0,0x98,0x0,0xa0,0x98,0xa4,0xa4,0xa2,0x0,0x98,0x0,0xa0,0xa8,0xa4,0xa4,0xa2,0x0,0x98,0x0,0xa0,0xa8,0xa4,0xb2,0xb2,0x0,0xb2,0x0,0xa0,0xb8,0xb8,0xbc,0xb2,0x0,0xbe,0x0,0xbc,0xb8,0xb8,0xbc,0xc2,0x0,0xc8,0x0,0xbc,0xc8,0xb8,0xca,0xc2,0x0,0xc8,0x0,0xce,0xc8,0xb8,0xd4,0xd2,0xd0,0xc8,0x0,0xd9,0xd8,0xd6,0xd4,0xd2,0xd0,0xc8,0x0,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0x0,0x0,0x0,0x0,0x80,0x83,0x0,0x83,0x0,0x0,0x88,0x0,0x80,0x8c,0x0,0x8d,0x0,0x8a,0x88,0x0,0x90,0x8c,0x90,0x96,0x0,0x96,0x88,0x0,0x90,0x8c,0x90,0x96,0x0,0xa0,0x9c,0x0,0xa0,0xa0,0x90,0xa3,0x0,0xa0,0xa8,0x0,0xa0,0xa0,0xa8,0xad,0x0,0xa0,0xa8,0x0,0xb0,0xa0,0xa8,0xb6,0x0,0xa0,0xa8,0x0,0xb0,0xa0,0xa8,0xb6,0x0,0xba,0xbc,0x0,0xc0,0xbe,0xc0,0xc3,0x0,0xc6,0xc8,0x0,0xc0,0xca,0xc0,0xcd,0xc8,0xd0,0xc8,0x0,0xd0,0xca,0xc0,0xd6,0xd4,0xd0,0xc8,0x0,0xda,0xd9,0xd8,0xd6,0xd4,0xd0,0xc8,0x0,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0x0,0x0,0x0,0x0,0x80,0x83,0x0,0x83,0x0,0x0,0x88,0x0,0x80,0x8c,0x0,0x8d,0x0,0x8a,0x88,0x0,0x90,0x8c,0x90,0x96,0x0,0x96,0x88,0x0,0x90,0x8c,0x90,0x96,0x0,0xa0,0x9c,0x0,0xa0,0xa0,0x90,0xa3,0x0,0xa0,0xa8,0x0,0xa0,0xa0,0xa8,0xad,0x0,0xa0,0xa8,0x0,0xb0,0xa0,0xa8,0xb6,0x0,0xa0,0xa8,0x0,0xb0,0xa0,0xa8,0xb6,0x0,0xba,0xbc,0x0,0xc0,0xbe,0xc0,0xc3,0x0,0xc6,0xc8,0x0,0xc0,0xca,0xc0,0xcd,0xc8,0xd0,0xc8,0x0,0xd0,0xca,0xc0,0xd6,0xd4,0xd0,0xc8,0x0,0xda,0xd9,0xd8,0xd6,0xd4,0xd0,0xc8,0x0,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0x0,0x0,0x0,0x0,0x0,0x84,0x84,0x84,0x0,0x84,0x84,0x0,0x0,0x84,0x84,0x84,0x0,0x84,0x90,0x0,0x0,0x84,0x92,0x84,0x0,0x94,0x90,0x94,0x0,0x98,0x9c,0x99,0x0,0x94,0x90,0x94,0x0,0x98,0x9c,0xa4,0x0,0xa4,0xa4,0xa6,0x0,0x98,0xaa,0xa4,0x0,0xa4,0xb0,0xb1,0x0,0x98,0xb4,0xa4,0x0,0xb4,0xb0,0xba,0x0,0xb6,0xb4,0xb9,0x0,0xb4,0xb0,0xba,0x0,0xc2,0xc2,0xc4,0x0,0xc4,0xc4,0xc8,0x0,0xc2,0xcc,0xc4,0x0,0xc4,0xd0,0xc8,0x0,0xd1,0xcc,0xc4,0x0,0xd4,0xd0,0xc8,0x0,0xdb,0xda,0xd9,0xd8,0xd4,0xd0,0xc8,0x0,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0x0,0x0,0x0,0x0,0x0,0x84,0x84,0x84,0x0,0x84,0x84,0x0,0x0,0x84,0x84,0x84,0x0,0x84,0x90,0x0,0x0,0x84,0x92,0x84,0x0,0x94,0x90,0x94,0x0,0x98,0x9c,0x99,0x0,0x94,0x90,0x94,0x0,0x98,0x9c,0xa4,0x0,0xa4,0xa4,0xa6,0x0,0x98,0xaa,0xa4,0x0,0xa4,0xb0,0xb1,0x0,0x98,0xb4,0xa4,0x0,0xb4,0xb0,0xba,0x0,0xb6,0xb4,0xb9,0x0,0xb4,0xb0,0xba,0x0,0xc2,0xc2,0xc4,0x0,0xc4,0xc4,0xc8,0x0,0xc2,0xcc,0xc4,0x0,0xc4,0xd0,0xc8,0x0,0xd1,0xcc,0xc4,0x0,0xd4,0xd0,0xc8,0x0,0xdb,0xda,0xd9,0xd8,0xd4,0xd0,0xc8,0x0,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x85,0x0,0x0,0x80,0x80,0x88,0x0,0x8a,0x8e,0x0,0x0,0x80,0x80,0x88,0x0,0x94,0x8e,0x0,0x92,0x94,0x80,0x98,0x0,0x94,0x9b,0x0,0x9e,0xa0,0x9c,0x98,0x0,0xa2,0xa5,0x0,0xa8,0xa0,0x9c,0xa8,0x0,0xac,0xae,0x0,0xa8,0xa0,0xae,0xa8,0x0,0xac,0xae,0x0,0xa8,0xb4,0xb9,0xb8,0x0,0xba,0xbb,0x0,0xa8,0xc0,0xc2,0xb8,0x0,0xc4,0xc5,0x0,0xc2,0xc0,0xc2,0xc8,0x0,0xc4,0xce,0xc8,0xce,0xc0,0xd0,0xc8,0x0,0xd2,0xce,0xc8,0xd8,0xd4,0xd0,0xc8,0x0,0xdc,0xdb,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x85,0x0,0x0,0x80,0x80,0x88,0x0,0x8a,0x8e,0x0,0x0,0x80,0x80,0x88,0x0,0x94,0x8e,0x0,0x92,0x94,0x80,0x98,0x0,0x94,0x9b,0x0,0x9e,0xa0,0x9c,0x98,0x0,0xa2,0xa5,0x0,0xa8,0xa0,0x9c,0xa8,0x0,0xac,0xae,0x0,0xa8,0xa0,0xae,0xa8,0x0,0xac,0xae,0x0,0xa8,0xb4,0xb9,0xb8,0x0,0xba,0xbb,0x0,0xa8,0xc0,0xc2,0xb8,0x0,0xc4,0xc5,0x0,0xc2,0xc0,0xc2,0xc8,0x0,0xc4,0xce,0xc8,0xce,0xc0,0xd0,0xc8,0x0,0xd2,0xce,0xc8,0xd8,0xd4,0xd0,0xc8,0x0,0xdc,0xdb,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x86,0x0,0x84,0x80,0x86,0x0,0x86,0x0,0x90,0x8c,0x84,0x90,0x92,0x0,0x93,0x0,0x90,0x98,0x96,0x90,0x92,0x0,0x9d,0x0,0x90,0x98,0xa1,0xa0,0xa1,0x0,0xa6,0x0,0x90,0x98,0xaa,0xa0,0xab,0x0,0xa6,0x0,0xaa,0xac,0xaa,0xb0,0xb4,0x0,0xb3,0x0,0xb6,0xb8,0xb8,0xb0,0xb4,0x0,0xbd,0x0,0xc0,0xb8,0xb8,0xc0,0xb4,0x0,0xc6,0x0,0xc0,0xb8,0xb8,0xc0,0xc8,0x0,0xc6,0x0,0xc0,0xcc,0xb8,0xd0,0xc8,0x0,0xd3,0xd0,0xc0,0xd8,0xd4,0xd0,0xc8,0x0,0xdd,0xdc,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x86,0x0,0x84,0x80,0x86,0x0,0x86,0x0,0x90,0x8c,0x84,0x90,0x92,0x0,0x93,0x0,0x90,0x98,0x96,0x90,0x92,0x0,0x9d,0x0,0x90,0x98,0xa1,0xa0,0xa1,0x0,0xa6,0x0,0x90,0x98,0xaa,0xa0,0xab,0x0,0xa6,0x0,0xaa,0xac,0xaa,0xb0,0xb4,0x0,0xb3,0x0,0xb6,0xb8,0xb8,0xb0,0xb4,0x0,0xbd,0x0,0xc0,0xb8,0xb8,0xc0,0xb4,0x0,0xc6,0x0,0xc0,0xb8,0xb8,0xc0,0xc8,0x0,0xc6,0x0,0xc0,0xcc,0xb8,0xd0,0xc8,0x0,0xd3,0xd0,0xc0,0xd8,0xd4,0xd0,0xc8,0x0,0xdd,0xdc,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x80,0x0,0x0,0x8c,0x0,0x88,0x0,0x8e,0x80,0x0,0x0,0x8c,0x0,0x88,0x0,0x9a,0x98,0x0,0x0,0x9c,0x0,0x88,0x0,0x9a,0x98,0x0,0x0,0x9c,0x0,0xa4,0x0,0xa9,0x98,0x0,0x0,0xac,0x0,0xa4,0x0,0xb3,0xb0,0x0,0x0,0xac,0x0,0xb6,0x0,0xbc,0xb0,0x0,0x0,0xbc,0x0,0xc1,0x0,0xbc,0xb0,0x0,0x0,0xbc,0x0,0xca,0x0,0xbc,0xc8,0x0,0xc8,0xcc,0x0,0xca,0x0,0xd0,0xc8,0x0,0xd4,0xcc,0x0,0xd8,0xd4,0xd0,0xc8,0x0,0xde,0xdc,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x80,0x0,0x0,0x8c,0x0,0x88,0x0,0x8e,0x80,0x0,0x0,0x8c,0x0,0x88,0x0,0x9a,0x98,0x0,0x0,0x9c,0x0,0x88,0x0,0x9a,0x98,0x0,0x0,0x9c,0x0,0xa4,0x0,0xa9,0x98,0x0,0x0,0xac,0x0,0xa4,0x0,0xb3,0xb0,0x0,0x0,0xac,0x0,0xb6,0x0,0xbc,0xb0,0x0,0x0,0xbc,0x0,0xc1,0x0,0xbc,0xb0,0x0,0x0,0xbc,0x0,0xca,0x0,0xbc,0xc8,0x0,0xc8,0xcc,0x0,0xca,0x0,0xd0,0xc8,0x0,0xd4,0xcc,0x0,0xd8,0xd4,0xd0,0xc8,0x0,0xde,0xdc,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x0,0x0,0x88,0x88,0x8a,0x88,0x88,0x88,0x88,0x0,0x88,0x88,0x8a,0x88,0x88,0x88,0x88,0x0,0x88,0x88,0x98,0x98,0x88,0x88,0x88,0x0,0x88,0x9c,0x98,0x98,0x88,0xa0,0x88,0x0,0xa2,0xa8,0x98,0xa8,0xa6,0xa0,0xa8,0x0,0xae,0xa8,0x98,0xa8,0xb2,0xa0,0xa8,0x0,0xb8,0xa8,0xb4,0xb8,0xb2,0xb8,0xa8,0x0,0xb8,0xbc,0xb4,0xb8,0xc1,0xb8,0xa8,0x0,0xb8,0xc8,0xc6,0xc8,0xcb,0xb8,0xc8,0x0,0xb8,0xc8,0xd1,0xc8,0xd4,0xd0,0xc8,0x0,0xd2,0xc8,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xde,0xdc,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0x0,0x0,0x0,0x81,0x0,0x0,0x0,0x0,0x0,0x88,0x88,0x8a,0x88,0x88,0x88,0x88,0x0,0x88,0x88,0x8a,0x88,0x88,0x88,0x88,0x0,0x88,0x88,0x98,0x98,0x88,0x88,0x88,0x0,0x88,0x9c,0x98,0x98,0x88,0xa0,0x88,0x0,0xa2,0xa8,0x98,0xa8,0xa6,0xa0,0xa8,0x0,0xae,0xa8,0x98,0xa8,0xb2,0xa0,0xa8,0x0,0xb8,0xa8,0xb4,0xb8,0xb2,0xb8,0xa8,0x0,0xb8,0xbc,0xb4,0xb8,0xc1,0xb8,0xa8,0x0,0xb8,0xc8,0xc6,0xc8,0xcb,0xb8,0xc8,0x0,0xb8,0xc8,0xd1,0xc8,0xd4,0xd0,0xc8,0x0,0xd2,0xc8,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xde,0xdc,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x89,0x0,0x89,0x0,0x0,0x80,0x8e,0x0,0x93,0x90,0x94,0x0,0x0,0x94,0x99,0x0,0x9c,0x90,0x94,0x0,0x0,0xa0,0xa2,0x0,0x9c,0x90,0x94,0x0,0x0,0xa0,0xa2,0x0,0x9c,0xa8,0xa9,0x0,0x0,0xa0,0xb0,0x0,0xb0,0xa8,0xb4,0x0,0x0,0xb4,0xb0,0x0,0xb0,0xa8,0xb4,0x0,0x0,0xc0,0xb0,0x0,0xb0,0xc0,0xb4,0x0,0x0,0xc0,0xb0,0x0,0xb0,0xc0,0xc9,0x0,0x0,0xc0,0xcc,0x0,0xce,0xc0,0xd4,0xd0,0x0,0xd4,0xcc,0x0,0xda,0xd8,0xd4,0xd0,0x0,0xe0,0xde,0xdc,0xda,0xd8,0xd4,0xd0,0x0,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x89,0x0,0x89,0x0,0x0,0x80,0x8e,0x0,0x93,0x90,0x94,0x0,0x0,0x94,0x99,0x0,0x9c,0x90,0x94,0x0,0x0,0xa0,0xa2,0x0,0x9c,0x90,0x94,0x0,0x0,0xa0,0xa2,0x0,0x9c,0xa8,0xa9,0x0,0x0,0xa0,0xb0,0x0,0xb0,0xa8,0xb4,0x0,0x0,0xb4,0xb0,0x0,0xb0,0xa8,0xb4,0x0,0x0,0xc0,0xb0,0x0,0xb0,0xc0,0xb4,0x0,0x0,0xc0,0xb0,0x0,0xb0,0xc0,0xc9,0x0,0x0,0xc0,0xcc,0x0,0xce,0xc0,0xd4,0xd0,0x0,0xd4,0xcc,0x0,0xda,0xd8,0xd4,0xd0,0x0,0xe0,0xde,0xdc,0xda,0xd8,0xd4,0xd0,0x0,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x86,0x80,0x8a,0x8a,0x8a,0x0,0x8a,0x0,0x91,0x90,0x8a,0x94,0x8a,0x0,0x96,0x0,0x9a,0x90,0x99,0x94,0x9a,0x0,0xa0,0x0,0x9a,0xa0,0xa3,0xa2,0x9a,0x0,0xa0,0x0,0xa8,0xa0,0xac,0xac,0xaa,0x0,0xa0,0x0,0xa8,0xb0,0xac,0xac,0xaa,0x0,0xa0,0x0,0xa8,0xb0,0xac,0xba,0xba,0x0,0xba,0x0,0xa8,0xc0,0xc0,0xc4,0xba,0x0,0xc6,0x0,0xc4,0xc0,0xc0,0xc4,0xca,0x0,0xd0,0x0,0xc4,0xd0,0xc0,0xd2,0xca,0x0,0xd0,0x0,0xd6,0xd0,0xc0,0xdc,0xda,0xd8,0xd0,0x0,0xe1,0xe0,0xde,0xdc,0xda,0xd8,0xd0,0x0,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x86,0x80,0x8a,0x8a,0x8a,0x0,0x8a,0x0,0x91,0x90,0x8a,0x94,0x8a,0x0,0x96,0x0,0x9a,0x90,0x99,0x94,0x9a,0x0,0xa0,0x0,0x9a,0xa0,0xa3,0xa2,0x9a,0x0,0xa0,0x0,0xa8,0xa0,0xac,0xac,0xaa,0x0,0xa0,0x0,0xa8,0xb0,0xac,0xac,0xaa,0x0,0xa0,0x0,0xa8,0xb0,0xac,0xba,0xba,0x0,0xba,0x0,0xa8,0xc0,0xc0,0xc4,0xba,0x0,0xc6,0x0,0xc4,0xc0,0xc0,0xc4,0xca,0x0,0xd0,0x0,0xc4,0xd0,0xc0,0xd2,0xca,0x0,0xd0,0x0,0xd6,0xd0,0xc0,0xdc,0xda,0xd8,0xd0,0x0,0xe1,0xe0,0xde,0xdc,0xda,0xd8,0xd0,0x0,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0x0,0x0,0x0,0x0,0x0,0x81,0x80,0x0,0x0,0x0,0x84,0x0,0x88,0x8b,0x80,0x8b,0x0,0x0,0x90,0x0,0x88,0x94,0x80,0x95,0x0,0x92,0x90,0x0,0x98,0x94,0x98,0x9e,0x0,0x9e,0x90,0x0,0x98,0x94,0x98,0x9e,0x0,0xa8,0xa4,0x0,0xa8,0xa8,0x98,0xab,0x0,0xa8,0xb0,0x0,0xa8,0xa8,0xb0,0xb5,0x0,0xa8,0xb0,0x0,0xb8,0xa8,0xb0,0xbe,0x0,0xa8,0xb0,0x0,0xb8,0xa8,0xb0,0xbe,0x0,0xc2,0xc4,0x0,0xc8,0xc6,0xc8,0xcb,0x0,0xce,0xd0,0x0,0xc8,0xd2,0xc8,0xd5,0xd0,0xd8,0xd0,0x0,0xd8,0xd2,0xc8,0xde,0xdc,0xd8,0xd0,0x0,0xe2,0xe1,0xe0,0xde,0xdc,0xd8,0xd0,0x0,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0x0,0x0,0x0,0x0,0x0,0x81,0x80,0x0,0x0,0x0,0x84,0x0,0x88,0x8b,0x80,0x8b,0x0,0x0,0x90,0x0,0x88,0x94,0x80,0x95,0x0,0x92,0x90,0x0,0x98,0x94,0x98,0x9e,0x0,0x9e,0x90,0x0,0x98,0x94,0x98,0x9e,0x0,0xa8,0xa4,0x0,0xa8,0xa8,0x98,0xab,0x0,0xa8,0xb0,0x0,0xa8,0xa8,0xb0,0xb5,0x0,0xa8,0xb0,0x0,0xb8,0xa8,0xb0,0xbe,0x0,0xa8,0xb0,0x0,0xb8,0xa8,0xb0,0xbe,0x0,0xc2,0xc4,0x0,0xc8,0xc6,0xc8,0xcb,0x0,0xce,0xd0,0x0,0xc8,0xd2,0xc8,0xd5,0xd0,0xd8,0xd0,0x0,0xd8,0xd2,0xc8,0xde,0xdc,0xd8,0xd0,0x0,0xe2,0xe1,0xe0,0xde,0xdc,0xd8,0xd0,0x0,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0x0,0x0,0x0,0x80,0x0,0x83,0x82,0x81,0x0,0x0,0x0,0x80,0x0,0x8c,0x8c,0x8c,0x0,0x8c,0x8c,0x80,0x0,0x8c,0x8c,0x8c,0x0,0x8c,0x98,0x80,0x0,0x8c,0x9a,0x8c,0x0,0x9c,0x98,0x9c,0x0,0xa0,0xa4,0xa1,0x0,0x9c,0x98,0x9c,0x0,0xa0,0xa4,0xac,0x0,0xac,0xac,0xae,0x0,0xa0,0xb2,0xac,0x0,0xac,0xb8,0xb9,0x0,0xa0,0xbc,0xac,0x0,0xbc,0xb8,0xc2,0x0,0xbe,0xbc,0xc1,0x0,0xbc,0xb8,0xc2,0x0,0xca,0xca,0xcc,0x0,0xcc,0xcc,0xd0,0x0,0xca,0xd4,0xcc,0x0,0xcc,0xd8,0xd0,0x0,0xd9,0xd4,0xcc,0x0,0xdc,0xd8,0xd0,0x0,0xe3,0xe2,0xe1,0xe0,0xdc,0xd8,0xd0,0x0,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0x0,0x0,0x0,0x80,0x0,0x83,0x82,0x81,0x0,0x0,0x0,0x80,0x0,0x8c,0x8c,0x8c,0x0,0x8c,0x8c,0x80,0x0,0x8c,0x8c,0x8c,0x0,0x8c,0x98,0x80,0x0,0x8c,0x9a,0x8c,0x0,0x9c,0x98,0x9c,0x0,0xa0,0xa4,0xa1,0x0,0x9c,0x98,0x9c,0x0,0xa0,0xa4,0xac,0x0,0xac,0xac,0xae,0x0,0xa0,0xb2,0xac,0x0,0xac,0xb8,0xb9,0x0,0xa0,0xbc,0xac,0x0,0xbc,0xb8,0xc2,0x0,0xbe,0xbc,0xc1,0x0,0xbc,0xb8,0xc2,0x0,0xca,0xca,0xcc,0x0,0xcc,0xcc,0xd0,0x0,0xca,0xd4,0xcc,0x0,0xcc,0xd8,0xd0,0x0,0xd9,0xd4,0xcc,0x0,0xdc,0xd8,0xd0,0x0,0xe3,0xe2,0xe1,0xe0,0xdc,0xd8,0xd0,0x0,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x83,0x0,0x80,0x88,0x88,0x80,0x0,0x84,0x8d,0x0,0x80,0x88,0x88,0x90,0x0,0x92,0x96,0x0,0x80,0x88,0x88,0x90,0x0,0x9c,0x96,0x0,0x9a,0x9c,0x88,0xa0,0x0,0x9c,0xa3,0x0,0xa6,0xa8,0xa4,0xa0,0x0,0xaa,0xad,0x0,0xb0,0xa8,0xa4,0xb0,0x0,0xb4,0xb6,0x0,0xb0,0xa8,0xb6,0xb0,0x0,0xb4,0xb6,0x0,0xb0,0xbc,0xc1,0xc0,0x0,0xc2,0xc3,0x0,0xb0,0xc8,0xca,0xc0,0x0,0xcc,0xcd,0x0,0xca,0xc8,0xca,0xd0,0x0,0xcc,0xd6,0xd0,0xd6,0xc8,0xd8,0xd0,0x0,0xda,0xd6,0xd0,0xe0,0xdc,0xd8,0xd0,0x0,0xe4,0xe3,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x83,0x0,0x80,0x88,0x88,0x80,0x0,0x84,0x8d,0x0,0x80,0x88,0x88,0x90,0x0,0x92,0x96,0x0,0x80,0x88,0x88,0x90,0x0,0x9c,0x96,0x0,0x9a,0x9c,0x88,0xa0,0x0,0x9c,0xa3,0x0,0xa6,0xa8,0xa4,0xa0,0x0,0xaa,0xad,0x0,0xb0,0xa8,0xa4,0xb0,0x0,0xb4,0xb6,0x0,0xb0,0xa8,0xb6,0xb0,0x0,0xb4,0xb6,0x0,0xb0,0xbc,0xc1,0xc0,0x0,0xc2,0xc3,0x0,0xb0,0xc8,0xca,0xc0,0x0,0xcc,0xcd,0x0,0xca,0xc8,0xca,0xd0,0x0,0xcc,0xd6,0xd0,0xd6,0xc8,0xd8,0xd0,0x0,0xda,0xd6,0xd0,0xe0,0xdc,0xd8,0xd0,0x0,0xe4,0xe3,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x85,0x0,0x82,0x80,0x0,0x88,0x0,0x0,0x8e,0x0,0x8e,0x80,0x8c,0x88,0x8e,0x0,0x8e,0x0,0x98,0x94,0x8c,0x98,0x9a,0x0,0x9b,0x0,0x98,0xa0,0x9e,0x98,0x9a,0x0,0xa5,0x0,0x98,0xa0,0xa9,0xa8,0xa9,0x0,0xae,0x0,0x98,0xa0,0xb2,0xa8,0xb3,0x0,0xae,0x0,0xb2,0xb4,0xb2,0xb8,0xbc,0x0,0xbb,0x0,0xbe,0xc0,0xc0,0xb8,0xbc,0x0,0xc5,0x0,0xc8,0xc0,0xc0,0xc8,0xbc,0x0,0xce,0x0,0xc8,0xc0,0xc0,0xc8,0xd0,0x0,0xce,0x0,0xc8,0xd4,0xc0,0xd8,0xd0,0x0,0xdb,0xd8,0xc8,0xe0,0xdc,0xd8,0xd0,0x0,0xe5,0xe4,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x85,0x0,0x82,0x80,0x0,0x88,0x0,0x0,0x8e,0x0,0x8e,0x80,0x8c,0x88,0x8e,0x0,0x8e,0x0,0x98,0x94,0x8c,0x98,0x9a,0x0,0x9b,0x0,0x98,0xa0,0x9e,0x98,0x9a,0x0,0xa5,0x0,0x98,0xa0,0xa9,0xa8,0xa9,0x0,0xae,0x0,0x98,0xa0,0xb2,0xa8,0xb3,0x0,0xae,0x0,0xb2,0xb4,0xb2,0xb8,0xbc,0x0,0xbb,0x0,0xbe,0xc0,0xc0,0xb8,0xbc,0x0,0xc5,0x0,0xc8,0xc0,0xc0,0xc8,0xbc,0x0,0xce,0x0,0xc8,0xc0,0xc0,0xc8,0xd0,0x0,0xce,0x0,0xc8,0xd4,0xc0,0xd8,0xd0,0x0,0xdb,0xd8,0xc8,0xe0,0xdc,0xd8,0xd0,0x0,0xe5,0xe4,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x82,0x0,0x0,0x88,0x0,0x0,0x84,0x0,0x90,0x0,0x0,0x88,0x0,0x0,0x94,0x0,0x90,0x0,0x96,0x88,0x0,0x0,0x94,0x0,0x90,0x0,0xa2,0xa0,0x0,0x0,0xa4,0x0,0x90,0x0,0xa2,0xa0,0x0,0x0,0xa4,0x0,0xac,0x0,0xb1,0xa0,0x0,0x0,0xb4,0x0,0xac,0x0,0xbb,0xb8,0x0,0x0,0xb4,0x0,0xbe,0x0,0xc4,0xb8,0x0,0x0,0xc4,0x0,0xc9,0x0,0xc4,0xb8,0x0,0x0,0xc4,0x0,0xd2,0x0,0xc4,0xd0,0x0,0xd0,0xd4,0x0,0xd2,0x0,0xd8,0xd0,0x0,0xdc,0xd4,0x0,0xe0,0xdc,0xd8,0xd0,0x0,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x82,0x0,0x0,0x88,0x0,0x0,0x84,0x0,0x90,0x0,0x0,0x88,0x0,0x0,0x94,0x0,0x90,0x0,0x96,0x88,0x0,0x0,0x94,0x0,0x90,0x0,0xa2,0xa0,0x0,0x0,0xa4,0x0,0x90,0x0,0xa2,0xa0,0x0,0x0,0xa4,0x0,0xac,0x0,0xb1,0xa0,0x0,0x0,0xb4,0x0,0xac,0x0,0xbb,0xb8,0x0,0x0,0xb4,0x0,0xbe,0x0,0xc4,0xb8,0x0,0x0,0xc4,0x0,0xc9,0x0,0xc4,0xb8,0x0,0x0,0xc4,0x0,0xd2,0x0,0xc4,0xd0,0x0,0xd0,0xd4,0x0,0xd2,0x0,0xd8,0xd0,0x0,0xdc,0xd4,0x0,0xe0,0xdc,0xd8,0xd0,0x0,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x86,0x84,0x89,0x80,0x0,0x0,0x0,0x0,0x90,0x90,0x92,0x90,0x90,0x90,0x90,0x0,0x90,0x90,0x92,0x90,0x90,0x90,0x90,0x0,0x90,0x90,0xa0,0xa0,0x90,0x90,0x90,0x0,0x90,0xa4,0xa0,0xa0,0x90,0xa8,0x90,0x0,0xaa,0xb0,0xa0,0xb0,0xae,0xa8,0xb0,0x0,0xb6,0xb0,0xa0,0xb0,0xba,0xa8,0xb0,0x0,0xc0,0xb0,0xbc,0xc0,0xba,0xc0,0xb0,0x0,0xc0,0xc4,0xbc,0xc0,0xc9,0xc0,0xb0,0x0,0xc0,0xd0,0xce,0xd0,0xd3,0xc0,0xd0,0x0,0xc0,0xd0,0xd9,0xd0,0xdc,0xd8,0xd0,0x0,0xda,0xd0,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x86,0x84,0x89,0x80,0x0,0x0,0x0,0x0,0x90,0x90,0x92,0x90,0x90,0x90,0x90,0x0,0x90,0x90,0x92,0x90,0x90,0x90,0x90,0x0,0x90,0x90,0xa0,0xa0,0x90,0x90,0x90,0x0,0x90,0xa4,0xa0,0xa0,0x90,0xa8,0x90,0x0,0xaa,0xb0,0xa0,0xb0,0xae,0xa8,0xb0,0x0,0xb6,0xb0,0xa0,0xb0,0xba,0xa8,0xb0,0x0,0xc0,0xb0,0xbc,0xc0,0xba,0xc0,0xb0,0x0,0xc0,0xc4,0xbc,0xc0,0xc9,0xc0,0xb0,0x0,0xc0,0xd0,0xce,0xd0,0xd3,0xc0,0xd0,0x0,0xc0,0xd0,0xd9,0xd0,0xdc,0xd8,0xd0,0x0,0xda,0xd0,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x84,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x84,0x0,0x91,0x80,0x91,0x0,0x0,0x88,0x96,0x0,0x9b,0x98,0x9c,0x0,0x0,0x9c,0xa1,0x0,0xa4,0x98,0x9c,0x0,0x0,0xa8,0xaa,0x0,0xa4,0x98,0x9c,0x0,0x0,0xa8,0xaa,0x0,0xa4,0xb0,0xb1,0x0,0x0,0xa8,0xb8,0x0,0xb8,0xb0,0xbc,0x0,0x0,0xbc,0xb8,0x0,0xb8,0xb0,0xbc,0x0,0x0,0xc8,0xb8,0x0,0xb8,0xc8,0xbc,0x0,0x0,0xc8,0xb8,0x0,0xb8,0xc8,0xd1,0x0,0x0,0xc8,0xd4,0x0,0xd6,0xc8,0xdc,0xd8,0x0,0xdc,0xd4,0x0,0xe2,0xe0,0xdc,0xd8,0x0,0xe8,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0x0,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x84,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x84,0x0,0x91,0x80,0x91,0x0,0x0,0x88,0x96,0x0,0x9b,0x98,0x9c,0x0,0x0,0x9c,0xa1,0x0,0xa4,0x98,0x9c,0x0,0x0,0xa8,0xaa,0x0,0xa4,0x98,0x9c,0x0,0x0,0xa8,0xaa,0x0,0xa4,0xb0,0xb1,0x0,0x0,0xa8,0xb8,0x0,0xb8,0xb0,0xbc,0x0,0x0,0xbc,0xb8,0x0,0xb8,0xb0,0xbc,0x0,0x0,0xc8,0xb8,0x0,0xb8,0xc8,0xbc,0x0,0x0,0xc8,0xb8,0x0,0xb8,0xc8,0xd1,0x0,0x0,0xc8,0xd4,0x0,0xd6,0xc8,0xdc,0xd8,0x0,0xdc,0xd4,0x0,0xe2,0xe0,0xdc,0xd8,0x0,0xe8,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0x0,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x82,0x0,0x0,0x0,0x0,0x88,0x86,0x84,0x82,0x0,0x0,0x0,0x8e,0x88,0x92,0x92,0x92,0x0,0x92,0x0,0x99,0x98,0x92,0x9c,0x92,0x0,0x9e,0x0,0xa2,0x98,0xa1,0x9c,0xa2,0x0,0xa8,0x0,0xa2,0xa8,0xab,0xaa,0xa2,0x0,0xa8,0x0,0xb0,0xa8,0xb4,0xb4,0xb2,0x0,0xa8,0x0,0xb0,0xb8,0xb4,0xb4,0xb2,0x0,0xa8,0x0,0xb0,0xb8,0xb4,0xc2,0xc2,0x0,0xc2,0x0,0xb0,0xc8,0xc8,0xcc,0xc2,0x0,0xce,0x0,0xcc,0xc8,0xc8,0xcc,0xd2,0x0,0xd8,0x0,0xcc,0xd8,0xc8,0xda,0xd2,0x0,0xd8,0x0,0xde,0xd8,0xc8,0xe4,0xe2,0xe0,0xd8,0x0,0xe9,0xe8,0xe6,0xe4,0xe2,0xe0,0xd8,0x0,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x82,0x0,0x0,0x0,0x0,0x88,0x86,0x84,0x82,0x0,0x0,0x0,0x8e,0x88,0x92,0x92,0x92,0x0,0x92,0x0,0x99,0x98,0x92,0x9c,0x92,0x0,0x9e,0x0,0xa2,0x98,0xa1,0x9c,0xa2,0x0,0xa8,0x0,0xa2,0xa8,0xab,0xaa,0xa2,0x0,0xa8,0x0,0xb0,0xa8,0xb4,0xb4,0xb2,0x0,0xa8,0x0,0xb0,0xb8,0xb4,0xb4,0xb2,0x0,0xa8,0x0,0xb0,0xb8,0xb4,0xc2,0xc2,0x0,0xc2,0x0,0xb0,0xc8,0xc8,0xcc,0xc2,0x0,0xce,0x0,0xcc,0xc8,0xc8,0xcc,0xd2,0x0,0xd8,0x0,0xcc,0xd8,0xc8,0xda,0xd2,0x0,0xd8,0x0,0xde,0xd8,0xc8,0xe4,0xe2,0xe0,0xd8,0x0,0xe9,0xe8,0xe6,0xe4,0xe2,0xe0,0xd8,0x0,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x80,0x0,0x0,0x80,0x89,0x88,0x86,0x0,0x80,0x8c,0x0,0x90,0x93,0x88,0x93,0x0,0x80,0x98,0x0,0x90,0x9c,0x88,0x9d,0x0,0x9a,0x98,0x0,0xa0,0x9c,0xa0,0xa6,0x0,0xa6,0x98,0x0,0xa0,0x9c,0xa0,0xa6,0x0,0xb0,0xac,0x0,0xb0,0xb0,0xa0,0xb3,0x0,0xb0,0xb8,0x0,0xb0,0xb0,0xb8,0xbd,0x0,0xb0,0xb8,0x0,0xc0,0xb0,0xb8,0xc6,0x0,0xb0,0xb8,0x0,0xc0,0xb0,0xb8,0xc6,0x0,0xca,0xcc,0x0,0xd0,0xce,0xd0,0xd3,0x0,0xd6,0xd8,0x0,0xd0,0xda,0xd0,0xdd,0xd8,0xe0,0xd8,0x0,0xe0,0xda,0xd0,0xe6,0xe4,0xe0,0xd8,0x0,0xea,0xe9,0xe8,0xe6,0xe4,0xe0,0xd8,0x0,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x80,0x0,0x0,0x80,0x89,0x88,0x86,0x0,0x80,0x8c,0x0,0x90,0x93,0x88,0x93,0x0,0x80,0x98,0x0,0x90,0x9c,0x88,0x9d,0x0,0x9a,0x98,0x0,0xa0,0x9c,0xa0,0xa6,0x0,0xa6,0x98,0x0,0xa0,0x9c,0xa0,0xa6,0x0,0xb0,0xac,0x0,0xb0,0xb0,0xa0,0xb3,0x0,0xb0,0xb8,0x0,0xb0,0xb0,0xb8,0xbd,0x0,0xb0,0xb8,0x0,0xc0,0xb0,0xb8,0xc6,0x0,0xb0,0xb8,0x0,0xc0,0xb0,0xb8,0xc6,0x0,0xca,0xcc,0x0,0xd0,0xce,0xd0,0xd3,0x0,0xd6,0xd8,0x0,0xd0,0xda,0xd0,0xdd,0xd8,0xe0,0xd8,0x0,0xe0,0xda,0xd0,0xe6,0xe4,0xe0,0xd8,0x0,0xea,0xe9,0xe8,0xe6,0xe4,0xe0,0xd8,0x0,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0xf3,0x0,0x0,0x80,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x80,0x88,0x0,0x8b,0x8a,0x89,0x0,0x84,0x80,0x88,0x0,0x94,0x94,0x94,0x0,0x94,0x94,0x88,0x0,0x94,0x94,0x94,0x0,0x94,0xa0,0x88,0x0,0x94,0xa2,0x94,0x0,0xa4,0xa0,0xa4,0x0,0xa8,0xac,0xa9,0x0,0xa4,0xa0,0xa4,0x0,0xa8,0xac,0xb4,0x0,0xb4,0xb4,0xb6,0x0,0xa8,0xba,0xb4,0x0,0xb4,0xc0,0xc1,0x0,0xa8,0xc4,0xb4,0x0,0xc4,0xc0,0xca,0x0,0xc6,0xc4,0xc9,0x0,0xc4,0xc0,0xca,0x0,0xd2,0xd2,0xd4,0x0,0xd4,0xd4,0xd8,0x0,0xd2,0xdc,0xd4,0x0,0xd4,0xe0,0xd8,0x0,0xe1,0xdc,0xd4,0x0,0xe4,0xe0,0xd8,0x0,0xeb,0xea,0xe9,0xe8,0xe4,0xe0,0xd8,0x0,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0x0,0x0,0x80,0x0,0x0,0x81,0x0,0x0,0x0,0x84,0x80,0x88,0x0,0x8b,0x8a,0x89,0x0,0x84,0x80,0x88,0x0,0x94,0x94,0x94,0x0,0x94,0x94,0x88,0x0,0x94,0x94,0x94,0x0,0x94,0xa0,0x88,0x0,0x94,0xa2,0x94,0x0,0xa4,0xa0,0xa4,0x0,0xa8,0xac,0xa9,0x0,0xa4,0xa0,0xa4,0x0,0xa8,0xac,0xb4,0x0,0xb4,0xb4,0xb6,0x0,0xa8,0xba,0xb4,0x0,0xb4,0xc0,0xc1,0x0,0xa8,0xc4,0xb4,0x0,0xc4,0xc0,0xca,0x0,0xc6,0xc4,0xc9,0x0,0xc4,0xc0,0xca,0x0,0xd2,0xd2,0xd4,0x0,0xd4,0xd4,0xd8,0x0,0xd2,0xdc,0xd4,0x0,0xd4,0xe0,0xd8,0x0,0xe1,0xdc,0xd4,0x0,0xe4,0xe0,0xd8,0x0,0xeb,0xea,0xe9,0xe8,0xe4,0xe0,0xd8,0x0,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0x0,0x0,0x0,0x82,0x0,0x0,0x82,0x0,0x0,0x88,0x84,0x82,0x88,0x0,0x8c,0x8b,0x0,0x88,0x90,0x90,0x88,0x0,0x8c,0x95,0x0,0x88,0x90,0x90,0x98,0x0,0x9a,0x9e,0x0,0x88,0x90,0x90,0x98,0x0,0xa4,0x9e,0x0,0xa2,0xa4,0x90,0xa8,0x0,0xa4,0xab,0x0,0xae,0xb0,0xac,0xa8,0x0,0xb2,0xb5,0x0,0xb8,0xb0,0xac,0xb8,0x0,0xbc,0xbe,0x0,0xb8,0xb0,0xbe,0xb8,0x0,0xbc,0xbe,0x0,0xb8,0xc4,0xc9,0xc8,0x0,0xca,0xcb,0x0,0xb8,0xd0,0xd2,0xc8,0x0,0xd4,0xd5,0x0,0xd2,0xd0,0xd2,0xd8,0x0,0xd4,0xde,0xd8,0xde,0xd0,0xe0,0xd8,0x0,0xe2,0xde,0xd8,0xe8,0xe4,0xe0,0xd8,0x0,0xec,0xeb,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0x0,0x0,0x0,0x82,0x0,0x0,0x82,0x0,0x0,0x88,0x84,0x82,0x88,0x0,0x8c,0x8b,0x0,0x88,0x90,0x90,0x88,0x0,0x8c,0x95,0x0,0x88,0x90,0x90,0x98,0x0,0x9a,0x9e,0x0,0x88,0x90,0x90,0x98,0x0,0xa4,0x9e,0x0,0xa2,0xa4,0x90,0xa8,0x0,0xa4,0xab,0x0,0xae,0xb0,0xac,0xa8,0x0,0xb2,0xb5,0x0,0xb8,0xb0,0xac,0xb8,0x0,0xbc,0xbe,0x0,0xb8,0xb0,0xbe,0xb8,0x0,0xbc,0xbe,0x0,0xb8,0xc4,0xc9,0xc8,0x0,0xca,0xcb,0x0,0xb8,0xd0,0xd2,0xc8,0x0,0xd4,0xd5,0x0,0xd2,0xd0,0xd2,0xd8,0x0,0xd4,0xde,0xd8,0xde,0xd0,0xe0,0xd8,0x0,0xe2,0xde,0xd8,0xe8,0xe4,0xe0,0xd8,0x0,0xec,0xeb,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0xf5,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x83,0x0,0x0,0x88,0x0,0x80,0x0,0x0,0x8d,0x0,0x8a,0x88,0x0,0x90,0x0,0x0,0x96,0x0,0x96,0x88,0x94,0x90,0x96,0x0,0x96,0x0,0xa0,0x9c,0x94,0xa0,0xa2,0x0,0xa3,0x0,0xa0,0xa8,0xa6,0xa0,0xa2,0x0,0xad,0x0,0xa0,0xa8,0xb1,0xb0,0xb1,0x0,0xb6,0x0,0xa0,0xa8,0xba,0xb0,0xbb,0x0,0xb6,0x0,0xba,0xbc,0xba,0xc0,0xc4,0x0,0xc3,0x0,0xc6,0xc8,0xc8,0xc0,0xc4,0x0,0xcd,0x0,0xd0,0xc8,0xc8,0xd0,0xc4,0x0,0xd6,0x0,0xd0,0xc8,0xc8,0xd0,0xd8,0x0,0xd6,0x0,0xd0,0xdc,0xc8,0xe0,0xd8,0x0,0xe3,0xe0,0xd0,0xe8,0xe4,0xe0,0xd8,0x0,0xed,0xec,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x83,0x0,0x0,0x88,0x0,0x80,0x0,0x0,0x8d,0x0,0x8a,0x88,0x0,0x90,0x0,0x0,0x96,0x0,0x96,0x88,0x94,0x90,0x96,0x0,0x96,0x0,0xa0,0x9c,0x94,0xa0,0xa2,0x0,0xa3,0x0,0xa0,0xa8,0xa6,0xa0,0xa2,0x0,0xad,0x0,0xa0,0xa8,0xb1,0xb0,0xb1,0x0,0xb6,0x0,0xa0,0xa8,0xba,0xb0,0xbb,0x0,0xb6,0x0,0xba,0xbc,0xba,0xc0,0xc4,0x0,0xc3,0x0,0xc6,0xc8,0xc8,0xc0,0xc4,0x0,0xcd,0x0,0xd0,0xc8,0xc8,0xd0,0xc4,0x0,0xd6,0x0,0xd0,0xc8,0xc8,0xd0,0xd8,0x0,0xd6,0x0,0xd0,0xdc,0xc8,0xe0,0xd8,0x0,0xe3,0xe0,0xd0,0xe8,0xe4,0xe0,0xd8,0x0,0xed,0xec,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0xf6,0x0,0x0,0x0,0x81,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x8a,0x0,0x80,0x0,0x0,0x0,0x8c,0x0,0x8a,0x0,0x80,0x90,0x0,0x0,0x8c,0x0,0x98,0x0,0x80,0x90,0x0,0x0,0x9c,0x0,0x98,0x0,0x9e,0x90,0x0,0x0,0x9c,0x0,0x98,0x0,0xaa,0xa8,0x0,0x0,0xac,0x0,0x98,0x0,0xaa,0xa8,0x0,0x0,0xac,0x0,0xb4,0x0,0xb9,0xa8,0x0,0x0,0xbc,0x0,0xb4,0x0,0xc3,0xc0,0x0,0x0,0xbc,0x0,0xc6,0x0,0xcc,0xc0,0x0,0x0,0xcc,0x0,0xd1,0x0,0xcc,0xc0,0x0,0x0,0xcc,0x0,0xda,0x0,0xcc,0xd8,0x0,0xd8,0xdc,0x0,0xda,0x0,0xe0,0xd8,0x0,0xe4,0xdc,0x0,0xe8,0xe4,0xe0,0xd8,0x0,0xee,0xec,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf7,0xf7,0xf7,0xf7,0xf7,0xf7,0xf7,0xf7,0x0,0x0,0x0,0x81,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x8a,0x0,0x80,0x0,0x0,0x0,0x8c,0x0,0x8a,0x0,0x80,0x90,0x0,0x0,0x8c,0x0,0x98,0x0,0x80,0x90,0x0,0x0,0x9c,0x0,0x98,0x0,0x9e,0x90,0x0,0x0,0x9c,0x0,0x98,0x0,0xaa,0xa8,0x0,0x0,0xac,0x0,0x98,0x0,0xaa,0xa8,0x0,0x0,0xac,0x0,0xb4,0x0,0xb9,0xa8,0x0,0x0,0xbc,0x0,0xb4,0x0,0xc3,0xc0,0x0,0x0,0xbc,0x0,0xc6,0x0,0xcc,0xc0,0x0,0x0,0xcc,0x0,0xd1,0x0,0xcc,0xc0,0x0,0x0,0xcc,0x0,0xda,0x0,0xcc,0xd8,0x0,0xd8,0xdc,0x0,0xda,0x0,0xe0,0xd8,0x0,0xe4,0xdc,0x0,0xe8,0xe4,0xe0,0xd8,0x0,0xee,0xec,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf7,0xf7,0xf7,0xf7,0xf7,0xf7,0xf7,0xf7,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x82,0x0,0x86,0x88,0x84,0x80,0x0,0x0,0x8e,0x8c,0x91,0x88,0x84,0x80,0x0,0x0,0x98,0x98,0x9a,0x98,0x98,0x98,0x98,0x0,0x98,0x98,0x9a,0x98,0x98,0x98,0x98,0x0,0x98,0x98,0xa8,0xa8,0x98,0x98,0x98,0x0,0x98,0xac,0xa8,0xa8,0x98,0xb0,0x98,0x0,0xb2,0xb8,0xa8,0xb8,0xb6,0xb0,0xb8,0x0,0xbe,0xb8,0xa8,0xb8,0xc2,0xb0,0xb8,0x0,0xc8,0xb8,0xc4,0xc8,0xc2,0xc8,0xb8,0x0,0xc8,0xcc,0xc4,0xc8,0xd1,0xc8,0xb8,0x0,0xc8,0xd8,0xd6,0xd8,0xdb,0xc8,0xd8,0x0,0xc8,0xd8,0xe1,0xd8,0xe4,0xe0,0xd8,0x0,0xe2,0xd8,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xee,0xec,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x82,0x0,0x86,0x88,0x84,0x80,0x0,0x0,0x8e,0x8c,0x91,0x88,0x84,0x80,0x0,0x0,0x98,0x98,0x9a,0x98,0x98,0x98,0x98,0x0,0x98,0x98,0x9a,0x98,0x98,0x98,0x98,0x0,0x98,0x98,0xa8,0xa8,0x98,0x98,0x98,0x0,0x98,0xac,0xa8,0xa8,0x98,0xb0,0x98,0x0,0xb2,0xb8,0xa8,0xb8,0xb6,0xb0,0xb8,0x0,0xbe,0xb8,0xa8,0xb8,0xc2,0xb0,0xb8,0x0,0xc8,0xb8,0xc4,0xc8,0xc2,0xc8,0xb8,0x0,0xc8,0xcc,0xc4,0xc8,0xd1,0xc8,0xb8,0x0,0xc8,0xd8,0xd6,0xd8,0xdb,0xc8,0xd8,0x0,0xc8,0xd8,0xe1,0xd8,0xe4,0xe0,0xd8,0x0,0xe2,0xd8,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xee,0xec,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x84,0x0,0x0,0x8a,0x88,0x84,0x0,0x0,0x90,0x8c,0x0,0x8a,0x88,0x84,0x0,0x0,0x90,0x8c,0x0,0x99,0x88,0x99,0x0,0x0,0x90,0x9e,0x0,0xa3,0xa0,0xa4,0x0,0x0,0xa4,0xa9,0x0,0xac,0xa0,0xa4,0x0,0x0,0xb0,0xb2,0x0,0xac,0xa0,0xa4,0x0,0x0,0xb0,0xb2,0x0,0xac,0xb8,0xb9,0x0,0x0,0xb0,0xc0,0x0,0xc0,0xb8,0xc4,0x0,0x0,0xc4,0xc0,0x0,0xc0,0xb8,0xc4,0x0,0x0,0xd0,0xc0,0x0,0xc0,0xd0,0xc4,0x0,0x0,0xd0,0xc0,0x0,0xc0,0xd0,0xd9,0x0,0x0,0xd0,0xdc,0x0,0xde,0xd0,0xe4,0xe0,0x0,0xe4,0xdc,0x0,0xea,0xe8,0xe4,0xe0,0x0,0xf0,0xee,0xec,0xea,0xe8,0xe4,0xe0,0x0,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x84,0x0,0x0,0x8a,0x88,0x84,0x0,0x0,0x90,0x8c,0x0,0x8a,0x88,0x84,0x0,0x0,0x90,0x8c,0x0,0x99,0x88,0x99,0x0,0x0,0x90,0x9e,0x0,0xa3,0xa0,0xa4,0x0,0x0,0xa4,0xa9,0x0,0xac,0xa0,0xa4,0x0,0x0,0xb0,0xb2,0x0,0xac,0xa0,0xa4,0x0,0x0,0xb0,0xb2,0x0,0xac,0xb8,0xb9,0x0,0x0,0xb0,0xc0,0x0,0xc0,0xb8,0xc4,0x0,0x0,0xc4,0xc0,0x0,0xc0,0xb8,0xc4,0x0,0x0,0xd0,0xc0,0x0,0xc0,0xd0,0xc4,0x0,0x0,0xd0,0xc0,0x0,0xc0,0xd0,0xd9,0x0,0x0,0xd0,0xdc,0x0,0xde,0xd0,0xe4,0xe0,0x0,0xe4,0xdc,0x0,0xea,0xe8,0xe4,0xe0,0x0,0xf0,0xee,0xec,0xea,0xe8,0xe4,0xe0,0x0,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0x0,0x80,0x0,0x0,0x80,0x0,0x82,0x0,0x0,0x80,0x0,0x84,0x80,0x0,0x8c,0x8a,0x0,0x80,0x0,0x84,0x90,0x8e,0x8c,0x8a,0x0,0x80,0x0,0x96,0x90,0x9a,0x9a,0x9a,0x0,0x9a,0x0,0xa1,0xa0,0x9a,0xa4,0x9a,0x0,0xa6,0x0,0xaa,0xa0,0xa9,0xa4,0xaa,0x0,0xb0,0x0,0xaa,0xb0,0xb3,0xb2,0xaa,0x0,0xb0,0x0,0xb8,0xb0,0xbc,0xbc,0xba,0x0,0xb0,0x0,0xb8,0xc0,0xbc,0xbc,0xba,0x0,0xb0,0x0,0xb8,0xc0,0xbc,0xca,0xca,0x0,0xca,0x0,0xb8,0xd0,0xd0,0xd4,0xca,0x0,0xd6,0x0,0xd4,0xd0,0xd0,0xd4,0xda,0x0,0xe0,0x0,0xd4,0xe0,0xd0,0xe2,0xda,0x0,0xe0,0x0,0xe6,0xe0,0xd0,0xec,0xea,0xe8,0xe0,0x0,0xf1,0xf0,0xee,0xec,0xea,0xe8,0xe0,0x0,0xfa,0xfa,0xfa,0xfa,0xfa,0x0,0x80,0x0,0x0,0x80,0x0,0x82,0x0,0x0,0x80,0x0,0x84,0x80,0x0,0x8c,0x8a,0x0,0x80,0x0,0x84,0x90,0x8e,0x8c,0x8a,0x0,0x80,0x0,0x96,0x90,0x9a,0x9a,0x9a,0x0,0x9a,0x0,0xa1,0xa0,0x9a,0xa4,0x9a,0x0,0xa6,0x0,0xaa,0xa0,0xa9,0xa4,0xaa,0x0,0xb0,0x0,0xaa,0xb0,0xb3,0xb2,0xaa,0x0,0xb0,0x0,0xb8,0xb0,0xbc,0xbc,0xba,0x0,0xb0,0x0,0xb8,0xc0,0xbc,0xbc,0xba,0x0,0xb0,0x0,0xb8,0xc0,0xbc,0xca,0xca,0x0,0xca,0x0,0xb8,0xd0,0xd0,0xd4,0xca,0x0,0xd6,0x0,0xd4,0xd0,0xd0,0xd4,0xda,0x0,0xe0,0x0,0xd4,0xe0,0xd0,0xe2,0xda,0x0,0xe0,0x0,0xe6,0xe0,0xd0,0xec,0xea,0xe8,0xe0,0x0,0xf1,0xf0,0xee,0xec,0xea,0xe8,0xe0,0x0,0xfa,0xfa,0xfa,0xfa,0xfa,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x85,0x0,0x88,0x80,0x0,0x88,0x82,0x0,0x8e,0x0,0x88,0x80,0x0,0x88,0x91,0x90,0x8e,0x0,0x88,0x94,0x0,0x98,0x9b,0x90,0x9b,0x0,0x88,0xa0,0x0,0x98,0xa4,0x90,0xa5,0x0,0xa2,0xa0,0x0,0xa8,0xa4,0xa8,0xae,0x0,0xae,0xa0,0x0,0xa8,0xa4,0xa8,0xae,0x0,0xb8,0xb4,0x0,0xb8,0xb8,0xa8,0xbb,0x0,0xb8,0xc0,0x0,0xb8,0xb8,0xc0,0xc5,0x0,0xb8,0xc0,0x0,0xc8,0xb8,0xc0,0xce,0x0,0xb8,0xc0,0x0,0xc8,0xb8,0xc0,0xce,0x0,0xd2,0xd4,0x0,0xd8,0xd6,0xd8,0xdb,0x0,0xde,0xe0,0x0,0xd8,0xe2,0xd8,0xe5,0xe0,0xe8,0xe0,0x0,0xe8,0xe2,0xd8,0xee,0xec,0xe8,0xe0,0x0,0xf2,0xf1,0xf0,0xee,0xec,0xe8,0xe0,0x0,0xfb,0xfb,0xfb,0xfb,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x85,0x0,0x88,0x80,0x0,0x88,0x82,0x0,0x8e,0x0,0x88,0x80,0x0,0x88,0x91,0x90,0x8e,0x0,0x88,0x94,0x0,0x98,0x9b,0x90,0x9b,0x0,0x88,0xa0,0x0,0x98,0xa4,0x90,0xa5,0x0,0xa2,0xa0,0x0,0xa8,0xa4,0xa8,0xae,0x0,0xae,0xa0,0x0,0xa8,0xa4,0xa8,0xae,0x0,0xb8,0xb4,0x0,0xb8,0xb8,0xa8,0xbb,0x0,0xb8,0xc0,0x0,0xb8,0xb8,0xc0,0xc5,0x0,0xb8,0xc0,0x0,0xc8,0xb8,0xc0,0xce,0x0,0xb8,0xc0,0x0,0xc8,0xb8,0xc0,0xce,0x0,0xd2,0xd4,0x0,0xd8,0xd6,0xd8,0xdb,0x0,0xde,0xe0,0x0,0xd8,0xe2,0xd8,0xe5,0xe0,0xe8,0xe0,0x0,0xe8,0xe2,0xd8,0xee,0xec,0xe8,0xe0,0x0,0xf2,0xf1,0xf0,0xee,0xec,0xe8,0xe0,0x0,0xfb,0xfb,0xfb,0xfb,0x0,0x0,0x0,0x82,0x0,0x0,0x84,0x0,0x0,0x0,0x88,0x82,0x0,0x89,0x84,0x0,0x0,0x8c,0x88,0x90,0x0,0x93,0x92,0x91,0x0,0x8c,0x88,0x90,0x0,0x9c,0x9c,0x9c,0x0,0x9c,0x9c,0x90,0x0,0x9c,0x9c,0x9c,0x0,0x9c,0xa8,0x90,0x0,0x9c,0xaa,0x9c,0x0,0xac,0xa8,0xac,0x0,0xb0,0xb4,0xb1,0x0,0xac,0xa8,0xac,0x0,0xb0,0xb4,0xbc,0x0,0xbc,0xbc,0xbe,0x0,0xb0,0xc2,0xbc,0x0,0xbc,0xc8,0xc9,0x0,0xb0,0xcc,0xbc,0x0,0xcc,0xc8,0xd2,0x0,0xce,0xcc,0xd1,0x0,0xcc,0xc8,0xd2,0x0,0xda,0xda,0xdc,0x0,0xdc,0xdc,0xe0,0x0,0xda,0xe4,0xdc,0x0,0xdc,0xe8,0xe0,0x0,0xe9,0xe4,0xdc,0x0,0xec,0xe8,0xe0,0x0,0xf3,0xf2,0xf1,0xf0,0xec,0xe8,0xe0,0x0,0xfc,0xfc,0xfc,0x0,0x0,0x0,0x82,0x0,0x0,0x84,0x0,0x0,0x0,0x88,0x82,0x0,0x89,0x84,0x0,0x0,0x8c,0x88,0x90,0x0,0x93,0x92,0x91,0x0,0x8c,0x88,0x90,0x0,0x9c,0x9c,0x9c,0x0,0x9c,0x9c,0x90,0x0,0x9c,0x9c,0x9c,0x0,0x9c,0xa8,0x90,0x0,0x9c,0xaa,0x9c,0x0,0xac,0xa8,0xac,0x0,0xb0,0xb4,0xb1,0x0,0xac,0xa8,0xac,0x0,0xb0,0xb4,0xbc,0x0,0xbc,0xbc,0xbe,0x0,0xb0,0xc2,0xbc,0x0,0xbc,0xc8,0xc9,0x0,0xb0,0xcc,0xbc,0x0,0xcc,0xc8,0xd2,0x0,0xce,0xcc,0xd1,0x0,0xcc,0xc8,0xd2,0x0,0xda,0xda,0xdc,0x0,0xdc,0xdc,0xe0,0x0,0xda,0xe4,0xdc,0x0,0xdc,0xe8,0xe0,0x0,0xe9,0xe4,0xdc,0x0,0xec,0xe8,0xe0,0x0,0xf3,0xf2,0xf1,0xf0,0xec,0xe8,0xe0,0x0,0xfc,0xfc,0xfc,0x0,0x0,0x0,0x81,0x80,0x0,0x0,0x86,0x0,0x86,0x0,0x8a,0x80,0x0,0x8a,0x86,0x0,0x90,0x8c,0x8a,0x90,0x0,0x94,0x93,0x0,0x90,0x98,0x98,0x90,0x0,0x94,0x9d,0x0,0x90,0x98,0x98,0xa0,0x0,0xa2,0xa6,0x0,0x90,0x98,0x98,0xa0,0x0,0xac,0xa6,0x0,0xaa,0xac,0x98,0xb0,0x0,0xac,0xb3,0x0,0xb6,0xb8,0xb4,0xb0,0x0,0xba,0xbd,0x0,0xc0,0xb8,0xb4,0xc0,0x0,0xc4,0xc6,0x0,0xc0,0xb8,0xc6,0xc0,0x0,0xc4,0xc6,0x0,0xc0,0xcc,0xd1,0xd0,0x0,0xd2,0xd3,0x0,0xc0,0xd8,0xda,0xd0,0x0,0xdc,0xdd,0x0,0xda,0xd8,0xda,0xe0,0x0,0xdc,0xe6,0xe0,0xe6,0xd8,0xe8,0xe0,0x0,0xea,0xe6,0xe0,0xf0,0xec,0xe8,0xe0,0x0,0xf4,0xf3,0xf2,0xf0,0xec,0xe8,0xe0,0x0,0xfd,0xfd,0x0,0x0,0x0,0x81,0x80,0x0,0x0,0x86,0x0,0x86,0x0,0x8a,0x80,0x0,0x8a,0x86,0x0,0x90,0x8c,0x8a,0x90,0x0,0x94,0x93,0x0,0x90,0x98,0x98,0x90,0x0,0x94,0x9d,0x0,0x90,0x98,0x98,0xa0,0x0,0xa2,0xa6,0x0,0x90,0x98,0x98,0xa0,0x0,0xac,0xa6,0x0,0xaa,0xac,0x98,0xb0,0x0,0xac,0xb3,0x0,0xb6,0xb8,0xb4,0xb0,0x0,0xba,0xbd,0x0,0xc0,0xb8,0xb4,0xc0,0x0,0xc4,0xc6,0x0,0xc0,0xb8,0xc6,0xc0,0x0,0xc4,0xc6,0x0,0xc0,0xcc,0xd1,0xd0,0x0,0xd2,0xd3,0x0,0xc0,0xd8,0xda,0xd0,0x0,0xdc,0xdd,0x0,0xda,0xd8,0xda,0xe0,0x0,0xdc,0xe6,0xe0,0xe6,0xd8,0xe8,0xe0,0x0,0xea,0xe6,0xe0,0xf0,0xec,0xe8,0xe0,0x0,0xf4,0xf3,0xf2,0xf0,0xec,0xe8,0xe0,0x0,0xfd,0xfd,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x0,0x0,0x84,0x80,0x88,0x80,0x0,0x8b,0x0,0x0,0x90,0x80,0x88,0x80,0x0,0x95,0x0,0x92,0x90,0x80,0x98,0x80,0x0,0x9e,0x0,0x9e,0x90,0x9c,0x98,0x9e,0x0,0x9e,0x0,0xa8,0xa4,0x9c,0xa8,0xaa,0x0,0xab,0x0,0xa8,0xb0,0xae,0xa8,0xaa,0x0,0xb5,0x0,0xa8,0xb0,0xb9,0xb8,0xb9,0x0,0xbe,0x0,0xa8,0xb0,0xc2,0xb8,0xc3,0x0,0xbe,0x0,0xc2,0xc4,0xc2,0xc8,0xcc,0x0,0xcb,0x0,0xce,0xd0,0xd0,0xc8,0xcc,0x0,0xd5,0x0,0xd8,0xd0,0xd0,0xd8,0xcc,0x0,0xde,0x0,0xd8,0xd0,0xd0,0xd8,0xe0,0x0,0xde,0x0,0xd8,0xe4,0xd0,0xe8,0xe0,0x0,0xeb,0xe8,0xd8,0xf0,0xec,0xe8,0xe0,0x0,0xf5,0xf4,0xf2,0xf0,0xec,0xe8,0xe0,0x0,0xfe,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x0,0x0,0x84,0x80,0x88,0x80,0x0,0x8b,0x0,0x0,0x90,0x80,0x88,0x80,0x0,0x95,0x0,0x92,0x90,0x80,0x98,0x80,0x0,0x9e,0x0,0x9e,0x90,0x9c,0x98,0x9e,0x0,0x9e,0x0,0xa8,0xa4,0x9c,0xa8,0xaa,0x0,0xab,0x0,0xa8,0xb0,0xae,0xa8,0xaa,0x0,0xb5,0x0,0xa8,0xb0,0xb9,0xb8,0xb9,0x0,0xbe,0x0,0xa8,0xb0,0xc2,0xb8,0xc3,0x0,0xbe,0x0,0xc2,0xc4,0xc2,0xc8,0xcc,0x0,0xcb,0x0,0xce,0xd0,0xd0,0xc8,0xcc,0x0,0xd5,0x0,0xd8,0xd0,0xd0,0xd8,0xcc,0x0,0xde,0x0,0xd8,0xd0,0xd0,0xd8,0xe0,0x0,0xde,0x0,0xd8,0xe4,0xd0,0xe8,0xe0,0x0,0xeb,0xe8,0xd8,0xf0,0xec,0xe8,0xe0,0x0,0xf5,0xf4,0xf2,0xf0,0xec,0xe8,0xe0,0x0,0xfe,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0}
+	
\ No newline at end of file
@@ -0,0 +1,192 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// Signbit reports whether sign bit of f is set
+func Signbit(f Float8) bool { return f&signMask != 0 }
+
+// Mode of float8 format interpretation. Package level functions implement
+// ModeDefault, other modes are available through methods of Mode. Modes are
+// flags, they are combined with bitwise or (e.g. ModeSignedZero|ModeSaturate).
+type Mode int
+
+const (
+	// ModeDefault has single zero 0x00, the pattern 0x80 is -2^-7
+	ModeDefault Mode = 0
+
+	// ModeSignedZero reinterprets the pattern 0x80 as negative zero, -2^-7
+	// is not representable and collapses to -0. Sign of zero is preserved
+	// by conversions and arithmetic according to IEEE 754: underflow keeps
+	// sign, x + (-x) is +0, sign of product and quotient is xor of signs.
+	ModeSignedZero Mode = 1
+
+	// ModeSaturate clamps overflow to ±MaxValue instead of ±Infinity as
+	// required by E4M3FN. Conversions and arithmetic never produce Infinity,
+	// x/0 is ±MaxValue and NaN is converted to 0.
+	ModeSaturate Mode = 2
+)
+
+const negativeZero = signMask
+
+func (m Mode) isZero(f Float8) bool {
+	return f == 0 || m&ModeSignedZero != 0 && f == negativeZero
+}
+
+// signed zero of given sign
+func zeroOf(negative bool) Float8 {
+	if negative {
+		return negativeZero
+	}
+	return 0
+}
+
+// zero of given sign if mode supports it
+func (m Mode) zeroOf(negative bool) Float8 {
+	return zeroOf(negative && m&ModeSignedZero != 0)
+}
+
+// Signbit reports whether f is negative, including negative zero
+func (m Mode) Signbit(f Float8) bool { return Signbit(f) }
+
+// ToFloat8 converts float32 to float8
+func (m Mode) ToFloat8(f32 float32) Float8 {
+	if m&ModeSaturate != 0 && math.IsNaN(float64(f32)) {
+		return 0
+	}
+
+	f8 := m.clamp(ToFloat8(f32))
+	if m&ModeSignedZero != 0 && f8 == 0 && math.Signbit(float64(f32)) {
+		return negativeZero
+	}
+	return f8
+}
+
+// ToFloat32 converts float8 to float32
+func (m Mode) ToFloat32(f Float8) float32 {
+	if m&ModeSignedZero != 0 && f == negativeZero {
+		return float32(math.Copysign(0, -1))
+	}
+	return f8tof32[f]
+}
+
+// Equals compares float8 values numerically, -0 == +0 if mode has signed zero
+func (m Mode) Equals(a, b Float8) bool { return m.ToFloat32(a) == m.ToFloat32(b) }
+
+// Neg flips sign of float8
+func (m Mode) Neg(f Float8) Float8 {
+	if m&ModeSignedZero == 0 && f == 0 {
+		return 0
+	}
+	return f ^ signMask
+}
+
+// Add float8(s)
+func (m Mode) Add(a, b Float8) Float8 {
+	if m == ModeDefault {
+		return Add(a, b)
+	}
+
+	if m&ModeSignedZero != 0 {
+		switch za, zb := m.isZero(a), m.isZero(b); {
+		case za && zb:
+			return m.zeroOf(Signbit(a) && Signbit(b))
+		case za:
+			return m.clamp(b)
+		case zb:
+			return m.clamp(a)
+		}
+	}
+
+	return m.rounded(m.lookup(Add, &satadd, a, b), f8tof32[a]+f8tof32[b])
+}
+
+// Sub float8(s)
+func (m Mode) Sub(a, b Float8) Float8 {
+	if m == ModeDefault {
+		return Sub(a, b)
+	}
+
+	if m&ModeSignedZero != 0 {
+		switch za, zb := m.isZero(a), m.isZero(b); {
+		case za && zb:
+			return m.zeroOf(Signbit(a) && !Signbit(b))
+		case za:
+			return m.clamp(b ^ signMask)
+		case zb:
+			return m.clamp(a)
+		}
+	}
+
+	return m.rounded(m.lookup(Sub, &satsub, a, b), f8tof32[a]-f8tof32[b])
+}
+
+// Mul float8(s)
+func (m Mode) Mul(a, b Float8) Float8 {
+	if m == ModeDefault {
+		return Mul(a, b)
+	}
+
+	sign := Signbit(a) != Signbit(b)
+	if m.isZero(a) || m.isZero(b) {
+		return m.zeroOf(sign)
+	}
+
+	return m.rounded(m.lookup(Mul, &satmul, a, b), f8tof32[a]*f8tof32[b])
+}
+
+// Div float8(s), see SetDivPolicy for division by zero
+func (m Mode) Div(a, b Float8) Float8 {
+	if m == ModeDefault {
+		return Div(a, b)
+	}
+
+	sign := Signbit(a) != Signbit(b)
+	switch za, zb := m.isZero(a), m.isZero(b); {
+	case za && zb:
+		return divByZero(0)
+	case zb:
+		f := divByZero(a&^signMask | zeroOf(sign))
+		if m&ModeSaturate != 0 {
+			return f&signMask | MaxValue
+		}
+		return f
+	case za:
+		return m.zeroOf(sign)
+	}
+
+	return m.rounded(m.lookup(Div, &satdiv, a, b), f8tof32[a]/f8tof32[b])
+}
+
+// lookup result in the saturated code book if mode saturates, otherwise
+// it is operation of the package (code books of Add and Mul are not linked
+// with build tag nolookup)
+func (m Mode) lookup(op func(a, b Float8) Float8, saturated *[0x10000]uint8, a, b Float8) Float8 {
+	if m&ModeSaturate != 0 {
+		return saturated[int(a)<<8|int(b)]
+	}
+	return op(a, b)
+}
+
+// clamp ±Infinity to ±MaxValue if mode saturates
+func (m Mode) clamp(f Float8) Float8 {
+	if m&ModeSaturate != 0 && f&^signMask == Infinity {
+		return f&signMask | MaxValue
+	}
+	return f
+}
+
+// zero result of code book gets sign of exact result, exact cancellation is +0
+func (m Mode) rounded(f Float8, exact float32) Float8 {
+	if f == 0 {
+		return m.zeroOf(exact < 0)
+	}
+	return f
+}
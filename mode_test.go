@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+func TestSignbit(t *testing.T) {
+	if Signbit(0x00) || !Signbit(0x80) || !Signbit(0xb8) || Signbit(0x38) {
+		t.Errorf("unexpected sign bit")
+	}
+}
+
+func TestModeDefault(t *testing.T) {
+	m := ModeDefault
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b += 7 {
+			x, y := uint8(a), uint8(b)
+			if m.Add(x, y) != Add(x, y) || m.Sub(x, y) != Sub(x, y) || m.Mul(x, y) != Mul(x, y) || m.Div(x, y) != Div(x, y) {
+				t.Fatalf("0x%02x, 0x%02x: mode differs from package", a, b)
+			}
+		}
+		if m.ToFloat32(uint8(a)) != ToFloat32(uint8(a)) {
+			t.Fatalf("0x%02x: mode differs from package", a)
+		}
+	}
+
+	if m.ToFloat8(float32(math.Copysign(0, -1))) != 0 || m.Neg(0) != 0 {
+		t.Errorf("default mode has negative zero")
+	}
+}
+
+func TestModeSignedZero(t *testing.T) {
+	m := ModeSignedZero
+	negZero := float32(math.Copysign(0, -1))
+
+	if f := m.ToFloat8(negZero); f != 0x80 {
+		t.Errorf("ToFloat8(-0) = 0x%02x", f)
+	}
+
+	if f := m.ToFloat8(-1e-9); f != 0x80 {
+		t.Errorf("ToFloat8(-1e-9) = 0x%02x", f)
+	}
+
+	if x := m.ToFloat32(0x80); x != 0 || !math.Signbit(float64(x)) {
+		t.Errorf("ToFloat32(0x80) = %g", x)
+	}
+
+	if f := m.ToFloat8(m.ToFloat32(0x80)); f != 0x80 {
+		t.Errorf("round trip of -0 = 0x%02x", f)
+	}
+
+	one, mone := Float8(0x38), Float8(0xb8)
+	for _, tc := range []struct {
+		name string
+		f    func(a, b Float8) Float8
+		a, b Float8
+		c    Float8
+	}{
+		{"-0 + -0", m.Add, 0x80, 0x80, 0x80},
+		{"-0 + +0", m.Add, 0x80, 0x00, 0x00},
+		{"-0 + 1", m.Add, 0x80, one, one},
+		{"1 + -1", m.Add, one, mone, 0x00},
+		{"-0 - +0", m.Sub, 0x80, 0x00, 0x80},
+		{"-0 - -0", m.Sub, 0x80, 0x80, 0x00},
+		{"+0 - 1", m.Sub, 0x00, one, mone},
+		{"1 - 1", m.Sub, one, one, 0x00},
+		{"-0 * 1", m.Mul, 0x80, one, 0x80},
+		{"-0 * -1", m.Mul, 0x80, mone, 0x00},
+		{"+0 * -1", m.Mul, 0x00, mone, 0x80},
+		{"-0 / 1", m.Div, 0x80, one, 0x80},
+		{"1 / -0", m.Div, one, 0x80, 0xff},
+		{"-1 / -0", m.Div, mone, 0x80, 0x7f},
+		{"1 / 1", m.Div, one, one, one},
+		{"0.01 * -0.01", m.Mul, m.ToFloat8(0.01), m.ToFloat8(-0.01), 0x80},
+	} {
+		if c := tc.f(tc.a, tc.b); c != tc.c {
+			t.Errorf("%s = 0x%02x, wanted 0x%02x", tc.name, c, tc.c)
+		}
+	}
+
+	if m.Neg(0) != 0x80 || m.Neg(0x80) != 0 || !m.Signbit(0x80) {
+		t.Errorf("unexpected negation of zero")
+	}
+
+	if !m.Equals(0x80, 0x00) || !m.Equals(0x00, 0x80) || m.Equals(0x80, one) || !m.Equals(mone, mone) {
+		t.Errorf("unexpected equality of signed zero")
+	}
+
+	if ModeDefault.Equals(0x80, 0x00) || Equals(0x80, 0x00) {
+		t.Errorf("0x80 is -2^-7 in default mode")
+	}
+}
+
+func TestModeSaturate(t *testing.T) {
+	for _, m := range []Mode{ModeSaturate, ModeSaturate | ModeSignedZero} {
+		for a := 0; a < 0x100; a++ {
+			for b := 0; b < 0x100; b++ {
+				x, y := uint8(a), uint8(b)
+				for _, c := range []Float8{m.Add(x, y), m.Sub(x, y), m.Mul(x, y), m.Div(x, y)} {
+					if IsInf(c, 0) {
+						t.Fatalf("mode %d: 0x%02x, 0x%02x: infinity is not saturated", m, a, b)
+					}
+				}
+			}
+		}
+	}
+
+	m := ModeSaturate
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			x, y := uint8(a), uint8(b)
+			if m.Add(x, y) != math8.SatAdd(x, y) || m.Sub(x, y) != math8.SatSub(x, y) || m.Mul(x, y) != math8.SatMul(x, y) || m.Div(x, y) != math8.SatDiv(x, y) {
+				t.Fatalf("0x%02x, 0x%02x: mode differs from math8", a, b)
+			}
+		}
+	}
+
+	for f32, f8 := range map[float32]Float8{
+		1000:                          MaxValue,
+		-1000:                         signMask | MaxValue,
+		448:                           MaxValue,
+		float32(math.Inf(1)):          MaxValue,
+		float32(math.Inf(-1)):         signMask | MaxValue,
+		float32(math.NaN()):           0,
+		1:                             0x38,
+		float32(math.Copysign(0, -1)): 0,
+	} {
+		if c := m.ToFloat8(f32); c != f8 {
+			t.Errorf("ToFloat8(%g) = 0x%02x, wanted 0x%02x", f32, c, f8)
+		}
+	}
+
+	ms := ModeSaturate | ModeSignedZero
+	if c := ms.ToFloat8(float32(math.Copysign(0, -1))); c != negativeZero {
+		t.Errorf("ToFloat8(-0) = 0x%02x", c)
+	}
+	if c := ms.Div(0xb8, 0x00); c != signMask|MaxValue {
+		t.Errorf("-1 / +0 = 0x%02x", c)
+	}
+	if c := ms.Div(0xb8, negativeZero); c != MaxValue {
+		t.Errorf("-1 / -0 = 0x%02x", c)
+	}
+	if c := ms.Add(negativeZero, Infinity); c != MaxValue {
+		t.Errorf("-0 + Infinity = 0x%02x", c)
+	}
+}
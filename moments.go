@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// Moments is single-pass streaming accumulator of mean, variance and
+// skewness over float8 values (Welford's algorithm in float64).
+// The zero value is empty accumulator.
+type Moments struct {
+	n      int
+	mean   float64
+	m2, m3 float64
+}
+
+// Push value to accumulator
+func (m *Moments) Push(f8 Float8) {
+	x := float64(f8tof32[f8])
+
+	n1 := float64(m.n)
+	m.n++
+	n := float64(m.n)
+
+	delta := x - m.mean
+	deltaN := delta / n
+	term := delta * deltaN * n1
+
+	m.mean += deltaN
+	m.m3 += term*deltaN*(n-2) - 3*deltaN*m.m2
+	m.m2 += term
+}
+
+// PushSlice pushes all values of slice to accumulator
+func (m *Moments) PushSlice(f8s []Float8) {
+	for _, f8 := range f8s {
+		m.Push(f8)
+	}
+}
+
+// Count of accumulated values
+func (m *Moments) Count() int { return m.n }
+
+// Mean of accumulated values, it is 0 for empty accumulator
+func (m *Moments) Mean() float32 { return float32(m.mean) }
+
+// Var is unbiased sample variance of accumulated values,
+// it is 0 for less than two values
+func (m *Moments) Var() float32 {
+	if m.n < 2 {
+		return 0
+	}
+	return float32(m.m2 / float64(m.n-1))
+}
+
+// Skew is sample skewness (Fisher-Pearson coefficient g1) of accumulated
+// values, it is 0 if values are constant
+func (m *Moments) Skew() float32 {
+	if m.m2 == 0 {
+		return 0
+	}
+	return float32(math.Sqrt(float64(m.n)) * m.m3 / math.Pow(m.m2, 1.5))
+}
+
+// Reset accumulator to empty state
+func (m *Moments) Reset() { *m = Moments{} }
+
+// mean of float8 values in float64
+func mean64(f8s []Float8) float64 {
+	s := 0.0
+	for _, f8 := range f8s {
+		s += float64(f8tof32[f8])
+	}
+	return s / float64(len(f8s))
+}
+
+// co-moments of a and b around their means (two-pass in float64)
+func comoments(a, b []Float8) (sab, saa, sbb float64) {
+	ma, mb := mean64(a), mean64(b)
+	for i := range a {
+		da := float64(f8tof32[a[i]]) - ma
+		db := float64(f8tof32[b[i]]) - mb
+		sab += da * db
+		saa += da * da
+		sbb += db * db
+	}
+	return
+}
+
+// Cov is unbiased sample covariance of vectors,
+// it is 0 for vectors of less than two elements
+func Cov(a, b []Float8) float32 {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+	if len(a) < 2 {
+		return 0
+	}
+
+	sab, _, _ := comoments(a, b)
+	return float32(sab / float64(len(a)-1))
+}
+
+// Pearson correlation coefficient of vectors in range [-1, 1],
+// it is 0 if any of vectors is constant
+func Pearson(a, b []Float8) float32 {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+	if len(a) < 2 {
+		return 0
+	}
+
+	sab, saa, sbb := comoments(a, b)
+	if saa == 0 || sbb == 0 {
+		return 0
+	}
+
+	r := sab / math.Sqrt(saa*sbb)
+	return float32(max(-1, min(1, r)))
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestMoments(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	data := make([]Float8, 10000)
+	for i := range data {
+		data[i] = ToFloat8(float32(r.ExpFloat64()))
+	}
+
+	// two-pass reference
+	n := float64(len(data))
+	mean := 0.0
+	for _, x := range data {
+		mean += float64(ToFloat32(x))
+	}
+	mean /= n
+
+	m2, m3 := 0.0, 0.0
+	for _, x := range data {
+		d := float64(ToFloat32(x)) - mean
+		m2 += d * d
+		m3 += d * d * d
+	}
+	variance := m2 / (n - 1)
+	skew := math.Sqrt(n) * m3 / math.Pow(m2, 1.5)
+
+	var m Moments
+	m.PushSlice(data[:5000])
+	for _, x := range data[5000:] {
+		m.Push(x)
+	}
+
+	if m.Count() != len(data) {
+		t.Errorf("count %d", m.Count())
+	}
+	for name, c := range map[string]struct{ got, expected float64 }{
+		"mean": {float64(m.Mean()), mean},
+		"var":  {float64(m.Var()), variance},
+		"skew": {float64(m.Skew()), skew},
+	} {
+		if math.Abs(c.got-c.expected) > 1e-5*math.Abs(c.expected) {
+			t.Errorf("%s got=%g expected=%g", name, c.got, c.expected)
+		}
+	}
+
+	// exponential distribution is right skewed
+	if m.Skew() < 1 {
+		t.Errorf("unexpected skew %g", m.Skew())
+	}
+
+	m.Reset()
+	m.Push(ToFloat8(2))
+	if m.Mean() != 2 || m.Var() != 0 || m.Skew() != 0 {
+		t.Errorf("unexpected moments of single value %g %g %g", m.Mean(), m.Var(), m.Skew())
+	}
+}
+
+func TestCov(t *testing.T) {
+	a := []Float8{ToFloat8(1), ToFloat8(2), ToFloat8(3), ToFloat8(4)}
+	b := []Float8{ToFloat8(2), ToFloat8(4), ToFloat8(6), ToFloat8(8)}
+	c := []Float8{ToFloat8(4), ToFloat8(3), ToFloat8(2), ToFloat8(1)}
+	k := []Float8{ToFloat8(1), ToFloat8(1), ToFloat8(1), ToFloat8(1)}
+
+	for _, tt := range []struct {
+		name      string
+		got, want float32
+	}{
+		{"cov(a, a)", Cov(a, a), 5.0 / 3.0},
+		{"cov(a, b)", Cov(a, b), 10.0 / 3.0},
+		{"cov(a, c)", Cov(a, c), -5.0 / 3.0},
+		{"cov(a, k)", Cov(a, k), 0},
+		{"pearson(a, b)", Pearson(a, b), 1},
+		{"pearson(a, c)", Pearson(a, c), -1},
+		{"pearson(a, k)", Pearson(a, k), 0},
+		{"pearson(nil, nil)", Pearson(nil, nil), 0},
+	} {
+		if math.Abs(float64(tt.got-tt.want)) > 1e-6 {
+			t.Errorf("%s got=%g want=%g", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// Var of Moments is covariance of vector with itself
+	r := rand.New(rand.NewPCG(1, 2))
+	x := randFloat8s(r, 1000)
+	var m Moments
+	m.PushSlice(x)
+	if v, cov := m.Var(), Cov(x, x); math.Abs(float64(v-cov)) > 1e-5*float64(v) {
+		t.Errorf("var=%g cov=%g", v, cov)
+	}
+}
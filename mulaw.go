@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// μ-law companding parameter
+const muLaw = 255
+
+// code book of μ-law decoded values
+var mulawtof32 [256]float32
+
+func init() {
+	for u := 0; u < 256; u++ {
+		y := float64(u&^signMask) / 127
+		x := float32((math.Pow(1+muLaw, y) - 1) / muLaw)
+		if u&signMask != 0 {
+			x = -x
+		}
+		mulawtof32[u] = x
+	}
+}
+
+// MuLawEncode compresses value from range [-1, 1] using continuous μ-law
+// (μ = 255) companding: sign bit followed by 7-bit magnitude of
+// ln(1 + μ|x|) / ln(1 + μ). Values outside of the range saturate.
+// Small values are represented with finer resolution than large ones.
+func MuLawEncode(x float32) byte {
+	sign := byte(0)
+	if x < 0 {
+		sign, x = signMask, -x
+	}
+
+	if !(x <= 1) {
+		// saturate, including NaN
+		x = 1
+	}
+
+	y := math.Log1p(muLaw*float64(x)) / math.Log1p(muLaw)
+	return sign | byte(math.Round(y*127))
+}
+
+// MuLawDecode expands μ-law code to value from range [-1, 1]
+func MuLawDecode(u byte) float32 { return mulawtof32[u] }
+
+// MuLawEncodeSlice compresses slice of values, see MuLawEncode
+func MuLawEncodeSlice(f32s []float32) []byte {
+	us := make([]byte, len(f32s))
+	for i, x := range f32s {
+		us[i] = MuLawEncode(x)
+	}
+	return us
+}
+
+// MuLawDecodeSlice expands slice of μ-law codes, see MuLawDecode
+func MuLawDecodeSlice(us []byte) []float32 {
+	f32s := make([]float32, len(us))
+	for i, u := range us {
+		f32s[i] = mulawtof32[u]
+	}
+	return f32s
+}
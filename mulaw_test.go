@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMuLaw(t *testing.T) {
+	for u := 0; u < 0x100; u++ {
+		if u == signMask {
+			continue // negative zero is not produced
+		}
+
+		if c := MuLawEncode(MuLawDecode(byte(u))); c != byte(u) {
+			t.Errorf("0x%02x got=0x%02x", u, c)
+		}
+	}
+
+	for x, u := range map[float32]byte{0: 0x00, 1: 0x7f, -1: 0xff, 2: 0x7f, -5: 0xff} {
+		if c := MuLawEncode(x); c != u {
+			t.Errorf("%g wanted=0x%02x, got=0x%02x", x, u, c)
+		}
+	}
+
+	if c := MuLawEncode(float32(math.NaN())); c != 0x7f {
+		t.Errorf("NaN got=0x%02x", c)
+	}
+}
+
+func TestMuLawSlice(t *testing.T) {
+	src := []float32{0.001, -0.01, 0.1, -0.5, 0.9}
+	dst := MuLawDecodeSlice(MuLawEncodeSlice(src))
+
+	for i, x := range src {
+		// step of companded domain is 1/127, relative error is about ln(256)/127/2
+		if d := math.Abs(float64(dst[i] - x)); d > 0.03*math.Abs(float64(x))+1e-4 {
+			t.Errorf("%g got=%g", x, dst[i])
+		}
+	}
+}
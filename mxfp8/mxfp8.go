@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package mxfp8 implements OCP microscaling MXFP8 format. The vector is
+// split into blocks of 32 elements, each block shares E8M0 scale, an 8-bit
+// power of two 2^(e - 127). Elements are OCP E4M3FN values (bias 7,
+// subnormals, no infinity), which are not bit compatible with float8:
+//
+//	x[i] = 2^(scale[i/32] - 127) × e4m3fn[i]
+//
+// The scale 0xff marks block with non finite values (NaN).
+package mxfp8
+
+import (
+	"math"
+
+	"github.com/kshard/float8/internal/ocp"
+)
+
+const (
+	// BlockSize is number of elements sharing the scale
+	BlockSize = 32
+
+	// E8M0 scale of block with non finite values
+	scaleNaN = 0xff
+
+	scaleBias = 127
+
+	// exponent of the largest power of two representable by float8 element
+	emax = 8
+
+	// the largest finite E4M3FN element (448)
+	maxFinite = 0x7e
+)
+
+// Vector in MXFP8 block layout
+type Vector struct {
+	// E8M0 scales, one per block
+	Scales []uint8
+
+	// OCP E4M3FN elements
+	Data []uint8
+}
+
+// Len is number of elements
+func (v Vector) Len() int { return len(v.Data) }
+
+// Encode float32 vector into MXFP8 blocks. Shared exponent of block is
+// ⌊log2(max-abs)⌋ - 8 so that the largest element lands onto largest
+// binade of E4M3FN, elements are rounded to nearest even and saturate to ±448.
+func Encode(f32s []float32) Vector {
+	v := Vector{
+		Scales: make([]uint8, (len(f32s)+BlockSize-1)/BlockSize),
+		Data:   make([]uint8, len(f32s)),
+	}
+
+	for b := range v.Scales {
+		block := f32s[b*BlockSize : min((b+1)*BlockSize, len(f32s))]
+		v.Scales[b] = encodeBlock(v.Data[b*BlockSize:], block)
+	}
+
+	return v
+}
+
+func encodeBlock(dst []uint8, block []float32) uint8 {
+	maxAbs := 0.0
+	for _, x := range block {
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return scaleNaN
+		}
+		maxAbs = math.Max(maxAbs, math.Abs(float64(x)))
+	}
+
+	if maxAbs == 0 {
+		return scaleBias
+	}
+
+	_, exp := math.Frexp(maxAbs)
+	shared := min(max(exp-1-emax, -scaleBias), scaleBias)
+
+	for i, x := range block {
+		dst[i] = ocp.EncodeE4M3FN(float32(math.Ldexp(float64(x), -shared)))
+	}
+
+	return uint8(shared + scaleBias)
+}
+
+// Decode MXFP8 blocks into float32 vector
+func (v Vector) Decode() []float32 {
+	f32s := make([]float32, len(v.Data))
+	for i, x := range v.Data {
+		s := v.Scales[i/BlockSize]
+		if s == scaleNaN {
+			f32s[i] = float32(math.NaN())
+			continue
+		}
+		f32s[i] = float32(math.Ldexp(float64(ocp.DecodeE4M3FN(x)), int(s)-scaleBias))
+	}
+	return f32s
+}
+
+// Dot product of MXFP8 vectors. Elements are multiplied exactly within block,
+// block partial sum is scaled once by product of shared scales.
+func Dot(a, b Vector) float32 {
+	if len(a.Data) != len(b.Data) {
+		panic("vectors must have same length")
+	}
+
+	d := 0.0
+	for blk := range a.Scales {
+		sa, sb := a.Scales[blk], b.Scales[blk]
+		if sa == scaleNaN || sb == scaleNaN {
+			return float32(math.NaN())
+		}
+
+		p := 0.0
+		for i := blk * BlockSize; i < min((blk+1)*BlockSize, len(a.Data)); i++ {
+			p += float64(ocp.DecodeE4M3FN(a.Data[i])) * float64(ocp.DecodeE4M3FN(b.Data[i]))
+		}
+		d += math.Ldexp(p, int(sa)+int(sb)-2*scaleBias)
+	}
+
+	return float32(d)
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package mxfp8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	// blocks of very different magnitude
+	src := make([]float32, 3*BlockSize+5)
+	for i := range src {
+		src[i] = float32(r.NormFloat64() * math.Pow(10, float64(i/BlockSize*3-4)))
+	}
+
+	v := Encode(src)
+	if v.Len() != len(src) || len(v.Scales) != 4 {
+		t.Fatalf("unexpected layout %d, %d", v.Len(), len(v.Scales))
+	}
+
+	x := v.Decode()
+	for i := range src {
+		blk := src[i/BlockSize*BlockSize : min((i/BlockSize+1)*BlockSize, len(src))]
+		maxAbs := 0.0
+		for _, y := range blk {
+			maxAbs = math.Max(maxAbs, math.Abs(float64(y)))
+		}
+
+		// rounding error is below 2^-4 relative, subnormal step is 2^-17 of block max
+		if d := math.Abs(float64(x[i] - src[i])); d > math.Abs(float64(src[i]))/16+maxAbs/(1<<17) {
+			t.Errorf("%d: %g decoded %g", i, src[i], x[i])
+		}
+	}
+}
+
+func TestEncodeSpecial(t *testing.T) {
+	v := Encode(make([]float32, BlockSize))
+	if v.Scales[0] != scaleBias || v.Decode()[0] != 0 {
+		t.Errorf("unexpected zero block %x", v.Scales)
+	}
+
+	v = Encode([]float32{1, float32(math.Inf(1))})
+	if v.Scales[0] != scaleNaN || !math.IsNaN(float64(v.Decode()[0])) {
+		t.Errorf("unexpected non finite block %x", v.Scales)
+	}
+
+	v = Encode([]float32{1, 500})
+	if v.Scales[0] != scaleBias || v.Data[1] != maxFinite || v.Data[0] != 0x38 {
+		t.Errorf("unexpected block %x %x", v.Scales, v.Data)
+	}
+
+	// elements are OCP E4M3FN: rounded to nearest even, subnormals are kept
+	v = Encode([]float32{448, 1.0625, -1.1875, 0x1p-9, -3 * 0x1p-9})
+	for i, e := range []uint8{0x7e, 0x38, 0xba, 0x01, 0x83} {
+		if v.Data[i] != e {
+			t.Errorf("%d: wanted=0x%02x, got=0x%02x", i, e, v.Data[i])
+		}
+	}
+}
+
+func TestDot(t *testing.T) {
+	a := make([]float32, 40)
+	b := make([]float32, 40)
+	expected := float32(0)
+	for i := range a {
+		a[i] = float32(i%4) * 1e-3
+		b[i] = float32(i%3) * 1e3
+		expected += a[i] * b[i]
+	}
+
+	if d := Dot(Encode(a), Encode(b)); math.Abs(float64(d-expected)) > 0.25*math.Abs(float64(expected)) {
+		t.Errorf("wanted=%g, got=%g", expected, d)
+	}
+
+	if d := Dot(Encode([]float32{float32(math.NaN())}), Encode([]float32{1})); !math.IsNaN(float64(d)) {
+		t.Errorf("NaN is expected, got=%g", d)
+	}
+}
@@ -0,0 +1,228 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// LayerNorm normalizes activations to zero mean and unit variance followed by
+// element wise affine transform: dst[i] = (src[i] - mean) / √(var + eps) × gamma[i] + beta[i].
+// Statistics are computed in float32, the result is quantized.
+// gamma and beta are optional (nil), dst and src might be the same slice.
+func LayerNorm(dst, src []Float8, gamma, beta []float32, eps float32) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+	if (gamma != nil && len(gamma) != len(src)) || (beta != nil && len(beta) != len(src)) {
+		panic("gamma and beta must have same length as input")
+	}
+	if len(src) == 0 {
+		return
+	}
+
+	n := float32(len(src))
+
+	mean := float32(0)
+	for _, f8 := range src {
+		mean += f8tof32[f8]
+	}
+	mean /= n
+
+	variance := float32(0)
+	for _, f8 := range src {
+		d := f8tof32[f8] - mean
+		variance += d * d
+	}
+	variance /= n
+
+	inv := float32(1 / math.Sqrt(float64(variance+eps)))
+	for i, f8 := range src {
+		x := (f8tof32[f8] - mean) * inv
+		if gamma != nil {
+			x *= gamma[i]
+		}
+		if beta != nil {
+			x += beta[i]
+		}
+		dst[i] = ToFloat8(x)
+	}
+}
+
+// Conv1D computes discrete convolution of signal with kernel (the kernel is
+// flipped) without padding, accumulating in float32:
+//
+//	dst[i] = Σ signal[i×stride + j] × kernel[len(kernel)-1-j]
+//
+// The length of dst must be (len(signal) - len(kernel)) / stride + 1.
+func Conv1D(dst []float32, signal []Float8, kernel []Float8, stride int) {
+	conv1D(dst, signal, kernel, stride, true)
+}
+
+// Correlate1D computes cross-correlation of signal with kernel (convolution
+// as defined by machine learning frameworks) without padding, accumulating in float32:
+//
+//	dst[i] = Σ signal[i×stride + j] × kernel[j]
+//
+// The length of dst must be (len(signal) - len(kernel)) / stride + 1.
+func Correlate1D(dst []float32, signal []Float8, kernel []Float8, stride int) {
+	conv1D(dst, signal, kernel, stride, false)
+}
+
+func conv1D(dst []float32, signal []Float8, kernel []Float8, stride int, flip bool) {
+	if stride < 1 {
+		panic("stride must be positive")
+	}
+	if len(kernel) == 0 || len(kernel) > len(signal) {
+		panic("kernel must be non empty and not longer than signal")
+	}
+	if len(dst) != (len(signal)-len(kernel))/stride+1 {
+		panic("invalid length of output")
+	}
+
+	k := make([]float32, len(kernel))
+	for j, f8 := range kernel {
+		if flip {
+			k[len(kernel)-1-j] = f8tof32[f8]
+		} else {
+			k[j] = f8tof32[f8]
+		}
+	}
+
+	for i := range dst {
+		window := signal[i*stride : i*stride+len(k)]
+		acc := float32(0)
+		for j, f8 := range window {
+			acc += f8tof32[f8] * k[j]
+		}
+		dst[i] = acc
+	}
+}
+
+// NHWC is shape of batch of images: number, height, width and channels.
+// Elements are stored in row-major order, channels are the innermost dimension.
+type NHWC struct{ N, H, W, C int }
+
+// Len returns number of elements
+func (s NHWC) Len() int { return s.N * s.H * s.W * s.C }
+
+func (s NHWC) at(n, y, x, c int) int { return ((n*s.H+y)*s.W+x)*s.C + c }
+
+// Window returns shape of output after sliding window of size kh × kw with
+// stride over images (no padding), the number of channels is c.
+func (s NHWC) Window(kh, kw, c, stride int) NHWC {
+	return NHWC{N: s.N, H: (s.H-kh)/stride + 1, W: (s.W-kw)/stride + 1, C: c}
+}
+
+func (s NHWC) window(kh, kw, stride int) {
+	if stride < 1 {
+		panic("stride must be positive")
+	}
+	if kh < 1 || kw < 1 || kh > s.H || kw > s.W {
+		panic("window must be non empty and fit into image")
+	}
+}
+
+// Conv2D computes 2D convolution (cross-correlation as defined by machine
+// learning frameworks) of images with kernel without padding, accumulating in float32.
+// The kernel is stored in HWIO layout: kh × kw × in.C × cout.
+// The shape of dst is in.Window(kh, kw, cout, stride).
+func Conv2D(dst []float32, src []Float8, in NHWC, kernel []Float8, kh, kw, cout, stride int) {
+	in.window(kh, kw, stride)
+	if len(src) != in.Len() {
+		panic("invalid length of input")
+	}
+	if len(kernel) != kh*kw*in.C*cout {
+		panic("invalid length of kernel")
+	}
+
+	out := in.Window(kh, kw, cout, stride)
+	if len(dst) != out.Len() {
+		panic("invalid length of output")
+	}
+
+	k := make([]float32, len(kernel))
+	for i, f8 := range kernel {
+		k[i] = f8tof32[f8]
+	}
+
+	for n := 0; n < out.N; n++ {
+		for y := 0; y < out.H; y++ {
+			for x := 0; x < out.W; x++ {
+				acc := dst[out.at(n, y, x, 0) : out.at(n, y, x, 0)+cout]
+				clear(acc)
+
+				for i := 0; i < kh; i++ {
+					for j := 0; j < kw; j++ {
+						pixel := src[in.at(n, y*stride+i, x*stride+j, 0):][:in.C]
+						for c, f8 := range pixel {
+							v := f8tof32[f8]
+							w := k[((i*kw+j)*in.C+c)*cout:][:cout]
+							for o := range acc {
+								acc[o] += v * w[o]
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// MaxPool computes maximum over windows of size × size with stride, per channel.
+// The shape of dst is in.Window(size, size, in.C, stride).
+func MaxPool(dst []Float8, src []Float8, in NHWC, size, stride int) {
+	pool(dst, src, in, size, stride, func(window []float32) float32 {
+		max := window[0]
+		for _, v := range window[1:] {
+			if v > max {
+				max = v
+			}
+		}
+		return max
+	})
+}
+
+// AvgPool computes average over windows of size × size with stride, per
+// channel, accumulating in float32. The shape of dst is in.Window(size, size, in.C, stride).
+func AvgPool(dst []Float8, src []Float8, in NHWC, size, stride int) {
+	pool(dst, src, in, size, stride, func(window []float32) float32 {
+		sum := float32(0)
+		for _, v := range window {
+			sum += v
+		}
+		return sum / float32(len(window))
+	})
+}
+
+func pool(dst []Float8, src []Float8, in NHWC, size, stride int, f func([]float32) float32) {
+	in.window(size, size, stride)
+	if len(src) != in.Len() {
+		panic("invalid length of input")
+	}
+
+	out := in.Window(size, size, in.C, stride)
+	if len(dst) != out.Len() {
+		panic("invalid length of output")
+	}
+
+	window := make([]float32, size*size)
+	for n := 0; n < out.N; n++ {
+		for y := 0; y < out.H; y++ {
+			for x := 0; x < out.W; x++ {
+				for c := 0; c < in.C; c++ {
+					for i := 0; i < size; i++ {
+						for j := 0; j < size; j++ {
+							window[i*size+j] = f8tof32[src[in.at(n, y*stride+i, x*stride+j, c)]]
+						}
+					}
+					dst[out.at(n, y, x, c)] = ToFloat8(f(window))
+				}
+			}
+		}
+	}
+}
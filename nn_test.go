@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLayerNorm(t *testing.T) {
+	src := []Float8{0x38, 0x40, 0x48, 0x50} // 1, 2, 4, 8; mean 3.75, std 2.68
+	dst := make([]Float8, len(src))
+
+	LayerNorm(dst, src, nil, nil, 1e-5)
+
+	mean := float32(0)
+	for _, f8 := range dst {
+		mean += ToFloat32(f8)
+	}
+	if mean/4 < -0.25 || mean/4 > 0.25 {
+		t.Errorf("mean is not zero %f", mean/4)
+	}
+
+	LayerNorm(dst, src, []float32{2, 2, 2, 2}, []float32{1, 1, 1, 1}, 1e-5)
+	// (x - 3.75) / 2.68 * 2 + 1
+	if expected := []Float8{0xb8, 0xa9, 0x39, 0x48}; !bytes.Equal(dst, expected) {
+		t.Errorf("got=%v expected=%v", dst, expected)
+	}
+}
+
+func TestConv1D(t *testing.T) {
+	signal := []Float8{0x38, 0x40, 0x44, 0x48, 0x4a} // 1, 2, 3, 4, 5
+	kernel := []Float8{0x38, 0x00, 0xb8}             // 1, 0, -1
+
+	dst := make([]float32, 3)
+	Correlate1D(dst, signal, kernel, 1)
+	for i, x := range []float32{-2, -2, -2} {
+		if dst[i] != x {
+			t.Errorf("correlate %d wanted=%f, got=%f", i, x, dst[i])
+		}
+	}
+
+	Conv1D(dst, signal, kernel, 1)
+	for i, x := range []float32{2, 2, 2} {
+		if dst[i] != x {
+			t.Errorf("convolve %d wanted=%f, got=%f", i, x, dst[i])
+		}
+	}
+
+	dst = make([]float32, 2)
+	Correlate1D(dst, signal, kernel, 2)
+	for i, x := range []float32{-2, -2} {
+		if dst[i] != x {
+			t.Errorf("stride %d wanted=%f, got=%f", i, x, dst[i])
+		}
+	}
+}
+
+// 1 × 3 × 3 × 2 image: channel 0 is 1..9, channel 1 is -1..-9
+var image = []Float8{
+	0x38, 0xb8, 0x40, 0xc0, 0x44, 0xc4,
+	0x48, 0xc8, 0x4a, 0xca, 0x4c, 0xcc,
+	0x4e, 0xce, 0x50, 0xd0, 0x51, 0xd1,
+}
+
+func TestConv2D(t *testing.T) {
+	in := NHWC{N: 1, H: 3, W: 3, C: 2}
+
+	// 2 × 2 kernel, 2 input channels, 1 output channel: sums channel 0
+	kernel := []Float8{0x38, 0x00, 0x38, 0x00, 0x38, 0x00, 0x38, 0x00}
+	out := in.Window(2, 2, 1, 1)
+	dst := make([]float32, out.Len())
+
+	Conv2D(dst, image, in, kernel, 2, 2, 1, 1)
+	for i, x := range []float32{12, 16, 24, 28} {
+		if dst[i] != x {
+			t.Errorf("%d wanted=%f, got=%f", i, x, dst[i])
+		}
+	}
+}
+
+func TestPool(t *testing.T) {
+	in := NHWC{N: 1, H: 3, W: 3, C: 2}
+	out := in.Window(2, 2, 2, 1)
+	dst := make([]Float8, out.Len())
+
+	MaxPool(dst, image, in, 2, 1)
+	// channel 0: 5, 6, 8, 9; channel 1: -1, -2, -4, -5
+	if expected := []Float8{0x4a, 0xb8, 0x4c, 0xc0, 0x50, 0xc8, 0x51, 0xca}; !bytes.Equal(dst, expected) {
+		t.Errorf("max got=%v expected=%v", dst, expected)
+	}
+
+	AvgPool(dst, image, in, 2, 1)
+	// channel 0: 3, 4, 6, 7; channel 1: -3, -4, -6, -7
+	if expected := []Float8{0x44, 0xc4, 0x48, 0xc8, 0x4c, 0xcc, 0x4e, 0xce}; !bytes.Equal(dst, expected) {
+		t.Errorf("avg got=%v expected=%v", dst, expected)
+	}
+}
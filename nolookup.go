@@ -0,0 +1,17 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+//go:build nolookup
+
+package float8
+
+// Add float8(s), computed without code book (build tag nolookup)
+func Add(a, b Float8) Float8 { return addBits(a, b) }
+
+// Multiply float8(s), computed without code book (build tag nolookup)
+func Mul(a, b Float8) Float8 { return mulBits(a, b) }
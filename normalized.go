@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// QuantizeNormalized prepares vector for cosine similarity search (e.g. ANN
+// index). The vector is L2-normalized, scaled so that max-abs element maps
+// onto the largest finite float8 and quantized. The L2 norm of original
+// vector is returned separately. Cosine is scale invariant, therefore
+// Cosine computed over quantized vectors approximates cosine of originals
+// within CosineErrorBound.
+func QuantizeNormalized(v []float32) (q []Float8, norm float32) {
+	ss, maxAbs := float64(0), float32(0)
+	for _, x := range v {
+		ss += float64(x) * float64(x)
+		if x < 0 {
+			x = -x
+		}
+		if x > maxAbs {
+			maxAbs = x
+		}
+	}
+
+	q = make([]Float8, len(v))
+	if maxAbs == 0 {
+		return q, 0
+	}
+
+	norm = float32(math.Sqrt(ss))
+
+	// normalization and scaling are fused: max-abs / norm ↦ largest finite
+	// scale is computed in float64, it overflows float32 for subnormal max-abs
+	s := float64(f8tof32[MaxValue]) / float64(maxAbs)
+	for i, x := range v {
+		q[i] = ToFloat8(float32(s * float64(x)))
+	}
+
+	return q, norm
+}
+
+// DequantizeNormalized restores vector produced by QuantizeNormalized,
+// the result has L2 norm equal to norm.
+func DequantizeNormalized(q []Float8, norm float32) []float32 {
+	v := make([]float32, len(q))
+
+	ss := float64(0)
+	for _, f8 := range q {
+		x := float64(f8tof32[f8])
+		ss += x * x
+	}
+
+	if ss == 0 {
+		return v
+	}
+
+	s := norm / float32(math.Sqrt(ss))
+	for i, f8 := range q {
+		v[i] = s * f8tof32[f8]
+	}
+
+	return v
+}
+
+// CosineErrorBound is upper bound of |cos(a, b) - cos(qa, qb)| where qa, qb
+// are n-dimensional vectors produced by QuantizeNormalized.
+//
+// Let u be the scaled unit vector, ToFloat8 truncates mantissa so that
+// element-wise relative error is below 2^-3, values below the smallest
+// positive float8 m are flushed to zero. The max-abs element of u is
+// scaled to 448, so flushed elements contribute below m/448 each.
+// Hence ‖q - u‖ ≤ η·‖u‖ with η = 2^-3 + √n·m/448. The angle between q
+// and u is at most arcsin(η), and cosine is 1-Lipschitz in angle, thus
+// the bound is 2·arcsin(η).
+func CosineErrorBound(n int) float32 {
+	eta := 0.125 + math.Sqrt(float64(n))*float64(f8tof32[0x01])/float64(f8tof32[MaxValue])
+	if eta >= 1 {
+		return 2
+	}
+
+	return float32(math.Min(2, 2*math.Asin(eta)))
+}
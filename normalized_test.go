@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func cosine32(a, b []float32) float64 {
+	ab, aa, bb := 0.0, 0.0, 0.0
+	for i := range a {
+		ab += float64(a[i]) * float64(b[i])
+		aa += float64(a[i]) * float64(a[i])
+		bb += float64(b[i]) * float64(b[i])
+	}
+	return ab / math.Sqrt(aa*bb)
+}
+
+func TestQuantizeNormalized(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{2, 16, 384, 1536} {
+		bound := float64(CosineErrorBound(n))
+
+		for k := 0; k < 20; k++ {
+			a, b := make([]float32, n), make([]float32, n)
+			for i := range a {
+				a[i] = 1e3 * float32(r.NormFloat64())
+				b[i] = 1e-3 * float32(r.NormFloat64())
+			}
+
+			qa, na := QuantizeNormalized(a)
+			qb, _ := QuantizeNormalized(b)
+
+			c := 1 - float64(Cosine(qa, qb))
+			if d := math.Abs(c - cosine32(a, b)); d > bound {
+				t.Errorf("n=%d: cosine error %g exceeds bound %g", n, d, bound)
+			}
+
+			x := DequantizeNormalized(qa, na)
+			if d := math.Abs(1 - cosine32(a, x)); d > bound {
+				t.Errorf("n=%d: cosine error %g of restored vector exceeds bound %g", n, d, bound)
+			}
+
+			ss := 0.0
+			for _, v := range x {
+				ss += float64(v) * float64(v)
+			}
+			if d := math.Abs(math.Sqrt(ss)-float64(na)) / float64(na); d > 1e-5 {
+				t.Errorf("n=%d: norm of restored vector %g, wanted %g", n, math.Sqrt(ss), na)
+			}
+		}
+	}
+}
+
+func TestQuantizeNormalizedZero(t *testing.T) {
+	q, norm := QuantizeNormalized([]float32{0, 0})
+	if norm != 0 || q[0] != 0 || q[1] != 0 {
+		t.Errorf("unexpected quantization %v, %g", q, norm)
+	}
+
+	if x := DequantizeNormalized(q, norm); x[0] != 0 || x[1] != 0 {
+		t.Errorf("unexpected vector %v", x)
+	}
+}
+
+func TestQuantizeNormalizedSubnormal(t *testing.T) {
+	// max-abs is float32 subnormal, 448 / max-abs overflows float32
+	q, _ := QuantizeNormalized([]float32{1e-40, 0, -1e-40, 5e-41})
+	if expected := []Float8{MaxValue, 0x00, signMask | MaxValue, ToFloat8(224)}; !bytes.Equal(q, expected) {
+		t.Errorf("got=%v expected=%v", q, expected)
+	}
+}
+
+func TestCosineErrorBound(t *testing.T) {
+	if b := CosineErrorBound(1); b < 0.25 || b > 0.26 {
+		t.Errorf("unexpected bound %g", b)
+	}
+
+	if CosineErrorBound(math.MaxInt32) != 2 {
+		t.Errorf("bound is not saturated")
+	}
+}
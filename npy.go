@@ -0,0 +1,217 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kshard/float8/internal/ocp"
+)
+
+// See https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html
+var npyMagic = []byte("\x93NUMPY")
+
+// limit of tensor elements, protects against malformed headers
+const npyMaxSize = 1 << 30
+
+// limit of header length, NumPy rejects larger headers by default
+const npyMaxHeaderSize = 10000
+
+// WriteNpy writes float8 tensor of given shape as NumPy .npy file (version 1.0).
+// Elements are converted to OCP E4M3FN (round to nearest even, saturated to
+// ±448) and tagged as uint8 ('|u1'), use .view(ml_dtypes.float8_e4m3fn)
+// to interpret them as float8 in Python.
+func WriteNpy(w io.Writer, data []Float8, shape []int) error {
+	if shapeSize(shape) != len(data) {
+		return fmt.Errorf("npy: shape %v does not match %d elements", shape, len(data))
+	}
+
+	dims := make([]string, len(shape))
+	for i, x := range shape {
+		dims[i] = strconv.Itoa(x)
+	}
+	tuple := strings.Join(dims, ", ")
+	if len(shape) == 1 {
+		tuple += ","
+	}
+
+	header := fmt.Sprintf("{'descr': '|u1', 'fortran_order': False, 'shape': (%s), }", tuple)
+
+	// magic, version, header length and header are aligned to 64 bytes
+	pad := 64 - (len(npyMagic)+2+2+len(header)+1)%64
+	if pad == 64 {
+		pad = 0
+	}
+	header += strings.Repeat(" ", pad) + "\n"
+
+	buf := make([]byte, 0, len(npyMagic)+4+len(header))
+	buf = append(buf, npyMagic...)
+	buf = append(buf, 1, 0)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(header)))
+	buf = append(buf, header...)
+
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+
+	payload := make([]byte, len(data))
+	for i, x := range data {
+		payload[i] = ocp.EncodeE4M3FN(ToFloat32(x))
+	}
+
+	_, err := w.Write(payload)
+	return err
+}
+
+// ReadNpy reads float8 tensor from NumPy .npy file. One byte payloads of
+// OCP E4M3FN are supported: uint8 ('|u1') as written by WriteNpy and opaque
+// ('|V1') as written by ml_dtypes.float8_e4m3fn. Elements are rounded to
+// nearest float8, subnormals below its range flush to zero and NaN becomes
+// Infinity. Fortran order is not supported.
+func ReadNpy(r io.Reader) (data []Float8, shape []int, err error) {
+	pre := make([]byte, len(npyMagic)+2)
+	if _, err = io.ReadFull(r, pre); err != nil {
+		return nil, nil, err
+	}
+
+	if !bytes.Equal(pre[:len(npyMagic)], npyMagic) {
+		return nil, nil, errors.New("npy: invalid magic")
+	}
+
+	var size int
+	switch pre[len(npyMagic)] {
+	case 1:
+		var n uint16
+		err = binary.Read(r, binary.LittleEndian, &n)
+		size = int(n)
+	case 2, 3:
+		var n uint32
+		err = binary.Read(r, binary.LittleEndian, &n)
+		size = int(n)
+	default:
+		return nil, nil, fmt.Errorf("npy: unsupported version %d", pre[len(npyMagic)])
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if size > npyMaxHeaderSize {
+		return nil, nil, fmt.Errorf("npy: header of %d bytes is too large", size)
+	}
+
+	header := make([]byte, size)
+	if _, err = io.ReadFull(r, header); err != nil {
+		return nil, nil, err
+	}
+
+	shape, err = npyHeader(string(header))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	size = 1
+	for _, x := range shape {
+		if x != 0 && size > npyMaxSize/x {
+			return nil, nil, fmt.Errorf("npy: shape %v is too large", shape)
+		}
+		size *= x
+	}
+
+	// payload grows as it is read, header cannot force large allocation
+	data, err = io.ReadAll(io.LimitReader(r, int64(size)))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) != size {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+
+	for i, x := range data {
+		data[i] = RoundNearestEven.ToFloat8(ocp.DecodeE4M3FN(x))
+	}
+
+	return data, shape, nil
+}
+
+// parse header dictionary, returns shape
+func npyHeader(header string) ([]int, error) {
+	descr, err := npyField(header, "descr")
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.Trim(descr, `'"`) {
+	case "|u1", "<u1", ">u1", "u1", "|V1", "<V1", ">V1", "V1":
+	default:
+		return nil, fmt.Errorf("npy: unsupported dtype %s", descr)
+	}
+
+	order, err := npyField(header, "fortran_order")
+	if err != nil {
+		return nil, err
+	}
+	if order != "False" {
+		return nil, errors.New("npy: fortran order is not supported")
+	}
+
+	tuple, err := npyField(header, "shape")
+	if err != nil {
+		return nil, err
+	}
+
+	tuple = strings.TrimSpace(tuple)
+	if len(tuple) < 2 || tuple[0] != '(' || tuple[len(tuple)-1] != ')' {
+		return nil, fmt.Errorf("npy: invalid shape %s", tuple)
+	}
+
+	shape := []int{}
+	for _, x := range strings.Split(tuple[1:len(tuple)-1], ",") {
+		x = strings.TrimSpace(x)
+		if x == "" {
+			continue
+		}
+		v, err := strconv.Atoi(x)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("npy: invalid shape %s", tuple)
+		}
+		shape = append(shape, v)
+	}
+
+	return shape, nil
+}
+
+// value of the key from python dict literal
+func npyField(header, key string) (string, error) {
+	at := strings.Index(header, "'"+key+"'")
+	if at == -1 {
+		return "", fmt.Errorf("npy: header has no %s", key)
+	}
+
+	s := strings.TrimSpace(header[at+len(key)+2:])
+	if !strings.HasPrefix(s, ":") {
+		return "", fmt.Errorf("npy: invalid header %s", header)
+	}
+	s = strings.TrimSpace(s[1:])
+
+	end := strings.IndexAny(s, ",}")
+	if strings.HasPrefix(s, "(") {
+		end = strings.Index(s, ")") + 1
+	}
+	if end <= 0 {
+		return "", fmt.Errorf("npy: invalid header %s", header)
+	}
+
+	return strings.TrimSpace(s[:end]), nil
+}
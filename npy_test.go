@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestNpy(t *testing.T) {
+	for _, shape := range [][]int{{6}, {2, 3}, {1, 2, 3}} {
+		data := []Float8{0x00, 0x38, 0xb8, 0x46, 0x7e, 0xfe}
+
+		var buf bytes.Buffer
+		if err := WriteNpy(&buf, data, shape); err != nil {
+			t.Fatal(err)
+		}
+
+		if (buf.Len()-len(data))%64 != 0 {
+			t.Errorf("header is not aligned %d", buf.Len())
+		}
+
+		v, s, err := ReadNpy(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(v, data) || !reflect.DeepEqual(s, shape) {
+			t.Errorf("got=%v %v expected=%v %v", v, s, data, shape)
+		}
+	}
+}
+
+func TestNpyMlDtypes(t *testing.T) {
+	// np.save of array(..., dtype=ml_dtypes.float8_e4m3fn)
+	header := "{'descr': '|V1', 'fortran_order': False, 'shape': (2, 2), }"
+	header += string(bytes.Repeat([]byte(" "), 128-10-len(header)-1)) + "\n"
+
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	buf.Write([]byte{byte(len(header)), 0})
+	buf.WriteString(header)
+	// 1, subnormal 2^-9, subnormal 6×2^-9, NaN
+	buf.Write([]byte{0x38, 0x01, 0x06, 0x7f})
+
+	v, s, err := ReadNpy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(v, []Float8{0x38, 0x00, ToFloat8(0.01171875), Infinity}) || !reflect.DeepEqual(s, []int{2, 2}) {
+		t.Errorf("unexpected tensor %v %v", v, s)
+	}
+}
+
+func TestNpyE4M3FN(t *testing.T) {
+	// payload is E4M3FN: 480 saturates, 0x01 of float8 is not E4M3FN subnormal
+	var buf bytes.Buffer
+	if err := WriteNpy(&buf, []Float8{0x01, Infinity, signMask | Infinity, 0x38}, []int{4}); err != nil {
+		t.Fatal(err)
+	}
+
+	payload := buf.Bytes()[buf.Len()-4:]
+	if !bytes.Equal(payload, []byte{0x04, 0x7e, 0xfe, 0x38}) {
+		t.Errorf("unexpected payload %#v", payload)
+	}
+}
+
+func TestNpyMalformed(t *testing.T) {
+	if err := WriteNpy(&bytes.Buffer{}, []Float8{1, 2, 3}, []int{2, 2}); err == nil {
+		t.Errorf("error is expected for invalid shape")
+	}
+
+	for _, header := range []string{
+		"{'descr': '<f4', 'fortran_order': False, 'shape': (2,), }",
+		"{'descr': '|u1', 'fortran_order': True, 'shape': (2,), }",
+		"{'descr': '|u1', 'fortran_order': False, }",
+		"{'descr': '|u1', 'fortran_order': False, 'shape': (3037000500, 3037000500), }",
+		"{'descr': '|u1', 'fortran_order': False, 'shape': (65536, 65536, 2), }",
+		"{'descr': '|u1', 'fortran_order': False, 'shape': (1048576,), }",
+	} {
+		var buf bytes.Buffer
+		buf.WriteString("\x93NUMPY\x01\x00")
+		buf.Write([]byte{byte(len(header)), 0})
+		buf.WriteString(header)
+		buf.Write([]byte{0x38, 0x40})
+
+		if _, _, err := ReadNpy(&buf); err == nil {
+			t.Errorf("error is expected for %s", header)
+		}
+	}
+
+	// version 2.0 header length of 4 GiB
+	buf := bytes.NewBufferString("\x93NUMPY\x02\x00\xff\xff\xff\xff")
+	if _, _, err := ReadNpy(buf); err == nil {
+		t.Errorf("error is expected for large header")
+	}
+}
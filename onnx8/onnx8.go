@@ -0,0 +1,206 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package onnx8 encodes float8 tensors as ONNX TensorProto
+// (https://github.com/onnx/onnx/blob/main/onnx/onnx.proto).
+// The package encodes wire format directly, it does not require protobuf
+// runtime or generated ONNX bindings.
+//
+// ONNX data types FLOAT8E4M3FN (subnormals, no infinity, 0x7f is NaN) and
+// FLOAT8E5M2 are not bit compatible with float8, tensors are decoded and
+// rounded to nearest even float8 on read (as float8.ReadNpy does). Tensors are written as FLOAT8E4M3FN raw data,
+// values below 2^-6 are rounded to subnormals and 480 saturates to 448.
+package onnx8
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/internal/ocp"
+)
+
+// TensorProto.DataType of 8-bit floating point values
+const (
+	DataTypeFloat8E4M3FN = 17
+	DataTypeFloat8E5M2   = 19
+)
+
+// field numbers of message TensorProto
+const (
+	fieldDims      = 1
+	fieldDataType  = 2
+	fieldInt32Data = 5
+	fieldName      = 8
+	fieldRawData   = 9
+)
+
+// wire types
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// Marshal encodes tensor as message TensorProto of FLOAT8E4M3FN with raw data
+func Marshal(name string, t *float8.Tensor) []byte {
+	data := t.Flat()
+	buf := make([]byte, 0, len(data)+len(name)+16*t.Rank()+16)
+
+	for _, x := range t.Shape() {
+		buf = binary.AppendUvarint(buf, fieldDims<<3|wireVarint)
+		buf = binary.AppendUvarint(buf, uint64(x))
+	}
+
+	buf = binary.AppendUvarint(buf, fieldDataType<<3|wireVarint)
+	buf = binary.AppendUvarint(buf, DataTypeFloat8E4M3FN)
+
+	if name != "" {
+		buf = binary.AppendUvarint(buf, fieldName<<3|wireBytes)
+		buf = binary.AppendUvarint(buf, uint64(len(name)))
+		buf = append(buf, name...)
+	}
+
+	buf = binary.AppendUvarint(buf, fieldRawData<<3|wireBytes)
+	buf = binary.AppendUvarint(buf, uint64(len(data)))
+	for _, x := range data {
+		buf = append(buf, ocp.EncodeE4M3FN(float8.ToFloat32(x)))
+	}
+
+	return buf
+}
+
+// Unmarshal decodes message TensorProto of FLOAT8E4M3FN or FLOAT8E5M2,
+// elements are read either from raw_data or int32_data. Unknown fields
+// are skipped.
+func Unmarshal(buf []byte) (string, *float8.Tensor, error) {
+	var (
+		name     string
+		dims     []int
+		dataType uint64
+		raw      []byte
+		int32s   []byte
+	)
+
+	for len(buf) > 0 {
+		tag, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return "", nil, errors.New("onnx8: malformed tag")
+		}
+		buf = buf[n:]
+
+		field, wire := tag>>3, tag&0x7
+		switch wire {
+		case wireVarint:
+			x, n := binary.Uvarint(buf)
+			if n <= 0 {
+				return "", nil, errors.New("onnx8: malformed varint")
+			}
+			buf = buf[n:]
+
+			switch field {
+			case fieldDims:
+				if x > 1<<31-1 {
+					return "", nil, errors.New("onnx8: invalid dimension")
+				}
+				dims = append(dims, int(x))
+			case fieldDataType:
+				dataType = x
+			case fieldInt32Data:
+				int32s = binary.AppendUvarint(int32s, x)
+			}
+
+		case wireBytes:
+			size, n := binary.Uvarint(buf)
+			if n <= 0 || size > uint64(len(buf)-n) {
+				return "", nil, errors.New("onnx8: malformed bytes")
+			}
+			buf = buf[n:]
+
+			switch field {
+			case fieldDims:
+				// packed dims
+				for seq := buf[:size]; len(seq) > 0; {
+					x, n := binary.Uvarint(seq)
+					if n <= 0 || x > 1<<31-1 {
+						return "", nil, errors.New("onnx8: invalid dimension")
+					}
+					dims = append(dims, int(x))
+					seq = seq[n:]
+				}
+			case fieldName:
+				name = string(buf[:size])
+			case fieldRawData:
+				raw = buf[:size]
+			case fieldInt32Data:
+				int32s = append(int32s, buf[:size]...)
+			}
+			buf = buf[size:]
+
+		case wireFixed32:
+			if len(buf) < 4 {
+				return "", nil, errors.New("onnx8: malformed fixed32")
+			}
+			buf = buf[4:]
+
+		case wireFixed64:
+			if len(buf) < 8 {
+				return "", nil, errors.New("onnx8: malformed fixed64")
+			}
+			buf = buf[8:]
+
+		default:
+			return "", nil, fmt.Errorf("onnx8: unsupported wire type %d", wire)
+		}
+	}
+
+	var decode func(uint8) float32
+	switch dataType {
+	case DataTypeFloat8E4M3FN:
+		decode = ocp.DecodeE4M3FN
+	case DataTypeFloat8E5M2:
+		decode = ocp.DecodeE5M2
+	default:
+		return "", nil, fmt.Errorf("onnx8: unsupported data type %d", dataType)
+	}
+
+	// int32_data holds one element per value
+	if raw == nil {
+		for seq := int32s; len(seq) > 0; {
+			x, n := binary.Uvarint(seq)
+			if n <= 0 || x > 0xff {
+				return "", nil, errors.New("onnx8: malformed int32_data")
+			}
+			raw = append(raw, byte(x))
+			seq = seq[n:]
+		}
+	}
+
+	size := uint64(1)
+	if slices.Contains(dims, 0) {
+		size = 0
+	}
+	for _, x := range dims {
+		if size *= uint64(x); size > uint64(len(raw)) {
+			break
+		}
+	}
+	if size != uint64(len(raw)) {
+		return "", nil, fmt.Errorf("onnx8: shape %v does not match %d elements", dims, len(raw))
+	}
+
+	data := make([]float8.Float8, len(raw))
+	for i, x := range raw {
+		data[i] = float8.RoundNearestEven.ToFloat8(decode(x))
+	}
+
+	return name, float8.TensorOf(data, dims...), nil
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package onnx8
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/internal/ocp"
+)
+
+func TestMarshal(t *testing.T) {
+	in := float8.TensorOf([]float8.Float8{0x00, 0x38, 0xb8, 0x46, 0x10, 0x50}, 2, 3)
+
+	buf := Marshal("w", in)
+
+	name, out, err := Unmarshal(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name != "w" || !reflect.DeepEqual(out.Shape(), in.Shape()) || !bytes.Equal(out.Flat(), in.Flat()) {
+		t.Errorf("got=%s %v %v expected=w %v %v", name, out.Shape(), out.Flat(), in.Shape(), in.Flat())
+	}
+}
+
+func TestUnmarshalInt32Data(t *testing.T) {
+	// dims: [2] packed, data_type: FLOAT8E4M3FN, int32_data: [1.0, -2.0] packed
+	buf := []byte{fieldDims<<3 | wireBytes, 1, 2}
+	buf = append(buf, fieldDataType<<3|wireVarint, DataTypeFloat8E4M3FN)
+	buf = append(buf, fieldInt32Data<<3|wireBytes, 3, 0x38, 0xc0, 0x01)
+
+	_, out, err := Unmarshal(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []float8.Float8{float8.ToFloat8(1), float8.ToFloat8(-2)}; !bytes.Equal(out.Flat(), expected) {
+		t.Errorf("got=%v expected=%v", out.Flat(), expected)
+	}
+}
+
+func TestUnmarshalE5M2(t *testing.T) {
+	raw := make([]byte, 0x100)
+	for i := range raw {
+		raw[i] = byte(i)
+	}
+
+	buf := []byte{fieldDims<<3 | wireVarint, 0x80, 0x02}
+	buf = append(buf, fieldDataType<<3|wireVarint, DataTypeFloat8E5M2)
+	buf = append(buf, fieldRawData<<3|wireBytes, 0x80, 0x02)
+	buf = append(buf, raw...)
+
+	_, out, err := Unmarshal(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// E5M2 values within range of float8 are exact, others are rounded
+	for i, f8 := range out.Flat() {
+		x := ocp.DecodeE5M2(byte(i))
+		if expected := float8.RoundNearestEven.ToFloat8(x); f8 != expected {
+			t.Errorf("E5M2 0x%02x (%g) got=0x%02x expected=0x%02x", i, x, f8, expected)
+		}
+		if a := float32(math.Abs(float64(x))); a >= 0.0087890625 && a <= 448 && float8.ToFloat32(f8) != x {
+			t.Errorf("E5M2 0x%02x (%g) is decoded as %g", i, x, float8.ToFloat32(f8))
+		}
+	}
+
+	// 1.5×2^-8 rounds to 0x01 (2^-7 × 1.125), truncation flushes it to zero
+	if f8 := out.Flat()[0x1e]; f8 != 0x01 {
+		t.Errorf("E5M2 0x1e got=0x%02x", f8)
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	valid := Marshal("", float8.TensorOf([]float8.Float8{0x38, 0x40}, 2))
+
+	dtype := binary.AppendUvarint(nil, fieldDataType<<3|wireVarint)
+	dtype = binary.AppendUvarint(dtype, 1)
+
+	for name, buf := range map[string][]byte{
+		"truncated": valid[:len(valid)-1],
+		"dtype":     append(append([]byte{}, valid...), dtype...),
+		"shape":     append([]byte{fieldDims<<3 | wireVarint, 3}, valid...),
+	} {
+		if _, _, err := Unmarshal(buf); err == nil {
+			t.Errorf("%s: error is expected", name)
+		}
+	}
+}
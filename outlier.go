@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"slices"
+)
+
+// OutlierVector is vector with outlier channel (LLM.int8() style). The k
+// largest by magnitude elements are kept in float32 side storage, the rest
+// is quantized as Vector, so that outliers do not blow up its scale.
+// Indices are ascending, Outliers[i] is element at Indices[i], elements
+// of Vector at these positions are zero.
+type OutlierVector struct {
+	Vector
+	Indices  []uint32
+	Outliers []float32
+}
+
+// QuantizeOutliers quantizes float32 vector keeping k largest by
+// magnitude elements in full precision
+func QuantizeOutliers(x []float32, k int) OutlierVector {
+	if uint64(len(x)) > math.MaxUint32 {
+		panic("index out of range")
+	}
+	k = max(0, min(k, len(x)))
+
+	idx := make([]int, len(x))
+	for i := range idx {
+		idx[i] = i
+	}
+	slices.SortStableFunc(idx, func(a, b int) int {
+		return cmpAbs(x[b], x[a])
+	})
+	idx = idx[:k]
+	slices.Sort(idx)
+
+	inliers := append([]float32{}, x...)
+	v := OutlierVector{
+		Indices:  make([]uint32, k),
+		Outliers: make([]float32, k),
+	}
+	for n, i := range idx {
+		v.Indices[n] = uint32(i)
+		v.Outliers[n] = x[i]
+		inliers[i] = 0
+	}
+	v.Vector = NewVector(inliers)
+
+	return v
+}
+
+func cmpAbs(a, b float32) int {
+	x, y := math.Abs(float64(a)), math.Abs(float64(b))
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Dequantize vector to float32
+func (v OutlierVector) Dequantize() []float32 {
+	x := v.Vector.Dequantize()
+	for n, i := range v.Indices {
+		x[i] = v.Outliers[n]
+	}
+	return x
+}
+
+// at is value of inlier element i
+func (v OutlierVector) at(i uint32) float32 { return v.Scale * f8tof32[v.Data[i]] }
+
+// Dot product of vectors. Inliers are multiplied by float8 kernel, products
+// involving outliers are computed in float32 by merging sorted indices.
+func (v OutlierVector) Dot(w OutlierVector) float32 {
+	if v.Dim != w.Dim {
+		panic("vectors must have same length")
+	}
+
+	d := v.Vector.Dot(w.Vector)
+	i, j := 0, 0
+	for i < len(v.Indices) || j < len(w.Indices) {
+		switch {
+		case j == len(w.Indices) || (i < len(v.Indices) && v.Indices[i] < w.Indices[j]):
+			d += v.Outliers[i] * w.at(v.Indices[i])
+			i++
+		case i == len(v.Indices) || v.Indices[i] > w.Indices[j]:
+			d += v.at(w.Indices[j]) * w.Outliers[j]
+			j++
+		default:
+			d += v.Outliers[i] * w.Outliers[j]
+			i++
+			j++
+		}
+	}
+	return d
+}
@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestOutlierVector(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	withOutliers := func() []float32 {
+		x := randVector(r, 256, 1)
+		for range 4 {
+			x[r.IntN(len(x))] = float32(r.NormFloat64() * 1000)
+		}
+		return x
+	}
+
+	sqerr := func(x, y []float32) float64 {
+		e := 0.0
+		for i := range x {
+			e += float64(x[i]-y[i]) * float64(x[i]-y[i])
+		}
+		return e
+	}
+
+	for range 20 {
+		x, y := withOutliers(), withOutliers()
+		ox, oy := QuantizeOutliers(x, 4), QuantizeOutliers(y, 4)
+
+		if len(ox.Indices) != 4 {
+			t.Fatalf("unexpected number of outliers %d", len(ox.Indices))
+		}
+		for n, i := range ox.Indices {
+			if ox.Outliers[n] != x[i] || ox.Data[i] != 0 {
+				t.Errorf("outlier %d is not kept", i)
+			}
+		}
+
+		// outlier channel is more accurate than plain quantization
+		if e, plain := sqerr(x, ox.Dequantize()), sqerr(x, NewVector(x).Dequantize()); e >= plain {
+			t.Errorf("outliers error %g, plain error %g", e, plain)
+		}
+
+		// dot kernel equals to dot product of dequantized vectors
+		dx, dy := ox.Dequantize(), oy.Dequantize()
+		expected := float32(0)
+		for i := range dx {
+			expected += dx[i] * dy[i]
+		}
+		if got := ox.Dot(oy); math.Abs(float64(got-expected)) > 1e-3*math.Abs(float64(expected))+1e-3 {
+			t.Errorf("dot got=%g expected=%g", got, expected)
+		}
+
+		// shared outliers
+		expected = 0
+		for i := range dx {
+			expected += dx[i] * dx[i]
+		}
+		if got := ox.Dot(ox); math.Abs(float64(got-expected)) > 1e-3*float64(expected) {
+			t.Errorf("self dot got=%g expected=%g", got, expected)
+		}
+	}
+
+	// k larger than dimension keeps everything
+	x := []float32{1, -2, 3}
+	if v := QuantizeOutliers(x, 10); v.Dequantize()[1] != -2 || v.Scale != 0 {
+		t.Errorf("unexpected vector %v", v)
+	}
+}
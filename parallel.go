@@ -0,0 +1,237 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// batch configuration of parallel operations
+type batch struct {
+	progress func(done, total int)
+}
+
+// BatchOption of parallel operations
+type BatchOption func(*batch)
+
+// WithProgress reports number of processed items of total. Calls are
+// serialized and done is increasing, the last call is done = total
+// unless the operation is cancelled.
+func WithProgress(f func(done, total int)) BatchOption {
+	return func(b *batch) { b.progress = f }
+}
+
+// QuantizeParallel converts vectors of float32 to float8 using pool of workers.
+// dst must have same length as src, nil vectors of dst are allocated.
+// The number of workers defaults to GOMAXPROCS if it is not positive.
+// The conversion stops if context is cancelled, returning context error.
+func QuantizeParallel(ctx context.Context, dst [][]Float8, src [][]float32, workers int, opts ...BatchOption) error {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	return parallel(ctx, len(src), workers, opts, func(i int) {
+		if dst[i] == nil {
+			dst[i] = make([]Float8, len(src[i]))
+		}
+		if len(dst[i]) != len(src[i]) {
+			panic("vectors must have same length")
+		}
+
+		for j, f32 := range src[i] {
+			dst[i][j] = ToFloat8(f32)
+		}
+	})
+}
+
+// DequantizeParallel converts vectors of float8 to float32 using pool of workers.
+// dst must have same length as src, nil vectors of dst are allocated.
+// The number of workers defaults to GOMAXPROCS if it is not positive.
+// The conversion stops if context is cancelled, returning context error.
+func DequantizeParallel(ctx context.Context, dst [][]float32, src [][]Float8, workers int, opts ...BatchOption) error {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	return parallel(ctx, len(src), workers, opts, func(i int) {
+		if dst[i] == nil {
+			dst[i] = make([]float32, len(src[i]))
+		}
+		if len(dst[i]) != len(src[i]) {
+			panic("vectors must have same length")
+		}
+
+		for j, f8 := range src[i] {
+			dst[i][j] = f8tof32[f8]
+		}
+	})
+}
+
+// DistancesParallel computes metric between query and each vector of xs
+// using pool of workers, see Metric.Distances. dst is reused if it has
+// enough capacity. The search stops if context is cancelled, returning
+// context error.
+func DistancesParallel(ctx context.Context, m Metric, dst []float32, q []Float8, xs [][]Float8, workers int, opts ...BatchOption) ([]float32, error) {
+	if cap(dst) < len(xs) {
+		dst = make([]float32, len(xs))
+	}
+	dst = dst[:len(xs)]
+
+	err := parallel(ctx, len(xs), workers, opts, func(i int) {
+		dst[i] = m.Distance(q, xs[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dst, nil
+}
+
+// number of elements of chunk of parallel reductions
+const reduceChunk = 4096
+
+// SumParallel returns sum of float8 values using pool of workers. The slice
+// is split into fixed chunks, each chunk is summed by SumCompensated and
+// partial sums are combined in order of chunks, therefore the result is
+// bit identical across runs and numbers of workers. Progress counts chunks.
+func SumParallel(ctx context.Context, f8s []Float8, workers int, opts ...BatchOption) (float32, error) {
+	return reduce(ctx, len(f8s), workers, opts, func(from, to int) float32 {
+		return SumCompensated(f8s[from:to])
+	})
+}
+
+// DotParallel returns dot product of vectors using pool of workers with
+// deterministic order of accumulation, see SumParallel.
+func DotParallel(ctx context.Context, a, b []Float8, workers int, opts ...BatchOption) (float32, error) {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	return reduce(ctx, len(a), workers, opts, func(from, to int) float32 {
+		return Dot(a[from:to], b[from:to])
+	})
+}
+
+// reduce chunks of [0, n) in parallel, partial results are combined
+// sequentially in order of chunks
+func reduce(ctx context.Context, n, workers int, opts []BatchOption, f func(from, to int) float32) (float32, error) {
+	partial := make([]float32, (n+reduceChunk-1)/reduceChunk)
+
+	err := parallel(ctx, len(partial), workers, opts, func(i int) {
+		from := i * reduceChunk
+		partial[i] = f(from, min(from+reduceChunk, n))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0.0
+	for _, x := range partial {
+		sum += float64(x)
+	}
+
+	return float32(sum), nil
+}
+
+// number of items claimed by worker at once
+const parallelBatch = 64
+
+// execute f(i) for i in [0, n) using pool of workers. Cancellation error is
+// returned only if some items are not processed, panic of f is re-raised
+// on the calling goroutine once workers are stopped.
+func parallel(ctx context.Context, n, workers int, opts []BatchOption, f func(i int)) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	var b batch
+	for _, opt := range opts {
+		opt(&b)
+	}
+
+	var (
+		pmu  sync.Mutex
+		done int
+	)
+	report := func(k int) {
+		if b.progress == nil {
+			return
+		}
+		pmu.Lock()
+		defer pmu.Unlock()
+		done += k
+		b.progress(done, n)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		next  int
+		fault any
+	)
+
+	claim := func() (int, int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if fault != nil {
+			return next, next
+		}
+
+		from := next
+		next = min(next+parallelBatch, n)
+		return from, next
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					defer mu.Unlock()
+					if fault == nil {
+						fault = r
+					}
+				}
+			}()
+
+			for {
+				if ctx.Err() != nil {
+					return
+				}
+
+				from, to := claim()
+				if from == to {
+					return
+				}
+
+				for i := from; i < to; i++ {
+					f(i)
+				}
+				report(to - from)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if fault != nil {
+		panic(fault)
+	}
+
+	if next < n {
+		return ctx.Err()
+	}
+
+	return nil
+}
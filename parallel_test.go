@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestQuantizeParallel(t *testing.T) {
+	src := make([][]float32, 1000)
+	for i := range src {
+		src[i] = []float32{float32(i % 7), -1, 0.5}
+	}
+
+	dst := make([][]Float8, len(src))
+	if err := QuantizeParallel(context.Background(), dst, src, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range src {
+		for j := range src[i] {
+			if dst[i][j] != ToFloat8(src[i][j]) {
+				t.Fatalf("(%d, %d) is not quantized", i, j)
+			}
+		}
+	}
+
+	back := make([][]float32, len(dst))
+	if err := DequantizeParallel(context.Background(), back, dst, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range src {
+		for j := range src[i] {
+			if back[i][j] != src[i][j] {
+				t.Fatalf("(%d, %d) is not dequantized", i, j)
+			}
+		}
+	}
+}
+
+func TestQuantizeParallelCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	src := make([][]float32, 1000)
+	dst := make([][]Float8, len(src))
+	if err := QuantizeParallel(ctx, dst, src, 4); !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestParallelCancelCompleted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	n := 1000
+	seen := make([]bool, n)
+	err := parallel(ctx, n, 1, nil, func(i int) {
+		seen[i] = true
+		if i == n-1 {
+			cancel()
+		}
+	})
+	if err != nil {
+		t.Errorf("all items are processed, got error %v", err)
+	}
+	for i, ok := range seen {
+		if !ok {
+			t.Fatalf("item %d is not processed", i)
+		}
+	}
+}
+
+func TestParallelPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "fault" {
+			t.Errorf("unexpected panic %v", r)
+		}
+	}()
+
+	parallel(context.Background(), 1000, 4, nil, func(i int) {
+		if i == 500 {
+			panic("fault")
+		}
+	})
+	t.Error("panic is not re-raised")
+}
+
+func TestParallelProgress(t *testing.T) {
+	src := make([][]float32, 1000)
+	for i := range src {
+		src[i] = []float32{1, 2}
+	}
+
+	last, calls := 0, 0
+	progress := WithProgress(func(done, total int) {
+		if done <= last || total != len(src) {
+			t.Errorf("unexpected progress %d of %d after %d", done, total, last)
+		}
+		last = done
+		calls++
+	})
+
+	if err := QuantizeParallel(context.Background(), make([][]Float8, len(src)), src, 4, progress); err != nil {
+		t.Fatal(err)
+	}
+	if last != len(src) || calls == 0 {
+		t.Errorf("progress is not completed %d", last)
+	}
+}
+
+func TestDistancesParallel(t *testing.T) {
+	xs := make([][]Float8, 500)
+	for i := range xs {
+		xs[i] = []Float8{Float8(i % 0x70), 0x38}
+	}
+	q := []Float8{0x38, 0x40}
+
+	d, err := DistancesParallel(context.Background(), L2, nil, q, xs, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := L2.Distances(nil, q, xs)
+	for i := range e {
+		if d[i] != e[i] {
+			t.Fatalf("%d got=%g expected=%g", i, d[i], e[i])
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := DistancesParallel(ctx, L2, nil, q, xs, 3); !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestReduceParallelDeterministic(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	a, b := randFloat8s(r, 100_003), randFloat8s(r, 100_003)
+
+	sum, err := SumParallel(context.Background(), a, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dot, err := DotParallel(context.Background(), a, b, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, workers := range []int{0, 2, 3, 7, 16} {
+		for run := 0; run < 3; run++ {
+			if s, _ := SumParallel(context.Background(), a, workers); math.Float32bits(s) != math.Float32bits(sum) {
+				t.Errorf("workers %d: sum %g is not %g", workers, s, sum)
+			}
+			if d, _ := DotParallel(context.Background(), a, b, workers); math.Float32bits(d) != math.Float32bits(dot) {
+				t.Errorf("workers %d: dot %g is not %g", workers, d, dot)
+			}
+		}
+	}
+
+	if e := SumCompensated(a); math.Abs(float64(e-sum)) > 1e-3*math.Abs(float64(e))+1e-3 {
+		t.Errorf("sum %g, expected %g", sum, e)
+	}
+
+	if s, err := SumParallel(context.Background(), nil, 4); s != 0 || err != nil {
+		t.Errorf("sum of empty slice %g %v", s, err)
+	}
+}
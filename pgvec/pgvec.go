@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package pgvec implements pgvector (https://github.com/pgvector/pgvector)
+// wire formats for float8 vectors. Vectors are expanded to float32 when
+// encoded and quantized to float8 when decoded.
+package pgvec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/kshard/float8"
+)
+
+// The maximum number of dimensions supported by pgvector
+const MaxDim = 16000
+
+// EncodeText encodes vector to pgvector text format "[1,2,3]"
+func EncodeText(v []float8.Float8) string {
+	buf := make([]byte, 0, 2+len(v)*8)
+	buf = append(buf, '[')
+	for i, x := range v {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendFloat(buf, float64(float8.ToFloat32(x)), 'g', -1, 32)
+	}
+	buf = append(buf, ']')
+
+	return string(buf)
+}
+
+// DecodeText decodes vector from pgvector text format "[1,2,3]"
+func DecodeText(s string) ([]float8.Float8, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return nil, fmt.Errorf("pgvec: malformed vector %q", s)
+	}
+
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	if s == "" {
+		return []float8.Float8{}, nil
+	}
+
+	seq := strings.Split(s, ",")
+	if len(seq) > MaxDim {
+		return nil, fmt.Errorf("pgvec: vector exceeds %d dimensions", MaxDim)
+	}
+
+	v := make([]float8.Float8, len(seq))
+	for i, x := range seq {
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 32)
+		if err != nil {
+			return nil, fmt.Errorf("pgvec: malformed element %q", x)
+		}
+		v[i] = float8.ToFloat8(float32(f))
+	}
+
+	return v, nil
+}
+
+// EncodeBinary encodes vector to pgvector binary format:
+// uint16 dim, uint16 unused, dim × float32 (big endian).
+func EncodeBinary(v []float8.Float8) ([]byte, error) {
+	if len(v) > MaxDim {
+		return nil, fmt.Errorf("pgvec: vector exceeds %d dimensions", MaxDim)
+	}
+
+	buf := make([]byte, 4+4*len(v))
+	binary.BigEndian.PutUint16(buf[0:], uint16(len(v)))
+	binary.BigEndian.PutUint16(buf[2:], 0)
+	for i, x := range v {
+		binary.BigEndian.PutUint32(buf[4+4*i:], math.Float32bits(float8.ToFloat32(x)))
+	}
+
+	return buf, nil
+}
+
+// DecodeBinary decodes vector from pgvector binary format
+func DecodeBinary(buf []byte) ([]float8.Float8, error) {
+	if len(buf) < 4 {
+		return nil, errors.New("pgvec: malformed binary vector")
+	}
+
+	dim := int(binary.BigEndian.Uint16(buf[0:]))
+	if len(buf) != 4+4*dim {
+		return nil, fmt.Errorf("pgvec: binary vector of %d dimensions has %d bytes", dim, len(buf))
+	}
+
+	v := make([]float8.Float8, dim)
+	for i := range v {
+		v[i] = float8.ToFloat8(math.Float32frombits(binary.BigEndian.Uint32(buf[4+4*i:])))
+	}
+
+	return v, nil
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package pgvec_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/pgvec"
+)
+
+var vector = []float8.Float8{0x00, 0x38, 0xb8, 0x46, 0x7f}
+
+func TestText(t *testing.T) {
+	txt := pgvec.EncodeText(vector)
+	if txt != "[0,1,-1,3.5,480]" {
+		t.Errorf("unexpected text %s", txt)
+	}
+
+	v, err := pgvec.DecodeText(txt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(v, vector) {
+		t.Errorf("got=%v expected=%v", v, vector)
+	}
+
+	for _, bad := range []string{"", "[", "1,2", "[1,x]"} {
+		if _, err := pgvec.DecodeText(bad); err == nil {
+			t.Errorf("error is expected for %q", bad)
+		}
+	}
+}
+
+func TestBinary(t *testing.T) {
+	buf, err := pgvec.EncodeBinary(vector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(buf) != 4+4*len(vector) || buf[1] != byte(len(vector)) {
+		t.Errorf("unexpected binary %x", buf)
+	}
+
+	v, err := pgvec.DecodeBinary(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(v, vector) {
+		t.Errorf("got=%v expected=%v", v, vector)
+	}
+
+	if _, err := pgvec.DecodeBinary(buf[:len(buf)-1]); err == nil {
+		t.Errorf("error is expected for truncated vector")
+	}
+}
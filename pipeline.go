@@ -0,0 +1,156 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"errors"
+	"math"
+
+	"github.com/kshard/float8/internal/half"
+)
+
+// Precision of pipeline stage
+type Precision int
+
+const (
+	PrecisionFP16 Precision = iota // IEEE 754 half precision
+	PrecisionBF16                  // bfloat16
+	PrecisionFP8                   // float8 of the package
+)
+
+func (p Precision) String() string {
+	switch p {
+	case PrecisionFP16:
+		return "fp16"
+	case PrecisionBF16:
+		return "bf16"
+	case PrecisionFP8:
+		return "fp8"
+	default:
+		return "unknown"
+	}
+}
+
+// Stage of pipeline converts values to precision using rounding mode
+type Stage struct {
+	Precision Precision
+	Rounding  Rounding
+}
+
+// Pipeline reproduces gradual precision downgrade of float32 values
+// (e.g. f32 → bf16 → f8), each stage converts output of previous one.
+type Pipeline struct {
+	stages []Stage
+}
+
+// NewPipeline creates pipeline of stages, the last stage must be PrecisionFP8
+func NewPipeline(stages ...Stage) (*Pipeline, error) {
+	if len(stages) == 0 || stages[len(stages)-1].Precision != PrecisionFP8 {
+		return nil, errors.New("float8: pipeline must end with fp8 stage")
+	}
+	for _, s := range stages {
+		if s.Precision < PrecisionFP16 || s.Precision > PrecisionFP8 {
+			return nil, errors.New("float8: unknown precision")
+		}
+		if s.Rounding != RoundTowardZero && s.Rounding != RoundNearestEven {
+			return nil, errors.New("float8: unknown rounding")
+		}
+	}
+
+	return &Pipeline{stages: append([]Stage{}, stages...)}, nil
+}
+
+// Stages of pipeline
+func (p *Pipeline) Stages() []Stage { return append([]Stage{}, p.stages...) }
+
+// Run converts x through stages. It returns float8 values and cumulative
+// error of each stage, i.e. error of stage output versus x.
+// dst is reused if it has enough capacity.
+func (p *Pipeline) Run(dst []Float8, x []float32) ([]Float8, []ErrorStats) {
+	if cap(dst) < len(x) {
+		dst = make([]Float8, len(x))
+	}
+	dst = dst[:len(x)]
+
+	y := append([]float32{}, x...)
+	stats := make([]ErrorStats, len(p.stages))
+	for k, s := range p.stages {
+		if s.Precision == PrecisionFP8 {
+			for i, e := range y {
+				dst[i] = s.Rounding.ToFloat8(e)
+				y[i] = f8tof32[dst[i]]
+			}
+		} else {
+			for i, e := range y {
+				y[i] = s.convert(e)
+			}
+		}
+		stats[k] = cumulativeError(x, y, s.Precision)
+	}
+
+	return dst, stats
+}
+
+// convert rounds float32 to precision of fp16 or bf16 stage
+func (s Stage) convert(f32 float32) float32 {
+	if s.Precision == PrecisionFP16 {
+		h := half.FromFloat32(f32)
+		if s.Rounding == RoundTowardZero && h&0x7fff != 0 && abs32(half.ToFloat32(h)) > abs32(f32) {
+			h-- // nearest is away from zero, step back by magnitude
+		}
+		return half.ToFloat32(h)
+	}
+
+	bits := math.Float32bits(f32)
+	if s.Rounding == RoundNearestEven && bits&0x7fffffff <= 0x7f800000 {
+		bits += 0x7fff + bits>>16&1
+	}
+	return math.Float32frombits(bits &^ 0xffff)
+}
+
+func abs32(f float32) float32 { return float32(math.Abs(float64(f))) }
+
+// largest finite magnitude of precision
+func maxFinite(p Precision) float32 {
+	switch p {
+	case PrecisionFP16:
+		return 65504
+	case PrecisionBF16:
+		return math.Float32frombits(0x7f7f0000)
+	default:
+		return f8tof32[MaxValue]
+	}
+}
+
+// relative error of y versus x, see ErrorStats
+func cumulativeError(x, y []float32, p Precision) ErrorStats {
+	var s ErrorStats
+	for i, e := range x {
+		switch {
+		case e == 0:
+			continue
+		case y[i] == 0:
+			s.Underflow++
+			continue
+		case abs32(y[i]) > maxFinite(p) || math.IsInf(float64(y[i]), 0):
+			s.Overflow++
+			continue
+		}
+
+		rel := math.Abs(float64(y[i])-float64(e)) / math.Abs(float64(e))
+		s.MaxRelative = max(s.MaxRelative, rel)
+		s.MeanRelative += rel
+		s.Samples++
+	}
+
+	if s.Samples > 0 {
+		s.MeanRelative /= float64(s.Samples)
+	}
+	return s
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestPipeline(t *testing.T) {
+	if _, err := NewPipeline(Stage{Precision: PrecisionFP16}); err == nil {
+		t.Errorf("pipeline without fp8 stage")
+	}
+	if _, err := NewPipeline(Stage{Precision: PrecisionFP8, Rounding: 7}); err == nil {
+		t.Errorf("pipeline with unknown rounding")
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	x := randVector(r, 1000, 10)
+
+	// single truncating stage is ToFloat8
+	p, err := NewPipeline(Stage{Precision: PrecisionFP8, Rounding: RoundTowardZero})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f8s, stats := p.Run(nil, x)
+	for i, e := range x {
+		if f8s[i] != ToFloat8(e) {
+			t.Fatalf("%g converted to %#x", e, f8s[i])
+		}
+	}
+	if len(stats) != 1 || stats[0].Samples == 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	p, err = NewPipeline(
+		Stage{Precision: PrecisionBF16, Rounding: RoundNearestEven},
+		Stage{Precision: PrecisionFP16, Rounding: RoundTowardZero},
+		Stage{Precision: PrecisionFP8, Rounding: RoundNearestEven},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := p.Stages(); len(s) != 3 || s[0].Precision != PrecisionBF16 {
+		t.Errorf("unexpected stages %v", s)
+	}
+
+	_, stats = p.Run(f8s, x)
+	if len(stats) != 3 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	// bf16 has 8 bits of mantissa, fp8 has 3 bits
+	if e := stats[0].MaxRelative; e == 0 || e > 1.0/256 {
+		t.Errorf("bf16 error %g", e)
+	}
+	if e := stats[2].MaxRelative; e <= stats[0].MaxRelative || e > 1.0/8 {
+		t.Errorf("fp8 error %g", e)
+	}
+
+	// stage rounding is applied
+	q := []float32{1.1, 1000, 1e-5}
+	out, stats := p.Run(nil, q)
+	if out[0] != ToFloat8(1.125) || out[1] != Infinity || out[2] != 0 {
+		t.Errorf("unexpected output %v", out)
+	}
+	if s := stats[2]; s.Overflow != 1 || s.Underflow != 1 || s.Samples != 1 {
+		t.Errorf("unexpected stats %+v", s)
+	}
+}
@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+var (
+	// ErrDivByZero is division of non zero value by zero
+	ErrDivByZero = errors.New("float8: division by zero")
+
+	// ErrInvalidOperation is operation without defined result (e.g. 0/0),
+	// the format has no NaN, the result is 0.
+	ErrInvalidOperation = errors.New("float8: invalid operation")
+)
+
+// DivPolicy defines behavior of Div on division by zero
+type DivPolicy int32
+
+const (
+	// DivInfinity returns ±Infinity with sign of x for x/0 and 0 for 0/0
+	// (default)
+	DivInfinity DivPolicy = iota
+
+	// DivPanic panics with ErrDivByZero or ErrInvalidOperation,
+	// it is intended for debugging of invalid operations.
+	DivPanic
+
+	// DivSaturate returns ±MaxValue with sign of x for x/0 and 0 for 0/0
+	DivSaturate
+)
+
+var divPolicy atomic.Int32
+
+// SetDivPolicy changes behavior of Div globally, it returns previous policy.
+// Use DivChecked to detect invalid operations without global state.
+func SetDivPolicy(p DivPolicy) DivPolicy {
+	return DivPolicy(divPolicy.Swap(int32(p)))
+}
+
+// DivChecked divides float8(s), it returns ErrDivByZero or
+// ErrInvalidOperation along with result of DivInfinity if b is zero.
+func DivChecked(a, b Float8) (Float8, error) {
+	if b == 0 {
+		return divInfinity(a), divError(a)
+	}
+
+	return div[int(a)<<8|int(b)], nil
+}
+
+func divError(a Float8) error {
+	if a == 0 {
+		return ErrInvalidOperation
+	}
+	return ErrDivByZero
+}
+
+// division by zero according to policy
+func divByZero(a Float8) Float8 {
+	switch DivPolicy(divPolicy.Load()) {
+	case DivPanic:
+		panic(divError(a))
+	case DivSaturate:
+		if a == 0 {
+			return 0
+		}
+		return a&signMask | MaxValue
+	}
+
+	return divInfinity(a)
+}
+
+// ±Infinity with sign of a, 0/0 is 0
+func divInfinity(a Float8) Float8 {
+	if a == 0 {
+		return 0
+	}
+	return a&signMask | Infinity
+}
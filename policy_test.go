@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDivChecked(t *testing.T) {
+	for _, tc := range []struct {
+		a, b, c Float8
+		err     error
+	}{
+		{0x40, 0x38, 0x40, nil},
+		{0x38, 0x00, Infinity, ErrDivByZero},
+		{0xb8, 0x00, signMask | Infinity, ErrDivByZero},
+		{0x00, 0x00, 0x00, ErrInvalidOperation},
+	} {
+		c, err := DivChecked(tc.a, tc.b)
+		if c != tc.c || !errors.Is(err, tc.err) {
+			t.Errorf("0x%02x / 0x%02x got=0x%02x, %v", tc.a, tc.b, c, err)
+		}
+		if d := Div(tc.a, tc.b); d != c {
+			t.Errorf("0x%02x / 0x%02x Div=0x%02x, DivChecked=0x%02x", tc.a, tc.b, d, c)
+		}
+	}
+}
+
+func TestDivPolicy(t *testing.T) {
+	defer SetDivPolicy(SetDivPolicy(DivPanic))
+
+	if c := Div(0x40, 0x38); c != 0x40 {
+		t.Errorf("unexpected result 0x%02x", c)
+	}
+
+	for _, tc := range []struct {
+		a   Float8
+		err error
+	}{
+		{0x38, ErrDivByZero},
+		{0x00, ErrInvalidOperation},
+	} {
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, tc.err) {
+					t.Errorf("0x%02x / 0 panic %v, wanted %v", tc.a, err, tc.err)
+				}
+			}()
+			Div(tc.a, 0)
+		}()
+	}
+}
+
+func TestDivSaturate(t *testing.T) {
+	defer SetDivPolicy(SetDivPolicy(DivSaturate))
+
+	for _, tc := range []struct{ a, c Float8 }{
+		{0x38, MaxValue},
+		{0xb8, signMask | MaxValue},
+		{0x80, signMask | MaxValue},
+		{Infinity, MaxValue},
+		{0x00, 0x00},
+	} {
+		if c := Div(tc.a, 0); c != tc.c {
+			t.Errorf("0x%02x / 0 = 0x%02x, wanted 0x%02x", tc.a, c, tc.c)
+		}
+	}
+
+	if c := Div(0x40, 0x38); c != 0x40 {
+		t.Errorf("unexpected result 0x%02x", c)
+	}
+}
+
+func TestDivInfinity(t *testing.T) {
+	defer SetDivPolicy(SetDivPolicy(DivInfinity))
+
+	for _, tc := range []struct{ a, c Float8 }{
+		{0x38, Infinity},
+		{0xb8, signMask | Infinity},
+		{0x00, 0x00},
+	} {
+		if c := Div(tc.a, 0); c != tc.c {
+			t.Errorf("0x%02x / 0 = 0x%02x, wanted 0x%02x", tc.a, c, tc.c)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package posit8
+
+//
+// The code book for translating posit8 (es=1) to float32
+//
+
+var p8tof32 = [0x100]float32{0,0.00024414062,0.0009765625,0.001953125,0.00390625,0.005859375,0.0078125,0.01171875,0.015625,0.01953125,0.0234375,0.02734375,0.03125,0.0390625,0.046875,0.0546875,0.0625,0.0703125,0.078125,0.0859375,0.09375,0.1015625,0.109375,0.1171875,0.125,0.140625,0.15625,0.171875,0.1875,0.203125,0.21875,0.234375,0.25,0.265625,0.28125,0.296875,0.3125,0.328125,0.34375,0.359375,0.375,0.390625,0.40625,0.421875,0.4375,0.453125,0.46875,0.484375,0.5,0.53125,0.5625,0.59375,0.625,0.65625,0.6875,0.71875,0.75,0.78125,0.8125,0.84375,0.875,0.90625,0.9375,0.96875,1,1.0625,1.125,1.1875,1.25,1.3125,1.375,1.4375,1.5,1.5625,1.625,1.6875,1.75,1.8125,1.875,1.9375,2,2.125,2.25,2.375,2.5,2.625,2.75,2.875,3,3.125,3.25,3.375,3.5,3.625,3.75,3.875,4,4.5,5,5.5,6,6.5,7,7.5,8,9,10,11,12,13,14,15,16,20,24,28,32,40,48,56,64,96,128,192,256,512,1024,4096,0,-4096,-1024,-512,-256,-192,-128,-96,-64,-56,-48,-40,-32,-28,-24,-20,-16,-15,-14,-13,-12,-11,-10,-9,-8,-7.5,-7,-6.5,-6,-5.5,-5,-4.5,-4,-3.875,-3.75,-3.625,-3.5,-3.375,-3.25,-3.125,-3,-2.875,-2.75,-2.625,-2.5,-2.375,-2.25,-2.125,-2,-1.9375,-1.875,-1.8125,-1.75,-1.6875,-1.625,-1.5625,-1.5,-1.4375,-1.375,-1.3125,-1.25,-1.1875,-1.125,-1.0625,-1,-0.96875,-0.9375,-0.90625,-0.875,-0.84375,-0.8125,-0.78125,-0.75,-0.71875,-0.6875,-0.65625,-0.625,-0.59375,-0.5625,-0.53125,-0.5,-0.484375,-0.46875,-0.453125,-0.4375,-0.421875,-0.40625,-0.390625,-0.375,-0.359375,-0.34375,-0.328125,-0.3125,-0.296875,-0.28125,-0.265625,-0.25,-0.234375,-0.21875,-0.203125,-0.1875,-0.171875,-0.15625,-0.140625,-0.125,-0.1171875,-0.109375,-0.1015625,-0.09375,-0.0859375,-0.078125,-0.0703125,-0.0625,-0.0546875,-0.046875,-0.0390625,-0.03125,-0.02734375,-0.0234375,-0.01953125,-0.015625,-0.01171875,-0.0078125,-0.005859375,-0.00390625,-0.001953125,-0.0009765625,-0.00024414062}
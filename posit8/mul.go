@@ -0,0 +1,8 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package posit8
+
+//
+// The code book for mul of posit8 (es=1)
+//
+
+var mul = [0x10000]uint8{0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x6,0x6,0x6,0x7,0x8,0x8,0xa,0xc,0xe,0x10,0x18,0x20,0x40,0x80,0xc0,0xe0,0xe8,0xf0,0xf2,0xf4,0xf6,0xf8,0xf8,0xf9,0xfa,0xfa,0xfa,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x14,0x18,0x1c,0x20,0x30,0x40,0x60,0x80,0xa0,0xc0,0xd0,0xe0,0xe4,0xe8,0xec,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x9,0xa,0xa,0xa,0xb,0xc,0xc,0xd,0xe,0xf,0x10,0x12,0x14,0x16,0x18,0x1c,0x20,0x28,0x30,0x40,0x50,0x68,0x80,0x98,0xb0,0xc0,0xd0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0xee,0xf0,0xf1,0xf2,0xf3,0xf4,0xf4,0xf5,0xf6,0xf6,0xf6,0xf7,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0xa,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xe,0xe,0xe,0xf,0x10,0x10,0x12,0x14,0x16,0x18,0x1a,0x1c,0x1e,0x20,0x28,0x30,0x38,0x40,0x50,0x60,0x70,0x80,0x90,0xa0,0xb0,0xc0,0xc8,0xd0,0xd8,0xe0,0xe2,0xe4,0xe6,0xe8,0xea,0xec,0xee,0xf0,0xf0,0xf1,0xf2,0xf2,0xf2,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf6,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x14,0x17,0x19,0x1a,0x1c,0x1f,0x22,0x25,0x28,0x32,0x38,0x42,0x48,0x58,0x64,0x72,0x80,0x8e,0x9c,0xa8,0xb8,0xbe,0xc8,0xce,0xd8,0xdb,0xde,0xe1,0xe4,0xe6,0xe7,0xe9,0xec,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xd,0xe,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x1a,0x1c,0x1e,0x20,0x24,0x28,0x2c,0x30,0x38,0x40,0x48,0x50,0x60,0x68,0x74,0x80,0x8c,0x98,0xa0,0xb0,0xb8,0xc0,0xc8,0xd0,0xd4,0xd8,0xdc,0xe0,0xe2,0xe4,0xe6,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf2,0xf3,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x14,0x16,0x17,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1f,0x22,0x25,0x28,0x2e,0x32,0x35,0x38,0x42,0x48,0x52,0x58,0x64,0x6c,0x76,0x80,0x8a,0x94,0x9c,0xa8,0xae,0xb8,0xbe,0xc8,0xcb,0xce,0xd2,0xd8,0xdb,0xde,0xe1,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xe9,0xea,0xec,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x24,0x28,0x2c,0x30,0x34,0x38,0x3c,0x40,0x48,0x50,0x58,0x60,0x68,0x70,0x78,0x80,0x88,0x90,0x98,0xa0,0xa8,0xb0,0xb8,0xc0,0xc4,0xc8,0xcc,0xd0,0xd4,0xd8,0xdc,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x13,0x14,0x16,0x17,0x18,0x19,0x19,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x23,0x24,0x29,0x2e,0x32,0x34,0x39,0x3e,0x42,0x44,0x4e,0x54,0x5e,0x62,0x6a,0x71,0x78,0x80,0x88,0x8f,0x96,0x9e,0xa2,0xac,0xb2,0xbc,0xbe,0xc2,0xc7,0xcc,0xce,0xd2,0xd7,0xdc,0xdd,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe7,0xe7,0xe8,0xe9,0xea,0xec,0xed,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x16,0x17,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x24,0x25,0x26,0x28,0x2e,0x32,0x35,0x38,0x3e,0x42,0x45,0x48,0x52,0x58,0x61,0x64,0x6c,0x72,0x79,0x80,0x87,0x8e,0x94,0x9c,0x9f,0xa8,0xae,0xb8,0xbb,0xbe,0xc2,0xc8,0xcb,0xce,0xd2,0xd8,0xda,0xdb,0xdc,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xe9,0xea,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xb,0xb,0xb,0xb,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0xf,0x10,0x10,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x16,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1d,0x1e,0x20,0x22,0x23,0x25,0x27,0x28,0x2a,0x2c,0x32,0x35,0x38,0x3c,0x42,0x45,0x48,0x4c,0x55,0x5c,0x62,0x66,0x6e,0x73,0x7a,0x80,0x86,0x8d,0x92,0x9a,0x9e,0xa4,0xab,0xb4,0xb8,0xbb,0xbe,0xc4,0xc8,0xcb,0xce,0xd4,0xd6,0xd8,0xd9,0xdb,0xdd,0xde,0xe0,0xe2,0xe3,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xea,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf1,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x12,0x12,0x12,0x13,0x14,0x14,0x14,0x15,0x16,0x16,0x16,0x17,0x18,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x22,0x24,0x26,0x28,0x2a,0x2c,0x2e,0x30,0x34,0x38,0x3c,0x40,0x44,0x48,0x4c,0x50,0x58,0x60,0x64,0x68,0x70,0x74,0x7a,0x80,0x86,0x8c,0x90,0x98,0x9c,0xa0,0xa8,0xb0,0xb4,0xb8,0xbc,0xc0,0xc4,0xc8,0xcc,0xd0,0xd2,0xd4,0xd6,0xd8,0xda,0xdc,0xde,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xea,0xeb,0xec,0xec,0xec,0xed,0xee,0xee,0xee,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x23,0x24,0x26,0x29,0x2c,0x2e,0x30,0x32,0x33,0x34,0x39,0x3e,0x42,0x44,0x49,0x4e,0x52,0x54,0x5e,0x62,0x67,0x6a,0x71,0x75,0x7a,0x80,0x86,0x8b,0x8f,0x96,0x99,0x9e,0xa2,0xac,0xae,0xb2,0xb7,0xbc,0xbe,0xc2,0xc7,0xcc,0xcd,0xce,0xd0,0xd2,0xd4,0xd7,0xda,0xdc,0xdd,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1e,0x1f,0x20,0x22,0x24,0x25,0x26,0x28,0x2b,0x2e,0x30,0x32,0x34,0x35,0x36,0x38,0x3e,0x42,0x45,0x48,0x4e,0x52,0x55,0x58,0x61,0x64,0x69,0x6c,0x72,0x76,0x7b,0x80,0x85,0x8a,0x8e,0x94,0x97,0x9c,0x9f,0xa8,0xab,0xae,0xb2,0xb8,0xbb,0xbe,0xc2,0xc8,0xca,0xcb,0xcc,0xce,0xd0,0xd2,0xd5,0xd8,0xda,0xdb,0xdc,0xde,0xe0,0xe1,0xe2,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xb,0xb,0xb,0xb,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0xf,0x10,0x10,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x20,0x22,0x23,0x25,0x27,0x28,0x2a,0x2c,0x30,0x32,0x33,0x35,0x37,0x38,0x3a,0x3c,0x42,0x45,0x48,0x4c,0x52,0x55,0x58,0x5c,0x62,0x66,0x6a,0x6e,0x73,0x77,0x7c,0x80,0x84,0x89,0x8d,0x92,0x96,0x9a,0x9e,0xa4,0xa8,0xab,0xae,0xb4,0xb8,0xbb,0xbe,0xc4,0xc6,0xc8,0xc9,0xcb,0xcd,0xce,0xd0,0xd4,0xd6,0xd8,0xd9,0xdb,0xdd,0xde,0xe0,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf1,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x2,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x12,0x12,0x12,0x13,0x14,0x14,0x14,0x15,0x16,0x16,0x16,0x17,0x18,0x18,0x18,0x19,0x1a,0x1a,0x1a,0x1b,0x1c,0x1c,0x1c,0x1d,0x1e,0x1e,0x1e,0x1f,0x20,0x20,0x22,0x24,0x26,0x28,0x2a,0x2c,0x2e,0x30,0x32,0x34,0x36,0x38,0x3a,0x3c,0x3e,0x40,0x44,0x48,0x4c,0x50,0x54,0x58,0x5c,0x60,0x64,0x68,0x6c,0x70,0x74,0x78,0x7c,0x80,0x84,0x88,0x8c,0x90,0x94,0x98,0x9c,0xa0,0xa4,0xa8,0xac,0xb0,0xb4,0xb8,0xbc,0xc0,0xc2,0xc4,0xc6,0xc8,0xca,0xcc,0xce,0xd0,0xd2,0xd4,0xd6,0xd8,0xda,0xdc,0xde,0xe0,0xe0,0xe1,0xe2,0xe2,0xe2,0xe3,0xe4,0xe4,0xe4,0xe5,0xe6,0xe6,0xe6,0xe7,0xe8,0xe8,0xe8,0xe9,0xea,0xea,0xea,0xeb,0xec,0xec,0xec,0xed,0xee,0xee,0xee,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x9,0x9,0x9,0xa,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x17,0x18,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x20,0x21,0x21,0x22,0x24,0x26,0x29,0x2b,0x2d,0x30,0x31,0x32,0x34,0x36,0x39,0x3b,0x3d,0x40,0x41,0x42,0x46,0x4b,0x50,0x52,0x56,0x5b,0x60,0x61,0x66,0x69,0x6e,0x70,0x74,0x78,0x7c,0x80,0x84,0x88,0x8c,0x90,0x92,0x97,0x9a,0x9f,0xa0,0xa5,0xaa,0xae,0xb0,0xb5,0xba,0xbe,0xbf,0xc0,0xc3,0xc5,0xc7,0xca,0xcc,0xce,0xcf,0xd0,0xd3,0xd5,0xd7,0xda,0xdc,0xde,0xdf,0xdf,0xe0,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe8,0xe9,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x4,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0x9,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x22,0x23,0x23,0x24,0x26,0x29,0x2c,0x2e,0x30,0x32,0x33,0x34,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x44,0x49,0x4e,0x52,0x54,0x59,0x5e,0x61,0x62,0x67,0x6a,0x6f,0x71,0x75,0x78,0x7c,0x80,0x84,0x88,0x8b,0x8f,0x91,0x96,0x99,0x9e,0x9f,0xa2,0xa7,0xac,0xae,0xb2,0xb7,0xbc,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcc,0xcd,0xce,0xd0,0xd2,0xd4,0xd7,0xda,0xdc,0xdd,0xdd,0xde,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x23,0x24,0x25,0x25,0x26,0x29,0x2c,0x2e,0x30,0x32,0x33,0x35,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x45,0x46,0x4c,0x50,0x53,0x56,0x5c,0x60,0x62,0x63,0x68,0x6b,0x70,0x72,0x76,0x79,0x7c,0x80,0x84,0x87,0x8a,0x8e,0x90,0x95,0x98,0x9d,0x9e,0xa0,0xa4,0xaa,0xad,0xb0,0xb4,0xba,0xbb,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcb,0xcd,0xce,0xd0,0xd2,0xd4,0xd7,0xda,0xdb,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x26,0x27,0x28,0x2b,0x2e,0x30,0x32,0x34,0x35,0x36,0x38,0x3b,0x3e,0x40,0x42,0x44,0x45,0x46,0x48,0x4e,0x52,0x55,0x58,0x5e,0x61,0x62,0x64,0x69,0x6c,0x70,0x72,0x76,0x79,0x7c,0x80,0x84,0x87,0x8a,0x8e,0x90,0x94,0x97,0x9c,0x9e,0x9f,0xa2,0xa8,0xab,0xae,0xb2,0xb8,0xba,0xbb,0xbc,0xbe,0xc0,0xc2,0xc5,0xc8,0xca,0xcb,0xcc,0xce,0xd0,0xd2,0xd5,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x1,0x2,0x2,0x3,0x3,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xe,0xf,0xf,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x13,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x17,0x17,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1f,0x1f,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2d,0x30,0x32,0x34,0x35,0x37,0x38,0x3a,0x3d,0x40,0x42,0x44,0x45,0x47,0x48,0x4a,0x50,0x54,0x57,0x5a,0x60,0x62,0x63,0x65,0x6a,0x6d,0x71,0x72,0x76,0x79,0x7d,0x80,0x83,0x87,0x8a,0x8e,0x8f,0x93,0x96,0x9b,0x9d,0x9e,0xa0,0xa6,0xa9,0xac,0xb0,0xb6,0xb8,0xb9,0xbb,0xbc,0xbe,0xc0,0xc3,0xc6,0xc8,0xc9,0xcb,0xcc,0xce,0xd0,0xd3,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe1,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe9,0xe9,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xed,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xb,0xb,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0xf,0x10,0x10,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x22,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2c,0x30,0x32,0x33,0x35,0x37,0x38,0x3a,0x3c,0x40,0x42,0x43,0x45,0x47,0x48,0x4a,0x4c,0x52,0x55,0x58,0x5c,0x61,0x62,0x64,0x66,0x6a,0x6e,0x71,0x73,0x77,0x7a,0x7d,0x80,0x83,0x86,0x89,0x8d,0x8f,0x92,0x96,0x9a,0x9c,0x9e,0x9f,0xa4,0xa8,0xab,0xae,0xb4,0xb6,0xb8,0xb9,0xbb,0xbd,0xbe,0xc0,0xc4,0xc6,0xc8,0xc9,0xcb,0xcd,0xce,0xd0,0xd4,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xde,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf1,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf5,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x6,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x16,0x17,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x31,0x33,0x35,0x36,0x38,0x3a,0x3c,0x3e,0x41,0x43,0x45,0x46,0x48,0x4a,0x4c,0x4e,0x53,0x56,0x5a,0x5e,0x61,0x63,0x65,0x67,0x6b,0x6f,0x72,0x74,0x78,0x7a,0x7d,0x80,0x83,0x86,0x88,0x8c,0x8e,0x91,0x95,0x99,0x9b,0x9d,0x9f,0xa2,0xa6,0xaa,0xad,0xb2,0xb4,0xb6,0xb8,0xba,0xbb,0xbd,0xbf,0xc2,0xc4,0xc6,0xc8,0xca,0xcb,0xcd,0xcf,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xe9,0xea,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x4,0x4,0x4,0x4,0x5,0x6,0x6,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x9,0xa,0xa,0xa,0xb,0xc,0xc,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x12,0x12,0x12,0x13,0x14,0x14,0x14,0x15,0x16,0x16,0x16,0x17,0x18,0x18,0x18,0x19,0x1a,0x1a,0x1a,0x1b,0x1c,0x1c,0x1c,0x1d,0x1e,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x32,0x34,0x36,0x38,0x3a,0x3c,0x3e,0x40,0x42,0x44,0x46,0x48,0x4a,0x4c,0x4e,0x50,0x54,0x58,0x5c,0x60,0x62,0x64,0x66,0x68,0x6c,0x70,0x72,0x74,0x78,0x7a,0x7d,0x80,0x83,0x86,0x88,0x8c,0x8e,0x90,0x94,0x98,0x9a,0x9c,0x9e,0xa0,0xa4,0xa8,0xac,0xb0,0xb2,0xb4,0xb6,0xb8,0xba,0xbc,0xbe,0xc0,0xc2,0xc4,0xc6,0xc8,0xca,0xcc,0xce,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe2,0xe3,0xe4,0xe4,0xe4,0xe5,0xe6,0xe6,0xe6,0xe7,0xe8,0xe8,0xe8,0xe9,0xea,0xea,0xea,0xeb,0xec,0xec,0xec,0xed,0xee,0xee,0xee,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf6,0xf7,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfa,0xfb,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x1,0x2,0x2,0x3,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x7,0x7,0x8,0x8,0x8,0x8,0x9,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x17,0x18,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x20,0x21,0x21,0x22,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x31,0x32,0x34,0x36,0x39,0x3b,0x3d,0x40,0x41,0x42,0x44,0x46,0x49,0x4b,0x4d,0x50,0x51,0x52,0x56,0x5b,0x60,0x61,0x63,0x66,0x68,0x69,0x6e,0x70,0x73,0x74,0x78,0x7a,0x7d,0x80,0x83,0x86,0x88,0x8c,0x8d,0x90,0x92,0x97,0x98,0x9a,0x9d,0x9f,0xa0,0xa5,0xaa,0xae,0xaf,0xb0,0xb3,0xb5,0xb7,0xba,0xbc,0xbe,0xbf,0xc0,0xc3,0xc5,0xc7,0xca,0xcc,0xce,0xcf,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xdf,0xe0,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe8,0xe9,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf7,0xf8,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x4,0x4,0x4,0x4,0x5,0x6,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x22,0x23,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x32,0x33,0x33,0x34,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x44,0x46,0x49,0x4c,0x4e,0x50,0x52,0x53,0x54,0x59,0x5e,0x61,0x62,0x64,0x67,0x69,0x6a,0x6f,0x71,0x74,0x75,0x78,0x7a,0x7d,0x80,0x83,0x86,0x88,0x8b,0x8c,0x8f,0x91,0x96,0x97,0x99,0x9c,0x9e,0x9f,0xa2,0xa7,0xac,0xad,0xae,0xb0,0xb2,0xb4,0xb7,0xba,0xbc,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcc,0xcd,0xcd,0xce,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xdd,0xde,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfa,0xfb,0xfc,0xfc,0xfc,0xfc,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x23,0x24,0x25,0x25,0x26,0x27,0x29,0x2a,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x32,0x33,0x33,0x34,0x35,0x35,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x45,0x46,0x49,0x4c,0x4e,0x50,0x52,0x53,0x55,0x56,0x5c,0x60,0x62,0x63,0x66,0x68,0x6a,0x6b,0x70,0x72,0x74,0x76,0x79,0x7b,0x7d,0x80,0x83,0x85,0x87,0x8a,0x8c,0x8e,0x90,0x95,0x96,0x98,0x9a,0x9d,0x9e,0xa0,0xa4,0xaa,0xab,0xad,0xae,0xb0,0xb2,0xb4,0xb7,0xba,0xbb,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcb,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd6,0xd7,0xd9,0xda,0xdb,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x2,0x2,0x3,0x4,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x26,0x27,0x28,0x2a,0x2b,0x2c,0x2e,0x30,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x36,0x37,0x38,0x3b,0x3e,0x40,0x42,0x44,0x45,0x46,0x48,0x4b,0x4e,0x50,0x52,0x54,0x55,0x56,0x58,0x5e,0x61,0x62,0x64,0x67,0x69,0x6a,0x6c,0x70,0x72,0x74,0x76,0x79,0x7b,0x7e,0x80,0x82,0x85,0x87,0x8a,0x8c,0x8e,0x90,0x94,0x96,0x97,0x99,0x9c,0x9e,0x9f,0xa2,0xa8,0xaa,0xab,0xac,0xae,0xb0,0xb2,0xb5,0xb8,0xba,0xbb,0xbc,0xbe,0xc0,0xc2,0xc5,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd4,0xd5,0xd6,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfe,0xfe,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x2,0x3,0x3,0x4,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xa,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xe,0xf,0xf,0x10,0x10,0x11,0x11,0x11,0x12,0x12,0x13,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x17,0x17,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1f,0x1f,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2c,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3d,0x40,0x42,0x44,0x45,0x47,0x48,0x4a,0x4d,0x50,0x52,0x54,0x55,0x57,0x58,0x5a,0x60,0x62,0x63,0x65,0x68,0x6a,0x6b,0x6d,0x71,0x72,0x75,0x76,0x79,0x7b,0x7e,0x80,0x82,0x85,0x87,0x8a,0x8b,0x8e,0x8f,0x93,0x95,0x96,0x98,0x9b,0x9d,0x9e,0xa0,0xa6,0xa8,0xa9,0xab,0xac,0xae,0xb0,0xb3,0xb6,0xb8,0xb9,0xbb,0xbc,0xbe,0xc0,0xc3,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd4,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe1,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe9,0xe9,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xed,0xee,0xee,0xef,0xef,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfc,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x2,0x3,0x3,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0xf,0xf,0x10,0x10,0x11,0x11,0x12,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x22,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2c,0x2e,0x30,0x31,0x32,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3c,0x40,0x42,0x43,0x45,0x47,0x48,0x4a,0x4c,0x50,0x52,0x53,0x55,0x57,0x58,0x5a,0x5c,0x61,0x62,0x64,0x66,0x69,0x6a,0x6c,0x6e,0x71,0x73,0x75,0x77,0x7a,0x7c,0x7e,0x80,0x82,0x84,0x86,0x89,0x8b,0x8d,0x8f,0x92,0x94,0x96,0x97,0x9a,0x9c,0x9e,0x9f,0xa4,0xa6,0xa8,0xa9,0xab,0xad,0xae,0xb0,0xb4,0xb6,0xb8,0xb9,0xbb,0xbd,0xbe,0xc0,0xc4,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xce,0xcf,0xd0,0xd2,0xd4,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xde,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf1,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x2,0x3,0x3,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0xf,0x10,0x10,0x10,0x11,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x16,0x17,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x41,0x43,0x45,0x46,0x48,0x4a,0x4c,0x4e,0x51,0x53,0x55,0x56,0x58,0x5a,0x5c,0x5e,0x61,0x63,0x65,0x67,0x69,0x6b,0x6d,0x6f,0x72,0x74,0x76,0x78,0x7a,0x7c,0x7e,0x80,0x82,0x84,0x86,0x88,0x8a,0x8c,0x8e,0x91,0x93,0x95,0x97,0x99,0x9b,0x9d,0x9f,0xa2,0xa4,0xa6,0xa8,0xaa,0xab,0xad,0xaf,0xb2,0xb4,0xb6,0xb8,0xba,0xbb,0xbd,0xbf,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xe9,0xea,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xef,0xf0,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x2,0x3,0x4,0x4,0x4,0x5,0x6,0x6,0x6,0x7,0x8,0x8,0x8,0x9,0xa,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xe,0xe,0xe,0xf,0x10,0x10,0x10,0x11,0x12,0x12,0x12,0x13,0x14,0x14,0x14,0x15,0x16,0x16,0x16,0x17,0x18,0x18,0x18,0x19,0x1a,0x1a,0x1a,0x1b,0x1c,0x1c,0x1c,0x1d,0x1e,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x42,0x44,0x46,0x48,0x4a,0x4c,0x4e,0x50,0x52,0x54,0x56,0x58,0x5a,0x5c,0x5e,0x60,0x62,0x64,0x66,0x68,0x6a,0x6c,0x6e,0x70,0x72,0x74,0x76,0x78,0x7a,0x7c,0x7e,0x80,0x82,0x84,0x86,0x88,0x8a,0x8c,0x8e,0x90,0x92,0x94,0x96,0x98,0x9a,0x9c,0x9e,0xa0,0xa2,0xa4,0xa6,0xa8,0xaa,0xac,0xae,0xb0,0xb2,0xb4,0xb6,0xb8,0xba,0xbc,0xbe,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe2,0xe3,0xe4,0xe4,0xe4,0xe5,0xe6,0xe6,0xe6,0xe7,0xe8,0xe8,0xe8,0xe9,0xea,0xea,0xea,0xeb,0xec,0xec,0xec,0xed,0xee,0xee,0xee,0xef,0xf0,0xf0,0xf0,0xf1,0xf2,0xf2,0xf2,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf6,0xf7,0xf8,0xf8,0xf8,0xf9,0xfa,0xfa,0xfa,0xfb,0xfc,0xfc,0xfc,0xfd,0xfe,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x3,0x3,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x9,0x9,0xa,0xa,0xb,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x15,0x16,0x16,0x17,0x17,0x18,0x18,0x18,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x40,0x41,0x43,0x45,0x47,0x4a,0x4c,0x4e,0x50,0x51,0x53,0x55,0x57,0x5a,0x5c,0x5e,0x60,0x60,0x63,0x65,0x67,0x68,0x6b,0x6d,0x6f,0x70,0x72,0x74,0x76,0x78,0x7a,0x7c,0x7e,0x80,0x82,0x84,0x86,0x88,0x8a,0x8c,0x8e,0x90,0x91,0x93,0x95,0x98,0x99,0x9b,0x9d,0xa0,0xa0,0xa2,0xa4,0xa6,0xa9,0xab,0xad,0xaf,0xb0,0xb2,0xb4,0xb6,0xb9,0xbb,0xbd,0xbf,0xc0,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe8,0xe8,0xe8,0xe9,0xe9,0xea,0xea,0xeb,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf7,0xf7,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x3,0x3,0x4,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x9,0xa,0xa,0xb,0xb,0xc,0xc,0xc,0xd,0xe,0xe,0xf,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x17,0x18,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x20,0x21,0x21,0x22,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x31,0x32,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x41,0x42,0x44,0x46,0x49,0x4b,0x4d,0x50,0x51,0x52,0x54,0x56,0x59,0x5b,0x5d,0x60,0x60,0x61,0x63,0x66,0x68,0x69,0x6b,0x6e,0x70,0x70,0x73,0x74,0x77,0x78,0x7a,0x7c,0x7e,0x80,0x82,0x84,0x86,0x88,0x89,0x8c,0x8d,0x90,0x90,0x92,0x95,0x97,0x98,0x9a,0x9d,0x9f,0xa0,0xa0,0xa3,0xa5,0xa7,0xaa,0xac,0xae,0xaf,0xb0,0xb3,0xb5,0xb7,0xba,0xbc,0xbe,0xbf,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xdf,0xe0,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe8,0xe9,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf6,0xf7,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x1,0x2,0x3,0x3,0x4,0x4,0x5,0x6,0x6,0x6,0x7,0x8,0x8,0x9,0x9,0xa,0xb,0xb,0xc,0xc,0xc,0xd,0xd,0xe,0xf,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x21,0x21,0x22,0x22,0x23,0x24,0x25,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2e,0x2f,0x30,0x31,0x31,0x32,0x32,0x33,0x34,0x35,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x41,0x42,0x42,0x43,0x45,0x48,0x4a,0x4c,0x4f,0x51,0x52,0x53,0x55,0x58,0x5a,0x5c,0x5f,0x60,0x61,0x62,0x64,0x66,0x68,0x6a,0x6c,0x6e,0x70,0x71,0x73,0x75,0x77,0x78,0x7a,0x7c,0x7e,0x80,0x82,0x84,0x86,0x88,0x89,0x8b,0x8d,0x8f,0x90,0x92,0x94,0x96,0x98,0x9a,0x9c,0x9e,0x9f,0xa0,0xa1,0xa4,0xa6,0xa8,0xab,0xad,0xae,0xaf,0xb1,0xb4,0xb6,0xb8,0xbb,0xbd,0xbe,0xbe,0xbf,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xcb,0xcc,0xcd,0xce,0xce,0xcf,0xcf,0xd0,0xd1,0xd2,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xdb,0xdc,0xdd,0xde,0xde,0xdf,0xdf,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf5,0xf6,0xf7,0xf7,0xf8,0xf8,0xf9,0xfa,0xfa,0xfa,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0xff,0x0,0x1,0x1,0x2,0x2,0x3,0x3,0x4,0x4,0x5,0x6,0x6,0x6,0x7,0x8,0x8,0x9,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0x10,0x10,0x11,0x11,0x12,0x13,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x22,0x23,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x32,0x33,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x42,0x43,0x43,0x44,0x46,0x49,0x4c,0x4e,0x50,0x52,0x53,0x54,0x56,0x59,0x5c,0x5e,0x60,0x61,0x61,0x62,0x64,0x67,0x69,0x6a,0x6c,0x6f,0x70,0x71,0x74,0x75,0x78,0x78,0x7a,0x7c,0x7e,0x80,0x82,0x84,0x86,0x88,0x88,0x8b,0x8c,0x8f,0x90,0x91,0x94,0x96,0x97,0x99,0x9c,0x9e,0x9f,0x9f,0xa0,0xa2,0xa4,0xa7,0xaa,0xac,0xad,0xae,0xb0,0xb2,0xb4,0xb7,0xba,0xbc,0xbd,0xbd,0xbe,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xdd,0xde,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xed,0xee,0xef,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf7,0xf8,0xf8,0xf9,0xfa,0xfa,0xfa,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x2,0x3,0x3,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x8,0x9,0x9,0xa,0xb,0xb,0xc,0xc,0xd,0xd,0xd,0xe,0xf,0xf,0x10,0x11,0x11,0x12,0x12,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1a,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x22,0x23,0x24,0x24,0x25,0x26,0x28,0x29,0x2a,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x32,0x32,0x33,0x34,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x42,0x43,0x44,0x44,0x45,0x48,0x4a,0x4d,0x50,0x51,0x52,0x54,0x55,0x58,0x5a,0x5d,0x60,0x61,0x61,0x62,0x62,0x65,0x68,0x69,0x6a,0x6d,0x70,0x71,0x71,0x74,0x75,0x78,0x79,0x7b,0x7c,0x7e,0x80,0x82,0x84,0x85,0x87,0x88,0x8b,0x8c,0x8f,0x8f,0x90,0x93,0x96,0x97,0x98,0x9b,0x9e,0x9e,0x9f,0x9f,0xa0,0xa3,0xa6,0xa8,0xab,0xac,0xae,0xaf,0xb0,0xb3,0xb6,0xb8,0xbb,0xbc,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xce,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe6,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xee,0xee,0xef,0xef,0xf0,0xf1,0xf1,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf5,0xf5,0xf6,0xf7,0xf7,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x2,0x3,0x3,0x4,0x5,0x5,0x6,0x6,0x7,0x7,0x8,0x9,0xa,0xa,0xb,0xc,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x23,0x24,0x25,0x25,0x26,0x27,0x29,0x2a,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x32,0x33,0x33,0x34,0x35,0x35,0x36,0x37,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x45,0x46,0x49,0x4c,0x4e,0x50,0x52,0x53,0x55,0x56,0x59,0x5c,0x5e,0x60,0x61,0x62,0x62,0x63,0x66,0x68,0x6a,0x6b,0x6e,0x70,0x71,0x72,0x74,0x76,0x78,0x79,0x7b,0x7c,0x7e,0x80,0x82,0x84,0x85,0x87,0x88,0x8a,0x8c,0x8e,0x8f,0x90,0x92,0x95,0x96,0x98,0x9a,0x9d,0x9e,0x9e,0x9f,0xa0,0xa2,0xa4,0xa7,0xaa,0xab,0xad,0xae,0xb0,0xb2,0xb4,0xb7,0xba,0xbb,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc9,0xca,0xcb,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd6,0xd7,0xd9,0xda,0xdb,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf6,0xf7,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfb,0xfc,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x2,0x3,0x3,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x9,0xa,0xa,0xb,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xf,0x10,0x11,0x11,0x12,0x13,0x14,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x21,0x22,0x23,0x23,0x24,0x25,0x26,0x26,0x27,0x28,0x2a,0x2b,0x2d,0x2e,0x30,0x31,0x31,0x32,0x33,0x33,0x34,0x35,0x36,0x36,0x37,0x38,0x3a,0x3b,0x3d,0x3e,0x40,0x41,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x46,0x47,0x4a,0x4d,0x50,0x51,0x53,0x54,0x56,0x57,0x5a,0x5d,0x60,0x61,0x61,0x62,0x63,0x64,0x66,0x69,0x6a,0x6c,0x6e,0x70,0x71,0x72,0x74,0x76,0x78,0x79,0x7b,0x7c,0x7e,0x80,0x82,0x84,0x85,0x87,0x88,0x8a,0x8c,0x8e,0x8f,0x90,0x92,0x94,0x96,0x97,0x9a,0x9c,0x9d,0x9e,0x9f,0x9f,0xa0,0xa3,0xa6,0xa9,0xaa,0xac,0xad,0xaf,0xb0,0xb3,0xb6,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xbf,0xc0,0xc2,0xc3,0xc5,0xc6,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xcf,0xd0,0xd2,0xd3,0xd5,0xd6,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xec,0xed,0xee,0xef,0xef,0xf0,0xf1,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf6,0xf7,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfd,0xfd,0xfe,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x2,0x3,0x4,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x9,0xa,0xb,0xc,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x26,0x27,0x28,0x2a,0x2b,0x2c,0x2e,0x30,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x36,0x37,0x38,0x3a,0x3b,0x3c,0x3e,0x40,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x46,0x47,0x48,0x4b,0x4e,0x50,0x52,0x54,0x55,0x56,0x58,0x5b,0x5e,0x60,0x61,0x62,0x62,0x63,0x64,0x67,0x69,0x6a,0x6c,0x6f,0x70,0x71,0x72,0x74,0x76,0x78,0x79,0x7b,0x7c,0x7e,0x80,0x82,0x84,0x85,0x87,0x88,0x8a,0x8c,0x8e,0x8f,0x90,0x91,0x94,0x96,0x97,0x99,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa2,0xa5,0xa8,0xaa,0xab,0xac,0xae,0xb0,0xb2,0xb5,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc4,0xc5,0xc6,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd4,0xd5,0xd6,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfc,0xfd,0xfe,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x3,0x4,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x9,0x9,0xa,0xb,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xf,0x10,0x11,0x11,0x12,0x13,0x14,0x14,0x15,0x16,0x17,0x18,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1b,0x1c,0x1c,0x1c,0x1d,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x27,0x27,0x28,0x29,0x2b,0x2c,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x37,0x38,0x39,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x47,0x48,0x49,0x4c,0x4f,0x51,0x53,0x54,0x56,0x57,0x59,0x5c,0x5f,0x61,0x61,0x62,0x63,0x64,0x64,0x68,0x69,0x6b,0x6c,0x70,0x71,0x71,0x72,0x75,0x76,0x78,0x79,0x7b,0x7d,0x7e,0x80,0x82,0x83,0x85,0x87,0x88,0x8a,0x8b,0x8e,0x8f,0x8f,0x90,0x94,0x95,0x97,0x98,0x9c,0x9c,0x9d,0x9e,0x9f,0x9f,0xa1,0xa4,0xa7,0xa9,0xaa,0xac,0xad,0xaf,0xb1,0xb4,0xb7,0xb8,0xb9,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc7,0xc8,0xc9,0xc9,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd4,0xd5,0xd7,0xd8,0xd9,0xd9,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe3,0xe4,0xe4,0xe4,0xe5,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xeb,0xec,0xec,0xed,0xee,0xef,0xef,0xf0,0xf1,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf7,0xf7,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x3,0x4,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x9,0xa,0xa,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xe,0xf,0x10,0x11,0x12,0x13,0x13,0x14,0x15,0x16,0x17,0x17,0x18,0x19,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1f,0x1f,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2c,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3c,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4d,0x50,0x52,0x54,0x55,0x57,0x58,0x5a,0x5d,0x60,0x61,0x62,0x63,0x63,0x64,0x65,0x68,0x6a,0x6b,0x6d,0x70,0x71,0x72,0x72,0x75,0x76,0x78,0x79,0x7b,0x7d,0x7e,0x80,0x82,0x83,0x85,0x87,0x88,0x8a,0x8b,0x8e,0x8e,0x8f,0x90,0x93,0x95,0x96,0x98,0x9b,0x9c,0x9d,0x9d,0x9e,0x9f,0xa0,0xa3,0xa6,0xa8,0xa9,0xab,0xac,0xae,0xb0,0xb3,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc4,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd4,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe1,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe7,0xe8,0xe9,0xe9,0xea,0xeb,0xec,0xed,0xed,0xee,0xef,0xf0,0xf1,0xf2,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf6,0xf7,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x3,0x4,0x5,0x5,0x6,0x7,0x7,0x7,0x8,0x9,0xa,0xb,0xc,0xc,0xd,0xd,0xd,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x16,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x22,0x23,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2d,0x2e,0x30,0x31,0x32,0x33,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3d,0x3e,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4e,0x51,0x53,0x54,0x56,0x58,0x59,0x5b,0x5e,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x68,0x6a,0x6c,0x6e,0x70,0x71,0x72,0x73,0x75,0x77,0x79,0x79,0x7b,0x7d,0x7e,0x80,0x82,0x83,0x85,0x87,0x87,0x89,0x8b,0x8d,0x8e,0x8f,0x90,0x92,0x94,0x96,0x98,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa2,0xa5,0xa7,0xa8,0xaa,0xac,0xad,0xaf,0xb2,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc2,0xc3,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xd0,0xd2,0xd3,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xf9,0xf9,0xfa,0xfb,0xfb,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x3,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x8,0x9,0xa,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0xf,0x10,0x11,0x12,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x22,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2c,0x2e,0x30,0x31,0x32,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3c,0x3e,0x40,0x41,0x42,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x50,0x52,0x53,0x55,0x57,0x58,0x5a,0x5c,0x60,0x61,0x62,0x62,0x63,0x64,0x65,0x66,0x69,0x6a,0x6c,0x6e,0x70,0x71,0x72,0x73,0x75,0x77,0x79,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x87,0x89,0x8b,0x8d,0x8e,0x8f,0x90,0x92,0x94,0x96,0x97,0x9a,0x9b,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa4,0xa6,0xa8,0xa9,0xab,0xad,0xae,0xb0,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc2,0xc4,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xce,0xcf,0xd0,0xd2,0xd4,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xde,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xee,0xef,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x3,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x9,0x9,0xa,0xb,0xc,0xd,0xd,0xd,0xe,0xe,0xf,0xf,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x16,0x17,0x18,0x19,0x19,0x1a,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1e,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x50,0x52,0x54,0x56,0x58,0x59,0x5b,0x5d,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x66,0x69,0x6b,0x6d,0x6e,0x71,0x71,0x72,0x73,0x75,0x77,0x79,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x87,0x89,0x8b,0x8d,0x8e,0x8f,0x8f,0x92,0x93,0x95,0x97,0x9a,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa3,0xa5,0xa7,0xa8,0xaa,0xac,0xae,0xb0,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe6,0xe7,0xe7,0xe8,0xe9,0xea,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf3,0xf4,0xf5,0xf6,0xf7,0xf7,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x3,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x9,0xa,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x51,0x53,0x55,0x56,0x58,0x5a,0x5c,0x5e,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x69,0x6b,0x6d,0x6f,0x71,0x72,0x73,0x74,0x76,0x78,0x79,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x87,0x88,0x8a,0x8c,0x8d,0x8e,0x8f,0x91,0x93,0x95,0x97,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa2,0xa4,0xa6,0xa8,0xaa,0xab,0xad,0xaf,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x3,0x4,0x5,0x6,0x6,0x7,0x7,0x8,0x9,0xa,0xb,0xc,0xc,0xd,0xd,0xe,0xe,0xf,0xf,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x51,0x53,0x55,0x57,0x59,0x5b,0x5d,0x5f,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x6a,0x6c,0x6e,0x70,0x71,0x72,0x73,0x74,0x76,0x78,0x79,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x87,0x88,0x8a,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x94,0x96,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa1,0xa3,0xa5,0xa7,0xa9,0xab,0xad,0xaf,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xf9,0xfa,0xfa,0xfb,0xfc,0xfd,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x4,0x4,0x5,0x6,0x6,0x7,0x8,0x8,0x9,0xa,0xb,0xc,0xc,0xd,0xe,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x18,0x19,0x1a,0x1a,0x1a,0x1b,0x1c,0x1c,0x1c,0x1d,0x1e,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x52,0x54,0x56,0x58,0x5a,0x5c,0x5e,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x6a,0x6c,0x6e,0x70,0x71,0x72,0x73,0x74,0x76,0x78,0x79,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x87,0x88,0x8a,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x94,0x96,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa2,0xa4,0xa6,0xa8,0xaa,0xac,0xae,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe2,0xe3,0xe4,0xe4,0xe4,0xe5,0xe6,0xe6,0xe6,0xe7,0xe8,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf2,0xf3,0xf4,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf9,0xfa,0xfa,0xfb,0xfc,0xfc,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x4,0x4,0x5,0x6,0x7,0x7,0x8,0x8,0x9,0xa,0xb,0xc,0xd,0xd,0xe,0xe,0xf,0xf,0x10,0x10,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x18,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x50,0x51,0x53,0x55,0x57,0x5a,0x5c,0x5e,0x60,0x60,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x68,0x6b,0x6d,0x6f,0x70,0x71,0x72,0x73,0x74,0x76,0x78,0x79,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x87,0x88,0x8a,0x8c,0x8d,0x8e,0x8f,0x90,0x91,0x93,0x95,0x98,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0xa0,0xa0,0xa2,0xa4,0xa6,0xa9,0xab,0xad,0xaf,0xb0,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe8,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf9,0xf9,0xfa,0xfb,0xfc,0xfc,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x4,0x4,0x5,0x6,0x7,0x7,0x8,0x8,0xa,0xb,0xc,0xc,0xd,0xe,0xe,0xf,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x16,0x17,0x18,0x18,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x20,0x21,0x21,0x22,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x31,0x32,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x41,0x42,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x51,0x52,0x54,0x56,0x59,0x5b,0x5d,0x60,0x60,0x61,0x62,0x63,0x64,0x66,0x67,0x68,0x68,0x69,0x6b,0x6e,0x70,0x70,0x72,0x73,0x74,0x74,0x77,0x78,0x79,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x87,0x88,0x89,0x8c,0x8c,0x8d,0x8e,0x90,0x90,0x92,0x95,0x97,0x98,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9f,0xa0,0xa0,0xa3,0xa5,0xa7,0xaa,0xac,0xae,0xaf,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xdf,0xe0,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe8,0xe8,0xe9,0xea,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf2,0xf3,0xf4,0xf4,0xf5,0xf6,0xf8,0xf8,0xf9,0xf9,0xfa,0xfb,0xfc,0xfc,0xfd,0xfe,0xff,0xff,0x0,0x1,0x1,0x2,0x3,0x4,0x4,0x6,0x6,0x7,0x8,0x8,0x9,0xa,0xb,0xc,0xd,0xd,0xe,0xf,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x1f,0x20,0x21,0x21,0x22,0x22,0x23,0x24,0x25,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2e,0x2f,0x30,0x31,0x31,0x32,0x32,0x33,0x34,0x35,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x41,0x42,0x42,0x43,0x44,0x45,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4e,0x4f,0x50,0x51,0x51,0x52,0x52,0x53,0x55,0x58,0x5a,0x5c,0x5f,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x69,0x6a,0x6c,0x6e,0x70,0x71,0x72,0x73,0x74,0x75,0x77,0x78,0x7a,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x86,0x88,0x89,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x94,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa1,0xa4,0xa6,0xa8,0xab,0xad,0xae,0xae,0xaf,0xaf,0xb0,0xb1,0xb2,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xbb,0xbc,0xbd,0xbe,0xbe,0xbf,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xcb,0xcc,0xcd,0xce,0xce,0xcf,0xcf,0xd0,0xd1,0xd2,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xdb,0xdc,0xdd,0xde,0xde,0xdf,0xdf,0xe0,0xe1,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe6,0xe7,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf1,0xf2,0xf3,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf9,0xfa,0xfa,0xfc,0xfc,0xfd,0xfe,0xff,0xff,0x0,0x1,0x2,0x2,0x3,0x4,0x4,0x6,0x6,0x7,0x8,0x8,0x9,0xa,0xc,0xc,0xd,0xe,0xe,0xf,0x10,0x10,0x11,0x11,0x12,0x13,0x14,0x16,0x17,0x18,0x19,0x19,0x1a,0x1b,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x22,0x23,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x32,0x33,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x42,0x43,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x52,0x53,0x53,0x54,0x56,0x59,0x5c,0x5e,0x60,0x61,0x61,0x62,0x63,0x64,0x66,0x67,0x68,0x69,0x69,0x6a,0x6c,0x6f,0x70,0x71,0x72,0x74,0x74,0x75,0x78,0x78,0x7a,0x7a,0x7c,0x7d,0x7e,0x80,0x82,0x83,0x84,0x86,0x86,0x88,0x88,0x8b,0x8c,0x8c,0x8e,0x8f,0x90,0x91,0x94,0x96,0x97,0x97,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9f,0x9f,0xa0,0xa2,0xa4,0xa7,0xaa,0xac,0xad,0xad,0xae,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xdd,0xde,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe5,0xe6,0xe7,0xe7,0xe8,0xe9,0xea,0xec,0xed,0xee,0xef,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf3,0xf4,0xf4,0xf6,0xf7,0xf8,0xf8,0xf9,0xfa,0xfa,0xfc,0xfc,0xfd,0xfe,0xfe,0xff,0x0,0x1,0x2,0x2,0x3,0x4,0x5,0x6,0x7,0x7,0x8,0x9,0x9,0xb,0xc,0xd,0xd,0xe,0xf,0xf,0x10,0x11,0x11,0x12,0x12,0x14,0x15,0x16,0x18,0x19,0x19,0x1a,0x1a,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x22,0x23,0x24,0x24,0x25,0x26,0x28,0x29,0x2a,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x32,0x32,0x33,0x34,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x42,0x43,0x44,0x44,0x45,0x46,0x48,0x49,0x4a,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x52,0x52,0x53,0x54,0x54,0x55,0x58,0x5a,0x5d,0x60,0x61,0x61,0x62,0x62,0x64,0x65,0x66,0x68,0x69,0x69,0x6a,0x6a,0x6d,0x70,0x71,0x71,0x73,0x74,0x75,0x75,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7f,0x80,0x81,0x83,0x84,0x85,0x86,0x87,0x88,0x8b,0x8b,0x8c,0x8d,0x8f,0x8f,0x90,0x93,0x96,0x96,0x97,0x97,0x98,0x9a,0x9b,0x9c,0x9e,0x9e,0x9f,0x9f,0xa0,0xa3,0xa6,0xa8,0xab,0xac,0xac,0xad,0xae,0xae,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xce,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe6,0xe6,0xe7,0xe7,0xe8,0xea,0xeb,0xec,0xee,0xee,0xef,0xef,0xf0,0xf1,0xf1,0xf2,0xf3,0xf3,0xf4,0xf5,0xf7,0xf7,0xf8,0xf9,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xfe,0xff,0x0,0x1,0x2,0x2,0x3,0x4,0x5,0x6,0x7,0x7,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xe,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x14,0x16,0x17,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x23,0x24,0x25,0x25,0x26,0x27,0x29,0x2a,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x32,0x33,0x33,0x34,0x35,0x35,0x36,0x37,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x45,0x46,0x47,0x49,0x4a,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x52,0x53,0x53,0x54,0x55,0x55,0x56,0x59,0x5c,0x5e,0x60,0x61,0x62,0x62,0x63,0x64,0x66,0x67,0x68,0x69,0x6a,0x6a,0x6b,0x6e,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7f,0x80,0x81,0x83,0x84,0x85,0x86,0x87,0x88,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x95,0x96,0x96,0x97,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa2,0xa4,0xa7,0xaa,0xab,0xab,0xac,0xad,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb6,0xb7,0xb9,0xba,0xbb,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc9,0xca,0xcb,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd6,0xd7,0xd9,0xda,0xdb,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xe9,0xea,0xec,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xfe,0xff,0x0,0x1,0x2,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xe,0xf,0x10,0x11,0x11,0x12,0x13,0x14,0x15,0x16,0x18,0x19,0x19,0x1a,0x1b,0x1c,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x21,0x22,0x23,0x23,0x24,0x25,0x26,0x26,0x27,0x28,0x2a,0x2b,0x2d,0x2e,0x30,0x31,0x31,0x32,0x33,0x33,0x34,0x35,0x36,0x36,0x37,0x38,0x3a,0x3b,0x3d,0x3e,0x40,0x41,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x46,0x47,0x48,0x4a,0x4b,0x4d,0x4e,0x50,0x51,0x51,0x52,0x53,0x53,0x54,0x55,0x56,0x56,0x57,0x5a,0x5d,0x60,0x61,0x61,0x62,0x63,0x64,0x65,0x66,0x68,0x69,0x69,0x6a,0x6b,0x6c,0x6e,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7f,0x80,0x81,0x83,0x84,0x85,0x86,0x87,0x88,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x94,0x95,0x96,0x97,0x97,0x98,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0x9f,0xa0,0xa3,0xa6,0xa9,0xaa,0xaa,0xab,0xac,0xad,0xad,0xae,0xaf,0xaf,0xb0,0xb2,0xb3,0xb5,0xb6,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xbf,0xc0,0xc2,0xc3,0xc5,0xc6,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xcf,0xd0,0xd2,0xd3,0xd5,0xd6,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe4,0xe5,0xe6,0xe7,0xe7,0xe8,0xea,0xeb,0xec,0xed,0xee,0xef,0xef,0xf0,0xf1,0xf2,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xfe,0xff,0x0,0x1,0x2,0x2,0x4,0x4,0x5,0x6,0x7,0x8,0x8,0x9,0xa,0xc,0xc,0xd,0xe,0xf,0x10,0x10,0x11,0x12,0x12,0x13,0x14,0x16,0x17,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x26,0x27,0x28,0x2a,0x2b,0x2c,0x2e,0x30,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x36,0x37,0x38,0x3a,0x3b,0x3c,0x3e,0x40,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x46,0x47,0x48,0x4a,0x4b,0x4c,0x4e,0x50,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x56,0x57,0x58,0x5b,0x5e,0x60,0x61,0x62,0x62,0x63,0x64,0x66,0x67,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6f,0x70,0x71,0x72,0x74,0x74,0x75,0x76,0x78,0x79,0x7a,0x7b,0x7c,0x7e,0x7f,0x80,0x81,0x82,0x84,0x85,0x86,0x87,0x88,0x8a,0x8b,0x8c,0x8c,0x8e,0x8f,0x90,0x91,0x94,0x95,0x96,0x96,0x97,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa2,0xa5,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb4,0xb5,0xb6,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc4,0xc5,0xc6,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd4,0xd5,0xd6,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xe9,0xea,0xec,0xed,0xee,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf3,0xf4,0xf4,0xf6,0xf7,0xf8,0xf8,0xf9,0xfa,0xfb,0xfc,0xfc,0xfe,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x4,0x5,0x6,0x7,0x8,0x9,0x9,0xa,0xc,0xd,0xd,0xe,0xf,0x10,0x11,0x11,0x12,0x13,0x14,0x14,0x16,0x18,0x19,0x19,0x1a,0x1b,0x1c,0x1c,0x1d,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x27,0x27,0x28,0x29,0x2b,0x2c,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x37,0x38,0x39,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x47,0x48,0x49,0x4b,0x4c,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x57,0x57,0x58,0x59,0x5c,0x5f,0x61,0x61,0x62,0x63,0x64,0x64,0x66,0x68,0x69,0x69,0x6a,0x6b,0x6c,0x6c,0x70,0x71,0x71,0x72,0x74,0x75,0x75,0x76,0x78,0x79,0x7a,0x7b,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x85,0x86,0x87,0x88,0x8a,0x8b,0x8b,0x8c,0x8e,0x8f,0x8f,0x90,0x94,0x94,0x95,0x96,0x97,0x97,0x98,0x9a,0x9c,0x9c,0x9d,0x9e,0x9f,0x9f,0xa1,0xa4,0xa7,0xa8,0xa9,0xa9,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb4,0xb5,0xb7,0xb8,0xb9,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc7,0xc8,0xc9,0xc9,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd4,0xd5,0xd7,0xd8,0xd9,0xd9,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe3,0xe4,0xe4,0xe5,0xe6,0xe7,0xe7,0xe8,0xea,0xec,0xec,0xed,0xee,0xef,0xef,0xf0,0xf1,0xf2,0xf3,0xf3,0xf4,0xf6,0xf7,0xf7,0xf8,0xf9,0xfa,0xfb,0xfc,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x4,0x5,0x6,0x7,0x8,0x9,0xa,0xa,0xc,0xd,0xe,0xe,0xf,0x10,0x11,0x12,0x13,0x13,0x14,0x15,0x17,0x18,0x19,0x1a,0x1b,0x1b,0x1c,0x1d,0x1e,0x1f,0x1f,0x20,0x21,0x22,0x23,0x24,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2c,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3c,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4c,0x4d,0x4f,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5d,0x60,0x61,0x62,0x63,0x63,0x64,0x65,0x67,0x68,0x69,0x6a,0x6b,0x6b,0x6c,0x6d,0x70,0x71,0x72,0x72,0x74,0x75,0x76,0x76,0x78,0x79,0x7a,0x7b,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x85,0x86,0x87,0x88,0x8a,0x8a,0x8b,0x8c,0x8e,0x8e,0x8f,0x90,0x93,0x94,0x95,0x95,0x96,0x97,0x98,0x99,0x9b,0x9c,0x9d,0x9d,0x9e,0x9f,0xa0,0xa3,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb3,0xb4,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc4,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd4,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe1,0xe2,0xe3,0xe4,0xe5,0xe5,0xe6,0xe7,0xe8,0xe9,0xeb,0xec,0xed,0xed,0xee,0xef,0xf0,0xf1,0xf2,0xf2,0xf3,0xf4,0xf6,0xf6,0xf7,0xf8,0xf9,0xfa,0xfb,0xfc,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x5,0x7,0x7,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x22,0x23,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2d,0x2e,0x30,0x31,0x32,0x33,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3d,0x3e,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4d,0x4e,0x50,0x51,0x52,0x53,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5e,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x79,0x79,0x7b,0x7b,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x85,0x85,0x87,0x87,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa2,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xad,0xae,0xaf,0xb0,0xb2,0xb3,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc2,0xc3,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xd0,0xd2,0xd3,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xf9,0xfb,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x11,0x12,0x12,0x13,0x14,0x15,0x16,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x21,0x22,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2c,0x2e,0x30,0x31,0x32,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3c,0x3e,0x40,0x41,0x42,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x4e,0x50,0x51,0x52,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5c,0x60,0x61,0x62,0x62,0x63,0x64,0x65,0x66,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6d,0x6e,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x93,0x94,0x95,0x96,0x96,0x97,0x98,0x9a,0x9b,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa4,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xae,0xaf,0xb0,0xb2,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc2,0xc4,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xce,0xcf,0xd0,0xd2,0xd4,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xde,0xdf,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xea,0xeb,0xec,0xed,0xee,0xee,0xef,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x9,0x9,0xa,0xb,0xd,0xd,0xe,0xf,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x16,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1e,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x66,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6e,0x71,0x71,0x72,0x73,0x75,0x75,0x76,0x77,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x89,0x8a,0x8b,0x8b,0x8d,0x8e,0x8f,0x8f,0x92,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x9a,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xea,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf1,0xf2,0xf3,0xf3,0xf5,0xf6,0xf7,0xf7,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xef,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x18,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x60,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x68,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x98,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0xa0,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd0,0xd1,0xd2,0xd3,0xd4,0xd5,0xd6,0xd8,0xd9,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe8,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0xa,0xb,0xc,0xc,0xe,0xf,0x10,0x11,0x12,0x13,0x14,0x16,0x17,0x18,0x18,0x19,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x31,0x32,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x41,0x42,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x51,0x52,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x60,0x60,0x61,0x61,0x62,0x63,0x64,0x66,0x67,0x68,0x68,0x69,0x6a,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x70,0x72,0x73,0x74,0x74,0x76,0x77,0x78,0x78,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x88,0x88,0x89,0x8a,0x8c,0x8c,0x8d,0x8e,0x90,0x90,0x90,0x91,0x92,0x94,0x95,0x96,0x97,0x98,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9f,0x9f,0xa0,0xa0,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xae,0xaf,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd5,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xde,0xdf,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe7,0xe8,0xe8,0xe9,0xea,0xec,0xed,0xee,0xef,0xf0,0xf1,0xf2,0xf4,0xf4,0xf5,0xf6,0xf8,0xf9,0xfa,0xfb,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x6,0x6,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x21,0x22,0x23,0x24,0x25,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2e,0x2f,0x30,0x31,0x31,0x32,0x32,0x33,0x34,0x35,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x41,0x42,0x42,0x43,0x44,0x45,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4e,0x4f,0x50,0x51,0x51,0x52,0x52,0x53,0x54,0x55,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5e,0x5f,0x60,0x60,0x61,0x61,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x78,0x7a,0x7a,0x7c,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x84,0x86,0x86,0x88,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa1,0xa2,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xab,0xac,0xad,0xae,0xae,0xaf,0xaf,0xb0,0xb1,0xb2,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xbb,0xbc,0xbd,0xbe,0xbe,0xbf,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xcb,0xcc,0xcd,0xce,0xce,0xcf,0xcf,0xd0,0xd1,0xd2,0xd4,0xd5,0xd6,0xd7,0xd8,0xd9,0xdb,0xdc,0xdd,0xde,0xdf,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xea,0xeb,0xec,0xed,0xee,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf7,0xf8,0xfa,0xfa,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x4,0x6,0x6,0x8,0x9,0xa,0xc,0xc,0xd,0xe,0x10,0x11,0x12,0x13,0x14,0x16,0x17,0x18,0x19,0x19,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x23,0x24,0x25,0x26,0x28,0x29,0x2a,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x32,0x33,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x42,0x43,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x52,0x53,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x64,0x66,0x67,0x68,0x69,0x69,0x6a,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x74,0x74,0x75,0x76,0x78,0x78,0x78,0x7a,0x7a,0x7c,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x84,0x86,0x86,0x88,0x88,0x88,0x8a,0x8b,0x8c,0x8c,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x95,0x96,0x97,0x97,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xad,0xae,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xce,0xcf,0xd0,0xd1,0xd2,0xd3,0xd4,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xdd,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe7,0xe7,0xe8,0xe9,0xea,0xec,0xed,0xee,0xef,0xf0,0xf2,0xf3,0xf4,0xf4,0xf6,0xf7,0xf8,0xfa,0xfa,0xfc,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x5,0x6,0x7,0x8,0x9,0xb,0xc,0xd,0xd,0xf,0x10,0x11,0x12,0x14,0x15,0x16,0x18,0x19,0x19,0x1a,0x1a,0x1c,0x1d,0x1e,0x20,0x21,0x22,0x24,0x25,0x26,0x28,0x29,0x2a,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x32,0x32,0x33,0x34,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x42,0x43,0x44,0x44,0x45,0x46,0x48,0x49,0x4a,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x52,0x52,0x53,0x54,0x54,0x55,0x56,0x58,0x59,0x5a,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x62,0x64,0x65,0x66,0x68,0x69,0x69,0x6a,0x6a,0x6c,0x6d,0x6e,0x70,0x70,0x71,0x71,0x71,0x73,0x74,0x75,0x75,0x77,0x78,0x78,0x79,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x84,0x85,0x86,0x87,0x88,0x88,0x89,0x8b,0x8b,0x8c,0x8d,0x8f,0x8f,0x8f,0x90,0x90,0x92,0x93,0x94,0x96,0x96,0x97,0x97,0x98,0x9a,0x9b,0x9c,0x9e,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xac,0xad,0xae,0xae,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xce,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd6,0xd7,0xd8,0xda,0xdb,0xdc,0xde,0xdf,0xe0,0xe2,0xe3,0xe4,0xe6,0xe6,0xe7,0xe7,0xe8,0xea,0xeb,0xec,0xee,0xef,0xf0,0xf1,0xf3,0xf3,0xf4,0xf5,0xf7,0xf8,0xf9,0xfa,0xfb,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x5,0x6,0x7,0x8,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x12,0x13,0x14,0x16,0x17,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x23,0x25,0x26,0x27,0x29,0x2a,0x2c,0x2d,0x2e,0x30,0x30,0x31,0x32,0x33,0x33,0x34,0x35,0x35,0x36,0x37,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x45,0x46,0x47,0x49,0x4a,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x52,0x53,0x53,0x54,0x55,0x55,0x56,0x57,0x59,0x5a,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x66,0x67,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x78,0x79,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x84,0x85,0x86,0x87,0x88,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x95,0x96,0x96,0x97,0x98,0x99,0x9a,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa6,0xa7,0xa9,0xaa,0xab,0xab,0xac,0xad,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb6,0xb7,0xb9,0xba,0xbb,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc9,0xca,0xcb,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd3,0xd4,0xd6,0xd7,0xd9,0xda,0xdb,0xdd,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xe9,0xea,0xec,0xed,0xee,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf8,0xf9,0xfa,0xfb,0xfd,0xfe,0xff,0x0,0x1,0x2,0x3,0x5,0x6,0x7,0x8,0xa,0xb,0xc,0xd,0xe,0xf,0x11,0x12,0x14,0x15,0x16,0x18,0x19,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x20,0x21,0x23,0x24,0x26,0x27,0x28,0x2a,0x2b,0x2d,0x2e,0x30,0x31,0x31,0x32,0x33,0x33,0x34,0x35,0x36,0x36,0x37,0x38,0x3a,0x3b,0x3d,0x3e,0x40,0x41,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x46,0x47,0x48,0x4a,0x4b,0x4d,0x4e,0x50,0x51,0x51,0x52,0x53,0x53,0x54,0x55,0x56,0x56,0x57,0x58,0x5a,0x5b,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x65,0x66,0x68,0x69,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x70,0x70,0x71,0x71,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7f,0x80,0x81,0x82,0x83,0x84,0x84,0x85,0x86,0x87,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x92,0x93,0x94,0x95,0x96,0x97,0x97,0x98,0x9a,0x9b,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa5,0xa6,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xad,0xad,0xae,0xaf,0xaf,0xb0,0xb2,0xb3,0xb5,0xb6,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xbf,0xc0,0xc2,0xc3,0xc5,0xc6,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xcf,0xd0,0xd2,0xd3,0xd5,0xd6,0xd8,0xd9,0xda,0xdc,0xdd,0xdf,0xe0,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe7,0xe8,0xea,0xeb,0xec,0xee,0xef,0xf1,0xf2,0xf3,0xf4,0xf5,0xf6,0xf8,0xf9,0xfa,0xfb,0xfd,0xfe,0xff,0x0,0x1,0x2,0x4,0x5,0x6,0x7,0x8,0xa,0xc,0xc,0xd,0xe,0x10,0x11,0x12,0x14,0x16,0x17,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x24,0x25,0x26,0x28,0x2a,0x2b,0x2c,0x2e,0x30,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x36,0x37,0x38,0x3a,0x3b,0x3c,0x3e,0x40,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x46,0x47,0x48,0x4a,0x4b,0x4c,0x4e,0x50,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x56,0x57,0x58,0x5a,0x5b,0x5c,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x66,0x67,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x74,0x74,0x75,0x76,0x78,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7c,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x84,0x84,0x85,0x86,0x87,0x87,0x88,0x88,0x8a,0x8b,0x8c,0x8c,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x95,0x96,0x96,0x97,0x98,0x99,0x9a,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa5,0xa6,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb4,0xb5,0xb6,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc4,0xc5,0xc6,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd0,0xd2,0xd4,0xd5,0xd6,0xd8,0xda,0xdb,0xdc,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xe9,0xea,0xec,0xee,0xef,0xf0,0xf2,0xf3,0xf4,0xf4,0xf6,0xf8,0xf9,0xfa,0xfb,0xfc,0xfe,0xff,0x0,0x1,0x3,0x4,0x5,0x6,0x7,0x9,0xa,0xc,0xd,0xd,0xe,0x10,0x11,0x13,0x14,0x16,0x18,0x19,0x19,0x1a,0x1b,0x1c,0x1c,0x1e,0x20,0x21,0x23,0x24,0x26,0x27,0x29,0x2b,0x2c,0x2e,0x2f,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x37,0x38,0x39,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x47,0x48,0x49,0x4b,0x4c,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x57,0x57,0x58,0x59,0x5b,0x5c,0x5e,0x5f,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x64,0x66,0x68,0x69,0x69,0x6a,0x6b,0x6c,0x6c,0x6e,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x74,0x75,0x75,0x76,0x78,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x85,0x86,0x87,0x87,0x88,0x88,0x8a,0x8b,0x8b,0x8c,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x92,0x94,0x94,0x95,0x96,0x97,0x97,0x98,0x9a,0x9c,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa1,0xa2,0xa4,0xa5,0xa7,0xa8,0xa9,0xa9,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb4,0xb5,0xb7,0xb8,0xb9,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc7,0xc8,0xc9,0xc9,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd2,0xd4,0xd5,0xd7,0xd9,0xda,0xdc,0xdd,0xdf,0xe0,0xe2,0xe4,0xe4,0xe5,0xe6,0xe7,0xe7,0xe8,0xea,0xec,0xed,0xef,0xf0,0xf2,0xf3,0xf3,0xf4,0xf6,0xf7,0xf9,0xfa,0xfb,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x5,0x6,0x7,0x9,0xa,0xc,0xd,0xe,0xe,0x10,0x12,0x13,0x15,0x17,0x18,0x19,0x1a,0x1b,0x1b,0x1c,0x1d,0x1f,0x20,0x22,0x24,0x25,0x27,0x28,0x2a,0x2c,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3c,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4c,0x4d,0x4f,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5c,0x5d,0x5f,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x65,0x65,0x67,0x68,0x69,0x6a,0x6b,0x6b,0x6c,0x6d,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x72,0x74,0x75,0x76,0x76,0x78,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x85,0x86,0x87,0x87,0x88,0x88,0x8a,0x8a,0x8b,0x8c,0x8e,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x93,0x94,0x95,0x95,0x96,0x97,0x98,0x99,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa1,0xa3,0xa4,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb3,0xb4,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc4,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd4,0xd6,0xd8,0xd9,0xdb,0xdc,0xde,0xe0,0xe1,0xe3,0xe4,0xe5,0xe5,0xe6,0xe7,0xe8,0xe9,0xeb,0xed,0xee,0xf0,0xf2,0xf2,0xf3,0xf4,0xf6,0xf7,0xf9,0xfa,0xfb,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x5,0x7,0x7,0x9,0xb,0xc,0xd,0xe,0xf,0x10,0x12,0x14,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x21,0x23,0x24,0x26,0x28,0x29,0x2b,0x2d,0x2e,0x30,0x31,0x32,0x33,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3d,0x3e,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4d,0x4e,0x50,0x51,0x52,0x53,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5d,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x71,0x71,0x71,0x72,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x79,0x79,0x79,0x7b,0x7b,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x85,0x85,0x87,0x87,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xad,0xae,0xaf,0xb0,0xb2,0xb3,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc2,0xc3,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xcd,0xce,0xcf,0xd0,0xd2,0xd3,0xd5,0xd7,0xd8,0xda,0xdc,0xdd,0xdf,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xea,0xec,0xee,0xf0,0xf1,0xf2,0xf3,0xf4,0xf5,0xf7,0xf9,0xf9,0xfb,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x7,0x8,0x9,0xb,0xc,0xd,0xe,0xf,0x11,0x12,0x14,0x16,0x18,0x19,0x1a,0x1a,0x1b,0x1c,0x1d,0x1e,0x20,0x22,0x23,0x25,0x27,0x28,0x2a,0x2c,0x2e,0x30,0x31,0x32,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3c,0x3e,0x40,0x41,0x42,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x4e,0x50,0x51,0x52,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5c,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6d,0x6e,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x75,0x76,0x77,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x84,0x85,0x86,0x87,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x92,0x93,0x94,0x95,0x96,0x96,0x97,0x98,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xae,0xaf,0xb0,0xb2,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc2,0xc4,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xce,0xcf,0xd0,0xd2,0xd4,0xd6,0xd8,0xd9,0xdb,0xdd,0xde,0xe0,0xe2,0xe3,0xe4,0xe5,0xe6,0xe6,0xe7,0xe8,0xea,0xec,0xee,0xef,0xf1,0xf2,0xf3,0xf4,0xf5,0xf7,0xf8,0xf9,0xfa,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x7,0x8,0x9,0xb,0xd,0xd,0xe,0xf,0x11,0x13,0x15,0x16,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1e,0x20,0x22,0x24,0x26,0x28,0x29,0x2b,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5f,0x60,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x66,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6e,0x70,0x71,0x71,0x71,0x72,0x72,0x73,0x73,0x75,0x75,0x76,0x77,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x84,0x85,0x86,0x87,0x87,0x88,0x89,0x8a,0x8b,0x8b,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x92,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x9a,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0xa0,0xa1,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd5,0xd7,0xd8,0xda,0xdc,0xde,0xe0,0xe2,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xea,0xeb,0xed,0xef,0xf1,0xf2,0xf3,0xf3,0xf5,0xf7,0xf8,0xf9,0xfa,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x7,0x8,0xa,0xc,0xd,0xe,0xf,0x10,0x11,0x13,0x15,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x21,0x23,0x25,0x26,0x28,0x2a,0x2c,0x2e,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x75,0x76,0x77,0x78,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x84,0x85,0x86,0x87,0x87,0x88,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd2,0xd4,0xd6,0xd8,0xda,0xdb,0xdd,0xdf,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xe9,0xeb,0xed,0xef,0xf0,0xf1,0xf2,0xf3,0xf4,0xf6,0xf8,0xf9,0xfa,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x7,0x8,0xa,0xc,0xd,0xe,0xf,0x10,0x12,0x14,0x16,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x21,0x23,0x25,0x27,0x29,0x2b,0x2d,0x2f,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x75,0x76,0x77,0x78,0x78,0x79,0x79,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x84,0x85,0x86,0x87,0x87,0x88,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd1,0xd3,0xd5,0xd7,0xd9,0xdb,0xdd,0xdf,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xea,0xec,0xee,0xf0,0xf1,0xf2,0xf3,0xf4,0xf6,0xf8,0xf9,0xfa,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x7,0x8,0xa,0xc,0xd,0xe,0xf,0x10,0x12,0x14,0x16,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x22,0x24,0x26,0x28,0x2a,0x2c,0x2e,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x61,0x62,0x62,0x62,0x63,0x64,0x64,0x64,0x65,0x66,0x66,0x66,0x67,0x68,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x71,0x72,0x72,0x72,0x73,0x74,0x74,0x75,0x76,0x77,0x78,0x78,0x79,0x7a,0x7a,0x7b,0x7c,0x7c,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x84,0x84,0x85,0x86,0x86,0x87,0x88,0x88,0x89,0x8a,0x8b,0x8c,0x8c,0x8d,0x8e,0x8e,0x8e,0x8f,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9a,0x9b,0x9c,0x9c,0x9c,0x9d,0x9e,0x9e,0x9e,0x9f,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd2,0xd4,0xd6,0xd8,0xda,0xdc,0xde,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe7,0xe8,0xea,0xec,0xee,0xf0,0xf1,0xf2,0xf3,0xf4,0xf6,0xf8,0xf9,0xfa,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x7,0x8,0xa,0xc,0xd,0xe,0xf,0x10,0x13,0x15,0x17,0x18,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x21,0x23,0x25,0x27,0x2a,0x2c,0x2e,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x37,0x38,0x3a,0x3b,0x3c,0x3d,0x3e,0x3f,0x40,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x60,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x68,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x74,0x75,0x76,0x77,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x83,0x84,0x85,0x86,0x86,0x87,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x98,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0xa0,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc0,0xc1,0xc2,0xc3,0xc4,0xc5,0xc6,0xc8,0xc9,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd2,0xd4,0xd6,0xd9,0xdb,0xdd,0xdf,0xe0,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe8,0xe9,0xeb,0xed,0xf0,0xf1,0xf2,0xf3,0xf4,0xf6,0xf8,0xf9,0xfa,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x7,0x8,0xb,0xc,0xe,0xf,0x10,0x11,0x13,0x16,0x18,0x19,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x21,0x22,0x24,0x26,0x29,0x2b,0x2d,0x30,0x31,0x32,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3b,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x41,0x42,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x51,0x52,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x68,0x69,0x69,0x6a,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x70,0x71,0x72,0x72,0x73,0x73,0x74,0x74,0x74,0x76,0x77,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x83,0x84,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x89,0x8a,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x90,0x90,0x90,0x91,0x92,0x94,0x95,0x96,0x97,0x97,0x98,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xae,0xaf,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc5,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xce,0xcf,0xd0,0xd3,0xd5,0xd7,0xda,0xdc,0xde,0xdf,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe7,0xe8,0xea,0xed,0xef,0xf0,0xf1,0xf2,0xf4,0xf5,0xf8,0xf9,0xfa,0xfc,0xfd,0xff,0x0,0x1,0x3,0x4,0x6,0x8,0x9,0xb,0xd,0xe,0xf,0x10,0x12,0x14,0x16,0x18,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x21,0x22,0x23,0x25,0x28,0x2a,0x2c,0x2f,0x31,0x32,0x33,0x34,0x35,0x37,0x38,0x39,0x3a,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x41,0x42,0x42,0x43,0x44,0x45,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4e,0x4f,0x50,0x51,0x51,0x52,0x52,0x53,0x54,0x55,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5e,0x5f,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x73,0x73,0x74,0x74,0x74,0x75,0x76,0x77,0x78,0x78,0x79,0x7a,0x7a,0x7a,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x83,0x84,0x84,0x86,0x86,0x86,0x87,0x88,0x88,0x89,0x8a,0x8b,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa1,0xa2,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xab,0xac,0xad,0xae,0xae,0xaf,0xaf,0xb0,0xb1,0xb2,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xbb,0xbc,0xbd,0xbe,0xbe,0xbf,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc6,0xc7,0xc8,0xc9,0xcb,0xcc,0xcd,0xce,0xcf,0xd1,0xd4,0xd6,0xd8,0xdb,0xdd,0xde,0xdf,0xe1,0xe2,0xe3,0xe4,0xe5,0xe6,0xe8,0xea,0xec,0xee,0xf0,0xf1,0xf2,0xf3,0xf5,0xf7,0xf8,0xfa,0xfc,0xfd,0xff,0x0,0x2,0x3,0x4,0x6,0x8,0x9,0xc,0xd,0xe,0x10,0x11,0x12,0x14,0x17,0x19,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x23,0x24,0x26,0x29,0x2c,0x2e,0x30,0x32,0x33,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x3f,0x40,0x41,0x42,0x42,0x43,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x52,0x53,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x75,0x76,0x78,0x78,0x78,0x79,0x7a,0x7a,0x7a,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x80,0x81,0x82,0x82,0x83,0x83,0x84,0x84,0x86,0x86,0x86,0x87,0x88,0x88,0x88,0x8a,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xad,0xae,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbe,0xbf,0xc0,0xc1,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xcd,0xce,0xd0,0xd2,0xd4,0xd7,0xda,0xdc,0xdd,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe7,0xe9,0xec,0xee,0xef,0xf0,0xf2,0xf3,0xf4,0xf7,0xf8,0xfa,0xfc,0xfd,0xfe,0x0,0x2,0x3,0x5,0x7,0x8,0x9,0xc,0xd,0xf,0x10,0x11,0x12,0x15,0x18,0x19,0x1a,0x1c,0x1d,0x1e,0x20,0x21,0x22,0x24,0x25,0x28,0x2a,0x2d,0x30,0x31,0x32,0x34,0x35,0x36,0x38,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x42,0x43,0x44,0x44,0x45,0x46,0x48,0x49,0x4a,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x52,0x52,0x53,0x54,0x54,0x55,0x56,0x58,0x59,0x5a,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x62,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6a,0x6c,0x6d,0x6e,0x70,0x70,0x71,0x71,0x71,0x72,0x73,0x73,0x74,0x74,0x75,0x75,0x75,0x77,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x83,0x83,0x84,0x84,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x89,0x8b,0x8b,0x8b,0x8c,0x8c,0x8d,0x8d,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x92,0x93,0x94,0x96,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9e,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xac,0xad,0xae,0xae,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc8,0xca,0xcb,0xcc,0xce,0xcf,0xd0,0xd3,0xd6,0xd8,0xdb,0xdc,0xde,0xdf,0xe0,0xe2,0xe3,0xe4,0xe6,0xe7,0xe8,0xeb,0xee,0xef,0xf0,0xf1,0xf3,0xf4,0xf7,0xf8,0xf9,0xfb,0xfd,0xfe,0x0,0x2,0x3,0x5,0x7,0x8,0xa,0xc,0xe,0xf,0x10,0x12,0x13,0x16,0x18,0x1a,0x1b,0x1c,0x1e,0x1f,0x20,0x22,0x23,0x25,0x26,0x29,0x2c,0x2e,0x30,0x32,0x33,0x35,0x36,0x37,0x39,0x3a,0x3c,0x3d,0x3e,0x40,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x45,0x46,0x47,0x49,0x4a,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x52,0x53,0x53,0x54,0x55,0x55,0x56,0x57,0x59,0x5a,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x75,0x76,0x77,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x83,0x83,0x84,0x84,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x89,0x8a,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa6,0xa7,0xa9,0xaa,0xab,0xab,0xac,0xad,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb6,0xb7,0xb9,0xba,0xbb,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc3,0xc4,0xc6,0xc7,0xc9,0xca,0xcb,0xcd,0xce,0xd0,0xd2,0xd4,0xd7,0xda,0xdb,0xdd,0xde,0xe0,0xe1,0xe2,0xe4,0xe5,0xe6,0xe8,0xea,0xed,0xee,0xf0,0xf1,0xf2,0xf4,0xf6,0xf8,0xf9,0xfb,0xfd,0xfe,0x0,0x2,0x3,0x5,0x7,0x8,0xa,0xc,0xe,0xf,0x11,0x12,0x14,0x16,0x19,0x1a,0x1c,0x1d,0x1e,0x20,0x21,0x23,0x24,0x26,0x27,0x2a,0x2d,0x30,0x31,0x33,0x34,0x36,0x37,0x38,0x3a,0x3b,0x3d,0x3e,0x40,0x41,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x46,0x47,0x48,0x4a,0x4b,0x4d,0x4e,0x50,0x51,0x51,0x52,0x53,0x53,0x54,0x55,0x56,0x56,0x57,0x58,0x5a,0x5b,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6d,0x6e,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x75,0x75,0x75,0x76,0x77,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x83,0x83,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x89,0x8a,0x8b,0x8b,0x8b,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x92,0x93,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa5,0xa6,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xad,0xad,0xae,0xaf,0xaf,0xb0,0xb2,0xb3,0xb5,0xb6,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xbf,0xc0,0xc2,0xc3,0xc5,0xc6,0xc8,0xc9,0xca,0xcc,0xcd,0xcf,0xd0,0xd3,0xd6,0xd9,0xda,0xdc,0xdd,0xdf,0xe0,0xe2,0xe3,0xe4,0xe6,0xe7,0xea,0xec,0xee,0xef,0xf1,0xf2,0xf4,0xf6,0xf8,0xf9,0xfb,0xfd,0xfe,0x0,0x2,0x4,0x5,0x7,0x8,0xa,0xc,0xe,0x10,0x11,0x12,0x14,0x17,0x19,0x1a,0x1c,0x1e,0x1f,0x20,0x22,0x24,0x25,0x26,0x28,0x2b,0x2e,0x30,0x32,0x34,0x35,0x36,0x38,0x3a,0x3b,0x3c,0x3e,0x40,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x46,0x47,0x48,0x4a,0x4b,0x4c,0x4e,0x50,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x56,0x57,0x58,0x5a,0x5b,0x5c,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x75,0x76,0x76,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa5,0xa6,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb4,0xb5,0xb6,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc0,0xc2,0xc4,0xc5,0xc6,0xc8,0xca,0xcb,0xcc,0xce,0xd0,0xd2,0xd5,0xd8,0xda,0xdb,0xdc,0xde,0xe0,0xe1,0xe2,0xe4,0xe6,0xe7,0xe9,0xec,0xee,0xef,0xf0,0xf2,0xf4,0xf6,0xf8,0xf9,0xfb,0xfc,0xfe,0x0,0x2,0x4,0x5,0x7,0x9,0xa,0xd,0xe,0x10,0x11,0x13,0x14,0x18,0x19,0x1b,0x1c,0x1e,0x20,0x21,0x23,0x24,0x26,0x27,0x29,0x2c,0x2f,0x31,0x33,0x34,0x36,0x37,0x39,0x3b,0x3c,0x3e,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x47,0x48,0x49,0x4b,0x4c,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x57,0x57,0x58,0x59,0x5b,0x5c,0x5e,0x5f,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x64,0x65,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6b,0x6c,0x6c,0x6c,0x6e,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x75,0x75,0x75,0x76,0x76,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x8a,0x8a,0x8b,0x8b,0x8b,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x92,0x94,0x94,0x94,0x95,0x95,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9b,0x9c,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa1,0xa2,0xa4,0xa5,0xa7,0xa8,0xa9,0xa9,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb4,0xb5,0xb7,0xb8,0xb9,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc2,0xc4,0xc5,0xc7,0xc9,0xca,0xcc,0xcd,0xcf,0xd1,0xd4,0xd7,0xd9,0xda,0xdc,0xdd,0xdf,0xe0,0xe2,0xe4,0xe5,0xe7,0xe8,0xec,0xed,0xef,0xf0,0xf2,0xf3,0xf6,0xf7,0xf9,0xfb,0xfc,0xfe,0x0,0x2,0x4,0x5,0x7,0x9,0xa,0xd,0xe,0x10,0x12,0x13,0x15,0x18,0x1a,0x1b,0x1d,0x1f,0x20,0x22,0x24,0x25,0x27,0x28,0x2a,0x2d,0x30,0x32,0x34,0x35,0x37,0x38,0x3a,0x3c,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4c,0x4d,0x4f,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5c,0x5d,0x5f,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x67,0x67,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x72,0x73,0x74,0x74,0x75,0x75,0x76,0x76,0x76,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x8a,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8d,0x8e,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x93,0x93,0x94,0x94,0x95,0x95,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x99,0x99,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa1,0xa3,0xa4,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb3,0xb4,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc4,0xc6,0xc8,0xc9,0xcb,0xcc,0xce,0xd0,0xd3,0xd6,0xd8,0xd9,0xdb,0xdc,0xde,0xe0,0xe1,0xe3,0xe5,0xe6,0xe8,0xeb,0xed,0xee,0xf0,0xf2,0xf3,0xf6,0xf7,0xf9,0xfb,0xfc,0xfe,0x0,0x2,0x4,0x5,0x7,0x9,0xb,0xd,0xf,0x10,0x12,0x14,0x16,0x18,0x1a,0x1c,0x1e,0x1f,0x21,0x23,0x24,0x26,0x28,0x29,0x2b,0x2e,0x31,0x33,0x34,0x36,0x38,0x39,0x3b,0x3d,0x3e,0x40,0x41,0x42,0x43,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4d,0x4e,0x50,0x51,0x52,0x53,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5d,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6f,0x70,0x71,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x75,0x75,0x75,0x76,0x76,0x77,0x78,0x79,0x79,0x79,0x7a,0x7b,0x7b,0x7b,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x85,0x85,0x85,0x86,0x87,0x87,0x87,0x88,0x89,0x8a,0x8a,0x8b,0x8b,0x8b,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x91,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x95,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xad,0xae,0xaf,0xb0,0xb2,0xb3,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbd,0xbe,0xbf,0xc0,0xc2,0xc3,0xc5,0xc7,0xc8,0xca,0xcc,0xcd,0xcf,0xd2,0xd5,0xd7,0xd8,0xda,0xdc,0xdd,0xdf,0xe1,0xe2,0xe4,0xe6,0xe8,0xea,0xec,0xee,0xf0,0xf1,0xf3,0xf5,0xf7,0xf9,0xfb,0xfc,0xfe,0x0,0x2,0x4,0x6,0x8,0x9,0xb,0xd,0xf,0x11,0x12,0x14,0x16,0x19,0x1a,0x1c,0x1e,0x20,0x22,0x23,0x25,0x27,0x28,0x2a,0x2c,0x30,0x32,0x33,0x35,0x37,0x38,0x3a,0x3c,0x3e,0x40,0x41,0x42,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x4e,0x50,0x51,0x52,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5c,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x77,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xae,0xaf,0xb0,0xb2,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbe,0xbf,0xc0,0xc2,0xc4,0xc6,0xc8,0xc9,0xcb,0xcd,0xce,0xd0,0xd4,0xd6,0xd8,0xd9,0xdb,0xdd,0xde,0xe0,0xe2,0xe4,0xe6,0xe7,0xea,0xec,0xee,0xef,0xf1,0xf3,0xf5,0xf7,0xf8,0xfa,0xfc,0xfe,0x0,0x2,0x4,0x6,0x8,0x9,0xb,0xd,0xf,0x11,0x13,0x15,0x16,0x19,0x1b,0x1d,0x1e,0x20,0x22,0x24,0x26,0x28,0x29,0x2b,0x2d,0x30,0x32,0x34,0x36,0x38,0x39,0x3b,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5f,0x60,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x66,0x67,0x68,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6e,0x70,0x71,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x75,0x75,0x75,0x76,0x76,0x77,0x77,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8b,0x8b,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x92,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x98,0x99,0x9a,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0xa0,0xa1,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc5,0xc7,0xc8,0xca,0xcc,0xce,0xd0,0xd3,0xd5,0xd7,0xd8,0xda,0xdc,0xde,0xe0,0xe2,0xe3,0xe5,0xe7,0xea,0xeb,0xed,0xef,0xf1,0xf3,0xf5,0xf7,0xf8,0xfa,0xfc,0xfe,0x0,0x2,0x4,0x6,0x8,0xa,0xc,0xe,0x10,0x11,0x13,0x15,0x17,0x19,0x1b,0x1d,0x1f,0x21,0x23,0x25,0x26,0x28,0x2a,0x2c,0x2e,0x31,0x33,0x35,0x36,0x38,0x3a,0x3c,0x3e,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6f,0x6f,0x70,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x77,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x91,0x91,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc2,0xc4,0xc6,0xc8,0xca,0xcb,0xcd,0xcf,0xd2,0xd4,0xd6,0xd8,0xda,0xdb,0xdd,0xdf,0xe1,0xe3,0xe5,0xe7,0xe9,0xeb,0xed,0xef,0xf0,0xf2,0xf4,0xf6,0xf8,0xfa,0xfc,0xfe,0x0,0x2,0x4,0x6,0x8,0xa,0xc,0xe,0x10,0x12,0x14,0x16,0x18,0x1a,0x1c,0x1e,0x20,0x21,0x23,0x25,0x27,0x29,0x2b,0x2d,0x2f,0x31,0x33,0x35,0x37,0x39,0x3b,0x3d,0x3f,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6f,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x73,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x77,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x91,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc1,0xc3,0xc5,0xc7,0xc9,0xcb,0xcd,0xcf,0xd1,0xd3,0xd5,0xd7,0xd9,0xdb,0xdd,0xdf,0xe0,0xe2,0xe4,0xe6,0xe8,0xea,0xec,0xee,0xf0,0xf2,0xf4,0xf6,0xf8,0xfa,0xfc,0xfe,0x0,0x2,0x4,0x6,0x8,0xa,0xc,0xe,0x10,0x12,0x14,0x16,0x18,0x1a,0x1c,0x1e,0x20,0x22,0x24,0x26,0x28,0x2a,0x2c,0x2e,0x30,0x32,0x34,0x36,0x38,0x3a,0x3c,0x3e,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x47,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x61,0x62,0x62,0x62,0x63,0x64,0x64,0x64,0x65,0x66,0x66,0x66,0x67,0x68,0x68,0x68,0x69,0x6a,0x6a,0x6a,0x6b,0x6c,0x6c,0x6c,0x6d,0x6e,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x71,0x72,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x76,0x76,0x76,0x77,0x78,0x78,0x78,0x79,0x7a,0x7a,0x7a,0x7b,0x7c,0x7c,0x7c,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x82,0x83,0x84,0x84,0x84,0x85,0x86,0x86,0x86,0x87,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8a,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8e,0x8f,0x90,0x90,0x90,0x91,0x92,0x92,0x92,0x93,0x94,0x94,0x94,0x95,0x96,0x96,0x96,0x97,0x98,0x98,0x98,0x99,0x9a,0x9a,0x9a,0x9b,0x9c,0x9c,0x9c,0x9d,0x9e,0x9e,0x9e,0x9f,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xb9,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc2,0xc4,0xc6,0xc8,0xca,0xcc,0xce,0xd0,0xd2,0xd4,0xd6,0xd8,0xda,0xdc,0xde,0xe0,0xe2,0xe4,0xe6,0xe8,0xea,0xec,0xee,0xf0,0xf2,0xf4,0xf6,0xf8,0xfa,0xfc,0xfe,0x0,0x2,0x4,0x6,0x8,0xb,0xc,0xf,0x11,0x13,0x16,0x18,0x19,0x1b,0x1e,0x20,0x22,0x24,0x26,0x29,0x2b,0x2d,0x30,0x31,0x32,0x34,0x36,0x39,0x3b,0x3d,0x40,0x41,0x42,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4b,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x51,0x52,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x6f,0x70,0x70,0x70,0x70,0x70,0x71,0x72,0x72,0x73,0x73,0x74,0x74,0x74,0x75,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x82,0x83,0x83,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8f,0x90,0x90,0x90,0x90,0x90,0x91,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x98,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xae,0xaf,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb5,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbe,0xbf,0xc0,0xc3,0xc5,0xc7,0xca,0xcc,0xce,0xcf,0xd0,0xd3,0xd5,0xd7,0xda,0xdc,0xde,0xe0,0xe2,0xe5,0xe7,0xe8,0xea,0xed,0xef,0xf1,0xf4,0xf5,0xf8,0xfa,0xfc,0xfe,0x0,0x2,0x4,0x6,0x9,0xc,0xd,0x10,0x12,0x14,0x17,0x19,0x1a,0x1c,0x1f,0x22,0x24,0x26,0x29,0x2c,0x2e,0x30,0x32,0x33,0x34,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x44,0x45,0x46,0x48,0x49,0x4a,0x4c,0x4d,0x4e,0x4f,0x50,0x51,0x52,0x52,0x53,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x78,0x79,0x7a,0x7a,0x7a,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x80,0x81,0x81,0x82,0x82,0x82,0x83,0x83,0x84,0x84,0x84,0x85,0x86,0x86,0x86,0x87,0x88,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xad,0xae,0xae,0xaf,0xb0,0xb1,0xb2,0xb3,0xb4,0xb6,0xb7,0xb8,0xba,0xbb,0xbc,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcc,0xcd,0xce,0xd0,0xd2,0xd4,0xd7,0xda,0xdc,0xde,0xe1,0xe4,0xe6,0xe7,0xe9,0xec,0xee,0xf0,0xf3,0xf4,0xf7,0xfa,0xfc,0xfe,0x0,0x2,0x5,0x7,0xa,0xc,0xe,0x10,0x13,0x16,0x18,0x1a,0x1b,0x1e,0x20,0x23,0x26,0x29,0x2c,0x2e,0x30,0x32,0x33,0x35,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x45,0x46,0x47,0x49,0x4a,0x4c,0x4d,0x4e,0x50,0x50,0x51,0x52,0x53,0x53,0x54,0x55,0x55,0x56,0x57,0x59,0x5a,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa6,0xa7,0xa9,0xaa,0xab,0xab,0xac,0xad,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb3,0xb4,0xb6,0xb7,0xb9,0xba,0xbb,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcb,0xcd,0xce,0xd0,0xd2,0xd4,0xd7,0xda,0xdd,0xe0,0xe2,0xe5,0xe6,0xe8,0xea,0xed,0xf0,0xf2,0xf4,0xf6,0xf9,0xfb,0xfe,0x0,0x2,0x5,0x7,0xa,0xc,0xe,0x11,0x14,0x17,0x19,0x1a,0x1c,0x1f,0x22,0x25,0x28,0x2b,0x2e,0x30,0x32,0x34,0x35,0x36,0x38,0x3b,0x3e,0x40,0x42,0x44,0x45,0x46,0x48,0x4a,0x4b,0x4c,0x4e,0x50,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x56,0x57,0x58,0x5a,0x5b,0x5c,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x82,0x83,0x84,0x84,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa5,0xa6,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb0,0xb2,0xb4,0xb5,0xb6,0xb8,0xba,0xbb,0xbc,0xbe,0xc0,0xc2,0xc5,0xc8,0xca,0xcb,0xcc,0xce,0xd0,0xd2,0xd5,0xd8,0xdb,0xde,0xe1,0xe4,0xe6,0xe7,0xe9,0xec,0xef,0xf2,0xf4,0xf6,0xf9,0xfb,0xfe,0x0,0x3,0x5,0x7,0xa,0xd,0xe,0x12,0x15,0x18,0x1a,0x1b,0x1d,0x20,0x24,0x27,0x2a,0x2d,0x30,0x32,0x34,0x35,0x37,0x38,0x3a,0x3d,0x40,0x42,0x44,0x45,0x47,0x48,0x4a,0x4c,0x4d,0x4f,0x50,0x51,0x52,0x53,0x54,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5c,0x5d,0x5f,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x67,0x67,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6f,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x72,0x73,0x74,0x74,0x75,0x75,0x76,0x76,0x76,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x82,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8d,0x8e,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x91,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x99,0x99,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa1,0xa3,0xa4,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb3,0xb4,0xb6,0xb8,0xb9,0xbb,0xbc,0xbe,0xc0,0xc3,0xc6,0xc8,0xc9,0xcb,0xcc,0xce,0xd0,0xd3,0xd6,0xd9,0xdc,0xe0,0xe3,0xe5,0xe6,0xe8,0xeb,0xee,0xf2,0xf3,0xf6,0xf9,0xfb,0xfd,0x0,0x3,0x6,0x8,0xb,0xd,0xf,0x12,0x16,0x19,0x1a,0x1c,0x1e,0x22,0x25,0x28,0x2c,0x30,0x32,0x33,0x35,0x37,0x38,0x3a,0x3c,0x40,0x42,0x43,0x45,0x47,0x48,0x4a,0x4c,0x4e,0x50,0x51,0x52,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x58,0x59,0x5a,0x5b,0x5c,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x82,0x83,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa5,0xa6,0xa7,0xa8,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xae,0xaf,0xb0,0xb2,0xb4,0xb6,0xb8,0xb9,0xbb,0xbd,0xbe,0xc0,0xc4,0xc6,0xc8,0xc9,0xcb,0xcd,0xce,0xd0,0xd4,0xd8,0xdb,0xde,0xe2,0xe4,0xe6,0xe7,0xea,0xee,0xf1,0xf3,0xf5,0xf8,0xfa,0xfd,0x0,0x3,0x6,0x8,0xc,0xe,0x10,0x13,0x17,0x19,0x1b,0x1d,0x1f,0x23,0x26,0x2a,0x2e,0x31,0x33,0x35,0x36,0x38,0x3a,0x3c,0x3e,0x41,0x43,0x45,0x46,0x48,0x4a,0x4c,0x4e,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6f,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x73,0x74,0x74,0x75,0x75,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x82,0x83,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8d,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x91,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb2,0xb4,0xb6,0xb8,0xba,0xbb,0xbd,0xbf,0xc2,0xc4,0xc6,0xc8,0xca,0xcb,0xcd,0xcf,0xd2,0xd6,0xda,0xdd,0xe1,0xe3,0xe5,0xe7,0xe9,0xed,0xf0,0xf2,0xf4,0xf8,0xfa,0xfd,0x0,0x3,0x6,0x8,0xc,0xe,0x10,0x14,0x18,0x1a,0x1c,0x1e,0x20,0x24,0x28,0x2c,0x30,0x32,0x34,0x36,0x38,0x3a,0x3c,0x3e,0x40,0x42,0x44,0x46,0x48,0x4a,0x4c,0x4e,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x57,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x61,0x62,0x62,0x62,0x63,0x64,0x64,0x64,0x65,0x66,0x66,0x66,0x67,0x68,0x68,0x68,0x69,0x6a,0x6a,0x6a,0x6b,0x6c,0x6c,0x6c,0x6d,0x6e,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x75,0x76,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x83,0x83,0x84,0x84,0x84,0x84,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8a,0x8b,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x92,0x93,0x94,0x94,0x94,0x95,0x96,0x96,0x96,0x97,0x98,0x98,0x98,0x99,0x9a,0x9a,0x9a,0x9b,0x9c,0x9c,0x9c,0x9d,0x9e,0x9e,0x9e,0x9f,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb2,0xb4,0xb6,0xb8,0xba,0xbc,0xbe,0xc0,0xc2,0xc4,0xc6,0xc8,0xca,0xcc,0xce,0xd0,0xd4,0xd8,0xdc,0xe0,0xe2,0xe4,0xe6,0xe8,0xec,0xf0,0xf2,0xf4,0xf8,0xfa,0xfd,0x0,0x3,0x6,0x8,0xc,0xf,0x11,0x16,0x19,0x1b,0x1e,0x20,0x22,0x26,0x2b,0x30,0x32,0x34,0x36,0x39,0x3b,0x3d,0x40,0x41,0x42,0x44,0x46,0x49,0x4b,0x4d,0x50,0x51,0x52,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5b,0x5c,0x5d,0x5e,0x60,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x6f,0x70,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x74,0x75,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x90,0x91,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x98,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa0,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb3,0xb5,0xb7,0xba,0xbc,0xbe,0xbf,0xc0,0xc3,0xc5,0xc7,0xca,0xcc,0xce,0xd0,0xd5,0xda,0xde,0xe0,0xe2,0xe5,0xe7,0xea,0xef,0xf1,0xf4,0xf8,0xfa,0xfd,0x0,0x3,0x6,0x9,0xd,0x10,0x12,0x17,0x1a,0x1c,0x1f,0x22,0x24,0x29,0x2e,0x32,0x34,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x44,0x46,0x49,0x4c,0x4e,0x50,0x52,0x53,0x54,0x55,0x56,0x58,0x59,0x5a,0x5c,0x5d,0x5e,0x5f,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x85,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa1,0xa2,0xa3,0xa4,0xa6,0xa7,0xa8,0xaa,0xab,0xac,0xad,0xae,0xb0,0xb2,0xb4,0xb7,0xba,0xbc,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcc,0xce,0xd2,0xd7,0xdc,0xde,0xe1,0xe4,0xe6,0xe9,0xee,0xf0,0xf3,0xf7,0xfa,0xfd,0x0,0x3,0x7,0xa,0xe,0x10,0x13,0x18,0x1b,0x1e,0x20,0x23,0x26,0x2c,0x30,0x33,0x36,0x39,0x3c,0x3e,0x40,0x42,0x43,0x45,0x46,0x49,0x4c,0x4e,0x50,0x52,0x53,0x55,0x56,0x57,0x59,0x5a,0x5c,0x5d,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa3,0xa4,0xa6,0xa7,0xa9,0xaa,0xab,0xad,0xae,0xb0,0xb2,0xb4,0xb7,0xba,0xbb,0xbd,0xbe,0xc0,0xc2,0xc4,0xc7,0xca,0xcd,0xd0,0xd4,0xda,0xdd,0xe0,0xe2,0xe5,0xe8,0xed,0xf0,0xf2,0xf6,0xf9,0xfd,0x0,0x4,0x7,0xa,0xe,0x11,0x14,0x19,0x1c,0x1f,0x22,0x25,0x28,0x2e,0x32,0x35,0x38,0x3b,0x3e,0x40,0x42,0x44,0x45,0x46,0x48,0x4b,0x4e,0x50,0x52,0x54,0x55,0x56,0x58,0x5a,0x5b,0x5c,0x5e,0x60,0x60,0x61,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa5,0xa6,0xa8,0xaa,0xab,0xac,0xae,0xb0,0xb2,0xb5,0xb8,0xba,0xbb,0xbc,0xbe,0xc0,0xc2,0xc5,0xc8,0xcb,0xce,0xd2,0xd8,0xdb,0xde,0xe1,0xe4,0xe7,0xec,0xef,0xf2,0xf6,0xf9,0xfc,0x0,0x4,0x7,0xa,0xe,0x12,0x15,0x1a,0x1d,0x20,0x24,0x27,0x2a,0x30,0x34,0x37,0x3a,0x3d,0x40,0x42,0x44,0x45,0x47,0x48,0x4a,0x4d,0x50,0x52,0x54,0x55,0x57,0x58,0x5a,0x5c,0x5d,0x5f,0x60,0x61,0x61,0x61,0x62,0x62,0x63,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x67,0x67,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6f,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x72,0x73,0x73,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x76,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x91,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x99,0x99,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0x9f,0xa0,0xa1,0xa3,0xa4,0xa6,0xa8,0xa9,0xab,0xac,0xae,0xb0,0xb3,0xb6,0xb8,0xb9,0xbb,0xbc,0xbe,0xc0,0xc3,0xc6,0xc9,0xcc,0xd0,0xd6,0xd9,0xdc,0xe0,0xe3,0xe6,0xeb,0xee,0xf2,0xf6,0xf9,0xfc,0x0,0x4,0x8,0xb,0xf,0x12,0x16,0x1a,0x1e,0x22,0x25,0x28,0x2c,0x32,0x35,0x38,0x3c,0x40,0x42,0x43,0x45,0x47,0x48,0x4a,0x4c,0x50,0x52,0x53,0x55,0x57,0x58,0x5a,0x5c,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x73,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa6,0xa8,0xa9,0xab,0xad,0xae,0xb0,0xb4,0xb6,0xb8,0xb9,0xbb,0xbd,0xbe,0xc0,0xc4,0xc8,0xcb,0xce,0xd4,0xd8,0xdb,0xde,0xe2,0xe6,0xea,0xee,0xf1,0xf5,0xf8,0xfc,0x0,0x4,0x8,0xc,0x10,0x13,0x17,0x1b,0x1f,0x23,0x26,0x2a,0x2e,0x33,0x36,0x3a,0x3e,0x41,0x43,0x45,0x46,0x48,0x4a,0x4c,0x4e,0x51,0x53,0x55,0x56,0x58,0x5a,0x5c,0x5e,0x60,0x60,0x61,0x61,0x62,0x62,0x63,0x63,0x64,0x64,0x65,0x65,0x66,0x66,0x67,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6f,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x91,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x99,0x9a,0x9a,0x9b,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9e,0x9f,0x9f,0xa0,0xa0,0xa2,0xa4,0xa6,0xa8,0xaa,0xab,0xad,0xaf,0xb2,0xb4,0xb6,0xb8,0xba,0xbb,0xbd,0xbf,0xc2,0xc6,0xca,0xcd,0xd2,0xd6,0xda,0xdd,0xe1,0xe5,0xe9,0xed,0xf0,0xf4,0xf8,0xfc,0x0,0x4,0x8,0xc,0x10,0x14,0x18,0x1c,0x20,0x24,0x28,0x2c,0x30,0x34,0x38,0x3c,0x40,0x42,0x44,0x46,0x48,0x4a,0x4c,0x4e,0x50,0x52,0x54,0x56,0x58,0x5a,0x5c,0x5e,0x60,0x60,0x61,0x62,0x62,0x62,0x63,0x64,0x64,0x64,0x65,0x66,0x66,0x66,0x67,0x68,0x68,0x68,0x69,0x6a,0x6a,0x6a,0x6b,0x6c,0x6c,0x6c,0x6d,0x6e,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x92,0x93,0x94,0x94,0x94,0x95,0x96,0x96,0x96,0x97,0x98,0x98,0x98,0x99,0x9a,0x9a,0x9a,0x9b,0x9c,0x9c,0x9c,0x9d,0x9e,0x9e,0x9e,0x9f,0xa0,0xa0,0xa2,0xa4,0xa6,0xa8,0xaa,0xac,0xae,0xb0,0xb2,0xb4,0xb6,0xb8,0xba,0xbc,0xbe,0xc0,0xc4,0xc8,0xcc,0xd0,0xd4,0xd8,0xdc,0xe0,0xe4,0xe8,0xec,0xf0,0xf4,0xf8,0xfc,0x0,0x4,0x9,0xd,0x12,0x17,0x1a,0x1f,0x24,0x29,0x2e,0x32,0x34,0x39,0x3e,0x42,0x44,0x46,0x49,0x4c,0x4e,0x50,0x52,0x53,0x54,0x56,0x59,0x5c,0x5e,0x60,0x61,0x61,0x62,0x63,0x63,0x64,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9c,0x9d,0x9d,0x9e,0x9f,0x9f,0xa0,0xa2,0xa4,0xa7,0xaa,0xac,0xad,0xae,0xb0,0xb2,0xb4,0xb7,0xba,0xbc,0xbe,0xc2,0xc7,0xcc,0xce,0xd2,0xd7,0xdc,0xe1,0xe6,0xe9,0xee,0xf3,0xf7,0xfc,0x0,0x5,0xa,0xe,0x14,0x19,0x1c,0x22,0x28,0x2e,0x32,0x35,0x38,0x3e,0x42,0x45,0x48,0x4b,0x4e,0x50,0x52,0x54,0x55,0x56,0x58,0x5b,0x5e,0x60,0x61,0x62,0x62,0x63,0x64,0x65,0x66,0x66,0x67,0x68,0x68,0x69,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x97,0x98,0x98,0x99,0x9a,0x9a,0x9b,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa2,0xa5,0xa8,0xaa,0xab,0xac,0xae,0xb0,0xb2,0xb5,0xb8,0xbb,0xbe,0xc2,0xc8,0xcb,0xce,0xd2,0xd8,0xde,0xe4,0xe7,0xec,0xf2,0xf6,0xfb,0x0,0x6,0xb,0xf,0x16,0x1a,0x1e,0x25,0x2c,0x32,0x35,0x38,0x3c,0x42,0x45,0x48,0x4c,0x50,0x52,0x53,0x55,0x57,0x58,0x5a,0x5c,0x60,0x61,0x62,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x68,0x69,0x69,0x6a,0x6a,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x73,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x93,0x94,0x94,0x95,0x95,0x96,0x96,0x96,0x97,0x97,0x98,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa4,0xa6,0xa8,0xa9,0xab,0xad,0xae,0xb0,0xb4,0xb8,0xbb,0xbe,0xc4,0xc8,0xcb,0xce,0xd4,0xdb,0xe2,0xe6,0xea,0xf1,0xf5,0xfa,0x0,0x6,0xc,0x10,0x18,0x1c,0x20,0x28,0x30,0x34,0x38,0x3c,0x40,0x44,0x48,0x4c,0x50,0x52,0x54,0x56,0x58,0x5a,0x5c,0x5e,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x68,0x69,0x6a,0x6a,0x6a,0x6b,0x6c,0x6c,0x6c,0x6d,0x6e,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x92,0x93,0x94,0x94,0x94,0x95,0x96,0x96,0x96,0x97,0x98,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa2,0xa4,0xa6,0xa8,0xaa,0xac,0xae,0xb0,0xb4,0xb8,0xbc,0xc0,0xc4,0xc8,0xcc,0xd0,0xd8,0xe0,0xe4,0xe8,0xf0,0xf4,0xfa,0x0,0x6,0xd,0x12,0x1a,0x1f,0x24,0x2e,0x34,0x39,0x3e,0x42,0x44,0x49,0x4e,0x52,0x54,0x56,0x59,0x5c,0x5e,0x60,0x61,0x61,0x62,0x63,0x64,0x66,0x67,0x68,0x69,0x69,0x6a,0x6b,0x6b,0x6c,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x94,0x95,0x95,0x96,0x97,0x97,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9f,0x9f,0xa0,0xa2,0xa4,0xa7,0xaa,0xac,0xae,0xb2,0xb7,0xbc,0xbe,0xc2,0xc7,0xcc,0xd2,0xdc,0xe1,0xe6,0xee,0xf3,0xfa,0x0,0x7,0xe,0x14,0x1c,0x22,0x28,0x32,0x38,0x3e,0x42,0x45,0x48,0x4e,0x52,0x55,0x58,0x5b,0x5e,0x60,0x61,0x62,0x62,0x63,0x64,0x66,0x67,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6d,0x6e,0x6e,0x6f,0x70,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x90,0x91,0x92,0x92,0x93,0x94,0x95,0x96,0x96,0x97,0x98,0x99,0x9a,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa2,0xa5,0xa8,0xab,0xae,0xb2,0xb8,0xbb,0xbe,0xc2,0xc8,0xce,0xd8,0xde,0xe4,0xec,0xf2,0xf9,0x0,0x8,0xf,0x16,0x1e,0x25,0x2c,0x35,0x3c,0x42,0x45,0x48,0x4c,0x52,0x55,0x58,0x5c,0x60,0x61,0x62,0x62,0x63,0x64,0x65,0x66,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x73,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x96,0x97,0x98,0x9a,0x9b,0x9c,0x9d,0x9e,0x9e,0x9f,0xa0,0xa4,0xa8,0xab,0xae,0xb4,0xb8,0xbb,0xbe,0xc4,0xcb,0xd4,0xdb,0xe2,0xea,0xf1,0xf8,0x0,0x8,0x10,0x18,0x20,0x28,0x30,0x38,0x40,0x44,0x48,0x4c,0x50,0x54,0x58,0x5c,0x60,0x61,0x62,0x63,0x64,0x65,0x66,0x67,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x70,0x71,0x71,0x71,0x72,0x72,0x72,0x72,0x72,0x73,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8d,0x8e,0x8e,0x8e,0x8e,0x8e,0x8f,0x8f,0x8f,0x90,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa4,0xa8,0xac,0xb0,0xb4,0xb8,0xbc,0xc0,0xc8,0xd0,0xd8,0xe0,0xe8,0xf0,0xf8,0x0,0xa,0x14,0x1c,0x28,0x32,0x38,0x42,0x48,0x4e,0x52,0x55,0x58,0x5e,0x61,0x62,0x64,0x66,0x67,0x68,0x69,0x6a,0x6a,0x6b,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x72,0x73,0x73,0x74,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8c,0x8d,0x8d,0x8e,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x95,0x96,0x96,0x97,0x98,0x99,0x9a,0x9c,0x9e,0x9f,0xa2,0xa8,0xab,0xae,0xb2,0xb8,0xbe,0xc8,0xce,0xd8,0xe4,0xec,0xf6,0x0,0xc,0x18,0x20,0x30,0x38,0x40,0x48,0x50,0x54,0x58,0x5c,0x60,0x62,0x64,0x66,0x68,0x69,0x6a,0x6b,0x6c,0x6d,0x6e,0x6f,0x70,0x70,0x71,0x72,0x72,0x72,0x73,0x74,0x74,0x74,0x74,0x75,0x75,0x75,0x76,0x76,0x76,0x76,0x76,0x77,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x89,0x8a,0x8a,0x8a,0x8a,0x8a,0x8b,0x8b,0x8b,0x8c,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8e,0x8f,0x90,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x9a,0x9c,0x9e,0xa0,0xa4,0xa8,0xac,0xb0,0xb8,0xc0,0xc8,0xd0,0xe0,0xe8,0xf4,0x0,0xe,0x1c,0x28,0x38,0x42,0x48,0x52,0x58,0x5e,0x61,0x62,0x64,0x67,0x69,0x6a,0x6c,0x6e,0x6f,0x70,0x70,0x71,0x71,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x75,0x76,0x76,0x76,0x77,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x89,0x8a,0x8a,0x8a,0x8b,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8f,0x8f,0x90,0x90,0x91,0x92,0x94,0x96,0x97,0x99,0x9c,0x9e,0x9f,0xa2,0xa8,0xae,0xb8,0xbe,0xc8,0xd8,0xe4,0xf2,0x0,0x10,0x20,0x30,0x40,0x48,0x50,0x58,0x60,0x62,0x64,0x66,0x68,0x6a,0x6c,0x6e,0x70,0x70,0x71,0x72,0x72,0x72,0x73,0x74,0x74,0x74,0x75,0x76,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8a,0x8b,0x8c,0x8c,0x8c,0x8d,0x8e,0x8e,0x8e,0x8f,0x90,0x90,0x92,0x94,0x96,0x98,0x9a,0x9c,0x9e,0xa0,0xa8,0xb0,0xb8,0xc0,0xd0,0xe0,0xf0,0x0,0x18,0x30,0x40,0x50,0x58,0x60,0x64,0x68,0x6a,0x6c,0x6e,0x70,0x71,0x72,0x73,0x74,0x74,0x75,0x76,0x76,0x76,0x77,0x78,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x88,0x89,0x8a,0x8a,0x8a,0x8b,0x8c,0x8c,0x8d,0x8e,0x8f,0x90,0x92,0x94,0x96,0x98,0x9c,0xa0,0xa8,0xb0,0xc0,0xd0,0xe8,0x0,0x20,0x40,0x50,0x60,0x64,0x68,0x6c,0x70,0x71,0x72,0x73,0x74,0x75,0x76,0x77,0x78,0x78,0x78,0x79,0x79,0x79,0x7a,0x7a,0x7a,0x7a,0x7a,0x7b,0x7b,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x85,0x85,0x86,0x86,0x86,0x86,0x86,0x87,0x87,0x87,0x88,0x88,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x94,0x98,0x9c,0xa0,0xb0,0xc0,0xe0,0x0,0x40,0x60,0x68,0x70,0x72,0x74,0x76,0x78,0x78,0x79,0x7a,0x7a,0x7a,0x7b,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x80,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x84,0x84,0x84,0x84,0x84,0x84,0x85,0x86,0x86,0x86,0x87,0x88,0x88,0x8a,0x8c,0x8e,0x90,0x98,0xa0,0xc0,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x0,0xc0,0xa0,0x98,0x90,0x8e,0x8c,0x8a,0x88,0x88,0x87,0x86,0x86,0x86,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7a,0x7a,0x7a,0x79,0x78,0x78,0x76,0x74,0x72,0x70,0x68,0x60,0x40,0x0,0xe0,0xc0,0xb0,0xa0,0x9c,0x98,0x94,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x6c,0x68,0x64,0x60,0x50,0x40,0x20,0x0,0xe8,0xd0,0xc0,0xb0,0xa8,0xa0,0x9c,0x98,0x96,0x94,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8c,0x8b,0x8a,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x76,0x75,0x74,0x74,0x73,0x72,0x71,0x70,0x6e,0x6c,0x6a,0x68,0x64,0x60,0x58,0x50,0x40,0x30,0x18,0x0,0xf0,0xe0,0xd0,0xc0,0xb8,0xb0,0xa8,0xa0,0x9e,0x9c,0x9a,0x98,0x96,0x94,0x92,0x90,0x90,0x8f,0x8e,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8a,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x76,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x72,0x71,0x70,0x70,0x6e,0x6c,0x6a,0x68,0x66,0x64,0x62,0x60,0x58,0x50,0x48,0x40,0x30,0x20,0x10,0x0,0xf2,0xe4,0xd8,0xc8,0xbe,0xb8,0xae,0xa8,0xa2,0x9f,0x9e,0x9c,0x99,0x97,0x96,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x76,0x75,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6a,0x69,0x67,0x64,0x62,0x61,0x5e,0x58,0x52,0x48,0x42,0x38,0x28,0x1c,0xe,0x0,0xf4,0xe8,0xe0,0xd0,0xc8,0xc0,0xb8,0xb0,0xac,0xa8,0xa4,0xa0,0x9e,0x9c,0x9a,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x8f,0x8e,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x73,0x72,0x72,0x72,0x71,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x66,0x64,0x62,0x60,0x5c,0x58,0x54,0x50,0x48,0x40,0x38,0x30,0x20,0x18,0xc,0x0,0xf6,0xec,0xe4,0xd8,0xce,0xc8,0xbe,0xb8,0xb2,0xae,0xab,0xa8,0xa2,0x9f,0x9e,0x9c,0x9a,0x99,0x98,0x97,0x96,0x96,0x95,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x72,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x67,0x66,0x64,0x62,0x61,0x5e,0x58,0x55,0x52,0x4e,0x48,0x42,0x38,0x32,0x28,0x1c,0x14,0xa,0x0,0xf8,0xf0,0xe8,0xe0,0xd8,0xd0,0xc8,0xc0,0xbc,0xb8,0xb4,0xb0,0xac,0xa8,0xa4,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5c,0x58,0x54,0x50,0x4c,0x48,0x44,0x40,0x38,0x30,0x28,0x20,0x18,0x10,0x8,0x0,0xf8,0xf1,0xea,0xe2,0xdb,0xd4,0xcb,0xc4,0xbe,0xbb,0xb8,0xb4,0xae,0xab,0xa8,0xa4,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9b,0x9a,0x98,0x97,0x96,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x73,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x61,0x60,0x5c,0x58,0x55,0x52,0x4c,0x48,0x45,0x42,0x3c,0x35,0x2c,0x25,0x1e,0x16,0xf,0x8,0x0,0xf9,0xf2,0xec,0xe4,0xde,0xd8,0xce,0xc8,0xc2,0xbe,0xbb,0xb8,0xb2,0xae,0xab,0xa8,0xa5,0xa2,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x97,0x96,0x96,0x95,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x67,0x66,0x64,0x63,0x62,0x62,0x61,0x60,0x5e,0x5b,0x58,0x55,0x52,0x4e,0x48,0x45,0x42,0x3e,0x38,0x32,0x28,0x22,0x1c,0x14,0xe,0x7,0x0,0xfa,0xf3,0xee,0xe6,0xe1,0xdc,0xd2,0xcc,0xc7,0xc2,0xbe,0xbc,0xb7,0xb2,0xae,0xac,0xaa,0xa7,0xa4,0xa2,0xa0,0x9f,0x9f,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x97,0x97,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x69,0x69,0x68,0x67,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5c,0x59,0x56,0x54,0x52,0x4e,0x49,0x44,0x42,0x3e,0x39,0x34,0x2e,0x24,0x1f,0x1a,0x12,0xd,0x6,0x0,0xfa,0xf4,0xf0,0xe8,0xe4,0xe0,0xd8,0xd0,0xcc,0xc8,0xc4,0xc0,0xbc,0xb8,0xb4,0xb0,0xae,0xac,0xaa,0xa8,0xa6,0xa4,0xa2,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x97,0x96,0x96,0x96,0x95,0x94,0x94,0x94,0x93,0x92,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6e,0x6d,0x6c,0x6c,0x6c,0x6b,0x6a,0x6a,0x6a,0x69,0x68,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5e,0x5c,0x5a,0x58,0x56,0x54,0x52,0x50,0x4c,0x48,0x44,0x40,0x3c,0x38,0x34,0x30,0x28,0x20,0x1c,0x18,0x10,0xc,0x6,0x0,0xfa,0xf5,0xf1,0xea,0xe6,0xe2,0xdb,0xd4,0xce,0xcb,0xc8,0xc4,0xbe,0xbb,0xb8,0xb4,0xb0,0xae,0xad,0xab,0xa9,0xa8,0xa6,0xa4,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x73,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x62,0x61,0x60,0x5c,0x5a,0x58,0x57,0x55,0x53,0x52,0x50,0x4c,0x48,0x45,0x42,0x3c,0x38,0x35,0x32,0x2c,0x25,0x1e,0x1a,0x16,0xf,0xb,0x6,0x0,0xfb,0xf6,0xf2,0xec,0xe7,0xe4,0xde,0xd8,0xd2,0xce,0xcb,0xc8,0xc2,0xbe,0xbb,0xb8,0xb5,0xb2,0xb0,0xae,0xac,0xab,0xaa,0xa8,0xa5,0xa2,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x63,0x62,0x62,0x61,0x60,0x5e,0x5b,0x58,0x56,0x55,0x54,0x52,0x50,0x4e,0x4b,0x48,0x45,0x42,0x3e,0x38,0x35,0x32,0x2e,0x28,0x22,0x1c,0x19,0x14,0xe,0xa,0x5,0x0,0xfc,0xf7,0xf3,0xee,0xe9,0xe6,0xe1,0xdc,0xd7,0xd2,0xce,0xcc,0xc7,0xc2,0xbe,0xbc,0xba,0xb7,0xb4,0xb2,0xb0,0xae,0xad,0xac,0xaa,0xa7,0xa4,0xa2,0xa0,0x9f,0x9f,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x61,0x61,0x60,0x5e,0x5c,0x59,0x56,0x54,0x53,0x52,0x50,0x4e,0x4c,0x49,0x46,0x44,0x42,0x3e,0x39,0x34,0x32,0x2e,0x29,0x24,0x1f,0x1a,0x17,0x12,0xd,0x9,0x4,0x0,0xfc,0xf8,0xf4,0xf0,0xec,0xe8,0xe4,0xe0,0xdc,0xd8,0xd4,0xd0,0xcc,0xc8,0xc4,0xc0,0xbe,0xbc,0xba,0xb8,0xb6,0xb4,0xb2,0xb0,0xae,0xac,0xaa,0xa8,0xa6,0xa4,0xa2,0xa0,0xa0,0x9f,0x9e,0x9e,0x9e,0x9d,0x9c,0x9c,0x9c,0x9b,0x9a,0x9a,0x9a,0x99,0x98,0x98,0x98,0x97,0x96,0x96,0x96,0x95,0x94,0x94,0x94,0x93,0x92,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6e,0x6d,0x6c,0x6c,0x6c,0x6b,0x6a,0x6a,0x6a,0x69,0x68,0x68,0x68,0x67,0x66,0x66,0x66,0x65,0x64,0x64,0x64,0x63,0x62,0x62,0x62,0x61,0x60,0x60,0x5e,0x5c,0x5a,0x58,0x56,0x54,0x52,0x50,0x4e,0x4c,0x4a,0x48,0x46,0x44,0x42,0x40,0x3c,0x38,0x34,0x30,0x2c,0x28,0x24,0x20,0x1c,0x18,0x14,0x10,0xc,0x8,0x4,0x0,0xfc,0xf8,0xf4,0xf0,0xed,0xe9,0xe5,0xe1,0xdd,0xda,0xd6,0xd2,0xcd,0xca,0xc6,0xc2,0xbf,0xbd,0xbb,0xba,0xb8,0xb6,0xb4,0xb2,0xaf,0xad,0xab,0xaa,0xa8,0xa6,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x91,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6f,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5a,0x58,0x56,0x55,0x53,0x51,0x4e,0x4c,0x4a,0x48,0x46,0x45,0x43,0x41,0x3e,0x3a,0x36,0x33,0x2e,0x2a,0x26,0x23,0x1f,0x1b,0x17,0x13,0x10,0xc,0x8,0x4,0x0,0xfc,0xf8,0xf5,0xf1,0xee,0xea,0xe6,0xe2,0xde,0xdb,0xd8,0xd4,0xce,0xcb,0xc8,0xc4,0xc0,0xbe,0xbd,0xbb,0xb9,0xb8,0xb6,0xb4,0xb0,0xae,0xad,0xab,0xa9,0xa8,0xa6,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x89,0x89,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x77,0x77,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x73,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5a,0x58,0x57,0x55,0x53,0x52,0x50,0x4c,0x4a,0x48,0x47,0x45,0x43,0x42,0x40,0x3c,0x38,0x35,0x32,0x2c,0x28,0x25,0x22,0x1e,0x1a,0x16,0x12,0xf,0xb,0x8,0x4,0x0,0xfc,0xf9,0xf6,0xf2,0xee,0xeb,0xe6,0xe3,0xe0,0xdc,0xd9,0xd6,0xd0,0xcc,0xc9,0xc6,0xc3,0xc0,0xbe,0xbc,0xbb,0xb9,0xb8,0xb6,0xb3,0xb0,0xae,0xac,0xab,0xa9,0xa8,0xa6,0xa4,0xa3,0xa1,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x99,0x99,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x91,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x8a,0x8a,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x76,0x76,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x73,0x73,0x72,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6f,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x67,0x67,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x5f,0x5d,0x5c,0x5a,0x58,0x57,0x55,0x54,0x52,0x50,0x4d,0x4a,0x48,0x47,0x45,0x44,0x42,0x40,0x3d,0x3a,0x37,0x34,0x30,0x2a,0x27,0x24,0x20,0x1d,0x1a,0x15,0x12,0xe,0xa,0x7,0x4,0x0,0xfc,0xf9,0xf6,0xf2,0xef,0xec,0xe7,0xe4,0xe1,0xde,0xdb,0xd8,0xd2,0xce,0xcb,0xc8,0xc5,0xc2,0xc0,0xbe,0xbc,0xbb,0xba,0xb8,0xb5,0xb2,0xb0,0xae,0xac,0xab,0xaa,0xa8,0xa6,0xa5,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x84,0x84,0x84,0x84,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x58,0x56,0x55,0x54,0x52,0x50,0x4e,0x4b,0x48,0x46,0x45,0x44,0x42,0x40,0x3e,0x3b,0x38,0x35,0x32,0x2e,0x28,0x25,0x22,0x1f,0x1c,0x19,0x14,0x11,0xe,0xa,0x7,0x4,0x0,0xfd,0xf9,0xf6,0xf2,0xf0,0xed,0xe8,0xe5,0xe2,0xe0,0xdd,0xda,0xd4,0xd0,0xcd,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbb,0xba,0xb7,0xb4,0xb2,0xb0,0xae,0xad,0xab,0xaa,0xa9,0xa7,0xa6,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x81,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x75,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5a,0x59,0x57,0x56,0x55,0x53,0x52,0x50,0x4e,0x4c,0x49,0x46,0x45,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x33,0x30,0x2c,0x26,0x23,0x20,0x1e,0x1b,0x18,0x13,0x10,0xe,0xa,0x7,0x3,0x0,0xfd,0xfa,0xf7,0xf3,0xf0,0xee,0xe9,0xe6,0xe4,0xe1,0xde,0xdc,0xd7,0xd2,0xce,0xcc,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbc,0xba,0xb7,0xb4,0xb2,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8b,0x8b,0x8b,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x86,0x85,0x84,0x84,0x84,0x83,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7a,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x75,0x75,0x75,0x75,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x50,0x4e,0x4c,0x49,0x46,0x44,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x34,0x32,0x2e,0x29,0x24,0x22,0x1f,0x1c,0x1a,0x17,0x12,0x10,0xd,0x9,0x6,0x3,0x0,0xfd,0xfa,0xf8,0xf4,0xf1,0xef,0xea,0xe7,0xe5,0xe2,0xe0,0xde,0xda,0xd5,0xd0,0xce,0xcc,0xca,0xc7,0xc5,0xc3,0xc0,0xbf,0xbe,0xbc,0xba,0xb7,0xb5,0xb3,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x98,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x91,0x90,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x83,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x75,0x74,0x74,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x70,0x6f,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x68,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4d,0x4b,0x49,0x46,0x44,0x42,0x41,0x40,0x3d,0x3b,0x39,0x36,0x34,0x32,0x30,0x2b,0x26,0x22,0x20,0x1e,0x1b,0x19,0x16,0x11,0xf,0xc,0x8,0x6,0x3,0x0,0xfd,0xfa,0xf8,0xf4,0xf2,0xf0,0xec,0xe8,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0xd4,0xd0,0xce,0xcc,0xca,0xc8,0xc6,0xc4,0xc2,0xc0,0xbe,0xbc,0xba,0xb8,0xb6,0xb4,0xb2,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9f,0x9e,0x9e,0x9e,0x9d,0x9c,0x9c,0x9c,0x9b,0x9a,0x9a,0x9a,0x99,0x98,0x98,0x98,0x97,0x96,0x96,0x96,0x95,0x94,0x94,0x94,0x93,0x92,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8c,0x8c,0x8b,0x8a,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x86,0x86,0x85,0x84,0x84,0x84,0x84,0x83,0x83,0x82,0x82,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7c,0x7c,0x7b,0x7a,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x76,0x75,0x74,0x74,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6e,0x6d,0x6c,0x6c,0x6c,0x6b,0x6a,0x6a,0x6a,0x69,0x68,0x68,0x68,0x67,0x66,0x66,0x66,0x65,0x64,0x64,0x64,0x63,0x62,0x62,0x62,0x61,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4e,0x4c,0x4a,0x48,0x46,0x44,0x42,0x40,0x3e,0x3c,0x3a,0x38,0x36,0x34,0x32,0x30,0x2c,0x28,0x24,0x20,0x1e,0x1c,0x1a,0x18,0x14,0x10,0xe,0xc,0x8,0x6,0x3,0x0,0xfd,0xfa,0xf8,0xf4,0xf2,0xf0,0xed,0xe9,0xe7,0xe5,0xe3,0xe1,0xdd,0xda,0xd6,0xd2,0xcf,0xcd,0xcb,0xca,0xc8,0xc6,0xc4,0xc2,0xbf,0xbd,0xbb,0xba,0xb8,0xb6,0xb4,0xb2,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x91,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8d,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x86,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x83,0x82,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x73,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6f,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4e,0x4c,0x4a,0x48,0x46,0x45,0x43,0x41,0x3e,0x3c,0x3a,0x38,0x36,0x35,0x33,0x31,0x2e,0x2a,0x26,0x23,0x1f,0x1d,0x1b,0x19,0x17,0x13,0x10,0xe,0xc,0x8,0x6,0x3,0x0,0xfd,0xfa,0xf8,0xf5,0xf3,0xf1,0xee,0xea,0xe7,0xe6,0xe4,0xe2,0xde,0xdb,0xd8,0xd4,0xd0,0xce,0xcd,0xcb,0xc9,0xc8,0xc6,0xc4,0xc0,0xbe,0xbd,0xbb,0xb9,0xb8,0xb6,0xb4,0xb2,0xb0,0xaf,0xae,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8d,0x8d,0x8d,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x83,0x82,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x73,0x73,0x73,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x52,0x51,0x50,0x4e,0x4c,0x4a,0x48,0x47,0x45,0x43,0x42,0x40,0x3c,0x3a,0x38,0x37,0x35,0x33,0x32,0x30,0x2c,0x28,0x25,0x22,0x1e,0x1c,0x1a,0x19,0x16,0x12,0xf,0xd,0xb,0x8,0x6,0x3,0x0,0xfd,0xfb,0xf9,0xf6,0xf3,0xf2,0xee,0xeb,0xe8,0xe6,0xe5,0xe3,0xe0,0xdc,0xd9,0xd6,0xd3,0xd0,0xce,0xcc,0xcb,0xc9,0xc8,0xc6,0xc3,0xc0,0xbe,0xbc,0xbb,0xb9,0xb8,0xb6,0xb4,0xb3,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa4,0xa3,0xa1,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x99,0x99,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x91,0x91,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8e,0x8e,0x8d,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x8a,0x89,0x88,0x88,0x88,0x87,0x87,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x82,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x79,0x79,0x78,0x78,0x78,0x77,0x76,0x76,0x76,0x75,0x75,0x74,0x74,0x73,0x72,0x72,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x6f,0x6f,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x67,0x67,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x5f,0x5d,0x5c,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x4f,0x4d,0x4c,0x4a,0x48,0x47,0x45,0x44,0x42,0x40,0x3d,0x3a,0x38,0x37,0x35,0x34,0x32,0x30,0x2d,0x2a,0x27,0x24,0x20,0x1d,0x1b,0x1a,0x18,0x15,0x12,0xe,0xd,0xa,0x7,0x5,0x3,0x0,0xfe,0xfb,0xf9,0xf6,0xf4,0xf2,0xef,0xec,0xe9,0xe7,0xe6,0xe4,0xe1,0xde,0xdb,0xd8,0xd5,0xd2,0xd0,0xce,0xcc,0xcb,0xca,0xc8,0xc5,0xc2,0xc0,0xbe,0xbc,0xbb,0xba,0xb8,0xb6,0xb5,0xb4,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa6,0xa5,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x84,0x84,0x83,0x82,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x58,0x57,0x56,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x48,0x46,0x45,0x44,0x42,0x40,0x3e,0x3b,0x38,0x36,0x35,0x34,0x32,0x30,0x2e,0x2b,0x28,0x25,0x22,0x1f,0x1c,0x1a,0x19,0x17,0x14,0x11,0xe,0xc,0xa,0x7,0x5,0x2,0x0,0xfe,0xfb,0xf9,0xf6,0xf4,0xf2,0xf0,0xed,0xea,0xe8,0xe6,0xe5,0xe2,0xe0,0xdd,0xda,0xd7,0xd4,0xd2,0xd0,0xce,0xcd,0xcb,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbb,0xba,0xb9,0xb7,0xb6,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xad,0xac,0xab,0xab,0xaa,0xa9,0xa7,0xa6,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x87,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x81,0x80,0x7f,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x79,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5a,0x59,0x57,0x56,0x55,0x55,0x54,0x53,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4a,0x49,0x47,0x46,0x45,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x35,0x33,0x32,0x30,0x2e,0x2c,0x29,0x26,0x23,0x20,0x1e,0x1b,0x1a,0x18,0x16,0x13,0x10,0xe,0xc,0xa,0x7,0x5,0x2,0x0,0xfe,0xfc,0xfa,0xf7,0xf4,0xf3,0xf0,0xee,0xec,0xe9,0xe7,0xe6,0xe4,0xe1,0xde,0xdc,0xda,0xd7,0xd4,0xd2,0xd0,0xce,0xcd,0xcc,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xae,0xad,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x90,0x90,0x90,0x90,0x8f,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x88,0x88,0x88,0x88,0x88,0x87,0x86,0x86,0x86,0x85,0x84,0x84,0x84,0x83,0x83,0x82,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7c,0x7b,0x7a,0x7a,0x7a,0x79,0x78,0x78,0x78,0x78,0x78,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x71,0x71,0x70,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x53,0x52,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x34,0x33,0x32,0x30,0x2e,0x2c,0x29,0x26,0x24,0x22,0x1f,0x1c,0x1a,0x19,0x17,0x14,0x12,0x10,0xd,0xc,0x9,0x6,0x4,0x2,0x0,0xfe,0xfc,0xfa,0xf8,0xf5,0xf4,0xf1,0xef,0xed,0xea,0xe8,0xe7,0xe5,0xe2,0xe0,0xde,0xdc,0xda,0xd7,0xd5,0xd3,0xd0,0xcf,0xce,0xcc,0xca,0xc7,0xc5,0xc3,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x98,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x92,0x92,0x91,0x91,0x90,0x90,0x90,0x90,0x90,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x83,0x83,0x82,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x78,0x77,0x77,0x76,0x76,0x75,0x74,0x74,0x74,0x73,0x73,0x72,0x72,0x71,0x70,0x70,0x70,0x70,0x70,0x6f,0x6f,0x6e,0x6e,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x68,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3d,0x3b,0x39,0x36,0x34,0x32,0x31,0x30,0x2d,0x2b,0x29,0x26,0x24,0x22,0x20,0x1e,0x1b,0x19,0x18,0x16,0x13,0x11,0xf,0xc,0xb,0x8,0x6,0x4,0x2,0x0,0xfe,0xfc,0xfa,0xf8,0xf6,0xf4,0xf2,0xf0,0xee,0xec,0xea,0xe8,0xe6,0xe4,0xe2,0xe0,0xde,0xdc,0xda,0xd8,0xd6,0xd4,0xd2,0xd0,0xce,0xcc,0xca,0xc8,0xc6,0xc4,0xc2,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9f,0x9e,0x9e,0x9e,0x9d,0x9c,0x9c,0x9c,0x9b,0x9a,0x9a,0x9a,0x99,0x98,0x98,0x98,0x97,0x96,0x96,0x96,0x95,0x94,0x94,0x94,0x93,0x92,0x92,0x92,0x91,0x90,0x90,0x90,0x8f,0x8e,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8a,0x8a,0x8a,0x89,0x88,0x88,0x88,0x87,0x86,0x86,0x86,0x85,0x84,0x84,0x84,0x83,0x82,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7c,0x7b,0x7a,0x7a,0x7a,0x79,0x78,0x78,0x78,0x77,0x76,0x76,0x76,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x72,0x71,0x70,0x70,0x70,0x6f,0x6e,0x6e,0x6e,0x6d,0x6c,0x6c,0x6c,0x6b,0x6a,0x6a,0x6a,0x69,0x68,0x68,0x68,0x67,0x66,0x66,0x66,0x65,0x64,0x64,0x64,0x63,0x62,0x62,0x62,0x61,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3e,0x3c,0x3a,0x38,0x36,0x34,0x32,0x30,0x2e,0x2c,0x2a,0x28,0x26,0x24,0x22,0x20,0x1e,0x1c,0x1a,0x18,0x16,0x14,0x12,0x10,0xe,0xc,0xa,0x8,0x6,0x4,0x2,0x0,0xfe,0xfc,0xfa,0xf8,0xf6,0xf4,0xf2,0xf0,0xee,0xec,0xea,0xe8,0xe6,0xe4,0xe2,0xe0,0xdf,0xdd,0xdb,0xd9,0xd7,0xd5,0xd3,0xd1,0xcf,0xcd,0xcb,0xc9,0xc7,0xc5,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x91,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x77,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6f,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3b,0x39,0x37,0x35,0x33,0x31,0x2f,0x2d,0x2b,0x29,0x27,0x25,0x23,0x21,0x20,0x1e,0x1c,0x1a,0x18,0x16,0x14,0x12,0x10,0xe,0xc,0xa,0x8,0x6,0x4,0x2,0x0,0xfe,0xfc,0xfa,0xf8,0xf6,0xf4,0xf2,0xf0,0xef,0xed,0xeb,0xe9,0xe7,0xe5,0xe3,0xe1,0xdf,0xdd,0xdb,0xda,0xd8,0xd6,0xd4,0xd2,0xcf,0xcd,0xcb,0xca,0xc8,0xc6,0xc4,0xc2,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x91,0x91,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x77,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x70,0x6f,0x6f,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3e,0x3c,0x3a,0x38,0x36,0x35,0x33,0x31,0x2e,0x2c,0x2a,0x28,0x26,0x25,0x23,0x21,0x1f,0x1d,0x1b,0x19,0x17,0x15,0x13,0x11,0x10,0xe,0xc,0xa,0x8,0x6,0x4,0x2,0x0,0xfe,0xfc,0xfa,0xf8,0xf7,0xf5,0xf3,0xf1,0xef,0xed,0xeb,0xea,0xe7,0xe5,0xe3,0xe2,0xe0,0xde,0xdc,0xda,0xd8,0xd7,0xd5,0xd3,0xd0,0xce,0xcc,0xca,0xc8,0xc7,0xc5,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa1,0xa0,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x9a,0x99,0x98,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x92,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8b,0x8b,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x77,0x77,0x76,0x76,0x75,0x75,0x75,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x71,0x70,0x6e,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x68,0x67,0x66,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x60,0x5f,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3b,0x39,0x38,0x36,0x34,0x32,0x30,0x2d,0x2b,0x29,0x28,0x26,0x24,0x22,0x20,0x1e,0x1d,0x1b,0x19,0x16,0x15,0x13,0x11,0xf,0xd,0xb,0x9,0x8,0x6,0x4,0x2,0x0,0xfe,0xfc,0xfa,0xf8,0xf7,0xf5,0xf3,0xf1,0xef,0xee,0xec,0xea,0xe7,0xe6,0xe4,0xe2,0xe0,0xde,0xdd,0xdb,0xd9,0xd8,0xd6,0xd4,0xd0,0xce,0xcd,0xcb,0xc9,0xc8,0xc6,0xc4,0xc2,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb2,0xb0,0xaf,0xae,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x92,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x89,0x89,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x77,0x77,0x76,0x76,0x75,0x75,0x74,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6e,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x52,0x51,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x42,0x41,0x40,0x3e,0x3c,0x3a,0x38,0x37,0x35,0x33,0x32,0x30,0x2c,0x2a,0x28,0x27,0x25,0x23,0x22,0x20,0x1e,0x1c,0x1a,0x19,0x16,0x14,0x12,0x11,0xf,0xd,0xb,0x9,0x8,0x6,0x4,0x2,0x0,0xfe,0xfc,0xfb,0xf9,0xf7,0xf5,0xf3,0xf1,0xf0,0xee,0xec,0xea,0xe8,0xe6,0xe4,0xe2,0xe1,0xdf,0xdd,0xdc,0xda,0xd8,0xd7,0xd5,0xd2,0xcf,0xcd,0xcc,0xca,0xc8,0xc7,0xc5,0xc3,0xc2,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb3,0xb2,0xb0,0xaf,0xae,0xad,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x96,0x96,0x95,0x95,0x95,0x94,0x94,0x93,0x93,0x92,0x91,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8b,0x8b,0x8b,0x8a,0x8a,0x89,0x88,0x87,0x87,0x87,0x86,0x85,0x85,0x85,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7b,0x7b,0x7b,0x7a,0x79,0x79,0x79,0x78,0x77,0x76,0x76,0x75,0x75,0x75,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x71,0x70,0x6f,0x6e,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x53,0x52,0x51,0x50,0x4e,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x3e,0x3d,0x3b,0x39,0x38,0x36,0x34,0x33,0x31,0x2e,0x2b,0x29,0x28,0x26,0x24,0x23,0x21,0x1f,0x1e,0x1c,0x1a,0x18,0x16,0x14,0x12,0x10,0xf,0xd,0xb,0x9,0x7,0x5,0x4,0x2,0x0,0xfe,0xfc,0xfb,0xf9,0xf7,0xf6,0xf3,0xf2,0xf0,0xee,0xed,0xeb,0xe8,0xe6,0xe5,0xe3,0xe1,0xe0,0xde,0xdc,0xdb,0xd9,0xd8,0xd6,0xd3,0xd0,0xce,0xcc,0xcb,0xc9,0xc8,0xc6,0xc4,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb4,0xb3,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa4,0xa3,0xa1,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x99,0x99,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x95,0x95,0x94,0x94,0x93,0x93,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8e,0x8d,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x8a,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x76,0x76,0x76,0x75,0x75,0x74,0x74,0x73,0x72,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6d,0x6d,0x6c,0x6c,0x6b,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x67,0x67,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x5f,0x5d,0x5c,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x4f,0x4d,0x4c,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3c,0x3a,0x38,0x37,0x35,0x34,0x32,0x30,0x2d,0x2a,0x28,0x27,0x25,0x24,0x22,0x20,0x1f,0x1d,0x1b,0x1a,0x18,0x15,0x13,0x12,0x10,0xe,0xd,0xa,0x9,0x7,0x5,0x4,0x2,0x0,0xfe,0xfc,0xfb,0xf9,0xf7,0xf6,0xf3,0xf2,0xf0,0xef,0xed,0xec,0xe8,0xe7,0xe5,0xe4,0xe2,0xe0,0xdf,0xdd,0xdc,0xda,0xd9,0xd7,0xd4,0xd1,0xcf,0xcd,0xcc,0xca,0xc9,0xc7,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb7,0xb5,0xb4,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa7,0xa5,0xa4,0xa2,0xa1,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x95,0x95,0x94,0x94,0x94,0x92,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8b,0x8b,0x8b,0x8a,0x8a,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x83,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x76,0x76,0x75,0x75,0x75,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6c,0x6c,0x6b,0x6b,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x65,0x64,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x5f,0x5e,0x5c,0x5b,0x59,0x58,0x57,0x57,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4c,0x4b,0x49,0x48,0x47,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x39,0x37,0x36,0x34,0x33,0x31,0x2f,0x2c,0x29,0x27,0x26,0x24,0x23,0x21,0x20,0x1e,0x1c,0x1b,0x19,0x18,0x14,0x13,0x11,0x10,0xe,0xd,0xa,0x9,0x7,0x5,0x4,0x2,0x0,0xfe,0xfc,0xfb,0xf9,0xf8,0xf6,0xf4,0xf2,0xf0,0xef,0xee,0xec,0xe9,0xe7,0xe6,0xe4,0xe2,0xe1,0xe0,0xde,0xdc,0xdb,0xda,0xd8,0xd5,0xd2,0xd0,0xce,0xcc,0xcb,0xca,0xc8,0xc6,0xc5,0xc4,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb6,0xb5,0xb4,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa6,0xa5,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8a,0x8a,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x83,0x82,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7e,0x7d,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x76,0x76,0x75,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x58,0x57,0x56,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x48,0x47,0x46,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x38,0x36,0x35,0x34,0x32,0x30,0x2e,0x2b,0x28,0x26,0x25,0x24,0x22,0x20,0x1f,0x1e,0x1c,0x1a,0x19,0x17,0x14,0x12,0x11,0x10,0xe,0xc,0xa,0x8,0x7,0x5,0x4,0x2,0x0,0xfe,0xfd,0xfb,0xf9,0xf8,0xf6,0xf4,0xf2,0xf1,0xef,0xee,0xec,0xea,0xe7,0xe6,0xe4,0xe3,0xe2,0xe0,0xdf,0xdd,0xdc,0xda,0xd9,0xd6,0xd3,0xd0,0xcf,0xcd,0xcc,0xca,0xc9,0xc8,0xc6,0xc5,0xc3,0xc2,0xc0,0xbf,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb6,0xb5,0xb3,0xb2,0xb0,0xaf,0xaf,0xae,0xad,0xad,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa6,0xa5,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x93,0x92,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8b,0x8b,0x8b,0x8a,0x89,0x88,0x87,0x87,0x86,0x86,0x85,0x85,0x84,0x84,0x83,0x83,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x77,0x76,0x75,0x75,0x75,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5b,0x5a,0x58,0x57,0x56,0x56,0x55,0x54,0x53,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4b,0x4a,0x48,0x47,0x46,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3b,0x3a,0x38,0x37,0x36,0x34,0x33,0x31,0x30,0x2d,0x2a,0x27,0x26,0x24,0x23,0x21,0x20,0x1e,0x1d,0x1c,0x1a,0x19,0x16,0x14,0x12,0x11,0xf,0xe,0xc,0xa,0x8,0x7,0x5,0x3,0x2,0x0,0xfe,0xfd,0xfb,0xf9,0xf8,0xf6,0xf4,0xf2,0xf1,0xf0,0xee,0xed,0xea,0xe8,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdd,0xdb,0xda,0xd7,0xd4,0xd2,0xd0,0xce,0xcd,0xcb,0xca,0xc9,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xbb,0xba,0xb9,0xb7,0xb6,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xad,0xac,0xab,0xab,0xaa,0xa9,0xa7,0xa6,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x95,0x95,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8a,0x89,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x84,0x84,0x83,0x83,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x77,0x76,0x75,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6b,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5a,0x59,0x57,0x56,0x55,0x55,0x54,0x53,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4a,0x49,0x47,0x46,0x45,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x37,0x36,0x35,0x33,0x32,0x30,0x2e,0x2c,0x29,0x26,0x25,0x23,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x18,0x16,0x13,0x12,0x10,0xf,0xe,0xc,0xa,0x8,0x7,0x5,0x3,0x2,0x0,0xfe,0xfd,0xfb,0xf9,0xf8,0xf7,0xf4,0xf3,0xf1,0xf0,0xef,0xee,0xeb,0xe8,0xe7,0xe6,0xe4,0xe3,0xe2,0xe0,0xdf,0xde,0xdc,0xdb,0xd8,0xd6,0xd3,0xd0,0xcf,0xce,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xae,0xae,0xad,0xac,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9e,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x96,0x96,0x94,0x93,0x92,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8d,0x8d,0x8c,0x8c,0x8b,0x8b,0x8b,0x89,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x84,0x84,0x83,0x83,0x82,0x81,0x81,0x80,0x7f,0x7f,0x7e,0x7d,0x7d,0x7c,0x7c,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x77,0x75,0x75,0x75,0x74,0x74,0x73,0x73,0x72,0x71,0x71,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6a,0x6a,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x62,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5a,0x59,0x58,0x56,0x55,0x54,0x54,0x53,0x52,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4a,0x49,0x48,0x46,0x45,0x44,0x44,0x43,0x42,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x32,0x31,0x30,0x2d,0x2a,0x28,0x25,0x24,0x22,0x21,0x20,0x1e,0x1d,0x1c,0x1a,0x19,0x18,0x15,0x12,0x11,0x10,0xf,0xd,0xc,0x9,0x8,0x7,0x5,0x3,0x2,0x0,0xfe,0xfd,0xfc,0xfa,0xf8,0xf7,0xf4,0xf3,0xf2,0xf0,0xef,0xee,0xec,0xe9,0xe7,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdd,0xdc,0xda,0xd7,0xd4,0xd2,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xae,0xad,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x98,0x98,0x98,0x97,0x97,0x97,0x96,0x96,0x95,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8c,0x8c,0x8b,0x8b,0x8a,0x88,0x88,0x88,0x87,0x86,0x86,0x86,0x84,0x84,0x83,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7a,0x7a,0x7a,0x79,0x78,0x78,0x78,0x76,0x75,0x75,0x74,0x74,0x74,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6a,0x6a,0x69,0x69,0x69,0x68,0x68,0x68,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x53,0x52,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x43,0x42,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x30,0x2e,0x2c,0x29,0x26,0x24,0x23,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x19,0x17,0x14,0x12,0x11,0x10,0xe,0xd,0xc,0x9,0x8,0x6,0x4,0x3,0x2,0x0,0xff,0xfd,0xfc,0xfa,0xf8,0xf7,0xf5,0xf3,0xf2,0xf1,0xf0,0xee,0xec,0xea,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xdf,0xde,0xdd,0xdb,0xd8,0xd6,0xd4,0xd1,0xcf,0xce,0xcd,0xcc,0xcb,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbf,0xbe,0xbe,0xbd,0xbc,0xbb,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb2,0xb1,0xb0,0xaf,0xaf,0xae,0xae,0xad,0xac,0xab,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa2,0xa1,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x97,0x97,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x87,0x86,0x86,0x86,0x84,0x84,0x83,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7c,0x7a,0x7a,0x7a,0x79,0x78,0x78,0x77,0x76,0x75,0x74,0x74,0x74,0x73,0x73,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x69,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5f,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x55,0x54,0x53,0x52,0x52,0x51,0x51,0x50,0x4f,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x45,0x44,0x43,0x42,0x42,0x41,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x35,0x34,0x33,0x32,0x31,0x2f,0x2c,0x2a,0x28,0x25,0x23,0x22,0x21,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x18,0x16,0x14,0x12,0x10,0xf,0xe,0xd,0xb,0x9,0x8,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfa,0xf9,0xf8,0xf5,0xf4,0xf2,0xf1,0xf0,0xef,0xed,0xea,0xe8,0xe7,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xdf,0xde,0xdc,0xda,0xd7,0xd5,0xd3,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x98,0x97,0x97,0x96,0x95,0x94,0x92,0x91,0x90,0x90,0x90,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x87,0x87,0x86,0x86,0x85,0x84,0x83,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x78,0x77,0x76,0x74,0x74,0x74,0x73,0x73,0x72,0x72,0x71,0x70,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2d,0x2b,0x29,0x26,0x24,0x22,0x21,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x16,0x13,0x11,0x10,0xf,0xe,0xc,0xb,0x8,0x7,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfa,0xf9,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xed,0xeb,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xdf,0xdd,0xdb,0xd9,0xd6,0xd4,0xd2,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0xa0,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x87,0x86,0x86,0x85,0x84,0x83,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7d,0x7c,0x7b,0x7a,0x7a,0x79,0x79,0x78,0x77,0x76,0x75,0x74,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x68,0x68,0x68,0x67,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x60,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2e,0x2c,0x2a,0x27,0x25,0x23,0x21,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x18,0x17,0x15,0x13,0x10,0xf,0xe,0xd,0xc,0xa,0x8,0x7,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfa,0xf9,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xee,0xec,0xea,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xde,0xdc,0xda,0xd8,0xd6,0xd4,0xd2,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9f,0x9e,0x9e,0x9e,0x9d,0x9c,0x9c,0x9c,0x9b,0x9a,0x9a,0x9a,0x99,0x98,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x8f,0x8e,0x8e,0x8e,0x8d,0x8c,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x87,0x86,0x86,0x85,0x84,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x7a,0x79,0x78,0x78,0x77,0x76,0x75,0x74,0x74,0x73,0x72,0x72,0x72,0x71,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x67,0x66,0x66,0x66,0x65,0x64,0x64,0x64,0x63,0x62,0x62,0x62,0x61,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2e,0x2c,0x2a,0x28,0x26,0x24,0x22,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x16,0x14,0x12,0x10,0xf,0xe,0xd,0xc,0xa,0x8,0x7,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfa,0xf9,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xee,0xec,0xea,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xdf,0xdd,0xdb,0xd9,0xd7,0xd5,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x87,0x87,0x86,0x85,0x84,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x77,0x76,0x75,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2b,0x29,0x27,0x25,0x23,0x21,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x16,0x14,0x12,0x10,0xf,0xe,0xd,0xc,0xa,0x8,0x7,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfa,0xf9,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xef,0xed,0xeb,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xdf,0xdd,0xdb,0xda,0xd8,0xd6,0xd4,0xd2,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x99,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x87,0x87,0x86,0x85,0x84,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x77,0x76,0x75,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x67,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2e,0x2c,0x2a,0x28,0x26,0x25,0x23,0x21,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x15,0x13,0x11,0x10,0xf,0xe,0xd,0xc,0xa,0x8,0x7,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfa,0xf9,0xf8,0xf7,0xf5,0xf3,0xf3,0xf2,0xf1,0xef,0xed,0xeb,0xea,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xde,0xdc,0xda,0xd8,0xd7,0xd5,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa1,0xa0,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x9a,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8b,0x8b,0x8a,0x89,0x88,0x87,0x87,0x86,0x85,0x84,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x77,0x76,0x75,0x75,0x73,0x73,0x72,0x72,0x71,0x71,0x71,0x70,0x6e,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x66,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x60,0x5f,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2b,0x29,0x28,0x26,0x24,0x22,0x20,0x1e,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x16,0x15,0x13,0x11,0xf,0xe,0xd,0xd,0xb,0x9,0x8,0x7,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfa,0xf9,0xf8,0xf7,0xf5,0xf4,0xf3,0xf2,0xf1,0xef,0xee,0xec,0xea,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe3,0xe2,0xe0,0xde,0xdd,0xdb,0xd9,0xd8,0xd6,0xd4,0xd2,0xd0,0xcf,0xce,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc4,0xc2,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb2,0xb0,0xaf,0xae,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x9a,0x98,0x97,0x96,0x96,0x95,0x94,0x93,0x92,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8d,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x87,0x86,0x85,0x84,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x77,0x76,0x75,0x74,0x73,0x73,0x72,0x72,0x71,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x66,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x52,0x51,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x42,0x41,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x32,0x31,0x30,0x2e,0x2c,0x2a,0x28,0x27,0x25,0x23,0x22,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x16,0x14,0x12,0x11,0xf,0xe,0xd,0xc,0xb,0x9,0x8,0x7,0x6,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfb,0xf9,0xf9,0xf7,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xee,0xec,0xea,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xdf,0xdd,0xdc,0xda,0xd8,0xd7,0xd5,0xd3,0xd2,0xd0,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc3,0xc2,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb3,0xb2,0xb0,0xaf,0xae,0xad,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x9a,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x87,0x87,0x85,0x85,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x79,0x79,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x72,0x71,0x71,0x71,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x66,0x65,0x65,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x53,0x52,0x51,0x50,0x4e,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x3e,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x33,0x32,0x31,0x30,0x2e,0x2d,0x2b,0x29,0x28,0x26,0x24,0x23,0x21,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x16,0x14,0x12,0x10,0xf,0xe,0xd,0xc,0xb,0x9,0x7,0x7,0x5,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfb,0xfa,0xf9,0xf7,0xf6,0xf4,0xf3,0xf2,0xf2,0xf0,0xee,0xed,0xeb,0xe9,0xe8,0xe7,0xe6,0xe5,0xe5,0xe4,0xe3,0xe1,0xe0,0xde,0xdc,0xdb,0xd9,0xd8,0xd6,0xd4,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc4,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb4,0xb3,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa4,0xa3,0xa1,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9b,0x9b,0x99,0x98,0x97,0x96,0x95,0x95,0x94,0x93,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8e,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x87,0x87,0x86,0x85,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x76,0x75,0x74,0x72,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6b,0x6b,0x6a,0x69,0x68,0x67,0x65,0x65,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x5f,0x5d,0x5c,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x4f,0x4d,0x4c,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3c,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2c,0x2a,0x28,0x27,0x25,0x24,0x22,0x20,0x1f,0x1d,0x1c,0x1b,0x1b,0x1a,0x19,0x18,0x17,0x15,0x13,0x12,0x10,0xe,0xe,0xd,0xc,0xa,0x9,0x7,0x6,0x5,0x4,0x3,0x1,0x0,0xff,0xfd,0xfc,0xfb,0xfa,0xf9,0xf7,0xf6,0xf4,0xf3,0xf3,0xf2,0xf0,0xef,0xed,0xec,0xea,0xe8,0xe7,0xe7,0xe6,0xe5,0xe4,0xe4,0xe2,0xe0,0xdf,0xdd,0xdc,0xda,0xd9,0xd7,0xd5,0xd4,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc7,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb7,0xb5,0xb4,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa7,0xa5,0xa4,0xa2,0xa1,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9d,0x9d,0x9c,0x9c,0x9c,0x9a,0x98,0x97,0x97,0x96,0x95,0x94,0x94,0x92,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8e,0x8c,0x8b,0x8b,0x8a,0x88,0x88,0x87,0x87,0x86,0x85,0x84,0x83,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x75,0x75,0x74,0x72,0x72,0x71,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x64,0x64,0x64,0x63,0x63,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x5f,0x5e,0x5c,0x5b,0x59,0x58,0x57,0x57,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4c,0x4b,0x49,0x48,0x47,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x39,0x38,0x37,0x37,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2c,0x2b,0x29,0x27,0x26,0x24,0x23,0x21,0x20,0x1e,0x1c,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x14,0x13,0x11,0x10,0xe,0xd,0xd,0xc,0xa,0x9,0x7,0x6,0x5,0x4,0x3,0x1,0x0,0xff,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf6,0xf4,0xf4,0xf3,0xf2,0xf0,0xef,0xee,0xec,0xea,0xe9,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdc,0xdb,0xda,0xd8,0xd6,0xd5,0xd4,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc6,0xc5,0xc4,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb6,0xb5,0xb4,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa6,0xa5,0xa4,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x97,0x96,0x96,0x95,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8e,0x8c,0x8c,0x8b,0x8a,0x88,0x88,0x87,0x87,0x86,0x85,0x84,0x84,0x82,0x82,0x81,0x80,0x7f,0x7e,0x7e,0x7c,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x67,0x66,0x64,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x58,0x57,0x56,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x48,0x47,0x46,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x38,0x37,0x36,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x28,0x26,0x25,0x24,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x17,0x16,0x14,0x12,0x11,0x10,0xe,0xd,0xc,0xc,0xa,0x8,0x7,0x6,0x5,0x4,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfb,0xfa,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xef,0xee,0xec,0xeb,0xea,0xe8,0xe7,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe0,0xdf,0xdd,0xdc,0xda,0xd9,0xd8,0xd6,0xd5,0xd3,0xd2,0xd0,0xcf,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc6,0xc5,0xc3,0xc2,0xc0,0xbf,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb6,0xb5,0xb3,0xb2,0xb0,0xaf,0xaf,0xae,0xad,0xad,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa6,0xa5,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9b,0x9a,0x98,0x97,0x97,0x96,0x95,0x94,0x93,0x92,0x90,0x90,0x8f,0x8f,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x87,0x86,0x85,0x84,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5b,0x5a,0x58,0x57,0x56,0x56,0x55,0x54,0x53,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4b,0x4a,0x48,0x47,0x46,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3b,0x3a,0x38,0x37,0x36,0x36,0x35,0x34,0x33,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2b,0x2a,0x28,0x27,0x26,0x24,0x23,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x12,0x11,0xf,0xe,0xd,0xc,0xb,0xa,0x8,0x7,0x6,0x5,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfb,0xfa,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdd,0xdb,0xda,0xd9,0xd7,0xd6,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xcb,0xca,0xc9,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xbb,0xba,0xb9,0xb7,0xb6,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xad,0xac,0xab,0xab,0xaa,0xa9,0xa7,0xa6,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9d,0x9d,0x9c,0x9a,0x99,0x98,0x97,0x96,0x96,0x95,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x87,0x86,0x85,0x84,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x67,0x66,0x64,0x63,0x63,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5a,0x59,0x57,0x56,0x55,0x55,0x54,0x53,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4a,0x49,0x47,0x46,0x45,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x37,0x36,0x35,0x35,0x34,0x33,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2a,0x29,0x27,0x26,0x25,0x23,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x17,0x16,0x14,0x13,0x12,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x8,0x7,0x6,0x5,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfb,0xfa,0xf9,0xf8,0xf7,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xef,0xee,0xec,0xeb,0xea,0xe8,0xe7,0xe7,0xe6,0xe6,0xe4,0xe3,0xe2,0xe0,0xdf,0xde,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xce,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xae,0xae,0xad,0xac,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa4,0xa3,0xa2,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9e,0x9e,0x9c,0x9b,0x9a,0x98,0x97,0x97,0x96,0x96,0x94,0x93,0x92,0x90,0x90,0x8f,0x8f,0x8f,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x87,0x86,0x85,0x84,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x77,0x75,0x75,0x74,0x73,0x71,0x71,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6a,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x62,0x62,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5a,0x59,0x58,0x56,0x55,0x54,0x54,0x53,0x52,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4a,0x49,0x48,0x46,0x45,0x44,0x44,0x43,0x42,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x34,0x33,0x32,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2a,0x29,0x28,0x26,0x25,0x24,0x22,0x21,0x20,0x1e,0x1d,0x1c,0x1a,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x12,0x11,0x10,0xf,0xd,0xd,0xc,0xb,0x9,0x8,0x7,0x6,0x5,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfa,0xfa,0xf8,0xf7,0xf6,0xf4,0xf4,0xf3,0xf2,0xf0,0xef,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe7,0xe7,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xae,0xad,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x97,0x97,0x96,0x95,0x94,0x92,0x91,0x90,0x90,0x8f,0x8f,0x8e,0x8c,0x8c,0x8b,0x8a,0x88,0x88,0x88,0x86,0x86,0x84,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7c,0x7a,0x7a,0x78,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x71,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x67,0x66,0x64,0x63,0x62,0x62,0x61,0x61,0x61,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x53,0x52,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x43,0x42,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x33,0x32,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x17,0x16,0x14,0x13,0x12,0x11,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfa,0xfa,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xdf,0xde,0xdd,0xdc,0xdb,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd2,0xd1,0xd0,0xcf,0xcf,0xce,0xce,0xcd,0xcc,0xcb,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbf,0xbe,0xbe,0xbd,0xbc,0xbb,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb2,0xb1,0xb0,0xaf,0xaf,0xae,0xae,0xad,0xac,0xab,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa2,0xa1,0xa0,0xa0,0x9f,0x9f,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7c,0x7a,0x7a,0x78,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x61,0x60,0x60,0x5f,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x55,0x54,0x53,0x52,0x52,0x51,0x51,0x50,0x4f,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x45,0x44,0x43,0x42,0x42,0x41,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x35,0x34,0x33,0x32,0x32,0x31,0x31,0x30,0x2f,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x25,0x24,0x23,0x22,0x21,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xef,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe8,0xe7,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0xa0,0xa0,0x9f,0x9f,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x98,0x97,0x96,0x95,0x94,0x92,0x91,0x90,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x87,0x86,0x85,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x77,0x76,0x74,0x74,0x73,0x72,0x70,0x70,0x70,0x6f,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x67,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x17,0x16,0x14,0x13,0x12,0x11,0x10,0xf,0xe,0xc,0xc,0xb,0xa,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x86,0x85,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x68,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x60,0x60,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x18,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x86,0x85,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x86,0x85,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x5f,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa2,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x91,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x88,0x87,0x86,0x85,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x6f,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x57,0x56,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf7,0xf7,0xf6,0xf5,0xf3,0xf3,0xf2,0xf1,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa4,0xa3,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x92,0x8f,0x8f,0x8e,0x8d,0x8b,0x8b,0x8a,0x89,0x87,0x86,0x85,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x77,0x76,0x75,0x75,0x73,0x72,0x71,0x71,0x6e,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x66,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5d,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x51,0x50,0x4f,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x16,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0xf,0xe,0xd,0xd,0xb,0xa,0x9,0x9,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xef,0xee,0xee,0xed,0xec,0xeb,0xea,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xdf,0xde,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd4,0xd2,0xd0,0xcf,0xce,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc4,0xc2,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb2,0xb0,0xaf,0xae,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa4,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9b,0x9a,0x98,0x97,0x96,0x96,0x95,0x94,0x93,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x87,0x86,0x85,0x84,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x61,0x60,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x52,0x52,0x51,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x42,0x41,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x32,0x31,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x22,0x21,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x11,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd3,0xd2,0xd0,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc3,0xc2,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb3,0xb2,0xb0,0xaf,0xae,0xad,0xad,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa5,0xa2,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x97,0x96,0x95,0x94,0x93,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x87,0x87,0x85,0x85,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7b,0x7b,0x79,0x79,0x77,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5e,0x5b,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x53,0x53,0x52,0x51,0x50,0x4e,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x3e,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x33,0x32,0x31,0x30,0x2e,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x7,0x7,0x5,0x5,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfc,0xfb,0xfa,0xf9,0xf8,0xf7,0xf6,0xf6,0xf4,0xf3,0xf2,0xf2,0xf1,0xf0,0xef,0xee,0xed,0xed,0xec,0xeb,0xe9,0xe8,0xe7,0xe6,0xe5,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd4,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc4,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb4,0xb3,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa7,0xa6,0xa3,0xa0,0x9f,0x9e,0x9d,0x9d,0x9c,0x9b,0x99,0x98,0x97,0x96,0x95,0x95,0x94,0x93,0x90,0x8f,0x8e,0x8e,0x8c,0x8b,0x8a,0x8a,0x88,0x87,0x86,0x85,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7b,0x7a,0x79,0x78,0x76,0x76,0x75,0x74,0x72,0x72,0x71,0x70,0x6d,0x6c,0x6b,0x6b,0x6a,0x69,0x68,0x67,0x65,0x64,0x63,0x63,0x62,0x61,0x60,0x5d,0x5a,0x59,0x58,0x58,0x57,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x4f,0x4d,0x4c,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3c,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2c,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x1f,0x1f,0x1e,0x1d,0x1c,0x1b,0x1b,0x1a,0x19,0x18,0x17,0x15,0x14,0x13,0x13,0x12,0x11,0x10,0xf,0xe,0xe,0xd,0xc,0xa,0xa,0x9,0x8,0x7,0x6,0x5,0x4,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfd,0xfc,0xfc,0xfb,0xfa,0xf9,0xf8,0xf7,0xf7,0xf6,0xf4,0xf3,0xf3,0xf2,0xf1,0xf0,0xef,0xef,0xee,0xed,0xec,0xec,0xea,0xe8,0xe7,0xe7,0xe6,0xe5,0xe4,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd7,0xd5,0xd4,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc7,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb7,0xb5,0xb4,0xb2,0xb1,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa7,0xa4,0xa1,0x9f,0x9f,0x9e,0x9d,0x9c,0x9c,0x9a,0x98,0x97,0x97,0x96,0x95,0x94,0x94,0x90,0x8f,0x8f,0x8e,0x8c,0x8b,0x8b,0x8a,0x88,0x87,0x86,0x85,0x83,0x82,0x81,0x80,0x7f,0x7e,0x7d,0x7b,0x7a,0x79,0x78,0x76,0x75,0x75,0x74,0x72,0x71,0x71,0x70,0x6c,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x64,0x64,0x63,0x62,0x61,0x61,0x5f,0x5c,0x59,0x58,0x57,0x57,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x4f,0x4e,0x4c,0x4b,0x49,0x48,0x47,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x39,0x38,0x37,0x37,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2c,0x2b,0x29,0x28,0x27,0x27,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1d,0x1c,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x14,0x14,0x13,0x12,0x11,0x11,0x10,0xf,0xe,0xd,0xd,0xc,0xa,0x9,0x9,0x8,0x7,0x6,0x5,0x4,0x4,0x3,0x2,0x1,0x0,0xff,0xfe,0xfe,0xfc,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf7,0xf6,0xf4,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd6,0xd5,0xd4,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc6,0xc5,0xc4,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb6,0xb5,0xb4,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xac,0xac,0xab,0xaa,0xaa,0xa9,0xa8,0xa5,0xa2,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x97,0x96,0x96,0x95,0x94,0x91,0x90,0x8f,0x8e,0x8c,0x8c,0x8b,0x8a,0x88,0x87,0x86,0x85,0x84,0x82,0x81,0x80,0x7f,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x6f,0x6c,0x6b,0x6a,0x6a,0x69,0x68,0x67,0x66,0x64,0x63,0x62,0x62,0x61,0x60,0x5e,0x5b,0x58,0x57,0x56,0x56,0x55,0x54,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x48,0x47,0x46,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x38,0x37,0x36,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x28,0x27,0x26,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x17,0x16,0x14,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x7,0x6,0x5,0x4,0x4,0x2,0x2,0x1,0x0,0xff,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf1,0xf0,0xef,0xef,0xee,0xed,0xec,0xeb,0xea,0xe8,0xe7,0xe7,0xe6,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd6,0xd5,0xd3,0xd2,0xd0,0xcf,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc6,0xc5,0xc3,0xc2,0xc0,0xbf,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb6,0xb5,0xb3,0xb2,0xb0,0xaf,0xaf,0xae,0xad,0xad,0xac,0xab,0xaa,0xaa,0xa9,0xa6,0xa3,0xa0,0x9f,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x98,0x97,0x97,0x96,0x95,0x94,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x88,0x87,0x86,0x85,0x84,0x83,0x81,0x80,0x7f,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5d,0x5a,0x57,0x56,0x56,0x55,0x54,0x53,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4b,0x4a,0x48,0x47,0x46,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3b,0x3a,0x38,0x37,0x36,0x36,0x35,0x34,0x33,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2b,0x2a,0x28,0x27,0x26,0x26,0x25,0x24,0x23,0x23,0x22,0x21,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xf,0xe,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x8,0x7,0x6,0x5,0x4,0x3,0x2,0x2,0x1,0x0,0xff,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xdb,0xda,0xd9,0xd7,0xd6,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xcb,0xca,0xc9,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xbb,0xba,0xb9,0xb7,0xb6,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xae,0xad,0xad,0xac,0xab,0xab,0xaa,0xa7,0xa4,0xa2,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x97,0x96,0x96,0x95,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x8a,0x88,0x87,0x86,0x85,0x84,0x83,0x81,0x80,0x7f,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x70,0x6e,0x6b,0x6a,0x6a,0x69,0x68,0x67,0x66,0x64,0x63,0x62,0x62,0x61,0x60,0x5e,0x5c,0x59,0x56,0x55,0x55,0x54,0x53,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4a,0x49,0x47,0x46,0x45,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x37,0x36,0x35,0x35,0x34,0x33,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2a,0x29,0x27,0x26,0x25,0x25,0x24,0x23,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x17,0x16,0x14,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x7,0x7,0x6,0x5,0x4,0x3,0x2,0x2,0x1,0x0,0xff,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf7,0xf7,0xf5,0xf4,0xf3,0xf3,0xf2,0xf1,0xf1,0xf0,0xef,0xef,0xee,0xee,0xec,0xeb,0xea,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xce,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xae,0xae,0xad,0xac,0xac,0xab,0xa8,0xa6,0xa3,0xa0,0x9f,0x9f,0x9e,0x9e,0x9c,0x9b,0x9a,0x98,0x97,0x97,0x96,0x96,0x93,0x90,0x8f,0x8f,0x8d,0x8c,0x8b,0x8b,0x88,0x87,0x86,0x85,0x84,0x83,0x81,0x80,0x7f,0x7d,0x7c,0x7b,0x7a,0x79,0x78,0x75,0x75,0x74,0x73,0x71,0x71,0x70,0x6d,0x6a,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x62,0x62,0x61,0x61,0x60,0x5d,0x5a,0x58,0x55,0x54,0x54,0x53,0x52,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4a,0x49,0x48,0x46,0x45,0x44,0x44,0x43,0x42,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x34,0x33,0x32,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2a,0x29,0x28,0x26,0x25,0x24,0x24,0x23,0x22,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x12,0x12,0x11,0x11,0x10,0xf,0xf,0xe,0xd,0xd,0xc,0xb,0x9,0x9,0x8,0x7,0x7,0x6,0x5,0x4,0x3,0x2,0x2,0x1,0x0,0xff,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf6,0xf4,0xf4,0xf3,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xef,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe7,0xe7,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xde,0xdd,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xaf,0xae,0xae,0xad,0xad,0xac,0xaa,0xa7,0xa4,0xa2,0xa0,0x9f,0x9f,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x97,0x97,0x96,0x94,0x91,0x90,0x8f,0x8e,0x8c,0x8c,0x8b,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x7a,0x78,0x78,0x75,0x74,0x74,0x72,0x71,0x70,0x6f,0x6c,0x6a,0x69,0x69,0x68,0x67,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5c,0x59,0x56,0x54,0x53,0x53,0x52,0x52,0x51,0x50,0x4f,0x4e,0x4d,0x4c,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x43,0x42,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x33,0x32,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x23,0x22,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x19,0x19,0x18,0x17,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xf,0xe,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x7,0x6,0x6,0x4,0x4,0x3,0x2,0x2,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfc,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xdf,0xdf,0xde,0xde,0xdd,0xdc,0xdb,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd2,0xd1,0xd0,0xcf,0xcf,0xce,0xce,0xcd,0xcc,0xcb,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbf,0xbe,0xbe,0xbd,0xbc,0xbb,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb2,0xb1,0xb0,0xaf,0xaf,0xae,0xae,0xad,0xab,0xa8,0xa6,0xa4,0xa1,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x97,0x96,0x94,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8b,0x89,0x88,0x86,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x7a,0x78,0x77,0x75,0x74,0x73,0x72,0x71,0x70,0x6e,0x6c,0x6a,0x69,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5f,0x5c,0x5a,0x58,0x55,0x53,0x52,0x52,0x51,0x51,0x50,0x4f,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x45,0x44,0x43,0x42,0x42,0x41,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x35,0x34,0x33,0x32,0x32,0x31,0x31,0x30,0x2f,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x25,0x24,0x23,0x22,0x22,0x21,0x21,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xf,0xe,0xd,0xd,0xc,0xb,0xa,0x9,0x8,0x8,0x7,0x6,0x6,0x4,0x4,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfc,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe8,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xe0,0xdf,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb0,0xb0,0xaf,0xaf,0xae,0xac,0xaa,0xa7,0xa5,0xa3,0xa0,0xa0,0x9f,0x9e,0x9d,0x9c,0x9a,0x99,0x98,0x98,0x97,0x95,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x89,0x88,0x87,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x79,0x78,0x77,0x74,0x74,0x73,0x72,0x70,0x70,0x6e,0x6b,0x69,0x68,0x68,0x67,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5d,0x5b,0x59,0x56,0x54,0x52,0x51,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x18,0x18,0x17,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xf,0xe,0xe,0xd,0xc,0xc,0xb,0xa,0x8,0x8,0x7,0x7,0x6,0x5,0x4,0x4,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfc,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xab,0xa9,0xa6,0xa4,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x95,0x93,0x91,0x90,0x8f,0x8e,0x8d,0x8c,0x8a,0x88,0x87,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x79,0x78,0x76,0x74,0x73,0x72,0x71,0x70,0x6f,0x6d,0x6b,0x68,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x60,0x60,0x5e,0x5c,0x5a,0x57,0x55,0x53,0x51,0x50,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x18,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x10,0x10,0xf,0xf,0xe,0xe,0xd,0xd,0xc,0xb,0xa,0x9,0x8,0x8,0x7,0x7,0x6,0x5,0x4,0x4,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfc,0xfc,0xfb,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf4,0xf3,0xf2,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe7,0xe6,0xe6,0xe6,0xe5,0xe4,0xe4,0xe4,0xe3,0xe2,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb0,0xae,0xac,0xaa,0xa8,0xa6,0xa4,0xa2,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x96,0x94,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8a,0x88,0x87,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x79,0x78,0x76,0x74,0x73,0x72,0x71,0x70,0x6e,0x6c,0x6a,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5e,0x5c,0x5a,0x58,0x56,0x54,0x52,0x50,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1e,0x1d,0x1c,0x1c,0x1c,0x1b,0x1a,0x1a,0x1a,0x19,0x18,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xe,0xd,0xc,0xc,0xb,0xa,0x9,0x8,0x8,0x7,0x6,0x6,0x5,0x4,0x4,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xaf,0xad,0xab,0xa9,0xa7,0xa5,0xa3,0xa1,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x98,0x96,0x94,0x92,0x90,0x8f,0x8e,0x8d,0x8c,0x8a,0x88,0x87,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x79,0x78,0x76,0x74,0x73,0x72,0x71,0x70,0x6e,0x6c,0x6a,0x68,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x5f,0x5d,0x5b,0x59,0x57,0x55,0x53,0x51,0x4f,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0xf,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xa,0x9,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x3,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb2,0xaf,0xad,0xab,0xaa,0xa8,0xa6,0xa4,0xa2,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x97,0x95,0x93,0x91,0x8f,0x8e,0x8d,0x8c,0x8a,0x88,0x87,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x79,0x78,0x76,0x74,0x73,0x72,0x71,0x6f,0x6d,0x6b,0x69,0x67,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5e,0x5c,0x5a,0x58,0x56,0x55,0x53,0x51,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x47,0x46,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xa,0x9,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x3,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf7,0xf7,0xf6,0xf5,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb3,0xb0,0xae,0xac,0xaa,0xa8,0xa7,0xa5,0xa3,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x97,0x95,0x93,0x92,0x8f,0x8f,0x8e,0x8d,0x8b,0x89,0x87,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x79,0x77,0x75,0x73,0x72,0x71,0x71,0x6e,0x6d,0x6b,0x69,0x66,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5d,0x5b,0x59,0x58,0x56,0x54,0x52,0x50,0x4d,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x18,0x17,0x16,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0xf,0xf,0xe,0xe,0xd,0xd,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x3,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xef,0xee,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xde,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd4,0xd2,0xd0,0xcf,0xce,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc4,0xc2,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb4,0xb0,0xae,0xad,0xab,0xa9,0xa8,0xa6,0xa4,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x9b,0x9a,0x97,0x96,0x94,0x92,0x90,0x8f,0x8e,0x8d,0x8b,0x89,0x87,0x86,0x84,0x83,0x82,0x80,0x7e,0x7d,0x7c,0x7a,0x79,0x77,0x75,0x73,0x72,0x71,0x70,0x6e,0x6c,0x6a,0x69,0x66,0x65,0x64,0x63,0x62,0x62,0x61,0x60,0x5c,0x5a,0x58,0x57,0x55,0x53,0x52,0x50,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x42,0x42,0x41,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x32,0x31,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x22,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x12,0x11,0x10,0xf,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xa,0x9,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x3,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfb,0xfb,0xfa,0xf9,0xf9,0xf9,0xf8,0xf7,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd3,0xd2,0xd0,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc3,0xc2,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb5,0xb2,0xaf,0xad,0xac,0xaa,0xa8,0xa7,0xa5,0xa2,0xa0,0x9f,0x9e,0x9d,0x9c,0x9b,0x9a,0x98,0x96,0x94,0x92,0x90,0x8f,0x8e,0x8d,0x8b,0x89,0x87,0x87,0x85,0x83,0x82,0x80,0x7e,0x7d,0x7b,0x79,0x79,0x77,0x75,0x73,0x72,0x71,0x70,0x6e,0x6c,0x6a,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x60,0x5e,0x5b,0x59,0x58,0x56,0x54,0x53,0x51,0x4e,0x4b,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x3e,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x33,0x32,0x31,0x30,0x2e,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x23,0x22,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xb,0xa,0x9,0x8,0x7,0x7,0x7,0x6,0x5,0x5,0x4,0x3,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf7,0xf6,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf0,0xef,0xee,0xed,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe1,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd4,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc4,0xc3,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb7,0xb6,0xb3,0xb0,0xae,0xac,0xab,0xa9,0xa8,0xa6,0xa3,0xa0,0x9f,0x9e,0x9d,0x9d,0x9c,0x9b,0x98,0x96,0x95,0x93,0x90,0x8f,0x8e,0x8e,0x8b,0x8a,0x88,0x87,0x85,0x83,0x82,0x80,0x7e,0x7d,0x7b,0x79,0x78,0x76,0x75,0x72,0x72,0x71,0x70,0x6d,0x6b,0x6a,0x68,0x65,0x64,0x63,0x63,0x62,0x61,0x60,0x5d,0x5a,0x58,0x57,0x55,0x54,0x52,0x50,0x4d,0x4a,0x49,0x48,0x48,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3d,0x3c,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2c,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x1f,0x1f,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x17,0x17,0x16,0x15,0x14,0x13,0x13,0x12,0x11,0x10,0xf,0xe,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xa,0xa,0x9,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x4,0x3,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf7,0xf7,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf1,0xf0,0xef,0xef,0xee,0xed,0xec,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe5,0xe4,0xe4,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd7,0xd5,0xd4,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc7,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb7,0xb4,0xb1,0xaf,0xad,0xac,0xaa,0xa9,0xa7,0xa4,0xa1,0x9f,0x9f,0x9e,0x9d,0x9c,0x9c,0x98,0x97,0x95,0x94,0x90,0x8f,0x8f,0x8e,0x8b,0x8a,0x88,0x87,0x85,0x83,0x82,0x80,0x7e,0x7d,0x7b,0x79,0x78,0x76,0x75,0x72,0x71,0x71,0x70,0x6c,0x6b,0x69,0x68,0x64,0x64,0x63,0x62,0x61,0x61,0x5f,0x5c,0x59,0x57,0x56,0x54,0x53,0x51,0x4f,0x4c,0x49,0x48,0x47,0x47,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x39,0x38,0x37,0x37,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2c,0x2b,0x29,0x28,0x27,0x27,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1d,0x1c,0x1c,0x1c,0x1b,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x15,0x14,0x14,0x13,0x12,0x11,0x11,0x10,0xf,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xb,0xa,0x9,0x9,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x4,0x3,0x3,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfe,0xfd,0xfc,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd6,0xd5,0xd4,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc6,0xc5,0xc4,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbc,0xbc,0xbb,0xba,0xba,0xb9,0xb8,0xb5,0xb2,0xb0,0xae,0xac,0xab,0xaa,0xa8,0xa5,0xa2,0xa0,0x9f,0x9e,0x9e,0x9d,0x9c,0x99,0x97,0x96,0x94,0x91,0x90,0x8f,0x8e,0x8c,0x8a,0x88,0x87,0x85,0x84,0x82,0x80,0x7e,0x7c,0x7b,0x79,0x78,0x76,0x74,0x72,0x71,0x70,0x6f,0x6c,0x6a,0x69,0x67,0x64,0x63,0x62,0x62,0x61,0x60,0x5e,0x5b,0x58,0x56,0x55,0x54,0x52,0x50,0x4e,0x4b,0x48,0x47,0x46,0x46,0x45,0x44,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x38,0x37,0x36,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x28,0x27,0x26,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xc,0xb,0xa,0x9,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x4,0x3,0x2,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfc,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf7,0xf6,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf1,0xf0,0xef,0xef,0xee,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd6,0xd5,0xd3,0xd2,0xd0,0xcf,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc6,0xc5,0xc3,0xc2,0xc0,0xbf,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xba,0xba,0xb9,0xb6,0xb3,0xb0,0xaf,0xad,0xac,0xaa,0xa9,0xa6,0xa3,0xa0,0x9f,0x9f,0x9e,0x9d,0x9c,0x9a,0x97,0x96,0x94,0x92,0x90,0x8f,0x8e,0x8c,0x8a,0x88,0x87,0x85,0x84,0x82,0x80,0x7e,0x7c,0x7b,0x79,0x78,0x76,0x74,0x72,0x71,0x70,0x6e,0x6c,0x6a,0x69,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x5d,0x5a,0x57,0x56,0x54,0x53,0x51,0x50,0x4d,0x4a,0x47,0x46,0x46,0x45,0x44,0x43,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3b,0x3a,0x38,0x37,0x36,0x36,0x35,0x34,0x33,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2b,0x2a,0x28,0x27,0x26,0x26,0x25,0x24,0x23,0x23,0x22,0x21,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x12,0x11,0x11,0x10,0xf,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xb,0xa,0xa,0x9,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x4,0x3,0x3,0x2,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf7,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xdb,0xda,0xd9,0xd7,0xd6,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xcb,0xca,0xc9,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbd,0xbd,0xbc,0xbb,0xbb,0xba,0xb7,0xb4,0xb2,0xb0,0xae,0xad,0xab,0xaa,0xa7,0xa4,0xa2,0xa0,0x9f,0x9e,0x9e,0x9d,0x9a,0x98,0x96,0x95,0x92,0x90,0x8f,0x8e,0x8c,0x8a,0x88,0x87,0x85,0x84,0x82,0x80,0x7e,0x7c,0x7b,0x79,0x78,0x76,0x74,0x72,0x71,0x70,0x6e,0x6b,0x6a,0x68,0x66,0x63,0x62,0x62,0x61,0x60,0x5e,0x5c,0x59,0x56,0x55,0x53,0x52,0x50,0x4e,0x4c,0x49,0x46,0x45,0x45,0x44,0x43,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x37,0x36,0x35,0x35,0x34,0x33,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2a,0x29,0x27,0x26,0x25,0x25,0x24,0x23,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0x9,0x8,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x3,0x3,0x2,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf7,0xf7,0xf6,0xf5,0xf5,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf1,0xf1,0xf0,0xef,0xef,0xee,0xee,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe6,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xce,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbe,0xbe,0xbd,0xbc,0xbc,0xbb,0xb8,0xb6,0xb3,0xb0,0xaf,0xae,0xac,0xab,0xa8,0xa6,0xa3,0xa0,0x9f,0x9f,0x9e,0x9e,0x9b,0x98,0x97,0x96,0x93,0x90,0x8f,0x8f,0x8c,0x8b,0x88,0x87,0x85,0x84,0x82,0x80,0x7e,0x7c,0x7b,0x79,0x78,0x75,0x74,0x71,0x71,0x70,0x6d,0x6a,0x69,0x68,0x65,0x62,0x62,0x61,0x61,0x60,0x5d,0x5a,0x58,0x55,0x54,0x52,0x51,0x50,0x4d,0x4a,0x48,0x45,0x44,0x44,0x43,0x42,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x34,0x33,0x32,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2a,0x29,0x28,0x26,0x25,0x24,0x24,0x23,0x22,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1a,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x12,0x12,0x11,0x11,0x10,0xf,0xf,0xe,0xd,0xd,0xd,0xc,0xc,0xb,0xb,0xa,0x9,0x9,0x8,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x3,0x3,0x2,0x2,0x1,0x1,0x0,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfa,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xef,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xde,0xdd,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xce,0xcd,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xbf,0xbe,0xbe,0xbd,0xbd,0xbc,0xba,0xb7,0xb4,0xb2,0xb0,0xae,0xad,0xac,0xaa,0xa7,0xa4,0xa2,0xa0,0x9f,0x9f,0x9e,0x9c,0x99,0x97,0x96,0x94,0x91,0x90,0x8f,0x8c,0x8b,0x88,0x88,0x86,0x84,0x82,0x80,0x7e,0x7c,0x7a,0x78,0x78,0x75,0x74,0x71,0x70,0x6f,0x6c,0x6a,0x69,0x67,0x64,0x62,0x61,0x61,0x60,0x5e,0x5c,0x59,0x56,0x54,0x53,0x52,0x50,0x4e,0x4c,0x49,0x46,0x44,0x43,0x43,0x42,0x42,0x41,0x40,0x3f,0x3e,0x3d,0x3c,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x33,0x32,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x23,0x22,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x11,0x11,0x10,0x10,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0x9,0x8,0x8,0x7,0x6,0x6,0x6,0x5,0x4,0x4,0x3,0x3,0x2,0x2,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfa,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf7,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xdf,0xdf,0xde,0xde,0xdd,0xdc,0xdb,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd2,0xd1,0xd0,0xcf,0xcf,0xce,0xce,0xcd,0xcc,0xcb,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc2,0xc1,0xc0,0xbf,0xbf,0xbe,0xbe,0xbd,0xbb,0xb8,0xb6,0xb4,0xb1,0xaf,0xae,0xad,0xab,0xa8,0xa6,0xa4,0xa1,0xa0,0x9f,0x9e,0x9c,0x9a,0x98,0x96,0x94,0x92,0x90,0x8f,0x8d,0x8b,0x89,0x88,0x86,0x84,0x82,0x80,0x7e,0x7c,0x7a,0x78,0x77,0x75,0x73,0x71,0x70,0x6e,0x6c,0x6a,0x68,0x66,0x64,0x62,0x61,0x60,0x5f,0x5c,0x5a,0x58,0x55,0x53,0x52,0x51,0x4f,0x4c,0x4a,0x48,0x45,0x43,0x42,0x42,0x41,0x41,0x40,0x3f,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x35,0x34,0x33,0x32,0x32,0x31,0x31,0x30,0x2f,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x25,0x24,0x23,0x22,0x22,0x21,0x21,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xf,0xe,0xd,0xd,0xc,0xc,0xc,0xb,0xb,0xa,0x9,0x9,0x8,0x8,0x7,0x6,0x6,0x6,0x5,0x4,0x4,0x3,0x3,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf7,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe9,0xe8,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xe0,0xdf,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc0,0xc0,0xbf,0xbf,0xbe,0xbc,0xba,0xb7,0xb5,0xb3,0xb0,0xaf,0xae,0xac,0xaa,0xa7,0xa5,0xa3,0xa0,0xa0,0x9f,0x9d,0x9a,0x98,0x97,0x95,0x92,0x90,0x90,0x8d,0x8c,0x89,0x88,0x86,0x84,0x82,0x80,0x7e,0x7c,0x7a,0x78,0x77,0x74,0x73,0x70,0x70,0x6e,0x6b,0x69,0x68,0x66,0x63,0x61,0x60,0x60,0x5d,0x5b,0x59,0x56,0x54,0x52,0x51,0x50,0x4d,0x4b,0x49,0x46,0x44,0x42,0x41,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x18,0x17,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xf,0xe,0xe,0xd,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0x9,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x3,0x3,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xea,0xe9,0xe9,0xe8,0xe8,0xe8,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbb,0xb9,0xb6,0xb4,0xb2,0xb0,0xaf,0xad,0xab,0xa9,0xa6,0xa4,0xa2,0xa0,0xa0,0x9d,0x9b,0x99,0x98,0x95,0x93,0x91,0x90,0x8e,0x8c,0x8a,0x88,0x86,0x84,0x82,0x80,0x7e,0x7c,0x7a,0x78,0x76,0x74,0x72,0x70,0x6f,0x6d,0x6b,0x68,0x67,0x65,0x63,0x60,0x60,0x5e,0x5c,0x5a,0x57,0x55,0x53,0x51,0x50,0x4e,0x4c,0x4a,0x47,0x45,0x43,0x41,0x40,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x18,0x18,0x18,0x17,0x17,0x16,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x3,0x3,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfe,0xfd,0xfc,0xfc,0xfc,0xfb,0xfa,0xfa,0xfa,0xf9,0xf8,0xf8,0xf8,0xf7,0xf6,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf2,0xf2,0xf2,0xf1,0xf0,0xf0,0xf0,0xef,0xee,0xee,0xee,0xed,0xec,0xec,0xec,0xeb,0xea,0xea,0xea,0xe9,0xe8,0xe8,0xe8,0xe7,0xe6,0xe6,0xe6,0xe5,0xe4,0xe4,0xe4,0xe3,0xe2,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc0,0xbe,0xbc,0xba,0xb8,0xb6,0xb4,0xb2,0xb0,0xae,0xac,0xaa,0xa8,0xa6,0xa4,0xa2,0xa0,0x9e,0x9c,0x9a,0x98,0x96,0x94,0x92,0x90,0x8e,0x8c,0x8a,0x88,0x86,0x84,0x82,0x80,0x7e,0x7c,0x7a,0x78,0x76,0x74,0x72,0x70,0x6e,0x6c,0x6a,0x68,0x66,0x64,0x62,0x60,0x5e,0x5c,0x5a,0x58,0x56,0x54,0x52,0x50,0x4e,0x4c,0x4a,0x48,0x46,0x44,0x42,0x40,0x3f,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1e,0x1d,0x1c,0x1c,0x1c,0x1b,0x1a,0x1a,0x1a,0x19,0x18,0x18,0x18,0x17,0x16,0x16,0x16,0x15,0x14,0x14,0x14,0x13,0x12,0x12,0x12,0x11,0x10,0x10,0x10,0xf,0xe,0xe,0xe,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0xa,0x9,0x8,0x8,0x8,0x7,0x6,0x6,0x6,0x5,0x4,0x4,0x4,0x3,0x2,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xea,0xe9,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd0,0xcf,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc7,0xc6,0xc5,0xc4,0xc3,0xc2,0xbf,0xbd,0xbb,0xba,0xb8,0xb6,0xb4,0xb2,0xaf,0xad,0xab,0xaa,0xa8,0xa6,0xa4,0xa2,0x9f,0x9d,0x9b,0x99,0x97,0x95,0x93,0x91,0x8e,0x8c,0x8a,0x88,0x86,0x84,0x82,0x80,0x7e,0x7c,0x7a,0x78,0x76,0x74,0x72,0x6f,0x6d,0x6b,0x69,0x67,0x65,0x63,0x61,0x5e,0x5c,0x5a,0x58,0x56,0x55,0x53,0x51,0x4e,0x4c,0x4a,0x48,0x46,0x45,0x43,0x41,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x38,0x37,0x36,0x36,0x35,0x34,0x33,0x32,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x17,0x16,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x3,0x3,0x2,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xde,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd4,0xd2,0xd0,0xcf,0xce,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc5,0xc4,0xc0,0xbe,0xbd,0xbb,0xb9,0xb8,0xb6,0xb4,0xb0,0xae,0xad,0xab,0xa9,0xa8,0xa6,0xa4,0x9f,0x9e,0x9c,0x9a,0x97,0x96,0x94,0x92,0x8f,0x8d,0x8b,0x89,0x86,0x84,0x82,0x80,0x7e,0x7c,0x7a,0x77,0x75,0x73,0x71,0x6e,0x6c,0x6a,0x69,0x66,0x64,0x62,0x61,0x5c,0x5a,0x58,0x57,0x55,0x53,0x52,0x50,0x4c,0x4a,0x48,0x47,0x45,0x43,0x42,0x40,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x33,0x32,0x32,0x31,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x22,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x3,0x3,0x2,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xed,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xe9,0xe9,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe1,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd4,0xd3,0xd1,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc7,0xc6,0xc3,0xc0,0xbe,0xbc,0xbb,0xb9,0xb8,0xb6,0xb3,0xb0,0xae,0xac,0xab,0xa9,0xa8,0xa6,0xa0,0x9e,0x9d,0x9b,0x98,0x96,0x95,0x93,0x8f,0x8e,0x8b,0x8a,0x87,0x85,0x82,0x80,0x7e,0x7b,0x79,0x76,0x75,0x72,0x71,0x6d,0x6b,0x6a,0x68,0x65,0x63,0x62,0x60,0x5a,0x58,0x57,0x55,0x54,0x52,0x50,0x4d,0x4a,0x48,0x47,0x45,0x44,0x42,0x40,0x3d,0x3a,0x39,0x38,0x38,0x37,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x2f,0x2d,0x2c,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x1f,0x1f,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x17,0x17,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x13,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xc,0xc,0xb,0xa,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x2,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd6,0xd5,0xd4,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcc,0xcc,0xcb,0xca,0xca,0xc9,0xc8,0xc5,0xc2,0xc0,0xbe,0xbc,0xbb,0xba,0xb8,0xb5,0xb2,0xb0,0xae,0xac,0xab,0xaa,0xa8,0xa2,0x9f,0x9e,0x9c,0x99,0x97,0x96,0x94,0x90,0x8e,0x8c,0x8a,0x87,0x85,0x82,0x80,0x7e,0x7b,0x79,0x76,0x74,0x72,0x70,0x6c,0x6a,0x69,0x67,0x64,0x62,0x61,0x5e,0x58,0x56,0x55,0x54,0x52,0x50,0x4e,0x4b,0x48,0x46,0x45,0x44,0x42,0x40,0x3e,0x3b,0x38,0x37,0x36,0x36,0x35,0x34,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x28,0x27,0x26,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x4,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xdb,0xda,0xd9,0xd7,0xd6,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xce,0xcd,0xcd,0xcc,0xcb,0xcb,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbb,0xba,0xb7,0xb4,0xb2,0xb0,0xae,0xad,0xab,0xaa,0xa4,0xa0,0x9e,0x9d,0x9a,0x98,0x96,0x95,0x90,0x8e,0x8c,0x8a,0x87,0x85,0x83,0x80,0x7d,0x7b,0x79,0x76,0x74,0x72,0x70,0x6b,0x6a,0x68,0x66,0x63,0x62,0x60,0x5c,0x56,0x55,0x53,0x52,0x50,0x4e,0x4c,0x49,0x46,0x45,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x35,0x35,0x34,0x33,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2a,0x29,0x27,0x26,0x25,0x25,0x24,0x23,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfb,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xde,0xdd,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xcf,0xce,0xce,0xcd,0xcd,0xcc,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbc,0xba,0xb7,0xb4,0xb2,0xb0,0xae,0xad,0xac,0xa7,0xa2,0x9f,0x9e,0x9c,0x99,0x97,0x96,0x91,0x8f,0x8c,0x8b,0x88,0x86,0x83,0x80,0x7d,0x7a,0x78,0x75,0x74,0x71,0x6f,0x6a,0x69,0x67,0x64,0x62,0x61,0x5e,0x59,0x54,0x53,0x52,0x50,0x4e,0x4c,0x49,0x46,0x44,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x34,0x33,0x33,0x32,0x32,0x31,0x30,0x2f,0x2e,0x2d,0x2c,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x23,0x22,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x6,0x5,0x4,0x4,0x4,0x4,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf7,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe9,0xe8,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xe0,0xdf,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd0,0xd0,0xcf,0xcf,0xce,0xcc,0xca,0xc7,0xc5,0xc3,0xc0,0xbf,0xbe,0xbc,0xba,0xb7,0xb5,0xb3,0xb0,0xaf,0xae,0xaa,0xa5,0xa0,0x9f,0x9d,0x9a,0x98,0x97,0x92,0x90,0x8d,0x8c,0x88,0x86,0x83,0x80,0x7d,0x7a,0x78,0x74,0x73,0x70,0x6e,0x69,0x68,0x66,0x63,0x61,0x60,0x5b,0x56,0x52,0x51,0x50,0x4d,0x4b,0x49,0x46,0x44,0x42,0x41,0x40,0x3d,0x3b,0x39,0x36,0x34,0x32,0x31,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x18,0x17,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0x9,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x3,0x2,0x2,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf7,0xf6,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xee,0xee,0xee,0xed,0xec,0xec,0xec,0xeb,0xea,0xea,0xea,0xe9,0xe8,0xe8,0xe8,0xe7,0xe6,0xe6,0xe6,0xe5,0xe4,0xe4,0xe4,0xe3,0xe2,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd0,0xce,0xcc,0xca,0xc8,0xc6,0xc4,0xc2,0xc0,0xbe,0xbc,0xba,0xb8,0xb6,0xb4,0xb2,0xb0,0xac,0xa8,0xa4,0xa0,0x9e,0x9c,0x9a,0x98,0x94,0x90,0x8e,0x8c,0x88,0x86,0x83,0x80,0x7d,0x7a,0x78,0x74,0x72,0x70,0x6c,0x68,0x66,0x64,0x62,0x60,0x5c,0x58,0x54,0x50,0x4e,0x4c,0x4a,0x48,0x46,0x44,0x42,0x40,0x3e,0x3c,0x3a,0x38,0x36,0x34,0x32,0x30,0x2f,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1e,0x1d,0x1c,0x1c,0x1c,0x1b,0x1a,0x1a,0x1a,0x19,0x18,0x18,0x18,0x17,0x16,0x16,0x16,0x15,0x14,0x14,0x14,0x13,0x12,0x12,0x12,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xb,0xa,0xa,0xa,0x9,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x4,0x4,0x4,0x4,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xea,0xe9,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd7,0xd6,0xd5,0xd4,0xd3,0xd2,0xcf,0xcd,0xcb,0xca,0xc8,0xc6,0xc4,0xc2,0xbf,0xbd,0xbb,0xba,0xb8,0xb6,0xb4,0xb2,0xad,0xaa,0xa6,0xa2,0x9f,0x9d,0x9b,0x99,0x95,0x91,0x8e,0x8c,0x88,0x86,0x83,0x80,0x7d,0x7a,0x78,0x74,0x72,0x6f,0x6b,0x67,0x65,0x63,0x61,0x5e,0x5a,0x56,0x53,0x4e,0x4c,0x4a,0x48,0x46,0x45,0x43,0x41,0x3e,0x3c,0x3a,0x38,0x36,0x35,0x33,0x31,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x28,0x27,0x26,0x26,0x25,0x24,0x23,0x22,0x21,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x17,0x16,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf1,0xf0,0xf0,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe1,0xe0,0xdf,0xde,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd5,0xd4,0xd0,0xce,0xcd,0xcb,0xc9,0xc8,0xc6,0xc4,0xc0,0xbe,0xbd,0xbb,0xb9,0xb8,0xb6,0xb4,0xae,0xab,0xa8,0xa4,0x9f,0x9e,0x9c,0x9a,0x96,0x92,0x8f,0x8d,0x89,0x86,0x83,0x80,0x7d,0x7a,0x77,0x73,0x71,0x6e,0x6a,0x66,0x64,0x62,0x61,0x5c,0x58,0x55,0x52,0x4c,0x4a,0x48,0x47,0x45,0x43,0x42,0x40,0x3c,0x3a,0x38,0x37,0x35,0x33,0x32,0x30,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x23,0x22,0x22,0x21,0x20,0x1f,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x10,0x10,0xf,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xed,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xe9,0xe9,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe1,0xe1,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd7,0xd6,0xd3,0xd0,0xce,0xcc,0xcb,0xc9,0xc8,0xc6,0xc3,0xc0,0xbe,0xbc,0xbb,0xb9,0xb8,0xb6,0xb0,0xac,0xa9,0xa6,0xa0,0x9e,0x9d,0x9b,0x96,0x93,0x8f,0x8e,0x8a,0x87,0x83,0x80,0x7d,0x79,0x76,0x72,0x71,0x6d,0x6a,0x65,0x63,0x62,0x60,0x5a,0x57,0x54,0x50,0x4a,0x48,0x47,0x45,0x44,0x42,0x40,0x3d,0x3a,0x38,0x37,0x35,0x34,0x32,0x30,0x2d,0x2a,0x29,0x28,0x28,0x27,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x1f,0x1f,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x17,0x17,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x13,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0xf,0xf,0xe,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdc,0xdc,0xdb,0xda,0xda,0xd9,0xd8,0xd5,0xd2,0xd0,0xce,0xcc,0xcb,0xca,0xc8,0xc5,0xc2,0xc0,0xbe,0xbc,0xbb,0xba,0xb8,0xb2,0xae,0xab,0xa8,0xa2,0x9f,0x9e,0x9c,0x97,0x94,0x90,0x8e,0x8a,0x87,0x84,0x80,0x7c,0x79,0x76,0x72,0x70,0x6c,0x69,0x64,0x62,0x61,0x5e,0x58,0x55,0x52,0x4e,0x48,0x46,0x45,0x44,0x42,0x40,0x3e,0x3b,0x38,0x36,0x35,0x34,0x32,0x30,0x2e,0x2b,0x28,0x27,0x26,0x26,0x25,0x24,0x24,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xdd,0xdd,0xdc,0xdb,0xdb,0xda,0xd7,0xd4,0xd2,0xd0,0xce,0xcd,0xcb,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbb,0xba,0xb4,0xb0,0xad,0xaa,0xa4,0xa0,0x9e,0x9d,0x98,0x95,0x90,0x8e,0x8a,0x87,0x84,0x80,0x7c,0x79,0x76,0x72,0x70,0x6b,0x68,0x63,0x62,0x60,0x5c,0x56,0x53,0x50,0x4c,0x46,0x45,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x35,0x33,0x32,0x30,0x2e,0x2c,0x29,0x26,0x25,0x25,0x24,0x23,0x23,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xde,0xde,0xdd,0xdd,0xdc,0xda,0xd7,0xd4,0xd2,0xd0,0xce,0xcd,0xcc,0xca,0xc7,0xc4,0xc2,0xc0,0xbe,0xbd,0xbc,0xb7,0xb2,0xae,0xac,0xa7,0xa2,0x9f,0x9e,0x99,0x96,0x91,0x8f,0x8b,0x88,0x84,0x80,0x7c,0x78,0x75,0x71,0x6f,0x6a,0x67,0x62,0x61,0x5e,0x59,0x54,0x52,0x4e,0x49,0x44,0x43,0x42,0x40,0x3e,0x3c,0x39,0x36,0x34,0x33,0x32,0x30,0x2e,0x2c,0x29,0x26,0x24,0x23,0x23,0x22,0x22,0x21,0x20,0x20,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0x9,0x9,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe9,0xe8,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe2,0xe2,0xe1,0xe1,0xe0,0xe0,0xdf,0xdf,0xde,0xdc,0xda,0xd7,0xd5,0xd3,0xd0,0xcf,0xce,0xcc,0xca,0xc7,0xc5,0xc3,0xc0,0xbf,0xbe,0xba,0xb5,0xb0,0xae,0xaa,0xa5,0xa0,0x9f,0x9a,0x97,0x92,0x90,0x8c,0x88,0x84,0x80,0x7c,0x78,0x74,0x70,0x6e,0x69,0x66,0x61,0x60,0x5b,0x56,0x52,0x50,0x4b,0x46,0x42,0x41,0x40,0x3d,0x3b,0x39,0x36,0x34,0x32,0x31,0x30,0x2d,0x2b,0x29,0x26,0x24,0x22,0x21,0x21,0x20,0x20,0x1f,0x1f,0x1e,0x1e,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x19,0x19,0x18,0x18,0x18,0x17,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xee,0xee,0xee,0xed,0xec,0xec,0xec,0xeb,0xea,0xea,0xea,0xe9,0xe8,0xe8,0xe8,0xe7,0xe6,0xe6,0xe6,0xe5,0xe4,0xe4,0xe4,0xe3,0xe2,0xe2,0xe2,0xe1,0xe0,0xe0,0xde,0xdc,0xda,0xd8,0xd6,0xd4,0xd2,0xd0,0xce,0xcc,0xca,0xc8,0xc6,0xc4,0xc2,0xc0,0xbc,0xb8,0xb4,0xb0,0xac,0xa8,0xa4,0xa0,0x9c,0x98,0x94,0x90,0x8c,0x88,0x84,0x80,0x7c,0x78,0x74,0x70,0x6c,0x68,0x64,0x60,0x5c,0x58,0x54,0x50,0x4c,0x48,0x44,0x40,0x3e,0x3c,0x3a,0x38,0x36,0x34,0x32,0x30,0x2e,0x2c,0x2a,0x28,0x26,0x24,0x22,0x20,0x20,0x1f,0x1e,0x1e,0x1e,0x1d,0x1c,0x1c,0x1c,0x1b,0x1a,0x1a,0x1a,0x19,0x18,0x18,0x18,0x17,0x16,0x16,0x16,0x15,0x14,0x14,0x14,0x13,0x12,0x12,0x12,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf1,0xf0,0xf0,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe3,0xe3,0xe2,0xe2,0xe0,0xde,0xdd,0xdb,0xd9,0xd8,0xd6,0xd4,0xd0,0xce,0xcd,0xcb,0xc9,0xc8,0xc6,0xc4,0xbe,0xbb,0xb8,0xb4,0xae,0xab,0xa8,0xa4,0x9e,0x9a,0x96,0x92,0x8d,0x89,0x84,0x80,0x7c,0x77,0x73,0x6e,0x6a,0x66,0x62,0x5c,0x58,0x55,0x52,0x4c,0x48,0x45,0x42,0x3c,0x3a,0x38,0x37,0x35,0x33,0x32,0x30,0x2c,0x2a,0x28,0x27,0x25,0x23,0x22,0x20,0x1e,0x1e,0x1d,0x1d,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x10,0x10,0xf,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xb,0xb,0xb,0xb,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe6,0xe5,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xde,0xdc,0xdb,0xda,0xd8,0xd5,0xd2,0xd0,0xce,0xcc,0xcb,0xca,0xc8,0xc2,0xbe,0xbb,0xb8,0xb2,0xae,0xab,0xa8,0x9f,0x9c,0x97,0x94,0x8e,0x8a,0x85,0x80,0x7b,0x76,0x72,0x6c,0x69,0x64,0x61,0x58,0x55,0x52,0x4e,0x48,0x45,0x42,0x3e,0x38,0x36,0x35,0x34,0x32,0x30,0x2e,0x2b,0x28,0x26,0x25,0x24,0x22,0x20,0x1f,0x1e,0x1c,0x1c,0x1b,0x1b,0x1a,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xea,0xea,0xe9,0xe8,0xe8,0xe8,0xe7,0xe7,0xe7,0xe6,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdd,0xdc,0xda,0xd7,0xd4,0xd2,0xd0,0xce,0xcd,0xcc,0xc7,0xc2,0xbe,0xbc,0xb7,0xb2,0xae,0xac,0xa2,0x9e,0x99,0x96,0x8f,0x8b,0x86,0x80,0x7a,0x75,0x71,0x6a,0x67,0x62,0x5e,0x54,0x52,0x4e,0x49,0x44,0x42,0x3e,0x39,0x34,0x33,0x32,0x30,0x2e,0x2c,0x29,0x26,0x24,0x23,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x1a,0x19,0x19,0x19,0x18,0x18,0x18,0x17,0x16,0x16,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xee,0xee,0xee,0xed,0xec,0xec,0xec,0xeb,0xea,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xde,0xdc,0xda,0xd8,0xd6,0xd4,0xd2,0xd0,0xcc,0xc8,0xc4,0xc0,0xbc,0xb8,0xb4,0xb0,0xa8,0xa0,0x9c,0x98,0x90,0x8c,0x86,0x80,0x7a,0x74,0x70,0x68,0x64,0x60,0x58,0x50,0x4c,0x48,0x44,0x40,0x3c,0x38,0x34,0x30,0x2e,0x2c,0x2a,0x28,0x26,0x24,0x22,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x17,0x16,0x16,0x16,0x15,0x14,0x14,0x14,0x13,0x12,0x12,0x12,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf1,0xf0,0xf0,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xeb,0xeb,0xea,0xea,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe3,0xe2,0xe0,0xde,0xdd,0xdb,0xd9,0xd8,0xd6,0xd4,0xce,0xcb,0xc8,0xc4,0xbe,0xbb,0xb8,0xb4,0xab,0xa4,0x9e,0x9a,0x92,0x8d,0x86,0x80,0x7a,0x73,0x6e,0x66,0x62,0x5c,0x55,0x4c,0x48,0x45,0x42,0x3c,0x38,0x35,0x32,0x2c,0x2a,0x28,0x27,0x25,0x23,0x22,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x16,0x16,0x15,0x15,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x10,0x10,0xf,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xb,0xb,0xb,0xb,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xee,0xed,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdc,0xdb,0xda,0xd8,0xd2,0xce,0xcb,0xc8,0xc2,0xbe,0xbb,0xb8,0xae,0xa8,0x9f,0x9c,0x94,0x8e,0x87,0x80,0x79,0x72,0x6c,0x64,0x61,0x58,0x52,0x48,0x45,0x42,0x3e,0x38,0x35,0x32,0x2e,0x28,0x26,0x25,0x24,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x17,0x16,0x14,0x14,0x13,0x13,0x12,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0xf,0xf,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xf0,0xef,0xef,0xef,0xee,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe7,0xe7,0xe6,0xe5,0xe4,0xe2,0xe1,0xe0,0xde,0xdd,0xdc,0xd7,0xd2,0xce,0xcc,0xc7,0xc2,0xbe,0xbc,0xb2,0xac,0xa2,0x9e,0x96,0x8f,0x88,0x80,0x78,0x71,0x6a,0x62,0x5e,0x54,0x4e,0x44,0x42,0x3e,0x39,0x34,0x32,0x2e,0x29,0x24,0x23,0x22,0x20,0x1f,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x17,0x16,0x14,0x13,0x12,0x12,0x11,0x11,0x11,0x10,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf2,0xf2,0xf1,0xf1,0xf1,0xf0,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe7,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe0,0xdc,0xd8,0xd4,0xd0,0xcc,0xc8,0xc4,0xc0,0xb8,0xb0,0xa8,0xa0,0x98,0x90,0x88,0x80,0x78,0x70,0x68,0x60,0x58,0x50,0x48,0x40,0x3c,0x38,0x34,0x30,0x2c,0x28,0x24,0x20,0x1f,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0x10,0xf,0xf,0xf,0xe,0xe,0xe,0xe,0xe,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf4,0xf3,0xf3,0xf3,0xf3,0xf3,0xf2,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xec,0xea,0xe9,0xe8,0xe7,0xe6,0xe6,0xe5,0xe4,0xe1,0xde,0xdb,0xd8,0xd2,0xce,0xcb,0xc8,0xbe,0xb8,0xae,0xa8,0x9c,0x94,0x8a,0x80,0x76,0x6c,0x64,0x58,0x52,0x48,0x42,0x38,0x35,0x32,0x2e,0x28,0x25,0x22,0x1f,0x1c,0x1b,0x1a,0x1a,0x19,0x18,0x17,0x16,0x14,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xe,0xd,0xd,0xd,0xd,0xd,0xc,0xc,0xc,0xc,0xc,0xb,0xb,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf6,0xf6,0xf5,0xf5,0xf5,0xf4,0xf4,0xf4,0xf4,0xf3,0xf2,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe8,0xe6,0xe4,0xe2,0xe0,0xdc,0xd8,0xd4,0xd0,0xc8,0xc0,0xb8,0xb0,0xa0,0x98,0x8c,0x80,0x74,0x68,0x60,0x50,0x48,0x40,0x38,0x30,0x2c,0x28,0x24,0x20,0x1e,0x1c,0x1a,0x18,0x17,0x16,0x15,0x14,0x13,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xe,0xd,0xc,0xc,0xc,0xc,0xb,0xb,0xb,0xa,0xa,0xa,0xa,0xa,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf7,0xf7,0xf7,0xf7,0xf6,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf3,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xee,0xee,0xed,0xec,0xe9,0xe7,0xe6,0xe4,0xe1,0xde,0xdb,0xd8,0xce,0xc8,0xbe,0xb8,0xa8,0x9c,0x8e,0x80,0x72,0x64,0x58,0x48,0x42,0x38,0x32,0x28,0x25,0x22,0x1f,0x1c,0x1a,0x19,0x17,0x14,0x13,0x12,0x12,0x11,0x10,0x10,0xf,0xe,0xe,0xd,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0xa,0x9,0x9,0x9,0x9,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf8,0xf8,0xf7,0xf6,0xf6,0xf6,0xf5,0xf4,0xf4,0xf4,0xf3,0xf2,0xf2,0xf2,0xf1,0xf0,0xf0,0xee,0xec,0xea,0xe8,0xe6,0xe4,0xe2,0xe0,0xd8,0xd0,0xc8,0xc0,0xb0,0xa0,0x90,0x80,0x70,0x60,0x50,0x40,0x38,0x30,0x28,0x20,0x1e,0x1c,0x1a,0x18,0x16,0x14,0x12,0x10,0x10,0xf,0xe,0xe,0xe,0xd,0xc,0xc,0xc,0xb,0xa,0xa,0xa,0x9,0x8,0x8,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf8,0xf7,0xf6,0xf6,0xf6,0xf5,0xf4,0xf4,0xf3,0xf2,0xf1,0xf0,0xee,0xec,0xea,0xe8,0xe4,0xe0,0xd8,0xd0,0xc0,0xb0,0x98,0x80,0x68,0x50,0x40,0x30,0x28,0x20,0x1c,0x18,0x16,0x14,0x12,0x10,0xf,0xe,0xd,0xc,0xc,0xb,0xa,0xa,0xa,0x9,0x8,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfb,0xfb,0xfa,0xfa,0xfa,0xfa,0xfa,0xf9,0xf9,0xf9,0xf8,0xf8,0xf8,0xf7,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf0,0xec,0xe8,0xe4,0xe0,0xd0,0xc0,0xa0,0x80,0x60,0x40,0x30,0x20,0x1c,0x18,0x14,0x10,0xf,0xe,0xd,0xc,0xb,0xa,0x9,0x8,0x8,0x8,0x7,0x7,0x7,0x6,0x6,0x6,0x6,0x6,0x5,0x5,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xff,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfd,0xfc,0xfc,0xfc,0xfc,0xfc,0xfc,0xfb,0xfa,0xfa,0xfa,0xf9,0xf8,0xf8,0xf6,0xf4,0xf2,0xf0,0xe8,0xe0,0xc0,0x80,0x40,0x20,0x18,0x10,0xe,0xc,0xa,0x8,0x8,0x7,0x6,0x6,0x6,0x5,0x4,0x4,0x4,0x4,0x4,0x4,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1}
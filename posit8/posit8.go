@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package posit8 implements experimental 8-bit posit with one exponent bit
+// (es=1), a sibling of E4M3 float8 for accuracy comparison. The number is
+// sign, variable length regime, exponent and fraction. Precision is tapered:
+// values near ±1 have 5 bits of fraction, the range is [4^-6, 4^6].
+// The pattern 0x80 is NaR (not a real), there is single zero.
+//
+// Arithmetic uses code books generated by cmd, results are rounded to the
+// nearest posit with ties to even pattern.
+package posit8
+
+import (
+	"math"
+	"sort"
+)
+
+// Posit8 data type
+type Posit8 = uint8
+
+// NaR is not a real, result of invalid operation (e.g. division by zero)
+const NaR = 0x80
+
+// IsNaR reports whether p is not a real
+func IsNaR(p Posit8) bool { return p == NaR }
+
+// ToFloat32 converts posit8 to float32, NaR is converted to NaN
+func ToFloat32(p Posit8) float32 {
+	if p == NaR {
+		return float32(math.NaN())
+	}
+	return p8tof32[p]
+}
+
+// FromFloat32 converts float32 to the nearest posit8. Non zero values never
+// round to zero, values beyond range saturate, NaN and Inf are NaR.
+func FromFloat32(f32 float32) Posit8 {
+	x := float64(f32)
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return NaR
+	case x == 0:
+		return 0
+	}
+
+	// posits are ordered as two's complement integers,
+	// search the first pattern in [-127, 127] which is not below x
+	i := sort.Search(255, func(i int) bool { return float64(p8tof32[uint8(int8(i-127))]) >= x })
+	hi := min(i, 254) - 127
+	lo := max(hi-1, -127)
+
+	// skip zero, non zero values never round to it
+	if hi == 0 {
+		hi = 1
+	}
+	if lo == 0 {
+		lo = -1
+	}
+
+	a, b := uint8(int8(lo)), uint8(int8(hi))
+	da, db := math.Abs(float64(p8tof32[a])-x), math.Abs(float64(p8tof32[b])-x)
+	switch {
+	case da < db:
+		return a
+	case db < da:
+		return b
+	case a&0x01 == 0:
+		return a
+	default:
+		return b
+	}
+}
+
+// ToSlice converts float32 slice to posit8
+func ToSlice(f32s []float32) []Posit8 {
+	ps := make([]Posit8, len(f32s))
+	for i, x := range f32s {
+		ps[i] = FromFloat32(x)
+	}
+	return ps
+}
+
+// Add posits
+func Add(a, b Posit8) Posit8 { return add[int(a)<<8|int(b)] }
+
+// Sub posits
+func Sub(a, b Posit8) Posit8 { return sub[int(a)<<8|int(b)] }
+
+// Mul posits
+func Mul(a, b Posit8) Posit8 { return mul[int(a)<<8|int(b)] }
+
+// Div posits, division by zero is NaR
+func Div(a, b Posit8) Posit8 { return div[int(a)<<8|int(b)] }
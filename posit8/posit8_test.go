@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package posit8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/kshard/float8/internal/math8"
+)
+
+func TestToFloat32(t *testing.T) {
+	for p := 0; p < 0x100; p++ {
+		c, e := ToFloat32(uint8(p)), float32(math8.PositToFloat64(uint8(p)))
+		if c != e && !(math.IsNaN(float64(c)) && math.IsNaN(float64(e))) {
+			t.Errorf("0x%02x wanted=%g, got=%g", p, e, c)
+		}
+	}
+
+	for p, x := range map[uint8]float32{0x40: 1, 0xc0: -1, 0x50: 2, 0x7f: 4096, 0x01: 1.0 / 4096} {
+		if c := ToFloat32(p); c != x {
+			t.Errorf("0x%02x wanted=%g, got=%g", p, x, c)
+		}
+	}
+}
+
+func TestFromFloat32(t *testing.T) {
+	for p := 0; p < 0x100; p++ {
+		if c := FromFloat32(ToFloat32(uint8(p))); c != uint8(p) {
+			t.Errorf("0x%02x got=0x%02x", p, c)
+		}
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 10000; i++ {
+		x := float32(r.NormFloat64() * math.Exp2(r.Float64()*30-15))
+		if c, e := FromFloat32(x), math8.PositFromFloat64(float64(x)); c != e {
+			t.Fatalf("%g wanted=0x%02x, got=0x%02x", x, e, c)
+		}
+	}
+
+	for x, e := range map[float32]uint8{1e9: 0x7f, -1e9: 0x81, 1e-9: 0x01, -1e-9: 0xff} {
+		if c := FromFloat32(x); c != e {
+			t.Errorf("%g wanted=0x%02x, got=0x%02x", x, e, c)
+		}
+	}
+
+	if c := FromFloat32(float32(math.Inf(1))); !IsNaR(c) {
+		t.Errorf("Inf got=0x%02x", c)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	for name, op := range map[string]struct {
+		p8  func(Posit8, Posit8) Posit8
+		ref func(uint8, uint8) uint8
+	}{
+		"add": {Add, math8.PositAdd},
+		"sub": {Sub, math8.PositSub},
+		"mul": {Mul, math8.PositMul},
+		"div": {Div, math8.PositDiv},
+	} {
+		for a := 0; a < 0x100; a++ {
+			for b := 0; b < 0x100; b++ {
+				if c, e := op.p8(uint8(a), uint8(b)), op.ref(uint8(a), uint8(b)); c != e {
+					t.Fatalf("%s(0x%02x, 0x%02x) wanted=0x%02x, got=0x%02x", name, a, b, e, c)
+				}
+			}
+		}
+	}
+
+	if c := Div(FromFloat32(1), 0); !IsNaR(c) {
+		t.Errorf("1 / 0 got=0x%02x", c)
+	}
+
+	if c := Mul(FromFloat32(1.5), FromFloat32(2)); ToFloat32(c) != 3 {
+		t.Errorf("1.5 * 2 got=%g", ToFloat32(c))
+	}
+}
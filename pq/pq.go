@@ -0,0 +1,242 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package pq implements product quantization companion codec. Vector of
+// dimension d is split into m sub-vectors of dimension d/m, each sub-vector
+// is replaced by index of the nearest centroid of its sub-quantizer. The
+// code of vector is m bytes.
+package pq
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/internal/kmeans"
+)
+
+// config of training
+type config struct {
+	k          int
+	iterations int
+	seed       uint64
+}
+
+// Option of training
+type Option func(*config)
+
+// WithCentroids defines number of centroids per sub-quantizer, at most 256
+func WithCentroids(k int) Option {
+	return func(c *config) { c.k = k }
+}
+
+// WithIterations defines number of k-means iterations per sub-quantizer
+func WithIterations(n int) Option {
+	return func(c *config) { c.iterations = n }
+}
+
+// WithSeed defines seed of random centroids initialization
+func WithSeed(seed uint64) Option {
+	return func(c *config) { c.seed = seed }
+}
+
+// Quantizer is trained product quantizer
+type Quantizer struct {
+	// dimension of vectors
+	Dim int
+
+	// number of sub-quantizers, the length of code
+	M int
+
+	// number of centroids per sub-quantizer
+	K int
+
+	// centroids of sub-quantizers, M × K × Dim/M row-major
+	Centroids []float32
+}
+
+// Train sub-quantizers over float32 vectors with k-means per sub-vector
+func Train(data [][]float32, m int, opts ...Option) (*Quantizer, error) {
+	c := config{k: 256, iterations: 25, seed: 1}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("pq: training set is empty")
+	}
+
+	dim := len(data[0])
+	if m <= 0 || dim%m != 0 {
+		return nil, fmt.Errorf("pq: dimension %d is not divisible by %d sub-quantizers", dim, m)
+	}
+
+	if c.k <= 0 || c.k > 256 {
+		return nil, fmt.Errorf("pq: invalid number of centroids %d", c.k)
+	}
+
+	if len(data) < c.k {
+		return nil, fmt.Errorf("pq: training set of %d vectors is smaller than %d centroids", len(data), c.k)
+	}
+
+	for _, v := range data {
+		if len(v) != dim {
+			return nil, errors.New("pq: vectors must have same length")
+		}
+	}
+
+	q := &Quantizer{
+		Dim:       dim,
+		M:         m,
+		K:         c.k,
+		Centroids: make([]float32, m*c.k*(dim/m)),
+	}
+
+	r := rand.New(rand.NewPCG(c.seed, 0))
+	for s := 0; s < m; s++ {
+		q.train(r, data, s, c.iterations)
+	}
+
+	return q, nil
+}
+
+// TrainFloat8 sub-quantizers over float8 vectors
+func TrainFloat8(data [][]float8.Float8, m int, opts ...Option) (*Quantizer, error) {
+	f32s := make([][]float32, len(data))
+	for i, v := range data {
+		f32s[i] = toFloat32(v)
+	}
+
+	return Train(f32s, m, opts...)
+}
+
+// Lloyd's k-means over s-th sub-vectors
+func (q *Quantizer) train(r *rand.Rand, data [][]float32, s, iterations int) {
+	dsub := q.Dim / q.M
+	cents := q.centroids(s)
+
+	vec := func(i int) []float32 { return data[i][s*dsub : (s+1)*dsub] }
+	kmeans.Seed(r, cents, len(data), dsub, q.K, vec, kmeans.Identity)
+
+	sums := make([]float64, len(cents))
+	counts := make([]int, q.K)
+	for it := 0; it < iterations; it++ {
+		clear(sums)
+		clear(counts)
+
+		for i := range data {
+			sub := vec(i)
+			c := q.nearest(s, sub)
+			counts[c]++
+			for j, x := range sub {
+				sums[c*dsub+j] += float64(x)
+			}
+		}
+
+		for c := 0; c < q.K; c++ {
+			if counts[c] == 0 {
+				// empty cluster is re-seeded by random sub-vector
+				copy(cents[c*dsub:(c+1)*dsub], vec(r.IntN(len(data))))
+				continue
+			}
+			for j := 0; j < dsub; j++ {
+				cents[c*dsub+j] = float32(sums[c*dsub+j] / float64(counts[c]))
+			}
+		}
+	}
+}
+
+// centroids of s-th sub-quantizer
+func (q *Quantizer) centroids(s int) []float32 {
+	size := q.K * (q.Dim / q.M)
+	return q.Centroids[s*size : (s+1)*size]
+}
+
+// nearest centroid of s-th sub-quantizer
+func (q *Quantizer) nearest(s int, sub []float32) int {
+	return kmeans.Nearest(q.centroids(s), q.Dim/q.M, sub, kmeans.Identity)
+}
+
+// Encode vector into PQ code of M bytes
+func (q *Quantizer) Encode(v []float32) []byte {
+	if len(v) != q.Dim {
+		panic("vector must have quantizer dimension")
+	}
+
+	dsub := q.Dim / q.M
+	code := make([]byte, q.M)
+	for s := range code {
+		code[s] = byte(q.nearest(s, v[s*dsub:(s+1)*dsub]))
+	}
+
+	return code
+}
+
+// EncodeFloat8 encodes float8 vector into PQ code of M bytes
+func (q *Quantizer) EncodeFloat8(v []float8.Float8) []byte {
+	return q.Encode(toFloat32(v))
+}
+
+// Decode PQ code into approximation of original vector
+func (q *Quantizer) Decode(code []byte) []float32 {
+	if len(code) != q.M {
+		panic("code must have M bytes")
+	}
+
+	dsub := q.Dim / q.M
+	v := make([]float32, q.Dim)
+	for s, c := range code {
+		copy(v[s*dsub:(s+1)*dsub], q.centroids(s)[int(c)*dsub:(int(c)+1)*dsub])
+	}
+
+	return v
+}
+
+// DistanceTable computes squared distances from sub-vectors of query to
+// all centroids, it is M × K row-major table used by AsymmetricDistance.
+func (q *Quantizer) DistanceTable(query []float32) []float32 {
+	if len(query) != q.Dim {
+		panic("vector must have quantizer dimension")
+	}
+
+	dsub := q.Dim / q.M
+	table := make([]float32, q.M*q.K)
+	for s := 0; s < q.M; s++ {
+		sub := query[s*dsub : (s+1)*dsub]
+		cents := q.centroids(s)
+		for c := 0; c < q.K; c++ {
+			table[s*q.K+c] = kmeans.SquaredDistance(cents[c*dsub:(c+1)*dsub], sub, kmeans.Identity)
+		}
+	}
+
+	return table
+}
+
+// AsymmetricDistance is squared Euclidean distance between query and
+// vector encoded as code, using query's DistanceTable.
+func (q *Quantizer) AsymmetricDistance(table []float32, code []byte) float32 {
+	if len(code) != q.M {
+		panic("code must have M bytes")
+	}
+
+	d := float32(0)
+	for s, c := range code {
+		d += table[s*q.K+int(c)]
+	}
+
+	return d
+}
+
+func toFloat32(v []float8.Float8) []float32 {
+	f32s := make([]float32, len(v))
+	for i, f8 := range v {
+		f32s[i] = float8.ToFloat32(f8)
+	}
+	return f32s
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package pq
+
+import (
+	"math"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/internal/kmeans"
+)
+
+func clusters(r *rand.Rand, n, dim int) [][]float32 {
+	data := make([][]float32, n)
+	for i := range data {
+		data[i] = make([]float32, dim)
+		center := float32(i%4) * 10
+		for j := range data[i] {
+			data[i][j] = center + 0.1*float32(r.NormFloat64())
+		}
+	}
+	return data
+}
+
+func TestTrain(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 0))
+	data := clusters(r, 400, 8)
+
+	q, err := Train(data, 4, WithCentroids(4), WithIterations(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if q.Dim != 8 || q.M != 4 || q.K != 4 || len(q.Centroids) != 4*4*2 {
+		t.Fatalf("unexpected quantizer %d×%d×%d", q.M, q.K, len(q.Centroids))
+	}
+
+	for _, v := range data[:20] {
+		code := q.Encode(v)
+		if len(code) != 4 {
+			t.Fatalf("unexpected code length %d", len(code))
+		}
+
+		x := q.Decode(code)
+		e := kmeans.SquaredDistance(v, x, kmeans.Identity)
+		if e > 1 {
+			t.Errorf("reconstruction error %f", e)
+		}
+
+		table := q.DistanceTable(v)
+		if d := q.AsymmetricDistance(table, code); math.Abs(float64(d-e)) > 1e-4 {
+			t.Errorf("asymmetric distance %f, wanted %f", d, e)
+		}
+	}
+}
+
+func TestTrainFloat8(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 0))
+	data := clusters(r, 300, 4)
+	f8s := make([][]float8.Float8, len(data))
+	for i, v := range data {
+		f8s[i] = float8.ToSlice8(v)
+	}
+
+	q, err := TrainFloat8(f8s, 2, WithCentroids(8), WithSeed(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, b := q.EncodeFloat8(f8s[0]), q.EncodeFloat8(f8s[4])
+	if string(a) != string(b) {
+		t.Errorf("vectors of same cluster have different codes %v, %v", a, b)
+	}
+}
+
+func TestTrainInvalid(t *testing.T) {
+	data := [][]float32{{1, 2, 3}, {4, 5, 6}}
+
+	for name, f := range map[string]func() (*Quantizer, error){
+		"empty":     func() (*Quantizer, error) { return Train(nil, 1) },
+		"divisible": func() (*Quantizer, error) { return Train(data, 2, WithCentroids(2)) },
+		"centroids": func() (*Quantizer, error) { return Train(data, 1, WithCentroids(300)) },
+		"small":     func() (*Quantizer, error) { return Train(data, 1) },
+		"length":    func() (*Quantizer, error) { return Train([][]float32{{1}, {1, 2}}, 1, WithCentroids(1)) },
+	} {
+		if _, err := f(); err == nil || !strings.HasPrefix(err.Error(), "pq: ") {
+			t.Errorf("%s: error is expected, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"sync"
+)
+
+// Op is arithmetic operation of the package
+type Op int
+
+const (
+	OpAdd Op = iota
+	OpSub
+	OpMul
+	OpDiv
+)
+
+func (op Op) String() string {
+	switch op {
+	case OpAdd:
+		return "add"
+	case OpSub:
+		return "sub"
+	case OpMul:
+		return "mul"
+	case OpDiv:
+		return "div"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrorStats is relative error of operation versus exact float64 arithmetic.
+// Only results which exact magnitude is within representable range
+// [ToFloat32(0x01), ToFloat32(MaxValue)] are measured, the rest is counted as underflow
+// (including exact zero) or overflow. Division by zero is excluded.
+type ErrorStats struct {
+	MaxRelative  float64
+	MeanRelative float64
+	Samples      int
+	Underflow    int
+	Overflow     int
+}
+
+var (
+	profileOnce [4]sync.Once
+	profiles    [4]ErrorStats
+)
+
+// ErrorProfile of operation computed by exhaustive enumeration of all
+// operand pairs. The profile is computed on first use and cached.
+func ErrorProfile(op Op) ErrorStats {
+	if op < OpAdd || op > OpDiv {
+		panic("float8: unknown operation")
+	}
+
+	profileOnce[op].Do(func() { profiles[op] = errorProfile(op) })
+	return profiles[op]
+}
+
+func errorProfile(op Op) ErrorStats {
+	var (
+		f     func(a, b Float8) Float8
+		exact func(x, y float64) float64
+	)
+
+	switch op {
+	case OpAdd:
+		f, exact = Add, func(x, y float64) float64 { return x + y }
+	case OpSub:
+		f, exact = Sub, func(x, y float64) float64 { return x - y }
+	case OpMul:
+		f, exact = Mul, func(x, y float64) float64 { return x * y }
+	case OpDiv:
+		f, exact = Div, func(x, y float64) float64 { return x / y }
+	}
+
+	lo, hi := float64(f8tof32[0x01]), float64(f8tof32[MaxValue])
+
+	var s ErrorStats
+	sum := 0.0
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b++ {
+			if op == OpDiv && b == 0 {
+				continue
+			}
+
+			e := exact(float64(f8tof32[a]), float64(f8tof32[b]))
+			switch m := math.Abs(e); {
+			case m < lo:
+				s.Underflow++
+				continue
+			case m > hi:
+				s.Overflow++
+				continue
+			}
+
+			rel := math.Abs(float64(f8tof32[f(Float8(a), Float8(b))])-e) / math.Abs(e)
+			s.MaxRelative = max(s.MaxRelative, rel)
+			sum += rel
+			s.Samples++
+		}
+	}
+
+	if s.Samples > 0 {
+		s.MeanRelative = sum / float64(s.Samples)
+	}
+
+	return s
+}
@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "testing"
+
+func TestErrorProfile(t *testing.T) {
+	for _, op := range []Op{OpAdd, OpSub, OpMul, OpDiv} {
+		s := ErrorProfile(op)
+		t.Logf("%s: %+v", op, s)
+
+		total := s.Samples + s.Underflow + s.Overflow
+		if op == OpDiv && total != 0x100*0xff || op != OpDiv && total != 0x10000 {
+			t.Errorf("%s: %d pairs are profiled", op, total)
+		}
+
+		// truncation of 3-bit mantissa loses less than 2⁻³ of value, except
+		// sub of code books, which negates 0 to 0x80 (-2⁻⁷) so that x - 0
+		// underflows for the smallest x.
+		bound := 0.125
+		if op == OpSub {
+			bound = 1
+		}
+		if s.MaxRelative <= 0 || s.MaxRelative > bound {
+			t.Errorf("%s: unexpected max relative error %g", op, s.MaxRelative)
+		}
+		if s.MeanRelative <= 0 || s.MeanRelative > s.MaxRelative {
+			t.Errorf("%s: unexpected mean relative error %g", op, s.MeanRelative)
+		}
+
+		if ErrorProfile(op) != s {
+			t.Errorf("%s: profile is not stable", op)
+		}
+	}
+}
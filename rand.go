@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math/rand/v2"
+
+// number of finite float8 values
+const finiteCount = 0x100 - 2
+
+// Rand returns float8 value chosen uniformly among finite representable values.
+// The default source is used if r is nil.
+func Rand(r *rand.Rand) Float8 {
+	var n int
+	if r == nil {
+		n = rand.IntN(finiteCount)
+	} else {
+		n = r.IntN(finiteCount)
+	}
+
+	// skip +Inf, -Inf is out of range
+	if n >= Infinity {
+		n++
+	}
+
+	return Float8(n)
+}
+
+// RandNormal returns normally distributed float8 value with given mean and
+// standard deviation, the value is quantized from float32.
+// The default source is used if r is nil.
+func RandNormal(r *rand.Rand, mean, std float32) Float8 {
+	var n float64
+	if r == nil {
+		n = rand.NormFloat64()
+	} else {
+		n = r.NormFloat64()
+	}
+
+	return ToFloat8(float32(n)*std + mean)
+}
+
+// FillRandom fills slice with values chosen uniformly among finite
+// representable values. The default source is used if r is nil.
+func FillRandom(r *rand.Rand, f8s []Float8) {
+	for i := range f8s {
+		f8s[i] = Rand(r)
+	}
+}
@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestIsInf(t *testing.T) {
+	if !IsInf(0x7f, 1) || IsInf(0x7f, -1) || !IsInf(0x7f, 0) {
+		t.Errorf("+Inf is not detected")
+	}
+
+	if !IsInf(0xff, -1) || IsInf(0xff, 1) || !IsInf(0xff, 0) {
+		t.Errorf("-Inf is not detected")
+	}
+
+	if IsInf(0x38, 0) || IsInf(0x78, 0) {
+		t.Errorf("finite value is infinity")
+	}
+}
+
+func TestRand(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	seen := map[Float8]int{}
+	for i := 0; i < 100000; i++ {
+		seen[Rand(r)]++
+	}
+
+	if len(seen) != finiteCount {
+		t.Errorf("only %d values are generated", len(seen))
+	}
+
+	for f8 := range seen {
+		if IsInf(f8, 0) {
+			t.Errorf("infinity is generated")
+		}
+	}
+}
+
+func TestRandNormal(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	sum := float32(0)
+	for i := 0; i < 10000; i++ {
+		sum += ToFloat32(RandNormal(r, 10, 1))
+	}
+
+	// conversion truncates, the mean is biased by half of ulp
+	if mean := sum / 10000; mean < 9.0 || mean > 10.5 {
+		t.Errorf("unexpected mean %f", mean)
+	}
+}
+
+func TestFillRandom(t *testing.T) {
+	f8s := make([]Float8, 1000)
+	FillRandom(nil, f8s)
+
+	zeros := 0
+	for _, f8 := range f8s {
+		if f8 == 0 {
+			zeros++
+		}
+	}
+
+	if zeros > 100 {
+		t.Errorf("slice is not filled")
+	}
+}
@@ -0,0 +1,11 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for recip of float8
+//
+
+var recip = [0x100]uint8{0x7f,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0}
+
+// Reciprocal (1/x) of float8
+func Recip(a Float8) Float8 { return recip[a] }
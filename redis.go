@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// EncodeRedis expands vector to blob of Redis vector similarity field
+// of type FLOAT32, which is sequence of little endian float32 values.
+// Values are scaled (see Vector.Dequantize).
+func EncodeRedis(v Vector) []byte {
+	blob := make([]byte, 4*v.Dim)
+	for i, x := range v.Data[:v.Dim] {
+		binary.LittleEndian.PutUint32(blob[4*i:], math.Float32bits(v.Scale*f8tof32[x]))
+	}
+	return blob
+}
+
+// DecodeRedis quantizes blob of Redis vector similarity field of type
+// FLOAT32 into vector, see Vector.Quantize. Vector is reused if it has
+// enough capacity.
+func DecodeRedis(v *Vector, blob []byte) error {
+	if len(blob)%4 != 0 {
+		return errors.New("float8: redis blob is not sequence of float32")
+	}
+
+	x := make([]float32, len(blob)/4)
+	for i := range x {
+		x[i] = math.Float32frombits(binary.LittleEndian.Uint32(blob[4*i:]))
+	}
+
+	v.Quantize(x)
+	return nil
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestRedis(t *testing.T) {
+	v := NewVector([]float32{1, -2, 0.5, 0})
+
+	blob := EncodeRedis(v)
+	if len(blob) != 16 {
+		t.Fatalf("blob has %d bytes", len(blob))
+	}
+	if x := math.Float32frombits(binary.LittleEndian.Uint32(blob[4:])); x != v.Scale*ToFloat32(v.Data[1]) {
+		t.Errorf("unexpected element %g", x)
+	}
+
+	var w Vector
+	if err := DecodeRedis(&w, blob); err != nil {
+		t.Fatal(err)
+	}
+	if w.Dim != v.Dim || !bytes.Equal(w.Data, v.Data) || math.Abs(float64(w.Scale-v.Scale)) > 1e-6 {
+		t.Errorf("got=%+v expected=%+v", w, v)
+	}
+
+	if err := DecodeRedis(&w, blob[:3]); err == nil {
+		t.Errorf("malformed blob is decoded")
+	}
+}
@@ -0,0 +1,9 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for translating float8 to float32
+//
+
+var remainder = [0x10000]uint8{0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x83,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x83,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x83,0x85,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x83,0x85,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x86,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x86,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x86,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x86,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x88,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x88,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0xa,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x86,0x89,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x86,0x89,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0xb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0xc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x86,0x89,0x8b,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x86,0x89,0x8b,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0xd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0xe,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x86,0x89,0x8b,0x8d,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x86,0x89,0x8b,0x8d,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0xf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0x8e,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0x8e,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x10,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x2,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0x8e,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x2,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0x8e,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x11,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x4,0x2,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0x90,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x4,0x2,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8c,0x90,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x12,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x6,0x4,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8e,0x91,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x6,0x4,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8a,0x8e,0x91,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x13,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x4,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8c,0x90,0x92,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x4,0x0,0x0,0x0,0x0,0x80,0x84,0x88,0x8c,0x90,0x92,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x14,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x80,0x84,0x8,0x4,0x0,0x0,0x0,0x0,0x80,0x84,0x8a,0x8e,0x91,0x93,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x80,0x84,0x8,0x4,0x0,0x0,0x0,0x0,0x80,0x84,0x8a,0x8e,0x91,0x93,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x15,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x80,0x88,0x8,0x4,0x0,0x0,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x80,0x88,0x8,0x4,0x0,0x0,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x16,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x84,0x8a,0x8,0x4,0x0,0x0,0x0,0x0,0x84,0x8a,0x8e,0x91,0x93,0x95,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x84,0x8a,0x8,0x4,0x0,0x0,0x0,0x0,0x84,0x8a,0x8e,0x91,0x93,0x95,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x17,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x4,0x0,0x0,0x0,0x80,0x88,0xa,0x8,0x4,0x0,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x96,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x4,0x0,0x0,0x0,0x80,0x88,0xa,0x8,0x4,0x0,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x96,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x18,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x84,0x4,0x0,0x0,0x80,0x88,0x8c,0xa,0x8,0x4,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x96,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x84,0x4,0x0,0x0,0x80,0x88,0x8c,0xa,0x8,0x4,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x96,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x19,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x82,0x8,0x0,0x0,0x80,0x88,0x8c,0xc,0xa,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x98,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x82,0x8,0x0,0x0,0x80,0x88,0x8c,0xc,0xa,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x94,0x98,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x1a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x2,0x0,0x0,0x80,0x8,0x0,0x0,0x80,0x88,0x8c,0xe,0xc,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x96,0x99,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x2,0x0,0x0,0x80,0x8,0x0,0x0,0x80,0x88,0x8c,0xe,0xc,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0x92,0x96,0x99,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x1b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x4,0x0,0x0,0x84,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0xc,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0x94,0x98,0x9a,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x4,0x0,0x0,0x84,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0xc,0x8,0x0,0x0,0x80,0x88,0x8c,0x90,0x94,0x98,0x9a,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x1c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x6,0x0,0x0,0x88,0x8,0x0,0x0,0x80,0x88,0x8c,0x10,0xc,0x8,0x0,0x0,0x80,0x88,0x8c,0x92,0x96,0x99,0x9b,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x6,0x0,0x0,0x88,0x8,0x0,0x0,0x80,0x88,0x8c,0x10,0xc,0x8,0x0,0x0,0x80,0x88,0x8c,0x92,0x96,0x99,0x9b,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x1d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x88,0x0,0x80,0x8a,0x8,0x0,0x0,0x80,0x88,0x90,0x10,0xc,0x8,0x0,0x0,0x80,0x88,0x90,0x94,0x98,0x9a,0x9c,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x88,0x0,0x80,0x8a,0x8,0x0,0x0,0x80,0x88,0x90,0x10,0xc,0x8,0x0,0x0,0x80,0x88,0x90,0x94,0x98,0x9a,0x9c,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x1e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x82,0x0,0x0,0x80,0x4,0x0,0x84,0xc,0x8,0x0,0x0,0x80,0x8c,0x92,0x10,0xc,0x8,0x0,0x0,0x80,0x8c,0x92,0x96,0x99,0x9b,0x9d,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x82,0x0,0x0,0x80,0x4,0x0,0x84,0xc,0x8,0x0,0x0,0x80,0x8c,0x92,0x10,0xc,0x8,0x0,0x0,0x80,0x8c,0x92,0x96,0x99,0x9b,0x9d,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x1f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x88,0x0,0x0,0x88,0xc,0x8,0x0,0x0,0x88,0x90,0x12,0x10,0xc,0x8,0x0,0x0,0x88,0x90,0x94,0x98,0x9a,0x9c,0x9e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x88,0x0,0x0,0x88,0xc,0x8,0x0,0x0,0x88,0x90,0x12,0x10,0xc,0x8,0x0,0x0,0x88,0x90,0x94,0x98,0x9a,0x9c,0x9e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x84,0x0,0x0,0x8,0x0,0x88,0x4,0x0,0x84,0x8c,0xc,0x8,0x0,0x88,0x90,0x94,0x12,0x10,0xc,0x8,0x0,0x88,0x90,0x94,0x98,0x9a,0x9c,0x9e,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x84,0x0,0x0,0x8,0x0,0x88,0x4,0x0,0x84,0x8c,0xc,0x8,0x0,0x88,0x90,0x94,0x12,0x10,0xc,0x8,0x0,0x88,0x90,0x94,0x98,0x9a,0x9c,0x9e,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x21,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x2,0x0,0x8,0x0,0x88,0x8,0x0,0x80,0x8a,0x10,0x8,0x0,0x88,0x90,0x94,0x14,0x12,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x9a,0x9c,0xa0,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x2,0x0,0x8,0x0,0x88,0x8,0x0,0x80,0x8a,0x10,0x8,0x0,0x88,0x90,0x94,0x14,0x12,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x9a,0x9c,0xa0,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x22,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x8,0x0,0x88,0xa,0x0,0x0,0x88,0x10,0x8,0x0,0x88,0x90,0x94,0x16,0x14,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x9a,0x9e,0xa1,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x8,0x0,0x88,0xa,0x0,0x0,0x88,0x10,0x8,0x0,0x88,0x90,0x94,0x16,0x14,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x9a,0x9e,0xa1,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x23,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x4,0x0,0x4,0x80,0x8,0x0,0x88,0xc,0x4,0x0,0x8c,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x14,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x9c,0xa0,0xa2,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x4,0x0,0x4,0x80,0x8,0x0,0x88,0xc,0x4,0x0,0x8c,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x14,0x10,0x8,0x0,0x88,0x90,0x94,0x98,0x9c,0xa0,0xa2,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x24,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x2,0x80,0x0,0x4,0x0,0x8,0x80,0x0,0x84,0x8,0x0,0x88,0xe,0x8,0x80,0x90,0x10,0x8,0x0,0x88,0x90,0x94,0x18,0x14,0x10,0x8,0x0,0x88,0x90,0x94,0x9a,0x9e,0xa1,0xa3,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x2,0x80,0x0,0x4,0x0,0x8,0x80,0x0,0x84,0x8,0x0,0x88,0xe,0x8,0x80,0x90,0x10,0x8,0x0,0x88,0x90,0x94,0x18,0x14,0x10,0x8,0x0,0x88,0x90,0x94,0x9a,0x9e,0xa1,0xa3,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x25,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x6,0x0,0x0,0x88,0x0,0x88,0x8,0x0,0x88,0x90,0x0,0x88,0x92,0x10,0x8,0x0,0x88,0x90,0x98,0x18,0x14,0x10,0x8,0x0,0x88,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x6,0x0,0x0,0x88,0x0,0x88,0x8,0x0,0x88,0x90,0x0,0x88,0x92,0x10,0x8,0x0,0x88,0x90,0x98,0x18,0x14,0x10,0x8,0x0,0x88,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x26,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x88,0x84,0x0,0xa,0x0,0x8a,0x8,0x0,0x88,0xc,0x0,0x8c,0x14,0x10,0x8,0x0,0x88,0x94,0x9a,0x18,0x14,0x10,0x8,0x0,0x88,0x94,0x9a,0x9e,0xa1,0xa3,0xa5,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x88,0x84,0x0,0xa,0x0,0x8a,0x8,0x0,0x88,0xc,0x0,0x8c,0x14,0x10,0x8,0x0,0x88,0x94,0x9a,0x18,0x14,0x10,0x8,0x0,0x88,0x94,0x9a,0x9e,0xa1,0xa3,0xa5,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x27,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x86,0x0,0x0,0x8,0x80,0x8,0x0,0x8c,0x8,0x0,0x90,0x8,0x80,0x90,0x14,0x10,0x8,0x0,0x90,0x98,0x1a,0x18,0x14,0x10,0x8,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0xa6,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x86,0x0,0x0,0x8,0x80,0x8,0x0,0x8c,0x8,0x0,0x90,0x8,0x80,0x90,0x14,0x10,0x8,0x0,0x90,0x98,0x1a,0x18,0x14,0x10,0x8,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0xa6,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x28,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8a,0x0,0x8c,0x0,0x84,0x10,0x0,0x90,0xc,0x0,0x8c,0x94,0x14,0x10,0x0,0x90,0x98,0x9c,0x1a,0x18,0x14,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0xa6,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8a,0x0,0x8c,0x0,0x84,0x10,0x0,0x90,0xc,0x0,0x8c,0x94,0x14,0x10,0x0,0x90,0x98,0x9c,0x1a,0x18,0x14,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0xa6,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x29,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x4,0x82,0x0,0x0,0x0,0x4,0x88,0x0,0x88,0xa,0x0,0x10,0x0,0x90,0x10,0x0,0x88,0x92,0x18,0x10,0x0,0x90,0x98,0x9c,0x1c,0x1a,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0xa8,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x4,0x82,0x0,0x0,0x0,0x4,0x88,0x0,0x88,0xa,0x0,0x10,0x0,0x90,0x10,0x0,0x88,0x92,0x18,0x10,0x0,0x90,0x98,0x9c,0x1c,0x1a,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa4,0xa8,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x2a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x80,0x84,0x84,0x80,0x0,0x80,0x80,0x0,0x8,0x84,0x8,0x80,0x90,0x80,0x10,0x0,0x90,0x12,0x8,0x80,0x90,0x18,0x10,0x0,0x90,0x98,0x9c,0x1e,0x1c,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa6,0xa9,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x80,0x84,0x84,0x80,0x0,0x80,0x80,0x0,0x8,0x84,0x8,0x80,0x90,0x80,0x10,0x0,0x90,0x12,0x8,0x80,0x90,0x18,0x10,0x0,0x90,0x98,0x9c,0x1e,0x1c,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa2,0xa6,0xa9,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x2b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x80,0x0,0x0,0x84,0x88,0x84,0x0,0xa,0x80,0xc,0x0,0xc,0x88,0x10,0x0,0x90,0x14,0xc,0x0,0x94,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0x1c,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa4,0xa8,0xaa,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x80,0x0,0x0,0x84,0x88,0x84,0x0,0xa,0x80,0xc,0x0,0xc,0x88,0x10,0x0,0x90,0x14,0xc,0x0,0x94,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0x1c,0x18,0x10,0x0,0x90,0x98,0x9c,0xa0,0xa4,0xa8,0xaa,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x2c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0xa,0x88,0x0,0xc,0x0,0x10,0x88,0x8,0x8c,0x10,0x0,0x90,0x16,0x10,0x88,0x98,0x18,0x10,0x0,0x90,0x98,0x9c,0x20,0x1c,0x18,0x10,0x0,0x90,0x98,0x9c,0xa2,0xa6,0xa9,0xab,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0xa,0x88,0x0,0xc,0x0,0x10,0x88,0x8,0x8c,0x10,0x0,0x90,0x16,0x10,0x88,0x98,0x18,0x10,0x0,0x90,0x98,0x9c,0x20,0x1c,0x18,0x10,0x0,0x90,0x98,0x9c,0xa2,0xa6,0xa9,0xab,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x2d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x8,0x8a,0x0,0xe,0x0,0x8,0x90,0x0,0x90,0x10,0x0,0x90,0x98,0x8,0x90,0x9a,0x18,0x10,0x0,0x90,0x98,0xa0,0x20,0x1c,0x18,0x10,0x0,0x90,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0x8,0x8a,0x0,0xe,0x0,0x8,0x90,0x0,0x90,0x10,0x0,0x90,0x98,0x8,0x90,0x9a,0x18,0x10,0x0,0x90,0x98,0xa0,0x20,0x1c,0x18,0x10,0x0,0x90,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x2e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x4,0x8c,0x0,0x90,0x8c,0x0,0x12,0x0,0x92,0x10,0x0,0x90,0x14,0x0,0x94,0x1c,0x18,0x10,0x0,0x90,0x9c,0xa2,0x20,0x1c,0x18,0x10,0x0,0x90,0x9c,0xa2,0xa6,0xa9,0xab,0xad,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x4,0x8c,0x0,0x90,0x8c,0x0,0x12,0x0,0x92,0x10,0x0,0x90,0x14,0x0,0x94,0x1c,0x18,0x10,0x0,0x90,0x9c,0xa2,0x20,0x1c,0x18,0x10,0x0,0x90,0x9c,0xa2,0xa6,0xa9,0xab,0xad,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x2f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x80,0x88,0x88,0x80,0x0,0x8e,0x0,0x0,0x10,0x88,0x10,0x80,0x94,0x10,0x0,0x98,0x10,0x88,0x98,0x1c,0x18,0x10,0x0,0x98,0xa0,0x22,0x20,0x1c,0x18,0x10,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0xae,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x80,0x88,0x88,0x80,0x0,0x8e,0x0,0x0,0x10,0x88,0x10,0x80,0x94,0x10,0x0,0x98,0x10,0x88,0x98,0x1c,0x18,0x10,0x0,0x98,0xa0,0x22,0x20,0x1c,0x18,0x10,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0xae,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x30,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x8c,0x0,0x0,0x8,0x92,0x0,0x94,0x8,0x8c,0x18,0x0,0x98,0x14,0x0,0x94,0x9c,0x1c,0x18,0x0,0x98,0xa0,0xa4,0x22,0x20,0x1c,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0xae,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x8,0x8c,0x0,0x0,0x8,0x92,0x0,0x94,0x8,0x8c,0x18,0x0,0x98,0x14,0x0,0x94,0x9c,0x1c,0x18,0x0,0x98,0xa0,0xa4,0x22,0x20,0x1c,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0xae,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x31,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x2,0x0,0x80,0x0,0x8a,0x8,0x8,0xc,0x8a,0x0,0x80,0x0,0xc,0x90,0x8,0x90,0x12,0x0,0x18,0x0,0x98,0x18,0x8,0x90,0x9a,0x20,0x18,0x0,0x98,0xa0,0xa4,0x24,0x22,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0xb0,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x2,0x0,0x80,0x0,0x8a,0x8,0x8,0xc,0x8a,0x0,0x80,0x0,0xc,0x90,0x8,0x90,0x12,0x0,0x18,0x0,0x98,0x18,0x8,0x90,0x9a,0x20,0x18,0x0,0x98,0xa0,0xa4,0x24,0x22,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xac,0xb0,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x32,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x88,0x88,0x0,0x88,0x8c,0x8c,0x88,0x0,0x88,0x88,0x0,0x10,0x8c,0x10,0x88,0x98,0x88,0x18,0x0,0x98,0x1a,0x10,0x88,0x98,0x20,0x18,0x0,0x98,0xa0,0xa4,0x26,0x24,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xae,0xb1,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x88,0x88,0x0,0x88,0x8c,0x8c,0x88,0x0,0x88,0x88,0x0,0x10,0x8c,0x10,0x88,0x98,0x88,0x18,0x0,0x98,0x1a,0x10,0x88,0x98,0x20,0x18,0x0,0x98,0xa0,0xa4,0x26,0x24,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xaa,0xae,0xb1,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x33,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x4,0x84,0x0,0x4,0x0,0xa,0x0,0x84,0x88,0x84,0x0,0x8c,0x90,0x8c,0x0,0x12,0x88,0x14,0x0,0x14,0x90,0x18,0x0,0x98,0x1c,0x14,0x0,0x9c,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0x24,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xac,0xb0,0xb2,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x4,0x84,0x0,0x4,0x0,0xa,0x0,0x84,0x88,0x84,0x0,0x8c,0x90,0x8c,0x0,0x12,0x88,0x14,0x0,0x14,0x90,0x18,0x0,0x98,0x1c,0x14,0x0,0x9c,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0x24,0x20,0x18,0x0,0x98,0xa0,0xa4,0xa8,0xac,0xb0,0xb2,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x34,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x80,0x80,0x0,0x0,0x80,0x0,0x8,0x0,0x80,0x80,0x0,0x90,0x10,0x12,0x90,0x0,0x14,0x80,0x18,0x90,0x10,0x94,0x18,0x0,0x98,0x1e,0x18,0x90,0xa0,0x20,0x18,0x0,0x98,0xa0,0xa4,0x28,0x24,0x20,0x18,0x0,0x98,0xa0,0xa4,0xaa,0xae,0xb1,0xb3,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x80,0x80,0x0,0x0,0x80,0x0,0x8,0x0,0x80,0x80,0x0,0x90,0x10,0x12,0x90,0x0,0x14,0x80,0x18,0x90,0x10,0x94,0x18,0x0,0x98,0x1e,0x18,0x90,0xa0,0x20,0x18,0x0,0x98,0xa0,0xa4,0x28,0x24,0x20,0x18,0x0,0x98,0xa0,0xa4,0xaa,0xae,0xb1,0xb3,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x35,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0x8,0x88,0x4,0x0,0x0,0x0,0x10,0x8,0x8,0x10,0x92,0x0,0x16,0x0,0x10,0x98,0x8,0x98,0x18,0x0,0x98,0xa0,0x10,0x98,0xa2,0x20,0x18,0x0,0x98,0xa0,0xa8,0x28,0x24,0x20,0x18,0x0,0x98,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0x8,0x88,0x4,0x0,0x0,0x0,0x10,0x8,0x8,0x10,0x92,0x0,0x16,0x0,0x10,0x98,0x8,0x98,0x18,0x0,0x98,0xa0,0x10,0x98,0xa2,0x20,0x18,0x0,0x98,0xa0,0xa8,0x28,0x24,0x20,0x18,0x0,0x98,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x36,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0xc,0x0,0x0,0x0,0xc,0x0,0x80,0x0,0xc,0x94,0x0,0x98,0x94,0x0,0x1a,0x0,0x9a,0x18,0x0,0x98,0x1c,0x0,0x9c,0x24,0x20,0x18,0x0,0x98,0xa4,0xaa,0x28,0x24,0x20,0x18,0x0,0x98,0xa4,0xaa,0xae,0xb1,0xb3,0xb5,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0xc,0x0,0x0,0x0,0xc,0x0,0x80,0x0,0xc,0x94,0x0,0x98,0x94,0x0,0x1a,0x0,0x9a,0x18,0x0,0x98,0x1c,0x0,0x9c,0x24,0x20,0x18,0x0,0x98,0xa4,0xaa,0x28,0x24,0x20,0x18,0x0,0x98,0xa4,0xaa,0xae,0xb1,0xb3,0xb5,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x37,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x2,0x84,0x0,0x0,0x8,0x88,0x4,0x8,0x88,0x8,0x0,0x0,0x8,0x88,0x90,0x90,0x88,0x8,0x96,0x0,0x8,0x18,0x90,0x18,0x88,0x9c,0x18,0x0,0xa0,0x18,0x90,0xa0,0x24,0x20,0x18,0x0,0xa0,0xa8,0x2a,0x28,0x24,0x20,0x18,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0xb6,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x2,0x84,0x0,0x0,0x8,0x88,0x4,0x8,0x88,0x8,0x0,0x0,0x8,0x88,0x90,0x90,0x88,0x8,0x96,0x0,0x8,0x18,0x90,0x18,0x88,0x9c,0x18,0x0,0xa0,0x18,0x90,0xa0,0x24,0x20,0x18,0x0,0xa0,0xa8,0x2a,0x28,0x24,0x20,0x18,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0xb6,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x38,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x80,0x0,0x0,0x0,0x8c,0x4,0x0,0x0,0x10,0x0,0x0,0x0,0x10,0x94,0x0,0x0,0x10,0x9a,0x0,0x9c,0x10,0x94,0x20,0x0,0xa0,0x1c,0x0,0x9c,0xa4,0x24,0x20,0x0,0xa0,0xa8,0xac,0x2a,0x28,0x24,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0xb6,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x80,0x0,0x0,0x0,0x8c,0x4,0x0,0x0,0x10,0x0,0x0,0x0,0x10,0x94,0x0,0x0,0x10,0x9a,0x0,0x9c,0x10,0x94,0x20,0x0,0xa0,0x1c,0x0,0x9c,0xa4,0x24,0x20,0x0,0xa0,0xa8,0xac,0x2a,0x28,0x24,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0xb6,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x39,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x88,0x0,0x0,0x88,0x8a,0x80,0xa,0x0,0x88,0x0,0x92,0x10,0x10,0x14,0x92,0x0,0x88,0x0,0x14,0x98,0x10,0x98,0x1a,0x0,0x20,0x0,0xa0,0x20,0x10,0x98,0xa2,0x28,0x20,0x0,0xa0,0xa8,0xac,0x2c,0x2a,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0xb8,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x88,0x0,0x0,0x88,0x8a,0x80,0xa,0x0,0x88,0x0,0x92,0x10,0x10,0x14,0x92,0x0,0x88,0x0,0x14,0x98,0x10,0x98,0x1a,0x0,0x20,0x0,0xa0,0x20,0x10,0x98,0xa2,0x28,0x20,0x0,0xa0,0xa8,0xac,0x2c,0x2a,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb4,0xb8,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x3a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x90,0x90,0x0,0x90,0x94,0x94,0x90,0x0,0x90,0x90,0x0,0x18,0x94,0x18,0x90,0xa0,0x90,0x20,0x0,0xa0,0x22,0x18,0x90,0xa0,0x28,0x20,0x0,0xa0,0xa8,0xac,0x2e,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb6,0xb9,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x0,0xe,0x0,0x90,0x90,0x0,0x90,0x94,0x94,0x90,0x0,0x90,0x90,0x0,0x18,0x94,0x18,0x90,0xa0,0x90,0x20,0x0,0xa0,0x22,0x18,0x90,0xa0,0x28,0x20,0x0,0xa0,0xa8,0xac,0x2e,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb2,0xb6,0xb9,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x3b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x8,0x0,0x0,0x8c,0xc,0x8c,0x0,0xc,0x8,0x12,0x0,0x8c,0x90,0x8c,0x0,0x94,0x98,0x94,0x0,0x1a,0x90,0x1c,0x0,0x1c,0x98,0x20,0x0,0xa0,0x24,0x1c,0x0,0xa4,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb4,0xb8,0xba,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x8,0x0,0x0,0x8c,0xc,0x8c,0x0,0xc,0x8,0x12,0x0,0x8c,0x90,0x8c,0x0,0x94,0x98,0x94,0x0,0x1a,0x90,0x1c,0x0,0x1c,0x98,0x20,0x0,0xa0,0x24,0x1c,0x0,0xa4,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb0,0xb4,0xb8,0xba,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x3c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x6,0x0,0x0,0x88,0x80,0x88,0x0,0x88,0x88,0x0,0x0,0x88,0x80,0x10,0x0,0x88,0x88,0x0,0x98,0x18,0x1a,0x98,0x0,0x1c,0x88,0x20,0x98,0x18,0x9c,0x20,0x0,0xa0,0x26,0x20,0x98,0xa8,0x28,0x20,0x0,0xa0,0xa8,0xac,0x30,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb2,0xb6,0xb9,0xbb,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x6,0x0,0x0,0x88,0x80,0x88,0x0,0x88,0x88,0x0,0x0,0x88,0x80,0x10,0x0,0x88,0x88,0x0,0x98,0x18,0x1a,0x98,0x0,0x1c,0x88,0x20,0x98,0x18,0x9c,0x20,0x0,0xa0,0x26,0x20,0x98,0xa8,0x28,0x20,0x0,0xa0,0xa8,0xac,0x30,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xac,0xb2,0xb6,0xb9,0xbb,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x3d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x2,0x80,0x0,0x0,0x80,0x0,0x80,0x80,0x84,0x8,0xc,0x0,0x80,0x0,0x80,0x10,0x10,0x90,0xc,0x0,0x80,0x0,0x18,0x10,0x10,0x18,0x9a,0x0,0x1e,0x0,0x18,0xa0,0x10,0xa0,0x20,0x0,0xa0,0xa8,0x18,0xa0,0xaa,0x28,0x20,0x0,0xa0,0xa8,0xb0,0x30,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x2,0x80,0x0,0x0,0x80,0x0,0x80,0x80,0x84,0x8,0xc,0x0,0x80,0x0,0x80,0x10,0x10,0x90,0xc,0x0,0x80,0x0,0x18,0x10,0x10,0x18,0x9a,0x0,0x1e,0x0,0x18,0xa0,0x10,0xa0,0x20,0x0,0xa0,0xa8,0x18,0xa0,0xaa,0x28,0x20,0x0,0xa0,0xa8,0xb0,0x30,0x2c,0x28,0x20,0x0,0xa0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x3e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x4,0x0,0x88,0x0,0x0,0x8,0x0,0x0,0x8c,0x0,0x88,0x0,0x14,0x8,0x0,0x0,0x14,0x0,0x88,0x0,0x14,0x9c,0x0,0xa0,0x9c,0x0,0x22,0x0,0xa2,0x20,0x0,0xa0,0x24,0x0,0xa4,0x2c,0x28,0x20,0x0,0xa0,0xac,0xb2,0x30,0x2c,0x28,0x20,0x0,0xa0,0xac,0xb2,0xb6,0xb9,0xbb,0xbd,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x4,0x0,0x88,0x0,0x0,0x8,0x0,0x0,0x8c,0x0,0x88,0x0,0x14,0x8,0x0,0x0,0x14,0x0,0x88,0x0,0x14,0x9c,0x0,0xa0,0x9c,0x0,0x22,0x0,0xa2,0x20,0x0,0xa0,0x24,0x0,0xa4,0x2c,0x28,0x20,0x0,0xa0,0xac,0xb2,0x30,0x2c,0x28,0x20,0x0,0xa0,0xac,0xb2,0xb6,0xb9,0xbb,0xbd,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x3f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x88,0xa,0x8c,0x0,0x0,0x10,0x90,0xc,0x10,0x90,0x10,0x0,0x0,0x10,0x90,0x98,0x98,0x90,0x10,0x9e,0x0,0x10,0x20,0x98,0x20,0x90,0xa4,0x20,0x0,0xa8,0x20,0x98,0xa8,0x2c,0x28,0x20,0x0,0xa8,0xb0,0x32,0x30,0x2c,0x28,0x20,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0xbe,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8a,0x88,0xa,0x8c,0x0,0x0,0x10,0x90,0xc,0x10,0x90,0x10,0x0,0x0,0x10,0x90,0x98,0x98,0x90,0x10,0x9e,0x0,0x10,0x20,0x98,0x20,0x90,0xa4,0x20,0x0,0xa8,0x20,0x98,0xa8,0x2c,0x28,0x20,0x0,0xa8,0xb0,0x32,0x30,0x2c,0x28,0x20,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0xbe,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x40,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x88,0x8,0x0,0x8,0x0,0xc,0x0,0x0,0x88,0x8,0x0,0x8,0x94,0xc,0x0,0x0,0x18,0x8,0x0,0x8,0x18,0x9c,0x0,0x0,0x18,0xa2,0x0,0xa4,0x18,0x9c,0x28,0x0,0xa8,0x24,0x0,0xa4,0xac,0x2c,0x28,0x0,0xa8,0xb0,0xb4,0x32,0x30,0x2c,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0xbe,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x88,0x8,0x0,0x8,0x0,0xc,0x0,0x0,0x88,0x8,0x0,0x8,0x94,0xc,0x0,0x0,0x18,0x8,0x0,0x8,0x18,0x9c,0x0,0x0,0x18,0xa2,0x0,0xa4,0x18,0x9c,0x28,0x0,0xa8,0x24,0x0,0xa4,0xac,0x2c,0x28,0x0,0xa8,0xb0,0xb4,0x32,0x30,0x2c,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0xbe,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x41,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x4,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x88,0x8a,0x0,0x90,0x0,0x0,0x90,0x92,0x88,0x12,0x0,0x90,0x0,0x9a,0x18,0x18,0x1c,0x9a,0x0,0x90,0x0,0x1c,0xa0,0x18,0xa0,0x22,0x0,0x28,0x0,0xa8,0x28,0x18,0xa0,0xaa,0x30,0x28,0x0,0xa8,0xb0,0xb4,0x34,0x32,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0xc0,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x4,0x4,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x88,0x8a,0x0,0x90,0x0,0x0,0x90,0x92,0x88,0x12,0x0,0x90,0x0,0x9a,0x18,0x18,0x1c,0x9a,0x0,0x90,0x0,0x1c,0xa0,0x18,0xa0,0x22,0x0,0x28,0x0,0xa8,0x28,0x18,0xa0,0xaa,0x30,0x28,0x0,0xa8,0xb0,0xb4,0x34,0x32,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbc,0xc0,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x42,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8,0x0,0x88,0x0,0x8,0x0,0x0,0x0,0x8,0x0,0x10,0x0,0x8,0x16,0x0,0x98,0x98,0x0,0x98,0x9c,0x9c,0x98,0x0,0x98,0x98,0x0,0x20,0x9c,0x20,0x98,0xa8,0x98,0x28,0x0,0xa8,0x2a,0x20,0x98,0xa8,0x30,0x28,0x0,0xa8,0xb0,0xb4,0x36,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbe,0xc1,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x8,0x0,0x88,0x0,0x8,0x0,0x0,0x0,0x8,0x0,0x10,0x0,0x8,0x16,0x0,0x98,0x98,0x0,0x98,0x9c,0x9c,0x98,0x0,0x98,0x98,0x0,0x20,0x9c,0x20,0x98,0xa8,0x98,0x28,0x0,0xa8,0x2a,0x20,0x98,0xa8,0x30,0x28,0x0,0xa8,0xb0,0xb4,0x36,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xba,0xbe,0xc1,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x43,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x80,0x4,0x0,0x4,0x80,0x80,0x0,0x0,0x80,0x4,0x0,0x8c,0x10,0x80,0x0,0x94,0x14,0x94,0x0,0x14,0x10,0x1a,0x0,0x94,0x98,0x94,0x0,0x9c,0xa0,0x9c,0x0,0x22,0x98,0x24,0x0,0x24,0xa0,0x28,0x0,0xa8,0x2c,0x24,0x0,0xac,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xbc,0xc0,0xc2,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x80,0x4,0x0,0x4,0x80,0x80,0x0,0x0,0x80,0x4,0x0,0x8c,0x10,0x80,0x0,0x94,0x14,0x94,0x0,0x14,0x10,0x1a,0x0,0x94,0x98,0x94,0x0,0x9c,0xa0,0x9c,0x0,0x22,0x98,0x24,0x0,0x24,0xa0,0x28,0x0,0xa8,0x2c,0x24,0x0,0xac,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xb8,0xbc,0xc0,0xc2,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x44,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x0,0x88,0x8,0x0,0xc,0xe,0x0,0x8,0x90,0x88,0x90,0x0,0x90,0x90,0x0,0x8,0x90,0x88,0x18,0x0,0x90,0x90,0x0,0xa0,0x20,0x22,0xa0,0x0,0x24,0x90,0x28,0xa0,0x20,0xa4,0x28,0x0,0xa8,0x2e,0x28,0xa0,0xb0,0x30,0x28,0x0,0xa8,0xb0,0xb4,0x38,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xba,0xbe,0xc1,0xc3,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x0,0x88,0x8,0x0,0xc,0xe,0x0,0x8,0x90,0x88,0x90,0x0,0x90,0x90,0x0,0x8,0x90,0x88,0x18,0x0,0x90,0x90,0x0,0xa0,0x20,0x22,0xa0,0x0,0x24,0x90,0x28,0xa0,0x20,0xa4,0x28,0x0,0xa8,0x2e,0x28,0xa0,0xb0,0x30,0x28,0x0,0xa8,0xb0,0xb4,0x38,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb4,0xba,0xbe,0xc1,0xc3,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x45,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x88,0x88,0xa,0x88,0x0,0x0,0x88,0x0,0x88,0x88,0x8c,0x10,0x14,0x0,0x88,0x0,0x88,0x18,0x18,0x98,0x14,0x0,0x88,0x0,0x20,0x18,0x18,0x20,0xa2,0x0,0x26,0x0,0x20,0xa8,0x18,0xa8,0x28,0x0,0xa8,0xb0,0x20,0xa8,0xb2,0x30,0x28,0x0,0xa8,0xb0,0xb8,0x38,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x88,0x88,0xa,0x88,0x0,0x0,0x88,0x0,0x88,0x88,0x8c,0x10,0x14,0x0,0x88,0x0,0x88,0x18,0x18,0x98,0x14,0x0,0x88,0x0,0x20,0x18,0x18,0x20,0xa2,0x0,0x26,0x0,0x20,0xa8,0x18,0xa8,0x28,0x0,0xa8,0xb0,0x20,0xa8,0xb2,0x30,0x28,0x0,0xa8,0xb0,0xb8,0x38,0x34,0x30,0x28,0x0,0xa8,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x46,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x80,0x0,0x0,0x0,0x84,0x0,0x4,0x0,0x80,0x8c,0x0,0x0,0xc,0x0,0x90,0x0,0x80,0x10,0x0,0x0,0x94,0x0,0x90,0x0,0x1c,0x10,0x0,0x0,0x1c,0x0,0x90,0x0,0x1c,0xa4,0x0,0xa8,0xa4,0x0,0x2a,0x0,0xaa,0x28,0x0,0xa8,0x2c,0x0,0xac,0x34,0x30,0x28,0x0,0xa8,0xb4,0xba,0x38,0x34,0x30,0x28,0x0,0xa8,0xb4,0xba,0xbe,0xc1,0xc3,0xc5,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x80,0x0,0x0,0x0,0x84,0x0,0x4,0x0,0x80,0x8c,0x0,0x0,0xc,0x0,0x90,0x0,0x80,0x10,0x0,0x0,0x94,0x0,0x90,0x0,0x1c,0x10,0x0,0x0,0x1c,0x0,0x90,0x0,0x1c,0xa4,0x0,0xa8,0xa4,0x0,0x2a,0x0,0xaa,0x28,0x0,0xa8,0x2c,0x0,0xac,0x34,0x30,0x28,0x0,0xa8,0xb4,0xba,0x38,0x34,0x30,0x28,0x0,0xa8,0xb4,0xba,0xbe,0xc1,0xc3,0xc5,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x47,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x84,0x0,0x86,0x0,0x80,0x8,0x0,0x8,0x84,0x0,0x8,0x0,0x80,0x8,0x92,0x90,0x12,0x94,0x8,0x0,0x18,0x98,0x14,0x18,0x98,0x18,0x8,0x0,0x18,0x98,0xa0,0xa0,0x98,0x18,0xa6,0x0,0x18,0x28,0xa0,0x28,0x98,0xac,0x28,0x0,0xb0,0x28,0xa0,0xb0,0x34,0x30,0x28,0x0,0xb0,0xb8,0x3a,0x38,0x34,0x30,0x28,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0xc6,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x84,0x0,0x86,0x0,0x80,0x8,0x0,0x8,0x84,0x0,0x8,0x0,0x80,0x8,0x92,0x90,0x12,0x94,0x8,0x0,0x18,0x98,0x14,0x18,0x98,0x18,0x8,0x0,0x18,0x98,0xa0,0xa0,0x98,0x18,0xa6,0x0,0x18,0x28,0xa0,0x28,0x98,0xac,0x28,0x0,0xb0,0x28,0xa0,0xb0,0x34,0x30,0x28,0x0,0xb0,0xb8,0x3a,0x38,0x34,0x30,0x28,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0xc6,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x48,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x84,0x84,0x0,0x0,0x0,0x84,0x0,0x8a,0x8,0x84,0x0,0x0,0x90,0x10,0x0,0x10,0x8,0x14,0x0,0x0,0x90,0x10,0x0,0x10,0x9c,0x14,0x0,0x0,0x20,0x10,0x0,0x10,0x20,0xa4,0x0,0x0,0x20,0xaa,0x0,0xac,0x20,0xa4,0x30,0x0,0xb0,0x2c,0x0,0xac,0xb4,0x34,0x30,0x0,0xb0,0xb8,0xbc,0x3a,0x38,0x34,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0xc6,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x84,0x84,0x0,0x0,0x0,0x84,0x0,0x8a,0x8,0x84,0x0,0x0,0x90,0x10,0x0,0x10,0x8,0x14,0x0,0x0,0x90,0x10,0x0,0x10,0x9c,0x14,0x0,0x0,0x20,0x10,0x0,0x10,0x20,0xa4,0x0,0x0,0x20,0xaa,0x0,0xac,0x20,0xa4,0x30,0x0,0xb0,0x2c,0x0,0xac,0xb4,0x34,0x30,0x0,0xb0,0xb8,0xbc,0x3a,0x38,0x34,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0xc6,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x49,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x8,0x88,0xc,0xc,0xa,0x0,0x0,0x0,0x8,0x10,0xc,0x90,0x92,0x0,0x98,0x0,0x8,0x98,0x9a,0x90,0x1a,0x0,0x98,0x0,0xa2,0x20,0x20,0x24,0xa2,0x0,0x98,0x0,0x24,0xa8,0x20,0xa8,0x2a,0x0,0x30,0x0,0xb0,0x30,0x20,0xa8,0xb2,0x38,0x30,0x0,0xb0,0xb8,0xbc,0x3c,0x3a,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0xc8,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x8,0x88,0xc,0xc,0xa,0x0,0x0,0x0,0x8,0x10,0xc,0x90,0x92,0x0,0x98,0x0,0x8,0x98,0x9a,0x90,0x1a,0x0,0x98,0x0,0xa2,0x20,0x20,0x24,0xa2,0x0,0x98,0x0,0x24,0xa8,0x20,0xa8,0x2a,0x0,0x30,0x0,0xb0,0x30,0x20,0xa8,0xb2,0x38,0x30,0x0,0xb0,0xb8,0xbc,0x3c,0x3a,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc4,0xc8,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x4a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x82,0x0,0x80,0x0,0x8,0x80,0x0,0x8,0x8,0x8c,0x80,0x0,0x8,0x10,0x0,0x90,0x8,0x10,0x80,0x0,0x8,0x10,0x0,0x18,0x8,0x10,0x1e,0x0,0xa0,0xa0,0x0,0xa0,0xa4,0xa4,0xa0,0x0,0xa0,0xa0,0x0,0x28,0xa4,0x28,0xa0,0xb0,0xa0,0x30,0x0,0xb0,0x32,0x28,0xa0,0xb0,0x38,0x30,0x0,0xb0,0xb8,0xbc,0x3e,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc6,0xc9,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x82,0x0,0x80,0x0,0x8,0x80,0x0,0x8,0x8,0x8c,0x80,0x0,0x8,0x10,0x0,0x90,0x8,0x10,0x80,0x0,0x8,0x10,0x0,0x18,0x8,0x10,0x1e,0x0,0xa0,0xa0,0x0,0xa0,0xa4,0xa4,0xa0,0x0,0xa0,0xa0,0x0,0x28,0xa4,0x28,0xa0,0xb0,0xa0,0x30,0x0,0xb0,0x32,0x28,0xa0,0xb0,0x38,0x30,0x0,0xb0,0xb8,0xbc,0x3e,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc2,0xc6,0xc9,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x4b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x84,0x88,0x88,0x0,0x0,0x88,0xc,0x0,0xc,0x88,0x88,0x0,0x0,0x88,0xc,0x0,0x94,0x18,0x88,0x0,0x9c,0x1c,0x9c,0x0,0x1c,0x18,0x22,0x0,0x9c,0xa0,0x9c,0x0,0xa4,0xa8,0xa4,0x0,0x2a,0xa0,0x2c,0x0,0x2c,0xa8,0x30,0x0,0xb0,0x34,0x2c,0x0,0xb4,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc4,0xc8,0xca,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x84,0x88,0x88,0x0,0x0,0x88,0xc,0x0,0xc,0x88,0x88,0x0,0x0,0x88,0xc,0x0,0x94,0x18,0x88,0x0,0x9c,0x1c,0x9c,0x0,0x1c,0x18,0x22,0x0,0x9c,0xa0,0x9c,0x0,0xa4,0xa8,0xa4,0x0,0x2a,0xa0,0x2c,0x0,0x2c,0xa8,0x30,0x0,0xb0,0x34,0x2c,0x0,0xb4,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc0,0xc4,0xc8,0xca,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x4c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x80,0x0,0x0,0x0,0x8,0x4,0x88,0x0,0x80,0x0,0x0,0x10,0x8,0x90,0x10,0x0,0x14,0x16,0x0,0x10,0x98,0x90,0x98,0x0,0x98,0x98,0x0,0x10,0x98,0x90,0x20,0x0,0x98,0x98,0x0,0xa8,0x28,0x2a,0xa8,0x0,0x2c,0x98,0x30,0xa8,0x28,0xac,0x30,0x0,0xb0,0x36,0x30,0xa8,0xb8,0x38,0x30,0x0,0xb0,0xb8,0xbc,0x40,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc2,0xc6,0xc9,0xcb,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x80,0x0,0x0,0x0,0x8,0x4,0x88,0x0,0x80,0x0,0x0,0x10,0x8,0x90,0x10,0x0,0x14,0x16,0x0,0x10,0x98,0x90,0x98,0x0,0x98,0x98,0x0,0x10,0x98,0x90,0x20,0x0,0x98,0x98,0x0,0xa8,0x28,0x2a,0xa8,0x0,0x2c,0x98,0x30,0xa8,0x28,0xac,0x30,0x0,0xb0,0x36,0x30,0xa8,0xb8,0x38,0x30,0x0,0xb0,0xb8,0xbc,0x40,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xbc,0xc2,0xc6,0xc9,0xcb,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x4d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x80,0x0,0xe,0x0,0x90,0x90,0x12,0x90,0x80,0x0,0x90,0x0,0x90,0x90,0x94,0x18,0x1c,0x0,0x90,0x0,0x90,0x20,0x20,0xa0,0x1c,0x0,0x90,0x0,0x28,0x20,0x20,0x28,0xaa,0x0,0x2e,0x0,0x28,0xb0,0x20,0xb0,0x30,0x0,0xb0,0xb8,0x28,0xb0,0xba,0x38,0x30,0x0,0xb0,0xb8,0xc0,0x40,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x80,0x0,0xe,0x0,0x90,0x90,0x12,0x90,0x80,0x0,0x90,0x0,0x90,0x90,0x94,0x18,0x1c,0x0,0x90,0x0,0x90,0x20,0x20,0xa0,0x1c,0x0,0x90,0x0,0x28,0x20,0x20,0x28,0xaa,0x0,0x2e,0x0,0x28,0xb0,0x20,0xb0,0x30,0x0,0xb0,0xb8,0x28,0xb0,0xba,0x38,0x30,0x0,0xb0,0xb8,0xc0,0x40,0x3c,0x38,0x30,0x0,0xb0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x4e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x4,0x0,0x8a,0x0,0x88,0x0,0x0,0x0,0x8c,0x0,0xc,0x0,0x88,0x94,0x0,0x0,0x14,0x0,0x98,0x0,0x88,0x18,0x0,0x0,0x9c,0x0,0x98,0x0,0x24,0x18,0x0,0x0,0x24,0x0,0x98,0x0,0x24,0xac,0x0,0xb0,0xac,0x0,0x32,0x0,0xb2,0x30,0x0,0xb0,0x34,0x0,0xb4,0x3c,0x38,0x30,0x0,0xb0,0xbc,0xc2,0x40,0x3c,0x38,0x30,0x0,0xb0,0xbc,0xc2,0xc6,0xc9,0xcb,0xcd,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x4,0x0,0x8a,0x0,0x88,0x0,0x0,0x0,0x8c,0x0,0xc,0x0,0x88,0x94,0x0,0x0,0x14,0x0,0x98,0x0,0x88,0x18,0x0,0x0,0x9c,0x0,0x98,0x0,0x24,0x18,0x0,0x0,0x24,0x0,0x98,0x0,0x24,0xac,0x0,0xb0,0xac,0x0,0x32,0x0,0xb2,0x30,0x0,0xb0,0x34,0x0,0xb4,0x3c,0x38,0x30,0x0,0xb0,0xbc,0xc2,0x40,0x3c,0x38,0x30,0x0,0xb0,0xbc,0xc2,0xc6,0xc9,0xcb,0xcd,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x4f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x84,0x0,0x0,0x88,0x80,0x0,0x88,0x8c,0x8,0x8e,0x0,0x88,0x10,0x0,0x10,0x8c,0x8,0x10,0x0,0x88,0x10,0x9a,0x98,0x1a,0x9c,0x10,0x0,0x20,0xa0,0x1c,0x20,0xa0,0x20,0x10,0x0,0x20,0xa0,0xa8,0xa8,0xa0,0x20,0xae,0x0,0x20,0x30,0xa8,0x30,0xa0,0xb4,0x30,0x0,0xb8,0x30,0xa8,0xb8,0x3c,0x38,0x30,0x0,0xb8,0xc0,0x42,0x40,0x3c,0x38,0x30,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0xce,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x84,0x0,0x0,0x88,0x80,0x0,0x88,0x8c,0x8,0x8e,0x0,0x88,0x10,0x0,0x10,0x8c,0x8,0x10,0x0,0x88,0x10,0x9a,0x98,0x1a,0x9c,0x10,0x0,0x20,0xa0,0x1c,0x20,0xa0,0x20,0x10,0x0,0x20,0xa0,0xa8,0xa8,0xa0,0x20,0xae,0x0,0x20,0x30,0xa8,0x30,0xa0,0xb4,0x30,0x0,0xb8,0x30,0xa8,0xb8,0x3c,0x38,0x30,0x0,0xb8,0xc0,0x42,0x40,0x3c,0x38,0x30,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0xce,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x50,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0xa,0x0,0x4,0x8c,0x8c,0x0,0x0,0x8,0x8c,0x0,0x92,0x10,0x8c,0x0,0x0,0x98,0x18,0x0,0x18,0x10,0x1c,0x0,0x0,0x98,0x18,0x0,0x18,0xa4,0x1c,0x0,0x0,0x28,0x18,0x0,0x18,0x28,0xac,0x0,0x0,0x28,0xb2,0x0,0xb4,0x28,0xac,0x38,0x0,0xb8,0x34,0x0,0xb4,0xbc,0x3c,0x38,0x0,0xb8,0xc0,0xc4,0x42,0x40,0x3c,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0xce,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x0,0x8,0xa,0x0,0x4,0x8c,0x8c,0x0,0x0,0x8,0x8c,0x0,0x92,0x10,0x8c,0x0,0x0,0x98,0x18,0x0,0x18,0x10,0x1c,0x0,0x0,0x98,0x18,0x0,0x18,0xa4,0x1c,0x0,0x0,0x28,0x18,0x0,0x18,0x28,0xac,0x0,0x0,0x28,0xb2,0x0,0xb4,0x28,0xac,0x38,0x0,0xb8,0x34,0x0,0xb4,0xbc,0x3c,0x38,0x0,0xb8,0xc0,0xc4,0x42,0x40,0x3c,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0xce,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x51,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x80,0x2,0x0,0x8,0x0,0x84,0x8,0x0,0x80,0x8a,0x0,0x8,0x0,0x10,0x90,0x14,0x14,0x12,0x0,0x8,0x0,0x10,0x18,0x14,0x98,0x9a,0x0,0xa0,0x0,0x10,0xa0,0xa2,0x98,0x22,0x0,0xa0,0x0,0xaa,0x28,0x28,0x2c,0xaa,0x0,0xa0,0x0,0x2c,0xb0,0x28,0xb0,0x32,0x0,0x38,0x0,0xb8,0x38,0x28,0xb0,0xba,0x40,0x38,0x0,0xb8,0xc0,0xc4,0x44,0x42,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0xd0,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x80,0x2,0x0,0x8,0x0,0x84,0x8,0x0,0x80,0x8a,0x0,0x8,0x0,0x10,0x90,0x14,0x14,0x12,0x0,0x8,0x0,0x10,0x18,0x14,0x98,0x9a,0x0,0xa0,0x0,0x10,0xa0,0xa2,0x98,0x22,0x0,0xa0,0x0,0xaa,0x28,0x28,0x2c,0xaa,0x0,0xa0,0x0,0x2c,0xb0,0x28,0xb0,0x32,0x0,0x38,0x0,0xb8,0x38,0x28,0xb0,0xba,0x40,0x38,0x0,0xb8,0xc0,0xc4,0x44,0x42,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xcc,0xd0,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x52,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x88,0x0,0x88,0x8a,0x0,0x88,0x0,0x10,0x88,0x0,0x10,0x10,0x94,0x88,0x0,0x10,0x18,0x0,0x98,0x10,0x18,0x88,0x0,0x10,0x18,0x0,0x20,0x10,0x18,0x26,0x0,0xa8,0xa8,0x0,0xa8,0xac,0xac,0xa8,0x0,0xa8,0xa8,0x0,0x30,0xac,0x30,0xa8,0xb8,0xa8,0x38,0x0,0xb8,0x3a,0x30,0xa8,0xb8,0x40,0x38,0x0,0xb8,0xc0,0xc4,0x46,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xce,0xd1,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x88,0x0,0x88,0x8a,0x0,0x88,0x0,0x10,0x88,0x0,0x10,0x10,0x94,0x88,0x0,0x10,0x18,0x0,0x98,0x10,0x18,0x88,0x0,0x10,0x18,0x0,0x20,0x10,0x18,0x26,0x0,0xa8,0xa8,0x0,0xa8,0xac,0xac,0xa8,0x0,0xa8,0xa8,0x0,0x30,0xac,0x30,0xa8,0xb8,0xa8,0x38,0x0,0xb8,0x3a,0x30,0xa8,0xb8,0x40,0x38,0x0,0xb8,0xc0,0xc4,0x46,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xca,0xce,0xd1,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x53,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x82,0x0,0x84,0x0,0x4,0x0,0x4,0x0,0x8,0xc,0x84,0x0,0x8c,0x90,0x90,0x0,0x8,0x90,0x14,0x0,0x14,0x90,0x90,0x0,0x8,0x90,0x14,0x0,0x9c,0x20,0x90,0x0,0xa4,0x24,0xa4,0x0,0x24,0x20,0x2a,0x0,0xa4,0xa8,0xa4,0x0,0xac,0xb0,0xac,0x0,0x32,0xa8,0x34,0x0,0x34,0xb0,0x38,0x0,0xb8,0x3c,0x34,0x0,0xbc,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xcc,0xd0,0xd2,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x82,0x0,0x84,0x0,0x4,0x0,0x4,0x0,0x8,0xc,0x84,0x0,0x8c,0x90,0x90,0x0,0x8,0x90,0x14,0x0,0x14,0x90,0x90,0x0,0x8,0x90,0x14,0x0,0x9c,0x20,0x90,0x0,0xa4,0x24,0xa4,0x0,0x24,0x20,0x2a,0x0,0xa4,0xa8,0xa4,0x0,0xac,0xb0,0xac,0x0,0x32,0xa8,0x34,0x0,0x34,0xb0,0x38,0x0,0xb8,0x3c,0x34,0x0,0xbc,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xc8,0xcc,0xd0,0xd2,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x54,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x80,0x0,0x4,0x80,0x0,0x80,0x80,0x8a,0x8,0x0,0x88,0x80,0x0,0x80,0x10,0xc,0x90,0x0,0x88,0x80,0x0,0x18,0x10,0x98,0x18,0x0,0x1c,0x1e,0x0,0x18,0xa0,0x98,0xa0,0x0,0xa0,0xa0,0x0,0x18,0xa0,0x98,0x28,0x0,0xa0,0xa0,0x0,0xb0,0x30,0x32,0xb0,0x0,0x34,0xa0,0x38,0xb0,0x30,0xb4,0x38,0x0,0xb8,0x3e,0x38,0xb0,0xc0,0x40,0x38,0x0,0xb8,0xc0,0xc4,0x48,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xca,0xce,0xd1,0xd3,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x80,0x0,0x4,0x80,0x0,0x80,0x80,0x8a,0x8,0x0,0x88,0x80,0x0,0x80,0x10,0xc,0x90,0x0,0x88,0x80,0x0,0x18,0x10,0x98,0x18,0x0,0x1c,0x1e,0x0,0x18,0xa0,0x98,0xa0,0x0,0xa0,0xa0,0x0,0x18,0xa0,0x98,0x28,0x0,0xa0,0xa0,0x0,0xb0,0x30,0x32,0xb0,0x0,0x34,0xa0,0x38,0xb0,0x30,0xb4,0x38,0x0,0xb8,0x3e,0x38,0xb0,0xc0,0x40,0x38,0x0,0xb8,0xc0,0xc4,0x48,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc4,0xca,0xce,0xd1,0xd3,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x55,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x80,0x88,0x88,0x0,0x0,0x0,0x0,0x8,0x80,0x10,0x88,0x0,0x16,0x0,0x98,0x98,0x1a,0x98,0x88,0x0,0x98,0x0,0x98,0x98,0x9c,0x20,0x24,0x0,0x98,0x0,0x98,0x28,0x28,0xa8,0x24,0x0,0x98,0x0,0x30,0x28,0x28,0x30,0xb2,0x0,0x36,0x0,0x30,0xb8,0x28,0xb8,0x38,0x0,0xb8,0xc0,0x30,0xb8,0xc2,0x40,0x38,0x0,0xb8,0xc0,0xc8,0x48,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x80,0x88,0x88,0x0,0x0,0x0,0x0,0x8,0x80,0x10,0x88,0x0,0x16,0x0,0x98,0x98,0x1a,0x98,0x88,0x0,0x98,0x0,0x98,0x98,0x9c,0x20,0x24,0x0,0x98,0x0,0x98,0x28,0x28,0xa8,0x24,0x0,0x98,0x0,0x30,0x28,0x28,0x30,0xb2,0x0,0x36,0x0,0x30,0xb8,0x28,0xb8,0x38,0x0,0xb8,0xc0,0x30,0xb8,0xc2,0x40,0x38,0x0,0xb8,0xc0,0xc8,0x48,0x44,0x40,0x38,0x0,0xb8,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x56,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x84,0x0,0x0,0x0,0xa,0x8,0x0,0x0,0xc,0x0,0x92,0x0,0x90,0x8,0x0,0x0,0x94,0x0,0x14,0x0,0x90,0x9c,0x0,0x0,0x1c,0x0,0xa0,0x0,0x90,0x20,0x0,0x0,0xa4,0x0,0xa0,0x0,0x2c,0x20,0x0,0x0,0x2c,0x0,0xa0,0x0,0x2c,0xb4,0x0,0xb8,0xb4,0x0,0x3a,0x0,0xba,0x38,0x0,0xb8,0x3c,0x0,0xbc,0x44,0x40,0x38,0x0,0xb8,0xc4,0xca,0x48,0x44,0x40,0x38,0x0,0xb8,0xc4,0xca,0xce,0xd1,0xd3,0xd5,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x84,0x0,0x0,0x0,0xa,0x8,0x0,0x0,0xc,0x0,0x92,0x0,0x90,0x8,0x0,0x0,0x94,0x0,0x14,0x0,0x90,0x9c,0x0,0x0,0x1c,0x0,0xa0,0x0,0x90,0x20,0x0,0x0,0xa4,0x0,0xa0,0x0,0x2c,0x20,0x0,0x0,0x2c,0x0,0xa0,0x0,0x2c,0xb4,0x0,0xb8,0xb4,0x0,0x3a,0x0,0xba,0x38,0x0,0xb8,0x3c,0x0,0xbc,0x44,0x40,0x38,0x0,0xb8,0xc4,0xca,0x48,0x44,0x40,0x38,0x0,0xb8,0xc4,0xca,0xce,0xd1,0xd3,0xd5,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x57,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0x8,0x0,0x8c,0x0,0x0,0x90,0x88,0x8,0x90,0x94,0x10,0x96,0x0,0x90,0x18,0x8,0x18,0x94,0x10,0x18,0x0,0x90,0x18,0xa2,0xa0,0x22,0xa4,0x18,0x0,0x28,0xa8,0x24,0x28,0xa8,0x28,0x18,0x0,0x28,0xa8,0xb0,0xb0,0xa8,0x28,0xb6,0x0,0x28,0x38,0xb0,0x38,0xa8,0xbc,0x38,0x0,0xc0,0x38,0xb0,0xc0,0x44,0x40,0x38,0x0,0xc0,0xc8,0x4a,0x48,0x44,0x40,0x38,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0xd6,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0x8,0x0,0x8c,0x0,0x0,0x90,0x88,0x8,0x90,0x94,0x10,0x96,0x0,0x90,0x18,0x8,0x18,0x94,0x10,0x18,0x0,0x90,0x18,0xa2,0xa0,0x22,0xa4,0x18,0x0,0x28,0xa8,0x24,0x28,0xa8,0x28,0x18,0x0,0x28,0xa8,0xb0,0xb0,0xa8,0x28,0xb6,0x0,0x28,0x38,0xb0,0x38,0xa8,0xbc,0x38,0x0,0xc0,0x38,0xb0,0xc0,0x44,0x40,0x38,0x0,0xc0,0xc8,0x4a,0x48,0x44,0x40,0x38,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0xd6,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x58,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x80,0x0,0x0,0xc,0x0,0x4,0x0,0x0,0x10,0x12,0x0,0xc,0x94,0x94,0x0,0x0,0x10,0x94,0x0,0x9a,0x18,0x94,0x0,0x0,0xa0,0x20,0x0,0x20,0x18,0x24,0x0,0x0,0xa0,0x20,0x0,0x20,0xac,0x24,0x0,0x0,0x30,0x20,0x0,0x20,0x30,0xb4,0x0,0x0,0x30,0xba,0x0,0xbc,0x30,0xb4,0x40,0x0,0xc0,0x3c,0x0,0xbc,0xc4,0x44,0x40,0x0,0xc0,0xc8,0xcc,0x4a,0x48,0x44,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0xd6,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x80,0x0,0x0,0xc,0x0,0x4,0x0,0x0,0x10,0x12,0x0,0xc,0x94,0x94,0x0,0x0,0x10,0x94,0x0,0x9a,0x18,0x94,0x0,0x0,0xa0,0x20,0x0,0x20,0x18,0x24,0x0,0x0,0xa0,0x20,0x0,0x20,0xac,0x24,0x0,0x0,0x30,0x20,0x0,0x20,0x30,0xb4,0x0,0x0,0x30,0xba,0x0,0xbc,0x30,0xb4,0x40,0x0,0xc0,0x3c,0x0,0xbc,0xc4,0x44,0x40,0x0,0xc0,0xc8,0xcc,0x4a,0x48,0x44,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0xd6,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x59,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x4,0x82,0x0,0x0,0x0,0xa,0x88,0x80,0x88,0xa,0x0,0x10,0x0,0x8c,0x10,0x80,0x88,0x92,0x0,0x10,0x0,0x18,0x98,0x1c,0x1c,0x1a,0x0,0x10,0x0,0x18,0x20,0x1c,0xa0,0xa2,0x0,0xa8,0x0,0x18,0xa8,0xaa,0xa0,0x2a,0x0,0xa8,0x0,0xb2,0x30,0x30,0x34,0xb2,0x0,0xa8,0x0,0x34,0xb8,0x30,0xb8,0x3a,0x0,0x40,0x0,0xc0,0x40,0x30,0xb8,0xc2,0x48,0x40,0x0,0xc0,0xc8,0xcc,0x4c,0x4a,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0xd8,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x4,0x82,0x0,0x0,0x0,0xa,0x88,0x80,0x88,0xa,0x0,0x10,0x0,0x8c,0x10,0x80,0x88,0x92,0x0,0x10,0x0,0x18,0x98,0x1c,0x1c,0x1a,0x0,0x10,0x0,0x18,0x20,0x1c,0xa0,0xa2,0x0,0xa8,0x0,0x18,0xa8,0xaa,0xa0,0x2a,0x0,0xa8,0x0,0xb2,0x30,0x30,0x34,0xb2,0x0,0xa8,0x0,0x34,0xb8,0x30,0xb8,0x3a,0x0,0x40,0x0,0xc0,0x40,0x30,0xb8,0xc2,0x48,0x40,0x0,0xc0,0xc8,0xcc,0x4c,0x4a,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd4,0xd8,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x5a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x4,0x84,0x0,0x0,0x80,0x0,0x0,0x8,0x4,0x8,0xe,0x0,0x80,0x90,0x0,0x90,0x92,0x8,0x90,0x0,0x18,0x90,0x0,0x18,0x18,0x9c,0x90,0x0,0x18,0x20,0x0,0xa0,0x18,0x20,0x90,0x0,0x18,0x20,0x0,0x28,0x18,0x20,0x2e,0x0,0xb0,0xb0,0x0,0xb0,0xb4,0xb4,0xb0,0x0,0xb0,0xb0,0x0,0x38,0xb4,0x38,0xb0,0xc0,0xb0,0x40,0x0,0xc0,0x42,0x38,0xb0,0xc0,0x48,0x40,0x0,0xc0,0xc8,0xcc,0x4e,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd6,0xd9,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x4,0x84,0x0,0x0,0x80,0x0,0x0,0x8,0x4,0x8,0xe,0x0,0x80,0x90,0x0,0x90,0x92,0x8,0x90,0x0,0x18,0x90,0x0,0x18,0x18,0x9c,0x90,0x0,0x18,0x20,0x0,0xa0,0x18,0x20,0x90,0x0,0x18,0x20,0x0,0x28,0x18,0x20,0x2e,0x0,0xb0,0xb0,0x0,0xb0,0xb4,0xb4,0xb0,0x0,0xb0,0xb0,0x0,0x38,0xb4,0x38,0xb0,0xc0,0xb0,0x40,0x0,0xc0,0x42,0x38,0xb0,0xc0,0x48,0x40,0x0,0xc0,0xc8,0xcc,0x4e,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd2,0xd6,0xd9,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x5b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x8,0x8a,0x0,0x8a,0x80,0x8c,0x0,0xc,0x8,0xc,0x0,0x10,0x14,0x8c,0x0,0x94,0x98,0x98,0x0,0x10,0x98,0x1c,0x0,0x1c,0x98,0x98,0x0,0x10,0x98,0x1c,0x0,0xa4,0x28,0x98,0x0,0xac,0x2c,0xac,0x0,0x2c,0x28,0x32,0x0,0xac,0xb0,0xac,0x0,0xb4,0xb8,0xb4,0x0,0x3a,0xb0,0x3c,0x0,0x3c,0xb8,0x40,0x0,0xc0,0x44,0x3c,0x0,0xc4,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd4,0xd8,0xda,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x8,0x8a,0x0,0x8a,0x80,0x8c,0x0,0xc,0x8,0xc,0x0,0x10,0x14,0x8c,0x0,0x94,0x98,0x98,0x0,0x10,0x98,0x1c,0x0,0x1c,0x98,0x98,0x0,0x10,0x98,0x1c,0x0,0xa4,0x28,0x98,0x0,0xac,0x2c,0xac,0x0,0x2c,0x28,0x32,0x0,0xac,0xb0,0xac,0x0,0xb4,0xb8,0xb4,0x0,0x3a,0xb0,0x3c,0x0,0x3c,0xb8,0x40,0x0,0xc0,0x44,0x3c,0x0,0xc4,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd0,0xd4,0xd8,0xda,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x5c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x88,0x88,0x0,0x88,0x0,0xc,0x88,0x0,0x88,0x88,0x92,0x10,0x0,0x90,0x88,0x0,0x88,0x18,0x14,0x98,0x0,0x90,0x88,0x0,0x20,0x18,0xa0,0x20,0x0,0x24,0x26,0x0,0x20,0xa8,0xa0,0xa8,0x0,0xa8,0xa8,0x0,0x20,0xa8,0xa0,0x30,0x0,0xa8,0xa8,0x0,0xb8,0x38,0x3a,0xb8,0x0,0x3c,0xa8,0x40,0xb8,0x38,0xbc,0x40,0x0,0xc0,0x46,0x40,0xb8,0xc8,0x48,0x40,0x0,0xc0,0xc8,0xcc,0x50,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd2,0xd6,0xd9,0xdb,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x88,0x88,0x0,0x88,0x0,0xc,0x88,0x0,0x88,0x88,0x92,0x10,0x0,0x90,0x88,0x0,0x88,0x18,0x14,0x98,0x0,0x90,0x88,0x0,0x20,0x18,0xa0,0x20,0x0,0x24,0x26,0x0,0x20,0xa8,0xa0,0xa8,0x0,0xa8,0xa8,0x0,0x20,0xa8,0xa0,0x30,0x0,0xa8,0xa8,0x0,0xb8,0x38,0x3a,0xb8,0x0,0x3c,0xa8,0x40,0xb8,0x38,0xbc,0x40,0x0,0xc0,0x46,0x40,0xb8,0xc8,0x48,0x40,0x0,0xc0,0xc8,0xcc,0x50,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xcc,0xd2,0xd6,0xd9,0xdb,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x5d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x80,0x88,0x8,0xa,0x0,0x80,0x0,0x8,0x10,0x88,0x90,0x90,0x0,0x80,0x0,0x8,0x10,0x88,0x18,0x90,0x0,0x1e,0x0,0xa0,0xa0,0x22,0xa0,0x90,0x0,0xa0,0x0,0xa0,0xa0,0xa4,0x28,0x2c,0x0,0xa0,0x0,0xa0,0x30,0x30,0xb0,0x2c,0x0,0xa0,0x0,0x38,0x30,0x30,0x38,0xba,0x0,0x3e,0x0,0x38,0xc0,0x30,0xc0,0x40,0x0,0xc0,0xc8,0x38,0xc0,0xca,0x48,0x40,0x0,0xc0,0xc8,0xd0,0x50,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x80,0x88,0x8,0xa,0x0,0x80,0x0,0x8,0x10,0x88,0x90,0x90,0x0,0x80,0x0,0x8,0x10,0x88,0x18,0x90,0x0,0x1e,0x0,0xa0,0xa0,0x22,0xa0,0x90,0x0,0xa0,0x0,0xa0,0xa0,0xa4,0x28,0x2c,0x0,0xa0,0x0,0xa0,0x30,0x30,0xb0,0x2c,0x0,0xa0,0x0,0x38,0x30,0x30,0x38,0xba,0x0,0x3e,0x0,0x38,0xc0,0x30,0xc0,0x40,0x0,0xc0,0xc8,0x38,0xc0,0xca,0x48,0x40,0x0,0xc0,0xc8,0xd0,0x50,0x4c,0x48,0x40,0x0,0xc0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x5e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x84,0x8c,0x0,0x0,0x8c,0x0,0x0,0x0,0x12,0x10,0x0,0x0,0x14,0x0,0x9a,0x0,0x98,0x10,0x0,0x0,0x9c,0x0,0x1c,0x0,0x98,0xa4,0x0,0x0,0x24,0x0,0xa8,0x0,0x98,0x28,0x0,0x0,0xac,0x0,0xa8,0x0,0x34,0x28,0x0,0x0,0x34,0x0,0xa8,0x0,0x34,0xbc,0x0,0xc0,0xbc,0x0,0x42,0x0,0xc2,0x40,0x0,0xc0,0x44,0x0,0xc4,0x4c,0x48,0x40,0x0,0xc0,0xcc,0xd2,0x50,0x4c,0x48,0x40,0x0,0xc0,0xcc,0xd2,0xd6,0xd9,0xdb,0xdd,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x84,0x8c,0x0,0x0,0x8c,0x0,0x0,0x0,0x12,0x10,0x0,0x0,0x14,0x0,0x9a,0x0,0x98,0x10,0x0,0x0,0x9c,0x0,0x1c,0x0,0x98,0xa4,0x0,0x0,0x24,0x0,0xa8,0x0,0x98,0x28,0x0,0x0,0xac,0x0,0xa8,0x0,0x34,0x28,0x0,0x0,0x34,0x0,0xa8,0x0,0x34,0xbc,0x0,0xc0,0xbc,0x0,0x42,0x0,0xc2,0x40,0x0,0xc0,0x44,0x0,0xc4,0x4c,0x48,0x40,0x0,0xc0,0xcc,0xd2,0x50,0x4c,0x48,0x40,0x0,0xc0,0xcc,0xd2,0xd6,0xd9,0xdb,0xdd,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x5f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x88,0x0,0x0,0x0,0x0,0x0,0x90,0x10,0x10,0x0,0x94,0x0,0x0,0x98,0x90,0x10,0x98,0x9c,0x18,0x9e,0x0,0x98,0x20,0x10,0x20,0x9c,0x18,0x20,0x0,0x98,0x20,0xaa,0xa8,0x2a,0xac,0x20,0x0,0x30,0xb0,0x2c,0x30,0xb0,0x30,0x20,0x0,0x30,0xb0,0xb8,0xb8,0xb0,0x30,0xbe,0x0,0x30,0x40,0xb8,0x40,0xb0,0xc4,0x40,0x0,0xc8,0x40,0xb8,0xc8,0x4c,0x48,0x40,0x0,0xc8,0xd0,0x52,0x50,0x4c,0x48,0x40,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0xde,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x88,0x0,0x0,0x0,0x0,0x0,0x90,0x10,0x10,0x0,0x94,0x0,0x0,0x98,0x90,0x10,0x98,0x9c,0x18,0x9e,0x0,0x98,0x20,0x10,0x20,0x9c,0x18,0x20,0x0,0x98,0x20,0xaa,0xa8,0x2a,0xac,0x20,0x0,0x30,0xb0,0x2c,0x30,0xb0,0x30,0x20,0x0,0x30,0xb0,0xb8,0xb8,0xb0,0x30,0xbe,0x0,0x30,0x40,0xb8,0x40,0xb0,0xc4,0x40,0x0,0xc8,0x40,0xb8,0xc8,0x4c,0x48,0x40,0x0,0xc8,0xd0,0x52,0x50,0x4c,0x48,0x40,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0xde,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x60,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x0,0x88,0x80,0x0,0x0,0x8,0xc,0x0,0x0,0x88,0x80,0x0,0x14,0x8,0xc,0x0,0x0,0x18,0x1a,0x0,0x14,0x9c,0x9c,0x0,0x0,0x18,0x9c,0x0,0xa2,0x20,0x9c,0x0,0x0,0xa8,0x28,0x0,0x28,0x20,0x2c,0x0,0x0,0xa8,0x28,0x0,0x28,0xb4,0x2c,0x0,0x0,0x38,0x28,0x0,0x28,0x38,0xbc,0x0,0x0,0x38,0xc2,0x0,0xc4,0x38,0xbc,0x48,0x0,0xc8,0x44,0x0,0xc4,0xcc,0x4c,0x48,0x0,0xc8,0xd0,0xd4,0x52,0x50,0x4c,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0xde,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x0,0x88,0x80,0x0,0x0,0x8,0xc,0x0,0x0,0x88,0x80,0x0,0x14,0x8,0xc,0x0,0x0,0x18,0x1a,0x0,0x14,0x9c,0x9c,0x0,0x0,0x18,0x9c,0x0,0xa2,0x20,0x9c,0x0,0x0,0xa8,0x28,0x0,0x28,0x20,0x2c,0x0,0x0,0xa8,0x28,0x0,0x28,0xb4,0x2c,0x0,0x0,0x38,0x28,0x0,0x28,0x38,0xbc,0x0,0x0,0x38,0xc2,0x0,0xc4,0x38,0xbc,0x48,0x0,0xc8,0x44,0x0,0xc4,0xcc,0x4c,0x48,0x0,0xc8,0xd0,0xd4,0x52,0x50,0x4c,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0xde,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x61,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x2,0x0,0x2,0x0,0x80,0x0,0x0,0x8,0x88,0xc,0x8a,0x0,0x80,0x0,0x12,0x90,0x88,0x90,0x12,0x0,0x18,0x0,0x94,0x18,0x88,0x90,0x9a,0x0,0x18,0x0,0x20,0xa0,0x24,0x24,0x22,0x0,0x18,0x0,0x20,0x28,0x24,0xa8,0xaa,0x0,0xb0,0x0,0x20,0xb0,0xb2,0xa8,0x32,0x0,0xb0,0x0,0xba,0x38,0x38,0x3c,0xba,0x0,0xb0,0x0,0x3c,0xc0,0x38,0xc0,0x42,0x0,0x48,0x0,0xc8,0x48,0x38,0xc0,0xca,0x50,0x48,0x0,0xc8,0xd0,0xd4,0x54,0x52,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0xe0,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x2,0x0,0x2,0x0,0x80,0x0,0x0,0x8,0x88,0xc,0x8a,0x0,0x80,0x0,0x12,0x90,0x88,0x90,0x12,0x0,0x18,0x0,0x94,0x18,0x88,0x90,0x9a,0x0,0x18,0x0,0x20,0xa0,0x24,0x24,0x22,0x0,0x18,0x0,0x20,0x28,0x24,0xa8,0xaa,0x0,0xb0,0x0,0x20,0xb0,0xb2,0xa8,0x32,0x0,0xb0,0x0,0xba,0x38,0x38,0x3c,0xba,0x0,0xb0,0x0,0x3c,0xc0,0x38,0xc0,0x42,0x0,0x48,0x0,0xc8,0x48,0x38,0xc0,0xca,0x50,0x48,0x0,0xc8,0xd0,0xd4,0x54,0x52,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xdc,0xe0,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x62,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0x0,0x88,0xc,0x8c,0x0,0x0,0x88,0x8,0x0,0x10,0xc,0x10,0x16,0x0,0x88,0x98,0x0,0x98,0x9a,0x10,0x98,0x0,0x20,0x98,0x0,0x20,0x20,0xa4,0x98,0x0,0x20,0x28,0x0,0xa8,0x20,0x28,0x98,0x0,0x20,0x28,0x0,0x30,0x20,0x28,0x36,0x0,0xb8,0xb8,0x0,0xb8,0xbc,0xbc,0xb8,0x0,0xb8,0xb8,0x0,0x40,0xbc,0x40,0xb8,0xc8,0xb8,0x48,0x0,0xc8,0x4a,0x40,0xb8,0xc8,0x50,0x48,0x0,0xc8,0xd0,0xd4,0x56,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xde,0xe1,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0x0,0x88,0xc,0x8c,0x0,0x0,0x88,0x8,0x0,0x10,0xc,0x10,0x16,0x0,0x88,0x98,0x0,0x98,0x9a,0x10,0x98,0x0,0x20,0x98,0x0,0x20,0x20,0xa4,0x98,0x0,0x20,0x28,0x0,0xa8,0x20,0x28,0x98,0x0,0x20,0x28,0x0,0x30,0x20,0x28,0x36,0x0,0xb8,0xb8,0x0,0xb8,0xbc,0xbc,0xb8,0x0,0xb8,0xb8,0x0,0x40,0xbc,0x40,0xb8,0xc8,0xb8,0x48,0x0,0xc8,0x4a,0x40,0xb8,0xc8,0x50,0x48,0x0,0xc8,0xd0,0xd4,0x56,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xda,0xde,0xe1,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x63,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x4,0x4,0x0,0x4,0x80,0x0,0x0,0x4,0x88,0x4,0x0,0x8c,0x10,0x92,0x0,0x92,0x88,0x94,0x0,0x14,0x10,0x14,0x0,0x18,0x1c,0x94,0x0,0x9c,0xa0,0xa0,0x0,0x18,0xa0,0x24,0x0,0x24,0xa0,0xa0,0x0,0x18,0xa0,0x24,0x0,0xac,0x30,0xa0,0x0,0xb4,0x34,0xb4,0x0,0x34,0x30,0x3a,0x0,0xb4,0xb8,0xb4,0x0,0xbc,0xc0,0xbc,0x0,0x42,0xb8,0x44,0x0,0x44,0xc0,0x48,0x0,0xc8,0x4c,0x44,0x0,0xcc,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xdc,0xe0,0xe2,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x4,0x4,0x0,0x4,0x80,0x0,0x0,0x4,0x88,0x4,0x0,0x8c,0x10,0x92,0x0,0x92,0x88,0x94,0x0,0x14,0x10,0x14,0x0,0x18,0x1c,0x94,0x0,0x9c,0xa0,0xa0,0x0,0x18,0xa0,0x24,0x0,0x24,0xa0,0xa0,0x0,0x18,0xa0,0x24,0x0,0xac,0x30,0xa0,0x0,0xb4,0x34,0xb4,0x0,0x34,0x30,0x3a,0x0,0xb4,0xb8,0xb4,0x0,0xbc,0xc0,0xbc,0x0,0x42,0xb8,0x44,0x0,0x44,0xc0,0x48,0x0,0xc8,0x4c,0x44,0x0,0xcc,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xd8,0xdc,0xe0,0xe2,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x64,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x80,0xe,0x0,0x90,0x90,0x0,0x90,0x0,0x14,0x90,0x0,0x90,0x90,0x9a,0x18,0x0,0x98,0x90,0x0,0x90,0x20,0x1c,0xa0,0x0,0x98,0x90,0x0,0x28,0x20,0xa8,0x28,0x0,0x2c,0x2e,0x0,0x28,0xb0,0xa8,0xb0,0x0,0xb0,0xb0,0x0,0x28,0xb0,0xa8,0x38,0x0,0xb0,0xb0,0x0,0xc0,0x40,0x42,0xc0,0x0,0x44,0xb0,0x48,0xc0,0x40,0xc4,0x48,0x0,0xc8,0x4e,0x48,0xc0,0xd0,0x50,0x48,0x0,0xc8,0xd0,0xd4,0x58,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xda,0xde,0xe1,0xe3,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x8,0x0,0x80,0xe,0x0,0x90,0x90,0x0,0x90,0x0,0x14,0x90,0x0,0x90,0x90,0x9a,0x18,0x0,0x98,0x90,0x0,0x90,0x20,0x1c,0xa0,0x0,0x98,0x90,0x0,0x28,0x20,0xa8,0x28,0x0,0x2c,0x2e,0x0,0x28,0xb0,0xa8,0xb0,0x0,0xb0,0xb0,0x0,0x28,0xb0,0xa8,0x38,0x0,0xb0,0xb0,0x0,0xc0,0x40,0x42,0xc0,0x0,0x44,0xb0,0x48,0xc0,0x40,0xc4,0x48,0x0,0xc8,0x4e,0x48,0xc0,0xd0,0x50,0x48,0x0,0xc8,0xd0,0xd4,0x58,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd4,0xda,0xde,0xe1,0xe3,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x65,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x84,0x0,0x6,0x0,0x0,0x88,0x4,0x88,0x84,0x0,0x88,0x0,0x10,0x88,0x90,0x10,0x12,0x0,0x88,0x0,0x10,0x18,0x90,0x98,0x98,0x0,0x88,0x0,0x10,0x18,0x90,0x20,0x98,0x0,0x26,0x0,0xa8,0xa8,0x2a,0xa8,0x98,0x0,0xa8,0x0,0xa8,0xa8,0xac,0x30,0x34,0x0,0xa8,0x0,0xa8,0x38,0x38,0xb8,0x34,0x0,0xa8,0x0,0x40,0x38,0x38,0x40,0xc2,0x0,0x46,0x0,0x40,0xc8,0x38,0xc8,0x48,0x0,0xc8,0xd0,0x40,0xc8,0xd2,0x50,0x48,0x0,0xc8,0xd0,0xd8,0x58,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x84,0x0,0x6,0x0,0x0,0x88,0x4,0x88,0x84,0x0,0x88,0x0,0x10,0x88,0x90,0x10,0x12,0x0,0x88,0x0,0x10,0x18,0x90,0x98,0x98,0x0,0x88,0x0,0x10,0x18,0x90,0x20,0x98,0x0,0x26,0x0,0xa8,0xa8,0x2a,0xa8,0x98,0x0,0xa8,0x0,0xa8,0xa8,0xac,0x30,0x34,0x0,0xa8,0x0,0xa8,0x38,0x38,0xb8,0x34,0x0,0xa8,0x0,0x40,0x38,0x38,0x40,0xc2,0x0,0x46,0x0,0x40,0xc8,0x38,0xc8,0x48,0x0,0xc8,0xd0,0x40,0xc8,0xd2,0x50,0x48,0x0,0xc8,0xd0,0xd8,0x58,0x54,0x50,0x48,0x0,0xc8,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x66,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x8,0x0,0x8c,0x0,0x0,0x0,0xc,0x0,0x8,0x0,0x8c,0x94,0x0,0x0,0x94,0x0,0x8,0x0,0x1a,0x18,0x0,0x0,0x1c,0x0,0xa2,0x0,0xa0,0x18,0x0,0x0,0xa4,0x0,0x24,0x0,0xa0,0xac,0x0,0x0,0x2c,0x0,0xb0,0x0,0xa0,0x30,0x0,0x0,0xb4,0x0,0xb0,0x0,0x3c,0x30,0x0,0x0,0x3c,0x0,0xb0,0x0,0x3c,0xc4,0x0,0xc8,0xc4,0x0,0x4a,0x0,0xca,0x48,0x0,0xc8,0x4c,0x0,0xcc,0x54,0x50,0x48,0x0,0xc8,0xd4,0xda,0x58,0x54,0x50,0x48,0x0,0xc8,0xd4,0xda,0xde,0xe1,0xe3,0xe5,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x8,0x0,0x8c,0x0,0x0,0x0,0xc,0x0,0x8,0x0,0x8c,0x94,0x0,0x0,0x94,0x0,0x8,0x0,0x1a,0x18,0x0,0x0,0x1c,0x0,0xa2,0x0,0xa0,0x18,0x0,0x0,0xa4,0x0,0x24,0x0,0xa0,0xac,0x0,0x0,0x2c,0x0,0xb0,0x0,0xa0,0x30,0x0,0x0,0xb4,0x0,0xb0,0x0,0x3c,0x30,0x0,0x0,0x3c,0x0,0xb0,0x0,0x3c,0xc4,0x0,0xc8,0xc4,0x0,0x4a,0x0,0xca,0x48,0x0,0xc8,0x4c,0x0,0xcc,0x54,0x50,0x48,0x0,0xc8,0xd4,0xda,0x58,0x54,0x50,0x48,0x0,0xc8,0xd4,0xda,0xde,0xe1,0xe3,0xe5,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x67,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x86,0x0,0x8,0x8,0xa,0x8,0x8,0x8,0x8,0x0,0x8,0x8,0x8c,0x90,0x8,0x8,0x8,0x0,0x8,0x98,0x18,0x18,0x8,0x9c,0x8,0x0,0xa0,0x98,0x18,0xa0,0xa4,0x20,0xa6,0x0,0xa0,0x28,0x18,0x28,0xa4,0x20,0x28,0x0,0xa0,0x28,0xb2,0xb0,0x32,0xb4,0x28,0x0,0x38,0xb8,0x34,0x38,0xb8,0x38,0x28,0x0,0x38,0xb8,0xc0,0xc0,0xb8,0x38,0xc6,0x0,0x38,0x48,0xc0,0x48,0xb8,0xcc,0x48,0x0,0xd0,0x48,0xc0,0xd0,0x54,0x50,0x48,0x0,0xd0,0xd8,0x5a,0x58,0x54,0x50,0x48,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0xe6,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x84,0x86,0x0,0x8,0x8,0xa,0x8,0x8,0x8,0x8,0x0,0x8,0x8,0x8c,0x90,0x8,0x8,0x8,0x0,0x8,0x98,0x18,0x18,0x8,0x9c,0x8,0x0,0xa0,0x98,0x18,0xa0,0xa4,0x20,0xa6,0x0,0xa0,0x28,0x18,0x28,0xa4,0x20,0x28,0x0,0xa0,0x28,0xb2,0xb0,0x32,0xb4,0x28,0x0,0x38,0xb8,0x34,0x38,0xb8,0x38,0x28,0x0,0x38,0xb8,0xc0,0xc0,0xb8,0x38,0xc6,0x0,0x38,0x48,0xc0,0x48,0xb8,0xcc,0x48,0x0,0xd0,0x48,0xc0,0xd0,0x54,0x50,0x48,0x0,0xd0,0xd8,0x5a,0x58,0x54,0x50,0x48,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0xe6,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x68,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x84,0x0,0x0,0x0,0x88,0x0,0x80,0x8c,0x84,0x0,0x0,0x90,0x88,0x0,0x80,0x10,0x14,0x0,0x0,0x90,0x88,0x0,0x1c,0x10,0x14,0x0,0x0,0x20,0x22,0x0,0x1c,0xa4,0xa4,0x0,0x0,0x20,0xa4,0x0,0xaa,0x28,0xa4,0x0,0x0,0xb0,0x30,0x0,0x30,0x28,0x34,0x0,0x0,0xb0,0x30,0x0,0x30,0xbc,0x34,0x0,0x0,0x40,0x30,0x0,0x30,0x40,0xc4,0x0,0x0,0x40,0xca,0x0,0xcc,0x40,0xc4,0x50,0x0,0xd0,0x4c,0x0,0xcc,0xd4,0x54,0x50,0x0,0xd0,0xd8,0xdc,0x5a,0x58,0x54,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0xe6,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x84,0x0,0x0,0x0,0x88,0x0,0x80,0x8c,0x84,0x0,0x0,0x90,0x88,0x0,0x80,0x10,0x14,0x0,0x0,0x90,0x88,0x0,0x1c,0x10,0x14,0x0,0x0,0x20,0x22,0x0,0x1c,0xa4,0xa4,0x0,0x0,0x20,0xa4,0x0,0xaa,0x28,0xa4,0x0,0x0,0xb0,0x30,0x0,0x30,0x28,0x34,0x0,0x0,0xb0,0x30,0x0,0x30,0xbc,0x34,0x0,0x0,0x40,0x30,0x0,0x30,0x40,0xc4,0x0,0x0,0x40,0xca,0x0,0xcc,0x40,0xc4,0x50,0x0,0xd0,0x4c,0x0,0xcc,0xd4,0x54,0x50,0x0,0xd0,0xd8,0xdc,0x5a,0x58,0x54,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0xe6,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x69,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x88,0x0,0x80,0x88,0xa,0x80,0xa,0x0,0x88,0x0,0x80,0x10,0x90,0x14,0x92,0x0,0x88,0x0,0x1a,0x98,0x90,0x98,0x1a,0x0,0x20,0x0,0x9c,0x20,0x90,0x98,0xa2,0x0,0x20,0x0,0x28,0xa8,0x2c,0x2c,0x2a,0x0,0x20,0x0,0x28,0x30,0x2c,0xb0,0xb2,0x0,0xb8,0x0,0x28,0xb8,0xba,0xb0,0x3a,0x0,0xb8,0x0,0xc2,0x40,0x40,0x44,0xc2,0x0,0xb8,0x0,0x44,0xc8,0x40,0xc8,0x4a,0x0,0x50,0x0,0xd0,0x50,0x40,0xc8,0xd2,0x58,0x50,0x0,0xd0,0xd8,0xdc,0x5c,0x5a,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0xe8,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x88,0x0,0x80,0x88,0xa,0x80,0xa,0x0,0x88,0x0,0x80,0x10,0x90,0x14,0x92,0x0,0x88,0x0,0x1a,0x98,0x90,0x98,0x1a,0x0,0x20,0x0,0x9c,0x20,0x90,0x98,0xa2,0x0,0x20,0x0,0x28,0xa8,0x2c,0x2c,0x2a,0x0,0x20,0x0,0x28,0x30,0x2c,0xb0,0xb2,0x0,0xb8,0x0,0x28,0xb8,0xba,0xb0,0x3a,0x0,0xb8,0x0,0xc2,0x40,0x40,0x44,0xc2,0x0,0xb8,0x0,0x44,0xc8,0x40,0xc8,0x4a,0x0,0x50,0x0,0xd0,0x50,0x40,0xc8,0xd2,0x58,0x50,0x0,0xd0,0xd8,0xdc,0x5c,0x5a,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe4,0xe8,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x6a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x0,0x0,0x80,0x0,0x90,0x10,0x0,0x90,0x14,0x94,0x80,0x0,0x90,0x10,0x0,0x18,0x14,0x18,0x1e,0x0,0x90,0xa0,0x0,0xa0,0xa2,0x18,0xa0,0x0,0x28,0xa0,0x0,0x28,0x28,0xac,0xa0,0x0,0x28,0x30,0x0,0xb0,0x28,0x30,0xa0,0x0,0x28,0x30,0x0,0x38,0x28,0x30,0x3e,0x0,0xc0,0xc0,0x0,0xc0,0xc4,0xc4,0xc0,0x0,0xc0,0xc0,0x0,0x48,0xc4,0x48,0xc0,0xd0,0xc0,0x50,0x0,0xd0,0x52,0x48,0xc0,0xd0,0x58,0x50,0x0,0xd0,0xd8,0xdc,0x5e,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe6,0xe9,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x0,0x0,0x80,0x0,0x90,0x10,0x0,0x90,0x14,0x94,0x80,0x0,0x90,0x10,0x0,0x18,0x14,0x18,0x1e,0x0,0x90,0xa0,0x0,0xa0,0xa2,0x18,0xa0,0x0,0x28,0xa0,0x0,0x28,0x28,0xac,0xa0,0x0,0x28,0x30,0x0,0xb0,0x28,0x30,0xa0,0x0,0x28,0x30,0x0,0x38,0x28,0x30,0x3e,0x0,0xc0,0xc0,0x0,0xc0,0xc4,0xc4,0xc0,0x0,0xc0,0xc0,0x0,0x48,0xc4,0x48,0xc0,0xd0,0xc0,0x50,0x0,0xd0,0x52,0x48,0xc0,0xd0,0x58,0x50,0x0,0xd0,0xd8,0xdc,0x5e,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe2,0xe6,0xe9,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x6b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x88,0x0,0x0,0xc,0xc,0xc,0x0,0xc,0x88,0x0,0x0,0xc,0x90,0xc,0x0,0x94,0x18,0x9a,0x0,0x9a,0x90,0x9c,0x0,0x1c,0x18,0x1c,0x0,0x20,0x24,0x9c,0x0,0xa4,0xa8,0xa8,0x0,0x20,0xa8,0x2c,0x0,0x2c,0xa8,0xa8,0x0,0x20,0xa8,0x2c,0x0,0xb4,0x38,0xa8,0x0,0xbc,0x3c,0xbc,0x0,0x3c,0x38,0x42,0x0,0xbc,0xc0,0xbc,0x0,0xc4,0xc8,0xc4,0x0,0x4a,0xc0,0x4c,0x0,0x4c,0xc8,0x50,0x0,0xd0,0x54,0x4c,0x0,0xd4,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe4,0xe8,0xea,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x88,0x0,0x0,0xc,0xc,0xc,0x0,0xc,0x88,0x0,0x0,0xc,0x90,0xc,0x0,0x94,0x18,0x9a,0x0,0x9a,0x90,0x9c,0x0,0x1c,0x18,0x1c,0x0,0x20,0x24,0x9c,0x0,0xa4,0xa8,0xa8,0x0,0x20,0xa8,0x2c,0x0,0x2c,0xa8,0xa8,0x0,0x20,0xa8,0x2c,0x0,0xb4,0x38,0xa8,0x0,0xbc,0x3c,0xbc,0x0,0x3c,0x38,0x42,0x0,0xbc,0xc0,0xbc,0x0,0xc4,0xc8,0xc4,0x0,0x4a,0xc0,0x4c,0x0,0x4c,0xc8,0x50,0x0,0xd0,0x54,0x4c,0x0,0xd4,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe0,0xe4,0xe8,0xea,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x6c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x8,0x88,0x0,0x88,0x0,0x0,0x0,0x8,0x8,0x10,0x0,0x88,0x16,0x0,0x98,0x98,0x8,0x98,0x0,0x1c,0x98,0x0,0x98,0x98,0xa2,0x20,0x0,0xa0,0x98,0x0,0x98,0x28,0x24,0xa8,0x0,0xa0,0x98,0x0,0x30,0x28,0xb0,0x30,0x0,0x34,0x36,0x0,0x30,0xb8,0xb0,0xb8,0x0,0xb8,0xb8,0x0,0x30,0xb8,0xb0,0x40,0x0,0xb8,0xb8,0x0,0xc8,0x48,0x4a,0xc8,0x0,0x4c,0xb8,0x50,0xc8,0x48,0xcc,0x50,0x0,0xd0,0x56,0x50,0xc8,0xd8,0x58,0x50,0x0,0xd0,0xd8,0xdc,0x60,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe2,0xe6,0xe9,0xeb,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x8,0x88,0x0,0x88,0x0,0x0,0x0,0x8,0x8,0x10,0x0,0x88,0x16,0x0,0x98,0x98,0x8,0x98,0x0,0x1c,0x98,0x0,0x98,0x98,0xa2,0x20,0x0,0xa0,0x98,0x0,0x98,0x28,0x24,0xa8,0x0,0xa0,0x98,0x0,0x30,0x28,0xb0,0x30,0x0,0x34,0x36,0x0,0x30,0xb8,0xb0,0xb8,0x0,0xb8,0xb8,0x0,0x30,0xb8,0xb0,0x40,0x0,0xb8,0xb8,0x0,0xc8,0x48,0x4a,0xc8,0x0,0x4c,0xb8,0x50,0xc8,0x48,0xcc,0x50,0x0,0xd0,0x56,0x50,0xc8,0xd8,0x58,0x50,0x0,0xd0,0xd8,0xdc,0x60,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xdc,0xe2,0xe6,0xe9,0xeb,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x6d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x80,0x0,0x8a,0x8,0x8c,0x0,0xe,0x0,0x80,0x90,0xc,0x90,0x8c,0x0,0x90,0x0,0x18,0x90,0x98,0x18,0x1a,0x0,0x90,0x0,0x18,0x20,0x98,0xa0,0xa0,0x0,0x90,0x0,0x18,0x20,0x98,0x28,0xa0,0x0,0x2e,0x0,0xb0,0xb0,0x32,0xb0,0xa0,0x0,0xb0,0x0,0xb0,0xb0,0xb4,0x38,0x3c,0x0,0xb0,0x0,0xb0,0x40,0x40,0xc0,0x3c,0x0,0xb0,0x0,0x48,0x40,0x40,0x48,0xca,0x0,0x4e,0x0,0x48,0xd0,0x40,0xd0,0x50,0x0,0xd0,0xd8,0x48,0xd0,0xda,0x58,0x50,0x0,0xd0,0xd8,0xe0,0x60,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x80,0x0,0x8a,0x8,0x8c,0x0,0xe,0x0,0x80,0x90,0xc,0x90,0x8c,0x0,0x90,0x0,0x18,0x90,0x98,0x18,0x1a,0x0,0x90,0x0,0x18,0x20,0x98,0xa0,0xa0,0x0,0x90,0x0,0x18,0x20,0x98,0x28,0xa0,0x0,0x2e,0x0,0xb0,0xb0,0x32,0xb0,0xa0,0x0,0xb0,0x0,0xb0,0xb0,0xb4,0x38,0x3c,0x0,0xb0,0x0,0xb0,0x40,0x40,0xc0,0x3c,0x0,0xb0,0x0,0x48,0x40,0x40,0x48,0xca,0x0,0x4e,0x0,0x48,0xd0,0x40,0xd0,0x50,0x0,0xd0,0xd8,0x48,0xd0,0xda,0x58,0x50,0x0,0xd0,0xd8,0xe0,0x60,0x5c,0x58,0x50,0x0,0xd0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x6e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x84,0x0,0x0,0x4,0x0,0x84,0x0,0x0,0x8,0x0,0x0,0x8c,0x0,0x10,0x0,0x94,0x8,0x0,0x0,0x14,0x0,0x10,0x0,0x94,0x9c,0x0,0x0,0x9c,0x0,0x10,0x0,0x22,0x20,0x0,0x0,0x24,0x0,0xaa,0x0,0xa8,0x20,0x0,0x0,0xac,0x0,0x2c,0x0,0xa8,0xb4,0x0,0x0,0x34,0x0,0xb8,0x0,0xa8,0x38,0x0,0x0,0xbc,0x0,0xb8,0x0,0x44,0x38,0x0,0x0,0x44,0x0,0xb8,0x0,0x44,0xcc,0x0,0xd0,0xcc,0x0,0x52,0x0,0xd2,0x50,0x0,0xd0,0x54,0x0,0xd4,0x5c,0x58,0x50,0x0,0xd0,0xdc,0xe2,0x60,0x5c,0x58,0x50,0x0,0xd0,0xdc,0xe2,0xe6,0xe9,0xeb,0xed,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x84,0x0,0x0,0x4,0x0,0x84,0x0,0x0,0x8,0x0,0x0,0x8c,0x0,0x10,0x0,0x94,0x8,0x0,0x0,0x14,0x0,0x10,0x0,0x94,0x9c,0x0,0x0,0x9c,0x0,0x10,0x0,0x22,0x20,0x0,0x0,0x24,0x0,0xaa,0x0,0xa8,0x20,0x0,0x0,0xac,0x0,0x2c,0x0,0xa8,0xb4,0x0,0x0,0x34,0x0,0xb8,0x0,0xa8,0x38,0x0,0x0,0xbc,0x0,0xb8,0x0,0x44,0x38,0x0,0x0,0x44,0x0,0xb8,0x0,0x44,0xcc,0x0,0xd0,0xcc,0x0,0x52,0x0,0xd2,0x50,0x0,0xd0,0x54,0x0,0xd4,0x5c,0x58,0x50,0x0,0xd0,0xdc,0xe2,0x60,0x5c,0x58,0x50,0x0,0xd0,0xdc,0xe2,0xe6,0xe9,0xeb,0xed,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x6f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x88,0x8a,0x8c,0x8e,0x0,0x10,0x10,0x12,0x10,0x10,0x10,0x10,0x0,0x10,0x10,0x94,0x98,0x10,0x10,0x10,0x0,0x10,0xa0,0x20,0x20,0x10,0xa4,0x10,0x0,0xa8,0xa0,0x20,0xa8,0xac,0x28,0xae,0x0,0xa8,0x30,0x20,0x30,0xac,0x28,0x30,0x0,0xa8,0x30,0xba,0xb8,0x3a,0xbc,0x30,0x0,0x40,0xc0,0x3c,0x40,0xc0,0x40,0x30,0x0,0x40,0xc0,0xc8,0xc8,0xc0,0x40,0xce,0x0,0x40,0x50,0xc8,0x50,0xc0,0xd4,0x50,0x0,0xd8,0x50,0xc8,0xd8,0x5c,0x58,0x50,0x0,0xd8,0xe0,0x62,0x60,0x5c,0x58,0x50,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0xee,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x88,0x8a,0x8c,0x8e,0x0,0x10,0x10,0x12,0x10,0x10,0x10,0x10,0x0,0x10,0x10,0x94,0x98,0x10,0x10,0x10,0x0,0x10,0xa0,0x20,0x20,0x10,0xa4,0x10,0x0,0xa8,0xa0,0x20,0xa8,0xac,0x28,0xae,0x0,0xa8,0x30,0x20,0x30,0xac,0x28,0x30,0x0,0xa8,0x30,0xba,0xb8,0x3a,0xbc,0x30,0x0,0x40,0xc0,0x3c,0x40,0xc0,0x40,0x30,0x0,0x40,0xc0,0xc8,0xc8,0xc0,0x40,0xce,0x0,0x40,0x50,0xc8,0x50,0xc0,0xd4,0x50,0x0,0xd8,0x50,0xc8,0xd8,0x5c,0x58,0x50,0x0,0xd8,0xe0,0x62,0x60,0x5c,0x58,0x50,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0xee,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x70,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x88,0x0,0x8c,0x0,0x0,0x8,0x90,0x0,0x88,0x94,0x8c,0x0,0x0,0x98,0x90,0x0,0x88,0x18,0x1c,0x0,0x0,0x98,0x90,0x0,0x24,0x18,0x1c,0x0,0x0,0x28,0x2a,0x0,0x24,0xac,0xac,0x0,0x0,0x28,0xac,0x0,0xb2,0x30,0xac,0x0,0x0,0xb8,0x38,0x0,0x38,0x30,0x3c,0x0,0x0,0xb8,0x38,0x0,0x38,0xc4,0x3c,0x0,0x0,0x48,0x38,0x0,0x38,0x48,0xcc,0x0,0x0,0x48,0xd2,0x0,0xd4,0x48,0xcc,0x58,0x0,0xd8,0x54,0x0,0xd4,0xdc,0x5c,0x58,0x0,0xd8,0xe0,0xe4,0x62,0x60,0x5c,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0xee,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x2,0x0,0x0,0x0,0x0,0x8,0x4,0x0,0x88,0x0,0x8c,0x0,0x0,0x8,0x90,0x0,0x88,0x94,0x8c,0x0,0x0,0x98,0x90,0x0,0x88,0x18,0x1c,0x0,0x0,0x98,0x90,0x0,0x24,0x18,0x1c,0x0,0x0,0x28,0x2a,0x0,0x24,0xac,0xac,0x0,0x0,0x28,0xac,0x0,0xb2,0x30,0xac,0x0,0x0,0xb8,0x38,0x0,0x38,0x30,0x3c,0x0,0x0,0xb8,0x38,0x0,0x38,0xc4,0x3c,0x0,0x0,0x48,0x38,0x0,0x38,0x48,0xcc,0x0,0x0,0x48,0xd2,0x0,0xd4,0x48,0xcc,0x58,0x0,0xd8,0x54,0x0,0xd4,0xdc,0x5c,0x58,0x0,0xd8,0xe0,0xe4,0x62,0x60,0x5c,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0xee,0x71,0x71,0x71,0x71,0x71,0x71,0x71,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x4,0x2,0x0,0x0,0x0,0x88,0x8,0x84,0x88,0x8a,0x0,0x90,0x0,0x88,0x90,0x12,0x88,0x12,0x0,0x90,0x0,0x88,0x18,0x98,0x1c,0x9a,0x0,0x90,0x0,0x22,0xa0,0x98,0xa0,0x22,0x0,0x28,0x0,0xa4,0x28,0x98,0xa0,0xaa,0x0,0x28,0x0,0x30,0xb0,0x34,0x34,0x32,0x0,0x28,0x0,0x30,0x38,0x34,0xb8,0xba,0x0,0xc0,0x0,0x30,0xc0,0xc2,0xb8,0x42,0x0,0xc0,0x0,0xca,0x48,0x48,0x4c,0xca,0x0,0xc0,0x0,0x4c,0xd0,0x48,0xd0,0x52,0x0,0x58,0x0,0xd8,0x58,0x48,0xd0,0xda,0x60,0x58,0x0,0xd8,0xe0,0xe4,0x64,0x62,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0xf0,0x72,0x72,0x72,0x72,0x72,0x72,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x4,0x2,0x0,0x0,0x0,0x88,0x8,0x84,0x88,0x8a,0x0,0x90,0x0,0x88,0x90,0x12,0x88,0x12,0x0,0x90,0x0,0x88,0x18,0x98,0x1c,0x9a,0x0,0x90,0x0,0x22,0xa0,0x98,0xa0,0x22,0x0,0x28,0x0,0xa4,0x28,0x98,0xa0,0xaa,0x0,0x28,0x0,0x30,0xb0,0x34,0x34,0x32,0x0,0x28,0x0,0x30,0x38,0x34,0xb8,0xba,0x0,0xc0,0x0,0x30,0xc0,0xc2,0xb8,0x42,0x0,0xc0,0x0,0xca,0x48,0x48,0x4c,0xca,0x0,0xc0,0x0,0x4c,0xd0,0x48,0xd0,0x52,0x0,0x58,0x0,0xd8,0x58,0x48,0xd0,0xda,0x60,0x58,0x0,0xd8,0xe0,0xe4,0x64,0x62,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xec,0xf0,0x72,0x72,0x72,0x72,0x72,0x72,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x6,0x0,0x0,0x88,0x0,0x88,0x80,0x8,0x88,0x0,0x0,0x88,0x0,0x10,0x80,0x8,0x88,0x0,0x98,0x18,0x0,0x98,0x1c,0x9c,0x88,0x0,0x98,0x18,0x0,0x20,0x1c,0x20,0x26,0x0,0x98,0xa8,0x0,0xa8,0xaa,0x20,0xa8,0x0,0x30,0xa8,0x0,0x30,0x30,0xb4,0xa8,0x0,0x30,0x38,0x0,0xb8,0x30,0x38,0xa8,0x0,0x30,0x38,0x0,0x40,0x30,0x38,0x46,0x0,0xc8,0xc8,0x0,0xc8,0xcc,0xcc,0xc8,0x0,0xc8,0xc8,0x0,0x50,0xcc,0x50,0xc8,0xd8,0xc8,0x58,0x0,0xd8,0x5a,0x50,0xc8,0xd8,0x60,0x58,0x0,0xd8,0xe0,0xe4,0x66,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xee,0xf1,0x73,0x73,0x73,0x73,0x73,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x6,0x0,0x0,0x88,0x0,0x88,0x80,0x8,0x88,0x0,0x0,0x88,0x0,0x10,0x80,0x8,0x88,0x0,0x98,0x18,0x0,0x98,0x1c,0x9c,0x88,0x0,0x98,0x18,0x0,0x20,0x1c,0x20,0x26,0x0,0x98,0xa8,0x0,0xa8,0xaa,0x20,0xa8,0x0,0x30,0xa8,0x0,0x30,0x30,0xb4,0xa8,0x0,0x30,0x38,0x0,0xb8,0x30,0x38,0xa8,0x0,0x30,0x38,0x0,0x40,0x30,0x38,0x46,0x0,0xc8,0xc8,0x0,0xc8,0xcc,0xcc,0xc8,0x0,0xc8,0xc8,0x0,0x50,0xcc,0x50,0xc8,0xd8,0xc8,0x58,0x0,0xd8,0x5a,0x50,0xc8,0xd8,0x60,0x58,0x0,0xd8,0xe0,0xe4,0x66,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xea,0xee,0xf1,0x73,0x73,0x73,0x73,0x73,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x4,0x0,0x8,0x0,0x0,0x80,0x84,0x0,0x8c,0x90,0x8,0x0,0x14,0x14,0x14,0x0,0x14,0x90,0x8,0x0,0x14,0x98,0x14,0x0,0x9c,0x20,0xa2,0x0,0xa2,0x98,0xa4,0x0,0x24,0x20,0x24,0x0,0x28,0x2c,0xa4,0x0,0xac,0xb0,0xb0,0x0,0x28,0xb0,0x34,0x0,0x34,0xb0,0xb0,0x0,0x28,0xb0,0x34,0x0,0xbc,0x40,0xb0,0x0,0xc4,0x44,0xc4,0x0,0x44,0x40,0x4a,0x0,0xc4,0xc8,0xc4,0x0,0xcc,0xd0,0xcc,0x0,0x52,0xc8,0x54,0x0,0x54,0xd0,0x58,0x0,0xd8,0x5c,0x54,0x0,0xdc,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xec,0xf0,0xf2,0x74,0x74,0x74,0x74,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x4,0x0,0x8,0x0,0x0,0x80,0x84,0x0,0x8c,0x90,0x8,0x0,0x14,0x14,0x14,0x0,0x14,0x90,0x8,0x0,0x14,0x98,0x14,0x0,0x9c,0x20,0xa2,0x0,0xa2,0x98,0xa4,0x0,0x24,0x20,0x24,0x0,0x28,0x2c,0xa4,0x0,0xac,0xb0,0xb0,0x0,0x28,0xb0,0x34,0x0,0x34,0xb0,0xb0,0x0,0x28,0xb0,0x34,0x0,0xbc,0x40,0xb0,0x0,0xc4,0x44,0xc4,0x0,0x44,0x40,0x4a,0x0,0xc4,0xc8,0xc4,0x0,0xcc,0xd0,0xcc,0x0,0x52,0xc8,0x54,0x0,0x54,0xd0,0x58,0x0,0xd8,0x5c,0x54,0x0,0xdc,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xe8,0xec,0xf0,0xf2,0x74,0x74,0x74,0x74,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x80,0x0,0x0,0x80,0x0,0x8,0x80,0x84,0x8,0x0,0xc,0x80,0x0,0x8,0x10,0x10,0x90,0x0,0x90,0x80,0x0,0x8,0x10,0x10,0x18,0x0,0x90,0x1e,0x0,0xa0,0xa0,0x10,0xa0,0x0,0x24,0xa0,0x0,0xa0,0xa0,0xaa,0x28,0x0,0xa8,0xa0,0x0,0xa0,0x30,0x2c,0xb0,0x0,0xa8,0xa0,0x0,0x38,0x30,0xb8,0x38,0x0,0x3c,0x3e,0x0,0x38,0xc0,0xb8,0xc0,0x0,0xc0,0xc0,0x0,0x38,0xc0,0xb8,0x48,0x0,0xc0,0xc0,0x0,0xd0,0x50,0x52,0xd0,0x0,0x54,0xc0,0x58,0xd0,0x50,0xd4,0x58,0x0,0xd8,0x5e,0x58,0xd0,0xe0,0x60,0x58,0x0,0xd8,0xe0,0xe4,0x68,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xea,0xee,0xf1,0xf3,0x75,0x75,0x75,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x80,0x0,0x0,0x80,0x0,0x8,0x80,0x84,0x8,0x0,0xc,0x80,0x0,0x8,0x10,0x10,0x90,0x0,0x90,0x80,0x0,0x8,0x10,0x10,0x18,0x0,0x90,0x1e,0x0,0xa0,0xa0,0x10,0xa0,0x0,0x24,0xa0,0x0,0xa0,0xa0,0xaa,0x28,0x0,0xa8,0xa0,0x0,0xa0,0x30,0x2c,0xb0,0x0,0xa8,0xa0,0x0,0x38,0x30,0xb8,0x38,0x0,0x3c,0x3e,0x0,0x38,0xc0,0xb8,0xc0,0x0,0xc0,0xc0,0x0,0x38,0xc0,0xb8,0x48,0x0,0xc0,0xc0,0x0,0xd0,0x50,0x52,0xd0,0x0,0x54,0xc0,0x58,0xd0,0x50,0xd4,0x58,0x0,0xd8,0x5e,0x58,0xd0,0xe0,0x60,0x58,0x0,0xd8,0xe0,0xe4,0x68,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe4,0xea,0xee,0xf1,0xf3,0x75,0x75,0x75,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0x0,0x88,0x0,0x0,0x0,0x0,0x88,0x8,0x92,0x10,0x94,0x0,0x16,0x0,0x88,0x98,0x14,0x98,0x94,0x0,0x98,0x0,0x20,0x98,0xa0,0x20,0x22,0x0,0x98,0x0,0x20,0x28,0xa0,0xa8,0xa8,0x0,0x98,0x0,0x20,0x28,0xa0,0x30,0xa8,0x0,0x36,0x0,0xb8,0xb8,0x3a,0xb8,0xa8,0x0,0xb8,0x0,0xb8,0xb8,0xbc,0x40,0x44,0x0,0xb8,0x0,0xb8,0x48,0x48,0xc8,0x44,0x0,0xb8,0x0,0x50,0x48,0x48,0x50,0xd2,0x0,0x56,0x0,0x50,0xd8,0x48,0xd8,0x58,0x0,0xd8,0xe0,0x50,0xd8,0xe2,0x60,0x58,0x0,0xd8,0xe0,0xe8,0x68,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe8,0xec,0xf0,0xf2,0xf4,0x76,0x76,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x8,0x0,0x88,0x0,0x0,0x0,0x0,0x88,0x8,0x92,0x10,0x94,0x0,0x16,0x0,0x88,0x98,0x14,0x98,0x94,0x0,0x98,0x0,0x20,0x98,0xa0,0x20,0x22,0x0,0x98,0x0,0x20,0x28,0xa0,0xa8,0xa8,0x0,0x98,0x0,0x20,0x28,0xa0,0x30,0xa8,0x0,0x36,0x0,0xb8,0xb8,0x3a,0xb8,0xa8,0x0,0xb8,0x0,0xb8,0xb8,0xbc,0x40,0x44,0x0,0xb8,0x0,0xb8,0x48,0x48,0xc8,0x44,0x0,0xb8,0x0,0x50,0x48,0x48,0x50,0xd2,0x0,0x56,0x0,0x50,0xd8,0x48,0xd8,0x58,0x0,0xd8,0xe0,0x50,0xd8,0xe2,0x60,0x58,0x0,0xd8,0xe0,0xe8,0x68,0x64,0x60,0x58,0x0,0xd8,0xe0,0xe8,0xec,0xf0,0xf2,0xf4,0x76,0x76,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0xa,0x0,0x0,0x8c,0x0,0x0,0xc,0x0,0x8c,0x0,0x0,0x10,0x0,0x0,0x94,0x0,0x18,0x0,0x9c,0x10,0x0,0x0,0x1c,0x0,0x18,0x0,0x9c,0xa4,0x0,0x0,0xa4,0x0,0x18,0x0,0x2a,0x28,0x0,0x0,0x2c,0x0,0xb2,0x0,0xb0,0x28,0x0,0x0,0xb4,0x0,0x34,0x0,0xb0,0xbc,0x0,0x0,0x3c,0x0,0xc0,0x0,0xb0,0x40,0x0,0x0,0xc4,0x0,0xc0,0x0,0x4c,0x40,0x0,0x0,0x4c,0x0,0xc0,0x0,0x4c,0xd4,0x0,0xd8,0xd4,0x0,0x5a,0x0,0xda,0x58,0x0,0xd8,0x5c,0x0,0xdc,0x64,0x60,0x58,0x0,0xd8,0xe4,0xea,0x68,0x64,0x60,0x58,0x0,0xd8,0xe4,0xea,0xee,0xf1,0xf3,0xf5,0x77,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0xa,0x0,0x0,0x8c,0x0,0x0,0xc,0x0,0x8c,0x0,0x0,0x10,0x0,0x0,0x94,0x0,0x18,0x0,0x9c,0x10,0x0,0x0,0x1c,0x0,0x18,0x0,0x9c,0xa4,0x0,0x0,0xa4,0x0,0x18,0x0,0x2a,0x28,0x0,0x0,0x2c,0x0,0xb2,0x0,0xb0,0x28,0x0,0x0,0xb4,0x0,0x34,0x0,0xb0,0xbc,0x0,0x0,0x3c,0x0,0xc0,0x0,0xb0,0x40,0x0,0x0,0xc4,0x0,0xc0,0x0,0x4c,0x40,0x0,0x0,0x4c,0x0,0xc0,0x0,0x4c,0xd4,0x0,0xd8,0xd4,0x0,0x5a,0x0,0xda,0x58,0x0,0xd8,0x5c,0x0,0xdc,0x64,0x60,0x58,0x0,0xd8,0xe4,0xea,0x68,0x64,0x60,0x58,0x0,0xd8,0xe4,0xea,0xee,0xf1,0xf3,0xf5,0x77,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x80,0x4,0x0,0x0,0x0,0x80,0x88,0x80,0x8,0x4,0x0,0x0,0x0,0x80,0x88,0x80,0x90,0x92,0x94,0x96,0x0,0x18,0x18,0x1a,0x18,0x18,0x18,0x18,0x0,0x18,0x18,0x9c,0xa0,0x18,0x18,0x18,0x0,0x18,0xa8,0x28,0x28,0x18,0xac,0x18,0x0,0xb0,0xa8,0x28,0xb0,0xb4,0x30,0xb6,0x0,0xb0,0x38,0x28,0x38,0xb4,0x30,0x38,0x0,0xb0,0x38,0xc2,0xc0,0x42,0xc4,0x38,0x0,0x48,0xc8,0x44,0x48,0xc8,0x48,0x38,0x0,0x48,0xc8,0xd0,0xd0,0xc8,0x48,0xd6,0x0,0x48,0x58,0xd0,0x58,0xc8,0xdc,0x58,0x0,0xe0,0x58,0xd0,0xe0,0x64,0x60,0x58,0x0,0xe0,0xe8,0x6a,0x68,0x64,0x60,0x58,0x0,0xe0,0xe8,0xec,0xf0,0xf2,0xf4,0xf6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x80,0x4,0x0,0x0,0x0,0x80,0x88,0x80,0x8,0x4,0x0,0x0,0x0,0x80,0x88,0x80,0x90,0x92,0x94,0x96,0x0,0x18,0x18,0x1a,0x18,0x18,0x18,0x18,0x0,0x18,0x18,0x9c,0xa0,0x18,0x18,0x18,0x0,0x18,0xa8,0x28,0x28,0x18,0xac,0x18,0x0,0xb0,0xa8,0x28,0xb0,0xb4,0x30,0xb6,0x0,0xb0,0x38,0x28,0x38,0xb4,0x30,0x38,0x0,0xb0,0x38,0xc2,0xc0,0x42,0xc4,0x38,0x0,0x48,0xc8,0x44,0x48,0xc8,0x48,0x38,0x0,0x48,0xc8,0xd0,0xd0,0xc8,0x48,0xd6,0x0,0x48,0x58,0xd0,0x58,0xc8,0xdc,0x58,0x0,0xe0,0x58,0xd0,0xe0,0x64,0x60,0x58,0x0,0xe0,0xe8,0x6a,0x68,0x64,0x60,0x58,0x0,0xe0,0xe8,0xec,0xf0,0xf2,0xf4,0xf6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x84,0x4,0x0,0x0,0x80,0x8a,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xc,0x0,0x90,0x8,0x94,0x0,0x0,0x10,0x98,0x0,0x90,0x9c,0x94,0x0,0x0,0xa0,0x98,0x0,0x90,0x20,0x24,0x0,0x0,0xa0,0x98,0x0,0x2c,0x20,0x24,0x0,0x0,0x30,0x32,0x0,0x2c,0xb4,0xb4,0x0,0x0,0x30,0xb4,0x0,0xba,0x38,0xb4,0x0,0x0,0xc0,0x40,0x0,0x40,0x38,0x44,0x0,0x0,0xc0,0x40,0x0,0x40,0xcc,0x44,0x0,0x0,0x50,0x40,0x0,0x40,0x50,0xd4,0x0,0x0,0x50,0xda,0x0,0xdc,0x50,0xd4,0x60,0x0,0xe0,0x5c,0x0,0xdc,0xe4,0x64,0x60,0x0,0xe0,0xe8,0xec,0x6a,0x68,0x64,0x60,0x0,0xe0,0xe8,0xec,0xf0,0xf2,0xf4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x84,0x4,0x0,0x0,0x80,0x8a,0x0,0xa,0x8,0x4,0x0,0x0,0x10,0xc,0x0,0x90,0x8,0x94,0x0,0x0,0x10,0x98,0x0,0x90,0x9c,0x94,0x0,0x0,0xa0,0x98,0x0,0x90,0x20,0x24,0x0,0x0,0xa0,0x98,0x0,0x2c,0x20,0x24,0x0,0x0,0x30,0x32,0x0,0x2c,0xb4,0xb4,0x0,0x0,0x30,0xb4,0x0,0xba,0x38,0xb4,0x0,0x0,0xc0,0x40,0x0,0x40,0x38,0x44,0x0,0x0,0xc0,0x40,0x0,0x40,0xcc,0x44,0x0,0x0,0x50,0x40,0x0,0x40,0x50,0xd4,0x0,0x0,0x50,0xda,0x0,0xdc,0x50,0xd4,0x60,0x0,0xe0,0x5c,0x0,0xdc,0xe4,0x64,0x60,0x0,0xe0,0xe8,0xec,0x6a,0x68,0x64,0x60,0x0,0xe0,0xe8,0xec,0xf0,0xf2,0xf4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x4,0x88,0x8c,0xc,0xa,0x0,0x0,0x0,0x90,0x10,0x8c,0x90,0x92,0x0,0x98,0x0,0x90,0x98,0x1a,0x90,0x1a,0x0,0x98,0x0,0x90,0x20,0xa0,0x24,0xa2,0x0,0x98,0x0,0x2a,0xa8,0xa0,0xa8,0x2a,0x0,0x30,0x0,0xac,0x30,0xa0,0xa8,0xb2,0x0,0x30,0x0,0x38,0xb8,0x3c,0x3c,0x3a,0x0,0x30,0x0,0x38,0x40,0x3c,0xc0,0xc2,0x0,0xc8,0x0,0x38,0xc8,0xca,0xc0,0x4a,0x0,0xc8,0x0,0xd2,0x50,0x50,0x54,0xd2,0x0,0xc8,0x0,0x54,0xd8,0x50,0xd8,0x5a,0x0,0x60,0x0,0xe0,0x60,0x50,0xd8,0xe2,0x68,0x60,0x0,0xe0,0xe8,0xec,0x6c,0x6a,0x68,0x60,0x0,0xe0,0xe8,0xec,0xf0,0xf2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x4,0x88,0x8c,0xc,0xa,0x0,0x0,0x0,0x90,0x10,0x8c,0x90,0x92,0x0,0x98,0x0,0x90,0x98,0x1a,0x90,0x1a,0x0,0x98,0x0,0x90,0x20,0xa0,0x24,0xa2,0x0,0x98,0x0,0x2a,0xa8,0xa0,0xa8,0x2a,0x0,0x30,0x0,0xac,0x30,0xa0,0xa8,0xb2,0x0,0x30,0x0,0x38,0xb8,0x3c,0x3c,0x3a,0x0,0x30,0x0,0x38,0x40,0x3c,0xc0,0xc2,0x0,0xc8,0x0,0x38,0xc8,0xca,0xc0,0x4a,0x0,0xc8,0x0,0xd2,0x50,0x50,0x54,0xd2,0x0,0xc8,0x0,0x54,0xd8,0x50,0xd8,0x5a,0x0,0x60,0x0,0xe0,0x60,0x50,0xd8,0xe2,0x68,0x60,0x0,0xe0,0xe8,0xec,0x6c,0x6a,0x68,0x60,0x0,0xe0,0xe8,0xec,0xf0,0xf2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x0,0x0,0x0,0x8,0x0,0x0,0x8,0x88,0x8c,0xe,0x0,0x8,0x90,0x0,0x90,0x88,0x10,0x90,0x0,0x8,0x90,0x0,0x18,0x88,0x10,0x90,0x0,0xa0,0x20,0x0,0xa0,0x24,0xa4,0x90,0x0,0xa0,0x20,0x0,0x28,0x24,0x28,0x2e,0x0,0xa0,0xb0,0x0,0xb0,0xb2,0x28,0xb0,0x0,0x38,0xb0,0x0,0x38,0x38,0xbc,0xb0,0x0,0x38,0x40,0x0,0xc0,0x38,0x40,0xb0,0x0,0x38,0x40,0x0,0x48,0x38,0x40,0x4e,0x0,0xd0,0xd0,0x0,0xd0,0xd4,0xd4,0xd0,0x0,0xd0,0xd0,0x0,0x58,0xd4,0x58,0xd0,0xe0,0xd0,0x60,0x0,0xe0,0x62,0x58,0xd0,0xe0,0x68,0x60,0x0,0xe0,0xe8,0xec,0x6e,0x6c,0x68,0x60,0x0,0xe0,0xe8,0xec,0xf0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x0,0x0,0x0,0x8,0x0,0x0,0x8,0x88,0x8c,0xe,0x0,0x8,0x90,0x0,0x90,0x88,0x10,0x90,0x0,0x8,0x90,0x0,0x18,0x88,0x10,0x90,0x0,0xa0,0x20,0x0,0xa0,0x24,0xa4,0x90,0x0,0xa0,0x20,0x0,0x28,0x24,0x28,0x2e,0x0,0xa0,0xb0,0x0,0xb0,0xb2,0x28,0xb0,0x0,0x38,0xb0,0x0,0x38,0x38,0xbc,0xb0,0x0,0x38,0x40,0x0,0xc0,0x38,0x40,0xb0,0x0,0x38,0x40,0x0,0x48,0x38,0x40,0x4e,0x0,0xd0,0xd0,0x0,0xd0,0xd4,0xd4,0xd0,0x0,0xd0,0xd0,0x0,0x58,0xd4,0x58,0xd0,0xe0,0xd0,0x60,0x0,0xe0,0x62,0x58,0xd0,0xe0,0x68,0x60,0x0,0xe0,0xe8,0xec,0x6e,0x6c,0x68,0x60,0x0,0xe0,0xe8,0xec,0xf0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x80,0x4,0x0,0x0,0x84,0x8,0x84,0x0,0x80,0x88,0x8c,0x0,0xc,0x8,0x10,0x0,0x80,0x88,0x8c,0x0,0x94,0x98,0x10,0x0,0x1c,0x1c,0x1c,0x0,0x1c,0x98,0x10,0x0,0x1c,0xa0,0x1c,0x0,0xa4,0x28,0xaa,0x0,0xaa,0xa0,0xac,0x0,0x2c,0x28,0x2c,0x0,0x30,0x34,0xac,0x0,0xb4,0xb8,0xb8,0x0,0x30,0xb8,0x3c,0x0,0x3c,0xb8,0xb8,0x0,0x30,0xb8,0x3c,0x0,0xc4,0x48,0xb8,0x0,0xcc,0x4c,0xcc,0x0,0x4c,0x48,0x52,0x0,0xcc,0xd0,0xcc,0x0,0xd4,0xd8,0xd4,0x0,0x5a,0xd0,0x5c,0x0,0x5c,0xd8,0x60,0x0,0xe0,0x64,0x5c,0x0,0xe4,0x68,0x60,0x0,0xe0,0xe8,0xec,0xf0,0x6c,0x68,0x60,0x0,0xe0,0xe8,0xec,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x80,0x4,0x0,0x0,0x84,0x8,0x84,0x0,0x80,0x88,0x8c,0x0,0xc,0x8,0x10,0x0,0x80,0x88,0x8c,0x0,0x94,0x98,0x10,0x0,0x1c,0x1c,0x1c,0x0,0x1c,0x98,0x10,0x0,0x1c,0xa0,0x1c,0x0,0xa4,0x28,0xaa,0x0,0xaa,0xa0,0xac,0x0,0x2c,0x28,0x2c,0x0,0x30,0x34,0xac,0x0,0xb4,0xb8,0xb8,0x0,0x30,0xb8,0x3c,0x0,0x3c,0xb8,0xb8,0x0,0x30,0xb8,0x3c,0x0,0xc4,0x48,0xb8,0x0,0xcc,0x4c,0xcc,0x0,0x4c,0x48,0x52,0x0,0xcc,0xd0,0xcc,0x0,0xd4,0xd8,0xd4,0x0,0x5a,0xd0,0x5c,0x0,0x5c,0xd8,0x60,0x0,0xe0,0x64,0x5c,0x0,0xe4,0x68,0x60,0x0,0xe0,0xe8,0xec,0xf0,0x6c,0x68,0x60,0x0,0xe0,0xe8,0xec,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x6,0x0,0x0,0x88,0xa,0x88,0x0,0x80,0x88,0x0,0x10,0x88,0x8c,0x10,0x0,0x14,0x88,0x0,0x10,0x18,0x18,0x98,0x0,0x98,0x88,0x0,0x10,0x18,0x18,0x20,0x0,0x98,0x26,0x0,0xa8,0xa8,0x18,0xa8,0x0,0x2c,0xa8,0x0,0xa8,0xa8,0xb2,0x30,0x0,0xb0,0xa8,0x0,0xa8,0x38,0x34,0xb8,0x0,0xb0,0xa8,0x0,0x40,0x38,0xc0,0x40,0x0,0x44,0x46,0x0,0x40,0xc8,0xc0,0xc8,0x0,0xc8,0xc8,0x0,0x40,0xc8,0xc0,0x50,0x0,0xc8,0xc8,0x0,0xd8,0x58,0x5a,0xd8,0x0,0x5c,0xc8,0x60,0xd8,0x58,0xdc,0x60,0x0,0xe0,0x66,0x60,0xd8,0xe8,0x68,0x60,0x0,0xe0,0xe8,0xec,0x70,0x6c,0x68,0x60,0x0,0xe0,0xe8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x6,0x0,0x0,0x88,0xa,0x88,0x0,0x80,0x88,0x0,0x10,0x88,0x8c,0x10,0x0,0x14,0x88,0x0,0x10,0x18,0x18,0x98,0x0,0x98,0x88,0x0,0x10,0x18,0x18,0x20,0x0,0x98,0x26,0x0,0xa8,0xa8,0x18,0xa8,0x0,0x2c,0xa8,0x0,0xa8,0xa8,0xb2,0x30,0x0,0xb0,0xa8,0x0,0xa8,0x38,0x34,0xb8,0x0,0xb0,0xa8,0x0,0x40,0x38,0xc0,0x40,0x0,0x44,0x46,0x0,0x40,0xc8,0xc0,0xc8,0x0,0xc8,0xc8,0x0,0x40,0xc8,0xc0,0x50,0x0,0xc8,0xc8,0x0,0xd8,0x58,0x5a,0xd8,0x0,0x5c,0xc8,0x60,0xd8,0x58,0xdc,0x60,0x0,0xe0,0x66,0x60,0xd8,0xe8,0x68,0x60,0x0,0xe0,0xe8,0xec,0x70,0x6c,0x68,0x60,0x0,0xe0,0xe8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x0,0x0,0x80,0x0,0x90,0x10,0x0,0x90,0x0,0x0,0x80,0x0,0x90,0x10,0x9a,0x18,0x9c,0x0,0x1e,0x0,0x90,0xa0,0x1c,0xa0,0x9c,0x0,0xa0,0x0,0x28,0xa0,0xa8,0x28,0x2a,0x0,0xa0,0x0,0x28,0x30,0xa8,0xb0,0xb0,0x0,0xa0,0x0,0x28,0x30,0xa8,0x38,0xb0,0x0,0x3e,0x0,0xc0,0xc0,0x42,0xc0,0xb0,0x0,0xc0,0x0,0xc0,0xc0,0xc4,0x48,0x4c,0x0,0xc0,0x0,0xc0,0x50,0x50,0xd0,0x4c,0x0,0xc0,0x0,0x58,0x50,0x50,0x58,0xda,0x0,0x5e,0x0,0x58,0xe0,0x50,0xe0,0x60,0x0,0xe0,0xe8,0x58,0xe0,0xea,0x68,0x60,0x0,0xe0,0xe8,0xf0,0x70,0x6c,0x68,0x60,0x0,0xe0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x0,0x0,0x80,0x0,0x90,0x10,0x0,0x90,0x0,0x0,0x80,0x0,0x90,0x10,0x9a,0x18,0x9c,0x0,0x1e,0x0,0x90,0xa0,0x1c,0xa0,0x9c,0x0,0xa0,0x0,0x28,0xa0,0xa8,0x28,0x2a,0x0,0xa0,0x0,0x28,0x30,0xa8,0xb0,0xb0,0x0,0xa0,0x0,0x28,0x30,0xa8,0x38,0xb0,0x0,0x3e,0x0,0xc0,0xc0,0x42,0xc0,0xb0,0x0,0xc0,0x0,0xc0,0xc0,0xc4,0x48,0x4c,0x0,0xc0,0x0,0xc0,0x50,0x50,0xd0,0x4c,0x0,0xc0,0x0,0x58,0x50,0x50,0x58,0xda,0x0,0x5e,0x0,0x58,0xe0,0x50,0xe0,0x60,0x0,0xe0,0xe8,0x58,0xe0,0xea,0x68,0x60,0x0,0xe0,0xe8,0xf0,0x70,0x6c,0x68,0x60,0x0,0xe0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x81,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x83,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x84,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x3,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x3,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x85,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x86,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x3,0x5,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x3,0x5,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x87,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x6,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x6,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x88,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x6,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x6,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x89,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x8,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x8,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x8a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x9,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x9,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x8b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x8c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x9,0xb,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x9,0xb,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x8d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x8e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x9,0xb,0xd,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x6,0x9,0xb,0xd,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x8f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0xe,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0xe,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x90,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x82,0x80,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0xe,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x82,0x80,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0xe,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x91,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0x10,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x84,0x82,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xc,0x10,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x92,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x86,0x84,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xe,0x11,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x86,0x84,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0xa,0xe,0x11,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x93,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0x84,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0xc,0x10,0x12,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0x84,0x80,0x0,0x0,0x0,0x0,0x4,0x8,0xc,0x10,0x12,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x94,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x88,0x84,0x80,0x0,0x0,0x0,0x0,0x4,0xa,0xe,0x11,0x13,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x88,0x84,0x80,0x0,0x0,0x0,0x0,0x4,0xa,0xe,0x11,0x13,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x95,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x84,0x80,0x0,0x0,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x84,0x80,0x0,0x0,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x96,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x0,0x4,0xa,0x88,0x84,0x80,0x0,0x0,0x0,0x4,0xa,0xe,0x11,0x13,0x15,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x0,0x4,0xa,0x88,0x84,0x80,0x0,0x0,0x0,0x4,0xa,0xe,0x11,0x13,0x15,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x97,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x0,0x8,0x8a,0x88,0x84,0x80,0x0,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x16,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x0,0x8,0x8a,0x88,0x84,0x80,0x0,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x16,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x98,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x4,0x84,0x80,0x0,0x0,0x8,0xc,0x8a,0x88,0x84,0x80,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x16,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x4,0x84,0x80,0x0,0x0,0x8,0xc,0x8a,0x88,0x84,0x80,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x16,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x99,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x2,0x88,0x80,0x0,0x0,0x8,0xc,0x8c,0x8a,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x18,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x2,0x88,0x80,0x0,0x0,0x8,0xc,0x8c,0x8a,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x12,0x14,0x18,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x9a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x80,0x0,0x0,0x8,0xc,0x8e,0x8c,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x12,0x16,0x19,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x80,0x0,0x0,0x8,0xc,0x8e,0x8c,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x12,0x16,0x19,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x9b,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x4,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x8c,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x14,0x18,0x1a,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x0,0x0,0x4,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x8c,0x88,0x80,0x0,0x0,0x8,0xc,0x10,0x14,0x18,0x1a,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x9c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x80,0x0,0x8,0x88,0x80,0x0,0x0,0x8,0xc,0x90,0x8c,0x88,0x80,0x0,0x0,0x8,0xc,0x12,0x16,0x19,0x1b,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x80,0x0,0x8,0x88,0x80,0x0,0x0,0x8,0xc,0x90,0x8c,0x88,0x80,0x0,0x0,0x8,0xc,0x12,0x16,0x19,0x1b,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x9d,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x8,0x0,0x0,0xa,0x88,0x80,0x0,0x0,0x8,0x10,0x90,0x8c,0x88,0x80,0x0,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x8,0x0,0x0,0xa,0x88,0x80,0x0,0x0,0x8,0x10,0x90,0x8c,0x88,0x80,0x0,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x9e,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x2,0x80,0x0,0x0,0x84,0x0,0x4,0x8c,0x88,0x80,0x0,0x0,0xc,0x12,0x90,0x8c,0x88,0x80,0x0,0x0,0xc,0x12,0x16,0x19,0x1b,0x1d,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x2,0x80,0x0,0x0,0x84,0x0,0x4,0x8c,0x88,0x80,0x0,0x0,0xc,0x12,0x90,0x8c,0x88,0x80,0x0,0x0,0xc,0x12,0x16,0x19,0x1b,0x1d,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x9f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x4,0x80,0x0,0x8,0x80,0x0,0x8,0x8c,0x88,0x80,0x0,0x8,0x10,0x92,0x90,0x8c,0x88,0x80,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x1e,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x4,0x80,0x0,0x8,0x80,0x0,0x8,0x8c,0x88,0x80,0x0,0x8,0x10,0x92,0x90,0x8c,0x88,0x80,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x1e,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0xa0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x4,0x0,0x0,0x88,0x0,0x8,0x84,0x0,0x4,0xc,0x8c,0x88,0x0,0x8,0x10,0x14,0x92,0x90,0x8c,0x88,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x1e,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x4,0x0,0x0,0x88,0x0,0x8,0x84,0x0,0x4,0xc,0x8c,0x88,0x0,0x8,0x10,0x14,0x92,0x90,0x8c,0x88,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x1e,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0xa1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x88,0x0,0x8,0x88,0x0,0x0,0xa,0x90,0x88,0x0,0x8,0x10,0x14,0x94,0x92,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x20,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x88,0x0,0x8,0x88,0x0,0x0,0xa,0x90,0x88,0x0,0x8,0x10,0x14,0x94,0x92,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x1a,0x1c,0x20,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0xa2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x8,0x0,0x88,0x0,0x8,0x8a,0x80,0x0,0x8,0x90,0x88,0x0,0x8,0x10,0x14,0x96,0x94,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x1a,0x1e,0x21,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x8,0x0,0x88,0x0,0x8,0x8a,0x80,0x0,0x8,0x90,0x88,0x0,0x8,0x10,0x14,0x96,0x94,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x1a,0x1e,0x21,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0xa3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x84,0x0,0x84,0x0,0x88,0x0,0x8,0x8c,0x84,0x0,0xc,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x94,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x1c,0x20,0x22,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x84,0x0,0x84,0x0,0x88,0x0,0x8,0x8c,0x84,0x0,0xc,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x94,0x90,0x88,0x0,0x8,0x10,0x14,0x18,0x1c,0x20,0x22,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0xa4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x0,0x84,0x0,0x88,0x0,0x80,0x4,0x88,0x0,0x8,0x8e,0x88,0x0,0x10,0x90,0x88,0x0,0x8,0x10,0x14,0x98,0x94,0x90,0x88,0x0,0x8,0x10,0x14,0x1a,0x1e,0x21,0x23,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x0,0x84,0x0,0x88,0x0,0x80,0x4,0x88,0x0,0x8,0x8e,0x88,0x0,0x10,0x90,0x88,0x0,0x8,0x10,0x14,0x98,0x94,0x90,0x88,0x0,0x8,0x10,0x14,0x1a,0x1e,0x21,0x23,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0xa5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x2,0x0,0x86,0x0,0x80,0x8,0x0,0x8,0x88,0x0,0x8,0x10,0x80,0x8,0x12,0x90,0x88,0x0,0x8,0x10,0x18,0x98,0x94,0x90,0x88,0x0,0x8,0x10,0x18,0x1c,0x20,0x22,0x24,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x2,0x0,0x86,0x0,0x80,0x8,0x0,0x8,0x88,0x0,0x8,0x10,0x80,0x8,0x12,0x90,0x88,0x0,0x8,0x10,0x18,0x98,0x94,0x90,0x88,0x0,0x8,0x10,0x18,0x1c,0x20,0x22,0x24,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0xa6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0x4,0x0,0x8a,0x0,0xa,0x88,0x0,0x8,0x8c,0x0,0xc,0x94,0x90,0x88,0x0,0x8,0x14,0x1a,0x98,0x94,0x90,0x88,0x0,0x8,0x14,0x1a,0x1e,0x21,0x23,0x25,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x8,0x4,0x0,0x8a,0x0,0xa,0x88,0x0,0x8,0x8c,0x0,0xc,0x94,0x90,0x88,0x0,0x8,0x14,0x1a,0x98,0x94,0x90,0x88,0x0,0x8,0x14,0x1a,0x1e,0x21,0x23,0x25,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0xa7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x88,0x0,0x88,0x0,0xc,0x88,0x0,0x10,0x88,0x0,0x10,0x94,0x90,0x88,0x0,0x10,0x18,0x9a,0x98,0x94,0x90,0x88,0x0,0x10,0x18,0x1c,0x20,0x22,0x24,0x26,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6,0x0,0x0,0x88,0x0,0x88,0x0,0xc,0x88,0x0,0x10,0x88,0x0,0x10,0x94,0x90,0x88,0x0,0x10,0x18,0x9a,0x98,0x94,0x90,0x88,0x0,0x10,0x18,0x1c,0x20,0x22,0x24,0x26,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0xa8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x4,0x0,0x0,0x80,0xa,0x0,0xc,0x80,0x4,0x90,0x0,0x10,0x8c,0x0,0xc,0x14,0x94,0x90,0x0,0x10,0x18,0x1c,0x9a,0x98,0x94,0x90,0x0,0x10,0x18,0x1c,0x20,0x22,0x24,0x26,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x4,0x0,0x0,0x80,0xa,0x0,0xc,0x80,0x4,0x90,0x0,0x10,0x8c,0x0,0xc,0x14,0x94,0x90,0x0,0x10,0x18,0x1c,0x9a,0x98,0x94,0x90,0x0,0x10,0x18,0x1c,0x20,0x22,0x24,0x26,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0xa9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x80,0x84,0x2,0x0,0x0,0x0,0x84,0x8,0x80,0x8,0x8a,0x0,0x90,0x0,0x10,0x90,0x80,0x8,0x12,0x98,0x90,0x0,0x10,0x18,0x1c,0x9c,0x9a,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x22,0x24,0x28,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x80,0x84,0x2,0x0,0x0,0x0,0x84,0x8,0x80,0x8,0x8a,0x0,0x90,0x0,0x10,0x90,0x80,0x8,0x12,0x98,0x90,0x0,0x10,0x18,0x1c,0x9c,0x9a,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x22,0x24,0x28,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0xaa,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x0,0x0,0x0,0x0,0x0,0x88,0x4,0x88,0x0,0x10,0x0,0x90,0x0,0x10,0x92,0x88,0x0,0x10,0x98,0x90,0x0,0x10,0x18,0x1c,0x9e,0x9c,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x22,0x26,0x29,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x4,0x0,0x0,0x0,0x0,0x0,0x88,0x4,0x88,0x0,0x10,0x0,0x90,0x0,0x10,0x92,0x88,0x0,0x10,0x98,0x90,0x0,0x10,0x18,0x1c,0x9e,0x9c,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x22,0x26,0x29,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0xab,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0x4,0x0,0x8a,0x0,0x8c,0x0,0x8c,0x8,0x90,0x0,0x10,0x94,0x8c,0x0,0x14,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x9c,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x24,0x28,0x2a,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0x4,0x0,0x8a,0x0,0x8c,0x0,0x8c,0x8,0x90,0x0,0x10,0x94,0x8c,0x0,0x14,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x9c,0x98,0x90,0x0,0x10,0x18,0x1c,0x20,0x24,0x28,0x2a,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0xac,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x8a,0x8,0x0,0x8c,0x0,0x90,0x8,0x88,0xc,0x90,0x0,0x10,0x96,0x90,0x8,0x18,0x98,0x90,0x0,0x10,0x18,0x1c,0xa0,0x9c,0x98,0x90,0x0,0x10,0x18,0x1c,0x22,0x26,0x29,0x2b,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x8a,0x8,0x0,0x8c,0x0,0x90,0x8,0x88,0xc,0x90,0x0,0x10,0x96,0x90,0x8,0x18,0x98,0x90,0x0,0x10,0x18,0x1c,0xa0,0x9c,0x98,0x90,0x0,0x10,0x18,0x1c,0x22,0x26,0x29,0x2b,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0xad,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x80,0x80,0x88,0xa,0x0,0x8e,0x0,0x88,0x10,0x80,0x10,0x90,0x0,0x10,0x18,0x88,0x10,0x1a,0x98,0x90,0x0,0x10,0x18,0x20,0xa0,0x9c,0x98,0x90,0x0,0x10,0x18,0x20,0x24,0x28,0x2a,0x2c,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x80,0x80,0x88,0xa,0x0,0x8e,0x0,0x88,0x10,0x80,0x10,0x90,0x0,0x10,0x18,0x88,0x10,0x1a,0x98,0x90,0x0,0x10,0x18,0x20,0xa0,0x9c,0x98,0x90,0x0,0x10,0x18,0x20,0x24,0x28,0x2a,0x2c,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0xae,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x84,0xc,0x0,0x10,0xc,0x0,0x92,0x0,0x12,0x90,0x0,0x10,0x94,0x0,0x14,0x9c,0x98,0x90,0x0,0x10,0x1c,0x22,0xa0,0x9c,0x98,0x90,0x0,0x10,0x1c,0x22,0x26,0x29,0x2b,0x2d,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x84,0xc,0x0,0x10,0xc,0x0,0x92,0x0,0x12,0x90,0x0,0x10,0x94,0x0,0x14,0x9c,0x98,0x90,0x0,0x10,0x1c,0x22,0xa0,0x9c,0x98,0x90,0x0,0x10,0x1c,0x22,0x26,0x29,0x2b,0x2d,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0xaf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x8,0x0,0x80,0xe,0x0,0x80,0x90,0x8,0x90,0x0,0x14,0x90,0x0,0x18,0x90,0x8,0x18,0x9c,0x98,0x90,0x0,0x18,0x20,0xa2,0xa0,0x9c,0x98,0x90,0x0,0x18,0x20,0x24,0x28,0x2a,0x2c,0x2e,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x8,0x8,0x0,0x80,0xe,0x0,0x80,0x90,0x8,0x90,0x0,0x14,0x90,0x0,0x18,0x90,0x8,0x18,0x9c,0x98,0x90,0x0,0x18,0x20,0xa2,0xa0,0x9c,0x98,0x90,0x0,0x18,0x20,0x24,0x28,0x2a,0x2c,0x2e,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0xb0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0xc,0x0,0x0,0x88,0x12,0x0,0x14,0x88,0xc,0x98,0x0,0x18,0x94,0x0,0x14,0x1c,0x9c,0x98,0x0,0x18,0x20,0x24,0xa2,0xa0,0x9c,0x98,0x0,0x18,0x20,0x24,0x28,0x2a,0x2c,0x2e,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x88,0xc,0x0,0x0,0x88,0x12,0x0,0x14,0x88,0xc,0x98,0x0,0x18,0x94,0x0,0x14,0x1c,0x9c,0x98,0x0,0x18,0x20,0x24,0xa2,0xa0,0x9c,0x98,0x0,0x18,0x20,0x24,0x28,0x2a,0x2c,0x2e,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0xb1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x82,0x0,0x0,0x0,0xa,0x88,0x88,0x8c,0xa,0x0,0x0,0x0,0x8c,0x10,0x88,0x10,0x92,0x0,0x98,0x0,0x18,0x98,0x88,0x10,0x1a,0xa0,0x98,0x0,0x18,0x20,0x24,0xa4,0xa2,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0x2a,0x2c,0x30,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x82,0x0,0x0,0x0,0xa,0x88,0x88,0x8c,0xa,0x0,0x0,0x0,0x8c,0x10,0x88,0x10,0x92,0x0,0x98,0x0,0x18,0x98,0x88,0x10,0x1a,0xa0,0x98,0x0,0x18,0x20,0x24,0xa4,0xa2,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0x2a,0x2c,0x30,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0xb2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8,0x8,0x0,0x8,0xc,0xc,0x8,0x0,0x8,0x8,0x0,0x90,0xc,0x90,0x8,0x18,0x8,0x98,0x0,0x18,0x9a,0x90,0x8,0x18,0xa0,0x98,0x0,0x18,0x20,0x24,0xa6,0xa4,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0x2a,0x2e,0x31,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8,0x8,0x0,0x8,0xc,0xc,0x8,0x0,0x8,0x8,0x0,0x90,0xc,0x90,0x8,0x18,0x8,0x98,0x0,0x18,0x9a,0x90,0x8,0x18,0xa0,0x98,0x0,0x18,0x20,0x24,0xa6,0xa4,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0x2a,0x2e,0x31,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0xb3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x84,0x4,0x0,0x84,0x80,0x8a,0x0,0x4,0x8,0x4,0x0,0xc,0x10,0xc,0x0,0x92,0x8,0x94,0x0,0x94,0x10,0x98,0x0,0x18,0x9c,0x94,0x0,0x1c,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0xa4,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0x2c,0x30,0x32,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x84,0x4,0x0,0x84,0x80,0x8a,0x0,0x4,0x8,0x4,0x0,0xc,0x10,0xc,0x0,0x92,0x8,0x94,0x0,0x94,0x10,0x98,0x0,0x18,0x9c,0x94,0x0,0x1c,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0xa4,0xa0,0x98,0x0,0x18,0x20,0x24,0x28,0x2c,0x30,0x32,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0xb4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x0,0x10,0x90,0x92,0x10,0x0,0x94,0x0,0x98,0x10,0x90,0x14,0x98,0x0,0x18,0x9e,0x98,0x10,0x20,0xa0,0x98,0x0,0x18,0x20,0x24,0xa8,0xa4,0xa0,0x98,0x0,0x18,0x20,0x24,0x2a,0x2e,0x31,0x33,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x0,0x0,0x0,0x10,0x90,0x92,0x10,0x0,0x94,0x0,0x98,0x10,0x90,0x14,0x98,0x0,0x18,0x9e,0x98,0x10,0x20,0xa0,0x98,0x0,0x18,0x20,0x24,0xa8,0xa4,0xa0,0x98,0x0,0x18,0x20,0x24,0x2a,0x2e,0x31,0x33,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0xb5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x0,0x0,0x0,0x88,0x88,0x8,0x84,0x0,0x0,0x0,0x90,0x88,0x88,0x90,0x12,0x0,0x96,0x0,0x90,0x18,0x88,0x18,0x98,0x0,0x18,0x20,0x90,0x18,0x22,0xa0,0x98,0x0,0x18,0x20,0x28,0xa8,0xa4,0xa0,0x98,0x0,0x18,0x20,0x28,0x2c,0x30,0x32,0x34,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x0,0x0,0x0,0x88,0x88,0x8,0x84,0x0,0x0,0x0,0x90,0x88,0x88,0x90,0x12,0x0,0x96,0x0,0x90,0x18,0x88,0x18,0x98,0x0,0x18,0x20,0x90,0x18,0x22,0xa0,0x98,0x0,0x18,0x20,0x28,0xa8,0xa4,0xa0,0x98,0x0,0x18,0x20,0x28,0x2c,0x30,0x32,0x34,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0xb6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x0,0x0,0x0,0x8c,0x80,0x0,0x0,0x8c,0x0,0x0,0x0,0x8c,0x14,0x0,0x18,0x14,0x0,0x9a,0x0,0x1a,0x98,0x0,0x18,0x9c,0x0,0x1c,0xa4,0xa0,0x98,0x0,0x18,0x24,0x2a,0xa8,0xa4,0xa0,0x98,0x0,0x18,0x24,0x2a,0x2e,0x31,0x33,0x35,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x0,0x0,0x0,0x8c,0x80,0x0,0x0,0x8c,0x0,0x0,0x0,0x8c,0x14,0x0,0x18,0x14,0x0,0x9a,0x0,0x1a,0x98,0x0,0x18,0x9c,0x0,0x1c,0xa4,0xa0,0x98,0x0,0x18,0x24,0x2a,0xa8,0xa4,0xa0,0x98,0x0,0x18,0x24,0x2a,0x2e,0x31,0x33,0x35,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x82,0x4,0x0,0x0,0x88,0x8,0x84,0x88,0x8,0x88,0x0,0x0,0x88,0x8,0x10,0x10,0x8,0x88,0x16,0x0,0x88,0x98,0x10,0x98,0x8,0x1c,0x98,0x0,0x20,0x98,0x10,0x20,0xa4,0xa0,0x98,0x0,0x20,0x28,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0x20,0x28,0x2c,0x30,0x32,0x34,0x36,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x82,0x4,0x0,0x0,0x88,0x8,0x84,0x88,0x8,0x88,0x0,0x0,0x88,0x8,0x10,0x10,0x8,0x88,0x16,0x0,0x88,0x98,0x10,0x98,0x8,0x1c,0x98,0x0,0x20,0x98,0x10,0x20,0xa4,0xa0,0x98,0x0,0x20,0x28,0xaa,0xa8,0xa4,0xa0,0x98,0x0,0x20,0x28,0x2c,0x30,0x32,0x34,0x36,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0xb8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x84,0x0,0x0,0x0,0x80,0x0,0x80,0xc,0x84,0x0,0x0,0x90,0x80,0x0,0x80,0x90,0x14,0x0,0x0,0x90,0x1a,0x0,0x1c,0x90,0x14,0xa0,0x0,0x20,0x9c,0x0,0x1c,0x24,0xa4,0xa0,0x0,0x20,0x28,0x2c,0xaa,0xa8,0xa4,0xa0,0x0,0x20,0x28,0x2c,0x30,0x32,0x34,0x36,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x84,0x0,0x0,0x0,0x80,0x0,0x80,0xc,0x84,0x0,0x0,0x90,0x80,0x0,0x80,0x90,0x14,0x0,0x0,0x90,0x1a,0x0,0x1c,0x90,0x14,0xa0,0x0,0x20,0x9c,0x0,0x1c,0x24,0xa4,0xa0,0x0,0x20,0x28,0x2c,0xaa,0xa8,0xa4,0xa0,0x0,0x20,0x28,0x2c,0x30,0x32,0x34,0x36,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0xb9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x8,0x0,0x0,0x8,0xa,0x0,0x8a,0x0,0x8,0x0,0x12,0x90,0x90,0x94,0x12,0x0,0x8,0x0,0x94,0x18,0x90,0x18,0x9a,0x0,0xa0,0x0,0x20,0xa0,0x90,0x18,0x22,0xa8,0xa0,0x0,0x20,0x28,0x2c,0xac,0xaa,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0x32,0x34,0x38,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x8,0x0,0x0,0x8,0xa,0x0,0x8a,0x0,0x8,0x0,0x12,0x90,0x90,0x94,0x12,0x0,0x8,0x0,0x94,0x18,0x90,0x18,0x9a,0x0,0xa0,0x0,0x20,0xa0,0x90,0x18,0x22,0xa8,0xa0,0x0,0x20,0x28,0x2c,0xac,0xaa,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0x32,0x34,0x38,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0xba,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x88,0x0,0x80,0x8e,0x0,0x10,0x10,0x0,0x10,0x14,0x14,0x10,0x0,0x10,0x10,0x0,0x98,0x14,0x98,0x10,0x20,0x10,0xa0,0x0,0x20,0xa2,0x98,0x10,0x20,0xa8,0xa0,0x0,0x20,0x28,0x2c,0xae,0xac,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0x32,0x36,0x39,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x80,0x0,0x88,0x0,0x80,0x8e,0x0,0x10,0x10,0x0,0x10,0x14,0x14,0x10,0x0,0x10,0x10,0x0,0x98,0x14,0x98,0x10,0x20,0x10,0xa0,0x0,0x20,0xa2,0x98,0x10,0x20,0xa8,0xa0,0x0,0x20,0x28,0x2c,0xae,0xac,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0x32,0x36,0x39,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0xbb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x88,0x0,0x0,0xc,0x8c,0xc,0x0,0x8c,0x88,0x92,0x0,0xc,0x10,0xc,0x0,0x14,0x18,0x14,0x0,0x9a,0x10,0x9c,0x0,0x9c,0x18,0xa0,0x0,0x20,0xa4,0x9c,0x0,0x24,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0xac,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0x34,0x38,0x3a,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x88,0x0,0x0,0xc,0x8c,0xc,0x0,0x8c,0x88,0x92,0x0,0xc,0x10,0xc,0x0,0x14,0x18,0x14,0x0,0x9a,0x10,0x9c,0x0,0x9c,0x18,0xa0,0x0,0x20,0xa4,0x9c,0x0,0x24,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0xac,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x30,0x34,0x38,0x3a,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0xbc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x86,0x0,0x80,0x8,0x0,0x8,0x0,0x8,0x8,0x0,0x80,0x8,0x0,0x90,0x0,0x8,0x8,0x0,0x18,0x98,0x9a,0x18,0x0,0x9c,0x8,0xa0,0x18,0x98,0x1c,0xa0,0x0,0x20,0xa6,0xa0,0x18,0x28,0xa8,0xa0,0x0,0x20,0x28,0x2c,0xb0,0xac,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x32,0x36,0x39,0x3b,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x86,0x0,0x80,0x8,0x0,0x8,0x0,0x8,0x8,0x0,0x80,0x8,0x0,0x90,0x0,0x8,0x8,0x0,0x18,0x98,0x9a,0x18,0x0,0x9c,0x8,0xa0,0x18,0x98,0x1c,0xa0,0x0,0x20,0xa6,0xa0,0x18,0x28,0xa8,0xa0,0x0,0x20,0x28,0x2c,0xb0,0xac,0xa8,0xa0,0x0,0x20,0x28,0x2c,0x32,0x36,0x39,0x3b,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0xbd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x88,0x8c,0x0,0x0,0x0,0x0,0x90,0x90,0x10,0x8c,0x0,0x0,0x0,0x98,0x90,0x90,0x98,0x1a,0x0,0x9e,0x0,0x98,0x20,0x90,0x20,0xa0,0x0,0x20,0x28,0x98,0x20,0x2a,0xa8,0xa0,0x0,0x20,0x28,0x30,0xb0,0xac,0xa8,0xa0,0x0,0x20,0x28,0x30,0x34,0x38,0x3a,0x3c,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x88,0x8c,0x0,0x0,0x0,0x0,0x90,0x90,0x10,0x8c,0x0,0x0,0x0,0x98,0x90,0x90,0x98,0x1a,0x0,0x9e,0x0,0x98,0x20,0x90,0x20,0xa0,0x0,0x20,0x28,0x98,0x20,0x2a,0xa8,0xa0,0x0,0x20,0x28,0x30,0xb0,0xac,0xa8,0xa0,0x0,0x20,0x28,0x30,0x34,0x38,0x3a,0x3c,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0xbe,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x84,0x0,0x8,0x0,0x0,0x88,0x0,0x0,0xc,0x0,0x8,0x0,0x94,0x88,0x0,0x0,0x94,0x0,0x8,0x0,0x94,0x1c,0x0,0x20,0x1c,0x0,0xa2,0x0,0x22,0xa0,0x0,0x20,0xa4,0x0,0x24,0xac,0xa8,0xa0,0x0,0x20,0x2c,0x32,0xb0,0xac,0xa8,0xa0,0x0,0x20,0x2c,0x32,0x36,0x39,0x3b,0x3d,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x84,0x0,0x8,0x0,0x0,0x88,0x0,0x0,0xc,0x0,0x8,0x0,0x94,0x88,0x0,0x0,0x94,0x0,0x8,0x0,0x94,0x1c,0x0,0x20,0x1c,0x0,0xa2,0x0,0x22,0xa0,0x0,0x20,0xa4,0x0,0x24,0xac,0xa8,0xa0,0x0,0x20,0x2c,0x32,0xb0,0xac,0xa8,0xa0,0x0,0x20,0x2c,0x32,0x36,0x39,0x3b,0x3d,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0xbf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x80,0xa,0x8,0x8a,0xc,0x80,0x0,0x90,0x10,0x8c,0x90,0x10,0x90,0x80,0x0,0x90,0x10,0x18,0x18,0x10,0x90,0x1e,0x0,0x90,0xa0,0x18,0xa0,0x10,0x24,0xa0,0x0,0x28,0xa0,0x18,0x28,0xac,0xa8,0xa0,0x0,0x28,0x30,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0x28,0x30,0x34,0x38,0x3a,0x3c,0x3e,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x80,0xa,0x8,0x8a,0xc,0x80,0x0,0x90,0x10,0x8c,0x90,0x10,0x90,0x80,0x0,0x90,0x10,0x18,0x18,0x10,0x90,0x1e,0x0,0x90,0xa0,0x18,0xa0,0x10,0x24,0xa0,0x0,0x28,0xa0,0x18,0x28,0xac,0xa8,0xa0,0x0,0x28,0x30,0xb2,0xb0,0xac,0xa8,0xa0,0x0,0x28,0x30,0x34,0x38,0x3a,0x3c,0x3e,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0xc0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x8,0x88,0x0,0x88,0x80,0x8c,0x0,0x0,0x8,0x88,0x0,0x88,0x14,0x8c,0x0,0x0,0x98,0x88,0x0,0x88,0x98,0x1c,0x0,0x0,0x98,0x22,0x0,0x24,0x98,0x1c,0xa8,0x0,0x28,0xa4,0x0,0x24,0x2c,0xac,0xa8,0x0,0x28,0x30,0x34,0xb2,0xb0,0xac,0xa8,0x0,0x28,0x30,0x34,0x38,0x3a,0x3c,0x3e,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x8,0x88,0x0,0x88,0x80,0x8c,0x0,0x0,0x8,0x88,0x0,0x88,0x14,0x8c,0x0,0x0,0x98,0x88,0x0,0x88,0x98,0x1c,0x0,0x0,0x98,0x22,0x0,0x24,0x98,0x1c,0xa8,0x0,0x28,0xa4,0x0,0x24,0x2c,0xac,0xa8,0x0,0x28,0x30,0x34,0xb2,0xb0,0xac,0xa8,0x0,0x28,0x30,0x34,0x38,0x3a,0x3c,0x3e,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0xc1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x84,0x84,0x82,0x0,0x0,0x0,0x80,0x88,0x84,0x8,0xa,0x0,0x10,0x0,0x80,0x10,0x12,0x8,0x92,0x0,0x10,0x0,0x1a,0x98,0x98,0x9c,0x1a,0x0,0x10,0x0,0x9c,0x20,0x98,0x20,0xa2,0x0,0xa8,0x0,0x28,0xa8,0x98,0x20,0x2a,0xb0,0xa8,0x0,0x28,0x30,0x34,0xb4,0xb2,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0x3a,0x3c,0x40,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x84,0x84,0x82,0x0,0x0,0x0,0x80,0x88,0x84,0x8,0xa,0x0,0x10,0x0,0x80,0x10,0x12,0x8,0x92,0x0,0x10,0x0,0x1a,0x98,0x98,0x9c,0x1a,0x0,0x10,0x0,0x9c,0x20,0x98,0x20,0xa2,0x0,0xa8,0x0,0x28,0xa8,0x98,0x20,0x2a,0xb0,0xa8,0x0,0x28,0x30,0x34,0xb4,0xb2,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0x3a,0x3c,0x40,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0xc2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x80,0x4,0x0,0x0,0x80,0x88,0x0,0x8,0x80,0x88,0x0,0x0,0x80,0x88,0x0,0x90,0x80,0x88,0x96,0x0,0x18,0x18,0x0,0x18,0x1c,0x1c,0x18,0x0,0x18,0x18,0x0,0xa0,0x1c,0xa0,0x18,0x28,0x18,0xa8,0x0,0x28,0xaa,0xa0,0x18,0x28,0xb0,0xa8,0x0,0x28,0x30,0x34,0xb6,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0x3a,0x3e,0x41,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x80,0x4,0x0,0x0,0x80,0x88,0x0,0x8,0x80,0x88,0x0,0x0,0x80,0x88,0x0,0x90,0x80,0x88,0x96,0x0,0x18,0x18,0x0,0x18,0x1c,0x1c,0x18,0x0,0x18,0x18,0x0,0xa0,0x1c,0xa0,0x18,0x28,0x18,0xa8,0x0,0x28,0xaa,0xa0,0x18,0x28,0xb0,0xa8,0x0,0x28,0x30,0x34,0xb6,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0x3a,0x3e,0x41,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0xc3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x84,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0xc,0x90,0x0,0x0,0x14,0x94,0x14,0x0,0x94,0x90,0x9a,0x0,0x14,0x18,0x14,0x0,0x1c,0x20,0x1c,0x0,0xa2,0x18,0xa4,0x0,0xa4,0x20,0xa8,0x0,0x28,0xac,0xa4,0x0,0x2c,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0x3c,0x40,0x42,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x84,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0xc,0x90,0x0,0x0,0x14,0x94,0x14,0x0,0x94,0x90,0x9a,0x0,0x14,0x18,0x14,0x0,0x1c,0x20,0x1c,0x0,0xa2,0x18,0xa4,0x0,0xa4,0x20,0xa8,0x0,0x28,0xac,0xa4,0x0,0x2c,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x34,0x38,0x3c,0x40,0x42,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0xc4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x80,0x8,0x88,0x0,0x8c,0x8e,0x0,0x88,0x10,0x8,0x10,0x0,0x10,0x10,0x0,0x88,0x10,0x8,0x98,0x0,0x10,0x10,0x0,0x20,0xa0,0xa2,0x20,0x0,0xa4,0x10,0xa8,0x20,0xa0,0x24,0xa8,0x0,0x28,0xae,0xa8,0x20,0x30,0xb0,0xa8,0x0,0x28,0x30,0x34,0xb8,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x34,0x3a,0x3e,0x41,0x43,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x80,0x8,0x88,0x0,0x8c,0x8e,0x0,0x88,0x10,0x8,0x10,0x0,0x10,0x10,0x0,0x88,0x10,0x8,0x98,0x0,0x10,0x10,0x0,0x20,0xa0,0xa2,0x20,0x0,0xa4,0x10,0xa8,0x20,0xa0,0x24,0xa8,0x0,0x28,0xae,0xa8,0x20,0x30,0xb0,0xa8,0x0,0x28,0x30,0x34,0xb8,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x34,0x3a,0x3e,0x41,0x43,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0xc5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8,0x8,0x8a,0x8,0x0,0x0,0x8,0x0,0x8,0x8,0xc,0x90,0x94,0x0,0x8,0x0,0x8,0x98,0x98,0x18,0x94,0x0,0x8,0x0,0xa0,0x98,0x98,0xa0,0x22,0x0,0xa6,0x0,0xa0,0x28,0x98,0x28,0xa8,0x0,0x28,0x30,0xa0,0x28,0x32,0xb0,0xa8,0x0,0x28,0x30,0x38,0xb8,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x38,0x3c,0x40,0x42,0x44,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8,0x8,0x8a,0x8,0x0,0x0,0x8,0x0,0x8,0x8,0xc,0x90,0x94,0x0,0x8,0x0,0x8,0x98,0x98,0x18,0x94,0x0,0x8,0x0,0xa0,0x98,0x98,0xa0,0x22,0x0,0xa6,0x0,0xa0,0x28,0x98,0x28,0xa8,0x0,0x28,0x30,0xa0,0x28,0x32,0xb0,0xa8,0x0,0x28,0x30,0x38,0xb8,0xb4,0xb0,0xa8,0x0,0x28,0x30,0x38,0x3c,0x40,0x42,0x44,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0xc6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x84,0x0,0x0,0xc,0x0,0x0,0x8c,0x0,0x10,0x0,0x0,0x90,0x0,0x0,0x14,0x0,0x10,0x0,0x9c,0x90,0x0,0x0,0x9c,0x0,0x10,0x0,0x9c,0x24,0x0,0x28,0x24,0x0,0xaa,0x0,0x2a,0xa8,0x0,0x28,0xac,0x0,0x2c,0xb4,0xb0,0xa8,0x0,0x28,0x34,0x3a,0xb8,0xb4,0xb0,0xa8,0x0,0x28,0x34,0x3a,0x3e,0x41,0x43,0x45,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x84,0x0,0x0,0xc,0x0,0x0,0x8c,0x0,0x10,0x0,0x0,0x90,0x0,0x0,0x14,0x0,0x10,0x0,0x9c,0x90,0x0,0x0,0x9c,0x0,0x10,0x0,0x9c,0x24,0x0,0x28,0x24,0x0,0xaa,0x0,0x2a,0xa8,0x0,0x28,0xac,0x0,0x2c,0xb4,0xb0,0xa8,0x0,0x28,0x34,0x3a,0xb8,0xb4,0xb0,0xa8,0x0,0x28,0x34,0x3a,0x3e,0x41,0x43,0x45,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0xc7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x80,0x6,0x0,0x0,0x88,0x0,0x88,0x4,0x80,0x88,0x0,0x0,0x88,0x12,0x10,0x92,0x14,0x88,0x0,0x98,0x18,0x94,0x98,0x18,0x98,0x88,0x0,0x98,0x18,0x20,0x20,0x18,0x98,0x26,0x0,0x98,0xa8,0x20,0xa8,0x18,0x2c,0xa8,0x0,0x30,0xa8,0x20,0x30,0xb4,0xb0,0xa8,0x0,0x30,0x38,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0x30,0x38,0x3c,0x40,0x42,0x44,0x46,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x80,0x6,0x0,0x0,0x88,0x0,0x88,0x4,0x80,0x88,0x0,0x0,0x88,0x12,0x10,0x92,0x14,0x88,0x0,0x98,0x18,0x94,0x98,0x18,0x98,0x88,0x0,0x98,0x18,0x20,0x20,0x18,0x98,0x26,0x0,0x98,0xa8,0x20,0xa8,0x18,0x2c,0xa8,0x0,0x30,0xa8,0x20,0x30,0xb4,0xb0,0xa8,0x0,0x30,0x38,0xba,0xb8,0xb4,0xb0,0xa8,0x0,0x30,0x38,0x3c,0x40,0x42,0x44,0x46,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0xc8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x0,0x4,0x4,0x0,0x0,0x80,0x4,0x0,0xa,0x88,0x4,0x0,0x0,0x10,0x90,0x0,0x90,0x88,0x94,0x0,0x0,0x10,0x90,0x0,0x90,0x1c,0x94,0x0,0x0,0xa0,0x90,0x0,0x90,0xa0,0x24,0x0,0x0,0xa0,0x2a,0x0,0x2c,0xa0,0x24,0xb0,0x0,0x30,0xac,0x0,0x2c,0x34,0xb4,0xb0,0x0,0x30,0x38,0x3c,0xba,0xb8,0xb4,0xb0,0x0,0x30,0x38,0x3c,0x40,0x42,0x44,0x46,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x82,0x0,0x0,0x4,0x4,0x0,0x0,0x80,0x4,0x0,0xa,0x88,0x4,0x0,0x0,0x10,0x90,0x0,0x90,0x88,0x94,0x0,0x0,0x10,0x90,0x0,0x90,0x1c,0x94,0x0,0x0,0xa0,0x90,0x0,0x90,0xa0,0x24,0x0,0x0,0xa0,0x2a,0x0,0x2c,0xa0,0x24,0xb0,0x0,0x30,0xac,0x0,0x2c,0x34,0xb4,0xb0,0x0,0x30,0x38,0x3c,0xba,0xb8,0xb4,0xb0,0x0,0x30,0x38,0x3c,0x40,0x42,0x44,0x46,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0xc9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x80,0x0,0x88,0x8,0x8c,0x8c,0x8a,0x0,0x80,0x0,0x88,0x90,0x8c,0x10,0x12,0x0,0x18,0x0,0x88,0x18,0x1a,0x10,0x9a,0x0,0x18,0x0,0x22,0xa0,0xa0,0xa4,0x22,0x0,0x18,0x0,0xa4,0x28,0xa0,0x28,0xaa,0x0,0xb0,0x0,0x30,0xb0,0xa0,0x28,0x32,0xb8,0xb0,0x0,0x30,0x38,0x3c,0xbc,0xba,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0x42,0x44,0x48,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x80,0x0,0x88,0x8,0x8c,0x8c,0x8a,0x0,0x80,0x0,0x88,0x90,0x8c,0x10,0x12,0x0,0x18,0x0,0x88,0x18,0x1a,0x10,0x9a,0x0,0x18,0x0,0x22,0xa0,0xa0,0xa4,0x22,0x0,0x18,0x0,0xa4,0x28,0xa0,0x28,0xaa,0x0,0xb0,0x0,0x30,0xb0,0xa0,0x28,0x32,0xb8,0xb0,0x0,0x30,0x38,0x3c,0xbc,0xba,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0x42,0x44,0x48,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0xca,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x88,0x0,0x0,0x88,0x88,0xc,0x0,0x0,0x88,0x90,0x0,0x10,0x88,0x90,0x0,0x0,0x88,0x90,0x0,0x98,0x88,0x90,0x9e,0x0,0x20,0x20,0x0,0x20,0x24,0x24,0x20,0x0,0x20,0x20,0x0,0xa8,0x24,0xa8,0x20,0x30,0x20,0xb0,0x0,0x30,0xb2,0xa8,0x20,0x30,0xb8,0xb0,0x0,0x30,0x38,0x3c,0xbe,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0x42,0x46,0x49,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x0,0x0,0x88,0x0,0x0,0x88,0x88,0xc,0x0,0x0,0x88,0x90,0x0,0x10,0x88,0x90,0x0,0x0,0x88,0x90,0x0,0x98,0x88,0x90,0x9e,0x0,0x20,0x20,0x0,0x20,0x24,0x24,0x20,0x0,0x20,0x20,0x0,0xa8,0x24,0xa8,0x20,0x30,0x20,0xb0,0x0,0x30,0xb2,0xa8,0x20,0x30,0xb8,0xb0,0x0,0x30,0x38,0x3c,0xbe,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0x42,0x46,0x49,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0xcb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x0,0x4,0x8,0x8,0x0,0x80,0x8,0x8c,0x0,0x8c,0x8,0x8,0x0,0x80,0x8,0x8c,0x0,0x14,0x98,0x8,0x0,0x1c,0x9c,0x1c,0x0,0x9c,0x98,0xa2,0x0,0x1c,0x20,0x1c,0x0,0x24,0x28,0x24,0x0,0xaa,0x20,0xac,0x0,0xac,0x28,0xb0,0x0,0x30,0xb4,0xac,0x0,0x34,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0x44,0x48,0x4a,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x0,0x4,0x8,0x8,0x0,0x80,0x8,0x8c,0x0,0x8c,0x8,0x8,0x0,0x80,0x8,0x8c,0x0,0x14,0x98,0x8,0x0,0x1c,0x9c,0x1c,0x0,0x9c,0x98,0xa2,0x0,0x1c,0x20,0x1c,0x0,0x24,0x28,0x24,0x0,0xaa,0x20,0xac,0x0,0xac,0x28,0xb0,0x0,0x30,0xb4,0xac,0x0,0x34,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x40,0x44,0x48,0x4a,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0xcc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x84,0x8,0x0,0x0,0x0,0x0,0x90,0x88,0x10,0x90,0x0,0x94,0x96,0x0,0x90,0x18,0x10,0x18,0x0,0x18,0x18,0x0,0x90,0x18,0x10,0xa0,0x0,0x18,0x18,0x0,0x28,0xa8,0xaa,0x28,0x0,0xac,0x18,0xb0,0x28,0xa8,0x2c,0xb0,0x0,0x30,0xb6,0xb0,0x28,0x38,0xb8,0xb0,0x0,0x30,0x38,0x3c,0xc0,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x42,0x46,0x49,0x4b,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x84,0x8,0x0,0x0,0x0,0x0,0x90,0x88,0x10,0x90,0x0,0x94,0x96,0x0,0x90,0x18,0x10,0x18,0x0,0x18,0x18,0x0,0x90,0x18,0x10,0xa0,0x0,0x18,0x18,0x0,0x28,0xa8,0xaa,0x28,0x0,0xac,0x18,0xb0,0x28,0xa8,0x2c,0xb0,0x0,0x30,0xb6,0xb0,0x28,0x38,0xb8,0xb0,0x0,0x30,0x38,0x3c,0xc0,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x3c,0x42,0x46,0x49,0x4b,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0xcd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x88,0x0,0x0,0x8e,0x0,0x10,0x10,0x92,0x10,0x0,0x0,0x10,0x0,0x10,0x10,0x14,0x98,0x9c,0x0,0x10,0x0,0x10,0xa0,0xa0,0x20,0x9c,0x0,0x10,0x0,0xa8,0xa0,0xa0,0xa8,0x2a,0x0,0xae,0x0,0xa8,0x30,0xa0,0x30,0xb0,0x0,0x30,0x38,0xa8,0x30,0x3a,0xb8,0xb0,0x0,0x30,0x38,0x40,0xc0,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x40,0x44,0x48,0x4a,0x4c,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x88,0x0,0x0,0x8e,0x0,0x10,0x10,0x92,0x10,0x0,0x0,0x10,0x0,0x10,0x10,0x14,0x98,0x9c,0x0,0x10,0x0,0x10,0xa0,0xa0,0x20,0x9c,0x0,0x10,0x0,0xa8,0xa0,0xa0,0xa8,0x2a,0x0,0xae,0x0,0xa8,0x30,0xa0,0x30,0xb0,0x0,0x30,0x38,0xa8,0x30,0x3a,0xb8,0xb0,0x0,0x30,0x38,0x40,0xc0,0xbc,0xb8,0xb0,0x0,0x30,0x38,0x40,0x44,0x48,0x4a,0x4c,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0xce,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x84,0x0,0xa,0x0,0x8,0x80,0x0,0x0,0xc,0x0,0x8c,0x0,0x8,0x14,0x0,0x0,0x94,0x0,0x18,0x0,0x8,0x98,0x0,0x0,0x1c,0x0,0x18,0x0,0xa4,0x98,0x0,0x0,0xa4,0x0,0x18,0x0,0xa4,0x2c,0x0,0x30,0x2c,0x0,0xb2,0x0,0x32,0xb0,0x0,0x30,0xb4,0x0,0x34,0xbc,0xb8,0xb0,0x0,0x30,0x3c,0x42,0xc0,0xbc,0xb8,0xb0,0x0,0x30,0x3c,0x42,0x46,0x49,0x4b,0x4d,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x80,0x0,0x0,0x84,0x0,0xa,0x0,0x8,0x80,0x0,0x0,0xc,0x0,0x8c,0x0,0x8,0x14,0x0,0x0,0x94,0x0,0x18,0x0,0x8,0x98,0x0,0x0,0x1c,0x0,0x18,0x0,0xa4,0x98,0x0,0x0,0xa4,0x0,0x18,0x0,0xa4,0x2c,0x0,0x30,0x2c,0x0,0xb2,0x0,0x32,0xb0,0x0,0x30,0xb4,0x0,0x34,0xbc,0xb8,0xb0,0x0,0x30,0x3c,0x42,0xc0,0xbc,0xb8,0xb0,0x0,0x30,0x3c,0x42,0x46,0x49,0x4b,0x4d,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0xcf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x4,0x0,0x0,0x8,0x0,0x80,0x8,0xc,0x88,0xe,0x0,0x8,0x90,0x80,0x90,0xc,0x88,0x90,0x0,0x8,0x90,0x1a,0x18,0x9a,0x1c,0x90,0x0,0xa0,0x20,0x9c,0xa0,0x20,0xa0,0x90,0x0,0xa0,0x20,0x28,0x28,0x20,0xa0,0x2e,0x0,0xa0,0xb0,0x28,0xb0,0x20,0x34,0xb0,0x0,0x38,0xb0,0x28,0x38,0xbc,0xb8,0xb0,0x0,0x38,0x40,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0x38,0x40,0x44,0x48,0x4a,0x4c,0x4e,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x4,0x0,0x0,0x8,0x0,0x80,0x8,0xc,0x88,0xe,0x0,0x8,0x90,0x80,0x90,0xc,0x88,0x90,0x0,0x8,0x90,0x1a,0x18,0x9a,0x1c,0x90,0x0,0xa0,0x20,0x9c,0xa0,0x20,0xa0,0x90,0x0,0xa0,0x20,0x28,0x28,0x20,0xa0,0x2e,0x0,0xa0,0xb0,0x28,0xb0,0x20,0x34,0xb0,0x0,0x38,0xb0,0x28,0x38,0xbc,0xb8,0xb0,0x0,0x38,0x40,0xc2,0xc0,0xbc,0xb8,0xb0,0x0,0x38,0x40,0x44,0x48,0x4a,0x4c,0x4e,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0xd0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x0,0x88,0x8a,0x0,0x84,0xc,0xc,0x0,0x0,0x88,0xc,0x0,0x12,0x90,0xc,0x0,0x0,0x18,0x98,0x0,0x98,0x90,0x9c,0x0,0x0,0x18,0x98,0x0,0x98,0x24,0x9c,0x0,0x0,0xa8,0x98,0x0,0x98,0xa8,0x2c,0x0,0x0,0xa8,0x32,0x0,0x34,0xa8,0x2c,0xb8,0x0,0x38,0xb4,0x0,0x34,0x3c,0xbc,0xb8,0x0,0x38,0x40,0x44,0xc2,0xc0,0xbc,0xb8,0x0,0x38,0x40,0x44,0x48,0x4a,0x4c,0x4e,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x0,0x88,0x8a,0x0,0x84,0xc,0xc,0x0,0x0,0x88,0xc,0x0,0x12,0x90,0xc,0x0,0x0,0x18,0x98,0x0,0x98,0x90,0x9c,0x0,0x0,0x18,0x98,0x0,0x98,0x24,0x9c,0x0,0x0,0xa8,0x98,0x0,0x98,0xa8,0x2c,0x0,0x0,0xa8,0x32,0x0,0x34,0xa8,0x2c,0xb8,0x0,0x38,0xb4,0x0,0x34,0x3c,0xbc,0xb8,0x0,0x38,0x40,0x44,0xc2,0xc0,0xbc,0xb8,0x0,0x38,0x40,0x44,0x48,0x4a,0x4c,0x4e,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0xd1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x82,0x0,0x88,0x0,0x4,0x88,0x0,0x0,0xa,0x0,0x88,0x0,0x90,0x10,0x94,0x94,0x92,0x0,0x88,0x0,0x90,0x98,0x94,0x18,0x1a,0x0,0x20,0x0,0x90,0x20,0x22,0x18,0xa2,0x0,0x20,0x0,0x2a,0xa8,0xa8,0xac,0x2a,0x0,0x20,0x0,0xac,0x30,0xa8,0x30,0xb2,0x0,0xb8,0x0,0x38,0xb8,0xa8,0x30,0x3a,0xc0,0xb8,0x0,0x38,0x40,0x44,0xc4,0xc2,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0x4a,0x4c,0x50,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x82,0x0,0x88,0x0,0x4,0x88,0x0,0x0,0xa,0x0,0x88,0x0,0x90,0x10,0x94,0x94,0x92,0x0,0x88,0x0,0x90,0x98,0x94,0x18,0x1a,0x0,0x20,0x0,0x90,0x20,0x22,0x18,0xa2,0x0,0x20,0x0,0x2a,0xa8,0xa8,0xac,0x2a,0x0,0x20,0x0,0xac,0x30,0xa8,0x30,0xb2,0x0,0xb8,0x0,0x38,0xb8,0xa8,0x30,0x3a,0xc0,0xb8,0x0,0x38,0x40,0x44,0xc4,0xc2,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0x4a,0x4c,0x50,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0xd2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x86,0x0,0x0,0x8,0x0,0x8,0xa,0x80,0x8,0x0,0x90,0x8,0x0,0x90,0x90,0x14,0x8,0x0,0x90,0x98,0x0,0x18,0x90,0x98,0x8,0x0,0x90,0x98,0x0,0xa0,0x90,0x98,0xa6,0x0,0x28,0x28,0x0,0x28,0x2c,0x2c,0x28,0x0,0x28,0x28,0x0,0xb0,0x2c,0xb0,0x28,0x38,0x28,0xb8,0x0,0x38,0xba,0xb0,0x28,0x38,0xc0,0xb8,0x0,0x38,0x40,0x44,0xc6,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0x4a,0x4e,0x51,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x86,0x0,0x0,0x8,0x0,0x8,0xa,0x80,0x8,0x0,0x90,0x8,0x0,0x90,0x90,0x14,0x8,0x0,0x90,0x98,0x0,0x18,0x90,0x98,0x8,0x0,0x90,0x98,0x0,0xa0,0x90,0x98,0xa6,0x0,0x28,0x28,0x0,0x28,0x2c,0x2c,0x28,0x0,0x28,0x28,0x0,0xb0,0x2c,0xb0,0x28,0x38,0x28,0xb8,0x0,0x38,0xba,0xb0,0x28,0x38,0xc0,0xb8,0x0,0x38,0x40,0x44,0xc6,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0x4a,0x4e,0x51,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0xd3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x0,0x2,0x0,0x4,0x0,0x84,0x80,0x84,0x0,0x88,0x8c,0x4,0x0,0xc,0x10,0x10,0x0,0x88,0x10,0x94,0x0,0x94,0x10,0x10,0x0,0x88,0x10,0x94,0x0,0x1c,0xa0,0x10,0x0,0x24,0xa4,0x24,0x0,0xa4,0xa0,0xaa,0x0,0x24,0x28,0x24,0x0,0x2c,0x30,0x2c,0x0,0xb2,0x28,0xb4,0x0,0xb4,0x30,0xb8,0x0,0x38,0xbc,0xb4,0x0,0x3c,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0x4c,0x50,0x52,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x2,0x0,0x2,0x0,0x4,0x0,0x84,0x80,0x84,0x0,0x88,0x8c,0x4,0x0,0xc,0x10,0x10,0x0,0x88,0x10,0x94,0x0,0x94,0x10,0x10,0x0,0x88,0x10,0x94,0x0,0x1c,0xa0,0x10,0x0,0x24,0xa4,0x24,0x0,0xa4,0xa0,0xaa,0x0,0x24,0x28,0x24,0x0,0x2c,0x30,0x2c,0x0,0xb2,0x28,0xb4,0x0,0xb4,0x30,0xb8,0x0,0x38,0xbc,0xb4,0x0,0x3c,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x44,0x48,0x4c,0x50,0x52,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0xd4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x0,0xa,0x88,0x0,0x8,0x0,0x0,0x0,0x90,0x8c,0x10,0x0,0x8,0x0,0x0,0x98,0x90,0x18,0x98,0x0,0x9c,0x9e,0x0,0x98,0x20,0x18,0x20,0x0,0x20,0x20,0x0,0x98,0x20,0x18,0xa8,0x0,0x20,0x20,0x0,0x30,0xb0,0xb2,0x30,0x0,0xb4,0x20,0xb8,0x30,0xb0,0x34,0xb8,0x0,0x38,0xbe,0xb8,0x30,0x40,0xc0,0xb8,0x0,0x38,0x40,0x44,0xc8,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x44,0x4a,0x4e,0x51,0x53,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x0,0x0,0x0,0x0,0xa,0x88,0x0,0x8,0x0,0x0,0x0,0x90,0x8c,0x10,0x0,0x8,0x0,0x0,0x98,0x90,0x18,0x98,0x0,0x9c,0x9e,0x0,0x98,0x20,0x18,0x20,0x0,0x20,0x20,0x0,0x98,0x20,0x18,0xa8,0x0,0x20,0x20,0x0,0x30,0xb0,0xb2,0x30,0x0,0xb4,0x20,0xb8,0x30,0xb0,0x34,0xb8,0x0,0x38,0xbe,0xb8,0x30,0x40,0xc0,0xb8,0x0,0x38,0x40,0x44,0xc8,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x44,0x4a,0x4e,0x51,0x53,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0xd5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x0,0x0,0x80,0x88,0x0,0x8,0x8,0x0,0x0,0x0,0x80,0x88,0x0,0x90,0x8,0x0,0x96,0x0,0x18,0x18,0x9a,0x18,0x8,0x0,0x18,0x0,0x18,0x18,0x1c,0xa0,0xa4,0x0,0x18,0x0,0x18,0xa8,0xa8,0x28,0xa4,0x0,0x18,0x0,0xb0,0xa8,0xa8,0xb0,0x32,0x0,0xb6,0x0,0xb0,0x38,0xa8,0x38,0xb8,0x0,0x38,0x40,0xb0,0x38,0x42,0xc0,0xb8,0x0,0x38,0x40,0x48,0xc8,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x48,0x4c,0x50,0x52,0x54,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x82,0x0,0x0,0x0,0x80,0x88,0x0,0x8,0x8,0x0,0x0,0x0,0x80,0x88,0x0,0x90,0x8,0x0,0x96,0x0,0x18,0x18,0x9a,0x18,0x8,0x0,0x18,0x0,0x18,0x18,0x1c,0xa0,0xa4,0x0,0x18,0x0,0x18,0xa8,0xa8,0x28,0xa4,0x0,0x18,0x0,0xb0,0xa8,0xa8,0xb0,0x32,0x0,0xb6,0x0,0xb0,0x38,0xa8,0x38,0xb8,0x0,0x38,0x40,0xb0,0x38,0x42,0xc0,0xb8,0x0,0x38,0x40,0x48,0xc8,0xc4,0xc0,0xb8,0x0,0x38,0x40,0x48,0x4c,0x50,0x52,0x54,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0xd6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0x0,0x8a,0x88,0x0,0x0,0x8c,0x0,0x12,0x0,0x10,0x88,0x0,0x0,0x14,0x0,0x94,0x0,0x10,0x1c,0x0,0x0,0x9c,0x0,0x20,0x0,0x10,0xa0,0x0,0x0,0x24,0x0,0x20,0x0,0xac,0xa0,0x0,0x0,0xac,0x0,0x20,0x0,0xac,0x34,0x0,0x38,0x34,0x0,0xba,0x0,0x3a,0xb8,0x0,0x38,0xbc,0x0,0x3c,0xc4,0xc0,0xb8,0x0,0x38,0x44,0x4a,0xc8,0xc4,0xc0,0xb8,0x0,0x38,0x44,0x4a,0x4e,0x51,0x53,0x55,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x4,0x0,0x0,0x0,0x8a,0x88,0x0,0x0,0x8c,0x0,0x12,0x0,0x10,0x88,0x0,0x0,0x14,0x0,0x94,0x0,0x10,0x1c,0x0,0x0,0x9c,0x0,0x20,0x0,0x10,0xa0,0x0,0x0,0x24,0x0,0x20,0x0,0xac,0xa0,0x0,0x0,0xac,0x0,0x20,0x0,0xac,0x34,0x0,0x38,0x34,0x0,0xba,0x0,0x3a,0xb8,0x0,0x38,0xbc,0x0,0x3c,0xc4,0xc0,0xb8,0x0,0x38,0x44,0x4a,0xc8,0xc4,0xc0,0xb8,0x0,0x38,0x44,0x4a,0x4e,0x51,0x53,0x55,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0xd7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x88,0x88,0x0,0xc,0x0,0x0,0x10,0x8,0x88,0x10,0x14,0x90,0x16,0x0,0x10,0x98,0x88,0x98,0x14,0x90,0x98,0x0,0x10,0x98,0x22,0x20,0xa2,0x24,0x98,0x0,0xa8,0x28,0xa4,0xa8,0x28,0xa8,0x98,0x0,0xa8,0x28,0x30,0x30,0x28,0xa8,0x36,0x0,0xa8,0xb8,0x30,0xb8,0x28,0x3c,0xb8,0x0,0x40,0xb8,0x30,0x40,0xc4,0xc0,0xb8,0x0,0x40,0x48,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0x40,0x48,0x4c,0x50,0x52,0x54,0x56,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0x88,0x88,0x0,0xc,0x0,0x0,0x10,0x8,0x88,0x10,0x14,0x90,0x16,0x0,0x10,0x98,0x88,0x98,0x14,0x90,0x98,0x0,0x10,0x98,0x22,0x20,0xa2,0x24,0x98,0x0,0xa8,0x28,0xa4,0xa8,0x28,0xa8,0x98,0x0,0xa8,0x28,0x30,0x30,0x28,0xa8,0x36,0x0,0xa8,0xb8,0x30,0xb8,0x28,0x3c,0xb8,0x0,0x40,0xb8,0x30,0x40,0xc4,0xc0,0xb8,0x0,0x40,0x48,0xca,0xc8,0xc4,0xc0,0xb8,0x0,0x40,0x48,0x4c,0x50,0x52,0x54,0x56,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0xd8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x0,0x0,0x0,0x0,0x8c,0x80,0x84,0x0,0x0,0x90,0x92,0x0,0x8c,0x14,0x14,0x0,0x0,0x90,0x14,0x0,0x1a,0x98,0x14,0x0,0x0,0x20,0xa0,0x0,0xa0,0x98,0xa4,0x0,0x0,0x20,0xa0,0x0,0xa0,0x2c,0xa4,0x0,0x0,0xb0,0xa0,0x0,0xa0,0xb0,0x34,0x0,0x0,0xb0,0x3a,0x0,0x3c,0xb0,0x34,0xc0,0x0,0x40,0xbc,0x0,0x3c,0x44,0xc4,0xc0,0x0,0x40,0x48,0x4c,0xca,0xc8,0xc4,0xc0,0x0,0x40,0x48,0x4c,0x50,0x52,0x54,0x56,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x84,0x0,0x0,0x0,0x0,0x0,0x8c,0x80,0x84,0x0,0x0,0x90,0x92,0x0,0x8c,0x14,0x14,0x0,0x0,0x90,0x14,0x0,0x1a,0x98,0x14,0x0,0x0,0x20,0xa0,0x0,0xa0,0x98,0xa4,0x0,0x0,0x20,0xa0,0x0,0xa0,0x2c,0xa4,0x0,0x0,0xb0,0xa0,0x0,0xa0,0xb0,0x34,0x0,0x0,0xb0,0x3a,0x0,0x3c,0xb0,0x34,0xc0,0x0,0x40,0xbc,0x0,0x3c,0x44,0xc4,0xc0,0x0,0x40,0x48,0x4c,0xca,0xc8,0xc4,0xc0,0x0,0x40,0x48,0x4c,0x50,0x52,0x54,0x56,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0xd9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x84,0x2,0x0,0x0,0x0,0x8a,0x8,0x0,0x8,0x8a,0x0,0x90,0x0,0xc,0x90,0x0,0x8,0x12,0x0,0x90,0x0,0x98,0x18,0x9c,0x9c,0x9a,0x0,0x90,0x0,0x98,0xa0,0x9c,0x20,0x22,0x0,0x28,0x0,0x98,0x28,0x2a,0x20,0xaa,0x0,0x28,0x0,0x32,0xb0,0xb0,0xb4,0x32,0x0,0x28,0x0,0xb4,0x38,0xb0,0x38,0xba,0x0,0xc0,0x0,0x40,0xc0,0xb0,0x38,0x42,0xc8,0xc0,0x0,0x40,0x48,0x4c,0xcc,0xca,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0x52,0x54,0x58,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x84,0x2,0x0,0x0,0x0,0x8a,0x8,0x0,0x8,0x8a,0x0,0x90,0x0,0xc,0x90,0x0,0x8,0x12,0x0,0x90,0x0,0x98,0x18,0x9c,0x9c,0x9a,0x0,0x90,0x0,0x98,0xa0,0x9c,0x20,0x22,0x0,0x28,0x0,0x98,0x28,0x2a,0x20,0xaa,0x0,0x28,0x0,0x32,0xb0,0xb0,0xb4,0x32,0x0,0x28,0x0,0xb4,0x38,0xb0,0x38,0xba,0x0,0xc0,0x0,0x40,0xc0,0xb0,0x38,0x42,0xc8,0xc0,0x0,0x40,0x48,0x4c,0xcc,0xca,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0x52,0x54,0x58,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0xda,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x4,0x0,0x0,0x0,0x80,0x0,0x88,0x84,0x88,0x8e,0x0,0x0,0x10,0x0,0x10,0x12,0x88,0x10,0x0,0x98,0x10,0x0,0x98,0x98,0x1c,0x10,0x0,0x98,0xa0,0x0,0x20,0x98,0xa0,0x10,0x0,0x98,0xa0,0x0,0xa8,0x98,0xa0,0xae,0x0,0x30,0x30,0x0,0x30,0x34,0x34,0x30,0x0,0x30,0x30,0x0,0xb8,0x34,0xb8,0x30,0x40,0x30,0xc0,0x0,0x40,0xc2,0xb8,0x30,0x40,0xc8,0xc0,0x0,0x40,0x48,0x4c,0xce,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0x52,0x56,0x59,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x84,0x4,0x0,0x0,0x0,0x80,0x0,0x88,0x84,0x88,0x8e,0x0,0x0,0x10,0x0,0x10,0x12,0x88,0x10,0x0,0x98,0x10,0x0,0x98,0x98,0x1c,0x10,0x0,0x98,0xa0,0x0,0x20,0x98,0xa0,0x10,0x0,0x98,0xa0,0x0,0xa8,0x98,0xa0,0xae,0x0,0x30,0x30,0x0,0x30,0x34,0x34,0x30,0x0,0x30,0x30,0x0,0xb8,0x34,0xb8,0x30,0x40,0x30,0xc0,0x0,0x40,0xc2,0xb8,0x30,0x40,0xc8,0xc0,0x0,0x40,0x48,0x4c,0xce,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0x52,0x56,0x59,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0xdb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x88,0xa,0x0,0xa,0x0,0xc,0x0,0x8c,0x88,0x8c,0x0,0x90,0x94,0xc,0x0,0x14,0x18,0x18,0x0,0x90,0x18,0x9c,0x0,0x9c,0x18,0x18,0x0,0x90,0x18,0x9c,0x0,0x24,0xa8,0x18,0x0,0x2c,0xac,0x2c,0x0,0xac,0xa8,0xb2,0x0,0x2c,0x30,0x2c,0x0,0x34,0x38,0x34,0x0,0xba,0x30,0xbc,0x0,0xbc,0x38,0xc0,0x0,0x40,0xc4,0xbc,0x0,0x44,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0x54,0x58,0x5a,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x88,0xa,0x0,0xa,0x0,0xc,0x0,0x8c,0x88,0x8c,0x0,0x90,0x94,0xc,0x0,0x14,0x18,0x18,0x0,0x90,0x18,0x9c,0x0,0x9c,0x18,0x18,0x0,0x90,0x18,0x9c,0x0,0x24,0xa8,0x18,0x0,0x2c,0xac,0x2c,0x0,0xac,0xa8,0xb2,0x0,0x2c,0x30,0x2c,0x0,0x34,0x38,0x34,0x0,0xba,0x30,0xbc,0x0,0xbc,0x38,0xc0,0x0,0x40,0xc4,0xbc,0x0,0x44,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x50,0x54,0x58,0x5a,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0xdc,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8,0x8,0x0,0x8,0x0,0x8c,0x8,0x0,0x8,0x8,0x12,0x90,0x0,0x10,0x8,0x0,0x8,0x98,0x94,0x18,0x0,0x10,0x8,0x0,0xa0,0x98,0x20,0xa0,0x0,0xa4,0xa6,0x0,0xa0,0x28,0x20,0x28,0x0,0x28,0x28,0x0,0xa0,0x28,0x20,0xb0,0x0,0x28,0x28,0x0,0x38,0xb8,0xba,0x38,0x0,0xbc,0x28,0xc0,0x38,0xb8,0x3c,0xc0,0x0,0x40,0xc6,0xc0,0x38,0x48,0xc8,0xc0,0x0,0x40,0x48,0x4c,0xd0,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x52,0x56,0x59,0x5b,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x8,0x8,0x0,0x8,0x0,0x8c,0x8,0x0,0x8,0x8,0x12,0x90,0x0,0x10,0x8,0x0,0x8,0x98,0x94,0x18,0x0,0x10,0x8,0x0,0xa0,0x98,0x20,0xa0,0x0,0xa4,0xa6,0x0,0xa0,0x28,0x20,0x28,0x0,0x28,0x28,0x0,0xa0,0x28,0x20,0xb0,0x0,0x28,0x28,0x0,0x38,0xb8,0xba,0x38,0x0,0xbc,0x28,0xc0,0x38,0xb8,0x3c,0xc0,0x0,0x40,0xc6,0xc0,0x38,0x48,0xc8,0xc0,0x0,0x40,0x48,0x4c,0xd0,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x4c,0x52,0x56,0x59,0x5b,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0xdd,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x8,0x88,0x8a,0x0,0x0,0x0,0x88,0x90,0x8,0x10,0x10,0x0,0x0,0x0,0x88,0x90,0x8,0x98,0x10,0x0,0x9e,0x0,0x20,0x20,0xa2,0x20,0x10,0x0,0x20,0x0,0x20,0x20,0x24,0xa8,0xac,0x0,0x20,0x0,0x20,0xb0,0xb0,0x30,0xac,0x0,0x20,0x0,0xb8,0xb0,0xb0,0xb8,0x3a,0x0,0xbe,0x0,0xb8,0x40,0xb0,0x40,0xc0,0x0,0x40,0x48,0xb8,0x40,0x4a,0xc8,0xc0,0x0,0x40,0x48,0x50,0xd0,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x50,0x54,0x58,0x5a,0x5c,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x8,0x88,0x8a,0x0,0x0,0x0,0x88,0x90,0x8,0x10,0x10,0x0,0x0,0x0,0x88,0x90,0x8,0x98,0x10,0x0,0x9e,0x0,0x20,0x20,0xa2,0x20,0x10,0x0,0x20,0x0,0x20,0x20,0x24,0xa8,0xac,0x0,0x20,0x0,0x20,0xb0,0xb0,0x30,0xac,0x0,0x20,0x0,0xb8,0xb0,0xb0,0xb8,0x3a,0x0,0xbe,0x0,0xb8,0x40,0xb0,0x40,0xc0,0x0,0x40,0x48,0xb8,0x40,0x4a,0xc8,0xc0,0x0,0x40,0x48,0x50,0xd0,0xcc,0xc8,0xc0,0x0,0x40,0x48,0x50,0x54,0x58,0x5a,0x5c,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0xde,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x4,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0x4,0xc,0x0,0x0,0xc,0x0,0x80,0x0,0x92,0x90,0x0,0x0,0x94,0x0,0x1a,0x0,0x18,0x90,0x0,0x0,0x1c,0x0,0x9c,0x0,0x18,0x24,0x0,0x0,0xa4,0x0,0x28,0x0,0x18,0xa8,0x0,0x0,0x2c,0x0,0x28,0x0,0xb4,0xa8,0x0,0x0,0xb4,0x0,0x28,0x0,0xb4,0x3c,0x0,0x40,0x3c,0x0,0xc2,0x0,0x42,0xc0,0x0,0x40,0xc4,0x0,0x44,0xcc,0xc8,0xc0,0x0,0x40,0x4c,0x52,0xd0,0xcc,0xc8,0xc0,0x0,0x40,0x4c,0x52,0x56,0x59,0x5b,0x5d,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x4,0x0,0x0,0x0,0x84,0x0,0x80,0x0,0x4,0xc,0x0,0x0,0xc,0x0,0x80,0x0,0x92,0x90,0x0,0x0,0x94,0x0,0x1a,0x0,0x18,0x90,0x0,0x0,0x1c,0x0,0x9c,0x0,0x18,0x24,0x0,0x0,0xa4,0x0,0x28,0x0,0x18,0xa8,0x0,0x0,0x2c,0x0,0x28,0x0,0xb4,0xa8,0x0,0x0,0xb4,0x0,0x28,0x0,0xb4,0x3c,0x0,0x40,0x3c,0x0,0xc2,0x0,0x42,0xc0,0x0,0x40,0xc4,0x0,0x44,0xcc,0xc8,0xc0,0x0,0x40,0x4c,0x52,0xd0,0xcc,0xc8,0xc0,0x0,0x40,0x4c,0x52,0x56,0x59,0x5b,0x5d,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0xdf,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x82,0x80,0x80,0x80,0x80,0x0,0x80,0x80,0x4,0x8,0x80,0x80,0x80,0x0,0x80,0x10,0x90,0x90,0x80,0x14,0x80,0x0,0x18,0x10,0x90,0x18,0x1c,0x98,0x1e,0x0,0x18,0xa0,0x90,0xa0,0x1c,0x98,0xa0,0x0,0x18,0xa0,0x2a,0x28,0xaa,0x2c,0xa0,0x0,0xb0,0x30,0xac,0xb0,0x30,0xb0,0xa0,0x0,0xb0,0x30,0x38,0x38,0x30,0xb0,0x3e,0x0,0xb0,0xc0,0x38,0xc0,0x30,0x44,0xc0,0x0,0x48,0xc0,0x38,0x48,0xcc,0xc8,0xc0,0x0,0x48,0x50,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0x48,0x50,0x54,0x58,0x5a,0x5c,0x5e,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x82,0x80,0x80,0x80,0x80,0x0,0x80,0x80,0x4,0x8,0x80,0x80,0x80,0x0,0x80,0x10,0x90,0x90,0x80,0x14,0x80,0x0,0x18,0x10,0x90,0x18,0x1c,0x98,0x1e,0x0,0x18,0xa0,0x90,0xa0,0x1c,0x98,0xa0,0x0,0x18,0xa0,0x2a,0x28,0xaa,0x2c,0xa0,0x0,0xb0,0x30,0xac,0xb0,0x30,0xb0,0xa0,0x0,0xb0,0x30,0x38,0x38,0x30,0xb0,0x3e,0x0,0xb0,0xc0,0x38,0xc0,0x30,0x44,0xc0,0x0,0x48,0xc0,0x38,0x48,0xcc,0xc8,0xc0,0x0,0x48,0x50,0xd2,0xd0,0xcc,0xc8,0xc0,0x0,0x48,0x50,0x54,0x58,0x5a,0x5c,0x5e,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0xe0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x88,0x8c,0x0,0x0,0x8,0x0,0x0,0x94,0x88,0x8c,0x0,0x0,0x98,0x9a,0x0,0x94,0x1c,0x1c,0x0,0x0,0x98,0x1c,0x0,0x22,0xa0,0x1c,0x0,0x0,0x28,0xa8,0x0,0xa8,0xa0,0xac,0x0,0x0,0x28,0xa8,0x0,0xa8,0x34,0xac,0x0,0x0,0xb8,0xa8,0x0,0xa8,0xb8,0x3c,0x0,0x0,0xb8,0x42,0x0,0x44,0xb8,0x3c,0xc8,0x0,0x48,0xc4,0x0,0x44,0x4c,0xcc,0xc8,0x0,0x48,0x50,0x54,0xd2,0xd0,0xcc,0xc8,0x0,0x48,0x50,0x54,0x58,0x5a,0x5c,0x5e,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x0,0x8,0x0,0x0,0x0,0x88,0x8c,0x0,0x0,0x8,0x0,0x0,0x94,0x88,0x8c,0x0,0x0,0x98,0x9a,0x0,0x94,0x1c,0x1c,0x0,0x0,0x98,0x1c,0x0,0x22,0xa0,0x1c,0x0,0x0,0x28,0xa8,0x0,0xa8,0xa0,0xac,0x0,0x0,0x28,0xa8,0x0,0xa8,0x34,0xac,0x0,0x0,0xb8,0xa8,0x0,0xa8,0xb8,0x3c,0x0,0x0,0xb8,0x42,0x0,0x44,0xb8,0x3c,0xc8,0x0,0x48,0xc4,0x0,0x44,0x4c,0xcc,0xc8,0x0,0x48,0x50,0x54,0xd2,0xd0,0xcc,0xc8,0x0,0x48,0x50,0x54,0x58,0x5a,0x5c,0x5e,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0xe1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x82,0x0,0x0,0x0,0x0,0x88,0x8,0x8c,0xa,0x0,0x0,0x0,0x92,0x10,0x8,0x10,0x92,0x0,0x98,0x0,0x14,0x98,0x8,0x10,0x1a,0x0,0x98,0x0,0xa0,0x20,0xa4,0xa4,0xa2,0x0,0x98,0x0,0xa0,0xa8,0xa4,0x28,0x2a,0x0,0x30,0x0,0xa0,0x30,0x32,0x28,0xb2,0x0,0x30,0x0,0x3a,0xb8,0xb8,0xbc,0x3a,0x0,0x30,0x0,0xbc,0x40,0xb8,0x40,0xc2,0x0,0xc8,0x0,0x48,0xc8,0xb8,0x40,0x4a,0xd0,0xc8,0x0,0x48,0x50,0x54,0xd4,0xd2,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0x5a,0x5c,0x60,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x82,0x0,0x0,0x0,0x0,0x88,0x8,0x8c,0xa,0x0,0x0,0x0,0x92,0x10,0x8,0x10,0x92,0x0,0x98,0x0,0x14,0x98,0x8,0x10,0x1a,0x0,0x98,0x0,0xa0,0x20,0xa4,0xa4,0xa2,0x0,0x98,0x0,0xa0,0xa8,0xa4,0x28,0x2a,0x0,0x30,0x0,0xa0,0x30,0x32,0x28,0xb2,0x0,0x30,0x0,0x3a,0xb8,0xb8,0xbc,0x3a,0x0,0x30,0x0,0xbc,0x40,0xb8,0x40,0xc2,0x0,0xc8,0x0,0x48,0xc8,0xb8,0x40,0x4a,0xd0,0xc8,0x0,0x48,0x50,0x54,0xd4,0xd2,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0x5a,0x5c,0x60,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0xe2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x0,0x8,0x8c,0xc,0x0,0x0,0x8,0x88,0x0,0x90,0x8c,0x90,0x96,0x0,0x8,0x18,0x0,0x18,0x1a,0x90,0x18,0x0,0xa0,0x18,0x0,0xa0,0xa0,0x24,0x18,0x0,0xa0,0xa8,0x0,0x28,0xa0,0xa8,0x18,0x0,0xa0,0xa8,0x0,0xb0,0xa0,0xa8,0xb6,0x0,0x38,0x38,0x0,0x38,0x3c,0x3c,0x38,0x0,0x38,0x38,0x0,0xc0,0x3c,0xc0,0x38,0x48,0x38,0xc8,0x0,0x48,0xca,0xc0,0x38,0x48,0xd0,0xc8,0x0,0x48,0x50,0x54,0xd6,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0x5a,0x5e,0x61,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x0,0x8,0x8c,0xc,0x0,0x0,0x8,0x88,0x0,0x90,0x8c,0x90,0x96,0x0,0x8,0x18,0x0,0x18,0x1a,0x90,0x18,0x0,0xa0,0x18,0x0,0xa0,0xa0,0x24,0x18,0x0,0xa0,0xa8,0x0,0x28,0xa0,0xa8,0x18,0x0,0xa0,0xa8,0x0,0xb0,0xa0,0xa8,0xb6,0x0,0x38,0x38,0x0,0x38,0x3c,0x3c,0x38,0x0,0x38,0x38,0x0,0xc0,0x3c,0xc0,0x38,0x48,0x38,0xc8,0x0,0x48,0xca,0xc0,0x38,0x48,0xd0,0xc8,0x0,0x48,0x50,0x54,0xd6,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0x5a,0x5e,0x61,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0xe3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x84,0x84,0x0,0x84,0x0,0x0,0x0,0x84,0x8,0x84,0x0,0xc,0x90,0x12,0x0,0x12,0x8,0x14,0x0,0x94,0x90,0x94,0x0,0x98,0x9c,0x14,0x0,0x1c,0x20,0x20,0x0,0x98,0x20,0xa4,0x0,0xa4,0x20,0x20,0x0,0x98,0x20,0xa4,0x0,0x2c,0xb0,0x20,0x0,0x34,0xb4,0x34,0x0,0xb4,0xb0,0xba,0x0,0x34,0x38,0x34,0x0,0x3c,0x40,0x3c,0x0,0xc2,0x38,0xc4,0x0,0xc4,0x40,0xc8,0x0,0x48,0xcc,0xc4,0x0,0x4c,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0x5c,0x60,0x62,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x84,0x84,0x0,0x84,0x0,0x0,0x0,0x84,0x8,0x84,0x0,0xc,0x90,0x12,0x0,0x12,0x8,0x14,0x0,0x94,0x90,0x94,0x0,0x98,0x9c,0x14,0x0,0x1c,0x20,0x20,0x0,0x98,0x20,0xa4,0x0,0xa4,0x20,0x20,0x0,0x98,0x20,0xa4,0x0,0x2c,0xb0,0x20,0x0,0x34,0xb4,0x34,0x0,0xb4,0xb0,0xba,0x0,0x34,0x38,0x34,0x0,0x3c,0x40,0x3c,0x0,0xc2,0x38,0xc4,0x0,0xc4,0x40,0xc8,0x0,0x48,0xcc,0xc4,0x0,0x4c,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x54,0x58,0x5c,0x60,0x62,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0xe4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x88,0x0,0x0,0x8e,0x0,0x10,0x10,0x80,0x10,0x0,0x94,0x10,0x0,0x10,0x10,0x1a,0x98,0x0,0x18,0x10,0x0,0x10,0xa0,0x9c,0x20,0x0,0x18,0x10,0x0,0xa8,0xa0,0x28,0xa8,0x0,0xac,0xae,0x0,0xa8,0x30,0x28,0x30,0x0,0x30,0x30,0x0,0xa8,0x30,0x28,0xb8,0x0,0x30,0x30,0x0,0x40,0xc0,0xc2,0x40,0x0,0xc4,0x30,0xc8,0x40,0xc0,0x44,0xc8,0x0,0x48,0xce,0xc8,0x40,0x50,0xd0,0xc8,0x0,0x48,0x50,0x54,0xd8,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x54,0x5a,0x5e,0x61,0x63,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x80,0x88,0x0,0x0,0x8e,0x0,0x10,0x10,0x80,0x10,0x0,0x94,0x10,0x0,0x10,0x10,0x1a,0x98,0x0,0x18,0x10,0x0,0x10,0xa0,0x9c,0x20,0x0,0x18,0x10,0x0,0xa8,0xa0,0x28,0xa8,0x0,0xac,0xae,0x0,0xa8,0x30,0x28,0x30,0x0,0x30,0x30,0x0,0xa8,0x30,0x28,0xb8,0x0,0x30,0x30,0x0,0x40,0xc0,0xc2,0x40,0x0,0xc4,0x30,0xc8,0x40,0xc0,0x44,0xc8,0x0,0x48,0xce,0xc8,0x40,0x50,0xd0,0xc8,0x0,0x48,0x50,0x54,0xd8,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x54,0x5a,0x5e,0x61,0x63,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0xe5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x4,0x0,0x86,0x0,0x0,0x8,0x84,0x8,0x4,0x0,0x8,0x0,0x90,0x8,0x10,0x90,0x92,0x0,0x8,0x0,0x90,0x98,0x10,0x18,0x18,0x0,0x8,0x0,0x90,0x98,0x10,0xa0,0x18,0x0,0xa6,0x0,0x28,0x28,0xaa,0x28,0x18,0x0,0x28,0x0,0x28,0x28,0x2c,0xb0,0xb4,0x0,0x28,0x0,0x28,0xb8,0xb8,0x38,0xb4,0x0,0x28,0x0,0xc0,0xb8,0xb8,0xc0,0x42,0x0,0xc6,0x0,0xc0,0x48,0xb8,0x48,0xc8,0x0,0x48,0x50,0xc0,0x48,0x52,0xd0,0xc8,0x0,0x48,0x50,0x58,0xd8,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x58,0x5c,0x60,0x62,0x64,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x80,0x4,0x0,0x86,0x0,0x0,0x8,0x84,0x8,0x4,0x0,0x8,0x0,0x90,0x8,0x10,0x90,0x92,0x0,0x8,0x0,0x90,0x98,0x10,0x18,0x18,0x0,0x8,0x0,0x90,0x98,0x10,0xa0,0x18,0x0,0xa6,0x0,0x28,0x28,0xaa,0x28,0x18,0x0,0x28,0x0,0x28,0x28,0x2c,0xb0,0xb4,0x0,0x28,0x0,0x28,0xb8,0xb8,0x38,0xb4,0x0,0x28,0x0,0xc0,0xb8,0xb8,0xc0,0x42,0x0,0xc6,0x0,0xc0,0x48,0xb8,0x48,0xc8,0x0,0x48,0x50,0xc0,0x48,0x52,0xd0,0xc8,0x0,0x48,0x50,0x58,0xd8,0xd4,0xd0,0xc8,0x0,0x48,0x50,0x58,0x5c,0x60,0x62,0x64,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0xe6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x0,0x88,0x0,0xc,0x80,0x0,0x0,0x8c,0x0,0x88,0x0,0xc,0x14,0x0,0x0,0x14,0x0,0x88,0x0,0x9a,0x98,0x0,0x0,0x9c,0x0,0x22,0x0,0x20,0x98,0x0,0x0,0x24,0x0,0xa4,0x0,0x20,0x2c,0x0,0x0,0xac,0x0,0x30,0x0,0x20,0xb0,0x0,0x0,0x34,0x0,0x30,0x0,0xbc,0xb0,0x0,0x0,0xbc,0x0,0x30,0x0,0xbc,0x44,0x0,0x48,0x44,0x0,0xca,0x0,0x4a,0xc8,0x0,0x48,0xcc,0x0,0x4c,0xd4,0xd0,0xc8,0x0,0x48,0x54,0x5a,0xd8,0xd4,0xd0,0xc8,0x0,0x48,0x54,0x5a,0x5e,0x61,0x63,0x65,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x4,0x0,0x88,0x0,0xc,0x80,0x0,0x0,0x8c,0x0,0x88,0x0,0xc,0x14,0x0,0x0,0x14,0x0,0x88,0x0,0x9a,0x98,0x0,0x0,0x9c,0x0,0x22,0x0,0x20,0x98,0x0,0x0,0x24,0x0,0xa4,0x0,0x20,0x2c,0x0,0x0,0xac,0x0,0x30,0x0,0x20,0xb0,0x0,0x0,0x34,0x0,0x30,0x0,0xbc,0xb0,0x0,0x0,0xbc,0x0,0x30,0x0,0xbc,0x44,0x0,0x48,0x44,0x0,0xca,0x0,0x4a,0xc8,0x0,0x48,0xcc,0x0,0x4c,0xd4,0xd0,0xc8,0x0,0x48,0x54,0x5a,0xd8,0xd4,0xd0,0xc8,0x0,0x48,0x54,0x5a,0x5e,0x61,0x63,0x65,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0xe7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x6,0x0,0x88,0x88,0x8a,0x88,0x88,0x88,0x88,0x0,0x88,0x88,0xc,0x10,0x88,0x88,0x88,0x0,0x88,0x18,0x98,0x98,0x88,0x1c,0x88,0x0,0x20,0x18,0x98,0x20,0x24,0xa0,0x26,0x0,0x20,0xa8,0x98,0xa8,0x24,0xa0,0xa8,0x0,0x20,0xa8,0x32,0x30,0xb2,0x34,0xa8,0x0,0xb8,0x38,0xb4,0xb8,0x38,0xb8,0xa8,0x0,0xb8,0x38,0x40,0x40,0x38,0xb8,0x46,0x0,0xb8,0xc8,0x40,0xc8,0x38,0x4c,0xc8,0x0,0x50,0xc8,0x40,0x50,0xd4,0xd0,0xc8,0x0,0x50,0x58,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0x50,0x58,0x5c,0x60,0x62,0x64,0x66,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x4,0x6,0x0,0x88,0x88,0x8a,0x88,0x88,0x88,0x88,0x0,0x88,0x88,0xc,0x10,0x88,0x88,0x88,0x0,0x88,0x18,0x98,0x98,0x88,0x1c,0x88,0x0,0x20,0x18,0x98,0x20,0x24,0xa0,0x26,0x0,0x20,0xa8,0x98,0xa8,0x24,0xa0,0xa8,0x0,0x20,0xa8,0x32,0x30,0xb2,0x34,0xa8,0x0,0xb8,0x38,0xb4,0xb8,0x38,0xb8,0xa8,0x0,0xb8,0x38,0x40,0x40,0x38,0xb8,0x46,0x0,0xb8,0xc8,0x40,0xc8,0x38,0x4c,0xc8,0x0,0x50,0xc8,0x40,0x50,0xd4,0xd0,0xc8,0x0,0x50,0x58,0xda,0xd8,0xd4,0xd0,0xc8,0x0,0x50,0x58,0x5c,0x60,0x62,0x64,0x66,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0xe8,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x80,0x8,0x0,0x0,0xc,0x4,0x0,0x0,0x10,0x8,0x0,0x0,0x90,0x94,0x0,0x0,0x10,0x8,0x0,0x9c,0x90,0x94,0x0,0x0,0xa0,0xa2,0x0,0x9c,0x24,0x24,0x0,0x0,0xa0,0x24,0x0,0x2a,0xa8,0x24,0x0,0x0,0x30,0xb0,0x0,0xb0,0xa8,0xb4,0x0,0x0,0x30,0xb0,0x0,0xb0,0x3c,0xb4,0x0,0x0,0xc0,0xb0,0x0,0xb0,0xc0,0x44,0x0,0x0,0xc0,0x4a,0x0,0x4c,0xc0,0x44,0xd0,0x0,0x50,0xcc,0x0,0x4c,0x54,0xd4,0xd0,0x0,0x50,0x58,0x5c,0xda,0xd8,0xd4,0xd0,0x0,0x50,0x58,0x5c,0x60,0x62,0x64,0x66,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x0,0x80,0x8,0x0,0x0,0xc,0x4,0x0,0x0,0x10,0x8,0x0,0x0,0x90,0x94,0x0,0x0,0x10,0x8,0x0,0x9c,0x90,0x94,0x0,0x0,0xa0,0xa2,0x0,0x9c,0x24,0x24,0x0,0x0,0xa0,0x24,0x0,0x2a,0xa8,0x24,0x0,0x0,0x30,0xb0,0x0,0xb0,0xa8,0xb4,0x0,0x0,0x30,0xb0,0x0,0xb0,0x3c,0xb4,0x0,0x0,0xc0,0xb0,0x0,0xb0,0xc0,0x44,0x0,0x0,0xc0,0x4a,0x0,0x4c,0xc0,0x44,0xd0,0x0,0x50,0xcc,0x0,0x4c,0x54,0xd4,0xd0,0x0,0x50,0x58,0x5c,0xda,0xd8,0xd4,0xd0,0x0,0x50,0x58,0x5c,0x60,0x62,0x64,0x66,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0xe9,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x8,0x0,0x0,0x8,0x8a,0x0,0x8a,0x0,0x8,0x0,0x0,0x90,0x10,0x94,0x12,0x0,0x8,0x0,0x9a,0x18,0x10,0x18,0x9a,0x0,0xa0,0x0,0x1c,0xa0,0x10,0x18,0x22,0x0,0xa0,0x0,0xa8,0x28,0xac,0xac,0xaa,0x0,0xa0,0x0,0xa8,0xb0,0xac,0x30,0x32,0x0,0x38,0x0,0xa8,0x38,0x3a,0x30,0xba,0x0,0x38,0x0,0x42,0xc0,0xc0,0xc4,0x42,0x0,0x38,0x0,0xc4,0x48,0xc0,0x48,0xca,0x0,0xd0,0x0,0x50,0xd0,0xc0,0x48,0x52,0xd8,0xd0,0x0,0x50,0x58,0x5c,0xdc,0xda,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0x62,0x64,0x68,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x2,0x0,0x8,0x0,0x0,0x8,0x8a,0x0,0x8a,0x0,0x8,0x0,0x0,0x90,0x10,0x94,0x12,0x0,0x8,0x0,0x9a,0x18,0x10,0x18,0x9a,0x0,0xa0,0x0,0x1c,0xa0,0x10,0x18,0x22,0x0,0xa0,0x0,0xa8,0x28,0xac,0xac,0xaa,0x0,0xa0,0x0,0xa8,0xb0,0xac,0x30,0x32,0x0,0x38,0x0,0xa8,0x38,0x3a,0x30,0xba,0x0,0x38,0x0,0x42,0xc0,0xc0,0xc4,0x42,0x0,0x38,0x0,0xc4,0x48,0xc0,0x48,0xca,0x0,0xd0,0x0,0x50,0xd0,0xc0,0x48,0x52,0xd8,0xd0,0x0,0x50,0x58,0x5c,0xdc,0xda,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0x62,0x64,0x68,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0xea,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x80,0x0,0x0,0x10,0x90,0x0,0x10,0x94,0x14,0x0,0x0,0x10,0x90,0x0,0x98,0x94,0x98,0x9e,0x0,0x10,0x20,0x0,0x20,0x22,0x98,0x20,0x0,0xa8,0x20,0x0,0xa8,0xa8,0x2c,0x20,0x0,0xa8,0xb0,0x0,0x30,0xa8,0xb0,0x20,0x0,0xa8,0xb0,0x0,0xb8,0xa8,0xb0,0xbe,0x0,0x40,0x40,0x0,0x40,0x44,0x44,0x40,0x0,0x40,0x40,0x0,0xc8,0x44,0xc8,0x40,0x50,0x40,0xd0,0x0,0x50,0xd2,0xc8,0x40,0x50,0xd8,0xd0,0x0,0x50,0x58,0x5c,0xde,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0x62,0x66,0x69,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x80,0x0,0x0,0x10,0x90,0x0,0x10,0x94,0x14,0x0,0x0,0x10,0x90,0x0,0x98,0x94,0x98,0x9e,0x0,0x10,0x20,0x0,0x20,0x22,0x98,0x20,0x0,0xa8,0x20,0x0,0xa8,0xa8,0x2c,0x20,0x0,0xa8,0xb0,0x0,0x30,0xa8,0xb0,0x20,0x0,0xa8,0xb0,0x0,0xb8,0xa8,0xb0,0xbe,0x0,0x40,0x40,0x0,0x40,0x44,0x44,0x40,0x0,0x40,0x40,0x0,0xc8,0x44,0xc8,0x40,0x50,0x40,0xd0,0x0,0x50,0xd2,0xc8,0x40,0x50,0xd8,0xd0,0x0,0x50,0x58,0x5c,0xde,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0x62,0x66,0x69,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0xeb,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0x80,0x0,0x8c,0x8c,0x8c,0x0,0x8c,0x8,0x80,0x0,0x8c,0x10,0x8c,0x0,0x14,0x98,0x1a,0x0,0x1a,0x10,0x1c,0x0,0x9c,0x98,0x9c,0x0,0xa0,0xa4,0x1c,0x0,0x24,0x28,0x28,0x0,0xa0,0x28,0xac,0x0,0xac,0x28,0x28,0x0,0xa0,0x28,0xac,0x0,0x34,0xb8,0x28,0x0,0x3c,0xbc,0x3c,0x0,0xbc,0xb8,0xc2,0x0,0x3c,0x40,0x3c,0x0,0x44,0x48,0x44,0x0,0xca,0x40,0xcc,0x0,0xcc,0x48,0xd0,0x0,0x50,0xd4,0xcc,0x0,0x54,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0x64,0x68,0x6a,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x4,0x8,0x80,0x0,0x8c,0x8c,0x8c,0x0,0x8c,0x8,0x80,0x0,0x8c,0x10,0x8c,0x0,0x14,0x98,0x1a,0x0,0x1a,0x10,0x1c,0x0,0x9c,0x98,0x9c,0x0,0xa0,0xa4,0x1c,0x0,0x24,0x28,0x28,0x0,0xa0,0x28,0xac,0x0,0xac,0x28,0x28,0x0,0xa0,0x28,0xac,0x0,0x34,0xb8,0x28,0x0,0x3c,0xbc,0x3c,0x0,0xbc,0xb8,0xc2,0x0,0x3c,0x40,0x3c,0x0,0x44,0x48,0x44,0x0,0xca,0x40,0xcc,0x0,0xcc,0x48,0xd0,0x0,0x50,0xd4,0xcc,0x0,0x54,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x60,0x64,0x68,0x6a,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0xec,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x0,0x0,0x80,0x88,0x88,0x8,0x0,0x8,0x0,0x0,0x80,0x88,0x88,0x90,0x0,0x8,0x96,0x0,0x18,0x18,0x88,0x18,0x0,0x9c,0x18,0x0,0x18,0x18,0x22,0xa0,0x0,0x20,0x18,0x0,0x18,0xa8,0xa4,0x28,0x0,0x20,0x18,0x0,0xb0,0xa8,0x30,0xb0,0x0,0xb4,0xb6,0x0,0xb0,0x38,0x30,0x38,0x0,0x38,0x38,0x0,0xb0,0x38,0x30,0xc0,0x0,0x38,0x38,0x0,0x48,0xc8,0xca,0x48,0x0,0xcc,0x38,0xd0,0x48,0xc8,0x4c,0xd0,0x0,0x50,0xd6,0xd0,0x48,0x58,0xd8,0xd0,0x0,0x50,0x58,0x5c,0xe0,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x62,0x66,0x69,0x6b,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x84,0x0,0x0,0x80,0x88,0x88,0x8,0x0,0x8,0x0,0x0,0x80,0x88,0x88,0x90,0x0,0x8,0x96,0x0,0x18,0x18,0x88,0x18,0x0,0x9c,0x18,0x0,0x18,0x18,0x22,0xa0,0x0,0x20,0x18,0x0,0x18,0xa8,0xa4,0x28,0x0,0x20,0x18,0x0,0xb0,0xa8,0x30,0xb0,0x0,0xb4,0xb6,0x0,0xb0,0x38,0x30,0x38,0x0,0x38,0x38,0x0,0xb0,0x38,0x30,0xc0,0x0,0x38,0x38,0x0,0x48,0xc8,0xca,0x48,0x0,0xcc,0x38,0xd0,0x48,0xc8,0x4c,0xd0,0x0,0x50,0xd6,0xd0,0x48,0x58,0xd8,0xd0,0x0,0x50,0x58,0x5c,0xe0,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x5c,0x62,0x66,0x69,0x6b,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0xed,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0xa,0x88,0xc,0x0,0x8e,0x0,0x0,0x10,0x8c,0x10,0xc,0x0,0x10,0x0,0x98,0x10,0x18,0x98,0x9a,0x0,0x10,0x0,0x98,0xa0,0x18,0x20,0x20,0x0,0x10,0x0,0x98,0xa0,0x18,0xa8,0x20,0x0,0xae,0x0,0x30,0x30,0xb2,0x30,0x20,0x0,0x30,0x0,0x30,0x30,0x34,0xb8,0xbc,0x0,0x30,0x0,0x30,0xc0,0xc0,0x40,0xbc,0x0,0x30,0x0,0xc8,0xc0,0xc0,0xc8,0x4a,0x0,0xce,0x0,0xc8,0x50,0xc0,0x50,0xd0,0x0,0x50,0x58,0xc8,0x50,0x5a,0xd8,0xd0,0x0,0x50,0x58,0x60,0xe0,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x60,0x64,0x68,0x6a,0x6c,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0xa,0x88,0xc,0x0,0x8e,0x0,0x0,0x10,0x8c,0x10,0xc,0x0,0x10,0x0,0x98,0x10,0x18,0x98,0x9a,0x0,0x10,0x0,0x98,0xa0,0x18,0x20,0x20,0x0,0x10,0x0,0x98,0xa0,0x18,0xa8,0x20,0x0,0xae,0x0,0x30,0x30,0xb2,0x30,0x20,0x0,0x30,0x0,0x30,0x30,0x34,0xb8,0xbc,0x0,0x30,0x0,0x30,0xc0,0xc0,0x40,0xbc,0x0,0x30,0x0,0xc8,0xc0,0xc0,0xc8,0x4a,0x0,0xce,0x0,0xc8,0x50,0xc0,0x50,0xd0,0x0,0x50,0x58,0xc8,0x50,0x5a,0xd8,0xd0,0x0,0x50,0x58,0x60,0xe0,0xdc,0xd8,0xd0,0x0,0x50,0x58,0x60,0x64,0x68,0x6a,0x6c,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0xee,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x4,0x0,0x0,0x84,0x0,0x4,0x0,0x0,0x88,0x0,0x0,0xc,0x0,0x90,0x0,0x14,0x88,0x0,0x0,0x94,0x0,0x90,0x0,0x14,0x1c,0x0,0x0,0x1c,0x0,0x90,0x0,0xa2,0xa0,0x0,0x0,0xa4,0x0,0x2a,0x0,0x28,0xa0,0x0,0x0,0x2c,0x0,0xac,0x0,0x28,0x34,0x0,0x0,0xb4,0x0,0x38,0x0,0x28,0xb8,0x0,0x0,0x3c,0x0,0x38,0x0,0xc4,0xb8,0x0,0x0,0xc4,0x0,0x38,0x0,0xc4,0x4c,0x0,0x50,0x4c,0x0,0xd2,0x0,0x52,0xd0,0x0,0x50,0xd4,0x0,0x54,0xdc,0xd8,0xd0,0x0,0x50,0x5c,0x62,0xe0,0xdc,0xd8,0xd0,0x0,0x50,0x5c,0x62,0x66,0x69,0x6b,0x6d,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x4,0x0,0x0,0x84,0x0,0x4,0x0,0x0,0x88,0x0,0x0,0xc,0x0,0x90,0x0,0x14,0x88,0x0,0x0,0x94,0x0,0x90,0x0,0x14,0x1c,0x0,0x0,0x1c,0x0,0x90,0x0,0xa2,0xa0,0x0,0x0,0xa4,0x0,0x2a,0x0,0x28,0xa0,0x0,0x0,0x2c,0x0,0xac,0x0,0x28,0x34,0x0,0x0,0xb4,0x0,0x38,0x0,0x28,0xb8,0x0,0x0,0x3c,0x0,0x38,0x0,0xc4,0xb8,0x0,0x0,0xc4,0x0,0x38,0x0,0xc4,0x4c,0x0,0x50,0x4c,0x0,0xd2,0x0,0x52,0xd0,0x0,0x50,0xd4,0x0,0x54,0xdc,0xd8,0xd0,0x0,0x50,0x5c,0x62,0xe0,0xdc,0xd8,0xd0,0x0,0x50,0x5c,0x62,0x66,0x69,0x6b,0x6d,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0xef,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0xa,0xc,0xe,0x0,0x90,0x90,0x92,0x90,0x90,0x90,0x90,0x0,0x90,0x90,0x14,0x18,0x90,0x90,0x90,0x0,0x90,0x20,0xa0,0xa0,0x90,0x24,0x90,0x0,0x28,0x20,0xa0,0x28,0x2c,0xa8,0x2e,0x0,0x28,0xb0,0xa0,0xb0,0x2c,0xa8,0xb0,0x0,0x28,0xb0,0x3a,0x38,0xba,0x3c,0xb0,0x0,0xc0,0x40,0xbc,0xc0,0x40,0xc0,0xb0,0x0,0xc0,0x40,0x48,0x48,0x40,0xc0,0x4e,0x0,0xc0,0xd0,0x48,0xd0,0x40,0x54,0xd0,0x0,0x58,0xd0,0x48,0x58,0xdc,0xd8,0xd0,0x0,0x58,0x60,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0x58,0x60,0x64,0x68,0x6a,0x6c,0x6e,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x8,0xa,0xc,0xe,0x0,0x90,0x90,0x92,0x90,0x90,0x90,0x90,0x0,0x90,0x90,0x14,0x18,0x90,0x90,0x90,0x0,0x90,0x20,0xa0,0xa0,0x90,0x24,0x90,0x0,0x28,0x20,0xa0,0x28,0x2c,0xa8,0x2e,0x0,0x28,0xb0,0xa0,0xb0,0x2c,0xa8,0xb0,0x0,0x28,0xb0,0x3a,0x38,0xba,0x3c,0xb0,0x0,0xc0,0x40,0xbc,0xc0,0x40,0xc0,0xb0,0x0,0xc0,0x40,0x48,0x48,0x40,0xc0,0x4e,0x0,0xc0,0xd0,0x48,0xd0,0x40,0x54,0xd0,0x0,0x58,0xd0,0x48,0x58,0xdc,0xd8,0xd0,0x0,0x58,0x60,0xe2,0xe0,0xdc,0xd8,0xd0,0x0,0x58,0x60,0x64,0x68,0x6a,0x6c,0x6e,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0xf0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x84,0x0,0x8,0x80,0xc,0x0,0x0,0x88,0x10,0x0,0x8,0x14,0xc,0x0,0x0,0x18,0x10,0x0,0x8,0x98,0x9c,0x0,0x0,0x18,0x10,0x0,0xa4,0x98,0x9c,0x0,0x0,0xa8,0xaa,0x0,0xa4,0x2c,0x2c,0x0,0x0,0xa8,0x2c,0x0,0x32,0xb0,0x2c,0x0,0x0,0x38,0xb8,0x0,0xb8,0xb0,0xbc,0x0,0x0,0x38,0xb8,0x0,0xb8,0x44,0xbc,0x0,0x0,0xc8,0xb8,0x0,0xb8,0xc8,0x4c,0x0,0x0,0xc8,0x52,0x0,0x54,0xc8,0x4c,0xd8,0x0,0x58,0xd4,0x0,0x54,0x5c,0xdc,0xd8,0x0,0x58,0x60,0x64,0xe2,0xe0,0xdc,0xd8,0x0,0x58,0x60,0x64,0x68,0x6a,0x6c,0x6e,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x2,0x0,0x82,0x80,0x0,0x0,0x0,0x88,0x84,0x0,0x8,0x80,0xc,0x0,0x0,0x88,0x10,0x0,0x8,0x14,0xc,0x0,0x0,0x18,0x10,0x0,0x8,0x98,0x9c,0x0,0x0,0x18,0x10,0x0,0xa4,0x98,0x9c,0x0,0x0,0xa8,0xaa,0x0,0xa4,0x2c,0x2c,0x0,0x0,0xa8,0x2c,0x0,0x32,0xb0,0x2c,0x0,0x0,0x38,0xb8,0x0,0xb8,0xb0,0xbc,0x0,0x0,0x38,0xb8,0x0,0xb8,0x44,0xbc,0x0,0x0,0xc8,0xb8,0x0,0xb8,0xc8,0x4c,0x0,0x0,0xc8,0x52,0x0,0x54,0xc8,0x4c,0xd8,0x0,0x58,0xd4,0x0,0x54,0x5c,0xdc,0xd8,0x0,0x58,0x60,0x64,0xe2,0xe0,0xdc,0xd8,0x0,0x58,0x60,0x64,0x68,0x6a,0x6c,0x6e,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0xf1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x84,0x82,0x0,0x0,0x0,0x8,0x88,0x4,0x8,0xa,0x0,0x10,0x0,0x8,0x10,0x92,0x8,0x92,0x0,0x10,0x0,0x8,0x98,0x18,0x9c,0x1a,0x0,0x10,0x0,0xa2,0x20,0x18,0x20,0xa2,0x0,0xa8,0x0,0x24,0xa8,0x18,0x20,0x2a,0x0,0xa8,0x0,0xb0,0x30,0xb4,0xb4,0xb2,0x0,0xa8,0x0,0xb0,0xb8,0xb4,0x38,0x3a,0x0,0x40,0x0,0xb0,0x40,0x42,0x38,0xc2,0x0,0x40,0x0,0x4a,0xc8,0xc8,0xcc,0x4a,0x0,0x40,0x0,0xcc,0x50,0xc8,0x50,0xd2,0x0,0xd8,0x0,0x58,0xd8,0xc8,0x50,0x5a,0xe0,0xd8,0x0,0x58,0x60,0x64,0xe4,0xe2,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0x6a,0x6c,0x70,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x84,0x82,0x0,0x0,0x0,0x8,0x88,0x4,0x8,0xa,0x0,0x10,0x0,0x8,0x10,0x92,0x8,0x92,0x0,0x10,0x0,0x8,0x98,0x18,0x9c,0x1a,0x0,0x10,0x0,0xa2,0x20,0x18,0x20,0xa2,0x0,0xa8,0x0,0x24,0xa8,0x18,0x20,0x2a,0x0,0xa8,0x0,0xb0,0x30,0xb4,0xb4,0xb2,0x0,0xa8,0x0,0xb0,0xb8,0xb4,0x38,0x3a,0x0,0x40,0x0,0xb0,0x40,0x42,0x38,0xc2,0x0,0x40,0x0,0x4a,0xc8,0xc8,0xcc,0x4a,0x0,0x40,0x0,0xcc,0x50,0xc8,0x50,0xd2,0x0,0xd8,0x0,0x58,0xd8,0xc8,0x50,0x5a,0xe0,0xd8,0x0,0x58,0x60,0x64,0xe4,0xe2,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0x6a,0x6c,0x70,0xf2,0xf2,0xf2,0xf2,0xf2,0xf2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x4,0x86,0x0,0x80,0x8,0x0,0x8,0x0,0x88,0x8,0x0,0x80,0x8,0x0,0x90,0x0,0x88,0x8,0x0,0x18,0x98,0x0,0x18,0x9c,0x1c,0x8,0x0,0x18,0x98,0x0,0xa0,0x9c,0xa0,0xa6,0x0,0x18,0x28,0x0,0x28,0x2a,0xa0,0x28,0x0,0xb0,0x28,0x0,0xb0,0xb0,0x34,0x28,0x0,0xb0,0xb8,0x0,0x38,0xb0,0xb8,0x28,0x0,0xb0,0xb8,0x0,0xc0,0xb0,0xb8,0xc6,0x0,0x48,0x48,0x0,0x48,0x4c,0x4c,0x48,0x0,0x48,0x48,0x0,0xd0,0x4c,0xd0,0x48,0x58,0x48,0xd8,0x0,0x58,0xda,0xd0,0x48,0x58,0xe0,0xd8,0x0,0x58,0x60,0x64,0xe6,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0x6a,0x6e,0x71,0xf3,0xf3,0xf3,0xf3,0xf3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x80,0x0,0x4,0x86,0x0,0x80,0x8,0x0,0x8,0x0,0x88,0x8,0x0,0x80,0x8,0x0,0x90,0x0,0x88,0x8,0x0,0x18,0x98,0x0,0x18,0x9c,0x1c,0x8,0x0,0x18,0x98,0x0,0xa0,0x9c,0xa0,0xa6,0x0,0x18,0x28,0x0,0x28,0x2a,0xa0,0x28,0x0,0xb0,0x28,0x0,0xb0,0xb0,0x34,0x28,0x0,0xb0,0xb8,0x0,0x38,0xb0,0xb8,0x28,0x0,0xb0,0xb8,0x0,0xc0,0xb0,0xb8,0xc6,0x0,0x48,0x48,0x0,0x48,0x4c,0x4c,0x48,0x0,0x48,0x48,0x0,0xd0,0x4c,0xd0,0x48,0x58,0x48,0xd8,0x0,0x58,0xda,0xd0,0x48,0x58,0xe0,0xd8,0x0,0x58,0x60,0x64,0xe6,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0x6a,0x6e,0x71,0xf3,0xf3,0xf3,0xf3,0xf3,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x84,0x80,0x88,0x0,0x0,0x0,0x4,0x0,0xc,0x10,0x88,0x0,0x94,0x94,0x94,0x0,0x94,0x10,0x88,0x0,0x94,0x18,0x94,0x0,0x1c,0xa0,0x22,0x0,0x22,0x18,0x24,0x0,0xa4,0xa0,0xa4,0x0,0xa8,0xac,0x24,0x0,0x2c,0x30,0x30,0x0,0xa8,0x30,0xb4,0x0,0xb4,0x30,0x30,0x0,0xa8,0x30,0xb4,0x0,0x3c,0xc0,0x30,0x0,0x44,0xc4,0x44,0x0,0xc4,0xc0,0xca,0x0,0x44,0x48,0x44,0x0,0x4c,0x50,0x4c,0x0,0xd2,0x48,0xd4,0x0,0xd4,0x50,0xd8,0x0,0x58,0xdc,0xd4,0x0,0x5c,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0x6c,0x70,0x72,0xf4,0xf4,0xf4,0xf4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x4,0x0,0x84,0x80,0x88,0x0,0x0,0x0,0x4,0x0,0xc,0x10,0x88,0x0,0x94,0x94,0x94,0x0,0x94,0x10,0x88,0x0,0x94,0x18,0x94,0x0,0x1c,0xa0,0x22,0x0,0x22,0x18,0x24,0x0,0xa4,0xa0,0xa4,0x0,0xa8,0xac,0x24,0x0,0x2c,0x30,0x30,0x0,0xa8,0x30,0xb4,0x0,0xb4,0x30,0x30,0x0,0xa8,0x30,0xb4,0x0,0x3c,0xc0,0x30,0x0,0x44,0xc4,0x44,0x0,0xc4,0xc0,0xca,0x0,0x44,0x48,0x44,0x0,0x4c,0x50,0x4c,0x0,0xd2,0x48,0xd4,0x0,0xd4,0x50,0xd8,0x0,0x58,0xdc,0xd4,0x0,0x5c,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x64,0x68,0x6c,0x70,0x72,0xf4,0xf4,0xf4,0xf4,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x4,0x88,0x0,0x8c,0x0,0x0,0x88,0x90,0x90,0x10,0x0,0x10,0x0,0x0,0x88,0x90,0x90,0x98,0x0,0x10,0x9e,0x0,0x20,0x20,0x90,0x20,0x0,0xa4,0x20,0x0,0x20,0x20,0x2a,0xa8,0x0,0x28,0x20,0x0,0x20,0xb0,0xac,0x30,0x0,0x28,0x20,0x0,0xb8,0xb0,0x38,0xb8,0x0,0xbc,0xbe,0x0,0xb8,0x40,0x38,0x40,0x0,0x40,0x40,0x0,0xb8,0x40,0x38,0xc8,0x0,0x40,0x40,0x0,0x50,0xd0,0xd2,0x50,0x0,0xd4,0x40,0xd8,0x50,0xd0,0x54,0xd8,0x0,0x58,0xde,0xd8,0x50,0x60,0xe0,0xd8,0x0,0x58,0x60,0x64,0xe8,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x64,0x6a,0x6e,0x71,0x73,0xf5,0xf5,0xf5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x0,0x0,0x0,0x88,0x0,0x4,0x88,0x0,0x8c,0x0,0x0,0x88,0x90,0x90,0x10,0x0,0x10,0x0,0x0,0x88,0x90,0x90,0x98,0x0,0x10,0x9e,0x0,0x20,0x20,0x90,0x20,0x0,0xa4,0x20,0x0,0x20,0x20,0x2a,0xa8,0x0,0x28,0x20,0x0,0x20,0xb0,0xac,0x30,0x0,0x28,0x20,0x0,0xb8,0xb0,0x38,0xb8,0x0,0xbc,0xbe,0x0,0xb8,0x40,0x38,0x40,0x0,0x40,0x40,0x0,0xb8,0x40,0x38,0xc8,0x0,0x40,0x40,0x0,0x50,0xd0,0xd2,0x50,0x0,0xd4,0x40,0xd8,0x50,0xd0,0x54,0xd8,0x0,0x58,0xde,0xd8,0x50,0x60,0xe0,0xd8,0x0,0x58,0x60,0x64,0xe8,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x64,0x6a,0x6e,0x71,0x73,0xf5,0xf5,0xf5,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x0,0x8,0x0,0x0,0x0,0x0,0x8,0x88,0x12,0x90,0x14,0x0,0x96,0x0,0x8,0x18,0x94,0x18,0x14,0x0,0x18,0x0,0xa0,0x18,0x20,0xa0,0xa2,0x0,0x18,0x0,0xa0,0xa8,0x20,0x28,0x28,0x0,0x18,0x0,0xa0,0xa8,0x20,0xb0,0x28,0x0,0xb6,0x0,0x38,0x38,0xba,0x38,0x28,0x0,0x38,0x0,0x38,0x38,0x3c,0xc0,0xc4,0x0,0x38,0x0,0x38,0xc8,0xc8,0x48,0xc4,0x0,0x38,0x0,0xd0,0xc8,0xc8,0xd0,0x52,0x0,0xd6,0x0,0xd0,0x58,0xc8,0x58,0xd8,0x0,0x58,0x60,0xd0,0x58,0x62,0xe0,0xd8,0x0,0x58,0x60,0x68,0xe8,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x68,0x6c,0x70,0x72,0x74,0xf6,0xf6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x0,0x8,0x88,0x0,0x8,0x0,0x0,0x0,0x0,0x8,0x88,0x12,0x90,0x14,0x0,0x96,0x0,0x8,0x18,0x94,0x18,0x14,0x0,0x18,0x0,0xa0,0x18,0x20,0xa0,0xa2,0x0,0x18,0x0,0xa0,0xa8,0x20,0x28,0x28,0x0,0x18,0x0,0xa0,0xa8,0x20,0xb0,0x28,0x0,0xb6,0x0,0x38,0x38,0xba,0x38,0x28,0x0,0x38,0x0,0x38,0x38,0x3c,0xc0,0xc4,0x0,0x38,0x0,0x38,0xc8,0xc8,0x48,0xc4,0x0,0x38,0x0,0xd0,0xc8,0xc8,0xd0,0x52,0x0,0xd6,0x0,0xd0,0x58,0xc8,0x58,0xd8,0x0,0x58,0x60,0xd0,0x58,0x62,0xe0,0xd8,0x0,0x58,0x60,0x68,0xe8,0xe4,0xe0,0xd8,0x0,0x58,0x60,0x68,0x6c,0x70,0x72,0x74,0xf6,0xf6,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x4,0x0,0x8a,0x0,0x80,0xc,0x0,0x0,0x8c,0x0,0xc,0x0,0x80,0x90,0x0,0x0,0x14,0x0,0x98,0x0,0x1c,0x90,0x0,0x0,0x9c,0x0,0x98,0x0,0x1c,0x24,0x0,0x0,0x24,0x0,0x98,0x0,0xaa,0xa8,0x0,0x0,0xac,0x0,0x32,0x0,0x30,0xa8,0x0,0x0,0x34,0x0,0xb4,0x0,0x30,0x3c,0x0,0x0,0xbc,0x0,0x40,0x0,0x30,0xc0,0x0,0x0,0x44,0x0,0x40,0x0,0xcc,0xc0,0x0,0x0,0xcc,0x0,0x40,0x0,0xcc,0x54,0x0,0x58,0x54,0x0,0xda,0x0,0x5a,0xd8,0x0,0x58,0xdc,0x0,0x5c,0xe4,0xe0,0xd8,0x0,0x58,0x64,0x6a,0xe8,0xe4,0xe0,0xd8,0x0,0x58,0x64,0x6a,0x6e,0x71,0x73,0x75,0xf7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x0,0x4,0x0,0x8a,0x0,0x80,0xc,0x0,0x0,0x8c,0x0,0xc,0x0,0x80,0x90,0x0,0x0,0x14,0x0,0x98,0x0,0x1c,0x90,0x0,0x0,0x9c,0x0,0x98,0x0,0x1c,0x24,0x0,0x0,0x24,0x0,0x98,0x0,0xaa,0xa8,0x0,0x0,0xac,0x0,0x32,0x0,0x30,0xa8,0x0,0x0,0x34,0x0,0xb4,0x0,0x30,0x3c,0x0,0x0,0xbc,0x0,0x40,0x0,0x30,0xc0,0x0,0x0,0x44,0x0,0x40,0x0,0xcc,0xc0,0x0,0x0,0xcc,0x0,0x40,0x0,0xcc,0x54,0x0,0x58,0x54,0x0,0xda,0x0,0x5a,0xd8,0x0,0x58,0xdc,0x0,0x5c,0xe4,0xe0,0xd8,0x0,0x58,0x64,0x6a,0xe8,0xe4,0xe0,0xd8,0x0,0x58,0x64,0x6a,0x6e,0x71,0x73,0x75,0xf7,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x0,0x8,0x0,0x88,0x84,0x80,0x0,0x0,0x0,0x8,0x0,0x10,0x12,0x14,0x16,0x0,0x98,0x98,0x9a,0x98,0x98,0x98,0x98,0x0,0x98,0x98,0x1c,0x20,0x98,0x98,0x98,0x0,0x98,0x28,0xa8,0xa8,0x98,0x2c,0x98,0x0,0x30,0x28,0xa8,0x30,0x34,0xb0,0x36,0x0,0x30,0xb8,0xa8,0xb8,0x34,0xb0,0xb8,0x0,0x30,0xb8,0x42,0x40,0xc2,0x44,0xb8,0x0,0xc8,0x48,0xc4,0xc8,0x48,0xc8,0xb8,0x0,0xc8,0x48,0x50,0x50,0x48,0xc8,0x56,0x0,0xc8,0xd8,0x50,0xd8,0x48,0x5c,0xd8,0x0,0x60,0xd8,0x50,0x60,0xe4,0xe0,0xd8,0x0,0x60,0x68,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0x60,0x68,0x6c,0x70,0x72,0x74,0x76,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x84,0x80,0x0,0x0,0x0,0x8,0x0,0x88,0x84,0x80,0x0,0x0,0x0,0x8,0x0,0x10,0x12,0x14,0x16,0x0,0x98,0x98,0x9a,0x98,0x98,0x98,0x98,0x0,0x98,0x98,0x1c,0x20,0x98,0x98,0x98,0x0,0x98,0x28,0xa8,0xa8,0x98,0x2c,0x98,0x0,0x30,0x28,0xa8,0x30,0x34,0xb0,0x36,0x0,0x30,0xb8,0xa8,0xb8,0x34,0xb0,0xb8,0x0,0x30,0xb8,0x42,0x40,0xc2,0x44,0xb8,0x0,0xc8,0x48,0xc4,0xc8,0x48,0xc8,0xb8,0x0,0xc8,0x48,0x50,0x50,0x48,0xc8,0x56,0x0,0xc8,0xd8,0x50,0xd8,0x48,0x5c,0xd8,0x0,0x60,0xd8,0x50,0x60,0xe4,0xe0,0xd8,0x0,0x60,0x68,0xea,0xe8,0xe4,0xe0,0xd8,0x0,0x60,0x68,0x6c,0x70,0x72,0x74,0x76,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x84,0x0,0x0,0x0,0xa,0x0,0x8a,0x88,0x84,0x0,0x0,0x90,0x8c,0x0,0x10,0x88,0x14,0x0,0x0,0x90,0x18,0x0,0x10,0x1c,0x14,0x0,0x0,0x20,0x18,0x0,0x10,0xa0,0xa4,0x0,0x0,0x20,0x18,0x0,0xac,0xa0,0xa4,0x0,0x0,0xb0,0xb2,0x0,0xac,0x34,0x34,0x0,0x0,0xb0,0x34,0x0,0x3a,0xb8,0x34,0x0,0x0,0x40,0xc0,0x0,0xc0,0xb8,0xc4,0x0,0x0,0x40,0xc0,0x0,0xc0,0x4c,0xc4,0x0,0x0,0xd0,0xc0,0x0,0xc0,0xd0,0x54,0x0,0x0,0xd0,0x5a,0x0,0x5c,0xd0,0x54,0xe0,0x0,0x60,0xdc,0x0,0x5c,0x64,0xe4,0xe0,0x0,0x60,0x68,0x6c,0xea,0xe8,0xe4,0xe0,0x0,0x60,0x68,0x6c,0x70,0x72,0x74,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4,0x84,0x0,0x0,0x0,0xa,0x0,0x8a,0x88,0x84,0x0,0x0,0x90,0x8c,0x0,0x10,0x88,0x14,0x0,0x0,0x90,0x18,0x0,0x10,0x1c,0x14,0x0,0x0,0x20,0x18,0x0,0x10,0xa0,0xa4,0x0,0x0,0x20,0x18,0x0,0xac,0xa0,0xa4,0x0,0x0,0xb0,0xb2,0x0,0xac,0x34,0x34,0x0,0x0,0xb0,0x34,0x0,0x3a,0xb8,0x34,0x0,0x0,0x40,0xc0,0x0,0xc0,0xb8,0xc4,0x0,0x0,0x40,0xc0,0x0,0xc0,0x4c,0xc4,0x0,0x0,0xd0,0xc0,0x0,0xc0,0xd0,0x54,0x0,0x0,0xd0,0x5a,0x0,0x5c,0xd0,0x54,0xe0,0x0,0x60,0xdc,0x0,0x5c,0x64,0xe4,0xe0,0x0,0x60,0x68,0x6c,0xea,0xe8,0xe4,0xe0,0x0,0x60,0x68,0x6c,0x70,0x72,0x74,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x2,0x80,0x0,0x0,0x2,0x0,0x80,0x0,0x84,0x8,0xc,0x8c,0x8a,0x0,0x80,0x0,0x10,0x90,0xc,0x10,0x12,0x0,0x18,0x0,0x10,0x18,0x9a,0x10,0x9a,0x0,0x18,0x0,0x10,0xa0,0x20,0xa4,0x22,0x0,0x18,0x0,0xaa,0x28,0x20,0x28,0xaa,0x0,0xb0,0x0,0x2c,0xb0,0x20,0x28,0x32,0x0,0xb0,0x0,0xb8,0x38,0xbc,0xbc,0xba,0x0,0xb0,0x0,0xb8,0xc0,0xbc,0x40,0x42,0x0,0x48,0x0,0xb8,0x48,0x4a,0x40,0xca,0x0,0x48,0x0,0x52,0xd0,0xd0,0xd4,0x52,0x0,0x48,0x0,0xd4,0x58,0xd0,0x58,0xda,0x0,0xe0,0x0,0x60,0xe0,0xd0,0x58,0x62,0xe8,0xe0,0x0,0x60,0x68,0x6c,0xec,0xea,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x70,0x72,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x2,0x80,0x0,0x0,0x2,0x0,0x80,0x0,0x84,0x8,0xc,0x8c,0x8a,0x0,0x80,0x0,0x10,0x90,0xc,0x10,0x12,0x0,0x18,0x0,0x10,0x18,0x9a,0x10,0x9a,0x0,0x18,0x0,0x10,0xa0,0x20,0xa4,0x22,0x0,0x18,0x0,0xaa,0x28,0x20,0x28,0xaa,0x0,0xb0,0x0,0x2c,0xb0,0x20,0x28,0x32,0x0,0xb0,0x0,0xb8,0x38,0xbc,0xbc,0xba,0x0,0xb0,0x0,0xb8,0xc0,0xbc,0x40,0x42,0x0,0x48,0x0,0xb8,0x48,0x4a,0x40,0xca,0x0,0x48,0x0,0x52,0xd0,0xd0,0xd4,0x52,0x0,0x48,0x0,0xd4,0x58,0xd0,0x58,0xda,0x0,0xe0,0x0,0x60,0xe0,0xd0,0x58,0x62,0xe8,0xe0,0x0,0x60,0x68,0x6c,0xec,0xea,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x70,0x72,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x80,0x0,0x88,0x8,0xc,0x8e,0x0,0x88,0x10,0x0,0x10,0x8,0x90,0x10,0x0,0x88,0x10,0x0,0x98,0x8,0x90,0x10,0x0,0x20,0xa0,0x0,0x20,0xa4,0x24,0x10,0x0,0x20,0xa0,0x0,0xa8,0xa4,0xa8,0xae,0x0,0x20,0x30,0x0,0x30,0x32,0xa8,0x30,0x0,0xb8,0x30,0x0,0xb8,0xb8,0x3c,0x30,0x0,0xb8,0xc0,0x0,0x40,0xb8,0xc0,0x30,0x0,0xb8,0xc0,0x0,0xc8,0xb8,0xc0,0xce,0x0,0x50,0x50,0x0,0x50,0x54,0x54,0x50,0x0,0x50,0x50,0x0,0xd8,0x54,0xd8,0x50,0x60,0x50,0xe0,0x0,0x60,0xe2,0xd8,0x50,0x60,0xe8,0xe0,0x0,0x60,0x68,0x6c,0xee,0xec,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x70,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x82,0x0,0x0,0x0,0x88,0x80,0x0,0x88,0x8,0xc,0x8e,0x0,0x88,0x10,0x0,0x10,0x8,0x90,0x10,0x0,0x88,0x10,0x0,0x98,0x8,0x90,0x10,0x0,0x20,0xa0,0x0,0x20,0xa4,0x24,0x10,0x0,0x20,0xa0,0x0,0xa8,0xa4,0xa8,0xae,0x0,0x20,0x30,0x0,0x30,0x32,0xa8,0x30,0x0,0xb8,0x30,0x0,0xb8,0xb8,0x3c,0x30,0x0,0xb8,0xc0,0x0,0x40,0xb8,0xc0,0x30,0x0,0xb8,0xc0,0x0,0xc8,0xb8,0xc0,0xce,0x0,0x50,0x50,0x0,0x50,0x54,0x54,0x50,0x0,0x50,0x50,0x0,0xd8,0x54,0xd8,0x50,0x60,0x50,0xe0,0x0,0x60,0xe2,0xd8,0x50,0x60,0xe8,0xe0,0x0,0x60,0x68,0x6c,0xee,0xec,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x70,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x84,0x0,0x0,0x4,0x88,0x4,0x0,0x0,0x8,0xc,0x0,0x8c,0x88,0x90,0x0,0x0,0x8,0xc,0x0,0x14,0x18,0x90,0x0,0x9c,0x9c,0x9c,0x0,0x9c,0x18,0x90,0x0,0x9c,0x20,0x9c,0x0,0x24,0xa8,0x2a,0x0,0x2a,0x20,0x2c,0x0,0xac,0xa8,0xac,0x0,0xb0,0xb4,0x2c,0x0,0x34,0x38,0x38,0x0,0xb0,0x38,0xbc,0x0,0xbc,0x38,0x38,0x0,0xb0,0x38,0xbc,0x0,0x44,0xc8,0x38,0x0,0x4c,0xcc,0x4c,0x0,0xcc,0xc8,0xd2,0x0,0x4c,0x50,0x4c,0x0,0x54,0x58,0x54,0x0,0xda,0x50,0xdc,0x0,0xdc,0x58,0xe0,0x0,0x60,0xe4,0xdc,0x0,0x64,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x70,0xec,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x82,0x0,0x0,0x84,0x0,0x0,0x4,0x88,0x4,0x0,0x0,0x8,0xc,0x0,0x8c,0x88,0x90,0x0,0x0,0x8,0xc,0x0,0x14,0x18,0x90,0x0,0x9c,0x9c,0x9c,0x0,0x9c,0x18,0x90,0x0,0x9c,0x20,0x9c,0x0,0x24,0xa8,0x2a,0x0,0x2a,0x20,0x2c,0x0,0xac,0xa8,0xac,0x0,0xb0,0xb4,0x2c,0x0,0x34,0x38,0x38,0x0,0xb0,0x38,0xbc,0x0,0xbc,0x38,0x38,0x0,0xb0,0x38,0xbc,0x0,0x44,0xc8,0x38,0x0,0x4c,0xcc,0x4c,0x0,0xcc,0xc8,0xd2,0x0,0x4c,0x50,0x4c,0x0,0x54,0x58,0x54,0x0,0xda,0x50,0xdc,0x0,0xdc,0x58,0xe0,0x0,0x60,0xe4,0xdc,0x0,0x64,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x70,0xec,0xe8,0xe0,0x0,0x60,0x68,0x6c,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x0,0x8,0x8a,0x8,0x0,0x0,0x8,0x0,0x90,0x8,0xc,0x90,0x0,0x94,0x8,0x0,0x90,0x98,0x98,0x18,0x0,0x18,0x8,0x0,0x90,0x98,0x98,0xa0,0x0,0x18,0xa6,0x0,0x28,0x28,0x98,0x28,0x0,0xac,0x28,0x0,0x28,0x28,0x32,0xb0,0x0,0x30,0x28,0x0,0x28,0xb8,0xb4,0x38,0x0,0x30,0x28,0x0,0xc0,0xb8,0x40,0xc0,0x0,0xc4,0xc6,0x0,0xc0,0x48,0x40,0x48,0x0,0x48,0x48,0x0,0xc0,0x48,0x40,0xd0,0x0,0x48,0x48,0x0,0x58,0xd8,0xda,0x58,0x0,0xdc,0x48,0xe0,0x58,0xd8,0x5c,0xe0,0x0,0x60,0xe6,0xe0,0x58,0x68,0xe8,0xe0,0x0,0x60,0x68,0x6c,0xf0,0xec,0xe8,0xe0,0x0,0x60,0x68,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x0,0x86,0x0,0x0,0x8,0x8a,0x8,0x0,0x0,0x8,0x0,0x90,0x8,0xc,0x90,0x0,0x94,0x8,0x0,0x90,0x98,0x98,0x18,0x0,0x18,0x8,0x0,0x90,0x98,0x98,0xa0,0x0,0x18,0xa6,0x0,0x28,0x28,0x98,0x28,0x0,0xac,0x28,0x0,0x28,0x28,0x32,0xb0,0x0,0x30,0x28,0x0,0x28,0xb8,0xb4,0x38,0x0,0x30,0x28,0x0,0xc0,0xb8,0x40,0xc0,0x0,0xc4,0xc6,0x0,0xc0,0x48,0x40,0x48,0x0,0x48,0x48,0x0,0xc0,0x48,0x40,0xd0,0x0,0x48,0x48,0x0,0x58,0xd8,0xda,0x58,0x0,0xdc,0x48,0xe0,0x58,0xd8,0x5c,0xe0,0x0,0x60,0xe6,0xe0,0x58,0x68,0xe8,0xe0,0x0,0x60,0x68,0x6c,0xf0,0xec,0xe8,0xe0,0x0,0x60,0x68,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x80,0x88,0x80,0x0,0x0,0x0,0x10,0x90,0x80,0x10,0x80,0x0,0x0,0x0,0x10,0x90,0x1a,0x98,0x1c,0x0,0x9e,0x0,0x10,0x20,0x9c,0x20,0x1c,0x0,0x20,0x0,0xa8,0x20,0x28,0xa8,0xaa,0x0,0x20,0x0,0xa8,0xb0,0x28,0x30,0x30,0x0,0x20,0x0,0xa8,0xb0,0x28,0xb8,0x30,0x0,0xbe,0x0,0x40,0x40,0xc2,0x40,0x30,0x0,0x40,0x0,0x40,0x40,0x44,0xc8,0xcc,0x0,0x40,0x0,0x40,0xd0,0xd0,0x50,0xcc,0x0,0x40,0x0,0xd8,0xd0,0xd0,0xd8,0x5a,0x0,0xde,0x0,0xd8,0x60,0xd0,0x60,0xe0,0x0,0x60,0x68,0xd8,0x60,0x6a,0xe8,0xe0,0x0,0x60,0x68,0x70,0xf0,0xec,0xe8,0xe0,0x0,0x60,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x80,0x0,0x80,0x0,0x0,0x0,0x0,0x0,0x80,0x88,0x80,0x0,0x0,0x0,0x10,0x90,0x80,0x10,0x80,0x0,0x0,0x0,0x10,0x90,0x1a,0x98,0x1c,0x0,0x9e,0x0,0x10,0x20,0x9c,0x20,0x1c,0x0,0x20,0x0,0xa8,0x20,0x28,0xa8,0xaa,0x0,0x20,0x0,0xa8,0xb0,0x28,0x30,0x30,0x0,0x20,0x0,0xa8,0xb0,0x28,0xb8,0x30,0x0,0xbe,0x0,0x40,0x40,0xc2,0x40,0x30,0x0,0x40,0x0,0x40,0x40,0x44,0xc8,0xcc,0x0,0x40,0x0,0x40,0xd0,0xd0,0x50,0xcc,0x0,0x40,0x0,0xd8,0xd0,0xd0,0xd8,0x5a,0x0,0xde,0x0,0xd8,0x60,0xd0,0x60,0xe0,0x0,0x60,0x68,0xd8,0x60,0x6a,0xe8,0xe0,0x0,0x60,0x68,0x70,0xf0,0xec,0xe8,0xe0,0x0,0x60,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0}
+	
\ No newline at end of file
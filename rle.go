@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// EncodeRLE encodes mostly zero data (e.g. pruned weights) as runs of zeros
+// followed by literal non zero values and appends it to dst. The layout is
+//
+//	uvarint(n) {uvarint(zeros) uvarint(literals) literal-bytes}...
+//
+// Each pair of zeros and literals takes 2 bytes of overhead, so encoding
+// is smaller than data if the density of non zero values is below ~1/3.
+func EncodeRLE(dst []byte, data []Float8) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(data)))
+
+	for at := 0; at < len(data); {
+		zeros := at
+		for zeros < len(data) && data[zeros] == 0 {
+			zeros++
+		}
+
+		// literal run ends at next pair of zeros, single zeros are cheaper
+		// to keep as literals than to start new run
+		end := zeros
+		for end < len(data) && (data[end] != 0 || end+1 < len(data) && data[end+1] != 0) {
+			end++
+		}
+
+		dst = binary.AppendUvarint(dst, uint64(zeros-at))
+		dst = binary.AppendUvarint(dst, uint64(end-zeros))
+		dst = append(dst, data[zeros:end]...)
+		at = end
+	}
+
+	return dst
+}
+
+// DecodeRLE decodes data encoded by EncodeRLE and appends it to dst,
+// it returns number of consumed bytes. Data longer than limit elements is
+// rejected before any allocation, the header is not trusted.
+func DecodeRLE(dst []Float8, buf []byte, limit int) ([]Float8, int, error) {
+	n, at := binary.Uvarint(buf)
+	if at <= 0 {
+		return dst, 0, errors.New("float8: invalid rle header")
+	}
+	if limit < 0 || n > uint64(limit) {
+		return dst, 0, errors.New("float8: rle data exceeds limit")
+	}
+
+	for left := n; left > 0; {
+		zeros, size := binary.Uvarint(buf[at:])
+		if size <= 0 || zeros > left {
+			return dst, 0, errors.New("float8: invalid rle run")
+		}
+		at += size
+		left -= zeros
+
+		literals, size := binary.Uvarint(buf[at:])
+		if size <= 0 || literals > left || literals > uint64(len(buf)-at-size) {
+			return dst, 0, errors.New("float8: invalid rle run")
+		}
+		at += size
+		left -= literals
+
+		if zeros == 0 && literals == 0 {
+			return dst, 0, errors.New("float8: empty rle run")
+		}
+
+		dst = append(dst, make([]Float8, zeros)...)
+		dst = append(dst, buf[at:at+int(literals)]...)
+		at += int(literals)
+	}
+
+	return dst, at, nil
+}
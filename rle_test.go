@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand/v2"
+	"testing"
+)
+
+// data with given density of non zero values
+func prunedData(r *rand.Rand, n int, density float64) []Float8 {
+	data := make([]Float8, n)
+	for i := range data {
+		if r.Float64() < density {
+			data[i] = Float8(1 + r.IntN(0xff))
+		}
+	}
+	return data
+}
+
+func TestRLE(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, data := range [][]Float8{
+		nil,
+		{0, 0, 0},
+		{1, 2, 3},
+		{0, 1, 0, 2, 0, 0, 3, 0},
+		prunedData(r, 10000, 0.05),
+		prunedData(r, 1000, 0.9),
+	} {
+		buf := EncodeRLE([]byte{0xff}, data)
+		x, n, err := DecodeRLE([]Float8{0x38}, buf[1:], len(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != len(buf)-1 || x[0] != 0x38 || !bytes.Equal(x[1:], data) {
+			t.Errorf("data is not restored %v", data)
+		}
+	}
+}
+
+func TestRLERatio(t *testing.T) {
+	data := prunedData(rand.New(rand.NewPCG(3, 4)), 100000, 0.05)
+
+	if ratio := float64(len(data)) / float64(len(EncodeRLE(nil, data))); ratio < 5 {
+		t.Errorf("compression ratio %.2f", ratio)
+	}
+}
+
+func TestRLEInvalid(t *testing.T) {
+	buf := EncodeRLE(nil, []Float8{0, 0, 1, 2, 0, 0, 0, 3})
+
+	for name, b := range map[string][]byte{
+		"empty":     nil,
+		"truncated": buf[:len(buf)-1],
+		"overflow":  {0x02, 0x03, 0x00},
+		"no-op":     {0x02, 0x00, 0x00},
+		"limit":     buf,
+		"huge":      binary.AppendUvarint(binary.AppendUvarint(nil, 1<<62), 1<<62),
+	} {
+		if _, _, err := DecodeRLE(nil, b, 7); err == nil {
+			t.Errorf("%s buffer is accepted", name)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// Rounding mode of conversions
+type Rounding int
+
+const (
+	// RoundTowardZero truncates extra mantissa bits, it is rounding of ToFloat8
+	RoundTowardZero Rounding = iota
+
+	// RoundNearestEven rounds to the nearest representable value,
+	// ties are rounded to value with even bit pattern
+	RoundNearestEven
+)
+
+func (r Rounding) String() string {
+	switch r {
+	case RoundTowardZero:
+		return "toward zero"
+	case RoundNearestEven:
+		return "nearest even"
+	default:
+		return "unknown"
+	}
+}
+
+// ToFloat8 converts float32 to float8 using rounding mode
+func (r Rounding) ToFloat8(f32 float32) Float8 {
+	f8 := ToFloat8(f32)
+	if r == RoundTowardZero || math.IsNaN(float64(f32)) {
+		return f8
+	}
+
+	var next Float8
+	switch x := f8tof32[f8]; {
+	case f32 > x:
+		next = nextUp(f8)
+	case f32 < x:
+		next = nextDown(f8)
+	default:
+		return f8
+	}
+
+	d0 := math.Abs(float64(f32) - float64(f8tof32[f8]))
+	d1 := math.Abs(float64(f8tof32[next]) - float64(f32))
+	if d1 < d0 || d1 == d0 && next&1 == 0 {
+		return next
+	}
+	return f8
+}
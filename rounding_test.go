@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestRounding(t *testing.T) {
+	for _, tt := range []struct {
+		f32  float32
+		r    Rounding
+		want Float8
+	}{
+		{1.1, RoundTowardZero, ToFloat8(1)},
+		{1.1, RoundNearestEven, ToFloat8(1.125)},
+		{1.0625, RoundNearestEven, ToFloat8(1)},    // tie, 0x38 is even
+		{1.1875, RoundNearestEven, ToFloat8(1.25)}, // tie, 0x3a is even
+		{-1.1, RoundNearestEven, ToFloat8(-1.125)},
+		{0.001, RoundNearestEven, 0x00},
+		{0.0087, RoundNearestEven, 0x01},
+		{460, RoundNearestEven, MaxValue},
+		{600, RoundNearestEven, Infinity},
+		{-1000, RoundNearestEven, signMask | Infinity},
+		{-1000, RoundTowardZero, signMask | Infinity},
+	} {
+		if v := tt.r.ToFloat8(tt.f32); v != tt.want {
+			t.Errorf("%s(%g) got=%#x want=%#x", tt.r, tt.f32, v, tt.want)
+		}
+	}
+
+	// nearest value is never further than truncated one
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 10000 {
+		x := float32(r.NormFloat64() * 10)
+		n, z := RoundNearestEven.ToFloat8(x), RoundTowardZero.ToFloat8(x)
+		if math.Abs(float64(ToFloat32(n)-x)) > math.Abs(float64(ToFloat32(z)-x)) {
+			t.Errorf("%g rounded to %g, truncated to %g", x, ToFloat32(n), ToFloat32(z))
+		}
+	}
+}
@@ -0,0 +1,201 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package safetensors reads and writes FP8 tensors in safetensors format
+// (https://github.com/huggingface/safetensors).
+//
+// safetensors dtypes F8_E4M3 (E4M3FN: subnormals, no infinity, 0x7f is NaN)
+// and F8_E5M2 are not bit compatible with float8, tensors are decoded
+// and rounded to nearest even float8 on read (as float8.ReadNpy does). Tensors are written as F8_E4M3, values
+// below 2^-6 are rounded to E4M3FN subnormals and 480 saturates to 448.
+package safetensors
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/kshard/float8"
+	"github.com/kshard/float8/internal/ocp"
+)
+
+// Supported dtypes
+const (
+	F8E4M3 = "F8_E4M3"
+	F8E5M2 = "F8_E5M2"
+)
+
+// limit of header size, protects against malformed files
+const maxHeaderSize = 100 << 20
+
+// Tensor of float8 values in row-major order
+type Tensor struct {
+	Shape []int
+	Data  []float8.Float8
+}
+
+type entry struct {
+	DType       string `json:"dtype"`
+	Shape       []int  `json:"shape"`
+	DataOffsets [2]int `json:"data_offsets"`
+}
+
+// Read FP8 tensors and metadata from safetensors file.
+// Tensors of other dtypes (e.g. F32 scales) are skipped.
+func Read(r io.Reader) (map[string]Tensor, map[string]string, error) {
+	var size uint64
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return nil, nil, err
+	}
+	if size > maxHeaderSize {
+		return nil, nil, fmt.Errorf("safetensors: header of %d bytes is too large", size)
+	}
+
+	header := make([]byte, size)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(header, &raw); err != nil {
+		return nil, nil, fmt.Errorf("safetensors: invalid header: %w", err)
+	}
+
+	var metadata map[string]string
+	entries := map[string]entry{}
+	end := 0
+	for name, msg := range raw {
+		if name == "__metadata__" {
+			if err := json.Unmarshal(msg, &metadata); err != nil {
+				return nil, nil, fmt.Errorf("safetensors: invalid metadata: %w", err)
+			}
+			continue
+		}
+
+		var e entry
+		if err := json.Unmarshal(msg, &e); err != nil {
+			return nil, nil, fmt.Errorf("safetensors: invalid tensor %s: %w", name, err)
+		}
+		if e.DataOffsets[0] < 0 || e.DataOffsets[1] < e.DataOffsets[0] {
+			return nil, nil, fmt.Errorf("safetensors: invalid offsets of tensor %s", name)
+		}
+		if e.DataOffsets[1] > end {
+			end = e.DataOffsets[1]
+		}
+		entries[name] = e
+	}
+
+	// offsets are not trusted, data is read incrementally so that
+	// allocation is bounded by actual size of the file
+	buf, err := io.ReadAll(io.LimitReader(r, int64(end)))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(buf) != end {
+		return nil, nil, fmt.Errorf("safetensors: data of %d bytes is truncated, expected %d", len(buf), end)
+	}
+
+	tensors := map[string]Tensor{}
+	for name, e := range entries {
+		var decode func(uint8) float32
+		switch e.DType {
+		case F8E4M3:
+			decode = ocp.DecodeE4M3FN
+		case F8E5M2:
+			decode = ocp.DecodeE5M2
+		default:
+			continue
+		}
+
+		n, ok := shapeSize(e.Shape, e.DataOffsets[1]-e.DataOffsets[0])
+		if !ok || e.DataOffsets[1]-e.DataOffsets[0] != n {
+			return nil, nil, fmt.Errorf("safetensors: tensor %s of shape %v has %d bytes", name, e.Shape, e.DataOffsets[1]-e.DataOffsets[0])
+		}
+
+		data := make([]float8.Float8, n)
+		for i, x := range buf[e.DataOffsets[0]:e.DataOffsets[1]] {
+			data[i] = float8.RoundNearestEven.ToFloat8(decode(x))
+		}
+
+		tensors[name] = Tensor{Shape: e.Shape, Data: data}
+	}
+
+	return tensors, metadata, nil
+}
+
+// Write tensors and metadata to safetensors file, tensors are encoded as F8_E4M3.
+func Write(w io.Writer, tensors map[string]Tensor, metadata map[string]string) error {
+	names := make([]string, 0, len(tensors))
+	for name := range tensors {
+		if name == "__metadata__" {
+			return errors.New("safetensors: __metadata__ is reserved name")
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	header := map[string]any{}
+	if len(metadata) != 0 {
+		header["__metadata__"] = metadata
+	}
+
+	offset := 0
+	for _, name := range names {
+		t := tensors[name]
+		n, ok := shapeSize(t.Shape, len(t.Data))
+		if !ok || n != len(t.Data) {
+			return fmt.Errorf("safetensors: tensor %s of shape %v has %d elements", name, t.Shape, len(t.Data))
+		}
+
+		header[name] = entry{DType: F8E4M3, Shape: t.Shape, DataOffsets: [2]int{offset, offset + n}}
+		offset += n
+	}
+
+	b, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	// header is padded by spaces to align the byte buffer
+	for len(b)%8 != 0 {
+		b = append(b, ' ')
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, uint64(len(b))); err != nil {
+		return err
+	}
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 0, offset)
+	for _, name := range names {
+		for _, x := range tensors[name].Data {
+			buf = append(buf, ocp.EncodeE4M3FN(float8.ToFloat32(x)))
+		}
+	}
+
+	_, err = w.Write(buf)
+	return err
+}
+
+// number of elements of shape, it is false if shape has negative dimension
+// or the number exceeds limit
+func shapeSize(shape []int, limit int) (int, bool) {
+	n := 1
+	for _, x := range shape {
+		if x < 0 || x != 0 && n > limit/x {
+			return 0, false
+		}
+		n *= x
+	}
+	return n, true
+}
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package safetensors
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+func TestReadWrite(t *testing.T) {
+	tensors := map[string]Tensor{
+		"a": {Shape: []int{2, 2}, Data: []float8.Float8{0x00, 0x38, 0xb8, 0x46}},
+		"b": {Shape: []int{3}, Data: []float8.Float8{0x10, 0x50, 0xd0}},
+	}
+	metadata := map[string]string{"format": "pt"}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, tensors, metadata); err != nil {
+		t.Fatal(err)
+	}
+
+	if binary.LittleEndian.Uint64(buf.Bytes())%8 != 0 {
+		t.Errorf("header is not aligned")
+	}
+
+	seq, meta, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(seq, tensors) || !reflect.DeepEqual(meta, metadata) {
+		t.Errorf("got=%v %v expected=%v %v", seq, meta, tensors, metadata)
+	}
+}
+
+func TestReadTruncated(t *testing.T) {
+	// offsets beyond the file must not be allocated
+	header := `{"x":{"dtype":"F8_E4M3","shape":[1099511627776],"data_offsets":[0,1099511627776]}}`
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, uint64(len(header)))
+	buf.WriteString(header)
+	buf.Write([]byte{0x38, 0x38})
+
+	if _, _, err := Read(&buf); err == nil {
+		t.Errorf("truncated file is accepted")
+	}
+}
+
+func TestReadE5M2(t *testing.T) {
+	header := `{"x":{"dtype":"F8_E5M2","shape":[2],"data_offsets":[0,2]},"s":{"dtype":"F32","shape":[1],"data_offsets":[2,6]}}`
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, uint64(len(header)))
+	buf.WriteString(header)
+	buf.Write([]byte{0x3c, 0xc0, 0, 0, 0x80, 0x3f})
+
+	seq, _, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seq) != 1 || !bytes.Equal(seq["x"].Data, []float8.Float8{0x38, 0xc0}) {
+		t.Errorf("unexpected tensors %v", seq)
+	}
+}
+
+func TestReadNegativeShape(t *testing.T) {
+	header := `{"x":{"dtype":"F8_E4M3","shape":[-2,-2],"data_offsets":[0,4]}}`
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, uint64(len(header)))
+	buf.WriteString(header)
+	buf.Write([]byte{0x38, 0x38, 0x38, 0x38})
+
+	if _, _, err := Read(&buf); err == nil {
+		t.Errorf("negative shape is accepted")
+	}
+
+	tensors := map[string]Tensor{"x": {Shape: []int{-1, -1}, Data: []float8.Float8{0x38}}}
+	if err := Write(&bytes.Buffer{}, tensors, nil); err == nil {
+		t.Errorf("negative shape is accepted")
+	}
+}
+
+func TestReadRounding(t *testing.T) {
+	// E4M3FN subnormal 0x03 is 3×2^-9, the nearest float8 is 0x01 (2^-7 × 1.125),
+	// truncation would flush it to zero
+	header := `{"x":{"dtype":"F8_E4M3","shape":[2],"data_offsets":[0,2]}}`
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, uint64(len(header)))
+	buf.WriteString(header)
+	buf.Write([]byte{0x03, 0x38})
+
+	seq, _, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(seq["x"].Data, []float8.Float8{0x01, 0x38}) {
+		t.Errorf("unexpected tensors %v", seq)
+	}
+}
@@ -0,0 +1,9 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for translating float8 to float32
+//
+
+var satdiv = [0x10000]uint8{0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x7e,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x7e,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x7e,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x7e,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xfe,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x81,0x80,0x0,0x0,0x0,0x0,0x0,0x0,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x82,0x80,0x80,0x0,0x0,0x0,0x0,0x0,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0x2,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x83,0x81,0x80,0x80,0x0,0x0,0x0,0x0,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0x3,0x1,0x0,0x0,0x0,0x0,0x0,0x0,0xfe,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x84,0x82,0x81,0x80,0x80,0x0,0x0,0x0,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0x4,0x2,0x1,0x0,0x0,0x0,0x0,0x0,0xfe,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x85,0x83,0x82,0x81,0x80,0x80,0x0,0x0,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0x5,0x3,0x2,0x1,0x0,0x0,0x0,0x0,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x86,0x84,0x83,0x82,0x81,0x80,0x80,0x0,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0x6,0x4,0x3,0x2,0x1,0x0,0x0,0x0,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x87,0x85,0x84,0x82,0x82,0x81,0x80,0x80,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0x7,0x5,0x4,0x2,0x2,0x1,0x0,0x0,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x88,0x86,0x84,0x83,0x82,0x81,0x81,0x80,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0x8,0x6,0x4,0x3,0x2,0x1,0x1,0x0,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x86,0x85,0x84,0x83,0x82,0x81,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0x9,0x8,0x6,0x5,0x4,0x3,0x2,0x1,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x8a,0x88,0x88,0x86,0x85,0x84,0x83,0x82,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xa,0x8,0x8,0x6,0x5,0x4,0x3,0x2,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8b,0x89,0x88,0x88,0x86,0x85,0x84,0x83,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xb,0x9,0x8,0x8,0x6,0x5,0x4,0x3,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8c,0x8a,0x89,0x88,0x88,0x86,0x85,0x84,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xc,0xa,0x9,0x8,0x8,0x6,0x5,0x4,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8d,0x8b,0x8a,0x89,0x88,0x88,0x86,0x85,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xd,0xb,0xa,0x9,0x8,0x8,0x6,0x5,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x8e,0x8c,0x8b,0x8a,0x89,0x88,0x88,0x86,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xe,0xc,0xb,0xa,0x9,0x8,0x8,0x6,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x8f,0x8d,0x8c,0x8a,0x8a,0x89,0x88,0x88,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xf,0xd,0xc,0xa,0xa,0x9,0x8,0x8,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x90,0x8e,0x8c,0x8b,0x8a,0x89,0x89,0x88,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0x10,0xe,0xc,0xb,0xa,0x9,0x9,0x8,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x91,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x89,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0x11,0x10,0xe,0xd,0xc,0xb,0xa,0x9,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x92,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x8a,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0x12,0x10,0x10,0xe,0xd,0xc,0xb,0xa,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x93,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x8b,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0x13,0x11,0x10,0x10,0xe,0xd,0xc,0xb,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x94,0x92,0x91,0x90,0x90,0x8e,0x8d,0x8c,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0x14,0x12,0x11,0x10,0x10,0xe,0xd,0xc,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x95,0x93,0x92,0x91,0x90,0x90,0x8e,0x8d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0x15,0x13,0x12,0x11,0x10,0x10,0xe,0xd,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x96,0x94,0x93,0x92,0x91,0x90,0x90,0x8e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0x16,0x14,0x13,0x12,0x11,0x10,0x10,0xe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x97,0x95,0x94,0x92,0x92,0x91,0x90,0x90,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0x17,0x15,0x14,0x12,0x12,0x11,0x10,0x10,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x98,0x96,0x94,0x93,0x92,0x91,0x91,0x90,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x18,0x16,0x14,0x13,0x12,0x11,0x11,0x10,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x96,0x95,0x94,0x93,0x92,0x91,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0x19,0x18,0x16,0x15,0x14,0x13,0x12,0x11,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x9a,0x98,0x98,0x96,0x95,0x94,0x93,0x92,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x1a,0x18,0x18,0x16,0x15,0x14,0x13,0x12,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9b,0x99,0x98,0x98,0x96,0x95,0x94,0x93,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1b,0x19,0x18,0x18,0x16,0x15,0x14,0x13,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9c,0x9a,0x99,0x98,0x98,0x96,0x95,0x94,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1c,0x1a,0x19,0x18,0x18,0x16,0x15,0x14,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9d,0x9b,0x9a,0x99,0x98,0x98,0x96,0x95,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1d,0x1b,0x1a,0x19,0x18,0x18,0x16,0x15,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9e,0x9c,0x9b,0x9a,0x99,0x98,0x98,0x96,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0x1e,0x1c,0x1b,0x1a,0x19,0x18,0x18,0x16,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x9f,0x9d,0x9c,0x9a,0x9a,0x99,0x98,0x98,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0x1f,0x1d,0x1c,0x1a,0x1a,0x19,0x18,0x18,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0xa0,0x9e,0x9c,0x9b,0x9a,0x99,0x99,0x98,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0x20,0x1e,0x1c,0x1b,0x1a,0x19,0x19,0x18,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x99,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0x21,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0x19,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa2,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x9a,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0x22,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0x1a,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa3,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x9b,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0x23,0x21,0x20,0x20,0x1e,0x1d,0x1c,0x1b,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa4,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x9c,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0x24,0x22,0x21,0x20,0x20,0x1e,0x1d,0x1c,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa5,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x9d,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0x25,0x23,0x22,0x21,0x20,0x20,0x1e,0x1d,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa6,0xa4,0xa3,0xa2,0xa1,0xa0,0xa0,0x9e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0x26,0x24,0x23,0x22,0x21,0x20,0x20,0x1e,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0xa7,0xa5,0xa4,0xa2,0xa2,0xa1,0xa0,0xa0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0x27,0x25,0x24,0x22,0x22,0x21,0x20,0x20,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa8,0xa6,0xa4,0xa3,0xa2,0xa1,0xa1,0xa0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x28,0x26,0x24,0x23,0x22,0x21,0x21,0x20,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0xa1,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0x29,0x28,0x26,0x25,0x24,0x23,0x22,0x21,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0xaa,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0xa2,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x2a,0x28,0x28,0x26,0x25,0x24,0x23,0x22,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0xab,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0xa3,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2b,0x29,0x28,0x28,0x26,0x25,0x24,0x23,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xac,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0xa4,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2c,0x2a,0x29,0x28,0x28,0x26,0x25,0x24,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xad,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0xa5,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2d,0x2b,0x2a,0x29,0x28,0x28,0x26,0x25,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xae,0xac,0xab,0xaa,0xa9,0xa8,0xa8,0xa6,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0x2e,0x2c,0x2b,0x2a,0x29,0x28,0x28,0x26,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0xaf,0xad,0xac,0xaa,0xaa,0xa9,0xa8,0xa8,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0x2f,0x2d,0x2c,0x2a,0x2a,0x29,0x28,0x28,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xb0,0xae,0xac,0xab,0xaa,0xa9,0xa9,0xa8,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0x30,0x2e,0x2c,0x2b,0x2a,0x29,0x29,0x28,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0xae,0xad,0xac,0xab,0xaa,0xa9,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0x31,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0x29,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb2,0xb0,0xb0,0xae,0xad,0xac,0xab,0xaa,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0x32,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0x2a,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb3,0xb1,0xb0,0xb0,0xae,0xad,0xac,0xab,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0x33,0x31,0x30,0x30,0x2e,0x2d,0x2c,0x2b,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb4,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0xac,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0x34,0x32,0x31,0x30,0x30,0x2e,0x2d,0x2c,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb5,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0xad,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0x35,0x33,0x32,0x31,0x30,0x30,0x2e,0x2d,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb6,0xb4,0xb3,0xb2,0xb1,0xb0,0xb0,0xae,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0x36,0x34,0x33,0x32,0x31,0x30,0x30,0x2e,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0xb7,0xb5,0xb4,0xb2,0xb2,0xb1,0xb0,0xb0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38,0x37,0x35,0x34,0x32,0x32,0x31,0x30,0x30,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf8,0xf6,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xf0,0xee,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe8,0xe6,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xe0,0xde,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd8,0xd6,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xd0,0xce,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc8,0xc6,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xc0,0xbe,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb8,0xb6,0xb4,0xb3,0xb2,0xb1,0xb1,0xb0,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x78,0x76,0x74,0x73,0x72,0x71,0x71,0x70,0x70,0x6e,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x68,0x66,0x64,0x63,0x62,0x61,0x61,0x60,0x60,0x5e,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x58,0x56,0x54,0x53,0x52,0x51,0x51,0x50,0x50,0x4e,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x48,0x46,0x44,0x43,0x42,0x41,0x41,0x40,0x40,0x3e,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x38,0x36,0x34,0x33,0x32,0x31,0x31,0x30,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xf9,0xf9,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf1,0xf1,0xf0,0xee,0xed,0xec,0xeb,0xea,0xe9,0xe9,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe1,0xe1,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xd9,0xd9,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd1,0xd1,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xc9,0xc9,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc1,0xc1,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xb9,0xb9,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0xb1,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x79,0x79,0x78,0x76,0x75,0x74,0x73,0x72,0x71,0x71,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x69,0x69,0x68,0x66,0x65,0x64,0x63,0x62,0x61,0x61,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x59,0x59,0x58,0x56,0x55,0x54,0x53,0x52,0x51,0x51,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x49,0x49,0x48,0x46,0x45,0x44,0x43,0x42,0x41,0x41,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x39,0x39,0x38,0x36,0x35,0x34,0x33,0x32,0x31,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfa,0xfa,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf2,0xf2,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xea,0xea,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe2,0xe2,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xda,0xda,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd2,0xd2,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xca,0xca,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc2,0xc2,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xba,0xba,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0xb2,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7a,0x7a,0x78,0x78,0x76,0x75,0x74,0x73,0x72,0x72,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6a,0x6a,0x68,0x68,0x66,0x65,0x64,0x63,0x62,0x62,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5a,0x5a,0x58,0x58,0x56,0x55,0x54,0x53,0x52,0x52,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4a,0x4a,0x48,0x48,0x46,0x45,0x44,0x43,0x42,0x42,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3a,0x3a,0x38,0x38,0x36,0x35,0x34,0x33,0x32,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfb,0xfb,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf3,0xf3,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xeb,0xeb,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe3,0xe3,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdb,0xdb,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd3,0xd3,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcb,0xcb,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc3,0xc3,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbb,0xbb,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0xb3,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7b,0x7b,0x79,0x78,0x78,0x76,0x75,0x74,0x73,0x73,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6b,0x6b,0x69,0x68,0x68,0x66,0x65,0x64,0x63,0x63,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5b,0x5b,0x59,0x58,0x58,0x56,0x55,0x54,0x53,0x53,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4b,0x4b,0x49,0x48,0x48,0x46,0x45,0x44,0x43,0x43,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3b,0x3b,0x39,0x38,0x38,0x36,0x35,0x34,0x33,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfc,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf4,0xf4,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xec,0xec,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe4,0xe4,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdc,0xdc,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd4,0xd4,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcc,0xcc,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc4,0xc4,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbc,0xbc,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0xb4,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7c,0x7a,0x79,0x78,0x78,0x76,0x75,0x74,0x74,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6c,0x6c,0x6a,0x69,0x68,0x68,0x66,0x65,0x64,0x64,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5c,0x5c,0x5a,0x59,0x58,0x58,0x56,0x55,0x54,0x54,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4c,0x4c,0x4a,0x49,0x48,0x48,0x46,0x45,0x44,0x44,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3c,0x3c,0x3a,0x39,0x38,0x38,0x36,0x35,0x34,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfd,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf5,0xf5,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xed,0xed,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe5,0xe5,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xdd,0xdd,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd5,0xd5,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xcd,0xcd,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc5,0xc5,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbd,0xbd,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0xb5,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7d,0x7b,0x7a,0x79,0x78,0x78,0x76,0x75,0x75,0x73,0x72,0x71,0x70,0x70,0x6e,0x6d,0x6d,0x6b,0x6a,0x69,0x68,0x68,0x66,0x65,0x65,0x63,0x62,0x61,0x60,0x60,0x5e,0x5d,0x5d,0x5b,0x5a,0x59,0x58,0x58,0x56,0x55,0x55,0x53,0x52,0x51,0x50,0x50,0x4e,0x4d,0x4d,0x4b,0x4a,0x49,0x48,0x48,0x46,0x45,0x45,0x43,0x42,0x41,0x40,0x40,0x3e,0x3d,0x3d,0x3b,0x3a,0x39,0x38,0x38,0x36,0x35,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfc,0xfb,0xfa,0xf9,0xf8,0xf8,0xf6,0xf6,0xf4,0xf3,0xf2,0xf1,0xf0,0xf0,0xee,0xee,0xec,0xeb,0xea,0xe9,0xe8,0xe8,0xe6,0xe6,0xe4,0xe3,0xe2,0xe1,0xe0,0xe0,0xde,0xde,0xdc,0xdb,0xda,0xd9,0xd8,0xd8,0xd6,0xd6,0xd4,0xd3,0xd2,0xd1,0xd0,0xd0,0xce,0xce,0xcc,0xcb,0xca,0xc9,0xc8,0xc8,0xc6,0xc6,0xc4,0xc3,0xc2,0xc1,0xc0,0xc0,0xbe,0xbe,0xbc,0xbb,0xba,0xb9,0xb8,0xb8,0xb6,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7c,0x7b,0x7a,0x79,0x78,0x78,0x76,0x76,0x74,0x73,0x72,0x71,0x70,0x70,0x6e,0x6e,0x6c,0x6b,0x6a,0x69,0x68,0x68,0x66,0x66,0x64,0x63,0x62,0x61,0x60,0x60,0x5e,0x5e,0x5c,0x5b,0x5a,0x59,0x58,0x58,0x56,0x56,0x54,0x53,0x52,0x51,0x50,0x50,0x4e,0x4e,0x4c,0x4b,0x4a,0x49,0x48,0x48,0x46,0x46,0x44,0x43,0x42,0x41,0x40,0x40,0x3e,0x3e,0x3c,0x3b,0x3a,0x39,0x38,0x38,0x36,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfe,0xfd,0xfc,0xfa,0xfa,0xf9,0xf8,0xf8,0xf7,0xf5,0xf4,0xf2,0xf2,0xf1,0xf0,0xf0,0xef,0xed,0xec,0xea,0xea,0xe9,0xe8,0xe8,0xe7,0xe5,0xe4,0xe2,0xe2,0xe1,0xe0,0xe0,0xdf,0xdd,0xdc,0xda,0xda,0xd9,0xd8,0xd8,0xd7,0xd5,0xd4,0xd2,0xd2,0xd1,0xd0,0xd0,0xcf,0xcd,0xcc,0xca,0xca,0xc9,0xc8,0xc8,0xc7,0xc5,0xc4,0xc2,0xc2,0xc1,0xc0,0xc0,0xbf,0xbd,0xbc,0xba,0xba,0xb9,0xb8,0xb8,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7e,0x7d,0x7c,0x7a,0x7a,0x79,0x78,0x78,0x77,0x75,0x74,0x72,0x72,0x71,0x70,0x70,0x6f,0x6d,0x6c,0x6a,0x6a,0x69,0x68,0x68,0x67,0x65,0x64,0x62,0x62,0x61,0x60,0x60,0x5f,0x5d,0x5c,0x5a,0x5a,0x59,0x58,0x58,0x57,0x55,0x54,0x52,0x52,0x51,0x50,0x50,0x4f,0x4d,0x4c,0x4a,0x4a,0x49,0x48,0x48,0x47,0x45,0x44,0x42,0x42,0x41,0x40,0x40,0x3f,0x3d,0x3c,0x3a,0x3a,0x39,0x38,0x38}
+	
\ No newline at end of file
@@ -0,0 +1,11 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for sin of float8
+//
+
+var sin = [0x100]uint8{0x0,0x0,0x1,0x2,0x3,0x4,0x5,0x6,0x7,0x8,0x9,0xa,0xb,0xc,0xd,0xe,0xf,0x10,0x11,0x12,0x13,0x14,0x15,0x16,0x17,0x18,0x19,0x1a,0x1b,0x1c,0x1d,0x1e,0x1f,0x20,0x21,0x22,0x23,0x24,0x25,0x26,0x27,0x28,0x29,0x2a,0x2b,0x2c,0x2d,0x2e,0x2f,0x30,0x31,0x32,0x32,0x33,0x34,0x34,0x35,0x36,0x37,0x37,0x37,0x37,0x37,0x37,0x36,0x34,0x31,0x2c,0x21,0x9d,0xab,0xb1,0xb4,0xb7,0xb7,0xb3,0xa8,0x25,0x32,0x37,0x37,0x2d,0xb0,0xb7,0xb0,0x2d,0x37,0x32,0xa9,0xb4,0x36,0x81,0xb6,0x34,0x28,0xb7,0x30,0xb7,0x33,0x9,0xb4,0x37,0xb0,0xa9,0x36,0x28,0xb7,0x11,0x37,0xaa,0xb6,0x31,0x33,0xaf,0x26,0x19,0xab,0x31,0xb4,0x37,0xb7,0xb5,0xad,0x21,0x32,0x37,0x37,0x31,0x0,0x80,0x81,0x82,0x83,0x84,0x85,0x86,0x87,0x88,0x89,0x8a,0x8b,0x8c,0x8d,0x8e,0x8f,0x90,0x91,0x92,0x93,0x94,0x95,0x96,0x97,0x98,0x99,0x9a,0x9b,0x9c,0x9d,0x9e,0x9f,0xa0,0xa1,0xa2,0xa3,0xa4,0xa5,0xa6,0xa7,0xa8,0xa9,0xaa,0xab,0xac,0xad,0xae,0xaf,0xb0,0xb1,0xb2,0xb2,0xb3,0xb4,0xb4,0xb5,0xb6,0xb7,0xb7,0xb7,0xb7,0xb7,0xb7,0xb6,0xb4,0xb1,0xac,0xa1,0x1d,0x2b,0x31,0x34,0x37,0x37,0x33,0x28,0xa5,0xb2,0xb7,0xb7,0xad,0x30,0x37,0x30,0xad,0xb7,0xb2,0x29,0x34,0xb6,0x1,0x36,0xb4,0xa8,0x37,0xb0,0x37,0xb3,0x89,0x34,0xb7,0x30,0x29,0xb6,0xa8,0x37,0x91,0xb7,0x2a,0x36,0xb1,0xb3,0x2f,0xa6,0x99,0x2b,0xb1,0x34,0xb7,0x37,0x35,0x2d,0xa1,0xb2,0xb7,0xb7,0xb1}
+
+// Sine of float8, argument is in radians
+func Sin(a Float8) Float8 { return sin[a] }
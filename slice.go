@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// Scale computes dst[i] = alpha × src[i] in one pass, values are decoded,
+// scaled in float32 and quantized. dst and src might be the same slice.
+func Scale(dst, src []Float8, alpha float32) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, f8 := range src {
+		dst[i] = ToFloat8(alpha * f8tof32[f8])
+	}
+}
+
+// ScaleShift computes dst[i] = alpha × src[i] + beta in one pass, values are
+// decoded, transformed in float32 and quantized. dst and src might be the same slice.
+func ScaleShift(dst, src []Float8, alpha, beta float32) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, f8 := range src {
+		dst[i] = ToFloat8(alpha*f8tof32[f8] + beta)
+	}
+}
+
+// Clamp limits values to the range [lo, hi], dst and src might be the same slice.
+// Bounds which are not representable are rounded inwards, lo upward and hi
+// downward, so that clamped values never fall outside of the range.
+func Clamp(dst, src []Float8, lo, hi float32) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	flo, fhi := ToFloat8(lo), ToFloat8(hi)
+	if f8tof32[flo] < lo {
+		flo = nextUp(flo)
+	}
+	if f8tof32[fhi] > hi {
+		fhi = nextDown(fhi)
+	}
+	for i, f8 := range src {
+		switch x := f8tof32[f8]; {
+		case x < lo:
+			dst[i] = flo
+		case x > hi:
+			dst[i] = fhi
+		default:
+			dst[i] = f8
+		}
+	}
+}
+
+// Sanitize replaces infinities with the largest finite value of same sign,
+// dst and src might be the same slice. The format has no NaN patterns.
+func Sanitize(dst, src []Float8) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, f8 := range src {
+		if IsInf(f8, 0) {
+			f8--
+		}
+		dst[i] = f8
+	}
+}
+
+// ScaleByPow2Slice computes dst[i] = src[i] × 2^k, see ScaleByPow2.
+// dst and src might be the same slice.
+func ScaleByPow2Slice(dst, src []Float8, k int) {
+	if len(dst) != len(src) {
+		panic("slices must have same length")
+	}
+
+	for i, f8 := range src {
+		dst[i] = ScaleByPow2(f8, k)
+	}
+}
+
+// Lerp interpolates linearly between a and b, a + t × (b - a), computed
+// in float32 and quantized. t = 0 gives exactly a and t = 1 gives exactly b.
+func Lerp(a, b Float8, t float32) Float8 {
+	switch t {
+	case 0:
+		return a
+	case 1:
+		return b
+	}
+
+	x, y := f8tof32[a], f8tof32[b]
+	return ToFloat8(x + t*(y-x))
+}
+
+// LerpSlice computes dst[i] = Lerp(a[i], b[i], t), dst might be the same
+// slice as a or b.
+func LerpSlice(dst, a, b []Float8, t float32) {
+	if len(dst) != len(a) || len(a) != len(b) {
+		panic("slices must have same length")
+	}
+
+	for i := range dst {
+		dst[i] = Lerp(a[i], b[i], t)
+	}
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestScale(t *testing.T) {
+	src := []Float8{0x00, 0x38, 0xb8, 0x40} // 0, 1, -1, 2
+	dst := make([]Float8, len(src))
+
+	Scale(dst, src, 2)
+	if expected := []Float8{0x00, 0x40, 0xc0, 0x48}; !bytes.Equal(dst, expected) {
+		t.Errorf("got=%v expected=%v", dst, expected)
+	}
+
+	// in-place
+	Scale(src, src, 0.5)
+	if expected := []Float8{0x00, 0x30, 0xb0, 0x38}; !bytes.Equal(src, expected) {
+		t.Errorf("got=%v expected=%v", src, expected)
+	}
+
+	// overflow keeps sign: ±300 × 4
+	Scale(dst[:2], []Float8{ToFloat8(300), ToFloat8(-300)}, 4)
+	if expected := []Float8{Infinity, signMask | Infinity}; !bytes.Equal(dst[:2], expected) {
+		t.Errorf("got=%v expected=%v", dst[:2], expected)
+	}
+}
+
+func TestScaleShift(t *testing.T) {
+	src := []Float8{0x00, 0x38, 0xb8, 0x40} // 0, 1, -1, 2
+	dst := make([]Float8, len(src))
+
+	ScaleShift(dst, src, 2, 1)
+	if expected := []Float8{0x38, 0x44, 0xb8, 0x4a}; !bytes.Equal(dst, expected) {
+		t.Errorf("got=%v expected=%v", dst, expected)
+	}
+
+	ScaleShift(dst[:2], []Float8{ToFloat8(300), ToFloat8(-300)}, 4, -1)
+	if expected := []Float8{Infinity, signMask | Infinity}; !bytes.Equal(dst[:2], expected) {
+		t.Errorf("got=%v expected=%v", dst[:2], expected)
+	}
+}
+
+func TestScalePanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("panic is expected")
+		}
+	}()
+
+	Scale(make([]Float8, 2), make([]Float8, 3), 1)
+}
+
+func TestClamp(t *testing.T) {
+	src := []Float8{0x00, 0x38, 0xb8, 0x48, 0x7f} // 0, 1, -1, 4, 480
+	dst := make([]Float8, len(src))
+
+	Clamp(dst, src, -0.5, 2)
+	if expected := []Float8{0x00, 0x38, 0xb0, 0x40, 0x40}; !bytes.Equal(dst, expected) {
+		t.Errorf("got=%v expected=%v", dst, expected)
+	}
+
+	// bounds between float8 values are rounded inwards: 0.3 → 0.3125, 3.3 → 3.25
+	Clamp(dst, src, 0.3, 3.3)
+	if expected := []Float8{0x2a, 0x38, 0x2a, 0x45, 0x45}; !bytes.Equal(dst, expected) {
+		t.Errorf("got=%v expected=%v", dst, expected)
+	}
+
+	Clamp(dst, src, -3.3, -0.3)
+	if expected := []Float8{0xaa, 0xaa, 0xb8, 0xaa, 0xaa}; !bytes.Equal(dst, expected) {
+		t.Errorf("got=%v expected=%v", dst, expected)
+	}
+
+	Clamp(dst, src, -1000, 1000)
+	if !bytes.Equal(dst, src) {
+		t.Errorf("got=%v expected=%v", dst, src)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	src := []Float8{0x00, 0x7f, 0xff, 0x7e, 0x38}
+	Sanitize(src, src)
+
+	if expected := []Float8{0x00, 0x7e, 0xfe, 0x7e, 0x38}; !bytes.Equal(src, expected) {
+		t.Errorf("got=%v expected=%v", src, expected)
+	}
+}
+
+func TestScaleByPow2Slice(t *testing.T) {
+	src := []Float8{0x38, 0xb8, 0x00, 0x70}
+	ScaleByPow2Slice(src, src, 2)
+
+	if expected := []Float8{0x48, 0xc8, 0x00, 0x7e}; !bytes.Equal(src, expected) {
+		t.Errorf("got=%v expected=%v", src, expected)
+	}
+}
+
+func TestLerp(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		for b := 0; b < 0x100; b += 5 {
+			if c := Lerp(uint8(a), uint8(b), 0); c != uint8(a) {
+				t.Fatalf("lerp(0x%02x, 0x%02x, 0) = 0x%02x", a, b, c)
+			}
+			if c := Lerp(uint8(a), uint8(b), 1); c != uint8(b) {
+				t.Fatalf("lerp(0x%02x, 0x%02x, 1) = 0x%02x", a, b, c)
+			}
+		}
+	}
+
+	// (1 + 4) / 2 = 2.5 within one ulp
+	if c := Lerp(0x38, 0x48, 0.5); math.Abs(exact(c)-2.5) > float64(Ulp(c)) {
+		t.Errorf("unexpected lerp 0x%02x", c)
+	}
+}
+
+func TestLerpSlice(t *testing.T) {
+	a := []Float8{0x38, 0x00, 0xb8}
+	b := []Float8{0x48, 0x40, 0x38}
+	LerpSlice(a, a, b, 0.5)
+
+	for i, x := range []float64{2.5, 1, 0} {
+		if math.Abs(exact(a[i])-x) > float64(Ulp(a[i])) {
+			t.Errorf("%d: got=%g expected=%g", i, exact(a[i]), x)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// DistanceFunc over raw vectors, the common signature of HNSW libraries
+// that store vectors as byte slices.
+type DistanceFunc func(a, b []byte) float32
+
+// Space is hnswlib-style distance provider over float8 vectors of fixed
+// dimension, each vector is stored as dim bytes.
+type Space struct {
+	dim    int
+	metric Metric
+}
+
+// NewSpace creates distance provider of dimension dim using metric
+// (e.g. L2, CosineMetric, DotMetric or any registered Metric).
+func NewSpace(dim int, metric Metric) *Space {
+	if dim <= 0 {
+		panic("dimension must be positive")
+	}
+
+	return &Space{dim: dim, metric: metric}
+}
+
+// Dim is dimension of vectors
+func (s *Space) Dim() int { return s.dim }
+
+// DataSize is number of bytes per vector
+func (s *Space) DataSize() int { return s.dim }
+
+// Distance between raw vectors, vectors are reinterpreted without copy
+func (s *Space) Distance(a, b []byte) float32 {
+	if len(a) != s.dim || len(b) != s.dim {
+		panic("vectors must have space dimension")
+	}
+
+	return s.metric.Distance(FromBytes(a), FromBytes(b))
+}
+
+// DistanceFunc returns Distance as a function value
+func (s *Space) DistanceFunc() DistanceFunc { return s.Distance }
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "testing"
+
+func TestSpace(t *testing.T) {
+	a := []byte{0x38, 0x40, 0x00} // 1, 2, 0
+	b := []byte{0x38, 0xb8, 0x48} // 1, -1, 4
+
+	s := NewSpace(3, L1)
+	if s.Dim() != 3 || s.DataSize() != 3 {
+		t.Errorf("unexpected dimension %d", s.Dim())
+	}
+
+	if d := s.Distance(a, b); d != 7 {
+		t.Errorf("unexpected distance %f", d)
+	}
+
+	var f DistanceFunc = s.DistanceFunc()
+	if d := f(a, b); d != Manhattan(a, b) {
+		t.Errorf("unexpected distance %f", d)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("panic is expected")
+		}
+	}()
+	s.Distance(a, b[:2])
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+)
+
+// SparseVector keeps non zero elements of vector, Indices are ascending
+// and Values[i] is element at Indices[i].
+type SparseVector struct {
+	Indices []uint32
+	Values  []Float8
+}
+
+// SparseFromMap quantizes elements of sparse vector given as index to value
+// map. Elements which quantize to zero are dropped.
+func SparseFromMap(m map[int]float32) SparseVector {
+	idx := make([]int, 0, len(m))
+	for i, x := range m {
+		if i < 0 || uint64(i) > math.MaxUint32 {
+			panic("index out of range")
+		}
+		if ToFloat8(x) != 0 {
+			idx = append(idx, i)
+		}
+	}
+	slices.Sort(idx)
+
+	v := SparseVector{
+		Indices: make([]uint32, len(idx)),
+		Values:  make([]Float8, len(idx)),
+	}
+	for k, i := range idx {
+		v.Indices[k] = uint32(i)
+		v.Values[k] = ToFloat8(m[i])
+	}
+
+	return v
+}
+
+// Len is number of stored elements
+func (v SparseVector) Len() int { return len(v.Indices) }
+
+// Dense expands vector into dense vector of dimension dim
+func (v SparseVector) Dense(dim int) []Float8 {
+	d := make([]Float8, dim)
+	for k, i := range v.Indices {
+		d[i] = v.Values[k]
+	}
+	return d
+}
+
+// Dot product with dense vector, indices must be less than len(dense)
+func (v SparseVector) Dot(dense []Float8) float32 {
+	d := float32(0)
+	for k, i := range v.Indices {
+		d += f8tof32[v.Values[k]] * f8tof32[dense[i]]
+	}
+	return d
+}
+
+// DotSparse is dot product of sparse vectors, it merges sorted indices
+func (v SparseVector) DotSparse(w SparseVector) float32 {
+	d := float32(0)
+	for i, j := 0, 0; i < len(v.Indices) && j < len(w.Indices); {
+		switch {
+		case v.Indices[i] < w.Indices[j]:
+			i++
+		case v.Indices[i] > w.Indices[j]:
+			j++
+		default:
+			d += f8tof32[v.Values[i]] * f8tof32[w.Values[j]]
+			i++
+			j++
+		}
+	}
+	return d
+}
+
+// MarshalBinary encodes vector as number of elements followed by delta
+// encoded indices and values. Numbers are unsigned varints.
+func (v SparseVector) MarshalBinary() ([]byte, error) {
+	if len(v.Indices) != len(v.Values) {
+		return nil, errors.New("float8: indices and values must have same length")
+	}
+
+	buf := binary.AppendUvarint(make([]byte, 0, 3*len(v.Indices)+binary.MaxVarintLen32), uint64(len(v.Indices)))
+	prev := uint32(0)
+	for k, i := range v.Indices {
+		if k > 0 && i <= prev {
+			return nil, errors.New("float8: indices must be ascending")
+		}
+		buf = binary.AppendUvarint(buf, uint64(i-prev))
+		prev = i
+	}
+
+	return append(buf, v.Values...), nil
+}
+
+// UnmarshalBinary decodes vector encoded by MarshalBinary
+func (v *SparseVector) UnmarshalBinary(buf []byte) error {
+	n, at := binary.Uvarint(buf)
+	if at <= 0 || n > uint64(len(buf)) {
+		return errors.New("float8: invalid sparse vector header")
+	}
+
+	indices := make([]uint32, n)
+	prev := uint64(0)
+	for k := range indices {
+		delta, size := binary.Uvarint(buf[at:])
+		if size <= 0 || (k > 0 && delta == 0) || prev+delta > math.MaxUint32 {
+			return errors.New("float8: invalid sparse vector index")
+		}
+		prev += delta
+		indices[k] = uint32(prev)
+		at += size
+	}
+
+	if len(buf)-at != int(n) {
+		return errors.New("float8: invalid sparse vector length")
+	}
+
+	v.Indices = indices
+	v.Values = append([]Float8(nil), buf[at:]...)
+	return nil
+}
@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestSparseFromMap(t *testing.T) {
+	v := SparseFromMap(map[int]float32{10: 2, 3: -1, 7: 1e-9, 100000: 0.5})
+
+	if !slices.Equal(v.Indices, []uint32{3, 10, 100000}) {
+		t.Errorf("unexpected indices %v", v.Indices)
+	}
+	if !bytes.Equal(v.Values, []Float8{ToFloat8(-1), ToFloat8(2), ToFloat8(0.5)}) {
+		t.Errorf("unexpected values %v", v.Values)
+	}
+	if v.Len() != 3 {
+		t.Errorf("unexpected length %d", v.Len())
+	}
+}
+
+func TestSparseDot(t *testing.T) {
+	a := SparseFromMap(map[int]float32{0: 1, 2: 2, 5: 3})
+	b := SparseFromMap(map[int]float32{1: 4, 2: 0.5, 5: -1, 6: 8})
+
+	dense := b.Dense(8)
+	if d := a.Dot(dense); d != -2 {
+		t.Errorf("dot with dense %g", d)
+	}
+	if d := a.DotSparse(b); d != -2 {
+		t.Errorf("dot with sparse %g", d)
+	}
+	if d, e := a.DotSparse(b), Dot(a.Dense(8), dense); d != e {
+		t.Errorf("sparse %g, dense %g", d, e)
+	}
+}
+
+func TestSparseMarshal(t *testing.T) {
+	v := SparseFromMap(map[int]float32{1: 1, 200: -2, 70000: 3, 1<<31 - 1: 4})
+
+	buf, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var w SparseVector
+	if err := w.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(w.Indices, v.Indices) || !bytes.Equal(w.Values, v.Values) {
+		t.Errorf("got=%v expected=%v", w, v)
+	}
+
+	for _, buf := range [][]byte{nil, {0x02, 0x01}, {0x02, 0x01, 0x00, 0x38, 0x38}, {0x01, 0x01, 0x38, 0x38}} {
+		if err := w.UnmarshalBinary(buf); err == nil {
+			t.Errorf("invalid buffer %v is accepted", buf)
+		}
+	}
+
+	if _, err := (SparseVector{Indices: []uint32{2, 1}, Values: []Float8{1, 1}}).MarshalBinary(); err == nil {
+		t.Errorf("unsorted indices are accepted")
+	}
+}
@@ -0,0 +1,9 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for squared difference of float8(s), values are computed in float64
+// and rounded to nearest float32
+//
+
+var sqdiff = [0x10000]float32{0,7.724762e-05,9.536743e-05,0.00011539459,0.0001373291,0.00016117096,0.00018692017,0.00021457672,0.00024414062,0.00030899048,0.00038146973,0.00046157837,0.0005493164,0.00064468384,0.00074768066,0.0008583069,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0021972656,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4,5.0625,6.25,7.5625,9,10.5625,12.25,14.0625,16,20.25,25,30.25,36,42.25,49,56.25,64,81,100,121,144,169,196,225,256,324,400,484,576,676,784,900,1024,1296,1600,1936,2304,2704,3136,3600,4096,5184,6400,7744,9216,10816,12544,14400,16384,20736,25600,30976,36864,43264,50176,57600,65536,82944,102400,123904,147456,173056,200704,230400,6.1035156e-05,7.724762e-05,9.536743e-05,0.00011539459,0.0001373291,0.00016117096,0.00018692017,0.00021457672,0.00024414062,0.00030899048,0.00038146973,0.00046157837,0.0005493164,0.00064468384,0.00074768066,0.0008583069,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0021972656,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4,5.0625,6.25,7.5625,9,10.5625,12.25,14.0625,16,20.25,25,30.25,36,42.25,49,56.25,64,81,100,121,144,169,196,225,256,324,400,484,576,676,784,900,1024,1296,1600,1936,2304,2704,3136,3600,4096,5184,6400,7744,9216,10816,12544,14400,16384,20736,25600,30976,36864,43264,50176,57600,65536,82944,102400,123904,147456,173056,200704,230400,7.724762e-05,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,2.3841858e-05,3.4332275e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00034427643,0.00042057037,0.0005044937,0.0006952286,0.000916481,0.001168251,0.0014505386,0.0017633438,0.0021066666,0.002480507,0.0028848648,0.0037851334,0.004807472,0.0059518814,0.007218361,0.008606911,0.010117531,0.011750221,0.013504982,0.017380714,0.021744728,0.026597023,0.0319376,0.037766457,0.044083595,0.050889015,0.058182716,0.07423496,0.09224033,0.11219883,0.13411045,0.1579752,0.18379307,0.21156406,0.24128819,0.3065958,0.37971592,0.46064854,0.54939365,0.6459513,0.7503214,0.862504,0.9824991,1.2459269,1.5406046,1.8665323,2.22371,2.6121378,3.0318155,3.4827433,3.964921,5.0230265,6.206132,7.5142374,8.947343,10.505448,12.188554,13.996659,15.929765,20.170975,24.912186,30.153397,35.894608,42.13582,48.87703,56.11824,63.85945,80.84187,99.824295,120.80672,143.78914,168.77156,195.75398,224.7364,255.71883,323.6837,399.64853,483.61337,575.5782,675.543,783.5079,899.4727,1023.43756,1295.3673,1599.297,1935.2267,2303.1562,2703.086,3135.0156,3598.9453,4094.875,5182.7344,6398.5938,7742.453,9214.3125,10814.172,12542.031,14397.891,16381.75,20733.469,25597.188,30972.906,36860.625,43260.344,50172.062,57595.78,65531.5,82938.94,102394.375,123897.81,147449.25,173048.69,200696.12,230391.56,0.00027561188,0.00030899048,0.00034427643,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.0006952286,0.0008020401,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0019311905,0.002289772,0.0026788712,0.0030984879,0.0035486221,0.004029274,0.0045404434,0.0050821304,0.006257057,0.0075540543,0.008973122,0.010514259,0.012177467,0.013962746,0.015870094,0.017899513,0.022324562,0.027237892,0.032639503,0.038529396,0.04490757,0.051774025,0.05912876,0.06697178,0.08412266,0.10322666,0.12428379,0.14729404,0.17225742,0.19917393,0.22804356,0.2588663,0.3263712,0.40168858,0.48481846,0.57576084,0.6745157,0.7810831,0.895463,1.0176554,1.2854776,1.5845499,1.9148722,2.2764444,2.6692667,3.093339,3.5486612,4.0352335,5.102128,6.2940226,7.610917,9.052812,10.619706,12.311601,14.128495,16.070389,20.329178,25.087967,30.346756,36.105545,42.364334,49.123123,56.381912,64.1407,81.15828,100.17586,121.193436,144.21101,169.22859,196.24617,225.26375,256.28134,324.3165,400.35165,484.3868,576.42194,676.4571,784.49225,900.5274,1024.5626,1296.6329,1600.7032,1936.7736,2304.8438,2704.914,3136.9844,3601.0547,4097.125,5185.2656,6401.4062,7745.547,9217.6875,10817.828,12545.969,14402.109,16386.25,20738.531,25602.812,30979.094,36867.375,43267.656,50179.938,57604.22,65540.5,82949.06,102405.625,123910.19,147462.75,173063.31,200711.88,230408.44,9.536743e-05,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,2.3841858e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00030899048,0.00038146973,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0020179749,0.0023841858,0.0027809143,0.003665924,0.004673004,0.0058021545,0.0070533752,0.008426666,0.009922028,0.011539459,0.013278961,0.017124176,0.021457672,0.02627945,0.031589508,0.037387848,0.04367447,0.05044937,0.057712555,0.073703766,0.0916481,0.11154556,0.13339615,0.15719986,0.1829567,0.21066666,0.24032974,0.3055153,0.37851334,0.45932388,0.54794693,0.6443825,0.7486305,0.8606911,0.9805641,1.2437477,1.5381813,1.8638649,2.2207985,2.608982,3.0284157,3.4790993,3.9610329,5.01865,6.2012672,7.5088844,8.941502,10.499119,12.181736,13.989353,15.92197,20.162205,24.90244,30.142673,35.882908,42.123142,48.863377,56.10361,63.843845,80.82431,99.80478,120.78525,143.76572,168.74619,195.72665,224.70712,255.68759,323.64853,399.60947,483.5704,575.5314,675.4923,783.45325,899.4142,1023.3751,1295.297,1599.2189,1935.1407,2303.0625,2702.9844,3134.9062,3598.8281,4094.75,5182.5938,6398.4375,7742.2812,9214.125,10813.969,12541.8125,14397.656,16381.5,20733.188,25596.875,30972.562,36860.25,43259.938,50171.625,57595.312,65531,82938.375,102393.75,123897.125,147448.5,173047.88,200695.25,230390.62,0.00030899048,0.00034427643,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.00074768066,0.0008583069,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0020179749,0.0023841858,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.006412506,0.007724762,0.009159088,0.010715485,0.012393951,0.0141944885,0.016117096,0.018161774,0.02261734,0.027561188,0.032993317,0.038913727,0.04532242,0.05221939,0.059604645,0.06747818,0.084690094,0.10385513,0.1249733,0.14804459,0.173069,0.20004654,0.2289772,0.259861,0.32748795,0.4029274,0.48617935,0.5772438,0.67612076,0.7828102,0.89731216,1.0196266,1.287693,1.5870094,1.9175758,2.2793922,2.6724586,3.096775,3.5523415,4.039158,5.1065407,6.2989235,7.6163063,9.058689,10.626072,12.318455,14.135838,16.07822,20.337986,25.097752,30.357517,36.117283,42.37705,49.136814,56.39658,64.15634,81.17587,100.195404,121.214935,144.23447,169.254,196.27353,225.29306,256.3126,324.35165,400.39072,484.42978,576.4689,676.50793,784.547,900.58606,1024.6251,1296.7032,1600.7814,1936.8595,2304.9375,2705.0156,3137.0938,3601.1719,4097.25,5185.4062,6401.5625,7745.7188,9217.875,10818.031,12546.1875,14402.344,16386.5,20738.812,25603.125,30979.438,36867.75,43268.062,50180.375,57604.688,65541,82949.625,102406.25,123910.875,147463.5,173064.12,200712.75,230409.38,0.00011539459,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,2.3841858e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00034427643,0.00042057037,0.00059604645,0.0008020401,0.0010385513,0.0013055801,0.0016031265,0.0019311905,0.002289772,0.0026788712,0.0035486221,0.0045404434,0.005654335,0.006890297,0.008248329,0.009728432,0.011330605,0.013054848,0.016869545,0.021172523,0.025963783,0.031243324,0.037011147,0.04326725,0.050011635,0.0572443,0.07317448,0.09105778,0.1108942,0.13268375,0.15642643,0.18212223,0.20977116,0.2393732,0.30443668,0.37731266,0.45800114,0.5465021,0.6428156,0.74694157,0.85888004,0.978631,1.2415705,1.5357599,1.8611994,2.2178888,2.6058283,3.0250177,3.4754572,3.9571466,5.0142756,6.1964045,7.5035334,8.935662,10.492791,12.17492,13.982049,15.914178,20.153435,24.892693,30.13195,35.87121,42.110466,48.849724,56.08898,63.82824,80.806755,99.78527,120.76379,143.74231,168.72083,195.69934,224.67786,255.65637,323.6134,399.57043,483.52747,575.4845,675.4415,783.39856,899.3556,1023.3126,1295.2267,1599.1407,1935.0548,2302.9688,2702.8828,3134.7969,3598.711,4094.625,5182.453,6398.2812,7742.1094,9213.9375,10813.766,12541.594,14397.422,16381.25,20732.906,25596.562,30972.219,36859.875,43259.53,50171.188,57594.844,65530.5,82937.81,102393.125,123896.44,147447.75,173047.06,200694.38,230389.69,0.00034427643,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.0008020401,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0017633438,0.0021066666,0.002480507,0.0028848648,0.0033197403,0.0037851334,0.004281044,0.004807472,0.005364418,0.0065698624,0.007897377,0.009346962,0.010918617,0.012612343,0.014428139,0.016366005,0.018425941,0.022912025,0.02788639,0.033349037,0.039299965,0.045739174,0.052666664,0.060082436,0.06798649,0.08525944,0.10448551,0.12566471,0.14879704,0.17388248,0.20092106,0.22991276,0.26085758,0.3286066,0.40416813,0.48754215,0.5787287,0.6777277,0.7845392,0.89916325,1.0215998,1.2899103,1.5894709,1.9202814,2.282342,2.6756525,3.100213,3.5560236,4.043084,5.110955,6.3038263,7.6216974,9.0645685,10.63244,12.325311,14.143182,16.086052,20.346794,25.107536,30.368279,36.12902,42.389763,49.150505,56.411247,64.17199,81.193474,100.21496,121.23644,144.25793,169.27942,196.3009,225.32239,256.34387,324.38684,400.4298,484.47278,576.51575,676.5587,784.6017,900.64465,1024.6876,1296.7736,1600.8595,1936.9454,2305.0312,2705.1172,3137.2031,3601.289,4097.375,5185.547,6401.7188,7745.8906,9218.0625,10818.234,12546.406,14402.578,16386.75,20739.094,25603.438,30979.781,36868.125,43268.47,50180.812,57605.156,65541.5,82950.19,102406.875,123911.56,147464.25,173064.94,200713.62,230410.31,0.0001373291,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00030899048,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0021972656,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.009536743,0.011123657,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.072647095,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.23841858,0.30336,0.3761139,0.4566803,0.5450592,0.6412506,0.7452545,0.8570709,0.9766998,1.2393951,1.5333405,1.8585358,2.214981,2.6026764,3.0216217,3.471817,3.9532623,5.009903,6.1915436,7.498184,8.929825,10.486465,12.168106,13.974747,15.906387,20.144669,24.88295,30.121231,35.859512,42.097794,48.836075,56.074356,63.812637,80.7892,99.76576,120.742325,143.71889,168.69545,195.67201,224.64857,255.62514,323.57825,399.53137,483.4845,575.4376,675.39075,783.3439,899.297,1023.2501,1295.1564,1599.0626,1934.9689,2302.8752,2702.7815,3134.6877,3598.594,4094.5002,5182.3125,6398.125,7741.9375,9213.75,10813.5625,12541.375,14397.1875,16381,20732.625,25596.25,30971.875,36859.5,43259.125,50170.75,57594.375,65530,82937.25,102392.5,123895.75,147447,173046.25,200693.5,230388.75,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008583069,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0021972656,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.006729126,0.008071899,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.23085022,0.26185608,0.32972717,0.40541077,0.48890686,0.58021545,0.67933655,0.78627014,0.90101624,1.0235748,1.2921295,1.5919342,1.9229889,2.2852936,2.6788483,3.103653,3.5597076,4.0470123,5.1153717,6.308731,7.6270905,9.07045,10.638809,12.332169,14.150528,16.093887,20.355606,25.117325,30.379044,36.140762,42.40248,49.1642,56.42592,64.18764,81.211075,100.23451,121.25795,144.28139,169.30482,196.32826,225.3517,256.37512,324.422,400.46887,484.51575,576.5626,676.6095,784.6564,900.70325,1024.7501,1296.8439,1600.9376,1937.0314,2305.1252,2705.219,3137.3127,3601.4065,4097.5,5185.6875,6401.875,7746.0625,9218.25,10818.4375,12546.625,14402.8125,16387,20739.375,25603.75,30980.125,36868.5,43268.875,50181.25,57605.625,65542,82950.75,102407.5,123912.25,147465,173065.75,200714.5,230411.25,0.00016117096,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,2.3841858e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00034427643,0.0005044937,0.0006952286,0.000916481,0.001168251,0.0014505386,0.0017633438,0.0021066666,0.002480507,0.0033197403,0.004281044,0.005364418,0.0065698624,0.007897377,0.009346962,0.010918617,0.012612343,0.016366005,0.020607948,0.025338173,0.030556679,0.036263466,0.042458534,0.049141884,0.056313515,0.07212162,0.08988285,0.109597206,0.13126469,0.15488529,0.18045902,0.20798588,0.23746586,0.3022852,0.37491703,0.45536137,0.5436182,0.63968754,0.7435694,0.8552637,0.97477055,1.2372217,1.5309229,1.8558741,2.2120752,2.5995264,3.0182276,3.4681787,3.94938,5.0055323,6.1866846,7.492837,8.923989,10.480142,12.161294,13.967446,15.898599,20.135902,24.873207,30.110512,35.847816,42.08512,48.822426,56.05973,63.797035,80.771645,99.746254,120.72086,143.69548,168.67009,195.6447,224.61931,255.59392,323.54312,399.49234,483.44156,575.3908,675.34,783.28925,899.23846,1023.1877,1295.086,1598.9845,1934.8829,2302.7815,2702.68,3134.5784,3598.4768,4094.3752,5182.172,6397.9688,7741.7656,9213.5625,10813.359,12541.156,14396.953,16380.75,20732.344,25595.938,30971.531,36859.125,43258.72,50170.312,57593.906,65529.5,82936.69,102391.875,123895.06,147446.25,173045.44,200692.62,230387.81,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0017633438,0.0019311905,0.002289772,0.0026788712,0.0030984879,0.0035486221,0.004029274,0.0045404434,0.0050821304,0.005654335,0.006890297,0.008248329,0.009728432,0.011330605,0.013054848,0.014901161,0.016869545,0.01896,0.023507118,0.028542519,0.0340662,0.040078163,0.046578407,0.053566933,0.06104374,0.06900883,0.08640385,0.10575199,0.12705326,0.15030766,0.17551517,0.20267582,0.23178959,0.26285648,0.33084965,0.4066553,0.49027348,0.58170414,0.6809473,0.78800297,0.90287113,1.0255518,1.2943506,1.5943995,1.9256983,2.288247,2.682046,3.1070948,3.5633936,4.0509424,5.11979,6.3136377,7.6324854,9.076333,10.645181,12.339028,14.157876,16.101723,20.364418,25.127113,30.389809,36.152504,42.4152,49.177895,56.44059,64.203285,81.228676,100.25407,121.27946,144.30486,169.33025,196.35564,225.38103,256.4064,324.45718,400.50797,484.55875,576.60956,676.66034,784.7111,900.7619,1024.8126,1296.9142,1601.0157,1937.1173,2305.219,2705.3206,3137.422,3601.5237,4097.625,5185.828,6402.0312,7746.2344,9218.4375,10818.641,12546.844,14403.047,16387.25,20739.656,25604.062,30980.469,36868.875,43269.28,50181.688,57606.094,65542.5,82951.31,102408.125,123912.94,147465.75,173066.56,200715.38,230412.19,0.00018692017,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0020179749,0.0023841858,0.0032081604,0.0041542053,0.0052223206,0.006412506,0.007724762,0.009159088,0.010715485,0.012393951,0.016117096,0.020328522,0.025028229,0.030216217,0.035892487,0.042057037,0.04870987,0.055850983,0.07159805,0.08929825,0.10895157,0.13055801,0.15411758,0.17963028,0.2070961,0.23651505,0.3012123,0.37372208,0.45404434,0.5421791,0.6381264,0.74188614,0.8534584,0.97284317,1.2350502,1.5285072,1.8532143,2.2091713,2.5963783,3.0148354,3.4645424,3.9454994,5.0011635,6.1818275,7.4874916,8.918156,10.47382,12.154484,13.960148,15.890812,20.12714,24.863468,30.099796,35.836124,42.072453,48.80878,56.04511,63.781437,80.75409,99.726746,120.6994,143.67206,168.64471,195.61737,224.59003,255.56268,323.508,399.4533,483.39862,575.34393,675.28925,783.23456,899.1799,1023.1252,1295.0159,1598.9065,1934.7971,2302.6877,2702.5784,3134.469,3598.3596,4094.2502,5182.0312,6397.8125,7741.5938,9213.375,10813.156,12540.9375,14396.719,16380.5,20732.062,25595.625,30971.188,36858.75,43258.312,50169.875,57593.438,65529,82936.125,102391.25,123894.375,147445.5,173044.62,200691.75,230386.88,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0023841858,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.0070533752,0.008426666,0.009922028,0.011539459,0.013278961,0.015140533,0.017124176,0.019229889,0.023807526,0.028873444,0.034427643,0.040470123,0.047000885,0.054019928,0.061527252,0.06952286,0.08697891,0.10638809,0.1277504,0.15106583,0.17633438,0.20355606,0.23273087,0.2638588,0.33197403,0.40790176,0.491642,0.58319473,0.68255997,0.7897377,0.90472794,1.0275307,1.2965736,1.5968666,1.9284096,2.2912025,2.6852455,3.1105385,3.5670815,4.0548744,5.1242104,6.3185463,7.637882,9.082218,10.651554,12.34589,14.165226,16.109562,20.373234,25.136906,30.400578,36.16425,42.42792,49.191593,56.455265,64.21893,81.24628,100.27362,121.300964,144.32831,169.35565,196.383,225.41034,256.43768,324.49237,400.54706,484.60175,576.65643,676.7111,784.7658,900.8205,1024.8752,1296.9846,1601.094,1937.2034,2305.3127,2705.422,3137.5315,3601.6409,4097.75,5185.9688,6402.1875,7746.4062,9218.625,10818.844,12547.0625,14403.281,16387.5,20739.938,25604.375,30980.812,36869.25,43269.688,50182.125,57606.562,65543,82951.875,102408.75,123913.625,147466.5,173067.38,200716.25,230413.12,0.00021457672,3.4332275e-05,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,8.583069e-06,2.3841858e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00042057037,0.00059604645,0.0008020401,0.0010385513,0.0013055801,0.0016031265,0.0019311905,0.002289772,0.0030984879,0.004029274,0.0050821304,0.006257057,0.0075540543,0.008973122,0.010514259,0.012177467,0.015870094,0.020051003,0.024720192,0.029877663,0.035523415,0.041657448,0.048279762,0.055390358,0.07107639,0.08871555,0.10830784,0.12985325,0.15335178,0.17880344,0.20620823,0.23556614,0.30014133,0.37252903,0.45272923,0.5407419,0.6365671,0.7402048,0.851655,0.9709177,1.2328806,1.5260935,1.8505564,2.2062693,2.5932322,3.011445,3.460908,3.9416208,4.9967966,6.1769724,7.482148,8.912324,10.4675,12.1476755,13.952851,15.883027,20.118378,24.85373,30.08908,35.824432,42.059784,48.795135,56.030487,63.76584,80.73654,99.707245,120.67795,143.64865,168.61935,195.59006,224.56076,255.53146,323.47287,399.41428,483.35568,575.2971,675.2385,783.17993,899.12134,1023.06274,1294.9456,1598.8284,1934.7112,2302.594,2702.4768,3134.3596,3598.2424,4094.1252,5181.8906,6397.6562,7741.422,9213.1875,10812.953,12540.719,14396.484,16380.25,20731.781,25595.312,30970.844,36858.375,43257.906,50169.438,57592.97,65528.5,82935.56,102390.625,123893.69,147444.75,173043.81,200690.88,230385.94,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0017633438,0.0019311905,0.0021066666,0.002480507,0.0028848648,0.0033197403,0.0037851334,0.004281044,0.004807472,0.005364418,0.0059518814,0.007218361,0.008606911,0.010117531,0.011750221,0.013504982,0.015381813,0.017380714,0.019501686,0.02410984,0.029206276,0.034790993,0.04086399,0.04742527,0.05447483,0.062012672,0.070038795,0.087555885,0.1070261,0.12844944,0.1518259,0.1771555,0.20443821,0.23367405,0.264863,0.33310032,0.40915012,0.49301243,0.58468723,0.68417454,0.79147434,0.90658665,1.0295115,1.2987986,1.5993357,1.9311228,2.29416,2.688447,3.113984,3.5707712,4.0588083,5.1286325,6.323457,7.643281,9.088105,10.657929,12.352754,14.172578,16.117401,20.38205,25.146698,30.411346,36.175995,42.440643,49.20529,56.46994,64.23459,81.263885,100.29318,121.32248,144.35178,169.38107,196.41037,225.43967,256.46896,324.52756,400.58615,484.64474,576.70337,676.76196,784.82056,900.87915,1024.9377,1297.0549,1601.1721,1937.2893,2305.4065,2705.5237,3137.6409,3601.758,4097.875,5186.1094,6402.3438,7746.578,9218.8125,10819.047,12547.281,14403.516,16387.75,20740.219,25604.688,30981.156,36869.625,43270.094,50182.562,57607.03,65543.5,82952.44,102409.375,123914.31,147467.25,173068.19,200717.12,230414.06,0.00024414062,4.673004e-05,3.4332275e-05,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.2307129,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,3.9377441,4.9924316,6.172119,7.4768066,8.906494,10.461182,12.140869,13.945557,15.875244,20.10962,24.843994,30.07837,35.812744,42.04712,48.781494,56.01587,63.750244,80.718994,99.687744,120.656494,143.62524,168.594,195.56274,224.5315,255.50024,323.43774,399.37524,483.31274,575.25024,675.18774,783.12524,899.06274,1023.00024,1294.8752,1598.7502,1934.6252,2302.5002,2702.3752,3134.2502,3598.1252,4094.0002,5181.75,6397.5,7741.25,9213,10812.75,12540.5,14396.25,16380,20731.5,25595,30970.5,36858,43257.5,50169,57592.5,65528,82935,102390,123893,147444,173043,200690,230385,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.000916481,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,3.574463,4.062744,5.1330566,6.328369,7.6486816,9.093994,10.664307,12.359619,14.179932,16.125244,20.39087,25.156494,30.42212,36.187744,42.45337,49.218994,56.48462,64.250244,81.281494,100.312744,121.343994,144.37524,169.4065,196.43774,225.469,256.50024,324.56274,400.62524,484.68774,576.75024,676.81274,784.87524,900.93774,1025.0002,1297.1252,1601.2502,1937.3752,2305.5002,2705.6252,3137.7502,3601.8752,4098,5186.25,6402.5,7746.75,9219,10819.25,12547.5,14403.75,16388,20740.5,25605,30981.5,36870,43270.5,50183,57607.5,65544,82953,102410,123915,147468,173069,200718,230415,0.00030899048,7.724762e-05,6.1035156e-05,4.673004e-05,3.4332275e-05,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0020179749,0.0027809143,0.003665924,0.004673004,0.0058021545,0.0070533752,0.008426666,0.009922028,0.011539459,0.015140533,0.019229889,0.023807526,0.028873444,0.034427643,0.040470123,0.047000885,0.054019928,0.06952286,0.08697891,0.10638809,0.1277504,0.15106583,0.17633438,0.20355606,0.23273087,0.29693985,0.36896133,0.44879532,0.5364418,0.6319008,0.7351723,0.84625626,0.96515274,1.2263832,1.5188637,1.8425941,2.1975746,2.583805,3.0012856,3.450016,3.9299965,4.9837074,6.1624184,7.4661293,8.89484,10.448551,12.127262,13.930973,15.859684,20.092106,24.824528,30.05695,35.78937,42.021793,48.754215,55.986637,63.71906,80.6839,99.64874,120.61359,143.57843,168.54327,195.50812,224.47296,255.4378,323.3675,399.29718,483.22687,575.15656,675.08624,783.0159,898.9456,1022.8753,1294.7347,1598.5941,1934.4535,2302.3127,2702.172,3134.0315,3597.8909,4093.7502,5181.469,6397.188,7740.9067,9212.625,10812.344,12540.0625,14395.781,16379.5,20730.938,25594.375,30969.812,36857.25,43256.688,50168.125,57591.562,65527,82933.875,102388.75,123891.625,147442.5,173041.38,200688.25,230383.12,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.000916481,0.0009765625,0.0010385513,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.007724762,0.009159088,0.010715485,0.012393951,0.0141944885,0.016117096,0.018161774,0.020328522,0.025028229,0.030216217,0.035892487,0.042057037,0.04870987,0.055850983,0.06348038,0.07159805,0.08929825,0.10895157,0.13055801,0.15411758,0.17963028,0.2070961,0.23651505,0.26788712,0.33649063,0.41290665,0.49713516,0.5891762,0.6890297,0.7966957,0.9121742,1.0354652,1.3054848,1.6067543,1.9392738,2.3030434,2.698063,3.1243324,3.581852,4.0706215,5.1419106,6.3381996,7.6594887,9.105778,10.677067,12.373356,14.194645,16.140934,20.408512,25.17609,30.443668,36.211246,42.478825,49.246403,56.51398,64.281555,81.31671,100.35187,121.387024,144.42218,169.45734,196.4925,225.52765,256.5628,324.63312,400.70343,484.77374,576.84406,676.91437,784.9847,901.055,1025.1254,1297.266,1601.4066,1937.5472,2305.6877,2705.8284,3137.969,3602.1096,4098.2505,5186.5317,6402.813,7747.094,9219.375,10819.656,12547.9375,14404.219,16388.5,20741.062,25605.625,30982.188,36870.75,43271.312,50183.875,57608.438,65545,82954.125,102411.25,123916.375,147469.5,173070.62,200719.75,230416.88,0.00038146973,0.00011539459,9.536743e-05,7.724762e-05,6.1035156e-05,4.673004e-05,3.4332275e-05,2.3841858e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.009536743,0.011123657,0.014663696,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.068496704,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.23085022,0.29481506,0.3665924,0.44618225,0.5335846,0.62879944,0.7318268,0.8426666,0.96131897,1.2220612,1.5140533,1.8372955,2.1917877,2.57753,2.994522,3.4427643,3.9222565,4.974991,6.152725,7.4554596,8.883194,10.435928,12.113663,13.916397,15.844131,20.0746,24.805069,30.035538,35.766006,41.996475,48.726944,55.957413,63.68788,80.64882,99.60976,120.570694,143.53163,168.49257,195.4535,224.41444,255.37538,323.29724,399.21912,483.141,575.06287,674.98474,782.9066,898.8285,1022.75037,1294.5941,1598.4379,1934.2816,2302.1255,2701.9692,3133.813,3597.6567,4093.5005,5181.188,6396.8755,7740.563,9212.25,10811.9375,12539.625,14395.3125,16379,20730.375,25593.75,30969.125,36856.5,43255.875,50167.25,57590.625,65526,82932.75,102387.5,123890.25,147441,173039.75,200686.5,230381.25,0.00074768066,0.0008020401,0.0008583069,0.000916481,0.0009765625,0.0010385513,0.0011024475,0.001168251,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.008071899,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.064468384,0.072647095,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.23841858,0.26991272,0.33876038,0.41542053,0.4998932,0.59217834,0.692276,0.80018616,0.9159088,1.039444,1.3099518,1.6117096,1.9447174,2.3089752,2.704483,3.1312408,3.5892487,4.0785065,5.150772,6.3480377,7.6703033,9.117569,10.689835,12.3871,14.209366,16.156631,20.426163,25.195694,30.465225,36.234756,42.504288,49.27382,56.54335,64.31288,81.351944,100.39101,121.43007,144.46913,169.5082,196.54726,225.58632,256.62537,324.7035,400.78162,484.85974,576.93787,677.016,785.0941,901.17224,1025.2504,1297.4066,1601.5629,1937.7191,2305.8755,2706.0317,3138.188,3602.3442,4098.5005,5186.813,6403.1255,7747.438,9219.75,10820.0625,12548.375,14404.6875,16389,20741.625,25606.25,30982.875,36871.5,43272.125,50184.75,57609.375,65546,82955.25,102412.5,123917.75,147471,173072.25,200721.5,230418.75,0.00046157837,0.00016117096,0.0001373291,0.00011539459,9.536743e-05,7.724762e-05,6.1035156e-05,4.673004e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0023841858,0.0032081604,0.0041542053,0.0052223206,0.006412506,0.007724762,0.009159088,0.010715485,0.0141944885,0.018161774,0.02261734,0.027561188,0.032993317,0.038913727,0.04532242,0.05221939,0.06747818,0.084690094,0.10385513,0.1249733,0.14804459,0.173069,0.20004654,0.2289772,0.2926979,0.3642311,0.4435768,0.530735,0.6257057,0.7284889,0.8390846,0.9574928,1.2177467,1.5092506,1.8320045,2.1860085,2.5712624,2.9877663,3.4355202,3.914524,4.966282,6.1430397,7.4447975,8.871555,10.423313,12.100071,13.901829,15.828587,20.057102,24.785618,30.014133,35.74265,41.971165,48.69968,55.928196,63.65671,80.61374,99.57077,120.5278,143.48483,168.44186,195.3989,224.35593,255.31296,323.22702,399.14108,483.05515,574.96924,674.8833,782.79736,898.7114,1022.6255,1294.4536,1598.2817,1934.1099,2301.938,2701.766,3133.5942,3597.4224,4093.2505,5180.9067,6396.563,7740.219,9211.875,10811.531,12539.1875,14394.844,16378.5,20729.812,25593.125,30968.438,36855.75,43255.062,50166.375,57589.688,65525,82931.625,102386.25,123888.875,147439.5,173038.12,200684.75,230379.38,0.0008583069,0.000916481,0.0009765625,0.0010385513,0.0011024475,0.001168251,0.0012359619,0.0013055801,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.0070533752,0.008426666,0.009922028,0.011539459,0.013278961,0.015140533,0.017124176,0.019229889,0.021457672,0.02627945,0.031589508,0.037387848,0.04367447,0.05044937,0.057712555,0.06546402,0.073703766,0.0916481,0.11154556,0.13339615,0.15719986,0.1829567,0.21066666,0.24032974,0.27194595,0.34103775,0.41794205,0.50265884,0.59518814,0.69552994,0.80368423,0.91965103,1.0434303,1.3144264,1.6166725,1.9501686,2.3149147,2.7109108,3.138157,3.596653,4.086399,5.1596413,6.3578835,7.6811256,9.129368,10.70261,12.400852,14.224094,16.172337,20.44382,25.215305,30.48679,36.258274,42.52976,49.301243,56.572727,64.34421,81.38718,100.430145,121.473114,144.51608,169.55905,196.60202,225.64499,256.68796,324.7739,400.85983,484.94577,577.03174,677.1177,785.2036,901.28955,1025.3755,1297.5474,1601.7192,1937.8911,2306.063,2706.2349,3138.4067,3602.5786,4098.7505,5187.094,6403.438,7747.7817,9220.125,10820.469,12548.8125,14405.156,16389.5,20742.188,25606.875,30983.562,36872.25,43272.938,50185.625,57610.312,65547,82956.375,102413.75,123919.125,147472.5,173073.88,200723.25,230420.62,0.0005493164,0.00021457672,0.00018692017,0.00016117096,0.0001373291,0.00011539459,9.536743e-05,7.724762e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.29058838,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,0.9536743,1.21344,1.5044556,1.8267212,2.1802368,2.5650024,2.981018,3.4282837,3.9067993,4.9575806,6.133362,7.434143,8.859924,10.410706,12.086487,13.887268,15.813049,20.039612,24.766174,29.992737,35.7193,41.94586,48.672424,55.898987,63.62555,80.578674,99.5318,120.484924,143.43805,168.39117,195.3443,224.29742,255.25055,323.1568,399.06305,482.9693,574.87555,674.7818,782.68805,898.5943,1022.50055,1294.313,1598.1255,1933.938,2301.7505,2701.563,3133.3755,3597.188,4093.0005,5180.6255,6396.2505,7739.8755,9211.501,10811.126,12538.751,14394.376,16378.001,20729.25,25592.5,30967.75,36855,43254.25,50165.5,57588.75,65524,82930.5,102385,123887.5,147438,173036.5,200683,230377.5,0.0009765625,0.0010385513,0.0011024475,0.001168251,0.0012359619,0.0013055801,0.0013771057,0.0014505386,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,0.9234009,1.0474243,1.3189087,1.6216431,1.9556274,2.3208618,2.7173462,3.1450806,3.604065,4.0942993,5.168518,6.367737,7.6919556,9.141174,10.715393,12.414612,14.238831,16.18805,20.461487,25.234924,30.508362,36.2818,42.555237,49.328674,56.60211,64.37555,81.422424,100.4693,121.516174,144.56305,169.60992,196.6568,225.70367,256.75055,324.8443,400.93805,485.0318,577.12555,677.2193,785.31305,901.4068,1025.5005,1297.688,1601.8755,1938.063,2306.2505,2706.438,3138.6255,3602.813,4099.0005,5187.3755,6403.7505,7748.1255,9220.501,10820.876,12549.251,14405.626,16390,20742.75,25607.5,30984.25,36873,43273.75,50186.5,57611.25,65548,82957.5,102415,123920.5,147474,173075.5,200725,230422.5,0.00064468384,0.00027561188,0.00024414062,0.00021457672,0.00018692017,0.00016117096,0.0001373291,0.00011539459,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,9.536743e-05,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0020179749,0.0027809143,0.003665924,0.004673004,0.0058021545,0.0070533752,0.008426666,0.009922028,0.013278961,0.017124176,0.021457672,0.02627945,0.031589508,0.037387848,0.04367447,0.05044937,0.06546402,0.08243179,0.10135269,0.122226715,0.14505386,0.16983414,0.19656754,0.22525406,0.28848648,0.3595314,0.43838882,0.52505875,0.61954117,0.7218361,0.8319435,0.94986343,1.2091408,1.4996681,1.8214455,2.1744728,2.5587502,2.9742775,3.4210548,3.8990822,4.948887,6.1236916,7.4234962,8.848301,10.398106,12.07291,13.872715,15.79752,20.02213,24.746738,29.971348,35.695957,41.920567,48.645176,55.869785,63.594395,80.54361,99.49283,120.44205,143.39127,168.34048,195.2897,224.23892,255.18814,323.08658,398.98502,482.88345,574.7819,674.68036,782.5788,898.47723,1022.3757,1294.1725,1597.9694,1933.7662,2301.5632,2701.36,3133.157,3596.9539,4092.7507,5180.344,6395.938,7739.5317,9211.126,10810.72,12538.313,14393.907,16377.501,20728.688,25591.875,30967.062,36854.25,43253.438,50164.625,57587.812,65523,82929.375,102383.75,123886.125,147436.5,173034.88,200681.25,230375.62,0.0011024475,0.001168251,0.0012359619,0.0013055801,0.0013771057,0.0014505386,0.0015258789,0.0016031265,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.0070533752,0.007724762,0.009159088,0.010715485,0.012393951,0.0141944885,0.016117096,0.018161774,0.020328522,0.02261734,0.027561188,0.032993317,0.038913727,0.04532242,0.05221939,0.059604645,0.06747818,0.07584,0.09402847,0.114170074,0.1362648,0.16031265,0.18631363,0.21426773,0.24417496,0.2760353,0.3456154,0.42300797,0.50821304,0.6012306,0.7020607,0.8107033,0.92715836,1.0514259,1.3233986,1.6266212,1.9610939,2.3268166,2.7237892,3.1520119,3.6114845,4.102207,5.1774025,6.377598,7.702793,9.152988,10.728184,12.428379,14.253574,16.20377,20.47916,25.25455,30.529942,36.305332,42.580723,49.356113,56.631504,64.40689,81.45767,100.50845,121.559235,144.61002,169.6608,196.71158,225.76236,256.81314,324.9147,401.01627,485.11783,577.2194,677.321,785.42255,901.5241,1025.6256,1297.8287,1602.0319,1938.235,2306.4382,2706.6414,3138.8445,3603.0476,4099.2505,5187.6567,6404.063,7748.469,9220.876,10821.282,12549.688,14406.095,16390.5,20743.312,25608.125,30984.938,36873.75,43274.562,50187.375,57612.188,65549,82958.625,102416.25,123921.875,147475.5,173077.12,200726.75,230424.38,0.00074768066,0.00034427643,0.00030899048,0.00027561188,0.00024414062,0.00021457672,0.00018692017,0.00016117096,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.009536743,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.064468384,0.08131409,0.100112915,0.12086487,0.14356995,0.16822815,0.19483948,0.22340393,0.2863922,0.357193,0.43580627,0.52223206,0.61647034,0.7185211,0.8283844,0.9460602,1.2048492,1.4948883,1.8161774,2.1687164,2.5525055,2.9675446,3.4138336,3.8913727,4.940201,6.114029,7.412857,8.836685,10.385513,12.059341,13.85817,15.781998,20.004654,24.72731,29.949966,35.672623,41.89528,48.617935,55.84059,63.563248,80.50856,99.45387,120.399185,143.3445,168.28981,195.23512,224.18044,255.12575,323.01636,398.90698,482.7976,574.68823,674.57886,782.4695,898.3601,1022.25073,1294.032,1597.8132,1933.5945,2301.3757,2701.157,3132.9382,3596.7195,4092.5007,5180.0635,6395.626,7739.1885,9210.751,10810.313,12537.876,14393.438,16377.001,20728.125,25591.25,30966.375,36853.5,43252.625,50163.75,57586.875,65522,82928.25,102382.5,123884.75,147435,173033.25,200679.5,230373.75,0.0012359619,0.0013055801,0.0013771057,0.0014505386,0.0015258789,0.0016031265,0.0016822815,0.0017633438,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.076919556,0.0952301,0.115493774,0.13771057,0.1618805,0.18800354,0.21607971,0.24610901,0.27809143,0.34791565,0.42555237,0.5110016,0.6042633,0.7053375,0.81422424,0.93092346,1.0554352,1.3278961,1.631607,1.966568,2.332779,2.7302399,3.1589508,3.6189117,4.1101227,5.1862946,6.3874664,7.7136383,9.16481,10.740982,12.442154,14.268326,16.219498,20.496841,25.274185,30.551529,36.328873,42.606216,49.38356,56.660904,64.43825,81.492935,100.54762,121.60231,144.657,169.71169,196.76637,225.82106,256.87573,324.9851,401.09448,485.20386,577.31323,677.4226,785.532,901.64136,1025.7507,1297.9695,1602.1882,1938.407,2306.6257,2706.8445,3139.0632,3603.282,4099.501,5187.9385,6404.376,7748.8135,9221.251,10821.688,12550.126,14406.563,16391,20743.875,25608.75,30985.625,36874.5,43275.375,50188.25,57613.125,65550,82959.75,102417.5,123923.25,147477,173078.75,200728.5,230426.25,0.0008583069,0.00042057037,0.00038146973,0.00034427643,0.00030899048,0.00027561188,0.00024414062,0.00021457672,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,3.4332275e-05,9.536743e-05,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0016822815,0.0023841858,0.0032081604,0.0041542053,0.0052223206,0.006412506,0.007724762,0.009159088,0.012393951,0.016117096,0.020328522,0.025028229,0.030216217,0.035892487,0.042057037,0.04870987,0.06348038,0.08020401,0.09888077,0.11951065,0.14209366,0.16662979,0.19311905,0.22156143,0.28430557,0.3548622,0.43323135,0.519413,0.61340714,0.7152138,0.8248329,0.94226456,1.2005653,1.4901161,1.8109169,2.1629677,2.5462685,2.9608192,3.40662,3.8836708,4.9315224,6.104374,7.4022255,8.825077,10.372929,12.04578,13.843632,15.766483,19.987186,24.70789,29.928593,35.649296,41.87,48.590702,55.811405,63.53211,80.47351,99.41492,120.35632,143.29773,168.23914,195.18054,224.12195,255.06335,322.94617,398.82898,482.7118,574.5946,674.4774,782.3602,898.24304,1022.12585,1293.8915,1597.6571,1933.4227,2301.1885,2700.954,3132.7197,3596.4854,4092.251,5179.782,6395.3135,7738.8447,9210.376,10809.907,12537.438,14392.97,16376.501,20727.562,25590.625,30965.688,36852.75,43251.812,50162.875,57585.938,65521,82927.125,102381.25,123883.375,147433.5,173031.62,200677.75,230371.88,0.0013771057,0.0014505386,0.0015258789,0.0016031265,0.0016822815,0.0017633438,0.0018463135,0.0019311905,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.0070533752,0.007724762,0.008426666,0.009922028,0.011539459,0.013278961,0.015140533,0.017124176,0.019229889,0.021457672,0.023807526,0.028873444,0.034427643,0.040470123,0.047000885,0.054019928,0.061527252,0.06952286,0.078006744,0.09643936,0.116825104,0.13916397,0.16345596,0.18970108,0.21789932,0.24805069,0.28015518,0.35022354,0.4281044,0.51379776,0.6073036,0.708622,0.81775284,0.9346962,1.059452,1.3324013,1.6366005,1.9720497,2.338749,2.7366982,3.1658974,3.6263466,4.118046,5.1951942,6.3973427,7.724491,9.17664,10.753788,12.455936,14.283085,16.235233,20.51453,25.293827,30.573124,36.35242,42.631718,49.411015,56.69031,64.469604,81.5282,100.58679,121.645386,144.70398,169.76257,196.82117,225.87976,256.93835,325.05554,401.17273,485.28992,577.4071,677.5243,785.6415,901.75867,1025.8759,1298.1102,1602.3446,1938.579,2306.8135,2707.0479,3139.2822,3603.5166,4099.751,5188.2197,6404.6885,7749.157,9221.626,10822.095,12550.563,14407.032,16391.5,20744.438,25609.375,30986.312,36875.25,43276.188,50189.125,57614.062,65551,82960.875,102418.75,123924.625,147478.5,173080.38,200730.25,230428.12,0.0009765625,0.0005044937,0.00046157837,0.00042057037,0.00038146973,0.00034427643,0.00030899048,0.00027561188,0.00024414062,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.9228516,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,15.750977,19.969727,24.688477,29.907227,35.625977,41.844727,48.563477,55.782227,63.500977,80.43848,99.37598,120.31348,143.25098,168.18848,195.12598,224.06348,255.00098,322.87598,398.75098,482.62598,574.501,674.376,782.251,898.126,1022.001,1293.751,1597.501,1933.251,2301.001,2700.751,3132.501,3596.251,4092.001,5179.501,6395.001,7738.501,9210.001,10809.501,12537.001,14392.501,16376.001,20727,25590,30965,36852,43251,50162,57585,65520,82926,102380,123882,147432,173030,200676,230370,0.0015258789,0.0016031265,0.0016822815,0.0017633438,0.0018463135,0.0019311905,0.0020179749,0.0021066666,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,14.297852,16.250977,20.532227,25.313477,30.594727,36.375977,42.657227,49.438477,56.719727,64.50098,81.56348,100.62598,121.68848,144.75098,169.81348,196.87598,225.93848,257.00098,325.12598,401.25098,485.37598,577.501,677.626,785.751,901.876,1026.001,1298.251,1602.501,1938.751,2307.001,2707.251,3139.501,3603.751,4100.001,5188.501,6405.001,7749.501,9222.001,10822.501,12551.001,14407.501,16392,20745,25610,30987,36876,43277,50190,57615,65552,82962,102420,123926,147480,173082,200732,230430,0.0012359619,0.0006952286,0.00064468384,0.00059604645,0.0005493164,0.0005044937,0.00046157837,0.00042057037,0.00038146973,0.00030899048,0.00024414062,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.011123657,0.014663696,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.060562134,0.076919556,0.0952301,0.115493774,0.13771057,0.1618805,0.18800354,0.21607971,0.27809143,0.34791565,0.42555237,0.5110016,0.6042633,0.7053375,0.81422424,0.93092346,1.1877594,1.4758453,1.7951813,2.1457672,2.5276031,2.940689,3.385025,3.860611,4.905533,6.0754547,7.3703766,8.790298,10.33522,12.005142,13.800064,15.719986,19.93483,24.649673,29.864517,35.57936,41.794205,48.50905,55.723892,63.438736,80.36842,99.29811,120.2278,143.15749,168.08717,195.01686,223.94655,254.87624,322.7356,398.59497,482.45435,574.3137,674.1731,782.0325,897.89185,1021.7512,1293.47,1597.1887,1932.9075,2300.6262,2700.345,3132.0637,3595.7825,4091.5012,5178.939,6394.3765,7737.814,9209.251,10808.688,12536.126,14391.563,16375.001,20725.877,25588.752,30963.627,36850.5,43249.375,50160.25,57583.125,65518,82923.75,102377.5,123879.25,147429,173026.75,200672.5,230366.25,0.0018463135,0.0019311905,0.0020179749,0.0021066666,0.0021972656,0.002289772,0.0023841858,0.002480507,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.00390625,0.0041542053,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.064468384,0.072647095,0.08131409,0.100112915,0.12086487,0.14356995,0.16822815,0.19483948,0.22340393,0.2539215,0.2863922,0.357193,0.43580627,0.52223206,0.61647034,0.7185211,0.8283844,0.9460602,1.0715485,1.3459625,1.6516266,1.9885406,2.3567047,2.7561188,3.1867828,3.648697,4.141861,5.221939,6.427017,7.7570953,9.212173,10.792252,12.49733,14.327408,16.282486,20.567642,25.352798,30.637955,36.42311,42.708267,49.493423,56.77858,64.563736,81.63405,100.70436,121.77467,144.84499,169.9153,196.98561,226.05592,257.12622,325.26685,401.40747,485.5481,577.6887,677.82935,785.97,902.1106,1026.2512,1298.5325,1602.8137,1939.095,2307.3762,2707.6575,3139.9387,3604.22,4100.5015,5189.064,6405.6265,7750.189,9222.751,10823.313,12551.876,14408.438,16393.002,20746.127,25611.252,30988.377,36877.5,43278.625,50191.75,57616.875,65554,82964.25,102422.5,123928.75,147483,173085.25,200735.5,230433.75,0.0015258789,0.000916481,0.0008583069,0.0008020401,0.00074768066,0.0006952286,0.00064468384,0.00059604645,0.0005493164,0.00046157837,0.00038146973,0.00030899048,0.00024414062,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.058654785,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.27398682,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,0.9234009,1.1792603,1.4663696,1.784729,2.1343384,2.5151978,2.9273071,3.3706665,3.8452759,4.8882446,6.0562134,7.349182,8.767151,10.31012,11.978088,13.771057,15.689026,19.899963,24.6109,29.821838,35.532776,41.743713,48.45465,55.66559,63.376526,80.2984,99.220276,120.14215,143.06403,167.9859,194.90778,223.82965,254.75153,322.59528,398.43903,482.28278,574.1265,673.9703,781.814,897.6578,1021.5015,1293.189,1596.8765,1932.564,2300.2515,2699.939,3131.6265,3595.314,4091.0015,5178.3765,6393.7515,7737.1265,9208.502,10807.877,12535.252,14390.627,16374.002,20724.752,25587.502,30962.252,36849,43247.75,50158.5,57581.25,65516,82921.5,102375,123876.5,147426,173023.5,200669,230362.5,0.0021972656,0.002289772,0.0023841858,0.002480507,0.0025787354,0.0026788712,0.0027809143,0.0028848648,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.00390625,0.0041542053,0.00440979,0.004673004,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.25787354,0.29058838,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,0.9536743,1.0796509,1.3550415,1.6616821,1.9995728,2.3687134,2.769104,3.2007446,3.6636353,4.157776,5.239807,6.4468384,7.7788696,9.235901,10.817932,12.524963,14.356995,16.314026,20.603088,25.39215,30.681213,36.470276,42.75934,49.5484,56.837463,64.626526,81.70465,100.782776,121.8609,144.93903,170.01715,197.09528,226.1734,257.25153,325.40778,401.56403,485.72028,577.8765,678.0328,786.189,902.3453,1026.5015,1298.814,1603.1265,1939.439,2307.7515,2708.064,3140.3765,3604.689,4101.0015,5189.6265,6406.2515,7750.8765,9223.502,10824.127,12552.752,14409.377,16394.002,20747.252,25612.502,30989.752,36879,43280.25,50193.5,57618.75,65556,82966.5,102425,123931.5,147486,173088.5,200739,230437.5,0.0018463135,0.001168251,0.0011024475,0.0010385513,0.0009765625,0.000916481,0.0008583069,0.0008020401,0.00074768066,0.00064468384,0.0005493164,0.00046157837,0.00038146973,0.00030899048,0.00024414062,0.00018692017,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.009536743,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.056777954,0.072647095,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.26991272,0.33876038,0.41542053,0.4998932,0.59217834,0.692276,0.80018616,0.9159088,1.1707916,1.4569244,1.7743073,2.12294,2.5028229,2.9139557,3.3563385,3.8299713,4.870987,6.0370026,7.328018,8.744034,10.285049,11.951065,13.742081,15.658096,19.865128,24.572159,29.77919,35.48622,41.693253,48.400284,55.607315,63.314346,80.22841,99.14247,120.05653,142.9706,167.88466,194.79872,223.71278,254.62685,322.45496,398.28308,482.1112,573.93933,673.76746,781.5956,897.4237,1021.25183,1292.9081,1596.5643,1932.2206,2299.877,2699.5332,3131.1895,3594.8457,4090.502,5177.8145,6393.127,7736.4395,9207.752,10807.064,12534.377,14389.689,16373.002,20723.627,25586.252,30960.877,36847.5,43246.125,50156.75,57579.375,65514,82919.25,102372.5,123873.75,147423,173020.25,200665.5,230358.75,0.0025787354,0.0026788712,0.0027809143,0.0028848648,0.0029907227,0.0030984879,0.0032081604,0.0033197403,0.0034332275,0.003665924,0.00390625,0.0041542053,0.00440979,0.004673004,0.0049438477,0.0052223206,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.076919556,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.23085022,0.26185608,0.29481506,0.3665924,0.44618225,0.5335846,0.62879944,0.7318268,0.8426666,0.96131897,1.0877838,1.364151,1.6717682,2.0106354,2.3807526,2.7821198,3.214737,3.6786041,4.1737213,5.2577057,6.46669,7.8006744,9.259659,10.843643,12.552628,14.386612,16.345596,20.638565,25.431534,30.724503,36.51747,42.81044,49.60341,56.896378,64.68935,81.77528,100.86122,121.94716,145.0331,170.11903,197.20497,226.29091,257.37683,325.5487,401.72058,485.89246,578.06433,678.2362,786.4081,902.57996,1026.7518,1299.0956,1603.4393,1939.7831,2308.127,2708.4707,3140.8145,3605.1582,4101.502,5190.1895,6406.877,7751.5645,9224.252,10824.939,12553.627,14410.314,16395.002,20748.377,25613.752,30991.127,36880.5,43281.875,50195.25,57620.625,65558,82968.75,102427.5,123934.25,147489,173091.75,200742.5,230441.25,0.0021972656,0.0014505386,0.0013771057,0.0013055801,0.0012359619,0.001168251,0.0011024475,0.0010385513,0.0009765625,0.0008583069,0.00074768066,0.00064468384,0.0005493164,0.00046157837,0.00038146973,0.00030899048,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.1623535,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,3.8146973,4.85376,6.0178223,7.306885,8.720947,10.26001,11.924072,13.713135,15.627197,19.830322,24.533447,29.736572,35.439697,41.642822,48.345947,55.549072,63.252197,80.15845,99.0647,119.97095,142.8772,167.78345,194.6897,223.59595,254.5022,322.3147,398.1272,481.9397,573.7522,673.5647,781.3772,897.1897,1021.0022,1292.6272,1596.2522,1931.8772,2299.5022,2699.1272,3130.7522,3594.3772,4090.0022,5177.252,6392.502,7735.752,9207.002,10806.252,12533.502,14388.752,16372.002,20722.502,25585.002,30959.502,36846.004,43244.504,50155.004,57577.504,65512.004,82917,102370,123871,147420,173017,200662,230355,0.0029907227,0.0030984879,0.0032081604,0.0033197403,0.0034332275,0.0035486221,0.003665924,0.0037851334,0.00390625,0.0041542053,0.00440979,0.004673004,0.0049438477,0.0052223206,0.005508423,0.0058021545,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,3.6936035,4.1896973,5.275635,6.4865723,7.82251,9.283447,10.869385,12.580322,14.41626,16.377197,20.674072,25.470947,30.767822,36.564697,42.861572,49.658447,56.955322,64.7522,81.84595,100.9397,122.03345,145.1272,170.22095,197.3147,226.40845,257.5022,325.6897,401.8772,486.0647,578.2522,678.4397,786.6272,902.8147,1027.0022,1299.3772,1603.7522,1940.1272,2308.5022,2708.8772,3141.2522,3605.6272,4102.002,5190.752,6407.502,7752.252,9225.002,10825.752,12554.502,14411.252,16396.002,20749.502,25615.002,30992.502,36882.004,43283.504,50197.004,57622.504,65560,82971,102430,123937,147492,173095,200746,230445,0.0025787354,0.0017633438,0.0016822815,0.0016031265,0.0015258789,0.0014505386,0.0013771057,0.0013055801,0.0012359619,0.0011024475,0.0009765625,0.0008583069,0.00074768066,0.00064468384,0.0005493164,0.00046157837,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00038146973,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.008071899,0.011123657,0.014663696,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.053115845,0.068496704,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.26185608,0.32972717,0.40541077,0.48890686,0.58021545,0.67933655,0.78627014,0.90101624,1.1539459,1.4381256,1.7535553,2.100235,2.4781647,2.8873444,3.327774,3.7994537,4.836563,5.9986725,7.285782,8.697891,10.235001,11.89711,13.684219,15.596329,19.795547,24.494766,29.693985,35.393204,41.592422,48.29164,55.49086,63.19008,80.08852,98.98695,119.88539,142.78383,167.68227,194.5807,223.47914,254.37758,322.17444,397.9713,481.7682,573.56506,673.36194,781.1588,896.9557,1020.75256,1292.3463,1595.9401,1931.5338,2299.1277,2698.7214,3130.3152,3593.909,4089.5027,5176.69,6391.8774,7735.065,9206.253,10805.44,12532.628,14387.815,16371.003,20721.377,25583.752,30958.127,36844.504,43242.88,50153.254,57575.63,65510.004,82914.75,102367.5,123868.25,147417,173013.75,200658.5,230351.25,0.0034332275,0.0035486221,0.003665924,0.0037851334,0.00390625,0.004029274,0.0041542053,0.004281044,0.00440979,0.004673004,0.0049438477,0.0052223206,0.005508423,0.0058021545,0.0061035156,0.006412506,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.028213501,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.064468384,0.072647095,0.08131409,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.23841858,0.26991272,0.30336,0.3761139,0.4566803,0.5450592,0.6412506,0.7452545,0.8570709,0.9766998,1.1041412,1.3824615,1.6920319,2.0328522,2.4049225,2.8082428,3.242813,3.7086334,4.2057037,5.2935944,6.506485,7.8443756,9.307266,10.895157,12.6080475,14.445938,16.408829,20.70961,25.510391,30.811172,36.611954,42.912735,49.713516,57.014297,64.81508,81.91664,101.0182,122.11977,145.22133,170.32289,197.42445,226.52602,257.62756,325.8307,402.0338,486.23694,578.44006,678.6432,786.8463,903.04944,1027.2526,1299.6588,1604.0651,1940.4713,2308.8777,2709.284,3141.6902,3606.0964,4102.5024,5191.315,6408.1274,7752.94,9225.753,10826.565,12555.378,14412.19,16397.002,20750.627,25616.252,30993.877,36883.504,43285.13,50198.754,57624.38,65562,82973.25,102432.5,123939.75,147495,173098.25,200749.5,230448.75,0.0029907227,0.0021066666,0.0020179749,0.0019311905,0.0018463135,0.0017633438,0.0016822815,0.0016031265,0.0015258789,0.0013771057,0.0012359619,0.0011024475,0.0009765625,0.0008583069,0.00074768066,0.00064468384,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.25787354,0.32525635,0.40045166,0.48345947,0.5742798,0.6729126,0.7793579,0.8936157,1.1455688,1.428772,1.7432251,2.0889282,2.4658813,2.8740845,3.3135376,3.7842407,4.819397,5.979553,7.2647095,8.674866,10.210022,11.870178,13.655334,15.565491,19.760803,24.456116,29.651428,35.34674,41.542053,48.237366,55.43268,63.12799,80.018616,98.90924,119.799866,142.69049,167.58112,194.47174,223.36237,254.25299,322.03424,397.8155,481.59674,573.378,673.15924,780.9405,896.72174,1020.503,1292.0654,1595.6279,1931.1904,2298.753,2698.3154,3129.878,3593.4404,4089.003,5176.128,6391.253,7734.378,9205.503,10804.628,12531.753,14386.878,16370.003,20720.254,25582.504,30956.754,36843.004,43241.254,50151.504,57573.754,65508.004,82912.5,102365,123865.5,147414,173010.5,200655,230347.5,0.00390625,0.004029274,0.0041542053,0.004281044,0.00440979,0.0045404434,0.004673004,0.004807472,0.0049438477,0.0052223206,0.005508423,0.0058021545,0.0061035156,0.006412506,0.006729126,0.0070533752,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.30767822,0.3809204,0.4619751,0.5508423,0.647522,0.75201416,0.86431885,0.98443604,1.1123657,1.3916626,1.7022095,2.0440063,2.4170532,2.82135,3.256897,3.7236938,4.2217407,5.3115845,6.526428,7.866272,9.331116,10.920959,12.635803,14.475647,16.44049,20.745178,25.549866,30.854553,36.65924,42.96393,49.768616,57.073303,64.87799,81.987366,101.09674,122.206116,145.31549,170.42487,197.53424,226.64362,257.753,325.97174,402.1905,486.40924,578.628,678.84674,787.0655,903.28424,1027.5029,1299.9404,1604.3779,1940.8154,2309.253,2709.6904,3142.128,3606.5654,4103.003,5191.878,6408.753,7753.628,9226.503,10827.378,12556.253,14413.128,16398.004,20751.754,25617.504,30995.254,36885.004,43286.754,50200.504,57626.254,65564,82975.5,102435,123942.5,147498,173101.5,200753,230452.5,0.0034332275,0.002480507,0.0023841858,0.002289772,0.0021972656,0.0021066666,0.0020179749,0.0019311905,0.0018463135,0.0016822815,0.0015258789,0.0013771057,0.0012359619,0.0011024475,0.0009765625,0.0008583069,0.00074768066,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,0.0001373291,0.00038146973,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.006729126,0.009536743,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.049575806,0.064468384,0.08131409,0.100112915,0.12086487,0.14356995,0.16822815,0.19483948,0.2539215,0.32081604,0.39552307,0.4780426,0.56837463,0.66651917,0.7724762,0.8862457,1.1372223,1.4194489,1.7329254,2.077652,2.4536285,2.860855,3.2993317,3.7690582,4.8022614,5.9604645,7.2436676,8.651871,10.185074,11.843277,13.62648,15.534683,19.72609,24.417496,29.608902,35.30031,41.491714,48.18312,55.374527,63.065933,79.948746,98.83156,119.71437,142.59718,167.48,194.36281,223.24562,254.12843,321.89404,397.65967,481.4253,573.1909,672.95654,780.72217,896.4878,1020.2534,1291.7847,1595.3159,1930.8472,2298.3784,2697.9097,3129.441,3592.9722,4088.5034,5175.566,6390.6284,7733.691,9204.754,10803.816,12530.879,14385.941,16369.004,20719.129,25581.254,30955.379,36841.504,43239.63,50149.754,57571.88,65506.004,82910.25,102362.5,123862.75,147411,173007.25,200651.5,230343.75,0.00440979,0.0045404434,0.004673004,0.004807472,0.0049438477,0.0050821304,0.0052223206,0.005364418,0.005508423,0.0058021545,0.0061035156,0.006412506,0.006729126,0.0070533752,0.007385254,0.007724762,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.028213501,0.030899048,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.076919556,0.08583069,0.0952301,0.115493774,0.13771057,0.1618805,0.18800354,0.21607971,0.24610901,0.27809143,0.31202698,0.38575745,0.46730042,0.5566559,0.65382385,0.7588043,0.8715973,0.99220276,1.1206207,1.4008942,1.7124176,2.055191,2.4292145,2.834488,3.2710114,3.7387848,4.237808,5.329605,6.546402,7.888199,9.354996,10.946793,12.6635895,14.505386,16.472183,20.780777,25.58937,30.897964,36.70656,43.015152,49.823746,57.13234,64.94093,82.05812,101.17531,122.292496,145.40968,170.52687,197.64406,226.76125,257.87842,326.1128,402.34717,486.58154,578.8159,679.0503,787.28467,903.51904,1027.7534,1300.2222,1604.6909,1941.1597,2309.6284,2710.0972,3142.566,3607.0347,4103.5034,5192.441,6409.3784,7754.316,9227.254,10828.191,12557.129,14414.066,16399.004,20752.879,25618.754,30996.629,36886.504,43288.38,50202.254,57628.13,65566,82977.75,102437.5,123945.25,147501,173104.75,200756.5,230456.25,0.00390625,0.0028848648,0.0027809143,0.0026788712,0.0025787354,0.002480507,0.0023841858,0.002289772,0.0021972656,0.0020179749,0.0018463135,0.0016822815,0.0015258789,0.0013771057,0.0012359619,0.0011024475,0.0009765625,0.00074768066,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,19.691406,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,63.003906,79.87891,98.75391,119.62891,142.5039,167.3789,194.2539,223.1289,254.0039,321.7539,397.5039,481.2539,573.0039,672.7539,780.5039,896.2539,1020.0039,1291.5039,1595.0039,1930.5039,2298.004,2697.504,3129.004,3592.504,4088.004,5175.004,6390.004,7733.004,9204.004,10803.004,12530.004,14385.004,16368.004,20718.004,25580.004,30954.004,36840.004,43238.004,50148.004,57570.004,65504.004,82908,102360,123860,147408,173004,200648,230340,0.0049438477,0.0050821304,0.0052223206,0.005364418,0.005508423,0.005654335,0.0058021545,0.0059518814,0.0061035156,0.006412506,0.006729126,0.0070533752,0.007385254,0.007724762,0.008071899,0.008426666,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.014663696,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,57.191406,65.00391,82.12891,101.25391,122.37891,145.5039,170.6289,197.7539,226.8789,258.0039,326.2539,402.5039,486.7539,579.0039,679.2539,787.5039,903.7539,1028.0039,1300.5039,1605.0039,1941.5039,2310.004,2710.504,3143.004,3607.504,4104.004,5193.004,6410.004,7755.004,9228.004,10829.004,12558.004,14415.004,16400.004,20754.004,25620.004,30998.004,36888.004,43290.004,50204.004,57630.004,65568,82980,102440,123948,147504,173108,200760,230460,0.0049438477,0.0037851334,0.003665924,0.0035486221,0.0034332275,0.0033197403,0.0032081604,0.0030984879,0.0029907227,0.0027809143,0.0025787354,0.0023841858,0.0021972656,0.0020179749,0.0018463135,0.0016822815,0.0015258789,0.0012359619,0.0009765625,0.00074768066,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.04449463,0.058654785,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.24224854,0.30767822,0.3809204,0.4619751,0.5508423,0.647522,0.75201416,0.86431885,1.1123657,1.3916626,1.7022095,2.0440063,2.4170532,2.82135,3.256897,3.7236938,4.7510376,5.9033813,7.180725,8.583069,10.110413,11.762756,13.5401,15.442444,19.622131,24.301819,29.481506,35.161194,41.34088,48.02057,55.200256,62.879944,79.73932,98.598694,119.45807,142.31744,167.17682,194.0362,222.89557,253.75494,321.4737,397.19244,480.9112,572.62994,672.3487,780.06744,895.7862,1019.50494,1290.9424,1594.3799,1929.8174,2297.255,2696.6924,3128.13,3591.5674,4087.005,5173.88,6388.755,7731.63,9202.505,10801.38,12528.255,14383.13,16366.005,20715.756,25577.506,30951.256,36837.004,43234.754,50144.504,57566.254,65500.004,82903.51,102355.01,123854.51,147402,172997.5,200641,230332.5,0.0061035156,0.006257057,0.006412506,0.0065698624,0.006729126,0.006890297,0.0070533752,0.007218361,0.007385254,0.007724762,0.008071899,0.008426666,0.0087890625,0.009159088,0.009536743,0.009922028,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.014663696,0.015625,0.016616821,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.25787354,0.29058838,0.32525635,0.40045166,0.48345947,0.5742798,0.6729126,0.7793579,0.8936157,1.015686,1.1455688,1.428772,1.7432251,2.0889282,2.4658813,2.8740845,3.3135376,3.7842407,4.286194,5.38385,6.6065063,7.9541626,9.426819,11.024475,12.747131,14.594788,16.567444,20.887756,25.708069,31.028381,36.848694,43.169006,49.98932,57.30963,65.129944,82.27057,101.411194,122.55182,145.69244,170.83307,197.9737,227.11432,258.25494,326.5362,402.81744,487.0987,579.37994,679.6612,787.94244,904.2237,1028.5049,1301.0674,1605.6299,1942.1924,2310.755,2711.3174,3143.88,3608.4424,4105.005,5194.13,6411.255,7756.38,9229.505,10830.63,12559.755,14416.88,16402.006,20756.256,25622.506,31000.756,36891.004,43293.254,50207.504,57633.754,65572.01,82984.51,102445.01,123953.51,147510,173114.5,200767,230467.5,0.0061035156,0.004807472,0.004673004,0.0045404434,0.00440979,0.004281044,0.0041542053,0.004029274,0.00390625,0.003665924,0.0034332275,0.0032081604,0.0029907227,0.0027809143,0.0025787354,0.0023841858,0.0021972656,0.0018463135,0.0015258789,0.0012359619,0.0009765625,0.00074768066,0.0005493164,0.00038146973,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,1.0959473,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,3.6936035,4.717041,5.8654785,7.138916,8.5373535,10.060791,11.7092285,13.482666,15.3811035,19.552979,24.224854,29.396729,35.068604,41.24048,47.912354,55.08423,62.756104,79.59985,98.4436,119.28735,142.1311,166.97485,193.8186,222.66235,253.5061,321.1936,396.8811,480.5686,572.2561,671.9436,779.6311,895.3186,1019.0061,1290.3811,1593.7561,1929.1311,2296.506,2695.881,3127.256,3590.631,4086.006,5172.756,6387.506,7730.256,9201.006,10799.756,12526.506,14381.256,16364.006,20713.506,25575.006,30948.506,36834.008,43231.508,50141.008,57562.508,65496.008,82899.01,102350.01,123849.01,147396,172991,200634,230325,0.007385254,0.0075540543,0.007724762,0.007897377,0.008071899,0.008248329,0.008426666,0.008606911,0.0087890625,0.009159088,0.009536743,0.009922028,0.010314941,0.010715485,0.011123657,0.011539459,0.011962891,0.012832642,0.01373291,0.014663696,0.015625,0.016616821,0.01763916,0.018692017,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.1623535,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,3.8146973,4.3186035,5.420166,6.6467285,7.998291,9.4748535,11.076416,12.8029785,14.654541,16.631104,20.959229,25.787354,31.115479,36.943604,43.27173,50.099854,57.42798,65.2561,82.41235,101.5686,122.72485,145.8811,171.03735,198.1936,227.34985,258.5061,326.8186,403.1311,487.4436,579.7561,680.0686,788.3811,904.6936,1029.0061,1301.6311,1606.2561,1942.8811,2311.506,2712.131,3144.756,3609.381,4106.006,5195.256,6412.506,7757.756,9231.006,10832.256,12561.506,14418.756,16404.006,20758.506,25625.006,31003.506,36894.008,43296.508,50211.008,57637.508,65576.01,82989.01,102450.01,123959.01,147516,173121,200774,230475,0.007385254,0.0059518814,0.0058021545,0.005654335,0.005508423,0.005364418,0.0052223206,0.0050821304,0.0049438477,0.004673004,0.00440979,0.0041542053,0.00390625,0.003665924,0.0034332275,0.0032081604,0.0029907227,0.0025787354,0.0021972656,0.0018463135,0.0015258789,0.0012359619,0.0009765625,0.00074768066,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.038146973,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.22711182,0.29058838,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,1.0796509,1.3550415,1.6616821,1.9995728,2.3687134,2.769104,3.2007446,3.6636353,4.6831665,5.8276978,7.097229,8.49176,10.0112915,11.655823,13.425354,15.319885,19.483948,24.14801,29.312073,34.976135,41.140198,47.80426,54.968323,62.632385,79.46051,98.288635,119.11676,141.94489,166.77301,193.60114,222.42926,253.25739,320.91364,396.5699,480.22614,571.8824,671.53864,779.1949,894.85114,1018.5074,1289.8198,1593.1323,1928.4448,2295.7573,2695.0698,3126.3823,3589.6948,4085.0073,5171.6323,6386.2573,7728.8823,9199.508,10798.133,12524.758,14379.383,16362.008,20711.258,25572.508,30945.758,36831.008,43228.258,50137.508,57558.758,65492.008,82894.51,102345.01,123843.51,147390,172984.5,200627,230317.5,0.0087890625,0.008973122,0.009159088,0.009346962,0.009536743,0.009728432,0.009922028,0.010117531,0.010314941,0.010715485,0.011123657,0.011539459,0.011962891,0.012393951,0.012832642,0.013278961,0.01373291,0.014663696,0.015625,0.016616821,0.01763916,0.018692017,0.01977539,0.020889282,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.30767822,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,0.9234009,1.0474243,1.1792603,1.4663696,1.784729,2.1343384,2.5151978,2.9273071,3.3706665,3.8452759,4.3511353,5.456604,6.6870728,8.0425415,9.52301,11.128479,12.858948,14.7144165,16.694885,21.030823,25.86676,31.202698,37.038635,43.374573,50.21051,57.546448,65.382385,82.55426,101.726135,122.89801,146.06989,171.24176,198.41364,227.58551,258.7574,327.10114,403.4449,487.78864,580.1324,680.47614,788.8199,905.16364,1029.5073,1302.1948,1606.8823,1943.5698,2312.2573,2712.9448,3145.6323,3610.3198,4107.0073,5196.3823,6413.7573,7759.1323,9232.508,10833.883,12563.258,14420.633,16406.008,20760.758,25627.508,31006.258,36897.008,43299.758,50214.508,57641.258,65580.01,82993.51,102455.01,123964.51,147522,173127.5,200781,230482.5,0.0087890625,0.007218361,0.0070533752,0.006890297,0.006729126,0.0065698624,0.006412506,0.006257057,0.0061035156,0.0058021545,0.005508423,0.0052223206,0.0049438477,0.004673004,0.00440979,0.0041542053,0.00390625,0.0034332275,0.0029907227,0.0025787354,0.0021972656,0.0018463135,0.0015258789,0.0012359619,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.649414,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,15.258789,19.41504,24.07129,29.22754,34.88379,41.04004,47.69629,54.85254,62.50879,79.32129,98.13379,118.94629,141.75879,166.57129,193.38379,222.19629,253.00879,320.6338,396.2588,479.8838,571.5088,671.1338,778.7588,894.3838,1018.0088,1289.2588,1592.5088,1927.7588,2295.0088,2694.2588,3125.5088,3588.7588,4084.0088,5170.509,6385.009,7727.509,9198.009,10796.509,12523.009,14377.509,16360.009,20709.008,25570.008,30943.008,36828.008,43225.008,50134.008,57555.008,65488.008,82890.01,102340.01,123838.01,147384.02,172978.02,200620.02,230310.02,0.010314941,0.010514259,0.010715485,0.010918617,0.011123657,0.011330605,0.011539459,0.011750221,0.011962891,0.012393951,0.012832642,0.013278961,0.01373291,0.0141944885,0.014663696,0.015140533,0.015625,0.016616821,0.01763916,0.018692017,0.01977539,0.020889282,0.022033691,0.023208618,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,14.774414,16.75879,21.10254,25.94629,31.29004,37.13379,43.47754,50.32129,57.66504,65.50879,82.69629,101.88379,123.07129,146.25879,171.44629,198.63379,227.82129,259.0088,327.3838,403.7588,488.1338,580.5088,680.8838,789.2588,905.6338,1030.0088,1302.7588,1607.5088,1944.2588,2313.0088,2713.7588,3146.5088,3611.2588,4108.009,5197.509,6415.009,7760.509,9234.009,10835.509,12565.009,14422.509,16408.008,20763.008,25630.008,31009.008,36900.008,43303.008,50218.008,57645.008,65584.01,82998.01,102460.01,123970.01,147528.02,173134.02,200788.02,230490.02,0.010314941,0.008606911,0.008426666,0.008248329,0.008071899,0.007897377,0.007724762,0.0075540543,0.007385254,0.0070533752,0.006729126,0.006412506,0.0061035156,0.0058021545,0.005508423,0.0052223206,0.0049438477,0.00440979,0.00390625,0.0034332275,0.0029907227,0.0025787354,0.0021972656,0.0018463135,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0015258789,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.032287598,0.04449463,0.058654785,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.21246338,0.27398682,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,1.0474243,1.3189087,1.6216431,1.9556274,2.3208618,2.7173462,3.1450806,3.604065,4.6157837,5.7525024,7.014221,8.40094,9.912659,11.549377,13.311096,15.197815,19.346252,23.99469,29.143127,34.791565,40.940002,47.58844,54.736877,62.385315,79.18219,97.979065,118.77594,141.57281,166.36969,193.16656,221.96344,252.76031,320.35406,395.9478,479.54156,571.1353,670.72906,778.3228,893.91656,1017.5103,1288.6978,1591.8853,1927.0728,2294.2603,2693.4478,3124.6353,3587.8228,4083.0103,5169.3853,6383.7603,7726.1353,9196.511,10794.886,12521.261,14375.636,16358.011,20706.76,25567.51,30940.26,36825.01,43221.76,50130.51,57551.26,65484.01,82885.51,102335.01,123832.51,147378.02,172971.52,200613.02,230302.52,0.011962891,0.012177467,0.012393951,0.012612343,0.012832642,0.013054848,0.013278961,0.013504982,0.01373291,0.0141944885,0.014663696,0.015140533,0.015625,0.016117096,0.016616821,0.017124176,0.01763916,0.018692017,0.01977539,0.020889282,0.022033691,0.023208618,0.024414062,0.025650024,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.112854004,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.25787354,0.29058838,0.32525635,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,0.9536743,1.0796509,1.21344,1.5044556,1.8267212,2.1802368,2.5650024,2.981018,3.4282837,3.9067993,4.416565,5.529846,6.7681274,8.131409,9.61969,11.232971,12.971252,14.834534,16.822815,21.174377,26.02594,31.377502,37.229065,43.580627,50.43219,57.783752,65.635315,82.83844,102.041565,123.24469,146.44781,171.65094,198.85406,228.05719,259.2603,327.66656,404.0728,488.47906,580.8853,681.29156,789.6978,906.10406,1030.5103,1303.3228,1608.1353,1944.9478,2313.7603,2714.5728,3147.3853,3612.1978,4109.0103,5198.6353,6416.2603,7761.8853,9235.511,10837.136,12566.761,14424.386,16410.01,20765.26,25632.51,31011.76,36903.01,43306.26,50221.51,57648.76,65588.01,83002.51,102465.01,123975.51,147534.02,173140.52,200795.02,230497.52,0.011962891,0.010117531,0.009922028,0.009728432,0.009536743,0.009346962,0.009159088,0.008973122,0.0087890625,0.008426666,0.008071899,0.007724762,0.007385254,0.0070533752,0.006729126,0.006412506,0.0061035156,0.005508423,0.0049438477,0.00440979,0.00390625,0.0034332275,0.0029907227,0.0025787354,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,1.0314941,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,3.574463,4.5822754,5.715088,6.9729004,8.355713,9.863525,11.496338,13.25415,15.136963,19.277588,23.918213,29.058838,34.699463,40.840088,47.480713,54.621338,62.261963,79.04321,97.82446,118.60571,141.38696,166.16821,192.94946,221.73071,252.51196,320.07446,395.63696,479.19946,570.76196,670.32446,777.88696,893.44946,1017.01196,1288.137,1591.262,1926.387,2293.512,2692.637,3123.762,3586.887,4082.012,5168.2617,6382.5117,7724.7617,9195.012,10793.262,12519.512,14373.762,16356.012,20704.512,25565.012,30937.512,36822.01,43218.51,50127.01,57547.51,65480.01,82881.016,102330.016,123827.016,147372.02,172965.02,200606.02,230295.02,0.01373291,0.013962746,0.0141944885,0.014428139,0.014663696,0.014901161,0.015140533,0.015381813,0.015625,0.016117096,0.016616821,0.017124176,0.01763916,0.018161774,0.018692017,0.019229889,0.01977539,0.020889282,0.022033691,0.023208618,0.024414062,0.025650024,0.026916504,0.028213501,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.2307129,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,3.9377441,4.449463,5.5666504,6.808838,8.176025,9.668213,11.2854,13.027588,14.894775,16.886963,21.246338,26.105713,31.465088,37.324463,43.683838,50.543213,57.902588,65.76196,82.98071,102.19946,123.41821,146.63696,171.85571,199.07446,228.29321,259.51196,327.94946,404.38696,488.82446,581.26196,681.69946,790.13696,906.57446,1031.012,1303.887,1608.762,1945.637,2314.512,2715.387,3148.262,3613.137,4110.0117,5199.7617,6417.5117,7763.2617,9237.012,10838.762,12568.512,14426.262,16412.012,20767.512,25635.012,31014.512,36906.01,43309.51,50225.01,57652.51,65592.016,83007.016,102470.016,123981.016,147540.02,173147.02,200802.02,230505.02,0.01373291,0.011750221,0.011539459,0.011330605,0.011123657,0.010918617,0.010715485,0.010514259,0.010314941,0.009922028,0.009536743,0.009159088,0.0087890625,0.008426666,0.008071899,0.007724762,0.007385254,0.006729126,0.0061035156,0.005508423,0.0049438477,0.00440979,0.00390625,0.0034332275,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.0005493164,0.0015258789,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.026916504,0.038146973,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.19830322,0.25787354,0.32525635,0.40045166,0.48345947,0.5742798,0.6729126,0.7793579,1.015686,1.2832642,1.5820923,1.9121704,2.2734985,2.6660767,3.0899048,3.544983,4.548889,5.6777954,6.9317017,8.310608,9.814514,11.44342,13.197327,15.076233,19.209045,23.841858,28.97467,34.607483,40.740295,47.373108,54.50592,62.138733,78.90436,97.66998,118.43561,141.20123,165.96686,192.73248,221.49811,252.26373,319.79498,395.32623,478.85748,570.38873,669.92,777.45123,892.9825,1016.51373,1287.5762,1590.6387,1925.7012,2292.7637,2691.8262,3122.8887,3585.9512,4081.0137,5167.1387,6381.2637,7723.3887,9193.514,10791.639,12517.764,14371.889,16354.014,20702.264,25562.514,30934.764,36819.016,43215.266,50123.516,57543.766,65476.016,82876.516,102325.016,123821.516,147366.02,172958.52,200599.02,230287.52,0.015625,0.015870094,0.016117096,0.016366005,0.016616821,0.016869545,0.017124176,0.017380714,0.01763916,0.018161774,0.018692017,0.019229889,0.01977539,0.020328522,0.020889282,0.021457672,0.022033691,0.023208618,0.024414062,0.025650024,0.026916504,0.028213501,0.029541016,0.030899048,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.112854004,0.12359619,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.30767822,0.34332275,0.3809204,0.4619751,0.5508423,0.647522,0.75201416,0.86431885,0.98443604,1.1123657,1.2481079,1.5430298,1.8692017,2.2266235,2.6152954,3.0352173,3.4863892,3.968811,4.482483,5.6035767,6.8496704,8.220764,9.716858,11.337952,13.084045,14.955139,16.951233,21.31842,26.185608,31.552795,37.419983,43.78717,50.654358,58.021545,65.88873,83.12311,102.35748,123.59186,146.82623,172.06061,199.29498,228.52936,259.76373,328.23248,404.70123,489.16998,581.63873,682.1075,790.57623,907.045,1031.5137,1304.4512,1609.3887,1946.3262,2315.2637,2716.2012,3149.1387,3614.0762,4111.0137,5200.8887,6418.7637,7764.6387,9238.514,10840.389,12570.264,14428.139,16414.014,20769.764,25637.514,31017.264,36909.016,43312.766,50228.516,57656.266,65596.016,83011.516,102475.016,123986.516,147546.02,173153.52,200809.02,230512.52,0.015625,0.013504982,0.013278961,0.013054848,0.012832642,0.012612343,0.012393951,0.012177467,0.011962891,0.011539459,0.011123657,0.010715485,0.010314941,0.009922028,0.009536743,0.009159088,0.0087890625,0.008071899,0.007385254,0.006729126,0.0061035156,0.005508423,0.0049438477,0.00440979,0.00390625,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,78.765625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,252.01562,319.51562,395.01562,478.51562,570.0156,669.5156,777.0156,892.5156,1016.0156,1287.0156,1590.0156,1925.0156,2292.0156,2691.0156,3122.0156,3585.0156,4080.0156,5166.0156,6380.0156,7722.0156,9192.016,10790.016,12516.016,14370.016,16352.016,20700.016,25560.016,30932.016,36816.016,43212.016,50120.016,57540.016,65472.016,82872.016,102320.016,123816.016,147360.02,172952.02,200592.02,230280.02,0.01763916,0.017899513,0.018161774,0.018425941,0.018692017,0.01896,0.019229889,0.019501686,0.01977539,0.020328522,0.020889282,0.021457672,0.022033691,0.02261734,0.023208618,0.023807526,0.024414062,0.025650024,0.026916504,0.028213501,0.029541016,0.030899048,0.032287598,0.033706665,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.058654785,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,228.76562,260.01562,328.51562,405.01562,489.51562,582.0156,682.5156,791.0156,907.5156,1032.0156,1305.0156,1610.0156,1947.0156,2316.0156,2717.0156,3150.0156,3615.0156,4112.0156,5202.0156,6420.0156,7766.0156,9240.016,10842.016,12572.016,14430.016,16416.016,20772.016,25640.016,31020.016,36912.016,43316.016,50232.016,57660.016,65600.016,83016.016,102480.016,123992.016,147552.02,173160.02,200816.02,230520.02,0.01977539,0.017380714,0.017124176,0.016869545,0.016616821,0.016366005,0.016117096,0.015870094,0.015625,0.015140533,0.014663696,0.0141944885,0.01373291,0.013278961,0.012832642,0.012393951,0.011962891,0.011123657,0.010314941,0.009536743,0.0087890625,0.008071899,0.007385254,0.006729126,0.0061035156,0.0049438477,0.00390625,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.17797852,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.96899414,1.2307129,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,4.449463,5.5666504,6.808838,8.176025,9.668213,11.2854,13.027588,14.894775,19.00415,23.613525,28.7229,34.332275,40.44165,47.051025,54.1604,61.769775,78.488525,97.207275,117.926025,140.64478,165.36353,192.08228,220.80103,251.51978,318.95728,394.39478,477.83228,569.2698,668.7073,776.1448,891.5823,1015.0198,1285.8948,1588.7698,1923.6448,2290.5198,2689.3948,3120.2698,3583.1448,4078.0198,5163.7695,6377.5195,7719.2695,9189.02,10786.77,12512.52,14366.27,16348.02,20695.52,25555.02,30926.52,36810.02,43205.52,50113.02,57532.52,65464.02,82863.02,102310.02,123805.02,147348.02,172939.02,200578.02,230265.02,0.022033691,0.022324562,0.02261734,0.022912025,0.023208618,0.023507118,0.023807526,0.02410984,0.024414062,0.025028229,0.025650024,0.02627945,0.026916504,0.027561188,0.028213501,0.028873444,0.029541016,0.030899048,0.032287598,0.033706665,0.03515625,0.036636353,0.038146973,0.03968811,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.058654785,0.0625,0.066467285,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.1623535,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,3.574463,4.062744,4.5822754,5.715088,6.9729004,8.355713,9.863525,11.496338,13.25415,15.136963,17.144775,21.5354,26.426025,31.81665,37.707275,44.0979,50.988525,58.37915,66.269775,83.551025,102.832275,124.113525,147.39478,172.67603,199.95728,229.23853,260.51978,329.08228,405.64478,490.20728,582.7698,683.3323,791.8948,908.4573,1033.0198,1306.1448,1611.2698,1948.3948,2317.5198,2718.6448,3151.7698,3616.8948,4114.0195,5204.2695,6422.5195,7768.7695,9243.02,10845.27,12575.52,14433.77,16420.02,20776.52,25645.02,31025.52,36918.02,43322.52,50239.02,57667.52,65608.02,83025.02,102490.02,124003.02,147564.02,173173.02,200830.02,230535.02,0.024414062,0.021744728,0.021457672,0.021172523,0.020889282,0.020607948,0.020328522,0.020051003,0.01977539,0.019229889,0.018692017,0.018161774,0.01763916,0.017124176,0.016616821,0.016117096,0.015625,0.014663696,0.01373291,0.012832642,0.011962891,0.011123657,0.010314941,0.009536743,0.0087890625,0.007385254,0.0061035156,0.0049438477,0.00390625,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,4.383789,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,14.774414,18.868164,23.461914,28.555664,34.149414,40.243164,46.836914,53.930664,61.524414,78.211914,96.899414,117.586914,140.27441,164.96191,191.64941,220.33691,251.02441,318.3994,393.7744,477.1494,568.5244,667.8994,775.2744,890.6494,1014.0244,1284.7744,1587.5244,1922.2744,2289.0244,2687.7744,3118.5244,3581.2744,4076.0244,5161.5244,6375.0244,7716.5244,9186.024,10783.524,12509.024,14362.524,16344.024,20691.023,25550.023,30921.023,36804.023,43199.023,50106.023,57525.023,65456.023,82854.02,102300.02,123794.02,147336.03,172926.03,200564.03,230250.03,0.026916504,0.027237892,0.027561188,0.02788639,0.028213501,0.028542519,0.028873444,0.029206276,0.029541016,0.030216217,0.030899048,0.031589508,0.032287598,0.032993317,0.033706665,0.034427643,0.03515625,0.036636353,0.038146973,0.03968811,0.041259766,0.04286194,0.04449463,0.046157837,0.047851562,0.051330566,0.05493164,0.058654785,0.0625,0.066467285,0.07055664,0.07476807,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,4.649414,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,15.258789,17.274414,21.680664,26.586914,31.993164,37.899414,44.305664,51.211914,58.618164,66.524414,83.836914,103.149414,124.461914,147.77441,173.08691,200.39941,229.71191,261.0244,329.6494,406.2744,490.8994,583.5244,684.1494,792.7744,909.3994,1034.0244,1307.2744,1612.5244,1949.7744,2319.0244,2720.2744,3153.5244,3618.7744,4116.0244,5206.5244,6425.0244,7771.5244,9246.024,10848.524,12579.024,14437.524,16424.023,20781.023,25650.023,31031.023,36924.023,43329.023,50246.023,57675.023,65616.02,83034.02,102500.02,124014.02,147576.03,173186.03,200844.03,230550.03,0.029541016,0.026597023,0.02627945,0.025963783,0.025650024,0.025338173,0.025028229,0.024720192,0.024414062,0.023807526,0.023208618,0.02261734,0.022033691,0.021457672,0.020889282,0.020328522,0.01977539,0.018692017,0.01763916,0.016616821,0.015625,0.014663696,0.01373291,0.012832642,0.011962891,0.010314941,0.0087890625,0.007385254,0.0061035156,0.0049438477,0.00390625,0.0029907227,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.15258789,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.90844727,1.1623535,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,4.3186035,5.420166,6.6467285,7.998291,9.4748535,11.076416,12.8029785,14.654541,18.732666,23.310791,28.388916,33.96704,40.045166,46.62329,53.701416,61.27954,77.93579,96.59204,117.24829,139.90454,164.56079,191.21704,219.87329,250.52954,317.84204,393.15454,476.46704,567.77954,667.09204,774.40454,889.71704,1013.02954,1283.6545,1586.2795,1920.9045,2287.5295,2686.1545,3116.7795,3579.4045,4074.0295,5159.2793,6372.5293,7713.7793,9183.029,10780.279,12505.529,14358.779,16340.029,20686.53,25545.03,30915.53,36798.03,43192.53,50099.03,57517.53,65448.03,82845.03,102290.03,123783.03,147324.03,172913.03,200550.03,230235.03,0.032287598,0.032639503,0.032993317,0.033349037,0.033706665,0.0340662,0.034427643,0.034790993,0.03515625,0.035892487,0.036636353,0.037387848,0.038146973,0.038913727,0.03968811,0.040470123,0.041259766,0.04286194,0.04449463,0.046157837,0.047851562,0.049575806,0.051330566,0.053115845,0.05493164,0.058654785,0.0625,0.066467285,0.07055664,0.07476807,0.07910156,0.08355713,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.2307129,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,3.6936035,4.1896973,4.717041,5.8654785,7.138916,8.5373535,10.060791,11.7092285,13.482666,15.3811035,17.404541,21.826416,26.748291,32.170166,38.09204,44.513916,51.43579,58.857666,66.77954,84.12329,103.46704,124.81079,148.15454,173.49829,200.84204,230.18579,261.52954,330.21704,406.90454,491.59204,584.27954,684.96704,793.65454,910.34204,1035.0295,1308.4045,1613.7795,1951.1545,2320.5295,2721.9045,3155.2795,3620.6545,4118.0293,5208.7793,6427.5293,7774.2793,9249.029,10851.779,12582.529,14441.279,16428.03,20785.53,25655.03,31036.53,36930.03,43335.53,50253.03,57682.53,65624.03,83043.03,102510.03,124025.03,147588.03,173199.03,200858.03,230565.03,0.03515625,0.0319376,0.031589508,0.031243324,0.030899048,0.030556679,0.030216217,0.029877663,0.029541016,0.028873444,0.028213501,0.027561188,0.026916504,0.02627945,0.025650024,0.025028229,0.024414062,0.023208618,0.022033691,0.020889282,0.01977539,0.018692017,0.01763916,0.016616821,0.015625,0.01373291,0.011962891,0.010314941,0.0087890625,0.007385254,0.0061035156,0.0049438477,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,18.597656,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,61.035156,77.66016,96.28516,116.91016,139.53516,164.16016,190.78516,219.41016,250.03516,317.28516,392.53516,475.78516,567.03516,666.28516,773.53516,888.78516,1012.03516,1282.5352,1585.0352,1919.5352,2286.0352,2684.5352,3115.0352,3577.5352,4072.0352,5157.035,6370.035,7711.035,9180.035,10777.035,12502.035,14355.035,16336.035,20682.035,25540.035,30910.035,36792.035,43186.035,50092.035,57510.035,65440.035,82836.03,102280.03,123772.03,147312.03,172900.03,200536.03,230220.03,0.038146973,0.038529396,0.038913727,0.039299965,0.03968811,0.040078163,0.040470123,0.04086399,0.041259766,0.042057037,0.04286194,0.04367447,0.04449463,0.04532242,0.046157837,0.047000885,0.047851562,0.049575806,0.051330566,0.053115845,0.05493164,0.056777954,0.058654785,0.060562134,0.0625,0.066467285,0.07055664,0.07476807,0.07910156,0.08355713,0.088134766,0.09283447,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,59.097656,67.03516,84.41016,103.78516,125.16016,148.53516,173.91016,201.28516,230.66016,262.03516,330.78516,407.53516,492.28516,585.03516,685.78516,794.53516,911.28516,1036.0352,1309.5352,1615.0352,1952.5352,2322.0352,2723.5352,3157.0352,3622.5352,4120.035,5211.035,6430.035,7777.035,9252.035,10855.035,12586.035,14445.035,16432.035,20790.035,25660.035,31042.035,36936.035,43342.035,50260.035,57690.035,65632.03,83052.03,102520.03,124036.03,147600.03,173212.03,200872.03,230580.03,0.041259766,0.037766457,0.037387848,0.037011147,0.036636353,0.036263466,0.035892487,0.035523415,0.03515625,0.034427643,0.033706665,0.032993317,0.032287598,0.031589508,0.030899048,0.030216217,0.029541016,0.028213501,0.026916504,0.025650024,0.024414062,0.023208618,0.022033691,0.020889282,0.01977539,0.01763916,0.015625,0.01373291,0.011962891,0.010314941,0.0087890625,0.007385254,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.0061035156,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.12915039,0.17797852,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.8498535,1.0959473,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,4.1896973,5.275635,6.4865723,7.82251,9.283447,10.869385,12.580322,14.41626,18.463135,23.01001,28.056885,33.60376,39.650635,46.19751,53.244385,60.79126,77.38501,95.97876,116.57251,139.16626,163.76001,190.35376,218.94751,249.54126,316.72876,391.91626,475.10376,566.29126,665.47876,772.66626,887.85376,1011.04126,1281.4163,1583.7913,1918.1663,2284.5413,2682.9163,3113.2913,3575.6663,4070.0413,5154.791,6367.541,7708.291,9177.041,10773.791,12498.541,14351.291,16332.041,20677.541,25535.041,30904.541,36786.043,43179.543,50085.043,57502.543,65432.043,82827.04,102270.04,123761.04,147300.05,172887.05,200522.05,230205.05,0.04449463,0.04490757,0.04532242,0.045739174,0.046157837,0.046578407,0.047000885,0.04742527,0.047851562,0.04870987,0.049575806,0.05044937,0.051330566,0.05221939,0.053115845,0.054019928,0.05493164,0.056777954,0.058654785,0.060562134,0.0625,0.064468384,0.066467285,0.068496704,0.07055664,0.07476807,0.07910156,0.08355713,0.088134766,0.09283447,0.09765625,0.1026001,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.45141602,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.1623535,1.3010254,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,3.8146973,4.3186035,4.85376,6.0178223,7.306885,8.720947,10.26001,11.924072,13.713135,15.627197,17.66626,22.119385,27.07251,32.525635,38.47876,44.931885,51.88501,59.338135,67.29126,84.69751,104.10376,125.51001,148.91626,174.32251,201.72876,231.13501,262.54126,331.35376,408.16626,492.97876,585.79126,686.60376,795.41626,912.22876,1037.0413,1310.6663,1616.2913,1953.9163,2323.5413,2725.1663,3158.7913,3624.4163,4122.041,5213.291,6432.541,7779.791,9255.041,10858.291,12589.541,14448.791,16436.041,20794.541,25665.041,31047.541,36942.043,43348.543,50267.043,57697.543,65640.04,83061.04,102530.04,124047.04,147612.05,173225.05,200886.05,230595.05,0.047851562,0.044083595,0.04367447,0.04326725,0.04286194,0.042458534,0.042057037,0.041657448,0.041259766,0.040470123,0.03968811,0.038913727,0.038146973,0.037387848,0.036636353,0.035892487,0.03515625,0.033706665,0.032287598,0.030899048,0.029541016,0.028213501,0.026916504,0.025650024,0.024414062,0.022033691,0.01977539,0.01763916,0.015625,0.01373291,0.011962891,0.010314941,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,4.1259766,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,14.297852,18.329102,22.860352,27.891602,33.42285,39.4541,45.98535,53.0166,60.54785,77.11035,95.67285,116.23535,138.79785,163.36035,189.92285,218.48535,249.04785,316.17285,391.29785,474.42285,565.54785,664.67285,771.79785,886.92285,1010.04785,1280.2979,1582.5479,1916.7979,2283.0479,2681.2979,3111.5479,3573.7979,4068.0479,5152.548,6365.048,7705.548,9174.048,10770.548,12495.048,14347.548,16328.048,20673.047,25530.047,30899.047,36780.047,43173.047,50078.047,57495.047,65424.047,82818.05,102260.05,123750.05,147288.05,172874.05,200508.05,230190.05,0.051330566,0.051774025,0.05221939,0.052666664,0.053115845,0.053566933,0.054019928,0.05447483,0.05493164,0.055850983,0.056777954,0.057712555,0.058654785,0.059604645,0.060562134,0.061527252,0.0625,0.064468384,0.066467285,0.068496704,0.07055664,0.072647095,0.07476807,0.076919556,0.07910156,0.08355713,0.088134766,0.09283447,0.09765625,0.1026001,0.107666016,0.112854004,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,4.9228516,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,15.750977,17.797852,22.266602,27.235352,32.7041,38.67285,45.1416,52.11035,59.5791,67.54785,84.98535,104.42285,125.86035,149.29785,174.73535,202.17285,231.61035,263.04785,331.92285,408.79785,493.67285,586.54785,687.42285,796.29785,913.17285,1038.0479,1311.7979,1617.5479,1955.2979,2325.0479,2726.7979,3160.5479,3626.2979,4124.048,5215.548,6435.048,7782.548,9258.048,10861.548,12593.048,14452.548,16440.047,20799.047,25670.047,31053.047,36948.047,43355.047,50274.047,57705.047,65648.05,83070.05,102540.05,124058.05,147624.05,173238.05,200900.05,230610.05,0.05493164,0.050889015,0.05044937,0.050011635,0.049575806,0.049141884,0.04870987,0.048279762,0.047851562,0.047000885,0.046157837,0.04532242,0.04449463,0.04367447,0.04286194,0.042057037,0.041259766,0.03968811,0.038146973,0.036636353,0.03515625,0.033706665,0.032287598,0.030899048,0.029541016,0.026916504,0.024414062,0.022033691,0.01977539,0.01763916,0.015625,0.01373291,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0021972656,0.0061035156,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.107666016,0.15258789,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.7932129,1.0314941,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,4.062744,5.1330566,6.328369,7.6486816,9.093994,10.664307,12.359619,14.179932,18.195557,22.711182,27.726807,33.24243,39.258057,45.77368,52.789307,60.30493,76.83618,95.36743,115.89868,138.42993,162.96118,189.49243,218.02368,248.55493,315.61743,390.67993,473.74243,564.80493,663.86743,770.92993,885.99243,1009.05493,1279.1799,1581.3049,1915.4299,2281.555,2679.68,3109.805,3571.93,4066.055,5150.3047,6362.5547,7702.8047,9171.055,10767.305,12491.555,14343.805,16324.055,20668.555,25525.055,30893.555,36774.055,43166.555,50071.055,57487.555,65416.055,82809.055,102250.055,123739.055,147276.06,172861.06,200494.06,230175.06,0.058654785,0.05912876,0.059604645,0.060082436,0.060562134,0.06104374,0.061527252,0.062012672,0.0625,0.06348038,0.064468384,0.06546402,0.066467285,0.06747818,0.068496704,0.06952286,0.07055664,0.072647095,0.07476807,0.076919556,0.07910156,0.08131409,0.08355713,0.08583069,0.088134766,0.09283447,0.09765625,0.1026001,0.107666016,0.112854004,0.11816406,0.12359619,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.45141602,0.49438477,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.2307129,1.373291,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,3.9377441,4.449463,4.9924316,6.172119,7.4768066,8.906494,10.461182,12.140869,13.945557,15.875244,17.929932,22.414307,27.398682,32.883057,38.86743,45.351807,52.33618,59.820557,67.80493,85.27368,104.74243,126.21118,149.67993,175.14868,202.61743,232.08618,263.55493,332.49243,409.42993,494.36743,587.30493,688.24243,797.17993,914.11743,1039.0549,1312.9299,1618.8049,1956.6799,2326.555,2728.43,3162.305,3628.18,4126.0547,5217.8047,6437.5547,7785.3047,9261.055,10864.805,12596.555,14456.305,16444.055,20803.555,25675.055,31058.555,36954.055,43361.555,50281.055,57712.555,65656.055,83079.055,102550.055,124069.055,147636.06,173251.06,200914.06,230625.06,0.0625,0.058182716,0.057712555,0.0572443,0.056777954,0.056313515,0.055850983,0.055390358,0.05493164,0.054019928,0.053115845,0.05221939,0.051330566,0.05044937,0.049575806,0.04870987,0.047851562,0.046157837,0.04449463,0.04286194,0.041259766,0.03968811,0.038146973,0.036636353,0.03515625,0.032287598,0.029541016,0.026916504,0.024414062,0.022033691,0.01977539,0.01763916,0.015625,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,4,5.0625,6.25,7.5625,9,10.5625,12.25,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,315.0625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1008.0625,1278.0625,1580.0625,1914.0625,2280.0625,2678.0625,3108.0625,3570.0625,4064.0625,5148.0625,6360.0625,7700.0625,9168.0625,10764.0625,12488.0625,14340.0625,16320.0625,20664.062,25520.062,30888.062,36768.062,43160.062,50064.062,57480.062,65408.062,82800.06,102240.06,123728.06,147264.06,172848.06,200480.06,230160.06,0.066467285,0.06697178,0.06747818,0.06798649,0.068496704,0.06900883,0.06952286,0.070038795,0.07055664,0.07159805,0.072647095,0.073703766,0.07476807,0.07584,0.076919556,0.078006744,0.07910156,0.08131409,0.08355713,0.08583069,0.088134766,0.09046936,0.09283447,0.0952301,0.09765625,0.1026001,0.107666016,0.112854004,0.11816406,0.12359619,0.12915039,0.13482666,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.23461914,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4,4.515625,5.0625,6.25,7.5625,9,10.5625,12.25,14.0625,16,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,915.0625,1040.0625,1314.0625,1620.0625,1958.0625,2328.0625,2730.0625,3164.0625,3630.0625,4128.0625,5220.0625,6440.0625,7788.0625,9264.0625,10868.0625,12600.0625,14460.0625,16448.062,20808.062,25680.062,31064.062,36960.062,43368.062,50288.062,57720.062,65664.06,83088.06,102560.06,124080.06,147648.06,173264.06,200928.06,230640.06,0.07910156,0.07423496,0.073703766,0.07317448,0.072647095,0.07212162,0.07159805,0.07107639,0.07055664,0.06952286,0.068496704,0.06747818,0.066467285,0.06546402,0.064468384,0.06348038,0.0625,0.060562134,0.058654785,0.056777954,0.05493164,0.053115845,0.051330566,0.049575806,0.047851562,0.04449463,0.041259766,0.038146973,0.03515625,0.032287598,0.029541016,0.026916504,0.024414062,0.01977539,0.015625,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.71191406,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.8759766,4.9228516,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,17.797852,22.266602,27.235352,32.7041,38.67285,45.1416,52.11035,59.5791,76.0166,94.4541,114.8916,137.3291,161.7666,188.2041,216.6416,247.0791,313.9541,388.8291,471.7041,562.5791,661.4541,768.3291,883.2041,1006.0791,1275.8291,1577.5791,1911.3291,2277.079,2674.829,3104.579,3566.329,4060.079,5143.579,6355.079,7694.579,9162.079,10757.579,12481.079,14332.579,16312.079,20655.078,25510.078,30877.078,36756.08,43147.08,50050.08,57465.08,65392.08,82782.08,102220.08,123706.08,147240.08,172822.08,200452.08,230130.08,0.08355713,0.08412266,0.084690094,0.08525944,0.08583069,0.08640385,0.08697891,0.087555885,0.088134766,0.08929825,0.09046936,0.0916481,0.09283447,0.09402847,0.0952301,0.09643936,0.09765625,0.100112915,0.1026001,0.1051178,0.107666016,0.11024475,0.112854004,0.115493774,0.11816406,0.12359619,0.12915039,0.13482666,0.140625,0.14654541,0.15258789,0.15875244,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.23461914,0.25,0.26586914,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,4.649414,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,14.297852,16.250977,18.329102,22.860352,27.891602,33.42285,39.4541,45.98535,53.0166,60.54785,68.5791,86.1416,105.7041,127.2666,150.8291,176.3916,203.9541,233.5166,265.0791,334.2041,411.3291,496.4541,589.5791,690.7041,799.8291,916.9541,1042.0791,1316.3291,1622.5791,1960.8291,2331.079,2733.329,3167.579,3633.829,4132.079,5224.579,6445.079,7793.579,9270.079,10874.579,12607.079,14467.579,16456.078,20817.078,25690.078,31075.078,36972.08,43381.08,50302.08,57735.08,65680.08,83106.08,102580.08,124102.08,147672.08,173290.08,200956.08,230670.08,0.09765625,0.09224033,0.0916481,0.09105778,0.09046936,0.08988285,0.08929825,0.08871555,0.088134766,0.08697891,0.08583069,0.084690094,0.08355713,0.08243179,0.08131409,0.08020401,0.07910156,0.076919556,0.07476807,0.072647095,0.07055664,0.068496704,0.066467285,0.064468384,0.0625,0.058654785,0.05493164,0.051330566,0.047851562,0.04449463,0.041259766,0.038146973,0.03515625,0.029541016,0.024414062,0.01977539,0.015625,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,17.535156,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,59.097656,75.47266,93.84766,114.22266,136.59766,160.97266,187.34766,215.72266,246.09766,312.84766,387.59766,470.34766,561.09766,659.84766,766.59766,881.34766,1004.09766,1273.5977,1575.0977,1908.5977,2274.0977,2671.5977,3101.0977,3562.5977,4056.0977,5139.0977,6350.0977,7689.0977,9156.098,10751.098,12474.098,14325.098,16304.098,20646.098,25500.098,30866.098,36744.098,43134.098,50036.098,57450.098,65376.098,82764.09,102200.09,123684.09,147216.1,172796.1,200424.1,230100.1,0.1026001,0.10322666,0.10385513,0.10448551,0.1051178,0.10575199,0.10638809,0.1070261,0.107666016,0.10895157,0.11024475,0.11154556,0.112854004,0.114170074,0.115493774,0.116825104,0.11816406,0.12086487,0.12359619,0.12635803,0.12915039,0.13197327,0.13482666,0.13771057,0.140625,0.14654541,0.15258789,0.15875244,0.16503906,0.17144775,0.17797852,0.18463135,0.19140625,0.20532227,0.21972656,0.23461914,0.25,0.26586914,0.28222656,0.29907227,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,18.597656,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,61.035156,69.09766,86.72266,106.34766,127.97266,151.59766,177.22266,204.84766,234.47266,266.09766,335.34766,412.59766,497.84766,591.09766,692.34766,801.59766,918.84766,1044.0977,1318.5977,1625.0977,1963.5977,2334.0977,2736.5977,3171.0977,3637.5977,4136.0977,5229.0977,6450.0977,7799.0977,9276.098,10881.098,12614.098,14475.098,16464.098,20826.098,25700.098,31086.098,36984.098,43394.098,50316.098,57750.098,65696.09,83124.09,102600.09,124124.09,147696.1,173316.1,200984.1,230700.1,0.11816406,0.11219883,0.11154556,0.1108942,0.11024475,0.109597206,0.10895157,0.10830784,0.107666016,0.10638809,0.1051178,0.10385513,0.1026001,0.10135269,0.100112915,0.09888077,0.09765625,0.0952301,0.09283447,0.09046936,0.088134766,0.08583069,0.08355713,0.08131409,0.07910156,0.07476807,0.07055664,0.066467285,0.0625,0.058654785,0.05493164,0.051330566,0.047851562,0.041259766,0.03515625,0.029541016,0.024414062,0.01977539,0.015625,0.011962891,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.61035156,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.633789,4.649414,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,17.274414,21.680664,26.586914,31.993164,37.899414,44.305664,51.211914,58.618164,74.930664,93.243164,113.555664,135.86816,160.18066,186.49316,214.80566,245.11816,311.74316,386.36816,468.99316,559.61816,658.24316,764.86816,879.49316,1002.11816,1271.3682,1572.6182,1905.8682,2271.1182,2668.3682,3097.6182,3558.8682,4052.1182,5134.618,6345.118,7683.618,9150.118,10744.618,12467.118,14317.618,16296.118,20637.117,25490.117,30855.117,36732.117,43121.117,50022.117,57435.117,65360.117,82746.12,102180.12,123662.12,147192.12,172770.12,200396.12,230070.12,0.12359619,0.12428379,0.1249733,0.12566471,0.12635803,0.12705326,0.1277504,0.12844944,0.12915039,0.13055801,0.13197327,0.13339615,0.13482666,0.1362648,0.13771057,0.13916397,0.140625,0.14356995,0.14654541,0.14955139,0.15258789,0.1556549,0.15875244,0.1618805,0.16503906,0.17144775,0.17797852,0.18463135,0.19140625,0.19830322,0.20532227,0.21246338,0.21972656,0.23461914,0.25,0.26586914,0.28222656,0.29907227,0.31640625,0.33422852,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,4.9228516,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,14.774414,16.75879,18.868164,23.461914,28.555664,34.149414,40.243164,46.836914,53.930664,61.524414,69.618164,87.305664,106.993164,128.68066,152.36816,178.05566,205.74316,235.43066,267.11816,336.49316,413.86816,499.24316,592.61816,693.99316,803.36816,920.74316,1046.1182,1320.8682,1627.6182,1966.3682,2337.1182,2739.8682,3174.6182,3641.3682,4140.118,5233.618,6455.118,7804.618,9282.118,10887.618,12621.118,14482.618,16472.117,20835.117,25710.117,31097.117,36996.117,43407.117,50330.117,57765.117,65712.12,83142.12,102620.12,124146.12,147720.12,173342.12,201012.12,230730.12,0.140625,0.13411045,0.13339615,0.13268375,0.13197327,0.13126469,0.13055801,0.12985325,0.12915039,0.1277504,0.12635803,0.1249733,0.12359619,0.122226715,0.12086487,0.11951065,0.11816406,0.115493774,0.112854004,0.11024475,0.107666016,0.1051178,0.1026001,0.100112915,0.09765625,0.09283447,0.088134766,0.08355713,0.07910156,0.07476807,0.07055664,0.066467285,0.0625,0.05493164,0.047851562,0.041259766,0.03515625,0.029541016,0.024414062,0.01977539,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,74.390625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,244.14062,310.64062,385.14062,467.64062,558.1406,656.6406,763.1406,877.6406,1000.1406,1269.1406,1570.1406,1903.1406,2268.1406,2665.1406,3094.1406,3555.1406,4048.1406,5130.1406,6340.1406,7678.1406,9144.141,10738.141,12460.141,14310.141,16288.141,20628.14,25480.14,30844.14,36720.14,43108.14,50008.14,57420.14,65344.14,82728.14,102160.14,123640.14,147168.14,172744.14,200368.14,230040.14,0.14654541,0.14729404,0.14804459,0.14879704,0.14955139,0.15030766,0.15106583,0.1518259,0.15258789,0.15411758,0.1556549,0.15719986,0.15875244,0.16031265,0.1618805,0.16345596,0.16503906,0.16822815,0.17144775,0.17469788,0.17797852,0.18128967,0.18463135,0.18800354,0.19140625,0.19830322,0.20532227,0.21246338,0.21972656,0.22711182,0.23461914,0.24224854,0.25,0.26586914,0.28222656,0.29907227,0.31640625,0.33422852,0.35253906,0.3713379,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.25,2.640625,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,236.39062,268.14062,337.64062,415.14062,500.64062,594.1406,695.6406,805.1406,922.6406,1048.1406,1323.1406,1630.1406,1969.1406,2340.1406,2743.1406,3178.1406,3645.1406,4144.1406,5238.1406,6460.1406,7810.1406,9288.141,10894.141,12628.141,14490.141,16480.14,20844.14,25720.14,31108.14,37008.14,43420.14,50344.14,57780.14,65728.14,83160.14,102640.14,124168.14,147744.14,173368.14,201040.14,230760.14,0.16503906,0.1579752,0.15719986,0.15642643,0.1556549,0.15488529,0.15411758,0.15335178,0.15258789,0.15106583,0.14955139,0.14804459,0.14654541,0.14505386,0.14356995,0.14209366,0.140625,0.13771057,0.13482666,0.13197327,0.12915039,0.12635803,0.12359619,0.12086487,0.11816406,0.112854004,0.107666016,0.1026001,0.09765625,0.09283447,0.088134766,0.08355713,0.07910156,0.07055664,0.0625,0.05493164,0.047851562,0.041259766,0.03515625,0.029541016,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.024414062,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.51660156,0.71191406,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,3.399414,4.383789,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,16.75879,21.10254,25.94629,31.29004,37.13379,43.47754,50.32129,57.66504,73.85254,92.04004,112.22754,134.41504,158.60254,184.79004,212.97754,243.16504,309.54004,383.91504,466.29004,556.66504,655.04004,761.41504,875.79004,998.16504,1266.915,1567.665,1900.415,2265.165,2661.915,3090.665,3551.415,4044.165,5125.665,6335.165,7672.665,9138.165,10731.665,12453.165,14302.665,16280.165,20619.164,25470.164,30833.164,36708.164,43095.164,49994.164,57405.164,65328.164,82710.164,102140.164,123618.164,147144.17,172718.17,200340.17,230010.17,0.17144775,0.17225742,0.173069,0.17388248,0.17469788,0.17551517,0.17633438,0.1771555,0.17797852,0.17963028,0.18128967,0.1829567,0.18463135,0.18631363,0.18800354,0.18970108,0.19140625,0.19483948,0.19830322,0.20179749,0.20532227,0.20887756,0.21246338,0.21607971,0.21972656,0.22711182,0.23461914,0.24224854,0.25,0.25787354,0.26586914,0.27398682,0.28222656,0.29907227,0.31640625,0.33422852,0.35253906,0.3713379,0.390625,0.4104004,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.8056641,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,4.649414,5.2041016,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,15.258789,17.274414,19.41504,24.07129,29.22754,34.88379,41.04004,47.69629,54.85254,62.50879,70.66504,88.47754,108.29004,130.10254,153.91504,179.72754,207.54004,237.35254,269.16504,338.79004,416.41504,502.04004,595.66504,697.29004,806.91504,924.54004,1050.165,1325.415,1632.665,1971.915,2343.165,2746.415,3181.665,3648.915,4148.165,5242.665,6465.165,7815.665,9294.165,10900.665,12635.165,14497.665,16488.164,20853.164,25730.164,31119.164,37020.164,43433.164,50358.164,57795.164,65744.164,83178.164,102660.164,124190.164,147768.17,173394.17,201068.17,230790.17,0.19140625,0.18379307,0.1829567,0.18212223,0.18128967,0.18045902,0.17963028,0.17880344,0.17797852,0.17633438,0.17469788,0.173069,0.17144775,0.16983414,0.16822815,0.16662979,0.16503906,0.1618805,0.15875244,0.1556549,0.15258789,0.14955139,0.14654541,0.14356995,0.140625,0.13482666,0.12915039,0.12359619,0.11816406,0.112854004,0.107666016,0.1026001,0.09765625,0.088134766,0.07910156,0.07055664,0.0625,0.05493164,0.047851562,0.041259766,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,16.503906,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,57.191406,73.31641,91.44141,111.56641,133.6914,157.8164,183.9414,212.0664,242.1914,308.4414,382.6914,464.9414,555.1914,653.4414,759.6914,873.9414,996.1914,1264.6914,1565.1914,1897.6914,2262.1914,2658.6914,3087.1914,3547.6914,4040.1914,5121.1914,6330.1914,7667.1914,9132.191,10725.191,12446.191,14295.191,16272.191,20610.191,25460.191,30822.191,36696.19,43082.19,49980.19,57390.19,65312.19,82692.19,102120.19,123596.19,147120.19,172692.19,200312.19,229980.19,0.19830322,0.19917393,0.20004654,0.20092106,0.20179749,0.20267582,0.20355606,0.20443821,0.20532227,0.2070961,0.20887756,0.21066666,0.21246338,0.21426773,0.21607971,0.21789932,0.21972656,0.22340393,0.22711182,0.23085022,0.23461914,0.23841858,0.24224854,0.24610901,0.25,0.25787354,0.26586914,0.27398682,0.28222656,0.29058838,0.29907227,0.30767822,0.31640625,0.33422852,0.35253906,0.3713379,0.390625,0.4104004,0.43066406,0.45141602,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,19.691406,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,63.003906,71.19141,89.06641,108.94141,130.8164,154.6914,180.5664,208.4414,238.3164,270.1914,339.9414,417.6914,503.4414,597.1914,698.9414,808.6914,926.4414,1052.1914,1327.6914,1635.1914,1974.6914,2346.1914,2749.6914,3185.1914,3652.6914,4152.1914,5247.1914,6470.1914,7821.1914,9300.191,10907.191,12642.191,14505.191,16496.191,20862.191,25740.191,31130.191,37032.19,43446.19,50372.19,57810.19,65760.19,83196.19,102680.19,124212.19,147792.19,173420.19,201096.19,230820.19,0.21972656,0.21156406,0.21066666,0.20977116,0.20887756,0.20798588,0.2070961,0.20620823,0.20532227,0.20355606,0.20179749,0.20004654,0.19830322,0.19656754,0.19483948,0.19311905,0.19140625,0.18800354,0.18463135,0.18128967,0.17797852,0.17469788,0.17144775,0.16822815,0.16503906,0.15875244,0.15258789,0.14654541,0.140625,0.13482666,0.12915039,0.12359619,0.11816406,0.107666016,0.09765625,0.088134766,0.07910156,0.07055664,0.0625,0.05493164,0.047851562,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.0087890625,0.024414062,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.43066406,0.61035156,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,3.1728516,4.1259766,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,16.250977,20.532227,25.313477,30.594727,36.375977,42.657227,49.438477,56.719727,72.78223,90.84473,110.90723,132.96973,157.03223,183.09473,211.15723,241.21973,307.34473,381.46973,463.59473,553.7197,651.8447,757.9697,872.0947,994.2197,1262.4697,1562.7197,1894.9697,2259.2197,2655.4697,3083.7197,3543.9697,4036.2197,5116.7197,6325.2197,7661.7197,9126.22,10718.72,12439.22,14287.72,16264.22,20601.219,25450.219,30811.219,36684.22,43069.22,49966.22,57375.22,65296.22,82674.22,102100.22,123574.22,147096.22,172666.22,200284.22,229950.22,0.22711182,0.22804356,0.2289772,0.22991276,0.23085022,0.23178959,0.23273087,0.23367405,0.23461914,0.23651505,0.23841858,0.24032974,0.24224854,0.24417496,0.24610901,0.24805069,0.25,0.2539215,0.25787354,0.26185608,0.26586914,0.26991272,0.27398682,0.27809143,0.28222656,0.29058838,0.29907227,0.30767822,0.31640625,0.32525635,0.33422852,0.34332275,0.35253906,0.3713379,0.390625,0.4104004,0.43066406,0.45141602,0.47265625,0.49438477,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.8056641,1.9775391,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,4.9228516,5.493164,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,15.750977,17.797852,19.969727,24.688477,29.907227,35.625977,41.844727,48.563477,55.782227,63.500977,71.71973,89.65723,109.59473,131.53223,155.46973,181.40723,209.34473,239.28223,271.21973,341.09473,418.96973,504.84473,598.7197,700.5947,810.4697,928.3447,1054.2197,1329.9697,1637.7197,1977.4697,2349.2197,2752.9697,3188.7197,3656.4697,4156.2197,5251.7197,6475.2197,7826.7197,9306.22,10913.72,12649.22,14512.72,16504.219,20871.219,25750.219,31141.219,37044.22,43459.22,50386.22,57825.22,65776.22,83214.22,102700.22,124234.22,147816.22,173446.22,201124.22,230850.22,0.25,0.24128819,0.24032974,0.2393732,0.23841858,0.23746586,0.23651505,0.23556614,0.23461914,0.23273087,0.23085022,0.2289772,0.22711182,0.22525406,0.22340393,0.22156143,0.21972656,0.21607971,0.21246338,0.20887756,0.20532227,0.20179749,0.19830322,0.19483948,0.19140625,0.18463135,0.17797852,0.17144775,0.16503906,0.15875244,0.15258789,0.14654541,0.140625,0.12915039,0.11816406,0.107666016,0.09765625,0.088134766,0.07910156,0.07055664,0.0625,0.047851562,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.25,3.0625,4,5.0625,6.25,7.5625,9,10.5625,12.25,16,20.25,25,30.25,36,42.25,49,56.25,72.25,90.25,110.25,132.25,156.25,182.25,210.25,240.25,306.25,380.25,462.25,552.25,650.25,756.25,870.25,992.25,1260.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4032.25,5112.25,6320.25,7656.25,9120.25,10712.25,12432.25,14280.25,16256.25,20592.25,25440.25,30800.25,36672.25,43056.25,49952.25,57360.25,65280.25,82656.25,102080.25,123552.25,147072.25,172640.25,200256.25,229920.25,0.25787354,0.2588663,0.259861,0.26085758,0.26185608,0.26285648,0.2638588,0.264863,0.26586914,0.26788712,0.26991272,0.27194595,0.27398682,0.2760353,0.27809143,0.28015518,0.28222656,0.2863922,0.29058838,0.29481506,0.29907227,0.30336,0.30767822,0.31202698,0.31640625,0.32525635,0.33422852,0.34332275,0.35253906,0.36187744,0.3713379,0.3809204,0.390625,0.4104004,0.43066406,0.45141602,0.47265625,0.49438477,0.51660156,0.53930664,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,0.93847656,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.640625,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.25,7.5625,9,10.5625,12.25,14.0625,16,18.0625,20.25,25,30.25,36,42.25,49,56.25,64,72.25,90.25,110.25,132.25,156.25,182.25,210.25,240.25,272.25,342.25,420.25,506.25,600.25,702.25,812.25,930.25,1056.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,3660.25,4160.25,5256.25,6480.25,7832.25,9312.25,10920.25,12656.25,14520.25,16512.25,20880.25,25760.25,31152.25,37056.25,43472.25,50400.25,57840.25,65792.25,83232.25,102720.25,124256.25,147840.25,173472.25,201152.25,230880.25,0.31640625,0.3065958,0.3055153,0.30443668,0.30336,0.3022852,0.3012123,0.30014133,0.29907227,0.29693985,0.29481506,0.2926979,0.29058838,0.28848648,0.2863922,0.28430557,0.28222656,0.27809143,0.27398682,0.26991272,0.26586914,0.26185608,0.25787354,0.2539215,0.25,0.24224854,0.23461914,0.22711182,0.21972656,0.21246338,0.20532227,0.19830322,0.19140625,0.17797852,0.16503906,0.15258789,0.140625,0.12915039,0.11816406,0.107666016,0.09765625,0.07910156,0.0625,0.047851562,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.8476562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,15.503906,19.691406,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,71.19141,89.06641,108.94141,130.8164,154.6914,180.5664,208.4414,238.3164,304.0664,377.8164,459.5664,549.3164,647.0664,752.8164,866.5664,988.3164,1255.8164,1555.3164,1886.8164,2250.3164,2645.8164,3073.3164,3532.8164,4024.3164,5103.3164,6310.3164,7645.3164,9108.316,10699.316,12418.316,14265.316,16240.316,20574.316,25420.316,30778.316,36648.316,43030.316,49924.316,57330.316,65248.316,82620.31,102040.31,123508.31,147024.31,172588.31,200200.31,229860.31,0.32525635,0.3263712,0.32748795,0.3286066,0.32972717,0.33084965,0.33197403,0.33310032,0.33422852,0.33649063,0.33876038,0.34103775,0.34332275,0.3456154,0.34791565,0.35022354,0.35253906,0.357193,0.36187744,0.3665924,0.3713379,0.3761139,0.3809204,0.38575745,0.390625,0.40045166,0.4104004,0.4204712,0.43066406,0.440979,0.45141602,0.4619751,0.47265625,0.49438477,0.51660156,0.53930664,0.5625,0.58618164,0.61035156,0.63500977,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,0.93847656,1,1.0634766,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,18.597656,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,57.191406,65.00391,73.31641,91.44141,111.56641,133.6914,157.8164,183.9414,212.0664,242.1914,274.3164,344.5664,422.8164,509.0664,603.3164,705.5664,815.8164,934.0664,1060.3164,1336.8164,1645.3164,1985.8164,2358.3164,2762.8164,3199.3164,3667.8164,4168.3164,5265.3164,6490.3164,7843.3164,9324.316,10933.316,12670.316,14535.316,16528.316,20898.316,25780.316,31174.316,37080.316,43498.316,50428.316,57870.316,65824.31,83268.31,102760.31,124300.31,147888.31,173524.31,201208.31,230940.31,0.390625,0.37971592,0.37851334,0.37731266,0.3761139,0.37491703,0.37372208,0.37252903,0.3713379,0.36896133,0.3665924,0.3642311,0.36187744,0.3595314,0.357193,0.3548622,0.35253906,0.34791565,0.34332275,0.33876038,0.33422852,0.32972717,0.32525635,0.32081604,0.31640625,0.30767822,0.29907227,0.29058838,0.28222656,0.27398682,0.26586914,0.25787354,0.25,0.23461914,0.21972656,0.20532227,0.19140625,0.17797852,0.16503906,0.15258789,0.140625,0.11816406,0.09765625,0.07910156,0.0625,0.047851562,0.03515625,0.024414062,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,70.140625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,236.39062,301.89062,375.39062,456.89062,546.3906,643.8906,749.3906,862.8906,984.3906,1251.3906,1550.3906,1881.3906,2244.3906,2639.3906,3066.3906,3525.3906,4016.3906,5094.3906,6300.3906,7634.3906,9096.391,10686.391,12404.391,14250.391,16224.391,20556.39,25400.39,30756.39,36624.39,43004.39,49896.39,57300.39,65216.39,82584.39,102000.39,123464.39,146976.39,172536.39,200144.39,229800.39,0.40045166,0.40168858,0.4029274,0.40416813,0.40541077,0.4066553,0.40790176,0.40915012,0.4104004,0.41290665,0.41542053,0.41794205,0.4204712,0.42300797,0.42555237,0.4281044,0.43066406,0.43580627,0.440979,0.44618225,0.45141602,0.4566803,0.4619751,0.46730042,0.47265625,0.48345947,0.49438477,0.5054321,0.51660156,0.52789307,0.53930664,0.5508423,0.5625,0.58618164,0.61035156,0.63500977,0.66015625,0.685791,0.71191406,0.7385254,0.765625,0.82128906,0.87890625,0.93847656,1,1.0634766,1.1289062,1.1962891,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.25,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,74.390625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,244.14062,276.39062,346.89062,425.39062,511.89062,606.3906,708.8906,819.3906,937.8906,1064.3906,1341.3906,1650.3906,1991.3906,2364.3906,2769.3906,3206.3906,3675.3906,4176.3906,5274.3906,6500.3906,7854.3906,9336.391,10946.391,12684.391,14550.391,16544.39,20916.39,25800.39,31196.39,37104.39,43524.39,50456.39,57900.39,65856.39,83304.39,102800.39,124344.39,147936.39,173576.39,201264.39,231000.39,0.47265625,0.46064854,0.45932388,0.45800114,0.4566803,0.45536137,0.45404434,0.45272923,0.45141602,0.44879532,0.44618225,0.4435768,0.440979,0.43838882,0.43580627,0.43323135,0.43066406,0.42555237,0.4204712,0.41542053,0.4104004,0.40541077,0.40045166,0.39552307,0.390625,0.3809204,0.3713379,0.36187744,0.35253906,0.34332275,0.33422852,0.32525635,0.31640625,0.29907227,0.28222656,0.26586914,0.25,0.23461914,0.21972656,0.20532227,0.19140625,0.16503906,0.140625,0.11816406,0.09765625,0.07910156,0.0625,0.047851562,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.4414062,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,14.535156,18.597656,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,69.09766,86.72266,106.34766,127.97266,151.59766,177.22266,204.84766,234.47266,299.72266,372.97266,454.22266,543.47266,640.72266,745.97266,859.22266,980.47266,1246.9727,1545.4727,1875.9727,2238.4727,2632.9727,3059.4727,3517.9727,4008.4727,5085.4727,6290.4727,7623.4727,9084.473,10673.473,12390.473,14235.473,16208.473,20538.473,25380.473,30734.473,36600.473,42978.473,49868.473,57270.473,65184.473,82548.47,101960.47,123420.47,146928.47,172484.47,200088.47,229740.47,0.48345947,0.48481846,0.48617935,0.48754215,0.48890686,0.49027348,0.491642,0.49301243,0.49438477,0.49713516,0.4998932,0.50265884,0.5054321,0.50821304,0.5110016,0.51379776,0.51660156,0.52223206,0.52789307,0.5335846,0.53930664,0.5450592,0.5508423,0.5566559,0.5625,0.5742798,0.58618164,0.59820557,0.61035156,0.6226196,0.63500977,0.647522,0.66015625,0.685791,0.71191406,0.7385254,0.765625,0.7932129,0.82128906,0.8498535,0.87890625,0.93847656,1,1.0634766,1.1289062,1.1962891,1.265625,1.3369141,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,19.691406,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,59.097656,67.03516,75.47266,93.84766,114.22266,136.59766,160.97266,187.34766,215.72266,246.09766,278.47266,349.22266,427.97266,514.72266,609.47266,712.22266,822.97266,941.72266,1068.4727,1345.9727,1655.4727,1996.9727,2370.4727,2775.9727,3213.4727,3682.9727,4184.4727,5283.4727,6510.4727,7865.4727,9348.473,10959.473,12698.473,14565.473,16560.473,20934.473,25820.473,31218.473,37128.473,43550.473,50484.473,57930.473,65888.47,83340.47,102840.47,124388.47,147984.47,173628.47,201320.47,231060.47,0.5625,0.54939365,0.54794693,0.5465021,0.5450592,0.5436182,0.5421791,0.5407419,0.53930664,0.5364418,0.5335846,0.530735,0.52789307,0.52505875,0.52223206,0.519413,0.51660156,0.5110016,0.5054321,0.4998932,0.49438477,0.48890686,0.48345947,0.4780426,0.47265625,0.4619751,0.45141602,0.440979,0.43066406,0.4204712,0.4104004,0.40045166,0.390625,0.3713379,0.35253906,0.33422852,0.31640625,0.29907227,0.28222656,0.26586914,0.25,0.21972656,0.19140625,0.16503906,0.140625,0.11816406,0.09765625,0.07910156,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.5625,2.25,3.0625,4,5.0625,6.25,7.5625,9,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,297.5625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,976.5625,1242.5625,1540.5625,1870.5625,2232.5625,2626.5625,3052.5625,3510.5625,4000.5625,5076.5625,6280.5625,7612.5625,9072.5625,10660.5625,12376.5625,14220.5625,16192.5625,20520.562,25360.562,30712.562,36576.562,42952.562,49840.562,57240.562,65152.562,82512.56,101920.56,123376.56,146880.56,172432.56,200032.56,229680.56,0.5742798,0.57576084,0.5772438,0.5787287,0.58021545,0.58170414,0.58319473,0.58468723,0.58618164,0.5891762,0.59217834,0.59518814,0.59820557,0.6012306,0.6042633,0.6073036,0.61035156,0.61647034,0.6226196,0.62879944,0.63500977,0.6412506,0.647522,0.65382385,0.66015625,0.6729126,0.685791,0.6987915,0.71191406,0.7251587,0.7385254,0.75201416,0.765625,0.7932129,0.82128906,0.8498535,0.87890625,0.90844727,0.93847656,0.96899414,1,1.0634766,1.1289062,1.1962891,1.265625,1.3369141,1.4101562,1.4853516,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,9,10.5625,12.25,14.0625,16,18.0625,20.25,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,945.5625,1072.5625,1350.5625,1660.5625,2002.5625,2376.5625,2782.5625,3220.5625,3690.5625,4192.5625,5292.5625,6520.5625,7876.5625,9360.5625,10972.5625,12712.5625,14580.5625,16576.562,20952.562,25840.562,31240.562,37152.562,43576.562,50512.562,57960.562,65920.56,83376.56,102880.56,124432.56,148032.56,173680.56,201376.56,231120.56,0.66015625,0.6459513,0.6443825,0.6428156,0.6412506,0.63968754,0.6381264,0.6365671,0.63500977,0.6319008,0.62879944,0.6257057,0.6226196,0.61954117,0.61647034,0.61340714,0.61035156,0.6042633,0.59820557,0.59217834,0.58618164,0.58021545,0.5742798,0.56837463,0.5625,0.5508423,0.53930664,0.52789307,0.51660156,0.5054321,0.49438477,0.48345947,0.47265625,0.45141602,0.43066406,0.4104004,0.390625,0.3713379,0.35253906,0.33422852,0.31640625,0.28222656,0.25,0.21972656,0.19140625,0.16503906,0.140625,0.11816406,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.09765625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,2.0664062,2.8476562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,13.597656,17.535156,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,67.03516,84.41016,103.78516,125.16016,148.53516,173.91016,201.28516,230.66016,295.41016,368.16016,448.91016,537.66016,634.41016,739.16016,851.91016,972.66016,1238.1602,1535.6602,1865.1602,2226.6602,2620.1602,3045.6602,3503.1602,3992.6602,5067.66,6270.66,7601.66,9060.66,10647.66,12362.66,14205.66,16176.66,20502.66,25340.66,30690.66,36552.66,42926.66,49812.66,57210.66,65120.66,82476.66,101880.66,123332.66,146832.66,172380.66,199976.66,229620.66,0.6729126,0.6745157,0.67612076,0.6777277,0.67933655,0.6809473,0.68255997,0.68417454,0.685791,0.6890297,0.692276,0.69552994,0.6987915,0.7020607,0.7053375,0.708622,0.71191406,0.7185211,0.7251587,0.7318268,0.7385254,0.7452545,0.75201416,0.7588043,0.765625,0.7793579,0.7932129,0.80718994,0.82128906,0.83551025,0.8498535,0.86431885,0.87890625,0.90844727,0.93847656,0.96899414,1,1.0314941,1.0634766,1.0959473,1.1289062,1.1962891,1.265625,1.3369141,1.4101562,1.4853516,1.5625,1.6416016,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.2226562,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,18.597656,20.816406,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,61.035156,69.09766,77.66016,96.28516,116.91016,139.53516,164.16016,190.78516,219.41016,250.03516,282.66016,353.91016,433.16016,520.41016,615.66016,718.91016,830.16016,949.41016,1076.6602,1355.1602,1665.6602,2008.1602,2382.6602,2789.1602,3227.6602,3698.1602,4200.66,5301.66,6530.66,7887.66,9372.66,10985.66,12726.66,14595.66,16592.66,20970.66,25860.66,31262.66,37176.66,43602.66,50540.66,57990.66,65952.66,83412.66,102920.66,124476.66,148080.66,173732.66,201432.66,231180.66,0.765625,0.7503214,0.7486305,0.74694157,0.7452545,0.7435694,0.74188614,0.7402048,0.7385254,0.7351723,0.7318268,0.7284889,0.7251587,0.7218361,0.7185211,0.7152138,0.71191406,0.7053375,0.6987915,0.692276,0.685791,0.67933655,0.6729126,0.66651917,0.66015625,0.647522,0.63500977,0.6226196,0.61035156,0.59820557,0.58618164,0.5742798,0.5625,0.53930664,0.51660156,0.49438477,0.47265625,0.45141602,0.43066406,0.4104004,0.390625,0.35253906,0.31640625,0.28222656,0.25,0.21972656,0.19140625,0.16503906,0.140625,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,66.015625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,228.76562,293.26562,365.76562,446.26562,534.7656,631.2656,735.7656,848.2656,968.7656,1233.7656,1530.7656,1859.7656,2220.7656,2613.7656,3038.7656,3495.7656,3984.7656,5058.7656,6260.7656,7590.7656,9048.766,10634.766,12348.766,14190.766,16160.766,20484.766,25320.766,30668.766,36528.766,42900.766,49784.766,57180.766,65088.766,82440.766,101840.766,123288.766,146784.77,172328.77,199920.77,229560.77,0.7793579,0.7810831,0.7828102,0.7845392,0.78627014,0.78800297,0.7897377,0.79147434,0.7932129,0.7966957,0.80018616,0.80368423,0.80718994,0.8107033,0.81422424,0.81775284,0.82128906,0.8283844,0.83551025,0.8426666,0.8498535,0.8570709,0.86431885,0.8715973,0.87890625,0.8936157,0.90844727,0.9234009,0.93847656,0.9536743,0.96899414,0.98443604,1,1.0314941,1.0634766,1.0959473,1.1289062,1.1623535,1.1962891,1.2307129,1.265625,1.3369141,1.4101562,1.4853516,1.5625,1.6416016,1.7226562,1.8056641,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.515625,4,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,78.765625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,252.01562,284.76562,356.26562,435.76562,523.2656,618.7656,722.2656,833.7656,953.2656,1080.7656,1359.7656,1670.7656,2013.7656,2388.7656,2795.7656,3234.7656,3705.7656,4208.7656,5310.7656,6540.7656,7898.7656,9384.766,10998.766,12740.766,14610.766,16608.766,20988.766,25880.766,31284.766,37200.766,43628.766,50568.766,58020.766,65984.766,83448.766,102960.766,124520.766,148128.77,173784.77,201488.77,231240.77,0.87890625,0.862504,0.8606911,0.85888004,0.8570709,0.8552637,0.8534584,0.851655,0.8498535,0.84625626,0.8426666,0.8390846,0.83551025,0.8319435,0.8283844,0.8248329,0.82128906,0.81422424,0.80718994,0.80018616,0.7932129,0.78627014,0.7793579,0.7724762,0.765625,0.75201416,0.7385254,0.7251587,0.71191406,0.6987915,0.685791,0.6729126,0.66015625,0.63500977,0.61035156,0.58618164,0.5625,0.53930664,0.51660156,0.49438477,0.47265625,0.43066406,0.390625,0.35253906,0.31640625,0.28222656,0.25,0.21972656,0.19140625,0.140625,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.03515625,0.09765625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.7226562,2.4414062,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,12.691406,16.503906,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,65.00391,82.12891,101.25391,122.37891,145.5039,170.6289,197.7539,226.8789,291.1289,363.3789,443.6289,531.8789,628.1289,732.3789,844.6289,964.8789,1229.3789,1525.8789,1854.3789,2214.879,2607.379,3031.879,3488.379,3976.879,5049.879,6250.879,7579.879,9036.879,10621.879,12334.879,14175.879,16144.879,20466.879,25300.879,30646.879,36504.88,42874.88,49756.88,57150.88,65056.88,82404.875,101800.875,123244.875,146736.88,172276.88,199864.88,229500.88,0.8936157,0.895463,0.89731216,0.89916325,0.90101624,0.90287113,0.90472794,0.90658665,0.90844727,0.9121742,0.9159088,0.91965103,0.9234009,0.92715836,0.93092346,0.9346962,0.93847656,0.9460602,0.9536743,0.96131897,0.96899414,0.9766998,0.98443604,0.99220276,1,1.015686,1.0314941,1.0474243,1.0634766,1.0796509,1.0959473,1.1123657,1.1289062,1.1623535,1.1962891,1.2307129,1.265625,1.3010254,1.3369141,1.373291,1.4101562,1.4853516,1.5625,1.6416016,1.7226562,1.8056641,1.890625,1.9775391,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.515625,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.2226562,7.9101562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,19.691406,21.972656,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,63.003906,71.19141,79.87891,98.75391,119.62891,142.5039,167.3789,194.2539,223.1289,254.0039,286.8789,358.6289,438.3789,526.1289,621.8789,725.6289,837.3789,957.1289,1084.8789,1364.3789,1675.8789,2019.3789,2394.879,2802.379,3241.879,3713.379,4216.879,5319.879,6550.879,7909.879,9396.879,11011.879,12754.879,14625.879,16624.879,21006.879,25900.879,31306.879,37224.88,43654.88,50596.88,58050.88,66016.875,83484.875,103000.875,124564.875,148176.88,173836.88,201544.88,231300.88,1,0.9824991,0.9805641,0.978631,0.9766998,0.97477055,0.97284317,0.9709177,0.96899414,0.96515274,0.96131897,0.9574928,0.9536743,0.94986343,0.9460602,0.94226456,0.93847656,0.93092346,0.9234009,0.9159088,0.90844727,0.90101624,0.8936157,0.8862457,0.87890625,0.86431885,0.8498535,0.83551025,0.82128906,0.80718994,0.7932129,0.7793579,0.765625,0.7385254,0.71191406,0.685791,0.66015625,0.63500977,0.61035156,0.58618164,0.5625,0.51660156,0.47265625,0.43066406,0.390625,0.35253906,0.31640625,0.28222656,0.25,0.19140625,0.140625,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.5625,2.25,3.0625,4,5.0625,6.25,7.5625,9,12.25,16,20.25,25,30.25,36,42.25,49,64,81,100,121,144,169,196,225,289,361,441,529,625,729,841,961,1225,1521,1849,2209,2601,3025,3481,3969,5041,6241,7569,9025,10609,12321,14161,16129,20449,25281,30625,36481,42849,49729,57121,65025,82369,101761,123201,146689,172225,199809,229441,1.015686,1.0176554,1.0196266,1.0215998,1.0235748,1.0255518,1.0275307,1.0295115,1.0314941,1.0354652,1.039444,1.0434303,1.0474243,1.0514259,1.0554352,1.059452,1.0634766,1.0715485,1.0796509,1.0877838,1.0959473,1.1041412,1.1123657,1.1206207,1.1289062,1.1455688,1.1623535,1.1792603,1.1962891,1.21344,1.2307129,1.2481079,1.265625,1.3010254,1.3369141,1.373291,1.4101562,1.4475098,1.4853516,1.5236816,1.5625,1.6416016,1.7226562,1.8056641,1.890625,1.9775391,2.0664062,2.1572266,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.515625,3.7539062,4,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9,10.5625,12.25,14.0625,16,18.0625,20.25,22.5625,25,30.25,36,42.25,49,56.25,64,72.25,81,100,121,144,169,196,225,256,289,361,441,529,625,729,841,961,1089,1369,1681,2025,2401,2809,3249,3721,4225,5329,6561,7921,9409,11025,12769,14641,16641,21025,25921,31329,37249,43681,50625,58081,66049,83521,103041,124609,148225,173889,201601,231361,1.265625,1.2459269,1.2437477,1.2415705,1.2393951,1.2372217,1.2350502,1.2328806,1.2307129,1.2263832,1.2220612,1.2177467,1.21344,1.2091408,1.2048492,1.2005653,1.1962891,1.1877594,1.1792603,1.1707916,1.1623535,1.1539459,1.1455688,1.1372223,1.1289062,1.1123657,1.0959473,1.0796509,1.0634766,1.0474243,1.0314941,1.015686,1,0.96899414,0.93847656,0.90844727,0.87890625,0.8498535,0.82128906,0.7932129,0.765625,0.71191406,0.66015625,0.61035156,0.5625,0.51660156,0.47265625,0.43066406,0.390625,0.31640625,0.25,0.19140625,0.140625,0.09765625,0.0625,0.03515625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,11.390625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,62.015625,78.765625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,284.76562,356.26562,435.76562,523.2656,618.7656,722.2656,833.7656,953.2656,1216.2656,1511.2656,1838.2656,2197.2656,2588.2656,3011.2656,3466.2656,3953.2656,5023.2656,6221.2656,7547.2656,9001.266,10583.266,12293.266,14131.266,16097.266,20413.266,25241.266,30581.266,36433.266,42797.266,49673.266,57061.266,64961.266,82297.266,101681.266,123113.266,146593.27,172121.27,199697.27,229321.27,1.2832642,1.2854776,1.287693,1.2899103,1.2921295,1.2943506,1.2965736,1.2987986,1.3010254,1.3054848,1.3099518,1.3144264,1.3189087,1.3233986,1.3278961,1.3324013,1.3369141,1.3459625,1.3550415,1.364151,1.373291,1.3824615,1.3916626,1.4008942,1.4101562,1.428772,1.4475098,1.4663696,1.4853516,1.5044556,1.5236816,1.5430298,1.5625,1.6018066,1.6416016,1.6818848,1.7226562,1.763916,1.8056641,1.8479004,1.890625,1.9775391,2.0664062,2.1572266,2.25,2.3447266,2.4414062,2.540039,2.640625,2.8476562,3.0625,3.2851562,3.515625,3.7539062,4,4.2539062,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,74.390625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,228.76562,260.01562,293.26562,365.76562,446.26562,534.7656,631.2656,735.7656,848.2656,968.7656,1097.2656,1378.2656,1691.2656,2036.2656,2413.2656,2822.2656,3263.2656,3736.2656,4241.2656,5347.2656,6581.2656,7943.2656,9433.266,11051.266,12797.266,14671.266,16673.266,21061.266,25961.266,31373.266,37297.266,43733.266,50681.266,58141.266,66113.266,83593.266,103121.266,124697.266,148321.27,173993.27,201713.27,231481.27,1.5625,1.5406046,1.5381813,1.5357599,1.5333405,1.5309229,1.5285072,1.5260935,1.5236816,1.5188637,1.5140533,1.5092506,1.5044556,1.4996681,1.4948883,1.4901161,1.4853516,1.4758453,1.4663696,1.4569244,1.4475098,1.4381256,1.428772,1.4194489,1.4101562,1.3916626,1.373291,1.3550415,1.3369141,1.3189087,1.3010254,1.2832642,1.265625,1.2307129,1.1962891,1.1623535,1.1289062,1.0959473,1.0634766,1.0314941,1,0.93847656,0.87890625,0.82128906,0.765625,0.71191406,0.66015625,0.61035156,0.5625,0.47265625,0.390625,0.31640625,0.25,0.19140625,0.140625,0.09765625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,1,1.5625,2.25,3.0625,4,5.0625,6.25,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,280.5625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,945.5625,1207.5625,1501.5625,1827.5625,2185.5625,2575.5625,2997.5625,3451.5625,3937.5625,5005.5625,6201.5625,7525.5625,8977.5625,10557.5625,12265.5625,14101.5625,16065.5625,20377.562,25201.562,30537.562,36385.562,42745.562,49617.562,57001.562,64897.562,82225.56,101601.56,123025.56,146497.56,172017.56,199585.56,229201.56,1.5820923,1.5845499,1.5870094,1.5894709,1.5919342,1.5943995,1.5968666,1.5993357,1.6018066,1.6067543,1.6117096,1.6166725,1.6216431,1.6266212,1.631607,1.6366005,1.6416016,1.6516266,1.6616821,1.6717682,1.6818848,1.6920319,1.7022095,1.7124176,1.7226562,1.7432251,1.763916,1.784729,1.8056641,1.8267212,1.8479004,1.8692017,1.890625,1.9338379,1.9775391,2.0217285,2.0664062,2.1115723,2.1572266,2.2033691,2.25,2.3447266,2.4414062,2.540039,2.640625,2.743164,2.8476562,2.9541016,3.0625,3.2851562,3.515625,3.7539062,4,4.2539062,4.515625,4.7851562,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9,9.765625,10.5625,12.25,14.0625,16,18.0625,20.25,22.5625,25,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,297.5625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,976.5625,1105.5625,1387.5625,1701.5625,2047.5625,2425.5625,2835.5625,3277.5625,3751.5625,4257.5625,5365.5625,6601.5625,7965.5625,9457.5625,11077.5625,12825.5625,14701.5625,16705.562,21097.562,26001.562,31417.562,37345.562,43785.562,50737.562,58201.562,66177.56,83665.56,103201.56,124785.56,148417.56,174097.56,201825.56,231601.56,1.890625,1.8665323,1.8638649,1.8611994,1.8585358,1.8558741,1.8532143,1.8505564,1.8479004,1.8425941,1.8372955,1.8320045,1.8267212,1.8214455,1.8161774,1.8109169,1.8056641,1.7951813,1.784729,1.7743073,1.763916,1.7535553,1.7432251,1.7329254,1.7226562,1.7022095,1.6818848,1.6616821,1.6416016,1.6216431,1.6018066,1.5820923,1.5625,1.5236816,1.4853516,1.4475098,1.4101562,1.373291,1.3369141,1.3010254,1.265625,1.1962891,1.1289062,1.0634766,1,0.93847656,0.87890625,0.82128906,0.765625,0.66015625,0.5625,0.47265625,0.390625,0.31640625,0.25,0.19140625,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,9.765625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,58.140625,74.390625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,276.39062,346.89062,425.39062,511.89062,606.3906,708.8906,819.3906,937.8906,1198.8906,1491.8906,1816.8906,2173.8906,2562.8906,2983.8906,3436.8906,3921.8906,4987.8906,6181.8906,7503.8906,8953.891,10531.891,12237.891,14071.891,16033.891,20341.89,25161.89,30493.89,36337.89,42693.89,49561.89,56941.89,64833.89,82153.89,101521.89,122937.89,146401.89,171913.89,199473.89,229081.89,1.9121704,1.9148722,1.9175758,1.9202814,1.9229889,1.9256983,1.9284096,1.9311228,1.9338379,1.9392738,1.9447174,1.9501686,1.9556274,1.9610939,1.966568,1.9720497,1.9775391,1.9885406,1.9995728,2.0106354,2.0217285,2.0328522,2.0440063,2.055191,2.0664062,2.0889282,2.1115723,2.1343384,2.1572266,2.1802368,2.2033691,2.2266235,2.25,2.2971191,2.3447266,2.3928223,2.4414062,2.4904785,2.540039,2.590088,2.640625,2.743164,2.8476562,2.9541016,3.0625,3.1728516,3.2851562,3.399414,3.515625,3.7539062,4,4.2539062,4.515625,4.7851562,5.0625,5.3476562,5.640625,6.25,6.890625,7.5625,8.265625,9,9.765625,10.5625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,78.765625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,236.39062,268.14062,301.89062,375.39062,456.89062,546.3906,643.8906,749.3906,862.8906,984.3906,1113.8906,1396.8906,1711.8906,2058.8906,2437.8906,2848.8906,3291.8906,3766.8906,4273.8906,5383.8906,6621.8906,7987.8906,9481.891,11103.891,12853.891,14731.891,16737.89,21133.89,26041.89,31461.89,37393.89,43837.89,50793.89,58261.89,66241.89,83737.89,103281.89,124873.89,148513.89,174201.89,201937.89,231721.89,2.25,2.22371,2.2207985,2.2178888,2.214981,2.2120752,2.2091713,2.2062693,2.2033691,2.1975746,2.1917877,2.1860085,2.1802368,2.1744728,2.1687164,2.1629677,2.1572266,2.1457672,2.1343384,2.12294,2.1115723,2.100235,2.0889282,2.077652,2.0664062,2.0440063,2.0217285,1.9995728,1.9775391,1.9556274,1.9338379,1.9121704,1.890625,1.8479004,1.8056641,1.763916,1.7226562,1.6818848,1.6416016,1.6018066,1.5625,1.4853516,1.4101562,1.3369141,1.265625,1.1962891,1.1289062,1.0634766,1,0.87890625,0.765625,0.66015625,0.5625,0.47265625,0.390625,0.31640625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.5625,1,1.5625,2.25,3.0625,4,5.0625,6.25,9,12.25,16,20.25,25,30.25,36,42.25,56.25,72.25,90.25,110.25,132.25,156.25,182.25,210.25,272.25,342.25,420.25,506.25,600.25,702.25,812.25,930.25,1190.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,3906.25,4970.25,6162.25,7482.25,8930.25,10506.25,12210.25,14042.25,16002.25,20306.25,25122.25,30450.25,36290.25,42642.25,49506.25,56882.25,64770.25,82082.25,101442.25,122850.25,146306.25,171810.25,199362.25,228962.25,2.2734985,2.2764444,2.2793922,2.282342,2.2852936,2.288247,2.2912025,2.29416,2.2971191,2.3030434,2.3089752,2.3149147,2.3208618,2.3268166,2.332779,2.338749,2.3447266,2.3567047,2.3687134,2.3807526,2.3928223,2.4049225,2.4170532,2.4292145,2.4414062,2.4658813,2.4904785,2.5151978,2.540039,2.5650024,2.590088,2.6152954,2.640625,2.6916504,2.743164,2.795166,2.8476562,2.9006348,2.9541016,3.0080566,3.0625,3.1728516,3.2851562,3.399414,3.515625,3.633789,3.7539062,3.8759766,4,4.2539062,4.515625,4.7851562,5.0625,5.3476562,5.640625,5.9414062,6.25,6.890625,7.5625,8.265625,9,9.765625,10.5625,11.390625,12.25,14.0625,16,18.0625,20.25,22.5625,25,27.5625,30.25,36,42.25,49,56.25,64,72.25,81,90.25,110.25,132.25,156.25,182.25,210.25,240.25,272.25,306.25,380.25,462.25,552.25,650.25,756.25,870.25,992.25,1122.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,3782.25,4290.25,5402.25,6642.25,8010.25,9506.25,11130.25,12882.25,14762.25,16770.25,21170.25,26082.25,31506.25,37442.25,43890.25,50850.25,58322.25,66306.25,83810.25,103362.25,124962.25,148610.25,174306.25,202050.25,231842.25,2.640625,2.6121378,2.608982,2.6058283,2.6026764,2.5995264,2.5963783,2.5932322,2.590088,2.583805,2.57753,2.5712624,2.5650024,2.5587502,2.5525055,2.5462685,2.540039,2.5276031,2.5151978,2.5028229,2.4904785,2.4781647,2.4658813,2.4536285,2.4414062,2.4170532,2.3928223,2.3687134,2.3447266,2.3208618,2.2971191,2.2734985,2.25,2.2033691,2.1572266,2.1115723,2.0664062,2.0217285,1.9775391,1.9338379,1.890625,1.8056641,1.7226562,1.6416016,1.5625,1.4853516,1.4101562,1.3369141,1.265625,1.1289062,1,0.87890625,0.765625,0.66015625,0.5625,0.47265625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.390625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,8.265625,11.390625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,54.390625,70.140625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,268.14062,337.64062,415.14062,500.64062,594.1406,695.6406,805.1406,922.6406,1181.6406,1472.6406,1795.6406,2150.6406,2537.6406,2956.6406,3407.6406,3890.6406,4952.6406,6142.6406,7460.6406,8906.641,10480.641,12182.641,14012.641,15970.641,20270.64,25082.64,30406.64,36242.64,42590.64,49450.64,56822.64,64706.64,82010.64,101362.64,122762.64,146210.64,171706.64,199250.64,228842.64,2.6660767,2.6692667,2.6724586,2.6756525,2.6788483,2.682046,2.6852455,2.688447,2.6916504,2.698063,2.704483,2.7109108,2.7173462,2.7237892,2.7302399,2.7366982,2.743164,2.7561188,2.769104,2.7821198,2.795166,2.8082428,2.82135,2.834488,2.8476562,2.8740845,2.9006348,2.9273071,2.9541016,2.981018,3.0080566,3.0352173,3.0625,3.1174316,3.1728516,3.2287598,3.2851562,3.342041,3.399414,3.4572754,3.515625,3.633789,3.7539062,3.8759766,4,4.1259766,4.2539062,4.383789,4.515625,4.7851562,5.0625,5.3476562,5.640625,5.9414062,6.25,6.5664062,6.890625,7.5625,8.265625,9,9.765625,10.5625,11.390625,12.25,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,28.890625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,74.390625,83.265625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,244.14062,276.39062,310.64062,385.14062,467.64062,558.1406,656.6406,763.1406,877.6406,1000.1406,1130.6406,1415.6406,1732.6406,2081.6406,2462.6406,2875.6406,3320.6406,3797.6406,4306.6406,5420.6406,6662.6406,8032.6406,9530.641,11156.641,12910.641,14792.641,16802.64,21206.64,26122.64,31550.64,37490.64,43942.64,50906.64,58382.64,66370.64,83882.64,103442.64,125050.64,148706.64,174410.64,202162.64,231962.64,3.0625,3.0318155,3.0284157,3.0250177,3.0216217,3.0182276,3.0148354,3.011445,3.0080566,3.0012856,2.994522,2.9877663,2.981018,2.9742775,2.9675446,2.9608192,2.9541016,2.940689,2.9273071,2.9139557,2.9006348,2.8873444,2.8740845,2.860855,2.8476562,2.82135,2.795166,2.769104,2.743164,2.7173462,2.6916504,2.6660767,2.640625,2.590088,2.540039,2.4904785,2.4414062,2.3928223,2.3447266,2.2971191,2.25,2.1572266,2.0664062,1.9775391,1.890625,1.8056641,1.7226562,1.6416016,1.5625,1.4101562,1.265625,1.1289062,1,0.87890625,0.765625,0.66015625,0.5625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.25,0.5625,1,1.5625,2.25,3.0625,4,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,264.0625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,915.0625,1173.0625,1463.0625,1785.0625,2139.0625,2525.0625,2943.0625,3393.0625,3875.0625,4935.0625,6123.0625,7439.0625,8883.0625,10455.0625,12155.0625,13983.0625,15939.0625,20235.062,25043.062,30363.062,36195.062,42539.062,49395.062,56763.062,64643.062,81939.06,101283.06,122675.06,146115.06,171603.06,199139.06,228723.06,3.0899048,3.093339,3.096775,3.100213,3.103653,3.1070948,3.1105385,3.113984,3.1174316,3.1243324,3.1312408,3.138157,3.1450806,3.1520119,3.1589508,3.1658974,3.1728516,3.1867828,3.2007446,3.214737,3.2287598,3.242813,3.256897,3.2710114,3.2851562,3.3135376,3.342041,3.3706665,3.399414,3.4282837,3.4572754,3.4863892,3.515625,3.574463,3.633789,3.6936035,3.7539062,3.8146973,3.8759766,3.9377441,4,4.1259766,4.2539062,4.383789,4.515625,4.649414,4.7851562,4.9228516,5.0625,5.3476562,5.640625,5.9414062,6.25,6.5664062,6.890625,7.2226562,7.5625,8.265625,9,9.765625,10.5625,11.390625,12.25,13.140625,14.0625,16,18.0625,20.25,22.5625,25,27.5625,30.25,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,315.0625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1008.0625,1139.0625,1425.0625,1743.0625,2093.0625,2475.0625,2889.0625,3335.0625,3813.0625,4323.0625,5439.0625,6683.0625,8055.0625,9555.0625,11183.0625,12939.0625,14823.0625,16835.062,21243.062,26163.062,31595.062,37539.062,43995.062,50963.062,58443.062,66435.06,83955.06,103523.06,125139.06,148803.06,174515.06,202275.06,232083.06,3.515625,3.4827433,3.4790993,3.4754572,3.471817,3.4681787,3.4645424,3.460908,3.4572754,3.450016,3.4427643,3.4355202,3.4282837,3.4210548,3.4138336,3.40662,3.399414,3.385025,3.3706665,3.3563385,3.342041,3.327774,3.3135376,3.2993317,3.2851562,3.256897,3.2287598,3.2007446,3.1728516,3.1450806,3.1174316,3.0899048,3.0625,3.0080566,2.9541016,2.9006348,2.8476562,2.795166,2.743164,2.6916504,2.640625,2.540039,2.4414062,2.3447266,2.25,2.1572266,2.0664062,1.9775391,1.890625,1.7226562,1.5625,1.4101562,1.265625,1.1289062,1,0.87890625,0.765625,0.5625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.140625,0.390625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,6.890625,9.765625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,50.765625,66.015625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,260.01562,328.51562,405.01562,489.51562,582.0156,682.5156,791.0156,907.5156,1164.5156,1453.5156,1774.5156,2127.5156,2512.5156,2929.5156,3378.5156,3859.5156,4917.5156,6103.5156,7417.5156,8859.516,10429.516,12127.516,13953.516,15907.516,20199.516,25003.516,30319.516,36147.516,42487.516,49339.516,56703.516,64579.516,81867.516,101203.516,122587.516,146019.52,171499.52,199027.52,228603.52,3.544983,3.5486612,3.5523415,3.5560236,3.5597076,3.5633936,3.5670815,3.5707712,3.574463,3.581852,3.5892487,3.596653,3.604065,3.6114845,3.6189117,3.6263466,3.633789,3.648697,3.6636353,3.6786041,3.6936035,3.7086334,3.7236938,3.7387848,3.7539062,3.7842407,3.8146973,3.8452759,3.8759766,3.9067993,3.9377441,3.968811,4,4.062744,4.1259766,4.1896973,4.2539062,4.3186035,4.383789,4.449463,4.515625,4.649414,4.7851562,4.9228516,5.0625,5.2041016,5.3476562,5.493164,5.640625,5.9414062,6.25,6.5664062,6.890625,7.2226562,7.5625,7.9101562,8.265625,9,9.765625,10.5625,11.390625,12.25,13.140625,14.0625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,28.890625,31.640625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,78.765625,87.890625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,252.01562,284.76562,319.51562,395.01562,478.51562,570.0156,669.5156,777.0156,892.5156,1016.0156,1147.5156,1434.5156,1753.5156,2104.5156,2487.5156,2902.5156,3349.5156,3828.5156,4339.5156,5457.5156,6703.5156,8077.5156,9579.516,11209.516,12967.516,14853.516,16867.516,21279.516,26203.516,31639.516,37587.516,44047.516,51019.516,58503.516,66499.516,84027.516,103603.516,125227.516,148899.52,174619.52,202387.52,232203.52,4,3.964921,3.9610329,3.9571466,3.9532623,3.94938,3.9454994,3.9416208,3.9377441,3.9299965,3.9222565,3.914524,3.9067993,3.8990822,3.8913727,3.8836708,3.8759766,3.860611,3.8452759,3.8299713,3.8146973,3.7994537,3.7842407,3.7690582,3.7539062,3.7236938,3.6936035,3.6636353,3.633789,3.604065,3.574463,3.544983,3.515625,3.4572754,3.399414,3.342041,3.2851562,3.2287598,3.1728516,3.1174316,3.0625,2.9541016,2.8476562,2.743164,2.640625,2.540039,2.4414062,2.3447266,2.25,2.0664062,1.890625,1.7226562,1.5625,1.4101562,1.265625,1.1289062,1,0.765625,0.5625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.0625,0.25,0.5625,1,1.5625,2.25,3.0625,4,6.25,9,12.25,16,20.25,25,30.25,36,49,64,81,100,121,144,169,196,256,324,400,484,576,676,784,900,1156,1444,1764,2116,2500,2916,3364,3844,4900,6084,7396,8836,10404,12100,13924,15876,20164,24964,30276,36100,42436,49284,56644,64516,81796,101124,122500,145924,171396,198916,228484,4.031311,4.0352335,4.039158,4.043084,4.0470123,4.0509424,4.0548744,4.0588083,4.062744,4.0706215,4.0785065,4.086399,4.0942993,4.102207,4.1101227,4.118046,4.1259766,4.141861,4.157776,4.1737213,4.1896973,4.2057037,4.2217407,4.237808,4.2539062,4.286194,4.3186035,4.3511353,4.383789,4.416565,4.449463,4.482483,4.515625,4.5822754,4.649414,4.717041,4.7851562,4.85376,4.9228516,4.9924316,5.0625,5.2041016,5.3476562,5.493164,5.640625,5.790039,5.9414062,6.0947266,6.25,6.5664062,6.890625,7.2226562,7.5625,7.9101562,8.265625,8.628906,9,9.765625,10.5625,11.390625,12.25,13.140625,14.0625,15.015625,16,18.0625,20.25,22.5625,25,27.5625,30.25,33.0625,36,42.25,49,56.25,64,72.25,81,90.25,100,121,144,169,196,225,256,289,324,400,484,576,676,784,900,1024,1156,1444,1764,2116,2500,2916,3364,3844,4356,5476,6724,8100,9604,11236,12996,14884,16900,21316,26244,31684,37636,44100,51076,58564,66564,84100,103684,125316,148996,174724,202500,232324,5.0625,5.0230265,5.01865,5.0142756,5.009903,5.0055323,5.0011635,4.9967966,4.9924316,4.9837074,4.974991,4.966282,4.9575806,4.948887,4.940201,4.9315224,4.9228516,4.905533,4.8882446,4.870987,4.85376,4.836563,4.819397,4.8022614,4.7851562,4.7510376,4.717041,4.6831665,4.649414,4.6157837,4.5822754,4.548889,4.515625,4.449463,4.383789,4.3186035,4.2539062,4.1896973,4.1259766,4.062744,4,3.8759766,3.7539062,3.633789,3.515625,3.399414,3.2851562,3.1728516,3.0625,2.8476562,2.640625,2.4414062,2.25,2.0664062,1.890625,1.7226562,1.5625,1.265625,1,0.765625,0.5625,0.390625,0.25,0.140625,0.0625,0,0.0625,0.25,0.5625,1,1.5625,2.25,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,45.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,248.0625,315.0625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1139.0625,1425.0625,1743.0625,2093.0625,2475.0625,2889.0625,3335.0625,3813.0625,4865.0625,6045.0625,7353.0625,8789.0625,10353.0625,12045.0625,13865.0625,15813.0625,20093.062,24885.062,30189.062,36005.062,42333.062,49173.062,56525.062,64389.062,81653.06,100965.06,122325.06,145733.06,171189.06,198693.06,228245.06,5.0977173,5.102128,5.1065407,5.110955,5.1153717,5.11979,5.1242104,5.1286325,5.1330566,5.1419106,5.150772,5.1596413,5.168518,5.1774025,5.1862946,5.1951942,5.2041016,5.221939,5.239807,5.2577057,5.275635,5.2935944,5.3115845,5.329605,5.3476562,5.38385,5.420166,5.456604,5.493164,5.529846,5.5666504,5.6035767,5.640625,5.715088,5.790039,5.8654785,5.9414062,6.0178223,6.0947266,6.172119,6.25,6.4072266,6.5664062,6.727539,6.890625,7.055664,7.2226562,7.3916016,7.5625,7.9101562,8.265625,8.628906,9,9.378906,9.765625,10.160156,10.5625,11.390625,12.25,13.140625,14.0625,15.015625,16,17.015625,18.0625,20.25,22.5625,25,27.5625,30.25,33.0625,36,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,297.5625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,915.0625,1040.0625,1173.0625,1463.0625,1785.0625,2139.0625,2525.0625,2943.0625,3393.0625,3875.0625,4389.0625,5513.0625,6765.0625,8145.0625,9653.0625,11289.0625,13053.0625,14945.0625,16965.062,21389.062,26325.062,31773.062,37733.062,44205.062,51189.062,58685.062,66693.06,84245.06,103845.06,125493.06,149189.06,174933.06,202725.06,232565.06,6.25,6.206132,6.2012672,6.1964045,6.1915436,6.1866846,6.1818275,6.1769724,6.172119,6.1624184,6.152725,6.1430397,6.133362,6.1236916,6.114029,6.104374,6.0947266,6.0754547,6.0562134,6.0370026,6.0178223,5.9986725,5.979553,5.9604645,5.9414062,5.9033813,5.8654785,5.8276978,5.790039,5.7525024,5.715088,5.6777954,5.640625,5.5666504,5.493164,5.420166,5.3476562,5.275635,5.2041016,5.1330566,5.0625,4.9228516,4.7851562,4.649414,4.515625,4.383789,4.2539062,4.1259766,4,3.7539062,3.515625,3.2851562,3.0625,2.8476562,2.640625,2.4414062,2.25,1.890625,1.5625,1.265625,1,0.765625,0.5625,0.390625,0.25,0.0625,0,0.0625,0.25,0.5625,1,1.5625,2.25,4,6.25,9,12.25,16,20.25,25,30.25,42.25,56.25,72.25,90.25,110.25,132.25,156.25,182.25,240.25,306.25,380.25,462.25,552.25,650.25,756.25,870.25,1122.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,3782.25,4830.25,6006.25,7310.25,8742.25,10302.25,11990.25,13806.25,15750.25,20022.25,24806.25,30102.25,35910.25,42230.25,49062.25,56406.25,64262.25,81510.25,100806.25,122150.25,145542.25,170982.25,198470.25,228006.25,6.2891235,6.2940226,6.2989235,6.3038263,6.308731,6.3136377,6.3185463,6.323457,6.328369,6.3381996,6.3480377,6.3578835,6.367737,6.377598,6.3874664,6.3973427,6.4072266,6.427017,6.4468384,6.46669,6.4865723,6.506485,6.526428,6.546402,6.5664062,6.6065063,6.6467285,6.6870728,6.727539,6.7681274,6.808838,6.8496704,6.890625,6.9729004,7.055664,7.138916,7.2226562,7.306885,7.3916016,7.4768066,7.5625,7.7353516,7.9101562,8.086914,8.265625,8.446289,8.628906,8.813477,9,9.378906,9.765625,10.160156,10.5625,10.972656,11.390625,11.816406,12.25,13.140625,14.0625,15.015625,16,17.015625,18.0625,19.140625,20.25,22.5625,25,27.5625,30.25,33.0625,36,39.0625,42.25,49,56.25,64,72.25,81,90.25,100,110.25,132.25,156.25,182.25,210.25,240.25,272.25,306.25,342.25,420.25,506.25,600.25,702.25,812.25,930.25,1056.25,1190.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,3906.25,4422.25,5550.25,6806.25,8190.25,9702.25,11342.25,13110.25,15006.25,17030.25,21462.25,26406.25,31862.25,37830.25,44310.25,51302.25,58806.25,66822.25,84390.25,104006.25,125670.25,149382.25,175142.25,202950.25,232806.25,7.5625,7.5142374,7.5088844,7.5035334,7.498184,7.492837,7.4874916,7.482148,7.4768066,7.4661293,7.4554596,7.4447975,7.434143,7.4234962,7.412857,7.4022255,7.3916016,7.3703766,7.349182,7.328018,7.306885,7.285782,7.2647095,7.2436676,7.2226562,7.180725,7.138916,7.097229,7.055664,7.014221,6.9729004,6.9317017,6.890625,6.808838,6.727539,6.6467285,6.5664062,6.4865723,6.4072266,6.328369,6.25,6.0947266,5.9414062,5.790039,5.640625,5.493164,5.3476562,5.2041016,5.0625,4.7851562,4.515625,4.2539062,4,3.7539062,3.515625,3.2851562,3.0625,2.640625,2.25,1.890625,1.5625,1.265625,1,0.765625,0.5625,0.25,0.0625,0,0.0625,0.25,0.5625,1,1.5625,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,39.0625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,232.5625,297.5625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,1105.5625,1387.5625,1701.5625,2047.5625,2425.5625,2835.5625,3277.5625,3751.5625,4795.5625,5967.5625,7267.5625,8695.5625,10251.5625,11935.5625,13747.5625,15687.5625,19951.562,24727.562,30015.562,35815.562,42127.562,48951.562,56287.562,64135.562,81367.56,100647.56,121975.56,145351.56,170775.56,198247.56,227767.56,7.60553,7.610917,7.6163063,7.6216974,7.6270905,7.6324854,7.637882,7.643281,7.6486816,7.6594887,7.6703033,7.6811256,7.6919556,7.702793,7.7136383,7.724491,7.7353516,7.7570953,7.7788696,7.8006744,7.82251,7.8443756,7.866272,7.888199,7.9101562,7.9541626,7.998291,8.0425415,8.086914,8.131409,8.176025,8.220764,8.265625,8.355713,8.446289,8.5373535,8.628906,8.720947,8.813477,8.906494,9,9.188477,9.378906,9.571289,9.765625,9.961914,10.160156,10.360352,10.5625,10.972656,11.390625,11.816406,12.25,12.691406,13.140625,13.597656,14.0625,15.015625,16,17.015625,18.0625,19.140625,20.25,21.390625,22.5625,25,27.5625,30.25,33.0625,36,39.0625,42.25,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,315.0625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,945.5625,1072.5625,1207.5625,1501.5625,1827.5625,2185.5625,2575.5625,2997.5625,3451.5625,3937.5625,4455.5625,5587.5625,6847.5625,8235.5625,9751.5625,11395.5625,13167.5625,15067.5625,17095.562,21535.562,26487.562,31951.562,37927.562,44415.562,51415.562,58927.562,66951.56,84535.56,104167.56,125847.56,149575.56,175351.56,203175.56,233047.56,9,8.947343,8.941502,8.935662,8.929825,8.923989,8.918156,8.912324,8.906494,8.89484,8.883194,8.871555,8.859924,8.848301,8.836685,8.825077,8.813477,8.790298,8.767151,8.744034,8.720947,8.697891,8.674866,8.651871,8.628906,8.583069,8.5373535,8.49176,8.446289,8.40094,8.355713,8.310608,8.265625,8.176025,8.086914,7.998291,7.9101562,7.82251,7.7353516,7.6486816,7.5625,7.3916016,7.2226562,7.055664,6.890625,6.727539,6.5664062,6.4072266,6.25,5.9414062,5.640625,5.3476562,5.0625,4.7851562,4.515625,4.2539062,4,3.515625,3.0625,2.640625,2.25,1.890625,1.5625,1.265625,1,0.5625,0.25,0.0625,0,0.0625,0.25,0.5625,1,2.25,4,6.25,9,12.25,16,20.25,25,36,49,64,81,100,121,144,169,225,289,361,441,529,625,729,841,1089,1369,1681,2025,2401,2809,3249,3721,4761,5929,7225,8649,10201,11881,13689,15625,19881,24649,29929,35721,42025,48841,56169,64009,81225,100489,121801,145161,170569,198025,227529,9.046936,9.052812,9.058689,9.0645685,9.07045,9.076333,9.082218,9.088105,9.093994,9.105778,9.117569,9.129368,9.141174,9.152988,9.16481,9.17664,9.188477,9.212173,9.235901,9.259659,9.283447,9.307266,9.331116,9.354996,9.378906,9.426819,9.4748535,9.52301,9.571289,9.61969,9.668213,9.716858,9.765625,9.863525,9.961914,10.060791,10.160156,10.26001,10.360352,10.461182,10.5625,10.766602,10.972656,11.180664,11.390625,11.602539,11.816406,12.032227,12.25,12.691406,13.140625,13.597656,14.0625,14.535156,15.015625,15.503906,16,17.015625,18.0625,19.140625,20.25,21.390625,22.5625,23.765625,25,27.5625,30.25,33.0625,36,39.0625,42.25,45.5625,49,56.25,64,72.25,81,90.25,100,110.25,121,144,169,196,225,256,289,324,361,441,529,625,729,841,961,1089,1225,1521,1849,2209,2601,3025,3481,3969,4489,5625,6889,8281,9801,11449,13225,15129,17161,21609,26569,32041,38025,44521,51529,59049,67081,84681,104329,126025,149769,175561,203401,233289,10.5625,10.505448,10.499119,10.492791,10.486465,10.480142,10.47382,10.4675,10.461182,10.448551,10.435928,10.423313,10.410706,10.398106,10.385513,10.372929,10.360352,10.33522,10.31012,10.285049,10.26001,10.235001,10.210022,10.185074,10.160156,10.110413,10.060791,10.0112915,9.961914,9.912659,9.863525,9.814514,9.765625,9.668213,9.571289,9.4748535,9.378906,9.283447,9.188477,9.093994,9,8.813477,8.628906,8.446289,8.265625,8.086914,7.9101562,7.7353516,7.5625,7.2226562,6.890625,6.5664062,6.25,5.9414062,5.640625,5.3476562,5.0625,4.515625,4,3.515625,3.0625,2.640625,2.25,1.890625,1.5625,1,0.5625,0.25,0.0625,0,0.0625,0.25,0.5625,1.5625,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,33.0625,45.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,217.5625,280.5625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,1072.5625,1350.5625,1660.5625,2002.5625,2376.5625,2782.5625,3220.5625,3690.5625,4726.5625,5890.5625,7182.5625,8602.5625,10150.5625,11826.5625,13630.5625,15562.5625,19810.562,24570.562,29842.562,35626.562,41922.562,48730.562,56050.562,63882.562,81082.56,100330.56,121626.56,144970.56,170362.56,197802.56,227290.56,10.613342,10.619706,10.626072,10.63244,10.638809,10.645181,10.651554,10.657929,10.664307,10.677067,10.689835,10.70261,10.715393,10.728184,10.740982,10.753788,10.766602,10.792252,10.817932,10.843643,10.869385,10.895157,10.920959,10.946793,10.972656,11.024475,11.076416,11.128479,11.180664,11.232971,11.2854,11.337952,11.390625,11.496338,11.602539,11.7092285,11.816406,11.924072,12.032227,12.140869,12.25,12.469727,12.691406,12.915039,13.140625,13.368164,13.597656,13.829102,14.0625,14.535156,15.015625,15.503906,16,16.503906,17.015625,17.535156,18.0625,19.140625,20.25,21.390625,22.5625,23.765625,25,26.265625,27.5625,30.25,33.0625,36,39.0625,42.25,45.5625,49,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,115.5625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,297.5625,333.0625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,976.5625,1105.5625,1242.5625,1540.5625,1870.5625,2232.5625,2626.5625,3052.5625,3510.5625,4000.5625,4522.5625,5662.5625,6930.5625,8326.5625,9850.5625,11502.5625,13282.5625,15190.5625,17226.562,21682.562,26650.562,32130.562,38122.562,44626.562,51642.562,59170.562,67210.56,84826.56,104490.56,126202.56,149962.56,175770.56,203626.56,233530.56,12.25,12.188554,12.181736,12.17492,12.168106,12.161294,12.154484,12.1476755,12.140869,12.127262,12.113663,12.100071,12.086487,12.07291,12.059341,12.04578,12.032227,12.005142,11.978088,11.951065,11.924072,11.89711,11.870178,11.843277,11.816406,11.762756,11.7092285,11.655823,11.602539,11.549377,11.496338,11.44342,11.390625,11.2854,11.180664,11.076416,10.972656,10.869385,10.766602,10.664307,10.5625,10.360352,10.160156,9.961914,9.765625,9.571289,9.378906,9.188477,9,8.628906,8.265625,7.9101562,7.5625,7.2226562,6.890625,6.5664062,6.25,5.640625,5.0625,4.515625,4,3.515625,3.0625,2.640625,2.25,1.5625,1,0.5625,0.25,0.0625,0,0.0625,0.25,1,2.25,4,6.25,9,12.25,16,20.25,30.25,42.25,56.25,72.25,90.25,110.25,132.25,156.25,210.25,272.25,342.25,420.25,506.25,600.25,702.25,812.25,1056.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,3660.25,4692.25,5852.25,7140.25,8556.25,10100.25,11772.25,13572.25,15500.25,19740.25,24492.25,29756.25,35532.25,41820.25,48620.25,55932.25,63756.25,80940.25,100172.25,121452.25,144780.25,170156.25,197580.25,227052.25,12.304749,12.311601,12.318455,12.325311,12.332169,12.339028,12.34589,12.352754,12.359619,12.373356,12.3871,12.400852,12.414612,12.428379,12.442154,12.455936,12.469727,12.49733,12.524963,12.552628,12.580322,12.6080475,12.635803,12.6635895,12.691406,12.747131,12.8029785,12.858948,12.915039,12.971252,13.027588,13.084045,13.140625,13.25415,13.368164,13.482666,13.597656,13.713135,13.829102,13.945557,14.0625,14.297852,14.535156,14.774414,15.015625,15.258789,15.503906,15.750977,16,16.503906,17.015625,17.535156,18.0625,18.597656,19.140625,19.691406,20.25,21.390625,22.5625,23.765625,25,26.265625,27.5625,28.890625,30.25,33.0625,36,39.0625,42.25,45.5625,49,52.5625,56.25,64,72.25,81,90.25,100,110.25,121,132.25,156.25,182.25,210.25,240.25,272.25,306.25,342.25,380.25,462.25,552.25,650.25,756.25,870.25,992.25,1122.25,1260.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4032.25,4556.25,5700.25,6972.25,8372.25,9900.25,11556.25,13340.25,15252.25,17292.25,21756.25,26732.25,32220.25,38220.25,44732.25,51756.25,59292.25,67340.25,84972.25,104652.25,126380.25,150156.25,175980.25,203852.25,233772.25,14.0625,13.996659,13.989353,13.982049,13.974747,13.967446,13.960148,13.952851,13.945557,13.930973,13.916397,13.901829,13.887268,13.872715,13.85817,13.843632,13.829102,13.800064,13.771057,13.742081,13.713135,13.684219,13.655334,13.62648,13.597656,13.5401,13.482666,13.425354,13.368164,13.311096,13.25415,13.197327,13.140625,13.027588,12.915039,12.8029785,12.691406,12.580322,12.469727,12.359619,12.25,12.032227,11.816406,11.602539,11.390625,11.180664,10.972656,10.766602,10.5625,10.160156,9.765625,9.378906,9,8.628906,8.265625,7.9101562,7.5625,6.890625,6.25,5.640625,5.0625,4.515625,4,3.515625,3.0625,2.25,1.5625,1,0.5625,0.25,0.0625,0,0.0625,0.5625,1.5625,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,27.5625,39.0625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,203.0625,264.0625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,1040.0625,1314.0625,1620.0625,1958.0625,2328.0625,2730.0625,3164.0625,3630.0625,4658.0625,5814.0625,7098.0625,8510.0625,10050.0625,11718.0625,13514.0625,15438.0625,19670.062,24414.062,29670.062,35438.062,41718.062,48510.062,55814.062,63630.062,80798.06,100014.06,121278.06,144590.06,169950.06,197358.06,226814.06,14.121155,14.128495,14.135838,14.143182,14.150528,14.157876,14.165226,14.172578,14.179932,14.194645,14.209366,14.224094,14.238831,14.253574,14.268326,14.283085,14.297852,14.327408,14.356995,14.386612,14.41626,14.445938,14.475647,14.505386,14.535156,14.594788,14.654541,14.7144165,14.774414,14.834534,14.894775,14.955139,15.015625,15.136963,15.258789,15.3811035,15.503906,15.627197,15.750977,15.875244,16,16.250977,16.503906,16.75879,17.015625,17.274414,17.535156,17.797852,18.0625,18.597656,19.140625,19.691406,20.25,20.816406,21.390625,21.972656,22.5625,23.765625,25,26.265625,27.5625,28.890625,30.25,31.640625,33.0625,36,39.0625,42.25,45.5625,49,52.5625,56.25,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,115.5625,126.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,315.0625,351.5625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1008.0625,1139.0625,1278.0625,1580.0625,1914.0625,2280.0625,2678.0625,3108.0625,3570.0625,4064.0625,4590.0625,5738.0625,7014.0625,8418.0625,9950.0625,11610.0625,13398.0625,15314.0625,17358.062,21830.062,26814.062,32310.062,38318.062,44838.062,51870.062,59414.062,67470.06,85118.06,104814.06,126558.06,150350.06,176190.06,204078.06,234014.06,16,15.929765,15.92197,15.914178,15.906387,15.898599,15.890812,15.883027,15.875244,15.859684,15.844131,15.828587,15.813049,15.79752,15.781998,15.766483,15.750977,15.719986,15.689026,15.658096,15.627197,15.596329,15.565491,15.534683,15.503906,15.442444,15.3811035,15.319885,15.258789,15.197815,15.136963,15.076233,15.015625,14.894775,14.774414,14.654541,14.535156,14.41626,14.297852,14.179932,14.0625,13.829102,13.597656,13.368164,13.140625,12.915039,12.691406,12.469727,12.25,11.816406,11.390625,10.972656,10.5625,10.160156,9.765625,9.378906,9,8.265625,7.5625,6.890625,6.25,5.640625,5.0625,4.515625,4,3.0625,2.25,1.5625,1,0.5625,0.25,0.0625,0,0.25,1,2.25,4,6.25,9,12.25,16,25,36,49,64,81,100,121,144,196,256,324,400,484,576,676,784,1024,1296,1600,1936,2304,2704,3136,3600,4624,5776,7056,8464,10000,11664,13456,15376,19600,24336,29584,35344,41616,48400,55696,63504,80656,99856,121104,144400,169744,197136,226576,16.062561,16.070389,16.07822,16.086052,16.093887,16.101723,16.109562,16.117401,16.125244,16.140934,16.156631,16.172337,16.18805,16.20377,16.219498,16.235233,16.250977,16.282486,16.314026,16.345596,16.377197,16.408829,16.44049,16.472183,16.503906,16.567444,16.631104,16.694885,16.75879,16.822815,16.886963,16.951233,17.015625,17.144775,17.274414,17.404541,17.535156,17.66626,17.797852,17.929932,18.0625,18.329102,18.597656,18.868164,19.140625,19.41504,19.691406,19.969727,20.25,20.816406,21.390625,21.972656,22.5625,23.160156,23.765625,24.378906,25,26.265625,27.5625,28.890625,30.25,31.640625,33.0625,34.515625,36,39.0625,42.25,45.5625,49,52.5625,56.25,60.0625,64,72.25,81,90.25,100,110.25,121,132.25,144,169,196,225,256,289,324,361,400,484,576,676,784,900,1024,1156,1296,1600,1936,2304,2704,3136,3600,4096,4624,5776,7056,8464,10000,11664,13456,15376,17424,21904,26896,32400,38416,44944,51984,59536,67600,85264,104976,126736,150544,176400,204304,234256,20.25,20.170975,20.162205,20.153435,20.144669,20.135902,20.12714,20.118378,20.10962,20.092106,20.0746,20.057102,20.039612,20.02213,20.004654,19.987186,19.969727,19.93483,19.899963,19.865128,19.830322,19.795547,19.760803,19.72609,19.691406,19.622131,19.552979,19.483948,19.41504,19.346252,19.277588,19.209045,19.140625,19.00415,18.868164,18.732666,18.597656,18.463135,18.329102,18.195557,18.0625,17.797852,17.535156,17.274414,17.015625,16.75879,16.503906,16.250977,16,15.503906,15.015625,14.535156,14.0625,13.597656,13.140625,12.691406,12.25,11.390625,10.5625,9.765625,9,8.265625,7.5625,6.890625,6.25,5.0625,4,3.0625,2.25,1.5625,1,0.5625,0.25,0,0.25,1,2.25,4,6.25,9,12.25,20.25,30.25,42.25,56.25,72.25,90.25,110.25,132.25,182.25,240.25,306.25,380.25,462.25,552.25,650.25,756.25,992.25,1260.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4556.25,5700.25,6972.25,8372.25,9900.25,11556.25,13340.25,15252.25,19460.25,24180.25,29412.25,35156.25,41412.25,48180.25,55460.25,63252.25,80372.25,99540.25,120756.25,144020.25,169332.25,196692.25,226100.25,20.320374,20.329178,20.337986,20.346794,20.355606,20.364418,20.373234,20.38205,20.39087,20.408512,20.426163,20.44382,20.461487,20.47916,20.496841,20.51453,20.532227,20.567642,20.603088,20.638565,20.674072,20.70961,20.745178,20.780777,20.816406,20.887756,20.959229,21.030823,21.10254,21.174377,21.246338,21.31842,21.390625,21.5354,21.680664,21.826416,21.972656,22.119385,22.266602,22.414307,22.5625,22.860352,23.160156,23.461914,23.765625,24.07129,24.378906,24.688477,25,25.628906,26.265625,26.910156,27.5625,28.222656,28.890625,29.566406,30.25,31.640625,33.0625,34.515625,36,37.515625,39.0625,40.640625,42.25,45.5625,49,52.5625,56.25,60.0625,64,68.0625,72.25,81,90.25,100,110.25,121,132.25,144,156.25,182.25,210.25,240.25,272.25,306.25,342.25,380.25,420.25,506.25,600.25,702.25,812.25,930.25,1056.25,1190.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,3660.25,4160.25,4692.25,5852.25,7140.25,8556.25,10100.25,11772.25,13572.25,15500.25,17556.25,22052.25,27060.25,32580.25,38612.25,45156.25,52212.25,59780.25,67860.25,85556.25,105300.25,127092.25,150932.25,176820.25,204756.25,234740.25,25,24.912186,24.90244,24.892693,24.88295,24.873207,24.863468,24.85373,24.843994,24.824528,24.805069,24.785618,24.766174,24.746738,24.72731,24.70789,24.688477,24.649673,24.6109,24.572159,24.533447,24.494766,24.456116,24.417496,24.378906,24.301819,24.224854,24.14801,24.07129,23.99469,23.918213,23.841858,23.765625,23.613525,23.461914,23.310791,23.160156,23.01001,22.860352,22.711182,22.5625,22.266602,21.972656,21.680664,21.390625,21.10254,20.816406,20.532227,20.25,19.691406,19.140625,18.597656,18.0625,17.535156,17.015625,16.503906,16,15.015625,14.0625,13.140625,12.25,11.390625,10.5625,9.765625,9,7.5625,6.25,5.0625,4,3.0625,2.25,1.5625,1,0.25,0,0.25,1,2.25,4,6.25,9,16,25,36,49,64,81,100,121,169,225,289,361,441,529,625,729,961,1225,1521,1849,2209,2601,3025,3481,4489,5625,6889,8281,9801,11449,13225,15129,19321,24025,29241,34969,41209,47961,55225,63001,80089,99225,120409,143641,168921,196249,225625,25.078186,25.087967,25.097752,25.107536,25.117325,25.127113,25.136906,25.146698,25.156494,25.17609,25.195694,25.215305,25.234924,25.25455,25.274185,25.293827,25.313477,25.352798,25.39215,25.431534,25.470947,25.510391,25.549866,25.58937,25.628906,25.708069,25.787354,25.86676,25.94629,26.02594,26.105713,26.185608,26.265625,26.426025,26.586914,26.748291,26.910156,27.07251,27.235352,27.398682,27.5625,27.891602,28.222656,28.555664,28.890625,29.22754,29.566406,29.907227,30.25,30.941406,31.640625,32.347656,33.0625,33.785156,34.515625,35.253906,36,37.515625,39.0625,40.640625,42.25,43.890625,45.5625,47.265625,49,52.5625,56.25,60.0625,64,68.0625,72.25,76.5625,81,90.25,100,110.25,121,132.25,144,156.25,169,196,225,256,289,324,361,400,441,529,625,729,841,961,1089,1225,1369,1681,2025,2401,2809,3249,3721,4225,4761,5929,7225,8649,10201,11881,13689,15625,17689,22201,27225,32761,38809,45369,52441,60025,68121,85849,105625,127449,151321,177241,205209,235225,30.25,30.153397,30.142673,30.13195,30.121231,30.110512,30.099796,30.08908,30.07837,30.05695,30.035538,30.014133,29.992737,29.971348,29.949966,29.928593,29.907227,29.864517,29.821838,29.77919,29.736572,29.693985,29.651428,29.608902,29.566406,29.481506,29.396729,29.312073,29.22754,29.143127,29.058838,28.97467,28.890625,28.7229,28.555664,28.388916,28.222656,28.056885,27.891602,27.726807,27.5625,27.235352,26.910156,26.586914,26.265625,25.94629,25.628906,25.313477,25,24.378906,23.765625,23.160156,22.5625,21.972656,21.390625,20.816406,20.25,19.140625,18.0625,17.015625,16,15.015625,14.0625,13.140625,12.25,10.5625,9,7.5625,6.25,5.0625,4,3.0625,2.25,1,0.25,0,0.25,1,2.25,4,6.25,12.25,20.25,30.25,42.25,56.25,72.25,90.25,110.25,156.25,210.25,272.25,342.25,420.25,506.25,600.25,702.25,930.25,1190.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,4422.25,5550.25,6806.25,8190.25,9702.25,11342.25,13110.25,15006.25,19182.25,23870.25,29070.25,34782.25,41006.25,47742.25,54990.25,62750.25,79806.25,98910.25,120062.25,143262.25,168510.25,195806.25,225150.25,30.335999,30.346756,30.357517,30.368279,30.379044,30.389809,30.400578,30.411346,30.42212,30.443668,30.465225,30.48679,30.508362,30.529942,30.551529,30.573124,30.594727,30.637955,30.681213,30.724503,30.767822,30.811172,30.854553,30.897964,30.941406,31.028381,31.115479,31.202698,31.29004,31.377502,31.465088,31.552795,31.640625,31.81665,31.993164,32.170166,32.347656,32.525635,32.7041,32.883057,33.0625,33.42285,33.785156,34.149414,34.515625,34.88379,35.253906,35.625977,36,36.753906,37.515625,38.285156,39.0625,39.847656,40.640625,41.441406,42.25,43.890625,45.5625,47.265625,49,50.765625,52.5625,54.390625,56.25,60.0625,64,68.0625,72.25,76.5625,81,85.5625,90.25,100,110.25,121,132.25,144,156.25,169,182.25,210.25,240.25,272.25,306.25,342.25,380.25,420.25,462.25,552.25,650.25,756.25,870.25,992.25,1122.25,1260.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,3782.25,4290.25,4830.25,6006.25,7310.25,8742.25,10302.25,11990.25,13806.25,15750.25,17822.25,22350.25,27390.25,32942.25,39006.25,45582.25,52670.25,60270.25,68382.25,86142.25,105950.25,127806.25,151710.25,177662.25,205662.25,235710.25,36,35.894608,35.882908,35.87121,35.859512,35.847816,35.836124,35.824432,35.812744,35.78937,35.766006,35.74265,35.7193,35.695957,35.672623,35.649296,35.625977,35.57936,35.532776,35.48622,35.439697,35.393204,35.34674,35.30031,35.253906,35.161194,35.068604,34.976135,34.88379,34.791565,34.699463,34.607483,34.515625,34.332275,34.149414,33.96704,33.785156,33.60376,33.42285,33.24243,33.0625,32.7041,32.347656,31.993164,31.640625,31.29004,30.941406,30.594727,30.25,29.566406,28.890625,28.222656,27.5625,26.910156,26.265625,25.628906,25,23.765625,22.5625,21.390625,20.25,19.140625,18.0625,17.015625,16,14.0625,12.25,10.5625,9,7.5625,6.25,5.0625,4,2.25,1,0.25,0,0.25,1,2.25,4,9,16,25,36,49,64,81,100,144,196,256,324,400,484,576,676,900,1156,1444,1764,2116,2500,2916,3364,4356,5476,6724,8100,9604,11236,12996,14884,19044,23716,28900,34596,40804,47524,54756,62500,79524,98596,119716,142884,168100,195364,224676,36.09381,36.105545,36.117283,36.12902,36.140762,36.152504,36.16425,36.175995,36.187744,36.211246,36.234756,36.258274,36.2818,36.305332,36.328873,36.35242,36.375977,36.42311,36.470276,36.51747,36.564697,36.611954,36.65924,36.70656,36.753906,36.848694,36.943604,37.038635,37.13379,37.229065,37.324463,37.419983,37.515625,37.707275,37.899414,38.09204,38.285156,38.47876,38.67285,38.86743,39.0625,39.4541,39.847656,40.243164,40.640625,41.04004,41.441406,41.844727,42.25,43.066406,43.890625,44.722656,45.5625,46.410156,47.265625,48.128906,49,50.765625,52.5625,54.390625,56.25,58.140625,60.0625,62.015625,64,68.0625,72.25,76.5625,81,85.5625,90.25,95.0625,100,110.25,121,132.25,144,156.25,169,182.25,196,225,256,289,324,361,400,441,484,576,676,784,900,1024,1156,1296,1444,1764,2116,2500,2916,3364,3844,4356,4900,6084,7396,8836,10404,12100,13924,15876,17956,22500,27556,33124,39204,45796,52900,60516,68644,86436,106276,128164,152100,178084,206116,236196,42.25,42.13582,42.123142,42.110466,42.097794,42.08512,42.072453,42.059784,42.04712,42.021793,41.996475,41.971165,41.94586,41.920567,41.89528,41.87,41.844727,41.794205,41.743713,41.693253,41.642822,41.592422,41.542053,41.491714,41.441406,41.34088,41.24048,41.140198,41.04004,40.940002,40.840088,40.740295,40.640625,40.44165,40.243164,40.045166,39.847656,39.650635,39.4541,39.258057,39.0625,38.67285,38.285156,37.899414,37.515625,37.13379,36.753906,36.375977,36,35.253906,34.515625,33.785156,33.0625,32.347656,31.640625,30.941406,30.25,28.890625,27.5625,26.265625,25,23.765625,22.5625,21.390625,20.25,18.0625,16,14.0625,12.25,10.5625,9,7.5625,6.25,4,2.25,1,0.25,0,0.25,1,2.25,6.25,12.25,20.25,30.25,42.25,56.25,72.25,90.25,132.25,182.25,240.25,306.25,380.25,462.25,552.25,650.25,870.25,1122.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,4290.25,5402.25,6642.25,8010.25,9506.25,11130.25,12882.25,14762.25,18906.25,23562.25,28730.25,34410.25,40602.25,47306.25,54522.25,62250.25,79242.25,98282.25,119370.25,142506.25,167690.25,194922.25,224202.25,42.351624,42.364334,42.37705,42.389763,42.40248,42.4152,42.42792,42.440643,42.45337,42.478825,42.504288,42.52976,42.555237,42.580723,42.606216,42.631718,42.657227,42.708267,42.75934,42.81044,42.861572,42.912735,42.96393,43.015152,43.066406,43.169006,43.27173,43.374573,43.47754,43.580627,43.683838,43.78717,43.890625,44.0979,44.305664,44.513916,44.722656,44.931885,45.1416,45.351807,45.5625,45.98535,46.410156,46.836914,47.265625,47.69629,48.128906,48.563477,49,49.878906,50.765625,51.660156,52.5625,53.472656,54.390625,55.316406,56.25,58.140625,60.0625,62.015625,64,66.015625,68.0625,70.140625,72.25,76.5625,81,85.5625,90.25,95.0625,100,105.0625,110.25,121,132.25,144,156.25,169,182.25,196,210.25,240.25,272.25,306.25,342.25,380.25,420.25,462.25,506.25,600.25,702.25,812.25,930.25,1056.25,1190.25,1332.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,3906.25,4422.25,4970.25,6162.25,7482.25,8930.25,10506.25,12210.25,14042.25,16002.25,18090.25,22650.25,27722.25,33306.25,39402.25,46010.25,53130.25,60762.25,68906.25,86730.25,106602.25,128522.25,152490.25,178506.25,206570.25,236682.25,49,48.87703,48.863377,48.849724,48.836075,48.822426,48.80878,48.795135,48.781494,48.754215,48.726944,48.69968,48.672424,48.645176,48.617935,48.590702,48.563477,48.50905,48.45465,48.400284,48.345947,48.29164,48.237366,48.18312,48.128906,48.02057,47.912354,47.80426,47.69629,47.58844,47.480713,47.373108,47.265625,47.051025,46.836914,46.62329,46.410156,46.19751,45.98535,45.77368,45.5625,45.1416,44.722656,44.305664,43.890625,43.47754,43.066406,42.657227,42.25,41.441406,40.640625,39.847656,39.0625,38.285156,37.515625,36.753906,36,34.515625,33.0625,31.640625,30.25,28.890625,27.5625,26.265625,25,22.5625,20.25,18.0625,16,14.0625,12.25,10.5625,9,6.25,4,2.25,1,0.25,0,0.25,1,4,9,16,25,36,49,64,81,121,169,225,289,361,441,529,625,841,1089,1369,1681,2025,2401,2809,3249,4225,5329,6561,7921,9409,11025,12769,14641,18769,23409,28561,34225,40401,47089,54289,62001,78961,97969,119025,142129,167281,194481,223729,49.109436,49.123123,49.136814,49.150505,49.1642,49.177895,49.191593,49.20529,49.218994,49.246403,49.27382,49.301243,49.328674,49.356113,49.38356,49.411015,49.438477,49.493423,49.5484,49.60341,49.658447,49.713516,49.768616,49.823746,49.878906,49.98932,50.099854,50.21051,50.32129,50.43219,50.543213,50.654358,50.765625,50.988525,51.211914,51.43579,51.660156,51.88501,52.11035,52.33618,52.5625,53.0166,53.472656,53.930664,54.390625,54.85254,55.316406,55.782227,56.25,57.191406,58.140625,59.097656,60.0625,61.035156,62.015625,63.003906,64,66.015625,68.0625,70.140625,72.25,74.390625,76.5625,78.765625,81,85.5625,90.25,95.0625,100,105.0625,110.25,115.5625,121,132.25,144,156.25,169,182.25,196,210.25,225,256,289,324,361,400,441,484,529,625,729,841,961,1089,1225,1369,1521,1849,2209,2601,3025,3481,3969,4489,5041,6241,7569,9025,10609,12321,14161,16129,18225,22801,27889,33489,39601,46225,53361,61009,69169,87025,106929,128881,152881,178929,207025,237169,56.25,56.11824,56.10361,56.08898,56.074356,56.05973,56.04511,56.030487,56.01587,55.986637,55.957413,55.928196,55.898987,55.869785,55.84059,55.811405,55.782227,55.723892,55.66559,55.607315,55.549072,55.49086,55.43268,55.374527,55.316406,55.200256,55.08423,54.968323,54.85254,54.736877,54.621338,54.50592,54.390625,54.1604,53.930664,53.701416,53.472656,53.244385,53.0166,52.789307,52.5625,52.11035,51.660156,51.211914,50.765625,50.32129,49.878906,49.438477,49,48.128906,47.265625,46.410156,45.5625,44.722656,43.890625,43.066406,42.25,40.640625,39.0625,37.515625,36,34.515625,33.0625,31.640625,30.25,27.5625,25,22.5625,20.25,18.0625,16,14.0625,12.25,9,6.25,4,2.25,1,0.25,0,0.25,2.25,6.25,12.25,20.25,30.25,42.25,56.25,72.25,110.25,156.25,210.25,272.25,342.25,420.25,506.25,600.25,812.25,1056.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,4160.25,5256.25,6480.25,7832.25,9312.25,10920.25,12656.25,14520.25,18632.25,23256.25,28392.25,34040.25,40200.25,46872.25,54056.25,61752.25,78680.25,97656.25,118680.25,141752.25,166872.25,194040.25,223256.25,56.36725,56.381912,56.39658,56.411247,56.42592,56.44059,56.455265,56.46994,56.48462,56.51398,56.54335,56.572727,56.60211,56.631504,56.660904,56.69031,56.719727,56.77858,56.837463,56.896378,56.955322,57.014297,57.073303,57.13234,57.191406,57.30963,57.42798,57.546448,57.66504,57.783752,57.902588,58.021545,58.140625,58.37915,58.618164,58.857666,59.097656,59.338135,59.5791,59.820557,60.0625,60.54785,61.035156,61.524414,62.015625,62.50879,63.003906,63.500977,64,65.00391,66.015625,67.03516,68.0625,69.09766,70.140625,71.19141,72.25,74.390625,76.5625,78.765625,81,83.265625,85.5625,87.890625,90.25,95.0625,100,105.0625,110.25,115.5625,121,126.5625,132.25,144,156.25,169,182.25,196,210.25,225,240.25,272.25,306.25,342.25,380.25,420.25,462.25,506.25,552.25,650.25,756.25,870.25,992.25,1122.25,1260.25,1406.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4032.25,4556.25,5112.25,6320.25,7656.25,9120.25,10712.25,12432.25,14280.25,16256.25,18360.25,22952.25,28056.25,33672.25,39800.25,46440.25,53592.25,61256.25,69432.25,87320.25,107256.25,129240.25,153272.25,179352.25,207480.25,237656.25,64,63.85945,63.843845,63.82824,63.812637,63.797035,63.781437,63.76584,63.750244,63.71906,63.68788,63.65671,63.62555,63.594395,63.563248,63.53211,63.500977,63.438736,63.376526,63.314346,63.252197,63.19008,63.12799,63.065933,63.003906,62.879944,62.756104,62.632385,62.50879,62.385315,62.261963,62.138733,62.015625,61.769775,61.524414,61.27954,61.035156,60.79126,60.54785,60.30493,60.0625,59.5791,59.097656,58.618164,58.140625,57.66504,57.191406,56.719727,56.25,55.316406,54.390625,53.472656,52.5625,51.660156,50.765625,49.878906,49,47.265625,45.5625,43.890625,42.25,40.640625,39.0625,37.515625,36,33.0625,30.25,27.5625,25,22.5625,20.25,18.0625,16,12.25,9,6.25,4,2.25,1,0.25,0,1,4,9,16,25,36,49,64,100,144,196,256,324,400,484,576,784,1024,1296,1600,1936,2304,2704,3136,4096,5184,6400,7744,9216,10816,12544,14400,18496,23104,28224,33856,40000,46656,53824,61504,78400,97344,118336,141376,166464,193600,222784,64.12506,64.1407,64.15634,64.17199,64.18764,64.203285,64.21893,64.23459,64.250244,64.281555,64.31288,64.34421,64.37555,64.40689,64.43825,64.469604,64.50098,64.563736,64.626526,64.68935,64.7522,64.81508,64.87799,64.94093,65.00391,65.129944,65.2561,65.382385,65.50879,65.635315,65.76196,65.88873,66.015625,66.269775,66.524414,66.77954,67.03516,67.29126,67.54785,67.80493,68.0625,68.5791,69.09766,69.618164,70.140625,70.66504,71.19141,71.71973,72.25,73.31641,74.390625,75.47266,76.5625,77.66016,78.765625,79.87891,81,83.265625,85.5625,87.890625,90.25,92.640625,95.0625,97.515625,100,105.0625,110.25,115.5625,121,126.5625,132.25,138.0625,144,156.25,169,182.25,196,210.25,225,240.25,256,289,324,361,400,441,484,529,576,676,784,900,1024,1156,1296,1444,1600,1936,2304,2704,3136,3600,4096,4624,5184,6400,7744,9216,10816,12544,14400,16384,18496,23104,28224,33856,40000,46656,53824,61504,69696,87616,107584,129600,153664,179776,207936,238144,81,80.84187,80.82431,80.806755,80.7892,80.771645,80.75409,80.73654,80.718994,80.6839,80.64882,80.61374,80.578674,80.54361,80.50856,80.47351,80.43848,80.36842,80.2984,80.22841,80.15845,80.08852,80.018616,79.948746,79.87891,79.73932,79.59985,79.46051,79.32129,79.18219,79.04321,78.90436,78.765625,78.488525,78.211914,77.93579,77.66016,77.38501,77.11035,76.83618,76.5625,76.0166,75.47266,74.930664,74.390625,73.85254,73.31641,72.78223,72.25,71.19141,70.140625,69.09766,68.0625,67.03516,66.015625,65.00391,64,62.015625,60.0625,58.140625,56.25,54.390625,52.5625,50.765625,49,45.5625,42.25,39.0625,36,33.0625,30.25,27.5625,25,20.25,16,12.25,9,6.25,4,2.25,1,0,1,4,9,16,25,36,49,81,121,169,225,289,361,441,529,729,961,1225,1521,1849,2209,2601,3025,3969,5041,6241,7569,9025,10609,12321,14161,18225,22801,27889,33489,39601,46225,53361,61009,77841,96721,117649,140625,165649,192721,221841,81.140686,81.15828,81.17587,81.193474,81.211075,81.228676,81.24628,81.263885,81.281494,81.31671,81.351944,81.38718,81.422424,81.45767,81.492935,81.5282,81.56348,81.63405,81.70465,81.77528,81.84595,81.91664,81.987366,82.05812,82.12891,82.27057,82.41235,82.55426,82.69629,82.83844,82.98071,83.12311,83.265625,83.551025,83.836914,84.12329,84.41016,84.69751,84.98535,85.27368,85.5625,86.1416,86.72266,87.305664,87.890625,88.47754,89.06641,89.65723,90.25,91.44141,92.640625,93.84766,95.0625,96.28516,97.515625,98.75391,100,102.515625,105.0625,107.640625,110.25,112.890625,115.5625,118.265625,121,126.5625,132.25,138.0625,144,150.0625,156.25,162.5625,169,182.25,196,210.25,225,240.25,256,272.25,289,324,361,400,441,484,529,576,625,729,841,961,1089,1225,1369,1521,1681,2025,2401,2809,3249,3721,4225,4761,5329,6561,7921,9409,11025,12769,14641,16641,18769,23409,28561,34225,40401,47089,54289,62001,70225,88209,108241,130321,154449,180625,208849,239121,100,99.824295,99.80478,99.78527,99.76576,99.746254,99.726746,99.707245,99.687744,99.64874,99.60976,99.57077,99.5318,99.49283,99.45387,99.41492,99.37598,99.29811,99.220276,99.14247,99.0647,98.98695,98.90924,98.83156,98.75391,98.598694,98.4436,98.288635,98.13379,97.979065,97.82446,97.66998,97.515625,97.207275,96.899414,96.59204,96.28516,95.97876,95.67285,95.36743,95.0625,94.4541,93.84766,93.243164,92.640625,92.04004,91.44141,90.84473,90.25,89.06641,87.890625,86.72266,85.5625,84.41016,83.265625,82.12891,81,78.765625,76.5625,74.390625,72.25,70.140625,68.0625,66.015625,64,60.0625,56.25,52.5625,49,45.5625,42.25,39.0625,36,30.25,25,20.25,16,12.25,9,6.25,4,1,0,1,4,9,16,25,36,64,100,144,196,256,324,400,484,676,900,1156,1444,1764,2116,2500,2916,3844,4900,6084,7396,8836,10404,12100,13924,17956,22500,27556,33124,39204,45796,52900,60516,77284,96100,116964,139876,164836,191844,220900,100.15631,100.17586,100.195404,100.21496,100.23451,100.25407,100.27362,100.29318,100.312744,100.35187,100.39101,100.430145,100.4693,100.50845,100.54762,100.58679,100.62598,100.70436,100.782776,100.86122,100.9397,101.0182,101.09674,101.17531,101.25391,101.411194,101.5686,101.726135,101.88379,102.041565,102.19946,102.35748,102.515625,102.832275,103.149414,103.46704,103.78516,104.10376,104.42285,104.74243,105.0625,105.7041,106.34766,106.993164,107.640625,108.29004,108.94141,109.59473,110.25,111.56641,112.890625,114.22266,115.5625,116.91016,118.265625,119.62891,121,123.765625,126.5625,129.39062,132.25,135.14062,138.0625,141.01562,144,150.0625,156.25,162.5625,169,175.5625,182.25,189.0625,196,210.25,225,240.25,256,272.25,289,306.25,324,361,400,441,484,529,576,625,676,784,900,1024,1156,1296,1444,1600,1764,2116,2500,2916,3364,3844,4356,4900,5476,6724,8100,9604,11236,12996,14884,16900,19044,23716,28900,34596,40804,47524,54756,62500,70756,88804,108900,131044,155236,181476,209764,240100,121,120.80672,120.78525,120.76379,120.742325,120.72086,120.6994,120.67795,120.656494,120.61359,120.570694,120.5278,120.484924,120.44205,120.399185,120.35632,120.31348,120.2278,120.14215,120.05653,119.97095,119.88539,119.799866,119.71437,119.62891,119.45807,119.28735,119.11676,118.94629,118.77594,118.60571,118.43561,118.265625,117.926025,117.586914,117.24829,116.91016,116.57251,116.23535,115.89868,115.5625,114.8916,114.22266,113.555664,112.890625,112.22754,111.56641,110.90723,110.25,108.94141,107.640625,106.34766,105.0625,103.78516,102.515625,101.25391,100,97.515625,95.0625,92.640625,90.25,87.890625,85.5625,83.265625,81,76.5625,72.25,68.0625,64,60.0625,56.25,52.5625,49,42.25,36,30.25,25,20.25,16,12.25,9,4,1,0,1,4,9,16,25,49,81,121,169,225,289,361,441,625,841,1089,1369,1681,2025,2401,2809,3721,4761,5929,7225,8649,10201,11881,13689,17689,22201,27225,32761,38809,45369,52441,60025,76729,95481,116281,139129,164025,190969,219961,121.171936,121.193436,121.214935,121.23644,121.25795,121.27946,121.300964,121.32248,121.343994,121.387024,121.43007,121.473114,121.516174,121.559235,121.60231,121.645386,121.68848,121.77467,121.8609,121.94716,122.03345,122.11977,122.206116,122.292496,122.37891,122.55182,122.72485,122.89801,123.07129,123.24469,123.41821,123.59186,123.765625,124.113525,124.461914,124.81079,125.16016,125.51001,125.86035,126.21118,126.5625,127.2666,127.97266,128.68066,129.39062,130.10254,130.8164,131.53223,132.25,133.6914,135.14062,136.59766,138.0625,139.53516,141.01562,142.5039,144,147.01562,150.0625,153.14062,156.25,159.39062,162.5625,165.76562,169,175.5625,182.25,189.0625,196,203.0625,210.25,217.5625,225,240.25,256,272.25,289,306.25,324,342.25,361,400,441,484,529,576,625,676,729,841,961,1089,1225,1369,1521,1681,1849,2209,2601,3025,3481,3969,4489,5041,5625,6889,8281,9801,11449,13225,15129,17161,19321,24025,29241,34969,41209,47961,55225,63001,71289,89401,109561,131769,156025,182329,210681,241081,144,143.78914,143.76572,143.74231,143.71889,143.69548,143.67206,143.64865,143.62524,143.57843,143.53163,143.48483,143.43805,143.39127,143.3445,143.29773,143.25098,143.15749,143.06403,142.9706,142.8772,142.78383,142.69049,142.59718,142.5039,142.31744,142.1311,141.94489,141.75879,141.57281,141.38696,141.20123,141.01562,140.64478,140.27441,139.90454,139.53516,139.16626,138.79785,138.42993,138.0625,137.3291,136.59766,135.86816,135.14062,134.41504,133.6914,132.96973,132.25,130.8164,129.39062,127.97266,126.5625,125.16016,123.765625,122.37891,121,118.265625,115.5625,112.890625,110.25,107.640625,105.0625,102.515625,100,95.0625,90.25,85.5625,81,76.5625,72.25,68.0625,64,56.25,49,42.25,36,30.25,25,20.25,16,9,4,1,0,1,4,9,16,36,64,100,144,196,256,324,400,576,784,1024,1296,1600,1936,2304,2704,3600,4624,5776,7056,8464,10000,11664,13456,17424,21904,26896,32400,38416,44944,51984,59536,76176,94864,115600,138384,163216,190096,219024,144.18756,144.21101,144.23447,144.25793,144.28139,144.30486,144.32831,144.35178,144.37524,144.42218,144.46913,144.51608,144.56305,144.61002,144.657,144.70398,144.75098,144.84499,144.93903,145.0331,145.1272,145.22133,145.31549,145.40968,145.5039,145.69244,145.8811,146.06989,146.25879,146.44781,146.63696,146.82623,147.01562,147.39478,147.77441,148.15454,148.53516,148.91626,149.29785,149.67993,150.0625,150.8291,151.59766,152.36816,153.14062,153.91504,154.6914,155.46973,156.25,157.8164,159.39062,160.97266,162.5625,164.16016,165.76562,167.3789,169,172.26562,175.5625,178.89062,182.25,185.64062,189.0625,192.51562,196,203.0625,210.25,217.5625,225,232.5625,240.25,248.0625,256,272.25,289,306.25,324,342.25,361,380.25,400,441,484,529,576,625,676,729,784,900,1024,1156,1296,1444,1600,1764,1936,2304,2704,3136,3600,4096,4624,5184,5776,7056,8464,10000,11664,13456,15376,17424,19600,24336,29584,35344,41616,48400,55696,63504,71824,90000,110224,132496,156816,183184,211600,242064,169,168.77156,168.74619,168.72083,168.69545,168.67009,168.64471,168.61935,168.594,168.54327,168.49257,168.44186,168.39117,168.34048,168.28981,168.23914,168.18848,168.08717,167.9859,167.88466,167.78345,167.68227,167.58112,167.48,167.3789,167.17682,166.97485,166.77301,166.57129,166.36969,166.16821,165.96686,165.76562,165.36353,164.96191,164.56079,164.16016,163.76001,163.36035,162.96118,162.5625,161.7666,160.97266,160.18066,159.39062,158.60254,157.8164,157.03223,156.25,154.6914,153.14062,151.59766,150.0625,148.53516,147.01562,145.5039,144,141.01562,138.0625,135.14062,132.25,129.39062,126.5625,123.765625,121,115.5625,110.25,105.0625,100,95.0625,90.25,85.5625,81,72.25,64,56.25,49,42.25,36,30.25,25,16,9,4,1,0,1,4,9,25,49,81,121,169,225,289,361,529,729,961,1225,1521,1849,2209,2601,3481,4489,5625,6889,8281,9801,11449,13225,17161,21609,26569,32041,38025,44521,51529,59049,75625,94249,114921,137641,162409,189225,218089,169.20319,169.22859,169.254,169.27942,169.30482,169.33025,169.35565,169.38107,169.4065,169.45734,169.5082,169.55905,169.60992,169.6608,169.71169,169.76257,169.81348,169.9153,170.01715,170.11903,170.22095,170.32289,170.42487,170.52687,170.6289,170.83307,171.03735,171.24176,171.44629,171.65094,171.85571,172.06061,172.26562,172.67603,173.08691,173.49829,173.91016,174.32251,174.73535,175.14868,175.5625,176.3916,177.22266,178.05566,178.89062,179.72754,180.5664,181.40723,182.25,183.9414,185.64062,187.34766,189.0625,190.78516,192.51562,194.2539,196,199.51562,203.0625,206.64062,210.25,213.89062,217.5625,221.26562,225,232.5625,240.25,248.0625,256,264.0625,272.25,280.5625,289,306.25,324,342.25,361,380.25,400,420.25,441,484,529,576,625,676,729,784,841,961,1089,1225,1369,1521,1681,1849,2025,2401,2809,3249,3721,4225,4761,5329,5929,7225,8649,10201,11881,13689,15625,17689,19881,24649,29929,35721,42025,48841,56169,64009,72361,90601,110889,133225,157609,184041,212521,243049,196,195.75398,195.72665,195.69934,195.67201,195.6447,195.61737,195.59006,195.56274,195.50812,195.4535,195.3989,195.3443,195.2897,195.23512,195.18054,195.12598,195.01686,194.90778,194.79872,194.6897,194.5807,194.47174,194.36281,194.2539,194.0362,193.8186,193.60114,193.38379,193.16656,192.94946,192.73248,192.51562,192.08228,191.64941,191.21704,190.78516,190.35376,189.92285,189.49243,189.0625,188.2041,187.34766,186.49316,185.64062,184.79004,183.9414,183.09473,182.25,180.5664,178.89062,177.22266,175.5625,173.91016,172.26562,170.6289,169,165.76562,162.5625,159.39062,156.25,153.14062,150.0625,147.01562,144,138.0625,132.25,126.5625,121,115.5625,110.25,105.0625,100,90.25,81,72.25,64,56.25,49,42.25,36,25,16,9,4,1,0,1,4,16,36,64,100,144,196,256,324,484,676,900,1156,1444,1764,2116,2500,3364,4356,5476,6724,8100,9604,11236,12996,16900,21316,26244,31684,37636,44100,51076,58564,75076,93636,114244,136900,161604,188356,217156,196.21881,196.24617,196.27353,196.3009,196.32826,196.35564,196.383,196.41037,196.43774,196.4925,196.54726,196.60202,196.6568,196.71158,196.76637,196.82117,196.87598,196.98561,197.09528,197.20497,197.3147,197.42445,197.53424,197.64406,197.7539,197.9737,198.1936,198.41364,198.63379,198.85406,199.07446,199.29498,199.51562,199.95728,200.39941,200.84204,201.28516,201.72876,202.17285,202.61743,203.0625,203.9541,204.84766,205.74316,206.64062,207.54004,208.4414,209.34473,210.25,212.0664,213.89062,215.72266,217.5625,219.41016,221.26562,223.1289,225,228.76562,232.5625,236.39062,240.25,244.14062,248.0625,252.01562,256,264.0625,272.25,280.5625,289,297.5625,306.25,315.0625,324,342.25,361,380.25,400,420.25,441,462.25,484,529,576,625,676,729,784,841,900,1024,1156,1296,1444,1600,1764,1936,2116,2500,2916,3364,3844,4356,4900,5476,6084,7396,8836,10404,12100,13924,15876,17956,20164,24964,30276,36100,42436,49284,56644,64516,72900,91204,111556,133956,158404,184900,213444,244036,225,224.7364,224.70712,224.67786,224.64857,224.61931,224.59003,224.56076,224.5315,224.47296,224.41444,224.35593,224.29742,224.23892,224.18044,224.12195,224.06348,223.94655,223.82965,223.71278,223.59595,223.47914,223.36237,223.24562,223.1289,222.89557,222.66235,222.42926,222.19629,221.96344,221.73071,221.49811,221.26562,220.80103,220.33691,219.87329,219.41016,218.94751,218.48535,218.02368,217.5625,216.6416,215.72266,214.80566,213.89062,212.97754,212.0664,211.15723,210.25,208.4414,206.64062,204.84766,203.0625,201.28516,199.51562,197.7539,196,192.51562,189.0625,185.64062,182.25,178.89062,175.5625,172.26562,169,162.5625,156.25,150.0625,144,138.0625,132.25,126.5625,121,110.25,100,90.25,81,72.25,64,56.25,49,36,25,16,9,4,1,0,1,9,25,49,81,121,169,225,289,441,625,841,1089,1369,1681,2025,2401,3249,4225,5329,6561,7921,9409,11025,12769,16641,21025,25921,31329,37249,43681,50625,58081,74529,93025,113569,136161,160801,187489,216225,225.23444,225.26375,225.29306,225.32239,225.3517,225.38103,225.41034,225.43967,225.469,225.52765,225.58632,225.64499,225.70367,225.76236,225.82106,225.87976,225.93848,226.05592,226.1734,226.29091,226.40845,226.52602,226.64362,226.76125,226.8789,227.11432,227.34985,227.58551,227.82129,228.05719,228.29321,228.52936,228.76562,229.23853,229.71191,230.18579,230.66016,231.13501,231.61035,232.08618,232.5625,233.5166,234.47266,235.43066,236.39062,237.35254,238.3164,239.28223,240.25,242.1914,244.14062,246.09766,248.0625,250.03516,252.01562,254.0039,256,260.01562,264.0625,268.14062,272.25,276.39062,280.5625,284.76562,289,297.5625,306.25,315.0625,324,333.0625,342.25,351.5625,361,380.25,400,420.25,441,462.25,484,506.25,529,576,625,676,729,784,841,900,961,1089,1225,1369,1521,1681,1849,2025,2209,2601,3025,3481,3969,4489,5041,5625,6241,7569,9025,10609,12321,14161,16129,18225,20449,25281,30625,36481,42849,49729,57121,65025,73441,91809,112225,134689,159201,185761,214369,245025,256,255.71883,255.68759,255.65637,255.62514,255.59392,255.56268,255.53146,255.50024,255.4378,255.37538,255.31296,255.25055,255.18814,255.12575,255.06335,255.00098,254.87624,254.75153,254.62685,254.5022,254.37758,254.25299,254.12843,254.0039,253.75494,253.5061,253.25739,253.00879,252.76031,252.51196,252.26373,252.01562,251.51978,251.02441,250.52954,250.03516,249.54126,249.04785,248.55493,248.0625,247.0791,246.09766,245.11816,244.14062,243.16504,242.1914,241.21973,240.25,238.3164,236.39062,234.47266,232.5625,230.66016,228.76562,226.8789,225,221.26562,217.5625,213.89062,210.25,206.64062,203.0625,199.51562,196,189.0625,182.25,175.5625,169,162.5625,156.25,150.0625,144,132.25,121,110.25,100,90.25,81,72.25,64,49,36,25,16,9,4,1,0,4,16,36,64,100,144,196,256,400,576,784,1024,1296,1600,1936,2304,3136,4096,5184,6400,7744,9216,10816,12544,16384,20736,25600,30976,36864,43264,50176,57600,73984,92416,112896,135424,160000,186624,215296,256.25006,256.28134,256.3126,256.34387,256.37512,256.4064,256.43768,256.46896,256.50024,256.5628,256.62537,256.68796,256.75055,256.81314,256.87573,256.93835,257.00098,257.12622,257.25153,257.37683,257.5022,257.62756,257.753,257.87842,258.0039,258.25494,258.5061,258.7574,259.0088,259.2603,259.51196,259.76373,260.01562,260.51978,261.0244,261.52954,262.03516,262.54126,263.04785,263.55493,264.0625,265.0791,266.09766,267.11816,268.14062,269.16504,270.1914,271.21973,272.25,274.3164,276.39062,278.47266,280.5625,282.66016,284.76562,286.8789,289,293.26562,297.5625,301.89062,306.25,310.64062,315.0625,319.51562,324,333.0625,342.25,351.5625,361,370.5625,380.25,390.0625,400,420.25,441,462.25,484,506.25,529,552.25,576,625,676,729,784,841,900,961,1024,1156,1296,1444,1600,1764,1936,2116,2304,2704,3136,3600,4096,4624,5184,5776,6400,7744,9216,10816,12544,14400,16384,18496,20736,25600,30976,36864,43264,50176,57600,65536,73984,92416,112896,135424,160000,186624,215296,246016,324,323.6837,323.64853,323.6134,323.57825,323.54312,323.508,323.47287,323.43774,323.3675,323.29724,323.22702,323.1568,323.08658,323.01636,322.94617,322.87598,322.7356,322.59528,322.45496,322.3147,322.17444,322.03424,321.89404,321.7539,321.4737,321.1936,320.91364,320.6338,320.35406,320.07446,319.79498,319.51562,318.95728,318.3994,317.84204,317.28516,316.72876,316.17285,315.61743,315.0625,313.9541,312.84766,311.74316,310.64062,309.54004,308.4414,307.34473,306.25,304.0664,301.89062,299.72266,297.5625,295.41016,293.26562,291.1289,289,284.76562,280.5625,276.39062,272.25,268.14062,264.0625,260.01562,256,248.0625,240.25,232.5625,225,217.5625,210.25,203.0625,196,182.25,169,156.25,144,132.25,121,110.25,100,81,64,49,36,25,16,9,4,0,4,16,36,64,100,144,196,324,484,676,900,1156,1444,1764,2116,2916,3844,4900,6084,7396,8836,10404,12100,15876,20164,24964,30276,36100,42436,49284,56644,72900,91204,111556,133956,158404,184900,213444,324.2813,324.3165,324.35165,324.38684,324.422,324.45718,324.49237,324.52756,324.56274,324.63312,324.7035,324.7739,324.8443,324.9147,324.9851,325.05554,325.12598,325.26685,325.40778,325.5487,325.6897,325.8307,325.97174,326.1128,326.2539,326.5362,326.8186,327.10114,327.3838,327.66656,327.94946,328.23248,328.51562,329.08228,329.6494,330.21704,330.78516,331.35376,331.92285,332.49243,333.0625,334.2041,335.34766,336.49316,337.64062,338.79004,339.9414,341.09473,342.25,344.5664,346.89062,349.22266,351.5625,353.91016,356.26562,358.6289,361,365.76562,370.5625,375.39062,380.25,385.14062,390.0625,395.01562,400,410.0625,420.25,430.5625,441,451.5625,462.25,473.0625,484,506.25,529,552.25,576,600.25,625,650.25,676,729,784,841,900,961,1024,1089,1156,1296,1444,1600,1764,1936,2116,2304,2500,2916,3364,3844,4356,4900,5476,6084,6724,8100,9604,11236,12996,14884,16900,19044,21316,26244,31684,37636,44100,51076,58564,66564,75076,93636,114244,136900,161604,188356,217156,248004,400,399.64853,399.60947,399.57043,399.53137,399.49234,399.4533,399.41428,399.37524,399.29718,399.21912,399.14108,399.06305,398.98502,398.90698,398.82898,398.75098,398.59497,398.43903,398.28308,398.1272,397.9713,397.8155,397.65967,397.5039,397.19244,396.8811,396.5699,396.2588,395.9478,395.63696,395.32623,395.01562,394.39478,393.7744,393.15454,392.53516,391.91626,391.29785,390.67993,390.0625,388.8291,387.59766,386.36816,385.14062,383.91504,382.6914,381.46973,380.25,377.8164,375.39062,372.97266,370.5625,368.16016,365.76562,363.3789,361,356.26562,351.5625,346.89062,342.25,337.64062,333.0625,328.51562,324,315.0625,306.25,297.5625,289,280.5625,272.25,264.0625,256,240.25,225,210.25,196,182.25,169,156.25,144,121,100,81,64,49,36,25,16,4,0,4,16,36,64,100,144,256,400,576,784,1024,1296,1600,1936,2704,3600,4624,5776,7056,8464,10000,11664,15376,19600,24336,29584,35344,41616,48400,55696,71824,90000,110224,132496,156816,183184,211600,400.31256,400.35165,400.39072,400.4298,400.46887,400.50797,400.54706,400.58615,400.62524,400.70343,400.78162,400.85983,400.93805,401.01627,401.09448,401.17273,401.25098,401.40747,401.56403,401.72058,401.8772,402.0338,402.1905,402.34717,402.5039,402.81744,403.1311,403.4449,403.7588,404.0728,404.38696,404.70123,405.01562,405.64478,406.2744,406.90454,407.53516,408.16626,408.79785,409.42993,410.0625,411.3291,412.59766,413.86816,415.14062,416.41504,417.6914,418.96973,420.25,422.8164,425.39062,427.97266,430.5625,433.16016,435.76562,438.3789,441,446.26562,451.5625,456.89062,462.25,467.64062,473.0625,478.51562,484,495.0625,506.25,517.5625,529,540.5625,552.25,564.0625,576,600.25,625,650.25,676,702.25,729,756.25,784,841,900,961,1024,1089,1156,1225,1296,1444,1600,1764,1936,2116,2304,2500,2704,3136,3600,4096,4624,5184,5776,6400,7056,8464,10000,11664,13456,15376,17424,19600,21904,26896,32400,38416,44944,51984,59536,67600,76176,94864,115600,138384,163216,190096,219024,250000,484,483.61337,483.5704,483.52747,483.4845,483.44156,483.39862,483.35568,483.31274,483.22687,483.141,483.05515,482.9693,482.88345,482.7976,482.7118,482.62598,482.45435,482.28278,482.1112,481.9397,481.7682,481.59674,481.4253,481.2539,480.9112,480.5686,480.22614,479.8838,479.54156,479.19946,478.85748,478.51562,477.83228,477.1494,476.46704,475.78516,475.10376,474.42285,473.74243,473.0625,471.7041,470.34766,468.99316,467.64062,466.29004,464.9414,463.59473,462.25,459.5664,456.89062,454.22266,451.5625,448.91016,446.26562,443.6289,441,435.76562,430.5625,425.39062,420.25,415.14062,410.0625,405.01562,400,390.0625,380.25,370.5625,361,351.5625,342.25,333.0625,324,306.25,289,272.25,256,240.25,225,210.25,196,169,144,121,100,81,64,49,36,16,4,0,4,16,36,64,100,196,324,484,676,900,1156,1444,1764,2500,3364,4356,5476,6724,8100,9604,11236,14884,19044,23716,28900,34596,40804,47524,54756,70756,88804,108900,131044,155236,181476,209764,484.3438,484.3868,484.42978,484.47278,484.51575,484.55875,484.60175,484.64474,484.68774,484.77374,484.85974,484.94577,485.0318,485.11783,485.20386,485.28992,485.37598,485.5481,485.72028,485.89246,486.0647,486.23694,486.40924,486.58154,486.7539,487.0987,487.4436,487.78864,488.1338,488.47906,488.82446,489.16998,489.51562,490.20728,490.8994,491.59204,492.28516,492.97876,493.67285,494.36743,495.0625,496.4541,497.84766,499.24316,500.64062,502.04004,503.4414,504.84473,506.25,509.0664,511.89062,514.72266,517.5625,520.41016,523.2656,526.1289,529,534.7656,540.5625,546.3906,552.25,558.1406,564.0625,570.0156,576,588.0625,600.25,612.5625,625,637.5625,650.25,663.0625,676,702.25,729,756.25,784,812.25,841,870.25,900,961,1024,1089,1156,1225,1296,1369,1444,1600,1764,1936,2116,2304,2500,2704,2916,3364,3844,4356,4900,5476,6084,6724,7396,8836,10404,12100,13924,15876,17956,20164,22500,27556,33124,39204,45796,52900,60516,68644,77284,96100,116964,139876,164836,191844,220900,252004,576,575.5782,575.5314,575.4845,575.4376,575.3908,575.34393,575.2971,575.25024,575.15656,575.06287,574.96924,574.87555,574.7819,574.68823,574.5946,574.501,574.3137,574.1265,573.93933,573.7522,573.56506,573.378,573.1909,573.0039,572.62994,572.2561,571.8824,571.5088,571.1353,570.76196,570.38873,570.0156,569.2698,568.5244,567.77954,567.03516,566.29126,565.54785,564.80493,564.0625,562.5791,561.09766,559.61816,558.1406,556.66504,555.1914,553.7197,552.25,549.3164,546.3906,543.47266,540.5625,537.66016,534.7656,531.8789,529,523.2656,517.5625,511.89062,506.25,500.64062,495.0625,489.51562,484,473.0625,462.25,451.5625,441,430.5625,420.25,410.0625,400,380.25,361,342.25,324,306.25,289,272.25,256,225,196,169,144,121,100,81,64,36,16,4,0,4,16,36,64,144,256,400,576,784,1024,1296,1600,2304,3136,4096,5184,6400,7744,9216,10816,14400,18496,23104,28224,33856,40000,46656,53824,69696,87616,107584,129600,153664,179776,207936,576.37506,576.42194,576.4689,576.51575,576.5626,576.60956,576.65643,576.70337,576.75024,576.84406,576.93787,577.03174,577.12555,577.2194,577.31323,577.4071,577.501,577.6887,577.8765,578.06433,578.2522,578.44006,578.628,578.8159,579.0039,579.37994,579.7561,580.1324,580.5088,580.8853,581.26196,581.63873,582.0156,582.7698,583.5244,584.27954,585.03516,585.79126,586.54785,587.30493,588.0625,589.5791,591.09766,592.61816,594.1406,595.66504,597.1914,598.7197,600.25,603.3164,606.3906,609.47266,612.5625,615.66016,618.7656,621.8789,625,631.2656,637.5625,643.8906,650.25,656.6406,663.0625,669.5156,676,689.0625,702.25,715.5625,729,742.5625,756.25,770.0625,784,812.25,841,870.25,900,930.25,961,992.25,1024,1089,1156,1225,1296,1369,1444,1521,1600,1764,1936,2116,2304,2500,2704,2916,3136,3600,4096,4624,5184,5776,6400,7056,7744,9216,10816,12544,14400,16384,18496,20736,23104,28224,33856,40000,46656,53824,61504,69696,78400,97344,118336,141376,166464,193600,222784,254016,676,675.543,675.4923,675.4415,675.39075,675.34,675.28925,675.2385,675.18774,675.08624,674.98474,674.8833,674.7818,674.68036,674.57886,674.4774,674.376,674.1731,673.9703,673.76746,673.5647,673.36194,673.15924,672.95654,672.7539,672.3487,671.9436,671.53864,671.1338,670.72906,670.32446,669.92,669.5156,668.7073,667.8994,667.09204,666.28516,665.47876,664.67285,663.86743,663.0625,661.4541,659.84766,658.24316,656.6406,655.04004,653.4414,651.8447,650.25,647.0664,643.8906,640.72266,637.5625,634.41016,631.2656,628.1289,625,618.7656,612.5625,606.3906,600.25,594.1406,588.0625,582.0156,576,564.0625,552.25,540.5625,529,517.5625,506.25,495.0625,484,462.25,441,420.25,400,380.25,361,342.25,324,289,256,225,196,169,144,121,100,64,36,16,4,0,4,16,36,100,196,324,484,676,900,1156,1444,2116,2916,3844,4900,6084,7396,8836,10404,13924,17956,22500,27556,33124,39204,45796,52900,68644,86436,106276,128164,152100,178084,206116,676.4063,676.4571,676.50793,676.5587,676.6095,676.66034,676.7111,676.76196,676.81274,676.91437,677.016,677.1177,677.2193,677.321,677.4226,677.5243,677.626,677.82935,678.0328,678.2362,678.4397,678.6432,678.84674,679.0503,679.2539,679.6612,680.0686,680.47614,680.8838,681.29156,681.69946,682.1075,682.5156,683.3323,684.1494,684.96704,685.78516,686.60376,687.42285,688.24243,689.0625,690.7041,692.34766,693.99316,695.6406,697.29004,698.9414,700.5947,702.25,705.5664,708.8906,712.22266,715.5625,718.91016,722.2656,725.6289,729,735.7656,742.5625,749.3906,756.25,763.1406,770.0625,777.0156,784,798.0625,812.25,826.5625,841,855.5625,870.25,885.0625,900,930.25,961,992.25,1024,1056.25,1089,1122.25,1156,1225,1296,1369,1444,1521,1600,1681,1764,1936,2116,2304,2500,2704,2916,3136,3364,3844,4356,4900,5476,6084,6724,7396,8100,9604,11236,12996,14884,16900,19044,21316,23716,28900,34596,40804,47524,54756,62500,70756,79524,98596,119716,142884,168100,195364,224676,256036,784,783.5079,783.45325,783.39856,783.3439,783.28925,783.23456,783.17993,783.12524,783.0159,782.9066,782.79736,782.68805,782.5788,782.4695,782.3602,782.251,782.0325,781.814,781.5956,781.3772,781.1588,780.9405,780.72217,780.5039,780.06744,779.6311,779.1949,778.7588,778.3228,777.88696,777.45123,777.0156,776.1448,775.2744,774.40454,773.53516,772.66626,771.79785,770.92993,770.0625,768.3291,766.59766,764.86816,763.1406,761.41504,759.6914,757.9697,756.25,752.8164,749.3906,745.97266,742.5625,739.16016,735.7656,732.3789,729,722.2656,715.5625,708.8906,702.25,695.6406,689.0625,682.5156,676,663.0625,650.25,637.5625,625,612.5625,600.25,588.0625,576,552.25,529,506.25,484,462.25,441,420.25,400,361,324,289,256,225,196,169,144,100,64,36,16,4,0,4,16,64,144,256,400,576,784,1024,1296,1936,2704,3600,4624,5776,7056,8464,10000,13456,17424,21904,26896,32400,38416,44944,51984,67600,85264,104976,126736,150544,176400,204304,784.43756,784.49225,784.547,784.6017,784.6564,784.7111,784.7658,784.82056,784.87524,784.9847,785.0941,785.2036,785.31305,785.42255,785.532,785.6415,785.751,785.97,786.189,786.4081,786.6272,786.8463,787.0655,787.28467,787.5039,787.94244,788.3811,788.8199,789.2588,789.6978,790.13696,790.57623,791.0156,791.8948,792.7744,793.65454,794.53516,795.41626,796.29785,797.17993,798.0625,799.8291,801.59766,803.36816,805.1406,806.91504,808.6914,810.4697,812.25,815.8164,819.3906,822.97266,826.5625,830.16016,833.7656,837.3789,841,848.2656,855.5625,862.8906,870.25,877.6406,885.0625,892.5156,900,915.0625,930.25,945.5625,961,976.5625,992.25,1008.0625,1024,1056.25,1089,1122.25,1156,1190.25,1225,1260.25,1296,1369,1444,1521,1600,1681,1764,1849,1936,2116,2304,2500,2704,2916,3136,3364,3600,4096,4624,5184,5776,6400,7056,7744,8464,10000,11664,13456,15376,17424,19600,21904,24336,29584,35344,41616,48400,55696,63504,71824,80656,99856,121104,144400,169744,197136,226576,258064,900,899.4727,899.4142,899.3556,899.297,899.23846,899.1799,899.12134,899.06274,898.9456,898.8285,898.7114,898.5943,898.47723,898.3601,898.24304,898.126,897.89185,897.6578,897.4237,897.1897,896.9557,896.72174,896.4878,896.2539,895.7862,895.3186,894.85114,894.3838,893.91656,893.44946,892.9825,892.5156,891.5823,890.6494,889.71704,888.78516,887.85376,886.92285,885.99243,885.0625,883.2041,881.34766,879.49316,877.6406,875.79004,873.9414,872.0947,870.25,866.5664,862.8906,859.22266,855.5625,851.91016,848.2656,844.6289,841,833.7656,826.5625,819.3906,812.25,805.1406,798.0625,791.0156,784,770.0625,756.25,742.5625,729,715.5625,702.25,689.0625,676,650.25,625,600.25,576,552.25,529,506.25,484,441,400,361,324,289,256,225,196,144,100,64,36,16,4,0,4,36,100,196,324,484,676,900,1156,1764,2500,3364,4356,5476,6724,8100,9604,12996,16900,21316,26244,31684,37636,44100,51076,66564,84100,103684,125316,148996,174724,202500,900.4688,900.5274,900.58606,900.64465,900.70325,900.7619,900.8205,900.87915,900.93774,901.055,901.17224,901.28955,901.4068,901.5241,901.64136,901.75867,901.876,902.1106,902.3453,902.57996,902.8147,903.04944,903.28424,903.51904,903.7539,904.2237,904.6936,905.16364,905.6338,906.10406,906.57446,907.045,907.5156,908.4573,909.3994,910.34204,911.28516,912.22876,913.17285,914.11743,915.0625,916.9541,918.84766,920.74316,922.6406,924.54004,926.4414,928.3447,930.25,934.0664,937.8906,941.72266,945.5625,949.41016,953.2656,957.1289,961,968.7656,976.5625,984.3906,992.25,1000.1406,1008.0625,1016.0156,1024,1040.0625,1056.25,1072.5625,1089,1105.5625,1122.25,1139.0625,1156,1190.25,1225,1260.25,1296,1332.25,1369,1406.25,1444,1521,1600,1681,1764,1849,1936,2025,2116,2304,2500,2704,2916,3136,3364,3600,3844,4356,4900,5476,6084,6724,7396,8100,8836,10404,12100,13924,15876,17956,20164,22500,24964,30276,36100,42436,49284,56644,64516,72900,81796,101124,122500,145924,171396,198916,228484,260100,1024,1023.43756,1023.3751,1023.3126,1023.2501,1023.1877,1023.1252,1023.06274,1023.00024,1022.8753,1022.75037,1022.6255,1022.50055,1022.3757,1022.25073,1022.12585,1022.001,1021.7512,1021.5015,1021.25183,1021.0022,1020.75256,1020.503,1020.2534,1020.0039,1019.50494,1019.0061,1018.5074,1018.0088,1017.5103,1017.01196,1016.51373,1016.0156,1015.0198,1014.0244,1013.02954,1012.03516,1011.04126,1010.04785,1009.05493,1008.0625,1006.0791,1004.09766,1002.11816,1000.1406,998.16504,996.1914,994.2197,992.25,988.3164,984.3906,980.47266,976.5625,972.66016,968.7656,964.8789,961,953.2656,945.5625,937.8906,930.25,922.6406,915.0625,907.5156,900,885.0625,870.25,855.5625,841,826.5625,812.25,798.0625,784,756.25,729,702.25,676,650.25,625,600.25,576,529,484,441,400,361,324,289,256,196,144,100,64,36,16,4,0,16,64,144,256,400,576,784,1024,1600,2304,3136,4096,5184,6400,7744,9216,12544,16384,20736,25600,30976,36864,43264,50176,65536,82944,102400,123904,147456,173056,200704,1024.5,1024.5626,1024.6251,1024.6876,1024.7501,1024.8126,1024.8752,1024.9377,1025.0002,1025.1254,1025.2504,1025.3755,1025.5005,1025.6256,1025.7507,1025.8759,1026.001,1026.2512,1026.5015,1026.7518,1027.0022,1027.2526,1027.5029,1027.7534,1028.0039,1028.5049,1029.0061,1029.5073,1030.0088,1030.5103,1031.012,1031.5137,1032.0156,1033.0198,1034.0244,1035.0295,1036.0352,1037.0413,1038.0479,1039.0549,1040.0625,1042.0791,1044.0977,1046.1182,1048.1406,1050.165,1052.1914,1054.2197,1056.25,1060.3164,1064.3906,1068.4727,1072.5625,1076.6602,1080.7656,1084.8789,1089,1097.2656,1105.5625,1113.8906,1122.25,1130.6406,1139.0625,1147.5156,1156,1173.0625,1190.25,1207.5625,1225,1242.5625,1260.25,1278.0625,1296,1332.25,1369,1406.25,1444,1482.25,1521,1560.25,1600,1681,1764,1849,1936,2025,2116,2209,2304,2500,2704,2916,3136,3364,3600,3844,4096,4624,5184,5776,6400,7056,7744,8464,9216,10816,12544,14400,16384,18496,20736,23104,25600,30976,36864,43264,50176,57600,65536,73984,82944,102400,123904,147456,173056,200704,230400,262144,1296,1295.3673,1295.297,1295.2267,1295.1564,1295.086,1295.0159,1294.9456,1294.8752,1294.7347,1294.5941,1294.4536,1294.313,1294.1725,1294.032,1293.8915,1293.751,1293.47,1293.189,1292.9081,1292.6272,1292.3463,1292.0654,1291.7847,1291.5039,1290.9424,1290.3811,1289.8198,1289.2588,1288.6978,1288.137,1287.5762,1287.0156,1285.8948,1284.7744,1283.6545,1282.5352,1281.4163,1280.2979,1279.1799,1278.0625,1275.8291,1273.5977,1271.3682,1269.1406,1266.915,1264.6914,1262.4697,1260.25,1255.8164,1251.3906,1246.9727,1242.5625,1238.1602,1233.7656,1229.3789,1225,1216.2656,1207.5625,1198.8906,1190.25,1181.6406,1173.0625,1164.5156,1156,1139.0625,1122.25,1105.5625,1089,1072.5625,1056.25,1040.0625,1024,992.25,961,930.25,900,870.25,841,812.25,784,729,676,625,576,529,484,441,400,324,256,196,144,100,64,36,16,0,16,64,144,256,400,576,784,1296,1936,2704,3600,4624,5776,7056,8464,11664,15376,19600,24336,29584,35344,41616,48400,63504,80656,99856,121104,144400,169744,197136,1296.5625,1296.6329,1296.7032,1296.7736,1296.8439,1296.9142,1296.9846,1297.0549,1297.1252,1297.266,1297.4066,1297.5474,1297.688,1297.8287,1297.9695,1298.1102,1298.251,1298.5325,1298.814,1299.0956,1299.3772,1299.6588,1299.9404,1300.2222,1300.5039,1301.0674,1301.6311,1302.1948,1302.7588,1303.3228,1303.887,1304.4512,1305.0156,1306.1448,1307.2744,1308.4045,1309.5352,1310.6663,1311.7979,1312.9299,1314.0625,1316.3291,1318.5977,1320.8682,1323.1406,1325.415,1327.6914,1329.9697,1332.25,1336.8164,1341.3906,1345.9727,1350.5625,1355.1602,1359.7656,1364.3789,1369,1378.2656,1387.5625,1396.8906,1406.25,1415.6406,1425.0625,1434.5156,1444,1463.0625,1482.25,1501.5625,1521,1540.5625,1560.25,1580.0625,1600,1640.25,1681,1722.25,1764,1806.25,1849,1892.25,1936,2025,2116,2209,2304,2401,2500,2601,2704,2916,3136,3364,3600,3844,4096,4356,4624,5184,5776,6400,7056,7744,8464,9216,10000,11664,13456,15376,17424,19600,21904,24336,26896,32400,38416,44944,51984,59536,67600,76176,85264,104976,126736,150544,176400,204304,234256,266256,1600,1599.297,1599.2189,1599.1407,1599.0626,1598.9845,1598.9065,1598.8284,1598.7502,1598.5941,1598.4379,1598.2817,1598.1255,1597.9694,1597.8132,1597.6571,1597.501,1597.1887,1596.8765,1596.5643,1596.2522,1595.9401,1595.6279,1595.3159,1595.0039,1594.3799,1593.7561,1593.1323,1592.5088,1591.8853,1591.262,1590.6387,1590.0156,1588.7698,1587.5244,1586.2795,1585.0352,1583.7913,1582.5479,1581.3049,1580.0625,1577.5791,1575.0977,1572.6182,1570.1406,1567.665,1565.1914,1562.7197,1560.25,1555.3164,1550.3906,1545.4727,1540.5625,1535.6602,1530.7656,1525.8789,1521,1511.2656,1501.5625,1491.8906,1482.25,1472.6406,1463.0625,1453.5156,1444,1425.0625,1406.25,1387.5625,1369,1350.5625,1332.25,1314.0625,1296,1260.25,1225,1190.25,1156,1122.25,1089,1056.25,1024,961,900,841,784,729,676,625,576,484,400,324,256,196,144,100,64,16,0,16,64,144,256,400,576,1024,1600,2304,3136,4096,5184,6400,7744,10816,14400,18496,23104,28224,33856,40000,46656,61504,78400,97344,118336,141376,166464,193600,1600.625,1600.7032,1600.7814,1600.8595,1600.9376,1601.0157,1601.094,1601.1721,1601.2502,1601.4066,1601.5629,1601.7192,1601.8755,1602.0319,1602.1882,1602.3446,1602.501,1602.8137,1603.1265,1603.4393,1603.7522,1604.0651,1604.3779,1604.6909,1605.0039,1605.6299,1606.2561,1606.8823,1607.5088,1608.1353,1608.762,1609.3887,1610.0156,1611.2698,1612.5244,1613.7795,1615.0352,1616.2913,1617.5479,1618.8049,1620.0625,1622.5791,1625.0977,1627.6182,1630.1406,1632.665,1635.1914,1637.7197,1640.25,1645.3164,1650.3906,1655.4727,1660.5625,1665.6602,1670.7656,1675.8789,1681,1691.2656,1701.5625,1711.8906,1722.25,1732.6406,1743.0625,1753.5156,1764,1785.0625,1806.25,1827.5625,1849,1870.5625,1892.25,1914.0625,1936,1980.25,2025,2070.25,2116,2162.25,2209,2256.25,2304,2401,2500,2601,2704,2809,2916,3025,3136,3364,3600,3844,4096,4356,4624,4900,5184,5776,6400,7056,7744,8464,9216,10000,10816,12544,14400,16384,18496,20736,23104,25600,28224,33856,40000,46656,53824,61504,69696,78400,87616,107584,129600,153664,179776,207936,238144,270400,1936,1935.2267,1935.1407,1935.0548,1934.9689,1934.8829,1934.7971,1934.7112,1934.6252,1934.4535,1934.2816,1934.1099,1933.938,1933.7662,1933.5945,1933.4227,1933.251,1932.9075,1932.564,1932.2206,1931.8772,1931.5338,1931.1904,1930.8472,1930.5039,1929.8174,1929.1311,1928.4448,1927.7588,1927.0728,1926.387,1925.7012,1925.0156,1923.6448,1922.2744,1920.9045,1919.5352,1918.1663,1916.7979,1915.4299,1914.0625,1911.3291,1908.5977,1905.8682,1903.1406,1900.415,1897.6914,1894.9697,1892.25,1886.8164,1881.3906,1875.9727,1870.5625,1865.1602,1859.7656,1854.3789,1849,1838.2656,1827.5625,1816.8906,1806.25,1795.6406,1785.0625,1774.5156,1764,1743.0625,1722.25,1701.5625,1681,1660.5625,1640.25,1620.0625,1600,1560.25,1521,1482.25,1444,1406.25,1369,1332.25,1296,1225,1156,1089,1024,961,900,841,784,676,576,484,400,324,256,196,144,64,16,0,16,64,144,256,400,784,1296,1936,2704,3600,4624,5776,7056,10000,13456,17424,21904,26896,32400,38416,44944,59536,76176,94864,115600,138384,163216,190096,1936.6875,1936.7736,1936.8595,1936.9454,1937.0314,1937.1173,1937.2034,1937.2893,1937.3752,1937.5472,1937.7191,1937.8911,1938.063,1938.235,1938.407,1938.579,1938.751,1939.095,1939.439,1939.7831,1940.1272,1940.4713,1940.8154,1941.1597,1941.5039,1942.1924,1942.8811,1943.5698,1944.2588,1944.9478,1945.637,1946.3262,1947.0156,1948.3948,1949.7744,1951.1545,1952.5352,1953.9163,1955.2979,1956.6799,1958.0625,1960.8291,1963.5977,1966.3682,1969.1406,1971.915,1974.6914,1977.4697,1980.25,1985.8164,1991.3906,1996.9727,2002.5625,2008.1602,2013.7656,2019.3789,2025,2036.2656,2047.5625,2058.8906,2070.25,2081.6406,2093.0625,2104.5156,2116,2139.0625,2162.25,2185.5625,2209,2232.5625,2256.25,2280.0625,2304,2352.25,2401,2450.25,2500,2550.25,2601,2652.25,2704,2809,2916,3025,3136,3249,3364,3481,3600,3844,4096,4356,4624,4900,5184,5476,5776,6400,7056,7744,8464,9216,10000,10816,11664,13456,15376,17424,19600,21904,24336,26896,29584,35344,41616,48400,55696,63504,71824,80656,90000,110224,132496,156816,183184,211600,242064,274576,2304,2303.1562,2303.0625,2302.9688,2302.8752,2302.7815,2302.6877,2302.594,2302.5002,2302.3127,2302.1255,2301.938,2301.7505,2301.5632,2301.3757,2301.1885,2301.001,2300.6262,2300.2515,2299.877,2299.5022,2299.1277,2298.753,2298.3784,2298.004,2297.255,2296.506,2295.7573,2295.0088,2294.2603,2293.512,2292.7637,2292.0156,2290.5198,2289.0244,2287.5295,2286.0352,2284.5413,2283.0479,2281.555,2280.0625,2277.079,2274.0977,2271.1182,2268.1406,2265.165,2262.1914,2259.2197,2256.25,2250.3164,2244.3906,2238.4727,2232.5625,2226.6602,2220.7656,2214.879,2209,2197.2656,2185.5625,2173.8906,2162.25,2150.6406,2139.0625,2127.5156,2116,2093.0625,2070.25,2047.5625,2025,2002.5625,1980.25,1958.0625,1936,1892.25,1849,1806.25,1764,1722.25,1681,1640.25,1600,1521,1444,1369,1296,1225,1156,1089,1024,900,784,676,576,484,400,324,256,144,64,16,0,16,64,144,256,576,1024,1600,2304,3136,4096,5184,6400,9216,12544,16384,20736,25600,30976,36864,43264,57600,73984,92416,112896,135424,160000,186624,2304.75,2304.8438,2304.9375,2305.0312,2305.1252,2305.219,2305.3127,2305.4065,2305.5002,2305.6877,2305.8755,2306.063,2306.2505,2306.4382,2306.6257,2306.8135,2307.001,2307.3762,2307.7515,2308.127,2308.5022,2308.8777,2309.253,2309.6284,2310.004,2310.755,2311.506,2312.2573,2313.0088,2313.7603,2314.512,2315.2637,2316.0156,2317.5198,2319.0244,2320.5295,2322.0352,2323.5413,2325.0479,2326.555,2328.0625,2331.079,2334.0977,2337.1182,2340.1406,2343.165,2346.1914,2349.2197,2352.25,2358.3164,2364.3906,2370.4727,2376.5625,2382.6602,2388.7656,2394.879,2401,2413.2656,2425.5625,2437.8906,2450.25,2462.6406,2475.0625,2487.5156,2500,2525.0625,2550.25,2575.5625,2601,2626.5625,2652.25,2678.0625,2704,2756.25,2809,2862.25,2916,2970.25,3025,3080.25,3136,3249,3364,3481,3600,3721,3844,3969,4096,4356,4624,4900,5184,5476,5776,6084,6400,7056,7744,8464,9216,10000,10816,11664,12544,14400,16384,18496,20736,23104,25600,28224,30976,36864,43264,50176,57600,65536,73984,82944,92416,112896,135424,160000,186624,215296,246016,278784,2704,2703.086,2702.9844,2702.8828,2702.7815,2702.68,2702.5784,2702.4768,2702.3752,2702.172,2701.9692,2701.766,2701.563,2701.36,2701.157,2700.954,2700.751,2700.345,2699.939,2699.5332,2699.1272,2698.7214,2698.3154,2697.9097,2697.504,2696.6924,2695.881,2695.0698,2694.2588,2693.4478,2692.637,2691.8262,2691.0156,2689.3948,2687.7744,2686.1545,2684.5352,2682.9163,2681.2979,2679.68,2678.0625,2674.829,2671.5977,2668.3682,2665.1406,2661.915,2658.6914,2655.4697,2652.25,2645.8164,2639.3906,2632.9727,2626.5625,2620.1602,2613.7656,2607.379,2601,2588.2656,2575.5625,2562.8906,2550.25,2537.6406,2525.0625,2512.5156,2500,2475.0625,2450.25,2425.5625,2401,2376.5625,2352.25,2328.0625,2304,2256.25,2209,2162.25,2116,2070.25,2025,1980.25,1936,1849,1764,1681,1600,1521,1444,1369,1296,1156,1024,900,784,676,576,484,400,256,144,64,16,0,16,64,144,400,784,1296,1936,2704,3600,4624,5776,8464,11664,15376,19600,24336,29584,35344,41616,55696,71824,90000,110224,132496,156816,183184,2704.8125,2704.914,2705.0156,2705.1172,2705.219,2705.3206,2705.422,2705.5237,2705.6252,2705.8284,2706.0317,2706.2349,2706.438,2706.6414,2706.8445,2707.0479,2707.251,2707.6575,2708.064,2708.4707,2708.8772,2709.284,2709.6904,2710.0972,2710.504,2711.3174,2712.131,2712.9448,2713.7588,2714.5728,2715.387,2716.2012,2717.0156,2718.6448,2720.2744,2721.9045,2723.5352,2725.1663,2726.7979,2728.43,2730.0625,2733.329,2736.5977,2739.8682,2743.1406,2746.415,2749.6914,2752.9697,2756.25,2762.8164,2769.3906,2775.9727,2782.5625,2789.1602,2795.7656,2802.379,2809,2822.2656,2835.5625,2848.8906,2862.25,2875.6406,2889.0625,2902.5156,2916,2943.0625,2970.25,2997.5625,3025,3052.5625,3080.25,3108.0625,3136,3192.25,3249,3306.25,3364,3422.25,3481,3540.25,3600,3721,3844,3969,4096,4225,4356,4489,4624,4900,5184,5476,5776,6084,6400,6724,7056,7744,8464,9216,10000,10816,11664,12544,13456,15376,17424,19600,21904,24336,26896,29584,32400,38416,44944,51984,59536,67600,76176,85264,94864,115600,138384,163216,190096,219024,250000,283024,3136,3135.0156,3134.9062,3134.7969,3134.6877,3134.5784,3134.469,3134.3596,3134.2502,3134.0315,3133.813,3133.5942,3133.3755,3133.157,3132.9382,3132.7197,3132.501,3132.0637,3131.6265,3131.1895,3130.7522,3130.3152,3129.878,3129.441,3129.004,3128.13,3127.256,3126.3823,3125.5088,3124.6353,3123.762,3122.8887,3122.0156,3120.2698,3118.5244,3116.7795,3115.0352,3113.2913,3111.5479,3109.805,3108.0625,3104.579,3101.0977,3097.6182,3094.1406,3090.665,3087.1914,3083.7197,3080.25,3073.3164,3066.3906,3059.4727,3052.5625,3045.6602,3038.7656,3031.879,3025,3011.2656,2997.5625,2983.8906,2970.25,2956.6406,2943.0625,2929.5156,2916,2889.0625,2862.25,2835.5625,2809,2782.5625,2756.25,2730.0625,2704,2652.25,2601,2550.25,2500,2450.25,2401,2352.25,2304,2209,2116,2025,1936,1849,1764,1681,1600,1444,1296,1156,1024,900,784,676,576,400,256,144,64,16,0,16,64,256,576,1024,1600,2304,3136,4096,5184,7744,10816,14400,18496,23104,28224,33856,40000,53824,69696,87616,107584,129600,153664,179776,3136.875,3136.9844,3137.0938,3137.2031,3137.3127,3137.422,3137.5315,3137.6409,3137.7502,3137.969,3138.188,3138.4067,3138.6255,3138.8445,3139.0632,3139.2822,3139.501,3139.9387,3140.3765,3140.8145,3141.2522,3141.6902,3142.128,3142.566,3143.004,3143.88,3144.756,3145.6323,3146.5088,3147.3853,3148.262,3149.1387,3150.0156,3151.7698,3153.5244,3155.2795,3157.0352,3158.7913,3160.5479,3162.305,3164.0625,3167.579,3171.0977,3174.6182,3178.1406,3181.665,3185.1914,3188.7197,3192.25,3199.3164,3206.3906,3213.4727,3220.5625,3227.6602,3234.7656,3241.879,3249,3263.2656,3277.5625,3291.8906,3306.25,3320.6406,3335.0625,3349.5156,3364,3393.0625,3422.25,3451.5625,3481,3510.5625,3540.25,3570.0625,3600,3660.25,3721,3782.25,3844,3906.25,3969,4032.25,4096,4225,4356,4489,4624,4761,4900,5041,5184,5476,5776,6084,6400,6724,7056,7396,7744,8464,9216,10000,10816,11664,12544,13456,14400,16384,18496,20736,23104,25600,28224,30976,33856,40000,46656,53824,61504,69696,78400,87616,97344,118336,141376,166464,193600,222784,254016,287296,3600,3598.9453,3598.8281,3598.711,3598.594,3598.4768,3598.3596,3598.2424,3598.1252,3597.8909,3597.6567,3597.4224,3597.188,3596.9539,3596.7195,3596.4854,3596.251,3595.7825,3595.314,3594.8457,3594.3772,3593.909,3593.4404,3592.9722,3592.504,3591.5674,3590.631,3589.6948,3588.7588,3587.8228,3586.887,3585.9512,3585.0156,3583.1448,3581.2744,3579.4045,3577.5352,3575.6663,3573.7979,3571.93,3570.0625,3566.329,3562.5977,3558.8682,3555.1406,3551.415,3547.6914,3543.9697,3540.25,3532.8164,3525.3906,3517.9727,3510.5625,3503.1602,3495.7656,3488.379,3481,3466.2656,3451.5625,3436.8906,3422.25,3407.6406,3393.0625,3378.5156,3364,3335.0625,3306.25,3277.5625,3249,3220.5625,3192.25,3164.0625,3136,3080.25,3025,2970.25,2916,2862.25,2809,2756.25,2704,2601,2500,2401,2304,2209,2116,2025,1936,1764,1600,1444,1296,1156,1024,900,784,576,400,256,144,64,16,0,16,144,400,784,1296,1936,2704,3600,4624,7056,10000,13456,17424,21904,26896,32400,38416,51984,67600,85264,104976,126736,150544,176400,3600.9375,3601.0547,3601.1719,3601.289,3601.4065,3601.5237,3601.6409,3601.758,3601.8752,3602.1096,3602.3442,3602.5786,3602.813,3603.0476,3603.282,3603.5166,3603.751,3604.22,3604.689,3605.1582,3605.6272,3606.0964,3606.5654,3607.0347,3607.504,3608.4424,3609.381,3610.3198,3611.2588,3612.1978,3613.137,3614.0762,3615.0156,3616.8948,3618.7744,3620.6545,3622.5352,3624.4163,3626.2979,3628.18,3630.0625,3633.829,3637.5977,3641.3682,3645.1406,3648.915,3652.6914,3656.4697,3660.25,3667.8164,3675.3906,3682.9727,3690.5625,3698.1602,3705.7656,3713.379,3721,3736.2656,3751.5625,3766.8906,3782.25,3797.6406,3813.0625,3828.5156,3844,3875.0625,3906.25,3937.5625,3969,4000.5625,4032.25,4064.0625,4096,4160.25,4225,4290.25,4356,4422.25,4489,4556.25,4624,4761,4900,5041,5184,5329,5476,5625,5776,6084,6400,6724,7056,7396,7744,8100,8464,9216,10000,10816,11664,12544,13456,14400,15376,17424,19600,21904,24336,26896,29584,32400,35344,41616,48400,55696,63504,71824,80656,90000,99856,121104,144400,169744,197136,226576,258064,291600,4096,4094.875,4094.75,4094.625,4094.5002,4094.3752,4094.2502,4094.1252,4094.0002,4093.7502,4093.5005,4093.2505,4093.0005,4092.7507,4092.5007,4092.251,4092.001,4091.5012,4091.0015,4090.502,4090.0022,4089.5027,4089.003,4088.5034,4088.004,4087.005,4086.006,4085.0073,4084.0088,4083.0103,4082.012,4081.0137,4080.0156,4078.0198,4076.0244,4074.0295,4072.0352,4070.0413,4068.0479,4066.055,4064.0625,4060.079,4056.0977,4052.1182,4048.1406,4044.165,4040.1914,4036.2197,4032.25,4024.3164,4016.3906,4008.4727,4000.5625,3992.6602,3984.7656,3976.879,3969,3953.2656,3937.5625,3921.8906,3906.25,3890.6406,3875.0625,3859.5156,3844,3813.0625,3782.25,3751.5625,3721,3690.5625,3660.25,3630.0625,3600,3540.25,3481,3422.25,3364,3306.25,3249,3192.25,3136,3025,2916,2809,2704,2601,2500,2401,2304,2116,1936,1764,1600,1444,1296,1156,1024,784,576,400,256,144,64,16,0,64,256,576,1024,1600,2304,3136,4096,6400,9216,12544,16384,20736,25600,30976,36864,50176,65536,82944,102400,123904,147456,173056,4097,4097.125,4097.25,4097.375,4097.5,4097.625,4097.75,4097.875,4098,4098.2505,4098.5005,4098.7505,4099.0005,4099.2505,4099.501,4099.751,4100.001,4100.5015,4101.0015,4101.502,4102.002,4102.5024,4103.003,4103.5034,4104.004,4105.005,4106.006,4107.0073,4108.009,4109.0103,4110.0117,4111.0137,4112.0156,4114.0195,4116.0244,4118.0293,4120.035,4122.041,4124.048,4126.0547,4128.0625,4132.079,4136.0977,4140.118,4144.1406,4148.165,4152.1914,4156.2197,4160.25,4168.3164,4176.3906,4184.4727,4192.5625,4200.66,4208.7656,4216.879,4225,4241.2656,4257.5625,4273.8906,4290.25,4306.6406,4323.0625,4339.5156,4356,4389.0625,4422.25,4455.5625,4489,4522.5625,4556.25,4590.0625,4624,4692.25,4761,4830.25,4900,4970.25,5041,5112.25,5184,5329,5476,5625,5776,5929,6084,6241,6400,6724,7056,7396,7744,8100,8464,8836,9216,10000,10816,11664,12544,13456,14400,15376,16384,18496,20736,23104,25600,28224,30976,33856,36864,43264,50176,57600,65536,73984,82944,92416,102400,123904,147456,173056,200704,230400,262144,295936,5184,5182.7344,5182.5938,5182.453,5182.3125,5182.172,5182.0312,5181.8906,5181.75,5181.469,5181.188,5180.9067,5180.6255,5180.344,5180.0635,5179.782,5179.501,5178.939,5178.3765,5177.8145,5177.252,5176.69,5176.128,5175.566,5175.004,5173.88,5172.756,5171.6323,5170.509,5169.3853,5168.2617,5167.1387,5166.0156,5163.7695,5161.5244,5159.2793,5157.035,5154.791,5152.548,5150.3047,5148.0625,5143.579,5139.0977,5134.618,5130.1406,5125.665,5121.1914,5116.7197,5112.25,5103.3164,5094.3906,5085.4727,5076.5625,5067.66,5058.7656,5049.879,5041,5023.2656,5005.5625,4987.8906,4970.25,4952.6406,4935.0625,4917.5156,4900,4865.0625,4830.25,4795.5625,4761,4726.5625,4692.25,4658.0625,4624,4556.25,4489,4422.25,4356,4290.25,4225,4160.25,4096,3969,3844,3721,3600,3481,3364,3249,3136,2916,2704,2500,2304,2116,1936,1764,1600,1296,1024,784,576,400,256,144,64,0,64,256,576,1024,1600,2304,3136,5184,7744,10816,14400,18496,23104,28224,33856,46656,61504,78400,97344,118336,141376,166464,5185.125,5185.2656,5185.4062,5185.547,5185.6875,5185.828,5185.9688,5186.1094,5186.25,5186.5317,5186.813,5187.094,5187.3755,5187.6567,5187.9385,5188.2197,5188.501,5189.064,5189.6265,5190.1895,5190.752,5191.315,5191.878,5192.441,5193.004,5194.13,5195.256,5196.3823,5197.509,5198.6353,5199.7617,5200.8887,5202.0156,5204.2695,5206.5244,5208.7793,5211.035,5213.291,5215.548,5217.8047,5220.0625,5224.579,5229.0977,5233.618,5238.1406,5242.665,5247.1914,5251.7197,5256.25,5265.3164,5274.3906,5283.4727,5292.5625,5301.66,5310.7656,5319.879,5329,5347.2656,5365.5625,5383.8906,5402.25,5420.6406,5439.0625,5457.5156,5476,5513.0625,5550.25,5587.5625,5625,5662.5625,5700.25,5738.0625,5776,5852.25,5929,6006.25,6084,6162.25,6241,6320.25,6400,6561,6724,6889,7056,7225,7396,7569,7744,8100,8464,8836,9216,9604,10000,10404,10816,11664,12544,13456,14400,15376,16384,17424,18496,20736,23104,25600,28224,30976,33856,36864,40000,46656,53824,61504,69696,78400,87616,97344,107584,129600,153664,179776,207936,238144,270400,304704,6400,6398.5938,6398.4375,6398.2812,6398.125,6397.9688,6397.8125,6397.6562,6397.5,6397.188,6396.8755,6396.563,6396.2505,6395.938,6395.626,6395.3135,6395.001,6394.3765,6393.7515,6393.127,6392.502,6391.8774,6391.253,6390.6284,6390.004,6388.755,6387.506,6386.2573,6385.009,6383.7603,6382.5117,6381.2637,6380.0156,6377.5195,6375.0244,6372.5293,6370.035,6367.541,6365.048,6362.5547,6360.0625,6355.079,6350.0977,6345.118,6340.1406,6335.165,6330.1914,6325.2197,6320.25,6310.3164,6300.3906,6290.4727,6280.5625,6270.66,6260.7656,6250.879,6241,6221.2656,6201.5625,6181.8906,6162.25,6142.6406,6123.0625,6103.5156,6084,6045.0625,6006.25,5967.5625,5929,5890.5625,5852.25,5814.0625,5776,5700.25,5625,5550.25,5476,5402.25,5329,5256.25,5184,5041,4900,4761,4624,4489,4356,4225,4096,3844,3600,3364,3136,2916,2704,2500,2304,1936,1600,1296,1024,784,576,400,256,64,0,64,256,576,1024,1600,2304,4096,6400,9216,12544,16384,20736,25600,30976,43264,57600,73984,92416,112896,135424,160000,6401.25,6401.4062,6401.5625,6401.7188,6401.875,6402.0312,6402.1875,6402.3438,6402.5,6402.813,6403.1255,6403.438,6403.7505,6404.063,6404.376,6404.6885,6405.001,6405.6265,6406.2515,6406.877,6407.502,6408.1274,6408.753,6409.3784,6410.004,6411.255,6412.506,6413.7573,6415.009,6416.2603,6417.5117,6418.7637,6420.0156,6422.5195,6425.0244,6427.5293,6430.035,6432.541,6435.048,6437.5547,6440.0625,6445.079,6450.0977,6455.118,6460.1406,6465.165,6470.1914,6475.2197,6480.25,6490.3164,6500.3906,6510.4727,6520.5625,6530.66,6540.7656,6550.879,6561,6581.2656,6601.5625,6621.8906,6642.25,6662.6406,6683.0625,6703.5156,6724,6765.0625,6806.25,6847.5625,6889,6930.5625,6972.25,7014.0625,7056,7140.25,7225,7310.25,7396,7482.25,7569,7656.25,7744,7921,8100,8281,8464,8649,8836,9025,9216,9604,10000,10404,10816,11236,11664,12100,12544,13456,14400,15376,16384,17424,18496,19600,20736,23104,25600,28224,30976,33856,36864,40000,43264,50176,57600,65536,73984,82944,92416,102400,112896,135424,160000,186624,215296,246016,278784,313600,7744,7742.453,7742.2812,7742.1094,7741.9375,7741.7656,7741.5938,7741.422,7741.25,7740.9067,7740.563,7740.219,7739.8755,7739.5317,7739.1885,7738.8447,7738.501,7737.814,7737.1265,7736.4395,7735.752,7735.065,7734.378,7733.691,7733.004,7731.63,7730.256,7728.8823,7727.509,7726.1353,7724.7617,7723.3887,7722.0156,7719.2695,7716.5244,7713.7793,7711.035,7708.291,7705.548,7702.8047,7700.0625,7694.579,7689.0977,7683.618,7678.1406,7672.665,7667.1914,7661.7197,7656.25,7645.3164,7634.3906,7623.4727,7612.5625,7601.66,7590.7656,7579.879,7569,7547.2656,7525.5625,7503.8906,7482.25,7460.6406,7439.0625,7417.5156,7396,7353.0625,7310.25,7267.5625,7225,7182.5625,7140.25,7098.0625,7056,6972.25,6889,6806.25,6724,6642.25,6561,6480.25,6400,6241,6084,5929,5776,5625,5476,5329,5184,4900,4624,4356,4096,3844,3600,3364,3136,2704,2304,1936,1600,1296,1024,784,576,256,64,0,64,256,576,1024,1600,3136,5184,7744,10816,14400,18496,23104,28224,40000,53824,69696,87616,107584,129600,153664,7745.375,7745.547,7745.7188,7745.8906,7746.0625,7746.2344,7746.4062,7746.578,7746.75,7747.094,7747.438,7747.7817,7748.1255,7748.469,7748.8135,7749.157,7749.501,7750.189,7750.8765,7751.5645,7752.252,7752.94,7753.628,7754.316,7755.004,7756.38,7757.756,7759.1323,7760.509,7761.8853,7763.2617,7764.6387,7766.0156,7768.7695,7771.5244,7774.2793,7777.035,7779.791,7782.548,7785.3047,7788.0625,7793.579,7799.0977,7804.618,7810.1406,7815.665,7821.1914,7826.7197,7832.25,7843.3164,7854.3906,7865.4727,7876.5625,7887.66,7898.7656,7909.879,7921,7943.2656,7965.5625,7987.8906,8010.25,8032.6406,8055.0625,8077.5156,8100,8145.0625,8190.25,8235.5625,8281,8326.5625,8372.25,8418.0625,8464,8556.25,8649,8742.25,8836,8930.25,9025,9120.25,9216,9409,9604,9801,10000,10201,10404,10609,10816,11236,11664,12100,12544,12996,13456,13924,14400,15376,16384,17424,18496,19600,20736,21904,23104,25600,28224,30976,33856,36864,40000,43264,46656,53824,61504,69696,78400,87616,97344,107584,118336,141376,166464,193600,222784,254016,287296,322624,9216,9214.3125,9214.125,9213.9375,9213.75,9213.5625,9213.375,9213.1875,9213,9212.625,9212.25,9211.875,9211.501,9211.126,9210.751,9210.376,9210.001,9209.251,9208.502,9207.752,9207.002,9206.253,9205.503,9204.754,9204.004,9202.505,9201.006,9199.508,9198.009,9196.511,9195.012,9193.514,9192.016,9189.02,9186.024,9183.029,9180.035,9177.041,9174.048,9171.055,9168.0625,9162.079,9156.098,9150.118,9144.141,9138.165,9132.191,9126.22,9120.25,9108.316,9096.391,9084.473,9072.5625,9060.66,9048.766,9036.879,9025,9001.266,8977.5625,8953.891,8930.25,8906.641,8883.0625,8859.516,8836,8789.0625,8742.25,8695.5625,8649,8602.5625,8556.25,8510.0625,8464,8372.25,8281,8190.25,8100,8010.25,7921,7832.25,7744,7569,7396,7225,7056,6889,6724,6561,6400,6084,5776,5476,5184,4900,4624,4356,4096,3600,3136,2704,2304,1936,1600,1296,1024,576,256,64,0,64,256,576,1024,2304,4096,6400,9216,12544,16384,20736,25600,36864,50176,65536,82944,102400,123904,147456,9217.5,9217.6875,9217.875,9218.0625,9218.25,9218.4375,9218.625,9218.8125,9219,9219.375,9219.75,9220.125,9220.501,9220.876,9221.251,9221.626,9222.001,9222.751,9223.502,9224.252,9225.002,9225.753,9226.503,9227.254,9228.004,9229.505,9231.006,9232.508,9234.009,9235.511,9237.012,9238.514,9240.016,9243.02,9246.024,9249.029,9252.035,9255.041,9258.048,9261.055,9264.0625,9270.079,9276.098,9282.118,9288.141,9294.165,9300.191,9306.22,9312.25,9324.316,9336.391,9348.473,9360.5625,9372.66,9384.766,9396.879,9409,9433.266,9457.5625,9481.891,9506.25,9530.641,9555.0625,9579.516,9604,9653.0625,9702.25,9751.5625,9801,9850.5625,9900.25,9950.0625,10000,10100.25,10201,10302.25,10404,10506.25,10609,10712.25,10816,11025,11236,11449,11664,11881,12100,12321,12544,12996,13456,13924,14400,14884,15376,15876,16384,17424,18496,19600,20736,21904,23104,24336,25600,28224,30976,33856,36864,40000,43264,46656,50176,57600,65536,73984,82944,92416,102400,112896,123904,147456,173056,200704,230400,262144,295936,331776,10816,10814.172,10813.969,10813.766,10813.5625,10813.359,10813.156,10812.953,10812.75,10812.344,10811.9375,10811.531,10811.126,10810.72,10810.313,10809.907,10809.501,10808.688,10807.877,10807.064,10806.252,10805.44,10804.628,10803.816,10803.004,10801.38,10799.756,10798.133,10796.509,10794.886,10793.262,10791.639,10790.016,10786.77,10783.524,10780.279,10777.035,10773.791,10770.548,10767.305,10764.0625,10757.579,10751.098,10744.618,10738.141,10731.665,10725.191,10718.72,10712.25,10699.316,10686.391,10673.473,10660.5625,10647.66,10634.766,10621.879,10609,10583.266,10557.5625,10531.891,10506.25,10480.641,10455.0625,10429.516,10404,10353.0625,10302.25,10251.5625,10201,10150.5625,10100.25,10050.0625,10000,9900.25,9801,9702.25,9604,9506.25,9409,9312.25,9216,9025,8836,8649,8464,8281,8100,7921,7744,7396,7056,6724,6400,6084,5776,5476,5184,4624,4096,3600,3136,2704,2304,1936,1600,1024,576,256,64,0,64,256,576,1600,3136,5184,7744,10816,14400,18496,23104,33856,46656,61504,78400,97344,118336,141376,10817.625,10817.828,10818.031,10818.234,10818.4375,10818.641,10818.844,10819.047,10819.25,10819.656,10820.0625,10820.469,10820.876,10821.282,10821.688,10822.095,10822.501,10823.313,10824.127,10824.939,10825.752,10826.565,10827.378,10828.191,10829.004,10830.63,10832.256,10833.883,10835.509,10837.136,10838.762,10840.389,10842.016,10845.27,10848.524,10851.779,10855.035,10858.291,10861.548,10864.805,10868.0625,10874.579,10881.098,10887.618,10894.141,10900.665,10907.191,10913.72,10920.25,10933.316,10946.391,10959.473,10972.5625,10985.66,10998.766,11011.879,11025,11051.266,11077.5625,11103.891,11130.25,11156.641,11183.0625,11209.516,11236,11289.0625,11342.25,11395.5625,11449,11502.5625,11556.25,11610.0625,11664,11772.25,11881,11990.25,12100,12210.25,12321,12432.25,12544,12769,12996,13225,13456,13689,13924,14161,14400,14884,15376,15876,16384,16900,17424,17956,18496,19600,20736,21904,23104,24336,25600,26896,28224,30976,33856,36864,40000,43264,46656,50176,53824,61504,69696,78400,87616,97344,107584,118336,129600,153664,179776,207936,238144,270400,304704,341056,12544,12542.031,12541.8125,12541.594,12541.375,12541.156,12540.9375,12540.719,12540.5,12540.0625,12539.625,12539.1875,12538.751,12538.313,12537.876,12537.438,12537.001,12536.126,12535.252,12534.377,12533.502,12532.628,12531.753,12530.879,12530.004,12528.255,12526.506,12524.758,12523.009,12521.261,12519.512,12517.764,12516.016,12512.52,12509.024,12505.529,12502.035,12498.541,12495.048,12491.555,12488.0625,12481.079,12474.098,12467.118,12460.141,12453.165,12446.191,12439.22,12432.25,12418.316,12404.391,12390.473,12376.5625,12362.66,12348.766,12334.879,12321,12293.266,12265.5625,12237.891,12210.25,12182.641,12155.0625,12127.516,12100,12045.0625,11990.25,11935.5625,11881,11826.5625,11772.25,11718.0625,11664,11556.25,11449,11342.25,11236,11130.25,11025,10920.25,10816,10609,10404,10201,10000,9801,9604,9409,9216,8836,8464,8100,7744,7396,7056,6724,6400,5776,5184,4624,4096,3600,3136,2704,2304,1600,1024,576,256,64,0,64,256,1024,2304,4096,6400,9216,12544,16384,20736,30976,43264,57600,73984,92416,112896,135424,12545.75,12545.969,12546.1875,12546.406,12546.625,12546.844,12547.0625,12547.281,12547.5,12547.9375,12548.375,12548.8125,12549.251,12549.688,12550.126,12550.563,12551.001,12551.876,12552.752,12553.627,12554.502,12555.378,12556.253,12557.129,12558.004,12559.755,12561.506,12563.258,12565.009,12566.761,12568.512,12570.264,12572.016,12575.52,12579.024,12582.529,12586.035,12589.541,12593.048,12596.555,12600.0625,12607.079,12614.098,12621.118,12628.141,12635.165,12642.191,12649.22,12656.25,12670.316,12684.391,12698.473,12712.5625,12726.66,12740.766,12754.879,12769,12797.266,12825.5625,12853.891,12882.25,12910.641,12939.0625,12967.516,12996,13053.0625,13110.25,13167.5625,13225,13282.5625,13340.25,13398.0625,13456,13572.25,13689,13806.25,13924,14042.25,14161,14280.25,14400,14641,14884,15129,15376,15625,15876,16129,16384,16900,17424,17956,18496,19044,19600,20164,20736,21904,23104,24336,25600,26896,28224,29584,30976,33856,36864,40000,43264,46656,50176,53824,57600,65536,73984,82944,92416,102400,112896,123904,135424,160000,186624,215296,246016,278784,313600,350464,14400,14397.891,14397.656,14397.422,14397.1875,14396.953,14396.719,14396.484,14396.25,14395.781,14395.3125,14394.844,14394.376,14393.907,14393.438,14392.97,14392.501,14391.563,14390.627,14389.689,14388.752,14387.815,14386.878,14385.941,14385.004,14383.13,14381.256,14379.383,14377.509,14375.636,14373.762,14371.889,14370.016,14366.27,14362.524,14358.779,14355.035,14351.291,14347.548,14343.805,14340.0625,14332.579,14325.098,14317.618,14310.141,14302.665,14295.191,14287.72,14280.25,14265.316,14250.391,14235.473,14220.5625,14205.66,14190.766,14175.879,14161,14131.266,14101.5625,14071.891,14042.25,14012.641,13983.0625,13953.516,13924,13865.0625,13806.25,13747.5625,13689,13630.5625,13572.25,13514.0625,13456,13340.25,13225,13110.25,12996,12882.25,12769,12656.25,12544,12321,12100,11881,11664,11449,11236,11025,10816,10404,10000,9604,9216,8836,8464,8100,7744,7056,6400,5776,5184,4624,4096,3600,3136,2304,1600,1024,576,256,64,0,64,576,1600,3136,5184,7744,10816,14400,18496,28224,40000,53824,69696,87616,107584,129600,14401.875,14402.109,14402.344,14402.578,14402.8125,14403.047,14403.281,14403.516,14403.75,14404.219,14404.6875,14405.156,14405.626,14406.095,14406.563,14407.032,14407.501,14408.438,14409.377,14410.314,14411.252,14412.19,14413.128,14414.066,14415.004,14416.88,14418.756,14420.633,14422.509,14424.386,14426.262,14428.139,14430.016,14433.77,14437.524,14441.279,14445.035,14448.791,14452.548,14456.305,14460.0625,14467.579,14475.098,14482.618,14490.141,14497.665,14505.191,14512.72,14520.25,14535.316,14550.391,14565.473,14580.5625,14595.66,14610.766,14625.879,14641,14671.266,14701.5625,14731.891,14762.25,14792.641,14823.0625,14853.516,14884,14945.0625,15006.25,15067.5625,15129,15190.5625,15252.25,15314.0625,15376,15500.25,15625,15750.25,15876,16002.25,16129,16256.25,16384,16641,16900,17161,17424,17689,17956,18225,18496,19044,19600,20164,20736,21316,21904,22500,23104,24336,25600,26896,28224,29584,30976,32400,33856,36864,40000,43264,46656,50176,53824,57600,61504,69696,78400,87616,97344,107584,118336,129600,141376,166464,193600,222784,254016,287296,322624,360000,16384,16381.75,16381.5,16381.25,16381,16380.75,16380.5,16380.25,16380,16379.5,16379,16378.5,16378.001,16377.501,16377.001,16376.501,16376.001,16375.001,16374.002,16373.002,16372.002,16371.003,16370.003,16369.004,16368.004,16366.005,16364.006,16362.008,16360.009,16358.011,16356.012,16354.014,16352.016,16348.02,16344.024,16340.029,16336.035,16332.041,16328.048,16324.055,16320.0625,16312.079,16304.098,16296.118,16288.141,16280.165,16272.191,16264.22,16256.25,16240.316,16224.391,16208.473,16192.5625,16176.66,16160.766,16144.879,16129,16097.266,16065.5625,16033.891,16002.25,15970.641,15939.0625,15907.516,15876,15813.0625,15750.25,15687.5625,15625,15562.5625,15500.25,15438.0625,15376,15252.25,15129,15006.25,14884,14762.25,14641,14520.25,14400,14161,13924,13689,13456,13225,12996,12769,12544,12100,11664,11236,10816,10404,10000,9604,9216,8464,7744,7056,6400,5776,5184,4624,4096,3136,2304,1600,1024,576,256,64,0,256,1024,2304,4096,6400,9216,12544,16384,25600,36864,50176,65536,82944,102400,123904,16386,16386.25,16386.5,16386.75,16387,16387.25,16387.5,16387.75,16388,16388.5,16389,16389.5,16390,16390.5,16391,16391.5,16392,16393.002,16394.002,16395.002,16396.002,16397.002,16398.004,16399.004,16400.004,16402.006,16404.006,16406.008,16408.008,16410.01,16412.012,16414.014,16416.016,16420.02,16424.023,16428.03,16432.035,16436.041,16440.047,16444.055,16448.062,16456.078,16464.098,16472.117,16480.14,16488.164,16496.191,16504.219,16512.25,16528.316,16544.39,16560.473,16576.562,16592.66,16608.766,16624.879,16641,16673.266,16705.562,16737.89,16770.25,16802.64,16835.062,16867.516,16900,16965.062,17030.25,17095.562,17161,17226.562,17292.25,17358.062,17424,17556.25,17689,17822.25,17956,18090.25,18225,18360.25,18496,18769,19044,19321,19600,19881,20164,20449,20736,21316,21904,22500,23104,23716,24336,24964,25600,26896,28224,29584,30976,32400,33856,35344,36864,40000,43264,46656,50176,53824,57600,61504,65536,73984,82944,92416,102400,112896,123904,135424,147456,173056,200704,230400,262144,295936,331776,369664,20736,20733.469,20733.188,20732.906,20732.625,20732.344,20732.062,20731.781,20731.5,20730.938,20730.375,20729.812,20729.25,20728.688,20728.125,20727.562,20727,20725.877,20724.752,20723.627,20722.502,20721.377,20720.254,20719.129,20718.004,20715.756,20713.506,20711.258,20709.008,20706.76,20704.512,20702.264,20700.016,20695.52,20691.023,20686.53,20682.035,20677.541,20673.047,20668.555,20664.062,20655.078,20646.098,20637.117,20628.14,20619.164,20610.191,20601.219,20592.25,20574.316,20556.39,20538.473,20520.562,20502.66,20484.766,20466.879,20449,20413.266,20377.562,20341.89,20306.25,20270.64,20235.062,20199.516,20164,20093.062,20022.25,19951.562,19881,19810.562,19740.25,19670.062,19600,19460.25,19321,19182.25,19044,18906.25,18769,18632.25,18496,18225,17956,17689,17424,17161,16900,16641,16384,15876,15376,14884,14400,13924,13456,12996,12544,11664,10816,10000,9216,8464,7744,7056,6400,5184,4096,3136,2304,1600,1024,576,256,0,256,1024,2304,4096,6400,9216,12544,20736,30976,43264,57600,73984,92416,112896,20738.25,20738.531,20738.812,20739.094,20739.375,20739.656,20739.938,20740.219,20740.5,20741.062,20741.625,20742.188,20742.75,20743.312,20743.875,20744.438,20745,20746.127,20747.252,20748.377,20749.502,20750.627,20751.754,20752.879,20754.004,20756.256,20758.506,20760.758,20763.008,20765.26,20767.512,20769.764,20772.016,20776.52,20781.023,20785.53,20790.035,20794.541,20799.047,20803.555,20808.062,20817.078,20826.098,20835.117,20844.14,20853.164,20862.191,20871.219,20880.25,20898.316,20916.39,20934.473,20952.562,20970.66,20988.766,21006.879,21025,21061.266,21097.562,21133.89,21170.25,21206.64,21243.062,21279.516,21316,21389.062,21462.25,21535.562,21609,21682.562,21756.25,21830.062,21904,22052.25,22201,22350.25,22500,22650.25,22801,22952.25,23104,23409,23716,24025,24336,24649,24964,25281,25600,26244,26896,27556,28224,28900,29584,30276,30976,32400,33856,35344,36864,38416,40000,41616,43264,46656,50176,53824,57600,61504,65536,69696,73984,82944,92416,102400,112896,123904,135424,147456,160000,186624,215296,246016,278784,313600,350464,389376,25600,25597.188,25596.875,25596.562,25596.25,25595.938,25595.625,25595.312,25595,25594.375,25593.75,25593.125,25592.5,25591.875,25591.25,25590.625,25590,25588.752,25587.502,25586.252,25585.002,25583.752,25582.504,25581.254,25580.004,25577.506,25575.006,25572.508,25570.008,25567.51,25565.012,25562.514,25560.016,25555.02,25550.023,25545.03,25540.035,25535.041,25530.047,25525.055,25520.062,25510.078,25500.098,25490.117,25480.14,25470.164,25460.191,25450.219,25440.25,25420.316,25400.39,25380.473,25360.562,25340.66,25320.766,25300.879,25281,25241.266,25201.562,25161.89,25122.25,25082.64,25043.062,25003.516,24964,24885.062,24806.25,24727.562,24649,24570.562,24492.25,24414.062,24336,24180.25,24025,23870.25,23716,23562.25,23409,23256.25,23104,22801,22500,22201,21904,21609,21316,21025,20736,20164,19600,19044,18496,17956,17424,16900,16384,15376,14400,13456,12544,11664,10816,10000,9216,7744,6400,5184,4096,3136,2304,1600,1024,256,0,256,1024,2304,4096,6400,9216,16384,25600,36864,50176,65536,82944,102400,25602.5,25602.812,25603.125,25603.438,25603.75,25604.062,25604.375,25604.688,25605,25605.625,25606.25,25606.875,25607.5,25608.125,25608.75,25609.375,25610,25611.252,25612.502,25613.752,25615.002,25616.252,25617.504,25618.754,25620.004,25622.506,25625.006,25627.508,25630.008,25632.51,25635.012,25637.514,25640.016,25645.02,25650.023,25655.03,25660.035,25665.041,25670.047,25675.055,25680.062,25690.078,25700.098,25710.117,25720.14,25730.164,25740.191,25750.219,25760.25,25780.316,25800.39,25820.473,25840.562,25860.66,25880.766,25900.879,25921,25961.266,26001.562,26041.89,26082.25,26122.64,26163.062,26203.516,26244,26325.062,26406.25,26487.562,26569,26650.562,26732.25,26814.062,26896,27060.25,27225,27390.25,27556,27722.25,27889,28056.25,28224,28561,28900,29241,29584,29929,30276,30625,30976,31684,32400,33124,33856,34596,35344,36100,36864,38416,40000,41616,43264,44944,46656,48400,50176,53824,57600,61504,65536,69696,73984,78400,82944,92416,102400,112896,123904,135424,147456,160000,173056,200704,230400,262144,295936,331776,369664,409600,30976,30972.906,30972.562,30972.219,30971.875,30971.531,30971.188,30970.844,30970.5,30969.812,30969.125,30968.438,30967.75,30967.062,30966.375,30965.688,30965,30963.627,30962.252,30960.877,30959.502,30958.127,30956.754,30955.379,30954.004,30951.256,30948.506,30945.758,30943.008,30940.26,30937.512,30934.764,30932.016,30926.52,30921.023,30915.53,30910.035,30904.541,30899.047,30893.555,30888.062,30877.078,30866.098,30855.117,30844.14,30833.164,30822.191,30811.219,30800.25,30778.316,30756.39,30734.473,30712.562,30690.66,30668.766,30646.879,30625,30581.266,30537.562,30493.89,30450.25,30406.64,30363.062,30319.516,30276,30189.062,30102.25,30015.562,29929,29842.562,29756.25,29670.062,29584,29412.25,29241,29070.25,28900,28730.25,28561,28392.25,28224,27889,27556,27225,26896,26569,26244,25921,25600,24964,24336,23716,23104,22500,21904,21316,20736,19600,18496,17424,16384,15376,14400,13456,12544,10816,9216,7744,6400,5184,4096,3136,2304,1024,256,0,256,1024,2304,4096,6400,12544,20736,30976,43264,57600,73984,92416,30978.75,30979.094,30979.438,30979.781,30980.125,30980.469,30980.812,30981.156,30981.5,30982.188,30982.875,30983.562,30984.25,30984.938,30985.625,30986.312,30987,30988.377,30989.752,30991.127,30992.502,30993.877,30995.254,30996.629,30998.004,31000.756,31003.506,31006.258,31009.008,31011.76,31014.512,31017.264,31020.016,31025.52,31031.023,31036.53,31042.035,31047.541,31053.047,31058.555,31064.062,31075.078,31086.098,31097.117,31108.14,31119.164,31130.191,31141.219,31152.25,31174.316,31196.39,31218.473,31240.562,31262.66,31284.766,31306.879,31329,31373.266,31417.562,31461.89,31506.25,31550.64,31595.062,31639.516,31684,31773.062,31862.25,31951.562,32041,32130.562,32220.25,32310.062,32400,32580.25,32761,32942.25,33124,33306.25,33489,33672.25,33856,34225,34596,34969,35344,35721,36100,36481,36864,37636,38416,39204,40000,40804,41616,42436,43264,44944,46656,48400,50176,51984,53824,55696,57600,61504,65536,69696,73984,78400,82944,87616,92416,102400,112896,123904,135424,147456,160000,173056,186624,215296,246016,278784,313600,350464,389376,430336,36864,36860.625,36860.25,36859.875,36859.5,36859.125,36858.75,36858.375,36858,36857.25,36856.5,36855.75,36855,36854.25,36853.5,36852.75,36852,36850.5,36849,36847.5,36846.004,36844.504,36843.004,36841.504,36840.004,36837.004,36834.008,36831.008,36828.008,36825.01,36822.01,36819.016,36816.016,36810.02,36804.023,36798.03,36792.035,36786.043,36780.047,36774.055,36768.062,36756.08,36744.098,36732.117,36720.14,36708.164,36696.19,36684.22,36672.25,36648.316,36624.39,36600.473,36576.562,36552.66,36528.766,36504.88,36481,36433.266,36385.562,36337.89,36290.25,36242.64,36195.062,36147.516,36100,36005.062,35910.25,35815.562,35721,35626.562,35532.25,35438.062,35344,35156.25,34969,34782.25,34596,34410.25,34225,34040.25,33856,33489,33124,32761,32400,32041,31684,31329,30976,30276,29584,28900,28224,27556,26896,26244,25600,24336,23104,21904,20736,19600,18496,17424,16384,14400,12544,10816,9216,7744,6400,5184,4096,2304,1024,256,0,256,1024,2304,4096,9216,16384,25600,36864,50176,65536,82944,36867,36867.375,36867.75,36868.125,36868.5,36868.875,36869.25,36869.625,36870,36870.75,36871.5,36872.25,36873,36873.75,36874.5,36875.25,36876,36877.5,36879,36880.5,36882.004,36883.504,36885.004,36886.504,36888.004,36891.004,36894.008,36897.008,36900.008,36903.01,36906.01,36909.016,36912.016,36918.02,36924.023,36930.03,36936.035,36942.043,36948.047,36954.055,36960.062,36972.08,36984.098,36996.117,37008.14,37020.164,37032.19,37044.22,37056.25,37080.316,37104.39,37128.473,37152.562,37176.66,37200.766,37224.88,37249,37297.266,37345.562,37393.89,37442.25,37490.64,37539.062,37587.516,37636,37733.062,37830.25,37927.562,38025,38122.562,38220.25,38318.062,38416,38612.25,38809,39006.25,39204,39402.25,39601,39800.25,40000,40401,40804,41209,41616,42025,42436,42849,43264,44100,44944,45796,46656,47524,48400,49284,50176,51984,53824,55696,57600,59536,61504,63504,65536,69696,73984,78400,82944,87616,92416,97344,102400,112896,123904,135424,147456,160000,173056,186624,200704,230400,262144,295936,331776,369664,409600,451584,43264,43260.344,43259.938,43259.53,43259.125,43258.72,43258.312,43257.906,43257.5,43256.688,43255.875,43255.062,43254.25,43253.438,43252.625,43251.812,43251,43249.375,43247.75,43246.125,43244.504,43242.88,43241.254,43239.63,43238.004,43234.754,43231.508,43228.258,43225.008,43221.76,43218.51,43215.266,43212.016,43205.52,43199.023,43192.53,43186.035,43179.543,43173.047,43166.555,43160.062,43147.08,43134.098,43121.117,43108.14,43095.164,43082.19,43069.22,43056.25,43030.316,43004.39,42978.473,42952.562,42926.66,42900.766,42874.88,42849,42797.266,42745.562,42693.89,42642.25,42590.64,42539.062,42487.516,42436,42333.062,42230.25,42127.562,42025,41922.562,41820.25,41718.062,41616,41412.25,41209,41006.25,40804,40602.25,40401,40200.25,40000,39601,39204,38809,38416,38025,37636,37249,36864,36100,35344,34596,33856,33124,32400,31684,30976,29584,28224,26896,25600,24336,23104,21904,20736,18496,16384,14400,12544,10816,9216,7744,6400,4096,2304,1024,256,0,256,1024,2304,6400,12544,20736,30976,43264,57600,73984,43267.25,43267.656,43268.062,43268.47,43268.875,43269.28,43269.688,43270.094,43270.5,43271.312,43272.125,43272.938,43273.75,43274.562,43275.375,43276.188,43277,43278.625,43280.25,43281.875,43283.504,43285.13,43286.754,43288.38,43290.004,43293.254,43296.508,43299.758,43303.008,43306.26,43309.51,43312.766,43316.016,43322.52,43329.023,43335.53,43342.035,43348.543,43355.047,43361.555,43368.062,43381.08,43394.098,43407.117,43420.14,43433.164,43446.19,43459.22,43472.25,43498.316,43524.39,43550.473,43576.562,43602.66,43628.766,43654.88,43681,43733.266,43785.562,43837.89,43890.25,43942.64,43995.062,44047.516,44100,44205.062,44310.25,44415.562,44521,44626.562,44732.25,44838.062,44944,45156.25,45369,45582.25,45796,46010.25,46225,46440.25,46656,47089,47524,47961,48400,48841,49284,49729,50176,51076,51984,52900,53824,54756,55696,56644,57600,59536,61504,63504,65536,67600,69696,71824,73984,78400,82944,87616,92416,97344,102400,107584,112896,123904,135424,147456,160000,173056,186624,200704,215296,246016,278784,313600,350464,389376,430336,473344,50176,50172.062,50171.625,50171.188,50170.75,50170.312,50169.875,50169.438,50169,50168.125,50167.25,50166.375,50165.5,50164.625,50163.75,50162.875,50162,50160.25,50158.5,50156.75,50155.004,50153.254,50151.504,50149.754,50148.004,50144.504,50141.008,50137.508,50134.008,50130.51,50127.01,50123.516,50120.016,50113.02,50106.023,50099.03,50092.035,50085.043,50078.047,50071.055,50064.062,50050.08,50036.098,50022.117,50008.14,49994.164,49980.19,49966.22,49952.25,49924.316,49896.39,49868.473,49840.562,49812.66,49784.766,49756.88,49729,49673.266,49617.562,49561.89,49506.25,49450.64,49395.062,49339.516,49284,49173.062,49062.25,48951.562,48841,48730.562,48620.25,48510.062,48400,48180.25,47961,47742.25,47524,47306.25,47089,46872.25,46656,46225,45796,45369,44944,44521,44100,43681,43264,42436,41616,40804,40000,39204,38416,37636,36864,35344,33856,32400,30976,29584,28224,26896,25600,23104,20736,18496,16384,14400,12544,10816,9216,6400,4096,2304,1024,256,0,256,1024,4096,9216,16384,25600,36864,50176,65536,50179.5,50179.938,50180.375,50180.812,50181.25,50181.688,50182.125,50182.562,50183,50183.875,50184.75,50185.625,50186.5,50187.375,50188.25,50189.125,50190,50191.75,50193.5,50195.25,50197.004,50198.754,50200.504,50202.254,50204.004,50207.504,50211.008,50214.508,50218.008,50221.51,50225.01,50228.516,50232.016,50239.02,50246.023,50253.03,50260.035,50267.043,50274.047,50281.055,50288.062,50302.08,50316.098,50330.117,50344.14,50358.164,50372.19,50386.22,50400.25,50428.316,50456.39,50484.473,50512.562,50540.66,50568.766,50596.88,50625,50681.266,50737.562,50793.89,50850.25,50906.64,50963.062,51019.516,51076,51189.062,51302.25,51415.562,51529,51642.562,51756.25,51870.062,51984,52212.25,52441,52670.25,52900,53130.25,53361,53592.25,53824,54289,54756,55225,55696,56169,56644,57121,57600,58564,59536,60516,61504,62500,63504,64516,65536,67600,69696,71824,73984,76176,78400,80656,82944,87616,92416,97344,102400,107584,112896,118336,123904,135424,147456,160000,173056,186624,200704,215296,230400,262144,295936,331776,369664,409600,451584,495616,57600,57595.78,57595.312,57594.844,57594.375,57593.906,57593.438,57592.97,57592.5,57591.562,57590.625,57589.688,57588.75,57587.812,57586.875,57585.938,57585,57583.125,57581.25,57579.375,57577.504,57575.63,57573.754,57571.88,57570.004,57566.254,57562.508,57558.758,57555.008,57551.26,57547.51,57543.766,57540.016,57532.52,57525.023,57517.53,57510.035,57502.543,57495.047,57487.555,57480.062,57465.08,57450.098,57435.117,57420.14,57405.164,57390.19,57375.22,57360.25,57330.316,57300.39,57270.473,57240.562,57210.66,57180.766,57150.88,57121,57061.266,57001.562,56941.89,56882.25,56822.64,56763.062,56703.516,56644,56525.062,56406.25,56287.562,56169,56050.562,55932.25,55814.062,55696,55460.25,55225,54990.25,54756,54522.25,54289,54056.25,53824,53361,52900,52441,51984,51529,51076,50625,50176,49284,48400,47524,46656,45796,44944,44100,43264,41616,40000,38416,36864,35344,33856,32400,30976,28224,25600,23104,20736,18496,16384,14400,12544,9216,6400,4096,2304,1024,256,0,256,2304,6400,12544,20736,30976,43264,57600,57603.75,57604.22,57604.688,57605.156,57605.625,57606.094,57606.562,57607.03,57607.5,57608.438,57609.375,57610.312,57611.25,57612.188,57613.125,57614.062,57615,57616.875,57618.75,57620.625,57622.504,57624.38,57626.254,57628.13,57630.004,57633.754,57637.508,57641.258,57645.008,57648.76,57652.51,57656.266,57660.016,57667.52,57675.023,57682.53,57690.035,57697.543,57705.047,57712.555,57720.062,57735.08,57750.098,57765.117,57780.14,57795.164,57810.19,57825.22,57840.25,57870.316,57900.39,57930.473,57960.562,57990.66,58020.766,58050.88,58081,58141.266,58201.562,58261.89,58322.25,58382.64,58443.062,58503.516,58564,58685.062,58806.25,58927.562,59049,59170.562,59292.25,59414.062,59536,59780.25,60025,60270.25,60516,60762.25,61009,61256.25,61504,62001,62500,63001,63504,64009,64516,65025,65536,66564,67600,68644,69696,70756,71824,72900,73984,76176,78400,80656,82944,85264,87616,90000,92416,97344,102400,107584,112896,118336,123904,129600,135424,147456,160000,173056,186624,200704,215296,230400,246016,278784,313600,350464,389376,430336,473344,518400,65536,65531.5,65531,65530.5,65530,65529.5,65529,65528.5,65528,65527,65526,65525,65524,65523,65522,65521,65520,65518,65516,65514,65512.004,65510.004,65508.004,65506.004,65504.004,65500.004,65496.008,65492.008,65488.008,65484.01,65480.01,65476.016,65472.016,65464.02,65456.023,65448.03,65440.035,65432.043,65424.047,65416.055,65408.062,65392.08,65376.098,65360.117,65344.14,65328.164,65312.19,65296.22,65280.25,65248.316,65216.39,65184.473,65152.562,65120.66,65088.766,65056.88,65025,64961.266,64897.562,64833.89,64770.25,64706.64,64643.062,64579.516,64516,64389.062,64262.25,64135.562,64009,63882.562,63756.25,63630.062,63504,63252.25,63001,62750.25,62500,62250.25,62001,61752.25,61504,61009,60516,60025,59536,59049,58564,58081,57600,56644,55696,54756,53824,52900,51984,51076,50176,48400,46656,44944,43264,41616,40000,38416,36864,33856,30976,28224,25600,23104,20736,18496,16384,12544,9216,6400,4096,2304,1024,256,0,1024,4096,9216,16384,25600,36864,50176,65540,65540.5,65541,65541.5,65542,65542.5,65543,65543.5,65544,65545,65546,65547,65548,65549,65550,65551,65552,65554,65556,65558,65560,65562,65564,65566,65568,65572.01,65576.01,65580.01,65584.01,65588.01,65592.016,65596.016,65600.016,65608.02,65616.02,65624.03,65632.03,65640.04,65648.05,65656.055,65664.06,65680.08,65696.09,65712.12,65728.14,65744.164,65760.19,65776.22,65792.25,65824.31,65856.39,65888.47,65920.56,65952.66,65984.766,66016.875,66049,66113.266,66177.56,66241.89,66306.25,66370.64,66435.06,66499.516,66564,66693.06,66822.25,66951.56,67081,67210.56,67340.25,67470.06,67600,67860.25,68121,68382.25,68644,68906.25,69169,69432.25,69696,70225,70756,71289,71824,72361,72900,73441,73984,75076,76176,77284,78400,79524,80656,81796,82944,85264,87616,90000,92416,94864,97344,99856,102400,107584,112896,118336,123904,129600,135424,141376,147456,160000,173056,186624,200704,215296,230400,246016,262144,295936,331776,369664,409600,451584,495616,541696,82944,82938.94,82938.375,82937.81,82937.25,82936.69,82936.125,82935.56,82935,82933.875,82932.75,82931.625,82930.5,82929.375,82928.25,82927.125,82926,82923.75,82921.5,82919.25,82917,82914.75,82912.5,82910.25,82908,82903.51,82899.01,82894.51,82890.01,82885.51,82881.016,82876.516,82872.016,82863.02,82854.02,82845.03,82836.03,82827.04,82818.05,82809.055,82800.06,82782.08,82764.09,82746.12,82728.14,82710.164,82692.19,82674.22,82656.25,82620.31,82584.39,82548.47,82512.56,82476.66,82440.766,82404.875,82369,82297.266,82225.56,82153.89,82082.25,82010.64,81939.06,81867.516,81796,81653.06,81510.25,81367.56,81225,81082.56,80940.25,80798.06,80656,80372.25,80089,79806.25,79524,79242.25,78961,78680.25,78400,77841,77284,76729,76176,75625,75076,74529,73984,72900,71824,70756,69696,68644,67600,66564,65536,63504,61504,59536,57600,55696,53824,51984,50176,46656,43264,40000,36864,33856,30976,28224,25600,20736,16384,12544,9216,6400,4096,2304,1024,0,1024,4096,9216,16384,25600,36864,82948.5,82949.06,82949.625,82950.19,82950.75,82951.31,82951.875,82952.44,82953,82954.125,82955.25,82956.375,82957.5,82958.625,82959.75,82960.875,82962,82964.25,82966.5,82968.75,82971,82973.25,82975.5,82977.75,82980,82984.51,82989.01,82993.51,82998.01,83002.51,83007.016,83011.516,83016.016,83025.02,83034.02,83043.03,83052.03,83061.04,83070.05,83079.055,83088.06,83106.08,83124.09,83142.12,83160.14,83178.164,83196.19,83214.22,83232.25,83268.31,83304.39,83340.47,83376.56,83412.66,83448.766,83484.875,83521,83593.266,83665.56,83737.89,83810.25,83882.64,83955.06,84027.516,84100,84245.06,84390.25,84535.56,84681,84826.56,84972.25,85118.06,85264,85556.25,85849,86142.25,86436,86730.25,87025,87320.25,87616,88209,88804,89401,90000,90601,91204,91809,92416,93636,94864,96100,97344,98596,99856,101124,102400,104976,107584,110224,112896,115600,118336,121104,123904,129600,135424,141376,147456,153664,160000,166464,173056,186624,200704,215296,230400,246016,262144,278784,295936,331776,369664,409600,451584,495616,541696,589824,102400,102394.375,102393.75,102393.125,102392.5,102391.875,102391.25,102390.625,102390,102388.75,102387.5,102386.25,102385,102383.75,102382.5,102381.25,102380,102377.5,102375,102372.5,102370,102367.5,102365,102362.5,102360,102355.01,102350.01,102345.01,102340.01,102335.01,102330.016,102325.016,102320.016,102310.02,102300.02,102290.03,102280.03,102270.04,102260.05,102250.055,102240.06,102220.08,102200.09,102180.12,102160.14,102140.164,102120.19,102100.22,102080.25,102040.31,102000.39,101960.47,101920.56,101880.66,101840.766,101800.875,101761,101681.266,101601.56,101521.89,101442.25,101362.64,101283.06,101203.516,101124,100965.06,100806.25,100647.56,100489,100330.56,100172.25,100014.06,99856,99540.25,99225,98910.25,98596,98282.25,97969,97656.25,97344,96721,96100,95481,94864,94249,93636,93025,92416,91204,90000,88804,87616,86436,85264,84100,82944,80656,78400,76176,73984,71824,69696,67600,65536,61504,57600,53824,50176,46656,43264,40000,36864,30976,25600,20736,16384,12544,9216,6400,4096,1024,0,1024,4096,9216,16384,25600,102405,102405.625,102406.25,102406.875,102407.5,102408.125,102408.75,102409.375,102410,102411.25,102412.5,102413.75,102415,102416.25,102417.5,102418.75,102420,102422.5,102425,102427.5,102430,102432.5,102435,102437.5,102440,102445.01,102450.01,102455.01,102460.01,102465.01,102470.016,102475.016,102480.016,102490.02,102500.02,102510.03,102520.03,102530.04,102540.05,102550.055,102560.06,102580.08,102600.09,102620.12,102640.14,102660.164,102680.19,102700.22,102720.25,102760.31,102800.39,102840.47,102880.56,102920.66,102960.766,103000.875,103041,103121.266,103201.56,103281.89,103362.25,103442.64,103523.06,103603.516,103684,103845.06,104006.25,104167.56,104329,104490.56,104652.25,104814.06,104976,105300.25,105625,105950.25,106276,106602.25,106929,107256.25,107584,108241,108900,109561,110224,110889,111556,112225,112896,114244,115600,116964,118336,119716,121104,122500,123904,126736,129600,132496,135424,138384,141376,144400,147456,153664,160000,166464,173056,179776,186624,193600,200704,215296,230400,246016,262144,278784,295936,313600,331776,369664,409600,451584,495616,541696,589824,640000,123904,123897.81,123897.125,123896.44,123895.75,123895.06,123894.375,123893.69,123893,123891.625,123890.25,123888.875,123887.5,123886.125,123884.75,123883.375,123882,123879.25,123876.5,123873.75,123871,123868.25,123865.5,123862.75,123860,123854.51,123849.01,123843.51,123838.01,123832.51,123827.016,123821.516,123816.016,123805.02,123794.02,123783.03,123772.03,123761.04,123750.05,123739.055,123728.06,123706.08,123684.09,123662.12,123640.14,123618.164,123596.19,123574.22,123552.25,123508.31,123464.39,123420.47,123376.56,123332.66,123288.766,123244.875,123201,123113.266,123025.56,122937.89,122850.25,122762.64,122675.06,122587.516,122500,122325.06,122150.25,121975.56,121801,121626.56,121452.25,121278.06,121104,120756.25,120409,120062.25,119716,119370.25,119025,118680.25,118336,117649,116964,116281,115600,114921,114244,113569,112896,111556,110224,108900,107584,106276,104976,103684,102400,99856,97344,94864,92416,90000,87616,85264,82944,78400,73984,69696,65536,61504,57600,53824,50176,43264,36864,30976,25600,20736,16384,12544,9216,4096,1024,0,1024,4096,9216,16384,123909.5,123910.19,123910.875,123911.56,123912.25,123912.94,123913.625,123914.31,123915,123916.375,123917.75,123919.125,123920.5,123921.875,123923.25,123924.625,123926,123928.75,123931.5,123934.25,123937,123939.75,123942.5,123945.25,123948,123953.51,123959.01,123964.51,123970.01,123975.51,123981.016,123986.516,123992.016,124003.02,124014.02,124025.03,124036.03,124047.04,124058.05,124069.055,124080.06,124102.08,124124.09,124146.12,124168.14,124190.164,124212.19,124234.22,124256.25,124300.31,124344.39,124388.47,124432.56,124476.66,124520.766,124564.875,124609,124697.266,124785.56,124873.89,124962.25,125050.64,125139.06,125227.516,125316,125493.06,125670.25,125847.56,126025,126202.56,126380.25,126558.06,126736,127092.25,127449,127806.25,128164,128522.25,128881,129240.25,129600,130321,131044,131769,132496,133225,133956,134689,135424,136900,138384,139876,141376,142884,144400,145924,147456,150544,153664,156816,160000,163216,166464,169744,173056,179776,186624,193600,200704,207936,215296,222784,230400,246016,262144,278784,295936,313600,331776,350464,369664,409600,451584,495616,541696,589824,640000,692224,147456,147449.25,147448.5,147447.75,147447,147446.25,147445.5,147444.75,147444,147442.5,147441,147439.5,147438,147436.5,147435,147433.5,147432,147429,147426,147423,147420,147417,147414,147411,147408,147402,147396,147390,147384.02,147378.02,147372.02,147366.02,147360.02,147348.02,147336.03,147324.03,147312.03,147300.05,147288.05,147276.06,147264.06,147240.08,147216.1,147192.12,147168.14,147144.17,147120.19,147096.22,147072.25,147024.31,146976.39,146928.47,146880.56,146832.66,146784.77,146736.88,146689,146593.27,146497.56,146401.89,146306.25,146210.64,146115.06,146019.52,145924,145733.06,145542.25,145351.56,145161,144970.56,144780.25,144590.06,144400,144020.25,143641,143262.25,142884,142506.25,142129,141752.25,141376,140625,139876,139129,138384,137641,136900,136161,135424,133956,132496,131044,129600,128164,126736,125316,123904,121104,118336,115600,112896,110224,107584,104976,102400,97344,92416,87616,82944,78400,73984,69696,65536,57600,50176,43264,36864,30976,25600,20736,16384,9216,4096,1024,0,1024,4096,9216,147462,147462.75,147463.5,147464.25,147465,147465.75,147466.5,147467.25,147468,147469.5,147471,147472.5,147474,147475.5,147477,147478.5,147480,147483,147486,147489,147492,147495,147498,147501,147504,147510,147516,147522,147528.02,147534.02,147540.02,147546.02,147552.02,147564.02,147576.03,147588.03,147600.03,147612.05,147624.05,147636.06,147648.06,147672.08,147696.1,147720.12,147744.14,147768.17,147792.19,147816.22,147840.25,147888.31,147936.39,147984.47,148032.56,148080.66,148128.77,148176.88,148225,148321.27,148417.56,148513.89,148610.25,148706.64,148803.06,148899.52,148996,149189.06,149382.25,149575.56,149769,149962.56,150156.25,150350.06,150544,150932.25,151321,151710.25,152100,152490.25,152881,153272.25,153664,154449,155236,156025,156816,157609,158404,159201,160000,161604,163216,164836,166464,168100,169744,171396,173056,176400,179776,183184,186624,190096,193600,197136,200704,207936,215296,222784,230400,238144,246016,254016,262144,278784,295936,313600,331776,350464,369664,389376,409600,451584,495616,541696,589824,640000,692224,746496,173056,173048.69,173047.88,173047.06,173046.25,173045.44,173044.62,173043.81,173043,173041.38,173039.75,173038.12,173036.5,173034.88,173033.25,173031.62,173030,173026.75,173023.5,173020.25,173017,173013.75,173010.5,173007.25,173004,172997.5,172991,172984.5,172978.02,172971.52,172965.02,172958.52,172952.02,172939.02,172926.03,172913.03,172900.03,172887.05,172874.05,172861.06,172848.06,172822.08,172796.1,172770.12,172744.14,172718.17,172692.19,172666.22,172640.25,172588.31,172536.39,172484.47,172432.56,172380.66,172328.77,172276.88,172225,172121.27,172017.56,171913.89,171810.25,171706.64,171603.06,171499.52,171396,171189.06,170982.25,170775.56,170569,170362.56,170156.25,169950.06,169744,169332.25,168921,168510.25,168100,167690.25,167281,166872.25,166464,165649,164836,164025,163216,162409,161604,160801,160000,158404,156816,155236,153664,152100,150544,148996,147456,144400,141376,138384,135424,132496,129600,126736,123904,118336,112896,107584,102400,97344,92416,87616,82944,73984,65536,57600,50176,43264,36864,30976,25600,16384,9216,4096,1024,0,1024,4096,173062.5,173063.31,173064.12,173064.94,173065.75,173066.56,173067.38,173068.19,173069,173070.62,173072.25,173073.88,173075.5,173077.12,173078.75,173080.38,173082,173085.25,173088.5,173091.75,173095,173098.25,173101.5,173104.75,173108,173114.5,173121,173127.5,173134.02,173140.52,173147.02,173153.52,173160.02,173173.02,173186.03,173199.03,173212.03,173225.05,173238.05,173251.06,173264.06,173290.08,173316.1,173342.12,173368.14,173394.17,173420.19,173446.22,173472.25,173524.31,173576.39,173628.47,173680.56,173732.66,173784.77,173836.88,173889,173993.27,174097.56,174201.89,174306.25,174410.64,174515.06,174619.52,174724,174933.06,175142.25,175351.56,175561,175770.56,175980.25,176190.06,176400,176820.25,177241,177662.25,178084,178506.25,178929,179352.25,179776,180625,181476,182329,183184,184041,184900,185761,186624,188356,190096,191844,193600,195364,197136,198916,200704,204304,207936,211600,215296,219024,222784,226576,230400,238144,246016,254016,262144,270400,278784,287296,295936,313600,331776,350464,369664,389376,409600,430336,451584,495616,541696,589824,640000,692224,746496,802816,200704,200696.12,200695.25,200694.38,200693.5,200692.62,200691.75,200690.88,200690,200688.25,200686.5,200684.75,200683,200681.25,200679.5,200677.75,200676,200672.5,200669,200665.5,200662,200658.5,200655,200651.5,200648,200641,200634,200627,200620.02,200613.02,200606.02,200599.02,200592.02,200578.02,200564.03,200550.03,200536.03,200522.05,200508.05,200494.06,200480.06,200452.08,200424.1,200396.12,200368.14,200340.17,200312.19,200284.22,200256.25,200200.31,200144.39,200088.47,200032.56,199976.66,199920.77,199864.88,199809,199697.27,199585.56,199473.89,199362.25,199250.64,199139.06,199027.52,198916,198693.06,198470.25,198247.56,198025,197802.56,197580.25,197358.06,197136,196692.25,196249,195806.25,195364,194922.25,194481,194040.25,193600,192721,191844,190969,190096,189225,188356,187489,186624,184900,183184,181476,179776,178084,176400,174724,173056,169744,166464,163216,160000,156816,153664,150544,147456,141376,135424,129600,123904,118336,112896,107584,102400,92416,82944,73984,65536,57600,50176,43264,36864,25600,16384,9216,4096,1024,0,1024,200711,200711.88,200712.75,200713.62,200714.5,200715.38,200716.25,200717.12,200718,200719.75,200721.5,200723.25,200725,200726.75,200728.5,200730.25,200732,200735.5,200739,200742.5,200746,200749.5,200753,200756.5,200760,200767,200774,200781,200788.02,200795.02,200802.02,200809.02,200816.02,200830.02,200844.03,200858.03,200872.03,200886.05,200900.05,200914.06,200928.06,200956.08,200984.1,201012.12,201040.14,201068.17,201096.19,201124.22,201152.25,201208.31,201264.39,201320.47,201376.56,201432.66,201488.77,201544.88,201601,201713.27,201825.56,201937.89,202050.25,202162.64,202275.06,202387.52,202500,202725.06,202950.25,203175.56,203401,203626.56,203852.25,204078.06,204304,204756.25,205209,205662.25,206116,206570.25,207025,207480.25,207936,208849,209764,210681,211600,212521,213444,214369,215296,217156,219024,220900,222784,224676,226576,228484,230400,234256,238144,242064,246016,250000,254016,258064,262144,270400,278784,287296,295936,304704,313600,322624,331776,350464,369664,389376,409600,430336,451584,473344,495616,541696,589824,640000,692224,746496,802816,861184,230400,230391.56,230390.62,230389.69,230388.75,230387.81,230386.88,230385.94,230385,230383.12,230381.25,230379.38,230377.5,230375.62,230373.75,230371.88,230370,230366.25,230362.5,230358.75,230355,230351.25,230347.5,230343.75,230340,230332.5,230325,230317.5,230310.02,230302.52,230295.02,230287.52,230280.02,230265.02,230250.03,230235.03,230220.03,230205.05,230190.05,230175.06,230160.06,230130.08,230100.1,230070.12,230040.14,230010.17,229980.19,229950.22,229920.25,229860.31,229800.39,229740.47,229680.56,229620.66,229560.77,229500.88,229441,229321.27,229201.56,229081.89,228962.25,228842.64,228723.06,228603.52,228484,228245.06,228006.25,227767.56,227529,227290.56,227052.25,226814.06,226576,226100.25,225625,225150.25,224676,224202.25,223729,223256.25,222784,221841,220900,219961,219024,218089,217156,216225,215296,213444,211600,209764,207936,206116,204304,202500,200704,197136,193600,190096,186624,183184,179776,176400,173056,166464,160000,153664,147456,141376,135424,129600,123904,112896,102400,92416,82944,73984,65536,57600,50176,36864,25600,16384,9216,4096,1024,0,230407.5,230408.44,230409.38,230410.31,230411.25,230412.19,230413.12,230414.06,230415,230416.88,230418.75,230420.62,230422.5,230424.38,230426.25,230428.12,230430,230433.75,230437.5,230441.25,230445,230448.75,230452.5,230456.25,230460,230467.5,230475,230482.5,230490.02,230497.52,230505.02,230512.52,230520.02,230535.02,230550.03,230565.03,230580.03,230595.05,230610.05,230625.06,230640.06,230670.08,230700.1,230730.12,230760.14,230790.17,230820.19,230850.22,230880.25,230940.31,231000.39,231060.47,231120.56,231180.66,231240.77,231300.88,231361,231481.27,231601.56,231721.89,231842.25,231962.64,232083.06,232203.52,232324,232565.06,232806.25,233047.56,233289,233530.56,233772.25,234014.06,234256,234740.25,235225,235710.25,236196,236682.25,237169,237656.25,238144,239121,240100,241081,242064,243049,244036,245025,246016,248004,250000,252004,254016,256036,258064,260100,262144,266256,270400,274576,278784,283024,287296,291600,295936,304704,313600,322624,331776,341056,350464,360000,369664,389376,409600,430336,451584,473344,495616,518400,541696,589824,640000,692224,746496,802816,861184,921600,6.1035156e-05,0.00027561188,0.00030899048,0.00034427643,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00064468384,0.00074768066,0.0008583069,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0018463135,0.0021972656,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.25787354,0.32525635,0.40045166,0.48345947,0.5742798,0.6729126,0.7793579,0.8936157,1.015686,1.2832642,1.5820923,1.9121704,2.2734985,2.6660767,3.0899048,3.544983,4.031311,5.0977173,6.2891235,7.60553,9.046936,10.613342,12.304749,14.121155,16.062561,20.320374,25.078186,30.335999,36.09381,42.351624,49.109436,56.36725,64.12506,81.140686,100.15631,121.171936,144.18756,169.20319,196.21881,225.23444,256.25006,324.2813,400.31256,484.3438,576.37506,676.4063,784.43756,900.4688,1024.5,1296.5625,1600.625,1936.6875,2304.75,2704.8125,3136.875,3600.9375,4097,5185.125,6401.25,7745.375,9217.5,10817.625,12545.75,14401.875,16386,20738.25,25602.5,30978.75,36867,43267.25,50179.5,57603.75,65540,82948.5,102405,123909.5,147462,173062.5,200711,230407.5,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,2.3841858e-05,3.4332275e-05,4.673004e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00030899048,0.00038146973,0.00046157837,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0021972656,0.0025787354,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.30767822,0.3809204,0.4619751,0.5508423,0.647522,0.75201416,0.86431885,0.98443604,1.2481079,1.5430298,1.8692017,2.2266235,2.6152954,3.0352173,3.4863892,3.968811,5.027405,6.2109985,7.5195923,8.953186,10.51178,12.195374,14.003967,15.937561,20.179749,24.921936,30.164124,35.90631,42.1485,48.890686,56.132874,63.87506,80.859436,99.84381,120.828186,143.81256,168.79694,195.78131,224.76569,255.75006,323.7188,399.68756,483.6563,575.62506,675.5938,783.56256,899.5313,1023.50006,1295.4375,1599.375,1935.3125,2303.25,2703.1875,3135.125,3599.0625,4095,5182.875,6398.75,7742.625,9214.5,10814.375,12542.25,14398.125,16382,20733.75,25597.5,30973.25,36861,43260.75,50172.5,57596.25,65532,82939.5,102395,123898.5,147450,173049.5,200697,230392.5,7.724762e-05,0.00030899048,0.00034427643,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.0006952286,0.0008020401,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0019311905,0.002289772,0.0026788712,0.0030984879,0.0035486221,0.004029274,0.0045404434,0.0050821304,0.006257057,0.0075540543,0.008973122,0.010514259,0.012177467,0.013962746,0.015870094,0.017899513,0.022324562,0.027237892,0.032639503,0.038529396,0.04490757,0.051774025,0.05912876,0.06697178,0.08412266,0.10322666,0.12428379,0.14729404,0.17225742,0.19917393,0.22804356,0.2588663,0.3263712,0.40168858,0.48481846,0.57576084,0.6745157,0.7810831,0.895463,1.0176554,1.2854776,1.5845499,1.9148722,2.2764444,2.6692667,3.093339,3.5486612,4.0352335,5.102128,6.2940226,7.610917,9.052812,10.619706,12.311601,14.128495,16.070389,20.329178,25.087967,30.346756,36.105545,42.364334,49.123123,56.381912,64.1407,81.15828,100.17586,121.193436,144.21101,169.22859,196.24617,225.26375,256.28134,324.3165,400.35165,484.3868,576.42194,676.4571,784.49225,900.5274,1024.5626,1296.6329,1600.7032,1936.7736,2304.8438,2704.914,3136.9844,3601.0547,4097.125,5185.2656,6401.4062,7745.547,9217.6875,10817.828,12545.969,14402.109,16386.25,20738.531,25602.812,30979.094,36867.375,43267.656,50179.938,57604.22,65540.5,82949.06,102405.625,123910.19,147462.75,173063.31,200711.88,230408.44,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,2.3841858e-05,3.4332275e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00034427643,0.00042057037,0.0005044937,0.0006952286,0.000916481,0.001168251,0.0014505386,0.0017633438,0.0021066666,0.002480507,0.0028848648,0.0037851334,0.004807472,0.0059518814,0.007218361,0.008606911,0.010117531,0.011750221,0.013504982,0.017380714,0.021744728,0.026597023,0.0319376,0.037766457,0.044083595,0.050889015,0.058182716,0.07423496,0.09224033,0.11219883,0.13411045,0.1579752,0.18379307,0.21156406,0.24128819,0.3065958,0.37971592,0.46064854,0.54939365,0.6459513,0.7503214,0.862504,0.9824991,1.2459269,1.5406046,1.8665323,2.22371,2.6121378,3.0318155,3.4827433,3.964921,5.0230265,6.206132,7.5142374,8.947343,10.505448,12.188554,13.996659,15.929765,20.170975,24.912186,30.153397,35.894608,42.13582,48.87703,56.11824,63.85945,80.84187,99.824295,120.80672,143.78914,168.77156,195.75398,224.7364,255.71883,323.6837,399.64853,483.61337,575.5782,675.543,783.5079,899.4727,1023.43756,1295.3673,1599.297,1935.2267,2303.1562,2703.086,3135.0156,3598.9453,4094.875,5182.7344,6398.5938,7742.453,9214.3125,10814.172,12542.031,14397.891,16381.75,20733.469,25597.188,30972.906,36860.625,43260.344,50172.062,57595.78,65531.5,82938.94,102394.375,123897.81,147449.25,173048.69,200696.12,230391.56,9.536743e-05,0.00034427643,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.00074768066,0.0008583069,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0020179749,0.0023841858,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.006412506,0.007724762,0.009159088,0.010715485,0.012393951,0.0141944885,0.016117096,0.018161774,0.02261734,0.027561188,0.032993317,0.038913727,0.04532242,0.05221939,0.059604645,0.06747818,0.084690094,0.10385513,0.1249733,0.14804459,0.173069,0.20004654,0.2289772,0.259861,0.32748795,0.4029274,0.48617935,0.5772438,0.67612076,0.7828102,0.89731216,1.0196266,1.287693,1.5870094,1.9175758,2.2793922,2.6724586,3.096775,3.5523415,4.039158,5.1065407,6.2989235,7.6163063,9.058689,10.626072,12.318455,14.135838,16.07822,20.337986,25.097752,30.357517,36.117283,42.37705,49.136814,56.39658,64.15634,81.17587,100.195404,121.214935,144.23447,169.254,196.27353,225.29306,256.3126,324.35165,400.39072,484.42978,576.4689,676.50793,784.547,900.58606,1024.6251,1296.7032,1600.7814,1936.8595,2304.9375,2705.0156,3137.0938,3601.1719,4097.25,5185.4062,6401.5625,7745.7188,9217.875,10818.031,12546.1875,14402.344,16386.5,20738.812,25603.125,30979.438,36867.75,43268.062,50180.375,57604.688,65541,82949.625,102406.25,123910.875,147463.5,173064.12,200712.75,230409.38,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,2.3841858e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00030899048,0.00038146973,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0020179749,0.0023841858,0.0027809143,0.003665924,0.004673004,0.0058021545,0.0070533752,0.008426666,0.009922028,0.011539459,0.013278961,0.017124176,0.021457672,0.02627945,0.031589508,0.037387848,0.04367447,0.05044937,0.057712555,0.073703766,0.0916481,0.11154556,0.13339615,0.15719986,0.1829567,0.21066666,0.24032974,0.3055153,0.37851334,0.45932388,0.54794693,0.6443825,0.7486305,0.8606911,0.9805641,1.2437477,1.5381813,1.8638649,2.2207985,2.608982,3.0284157,3.4790993,3.9610329,5.01865,6.2012672,7.5088844,8.941502,10.499119,12.181736,13.989353,15.92197,20.162205,24.90244,30.142673,35.882908,42.123142,48.863377,56.10361,63.843845,80.82431,99.80478,120.78525,143.76572,168.74619,195.72665,224.70712,255.68759,323.64853,399.60947,483.5704,575.5314,675.4923,783.45325,899.4142,1023.3751,1295.297,1599.2189,1935.1407,2303.0625,2702.9844,3134.9062,3598.8281,4094.75,5182.5938,6398.4375,7742.2812,9214.125,10813.969,12541.8125,14397.656,16381.5,20733.188,25596.875,30972.562,36860.25,43259.938,50171.625,57595.312,65531,82938.375,102393.75,123897.125,147448.5,173047.88,200695.25,230390.62,0.00011539459,0.00038146973,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.0008020401,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0017633438,0.0021066666,0.002480507,0.0028848648,0.0033197403,0.0037851334,0.004281044,0.004807472,0.005364418,0.0065698624,0.007897377,0.009346962,0.010918617,0.012612343,0.014428139,0.016366005,0.018425941,0.022912025,0.02788639,0.033349037,0.039299965,0.045739174,0.052666664,0.060082436,0.06798649,0.08525944,0.10448551,0.12566471,0.14879704,0.17388248,0.20092106,0.22991276,0.26085758,0.3286066,0.40416813,0.48754215,0.5787287,0.6777277,0.7845392,0.89916325,1.0215998,1.2899103,1.5894709,1.9202814,2.282342,2.6756525,3.100213,3.5560236,4.043084,5.110955,6.3038263,7.6216974,9.0645685,10.63244,12.325311,14.143182,16.086052,20.346794,25.107536,30.368279,36.12902,42.389763,49.150505,56.411247,64.17199,81.193474,100.21496,121.23644,144.25793,169.27942,196.3009,225.32239,256.34387,324.38684,400.4298,484.47278,576.51575,676.5587,784.6017,900.64465,1024.6876,1296.7736,1600.8595,1936.9454,2305.0312,2705.1172,3137.2031,3601.289,4097.375,5185.547,6401.7188,7745.8906,9218.0625,10818.234,12546.406,14402.578,16386.75,20739.094,25603.438,30979.781,36868.125,43268.47,50180.812,57605.156,65541.5,82950.19,102406.875,123911.56,147464.25,173064.94,200713.62,230410.31,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,2.3841858e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00034427643,0.00042057037,0.00059604645,0.0008020401,0.0010385513,0.0013055801,0.0016031265,0.0019311905,0.002289772,0.0026788712,0.0035486221,0.0045404434,0.005654335,0.006890297,0.008248329,0.009728432,0.011330605,0.013054848,0.016869545,0.021172523,0.025963783,0.031243324,0.037011147,0.04326725,0.050011635,0.0572443,0.07317448,0.09105778,0.1108942,0.13268375,0.15642643,0.18212223,0.20977116,0.2393732,0.30443668,0.37731266,0.45800114,0.5465021,0.6428156,0.74694157,0.85888004,0.978631,1.2415705,1.5357599,1.8611994,2.2178888,2.6058283,3.0250177,3.4754572,3.9571466,5.0142756,6.1964045,7.5035334,8.935662,10.492791,12.17492,13.982049,15.914178,20.153435,24.892693,30.13195,35.87121,42.110466,48.849724,56.08898,63.82824,80.806755,99.78527,120.76379,143.74231,168.72083,195.69934,224.67786,255.65637,323.6134,399.57043,483.52747,575.4845,675.4415,783.39856,899.3556,1023.3126,1295.2267,1599.1407,1935.0548,2302.9688,2702.8828,3134.7969,3598.711,4094.625,5182.453,6398.2812,7742.1094,9213.9375,10813.766,12541.594,14397.422,16381.25,20732.906,25596.562,30972.219,36859.875,43259.53,50171.188,57594.844,65530.5,82937.81,102393.125,123896.44,147447.75,173047.06,200694.38,230389.69,0.0001373291,0.00042057037,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008583069,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0021972656,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.006729126,0.008071899,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.23085022,0.26185608,0.32972717,0.40541077,0.48890686,0.58021545,0.67933655,0.78627014,0.90101624,1.0235748,1.2921295,1.5919342,1.9229889,2.2852936,2.6788483,3.103653,3.5597076,4.0470123,5.1153717,6.308731,7.6270905,9.07045,10.638809,12.332169,14.150528,16.093887,20.355606,25.117325,30.379044,36.140762,42.40248,49.1642,56.42592,64.18764,81.211075,100.23451,121.25795,144.28139,169.30482,196.32826,225.3517,256.37512,324.422,400.46887,484.51575,576.5626,676.6095,784.6564,900.70325,1024.7501,1296.8439,1600.9376,1937.0314,2305.1252,2705.219,3137.3127,3601.4065,4097.5,5185.6875,6401.875,7746.0625,9218.25,10818.4375,12546.625,14402.8125,16387,20739.375,25603.75,30980.125,36868.5,43268.875,50181.25,57605.625,65542,82950.75,102407.5,123912.25,147465,173065.75,200714.5,230411.25,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00030899048,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0021972656,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.009536743,0.011123657,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.072647095,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.23841858,0.30336,0.3761139,0.4566803,0.5450592,0.6412506,0.7452545,0.8570709,0.9766998,1.2393951,1.5333405,1.8585358,2.214981,2.6026764,3.0216217,3.471817,3.9532623,5.009903,6.1915436,7.498184,8.929825,10.486465,12.168106,13.974747,15.906387,20.144669,24.88295,30.121231,35.859512,42.097794,48.836075,56.074356,63.812637,80.7892,99.76576,120.742325,143.71889,168.69545,195.67201,224.64857,255.62514,323.57825,399.53137,483.4845,575.4376,675.39075,783.3439,899.297,1023.2501,1295.1564,1599.0626,1934.9689,2302.8752,2702.7815,3134.6877,3598.594,4094.5002,5182.3125,6398.125,7741.9375,9213.75,10813.5625,12541.375,14397.1875,16381,20732.625,25596.25,30971.875,36859.5,43259.125,50170.75,57594.375,65530,82937.25,102392.5,123895.75,147447,173046.25,200693.5,230388.75,0.00016117096,0.00046157837,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0017633438,0.0019311905,0.002289772,0.0026788712,0.0030984879,0.0035486221,0.004029274,0.0045404434,0.0050821304,0.005654335,0.006890297,0.008248329,0.009728432,0.011330605,0.013054848,0.014901161,0.016869545,0.01896,0.023507118,0.028542519,0.0340662,0.040078163,0.046578407,0.053566933,0.06104374,0.06900883,0.08640385,0.10575199,0.12705326,0.15030766,0.17551517,0.20267582,0.23178959,0.26285648,0.33084965,0.4066553,0.49027348,0.58170414,0.6809473,0.78800297,0.90287113,1.0255518,1.2943506,1.5943995,1.9256983,2.288247,2.682046,3.1070948,3.5633936,4.0509424,5.11979,6.3136377,7.6324854,9.076333,10.645181,12.339028,14.157876,16.101723,20.364418,25.127113,30.389809,36.152504,42.4152,49.177895,56.44059,64.203285,81.228676,100.25407,121.27946,144.30486,169.33025,196.35564,225.38103,256.4064,324.45718,400.50797,484.55875,576.60956,676.66034,784.7111,900.7619,1024.8126,1296.9142,1601.0157,1937.1173,2305.219,2705.3206,3137.422,3601.5237,4097.625,5185.828,6402.0312,7746.2344,9218.4375,10818.641,12546.844,14403.047,16387.25,20739.656,25604.062,30980.469,36868.875,43269.28,50181.688,57606.094,65542.5,82951.31,102408.125,123912.94,147465.75,173066.56,200715.38,230412.19,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,8.583069e-06,2.3841858e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00034427643,0.0005044937,0.0006952286,0.000916481,0.001168251,0.0014505386,0.0017633438,0.0021066666,0.002480507,0.0033197403,0.004281044,0.005364418,0.0065698624,0.007897377,0.009346962,0.010918617,0.012612343,0.016366005,0.020607948,0.025338173,0.030556679,0.036263466,0.042458534,0.049141884,0.056313515,0.07212162,0.08988285,0.109597206,0.13126469,0.15488529,0.18045902,0.20798588,0.23746586,0.3022852,0.37491703,0.45536137,0.5436182,0.63968754,0.7435694,0.8552637,0.97477055,1.2372217,1.5309229,1.8558741,2.2120752,2.5995264,3.0182276,3.4681787,3.94938,5.0055323,6.1866846,7.492837,8.923989,10.480142,12.161294,13.967446,15.898599,20.135902,24.873207,30.110512,35.847816,42.08512,48.822426,56.05973,63.797035,80.771645,99.746254,120.72086,143.69548,168.67009,195.6447,224.61931,255.59392,323.54312,399.49234,483.44156,575.3908,675.34,783.28925,899.23846,1023.1877,1295.086,1598.9845,1934.8829,2302.7815,2702.68,3134.5784,3598.4768,4094.3752,5182.172,6397.9688,7741.7656,9213.5625,10813.359,12541.156,14396.953,16380.75,20732.344,25595.938,30971.531,36859.125,43258.72,50170.312,57593.906,65529.5,82936.69,102391.875,123895.06,147446.25,173045.44,200692.62,230387.81,0.00018692017,0.0005044937,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0023841858,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.0070533752,0.008426666,0.009922028,0.011539459,0.013278961,0.015140533,0.017124176,0.019229889,0.023807526,0.028873444,0.034427643,0.040470123,0.047000885,0.054019928,0.061527252,0.06952286,0.08697891,0.10638809,0.1277504,0.15106583,0.17633438,0.20355606,0.23273087,0.2638588,0.33197403,0.40790176,0.491642,0.58319473,0.68255997,0.7897377,0.90472794,1.0275307,1.2965736,1.5968666,1.9284096,2.2912025,2.6852455,3.1105385,3.5670815,4.0548744,5.1242104,6.3185463,7.637882,9.082218,10.651554,12.34589,14.165226,16.109562,20.373234,25.136906,30.400578,36.16425,42.42792,49.191593,56.455265,64.21893,81.24628,100.27362,121.300964,144.32831,169.35565,196.383,225.41034,256.43768,324.49237,400.54706,484.60175,576.65643,676.7111,784.7658,900.8205,1024.8752,1296.9846,1601.094,1937.2034,2305.3127,2705.422,3137.5315,3601.6409,4097.75,5185.9688,6402.1875,7746.4062,9218.625,10818.844,12547.0625,14403.281,16387.5,20739.938,25604.375,30980.812,36869.25,43269.688,50182.125,57606.562,65543,82951.875,102408.75,123913.625,147466.5,173067.38,200716.25,230413.12,3.4332275e-05,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0020179749,0.0023841858,0.0032081604,0.0041542053,0.0052223206,0.006412506,0.007724762,0.009159088,0.010715485,0.012393951,0.016117096,0.020328522,0.025028229,0.030216217,0.035892487,0.042057037,0.04870987,0.055850983,0.07159805,0.08929825,0.10895157,0.13055801,0.15411758,0.17963028,0.2070961,0.23651505,0.3012123,0.37372208,0.45404434,0.5421791,0.6381264,0.74188614,0.8534584,0.97284317,1.2350502,1.5285072,1.8532143,2.2091713,2.5963783,3.0148354,3.4645424,3.9454994,5.0011635,6.1818275,7.4874916,8.918156,10.47382,12.154484,13.960148,15.890812,20.12714,24.863468,30.099796,35.836124,42.072453,48.80878,56.04511,63.781437,80.75409,99.726746,120.6994,143.67206,168.64471,195.61737,224.59003,255.56268,323.508,399.4533,483.39862,575.34393,675.28925,783.23456,899.1799,1023.1252,1295.0159,1598.9065,1934.7971,2302.6877,2702.5784,3134.469,3598.3596,4094.2502,5182.0312,6397.8125,7741.5938,9213.375,10813.156,12540.9375,14396.719,16380.5,20732.062,25595.625,30971.188,36858.75,43258.312,50169.875,57593.438,65529,82936.125,102391.25,123894.375,147445.5,173044.62,200691.75,230386.88,0.00021457672,0.0005493164,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.000916481,0.0010385513,0.001168251,0.0013055801,0.0014505386,0.0016031265,0.0017633438,0.0019311905,0.0021066666,0.002480507,0.0028848648,0.0033197403,0.0037851334,0.004281044,0.004807472,0.005364418,0.0059518814,0.007218361,0.008606911,0.010117531,0.011750221,0.013504982,0.015381813,0.017380714,0.019501686,0.02410984,0.029206276,0.034790993,0.04086399,0.04742527,0.05447483,0.062012672,0.070038795,0.087555885,0.1070261,0.12844944,0.1518259,0.1771555,0.20443821,0.23367405,0.264863,0.33310032,0.40915012,0.49301243,0.58468723,0.68417454,0.79147434,0.90658665,1.0295115,1.2987986,1.5993357,1.9311228,2.29416,2.688447,3.113984,3.5707712,4.0588083,5.1286325,6.323457,7.643281,9.088105,10.657929,12.352754,14.172578,16.117401,20.38205,25.146698,30.411346,36.175995,42.440643,49.20529,56.46994,64.23459,81.263885,100.29318,121.32248,144.35178,169.38107,196.41037,225.43967,256.46896,324.52756,400.58615,484.64474,576.70337,676.76196,784.82056,900.87915,1024.9377,1297.0549,1601.1721,1937.2893,2305.4065,2705.5237,3137.6409,3601.758,4097.875,5186.1094,6402.3438,7746.578,9218.8125,10819.047,12547.281,14403.516,16387.75,20740.219,25604.688,30981.156,36869.625,43270.094,50182.562,57607.03,65543.5,82952.44,102409.375,123914.31,147467.25,173068.19,200717.12,230414.06,4.673004e-05,3.4332275e-05,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,9.536743e-07,8.583069e-06,2.3841858e-05,4.673004e-05,7.724762e-05,0.00011539459,0.00016117096,0.00021457672,0.00027561188,0.00042057037,0.00059604645,0.0008020401,0.0010385513,0.0013055801,0.0016031265,0.0019311905,0.002289772,0.0030984879,0.004029274,0.0050821304,0.006257057,0.0075540543,0.008973122,0.010514259,0.012177467,0.015870094,0.020051003,0.024720192,0.029877663,0.035523415,0.041657448,0.048279762,0.055390358,0.07107639,0.08871555,0.10830784,0.12985325,0.15335178,0.17880344,0.20620823,0.23556614,0.30014133,0.37252903,0.45272923,0.5407419,0.6365671,0.7402048,0.851655,0.9709177,1.2328806,1.5260935,1.8505564,2.2062693,2.5932322,3.011445,3.460908,3.9416208,4.9967966,6.1769724,7.482148,8.912324,10.4675,12.1476755,13.952851,15.883027,20.118378,24.85373,30.08908,35.824432,42.059784,48.795135,56.030487,63.76584,80.73654,99.707245,120.67795,143.64865,168.61935,195.59006,224.56076,255.53146,323.47287,399.41428,483.35568,575.2971,675.2385,783.17993,899.12134,1023.06274,1294.9456,1598.8284,1934.7112,2302.594,2702.4768,3134.3596,3598.2424,4094.1252,5181.8906,6397.6562,7741.422,9213.1875,10812.953,12540.719,14396.484,16380.25,20731.781,25595.312,30970.844,36858.375,43257.906,50169.438,57592.97,65528.5,82935.56,102390.625,123893.69,147444.75,173043.81,200690.88,230385.94,0.00024414062,0.00059604645,0.00064468384,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.000916481,0.0009765625,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,3.574463,4.062744,5.1330566,6.328369,7.6486816,9.093994,10.664307,12.359619,14.179932,16.125244,20.39087,25.156494,30.42212,36.187744,42.45337,49.218994,56.48462,64.250244,81.281494,100.312744,121.343994,144.37524,169.4065,196.43774,225.469,256.50024,324.56274,400.62524,484.68774,576.75024,676.81274,784.87524,900.93774,1025.0002,1297.1252,1601.2502,1937.3752,2305.5002,2705.6252,3137.7502,3601.8752,4098,5186.25,6402.5,7746.75,9219,10819.25,12547.5,14403.75,16388,20740.5,25605,30981.5,36870,43270.5,50183,57607.5,65544,82953,102410,123915,147468,173069,200718,230415,6.1035156e-05,4.673004e-05,3.4332275e-05,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,9.536743e-07,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.2307129,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,3.9377441,4.9924316,6.172119,7.4768066,8.906494,10.461182,12.140869,13.945557,15.875244,20.10962,24.843994,30.07837,35.812744,42.04712,48.781494,56.01587,63.750244,80.718994,99.687744,120.656494,143.62524,168.594,195.56274,224.5315,255.50024,323.43774,399.37524,483.31274,575.25024,675.18774,783.12524,899.06274,1023.00024,1294.8752,1598.7502,1934.6252,2302.5002,2702.3752,3134.2502,3598.1252,4094.0002,5181.75,6397.5,7741.25,9213,10812.75,12540.5,14396.25,16380,20731.5,25595,30970.5,36858,43257.5,50169,57592.5,65528,82935,102390,123893,147444,173043,200690,230385,0.00030899048,0.0006952286,0.00074768066,0.0008020401,0.0008583069,0.000916481,0.0009765625,0.0010385513,0.0011024475,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.007724762,0.009159088,0.010715485,0.012393951,0.0141944885,0.016117096,0.018161774,0.020328522,0.025028229,0.030216217,0.035892487,0.042057037,0.04870987,0.055850983,0.06348038,0.07159805,0.08929825,0.10895157,0.13055801,0.15411758,0.17963028,0.2070961,0.23651505,0.26788712,0.33649063,0.41290665,0.49713516,0.5891762,0.6890297,0.7966957,0.9121742,1.0354652,1.3054848,1.6067543,1.9392738,2.3030434,2.698063,3.1243324,3.581852,4.0706215,5.1419106,6.3381996,7.6594887,9.105778,10.677067,12.373356,14.194645,16.140934,20.408512,25.17609,30.443668,36.211246,42.478825,49.246403,56.51398,64.281555,81.31671,100.35187,121.387024,144.42218,169.45734,196.4925,225.52765,256.5628,324.63312,400.70343,484.77374,576.84406,676.91437,784.9847,901.055,1025.1254,1297.266,1601.4066,1937.5472,2305.6877,2705.8284,3137.969,3602.1096,4098.2505,5186.5317,6402.813,7747.094,9219.375,10819.656,12547.9375,14404.219,16388.5,20741.062,25605.625,30982.188,36870.75,43271.312,50183.875,57608.438,65545,82954.125,102411.25,123916.375,147469.5,173070.62,200719.75,230416.88,9.536743e-05,7.724762e-05,6.1035156e-05,4.673004e-05,3.4332275e-05,2.3841858e-05,1.5258789e-05,8.583069e-06,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0020179749,0.0027809143,0.003665924,0.004673004,0.0058021545,0.0070533752,0.008426666,0.009922028,0.011539459,0.015140533,0.019229889,0.023807526,0.028873444,0.034427643,0.040470123,0.047000885,0.054019928,0.06952286,0.08697891,0.10638809,0.1277504,0.15106583,0.17633438,0.20355606,0.23273087,0.29693985,0.36896133,0.44879532,0.5364418,0.6319008,0.7351723,0.84625626,0.96515274,1.2263832,1.5188637,1.8425941,2.1975746,2.583805,3.0012856,3.450016,3.9299965,4.9837074,6.1624184,7.4661293,8.89484,10.448551,12.127262,13.930973,15.859684,20.092106,24.824528,30.05695,35.78937,42.021793,48.754215,55.986637,63.71906,80.6839,99.64874,120.61359,143.57843,168.54327,195.50812,224.47296,255.4378,323.3675,399.29718,483.22687,575.15656,675.08624,783.0159,898.9456,1022.8753,1294.7347,1598.5941,1934.4535,2302.3127,2702.172,3134.0315,3597.8909,4093.7502,5181.469,6397.188,7740.9067,9212.625,10812.344,12540.0625,14395.781,16379.5,20730.938,25594.375,30969.812,36857.25,43256.688,50168.125,57591.562,65527,82933.875,102388.75,123891.625,147442.5,173041.38,200688.25,230383.12,0.00038146973,0.0008020401,0.0008583069,0.000916481,0.0009765625,0.0010385513,0.0011024475,0.001168251,0.0012359619,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.008071899,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.064468384,0.072647095,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.23841858,0.26991272,0.33876038,0.41542053,0.4998932,0.59217834,0.692276,0.80018616,0.9159088,1.039444,1.3099518,1.6117096,1.9447174,2.3089752,2.704483,3.1312408,3.5892487,4.0785065,5.150772,6.3480377,7.6703033,9.117569,10.689835,12.3871,14.209366,16.156631,20.426163,25.195694,30.465225,36.234756,42.504288,49.27382,56.54335,64.31288,81.351944,100.39101,121.43007,144.46913,169.5082,196.54726,225.58632,256.62537,324.7035,400.78162,484.85974,576.93787,677.016,785.0941,901.17224,1025.2504,1297.4066,1601.5629,1937.7191,2305.8755,2706.0317,3138.188,3602.3442,4098.5005,5186.813,6403.1255,7747.438,9219.75,10820.0625,12548.375,14404.6875,16389,20741.625,25606.25,30982.875,36871.5,43272.125,50184.75,57609.375,65546,82955.25,102412.5,123917.75,147471,173072.25,200721.5,230418.75,0.0001373291,0.00011539459,9.536743e-05,7.724762e-05,6.1035156e-05,4.673004e-05,3.4332275e-05,2.3841858e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.009536743,0.011123657,0.014663696,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.068496704,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.23085022,0.29481506,0.3665924,0.44618225,0.5335846,0.62879944,0.7318268,0.8426666,0.96131897,1.2220612,1.5140533,1.8372955,2.1917877,2.57753,2.994522,3.4427643,3.9222565,4.974991,6.152725,7.4554596,8.883194,10.435928,12.113663,13.916397,15.844131,20.0746,24.805069,30.035538,35.766006,41.996475,48.726944,55.957413,63.68788,80.64882,99.60976,120.570694,143.53163,168.49257,195.4535,224.41444,255.37538,323.29724,399.21912,483.141,575.06287,674.98474,782.9066,898.8285,1022.75037,1294.5941,1598.4379,1934.2816,2302.1255,2701.9692,3133.813,3597.6567,4093.5005,5181.188,6396.8755,7740.563,9212.25,10811.9375,12539.625,14395.3125,16379,20730.375,25593.75,30969.125,36856.5,43255.875,50167.25,57590.625,65526,82932.75,102387.5,123890.25,147441,173039.75,200686.5,230381.25,0.00046157837,0.000916481,0.0009765625,0.0010385513,0.0011024475,0.001168251,0.0012359619,0.0013055801,0.0013771057,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.0070533752,0.008426666,0.009922028,0.011539459,0.013278961,0.015140533,0.017124176,0.019229889,0.021457672,0.02627945,0.031589508,0.037387848,0.04367447,0.05044937,0.057712555,0.06546402,0.073703766,0.0916481,0.11154556,0.13339615,0.15719986,0.1829567,0.21066666,0.24032974,0.27194595,0.34103775,0.41794205,0.50265884,0.59518814,0.69552994,0.80368423,0.91965103,1.0434303,1.3144264,1.6166725,1.9501686,2.3149147,2.7109108,3.138157,3.596653,4.086399,5.1596413,6.3578835,7.6811256,9.129368,10.70261,12.400852,14.224094,16.172337,20.44382,25.215305,30.48679,36.258274,42.52976,49.301243,56.572727,64.34421,81.38718,100.430145,121.473114,144.51608,169.55905,196.60202,225.64499,256.68796,324.7739,400.85983,484.94577,577.03174,677.1177,785.2036,901.28955,1025.3755,1297.5474,1601.7192,1937.8911,2306.063,2706.2349,3138.4067,3602.5786,4098.7505,5187.094,6403.438,7747.7817,9220.125,10820.469,12548.8125,14405.156,16389.5,20742.188,25606.875,30983.562,36872.25,43272.938,50185.625,57610.312,65547,82956.375,102413.75,123919.125,147472.5,173073.88,200723.25,230420.62,0.00018692017,0.00016117096,0.0001373291,0.00011539459,9.536743e-05,7.724762e-05,6.1035156e-05,4.673004e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,9.536743e-05,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0016822815,0.0023841858,0.0032081604,0.0041542053,0.0052223206,0.006412506,0.007724762,0.009159088,0.010715485,0.0141944885,0.018161774,0.02261734,0.027561188,0.032993317,0.038913727,0.04532242,0.05221939,0.06747818,0.084690094,0.10385513,0.1249733,0.14804459,0.173069,0.20004654,0.2289772,0.2926979,0.3642311,0.4435768,0.530735,0.6257057,0.7284889,0.8390846,0.9574928,1.2177467,1.5092506,1.8320045,2.1860085,2.5712624,2.9877663,3.4355202,3.914524,4.966282,6.1430397,7.4447975,8.871555,10.423313,12.100071,13.901829,15.828587,20.057102,24.785618,30.014133,35.74265,41.971165,48.69968,55.928196,63.65671,80.61374,99.57077,120.5278,143.48483,168.44186,195.3989,224.35593,255.31296,323.22702,399.14108,483.05515,574.96924,674.8833,782.79736,898.7114,1022.6255,1294.4536,1598.2817,1934.1099,2301.938,2701.766,3133.5942,3597.4224,4093.2505,5180.9067,6396.563,7740.219,9211.875,10811.531,12539.1875,14394.844,16378.5,20729.812,25593.125,30968.438,36855.75,43255.062,50166.375,57589.688,65525,82931.625,102386.25,123888.875,147439.5,173038.12,200684.75,230379.38,0.0005493164,0.0010385513,0.0011024475,0.001168251,0.0012359619,0.0013055801,0.0013771057,0.0014505386,0.0015258789,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,0.9234009,1.0474243,1.3189087,1.6216431,1.9556274,2.3208618,2.7173462,3.1450806,3.604065,4.0942993,5.168518,6.367737,7.6919556,9.141174,10.715393,12.414612,14.238831,16.18805,20.461487,25.234924,30.508362,36.2818,42.555237,49.328674,56.60211,64.37555,81.422424,100.4693,121.516174,144.56305,169.60992,196.6568,225.70367,256.75055,324.8443,400.93805,485.0318,577.12555,677.2193,785.31305,901.4068,1025.5005,1297.688,1601.8755,1938.063,2306.2505,2706.438,3138.6255,3602.813,4099.0005,5187.3755,6403.7505,7748.1255,9220.501,10820.876,12549.251,14405.626,16390,20742.75,25607.5,30984.25,36873,43273.75,50186.5,57611.25,65548,82957.5,102415,123920.5,147474,173075.5,200725,230422.5,0.00024414062,0.00021457672,0.00018692017,0.00016117096,0.0001373291,0.00011539459,9.536743e-05,7.724762e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.29058838,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,0.9536743,1.21344,1.5044556,1.8267212,2.1802368,2.5650024,2.981018,3.4282837,3.9067993,4.9575806,6.133362,7.434143,8.859924,10.410706,12.086487,13.887268,15.813049,20.039612,24.766174,29.992737,35.7193,41.94586,48.672424,55.898987,63.62555,80.578674,99.5318,120.484924,143.43805,168.39117,195.3443,224.29742,255.25055,323.1568,399.06305,482.9693,574.87555,674.7818,782.68805,898.5943,1022.50055,1294.313,1598.1255,1933.938,2301.7505,2701.563,3133.3755,3597.188,4093.0005,5180.6255,6396.2505,7739.8755,9211.501,10811.126,12538.751,14394.376,16378.001,20729.25,25592.5,30967.75,36855,43254.25,50165.5,57588.75,65524,82930.5,102385,123887.5,147438,173036.5,200683,230377.5,0.00064468384,0.001168251,0.0012359619,0.0013055801,0.0013771057,0.0014505386,0.0015258789,0.0016031265,0.0016822815,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.0070533752,0.007724762,0.009159088,0.010715485,0.012393951,0.0141944885,0.016117096,0.018161774,0.020328522,0.02261734,0.027561188,0.032993317,0.038913727,0.04532242,0.05221939,0.059604645,0.06747818,0.07584,0.09402847,0.114170074,0.1362648,0.16031265,0.18631363,0.21426773,0.24417496,0.2760353,0.3456154,0.42300797,0.50821304,0.6012306,0.7020607,0.8107033,0.92715836,1.0514259,1.3233986,1.6266212,1.9610939,2.3268166,2.7237892,3.1520119,3.6114845,4.102207,5.1774025,6.377598,7.702793,9.152988,10.728184,12.428379,14.253574,16.20377,20.47916,25.25455,30.529942,36.305332,42.580723,49.356113,56.631504,64.40689,81.45767,100.50845,121.559235,144.61002,169.6608,196.71158,225.76236,256.81314,324.9147,401.01627,485.11783,577.2194,677.321,785.42255,901.5241,1025.6256,1297.8287,1602.0319,1938.235,2306.4382,2706.6414,3138.8445,3603.0476,4099.2505,5187.6567,6404.063,7748.469,9220.876,10821.282,12549.688,14406.095,16390.5,20743.312,25608.125,30984.938,36873.75,43274.562,50187.375,57612.188,65549,82958.625,102416.25,123921.875,147475.5,173077.12,200726.75,230424.38,0.00030899048,0.00027561188,0.00024414062,0.00021457672,0.00018692017,0.00016117096,0.0001373291,0.00011539459,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,3.4332275e-05,9.536743e-05,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0013771057,0.0020179749,0.0027809143,0.003665924,0.004673004,0.0058021545,0.0070533752,0.008426666,0.009922028,0.013278961,0.017124176,0.021457672,0.02627945,0.031589508,0.037387848,0.04367447,0.05044937,0.06546402,0.08243179,0.10135269,0.122226715,0.14505386,0.16983414,0.19656754,0.22525406,0.28848648,0.3595314,0.43838882,0.52505875,0.61954117,0.7218361,0.8319435,0.94986343,1.2091408,1.4996681,1.8214455,2.1744728,2.5587502,2.9742775,3.4210548,3.8990822,4.948887,6.1236916,7.4234962,8.848301,10.398106,12.07291,13.872715,15.79752,20.02213,24.746738,29.971348,35.695957,41.920567,48.645176,55.869785,63.594395,80.54361,99.49283,120.44205,143.39127,168.34048,195.2897,224.23892,255.18814,323.08658,398.98502,482.88345,574.7819,674.68036,782.5788,898.47723,1022.3757,1294.1725,1597.9694,1933.7662,2301.5632,2701.36,3133.157,3596.9539,4092.7507,5180.344,6395.938,7739.5317,9211.126,10810.72,12538.313,14393.907,16377.501,20728.688,25591.875,30967.062,36854.25,43253.438,50164.625,57587.812,65523,82929.375,102383.75,123886.125,147436.5,173034.88,200681.25,230375.62,0.00074768066,0.0013055801,0.0013771057,0.0014505386,0.0015258789,0.0016031265,0.0016822815,0.0017633438,0.0018463135,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.076919556,0.0952301,0.115493774,0.13771057,0.1618805,0.18800354,0.21607971,0.24610901,0.27809143,0.34791565,0.42555237,0.5110016,0.6042633,0.7053375,0.81422424,0.93092346,1.0554352,1.3278961,1.631607,1.966568,2.332779,2.7302399,3.1589508,3.6189117,4.1101227,5.1862946,6.3874664,7.7136383,9.16481,10.740982,12.442154,14.268326,16.219498,20.496841,25.274185,30.551529,36.328873,42.606216,49.38356,56.660904,64.43825,81.492935,100.54762,121.60231,144.657,169.71169,196.76637,225.82106,256.87573,324.9851,401.09448,485.20386,577.31323,677.4226,785.532,901.64136,1025.7507,1297.9695,1602.1882,1938.407,2306.6257,2706.8445,3139.0632,3603.282,4099.501,5187.9385,6404.376,7748.8135,9221.251,10821.688,12550.126,14406.563,16391,20743.875,25608.75,30985.625,36874.5,43275.375,50188.25,57613.125,65550,82959.75,102417.5,123923.25,147477,173078.75,200728.5,230426.25,0.00038146973,0.00034427643,0.00030899048,0.00027561188,0.00024414062,0.00021457672,0.00018692017,0.00016117096,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.009536743,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.064468384,0.08131409,0.100112915,0.12086487,0.14356995,0.16822815,0.19483948,0.22340393,0.2863922,0.357193,0.43580627,0.52223206,0.61647034,0.7185211,0.8283844,0.9460602,1.2048492,1.4948883,1.8161774,2.1687164,2.5525055,2.9675446,3.4138336,3.8913727,4.940201,6.114029,7.412857,8.836685,10.385513,12.059341,13.85817,15.781998,20.004654,24.72731,29.949966,35.672623,41.89528,48.617935,55.84059,63.563248,80.50856,99.45387,120.399185,143.3445,168.28981,195.23512,224.18044,255.12575,323.01636,398.90698,482.7976,574.68823,674.57886,782.4695,898.3601,1022.25073,1294.032,1597.8132,1933.5945,2301.3757,2701.157,3132.9382,3596.7195,4092.5007,5180.0635,6395.626,7739.1885,9210.751,10810.313,12537.876,14393.438,16377.001,20728.125,25591.25,30966.375,36853.5,43252.625,50163.75,57586.875,65522,82928.25,102382.5,123884.75,147435,173033.25,200679.5,230373.75,0.0008583069,0.0014505386,0.0015258789,0.0016031265,0.0016822815,0.0017633438,0.0018463135,0.0019311905,0.0020179749,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.0041542053,0.004673004,0.0052223206,0.0058021545,0.006412506,0.0070533752,0.007724762,0.008426666,0.009922028,0.011539459,0.013278961,0.015140533,0.017124176,0.019229889,0.021457672,0.023807526,0.028873444,0.034427643,0.040470123,0.047000885,0.054019928,0.061527252,0.06952286,0.078006744,0.09643936,0.116825104,0.13916397,0.16345596,0.18970108,0.21789932,0.24805069,0.28015518,0.35022354,0.4281044,0.51379776,0.6073036,0.708622,0.81775284,0.9346962,1.059452,1.3324013,1.6366005,1.9720497,2.338749,2.7366982,3.1658974,3.6263466,4.118046,5.1951942,6.3973427,7.724491,9.17664,10.753788,12.455936,14.283085,16.235233,20.51453,25.293827,30.573124,36.35242,42.631718,49.411015,56.69031,64.469604,81.5282,100.58679,121.645386,144.70398,169.76257,196.82117,225.87976,256.93835,325.05554,401.17273,485.28992,577.4071,677.5243,785.6415,901.75867,1025.8759,1298.1102,1602.3446,1938.579,2306.8135,2707.0479,3139.2822,3603.5166,4099.751,5188.2197,6404.6885,7749.157,9221.626,10822.095,12550.563,14407.032,16391.5,20744.438,25609.375,30986.312,36875.25,43276.188,50189.125,57614.062,65551,82960.875,102418.75,123924.625,147478.5,173080.38,200730.25,230428.12,0.00046157837,0.00042057037,0.00038146973,0.00034427643,0.00030899048,0.00027561188,0.00024414062,0.00021457672,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,3.8146973e-06,3.4332275e-05,9.536743e-05,0.00018692017,0.00030899048,0.00046157837,0.00064468384,0.0008583069,0.0011024475,0.0016822815,0.0023841858,0.0032081604,0.0041542053,0.0052223206,0.006412506,0.007724762,0.009159088,0.012393951,0.016117096,0.020328522,0.025028229,0.030216217,0.035892487,0.042057037,0.04870987,0.06348038,0.08020401,0.09888077,0.11951065,0.14209366,0.16662979,0.19311905,0.22156143,0.28430557,0.3548622,0.43323135,0.519413,0.61340714,0.7152138,0.8248329,0.94226456,1.2005653,1.4901161,1.8109169,2.1629677,2.5462685,2.9608192,3.40662,3.8836708,4.9315224,6.104374,7.4022255,8.825077,10.372929,12.04578,13.843632,15.766483,19.987186,24.70789,29.928593,35.649296,41.87,48.590702,55.811405,63.53211,80.47351,99.41492,120.35632,143.29773,168.23914,195.18054,224.12195,255.06335,322.94617,398.82898,482.7118,574.5946,674.4774,782.3602,898.24304,1022.12585,1293.8915,1597.6571,1933.4227,2301.1885,2700.954,3132.7197,3596.4854,4092.251,5179.782,6395.3135,7738.8447,9210.376,10809.907,12537.438,14392.97,16376.501,20727.562,25590.625,30965.688,36852.75,43251.812,50162.875,57585.938,65521,82927.125,102381.25,123883.375,147433.5,173031.62,200677.75,230371.88,0.0009765625,0.0016031265,0.0016822815,0.0017633438,0.0018463135,0.0019311905,0.0020179749,0.0021066666,0.0021972656,0.0023841858,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.00390625,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,14.297852,16.250977,20.532227,25.313477,30.594727,36.375977,42.657227,49.438477,56.719727,64.50098,81.56348,100.62598,121.68848,144.75098,169.81348,196.87598,225.93848,257.00098,325.12598,401.25098,485.37598,577.501,677.626,785.751,901.876,1026.001,1298.251,1602.501,1938.751,2307.001,2707.251,3139.501,3603.751,4100.001,5188.501,6405.001,7749.501,9222.001,10822.501,12551.001,14407.501,16392,20745,25610,30987,36876,43277,50190,57615,65552,82962,102420,123926,147480,173082,200732,230430,0.0005493164,0.0005044937,0.00046157837,0.00042057037,0.00038146973,0.00034427643,0.00030899048,0.00027561188,0.00024414062,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,3.8146973e-06,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.9228516,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,15.750977,19.969727,24.688477,29.907227,35.625977,41.844727,48.563477,55.782227,63.500977,80.43848,99.37598,120.31348,143.25098,168.18848,195.12598,224.06348,255.00098,322.87598,398.75098,482.62598,574.501,674.376,782.251,898.126,1022.001,1293.751,1597.501,1933.251,2301.001,2700.751,3132.501,3596.251,4092.001,5179.501,6395.001,7738.501,9210.001,10809.501,12537.001,14392.501,16376.001,20727,25590,30965,36852,43251,50162,57585,65520,82926,102380,123882,147432,173030,200676,230370,0.0012359619,0.0019311905,0.0020179749,0.0021066666,0.0021972656,0.002289772,0.0023841858,0.002480507,0.0025787354,0.0027809143,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.00390625,0.0041542053,0.00440979,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.064468384,0.072647095,0.08131409,0.100112915,0.12086487,0.14356995,0.16822815,0.19483948,0.22340393,0.2539215,0.2863922,0.357193,0.43580627,0.52223206,0.61647034,0.7185211,0.8283844,0.9460602,1.0715485,1.3459625,1.6516266,1.9885406,2.3567047,2.7561188,3.1867828,3.648697,4.141861,5.221939,6.427017,7.7570953,9.212173,10.792252,12.49733,14.327408,16.282486,20.567642,25.352798,30.637955,36.42311,42.708267,49.493423,56.77858,64.563736,81.63405,100.70436,121.77467,144.84499,169.9153,196.98561,226.05592,257.12622,325.26685,401.40747,485.5481,577.6887,677.82935,785.97,902.1106,1026.2512,1298.5325,1602.8137,1939.095,2307.3762,2707.6575,3139.9387,3604.22,4100.5015,5189.064,6405.6265,7750.189,9222.751,10823.313,12551.876,14408.438,16393.002,20746.127,25611.252,30988.377,36877.5,43278.625,50191.75,57616.875,65554,82964.25,102422.5,123928.75,147483,173085.25,200735.5,230433.75,0.00074768066,0.0006952286,0.00064468384,0.00059604645,0.0005493164,0.0005044937,0.00046157837,0.00042057037,0.00038146973,0.00030899048,0.00024414062,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,3.4332275e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.008071899,0.011123657,0.014663696,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.046157837,0.060562134,0.076919556,0.0952301,0.115493774,0.13771057,0.1618805,0.18800354,0.21607971,0.27809143,0.34791565,0.42555237,0.5110016,0.6042633,0.7053375,0.81422424,0.93092346,1.1877594,1.4758453,1.7951813,2.1457672,2.5276031,2.940689,3.385025,3.860611,4.905533,6.0754547,7.3703766,8.790298,10.33522,12.005142,13.800064,15.719986,19.93483,24.649673,29.864517,35.57936,41.794205,48.50905,55.723892,63.438736,80.36842,99.29811,120.2278,143.15749,168.08717,195.01686,223.94655,254.87624,322.7356,398.59497,482.45435,574.3137,674.1731,782.0325,897.89185,1021.7512,1293.47,1597.1887,1932.9075,2300.6262,2700.345,3132.0637,3595.7825,4091.5012,5178.939,6394.3765,7737.814,9209.251,10808.688,12536.126,14391.563,16375.001,20725.877,25588.752,30963.627,36850.5,43249.375,50160.25,57583.125,65518,82923.75,102377.5,123879.25,147429,173026.75,200672.5,230366.25,0.0015258789,0.002289772,0.0023841858,0.002480507,0.0025787354,0.0026788712,0.0027809143,0.0028848648,0.0029907227,0.0032081604,0.0034332275,0.003665924,0.00390625,0.0041542053,0.00440979,0.004673004,0.0049438477,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.25787354,0.29058838,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,0.9536743,1.0796509,1.3550415,1.6616821,1.9995728,2.3687134,2.769104,3.2007446,3.6636353,4.157776,5.239807,6.4468384,7.7788696,9.235901,10.817932,12.524963,14.356995,16.314026,20.603088,25.39215,30.681213,36.470276,42.75934,49.5484,56.837463,64.626526,81.70465,100.782776,121.8609,144.93903,170.01715,197.09528,226.1734,257.25153,325.40778,401.56403,485.72028,577.8765,678.0328,786.189,902.3453,1026.5015,1298.814,1603.1265,1939.439,2307.7515,2708.064,3140.3765,3604.689,4101.0015,5189.6265,6406.2515,7750.8765,9223.502,10824.127,12552.752,14409.377,16394.002,20747.252,25612.502,30989.752,36879,43280.25,50193.5,57618.75,65556,82966.5,102425,123931.5,147486,173088.5,200739,230437.5,0.0009765625,0.000916481,0.0008583069,0.0008020401,0.00074768066,0.0006952286,0.00064468384,0.00059604645,0.0005493164,0.00046157837,0.00038146973,0.00030899048,0.00024414062,0.00018692017,0.0001373291,9.536743e-05,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.04449463,0.058654785,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.27398682,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,0.9234009,1.1792603,1.4663696,1.784729,2.1343384,2.5151978,2.9273071,3.3706665,3.8452759,4.8882446,6.0562134,7.349182,8.767151,10.31012,11.978088,13.771057,15.689026,19.899963,24.6109,29.821838,35.532776,41.743713,48.45465,55.66559,63.376526,80.2984,99.220276,120.14215,143.06403,167.9859,194.90778,223.82965,254.75153,322.59528,398.43903,482.28278,574.1265,673.9703,781.814,897.6578,1021.5015,1293.189,1596.8765,1932.564,2300.2515,2699.939,3131.6265,3595.314,4091.0015,5178.3765,6393.7515,7737.1265,9208.502,10807.877,12535.252,14390.627,16374.002,20724.752,25587.502,30962.252,36849,43247.75,50158.5,57581.25,65516,82921.5,102375,123876.5,147426,173023.5,200669,230362.5,0.0018463135,0.0026788712,0.0027809143,0.0028848648,0.0029907227,0.0030984879,0.0032081604,0.0033197403,0.0034332275,0.003665924,0.00390625,0.0041542053,0.00440979,0.004673004,0.0049438477,0.0052223206,0.005508423,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.028213501,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.076919556,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.23085022,0.26185608,0.29481506,0.3665924,0.44618225,0.5335846,0.62879944,0.7318268,0.8426666,0.96131897,1.0877838,1.364151,1.6717682,2.0106354,2.3807526,2.7821198,3.214737,3.6786041,4.1737213,5.2577057,6.46669,7.8006744,9.259659,10.843643,12.552628,14.386612,16.345596,20.638565,25.431534,30.724503,36.51747,42.81044,49.60341,56.896378,64.68935,81.77528,100.86122,121.94716,145.0331,170.11903,197.20497,226.29091,257.37683,325.5487,401.72058,485.89246,578.06433,678.2362,786.4081,902.57996,1026.7518,1299.0956,1603.4393,1939.7831,2308.127,2708.4707,3140.8145,3605.1582,4101.502,5190.1895,6406.877,7751.5645,9224.252,10824.939,12553.627,14410.314,16395.002,20748.377,25613.752,30991.127,36880.5,43281.875,50195.25,57620.625,65558,82968.75,102427.5,123934.25,147489,173091.75,200742.5,230441.25,0.0012359619,0.001168251,0.0011024475,0.0010385513,0.0009765625,0.000916481,0.0008583069,0.0008020401,0.00074768066,0.00064468384,0.0005493164,0.00046157837,0.00038146973,0.00030899048,0.00024414062,0.00018692017,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.00038146973,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.006729126,0.009536743,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.04286194,0.056777954,0.072647095,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.26991272,0.33876038,0.41542053,0.4998932,0.59217834,0.692276,0.80018616,0.9159088,1.1707916,1.4569244,1.7743073,2.12294,2.5028229,2.9139557,3.3563385,3.8299713,4.870987,6.0370026,7.328018,8.744034,10.285049,11.951065,13.742081,15.658096,19.865128,24.572159,29.77919,35.48622,41.693253,48.400284,55.607315,63.314346,80.22841,99.14247,120.05653,142.9706,167.88466,194.79872,223.71278,254.62685,322.45496,398.28308,482.1112,573.93933,673.76746,781.5956,897.4237,1021.25183,1292.9081,1596.5643,1932.2206,2299.877,2699.5332,3131.1895,3594.8457,4090.502,5177.8145,6393.127,7736.4395,9207.752,10807.064,12534.377,14389.689,16373.002,20723.627,25586.252,30960.877,36847.5,43246.125,50156.75,57579.375,65514,82919.25,102372.5,123873.75,147423,173020.25,200665.5,230358.75,0.0021972656,0.0030984879,0.0032081604,0.0033197403,0.0034332275,0.0035486221,0.003665924,0.0037851334,0.00390625,0.0041542053,0.00440979,0.004673004,0.0049438477,0.0052223206,0.005508423,0.0058021545,0.0061035156,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,3.6936035,4.1896973,5.275635,6.4865723,7.82251,9.283447,10.869385,12.580322,14.41626,16.377197,20.674072,25.470947,30.767822,36.564697,42.861572,49.658447,56.955322,64.7522,81.84595,100.9397,122.03345,145.1272,170.22095,197.3147,226.40845,257.5022,325.6897,401.8772,486.0647,578.2522,678.4397,786.6272,902.8147,1027.0022,1299.3772,1603.7522,1940.1272,2308.5022,2708.8772,3141.2522,3605.6272,4102.002,5190.752,6407.502,7752.252,9225.002,10825.752,12554.502,14411.252,16396.002,20749.502,25615.002,30992.502,36882.004,43283.504,50197.004,57622.504,65560,82971,102430,123937,147492,173095,200746,230445,0.0015258789,0.0014505386,0.0013771057,0.0013055801,0.0012359619,0.001168251,0.0011024475,0.0010385513,0.0009765625,0.0008583069,0.00074768066,0.00064468384,0.0005493164,0.00046157837,0.00038146973,0.00030899048,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.1623535,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,3.8146973,4.85376,6.0178223,7.306885,8.720947,10.26001,11.924072,13.713135,15.627197,19.830322,24.533447,29.736572,35.439697,41.642822,48.345947,55.549072,63.252197,80.15845,99.0647,119.97095,142.8772,167.78345,194.6897,223.59595,254.5022,322.3147,398.1272,481.9397,573.7522,673.5647,781.3772,897.1897,1021.0022,1292.6272,1596.2522,1931.8772,2299.5022,2699.1272,3130.7522,3594.3772,4090.0022,5177.252,6392.502,7735.752,9207.002,10806.252,12533.502,14388.752,16372.002,20722.502,25585.002,30959.502,36846.004,43244.504,50155.004,57577.504,65512.004,82917,102370,123871,147420,173017,200662,230355,0.0025787354,0.0035486221,0.003665924,0.0037851334,0.00390625,0.004029274,0.0041542053,0.004281044,0.00440979,0.004673004,0.0049438477,0.0052223206,0.005508423,0.0058021545,0.0061035156,0.006412506,0.006729126,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.028213501,0.030899048,0.036636353,0.04286194,0.049575806,0.056777954,0.064468384,0.072647095,0.08131409,0.09046936,0.11024475,0.13197327,0.1556549,0.18128967,0.20887756,0.23841858,0.26991272,0.30336,0.3761139,0.4566803,0.5450592,0.6412506,0.7452545,0.8570709,0.9766998,1.1041412,1.3824615,1.6920319,2.0328522,2.4049225,2.8082428,3.242813,3.7086334,4.2057037,5.2935944,6.506485,7.8443756,9.307266,10.895157,12.6080475,14.445938,16.408829,20.70961,25.510391,30.811172,36.611954,42.912735,49.713516,57.014297,64.81508,81.91664,101.0182,122.11977,145.22133,170.32289,197.42445,226.52602,257.62756,325.8307,402.0338,486.23694,578.44006,678.6432,786.8463,903.04944,1027.2526,1299.6588,1604.0651,1940.4713,2308.8777,2709.284,3141.6902,3606.0964,4102.5024,5191.315,6408.1274,7752.94,9225.753,10826.565,12555.378,14412.19,16397.002,20750.627,25616.252,30993.877,36883.504,43285.13,50198.754,57624.38,65562,82973.25,102432.5,123939.75,147495,173098.25,200749.5,230448.75,0.0018463135,0.0017633438,0.0016822815,0.0016031265,0.0015258789,0.0014505386,0.0013771057,0.0013055801,0.0012359619,0.0011024475,0.0009765625,0.0008583069,0.00074768066,0.00064468384,0.0005493164,0.00046157837,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.0001373291,0.00038146973,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.005508423,0.008071899,0.011123657,0.014663696,0.018692017,0.023208618,0.028213501,0.033706665,0.03968811,0.053115845,0.068496704,0.08583069,0.1051178,0.12635803,0.14955139,0.17469788,0.20179749,0.26185608,0.32972717,0.40541077,0.48890686,0.58021545,0.67933655,0.78627014,0.90101624,1.1539459,1.4381256,1.7535553,2.100235,2.4781647,2.8873444,3.327774,3.7994537,4.836563,5.9986725,7.285782,8.697891,10.235001,11.89711,13.684219,15.596329,19.795547,24.494766,29.693985,35.393204,41.592422,48.29164,55.49086,63.19008,80.08852,98.98695,119.88539,142.78383,167.68227,194.5807,223.47914,254.37758,322.17444,397.9713,481.7682,573.56506,673.36194,781.1588,896.9557,1020.75256,1292.3463,1595.9401,1931.5338,2299.1277,2698.7214,3130.3152,3593.909,4089.5027,5176.69,6391.8774,7735.065,9206.253,10805.44,12532.628,14387.815,16371.003,20721.377,25583.752,30958.127,36844.504,43242.88,50153.254,57575.63,65510.004,82914.75,102367.5,123868.25,147417,173013.75,200658.5,230351.25,0.0029907227,0.004029274,0.0041542053,0.004281044,0.00440979,0.0045404434,0.004673004,0.004807472,0.0049438477,0.0052223206,0.005508423,0.0058021545,0.0061035156,0.006412506,0.006729126,0.0070533752,0.007385254,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.30767822,0.3809204,0.4619751,0.5508423,0.647522,0.75201416,0.86431885,0.98443604,1.1123657,1.3916626,1.7022095,2.0440063,2.4170532,2.82135,3.256897,3.7236938,4.2217407,5.3115845,6.526428,7.866272,9.331116,10.920959,12.635803,14.475647,16.44049,20.745178,25.549866,30.854553,36.65924,42.96393,49.768616,57.073303,64.87799,81.987366,101.09674,122.206116,145.31549,170.42487,197.53424,226.64362,257.753,325.97174,402.1905,486.40924,578.628,678.84674,787.0655,903.28424,1027.5029,1299.9404,1604.3779,1940.8154,2309.253,2709.6904,3142.128,3606.5654,4103.003,5191.878,6408.753,7753.628,9226.503,10827.378,12556.253,14413.128,16398.004,20751.754,25617.504,30995.254,36885.004,43286.754,50200.504,57626.254,65564,82975.5,102435,123942.5,147498,173101.5,200753,230452.5,0.0021972656,0.0021066666,0.0020179749,0.0019311905,0.0018463135,0.0017633438,0.0016822815,0.0016031265,0.0015258789,0.0013771057,0.0012359619,0.0011024475,0.0009765625,0.0008583069,0.00074768066,0.00064468384,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.038146973,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.25787354,0.32525635,0.40045166,0.48345947,0.5742798,0.6729126,0.7793579,0.8936157,1.1455688,1.428772,1.7432251,2.0889282,2.4658813,2.8740845,3.3135376,3.7842407,4.819397,5.979553,7.2647095,8.674866,10.210022,11.870178,13.655334,15.565491,19.760803,24.456116,29.651428,35.34674,41.542053,48.237366,55.43268,63.12799,80.018616,98.90924,119.799866,142.69049,167.58112,194.47174,223.36237,254.25299,322.03424,397.8155,481.59674,573.378,673.15924,780.9405,896.72174,1020.503,1292.0654,1595.6279,1931.1904,2298.753,2698.3154,3129.878,3593.4404,4089.003,5176.128,6391.253,7734.378,9205.503,10804.628,12531.753,14386.878,16370.003,20720.254,25582.504,30956.754,36843.004,43241.254,50151.504,57573.754,65508.004,82912.5,102365,123865.5,147414,173010.5,200655,230347.5,0.0034332275,0.0045404434,0.004673004,0.004807472,0.0049438477,0.0050821304,0.0052223206,0.005364418,0.005508423,0.0058021545,0.0061035156,0.006412506,0.006729126,0.0070533752,0.007385254,0.007724762,0.008071899,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.014663696,0.016616821,0.018692017,0.020889282,0.023208618,0.025650024,0.028213501,0.030899048,0.033706665,0.03968811,0.046157837,0.053115845,0.060562134,0.068496704,0.076919556,0.08583069,0.0952301,0.115493774,0.13771057,0.1618805,0.18800354,0.21607971,0.24610901,0.27809143,0.31202698,0.38575745,0.46730042,0.5566559,0.65382385,0.7588043,0.8715973,0.99220276,1.1206207,1.4008942,1.7124176,2.055191,2.4292145,2.834488,3.2710114,3.7387848,4.237808,5.329605,6.546402,7.888199,9.354996,10.946793,12.6635895,14.505386,16.472183,20.780777,25.58937,30.897964,36.70656,43.015152,49.823746,57.13234,64.94093,82.05812,101.17531,122.292496,145.40968,170.52687,197.64406,226.76125,257.87842,326.1128,402.34717,486.58154,578.8159,679.0503,787.28467,903.51904,1027.7534,1300.2222,1604.6909,1941.1597,2309.6284,2710.0972,3142.566,3607.0347,4103.5034,5192.441,6409.3784,7754.316,9227.254,10828.191,12557.129,14414.066,16399.004,20752.879,25618.754,30996.629,36886.504,43288.38,50202.254,57628.13,65566,82977.75,102437.5,123945.25,147501,173104.75,200756.5,230456.25,0.0025787354,0.002480507,0.0023841858,0.002289772,0.0021972656,0.0021066666,0.0020179749,0.0019311905,0.0018463135,0.0016822815,0.0015258789,0.0013771057,0.0012359619,0.0011024475,0.0009765625,0.0008583069,0.00074768066,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,1.5258789e-05,0.0001373291,0.00038146973,0.00074768066,0.0012359619,0.0018463135,0.0025787354,0.0034332275,0.00440979,0.006729126,0.009536743,0.012832642,0.016616821,0.020889282,0.025650024,0.030899048,0.036636353,0.049575806,0.064468384,0.08131409,0.100112915,0.12086487,0.14356995,0.16822815,0.19483948,0.2539215,0.32081604,0.39552307,0.4780426,0.56837463,0.66651917,0.7724762,0.8862457,1.1372223,1.4194489,1.7329254,2.077652,2.4536285,2.860855,3.2993317,3.7690582,4.8022614,5.9604645,7.2436676,8.651871,10.185074,11.843277,13.62648,15.534683,19.72609,24.417496,29.608902,35.30031,41.491714,48.18312,55.374527,63.065933,79.948746,98.83156,119.71437,142.59718,167.48,194.36281,223.24562,254.12843,321.89404,397.65967,481.4253,573.1909,672.95654,780.72217,896.4878,1020.2534,1291.7847,1595.3159,1930.8472,2298.3784,2697.9097,3129.441,3592.9722,4088.5034,5175.566,6390.6284,7733.691,9204.754,10803.816,12530.879,14385.941,16369.004,20719.129,25581.254,30955.379,36841.504,43239.63,50149.754,57571.88,65506.004,82910.25,102362.5,123862.75,147411,173007.25,200651.5,230343.75,0.00390625,0.0050821304,0.0052223206,0.005364418,0.005508423,0.005654335,0.0058021545,0.0059518814,0.0061035156,0.006412506,0.006729126,0.0070533752,0.007385254,0.007724762,0.008071899,0.008426666,0.0087890625,0.009536743,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.014663696,0.015625,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,57.191406,65.00391,82.12891,101.25391,122.37891,145.5039,170.6289,197.7539,226.8789,258.0039,326.2539,402.5039,486.7539,579.0039,679.2539,787.5039,903.7539,1028.0039,1300.5039,1605.0039,1941.5039,2310.004,2710.504,3143.004,3607.504,4104.004,5193.004,6410.004,7755.004,9228.004,10829.004,12558.004,14415.004,16400.004,20754.004,25620.004,30998.004,36888.004,43290.004,50204.004,57630.004,65568,82980,102440,123948,147504,173108,200760,230460,0.0029907227,0.0028848648,0.0027809143,0.0026788712,0.0025787354,0.002480507,0.0023841858,0.002289772,0.0021972656,0.0020179749,0.0018463135,0.0016822815,0.0015258789,0.0013771057,0.0012359619,0.0011024475,0.0009765625,0.00074768066,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,1.5258789e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,19.691406,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,63.003906,79.87891,98.75391,119.62891,142.5039,167.3789,194.2539,223.1289,254.0039,321.7539,397.5039,481.2539,573.0039,672.7539,780.5039,896.2539,1020.0039,1291.5039,1595.0039,1930.5039,2298.004,2697.504,3129.004,3592.504,4088.004,5175.004,6390.004,7733.004,9204.004,10803.004,12530.004,14385.004,16368.004,20718.004,25580.004,30954.004,36840.004,43238.004,50148.004,57570.004,65504.004,82908,102360,123860,147408,173004,200648,230340,0.0049438477,0.006257057,0.006412506,0.0065698624,0.006729126,0.006890297,0.0070533752,0.007218361,0.007385254,0.007724762,0.008071899,0.008426666,0.0087890625,0.009159088,0.009536743,0.009922028,0.010314941,0.011123657,0.011962891,0.012832642,0.01373291,0.014663696,0.015625,0.016616821,0.01763916,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.25787354,0.29058838,0.32525635,0.40045166,0.48345947,0.5742798,0.6729126,0.7793579,0.8936157,1.015686,1.1455688,1.428772,1.7432251,2.0889282,2.4658813,2.8740845,3.3135376,3.7842407,4.286194,5.38385,6.6065063,7.9541626,9.426819,11.024475,12.747131,14.594788,16.567444,20.887756,25.708069,31.028381,36.848694,43.169006,49.98932,57.30963,65.129944,82.27057,101.411194,122.55182,145.69244,170.83307,197.9737,227.11432,258.25494,326.5362,402.81744,487.0987,579.37994,679.6612,787.94244,904.2237,1028.5049,1301.0674,1605.6299,1942.1924,2310.755,2711.3174,3143.88,3608.4424,4105.005,5194.13,6411.255,7756.38,9229.505,10830.63,12559.755,14416.88,16402.006,20756.256,25622.506,31000.756,36891.004,43293.254,50207.504,57633.754,65572.01,82984.51,102445.01,123953.51,147510,173114.5,200767,230467.5,0.00390625,0.0037851334,0.003665924,0.0035486221,0.0034332275,0.0033197403,0.0032081604,0.0030984879,0.0029907227,0.0027809143,0.0025787354,0.0023841858,0.0021972656,0.0020179749,0.0018463135,0.0016822815,0.0015258789,0.0012359619,0.0009765625,0.00074768066,0.0005493164,0.00038146973,0.00024414062,0.0001373291,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.032287598,0.04449463,0.058654785,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.18463135,0.24224854,0.30767822,0.3809204,0.4619751,0.5508423,0.647522,0.75201416,0.86431885,1.1123657,1.3916626,1.7022095,2.0440063,2.4170532,2.82135,3.256897,3.7236938,4.7510376,5.9033813,7.180725,8.583069,10.110413,11.762756,13.5401,15.442444,19.622131,24.301819,29.481506,35.161194,41.34088,48.02057,55.200256,62.879944,79.73932,98.598694,119.45807,142.31744,167.17682,194.0362,222.89557,253.75494,321.4737,397.19244,480.9112,572.62994,672.3487,780.06744,895.7862,1019.50494,1290.9424,1594.3799,1929.8174,2297.255,2696.6924,3128.13,3591.5674,4087.005,5173.88,6388.755,7731.63,9202.505,10801.38,12528.255,14383.13,16366.005,20715.756,25577.506,30951.256,36837.004,43234.754,50144.504,57566.254,65500.004,82903.51,102355.01,123854.51,147402,172997.5,200641,230332.5,0.0061035156,0.0075540543,0.007724762,0.007897377,0.008071899,0.008248329,0.008426666,0.008606911,0.0087890625,0.009159088,0.009536743,0.009922028,0.010314941,0.010715485,0.011123657,0.011539459,0.011962891,0.012832642,0.01373291,0.014663696,0.015625,0.016616821,0.01763916,0.018692017,0.01977539,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.1623535,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,3.8146973,4.3186035,5.420166,6.6467285,7.998291,9.4748535,11.076416,12.8029785,14.654541,16.631104,20.959229,25.787354,31.115479,36.943604,43.27173,50.099854,57.42798,65.2561,82.41235,101.5686,122.72485,145.8811,171.03735,198.1936,227.34985,258.5061,326.8186,403.1311,487.4436,579.7561,680.0686,788.3811,904.6936,1029.0061,1301.6311,1606.2561,1942.8811,2311.506,2712.131,3144.756,3609.381,4106.006,5195.256,6412.506,7757.756,9231.006,10832.256,12561.506,14418.756,16404.006,20758.506,25625.006,31003.506,36894.008,43296.508,50211.008,57637.508,65576.01,82989.01,102450.01,123959.01,147516,173121,200774,230475,0.0049438477,0.004807472,0.004673004,0.0045404434,0.00440979,0.004281044,0.0041542053,0.004029274,0.00390625,0.003665924,0.0034332275,0.0032081604,0.0029907227,0.0027809143,0.0025787354,0.0023841858,0.0021972656,0.0018463135,0.0015258789,0.0012359619,0.0009765625,0.00074768066,0.0005493164,0.00038146973,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.17797852,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,1.0959473,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,3.6936035,4.717041,5.8654785,7.138916,8.5373535,10.060791,11.7092285,13.482666,15.3811035,19.552979,24.224854,29.396729,35.068604,41.24048,47.912354,55.08423,62.756104,79.59985,98.4436,119.28735,142.1311,166.97485,193.8186,222.66235,253.5061,321.1936,396.8811,480.5686,572.2561,671.9436,779.6311,895.3186,1019.0061,1290.3811,1593.7561,1929.1311,2296.506,2695.881,3127.256,3590.631,4086.006,5172.756,6387.506,7730.256,9201.006,10799.756,12526.506,14381.256,16364.006,20713.506,25575.006,30948.506,36834.008,43231.508,50141.008,57562.508,65496.008,82899.01,102350.01,123849.01,147396,172991,200634,230325,0.007385254,0.008973122,0.009159088,0.009346962,0.009536743,0.009728432,0.009922028,0.010117531,0.010314941,0.010715485,0.011123657,0.011539459,0.011962891,0.012393951,0.012832642,0.013278961,0.01373291,0.014663696,0.015625,0.016616821,0.01763916,0.018692017,0.01977539,0.020889282,0.022033691,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.112854004,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.30767822,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,0.9234009,1.0474243,1.1792603,1.4663696,1.784729,2.1343384,2.5151978,2.9273071,3.3706665,3.8452759,4.3511353,5.456604,6.6870728,8.0425415,9.52301,11.128479,12.858948,14.7144165,16.694885,21.030823,25.86676,31.202698,37.038635,43.374573,50.21051,57.546448,65.382385,82.55426,101.726135,122.89801,146.06989,171.24176,198.41364,227.58551,258.7574,327.10114,403.4449,487.78864,580.1324,680.47614,788.8199,905.16364,1029.5073,1302.1948,1606.8823,1943.5698,2312.2573,2712.9448,3145.6323,3610.3198,4107.0073,5196.3823,6413.7573,7759.1323,9232.508,10833.883,12563.258,14420.633,16406.008,20760.758,25627.508,31006.258,36897.008,43299.758,50214.508,57641.258,65580.01,82993.51,102455.01,123964.51,147522,173127.5,200781,230482.5,0.0061035156,0.0059518814,0.0058021545,0.005654335,0.005508423,0.005364418,0.0052223206,0.0050821304,0.0049438477,0.004673004,0.00440979,0.0041542053,0.00390625,0.003665924,0.0034332275,0.0032081604,0.0029907227,0.0025787354,0.0021972656,0.0018463135,0.0015258789,0.0012359619,0.0009765625,0.00074768066,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0015258789,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.026916504,0.038146973,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.17144775,0.22711182,0.29058838,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,1.0796509,1.3550415,1.6616821,1.9995728,2.3687134,2.769104,3.2007446,3.6636353,4.6831665,5.8276978,7.097229,8.49176,10.0112915,11.655823,13.425354,15.319885,19.483948,24.14801,29.312073,34.976135,41.140198,47.80426,54.968323,62.632385,79.46051,98.288635,119.11676,141.94489,166.77301,193.60114,222.42926,253.25739,320.91364,396.5699,480.22614,571.8824,671.53864,779.1949,894.85114,1018.5074,1289.8198,1593.1323,1928.4448,2295.7573,2695.0698,3126.3823,3589.6948,4085.0073,5171.6323,6386.2573,7728.8823,9199.508,10798.133,12524.758,14379.383,16362.008,20711.258,25572.508,30945.758,36831.008,43228.258,50137.508,57558.758,65492.008,82894.51,102345.01,123843.51,147390,172984.5,200627,230317.5,0.0087890625,0.010514259,0.010715485,0.010918617,0.011123657,0.011330605,0.011539459,0.011750221,0.011962891,0.012393951,0.012832642,0.013278961,0.01373291,0.0141944885,0.014663696,0.015140533,0.015625,0.016616821,0.01763916,0.018692017,0.01977539,0.020889282,0.022033691,0.023208618,0.024414062,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,14.774414,16.75879,21.10254,25.94629,31.29004,37.13379,43.47754,50.32129,57.66504,65.50879,82.69629,101.88379,123.07129,146.25879,171.44629,198.63379,227.82129,259.0088,327.3838,403.7588,488.1338,580.5088,680.8838,789.2588,905.6338,1030.0088,1302.7588,1607.5088,1944.2588,2313.0088,2713.7588,3146.5088,3611.2588,4108.009,5197.509,6415.009,7760.509,9234.009,10835.509,12565.009,14422.509,16408.008,20763.008,25630.008,31009.008,36900.008,43303.008,50218.008,57645.008,65584.01,82998.01,102460.01,123970.01,147528.02,173134.02,200788.02,230490.02,0.007385254,0.007218361,0.0070533752,0.006890297,0.006729126,0.0065698624,0.006412506,0.006257057,0.0061035156,0.0058021545,0.005508423,0.0052223206,0.0049438477,0.004673004,0.00440979,0.0041542053,0.00390625,0.0034332275,0.0029907227,0.0025787354,0.0021972656,0.0018463135,0.0015258789,0.0012359619,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.649414,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,15.258789,19.41504,24.07129,29.22754,34.88379,41.04004,47.69629,54.85254,62.50879,79.32129,98.13379,118.94629,141.75879,166.57129,193.38379,222.19629,253.00879,320.6338,396.2588,479.8838,571.5088,671.1338,778.7588,894.3838,1018.0088,1289.2588,1592.5088,1927.7588,2295.0088,2694.2588,3125.5088,3588.7588,4084.0088,5170.509,6385.009,7727.509,9198.009,10796.509,12523.009,14377.509,16360.009,20709.008,25570.008,30943.008,36828.008,43225.008,50134.008,57555.008,65488.008,82890.01,102340.01,123838.01,147384.02,172978.02,200620.02,230310.02,0.010314941,0.012177467,0.012393951,0.012612343,0.012832642,0.013054848,0.013278961,0.013504982,0.01373291,0.0141944885,0.014663696,0.015140533,0.015625,0.016117096,0.016616821,0.017124176,0.01763916,0.018692017,0.01977539,0.020889282,0.022033691,0.023208618,0.024414062,0.025650024,0.026916504,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.112854004,0.12359619,0.14654541,0.17144775,0.19830322,0.22711182,0.25787354,0.29058838,0.32525635,0.36187744,0.440979,0.52789307,0.6226196,0.7251587,0.83551025,0.9536743,1.0796509,1.21344,1.5044556,1.8267212,2.1802368,2.5650024,2.981018,3.4282837,3.9067993,4.416565,5.529846,6.7681274,8.131409,9.61969,11.232971,12.971252,14.834534,16.822815,21.174377,26.02594,31.377502,37.229065,43.580627,50.43219,57.783752,65.635315,82.83844,102.041565,123.24469,146.44781,171.65094,198.85406,228.05719,259.2603,327.66656,404.0728,488.47906,580.8853,681.29156,789.6978,906.10406,1030.5103,1303.3228,1608.1353,1944.9478,2313.7603,2714.5728,3147.3853,3612.1978,4109.0103,5198.6353,6416.2603,7761.8853,9235.511,10837.136,12566.761,14424.386,16410.01,20765.26,25632.51,31011.76,36903.01,43306.26,50221.51,57648.76,65588.01,83002.51,102465.01,123975.51,147534.02,173140.52,200795.02,230497.52,0.0087890625,0.008606911,0.008426666,0.008248329,0.008071899,0.007897377,0.007724762,0.0075540543,0.007385254,0.0070533752,0.006729126,0.006412506,0.0061035156,0.0058021545,0.005508423,0.0052223206,0.0049438477,0.00440979,0.00390625,0.0034332275,0.0029907227,0.0025787354,0.0021972656,0.0018463135,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0005493164,0.0015258789,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.022033691,0.032287598,0.04449463,0.058654785,0.07476807,0.09283447,0.112854004,0.13482666,0.15875244,0.21246338,0.27398682,0.34332275,0.4204712,0.5054321,0.59820557,0.6987915,0.80718994,1.0474243,1.3189087,1.6216431,1.9556274,2.3208618,2.7173462,3.1450806,3.604065,4.6157837,5.7525024,7.014221,8.40094,9.912659,11.549377,13.311096,15.197815,19.346252,23.99469,29.143127,34.791565,40.940002,47.58844,54.736877,62.385315,79.18219,97.979065,118.77594,141.57281,166.36969,193.16656,221.96344,252.76031,320.35406,395.9478,479.54156,571.1353,670.72906,778.3228,893.91656,1017.5103,1288.6978,1591.8853,1927.0728,2294.2603,2693.4478,3124.6353,3587.8228,4083.0103,5169.3853,6383.7603,7726.1353,9196.511,10794.886,12521.261,14375.636,16358.011,20706.76,25567.51,30940.26,36825.01,43221.76,50130.51,57551.26,65484.01,82885.51,102335.01,123832.51,147378.02,172971.52,200613.02,230302.52,0.011962891,0.013962746,0.0141944885,0.014428139,0.014663696,0.014901161,0.015140533,0.015381813,0.015625,0.016117096,0.016616821,0.017124176,0.01763916,0.018161774,0.018692017,0.019229889,0.01977539,0.020889282,0.022033691,0.023208618,0.024414062,0.025650024,0.026916504,0.028213501,0.029541016,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.2307129,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,3.9377441,4.449463,5.5666504,6.808838,8.176025,9.668213,11.2854,13.027588,14.894775,16.886963,21.246338,26.105713,31.465088,37.324463,43.683838,50.543213,57.902588,65.76196,82.98071,102.19946,123.41821,146.63696,171.85571,199.07446,228.29321,259.51196,327.94946,404.38696,488.82446,581.26196,681.69946,790.13696,906.57446,1031.012,1303.887,1608.762,1945.637,2314.512,2715.387,3148.262,3613.137,4110.0117,5199.7617,6417.5117,7763.2617,9237.012,10838.762,12568.512,14426.262,16412.012,20767.512,25635.012,31014.512,36906.01,43309.51,50225.01,57652.51,65592.016,83007.016,102470.016,123981.016,147540.02,173147.02,200802.02,230505.02,0.010314941,0.010117531,0.009922028,0.009728432,0.009536743,0.009346962,0.009159088,0.008973122,0.0087890625,0.008426666,0.008071899,0.007724762,0.007385254,0.0070533752,0.006729126,0.006412506,0.0061035156,0.005508423,0.0049438477,0.00440979,0.00390625,0.0034332275,0.0029907227,0.0025787354,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.15258789,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,1.0314941,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,3.574463,4.5822754,5.715088,6.9729004,8.355713,9.863525,11.496338,13.25415,15.136963,19.277588,23.918213,29.058838,34.699463,40.840088,47.480713,54.621338,62.261963,79.04321,97.82446,118.60571,141.38696,166.16821,192.94946,221.73071,252.51196,320.07446,395.63696,479.19946,570.76196,670.32446,777.88696,893.44946,1017.01196,1288.137,1591.262,1926.387,2293.512,2692.637,3123.762,3586.887,4082.012,5168.2617,6382.5117,7724.7617,9195.012,10793.262,12519.512,14373.762,16356.012,20704.512,25565.012,30937.512,36822.01,43218.51,50127.01,57547.51,65480.01,82881.016,102330.016,123827.016,147372.02,172965.02,200606.02,230295.02,0.01373291,0.015870094,0.016117096,0.016366005,0.016616821,0.016869545,0.017124176,0.017380714,0.01763916,0.018161774,0.018692017,0.019229889,0.01977539,0.020328522,0.020889282,0.021457672,0.022033691,0.023208618,0.024414062,0.025650024,0.026916504,0.028213501,0.029541016,0.030899048,0.032287598,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.058654785,0.066467285,0.07476807,0.08355713,0.09283447,0.1026001,0.112854004,0.12359619,0.13482666,0.15875244,0.18463135,0.21246338,0.24224854,0.27398682,0.30767822,0.34332275,0.3809204,0.4619751,0.5508423,0.647522,0.75201416,0.86431885,0.98443604,1.1123657,1.2481079,1.5430298,1.8692017,2.2266235,2.6152954,3.0352173,3.4863892,3.968811,4.482483,5.6035767,6.8496704,8.220764,9.716858,11.337952,13.084045,14.955139,16.951233,21.31842,26.185608,31.552795,37.419983,43.78717,50.654358,58.021545,65.88873,83.12311,102.35748,123.59186,146.82623,172.06061,199.29498,228.52936,259.76373,328.23248,404.70123,489.16998,581.63873,682.1075,790.57623,907.045,1031.5137,1304.4512,1609.3887,1946.3262,2315.2637,2716.2012,3149.1387,3614.0762,4111.0137,5200.8887,6418.7637,7764.6387,9238.514,10840.389,12570.264,14428.139,16414.014,20769.764,25637.514,31017.264,36909.016,43312.766,50228.516,57656.266,65596.016,83011.516,102475.016,123986.516,147546.02,173153.52,200809.02,230512.52,0.011962891,0.011750221,0.011539459,0.011330605,0.011123657,0.010918617,0.010715485,0.010514259,0.010314941,0.009922028,0.009536743,0.009159088,0.0087890625,0.008426666,0.008071899,0.007724762,0.007385254,0.006729126,0.0061035156,0.005508423,0.0049438477,0.00440979,0.00390625,0.0034332275,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,6.1035156e-05,0.0005493164,0.0015258789,0.0029907227,0.0049438477,0.007385254,0.010314941,0.01373291,0.01763916,0.026916504,0.038146973,0.051330566,0.066467285,0.08355713,0.1026001,0.12359619,0.14654541,0.19830322,0.25787354,0.32525635,0.40045166,0.48345947,0.5742798,0.6729126,0.7793579,1.015686,1.2832642,1.5820923,1.9121704,2.2734985,2.6660767,3.0899048,3.544983,4.548889,5.6777954,6.9317017,8.310608,9.814514,11.44342,13.197327,15.076233,19.209045,23.841858,28.97467,34.607483,40.740295,47.373108,54.50592,62.138733,78.90436,97.66998,118.43561,141.20123,165.96686,192.73248,221.49811,252.26373,319.79498,395.32623,478.85748,570.38873,669.92,777.45123,892.9825,1016.51373,1287.5762,1590.6387,1925.7012,2292.7637,2691.8262,3122.8887,3585.9512,4081.0137,5167.1387,6381.2637,7723.3887,9193.514,10791.639,12517.764,14371.889,16354.014,20702.264,25562.514,30934.764,36819.016,43215.266,50123.516,57543.766,65476.016,82876.516,102325.016,123821.516,147366.02,172958.52,200599.02,230287.52,0.015625,0.017899513,0.018161774,0.018425941,0.018692017,0.01896,0.019229889,0.019501686,0.01977539,0.020328522,0.020889282,0.021457672,0.022033691,0.02261734,0.023208618,0.023807526,0.024414062,0.025650024,0.026916504,0.028213501,0.029541016,0.030899048,0.032287598,0.033706665,0.03515625,0.038146973,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.058654785,0.0625,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,228.76562,260.01562,328.51562,405.01562,489.51562,582.0156,682.5156,791.0156,907.5156,1032.0156,1305.0156,1610.0156,1947.0156,2316.0156,2717.0156,3150.0156,3615.0156,4112.0156,5202.0156,6420.0156,7766.0156,9240.016,10842.016,12572.016,14430.016,16416.016,20772.016,25640.016,31020.016,36912.016,43316.016,50232.016,57660.016,65600.016,83016.016,102480.016,123992.016,147552.02,173160.02,200816.02,230520.02,0.01373291,0.013504982,0.013278961,0.013054848,0.012832642,0.012612343,0.012393951,0.012177467,0.011962891,0.011539459,0.011123657,0.010715485,0.010314941,0.009922028,0.009536743,0.009159088,0.0087890625,0.008071899,0.007385254,0.006729126,0.0061035156,0.005508423,0.0049438477,0.00440979,0.00390625,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,6.1035156e-05,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.765625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,78.765625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,252.01562,319.51562,395.01562,478.51562,570.0156,669.5156,777.0156,892.5156,1016.0156,1287.0156,1590.0156,1925.0156,2292.0156,2691.0156,3122.0156,3585.0156,4080.0156,5166.0156,6380.0156,7722.0156,9192.016,10790.016,12516.016,14370.016,16352.016,20700.016,25560.016,30932.016,36816.016,43212.016,50120.016,57540.016,65472.016,82872.016,102320.016,123816.016,147360.02,172952.02,200592.02,230280.02,0.01977539,0.022324562,0.02261734,0.022912025,0.023208618,0.023507118,0.023807526,0.02410984,0.024414062,0.025028229,0.025650024,0.02627945,0.026916504,0.027561188,0.028213501,0.028873444,0.029541016,0.030899048,0.032287598,0.033706665,0.03515625,0.036636353,0.038146973,0.03968811,0.041259766,0.04449463,0.047851562,0.051330566,0.05493164,0.058654785,0.0625,0.066467285,0.07055664,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.1623535,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,3.574463,4.062744,4.5822754,5.715088,6.9729004,8.355713,9.863525,11.496338,13.25415,15.136963,17.144775,21.5354,26.426025,31.81665,37.707275,44.0979,50.988525,58.37915,66.269775,83.551025,102.832275,124.113525,147.39478,172.67603,199.95728,229.23853,260.51978,329.08228,405.64478,490.20728,582.7698,683.3323,791.8948,908.4573,1033.0198,1306.1448,1611.2698,1948.3948,2317.5198,2718.6448,3151.7698,3616.8948,4114.0195,5204.2695,6422.5195,7768.7695,9243.02,10845.27,12575.52,14433.77,16420.02,20776.52,25645.02,31025.52,36918.02,43322.52,50239.02,57667.52,65608.02,83025.02,102490.02,124003.02,147564.02,173173.02,200830.02,230535.02,0.01763916,0.017380714,0.017124176,0.016869545,0.016616821,0.016366005,0.016117096,0.015870094,0.015625,0.015140533,0.014663696,0.0141944885,0.01373291,0.013278961,0.012832642,0.012393951,0.011962891,0.011123657,0.010314941,0.009536743,0.0087890625,0.008071899,0.007385254,0.006729126,0.0061035156,0.0049438477,0.00390625,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.0005493164,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.12915039,0.17797852,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.7385254,0.96899414,1.2307129,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,4.449463,5.5666504,6.808838,8.176025,9.668213,11.2854,13.027588,14.894775,19.00415,23.613525,28.7229,34.332275,40.44165,47.051025,54.1604,61.769775,78.488525,97.207275,117.926025,140.64478,165.36353,192.08228,220.80103,251.51978,318.95728,394.39478,477.83228,569.2698,668.7073,776.1448,891.5823,1015.0198,1285.8948,1588.7698,1923.6448,2290.5198,2689.3948,3120.2698,3583.1448,4078.0198,5163.7695,6377.5195,7719.2695,9189.02,10786.77,12512.52,14366.27,16348.02,20695.52,25555.02,30926.52,36810.02,43205.52,50113.02,57532.52,65464.02,82863.02,102310.02,123805.02,147348.02,172939.02,200578.02,230265.02,0.024414062,0.027237892,0.027561188,0.02788639,0.028213501,0.028542519,0.028873444,0.029206276,0.029541016,0.030216217,0.030899048,0.031589508,0.032287598,0.032993317,0.033706665,0.034427643,0.03515625,0.036636353,0.038146973,0.03968811,0.041259766,0.04286194,0.04449463,0.046157837,0.047851562,0.051330566,0.05493164,0.058654785,0.0625,0.066467285,0.07055664,0.07476807,0.07910156,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,4.649414,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,15.258789,17.274414,21.680664,26.586914,31.993164,37.899414,44.305664,51.211914,58.618164,66.524414,83.836914,103.149414,124.461914,147.77441,173.08691,200.39941,229.71191,261.0244,329.6494,406.2744,490.8994,583.5244,684.1494,792.7744,909.3994,1034.0244,1307.2744,1612.5244,1949.7744,2319.0244,2720.2744,3153.5244,3618.7744,4116.0244,5206.5244,6425.0244,7771.5244,9246.024,10848.524,12579.024,14437.524,16424.023,20781.023,25650.023,31031.023,36924.023,43329.023,50246.023,57675.023,65616.02,83034.02,102500.02,124014.02,147576.03,173186.03,200844.03,230550.03,0.022033691,0.021744728,0.021457672,0.021172523,0.020889282,0.020607948,0.020328522,0.020051003,0.01977539,0.019229889,0.018692017,0.018161774,0.01763916,0.017124176,0.016616821,0.016117096,0.015625,0.014663696,0.01373291,0.012832642,0.011962891,0.011123657,0.010314941,0.009536743,0.0087890625,0.007385254,0.0061035156,0.0049438477,0.00390625,0.0029907227,0.0021972656,0.0015258789,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.71191406,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,4.383789,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,14.774414,18.868164,23.461914,28.555664,34.149414,40.243164,46.836914,53.930664,61.524414,78.211914,96.899414,117.586914,140.27441,164.96191,191.64941,220.33691,251.02441,318.3994,393.7744,477.1494,568.5244,667.8994,775.2744,890.6494,1014.0244,1284.7744,1587.5244,1922.2744,2289.0244,2687.7744,3118.5244,3581.2744,4076.0244,5161.5244,6375.0244,7716.5244,9186.024,10783.524,12509.024,14362.524,16344.024,20691.023,25550.023,30921.023,36804.023,43199.023,50106.023,57525.023,65456.023,82854.02,102300.02,123794.02,147336.03,172926.03,200564.03,230250.03,0.029541016,0.032639503,0.032993317,0.033349037,0.033706665,0.0340662,0.034427643,0.034790993,0.03515625,0.035892487,0.036636353,0.037387848,0.038146973,0.038913727,0.03968811,0.040470123,0.041259766,0.04286194,0.04449463,0.046157837,0.047851562,0.049575806,0.051330566,0.053115845,0.05493164,0.058654785,0.0625,0.066467285,0.07055664,0.07476807,0.07910156,0.08355713,0.088134766,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.45141602,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.2307129,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,3.6936035,4.1896973,4.717041,5.8654785,7.138916,8.5373535,10.060791,11.7092285,13.482666,15.3811035,17.404541,21.826416,26.748291,32.170166,38.09204,44.513916,51.43579,58.857666,66.77954,84.12329,103.46704,124.81079,148.15454,173.49829,200.84204,230.18579,261.52954,330.21704,406.90454,491.59204,584.27954,684.96704,793.65454,910.34204,1035.0295,1308.4045,1613.7795,1951.1545,2320.5295,2721.9045,3155.2795,3620.6545,4118.0293,5208.7793,6427.5293,7774.2793,9249.029,10851.779,12582.529,14441.279,16428.03,20785.53,25655.03,31036.53,36930.03,43335.53,50253.03,57682.53,65624.03,83043.03,102510.03,124025.03,147588.03,173199.03,200858.03,230565.03,0.026916504,0.026597023,0.02627945,0.025963783,0.025650024,0.025338173,0.025028229,0.024720192,0.024414062,0.023807526,0.023208618,0.02261734,0.022033691,0.021457672,0.020889282,0.020328522,0.01977539,0.018692017,0.01763916,0.016616821,0.015625,0.014663696,0.01373291,0.012832642,0.011962891,0.010314941,0.0087890625,0.007385254,0.0061035156,0.0049438477,0.00390625,0.0029907227,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0061035156,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.107666016,0.15258789,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.685791,0.90844727,1.1623535,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,4.3186035,5.420166,6.6467285,7.998291,9.4748535,11.076416,12.8029785,14.654541,18.732666,23.310791,28.388916,33.96704,40.045166,46.62329,53.701416,61.27954,77.93579,96.59204,117.24829,139.90454,164.56079,191.21704,219.87329,250.52954,317.84204,393.15454,476.46704,567.77954,667.09204,774.40454,889.71704,1013.02954,1283.6545,1586.2795,1920.9045,2287.5295,2686.1545,3116.7795,3579.4045,4074.0295,5159.2793,6372.5293,7713.7793,9183.029,10780.279,12505.529,14358.779,16340.029,20686.53,25545.03,30915.53,36798.03,43192.53,50099.03,57517.53,65448.03,82845.03,102290.03,123783.03,147324.03,172913.03,200550.03,230235.03,0.03515625,0.038529396,0.038913727,0.039299965,0.03968811,0.040078163,0.040470123,0.04086399,0.041259766,0.042057037,0.04286194,0.04367447,0.04449463,0.04532242,0.046157837,0.047000885,0.047851562,0.049575806,0.051330566,0.053115845,0.05493164,0.056777954,0.058654785,0.060562134,0.0625,0.066467285,0.07055664,0.07476807,0.07910156,0.08355713,0.088134766,0.09283447,0.09765625,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,59.097656,67.03516,84.41016,103.78516,125.16016,148.53516,173.91016,201.28516,230.66016,262.03516,330.78516,407.53516,492.28516,585.03516,685.78516,794.53516,911.28516,1036.0352,1309.5352,1615.0352,1952.5352,2322.0352,2723.5352,3157.0352,3622.5352,4120.035,5211.035,6430.035,7777.035,9252.035,10855.035,12586.035,14445.035,16432.035,20790.035,25660.035,31042.035,36936.035,43342.035,50260.035,57690.035,65632.03,83052.03,102520.03,124036.03,147600.03,173212.03,200872.03,230580.03,0.032287598,0.0319376,0.031589508,0.031243324,0.030899048,0.030556679,0.030216217,0.029877663,0.029541016,0.028873444,0.028213501,0.027561188,0.026916504,0.02627945,0.025650024,0.025028229,0.024414062,0.023208618,0.022033691,0.020889282,0.01977539,0.018692017,0.01763916,0.016616821,0.015625,0.01373291,0.011962891,0.010314941,0.0087890625,0.007385254,0.0061035156,0.0049438477,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,18.597656,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,61.035156,77.66016,96.28516,116.91016,139.53516,164.16016,190.78516,219.41016,250.03516,317.28516,392.53516,475.78516,567.03516,666.28516,773.53516,888.78516,1012.03516,1282.5352,1585.0352,1919.5352,2286.0352,2684.5352,3115.0352,3577.5352,4072.0352,5157.035,6370.035,7711.035,9180.035,10777.035,12502.035,14355.035,16336.035,20682.035,25540.035,30910.035,36792.035,43186.035,50092.035,57510.035,65440.035,82836.03,102280.03,123772.03,147312.03,172900.03,200536.03,230220.03,0.041259766,0.04490757,0.04532242,0.045739174,0.046157837,0.046578407,0.047000885,0.04742527,0.047851562,0.04870987,0.049575806,0.05044937,0.051330566,0.05221939,0.053115845,0.054019928,0.05493164,0.056777954,0.058654785,0.060562134,0.0625,0.064468384,0.066467285,0.068496704,0.07055664,0.07476807,0.07910156,0.08355713,0.088134766,0.09283447,0.09765625,0.1026001,0.107666016,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.45141602,0.49438477,0.58618164,0.685791,0.7932129,0.90844727,1.0314941,1.1623535,1.3010254,1.4475098,1.763916,2.1115723,2.4904785,2.9006348,3.342041,3.8146973,4.3186035,4.85376,6.0178223,7.306885,8.720947,10.26001,11.924072,13.713135,15.627197,17.66626,22.119385,27.07251,32.525635,38.47876,44.931885,51.88501,59.338135,67.29126,84.69751,104.10376,125.51001,148.91626,174.32251,201.72876,231.13501,262.54126,331.35376,408.16626,492.97876,585.79126,686.60376,795.41626,912.22876,1037.0413,1310.6663,1616.2913,1953.9163,2323.5413,2725.1663,3158.7913,3624.4163,4122.041,5213.291,6432.541,7779.791,9255.041,10858.291,12589.541,14448.791,16436.041,20794.541,25665.041,31047.541,36942.043,43348.543,50267.043,57697.543,65640.04,83061.04,102530.04,124047.04,147612.05,173225.05,200886.05,230595.05,0.038146973,0.037766457,0.037387848,0.037011147,0.036636353,0.036263466,0.035892487,0.035523415,0.03515625,0.034427643,0.033706665,0.032993317,0.032287598,0.031589508,0.030899048,0.030216217,0.029541016,0.028213501,0.026916504,0.025650024,0.024414062,0.023208618,0.022033691,0.020889282,0.01977539,0.01763916,0.015625,0.01373291,0.011962891,0.010314941,0.0087890625,0.007385254,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.0021972656,0.0061035156,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.088134766,0.12915039,0.17797852,0.23461914,0.29907227,0.3713379,0.45141602,0.53930664,0.63500977,0.8498535,1.0959473,1.373291,1.6818848,2.0217285,2.3928223,2.795166,3.2287598,4.1896973,5.275635,6.4865723,7.82251,9.283447,10.869385,12.580322,14.41626,18.463135,23.01001,28.056885,33.60376,39.650635,46.19751,53.244385,60.79126,77.38501,95.97876,116.57251,139.16626,163.76001,190.35376,218.94751,249.54126,316.72876,391.91626,475.10376,566.29126,665.47876,772.66626,887.85376,1011.04126,1281.4163,1583.7913,1918.1663,2284.5413,2682.9163,3113.2913,3575.6663,4070.0413,5154.791,6367.541,7708.291,9177.041,10773.791,12498.541,14351.291,16332.041,20677.541,25535.041,30904.541,36786.043,43179.543,50085.043,57502.543,65432.043,82827.04,102270.04,123761.04,147300.05,172887.05,200522.05,230205.05,0.047851562,0.051774025,0.05221939,0.052666664,0.053115845,0.053566933,0.054019928,0.05447483,0.05493164,0.055850983,0.056777954,0.057712555,0.058654785,0.059604645,0.060562134,0.061527252,0.0625,0.064468384,0.066467285,0.068496704,0.07055664,0.072647095,0.07476807,0.076919556,0.07910156,0.08355713,0.088134766,0.09283447,0.09765625,0.1026001,0.107666016,0.112854004,0.11816406,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,4.9228516,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,15.750977,17.797852,22.266602,27.235352,32.7041,38.67285,45.1416,52.11035,59.5791,67.54785,84.98535,104.42285,125.86035,149.29785,174.73535,202.17285,231.61035,263.04785,331.92285,408.79785,493.67285,586.54785,687.42285,796.29785,913.17285,1038.0479,1311.7979,1617.5479,1955.2979,2325.0479,2726.7979,3160.5479,3626.2979,4124.048,5215.548,6435.048,7782.548,9258.048,10861.548,12593.048,14452.548,16440.047,20799.047,25670.047,31053.047,36948.047,43355.047,50274.047,57705.047,65648.05,83070.05,102540.05,124058.05,147624.05,173238.05,200900.05,230610.05,0.04449463,0.044083595,0.04367447,0.04326725,0.04286194,0.042458534,0.042057037,0.041657448,0.041259766,0.040470123,0.03968811,0.038913727,0.038146973,0.037387848,0.036636353,0.035892487,0.03515625,0.033706665,0.032287598,0.030899048,0.029541016,0.028213501,0.026916504,0.025650024,0.024414062,0.022033691,0.01977539,0.01763916,0.015625,0.01373291,0.011962891,0.010314941,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.61035156,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,4.1259766,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,14.297852,18.329102,22.860352,27.891602,33.42285,39.4541,45.98535,53.0166,60.54785,77.11035,95.67285,116.23535,138.79785,163.36035,189.92285,218.48535,249.04785,316.17285,391.29785,474.42285,565.54785,664.67285,771.79785,886.92285,1010.04785,1280.2979,1582.5479,1916.7979,2283.0479,2681.2979,3111.5479,3573.7979,4068.0479,5152.548,6365.048,7705.548,9174.048,10770.548,12495.048,14347.548,16328.048,20673.047,25530.047,30899.047,36780.047,43173.047,50078.047,57495.047,65424.047,82818.05,102260.05,123750.05,147288.05,172874.05,200508.05,230190.05,0.05493164,0.05912876,0.059604645,0.060082436,0.060562134,0.06104374,0.061527252,0.062012672,0.0625,0.06348038,0.064468384,0.06546402,0.066467285,0.06747818,0.068496704,0.06952286,0.07055664,0.072647095,0.07476807,0.076919556,0.07910156,0.08131409,0.08355713,0.08583069,0.088134766,0.09283447,0.09765625,0.1026001,0.107666016,0.112854004,0.11816406,0.12359619,0.12915039,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.23461914,0.26586914,0.29907227,0.33422852,0.3713379,0.4104004,0.45141602,0.49438477,0.53930664,0.63500977,0.7385254,0.8498535,0.96899414,1.0959473,1.2307129,1.373291,1.5236816,1.8479004,2.2033691,2.590088,3.0080566,3.4572754,3.9377441,4.449463,4.9924316,6.172119,7.4768066,8.906494,10.461182,12.140869,13.945557,15.875244,17.929932,22.414307,27.398682,32.883057,38.86743,45.351807,52.33618,59.820557,67.80493,85.27368,104.74243,126.21118,149.67993,175.14868,202.61743,232.08618,263.55493,332.49243,409.42993,494.36743,587.30493,688.24243,797.17993,914.11743,1039.0549,1312.9299,1618.8049,1956.6799,2326.555,2728.43,3162.305,3628.18,4126.0547,5217.8047,6437.5547,7785.3047,9261.055,10864.805,12596.555,14456.305,16444.055,20803.555,25675.055,31058.555,36954.055,43361.555,50281.055,57712.555,65656.055,83079.055,102550.055,124069.055,147636.06,173251.06,200914.06,230625.06,0.051330566,0.050889015,0.05044937,0.050011635,0.049575806,0.049141884,0.04870987,0.048279762,0.047851562,0.047000885,0.046157837,0.04532242,0.04449463,0.04367447,0.04286194,0.042057037,0.041259766,0.03968811,0.038146973,0.036636353,0.03515625,0.033706665,0.032287598,0.030899048,0.029541016,0.026916504,0.024414062,0.022033691,0.01977539,0.01763916,0.015625,0.01373291,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.00024414062,0.0021972656,0.0061035156,0.011962891,0.01977539,0.029541016,0.041259766,0.05493164,0.07055664,0.107666016,0.15258789,0.20532227,0.26586914,0.33422852,0.4104004,0.49438477,0.58618164,0.7932129,1.0314941,1.3010254,1.6018066,1.9338379,2.2971191,2.6916504,3.1174316,4.062744,5.1330566,6.328369,7.6486816,9.093994,10.664307,12.359619,14.179932,18.195557,22.711182,27.726807,33.24243,39.258057,45.77368,52.789307,60.30493,76.83618,95.36743,115.89868,138.42993,162.96118,189.49243,218.02368,248.55493,315.61743,390.67993,473.74243,564.80493,663.86743,770.92993,885.99243,1009.05493,1279.1799,1581.3049,1915.4299,2281.555,2679.68,3109.805,3571.93,4066.055,5150.3047,6362.5547,7702.8047,9171.055,10767.305,12491.555,14343.805,16324.055,20668.555,25525.055,30893.555,36774.055,43166.555,50071.055,57487.555,65416.055,82809.055,102250.055,123739.055,147276.06,172861.06,200494.06,230175.06,0.0625,0.06697178,0.06747818,0.06798649,0.068496704,0.06900883,0.06952286,0.070038795,0.07055664,0.07159805,0.072647095,0.073703766,0.07476807,0.07584,0.076919556,0.078006744,0.07910156,0.08131409,0.08355713,0.08583069,0.088134766,0.09046936,0.09283447,0.0952301,0.09765625,0.1026001,0.107666016,0.112854004,0.11816406,0.12359619,0.12915039,0.13482666,0.140625,0.15258789,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.23461914,0.25,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.890625,2.25,2.640625,3.0625,3.515625,4,4.515625,5.0625,6.25,7.5625,9,10.5625,12.25,14.0625,16,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,915.0625,1040.0625,1314.0625,1620.0625,1958.0625,2328.0625,2730.0625,3164.0625,3630.0625,4128.0625,5220.0625,6440.0625,7788.0625,9264.0625,10868.0625,12600.0625,14460.0625,16448.062,20808.062,25680.062,31064.062,36960.062,43368.062,50288.062,57720.062,65664.06,83088.06,102560.06,124080.06,147648.06,173264.06,200928.06,230640.06,0.058654785,0.058182716,0.057712555,0.0572443,0.056777954,0.056313515,0.055850983,0.055390358,0.05493164,0.054019928,0.053115845,0.05221939,0.051330566,0.05044937,0.049575806,0.04870987,0.047851562,0.046157837,0.04449463,0.04286194,0.041259766,0.03968811,0.038146973,0.036636353,0.03515625,0.032287598,0.029541016,0.026916504,0.024414062,0.022033691,0.01977539,0.01763916,0.015625,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0.00024414062,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.0625,4,5.0625,6.25,7.5625,9,10.5625,12.25,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,315.0625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1008.0625,1278.0625,1580.0625,1914.0625,2280.0625,2678.0625,3108.0625,3570.0625,4064.0625,5148.0625,6360.0625,7700.0625,9168.0625,10764.0625,12488.0625,14340.0625,16320.0625,20664.062,25520.062,30888.062,36768.062,43160.062,50064.062,57480.062,65408.062,82800.06,102240.06,123728.06,147264.06,172848.06,200480.06,230160.06,0.07910156,0.08412266,0.084690094,0.08525944,0.08583069,0.08640385,0.08697891,0.087555885,0.088134766,0.08929825,0.09046936,0.0916481,0.09283447,0.09402847,0.0952301,0.09643936,0.09765625,0.100112915,0.1026001,0.1051178,0.107666016,0.11024475,0.112854004,0.115493774,0.11816406,0.12359619,0.12915039,0.13482666,0.140625,0.14654541,0.15258789,0.15875244,0.16503906,0.17797852,0.19140625,0.20532227,0.21972656,0.23461914,0.25,0.26586914,0.28222656,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,4.649414,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,14.297852,16.250977,18.329102,22.860352,27.891602,33.42285,39.4541,45.98535,53.0166,60.54785,68.5791,86.1416,105.7041,127.2666,150.8291,176.3916,203.9541,233.5166,265.0791,334.2041,411.3291,496.4541,589.5791,690.7041,799.8291,916.9541,1042.0791,1316.3291,1622.5791,1960.8291,2331.079,2733.329,3167.579,3633.829,4132.079,5224.579,6445.079,7793.579,9270.079,10874.579,12607.079,14467.579,16456.078,20817.078,25690.078,31075.078,36972.08,43381.08,50302.08,57735.08,65680.08,83106.08,102580.08,124102.08,147672.08,173290.08,200956.08,230670.08,0.07476807,0.07423496,0.073703766,0.07317448,0.072647095,0.07212162,0.07159805,0.07107639,0.07055664,0.06952286,0.068496704,0.06747818,0.066467285,0.06546402,0.064468384,0.06348038,0.0625,0.060562134,0.058654785,0.056777954,0.05493164,0.053115845,0.051330566,0.049575806,0.047851562,0.04449463,0.041259766,0.038146973,0.03515625,0.032287598,0.029541016,0.026916504,0.024414062,0.01977539,0.015625,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0021972656,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.51660156,0.71191406,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,2.9541016,3.8759766,4.9228516,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,17.797852,22.266602,27.235352,32.7041,38.67285,45.1416,52.11035,59.5791,76.0166,94.4541,114.8916,137.3291,161.7666,188.2041,216.6416,247.0791,313.9541,388.8291,471.7041,562.5791,661.4541,768.3291,883.2041,1006.0791,1275.8291,1577.5791,1911.3291,2277.079,2674.829,3104.579,3566.329,4060.079,5143.579,6355.079,7694.579,9162.079,10757.579,12481.079,14332.579,16312.079,20655.078,25510.078,30877.078,36756.08,43147.08,50050.08,57465.08,65392.08,82782.08,102220.08,123706.08,147240.08,172822.08,200452.08,230130.08,0.09765625,0.10322666,0.10385513,0.10448551,0.1051178,0.10575199,0.10638809,0.1070261,0.107666016,0.10895157,0.11024475,0.11154556,0.112854004,0.114170074,0.115493774,0.116825104,0.11816406,0.12086487,0.12359619,0.12635803,0.12915039,0.13197327,0.13482666,0.13771057,0.140625,0.14654541,0.15258789,0.15875244,0.16503906,0.17144775,0.17797852,0.18463135,0.19140625,0.20532227,0.21972656,0.23461914,0.25,0.26586914,0.28222656,0.29907227,0.31640625,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,18.597656,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,61.035156,69.09766,86.72266,106.34766,127.97266,151.59766,177.22266,204.84766,234.47266,266.09766,335.34766,412.59766,497.84766,591.09766,692.34766,801.59766,918.84766,1044.0977,1318.5977,1625.0977,1963.5977,2334.0977,2736.5977,3171.0977,3637.5977,4136.0977,5229.0977,6450.0977,7799.0977,9276.098,10881.098,12614.098,14475.098,16464.098,20826.098,25700.098,31086.098,36984.098,43394.098,50316.098,57750.098,65696.09,83124.09,102600.09,124124.09,147696.1,173316.1,200984.1,230700.1,0.09283447,0.09224033,0.0916481,0.09105778,0.09046936,0.08988285,0.08929825,0.08871555,0.088134766,0.08697891,0.08583069,0.084690094,0.08355713,0.08243179,0.08131409,0.08020401,0.07910156,0.076919556,0.07476807,0.072647095,0.07055664,0.068496704,0.066467285,0.064468384,0.0625,0.058654785,0.05493164,0.051330566,0.047851562,0.04449463,0.041259766,0.038146973,0.03515625,0.029541016,0.024414062,0.01977539,0.015625,0.011962891,0.0087890625,0.0061035156,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,2.8476562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,17.535156,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,59.097656,75.47266,93.84766,114.22266,136.59766,160.97266,187.34766,215.72266,246.09766,312.84766,387.59766,470.34766,561.09766,659.84766,766.59766,881.34766,1004.09766,1273.5977,1575.0977,1908.5977,2274.0977,2671.5977,3101.0977,3562.5977,4056.0977,5139.0977,6350.0977,7689.0977,9156.098,10751.098,12474.098,14325.098,16304.098,20646.098,25500.098,30866.098,36744.098,43134.098,50036.098,57450.098,65376.098,82764.09,102200.09,123684.09,147216.1,172796.1,200424.1,230100.1,0.11816406,0.12428379,0.1249733,0.12566471,0.12635803,0.12705326,0.1277504,0.12844944,0.12915039,0.13055801,0.13197327,0.13339615,0.13482666,0.1362648,0.13771057,0.13916397,0.140625,0.14356995,0.14654541,0.14955139,0.15258789,0.1556549,0.15875244,0.1618805,0.16503906,0.17144775,0.17797852,0.18463135,0.19140625,0.19830322,0.20532227,0.21246338,0.21972656,0.23461914,0.25,0.26586914,0.28222656,0.29907227,0.31640625,0.33422852,0.35253906,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.8056641,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,4.9228516,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,14.774414,16.75879,18.868164,23.461914,28.555664,34.149414,40.243164,46.836914,53.930664,61.524414,69.618164,87.305664,106.993164,128.68066,152.36816,178.05566,205.74316,235.43066,267.11816,336.49316,413.86816,499.24316,592.61816,693.99316,803.36816,920.74316,1046.1182,1320.8682,1627.6182,1966.3682,2337.1182,2739.8682,3174.6182,3641.3682,4140.118,5233.618,6455.118,7804.618,9282.118,10887.618,12621.118,14482.618,16472.117,20835.117,25710.117,31097.117,36996.117,43407.117,50330.117,57765.117,65712.12,83142.12,102620.12,124146.12,147720.12,173342.12,201012.12,230730.12,0.112854004,0.11219883,0.11154556,0.1108942,0.11024475,0.109597206,0.10895157,0.10830784,0.107666016,0.10638809,0.1051178,0.10385513,0.1026001,0.10135269,0.100112915,0.09888077,0.09765625,0.0952301,0.09283447,0.09046936,0.088134766,0.08583069,0.08355713,0.08131409,0.07910156,0.07476807,0.07055664,0.066467285,0.0625,0.058654785,0.05493164,0.051330566,0.047851562,0.041259766,0.03515625,0.029541016,0.024414062,0.01977539,0.015625,0.011962891,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.024414062,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.43066406,0.61035156,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,2.743164,3.633789,4.649414,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,17.274414,21.680664,26.586914,31.993164,37.899414,44.305664,51.211914,58.618164,74.930664,93.243164,113.555664,135.86816,160.18066,186.49316,214.80566,245.11816,311.74316,386.36816,468.99316,559.61816,658.24316,764.86816,879.49316,1002.11816,1271.3682,1572.6182,1905.8682,2271.1182,2668.3682,3097.6182,3558.8682,4052.1182,5134.618,6345.118,7683.618,9150.118,10744.618,12467.118,14317.618,16296.118,20637.117,25490.117,30855.117,36732.117,43121.117,50022.117,57435.117,65360.117,82746.12,102180.12,123662.12,147192.12,172770.12,200396.12,230070.12,0.140625,0.14729404,0.14804459,0.14879704,0.14955139,0.15030766,0.15106583,0.1518259,0.15258789,0.15411758,0.1556549,0.15719986,0.15875244,0.16031265,0.1618805,0.16345596,0.16503906,0.16822815,0.17144775,0.17469788,0.17797852,0.18128967,0.18463135,0.18800354,0.19140625,0.19830322,0.20532227,0.21246338,0.21972656,0.22711182,0.23461914,0.24224854,0.25,0.26586914,0.28222656,0.29907227,0.31640625,0.33422852,0.35253906,0.3713379,0.390625,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.25,2.640625,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,236.39062,268.14062,337.64062,415.14062,500.64062,594.1406,695.6406,805.1406,922.6406,1048.1406,1323.1406,1630.1406,1969.1406,2340.1406,2743.1406,3178.1406,3645.1406,4144.1406,5238.1406,6460.1406,7810.1406,9288.141,10894.141,12628.141,14490.141,16480.14,20844.14,25720.14,31108.14,37008.14,43420.14,50344.14,57780.14,65728.14,83160.14,102640.14,124168.14,147744.14,173368.14,201040.14,230760.14,0.13482666,0.13411045,0.13339615,0.13268375,0.13197327,0.13126469,0.13055801,0.12985325,0.12915039,0.1277504,0.12635803,0.1249733,0.12359619,0.122226715,0.12086487,0.11951065,0.11816406,0.115493774,0.112854004,0.11024475,0.107666016,0.1051178,0.1026001,0.100112915,0.09765625,0.09283447,0.088134766,0.08355713,0.07910156,0.07476807,0.07055664,0.066467285,0.0625,0.05493164,0.047851562,0.041259766,0.03515625,0.029541016,0.024414062,0.01977539,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.390625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.25,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,74.390625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,244.14062,310.64062,385.14062,467.64062,558.1406,656.6406,763.1406,877.6406,1000.1406,1269.1406,1570.1406,1903.1406,2268.1406,2665.1406,3094.1406,3555.1406,4048.1406,5130.1406,6340.1406,7678.1406,9144.141,10738.141,12460.141,14310.141,16288.141,20628.14,25480.14,30844.14,36720.14,43108.14,50008.14,57420.14,65344.14,82728.14,102160.14,123640.14,147168.14,172744.14,200368.14,230040.14,0.16503906,0.17225742,0.173069,0.17388248,0.17469788,0.17551517,0.17633438,0.1771555,0.17797852,0.17963028,0.18128967,0.1829567,0.18463135,0.18631363,0.18800354,0.18970108,0.19140625,0.19483948,0.19830322,0.20179749,0.20532227,0.20887756,0.21246338,0.21607971,0.21972656,0.22711182,0.23461914,0.24224854,0.25,0.25787354,0.26586914,0.27398682,0.28222656,0.29907227,0.31640625,0.33422852,0.35253906,0.3713379,0.390625,0.4104004,0.43066406,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.8056641,1.9775391,2.3447266,2.743164,3.1728516,3.633789,4.1259766,4.649414,5.2041016,5.790039,7.055664,8.446289,9.961914,11.602539,13.368164,15.258789,17.274414,19.41504,24.07129,29.22754,34.88379,41.04004,47.69629,54.85254,62.50879,70.66504,88.47754,108.29004,130.10254,153.91504,179.72754,207.54004,237.35254,269.16504,338.79004,416.41504,502.04004,595.66504,697.29004,806.91504,924.54004,1050.165,1325.415,1632.665,1971.915,2343.165,2746.415,3181.665,3648.915,4148.165,5242.665,6465.165,7815.665,9294.165,10900.665,12635.165,14497.665,16488.164,20853.164,25730.164,31119.164,37020.164,43433.164,50358.164,57795.164,65744.164,83178.164,102660.164,124190.164,147768.17,173394.17,201068.17,230790.17,0.15875244,0.1579752,0.15719986,0.15642643,0.1556549,0.15488529,0.15411758,0.15335178,0.15258789,0.15106583,0.14955139,0.14804459,0.14654541,0.14505386,0.14356995,0.14209366,0.140625,0.13771057,0.13482666,0.13197327,0.12915039,0.12635803,0.12359619,0.12086487,0.11816406,0.112854004,0.107666016,0.1026001,0.09765625,0.09283447,0.088134766,0.08355713,0.07910156,0.07055664,0.0625,0.05493164,0.047851562,0.041259766,0.03515625,0.029541016,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.0087890625,0.024414062,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.35253906,0.51660156,0.71191406,0.93847656,1.1962891,1.4853516,1.8056641,2.1572266,2.540039,3.399414,4.383789,5.493164,6.727539,8.086914,9.571289,11.180664,12.915039,16.75879,21.10254,25.94629,31.29004,37.13379,43.47754,50.32129,57.66504,73.85254,92.04004,112.22754,134.41504,158.60254,184.79004,212.97754,243.16504,309.54004,383.91504,466.29004,556.66504,655.04004,761.41504,875.79004,998.16504,1266.915,1567.665,1900.415,2265.165,2661.915,3090.665,3551.415,4044.165,5125.665,6335.165,7672.665,9138.165,10731.665,12453.165,14302.665,16280.165,20619.164,25470.164,30833.164,36708.164,43095.164,49994.164,57405.164,65328.164,82710.164,102140.164,123618.164,147144.17,172718.17,200340.17,230010.17,0.19140625,0.19917393,0.20004654,0.20092106,0.20179749,0.20267582,0.20355606,0.20443821,0.20532227,0.2070961,0.20887756,0.21066666,0.21246338,0.21426773,0.21607971,0.21789932,0.21972656,0.22340393,0.22711182,0.23085022,0.23461914,0.23841858,0.24224854,0.24610901,0.25,0.25787354,0.26586914,0.27398682,0.28222656,0.29058838,0.29907227,0.30767822,0.31640625,0.33422852,0.35253906,0.3713379,0.390625,0.4104004,0.43066406,0.45141602,0.47265625,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,19.691406,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,63.003906,71.19141,89.06641,108.94141,130.8164,154.6914,180.5664,208.4414,238.3164,270.1914,339.9414,417.6914,503.4414,597.1914,698.9414,808.6914,926.4414,1052.1914,1327.6914,1635.1914,1974.6914,2346.1914,2749.6914,3185.1914,3652.6914,4152.1914,5247.1914,6470.1914,7821.1914,9300.191,10907.191,12642.191,14505.191,16496.191,20862.191,25740.191,31130.191,37032.19,43446.19,50372.19,57810.19,65760.19,83196.19,102680.19,124212.19,147792.19,173420.19,201096.19,230820.19,0.18463135,0.18379307,0.1829567,0.18212223,0.18128967,0.18045902,0.17963028,0.17880344,0.17797852,0.17633438,0.17469788,0.173069,0.17144775,0.16983414,0.16822815,0.16662979,0.16503906,0.1618805,0.15875244,0.1556549,0.15258789,0.14955139,0.14654541,0.14356995,0.140625,0.13482666,0.12915039,0.12359619,0.11816406,0.112854004,0.107666016,0.1026001,0.09765625,0.088134766,0.07910156,0.07055664,0.0625,0.05493164,0.047851562,0.041259766,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.4414062,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,12.691406,16.503906,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,57.191406,73.31641,91.44141,111.56641,133.6914,157.8164,183.9414,212.0664,242.1914,308.4414,382.6914,464.9414,555.1914,653.4414,759.6914,873.9414,996.1914,1264.6914,1565.1914,1897.6914,2262.1914,2658.6914,3087.1914,3547.6914,4040.1914,5121.1914,6330.1914,7667.1914,9132.191,10725.191,12446.191,14295.191,16272.191,20610.191,25460.191,30822.191,36696.19,43082.19,49980.19,57390.19,65312.19,82692.19,102120.19,123596.19,147120.19,172692.19,200312.19,229980.19,0.21972656,0.22804356,0.2289772,0.22991276,0.23085022,0.23178959,0.23273087,0.23367405,0.23461914,0.23651505,0.23841858,0.24032974,0.24224854,0.24417496,0.24610901,0.24805069,0.25,0.2539215,0.25787354,0.26185608,0.26586914,0.26991272,0.27398682,0.27809143,0.28222656,0.29058838,0.29907227,0.30767822,0.31640625,0.32525635,0.33422852,0.34332275,0.35253906,0.3713379,0.390625,0.4104004,0.43066406,0.45141602,0.47265625,0.49438477,0.51660156,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,0.93847656,1.0634766,1.1962891,1.3369141,1.4853516,1.6416016,1.8056641,1.9775391,2.1572266,2.540039,2.9541016,3.399414,3.8759766,4.383789,4.9228516,5.493164,6.0947266,7.3916016,8.813477,10.360352,12.032227,13.829102,15.750977,17.797852,19.969727,24.688477,29.907227,35.625977,41.844727,48.563477,55.782227,63.500977,71.71973,89.65723,109.59473,131.53223,155.46973,181.40723,209.34473,239.28223,271.21973,341.09473,418.96973,504.84473,598.7197,700.5947,810.4697,928.3447,1054.2197,1329.9697,1637.7197,1977.4697,2349.2197,2752.9697,3188.7197,3656.4697,4156.2197,5251.7197,6475.2197,7826.7197,9306.22,10913.72,12649.22,14512.72,16504.219,20871.219,25750.219,31141.219,37044.22,43459.22,50386.22,57825.22,65776.22,83214.22,102700.22,124234.22,147816.22,173446.22,201124.22,230850.22,0.21246338,0.21156406,0.21066666,0.20977116,0.20887756,0.20798588,0.2070961,0.20620823,0.20532227,0.20355606,0.20179749,0.20004654,0.19830322,0.19656754,0.19483948,0.19311905,0.19140625,0.18800354,0.18463135,0.18128967,0.17797852,0.17469788,0.17144775,0.16822815,0.16503906,0.15875244,0.15258789,0.14654541,0.140625,0.13482666,0.12915039,0.12359619,0.11816406,0.107666016,0.09765625,0.088134766,0.07910156,0.07055664,0.0625,0.05493164,0.047851562,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.0009765625,0.0087890625,0.024414062,0.047851562,0.07910156,0.11816406,0.16503906,0.21972656,0.28222656,0.43066406,0.61035156,0.82128906,1.0634766,1.3369141,1.6416016,1.9775391,2.3447266,3.1728516,4.1259766,5.2041016,6.4072266,7.7353516,9.188477,10.766602,12.469727,16.250977,20.532227,25.313477,30.594727,36.375977,42.657227,49.438477,56.719727,72.78223,90.84473,110.90723,132.96973,157.03223,183.09473,211.15723,241.21973,307.34473,381.46973,463.59473,553.7197,651.8447,757.9697,872.0947,994.2197,1262.4697,1562.7197,1894.9697,2259.2197,2655.4697,3083.7197,3543.9697,4036.2197,5116.7197,6325.2197,7661.7197,9126.22,10718.72,12439.22,14287.72,16264.22,20601.219,25450.219,30811.219,36684.22,43069.22,49966.22,57375.22,65296.22,82674.22,102100.22,123574.22,147096.22,172666.22,200284.22,229950.22,0.25,0.2588663,0.259861,0.26085758,0.26185608,0.26285648,0.2638588,0.264863,0.26586914,0.26788712,0.26991272,0.27194595,0.27398682,0.2760353,0.27809143,0.28015518,0.28222656,0.2863922,0.29058838,0.29481506,0.29907227,0.30336,0.30767822,0.31202698,0.31640625,0.32525635,0.33422852,0.34332275,0.35253906,0.36187744,0.3713379,0.3809204,0.390625,0.4104004,0.43066406,0.45141602,0.47265625,0.49438477,0.51660156,0.53930664,0.5625,0.61035156,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,0.93847656,1,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.640625,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.25,7.5625,9,10.5625,12.25,14.0625,16,18.0625,20.25,25,30.25,36,42.25,49,56.25,64,72.25,90.25,110.25,132.25,156.25,182.25,210.25,240.25,272.25,342.25,420.25,506.25,600.25,702.25,812.25,930.25,1056.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,3660.25,4160.25,5256.25,6480.25,7832.25,9312.25,10920.25,12656.25,14520.25,16512.25,20880.25,25760.25,31152.25,37056.25,43472.25,50400.25,57840.25,65792.25,83232.25,102720.25,124256.25,147840.25,173472.25,201152.25,230880.25,0.24224854,0.24128819,0.24032974,0.2393732,0.23841858,0.23746586,0.23651505,0.23556614,0.23461914,0.23273087,0.23085022,0.2289772,0.22711182,0.22525406,0.22340393,0.22156143,0.21972656,0.21607971,0.21246338,0.20887756,0.20532227,0.20179749,0.19830322,0.19483948,0.19140625,0.18463135,0.17797852,0.17144775,0.16503906,0.15875244,0.15258789,0.14654541,0.140625,0.12915039,0.11816406,0.107666016,0.09765625,0.088134766,0.07910156,0.07055664,0.0625,0.047851562,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0.0009765625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.25,3.0625,4,5.0625,6.25,7.5625,9,10.5625,12.25,16,20.25,25,30.25,36,42.25,49,56.25,72.25,90.25,110.25,132.25,156.25,182.25,210.25,240.25,306.25,380.25,462.25,552.25,650.25,756.25,870.25,992.25,1260.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4032.25,5112.25,6320.25,7656.25,9120.25,10712.25,12432.25,14280.25,16256.25,20592.25,25440.25,30800.25,36672.25,43056.25,49952.25,57360.25,65280.25,82656.25,102080.25,123552.25,147072.25,172640.25,200256.25,229920.25,0.31640625,0.3263712,0.32748795,0.3286066,0.32972717,0.33084965,0.33197403,0.33310032,0.33422852,0.33649063,0.33876038,0.34103775,0.34332275,0.3456154,0.34791565,0.35022354,0.35253906,0.357193,0.36187744,0.3665924,0.3713379,0.3761139,0.3809204,0.38575745,0.390625,0.40045166,0.4104004,0.4204712,0.43066406,0.440979,0.45141602,0.4619751,0.47265625,0.49438477,0.51660156,0.53930664,0.5625,0.58618164,0.61035156,0.63500977,0.66015625,0.71191406,0.765625,0.82128906,0.87890625,0.93847656,1,1.0634766,1.1289062,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,18.597656,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,57.191406,65.00391,73.31641,91.44141,111.56641,133.6914,157.8164,183.9414,212.0664,242.1914,274.3164,344.5664,422.8164,509.0664,603.3164,705.5664,815.8164,934.0664,1060.3164,1336.8164,1645.3164,1985.8164,2358.3164,2762.8164,3199.3164,3667.8164,4168.3164,5265.3164,6490.3164,7843.3164,9324.316,10933.316,12670.316,14535.316,16528.316,20898.316,25780.316,31174.316,37080.316,43498.316,50428.316,57870.316,65824.31,83268.31,102760.31,124300.31,147888.31,173524.31,201208.31,230940.31,0.30767822,0.3065958,0.3055153,0.30443668,0.30336,0.3022852,0.3012123,0.30014133,0.29907227,0.29693985,0.29481506,0.2926979,0.29058838,0.28848648,0.2863922,0.28430557,0.28222656,0.27809143,0.27398682,0.26991272,0.26586914,0.26185608,0.25787354,0.2539215,0.25,0.24224854,0.23461914,0.22711182,0.21972656,0.21246338,0.20532227,0.19830322,0.19140625,0.17797852,0.16503906,0.15258789,0.140625,0.12915039,0.11816406,0.107666016,0.09765625,0.07910156,0.0625,0.047851562,0.03515625,0.024414062,0.015625,0.0087890625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.0664062,2.8476562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,11.816406,15.503906,19.691406,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,71.19141,89.06641,108.94141,130.8164,154.6914,180.5664,208.4414,238.3164,304.0664,377.8164,459.5664,549.3164,647.0664,752.8164,866.5664,988.3164,1255.8164,1555.3164,1886.8164,2250.3164,2645.8164,3073.3164,3532.8164,4024.3164,5103.3164,6310.3164,7645.3164,9108.316,10699.316,12418.316,14265.316,16240.316,20574.316,25420.316,30778.316,36648.316,43030.316,49924.316,57330.316,65248.316,82620.31,102040.31,123508.31,147024.31,172588.31,200200.31,229860.31,0.390625,0.40168858,0.4029274,0.40416813,0.40541077,0.4066553,0.40790176,0.40915012,0.4104004,0.41290665,0.41542053,0.41794205,0.4204712,0.42300797,0.42555237,0.4281044,0.43066406,0.43580627,0.440979,0.44618225,0.45141602,0.4566803,0.4619751,0.46730042,0.47265625,0.48345947,0.49438477,0.5054321,0.51660156,0.52789307,0.53930664,0.5508423,0.5625,0.58618164,0.61035156,0.63500977,0.66015625,0.685791,0.71191406,0.7385254,0.765625,0.82128906,0.87890625,0.93847656,1,1.0634766,1.1289062,1.1962891,1.265625,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.25,6.890625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,74.390625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,244.14062,276.39062,346.89062,425.39062,511.89062,606.3906,708.8906,819.3906,937.8906,1064.3906,1341.3906,1650.3906,1991.3906,2364.3906,2769.3906,3206.3906,3675.3906,4176.3906,5274.3906,6500.3906,7854.3906,9336.391,10946.391,12684.391,14550.391,16544.39,20916.39,25800.39,31196.39,37104.39,43524.39,50456.39,57900.39,65856.39,83304.39,102800.39,124344.39,147936.39,173576.39,201264.39,231000.39,0.3809204,0.37971592,0.37851334,0.37731266,0.3761139,0.37491703,0.37372208,0.37252903,0.3713379,0.36896133,0.3665924,0.3642311,0.36187744,0.3595314,0.357193,0.3548622,0.35253906,0.34791565,0.34332275,0.33876038,0.33422852,0.32972717,0.32525635,0.32081604,0.31640625,0.30767822,0.29907227,0.29058838,0.28222656,0.27398682,0.26586914,0.25787354,0.25,0.23461914,0.21972656,0.20532227,0.19140625,0.17797852,0.16503906,0.15258789,0.140625,0.11816406,0.09765625,0.07910156,0.0625,0.047851562,0.03515625,0.024414062,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.5625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,11.390625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,70.140625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,236.39062,301.89062,375.39062,456.89062,546.3906,643.8906,749.3906,862.8906,984.3906,1251.3906,1550.3906,1881.3906,2244.3906,2639.3906,3066.3906,3525.3906,4016.3906,5094.3906,6300.3906,7634.3906,9096.391,10686.391,12404.391,14250.391,16224.391,20556.39,25400.39,30756.39,36624.39,43004.39,49896.39,57300.39,65216.39,82584.39,102000.39,123464.39,146976.39,172536.39,200144.39,229800.39,0.47265625,0.48481846,0.48617935,0.48754215,0.48890686,0.49027348,0.491642,0.49301243,0.49438477,0.49713516,0.4998932,0.50265884,0.5054321,0.50821304,0.5110016,0.51379776,0.51660156,0.52223206,0.52789307,0.5335846,0.53930664,0.5450592,0.5508423,0.5566559,0.5625,0.5742798,0.58618164,0.59820557,0.61035156,0.6226196,0.63500977,0.647522,0.66015625,0.685791,0.71191406,0.7385254,0.765625,0.7932129,0.82128906,0.8498535,0.87890625,0.93847656,1,1.0634766,1.1289062,1.1962891,1.265625,1.3369141,1.4101562,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.2226562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,19.691406,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,59.097656,67.03516,75.47266,93.84766,114.22266,136.59766,160.97266,187.34766,215.72266,246.09766,278.47266,349.22266,427.97266,514.72266,609.47266,712.22266,822.97266,941.72266,1068.4727,1345.9727,1655.4727,1996.9727,2370.4727,2775.9727,3213.4727,3682.9727,4184.4727,5283.4727,6510.4727,7865.4727,9348.473,10959.473,12698.473,14565.473,16560.473,20934.473,25820.473,31218.473,37128.473,43550.473,50484.473,57930.473,65888.47,83340.47,102840.47,124388.47,147984.47,173628.47,201320.47,231060.47,0.4619751,0.46064854,0.45932388,0.45800114,0.4566803,0.45536137,0.45404434,0.45272923,0.45141602,0.44879532,0.44618225,0.4435768,0.440979,0.43838882,0.43580627,0.43323135,0.43066406,0.42555237,0.4204712,0.41542053,0.4104004,0.40541077,0.40045166,0.39552307,0.390625,0.3809204,0.3713379,0.36187744,0.35253906,0.34332275,0.33422852,0.32525635,0.31640625,0.29907227,0.28222656,0.26586914,0.25,0.23461914,0.21972656,0.20532227,0.19140625,0.16503906,0.140625,0.11816406,0.09765625,0.07910156,0.0625,0.047851562,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.09765625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,1.7226562,2.4414062,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,10.972656,14.535156,18.597656,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,69.09766,86.72266,106.34766,127.97266,151.59766,177.22266,204.84766,234.47266,299.72266,372.97266,454.22266,543.47266,640.72266,745.97266,859.22266,980.47266,1246.9727,1545.4727,1875.9727,2238.4727,2632.9727,3059.4727,3517.9727,4008.4727,5085.4727,6290.4727,7623.4727,9084.473,10673.473,12390.473,14235.473,16208.473,20538.473,25380.473,30734.473,36600.473,42978.473,49868.473,57270.473,65184.473,82548.47,101960.47,123420.47,146928.47,172484.47,200088.47,229740.47,0.5625,0.57576084,0.5772438,0.5787287,0.58021545,0.58170414,0.58319473,0.58468723,0.58618164,0.5891762,0.59217834,0.59518814,0.59820557,0.6012306,0.6042633,0.6073036,0.61035156,0.61647034,0.6226196,0.62879944,0.63500977,0.6412506,0.647522,0.65382385,0.66015625,0.6729126,0.685791,0.6987915,0.71191406,0.7251587,0.7385254,0.75201416,0.765625,0.7932129,0.82128906,0.8498535,0.87890625,0.90844727,0.93847656,0.96899414,1,1.0634766,1.1289062,1.1962891,1.265625,1.3369141,1.4101562,1.4853516,1.5625,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.515625,4,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,9,10.5625,12.25,14.0625,16,18.0625,20.25,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,945.5625,1072.5625,1350.5625,1660.5625,2002.5625,2376.5625,2782.5625,3220.5625,3690.5625,4192.5625,5292.5625,6520.5625,7876.5625,9360.5625,10972.5625,12712.5625,14580.5625,16576.562,20952.562,25840.562,31240.562,37152.562,43576.562,50512.562,57960.562,65920.56,83376.56,102880.56,124432.56,148032.56,173680.56,201376.56,231120.56,0.5508423,0.54939365,0.54794693,0.5465021,0.5450592,0.5436182,0.5421791,0.5407419,0.53930664,0.5364418,0.5335846,0.530735,0.52789307,0.52505875,0.52223206,0.519413,0.51660156,0.5110016,0.5054321,0.4998932,0.49438477,0.48890686,0.48345947,0.4780426,0.47265625,0.4619751,0.45141602,0.440979,0.43066406,0.4204712,0.4104004,0.40045166,0.390625,0.3713379,0.35253906,0.33422852,0.31640625,0.29907227,0.28222656,0.26586914,0.25,0.21972656,0.19140625,0.16503906,0.140625,0.11816406,0.09765625,0.07910156,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.5625,2.25,3.0625,4,5.0625,6.25,7.5625,9,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,297.5625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,976.5625,1242.5625,1540.5625,1870.5625,2232.5625,2626.5625,3052.5625,3510.5625,4000.5625,5076.5625,6280.5625,7612.5625,9072.5625,10660.5625,12376.5625,14220.5625,16192.5625,20520.562,25360.562,30712.562,36576.562,42952.562,49840.562,57240.562,65152.562,82512.56,101920.56,123376.56,146880.56,172432.56,200032.56,229680.56,0.66015625,0.6745157,0.67612076,0.6777277,0.67933655,0.6809473,0.68255997,0.68417454,0.685791,0.6890297,0.692276,0.69552994,0.6987915,0.7020607,0.7053375,0.708622,0.71191406,0.7185211,0.7251587,0.7318268,0.7385254,0.7452545,0.75201416,0.7588043,0.765625,0.7793579,0.7932129,0.80718994,0.82128906,0.83551025,0.8498535,0.86431885,0.87890625,0.90844727,0.93847656,0.96899414,1,1.0314941,1.0634766,1.0959473,1.1289062,1.1962891,1.265625,1.3369141,1.4101562,1.4853516,1.5625,1.6416016,1.7226562,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.2226562,7.9101562,9.378906,10.972656,12.691406,14.535156,16.503906,18.597656,20.816406,23.160156,28.222656,33.785156,39.847656,46.410156,53.472656,61.035156,69.09766,77.66016,96.28516,116.91016,139.53516,164.16016,190.78516,219.41016,250.03516,282.66016,353.91016,433.16016,520.41016,615.66016,718.91016,830.16016,949.41016,1076.6602,1355.1602,1665.6602,2008.1602,2382.6602,2789.1602,3227.6602,3698.1602,4200.66,5301.66,6530.66,7887.66,9372.66,10985.66,12726.66,14595.66,16592.66,20970.66,25860.66,31262.66,37176.66,43602.66,50540.66,57990.66,65952.66,83412.66,102920.66,124476.66,148080.66,173732.66,201432.66,231180.66,0.647522,0.6459513,0.6443825,0.6428156,0.6412506,0.63968754,0.6381264,0.6365671,0.63500977,0.6319008,0.62879944,0.6257057,0.6226196,0.61954117,0.61647034,0.61340714,0.61035156,0.6042633,0.59820557,0.59217834,0.58618164,0.58021545,0.5742798,0.56837463,0.5625,0.5508423,0.53930664,0.52789307,0.51660156,0.5054321,0.49438477,0.48345947,0.47265625,0.45141602,0.43066406,0.4104004,0.390625,0.3713379,0.35253906,0.33422852,0.31640625,0.28222656,0.25,0.21972656,0.19140625,0.16503906,0.140625,0.11816406,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.03515625,0.09765625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.4101562,2.0664062,2.8476562,3.7539062,4.7851562,5.9414062,7.2226562,8.628906,10.160156,13.597656,17.535156,21.972656,26.910156,32.347656,38.285156,44.722656,51.660156,67.03516,84.41016,103.78516,125.16016,148.53516,173.91016,201.28516,230.66016,295.41016,368.16016,448.91016,537.66016,634.41016,739.16016,851.91016,972.66016,1238.1602,1535.6602,1865.1602,2226.6602,2620.1602,3045.6602,3503.1602,3992.6602,5067.66,6270.66,7601.66,9060.66,10647.66,12362.66,14205.66,16176.66,20502.66,25340.66,30690.66,36552.66,42926.66,49812.66,57210.66,65120.66,82476.66,101880.66,123332.66,146832.66,172380.66,199976.66,229620.66,0.765625,0.7810831,0.7828102,0.7845392,0.78627014,0.78800297,0.7897377,0.79147434,0.7932129,0.7966957,0.80018616,0.80368423,0.80718994,0.8107033,0.81422424,0.81775284,0.82128906,0.8283844,0.83551025,0.8426666,0.8498535,0.8570709,0.86431885,0.8715973,0.87890625,0.8936157,0.90844727,0.9234009,0.93847656,0.9536743,0.96899414,0.98443604,1,1.0314941,1.0634766,1.0959473,1.1289062,1.1623535,1.1962891,1.2307129,1.265625,1.3369141,1.4101562,1.4853516,1.5625,1.6416016,1.7226562,1.8056641,1.890625,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.515625,4,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,78.765625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,252.01562,284.76562,356.26562,435.76562,523.2656,618.7656,722.2656,833.7656,953.2656,1080.7656,1359.7656,1670.7656,2013.7656,2388.7656,2795.7656,3234.7656,3705.7656,4208.7656,5310.7656,6540.7656,7898.7656,9384.766,10998.766,12740.766,14610.766,16608.766,20988.766,25880.766,31284.766,37200.766,43628.766,50568.766,58020.766,65984.766,83448.766,102960.766,124520.766,148128.77,173784.77,201488.77,231240.77,0.75201416,0.7503214,0.7486305,0.74694157,0.7452545,0.7435694,0.74188614,0.7402048,0.7385254,0.7351723,0.7318268,0.7284889,0.7251587,0.7218361,0.7185211,0.7152138,0.71191406,0.7053375,0.6987915,0.692276,0.685791,0.67933655,0.6729126,0.66651917,0.66015625,0.647522,0.63500977,0.6226196,0.61035156,0.59820557,0.58618164,0.5742798,0.5625,0.53930664,0.51660156,0.49438477,0.47265625,0.45141602,0.43066406,0.4104004,0.390625,0.35253906,0.31640625,0.28222656,0.25,0.21972656,0.19140625,0.16503906,0.140625,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,9.765625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,50.765625,66.015625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,228.76562,293.26562,365.76562,446.26562,534.7656,631.2656,735.7656,848.2656,968.7656,1233.7656,1530.7656,1859.7656,2220.7656,2613.7656,3038.7656,3495.7656,3984.7656,5058.7656,6260.7656,7590.7656,9048.766,10634.766,12348.766,14190.766,16160.766,20484.766,25320.766,30668.766,36528.766,42900.766,49784.766,57180.766,65088.766,82440.766,101840.766,123288.766,146784.77,172328.77,199920.77,229560.77,0.87890625,0.895463,0.89731216,0.89916325,0.90101624,0.90287113,0.90472794,0.90658665,0.90844727,0.9121742,0.9159088,0.91965103,0.9234009,0.92715836,0.93092346,0.9346962,0.93847656,0.9460602,0.9536743,0.96131897,0.96899414,0.9766998,0.98443604,0.99220276,1,1.015686,1.0314941,1.0474243,1.0634766,1.0796509,1.0959473,1.1123657,1.1289062,1.1623535,1.1962891,1.2307129,1.265625,1.3010254,1.3369141,1.373291,1.4101562,1.4853516,1.5625,1.6416016,1.7226562,1.8056641,1.890625,1.9775391,2.0664062,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.515625,3.7539062,4.2539062,4.7851562,5.3476562,5.9414062,6.5664062,7.2226562,7.9101562,8.628906,10.160156,11.816406,13.597656,15.503906,17.535156,19.691406,21.972656,24.378906,29.566406,35.253906,41.441406,48.128906,55.316406,63.003906,71.19141,79.87891,98.75391,119.62891,142.5039,167.3789,194.2539,223.1289,254.0039,286.8789,358.6289,438.3789,526.1289,621.8789,725.6289,837.3789,957.1289,1084.8789,1364.3789,1675.8789,2019.3789,2394.879,2802.379,3241.879,3713.379,4216.879,5319.879,6550.879,7909.879,9396.879,11011.879,12754.879,14625.879,16624.879,21006.879,25900.879,31306.879,37224.88,43654.88,50596.88,58050.88,66016.875,83484.875,103000.875,124564.875,148176.88,173836.88,201544.88,231300.88,0.86431885,0.862504,0.8606911,0.85888004,0.8570709,0.8552637,0.8534584,0.851655,0.8498535,0.84625626,0.8426666,0.8390846,0.83551025,0.8319435,0.8283844,0.8248329,0.82128906,0.81422424,0.80718994,0.80018616,0.7932129,0.78627014,0.7793579,0.7724762,0.765625,0.75201416,0.7385254,0.7251587,0.71191406,0.6987915,0.685791,0.6729126,0.66015625,0.63500977,0.61035156,0.58618164,0.5625,0.53930664,0.51660156,0.49438477,0.47265625,0.43066406,0.390625,0.35253906,0.31640625,0.28222656,0.25,0.21972656,0.19140625,0.140625,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.00390625,0.03515625,0.09765625,0.19140625,0.31640625,0.47265625,0.66015625,0.87890625,1.1289062,1.7226562,2.4414062,3.2851562,4.2539062,5.3476562,6.5664062,7.9101562,9.378906,12.691406,16.503906,20.816406,25.628906,30.941406,36.753906,43.066406,49.878906,65.00391,82.12891,101.25391,122.37891,145.5039,170.6289,197.7539,226.8789,291.1289,363.3789,443.6289,531.8789,628.1289,732.3789,844.6289,964.8789,1229.3789,1525.8789,1854.3789,2214.879,2607.379,3031.879,3488.379,3976.879,5049.879,6250.879,7579.879,9036.879,10621.879,12334.879,14175.879,16144.879,20466.879,25300.879,30646.879,36504.88,42874.88,49756.88,57150.88,65056.88,82404.875,101800.875,123244.875,146736.88,172276.88,199864.88,229500.88,1,1.0176554,1.0196266,1.0215998,1.0235748,1.0255518,1.0275307,1.0295115,1.0314941,1.0354652,1.039444,1.0434303,1.0474243,1.0514259,1.0554352,1.059452,1.0634766,1.0715485,1.0796509,1.0877838,1.0959473,1.1041412,1.1123657,1.1206207,1.1289062,1.1455688,1.1623535,1.1792603,1.1962891,1.21344,1.2307129,1.2481079,1.265625,1.3010254,1.3369141,1.373291,1.4101562,1.4475098,1.4853516,1.5236816,1.5625,1.6416016,1.7226562,1.8056641,1.890625,1.9775391,2.0664062,2.1572266,2.25,2.4414062,2.640625,2.8476562,3.0625,3.2851562,3.515625,3.7539062,4,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9,10.5625,12.25,14.0625,16,18.0625,20.25,22.5625,25,30.25,36,42.25,49,56.25,64,72.25,81,100,121,144,169,196,225,256,289,361,441,529,625,729,841,961,1089,1369,1681,2025,2401,2809,3249,3721,4225,5329,6561,7921,9409,11025,12769,14641,16641,21025,25921,31329,37249,43681,50625,58081,66049,83521,103041,124609,148225,173889,201601,231361,0.98443604,0.9824991,0.9805641,0.978631,0.9766998,0.97477055,0.97284317,0.9709177,0.96899414,0.96515274,0.96131897,0.9574928,0.9536743,0.94986343,0.9460602,0.94226456,0.93847656,0.93092346,0.9234009,0.9159088,0.90844727,0.90101624,0.8936157,0.8862457,0.87890625,0.86431885,0.8498535,0.83551025,0.82128906,0.80718994,0.7932129,0.7793579,0.765625,0.7385254,0.71191406,0.685791,0.66015625,0.63500977,0.61035156,0.58618164,0.5625,0.51660156,0.47265625,0.43066406,0.390625,0.35253906,0.31640625,0.28222656,0.25,0.19140625,0.140625,0.09765625,0.0625,0.03515625,0.015625,0.00390625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1,1.5625,2.25,3.0625,4,5.0625,6.25,7.5625,9,12.25,16,20.25,25,30.25,36,42.25,49,64,81,100,121,144,169,196,225,289,361,441,529,625,729,841,961,1225,1521,1849,2209,2601,3025,3481,3969,5041,6241,7569,9025,10609,12321,14161,16129,20449,25281,30625,36481,42849,49729,57121,65025,82369,101761,123201,146689,172225,199809,229441,1.265625,1.2854776,1.287693,1.2899103,1.2921295,1.2943506,1.2965736,1.2987986,1.3010254,1.3054848,1.3099518,1.3144264,1.3189087,1.3233986,1.3278961,1.3324013,1.3369141,1.3459625,1.3550415,1.364151,1.373291,1.3824615,1.3916626,1.4008942,1.4101562,1.428772,1.4475098,1.4663696,1.4853516,1.5044556,1.5236816,1.5430298,1.5625,1.6018066,1.6416016,1.6818848,1.7226562,1.763916,1.8056641,1.8479004,1.890625,1.9775391,2.0664062,2.1572266,2.25,2.3447266,2.4414062,2.540039,2.640625,2.8476562,3.0625,3.2851562,3.515625,3.7539062,4,4.2539062,4.515625,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9,9.765625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,74.390625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,228.76562,260.01562,293.26562,365.76562,446.26562,534.7656,631.2656,735.7656,848.2656,968.7656,1097.2656,1378.2656,1691.2656,2036.2656,2413.2656,2822.2656,3263.2656,3736.2656,4241.2656,5347.2656,6581.2656,7943.2656,9433.266,11051.266,12797.266,14671.266,16673.266,21061.266,25961.266,31373.266,37297.266,43733.266,50681.266,58141.266,66113.266,83593.266,103121.266,124697.266,148321.27,173993.27,201713.27,231481.27,1.2481079,1.2459269,1.2437477,1.2415705,1.2393951,1.2372217,1.2350502,1.2328806,1.2307129,1.2263832,1.2220612,1.2177467,1.21344,1.2091408,1.2048492,1.2005653,1.1962891,1.1877594,1.1792603,1.1707916,1.1623535,1.1539459,1.1455688,1.1372223,1.1289062,1.1123657,1.0959473,1.0796509,1.0634766,1.0474243,1.0314941,1.015686,1,0.96899414,0.93847656,0.90844727,0.87890625,0.8498535,0.82128906,0.7932129,0.765625,0.71191406,0.66015625,0.61035156,0.5625,0.51660156,0.47265625,0.43066406,0.390625,0.31640625,0.25,0.19140625,0.140625,0.09765625,0.0625,0.03515625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,8.265625,11.390625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,47.265625,62.015625,78.765625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,284.76562,356.26562,435.76562,523.2656,618.7656,722.2656,833.7656,953.2656,1216.2656,1511.2656,1838.2656,2197.2656,2588.2656,3011.2656,3466.2656,3953.2656,5023.2656,6221.2656,7547.2656,9001.266,10583.266,12293.266,14131.266,16097.266,20413.266,25241.266,30581.266,36433.266,42797.266,49673.266,57061.266,64961.266,82297.266,101681.266,123113.266,146593.27,172121.27,199697.27,229321.27,1.5625,1.5845499,1.5870094,1.5894709,1.5919342,1.5943995,1.5968666,1.5993357,1.6018066,1.6067543,1.6117096,1.6166725,1.6216431,1.6266212,1.631607,1.6366005,1.6416016,1.6516266,1.6616821,1.6717682,1.6818848,1.6920319,1.7022095,1.7124176,1.7226562,1.7432251,1.763916,1.784729,1.8056641,1.8267212,1.8479004,1.8692017,1.890625,1.9338379,1.9775391,2.0217285,2.0664062,2.1115723,2.1572266,2.2033691,2.25,2.3447266,2.4414062,2.540039,2.640625,2.743164,2.8476562,2.9541016,3.0625,3.2851562,3.515625,3.7539062,4,4.2539062,4.515625,4.7851562,5.0625,5.640625,6.25,6.890625,7.5625,8.265625,9,9.765625,10.5625,12.25,14.0625,16,18.0625,20.25,22.5625,25,27.5625,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,297.5625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,976.5625,1105.5625,1387.5625,1701.5625,2047.5625,2425.5625,2835.5625,3277.5625,3751.5625,4257.5625,5365.5625,6601.5625,7965.5625,9457.5625,11077.5625,12825.5625,14701.5625,16705.562,21097.562,26001.562,31417.562,37345.562,43785.562,50737.562,58201.562,66177.56,83665.56,103201.56,124785.56,148417.56,174097.56,201825.56,231601.56,1.5430298,1.5406046,1.5381813,1.5357599,1.5333405,1.5309229,1.5285072,1.5260935,1.5236816,1.5188637,1.5140533,1.5092506,1.5044556,1.4996681,1.4948883,1.4901161,1.4853516,1.4758453,1.4663696,1.4569244,1.4475098,1.4381256,1.428772,1.4194489,1.4101562,1.3916626,1.373291,1.3550415,1.3369141,1.3189087,1.3010254,1.2832642,1.265625,1.2307129,1.1962891,1.1623535,1.1289062,1.0959473,1.0634766,1.0314941,1,0.93847656,0.87890625,0.82128906,0.765625,0.71191406,0.66015625,0.61035156,0.5625,0.47265625,0.390625,0.31640625,0.25,0.19140625,0.140625,0.09765625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.5625,1,1.5625,2.25,3.0625,4,5.0625,6.25,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,45.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,280.5625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,945.5625,1207.5625,1501.5625,1827.5625,2185.5625,2575.5625,2997.5625,3451.5625,3937.5625,5005.5625,6201.5625,7525.5625,8977.5625,10557.5625,12265.5625,14101.5625,16065.5625,20377.562,25201.562,30537.562,36385.562,42745.562,49617.562,57001.562,64897.562,82225.56,101601.56,123025.56,146497.56,172017.56,199585.56,229201.56,1.890625,1.9148722,1.9175758,1.9202814,1.9229889,1.9256983,1.9284096,1.9311228,1.9338379,1.9392738,1.9447174,1.9501686,1.9556274,1.9610939,1.966568,1.9720497,1.9775391,1.9885406,1.9995728,2.0106354,2.0217285,2.0328522,2.0440063,2.055191,2.0664062,2.0889282,2.1115723,2.1343384,2.1572266,2.1802368,2.2033691,2.2266235,2.25,2.2971191,2.3447266,2.3928223,2.4414062,2.4904785,2.540039,2.590088,2.640625,2.743164,2.8476562,2.9541016,3.0625,3.1728516,3.2851562,3.399414,3.515625,3.7539062,4,4.2539062,4.515625,4.7851562,5.0625,5.3476562,5.640625,6.25,6.890625,7.5625,8.265625,9,9.765625,10.5625,11.390625,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,28.890625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,78.765625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,236.39062,268.14062,301.89062,375.39062,456.89062,546.3906,643.8906,749.3906,862.8906,984.3906,1113.8906,1396.8906,1711.8906,2058.8906,2437.8906,2848.8906,3291.8906,3766.8906,4273.8906,5383.8906,6621.8906,7987.8906,9481.891,11103.891,12853.891,14731.891,16737.89,21133.89,26041.89,31461.89,37393.89,43837.89,50793.89,58261.89,66241.89,83737.89,103281.89,124873.89,148513.89,174201.89,201937.89,231721.89,1.8692017,1.8665323,1.8638649,1.8611994,1.8585358,1.8558741,1.8532143,1.8505564,1.8479004,1.8425941,1.8372955,1.8320045,1.8267212,1.8214455,1.8161774,1.8109169,1.8056641,1.7951813,1.784729,1.7743073,1.763916,1.7535553,1.7432251,1.7329254,1.7226562,1.7022095,1.6818848,1.6616821,1.6416016,1.6216431,1.6018066,1.5820923,1.5625,1.5236816,1.4853516,1.4475098,1.4101562,1.373291,1.3369141,1.3010254,1.265625,1.1962891,1.1289062,1.0634766,1,0.93847656,0.87890625,0.82128906,0.765625,0.66015625,0.5625,0.47265625,0.390625,0.31640625,0.25,0.19140625,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.390625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,6.890625,9.765625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,43.890625,58.140625,74.390625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,276.39062,346.89062,425.39062,511.89062,606.3906,708.8906,819.3906,937.8906,1198.8906,1491.8906,1816.8906,2173.8906,2562.8906,2983.8906,3436.8906,3921.8906,4987.8906,6181.8906,7503.8906,8953.891,10531.891,12237.891,14071.891,16033.891,20341.89,25161.89,30493.89,36337.89,42693.89,49561.89,56941.89,64833.89,82153.89,101521.89,122937.89,146401.89,171913.89,199473.89,229081.89,2.25,2.2764444,2.2793922,2.282342,2.2852936,2.288247,2.2912025,2.29416,2.2971191,2.3030434,2.3089752,2.3149147,2.3208618,2.3268166,2.332779,2.338749,2.3447266,2.3567047,2.3687134,2.3807526,2.3928223,2.4049225,2.4170532,2.4292145,2.4414062,2.4658813,2.4904785,2.5151978,2.540039,2.5650024,2.590088,2.6152954,2.640625,2.6916504,2.743164,2.795166,2.8476562,2.9006348,2.9541016,3.0080566,3.0625,3.1728516,3.2851562,3.399414,3.515625,3.633789,3.7539062,3.8759766,4,4.2539062,4.515625,4.7851562,5.0625,5.3476562,5.640625,5.9414062,6.25,6.890625,7.5625,8.265625,9,9.765625,10.5625,11.390625,12.25,14.0625,16,18.0625,20.25,22.5625,25,27.5625,30.25,36,42.25,49,56.25,64,72.25,81,90.25,110.25,132.25,156.25,182.25,210.25,240.25,272.25,306.25,380.25,462.25,552.25,650.25,756.25,870.25,992.25,1122.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,3782.25,4290.25,5402.25,6642.25,8010.25,9506.25,11130.25,12882.25,14762.25,16770.25,21170.25,26082.25,31506.25,37442.25,43890.25,50850.25,58322.25,66306.25,83810.25,103362.25,124962.25,148610.25,174306.25,202050.25,231842.25,2.2266235,2.22371,2.2207985,2.2178888,2.214981,2.2120752,2.2091713,2.2062693,2.2033691,2.1975746,2.1917877,2.1860085,2.1802368,2.1744728,2.1687164,2.1629677,2.1572266,2.1457672,2.1343384,2.12294,2.1115723,2.100235,2.0889282,2.077652,2.0664062,2.0440063,2.0217285,1.9995728,1.9775391,1.9556274,1.9338379,1.9121704,1.890625,1.8479004,1.8056641,1.763916,1.7226562,1.6818848,1.6416016,1.6018066,1.5625,1.4853516,1.4101562,1.3369141,1.265625,1.1962891,1.1289062,1.0634766,1,0.87890625,0.765625,0.66015625,0.5625,0.47265625,0.390625,0.31640625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.25,0.5625,1,1.5625,2.25,3.0625,4,5.0625,6.25,9,12.25,16,20.25,25,30.25,36,42.25,56.25,72.25,90.25,110.25,132.25,156.25,182.25,210.25,272.25,342.25,420.25,506.25,600.25,702.25,812.25,930.25,1190.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,3906.25,4970.25,6162.25,7482.25,8930.25,10506.25,12210.25,14042.25,16002.25,20306.25,25122.25,30450.25,36290.25,42642.25,49506.25,56882.25,64770.25,82082.25,101442.25,122850.25,146306.25,171810.25,199362.25,228962.25,2.640625,2.6692667,2.6724586,2.6756525,2.6788483,2.682046,2.6852455,2.688447,2.6916504,2.698063,2.704483,2.7109108,2.7173462,2.7237892,2.7302399,2.7366982,2.743164,2.7561188,2.769104,2.7821198,2.795166,2.8082428,2.82135,2.834488,2.8476562,2.8740845,2.9006348,2.9273071,2.9541016,2.981018,3.0080566,3.0352173,3.0625,3.1174316,3.1728516,3.2287598,3.2851562,3.342041,3.399414,3.4572754,3.515625,3.633789,3.7539062,3.8759766,4,4.1259766,4.2539062,4.383789,4.515625,4.7851562,5.0625,5.3476562,5.640625,5.9414062,6.25,6.5664062,6.890625,7.5625,8.265625,9,9.765625,10.5625,11.390625,12.25,13.140625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,28.890625,31.640625,37.515625,43.890625,50.765625,58.140625,66.015625,74.390625,83.265625,92.640625,112.890625,135.14062,159.39062,185.64062,213.89062,244.14062,276.39062,310.64062,385.14062,467.64062,558.1406,656.6406,763.1406,877.6406,1000.1406,1130.6406,1415.6406,1732.6406,2081.6406,2462.6406,2875.6406,3320.6406,3797.6406,4306.6406,5420.6406,6662.6406,8032.6406,9530.641,11156.641,12910.641,14792.641,16802.64,21206.64,26122.64,31550.64,37490.64,43942.64,50906.64,58382.64,66370.64,83882.64,103442.64,125050.64,148706.64,174410.64,202162.64,231962.64,2.6152954,2.6121378,2.608982,2.6058283,2.6026764,2.5995264,2.5963783,2.5932322,2.590088,2.583805,2.57753,2.5712624,2.5650024,2.5587502,2.5525055,2.5462685,2.540039,2.5276031,2.5151978,2.5028229,2.4904785,2.4781647,2.4658813,2.4536285,2.4414062,2.4170532,2.3928223,2.3687134,2.3447266,2.3208618,2.2971191,2.2734985,2.25,2.2033691,2.1572266,2.1115723,2.0664062,2.0217285,1.9775391,1.9338379,1.890625,1.8056641,1.7226562,1.6416016,1.5625,1.4853516,1.4101562,1.3369141,1.265625,1.1289062,1,0.87890625,0.765625,0.66015625,0.5625,0.47265625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.140625,0.390625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,5.640625,8.265625,11.390625,15.015625,19.140625,23.765625,28.890625,34.515625,40.640625,54.390625,70.140625,87.890625,107.640625,129.39062,153.14062,178.89062,206.64062,268.14062,337.64062,415.14062,500.64062,594.1406,695.6406,805.1406,922.6406,1181.6406,1472.6406,1795.6406,2150.6406,2537.6406,2956.6406,3407.6406,3890.6406,4952.6406,6142.6406,7460.6406,8906.641,10480.641,12182.641,14012.641,15970.641,20270.64,25082.64,30406.64,36242.64,42590.64,49450.64,56822.64,64706.64,82010.64,101362.64,122762.64,146210.64,171706.64,199250.64,228842.64,3.0625,3.093339,3.096775,3.100213,3.103653,3.1070948,3.1105385,3.113984,3.1174316,3.1243324,3.1312408,3.138157,3.1450806,3.1520119,3.1589508,3.1658974,3.1728516,3.1867828,3.2007446,3.214737,3.2287598,3.242813,3.256897,3.2710114,3.2851562,3.3135376,3.342041,3.3706665,3.399414,3.4282837,3.4572754,3.4863892,3.515625,3.574463,3.633789,3.6936035,3.7539062,3.8146973,3.8759766,3.9377441,4,4.1259766,4.2539062,4.383789,4.515625,4.649414,4.7851562,4.9228516,5.0625,5.3476562,5.640625,5.9414062,6.25,6.5664062,6.890625,7.2226562,7.5625,8.265625,9,9.765625,10.5625,11.390625,12.25,13.140625,14.0625,16,18.0625,20.25,22.5625,25,27.5625,30.25,33.0625,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,315.0625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1008.0625,1139.0625,1425.0625,1743.0625,2093.0625,2475.0625,2889.0625,3335.0625,3813.0625,4323.0625,5439.0625,6683.0625,8055.0625,9555.0625,11183.0625,12939.0625,14823.0625,16835.062,21243.062,26163.062,31595.062,37539.062,43995.062,50963.062,58443.062,66435.06,83955.06,103523.06,125139.06,148803.06,174515.06,202275.06,232083.06,3.0352173,3.0318155,3.0284157,3.0250177,3.0216217,3.0182276,3.0148354,3.011445,3.0080566,3.0012856,2.994522,2.9877663,2.981018,2.9742775,2.9675446,2.9608192,2.9541016,2.940689,2.9273071,2.9139557,2.9006348,2.8873444,2.8740845,2.860855,2.8476562,2.82135,2.795166,2.769104,2.743164,2.7173462,2.6916504,2.6660767,2.640625,2.590088,2.540039,2.4904785,2.4414062,2.3928223,2.3447266,2.2971191,2.25,2.1572266,2.0664062,1.9775391,1.890625,1.8056641,1.7226562,1.6416016,1.5625,1.4101562,1.265625,1.1289062,1,0.87890625,0.765625,0.66015625,0.5625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.0625,0.25,0.5625,1,1.5625,2.25,3.0625,4,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,39.0625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,203.0625,264.0625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,915.0625,1173.0625,1463.0625,1785.0625,2139.0625,2525.0625,2943.0625,3393.0625,3875.0625,4935.0625,6123.0625,7439.0625,8883.0625,10455.0625,12155.0625,13983.0625,15939.0625,20235.062,25043.062,30363.062,36195.062,42539.062,49395.062,56763.062,64643.062,81939.06,101283.06,122675.06,146115.06,171603.06,199139.06,228723.06,3.515625,3.5486612,3.5523415,3.5560236,3.5597076,3.5633936,3.5670815,3.5707712,3.574463,3.581852,3.5892487,3.596653,3.604065,3.6114845,3.6189117,3.6263466,3.633789,3.648697,3.6636353,3.6786041,3.6936035,3.7086334,3.7236938,3.7387848,3.7539062,3.7842407,3.8146973,3.8452759,3.8759766,3.9067993,3.9377441,3.968811,4,4.062744,4.1259766,4.1896973,4.2539062,4.3186035,4.383789,4.449463,4.515625,4.649414,4.7851562,4.9228516,5.0625,5.2041016,5.3476562,5.493164,5.640625,5.9414062,6.25,6.5664062,6.890625,7.2226562,7.5625,7.9101562,8.265625,9,9.765625,10.5625,11.390625,12.25,13.140625,14.0625,15.015625,17.015625,19.140625,21.390625,23.765625,26.265625,28.890625,31.640625,34.515625,40.640625,47.265625,54.390625,62.015625,70.140625,78.765625,87.890625,97.515625,118.265625,141.01562,165.76562,192.51562,221.26562,252.01562,284.76562,319.51562,395.01562,478.51562,570.0156,669.5156,777.0156,892.5156,1016.0156,1147.5156,1434.5156,1753.5156,2104.5156,2487.5156,2902.5156,3349.5156,3828.5156,4339.5156,5457.5156,6703.5156,8077.5156,9579.516,11209.516,12967.516,14853.516,16867.516,21279.516,26203.516,31639.516,37587.516,44047.516,51019.516,58503.516,66499.516,84027.516,103603.516,125227.516,148899.52,174619.52,202387.52,232203.52,3.4863892,3.4827433,3.4790993,3.4754572,3.471817,3.4681787,3.4645424,3.460908,3.4572754,3.450016,3.4427643,3.4355202,3.4282837,3.4210548,3.4138336,3.40662,3.399414,3.385025,3.3706665,3.3563385,3.342041,3.327774,3.3135376,3.2993317,3.2851562,3.256897,3.2287598,3.2007446,3.1728516,3.1450806,3.1174316,3.0899048,3.0625,3.0080566,2.9541016,2.9006348,2.8476562,2.795166,2.743164,2.6916504,2.640625,2.540039,2.4414062,2.3447266,2.25,2.1572266,2.0664062,1.9775391,1.890625,1.7226562,1.5625,1.4101562,1.265625,1.1289062,1,0.87890625,0.765625,0.5625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.015625,0.140625,0.390625,0.765625,1.265625,1.890625,2.640625,3.515625,4.515625,6.890625,9.765625,13.140625,17.015625,21.390625,26.265625,31.640625,37.515625,50.765625,66.015625,83.265625,102.515625,123.765625,147.01562,172.26562,199.51562,260.01562,328.51562,405.01562,489.51562,582.0156,682.5156,791.0156,907.5156,1164.5156,1453.5156,1774.5156,2127.5156,2512.5156,2929.5156,3378.5156,3859.5156,4917.5156,6103.5156,7417.5156,8859.516,10429.516,12127.516,13953.516,15907.516,20199.516,25003.516,30319.516,36147.516,42487.516,49339.516,56703.516,64579.516,81867.516,101203.516,122587.516,146019.52,171499.52,199027.52,228603.52,4,4.0352335,4.039158,4.043084,4.0470123,4.0509424,4.0548744,4.0588083,4.062744,4.0706215,4.0785065,4.086399,4.0942993,4.102207,4.1101227,4.118046,4.1259766,4.141861,4.157776,4.1737213,4.1896973,4.2057037,4.2217407,4.237808,4.2539062,4.286194,4.3186035,4.3511353,4.383789,4.416565,4.449463,4.482483,4.515625,4.5822754,4.649414,4.717041,4.7851562,4.85376,4.9228516,4.9924316,5.0625,5.2041016,5.3476562,5.493164,5.640625,5.790039,5.9414062,6.0947266,6.25,6.5664062,6.890625,7.2226562,7.5625,7.9101562,8.265625,8.628906,9,9.765625,10.5625,11.390625,12.25,13.140625,14.0625,15.015625,16,18.0625,20.25,22.5625,25,27.5625,30.25,33.0625,36,42.25,49,56.25,64,72.25,81,90.25,100,121,144,169,196,225,256,289,324,400,484,576,676,784,900,1024,1156,1444,1764,2116,2500,2916,3364,3844,4356,5476,6724,8100,9604,11236,12996,14884,16900,21316,26244,31684,37636,44100,51076,58564,66564,84100,103684,125316,148996,174724,202500,232324,3.968811,3.964921,3.9610329,3.9571466,3.9532623,3.94938,3.9454994,3.9416208,3.9377441,3.9299965,3.9222565,3.914524,3.9067993,3.8990822,3.8913727,3.8836708,3.8759766,3.860611,3.8452759,3.8299713,3.8146973,3.7994537,3.7842407,3.7690582,3.7539062,3.7236938,3.6936035,3.6636353,3.633789,3.604065,3.574463,3.544983,3.515625,3.4572754,3.399414,3.342041,3.2851562,3.2287598,3.1728516,3.1174316,3.0625,2.9541016,2.8476562,2.743164,2.640625,2.540039,2.4414062,2.3447266,2.25,2.0664062,1.890625,1.7226562,1.5625,1.4101562,1.265625,1.1289062,1,0.765625,0.5625,0.390625,0.25,0.140625,0.0625,0.015625,0,0.0625,0.25,0.5625,1,1.5625,2.25,3.0625,4,6.25,9,12.25,16,20.25,25,30.25,36,49,64,81,100,121,144,169,196,256,324,400,484,576,676,784,900,1156,1444,1764,2116,2500,2916,3364,3844,4900,6084,7396,8836,10404,12100,13924,15876,20164,24964,30276,36100,42436,49284,56644,64516,81796,101124,122500,145924,171396,198916,228484,5.0625,5.102128,5.1065407,5.110955,5.1153717,5.11979,5.1242104,5.1286325,5.1330566,5.1419106,5.150772,5.1596413,5.168518,5.1774025,5.1862946,5.1951942,5.2041016,5.221939,5.239807,5.2577057,5.275635,5.2935944,5.3115845,5.329605,5.3476562,5.38385,5.420166,5.456604,5.493164,5.529846,5.5666504,5.6035767,5.640625,5.715088,5.790039,5.8654785,5.9414062,6.0178223,6.0947266,6.172119,6.25,6.4072266,6.5664062,6.727539,6.890625,7.055664,7.2226562,7.3916016,7.5625,7.9101562,8.265625,8.628906,9,9.378906,9.765625,10.160156,10.5625,11.390625,12.25,13.140625,14.0625,15.015625,16,17.015625,18.0625,20.25,22.5625,25,27.5625,30.25,33.0625,36,39.0625,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,297.5625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,915.0625,1040.0625,1173.0625,1463.0625,1785.0625,2139.0625,2525.0625,2943.0625,3393.0625,3875.0625,4389.0625,5513.0625,6765.0625,8145.0625,9653.0625,11289.0625,13053.0625,14945.0625,16965.062,21389.062,26325.062,31773.062,37733.062,44205.062,51189.062,58685.062,66693.06,84245.06,103845.06,125493.06,149189.06,174933.06,202725.06,232565.06,5.027405,5.0230265,5.01865,5.0142756,5.009903,5.0055323,5.0011635,4.9967966,4.9924316,4.9837074,4.974991,4.966282,4.9575806,4.948887,4.940201,4.9315224,4.9228516,4.905533,4.8882446,4.870987,4.85376,4.836563,4.819397,4.8022614,4.7851562,4.7510376,4.717041,4.6831665,4.649414,4.6157837,4.5822754,4.548889,4.515625,4.449463,4.383789,4.3186035,4.2539062,4.1896973,4.1259766,4.062744,4,3.8759766,3.7539062,3.633789,3.515625,3.399414,3.2851562,3.1728516,3.0625,2.8476562,2.640625,2.4414062,2.25,2.0664062,1.890625,1.7226562,1.5625,1.265625,1,0.765625,0.5625,0.390625,0.25,0.140625,0.0625,0,0.0625,0.25,0.5625,1,1.5625,2.25,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,33.0625,45.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,189.0625,248.0625,315.0625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1139.0625,1425.0625,1743.0625,2093.0625,2475.0625,2889.0625,3335.0625,3813.0625,4865.0625,6045.0625,7353.0625,8789.0625,10353.0625,12045.0625,13865.0625,15813.0625,20093.062,24885.062,30189.062,36005.062,42333.062,49173.062,56525.062,64389.062,81653.06,100965.06,122325.06,145733.06,171189.06,198693.06,228245.06,6.25,6.2940226,6.2989235,6.3038263,6.308731,6.3136377,6.3185463,6.323457,6.328369,6.3381996,6.3480377,6.3578835,6.367737,6.377598,6.3874664,6.3973427,6.4072266,6.427017,6.4468384,6.46669,6.4865723,6.506485,6.526428,6.546402,6.5664062,6.6065063,6.6467285,6.6870728,6.727539,6.7681274,6.808838,6.8496704,6.890625,6.9729004,7.055664,7.138916,7.2226562,7.306885,7.3916016,7.4768066,7.5625,7.7353516,7.9101562,8.086914,8.265625,8.446289,8.628906,8.813477,9,9.378906,9.765625,10.160156,10.5625,10.972656,11.390625,11.816406,12.25,13.140625,14.0625,15.015625,16,17.015625,18.0625,19.140625,20.25,22.5625,25,27.5625,30.25,33.0625,36,39.0625,42.25,49,56.25,64,72.25,81,90.25,100,110.25,132.25,156.25,182.25,210.25,240.25,272.25,306.25,342.25,420.25,506.25,600.25,702.25,812.25,930.25,1056.25,1190.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,3906.25,4422.25,5550.25,6806.25,8190.25,9702.25,11342.25,13110.25,15006.25,17030.25,21462.25,26406.25,31862.25,37830.25,44310.25,51302.25,58806.25,66822.25,84390.25,104006.25,125670.25,149382.25,175142.25,202950.25,232806.25,6.2109985,6.206132,6.2012672,6.1964045,6.1915436,6.1866846,6.1818275,6.1769724,6.172119,6.1624184,6.152725,6.1430397,6.133362,6.1236916,6.114029,6.104374,6.0947266,6.0754547,6.0562134,6.0370026,6.0178223,5.9986725,5.979553,5.9604645,5.9414062,5.9033813,5.8654785,5.8276978,5.790039,5.7525024,5.715088,5.6777954,5.640625,5.5666504,5.493164,5.420166,5.3476562,5.275635,5.2041016,5.1330566,5.0625,4.9228516,4.7851562,4.649414,4.515625,4.383789,4.2539062,4.1259766,4,3.7539062,3.515625,3.2851562,3.0625,2.8476562,2.640625,2.4414062,2.25,1.890625,1.5625,1.265625,1,0.765625,0.5625,0.390625,0.25,0.0625,0,0.0625,0.25,0.5625,1,1.5625,2.25,4,6.25,9,12.25,16,20.25,25,30.25,42.25,56.25,72.25,90.25,110.25,132.25,156.25,182.25,240.25,306.25,380.25,462.25,552.25,650.25,756.25,870.25,1122.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,3782.25,4830.25,6006.25,7310.25,8742.25,10302.25,11990.25,13806.25,15750.25,20022.25,24806.25,30102.25,35910.25,42230.25,49062.25,56406.25,64262.25,81510.25,100806.25,122150.25,145542.25,170982.25,198470.25,228006.25,7.5625,7.610917,7.6163063,7.6216974,7.6270905,7.6324854,7.637882,7.643281,7.6486816,7.6594887,7.6703033,7.6811256,7.6919556,7.702793,7.7136383,7.724491,7.7353516,7.7570953,7.7788696,7.8006744,7.82251,7.8443756,7.866272,7.888199,7.9101562,7.9541626,7.998291,8.0425415,8.086914,8.131409,8.176025,8.220764,8.265625,8.355713,8.446289,8.5373535,8.628906,8.720947,8.813477,8.906494,9,9.188477,9.378906,9.571289,9.765625,9.961914,10.160156,10.360352,10.5625,10.972656,11.390625,11.816406,12.25,12.691406,13.140625,13.597656,14.0625,15.015625,16,17.015625,18.0625,19.140625,20.25,21.390625,22.5625,25,27.5625,30.25,33.0625,36,39.0625,42.25,45.5625,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,115.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,315.0625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,945.5625,1072.5625,1207.5625,1501.5625,1827.5625,2185.5625,2575.5625,2997.5625,3451.5625,3937.5625,4455.5625,5587.5625,6847.5625,8235.5625,9751.5625,11395.5625,13167.5625,15067.5625,17095.562,21535.562,26487.562,31951.562,37927.562,44415.562,51415.562,58927.562,66951.56,84535.56,104167.56,125847.56,149575.56,175351.56,203175.56,233047.56,7.5195923,7.5142374,7.5088844,7.5035334,7.498184,7.492837,7.4874916,7.482148,7.4768066,7.4661293,7.4554596,7.4447975,7.434143,7.4234962,7.412857,7.4022255,7.3916016,7.3703766,7.349182,7.328018,7.306885,7.285782,7.2647095,7.2436676,7.2226562,7.180725,7.138916,7.097229,7.055664,7.014221,6.9729004,6.9317017,6.890625,6.808838,6.727539,6.6467285,6.5664062,6.4865723,6.4072266,6.328369,6.25,6.0947266,5.9414062,5.790039,5.640625,5.493164,5.3476562,5.2041016,5.0625,4.7851562,4.515625,4.2539062,4,3.7539062,3.515625,3.2851562,3.0625,2.640625,2.25,1.890625,1.5625,1.265625,1,0.765625,0.5625,0.25,0.0625,0,0.0625,0.25,0.5625,1,1.5625,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,27.5625,39.0625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,175.5625,232.5625,297.5625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,1105.5625,1387.5625,1701.5625,2047.5625,2425.5625,2835.5625,3277.5625,3751.5625,4795.5625,5967.5625,7267.5625,8695.5625,10251.5625,11935.5625,13747.5625,15687.5625,19951.562,24727.562,30015.562,35815.562,42127.562,48951.562,56287.562,64135.562,81367.56,100647.56,121975.56,145351.56,170775.56,198247.56,227767.56,9,9.052812,9.058689,9.0645685,9.07045,9.076333,9.082218,9.088105,9.093994,9.105778,9.117569,9.129368,9.141174,9.152988,9.16481,9.17664,9.188477,9.212173,9.235901,9.259659,9.283447,9.307266,9.331116,9.354996,9.378906,9.426819,9.4748535,9.52301,9.571289,9.61969,9.668213,9.716858,9.765625,9.863525,9.961914,10.060791,10.160156,10.26001,10.360352,10.461182,10.5625,10.766602,10.972656,11.180664,11.390625,11.602539,11.816406,12.032227,12.25,12.691406,13.140625,13.597656,14.0625,14.535156,15.015625,15.503906,16,17.015625,18.0625,19.140625,20.25,21.390625,22.5625,23.765625,25,27.5625,30.25,33.0625,36,39.0625,42.25,45.5625,49,56.25,64,72.25,81,90.25,100,110.25,121,144,169,196,225,256,289,324,361,441,529,625,729,841,961,1089,1225,1521,1849,2209,2601,3025,3481,3969,4489,5625,6889,8281,9801,11449,13225,15129,17161,21609,26569,32041,38025,44521,51529,59049,67081,84681,104329,126025,149769,175561,203401,233289,8.953186,8.947343,8.941502,8.935662,8.929825,8.923989,8.918156,8.912324,8.906494,8.89484,8.883194,8.871555,8.859924,8.848301,8.836685,8.825077,8.813477,8.790298,8.767151,8.744034,8.720947,8.697891,8.674866,8.651871,8.628906,8.583069,8.5373535,8.49176,8.446289,8.40094,8.355713,8.310608,8.265625,8.176025,8.086914,7.998291,7.9101562,7.82251,7.7353516,7.6486816,7.5625,7.3916016,7.2226562,7.055664,6.890625,6.727539,6.5664062,6.4072266,6.25,5.9414062,5.640625,5.3476562,5.0625,4.7851562,4.515625,4.2539062,4,3.515625,3.0625,2.640625,2.25,1.890625,1.5625,1.265625,1,0.5625,0.25,0.0625,0,0.0625,0.25,0.5625,1,2.25,4,6.25,9,12.25,16,20.25,25,36,49,64,81,100,121,144,169,225,289,361,441,529,625,729,841,1089,1369,1681,2025,2401,2809,3249,3721,4761,5929,7225,8649,10201,11881,13689,15625,19881,24649,29929,35721,42025,48841,56169,64009,81225,100489,121801,145161,170569,198025,227529,10.5625,10.619706,10.626072,10.63244,10.638809,10.645181,10.651554,10.657929,10.664307,10.677067,10.689835,10.70261,10.715393,10.728184,10.740982,10.753788,10.766602,10.792252,10.817932,10.843643,10.869385,10.895157,10.920959,10.946793,10.972656,11.024475,11.076416,11.128479,11.180664,11.232971,11.2854,11.337952,11.390625,11.496338,11.602539,11.7092285,11.816406,11.924072,12.032227,12.140869,12.25,12.469727,12.691406,12.915039,13.140625,13.368164,13.597656,13.829102,14.0625,14.535156,15.015625,15.503906,16,16.503906,17.015625,17.535156,18.0625,19.140625,20.25,21.390625,22.5625,23.765625,25,26.265625,27.5625,30.25,33.0625,36,39.0625,42.25,45.5625,49,52.5625,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,115.5625,126.5625,150.0625,175.5625,203.0625,232.5625,264.0625,297.5625,333.0625,370.5625,451.5625,540.5625,637.5625,742.5625,855.5625,976.5625,1105.5625,1242.5625,1540.5625,1870.5625,2232.5625,2626.5625,3052.5625,3510.5625,4000.5625,4522.5625,5662.5625,6930.5625,8326.5625,9850.5625,11502.5625,13282.5625,15190.5625,17226.562,21682.562,26650.562,32130.562,38122.562,44626.562,51642.562,59170.562,67210.56,84826.56,104490.56,126202.56,149962.56,175770.56,203626.56,233530.56,10.51178,10.505448,10.499119,10.492791,10.486465,10.480142,10.47382,10.4675,10.461182,10.448551,10.435928,10.423313,10.410706,10.398106,10.385513,10.372929,10.360352,10.33522,10.31012,10.285049,10.26001,10.235001,10.210022,10.185074,10.160156,10.110413,10.060791,10.0112915,9.961914,9.912659,9.863525,9.814514,9.765625,9.668213,9.571289,9.4748535,9.378906,9.283447,9.188477,9.093994,9,8.813477,8.628906,8.446289,8.265625,8.086914,7.9101562,7.7353516,7.5625,7.2226562,6.890625,6.5664062,6.25,5.9414062,5.640625,5.3476562,5.0625,4.515625,4,3.515625,3.0625,2.640625,2.25,1.890625,1.5625,1,0.5625,0.25,0.0625,0,0.0625,0.25,0.5625,1.5625,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,22.5625,33.0625,45.5625,60.0625,76.5625,95.0625,115.5625,138.0625,162.5625,217.5625,280.5625,351.5625,430.5625,517.5625,612.5625,715.5625,826.5625,1072.5625,1350.5625,1660.5625,2002.5625,2376.5625,2782.5625,3220.5625,3690.5625,4726.5625,5890.5625,7182.5625,8602.5625,10150.5625,11826.5625,13630.5625,15562.5625,19810.562,24570.562,29842.562,35626.562,41922.562,48730.562,56050.562,63882.562,81082.56,100330.56,121626.56,144970.56,170362.56,197802.56,227290.56,12.25,12.311601,12.318455,12.325311,12.332169,12.339028,12.34589,12.352754,12.359619,12.373356,12.3871,12.400852,12.414612,12.428379,12.442154,12.455936,12.469727,12.49733,12.524963,12.552628,12.580322,12.6080475,12.635803,12.6635895,12.691406,12.747131,12.8029785,12.858948,12.915039,12.971252,13.027588,13.084045,13.140625,13.25415,13.368164,13.482666,13.597656,13.713135,13.829102,13.945557,14.0625,14.297852,14.535156,14.774414,15.015625,15.258789,15.503906,15.750977,16,16.503906,17.015625,17.535156,18.0625,18.597656,19.140625,19.691406,20.25,21.390625,22.5625,23.765625,25,26.265625,27.5625,28.890625,30.25,33.0625,36,39.0625,42.25,45.5625,49,52.5625,56.25,64,72.25,81,90.25,100,110.25,121,132.25,156.25,182.25,210.25,240.25,272.25,306.25,342.25,380.25,462.25,552.25,650.25,756.25,870.25,992.25,1122.25,1260.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4032.25,4556.25,5700.25,6972.25,8372.25,9900.25,11556.25,13340.25,15252.25,17292.25,21756.25,26732.25,32220.25,38220.25,44732.25,51756.25,59292.25,67340.25,84972.25,104652.25,126380.25,150156.25,175980.25,203852.25,233772.25,12.195374,12.188554,12.181736,12.17492,12.168106,12.161294,12.154484,12.1476755,12.140869,12.127262,12.113663,12.100071,12.086487,12.07291,12.059341,12.04578,12.032227,12.005142,11.978088,11.951065,11.924072,11.89711,11.870178,11.843277,11.816406,11.762756,11.7092285,11.655823,11.602539,11.549377,11.496338,11.44342,11.390625,11.2854,11.180664,11.076416,10.972656,10.869385,10.766602,10.664307,10.5625,10.360352,10.160156,9.961914,9.765625,9.571289,9.378906,9.188477,9,8.628906,8.265625,7.9101562,7.5625,7.2226562,6.890625,6.5664062,6.25,5.640625,5.0625,4.515625,4,3.515625,3.0625,2.640625,2.25,1.5625,1,0.5625,0.25,0.0625,0,0.0625,0.25,1,2.25,4,6.25,9,12.25,16,20.25,30.25,42.25,56.25,72.25,90.25,110.25,132.25,156.25,210.25,272.25,342.25,420.25,506.25,600.25,702.25,812.25,1056.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,3660.25,4692.25,5852.25,7140.25,8556.25,10100.25,11772.25,13572.25,15500.25,19740.25,24492.25,29756.25,35532.25,41820.25,48620.25,55932.25,63756.25,80940.25,100172.25,121452.25,144780.25,170156.25,197580.25,227052.25,14.0625,14.128495,14.135838,14.143182,14.150528,14.157876,14.165226,14.172578,14.179932,14.194645,14.209366,14.224094,14.238831,14.253574,14.268326,14.283085,14.297852,14.327408,14.356995,14.386612,14.41626,14.445938,14.475647,14.505386,14.535156,14.594788,14.654541,14.7144165,14.774414,14.834534,14.894775,14.955139,15.015625,15.136963,15.258789,15.3811035,15.503906,15.627197,15.750977,15.875244,16,16.250977,16.503906,16.75879,17.015625,17.274414,17.535156,17.797852,18.0625,18.597656,19.140625,19.691406,20.25,20.816406,21.390625,21.972656,22.5625,23.765625,25,26.265625,27.5625,28.890625,30.25,31.640625,33.0625,36,39.0625,42.25,45.5625,49,52.5625,56.25,60.0625,68.0625,76.5625,85.5625,95.0625,105.0625,115.5625,126.5625,138.0625,162.5625,189.0625,217.5625,248.0625,280.5625,315.0625,351.5625,390.0625,473.0625,564.0625,663.0625,770.0625,885.0625,1008.0625,1139.0625,1278.0625,1580.0625,1914.0625,2280.0625,2678.0625,3108.0625,3570.0625,4064.0625,4590.0625,5738.0625,7014.0625,8418.0625,9950.0625,11610.0625,13398.0625,15314.0625,17358.062,21830.062,26814.062,32310.062,38318.062,44838.062,51870.062,59414.062,67470.06,85118.06,104814.06,126558.06,150350.06,176190.06,204078.06,234014.06,14.003967,13.996659,13.989353,13.982049,13.974747,13.967446,13.960148,13.952851,13.945557,13.930973,13.916397,13.901829,13.887268,13.872715,13.85817,13.843632,13.829102,13.800064,13.771057,13.742081,13.713135,13.684219,13.655334,13.62648,13.597656,13.5401,13.482666,13.425354,13.368164,13.311096,13.25415,13.197327,13.140625,13.027588,12.915039,12.8029785,12.691406,12.580322,12.469727,12.359619,12.25,12.032227,11.816406,11.602539,11.390625,11.180664,10.972656,10.766602,10.5625,10.160156,9.765625,9.378906,9,8.628906,8.265625,7.9101562,7.5625,6.890625,6.25,5.640625,5.0625,4.515625,4,3.515625,3.0625,2.25,1.5625,1,0.5625,0.25,0.0625,0,0.0625,0.5625,1.5625,3.0625,5.0625,7.5625,10.5625,14.0625,18.0625,27.5625,39.0625,52.5625,68.0625,85.5625,105.0625,126.5625,150.0625,203.0625,264.0625,333.0625,410.0625,495.0625,588.0625,689.0625,798.0625,1040.0625,1314.0625,1620.0625,1958.0625,2328.0625,2730.0625,3164.0625,3630.0625,4658.0625,5814.0625,7098.0625,8510.0625,10050.0625,11718.0625,13514.0625,15438.0625,19670.062,24414.062,29670.062,35438.062,41718.062,48510.062,55814.062,63630.062,80798.06,100014.06,121278.06,144590.06,169950.06,197358.06,226814.06,16,16.070389,16.07822,16.086052,16.093887,16.101723,16.109562,16.117401,16.125244,16.140934,16.156631,16.172337,16.18805,16.20377,16.219498,16.235233,16.250977,16.282486,16.314026,16.345596,16.377197,16.408829,16.44049,16.472183,16.503906,16.567444,16.631104,16.694885,16.75879,16.822815,16.886963,16.951233,17.015625,17.144775,17.274414,17.404541,17.535156,17.66626,17.797852,17.929932,18.0625,18.329102,18.597656,18.868164,19.140625,19.41504,19.691406,19.969727,20.25,20.816406,21.390625,21.972656,22.5625,23.160156,23.765625,24.378906,25,26.265625,27.5625,28.890625,30.25,31.640625,33.0625,34.515625,36,39.0625,42.25,45.5625,49,52.5625,56.25,60.0625,64,72.25,81,90.25,100,110.25,121,132.25,144,169,196,225,256,289,324,361,400,484,576,676,784,900,1024,1156,1296,1600,1936,2304,2704,3136,3600,4096,4624,5776,7056,8464,10000,11664,13456,15376,17424,21904,26896,32400,38416,44944,51984,59536,67600,85264,104976,126736,150544,176400,204304,234256,15.937561,15.929765,15.92197,15.914178,15.906387,15.898599,15.890812,15.883027,15.875244,15.859684,15.844131,15.828587,15.813049,15.79752,15.781998,15.766483,15.750977,15.719986,15.689026,15.658096,15.627197,15.596329,15.565491,15.534683,15.503906,15.442444,15.3811035,15.319885,15.258789,15.197815,15.136963,15.076233,15.015625,14.894775,14.774414,14.654541,14.535156,14.41626,14.297852,14.179932,14.0625,13.829102,13.597656,13.368164,13.140625,12.915039,12.691406,12.469727,12.25,11.816406,11.390625,10.972656,10.5625,10.160156,9.765625,9.378906,9,8.265625,7.5625,6.890625,6.25,5.640625,5.0625,4.515625,4,3.0625,2.25,1.5625,1,0.5625,0.25,0.0625,0,0.25,1,2.25,4,6.25,9,12.25,16,25,36,49,64,81,100,121,144,196,256,324,400,484,576,676,784,1024,1296,1600,1936,2304,2704,3136,3600,4624,5776,7056,8464,10000,11664,13456,15376,19600,24336,29584,35344,41616,48400,55696,63504,80656,99856,121104,144400,169744,197136,226576,20.25,20.329178,20.337986,20.346794,20.355606,20.364418,20.373234,20.38205,20.39087,20.408512,20.426163,20.44382,20.461487,20.47916,20.496841,20.51453,20.532227,20.567642,20.603088,20.638565,20.674072,20.70961,20.745178,20.780777,20.816406,20.887756,20.959229,21.030823,21.10254,21.174377,21.246338,21.31842,21.390625,21.5354,21.680664,21.826416,21.972656,22.119385,22.266602,22.414307,22.5625,22.860352,23.160156,23.461914,23.765625,24.07129,24.378906,24.688477,25,25.628906,26.265625,26.910156,27.5625,28.222656,28.890625,29.566406,30.25,31.640625,33.0625,34.515625,36,37.515625,39.0625,40.640625,42.25,45.5625,49,52.5625,56.25,60.0625,64,68.0625,72.25,81,90.25,100,110.25,121,132.25,144,156.25,182.25,210.25,240.25,272.25,306.25,342.25,380.25,420.25,506.25,600.25,702.25,812.25,930.25,1056.25,1190.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,3660.25,4160.25,4692.25,5852.25,7140.25,8556.25,10100.25,11772.25,13572.25,15500.25,17556.25,22052.25,27060.25,32580.25,38612.25,45156.25,52212.25,59780.25,67860.25,85556.25,105300.25,127092.25,150932.25,176820.25,204756.25,234740.25,20.179749,20.170975,20.162205,20.153435,20.144669,20.135902,20.12714,20.118378,20.10962,20.092106,20.0746,20.057102,20.039612,20.02213,20.004654,19.987186,19.969727,19.93483,19.899963,19.865128,19.830322,19.795547,19.760803,19.72609,19.691406,19.622131,19.552979,19.483948,19.41504,19.346252,19.277588,19.209045,19.140625,19.00415,18.868164,18.732666,18.597656,18.463135,18.329102,18.195557,18.0625,17.797852,17.535156,17.274414,17.015625,16.75879,16.503906,16.250977,16,15.503906,15.015625,14.535156,14.0625,13.597656,13.140625,12.691406,12.25,11.390625,10.5625,9.765625,9,8.265625,7.5625,6.890625,6.25,5.0625,4,3.0625,2.25,1.5625,1,0.5625,0.25,0,0.25,1,2.25,4,6.25,9,12.25,20.25,30.25,42.25,56.25,72.25,90.25,110.25,132.25,182.25,240.25,306.25,380.25,462.25,552.25,650.25,756.25,992.25,1260.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4556.25,5700.25,6972.25,8372.25,9900.25,11556.25,13340.25,15252.25,19460.25,24180.25,29412.25,35156.25,41412.25,48180.25,55460.25,63252.25,80372.25,99540.25,120756.25,144020.25,169332.25,196692.25,226100.25,25,25.087967,25.097752,25.107536,25.117325,25.127113,25.136906,25.146698,25.156494,25.17609,25.195694,25.215305,25.234924,25.25455,25.274185,25.293827,25.313477,25.352798,25.39215,25.431534,25.470947,25.510391,25.549866,25.58937,25.628906,25.708069,25.787354,25.86676,25.94629,26.02594,26.105713,26.185608,26.265625,26.426025,26.586914,26.748291,26.910156,27.07251,27.235352,27.398682,27.5625,27.891602,28.222656,28.555664,28.890625,29.22754,29.566406,29.907227,30.25,30.941406,31.640625,32.347656,33.0625,33.785156,34.515625,35.253906,36,37.515625,39.0625,40.640625,42.25,43.890625,45.5625,47.265625,49,52.5625,56.25,60.0625,64,68.0625,72.25,76.5625,81,90.25,100,110.25,121,132.25,144,156.25,169,196,225,256,289,324,361,400,441,529,625,729,841,961,1089,1225,1369,1681,2025,2401,2809,3249,3721,4225,4761,5929,7225,8649,10201,11881,13689,15625,17689,22201,27225,32761,38809,45369,52441,60025,68121,85849,105625,127449,151321,177241,205209,235225,24.921936,24.912186,24.90244,24.892693,24.88295,24.873207,24.863468,24.85373,24.843994,24.824528,24.805069,24.785618,24.766174,24.746738,24.72731,24.70789,24.688477,24.649673,24.6109,24.572159,24.533447,24.494766,24.456116,24.417496,24.378906,24.301819,24.224854,24.14801,24.07129,23.99469,23.918213,23.841858,23.765625,23.613525,23.461914,23.310791,23.160156,23.01001,22.860352,22.711182,22.5625,22.266602,21.972656,21.680664,21.390625,21.10254,20.816406,20.532227,20.25,19.691406,19.140625,18.597656,18.0625,17.535156,17.015625,16.503906,16,15.015625,14.0625,13.140625,12.25,11.390625,10.5625,9.765625,9,7.5625,6.25,5.0625,4,3.0625,2.25,1.5625,1,0.25,0,0.25,1,2.25,4,6.25,9,16,25,36,49,64,81,100,121,169,225,289,361,441,529,625,729,961,1225,1521,1849,2209,2601,3025,3481,4489,5625,6889,8281,9801,11449,13225,15129,19321,24025,29241,34969,41209,47961,55225,63001,80089,99225,120409,143641,168921,196249,225625,30.25,30.346756,30.357517,30.368279,30.379044,30.389809,30.400578,30.411346,30.42212,30.443668,30.465225,30.48679,30.508362,30.529942,30.551529,30.573124,30.594727,30.637955,30.681213,30.724503,30.767822,30.811172,30.854553,30.897964,30.941406,31.028381,31.115479,31.202698,31.29004,31.377502,31.465088,31.552795,31.640625,31.81665,31.993164,32.170166,32.347656,32.525635,32.7041,32.883057,33.0625,33.42285,33.785156,34.149414,34.515625,34.88379,35.253906,35.625977,36,36.753906,37.515625,38.285156,39.0625,39.847656,40.640625,41.441406,42.25,43.890625,45.5625,47.265625,49,50.765625,52.5625,54.390625,56.25,60.0625,64,68.0625,72.25,76.5625,81,85.5625,90.25,100,110.25,121,132.25,144,156.25,169,182.25,210.25,240.25,272.25,306.25,342.25,380.25,420.25,462.25,552.25,650.25,756.25,870.25,992.25,1122.25,1260.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,3782.25,4290.25,4830.25,6006.25,7310.25,8742.25,10302.25,11990.25,13806.25,15750.25,17822.25,22350.25,27390.25,32942.25,39006.25,45582.25,52670.25,60270.25,68382.25,86142.25,105950.25,127806.25,151710.25,177662.25,205662.25,235710.25,30.164124,30.153397,30.142673,30.13195,30.121231,30.110512,30.099796,30.08908,30.07837,30.05695,30.035538,30.014133,29.992737,29.971348,29.949966,29.928593,29.907227,29.864517,29.821838,29.77919,29.736572,29.693985,29.651428,29.608902,29.566406,29.481506,29.396729,29.312073,29.22754,29.143127,29.058838,28.97467,28.890625,28.7229,28.555664,28.388916,28.222656,28.056885,27.891602,27.726807,27.5625,27.235352,26.910156,26.586914,26.265625,25.94629,25.628906,25.313477,25,24.378906,23.765625,23.160156,22.5625,21.972656,21.390625,20.816406,20.25,19.140625,18.0625,17.015625,16,15.015625,14.0625,13.140625,12.25,10.5625,9,7.5625,6.25,5.0625,4,3.0625,2.25,1,0.25,0,0.25,1,2.25,4,6.25,12.25,20.25,30.25,42.25,56.25,72.25,90.25,110.25,156.25,210.25,272.25,342.25,420.25,506.25,600.25,702.25,930.25,1190.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,4422.25,5550.25,6806.25,8190.25,9702.25,11342.25,13110.25,15006.25,19182.25,23870.25,29070.25,34782.25,41006.25,47742.25,54990.25,62750.25,79806.25,98910.25,120062.25,143262.25,168510.25,195806.25,225150.25,36,36.105545,36.117283,36.12902,36.140762,36.152504,36.16425,36.175995,36.187744,36.211246,36.234756,36.258274,36.2818,36.305332,36.328873,36.35242,36.375977,36.42311,36.470276,36.51747,36.564697,36.611954,36.65924,36.70656,36.753906,36.848694,36.943604,37.038635,37.13379,37.229065,37.324463,37.419983,37.515625,37.707275,37.899414,38.09204,38.285156,38.47876,38.67285,38.86743,39.0625,39.4541,39.847656,40.243164,40.640625,41.04004,41.441406,41.844727,42.25,43.066406,43.890625,44.722656,45.5625,46.410156,47.265625,48.128906,49,50.765625,52.5625,54.390625,56.25,58.140625,60.0625,62.015625,64,68.0625,72.25,76.5625,81,85.5625,90.25,95.0625,100,110.25,121,132.25,144,156.25,169,182.25,196,225,256,289,324,361,400,441,484,576,676,784,900,1024,1156,1296,1444,1764,2116,2500,2916,3364,3844,4356,4900,6084,7396,8836,10404,12100,13924,15876,17956,22500,27556,33124,39204,45796,52900,60516,68644,86436,106276,128164,152100,178084,206116,236196,35.90631,35.894608,35.882908,35.87121,35.859512,35.847816,35.836124,35.824432,35.812744,35.78937,35.766006,35.74265,35.7193,35.695957,35.672623,35.649296,35.625977,35.57936,35.532776,35.48622,35.439697,35.393204,35.34674,35.30031,35.253906,35.161194,35.068604,34.976135,34.88379,34.791565,34.699463,34.607483,34.515625,34.332275,34.149414,33.96704,33.785156,33.60376,33.42285,33.24243,33.0625,32.7041,32.347656,31.993164,31.640625,31.29004,30.941406,30.594727,30.25,29.566406,28.890625,28.222656,27.5625,26.910156,26.265625,25.628906,25,23.765625,22.5625,21.390625,20.25,19.140625,18.0625,17.015625,16,14.0625,12.25,10.5625,9,7.5625,6.25,5.0625,4,2.25,1,0.25,0,0.25,1,2.25,4,9,16,25,36,49,64,81,100,144,196,256,324,400,484,576,676,900,1156,1444,1764,2116,2500,2916,3364,4356,5476,6724,8100,9604,11236,12996,14884,19044,23716,28900,34596,40804,47524,54756,62500,79524,98596,119716,142884,168100,195364,224676,42.25,42.364334,42.37705,42.389763,42.40248,42.4152,42.42792,42.440643,42.45337,42.478825,42.504288,42.52976,42.555237,42.580723,42.606216,42.631718,42.657227,42.708267,42.75934,42.81044,42.861572,42.912735,42.96393,43.015152,43.066406,43.169006,43.27173,43.374573,43.47754,43.580627,43.683838,43.78717,43.890625,44.0979,44.305664,44.513916,44.722656,44.931885,45.1416,45.351807,45.5625,45.98535,46.410156,46.836914,47.265625,47.69629,48.128906,48.563477,49,49.878906,50.765625,51.660156,52.5625,53.472656,54.390625,55.316406,56.25,58.140625,60.0625,62.015625,64,66.015625,68.0625,70.140625,72.25,76.5625,81,85.5625,90.25,95.0625,100,105.0625,110.25,121,132.25,144,156.25,169,182.25,196,210.25,240.25,272.25,306.25,342.25,380.25,420.25,462.25,506.25,600.25,702.25,812.25,930.25,1056.25,1190.25,1332.25,1482.25,1806.25,2162.25,2550.25,2970.25,3422.25,3906.25,4422.25,4970.25,6162.25,7482.25,8930.25,10506.25,12210.25,14042.25,16002.25,18090.25,22650.25,27722.25,33306.25,39402.25,46010.25,53130.25,60762.25,68906.25,86730.25,106602.25,128522.25,152490.25,178506.25,206570.25,236682.25,42.1485,42.13582,42.123142,42.110466,42.097794,42.08512,42.072453,42.059784,42.04712,42.021793,41.996475,41.971165,41.94586,41.920567,41.89528,41.87,41.844727,41.794205,41.743713,41.693253,41.642822,41.592422,41.542053,41.491714,41.441406,41.34088,41.24048,41.140198,41.04004,40.940002,40.840088,40.740295,40.640625,40.44165,40.243164,40.045166,39.847656,39.650635,39.4541,39.258057,39.0625,38.67285,38.285156,37.899414,37.515625,37.13379,36.753906,36.375977,36,35.253906,34.515625,33.785156,33.0625,32.347656,31.640625,30.941406,30.25,28.890625,27.5625,26.265625,25,23.765625,22.5625,21.390625,20.25,18.0625,16,14.0625,12.25,10.5625,9,7.5625,6.25,4,2.25,1,0.25,0,0.25,1,2.25,6.25,12.25,20.25,30.25,42.25,56.25,72.25,90.25,132.25,182.25,240.25,306.25,380.25,462.25,552.25,650.25,870.25,1122.25,1406.25,1722.25,2070.25,2450.25,2862.25,3306.25,4290.25,5402.25,6642.25,8010.25,9506.25,11130.25,12882.25,14762.25,18906.25,23562.25,28730.25,34410.25,40602.25,47306.25,54522.25,62250.25,79242.25,98282.25,119370.25,142506.25,167690.25,194922.25,224202.25,49,49.123123,49.136814,49.150505,49.1642,49.177895,49.191593,49.20529,49.218994,49.246403,49.27382,49.301243,49.328674,49.356113,49.38356,49.411015,49.438477,49.493423,49.5484,49.60341,49.658447,49.713516,49.768616,49.823746,49.878906,49.98932,50.099854,50.21051,50.32129,50.43219,50.543213,50.654358,50.765625,50.988525,51.211914,51.43579,51.660156,51.88501,52.11035,52.33618,52.5625,53.0166,53.472656,53.930664,54.390625,54.85254,55.316406,55.782227,56.25,57.191406,58.140625,59.097656,60.0625,61.035156,62.015625,63.003906,64,66.015625,68.0625,70.140625,72.25,74.390625,76.5625,78.765625,81,85.5625,90.25,95.0625,100,105.0625,110.25,115.5625,121,132.25,144,156.25,169,182.25,196,210.25,225,256,289,324,361,400,441,484,529,625,729,841,961,1089,1225,1369,1521,1849,2209,2601,3025,3481,3969,4489,5041,6241,7569,9025,10609,12321,14161,16129,18225,22801,27889,33489,39601,46225,53361,61009,69169,87025,106929,128881,152881,178929,207025,237169,48.890686,48.87703,48.863377,48.849724,48.836075,48.822426,48.80878,48.795135,48.781494,48.754215,48.726944,48.69968,48.672424,48.645176,48.617935,48.590702,48.563477,48.50905,48.45465,48.400284,48.345947,48.29164,48.237366,48.18312,48.128906,48.02057,47.912354,47.80426,47.69629,47.58844,47.480713,47.373108,47.265625,47.051025,46.836914,46.62329,46.410156,46.19751,45.98535,45.77368,45.5625,45.1416,44.722656,44.305664,43.890625,43.47754,43.066406,42.657227,42.25,41.441406,40.640625,39.847656,39.0625,38.285156,37.515625,36.753906,36,34.515625,33.0625,31.640625,30.25,28.890625,27.5625,26.265625,25,22.5625,20.25,18.0625,16,14.0625,12.25,10.5625,9,6.25,4,2.25,1,0.25,0,0.25,1,4,9,16,25,36,49,64,81,121,169,225,289,361,441,529,625,841,1089,1369,1681,2025,2401,2809,3249,4225,5329,6561,7921,9409,11025,12769,14641,18769,23409,28561,34225,40401,47089,54289,62001,78961,97969,119025,142129,167281,194481,223729,56.25,56.381912,56.39658,56.411247,56.42592,56.44059,56.455265,56.46994,56.48462,56.51398,56.54335,56.572727,56.60211,56.631504,56.660904,56.69031,56.719727,56.77858,56.837463,56.896378,56.955322,57.014297,57.073303,57.13234,57.191406,57.30963,57.42798,57.546448,57.66504,57.783752,57.902588,58.021545,58.140625,58.37915,58.618164,58.857666,59.097656,59.338135,59.5791,59.820557,60.0625,60.54785,61.035156,61.524414,62.015625,62.50879,63.003906,63.500977,64,65.00391,66.015625,67.03516,68.0625,69.09766,70.140625,71.19141,72.25,74.390625,76.5625,78.765625,81,83.265625,85.5625,87.890625,90.25,95.0625,100,105.0625,110.25,115.5625,121,126.5625,132.25,144,156.25,169,182.25,196,210.25,225,240.25,272.25,306.25,342.25,380.25,420.25,462.25,506.25,552.25,650.25,756.25,870.25,992.25,1122.25,1260.25,1406.25,1560.25,1892.25,2256.25,2652.25,3080.25,3540.25,4032.25,4556.25,5112.25,6320.25,7656.25,9120.25,10712.25,12432.25,14280.25,16256.25,18360.25,22952.25,28056.25,33672.25,39800.25,46440.25,53592.25,61256.25,69432.25,87320.25,107256.25,129240.25,153272.25,179352.25,207480.25,237656.25,56.132874,56.11824,56.10361,56.08898,56.074356,56.05973,56.04511,56.030487,56.01587,55.986637,55.957413,55.928196,55.898987,55.869785,55.84059,55.811405,55.782227,55.723892,55.66559,55.607315,55.549072,55.49086,55.43268,55.374527,55.316406,55.200256,55.08423,54.968323,54.85254,54.736877,54.621338,54.50592,54.390625,54.1604,53.930664,53.701416,53.472656,53.244385,53.0166,52.789307,52.5625,52.11035,51.660156,51.211914,50.765625,50.32129,49.878906,49.438477,49,48.128906,47.265625,46.410156,45.5625,44.722656,43.890625,43.066406,42.25,40.640625,39.0625,37.515625,36,34.515625,33.0625,31.640625,30.25,27.5625,25,22.5625,20.25,18.0625,16,14.0625,12.25,9,6.25,4,2.25,1,0.25,0,0.25,2.25,6.25,12.25,20.25,30.25,42.25,56.25,72.25,110.25,156.25,210.25,272.25,342.25,420.25,506.25,600.25,812.25,1056.25,1332.25,1640.25,1980.25,2352.25,2756.25,3192.25,4160.25,5256.25,6480.25,7832.25,9312.25,10920.25,12656.25,14520.25,18632.25,23256.25,28392.25,34040.25,40200.25,46872.25,54056.25,61752.25,78680.25,97656.25,118680.25,141752.25,166872.25,194040.25,223256.25,64,64.1407,64.15634,64.17199,64.18764,64.203285,64.21893,64.23459,64.250244,64.281555,64.31288,64.34421,64.37555,64.40689,64.43825,64.469604,64.50098,64.563736,64.626526,64.68935,64.7522,64.81508,64.87799,64.94093,65.00391,65.129944,65.2561,65.382385,65.50879,65.635315,65.76196,65.88873,66.015625,66.269775,66.524414,66.77954,67.03516,67.29126,67.54785,67.80493,68.0625,68.5791,69.09766,69.618164,70.140625,70.66504,71.19141,71.71973,72.25,73.31641,74.390625,75.47266,76.5625,77.66016,78.765625,79.87891,81,83.265625,85.5625,87.890625,90.25,92.640625,95.0625,97.515625,100,105.0625,110.25,115.5625,121,126.5625,132.25,138.0625,144,156.25,169,182.25,196,210.25,225,240.25,256,289,324,361,400,441,484,529,576,676,784,900,1024,1156,1296,1444,1600,1936,2304,2704,3136,3600,4096,4624,5184,6400,7744,9216,10816,12544,14400,16384,18496,23104,28224,33856,40000,46656,53824,61504,69696,87616,107584,129600,153664,179776,207936,238144,63.87506,63.85945,63.843845,63.82824,63.812637,63.797035,63.781437,63.76584,63.750244,63.71906,63.68788,63.65671,63.62555,63.594395,63.563248,63.53211,63.500977,63.438736,63.376526,63.314346,63.252197,63.19008,63.12799,63.065933,63.003906,62.879944,62.756104,62.632385,62.50879,62.385315,62.261963,62.138733,62.015625,61.769775,61.524414,61.27954,61.035156,60.79126,60.54785,60.30493,60.0625,59.5791,59.097656,58.618164,58.140625,57.66504,57.191406,56.719727,56.25,55.316406,54.390625,53.472656,52.5625,51.660156,50.765625,49.878906,49,47.265625,45.5625,43.890625,42.25,40.640625,39.0625,37.515625,36,33.0625,30.25,27.5625,25,22.5625,20.25,18.0625,16,12.25,9,6.25,4,2.25,1,0.25,0,1,4,9,16,25,36,49,64,100,144,196,256,324,400,484,576,784,1024,1296,1600,1936,2304,2704,3136,4096,5184,6400,7744,9216,10816,12544,14400,18496,23104,28224,33856,40000,46656,53824,61504,78400,97344,118336,141376,166464,193600,222784,81,81.15828,81.17587,81.193474,81.211075,81.228676,81.24628,81.263885,81.281494,81.31671,81.351944,81.38718,81.422424,81.45767,81.492935,81.5282,81.56348,81.63405,81.70465,81.77528,81.84595,81.91664,81.987366,82.05812,82.12891,82.27057,82.41235,82.55426,82.69629,82.83844,82.98071,83.12311,83.265625,83.551025,83.836914,84.12329,84.41016,84.69751,84.98535,85.27368,85.5625,86.1416,86.72266,87.305664,87.890625,88.47754,89.06641,89.65723,90.25,91.44141,92.640625,93.84766,95.0625,96.28516,97.515625,98.75391,100,102.515625,105.0625,107.640625,110.25,112.890625,115.5625,118.265625,121,126.5625,132.25,138.0625,144,150.0625,156.25,162.5625,169,182.25,196,210.25,225,240.25,256,272.25,289,324,361,400,441,484,529,576,625,729,841,961,1089,1225,1369,1521,1681,2025,2401,2809,3249,3721,4225,4761,5329,6561,7921,9409,11025,12769,14641,16641,18769,23409,28561,34225,40401,47089,54289,62001,70225,88209,108241,130321,154449,180625,208849,239121,80.859436,80.84187,80.82431,80.806755,80.7892,80.771645,80.75409,80.73654,80.718994,80.6839,80.64882,80.61374,80.578674,80.54361,80.50856,80.47351,80.43848,80.36842,80.2984,80.22841,80.15845,80.08852,80.018616,79.948746,79.87891,79.73932,79.59985,79.46051,79.32129,79.18219,79.04321,78.90436,78.765625,78.488525,78.211914,77.93579,77.66016,77.38501,77.11035,76.83618,76.5625,76.0166,75.47266,74.930664,74.390625,73.85254,73.31641,72.78223,72.25,71.19141,70.140625,69.09766,68.0625,67.03516,66.015625,65.00391,64,62.015625,60.0625,58.140625,56.25,54.390625,52.5625,50.765625,49,45.5625,42.25,39.0625,36,33.0625,30.25,27.5625,25,20.25,16,12.25,9,6.25,4,2.25,1,0,1,4,9,16,25,36,49,81,121,169,225,289,361,441,529,729,961,1225,1521,1849,2209,2601,3025,3969,5041,6241,7569,9025,10609,12321,14161,18225,22801,27889,33489,39601,46225,53361,61009,77841,96721,117649,140625,165649,192721,221841,100,100.17586,100.195404,100.21496,100.23451,100.25407,100.27362,100.29318,100.312744,100.35187,100.39101,100.430145,100.4693,100.50845,100.54762,100.58679,100.62598,100.70436,100.782776,100.86122,100.9397,101.0182,101.09674,101.17531,101.25391,101.411194,101.5686,101.726135,101.88379,102.041565,102.19946,102.35748,102.515625,102.832275,103.149414,103.46704,103.78516,104.10376,104.42285,104.74243,105.0625,105.7041,106.34766,106.993164,107.640625,108.29004,108.94141,109.59473,110.25,111.56641,112.890625,114.22266,115.5625,116.91016,118.265625,119.62891,121,123.765625,126.5625,129.39062,132.25,135.14062,138.0625,141.01562,144,150.0625,156.25,162.5625,169,175.5625,182.25,189.0625,196,210.25,225,240.25,256,272.25,289,306.25,324,361,400,441,484,529,576,625,676,784,900,1024,1156,1296,1444,1600,1764,2116,2500,2916,3364,3844,4356,4900,5476,6724,8100,9604,11236,12996,14884,16900,19044,23716,28900,34596,40804,47524,54756,62500,70756,88804,108900,131044,155236,181476,209764,240100,99.84381,99.824295,99.80478,99.78527,99.76576,99.746254,99.726746,99.707245,99.687744,99.64874,99.60976,99.57077,99.5318,99.49283,99.45387,99.41492,99.37598,99.29811,99.220276,99.14247,99.0647,98.98695,98.90924,98.83156,98.75391,98.598694,98.4436,98.288635,98.13379,97.979065,97.82446,97.66998,97.515625,97.207275,96.899414,96.59204,96.28516,95.97876,95.67285,95.36743,95.0625,94.4541,93.84766,93.243164,92.640625,92.04004,91.44141,90.84473,90.25,89.06641,87.890625,86.72266,85.5625,84.41016,83.265625,82.12891,81,78.765625,76.5625,74.390625,72.25,70.140625,68.0625,66.015625,64,60.0625,56.25,52.5625,49,45.5625,42.25,39.0625,36,30.25,25,20.25,16,12.25,9,6.25,4,1,0,1,4,9,16,25,36,64,100,144,196,256,324,400,484,676,900,1156,1444,1764,2116,2500,2916,3844,4900,6084,7396,8836,10404,12100,13924,17956,22500,27556,33124,39204,45796,52900,60516,77284,96100,116964,139876,164836,191844,220900,121,121.193436,121.214935,121.23644,121.25795,121.27946,121.300964,121.32248,121.343994,121.387024,121.43007,121.473114,121.516174,121.559235,121.60231,121.645386,121.68848,121.77467,121.8609,121.94716,122.03345,122.11977,122.206116,122.292496,122.37891,122.55182,122.72485,122.89801,123.07129,123.24469,123.41821,123.59186,123.765625,124.113525,124.461914,124.81079,125.16016,125.51001,125.86035,126.21118,126.5625,127.2666,127.97266,128.68066,129.39062,130.10254,130.8164,131.53223,132.25,133.6914,135.14062,136.59766,138.0625,139.53516,141.01562,142.5039,144,147.01562,150.0625,153.14062,156.25,159.39062,162.5625,165.76562,169,175.5625,182.25,189.0625,196,203.0625,210.25,217.5625,225,240.25,256,272.25,289,306.25,324,342.25,361,400,441,484,529,576,625,676,729,841,961,1089,1225,1369,1521,1681,1849,2209,2601,3025,3481,3969,4489,5041,5625,6889,8281,9801,11449,13225,15129,17161,19321,24025,29241,34969,41209,47961,55225,63001,71289,89401,109561,131769,156025,182329,210681,241081,120.828186,120.80672,120.78525,120.76379,120.742325,120.72086,120.6994,120.67795,120.656494,120.61359,120.570694,120.5278,120.484924,120.44205,120.399185,120.35632,120.31348,120.2278,120.14215,120.05653,119.97095,119.88539,119.799866,119.71437,119.62891,119.45807,119.28735,119.11676,118.94629,118.77594,118.60571,118.43561,118.265625,117.926025,117.586914,117.24829,116.91016,116.57251,116.23535,115.89868,115.5625,114.8916,114.22266,113.555664,112.890625,112.22754,111.56641,110.90723,110.25,108.94141,107.640625,106.34766,105.0625,103.78516,102.515625,101.25391,100,97.515625,95.0625,92.640625,90.25,87.890625,85.5625,83.265625,81,76.5625,72.25,68.0625,64,60.0625,56.25,52.5625,49,42.25,36,30.25,25,20.25,16,12.25,9,4,1,0,1,4,9,16,25,49,81,121,169,225,289,361,441,625,841,1089,1369,1681,2025,2401,2809,3721,4761,5929,7225,8649,10201,11881,13689,17689,22201,27225,32761,38809,45369,52441,60025,76729,95481,116281,139129,164025,190969,219961,144,144.21101,144.23447,144.25793,144.28139,144.30486,144.32831,144.35178,144.37524,144.42218,144.46913,144.51608,144.56305,144.61002,144.657,144.70398,144.75098,144.84499,144.93903,145.0331,145.1272,145.22133,145.31549,145.40968,145.5039,145.69244,145.8811,146.06989,146.25879,146.44781,146.63696,146.82623,147.01562,147.39478,147.77441,148.15454,148.53516,148.91626,149.29785,149.67993,150.0625,150.8291,151.59766,152.36816,153.14062,153.91504,154.6914,155.46973,156.25,157.8164,159.39062,160.97266,162.5625,164.16016,165.76562,167.3789,169,172.26562,175.5625,178.89062,182.25,185.64062,189.0625,192.51562,196,203.0625,210.25,217.5625,225,232.5625,240.25,248.0625,256,272.25,289,306.25,324,342.25,361,380.25,400,441,484,529,576,625,676,729,784,900,1024,1156,1296,1444,1600,1764,1936,2304,2704,3136,3600,4096,4624,5184,5776,7056,8464,10000,11664,13456,15376,17424,19600,24336,29584,35344,41616,48400,55696,63504,71824,90000,110224,132496,156816,183184,211600,242064,143.81256,143.78914,143.76572,143.74231,143.71889,143.69548,143.67206,143.64865,143.62524,143.57843,143.53163,143.48483,143.43805,143.39127,143.3445,143.29773,143.25098,143.15749,143.06403,142.9706,142.8772,142.78383,142.69049,142.59718,142.5039,142.31744,142.1311,141.94489,141.75879,141.57281,141.38696,141.20123,141.01562,140.64478,140.27441,139.90454,139.53516,139.16626,138.79785,138.42993,138.0625,137.3291,136.59766,135.86816,135.14062,134.41504,133.6914,132.96973,132.25,130.8164,129.39062,127.97266,126.5625,125.16016,123.765625,122.37891,121,118.265625,115.5625,112.890625,110.25,107.640625,105.0625,102.515625,100,95.0625,90.25,85.5625,81,76.5625,72.25,68.0625,64,56.25,49,42.25,36,30.25,25,20.25,16,9,4,1,0,1,4,9,16,36,64,100,144,196,256,324,400,576,784,1024,1296,1600,1936,2304,2704,3600,4624,5776,7056,8464,10000,11664,13456,17424,21904,26896,32400,38416,44944,51984,59536,76176,94864,115600,138384,163216,190096,219024,169,169.22859,169.254,169.27942,169.30482,169.33025,169.35565,169.38107,169.4065,169.45734,169.5082,169.55905,169.60992,169.6608,169.71169,169.76257,169.81348,169.9153,170.01715,170.11903,170.22095,170.32289,170.42487,170.52687,170.6289,170.83307,171.03735,171.24176,171.44629,171.65094,171.85571,172.06061,172.26562,172.67603,173.08691,173.49829,173.91016,174.32251,174.73535,175.14868,175.5625,176.3916,177.22266,178.05566,178.89062,179.72754,180.5664,181.40723,182.25,183.9414,185.64062,187.34766,189.0625,190.78516,192.51562,194.2539,196,199.51562,203.0625,206.64062,210.25,213.89062,217.5625,221.26562,225,232.5625,240.25,248.0625,256,264.0625,272.25,280.5625,289,306.25,324,342.25,361,380.25,400,420.25,441,484,529,576,625,676,729,784,841,961,1089,1225,1369,1521,1681,1849,2025,2401,2809,3249,3721,4225,4761,5329,5929,7225,8649,10201,11881,13689,15625,17689,19881,24649,29929,35721,42025,48841,56169,64009,72361,90601,110889,133225,157609,184041,212521,243049,168.79694,168.77156,168.74619,168.72083,168.69545,168.67009,168.64471,168.61935,168.594,168.54327,168.49257,168.44186,168.39117,168.34048,168.28981,168.23914,168.18848,168.08717,167.9859,167.88466,167.78345,167.68227,167.58112,167.48,167.3789,167.17682,166.97485,166.77301,166.57129,166.36969,166.16821,165.96686,165.76562,165.36353,164.96191,164.56079,164.16016,163.76001,163.36035,162.96118,162.5625,161.7666,160.97266,160.18066,159.39062,158.60254,157.8164,157.03223,156.25,154.6914,153.14062,151.59766,150.0625,148.53516,147.01562,145.5039,144,141.01562,138.0625,135.14062,132.25,129.39062,126.5625,123.765625,121,115.5625,110.25,105.0625,100,95.0625,90.25,85.5625,81,72.25,64,56.25,49,42.25,36,30.25,25,16,9,4,1,0,1,4,9,25,49,81,121,169,225,289,361,529,729,961,1225,1521,1849,2209,2601,3481,4489,5625,6889,8281,9801,11449,13225,17161,21609,26569,32041,38025,44521,51529,59049,75625,94249,114921,137641,162409,189225,218089,196,196.24617,196.27353,196.3009,196.32826,196.35564,196.383,196.41037,196.43774,196.4925,196.54726,196.60202,196.6568,196.71158,196.76637,196.82117,196.87598,196.98561,197.09528,197.20497,197.3147,197.42445,197.53424,197.64406,197.7539,197.9737,198.1936,198.41364,198.63379,198.85406,199.07446,199.29498,199.51562,199.95728,200.39941,200.84204,201.28516,201.72876,202.17285,202.61743,203.0625,203.9541,204.84766,205.74316,206.64062,207.54004,208.4414,209.34473,210.25,212.0664,213.89062,215.72266,217.5625,219.41016,221.26562,223.1289,225,228.76562,232.5625,236.39062,240.25,244.14062,248.0625,252.01562,256,264.0625,272.25,280.5625,289,297.5625,306.25,315.0625,324,342.25,361,380.25,400,420.25,441,462.25,484,529,576,625,676,729,784,841,900,1024,1156,1296,1444,1600,1764,1936,2116,2500,2916,3364,3844,4356,4900,5476,6084,7396,8836,10404,12100,13924,15876,17956,20164,24964,30276,36100,42436,49284,56644,64516,72900,91204,111556,133956,158404,184900,213444,244036,195.78131,195.75398,195.72665,195.69934,195.67201,195.6447,195.61737,195.59006,195.56274,195.50812,195.4535,195.3989,195.3443,195.2897,195.23512,195.18054,195.12598,195.01686,194.90778,194.79872,194.6897,194.5807,194.47174,194.36281,194.2539,194.0362,193.8186,193.60114,193.38379,193.16656,192.94946,192.73248,192.51562,192.08228,191.64941,191.21704,190.78516,190.35376,189.92285,189.49243,189.0625,188.2041,187.34766,186.49316,185.64062,184.79004,183.9414,183.09473,182.25,180.5664,178.89062,177.22266,175.5625,173.91016,172.26562,170.6289,169,165.76562,162.5625,159.39062,156.25,153.14062,150.0625,147.01562,144,138.0625,132.25,126.5625,121,115.5625,110.25,105.0625,100,90.25,81,72.25,64,56.25,49,42.25,36,25,16,9,4,1,0,1,4,16,36,64,100,144,196,256,324,484,676,900,1156,1444,1764,2116,2500,3364,4356,5476,6724,8100,9604,11236,12996,16900,21316,26244,31684,37636,44100,51076,58564,75076,93636,114244,136900,161604,188356,217156,225,225.26375,225.29306,225.32239,225.3517,225.38103,225.41034,225.43967,225.469,225.52765,225.58632,225.64499,225.70367,225.76236,225.82106,225.87976,225.93848,226.05592,226.1734,226.29091,226.40845,226.52602,226.64362,226.76125,226.8789,227.11432,227.34985,227.58551,227.82129,228.05719,228.29321,228.52936,228.76562,229.23853,229.71191,230.18579,230.66016,231.13501,231.61035,232.08618,232.5625,233.5166,234.47266,235.43066,236.39062,237.35254,238.3164,239.28223,240.25,242.1914,244.14062,246.09766,248.0625,250.03516,252.01562,254.0039,256,260.01562,264.0625,268.14062,272.25,276.39062,280.5625,284.76562,289,297.5625,306.25,315.0625,324,333.0625,342.25,351.5625,361,380.25,400,420.25,441,462.25,484,506.25,529,576,625,676,729,784,841,900,961,1089,1225,1369,1521,1681,1849,2025,2209,2601,3025,3481,3969,4489,5041,5625,6241,7569,9025,10609,12321,14161,16129,18225,20449,25281,30625,36481,42849,49729,57121,65025,73441,91809,112225,134689,159201,185761,214369,245025,224.76569,224.7364,224.70712,224.67786,224.64857,224.61931,224.59003,224.56076,224.5315,224.47296,224.41444,224.35593,224.29742,224.23892,224.18044,224.12195,224.06348,223.94655,223.82965,223.71278,223.59595,223.47914,223.36237,223.24562,223.1289,222.89557,222.66235,222.42926,222.19629,221.96344,221.73071,221.49811,221.26562,220.80103,220.33691,219.87329,219.41016,218.94751,218.48535,218.02368,217.5625,216.6416,215.72266,214.80566,213.89062,212.97754,212.0664,211.15723,210.25,208.4414,206.64062,204.84766,203.0625,201.28516,199.51562,197.7539,196,192.51562,189.0625,185.64062,182.25,178.89062,175.5625,172.26562,169,162.5625,156.25,150.0625,144,138.0625,132.25,126.5625,121,110.25,100,90.25,81,72.25,64,56.25,49,36,25,16,9,4,1,0,1,9,25,49,81,121,169,225,289,441,625,841,1089,1369,1681,2025,2401,3249,4225,5329,6561,7921,9409,11025,12769,16641,21025,25921,31329,37249,43681,50625,58081,74529,93025,113569,136161,160801,187489,216225,256,256.28134,256.3126,256.34387,256.37512,256.4064,256.43768,256.46896,256.50024,256.5628,256.62537,256.68796,256.75055,256.81314,256.87573,256.93835,257.00098,257.12622,257.25153,257.37683,257.5022,257.62756,257.753,257.87842,258.0039,258.25494,258.5061,258.7574,259.0088,259.2603,259.51196,259.76373,260.01562,260.51978,261.0244,261.52954,262.03516,262.54126,263.04785,263.55493,264.0625,265.0791,266.09766,267.11816,268.14062,269.16504,270.1914,271.21973,272.25,274.3164,276.39062,278.47266,280.5625,282.66016,284.76562,286.8789,289,293.26562,297.5625,301.89062,306.25,310.64062,315.0625,319.51562,324,333.0625,342.25,351.5625,361,370.5625,380.25,390.0625,400,420.25,441,462.25,484,506.25,529,552.25,576,625,676,729,784,841,900,961,1024,1156,1296,1444,1600,1764,1936,2116,2304,2704,3136,3600,4096,4624,5184,5776,6400,7744,9216,10816,12544,14400,16384,18496,20736,25600,30976,36864,43264,50176,57600,65536,73984,92416,112896,135424,160000,186624,215296,246016,255.75006,255.71883,255.68759,255.65637,255.62514,255.59392,255.56268,255.53146,255.50024,255.4378,255.37538,255.31296,255.25055,255.18814,255.12575,255.06335,255.00098,254.87624,254.75153,254.62685,254.5022,254.37758,254.25299,254.12843,254.0039,253.75494,253.5061,253.25739,253.00879,252.76031,252.51196,252.26373,252.01562,251.51978,251.02441,250.52954,250.03516,249.54126,249.04785,248.55493,248.0625,247.0791,246.09766,245.11816,244.14062,243.16504,242.1914,241.21973,240.25,238.3164,236.39062,234.47266,232.5625,230.66016,228.76562,226.8789,225,221.26562,217.5625,213.89062,210.25,206.64062,203.0625,199.51562,196,189.0625,182.25,175.5625,169,162.5625,156.25,150.0625,144,132.25,121,110.25,100,90.25,81,72.25,64,49,36,25,16,9,4,1,0,4,16,36,64,100,144,196,256,400,576,784,1024,1296,1600,1936,2304,3136,4096,5184,6400,7744,9216,10816,12544,16384,20736,25600,30976,36864,43264,50176,57600,73984,92416,112896,135424,160000,186624,215296,324,324.3165,324.35165,324.38684,324.422,324.45718,324.49237,324.52756,324.56274,324.63312,324.7035,324.7739,324.8443,324.9147,324.9851,325.05554,325.12598,325.26685,325.40778,325.5487,325.6897,325.8307,325.97174,326.1128,326.2539,326.5362,326.8186,327.10114,327.3838,327.66656,327.94946,328.23248,328.51562,329.08228,329.6494,330.21704,330.78516,331.35376,331.92285,332.49243,333.0625,334.2041,335.34766,336.49316,337.64062,338.79004,339.9414,341.09473,342.25,344.5664,346.89062,349.22266,351.5625,353.91016,356.26562,358.6289,361,365.76562,370.5625,375.39062,380.25,385.14062,390.0625,395.01562,400,410.0625,420.25,430.5625,441,451.5625,462.25,473.0625,484,506.25,529,552.25,576,600.25,625,650.25,676,729,784,841,900,961,1024,1089,1156,1296,1444,1600,1764,1936,2116,2304,2500,2916,3364,3844,4356,4900,5476,6084,6724,8100,9604,11236,12996,14884,16900,19044,21316,26244,31684,37636,44100,51076,58564,66564,75076,93636,114244,136900,161604,188356,217156,248004,323.7188,323.6837,323.64853,323.6134,323.57825,323.54312,323.508,323.47287,323.43774,323.3675,323.29724,323.22702,323.1568,323.08658,323.01636,322.94617,322.87598,322.7356,322.59528,322.45496,322.3147,322.17444,322.03424,321.89404,321.7539,321.4737,321.1936,320.91364,320.6338,320.35406,320.07446,319.79498,319.51562,318.95728,318.3994,317.84204,317.28516,316.72876,316.17285,315.61743,315.0625,313.9541,312.84766,311.74316,310.64062,309.54004,308.4414,307.34473,306.25,304.0664,301.89062,299.72266,297.5625,295.41016,293.26562,291.1289,289,284.76562,280.5625,276.39062,272.25,268.14062,264.0625,260.01562,256,248.0625,240.25,232.5625,225,217.5625,210.25,203.0625,196,182.25,169,156.25,144,132.25,121,110.25,100,81,64,49,36,25,16,9,4,0,4,16,36,64,100,144,196,324,484,676,900,1156,1444,1764,2116,2916,3844,4900,6084,7396,8836,10404,12100,15876,20164,24964,30276,36100,42436,49284,56644,72900,91204,111556,133956,158404,184900,213444,400,400.35165,400.39072,400.4298,400.46887,400.50797,400.54706,400.58615,400.62524,400.70343,400.78162,400.85983,400.93805,401.01627,401.09448,401.17273,401.25098,401.40747,401.56403,401.72058,401.8772,402.0338,402.1905,402.34717,402.5039,402.81744,403.1311,403.4449,403.7588,404.0728,404.38696,404.70123,405.01562,405.64478,406.2744,406.90454,407.53516,408.16626,408.79785,409.42993,410.0625,411.3291,412.59766,413.86816,415.14062,416.41504,417.6914,418.96973,420.25,422.8164,425.39062,427.97266,430.5625,433.16016,435.76562,438.3789,441,446.26562,451.5625,456.89062,462.25,467.64062,473.0625,478.51562,484,495.0625,506.25,517.5625,529,540.5625,552.25,564.0625,576,600.25,625,650.25,676,702.25,729,756.25,784,841,900,961,1024,1089,1156,1225,1296,1444,1600,1764,1936,2116,2304,2500,2704,3136,3600,4096,4624,5184,5776,6400,7056,8464,10000,11664,13456,15376,17424,19600,21904,26896,32400,38416,44944,51984,59536,67600,76176,94864,115600,138384,163216,190096,219024,250000,399.68756,399.64853,399.60947,399.57043,399.53137,399.49234,399.4533,399.41428,399.37524,399.29718,399.21912,399.14108,399.06305,398.98502,398.90698,398.82898,398.75098,398.59497,398.43903,398.28308,398.1272,397.9713,397.8155,397.65967,397.5039,397.19244,396.8811,396.5699,396.2588,395.9478,395.63696,395.32623,395.01562,394.39478,393.7744,393.15454,392.53516,391.91626,391.29785,390.67993,390.0625,388.8291,387.59766,386.36816,385.14062,383.91504,382.6914,381.46973,380.25,377.8164,375.39062,372.97266,370.5625,368.16016,365.76562,363.3789,361,356.26562,351.5625,346.89062,342.25,337.64062,333.0625,328.51562,324,315.0625,306.25,297.5625,289,280.5625,272.25,264.0625,256,240.25,225,210.25,196,182.25,169,156.25,144,121,100,81,64,49,36,25,16,4,0,4,16,36,64,100,144,256,400,576,784,1024,1296,1600,1936,2704,3600,4624,5776,7056,8464,10000,11664,15376,19600,24336,29584,35344,41616,48400,55696,71824,90000,110224,132496,156816,183184,211600,484,484.3868,484.42978,484.47278,484.51575,484.55875,484.60175,484.64474,484.68774,484.77374,484.85974,484.94577,485.0318,485.11783,485.20386,485.28992,485.37598,485.5481,485.72028,485.89246,486.0647,486.23694,486.40924,486.58154,486.7539,487.0987,487.4436,487.78864,488.1338,488.47906,488.82446,489.16998,489.51562,490.20728,490.8994,491.59204,492.28516,492.97876,493.67285,494.36743,495.0625,496.4541,497.84766,499.24316,500.64062,502.04004,503.4414,504.84473,506.25,509.0664,511.89062,514.72266,517.5625,520.41016,523.2656,526.1289,529,534.7656,540.5625,546.3906,552.25,558.1406,564.0625,570.0156,576,588.0625,600.25,612.5625,625,637.5625,650.25,663.0625,676,702.25,729,756.25,784,812.25,841,870.25,900,961,1024,1089,1156,1225,1296,1369,1444,1600,1764,1936,2116,2304,2500,2704,2916,3364,3844,4356,4900,5476,6084,6724,7396,8836,10404,12100,13924,15876,17956,20164,22500,27556,33124,39204,45796,52900,60516,68644,77284,96100,116964,139876,164836,191844,220900,252004,483.6563,483.61337,483.5704,483.52747,483.4845,483.44156,483.39862,483.35568,483.31274,483.22687,483.141,483.05515,482.9693,482.88345,482.7976,482.7118,482.62598,482.45435,482.28278,482.1112,481.9397,481.7682,481.59674,481.4253,481.2539,480.9112,480.5686,480.22614,479.8838,479.54156,479.19946,478.85748,478.51562,477.83228,477.1494,476.46704,475.78516,475.10376,474.42285,473.74243,473.0625,471.7041,470.34766,468.99316,467.64062,466.29004,464.9414,463.59473,462.25,459.5664,456.89062,454.22266,451.5625,448.91016,446.26562,443.6289,441,435.76562,430.5625,425.39062,420.25,415.14062,410.0625,405.01562,400,390.0625,380.25,370.5625,361,351.5625,342.25,333.0625,324,306.25,289,272.25,256,240.25,225,210.25,196,169,144,121,100,81,64,49,36,16,4,0,4,16,36,64,100,196,324,484,676,900,1156,1444,1764,2500,3364,4356,5476,6724,8100,9604,11236,14884,19044,23716,28900,34596,40804,47524,54756,70756,88804,108900,131044,155236,181476,209764,576,576.42194,576.4689,576.51575,576.5626,576.60956,576.65643,576.70337,576.75024,576.84406,576.93787,577.03174,577.12555,577.2194,577.31323,577.4071,577.501,577.6887,577.8765,578.06433,578.2522,578.44006,578.628,578.8159,579.0039,579.37994,579.7561,580.1324,580.5088,580.8853,581.26196,581.63873,582.0156,582.7698,583.5244,584.27954,585.03516,585.79126,586.54785,587.30493,588.0625,589.5791,591.09766,592.61816,594.1406,595.66504,597.1914,598.7197,600.25,603.3164,606.3906,609.47266,612.5625,615.66016,618.7656,621.8789,625,631.2656,637.5625,643.8906,650.25,656.6406,663.0625,669.5156,676,689.0625,702.25,715.5625,729,742.5625,756.25,770.0625,784,812.25,841,870.25,900,930.25,961,992.25,1024,1089,1156,1225,1296,1369,1444,1521,1600,1764,1936,2116,2304,2500,2704,2916,3136,3600,4096,4624,5184,5776,6400,7056,7744,9216,10816,12544,14400,16384,18496,20736,23104,28224,33856,40000,46656,53824,61504,69696,78400,97344,118336,141376,166464,193600,222784,254016,575.62506,575.5782,575.5314,575.4845,575.4376,575.3908,575.34393,575.2971,575.25024,575.15656,575.06287,574.96924,574.87555,574.7819,574.68823,574.5946,574.501,574.3137,574.1265,573.93933,573.7522,573.56506,573.378,573.1909,573.0039,572.62994,572.2561,571.8824,571.5088,571.1353,570.76196,570.38873,570.0156,569.2698,568.5244,567.77954,567.03516,566.29126,565.54785,564.80493,564.0625,562.5791,561.09766,559.61816,558.1406,556.66504,555.1914,553.7197,552.25,549.3164,546.3906,543.47266,540.5625,537.66016,534.7656,531.8789,529,523.2656,517.5625,511.89062,506.25,500.64062,495.0625,489.51562,484,473.0625,462.25,451.5625,441,430.5625,420.25,410.0625,400,380.25,361,342.25,324,306.25,289,272.25,256,225,196,169,144,121,100,81,64,36,16,4,0,4,16,36,64,144,256,400,576,784,1024,1296,1600,2304,3136,4096,5184,6400,7744,9216,10816,14400,18496,23104,28224,33856,40000,46656,53824,69696,87616,107584,129600,153664,179776,207936,676,676.4571,676.50793,676.5587,676.6095,676.66034,676.7111,676.76196,676.81274,676.91437,677.016,677.1177,677.2193,677.321,677.4226,677.5243,677.626,677.82935,678.0328,678.2362,678.4397,678.6432,678.84674,679.0503,679.2539,679.6612,680.0686,680.47614,680.8838,681.29156,681.69946,682.1075,682.5156,683.3323,684.1494,684.96704,685.78516,686.60376,687.42285,688.24243,689.0625,690.7041,692.34766,693.99316,695.6406,697.29004,698.9414,700.5947,702.25,705.5664,708.8906,712.22266,715.5625,718.91016,722.2656,725.6289,729,735.7656,742.5625,749.3906,756.25,763.1406,770.0625,777.0156,784,798.0625,812.25,826.5625,841,855.5625,870.25,885.0625,900,930.25,961,992.25,1024,1056.25,1089,1122.25,1156,1225,1296,1369,1444,1521,1600,1681,1764,1936,2116,2304,2500,2704,2916,3136,3364,3844,4356,4900,5476,6084,6724,7396,8100,9604,11236,12996,14884,16900,19044,21316,23716,28900,34596,40804,47524,54756,62500,70756,79524,98596,119716,142884,168100,195364,224676,256036,675.5938,675.543,675.4923,675.4415,675.39075,675.34,675.28925,675.2385,675.18774,675.08624,674.98474,674.8833,674.7818,674.68036,674.57886,674.4774,674.376,674.1731,673.9703,673.76746,673.5647,673.36194,673.15924,672.95654,672.7539,672.3487,671.9436,671.53864,671.1338,670.72906,670.32446,669.92,669.5156,668.7073,667.8994,667.09204,666.28516,665.47876,664.67285,663.86743,663.0625,661.4541,659.84766,658.24316,656.6406,655.04004,653.4414,651.8447,650.25,647.0664,643.8906,640.72266,637.5625,634.41016,631.2656,628.1289,625,618.7656,612.5625,606.3906,600.25,594.1406,588.0625,582.0156,576,564.0625,552.25,540.5625,529,517.5625,506.25,495.0625,484,462.25,441,420.25,400,380.25,361,342.25,324,289,256,225,196,169,144,121,100,64,36,16,4,0,4,16,36,100,196,324,484,676,900,1156,1444,2116,2916,3844,4900,6084,7396,8836,10404,13924,17956,22500,27556,33124,39204,45796,52900,68644,86436,106276,128164,152100,178084,206116,784,784.49225,784.547,784.6017,784.6564,784.7111,784.7658,784.82056,784.87524,784.9847,785.0941,785.2036,785.31305,785.42255,785.532,785.6415,785.751,785.97,786.189,786.4081,786.6272,786.8463,787.0655,787.28467,787.5039,787.94244,788.3811,788.8199,789.2588,789.6978,790.13696,790.57623,791.0156,791.8948,792.7744,793.65454,794.53516,795.41626,796.29785,797.17993,798.0625,799.8291,801.59766,803.36816,805.1406,806.91504,808.6914,810.4697,812.25,815.8164,819.3906,822.97266,826.5625,830.16016,833.7656,837.3789,841,848.2656,855.5625,862.8906,870.25,877.6406,885.0625,892.5156,900,915.0625,930.25,945.5625,961,976.5625,992.25,1008.0625,1024,1056.25,1089,1122.25,1156,1190.25,1225,1260.25,1296,1369,1444,1521,1600,1681,1764,1849,1936,2116,2304,2500,2704,2916,3136,3364,3600,4096,4624,5184,5776,6400,7056,7744,8464,10000,11664,13456,15376,17424,19600,21904,24336,29584,35344,41616,48400,55696,63504,71824,80656,99856,121104,144400,169744,197136,226576,258064,783.56256,783.5079,783.45325,783.39856,783.3439,783.28925,783.23456,783.17993,783.12524,783.0159,782.9066,782.79736,782.68805,782.5788,782.4695,782.3602,782.251,782.0325,781.814,781.5956,781.3772,781.1588,780.9405,780.72217,780.5039,780.06744,779.6311,779.1949,778.7588,778.3228,777.88696,777.45123,777.0156,776.1448,775.2744,774.40454,773.53516,772.66626,771.79785,770.92993,770.0625,768.3291,766.59766,764.86816,763.1406,761.41504,759.6914,757.9697,756.25,752.8164,749.3906,745.97266,742.5625,739.16016,735.7656,732.3789,729,722.2656,715.5625,708.8906,702.25,695.6406,689.0625,682.5156,676,663.0625,650.25,637.5625,625,612.5625,600.25,588.0625,576,552.25,529,506.25,484,462.25,441,420.25,400,361,324,289,256,225,196,169,144,100,64,36,16,4,0,4,16,64,144,256,400,576,784,1024,1296,1936,2704,3600,4624,5776,7056,8464,10000,13456,17424,21904,26896,32400,38416,44944,51984,67600,85264,104976,126736,150544,176400,204304,900,900.5274,900.58606,900.64465,900.70325,900.7619,900.8205,900.87915,900.93774,901.055,901.17224,901.28955,901.4068,901.5241,901.64136,901.75867,901.876,902.1106,902.3453,902.57996,902.8147,903.04944,903.28424,903.51904,903.7539,904.2237,904.6936,905.16364,905.6338,906.10406,906.57446,907.045,907.5156,908.4573,909.3994,910.34204,911.28516,912.22876,913.17285,914.11743,915.0625,916.9541,918.84766,920.74316,922.6406,924.54004,926.4414,928.3447,930.25,934.0664,937.8906,941.72266,945.5625,949.41016,953.2656,957.1289,961,968.7656,976.5625,984.3906,992.25,1000.1406,1008.0625,1016.0156,1024,1040.0625,1056.25,1072.5625,1089,1105.5625,1122.25,1139.0625,1156,1190.25,1225,1260.25,1296,1332.25,1369,1406.25,1444,1521,1600,1681,1764,1849,1936,2025,2116,2304,2500,2704,2916,3136,3364,3600,3844,4356,4900,5476,6084,6724,7396,8100,8836,10404,12100,13924,15876,17956,20164,22500,24964,30276,36100,42436,49284,56644,64516,72900,81796,101124,122500,145924,171396,198916,228484,260100,899.5313,899.4727,899.4142,899.3556,899.297,899.23846,899.1799,899.12134,899.06274,898.9456,898.8285,898.7114,898.5943,898.47723,898.3601,898.24304,898.126,897.89185,897.6578,897.4237,897.1897,896.9557,896.72174,896.4878,896.2539,895.7862,895.3186,894.85114,894.3838,893.91656,893.44946,892.9825,892.5156,891.5823,890.6494,889.71704,888.78516,887.85376,886.92285,885.99243,885.0625,883.2041,881.34766,879.49316,877.6406,875.79004,873.9414,872.0947,870.25,866.5664,862.8906,859.22266,855.5625,851.91016,848.2656,844.6289,841,833.7656,826.5625,819.3906,812.25,805.1406,798.0625,791.0156,784,770.0625,756.25,742.5625,729,715.5625,702.25,689.0625,676,650.25,625,600.25,576,552.25,529,506.25,484,441,400,361,324,289,256,225,196,144,100,64,36,16,4,0,4,36,100,196,324,484,676,900,1156,1764,2500,3364,4356,5476,6724,8100,9604,12996,16900,21316,26244,31684,37636,44100,51076,66564,84100,103684,125316,148996,174724,202500,1024,1024.5626,1024.6251,1024.6876,1024.7501,1024.8126,1024.8752,1024.9377,1025.0002,1025.1254,1025.2504,1025.3755,1025.5005,1025.6256,1025.7507,1025.8759,1026.001,1026.2512,1026.5015,1026.7518,1027.0022,1027.2526,1027.5029,1027.7534,1028.0039,1028.5049,1029.0061,1029.5073,1030.0088,1030.5103,1031.012,1031.5137,1032.0156,1033.0198,1034.0244,1035.0295,1036.0352,1037.0413,1038.0479,1039.0549,1040.0625,1042.0791,1044.0977,1046.1182,1048.1406,1050.165,1052.1914,1054.2197,1056.25,1060.3164,1064.3906,1068.4727,1072.5625,1076.6602,1080.7656,1084.8789,1089,1097.2656,1105.5625,1113.8906,1122.25,1130.6406,1139.0625,1147.5156,1156,1173.0625,1190.25,1207.5625,1225,1242.5625,1260.25,1278.0625,1296,1332.25,1369,1406.25,1444,1482.25,1521,1560.25,1600,1681,1764,1849,1936,2025,2116,2209,2304,2500,2704,2916,3136,3364,3600,3844,4096,4624,5184,5776,6400,7056,7744,8464,9216,10816,12544,14400,16384,18496,20736,23104,25600,30976,36864,43264,50176,57600,65536,73984,82944,102400,123904,147456,173056,200704,230400,262144,1023.50006,1023.43756,1023.3751,1023.3126,1023.2501,1023.1877,1023.1252,1023.06274,1023.00024,1022.8753,1022.75037,1022.6255,1022.50055,1022.3757,1022.25073,1022.12585,1022.001,1021.7512,1021.5015,1021.25183,1021.0022,1020.75256,1020.503,1020.2534,1020.0039,1019.50494,1019.0061,1018.5074,1018.0088,1017.5103,1017.01196,1016.51373,1016.0156,1015.0198,1014.0244,1013.02954,1012.03516,1011.04126,1010.04785,1009.05493,1008.0625,1006.0791,1004.09766,1002.11816,1000.1406,998.16504,996.1914,994.2197,992.25,988.3164,984.3906,980.47266,976.5625,972.66016,968.7656,964.8789,961,953.2656,945.5625,937.8906,930.25,922.6406,915.0625,907.5156,900,885.0625,870.25,855.5625,841,826.5625,812.25,798.0625,784,756.25,729,702.25,676,650.25,625,600.25,576,529,484,441,400,361,324,289,256,196,144,100,64,36,16,4,0,16,64,144,256,400,576,784,1024,1600,2304,3136,4096,5184,6400,7744,9216,12544,16384,20736,25600,30976,36864,43264,50176,65536,82944,102400,123904,147456,173056,200704,1296,1296.6329,1296.7032,1296.7736,1296.8439,1296.9142,1296.9846,1297.0549,1297.1252,1297.266,1297.4066,1297.5474,1297.688,1297.8287,1297.9695,1298.1102,1298.251,1298.5325,1298.814,1299.0956,1299.3772,1299.6588,1299.9404,1300.2222,1300.5039,1301.0674,1301.6311,1302.1948,1302.7588,1303.3228,1303.887,1304.4512,1305.0156,1306.1448,1307.2744,1308.4045,1309.5352,1310.6663,1311.7979,1312.9299,1314.0625,1316.3291,1318.5977,1320.8682,1323.1406,1325.415,1327.6914,1329.9697,1332.25,1336.8164,1341.3906,1345.9727,1350.5625,1355.1602,1359.7656,1364.3789,1369,1378.2656,1387.5625,1396.8906,1406.25,1415.6406,1425.0625,1434.5156,1444,1463.0625,1482.25,1501.5625,1521,1540.5625,1560.25,1580.0625,1600,1640.25,1681,1722.25,1764,1806.25,1849,1892.25,1936,2025,2116,2209,2304,2401,2500,2601,2704,2916,3136,3364,3600,3844,4096,4356,4624,5184,5776,6400,7056,7744,8464,9216,10000,11664,13456,15376,17424,19600,21904,24336,26896,32400,38416,44944,51984,59536,67600,76176,85264,104976,126736,150544,176400,204304,234256,266256,1295.4375,1295.3673,1295.297,1295.2267,1295.1564,1295.086,1295.0159,1294.9456,1294.8752,1294.7347,1294.5941,1294.4536,1294.313,1294.1725,1294.032,1293.8915,1293.751,1293.47,1293.189,1292.9081,1292.6272,1292.3463,1292.0654,1291.7847,1291.5039,1290.9424,1290.3811,1289.8198,1289.2588,1288.6978,1288.137,1287.5762,1287.0156,1285.8948,1284.7744,1283.6545,1282.5352,1281.4163,1280.2979,1279.1799,1278.0625,1275.8291,1273.5977,1271.3682,1269.1406,1266.915,1264.6914,1262.4697,1260.25,1255.8164,1251.3906,1246.9727,1242.5625,1238.1602,1233.7656,1229.3789,1225,1216.2656,1207.5625,1198.8906,1190.25,1181.6406,1173.0625,1164.5156,1156,1139.0625,1122.25,1105.5625,1089,1072.5625,1056.25,1040.0625,1024,992.25,961,930.25,900,870.25,841,812.25,784,729,676,625,576,529,484,441,400,324,256,196,144,100,64,36,16,0,16,64,144,256,400,576,784,1296,1936,2704,3600,4624,5776,7056,8464,11664,15376,19600,24336,29584,35344,41616,48400,63504,80656,99856,121104,144400,169744,197136,1600,1600.7032,1600.7814,1600.8595,1600.9376,1601.0157,1601.094,1601.1721,1601.2502,1601.4066,1601.5629,1601.7192,1601.8755,1602.0319,1602.1882,1602.3446,1602.501,1602.8137,1603.1265,1603.4393,1603.7522,1604.0651,1604.3779,1604.6909,1605.0039,1605.6299,1606.2561,1606.8823,1607.5088,1608.1353,1608.762,1609.3887,1610.0156,1611.2698,1612.5244,1613.7795,1615.0352,1616.2913,1617.5479,1618.8049,1620.0625,1622.5791,1625.0977,1627.6182,1630.1406,1632.665,1635.1914,1637.7197,1640.25,1645.3164,1650.3906,1655.4727,1660.5625,1665.6602,1670.7656,1675.8789,1681,1691.2656,1701.5625,1711.8906,1722.25,1732.6406,1743.0625,1753.5156,1764,1785.0625,1806.25,1827.5625,1849,1870.5625,1892.25,1914.0625,1936,1980.25,2025,2070.25,2116,2162.25,2209,2256.25,2304,2401,2500,2601,2704,2809,2916,3025,3136,3364,3600,3844,4096,4356,4624,4900,5184,5776,6400,7056,7744,8464,9216,10000,10816,12544,14400,16384,18496,20736,23104,25600,28224,33856,40000,46656,53824,61504,69696,78400,87616,107584,129600,153664,179776,207936,238144,270400,1599.375,1599.297,1599.2189,1599.1407,1599.0626,1598.9845,1598.9065,1598.8284,1598.7502,1598.5941,1598.4379,1598.2817,1598.1255,1597.9694,1597.8132,1597.6571,1597.501,1597.1887,1596.8765,1596.5643,1596.2522,1595.9401,1595.6279,1595.3159,1595.0039,1594.3799,1593.7561,1593.1323,1592.5088,1591.8853,1591.262,1590.6387,1590.0156,1588.7698,1587.5244,1586.2795,1585.0352,1583.7913,1582.5479,1581.3049,1580.0625,1577.5791,1575.0977,1572.6182,1570.1406,1567.665,1565.1914,1562.7197,1560.25,1555.3164,1550.3906,1545.4727,1540.5625,1535.6602,1530.7656,1525.8789,1521,1511.2656,1501.5625,1491.8906,1482.25,1472.6406,1463.0625,1453.5156,1444,1425.0625,1406.25,1387.5625,1369,1350.5625,1332.25,1314.0625,1296,1260.25,1225,1190.25,1156,1122.25,1089,1056.25,1024,961,900,841,784,729,676,625,576,484,400,324,256,196,144,100,64,16,0,16,64,144,256,400,576,1024,1600,2304,3136,4096,5184,6400,7744,10816,14400,18496,23104,28224,33856,40000,46656,61504,78400,97344,118336,141376,166464,193600,1936,1936.7736,1936.8595,1936.9454,1937.0314,1937.1173,1937.2034,1937.2893,1937.3752,1937.5472,1937.7191,1937.8911,1938.063,1938.235,1938.407,1938.579,1938.751,1939.095,1939.439,1939.7831,1940.1272,1940.4713,1940.8154,1941.1597,1941.5039,1942.1924,1942.8811,1943.5698,1944.2588,1944.9478,1945.637,1946.3262,1947.0156,1948.3948,1949.7744,1951.1545,1952.5352,1953.9163,1955.2979,1956.6799,1958.0625,1960.8291,1963.5977,1966.3682,1969.1406,1971.915,1974.6914,1977.4697,1980.25,1985.8164,1991.3906,1996.9727,2002.5625,2008.1602,2013.7656,2019.3789,2025,2036.2656,2047.5625,2058.8906,2070.25,2081.6406,2093.0625,2104.5156,2116,2139.0625,2162.25,2185.5625,2209,2232.5625,2256.25,2280.0625,2304,2352.25,2401,2450.25,2500,2550.25,2601,2652.25,2704,2809,2916,3025,3136,3249,3364,3481,3600,3844,4096,4356,4624,4900,5184,5476,5776,6400,7056,7744,8464,9216,10000,10816,11664,13456,15376,17424,19600,21904,24336,26896,29584,35344,41616,48400,55696,63504,71824,80656,90000,110224,132496,156816,183184,211600,242064,274576,1935.3125,1935.2267,1935.1407,1935.0548,1934.9689,1934.8829,1934.7971,1934.7112,1934.6252,1934.4535,1934.2816,1934.1099,1933.938,1933.7662,1933.5945,1933.4227,1933.251,1932.9075,1932.564,1932.2206,1931.8772,1931.5338,1931.1904,1930.8472,1930.5039,1929.8174,1929.1311,1928.4448,1927.7588,1927.0728,1926.387,1925.7012,1925.0156,1923.6448,1922.2744,1920.9045,1919.5352,1918.1663,1916.7979,1915.4299,1914.0625,1911.3291,1908.5977,1905.8682,1903.1406,1900.415,1897.6914,1894.9697,1892.25,1886.8164,1881.3906,1875.9727,1870.5625,1865.1602,1859.7656,1854.3789,1849,1838.2656,1827.5625,1816.8906,1806.25,1795.6406,1785.0625,1774.5156,1764,1743.0625,1722.25,1701.5625,1681,1660.5625,1640.25,1620.0625,1600,1560.25,1521,1482.25,1444,1406.25,1369,1332.25,1296,1225,1156,1089,1024,961,900,841,784,676,576,484,400,324,256,196,144,64,16,0,16,64,144,256,400,784,1296,1936,2704,3600,4624,5776,7056,10000,13456,17424,21904,26896,32400,38416,44944,59536,76176,94864,115600,138384,163216,190096,2304,2304.8438,2304.9375,2305.0312,2305.1252,2305.219,2305.3127,2305.4065,2305.5002,2305.6877,2305.8755,2306.063,2306.2505,2306.4382,2306.6257,2306.8135,2307.001,2307.3762,2307.7515,2308.127,2308.5022,2308.8777,2309.253,2309.6284,2310.004,2310.755,2311.506,2312.2573,2313.0088,2313.7603,2314.512,2315.2637,2316.0156,2317.5198,2319.0244,2320.5295,2322.0352,2323.5413,2325.0479,2326.555,2328.0625,2331.079,2334.0977,2337.1182,2340.1406,2343.165,2346.1914,2349.2197,2352.25,2358.3164,2364.3906,2370.4727,2376.5625,2382.6602,2388.7656,2394.879,2401,2413.2656,2425.5625,2437.8906,2450.25,2462.6406,2475.0625,2487.5156,2500,2525.0625,2550.25,2575.5625,2601,2626.5625,2652.25,2678.0625,2704,2756.25,2809,2862.25,2916,2970.25,3025,3080.25,3136,3249,3364,3481,3600,3721,3844,3969,4096,4356,4624,4900,5184,5476,5776,6084,6400,7056,7744,8464,9216,10000,10816,11664,12544,14400,16384,18496,20736,23104,25600,28224,30976,36864,43264,50176,57600,65536,73984,82944,92416,112896,135424,160000,186624,215296,246016,278784,2303.25,2303.1562,2303.0625,2302.9688,2302.8752,2302.7815,2302.6877,2302.594,2302.5002,2302.3127,2302.1255,2301.938,2301.7505,2301.5632,2301.3757,2301.1885,2301.001,2300.6262,2300.2515,2299.877,2299.5022,2299.1277,2298.753,2298.3784,2298.004,2297.255,2296.506,2295.7573,2295.0088,2294.2603,2293.512,2292.7637,2292.0156,2290.5198,2289.0244,2287.5295,2286.0352,2284.5413,2283.0479,2281.555,2280.0625,2277.079,2274.0977,2271.1182,2268.1406,2265.165,2262.1914,2259.2197,2256.25,2250.3164,2244.3906,2238.4727,2232.5625,2226.6602,2220.7656,2214.879,2209,2197.2656,2185.5625,2173.8906,2162.25,2150.6406,2139.0625,2127.5156,2116,2093.0625,2070.25,2047.5625,2025,2002.5625,1980.25,1958.0625,1936,1892.25,1849,1806.25,1764,1722.25,1681,1640.25,1600,1521,1444,1369,1296,1225,1156,1089,1024,900,784,676,576,484,400,324,256,144,64,16,0,16,64,144,256,576,1024,1600,2304,3136,4096,5184,6400,9216,12544,16384,20736,25600,30976,36864,43264,57600,73984,92416,112896,135424,160000,186624,2704,2704.914,2705.0156,2705.1172,2705.219,2705.3206,2705.422,2705.5237,2705.6252,2705.8284,2706.0317,2706.2349,2706.438,2706.6414,2706.8445,2707.0479,2707.251,2707.6575,2708.064,2708.4707,2708.8772,2709.284,2709.6904,2710.0972,2710.504,2711.3174,2712.131,2712.9448,2713.7588,2714.5728,2715.387,2716.2012,2717.0156,2718.6448,2720.2744,2721.9045,2723.5352,2725.1663,2726.7979,2728.43,2730.0625,2733.329,2736.5977,2739.8682,2743.1406,2746.415,2749.6914,2752.9697,2756.25,2762.8164,2769.3906,2775.9727,2782.5625,2789.1602,2795.7656,2802.379,2809,2822.2656,2835.5625,2848.8906,2862.25,2875.6406,2889.0625,2902.5156,2916,2943.0625,2970.25,2997.5625,3025,3052.5625,3080.25,3108.0625,3136,3192.25,3249,3306.25,3364,3422.25,3481,3540.25,3600,3721,3844,3969,4096,4225,4356,4489,4624,4900,5184,5476,5776,6084,6400,6724,7056,7744,8464,9216,10000,10816,11664,12544,13456,15376,17424,19600,21904,24336,26896,29584,32400,38416,44944,51984,59536,67600,76176,85264,94864,115600,138384,163216,190096,219024,250000,283024,2703.1875,2703.086,2702.9844,2702.8828,2702.7815,2702.68,2702.5784,2702.4768,2702.3752,2702.172,2701.9692,2701.766,2701.563,2701.36,2701.157,2700.954,2700.751,2700.345,2699.939,2699.5332,2699.1272,2698.7214,2698.3154,2697.9097,2697.504,2696.6924,2695.881,2695.0698,2694.2588,2693.4478,2692.637,2691.8262,2691.0156,2689.3948,2687.7744,2686.1545,2684.5352,2682.9163,2681.2979,2679.68,2678.0625,2674.829,2671.5977,2668.3682,2665.1406,2661.915,2658.6914,2655.4697,2652.25,2645.8164,2639.3906,2632.9727,2626.5625,2620.1602,2613.7656,2607.379,2601,2588.2656,2575.5625,2562.8906,2550.25,2537.6406,2525.0625,2512.5156,2500,2475.0625,2450.25,2425.5625,2401,2376.5625,2352.25,2328.0625,2304,2256.25,2209,2162.25,2116,2070.25,2025,1980.25,1936,1849,1764,1681,1600,1521,1444,1369,1296,1156,1024,900,784,676,576,484,400,256,144,64,16,0,16,64,144,400,784,1296,1936,2704,3600,4624,5776,8464,11664,15376,19600,24336,29584,35344,41616,55696,71824,90000,110224,132496,156816,183184,3136,3136.9844,3137.0938,3137.2031,3137.3127,3137.422,3137.5315,3137.6409,3137.7502,3137.969,3138.188,3138.4067,3138.6255,3138.8445,3139.0632,3139.2822,3139.501,3139.9387,3140.3765,3140.8145,3141.2522,3141.6902,3142.128,3142.566,3143.004,3143.88,3144.756,3145.6323,3146.5088,3147.3853,3148.262,3149.1387,3150.0156,3151.7698,3153.5244,3155.2795,3157.0352,3158.7913,3160.5479,3162.305,3164.0625,3167.579,3171.0977,3174.6182,3178.1406,3181.665,3185.1914,3188.7197,3192.25,3199.3164,3206.3906,3213.4727,3220.5625,3227.6602,3234.7656,3241.879,3249,3263.2656,3277.5625,3291.8906,3306.25,3320.6406,3335.0625,3349.5156,3364,3393.0625,3422.25,3451.5625,3481,3510.5625,3540.25,3570.0625,3600,3660.25,3721,3782.25,3844,3906.25,3969,4032.25,4096,4225,4356,4489,4624,4761,4900,5041,5184,5476,5776,6084,6400,6724,7056,7396,7744,8464,9216,10000,10816,11664,12544,13456,14400,16384,18496,20736,23104,25600,28224,30976,33856,40000,46656,53824,61504,69696,78400,87616,97344,118336,141376,166464,193600,222784,254016,287296,3135.125,3135.0156,3134.9062,3134.7969,3134.6877,3134.5784,3134.469,3134.3596,3134.2502,3134.0315,3133.813,3133.5942,3133.3755,3133.157,3132.9382,3132.7197,3132.501,3132.0637,3131.6265,3131.1895,3130.7522,3130.3152,3129.878,3129.441,3129.004,3128.13,3127.256,3126.3823,3125.5088,3124.6353,3123.762,3122.8887,3122.0156,3120.2698,3118.5244,3116.7795,3115.0352,3113.2913,3111.5479,3109.805,3108.0625,3104.579,3101.0977,3097.6182,3094.1406,3090.665,3087.1914,3083.7197,3080.25,3073.3164,3066.3906,3059.4727,3052.5625,3045.6602,3038.7656,3031.879,3025,3011.2656,2997.5625,2983.8906,2970.25,2956.6406,2943.0625,2929.5156,2916,2889.0625,2862.25,2835.5625,2809,2782.5625,2756.25,2730.0625,2704,2652.25,2601,2550.25,2500,2450.25,2401,2352.25,2304,2209,2116,2025,1936,1849,1764,1681,1600,1444,1296,1156,1024,900,784,676,576,400,256,144,64,16,0,16,64,256,576,1024,1600,2304,3136,4096,5184,7744,10816,14400,18496,23104,28224,33856,40000,53824,69696,87616,107584,129600,153664,179776,3600,3601.0547,3601.1719,3601.289,3601.4065,3601.5237,3601.6409,3601.758,3601.8752,3602.1096,3602.3442,3602.5786,3602.813,3603.0476,3603.282,3603.5166,3603.751,3604.22,3604.689,3605.1582,3605.6272,3606.0964,3606.5654,3607.0347,3607.504,3608.4424,3609.381,3610.3198,3611.2588,3612.1978,3613.137,3614.0762,3615.0156,3616.8948,3618.7744,3620.6545,3622.5352,3624.4163,3626.2979,3628.18,3630.0625,3633.829,3637.5977,3641.3682,3645.1406,3648.915,3652.6914,3656.4697,3660.25,3667.8164,3675.3906,3682.9727,3690.5625,3698.1602,3705.7656,3713.379,3721,3736.2656,3751.5625,3766.8906,3782.25,3797.6406,3813.0625,3828.5156,3844,3875.0625,3906.25,3937.5625,3969,4000.5625,4032.25,4064.0625,4096,4160.25,4225,4290.25,4356,4422.25,4489,4556.25,4624,4761,4900,5041,5184,5329,5476,5625,5776,6084,6400,6724,7056,7396,7744,8100,8464,9216,10000,10816,11664,12544,13456,14400,15376,17424,19600,21904,24336,26896,29584,32400,35344,41616,48400,55696,63504,71824,80656,90000,99856,121104,144400,169744,197136,226576,258064,291600,3599.0625,3598.9453,3598.8281,3598.711,3598.594,3598.4768,3598.3596,3598.2424,3598.1252,3597.8909,3597.6567,3597.4224,3597.188,3596.9539,3596.7195,3596.4854,3596.251,3595.7825,3595.314,3594.8457,3594.3772,3593.909,3593.4404,3592.9722,3592.504,3591.5674,3590.631,3589.6948,3588.7588,3587.8228,3586.887,3585.9512,3585.0156,3583.1448,3581.2744,3579.4045,3577.5352,3575.6663,3573.7979,3571.93,3570.0625,3566.329,3562.5977,3558.8682,3555.1406,3551.415,3547.6914,3543.9697,3540.25,3532.8164,3525.3906,3517.9727,3510.5625,3503.1602,3495.7656,3488.379,3481,3466.2656,3451.5625,3436.8906,3422.25,3407.6406,3393.0625,3378.5156,3364,3335.0625,3306.25,3277.5625,3249,3220.5625,3192.25,3164.0625,3136,3080.25,3025,2970.25,2916,2862.25,2809,2756.25,2704,2601,2500,2401,2304,2209,2116,2025,1936,1764,1600,1444,1296,1156,1024,900,784,576,400,256,144,64,16,0,16,144,400,784,1296,1936,2704,3600,4624,7056,10000,13456,17424,21904,26896,32400,38416,51984,67600,85264,104976,126736,150544,176400,4096,4097.125,4097.25,4097.375,4097.5,4097.625,4097.75,4097.875,4098,4098.2505,4098.5005,4098.7505,4099.0005,4099.2505,4099.501,4099.751,4100.001,4100.5015,4101.0015,4101.502,4102.002,4102.5024,4103.003,4103.5034,4104.004,4105.005,4106.006,4107.0073,4108.009,4109.0103,4110.0117,4111.0137,4112.0156,4114.0195,4116.0244,4118.0293,4120.035,4122.041,4124.048,4126.0547,4128.0625,4132.079,4136.0977,4140.118,4144.1406,4148.165,4152.1914,4156.2197,4160.25,4168.3164,4176.3906,4184.4727,4192.5625,4200.66,4208.7656,4216.879,4225,4241.2656,4257.5625,4273.8906,4290.25,4306.6406,4323.0625,4339.5156,4356,4389.0625,4422.25,4455.5625,4489,4522.5625,4556.25,4590.0625,4624,4692.25,4761,4830.25,4900,4970.25,5041,5112.25,5184,5329,5476,5625,5776,5929,6084,6241,6400,6724,7056,7396,7744,8100,8464,8836,9216,10000,10816,11664,12544,13456,14400,15376,16384,18496,20736,23104,25600,28224,30976,33856,36864,43264,50176,57600,65536,73984,82944,92416,102400,123904,147456,173056,200704,230400,262144,295936,4095,4094.875,4094.75,4094.625,4094.5002,4094.3752,4094.2502,4094.1252,4094.0002,4093.7502,4093.5005,4093.2505,4093.0005,4092.7507,4092.5007,4092.251,4092.001,4091.5012,4091.0015,4090.502,4090.0022,4089.5027,4089.003,4088.5034,4088.004,4087.005,4086.006,4085.0073,4084.0088,4083.0103,4082.012,4081.0137,4080.0156,4078.0198,4076.0244,4074.0295,4072.0352,4070.0413,4068.0479,4066.055,4064.0625,4060.079,4056.0977,4052.1182,4048.1406,4044.165,4040.1914,4036.2197,4032.25,4024.3164,4016.3906,4008.4727,4000.5625,3992.6602,3984.7656,3976.879,3969,3953.2656,3937.5625,3921.8906,3906.25,3890.6406,3875.0625,3859.5156,3844,3813.0625,3782.25,3751.5625,3721,3690.5625,3660.25,3630.0625,3600,3540.25,3481,3422.25,3364,3306.25,3249,3192.25,3136,3025,2916,2809,2704,2601,2500,2401,2304,2116,1936,1764,1600,1444,1296,1156,1024,784,576,400,256,144,64,16,0,64,256,576,1024,1600,2304,3136,4096,6400,9216,12544,16384,20736,25600,30976,36864,50176,65536,82944,102400,123904,147456,173056,5184,5185.2656,5185.4062,5185.547,5185.6875,5185.828,5185.9688,5186.1094,5186.25,5186.5317,5186.813,5187.094,5187.3755,5187.6567,5187.9385,5188.2197,5188.501,5189.064,5189.6265,5190.1895,5190.752,5191.315,5191.878,5192.441,5193.004,5194.13,5195.256,5196.3823,5197.509,5198.6353,5199.7617,5200.8887,5202.0156,5204.2695,5206.5244,5208.7793,5211.035,5213.291,5215.548,5217.8047,5220.0625,5224.579,5229.0977,5233.618,5238.1406,5242.665,5247.1914,5251.7197,5256.25,5265.3164,5274.3906,5283.4727,5292.5625,5301.66,5310.7656,5319.879,5329,5347.2656,5365.5625,5383.8906,5402.25,5420.6406,5439.0625,5457.5156,5476,5513.0625,5550.25,5587.5625,5625,5662.5625,5700.25,5738.0625,5776,5852.25,5929,6006.25,6084,6162.25,6241,6320.25,6400,6561,6724,6889,7056,7225,7396,7569,7744,8100,8464,8836,9216,9604,10000,10404,10816,11664,12544,13456,14400,15376,16384,17424,18496,20736,23104,25600,28224,30976,33856,36864,40000,46656,53824,61504,69696,78400,87616,97344,107584,129600,153664,179776,207936,238144,270400,304704,5182.875,5182.7344,5182.5938,5182.453,5182.3125,5182.172,5182.0312,5181.8906,5181.75,5181.469,5181.188,5180.9067,5180.6255,5180.344,5180.0635,5179.782,5179.501,5178.939,5178.3765,5177.8145,5177.252,5176.69,5176.128,5175.566,5175.004,5173.88,5172.756,5171.6323,5170.509,5169.3853,5168.2617,5167.1387,5166.0156,5163.7695,5161.5244,5159.2793,5157.035,5154.791,5152.548,5150.3047,5148.0625,5143.579,5139.0977,5134.618,5130.1406,5125.665,5121.1914,5116.7197,5112.25,5103.3164,5094.3906,5085.4727,5076.5625,5067.66,5058.7656,5049.879,5041,5023.2656,5005.5625,4987.8906,4970.25,4952.6406,4935.0625,4917.5156,4900,4865.0625,4830.25,4795.5625,4761,4726.5625,4692.25,4658.0625,4624,4556.25,4489,4422.25,4356,4290.25,4225,4160.25,4096,3969,3844,3721,3600,3481,3364,3249,3136,2916,2704,2500,2304,2116,1936,1764,1600,1296,1024,784,576,400,256,144,64,0,64,256,576,1024,1600,2304,3136,5184,7744,10816,14400,18496,23104,28224,33856,46656,61504,78400,97344,118336,141376,166464,6400,6401.4062,6401.5625,6401.7188,6401.875,6402.0312,6402.1875,6402.3438,6402.5,6402.813,6403.1255,6403.438,6403.7505,6404.063,6404.376,6404.6885,6405.001,6405.6265,6406.2515,6406.877,6407.502,6408.1274,6408.753,6409.3784,6410.004,6411.255,6412.506,6413.7573,6415.009,6416.2603,6417.5117,6418.7637,6420.0156,6422.5195,6425.0244,6427.5293,6430.035,6432.541,6435.048,6437.5547,6440.0625,6445.079,6450.0977,6455.118,6460.1406,6465.165,6470.1914,6475.2197,6480.25,6490.3164,6500.3906,6510.4727,6520.5625,6530.66,6540.7656,6550.879,6561,6581.2656,6601.5625,6621.8906,6642.25,6662.6406,6683.0625,6703.5156,6724,6765.0625,6806.25,6847.5625,6889,6930.5625,6972.25,7014.0625,7056,7140.25,7225,7310.25,7396,7482.25,7569,7656.25,7744,7921,8100,8281,8464,8649,8836,9025,9216,9604,10000,10404,10816,11236,11664,12100,12544,13456,14400,15376,16384,17424,18496,19600,20736,23104,25600,28224,30976,33856,36864,40000,43264,50176,57600,65536,73984,82944,92416,102400,112896,135424,160000,186624,215296,246016,278784,313600,6398.75,6398.5938,6398.4375,6398.2812,6398.125,6397.9688,6397.8125,6397.6562,6397.5,6397.188,6396.8755,6396.563,6396.2505,6395.938,6395.626,6395.3135,6395.001,6394.3765,6393.7515,6393.127,6392.502,6391.8774,6391.253,6390.6284,6390.004,6388.755,6387.506,6386.2573,6385.009,6383.7603,6382.5117,6381.2637,6380.0156,6377.5195,6375.0244,6372.5293,6370.035,6367.541,6365.048,6362.5547,6360.0625,6355.079,6350.0977,6345.118,6340.1406,6335.165,6330.1914,6325.2197,6320.25,6310.3164,6300.3906,6290.4727,6280.5625,6270.66,6260.7656,6250.879,6241,6221.2656,6201.5625,6181.8906,6162.25,6142.6406,6123.0625,6103.5156,6084,6045.0625,6006.25,5967.5625,5929,5890.5625,5852.25,5814.0625,5776,5700.25,5625,5550.25,5476,5402.25,5329,5256.25,5184,5041,4900,4761,4624,4489,4356,4225,4096,3844,3600,3364,3136,2916,2704,2500,2304,1936,1600,1296,1024,784,576,400,256,64,0,64,256,576,1024,1600,2304,4096,6400,9216,12544,16384,20736,25600,30976,43264,57600,73984,92416,112896,135424,160000,7744,7745.547,7745.7188,7745.8906,7746.0625,7746.2344,7746.4062,7746.578,7746.75,7747.094,7747.438,7747.7817,7748.1255,7748.469,7748.8135,7749.157,7749.501,7750.189,7750.8765,7751.5645,7752.252,7752.94,7753.628,7754.316,7755.004,7756.38,7757.756,7759.1323,7760.509,7761.8853,7763.2617,7764.6387,7766.0156,7768.7695,7771.5244,7774.2793,7777.035,7779.791,7782.548,7785.3047,7788.0625,7793.579,7799.0977,7804.618,7810.1406,7815.665,7821.1914,7826.7197,7832.25,7843.3164,7854.3906,7865.4727,7876.5625,7887.66,7898.7656,7909.879,7921,7943.2656,7965.5625,7987.8906,8010.25,8032.6406,8055.0625,8077.5156,8100,8145.0625,8190.25,8235.5625,8281,8326.5625,8372.25,8418.0625,8464,8556.25,8649,8742.25,8836,8930.25,9025,9120.25,9216,9409,9604,9801,10000,10201,10404,10609,10816,11236,11664,12100,12544,12996,13456,13924,14400,15376,16384,17424,18496,19600,20736,21904,23104,25600,28224,30976,33856,36864,40000,43264,46656,53824,61504,69696,78400,87616,97344,107584,118336,141376,166464,193600,222784,254016,287296,322624,7742.625,7742.453,7742.2812,7742.1094,7741.9375,7741.7656,7741.5938,7741.422,7741.25,7740.9067,7740.563,7740.219,7739.8755,7739.5317,7739.1885,7738.8447,7738.501,7737.814,7737.1265,7736.4395,7735.752,7735.065,7734.378,7733.691,7733.004,7731.63,7730.256,7728.8823,7727.509,7726.1353,7724.7617,7723.3887,7722.0156,7719.2695,7716.5244,7713.7793,7711.035,7708.291,7705.548,7702.8047,7700.0625,7694.579,7689.0977,7683.618,7678.1406,7672.665,7667.1914,7661.7197,7656.25,7645.3164,7634.3906,7623.4727,7612.5625,7601.66,7590.7656,7579.879,7569,7547.2656,7525.5625,7503.8906,7482.25,7460.6406,7439.0625,7417.5156,7396,7353.0625,7310.25,7267.5625,7225,7182.5625,7140.25,7098.0625,7056,6972.25,6889,6806.25,6724,6642.25,6561,6480.25,6400,6241,6084,5929,5776,5625,5476,5329,5184,4900,4624,4356,4096,3844,3600,3364,3136,2704,2304,1936,1600,1296,1024,784,576,256,64,0,64,256,576,1024,1600,3136,5184,7744,10816,14400,18496,23104,28224,40000,53824,69696,87616,107584,129600,153664,9216,9217.6875,9217.875,9218.0625,9218.25,9218.4375,9218.625,9218.8125,9219,9219.375,9219.75,9220.125,9220.501,9220.876,9221.251,9221.626,9222.001,9222.751,9223.502,9224.252,9225.002,9225.753,9226.503,9227.254,9228.004,9229.505,9231.006,9232.508,9234.009,9235.511,9237.012,9238.514,9240.016,9243.02,9246.024,9249.029,9252.035,9255.041,9258.048,9261.055,9264.0625,9270.079,9276.098,9282.118,9288.141,9294.165,9300.191,9306.22,9312.25,9324.316,9336.391,9348.473,9360.5625,9372.66,9384.766,9396.879,9409,9433.266,9457.5625,9481.891,9506.25,9530.641,9555.0625,9579.516,9604,9653.0625,9702.25,9751.5625,9801,9850.5625,9900.25,9950.0625,10000,10100.25,10201,10302.25,10404,10506.25,10609,10712.25,10816,11025,11236,11449,11664,11881,12100,12321,12544,12996,13456,13924,14400,14884,15376,15876,16384,17424,18496,19600,20736,21904,23104,24336,25600,28224,30976,33856,36864,40000,43264,46656,50176,57600,65536,73984,82944,92416,102400,112896,123904,147456,173056,200704,230400,262144,295936,331776,9214.5,9214.3125,9214.125,9213.9375,9213.75,9213.5625,9213.375,9213.1875,9213,9212.625,9212.25,9211.875,9211.501,9211.126,9210.751,9210.376,9210.001,9209.251,9208.502,9207.752,9207.002,9206.253,9205.503,9204.754,9204.004,9202.505,9201.006,9199.508,9198.009,9196.511,9195.012,9193.514,9192.016,9189.02,9186.024,9183.029,9180.035,9177.041,9174.048,9171.055,9168.0625,9162.079,9156.098,9150.118,9144.141,9138.165,9132.191,9126.22,9120.25,9108.316,9096.391,9084.473,9072.5625,9060.66,9048.766,9036.879,9025,9001.266,8977.5625,8953.891,8930.25,8906.641,8883.0625,8859.516,8836,8789.0625,8742.25,8695.5625,8649,8602.5625,8556.25,8510.0625,8464,8372.25,8281,8190.25,8100,8010.25,7921,7832.25,7744,7569,7396,7225,7056,6889,6724,6561,6400,6084,5776,5476,5184,4900,4624,4356,4096,3600,3136,2704,2304,1936,1600,1296,1024,576,256,64,0,64,256,576,1024,2304,4096,6400,9216,12544,16384,20736,25600,36864,50176,65536,82944,102400,123904,147456,10816,10817.828,10818.031,10818.234,10818.4375,10818.641,10818.844,10819.047,10819.25,10819.656,10820.0625,10820.469,10820.876,10821.282,10821.688,10822.095,10822.501,10823.313,10824.127,10824.939,10825.752,10826.565,10827.378,10828.191,10829.004,10830.63,10832.256,10833.883,10835.509,10837.136,10838.762,10840.389,10842.016,10845.27,10848.524,10851.779,10855.035,10858.291,10861.548,10864.805,10868.0625,10874.579,10881.098,10887.618,10894.141,10900.665,10907.191,10913.72,10920.25,10933.316,10946.391,10959.473,10972.5625,10985.66,10998.766,11011.879,11025,11051.266,11077.5625,11103.891,11130.25,11156.641,11183.0625,11209.516,11236,11289.0625,11342.25,11395.5625,11449,11502.5625,11556.25,11610.0625,11664,11772.25,11881,11990.25,12100,12210.25,12321,12432.25,12544,12769,12996,13225,13456,13689,13924,14161,14400,14884,15376,15876,16384,16900,17424,17956,18496,19600,20736,21904,23104,24336,25600,26896,28224,30976,33856,36864,40000,43264,46656,50176,53824,61504,69696,78400,87616,97344,107584,118336,129600,153664,179776,207936,238144,270400,304704,341056,10814.375,10814.172,10813.969,10813.766,10813.5625,10813.359,10813.156,10812.953,10812.75,10812.344,10811.9375,10811.531,10811.126,10810.72,10810.313,10809.907,10809.501,10808.688,10807.877,10807.064,10806.252,10805.44,10804.628,10803.816,10803.004,10801.38,10799.756,10798.133,10796.509,10794.886,10793.262,10791.639,10790.016,10786.77,10783.524,10780.279,10777.035,10773.791,10770.548,10767.305,10764.0625,10757.579,10751.098,10744.618,10738.141,10731.665,10725.191,10718.72,10712.25,10699.316,10686.391,10673.473,10660.5625,10647.66,10634.766,10621.879,10609,10583.266,10557.5625,10531.891,10506.25,10480.641,10455.0625,10429.516,10404,10353.0625,10302.25,10251.5625,10201,10150.5625,10100.25,10050.0625,10000,9900.25,9801,9702.25,9604,9506.25,9409,9312.25,9216,9025,8836,8649,8464,8281,8100,7921,7744,7396,7056,6724,6400,6084,5776,5476,5184,4624,4096,3600,3136,2704,2304,1936,1600,1024,576,256,64,0,64,256,576,1600,3136,5184,7744,10816,14400,18496,23104,33856,46656,61504,78400,97344,118336,141376,12544,12545.969,12546.1875,12546.406,12546.625,12546.844,12547.0625,12547.281,12547.5,12547.9375,12548.375,12548.8125,12549.251,12549.688,12550.126,12550.563,12551.001,12551.876,12552.752,12553.627,12554.502,12555.378,12556.253,12557.129,12558.004,12559.755,12561.506,12563.258,12565.009,12566.761,12568.512,12570.264,12572.016,12575.52,12579.024,12582.529,12586.035,12589.541,12593.048,12596.555,12600.0625,12607.079,12614.098,12621.118,12628.141,12635.165,12642.191,12649.22,12656.25,12670.316,12684.391,12698.473,12712.5625,12726.66,12740.766,12754.879,12769,12797.266,12825.5625,12853.891,12882.25,12910.641,12939.0625,12967.516,12996,13053.0625,13110.25,13167.5625,13225,13282.5625,13340.25,13398.0625,13456,13572.25,13689,13806.25,13924,14042.25,14161,14280.25,14400,14641,14884,15129,15376,15625,15876,16129,16384,16900,17424,17956,18496,19044,19600,20164,20736,21904,23104,24336,25600,26896,28224,29584,30976,33856,36864,40000,43264,46656,50176,53824,57600,65536,73984,82944,92416,102400,112896,123904,135424,160000,186624,215296,246016,278784,313600,350464,12542.25,12542.031,12541.8125,12541.594,12541.375,12541.156,12540.9375,12540.719,12540.5,12540.0625,12539.625,12539.1875,12538.751,12538.313,12537.876,12537.438,12537.001,12536.126,12535.252,12534.377,12533.502,12532.628,12531.753,12530.879,12530.004,12528.255,12526.506,12524.758,12523.009,12521.261,12519.512,12517.764,12516.016,12512.52,12509.024,12505.529,12502.035,12498.541,12495.048,12491.555,12488.0625,12481.079,12474.098,12467.118,12460.141,12453.165,12446.191,12439.22,12432.25,12418.316,12404.391,12390.473,12376.5625,12362.66,12348.766,12334.879,12321,12293.266,12265.5625,12237.891,12210.25,12182.641,12155.0625,12127.516,12100,12045.0625,11990.25,11935.5625,11881,11826.5625,11772.25,11718.0625,11664,11556.25,11449,11342.25,11236,11130.25,11025,10920.25,10816,10609,10404,10201,10000,9801,9604,9409,9216,8836,8464,8100,7744,7396,7056,6724,6400,5776,5184,4624,4096,3600,3136,2704,2304,1600,1024,576,256,64,0,64,256,1024,2304,4096,6400,9216,12544,16384,20736,30976,43264,57600,73984,92416,112896,135424,14400,14402.109,14402.344,14402.578,14402.8125,14403.047,14403.281,14403.516,14403.75,14404.219,14404.6875,14405.156,14405.626,14406.095,14406.563,14407.032,14407.501,14408.438,14409.377,14410.314,14411.252,14412.19,14413.128,14414.066,14415.004,14416.88,14418.756,14420.633,14422.509,14424.386,14426.262,14428.139,14430.016,14433.77,14437.524,14441.279,14445.035,14448.791,14452.548,14456.305,14460.0625,14467.579,14475.098,14482.618,14490.141,14497.665,14505.191,14512.72,14520.25,14535.316,14550.391,14565.473,14580.5625,14595.66,14610.766,14625.879,14641,14671.266,14701.5625,14731.891,14762.25,14792.641,14823.0625,14853.516,14884,14945.0625,15006.25,15067.5625,15129,15190.5625,15252.25,15314.0625,15376,15500.25,15625,15750.25,15876,16002.25,16129,16256.25,16384,16641,16900,17161,17424,17689,17956,18225,18496,19044,19600,20164,20736,21316,21904,22500,23104,24336,25600,26896,28224,29584,30976,32400,33856,36864,40000,43264,46656,50176,53824,57600,61504,69696,78400,87616,97344,107584,118336,129600,141376,166464,193600,222784,254016,287296,322624,360000,14398.125,14397.891,14397.656,14397.422,14397.1875,14396.953,14396.719,14396.484,14396.25,14395.781,14395.3125,14394.844,14394.376,14393.907,14393.438,14392.97,14392.501,14391.563,14390.627,14389.689,14388.752,14387.815,14386.878,14385.941,14385.004,14383.13,14381.256,14379.383,14377.509,14375.636,14373.762,14371.889,14370.016,14366.27,14362.524,14358.779,14355.035,14351.291,14347.548,14343.805,14340.0625,14332.579,14325.098,14317.618,14310.141,14302.665,14295.191,14287.72,14280.25,14265.316,14250.391,14235.473,14220.5625,14205.66,14190.766,14175.879,14161,14131.266,14101.5625,14071.891,14042.25,14012.641,13983.0625,13953.516,13924,13865.0625,13806.25,13747.5625,13689,13630.5625,13572.25,13514.0625,13456,13340.25,13225,13110.25,12996,12882.25,12769,12656.25,12544,12321,12100,11881,11664,11449,11236,11025,10816,10404,10000,9604,9216,8836,8464,8100,7744,7056,6400,5776,5184,4624,4096,3600,3136,2304,1600,1024,576,256,64,0,64,576,1600,3136,5184,7744,10816,14400,18496,28224,40000,53824,69696,87616,107584,129600,16384,16386.25,16386.5,16386.75,16387,16387.25,16387.5,16387.75,16388,16388.5,16389,16389.5,16390,16390.5,16391,16391.5,16392,16393.002,16394.002,16395.002,16396.002,16397.002,16398.004,16399.004,16400.004,16402.006,16404.006,16406.008,16408.008,16410.01,16412.012,16414.014,16416.016,16420.02,16424.023,16428.03,16432.035,16436.041,16440.047,16444.055,16448.062,16456.078,16464.098,16472.117,16480.14,16488.164,16496.191,16504.219,16512.25,16528.316,16544.39,16560.473,16576.562,16592.66,16608.766,16624.879,16641,16673.266,16705.562,16737.89,16770.25,16802.64,16835.062,16867.516,16900,16965.062,17030.25,17095.562,17161,17226.562,17292.25,17358.062,17424,17556.25,17689,17822.25,17956,18090.25,18225,18360.25,18496,18769,19044,19321,19600,19881,20164,20449,20736,21316,21904,22500,23104,23716,24336,24964,25600,26896,28224,29584,30976,32400,33856,35344,36864,40000,43264,46656,50176,53824,57600,61504,65536,73984,82944,92416,102400,112896,123904,135424,147456,173056,200704,230400,262144,295936,331776,369664,16382,16381.75,16381.5,16381.25,16381,16380.75,16380.5,16380.25,16380,16379.5,16379,16378.5,16378.001,16377.501,16377.001,16376.501,16376.001,16375.001,16374.002,16373.002,16372.002,16371.003,16370.003,16369.004,16368.004,16366.005,16364.006,16362.008,16360.009,16358.011,16356.012,16354.014,16352.016,16348.02,16344.024,16340.029,16336.035,16332.041,16328.048,16324.055,16320.0625,16312.079,16304.098,16296.118,16288.141,16280.165,16272.191,16264.22,16256.25,16240.316,16224.391,16208.473,16192.5625,16176.66,16160.766,16144.879,16129,16097.266,16065.5625,16033.891,16002.25,15970.641,15939.0625,15907.516,15876,15813.0625,15750.25,15687.5625,15625,15562.5625,15500.25,15438.0625,15376,15252.25,15129,15006.25,14884,14762.25,14641,14520.25,14400,14161,13924,13689,13456,13225,12996,12769,12544,12100,11664,11236,10816,10404,10000,9604,9216,8464,7744,7056,6400,5776,5184,4624,4096,3136,2304,1600,1024,576,256,64,0,256,1024,2304,4096,6400,9216,12544,16384,25600,36864,50176,65536,82944,102400,123904,20736,20738.531,20738.812,20739.094,20739.375,20739.656,20739.938,20740.219,20740.5,20741.062,20741.625,20742.188,20742.75,20743.312,20743.875,20744.438,20745,20746.127,20747.252,20748.377,20749.502,20750.627,20751.754,20752.879,20754.004,20756.256,20758.506,20760.758,20763.008,20765.26,20767.512,20769.764,20772.016,20776.52,20781.023,20785.53,20790.035,20794.541,20799.047,20803.555,20808.062,20817.078,20826.098,20835.117,20844.14,20853.164,20862.191,20871.219,20880.25,20898.316,20916.39,20934.473,20952.562,20970.66,20988.766,21006.879,21025,21061.266,21097.562,21133.89,21170.25,21206.64,21243.062,21279.516,21316,21389.062,21462.25,21535.562,21609,21682.562,21756.25,21830.062,21904,22052.25,22201,22350.25,22500,22650.25,22801,22952.25,23104,23409,23716,24025,24336,24649,24964,25281,25600,26244,26896,27556,28224,28900,29584,30276,30976,32400,33856,35344,36864,38416,40000,41616,43264,46656,50176,53824,57600,61504,65536,69696,73984,82944,92416,102400,112896,123904,135424,147456,160000,186624,215296,246016,278784,313600,350464,389376,20733.75,20733.469,20733.188,20732.906,20732.625,20732.344,20732.062,20731.781,20731.5,20730.938,20730.375,20729.812,20729.25,20728.688,20728.125,20727.562,20727,20725.877,20724.752,20723.627,20722.502,20721.377,20720.254,20719.129,20718.004,20715.756,20713.506,20711.258,20709.008,20706.76,20704.512,20702.264,20700.016,20695.52,20691.023,20686.53,20682.035,20677.541,20673.047,20668.555,20664.062,20655.078,20646.098,20637.117,20628.14,20619.164,20610.191,20601.219,20592.25,20574.316,20556.39,20538.473,20520.562,20502.66,20484.766,20466.879,20449,20413.266,20377.562,20341.89,20306.25,20270.64,20235.062,20199.516,20164,20093.062,20022.25,19951.562,19881,19810.562,19740.25,19670.062,19600,19460.25,19321,19182.25,19044,18906.25,18769,18632.25,18496,18225,17956,17689,17424,17161,16900,16641,16384,15876,15376,14884,14400,13924,13456,12996,12544,11664,10816,10000,9216,8464,7744,7056,6400,5184,4096,3136,2304,1600,1024,576,256,0,256,1024,2304,4096,6400,9216,12544,20736,30976,43264,57600,73984,92416,112896,25600,25602.812,25603.125,25603.438,25603.75,25604.062,25604.375,25604.688,25605,25605.625,25606.25,25606.875,25607.5,25608.125,25608.75,25609.375,25610,25611.252,25612.502,25613.752,25615.002,25616.252,25617.504,25618.754,25620.004,25622.506,25625.006,25627.508,25630.008,25632.51,25635.012,25637.514,25640.016,25645.02,25650.023,25655.03,25660.035,25665.041,25670.047,25675.055,25680.062,25690.078,25700.098,25710.117,25720.14,25730.164,25740.191,25750.219,25760.25,25780.316,25800.39,25820.473,25840.562,25860.66,25880.766,25900.879,25921,25961.266,26001.562,26041.89,26082.25,26122.64,26163.062,26203.516,26244,26325.062,26406.25,26487.562,26569,26650.562,26732.25,26814.062,26896,27060.25,27225,27390.25,27556,27722.25,27889,28056.25,28224,28561,28900,29241,29584,29929,30276,30625,30976,31684,32400,33124,33856,34596,35344,36100,36864,38416,40000,41616,43264,44944,46656,48400,50176,53824,57600,61504,65536,69696,73984,78400,82944,92416,102400,112896,123904,135424,147456,160000,173056,200704,230400,262144,295936,331776,369664,409600,25597.5,25597.188,25596.875,25596.562,25596.25,25595.938,25595.625,25595.312,25595,25594.375,25593.75,25593.125,25592.5,25591.875,25591.25,25590.625,25590,25588.752,25587.502,25586.252,25585.002,25583.752,25582.504,25581.254,25580.004,25577.506,25575.006,25572.508,25570.008,25567.51,25565.012,25562.514,25560.016,25555.02,25550.023,25545.03,25540.035,25535.041,25530.047,25525.055,25520.062,25510.078,25500.098,25490.117,25480.14,25470.164,25460.191,25450.219,25440.25,25420.316,25400.39,25380.473,25360.562,25340.66,25320.766,25300.879,25281,25241.266,25201.562,25161.89,25122.25,25082.64,25043.062,25003.516,24964,24885.062,24806.25,24727.562,24649,24570.562,24492.25,24414.062,24336,24180.25,24025,23870.25,23716,23562.25,23409,23256.25,23104,22801,22500,22201,21904,21609,21316,21025,20736,20164,19600,19044,18496,17956,17424,16900,16384,15376,14400,13456,12544,11664,10816,10000,9216,7744,6400,5184,4096,3136,2304,1600,1024,256,0,256,1024,2304,4096,6400,9216,16384,25600,36864,50176,65536,82944,102400,30976,30979.094,30979.438,30979.781,30980.125,30980.469,30980.812,30981.156,30981.5,30982.188,30982.875,30983.562,30984.25,30984.938,30985.625,30986.312,30987,30988.377,30989.752,30991.127,30992.502,30993.877,30995.254,30996.629,30998.004,31000.756,31003.506,31006.258,31009.008,31011.76,31014.512,31017.264,31020.016,31025.52,31031.023,31036.53,31042.035,31047.541,31053.047,31058.555,31064.062,31075.078,31086.098,31097.117,31108.14,31119.164,31130.191,31141.219,31152.25,31174.316,31196.39,31218.473,31240.562,31262.66,31284.766,31306.879,31329,31373.266,31417.562,31461.89,31506.25,31550.64,31595.062,31639.516,31684,31773.062,31862.25,31951.562,32041,32130.562,32220.25,32310.062,32400,32580.25,32761,32942.25,33124,33306.25,33489,33672.25,33856,34225,34596,34969,35344,35721,36100,36481,36864,37636,38416,39204,40000,40804,41616,42436,43264,44944,46656,48400,50176,51984,53824,55696,57600,61504,65536,69696,73984,78400,82944,87616,92416,102400,112896,123904,135424,147456,160000,173056,186624,215296,246016,278784,313600,350464,389376,430336,30973.25,30972.906,30972.562,30972.219,30971.875,30971.531,30971.188,30970.844,30970.5,30969.812,30969.125,30968.438,30967.75,30967.062,30966.375,30965.688,30965,30963.627,30962.252,30960.877,30959.502,30958.127,30956.754,30955.379,30954.004,30951.256,30948.506,30945.758,30943.008,30940.26,30937.512,30934.764,30932.016,30926.52,30921.023,30915.53,30910.035,30904.541,30899.047,30893.555,30888.062,30877.078,30866.098,30855.117,30844.14,30833.164,30822.191,30811.219,30800.25,30778.316,30756.39,30734.473,30712.562,30690.66,30668.766,30646.879,30625,30581.266,30537.562,30493.89,30450.25,30406.64,30363.062,30319.516,30276,30189.062,30102.25,30015.562,29929,29842.562,29756.25,29670.062,29584,29412.25,29241,29070.25,28900,28730.25,28561,28392.25,28224,27889,27556,27225,26896,26569,26244,25921,25600,24964,24336,23716,23104,22500,21904,21316,20736,19600,18496,17424,16384,15376,14400,13456,12544,10816,9216,7744,6400,5184,4096,3136,2304,1024,256,0,256,1024,2304,4096,6400,12544,20736,30976,43264,57600,73984,92416,36864,36867.375,36867.75,36868.125,36868.5,36868.875,36869.25,36869.625,36870,36870.75,36871.5,36872.25,36873,36873.75,36874.5,36875.25,36876,36877.5,36879,36880.5,36882.004,36883.504,36885.004,36886.504,36888.004,36891.004,36894.008,36897.008,36900.008,36903.01,36906.01,36909.016,36912.016,36918.02,36924.023,36930.03,36936.035,36942.043,36948.047,36954.055,36960.062,36972.08,36984.098,36996.117,37008.14,37020.164,37032.19,37044.22,37056.25,37080.316,37104.39,37128.473,37152.562,37176.66,37200.766,37224.88,37249,37297.266,37345.562,37393.89,37442.25,37490.64,37539.062,37587.516,37636,37733.062,37830.25,37927.562,38025,38122.562,38220.25,38318.062,38416,38612.25,38809,39006.25,39204,39402.25,39601,39800.25,40000,40401,40804,41209,41616,42025,42436,42849,43264,44100,44944,45796,46656,47524,48400,49284,50176,51984,53824,55696,57600,59536,61504,63504,65536,69696,73984,78400,82944,87616,92416,97344,102400,112896,123904,135424,147456,160000,173056,186624,200704,230400,262144,295936,331776,369664,409600,451584,36861,36860.625,36860.25,36859.875,36859.5,36859.125,36858.75,36858.375,36858,36857.25,36856.5,36855.75,36855,36854.25,36853.5,36852.75,36852,36850.5,36849,36847.5,36846.004,36844.504,36843.004,36841.504,36840.004,36837.004,36834.008,36831.008,36828.008,36825.01,36822.01,36819.016,36816.016,36810.02,36804.023,36798.03,36792.035,36786.043,36780.047,36774.055,36768.062,36756.08,36744.098,36732.117,36720.14,36708.164,36696.19,36684.22,36672.25,36648.316,36624.39,36600.473,36576.562,36552.66,36528.766,36504.88,36481,36433.266,36385.562,36337.89,36290.25,36242.64,36195.062,36147.516,36100,36005.062,35910.25,35815.562,35721,35626.562,35532.25,35438.062,35344,35156.25,34969,34782.25,34596,34410.25,34225,34040.25,33856,33489,33124,32761,32400,32041,31684,31329,30976,30276,29584,28900,28224,27556,26896,26244,25600,24336,23104,21904,20736,19600,18496,17424,16384,14400,12544,10816,9216,7744,6400,5184,4096,2304,1024,256,0,256,1024,2304,4096,9216,16384,25600,36864,50176,65536,82944,43264,43267.656,43268.062,43268.47,43268.875,43269.28,43269.688,43270.094,43270.5,43271.312,43272.125,43272.938,43273.75,43274.562,43275.375,43276.188,43277,43278.625,43280.25,43281.875,43283.504,43285.13,43286.754,43288.38,43290.004,43293.254,43296.508,43299.758,43303.008,43306.26,43309.51,43312.766,43316.016,43322.52,43329.023,43335.53,43342.035,43348.543,43355.047,43361.555,43368.062,43381.08,43394.098,43407.117,43420.14,43433.164,43446.19,43459.22,43472.25,43498.316,43524.39,43550.473,43576.562,43602.66,43628.766,43654.88,43681,43733.266,43785.562,43837.89,43890.25,43942.64,43995.062,44047.516,44100,44205.062,44310.25,44415.562,44521,44626.562,44732.25,44838.062,44944,45156.25,45369,45582.25,45796,46010.25,46225,46440.25,46656,47089,47524,47961,48400,48841,49284,49729,50176,51076,51984,52900,53824,54756,55696,56644,57600,59536,61504,63504,65536,67600,69696,71824,73984,78400,82944,87616,92416,97344,102400,107584,112896,123904,135424,147456,160000,173056,186624,200704,215296,246016,278784,313600,350464,389376,430336,473344,43260.75,43260.344,43259.938,43259.53,43259.125,43258.72,43258.312,43257.906,43257.5,43256.688,43255.875,43255.062,43254.25,43253.438,43252.625,43251.812,43251,43249.375,43247.75,43246.125,43244.504,43242.88,43241.254,43239.63,43238.004,43234.754,43231.508,43228.258,43225.008,43221.76,43218.51,43215.266,43212.016,43205.52,43199.023,43192.53,43186.035,43179.543,43173.047,43166.555,43160.062,43147.08,43134.098,43121.117,43108.14,43095.164,43082.19,43069.22,43056.25,43030.316,43004.39,42978.473,42952.562,42926.66,42900.766,42874.88,42849,42797.266,42745.562,42693.89,42642.25,42590.64,42539.062,42487.516,42436,42333.062,42230.25,42127.562,42025,41922.562,41820.25,41718.062,41616,41412.25,41209,41006.25,40804,40602.25,40401,40200.25,40000,39601,39204,38809,38416,38025,37636,37249,36864,36100,35344,34596,33856,33124,32400,31684,30976,29584,28224,26896,25600,24336,23104,21904,20736,18496,16384,14400,12544,10816,9216,7744,6400,4096,2304,1024,256,0,256,1024,2304,6400,12544,20736,30976,43264,57600,73984,50176,50179.938,50180.375,50180.812,50181.25,50181.688,50182.125,50182.562,50183,50183.875,50184.75,50185.625,50186.5,50187.375,50188.25,50189.125,50190,50191.75,50193.5,50195.25,50197.004,50198.754,50200.504,50202.254,50204.004,50207.504,50211.008,50214.508,50218.008,50221.51,50225.01,50228.516,50232.016,50239.02,50246.023,50253.03,50260.035,50267.043,50274.047,50281.055,50288.062,50302.08,50316.098,50330.117,50344.14,50358.164,50372.19,50386.22,50400.25,50428.316,50456.39,50484.473,50512.562,50540.66,50568.766,50596.88,50625,50681.266,50737.562,50793.89,50850.25,50906.64,50963.062,51019.516,51076,51189.062,51302.25,51415.562,51529,51642.562,51756.25,51870.062,51984,52212.25,52441,52670.25,52900,53130.25,53361,53592.25,53824,54289,54756,55225,55696,56169,56644,57121,57600,58564,59536,60516,61504,62500,63504,64516,65536,67600,69696,71824,73984,76176,78400,80656,82944,87616,92416,97344,102400,107584,112896,118336,123904,135424,147456,160000,173056,186624,200704,215296,230400,262144,295936,331776,369664,409600,451584,495616,50172.5,50172.062,50171.625,50171.188,50170.75,50170.312,50169.875,50169.438,50169,50168.125,50167.25,50166.375,50165.5,50164.625,50163.75,50162.875,50162,50160.25,50158.5,50156.75,50155.004,50153.254,50151.504,50149.754,50148.004,50144.504,50141.008,50137.508,50134.008,50130.51,50127.01,50123.516,50120.016,50113.02,50106.023,50099.03,50092.035,50085.043,50078.047,50071.055,50064.062,50050.08,50036.098,50022.117,50008.14,49994.164,49980.19,49966.22,49952.25,49924.316,49896.39,49868.473,49840.562,49812.66,49784.766,49756.88,49729,49673.266,49617.562,49561.89,49506.25,49450.64,49395.062,49339.516,49284,49173.062,49062.25,48951.562,48841,48730.562,48620.25,48510.062,48400,48180.25,47961,47742.25,47524,47306.25,47089,46872.25,46656,46225,45796,45369,44944,44521,44100,43681,43264,42436,41616,40804,40000,39204,38416,37636,36864,35344,33856,32400,30976,29584,28224,26896,25600,23104,20736,18496,16384,14400,12544,10816,9216,6400,4096,2304,1024,256,0,256,1024,4096,9216,16384,25600,36864,50176,65536,57600,57604.22,57604.688,57605.156,57605.625,57606.094,57606.562,57607.03,57607.5,57608.438,57609.375,57610.312,57611.25,57612.188,57613.125,57614.062,57615,57616.875,57618.75,57620.625,57622.504,57624.38,57626.254,57628.13,57630.004,57633.754,57637.508,57641.258,57645.008,57648.76,57652.51,57656.266,57660.016,57667.52,57675.023,57682.53,57690.035,57697.543,57705.047,57712.555,57720.062,57735.08,57750.098,57765.117,57780.14,57795.164,57810.19,57825.22,57840.25,57870.316,57900.39,57930.473,57960.562,57990.66,58020.766,58050.88,58081,58141.266,58201.562,58261.89,58322.25,58382.64,58443.062,58503.516,58564,58685.062,58806.25,58927.562,59049,59170.562,59292.25,59414.062,59536,59780.25,60025,60270.25,60516,60762.25,61009,61256.25,61504,62001,62500,63001,63504,64009,64516,65025,65536,66564,67600,68644,69696,70756,71824,72900,73984,76176,78400,80656,82944,85264,87616,90000,92416,97344,102400,107584,112896,118336,123904,129600,135424,147456,160000,173056,186624,200704,215296,230400,246016,278784,313600,350464,389376,430336,473344,518400,57596.25,57595.78,57595.312,57594.844,57594.375,57593.906,57593.438,57592.97,57592.5,57591.562,57590.625,57589.688,57588.75,57587.812,57586.875,57585.938,57585,57583.125,57581.25,57579.375,57577.504,57575.63,57573.754,57571.88,57570.004,57566.254,57562.508,57558.758,57555.008,57551.26,57547.51,57543.766,57540.016,57532.52,57525.023,57517.53,57510.035,57502.543,57495.047,57487.555,57480.062,57465.08,57450.098,57435.117,57420.14,57405.164,57390.19,57375.22,57360.25,57330.316,57300.39,57270.473,57240.562,57210.66,57180.766,57150.88,57121,57061.266,57001.562,56941.89,56882.25,56822.64,56763.062,56703.516,56644,56525.062,56406.25,56287.562,56169,56050.562,55932.25,55814.062,55696,55460.25,55225,54990.25,54756,54522.25,54289,54056.25,53824,53361,52900,52441,51984,51529,51076,50625,50176,49284,48400,47524,46656,45796,44944,44100,43264,41616,40000,38416,36864,35344,33856,32400,30976,28224,25600,23104,20736,18496,16384,14400,12544,9216,6400,4096,2304,1024,256,0,256,2304,6400,12544,20736,30976,43264,57600,65536,65540.5,65541,65541.5,65542,65542.5,65543,65543.5,65544,65545,65546,65547,65548,65549,65550,65551,65552,65554,65556,65558,65560,65562,65564,65566,65568,65572.01,65576.01,65580.01,65584.01,65588.01,65592.016,65596.016,65600.016,65608.02,65616.02,65624.03,65632.03,65640.04,65648.05,65656.055,65664.06,65680.08,65696.09,65712.12,65728.14,65744.164,65760.19,65776.22,65792.25,65824.31,65856.39,65888.47,65920.56,65952.66,65984.766,66016.875,66049,66113.266,66177.56,66241.89,66306.25,66370.64,66435.06,66499.516,66564,66693.06,66822.25,66951.56,67081,67210.56,67340.25,67470.06,67600,67860.25,68121,68382.25,68644,68906.25,69169,69432.25,69696,70225,70756,71289,71824,72361,72900,73441,73984,75076,76176,77284,78400,79524,80656,81796,82944,85264,87616,90000,92416,94864,97344,99856,102400,107584,112896,118336,123904,129600,135424,141376,147456,160000,173056,186624,200704,215296,230400,246016,262144,295936,331776,369664,409600,451584,495616,541696,65532,65531.5,65531,65530.5,65530,65529.5,65529,65528.5,65528,65527,65526,65525,65524,65523,65522,65521,65520,65518,65516,65514,65512.004,65510.004,65508.004,65506.004,65504.004,65500.004,65496.008,65492.008,65488.008,65484.01,65480.01,65476.016,65472.016,65464.02,65456.023,65448.03,65440.035,65432.043,65424.047,65416.055,65408.062,65392.08,65376.098,65360.117,65344.14,65328.164,65312.19,65296.22,65280.25,65248.316,65216.39,65184.473,65152.562,65120.66,65088.766,65056.88,65025,64961.266,64897.562,64833.89,64770.25,64706.64,64643.062,64579.516,64516,64389.062,64262.25,64135.562,64009,63882.562,63756.25,63630.062,63504,63252.25,63001,62750.25,62500,62250.25,62001,61752.25,61504,61009,60516,60025,59536,59049,58564,58081,57600,56644,55696,54756,53824,52900,51984,51076,50176,48400,46656,44944,43264,41616,40000,38416,36864,33856,30976,28224,25600,23104,20736,18496,16384,12544,9216,6400,4096,2304,1024,256,0,1024,4096,9216,16384,25600,36864,50176,82944,82949.06,82949.625,82950.19,82950.75,82951.31,82951.875,82952.44,82953,82954.125,82955.25,82956.375,82957.5,82958.625,82959.75,82960.875,82962,82964.25,82966.5,82968.75,82971,82973.25,82975.5,82977.75,82980,82984.51,82989.01,82993.51,82998.01,83002.51,83007.016,83011.516,83016.016,83025.02,83034.02,83043.03,83052.03,83061.04,83070.05,83079.055,83088.06,83106.08,83124.09,83142.12,83160.14,83178.164,83196.19,83214.22,83232.25,83268.31,83304.39,83340.47,83376.56,83412.66,83448.766,83484.875,83521,83593.266,83665.56,83737.89,83810.25,83882.64,83955.06,84027.516,84100,84245.06,84390.25,84535.56,84681,84826.56,84972.25,85118.06,85264,85556.25,85849,86142.25,86436,86730.25,87025,87320.25,87616,88209,88804,89401,90000,90601,91204,91809,92416,93636,94864,96100,97344,98596,99856,101124,102400,104976,107584,110224,112896,115600,118336,121104,123904,129600,135424,141376,147456,153664,160000,166464,173056,186624,200704,215296,230400,246016,262144,278784,295936,331776,369664,409600,451584,495616,541696,589824,82939.5,82938.94,82938.375,82937.81,82937.25,82936.69,82936.125,82935.56,82935,82933.875,82932.75,82931.625,82930.5,82929.375,82928.25,82927.125,82926,82923.75,82921.5,82919.25,82917,82914.75,82912.5,82910.25,82908,82903.51,82899.01,82894.51,82890.01,82885.51,82881.016,82876.516,82872.016,82863.02,82854.02,82845.03,82836.03,82827.04,82818.05,82809.055,82800.06,82782.08,82764.09,82746.12,82728.14,82710.164,82692.19,82674.22,82656.25,82620.31,82584.39,82548.47,82512.56,82476.66,82440.766,82404.875,82369,82297.266,82225.56,82153.89,82082.25,82010.64,81939.06,81867.516,81796,81653.06,81510.25,81367.56,81225,81082.56,80940.25,80798.06,80656,80372.25,80089,79806.25,79524,79242.25,78961,78680.25,78400,77841,77284,76729,76176,75625,75076,74529,73984,72900,71824,70756,69696,68644,67600,66564,65536,63504,61504,59536,57600,55696,53824,51984,50176,46656,43264,40000,36864,33856,30976,28224,25600,20736,16384,12544,9216,6400,4096,2304,1024,0,1024,4096,9216,16384,25600,36864,102400,102405.625,102406.25,102406.875,102407.5,102408.125,102408.75,102409.375,102410,102411.25,102412.5,102413.75,102415,102416.25,102417.5,102418.75,102420,102422.5,102425,102427.5,102430,102432.5,102435,102437.5,102440,102445.01,102450.01,102455.01,102460.01,102465.01,102470.016,102475.016,102480.016,102490.02,102500.02,102510.03,102520.03,102530.04,102540.05,102550.055,102560.06,102580.08,102600.09,102620.12,102640.14,102660.164,102680.19,102700.22,102720.25,102760.31,102800.39,102840.47,102880.56,102920.66,102960.766,103000.875,103041,103121.266,103201.56,103281.89,103362.25,103442.64,103523.06,103603.516,103684,103845.06,104006.25,104167.56,104329,104490.56,104652.25,104814.06,104976,105300.25,105625,105950.25,106276,106602.25,106929,107256.25,107584,108241,108900,109561,110224,110889,111556,112225,112896,114244,115600,116964,118336,119716,121104,122500,123904,126736,129600,132496,135424,138384,141376,144400,147456,153664,160000,166464,173056,179776,186624,193600,200704,215296,230400,246016,262144,278784,295936,313600,331776,369664,409600,451584,495616,541696,589824,640000,102395,102394.375,102393.75,102393.125,102392.5,102391.875,102391.25,102390.625,102390,102388.75,102387.5,102386.25,102385,102383.75,102382.5,102381.25,102380,102377.5,102375,102372.5,102370,102367.5,102365,102362.5,102360,102355.01,102350.01,102345.01,102340.01,102335.01,102330.016,102325.016,102320.016,102310.02,102300.02,102290.03,102280.03,102270.04,102260.05,102250.055,102240.06,102220.08,102200.09,102180.12,102160.14,102140.164,102120.19,102100.22,102080.25,102040.31,102000.39,101960.47,101920.56,101880.66,101840.766,101800.875,101761,101681.266,101601.56,101521.89,101442.25,101362.64,101283.06,101203.516,101124,100965.06,100806.25,100647.56,100489,100330.56,100172.25,100014.06,99856,99540.25,99225,98910.25,98596,98282.25,97969,97656.25,97344,96721,96100,95481,94864,94249,93636,93025,92416,91204,90000,88804,87616,86436,85264,84100,82944,80656,78400,76176,73984,71824,69696,67600,65536,61504,57600,53824,50176,46656,43264,40000,36864,30976,25600,20736,16384,12544,9216,6400,4096,1024,0,1024,4096,9216,16384,25600,123904,123910.19,123910.875,123911.56,123912.25,123912.94,123913.625,123914.31,123915,123916.375,123917.75,123919.125,123920.5,123921.875,123923.25,123924.625,123926,123928.75,123931.5,123934.25,123937,123939.75,123942.5,123945.25,123948,123953.51,123959.01,123964.51,123970.01,123975.51,123981.016,123986.516,123992.016,124003.02,124014.02,124025.03,124036.03,124047.04,124058.05,124069.055,124080.06,124102.08,124124.09,124146.12,124168.14,124190.164,124212.19,124234.22,124256.25,124300.31,124344.39,124388.47,124432.56,124476.66,124520.766,124564.875,124609,124697.266,124785.56,124873.89,124962.25,125050.64,125139.06,125227.516,125316,125493.06,125670.25,125847.56,126025,126202.56,126380.25,126558.06,126736,127092.25,127449,127806.25,128164,128522.25,128881,129240.25,129600,130321,131044,131769,132496,133225,133956,134689,135424,136900,138384,139876,141376,142884,144400,145924,147456,150544,153664,156816,160000,163216,166464,169744,173056,179776,186624,193600,200704,207936,215296,222784,230400,246016,262144,278784,295936,313600,331776,350464,369664,409600,451584,495616,541696,589824,640000,692224,123898.5,123897.81,123897.125,123896.44,123895.75,123895.06,123894.375,123893.69,123893,123891.625,123890.25,123888.875,123887.5,123886.125,123884.75,123883.375,123882,123879.25,123876.5,123873.75,123871,123868.25,123865.5,123862.75,123860,123854.51,123849.01,123843.51,123838.01,123832.51,123827.016,123821.516,123816.016,123805.02,123794.02,123783.03,123772.03,123761.04,123750.05,123739.055,123728.06,123706.08,123684.09,123662.12,123640.14,123618.164,123596.19,123574.22,123552.25,123508.31,123464.39,123420.47,123376.56,123332.66,123288.766,123244.875,123201,123113.266,123025.56,122937.89,122850.25,122762.64,122675.06,122587.516,122500,122325.06,122150.25,121975.56,121801,121626.56,121452.25,121278.06,121104,120756.25,120409,120062.25,119716,119370.25,119025,118680.25,118336,117649,116964,116281,115600,114921,114244,113569,112896,111556,110224,108900,107584,106276,104976,103684,102400,99856,97344,94864,92416,90000,87616,85264,82944,78400,73984,69696,65536,61504,57600,53824,50176,43264,36864,30976,25600,20736,16384,12544,9216,4096,1024,0,1024,4096,9216,16384,147456,147462.75,147463.5,147464.25,147465,147465.75,147466.5,147467.25,147468,147469.5,147471,147472.5,147474,147475.5,147477,147478.5,147480,147483,147486,147489,147492,147495,147498,147501,147504,147510,147516,147522,147528.02,147534.02,147540.02,147546.02,147552.02,147564.02,147576.03,147588.03,147600.03,147612.05,147624.05,147636.06,147648.06,147672.08,147696.1,147720.12,147744.14,147768.17,147792.19,147816.22,147840.25,147888.31,147936.39,147984.47,148032.56,148080.66,148128.77,148176.88,148225,148321.27,148417.56,148513.89,148610.25,148706.64,148803.06,148899.52,148996,149189.06,149382.25,149575.56,149769,149962.56,150156.25,150350.06,150544,150932.25,151321,151710.25,152100,152490.25,152881,153272.25,153664,154449,155236,156025,156816,157609,158404,159201,160000,161604,163216,164836,166464,168100,169744,171396,173056,176400,179776,183184,186624,190096,193600,197136,200704,207936,215296,222784,230400,238144,246016,254016,262144,278784,295936,313600,331776,350464,369664,389376,409600,451584,495616,541696,589824,640000,692224,746496,147450,147449.25,147448.5,147447.75,147447,147446.25,147445.5,147444.75,147444,147442.5,147441,147439.5,147438,147436.5,147435,147433.5,147432,147429,147426,147423,147420,147417,147414,147411,147408,147402,147396,147390,147384.02,147378.02,147372.02,147366.02,147360.02,147348.02,147336.03,147324.03,147312.03,147300.05,147288.05,147276.06,147264.06,147240.08,147216.1,147192.12,147168.14,147144.17,147120.19,147096.22,147072.25,147024.31,146976.39,146928.47,146880.56,146832.66,146784.77,146736.88,146689,146593.27,146497.56,146401.89,146306.25,146210.64,146115.06,146019.52,145924,145733.06,145542.25,145351.56,145161,144970.56,144780.25,144590.06,144400,144020.25,143641,143262.25,142884,142506.25,142129,141752.25,141376,140625,139876,139129,138384,137641,136900,136161,135424,133956,132496,131044,129600,128164,126736,125316,123904,121104,118336,115600,112896,110224,107584,104976,102400,97344,92416,87616,82944,78400,73984,69696,65536,57600,50176,43264,36864,30976,25600,20736,16384,9216,4096,1024,0,1024,4096,9216,173056,173063.31,173064.12,173064.94,173065.75,173066.56,173067.38,173068.19,173069,173070.62,173072.25,173073.88,173075.5,173077.12,173078.75,173080.38,173082,173085.25,173088.5,173091.75,173095,173098.25,173101.5,173104.75,173108,173114.5,173121,173127.5,173134.02,173140.52,173147.02,173153.52,173160.02,173173.02,173186.03,173199.03,173212.03,173225.05,173238.05,173251.06,173264.06,173290.08,173316.1,173342.12,173368.14,173394.17,173420.19,173446.22,173472.25,173524.31,173576.39,173628.47,173680.56,173732.66,173784.77,173836.88,173889,173993.27,174097.56,174201.89,174306.25,174410.64,174515.06,174619.52,174724,174933.06,175142.25,175351.56,175561,175770.56,175980.25,176190.06,176400,176820.25,177241,177662.25,178084,178506.25,178929,179352.25,179776,180625,181476,182329,183184,184041,184900,185761,186624,188356,190096,191844,193600,195364,197136,198916,200704,204304,207936,211600,215296,219024,222784,226576,230400,238144,246016,254016,262144,270400,278784,287296,295936,313600,331776,350464,369664,389376,409600,430336,451584,495616,541696,589824,640000,692224,746496,802816,173049.5,173048.69,173047.88,173047.06,173046.25,173045.44,173044.62,173043.81,173043,173041.38,173039.75,173038.12,173036.5,173034.88,173033.25,173031.62,173030,173026.75,173023.5,173020.25,173017,173013.75,173010.5,173007.25,173004,172997.5,172991,172984.5,172978.02,172971.52,172965.02,172958.52,172952.02,172939.02,172926.03,172913.03,172900.03,172887.05,172874.05,172861.06,172848.06,172822.08,172796.1,172770.12,172744.14,172718.17,172692.19,172666.22,172640.25,172588.31,172536.39,172484.47,172432.56,172380.66,172328.77,172276.88,172225,172121.27,172017.56,171913.89,171810.25,171706.64,171603.06,171499.52,171396,171189.06,170982.25,170775.56,170569,170362.56,170156.25,169950.06,169744,169332.25,168921,168510.25,168100,167690.25,167281,166872.25,166464,165649,164836,164025,163216,162409,161604,160801,160000,158404,156816,155236,153664,152100,150544,148996,147456,144400,141376,138384,135424,132496,129600,126736,123904,118336,112896,107584,102400,97344,92416,87616,82944,73984,65536,57600,50176,43264,36864,30976,25600,16384,9216,4096,1024,0,1024,4096,200704,200711.88,200712.75,200713.62,200714.5,200715.38,200716.25,200717.12,200718,200719.75,200721.5,200723.25,200725,200726.75,200728.5,200730.25,200732,200735.5,200739,200742.5,200746,200749.5,200753,200756.5,200760,200767,200774,200781,200788.02,200795.02,200802.02,200809.02,200816.02,200830.02,200844.03,200858.03,200872.03,200886.05,200900.05,200914.06,200928.06,200956.08,200984.1,201012.12,201040.14,201068.17,201096.19,201124.22,201152.25,201208.31,201264.39,201320.47,201376.56,201432.66,201488.77,201544.88,201601,201713.27,201825.56,201937.89,202050.25,202162.64,202275.06,202387.52,202500,202725.06,202950.25,203175.56,203401,203626.56,203852.25,204078.06,204304,204756.25,205209,205662.25,206116,206570.25,207025,207480.25,207936,208849,209764,210681,211600,212521,213444,214369,215296,217156,219024,220900,222784,224676,226576,228484,230400,234256,238144,242064,246016,250000,254016,258064,262144,270400,278784,287296,295936,304704,313600,322624,331776,350464,369664,389376,409600,430336,451584,473344,495616,541696,589824,640000,692224,746496,802816,861184,200697,200696.12,200695.25,200694.38,200693.5,200692.62,200691.75,200690.88,200690,200688.25,200686.5,200684.75,200683,200681.25,200679.5,200677.75,200676,200672.5,200669,200665.5,200662,200658.5,200655,200651.5,200648,200641,200634,200627,200620.02,200613.02,200606.02,200599.02,200592.02,200578.02,200564.03,200550.03,200536.03,200522.05,200508.05,200494.06,200480.06,200452.08,200424.1,200396.12,200368.14,200340.17,200312.19,200284.22,200256.25,200200.31,200144.39,200088.47,200032.56,199976.66,199920.77,199864.88,199809,199697.27,199585.56,199473.89,199362.25,199250.64,199139.06,199027.52,198916,198693.06,198470.25,198247.56,198025,197802.56,197580.25,197358.06,197136,196692.25,196249,195806.25,195364,194922.25,194481,194040.25,193600,192721,191844,190969,190096,189225,188356,187489,186624,184900,183184,181476,179776,178084,176400,174724,173056,169744,166464,163216,160000,156816,153664,150544,147456,141376,135424,129600,123904,118336,112896,107584,102400,92416,82944,73984,65536,57600,50176,43264,36864,25600,16384,9216,4096,1024,0,1024,230400,230408.44,230409.38,230410.31,230411.25,230412.19,230413.12,230414.06,230415,230416.88,230418.75,230420.62,230422.5,230424.38,230426.25,230428.12,230430,230433.75,230437.5,230441.25,230445,230448.75,230452.5,230456.25,230460,230467.5,230475,230482.5,230490.02,230497.52,230505.02,230512.52,230520.02,230535.02,230550.03,230565.03,230580.03,230595.05,230610.05,230625.06,230640.06,230670.08,230700.1,230730.12,230760.14,230790.17,230820.19,230850.22,230880.25,230940.31,231000.39,231060.47,231120.56,231180.66,231240.77,231300.88,231361,231481.27,231601.56,231721.89,231842.25,231962.64,232083.06,232203.52,232324,232565.06,232806.25,233047.56,233289,233530.56,233772.25,234014.06,234256,234740.25,235225,235710.25,236196,236682.25,237169,237656.25,238144,239121,240100,241081,242064,243049,244036,245025,246016,248004,250000,252004,254016,256036,258064,260100,262144,266256,270400,274576,278784,283024,287296,291600,295936,304704,313600,322624,331776,341056,350464,360000,369664,389376,409600,430336,451584,473344,495616,518400,541696,589824,640000,692224,746496,802816,861184,921600,230392.5,230391.56,230390.62,230389.69,230388.75,230387.81,230386.88,230385.94,230385,230383.12,230381.25,230379.38,230377.5,230375.62,230373.75,230371.88,230370,230366.25,230362.5,230358.75,230355,230351.25,230347.5,230343.75,230340,230332.5,230325,230317.5,230310.02,230302.52,230295.02,230287.52,230280.02,230265.02,230250.03,230235.03,230220.03,230205.05,230190.05,230175.06,230160.06,230130.08,230100.1,230070.12,230040.14,230010.17,229980.19,229950.22,229920.25,229860.31,229800.39,229740.47,229680.56,229620.66,229560.77,229500.88,229441,229321.27,229201.56,229081.89,228962.25,228842.64,228723.06,228603.52,228484,228245.06,228006.25,227767.56,227529,227290.56,227052.25,226814.06,226576,226100.25,225625,225150.25,224676,224202.25,223729,223256.25,222784,221841,220900,219961,219024,218089,217156,216225,215296,213444,211600,209764,207936,206116,204304,202500,200704,197136,193600,190096,186624,183184,179776,176400,173056,166464,160000,153664,147456,141376,135424,129600,123904,112896,102400,92416,82944,73984,65536,57600,50176,36864,25600,16384,9216,4096,1024,0}
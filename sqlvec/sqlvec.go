@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package sqlvec persists float8 vectors through database/sql as BLOB/bytea.
+// The stored value is a 4 bytes header followed by float8 bytes:
+//
+//	'F' '8' version format | payload ...
+package sqlvec
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/kshard/float8"
+)
+
+const (
+	headerLen  = 4
+	version    = 1
+	formatE4M3 = 0
+)
+
+// Vector of float8 values implementing driver.Valuer and sql.Scanner.
+// The nil vector is stored as NULL.
+type Vector []float8.Float8
+
+var (
+	_ driver.Valuer = Vector(nil)
+	_ sql.Scanner   = (*Vector)(nil)
+)
+
+// Value implements driver.Valuer
+func (v Vector) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	buf := make([]byte, headerLen+len(v))
+	buf[0], buf[1], buf[2], buf[3] = 'F', '8', version, formatE4M3
+	copy(buf[headerLen:], v)
+
+	return buf, nil
+}
+
+// Scan implements sql.Scanner
+func (v *Vector) Scan(src any) error {
+	switch x := src.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case []byte:
+		return v.decode(x)
+	case string:
+		return v.decode([]byte(x))
+	default:
+		return fmt.Errorf("sqlvec: unsupported type %T", src)
+	}
+}
+
+func (v *Vector) decode(buf []byte) error {
+	if len(buf) < headerLen || buf[0] != 'F' || buf[1] != '8' {
+		return errors.New("sqlvec: malformed vector")
+	}
+
+	if buf[2] != version {
+		return fmt.Errorf("sqlvec: unsupported version %d", buf[2])
+	}
+
+	if buf[3] != formatE4M3 {
+		return fmt.Errorf("sqlvec: unsupported format %d", buf[3])
+	}
+
+	// the driver might reuse buffer, the vector requires own copy
+	*v = make(Vector, len(buf)-headerLen)
+	copy(*v, buf[headerLen:])
+
+	return nil
+}
@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package sqlvec_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kshard/float8/sqlvec"
+)
+
+func TestValueScan(t *testing.T) {
+	v := sqlvec.Vector{0x00, 0x38, 0xb8, 0x7f}
+
+	val, err := v.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := val.([]byte)
+	if !bytes.Equal(buf[:2], []byte("F8")) || len(buf) != 4+len(v) {
+		t.Errorf("unexpected encoding %x", buf)
+	}
+
+	var x sqlvec.Vector
+	if err := x.Scan(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(x, v) {
+		t.Errorf("got=%v expected=%v", x, v)
+	}
+
+	// decoded vector does not share buffer with driver
+	buf[4] = 0xff
+	if x[0] != 0x00 {
+		t.Errorf("vector shares buffer")
+	}
+}
+
+func TestNull(t *testing.T) {
+	val, err := sqlvec.Vector(nil).Value()
+	if err != nil || val != nil {
+		t.Errorf("nil vector is not NULL: %v", val)
+	}
+
+	x := sqlvec.Vector{0x38}
+	if err := x.Scan(nil); err != nil || x != nil {
+		t.Errorf("NULL is not scanned as nil vector")
+	}
+}
+
+func TestScanMalformed(t *testing.T) {
+	var x sqlvec.Vector
+	for _, src := range []any{[]byte("F"), []byte("XX\x01\x00"), []byte("F8\x02\x00"), 42} {
+		if err := x.Scan(src); err == nil {
+			t.Errorf("error is expected for %v", src)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for sqr of float8
+//
+
+var sqr = [0x100]uint8{0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x2,0x4,0x6,0x8,0xa,0xc,0xf,0x11,0x12,0x14,0x16,0x18,0x1a,0x1c,0x1f,0x21,0x22,0x24,0x26,0x28,0x2a,0x2c,0x2f,0x31,0x32,0x34,0x36,0x38,0x3a,0x3c,0x3f,0x41,0x42,0x44,0x46,0x48,0x4a,0x4c,0x4f,0x51,0x52,0x54,0x56,0x58,0x5a,0x5c,0x5f,0x61,0x62,0x64,0x66,0x68,0x6a,0x6c,0x6f,0x71,0x72,0x74,0x76,0x78,0x7a,0x7c,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x1,0x2,0x4,0x6,0x8,0xa,0xc,0xf,0x11,0x12,0x14,0x16,0x18,0x1a,0x1c,0x1f,0x21,0x22,0x24,0x26,0x28,0x2a,0x2c,0x2f,0x31,0x32,0x34,0x36,0x38,0x3a,0x3c,0x3f,0x41,0x42,0x44,0x46,0x48,0x4a,0x4c,0x4f,0x51,0x52,0x54,0x56,0x58,0x5a,0x5c,0x5f,0x61,0x62,0x64,0x66,0x68,0x6a,0x6c,0x6f,0x71,0x72,0x74,0x76,0x78,0x7a,0x7c,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f,0x7f}
+
+// Square (x²) of float8, it is identical to Mul(x, x)
+func Sqr(a Float8) Float8 { return sqr[a] }
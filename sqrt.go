@@ -0,0 +1,11 @@
+// DO NOT EDIT! Use cmd to regenerate it.
+package float8
+
+//
+// The code book for sqrt of float8
+//
+
+var sqrt = [0x100]uint8{0x0,0x1c,0x1c,0x1d,0x1d,0x1e,0x1e,0x1f,0x20,0x20,0x20,0x21,0x21,0x22,0x22,0x22,0x23,0x24,0x24,0x25,0x25,0x26,0x26,0x27,0x28,0x28,0x28,0x29,0x29,0x2a,0x2a,0x2a,0x2b,0x2c,0x2c,0x2d,0x2d,0x2e,0x2e,0x2f,0x30,0x30,0x30,0x31,0x31,0x32,0x32,0x32,0x33,0x34,0x34,0x35,0x35,0x36,0x36,0x37,0x38,0x38,0x38,0x39,0x39,0x3a,0x3a,0x3a,0x3b,0x3c,0x3c,0x3d,0x3d,0x3e,0x3e,0x3f,0x40,0x40,0x40,0x41,0x41,0x42,0x42,0x42,0x43,0x44,0x44,0x45,0x45,0x46,0x46,0x47,0x48,0x48,0x48,0x49,0x49,0x4a,0x4a,0x4a,0x4b,0x4c,0x4c,0x4d,0x4d,0x4e,0x4e,0x4f,0x50,0x50,0x50,0x51,0x51,0x52,0x52,0x52,0x53,0x54,0x54,0x55,0x55,0x56,0x56,0x57,0x58,0x58,0x58,0x59,0x59,0x5a,0x5a,0x5a,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0,0x0}
+
+// Square root of float8
+func Sqrt(a Float8) Float8 { return sqrt[a] }
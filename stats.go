@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// Histogram counts occurrences of each float8 value, the domain has only
+// 256 values, so histogram is exact and indexed by bit pattern.
+func Histogram(data []Float8) [256]int {
+	var h [256]int
+	for _, f8 := range data {
+		h[f8]++
+	}
+	return h
+}
+
+// Quantile returns exact q-quantile of data in O(n) using Histogram.
+// It is the smallest value x such that at least ⌈q·n⌉ values are ≤ x
+// (nearest rank method), q = 0 gives minimum and q = 1 gives maximum.
+// It returns NaN for empty data and panics if q is not in [0, 1].
+func Quantile(data []Float8, q float64) float32 {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		panic("quantile must be in range [0, 1]")
+	}
+
+	if len(data) == 0 {
+		return float32(math.NaN())
+	}
+
+	h := Histogram(data)
+	k := max(int(math.Ceil(q*float64(len(data)))), 1)
+
+	// bit patterns in numeric order: negative values from -∞, then positive
+	seen := 0
+	for f8 := 0xff; f8 >= signMask; f8-- {
+		if seen += h[f8]; seen >= k {
+			return f8tof32[f8]
+		}
+	}
+	for f8 := 0x00; f8 < signMask; f8++ {
+		if seen += h[f8]; seen >= k {
+			return f8tof32[f8]
+		}
+	}
+
+	return f8tof32[Infinity]
+}
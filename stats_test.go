@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	h := Histogram([]Float8{0x38, 0x38, 0xb8, 0x00})
+	if h[0x38] != 2 || h[0xb8] != 1 || h[0x00] != 1 || h[0x40] != 0 {
+		t.Errorf("unexpected histogram")
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	data := make([]Float8, 1001)
+	FillRandom(r, data)
+
+	sorted := make([]float32, len(data))
+	for i, f8 := range data {
+		sorted[i] = ToFloat32(f8)
+	}
+	slices.Sort(sorted)
+
+	for _, q := range []float64{0, 0.01, 0.25, 0.5, 0.75, 0.99, 1} {
+		k := max(int(math.Ceil(q*float64(len(data)))), 1)
+		if v := Quantile(data, q); v != sorted[k-1] {
+			t.Errorf("quantile %g wanted=%g, got=%g", q, sorted[k-1], v)
+		}
+	}
+
+	if v := Quantile(nil, 0.5); !math.IsNaN(float64(v)) {
+		t.Errorf("quantile of empty data is %g", v)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("panic is expected")
+		}
+	}()
+	Quantile(data, 1.5)
+}
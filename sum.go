@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// SumCompensated returns sum of float8 values using Neumaier (improved Kahan)
+// compensated summation of decoded float32 values. The error is bounded
+// independently of the slice length, unlike naive float32 accumulation.
+func SumCompensated(f8s []Float8) float32 {
+	var acc Accumulator
+	acc.AddSlice(f8s)
+	return acc.Sum()
+}
+
+// Accumulator is streaming reduction over float8 values. Values are decoded
+// once when added, sum uses Neumaier compensated summation (see SumCompensated).
+// The zero value is empty accumulator.
+type Accumulator struct {
+	sum, c float32
+	max    float32
+	n      int
+}
+
+// Add value to accumulator
+func (acc *Accumulator) Add(f8 Float8) {
+	x := f8tof32[f8]
+	t := acc.sum + x
+	if abs(acc.sum) >= abs(x) {
+		acc.c += (acc.sum - t) + x
+	} else {
+		acc.c += (x - t) + acc.sum
+	}
+	acc.sum = t
+
+	if acc.n == 0 || x > acc.max {
+		acc.max = x
+	}
+	acc.n++
+}
+
+// AddSlice adds all values of slice to accumulator
+func (acc *Accumulator) AddSlice(f8s []Float8) {
+	for _, f8 := range f8s {
+		acc.Add(f8)
+	}
+}
+
+// Count of accumulated values
+func (acc *Accumulator) Count() int { return acc.n }
+
+// Sum of accumulated values
+func (acc *Accumulator) Sum() float32 { return acc.sum + acc.c }
+
+// Mean of accumulated values, it is 0 for empty accumulator
+func (acc *Accumulator) Mean() float32 {
+	if acc.n == 0 {
+		return 0
+	}
+	return acc.Sum() / float32(acc.n)
+}
+
+// Max of accumulated values, it is 0 for empty accumulator
+func (acc *Accumulator) Max() float32 { return acc.max }
+
+// Reset accumulator to empty state
+func (acc *Accumulator) Reset() { *acc = Accumulator{} }
+
+func abs(x float32) float32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumCompensated(t *testing.T) {
+	// 2^24 + many small values: naive float32 summation loses them
+	f8s := make([]Float8, 1_000_001)
+	f8s[0] = 0x7f // 480
+	for i := 1; i < len(f8s); i++ {
+		f8s[i] = ToFloat8(0.0625)
+	}
+
+	exact := 0.0
+	naive := float32(0)
+	for _, f8 := range f8s {
+		exact += float64(ToFloat32(f8))
+		naive += ToFloat32(f8)
+	}
+
+	sum := SumCompensated(f8s)
+	if d := math.Abs(float64(sum) - exact); d > 1e-3 {
+		t.Errorf("wanted=%f, got=%f", exact, sum)
+	}
+
+	if math.Abs(float64(naive)-exact) < math.Abs(float64(sum)-exact) {
+		t.Errorf("naive summation is more accurate %f", naive)
+	}
+
+	if v := SumCompensated(nil); v != 0 {
+		t.Errorf("sum of empty slice is %f", v)
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	var acc Accumulator
+	if acc.Count() != 0 || acc.Sum() != 0 || acc.Mean() != 0 || acc.Max() != 0 {
+		t.Errorf("empty accumulator is not zero")
+	}
+
+	acc.Add(0xb8)                      // -1
+	acc.AddSlice([]Float8{0xc0, 0xc8}) // -2, -4
+	if acc.Count() != 3 || acc.Sum() != -7 || acc.Max() != -1 {
+		t.Errorf("unexpected state count=%d sum=%f max=%f", acc.Count(), acc.Sum(), acc.Max())
+	}
+
+	acc.AddSlice([]Float8{0x48, 0x40}) // 4, 2
+	if acc.Count() != 5 || acc.Sum() != -1 || acc.Mean() != -0.2 || acc.Max() != 4 {
+		t.Errorf("unexpected state count=%d sum=%f mean=%f max=%f", acc.Count(), acc.Sum(), acc.Mean(), acc.Max())
+	}
+
+	acc.Reset()
+	if acc.Count() != 0 || acc.Sum() != 0 {
+		t.Errorf("accumulator is not reset")
+	}
+}
+
+func BenchmarkSumCompensated(b *testing.B) {
+	f8s := make([]Float8, 1024)
+	FillRandom(nil, f8s)
+
+	for i := b.N; i > 0; i-- {
+		f32 = SumCompensated(f8s)
+	}
+}
@@ -0,0 +1,298 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Tensor is multi-dimensional view over float8 data defined by shape and
+// strides. Slice, Reshape and Transpose create views sharing data with
+// the original tensor, no data is copied.
+type Tensor struct {
+	data    []Float8
+	offset  int
+	shape   []int
+	strides []int
+}
+
+// NewTensor allocates zero tensor of given shape
+func NewTensor(shape ...int) *Tensor {
+	return TensorOf(make([]Float8, shapeSize(shape)), shape...)
+}
+
+// TensorOf creates tensor of given shape over data in row-major order
+func TensorOf(data []Float8, shape ...int) *Tensor {
+	for _, x := range shape {
+		if x < 0 {
+			panic("tensor dimension must be non negative")
+		}
+	}
+	if shapeSize(shape) != len(data) {
+		panic("shape does not match length of data")
+	}
+
+	return &Tensor{
+		data:    data,
+		shape:   append([]int{}, shape...),
+		strides: rowMajor(shape),
+	}
+}
+
+// number of elements in tensor of given shape
+func shapeSize(shape []int) int {
+	n := 1
+	for _, x := range shape {
+		n *= x
+	}
+	return n
+}
+
+func rowMajor(shape []int) []int {
+	strides := make([]int, len(shape))
+	step := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		strides[i] = step
+		step *= shape[i]
+	}
+	return strides
+}
+
+// Shape of tensor
+func (t *Tensor) Shape() []int { return append([]int{}, t.shape...) }
+
+// Strides of tensor, number of elements to skip to advance each dimension
+func (t *Tensor) Strides() []int { return append([]int{}, t.strides...) }
+
+// Rank is number of dimensions
+func (t *Tensor) Rank() int { return len(t.shape) }
+
+// Len is number of elements
+func (t *Tensor) Len() int { return shapeSize(t.shape) }
+
+func (t *Tensor) index(idx []int) int {
+	if len(idx) != len(t.shape) {
+		panic("index does not match rank of tensor")
+	}
+
+	at := t.offset
+	for i, x := range idx {
+		if x < 0 || x >= t.shape[i] {
+			panic("index out of range")
+		}
+		at += x * t.strides[i]
+	}
+	return at
+}
+
+// At returns decoded value at index
+func (t *Tensor) At(idx ...int) float32 { return ToFloat32(t.data[t.index(idx)]) }
+
+// Set quantizes and stores value at index
+func (t *Tensor) Set(v float32, idx ...int) { t.data[t.index(idx)] = ToFloat8(v) }
+
+// At8 returns float8 value at index
+func (t *Tensor) At8(idx ...int) Float8 { return t.data[t.index(idx)] }
+
+// Set8 stores float8 value at index
+func (t *Tensor) Set8(v Float8, idx ...int) { t.data[t.index(idx)] = v }
+
+// Slice returns view of elements [from, to) along dimension dim
+func (t *Tensor) Slice(dim, from, to int) *Tensor {
+	if dim < 0 || dim >= len(t.shape) {
+		panic("dimension out of range")
+	}
+	if from < 0 || to < from || to > t.shape[dim] {
+		panic("slice out of range")
+	}
+
+	v := t.view()
+	v.offset += from * t.strides[dim]
+	v.shape[dim] = to - from
+	return v
+}
+
+// Transpose returns view with permuted dimensions,
+// dimensions are reversed if permutation is not given.
+func (t *Tensor) Transpose(perm ...int) *Tensor {
+	if len(perm) == 0 {
+		perm = make([]int, len(t.shape))
+		for i := range perm {
+			perm[i] = len(t.shape) - 1 - i
+		}
+	}
+	if len(perm) != len(t.shape) {
+		panic("permutation does not match rank of tensor")
+	}
+
+	v := t.view()
+	seen := make([]bool, len(perm))
+	for i, p := range perm {
+		if p < 0 || p >= len(perm) || seen[p] {
+			panic("invalid permutation")
+		}
+		seen[p] = true
+		v.shape[i], v.strides[i] = t.shape[p], t.strides[p]
+	}
+	return v
+}
+
+// Reshape returns view of contiguous tensor with new shape,
+// it panics if tensor is not contiguous (use Contiguous to copy it).
+func (t *Tensor) Reshape(shape ...int) *Tensor {
+	if !t.IsContiguous() {
+		panic("reshape of non contiguous tensor")
+	}
+
+	v := TensorOf(t.data[t.offset:t.offset+t.Len()], shape...)
+	return v
+}
+
+// IsContiguous reports whether elements are stored in row-major order without gaps
+func (t *Tensor) IsContiguous() bool {
+	step := 1
+	for i := len(t.shape) - 1; i >= 0; i-- {
+		if t.shape[i] != 1 && t.strides[i] != step {
+			return false
+		}
+		step *= t.shape[i]
+	}
+	return true
+}
+
+// Contiguous returns the tensor if it is contiguous, otherwise its copy
+// in row-major order.
+func (t *Tensor) Contiguous() *Tensor {
+	if t.IsContiguous() {
+		return t
+	}
+
+	data := make([]Float8, 0, t.Len())
+	t.each(func(at int) { data = append(data, t.data[at]) })
+	return TensorOf(data, t.shape...)
+}
+
+// Flat returns elements in row-major order as slice, which is shared with
+// the tensor if it is contiguous. Use it to apply slice operations
+// (Scale, Clamp, LayerNorm, etc) to tensors.
+func (t *Tensor) Flat() []Float8 {
+	c := t.Contiguous()
+	return c.data[c.offset : c.offset+c.Len() : c.offset+c.Len()]
+}
+
+// visit positions of elements in row-major order
+func (t *Tensor) each(f func(at int)) {
+	if t.Len() == 0 {
+		return
+	}
+
+	idx := make([]int, len(t.shape))
+	at := t.offset
+	for {
+		f(at)
+
+		d := len(idx) - 1
+		for ; d >= 0; d-- {
+			idx[d]++
+			at += t.strides[d]
+			if idx[d] < t.shape[d] {
+				break
+			}
+			at -= idx[d] * t.strides[d]
+			idx[d] = 0
+		}
+		if d < 0 {
+			return
+		}
+	}
+}
+
+func (t *Tensor) view() *Tensor {
+	return &Tensor{
+		data:    t.data,
+		offset:  t.offset,
+		shape:   append([]int{}, t.shape...),
+		strides: append([]int{}, t.strides...),
+	}
+}
+
+// Encodings of tensor payload
+const (
+	tensorDense = 0
+	tensorRLE   = 1
+)
+
+// MarshalBinary encodes tensor as encoding byte, uvarint rank and
+// dimensions followed by elements in row-major order. Elements are stored
+// as is or run-length encoded (see EncodeRLE) if it is smaller, which is
+// the case for pruned tensors with mostly zero elements.
+func (t *Tensor) MarshalBinary() ([]byte, error) {
+	data := t.Flat()
+
+	head := []byte{tensorDense}
+	head = binary.AppendUvarint(head, uint64(len(t.shape)))
+	for _, x := range t.shape {
+		head = binary.AppendUvarint(head, uint64(x))
+	}
+
+	if rle := EncodeRLE(nil, data); len(rle) < len(data) {
+		head[0] = tensorRLE
+		return append(head, rle...), nil
+	}
+
+	return append(head, data...), nil
+}
+
+// UnmarshalBinary decodes tensor encoded by MarshalBinary
+func (t *Tensor) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 || buf[0] > tensorRLE {
+		return errors.New("float8: invalid tensor encoding")
+	}
+	encoding, at := buf[0], 1
+
+	rank, size := binary.Uvarint(buf[at:])
+	if size <= 0 || rank > uint64(len(buf)) {
+		return errors.New("float8: invalid tensor rank")
+	}
+	at += size
+
+	n := uint64(1)
+	shape := make([]int, rank)
+	for i := range shape {
+		x, size := binary.Uvarint(buf[at:])
+		if size <= 0 || x > 1<<31-1 || n*x > 1<<31-1 {
+			return errors.New("float8: invalid tensor shape")
+		}
+		shape[i] = int(x)
+		n *= x
+		at += size
+	}
+
+	var data []Float8
+	switch encoding {
+	case tensorDense:
+		if uint64(len(buf)-at) != n {
+			return errors.New("float8: invalid tensor length")
+		}
+		data = append(data, buf[at:]...)
+	case tensorRLE:
+		var err error
+		data, size, err = DecodeRLE(nil, buf[at:], int(n))
+		if err != nil {
+			return err
+		}
+		if uint64(len(data)) != n || at+size != len(buf) {
+			return errors.New("float8: invalid tensor length")
+		}
+	}
+
+	*t = *TensorOf(data, shape...)
+	return nil
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// 2 × 3 tensor of 1..6
+func tensor23() *Tensor {
+	return TensorOf([]Float8{0x38, 0x40, 0x44, 0x48, 0x4a, 0x4c}, 2, 3)
+}
+
+func TestTensorAt(t *testing.T) {
+	x := tensor23()
+	if x.At(1, 2) != 6 || x.At(0, 1) != 2 || x.Len() != 6 || x.Rank() != 2 {
+		t.Errorf("unexpected tensor")
+	}
+
+	x.Set(-1, 0, 0)
+	if x.At8(0, 0) != 0xb8 {
+		t.Errorf("set is not applied")
+	}
+
+	x.Set8(0x38, 0, 0)
+	if x.At(0, 0) != 1 {
+		t.Errorf("set8 is not applied")
+	}
+}
+
+func TestTensorTranspose(t *testing.T) {
+	x := tensor23()
+	y := x.Transpose()
+
+	if !reflect.DeepEqual(y.Shape(), []int{3, 2}) || y.IsContiguous() {
+		t.Errorf("unexpected shape %v", y.Shape())
+	}
+
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			if x.At(i, j) != y.At(j, i) {
+				t.Errorf("(%d, %d) is not transposed", i, j)
+			}
+		}
+	}
+
+	// view shares data
+	y.Set(8, 2, 1)
+	if x.At(1, 2) != 8 {
+		t.Errorf("transpose is not a view")
+	}
+
+	if expected := []Float8{0x38, 0x48, 0x40, 0x4a, 0x44, 0x50}; !bytes.Equal(y.Flat(), expected) {
+		t.Errorf("got=%v expected=%v", y.Flat(), expected)
+	}
+}
+
+func TestTensorSlice(t *testing.T) {
+	x := tensor23()
+	y := x.Slice(1, 1, 3)
+
+	if !reflect.DeepEqual(y.Shape(), []int{2, 2}) || y.At(1, 0) != 5 {
+		t.Errorf("unexpected slice %v", y.Shape())
+	}
+
+	if expected := []Float8{0x40, 0x44, 0x4a, 0x4c}; !bytes.Equal(y.Flat(), expected) {
+		t.Errorf("got=%v expected=%v", y.Flat(), expected)
+	}
+
+	// row slice is contiguous
+	r := x.Slice(0, 1, 2)
+	if !r.IsContiguous() || r.Reshape(3).At(2) != 6 {
+		t.Errorf("row slice is not contiguous")
+	}
+}
+
+func TestTensorReshape(t *testing.T) {
+	x := tensor23()
+	y := x.Reshape(3, 2)
+
+	if y.At(2, 1) != 6 || y.At(1, 0) != 3 {
+		t.Errorf("unexpected reshape")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("panic is expected")
+		}
+	}()
+	x.Transpose().Reshape(6)
+}
+
+func TestTensorFlat(t *testing.T) {
+	x := tensor23()
+	Scale(x.Flat(), x.Flat(), 2)
+
+	if x.At(1, 2) != 12 {
+		t.Errorf("flat is not shared")
+	}
+}
+
+func TestTensorMarshal(t *testing.T) {
+	pruned := NewTensor(64, 64)
+	for i := 0; i < 64; i += 9 {
+		pruned.Set(1, i, i)
+	}
+
+	for name, x := range map[string]*Tensor{
+		"dense":      tensor23(),
+		"transposed": tensor23().Transpose(),
+		"pruned":     pruned,
+		"scalar":     NewTensor(),
+		"empty":      NewTensor(0, 3),
+	} {
+		buf, err := x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var y Tensor
+		if err := y.UnmarshalBinary(buf); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !reflect.DeepEqual(y.Shape(), x.Shape()) || !bytes.Equal(y.Flat(), x.Flat()) {
+			t.Errorf("%s: tensor is not restored", name)
+		}
+	}
+
+	if buf, _ := pruned.MarshalBinary(); buf[0] != tensorRLE || len(buf) > pruned.Len()/10 {
+		t.Errorf("pruned tensor is not compressed, %d bytes", len(buf))
+	}
+
+	// run of 2^62 zeros must not be allocated for tensor of 2 elements
+	huge := binary.AppendUvarint(binary.AppendUvarint([]byte{0x01, 0x01, 0x02}, 1<<62), 1<<62)
+
+	for _, buf := range [][]byte{nil, {0x02}, {0x00, 0x01, 0x02, 0x38}, {0x01, 0x01, 0x02, 0x02, 0x00, 0x03}, huge} {
+		var y Tensor
+		if err := y.UnmarshalBinary(buf); err == nil {
+			t.Errorf("invalid buffer %v is accepted", buf)
+		}
+	}
+}
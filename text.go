@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// TextPrefix identifies format of vectors encoded as text
+const TextPrefix = "f8e4m3:"
+
+// EncodeToString encodes vector to compact text form "f8e4m3:<base64>",
+// the url-safe alphabet without padding is used, so that vectors are
+// embeddable into JSON documents, log lines and URLs as is.
+func EncodeToString(v []Float8) string {
+	return TextPrefix + base64.RawURLEncoding.EncodeToString(v)
+}
+
+// DecodeString decodes vector from text form produced by EncodeToString
+func DecodeString(s string) ([]Float8, error) {
+	if !strings.HasPrefix(s, TextPrefix) {
+		return nil, fmt.Errorf("float8: text vector has no %q prefix", TextPrefix)
+	}
+
+	v, err := base64.RawURLEncoding.DecodeString(s[len(TextPrefix):])
+	if err != nil {
+		return nil, fmt.Errorf("float8: malformed text vector: %w", err)
+	}
+
+	return v, nil
+}
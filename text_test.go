@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeToString(t *testing.T) {
+	v := []Float8{0x00, 0x01, 0x02, 0xf8, 0xff}
+
+	s := EncodeToString(v)
+	if s != "f8e4m3:AAEC-P8" {
+		t.Errorf("unexpected text %s", s)
+	}
+
+	x, err := DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(x, v) {
+		t.Errorf("got=%v expected=%v", x, v)
+	}
+}
+
+func TestDecodeStringMalformed(t *testing.T) {
+	for _, s := range []string{"", "AAEC", "f8e5m2:AAEC", "f8e4m3:AA=C", "f8e4m3:A"} {
+		if _, err := DecodeString(s); err == nil {
+			t.Errorf("error is expected for %q", s)
+		}
+	}
+}
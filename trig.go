@@ -0,0 +1,12 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+// SinCos returns Sin(a), Cos(a) of float8, argument is in radians
+func SinCos(a Float8) (s, c Float8) { return sin[a], cos[a] }
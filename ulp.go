@@ -0,0 +1,90 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+const (
+	maxPositive = 0x7f // the largest positive value
+	maxNegative = 0xff // the largest negative value (by magnitude)
+	minNegative = 0x80 // the smallest negative value (by magnitude)
+)
+
+// Nextafter returns the next representable float8 value after a towards b.
+// If a == b, then a is returned.
+func Nextafter(a, b Float8) Float8 {
+	x, y := ToFloat32(a), ToFloat32(b)
+	switch {
+	case x < y:
+		return nextUp(a)
+	case x > y:
+		return nextDown(a)
+	default:
+		return a
+	}
+}
+
+// Ulp returns the unit in the last place of float8 value, which is distance
+// to the next representable value away from zero. The largest magnitude uses
+// the distance to the value towards zero.
+func Ulp(a Float8) float32 {
+	var away Float8
+	switch {
+	case a == maxPositive:
+		away = nextDown(a)
+	case a == maxNegative:
+		away = nextUp(a)
+	case a&signMask == 0:
+		away = nextUp(a)
+	default:
+		away = nextDown(a)
+	}
+
+	d := ToFloat32(away) - ToFloat32(a)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// UlpDiff returns distance between float8 values in representable steps,
+// adjacent values differ by 1 ULP and UlpDiff(a, a) is 0.
+func UlpDiff(a, b Float8) int {
+	d := rank(a) - rank(b)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// the smallest representable value greater than a (saturates)
+func nextUp(a Float8) Float8 {
+	switch {
+	case a == maxPositive:
+		return a
+	case a == minNegative:
+		return 0x00
+	case a&signMask == 0:
+		return a + 1
+	default:
+		return a - 1
+	}
+}
+
+// the largest representable value less than a (saturates)
+func nextDown(a Float8) Float8 {
+	switch {
+	case a == maxNegative:
+		return a
+	case a == 0x00:
+		return minNegative
+	case a&signMask == 0:
+		return a - 1
+	default:
+		return a + 1
+	}
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "testing"
+
+func TestNextafter(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		x := ToFloat32(uint8(a))
+
+		up := Nextafter(uint8(a), maxPositive)
+		if a != maxPositive && ToFloat32(up) <= x {
+			t.Errorf("0x%02x up got=0x%02x", a, up)
+		}
+
+		down := Nextafter(uint8(a), maxNegative)
+		if a != maxNegative && ToFloat32(down) >= x {
+			t.Errorf("0x%02x down got=0x%02x", a, down)
+		}
+
+		if v := Nextafter(uint8(a), uint8(a)); v != uint8(a) {
+			t.Errorf("0x%02x self got=0x%02x", a, v)
+		}
+	}
+
+	// no value is skipped: walking up from the largest negative visits all
+	seen := map[Float8]bool{}
+	for v := Float8(maxNegative); ; v = Nextafter(v, maxPositive) {
+		seen[v] = true
+		if v == maxPositive {
+			break
+		}
+	}
+	if len(seen) != 0x100 {
+		t.Errorf("visited %d values", len(seen))
+	}
+}
+
+func TestUlp(t *testing.T) {
+	for f8, ulp := range map[Float8]float32{
+		0x38: 0.125, // 1.0
+		0xb8: 0.125, // -1.0
+		0x5c: 2.0,   // 24.0
+		0x7f: 32.0,  // 480.0
+		0xff: 32.0,  // -480.0
+	} {
+		if v := Ulp(f8); v != ulp {
+			t.Errorf("0x%02x wanted=%f, got=%f", f8, ulp, v)
+		}
+	}
+}
+
+func TestUlpDiff(t *testing.T) {
+	for a := 0; a < 0x100; a++ {
+		if d := UlpDiff(Float8(a), Float8(a)); d != 0 {
+			t.Errorf("ulpdiff(0x%02x, 0x%02x) = %d", a, a, d)
+		}
+
+		if n := nextUp(Float8(a)); n != Float8(a) {
+			if d := UlpDiff(Float8(a), n); d != 1 {
+				t.Errorf("ulpdiff(0x%02x, 0x%02x) = %d", a, n, d)
+			}
+			if d := UlpDiff(n, Float8(a)); d != 1 {
+				t.Errorf("ulpdiff(0x%02x, 0x%02x) = %d", n, a, d)
+			}
+		}
+	}
+
+	if d := UlpDiff(0xff, 0x7f); d != 0xff {
+		t.Errorf("ulpdiff(-480, 480) = %d", d)
+	}
+}
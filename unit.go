@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import "math"
+
+// UnitEncode maps value from range [0, 1] linearly onto 0..255, values
+// outside of the range saturate. Unlike minifloat, steps are uniform,
+// which suits probabilities and attention weights.
+func UnitEncode(x float32) byte {
+	if !(x > 0) {
+		// saturate, including NaN
+		return 0
+	}
+	if x >= 1 {
+		return 0xff
+	}
+	return byte(math.Round(float64(x) * 0xff))
+}
+
+// UnitDecode maps code to value from range [0, 1]
+func UnitDecode(u byte) float32 { return float32(u) / 0xff }
+
+// UnitEncodeSlice encodes slice of values, see UnitEncode
+func UnitEncodeSlice(f32s []float32) []byte {
+	us := make([]byte, len(f32s))
+	for i, x := range f32s {
+		us[i] = UnitEncode(x)
+	}
+	return us
+}
+
+// UnitDecodeSlice decodes slice of codes, see UnitDecode
+func UnitDecodeSlice(us []byte) []float32 {
+	f32s := make([]float32, len(us))
+	for i, u := range us {
+		f32s[i] = UnitDecode(u)
+	}
+	return f32s
+}
+
+// UnitDot is dot product of encoded vectors, products are accumulated
+// in integers and scaled once.
+func UnitDot(a, b []byte) float32 {
+	if len(a) != len(b) {
+		panic("vectors must have same length")
+	}
+
+	d := uint64(0)
+	for i := range a {
+		d += uint64(a[i]) * uint64(b[i])
+	}
+
+	return float32(float64(d) / (0xff * 0xff))
+}
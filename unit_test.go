@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestUnit(t *testing.T) {
+	for u := 0; u < 0x100; u++ {
+		if c := UnitEncode(UnitDecode(byte(u))); c != byte(u) {
+			t.Errorf("0x%02x round trip got=0x%02x", u, c)
+		}
+	}
+
+	for x, e := range map[float32]byte{
+		-1: 0, 0: 0, 0.5: 0x80, 1: 0xff, 2: 0xff, float32(math.NaN()): 0,
+	} {
+		if c := UnitEncode(x); c != e {
+			t.Errorf("%g wanted=0x%02x got=0x%02x", x, e, c)
+		}
+	}
+}
+
+func TestUnitDot(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	a, b := make([]float32, 100), make([]float32, 100)
+	for i := range a {
+		a[i], b[i] = r.Float32(), r.Float32()
+	}
+
+	ua, ub := UnitEncodeSlice(a), UnitEncodeSlice(b)
+	xa, xb := UnitDecodeSlice(ua), UnitDecodeSlice(ub)
+
+	e := 0.0
+	for i := range a {
+		if d := math.Abs(float64(xa[i] - a[i])); d > 0.5/0xff+1e-6 {
+			t.Errorf("%g is decoded as %g", a[i], xa[i])
+		}
+		e += float64(xa[i]) * float64(xb[i])
+	}
+
+	if d := UnitDot(ua, ub); math.Abs(float64(d)-e) > 1e-4 {
+		t.Errorf("dot wanted=%g got=%g", e, d)
+	}
+}
@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package veclog implements append-only log of float8 vectors, the durable
+// ingestion format of streaming embedding pipelines. Each vector is framed
+//
+//	dim (uint32), scale (float32), dim bytes of float8, crc (uint32)
+//
+// integers and floats are little endian, crc is CRC-32 (Castagnoli) of
+// dim, scale and payload. Frames are independent, the log is concatenation
+// of frames so that it is appended without rewriting.
+package veclog
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+
+	"github.com/kshard/float8"
+)
+
+const (
+	headSize = 8
+	crcSize  = 4
+)
+
+// MaxDim is the largest dimension of vector accepted by Scanner,
+// it protects against allocation driven by corrupted frames.
+const MaxDim = 1 << 24
+
+var (
+	// ErrChecksum is frame with invalid checksum
+	ErrChecksum = errors.New("veclog: checksum mismatch")
+
+	// ErrTruncated is incomplete frame at the end of log, e.g. append
+	// interrupted by crash
+	ErrTruncated = errors.New("veclog: truncated frame")
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// Writer appends vectors to the log
+type Writer struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewWriter creates writer of log
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Append vector to the log, the frame is written by single call of
+// underlying writer.
+func (w *Writer) Append(v float8.Vector) error {
+	if v.Dim > MaxDim {
+		return errors.New("veclog: dimension is too large")
+	}
+
+	w.buf = w.buf[:0]
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, uint32(v.Dim))
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, math.Float32bits(v.Scale))
+	w.buf = append(w.buf, v.Data[:v.Dim]...)
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, crc32.Checksum(w.buf, castagnoli))
+
+	_, err := w.w.Write(w.buf)
+	return err
+}
+
+// File is log file opened for appending
+type File struct {
+	*Writer
+	fd *os.File
+}
+
+// OpenFile opens log file for appending, the file is created if it does not exist
+func OpenFile(path string) (*File, error) {
+	fd, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &File{Writer: NewWriter(fd), fd: fd}, nil
+}
+
+// Sync commits appended vectors to stable storage
+func (f *File) Sync() error { return f.fd.Sync() }
+
+// Close the log file
+func (f *File) Close() error { return f.fd.Close() }
+
+// Scanner reads vectors from the log
+type Scanner struct {
+	r   io.Reader
+	buf []byte
+	v   float8.Vector
+	err error
+}
+
+// NewScanner creates scanner of log
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{r: r}
+}
+
+// Scan advances to the next vector, it returns false at the end of log
+// or on error, see Err.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+
+	var head [headSize]byte
+	if _, err := io.ReadFull(s.r, head[:]); err != nil {
+		s.fail(err)
+		return false
+	}
+
+	dim := binary.LittleEndian.Uint32(head[:])
+	if dim > MaxDim {
+		s.err = ErrChecksum
+		return false
+	}
+
+	size := headSize + int(dim) + crcSize
+	if cap(s.buf) < size {
+		s.buf = make([]byte, size)
+	}
+	s.buf = s.buf[:size]
+	copy(s.buf, head[:])
+
+	if _, err := io.ReadFull(s.r, s.buf[headSize:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		s.fail(err)
+		return false
+	}
+
+	at := size - crcSize
+	if crc32.Checksum(s.buf[:at], castagnoli) != binary.LittleEndian.Uint32(s.buf[at:]) {
+		s.err = ErrChecksum
+		return false
+	}
+
+	s.v = float8.Vector{
+		Data:  s.buf[headSize:at:at],
+		Scale: math.Float32frombits(binary.LittleEndian.Uint32(head[4:])),
+		Dim:   int(dim),
+	}
+	return true
+}
+
+func (s *Scanner) fail(err error) {
+	switch err {
+	case io.EOF:
+		s.err = io.EOF
+	case io.ErrUnexpectedEOF:
+		s.err = ErrTruncated
+	default:
+		s.err = err
+	}
+}
+
+// Vector returns the current vector, the data is reused by next Scan
+func (s *Scanner) Vector() float8.Vector { return s.v }
+
+// Err returns the first error of scanner, it is nil at the end of log
+func (s *Scanner) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+	return s.err
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package veclog
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+var vectors = []float8.Vector{
+	float8.NewVector([]float32{1, -2, 3}),
+	float8.NewVector([]float32{0.5}),
+	{},
+	float8.NewVector([]float32{10, 20, -30, 40}),
+}
+
+func check(t *testing.T, s *Scanner, n int) {
+	t.Helper()
+
+	i := 0
+	for ; s.Scan(); i++ {
+		v := s.Vector()
+		if v.Dim != vectors[i].Dim || v.Scale != vectors[i].Scale || !bytes.Equal(v.Data, vectors[i].Data) {
+			t.Errorf("vector %d got=%+v expected=%+v", i, v, vectors[i])
+		}
+	}
+
+	if i != n {
+		t.Errorf("got %d vectors, expected %d", i, n)
+	}
+}
+
+func TestLog(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, v := range vectors {
+		if err := w.Append(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := NewScanner(bytes.NewReader(buf.Bytes()))
+	check(t, s, len(vectors))
+	if err := s.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLogCorrupted(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, v := range vectors {
+		w.Append(v)
+	}
+	data := buf.Bytes()
+
+	// torn tail
+	s := NewScanner(bytes.NewReader(data[:len(data)-2]))
+	check(t, s, len(vectors)-1)
+	if err := s.Err(); err != ErrTruncated {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// bit flip in the payload of the second vector
+	corrupted := append([]byte{}, data...)
+	corrupted[len(vectors[0].Data)+2*headSize+crcSize] ^= 0x01
+	s = NewScanner(bytes.NewReader(corrupted))
+	check(t, s, 1)
+	if err := s.Err(); err != ErrChecksum {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vectors.log")
+
+	// two sessions append to the same log
+	for _, seq := range [][]float8.Vector{vectors[:2], vectors[2:]} {
+		f, err := OpenFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range seq {
+			if err := f.Append(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := f.Sync(); err != nil {
+			t.Fatal(err)
+		}
+		if err := f.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fd, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	s := NewScanner(fd)
+	check(t, s, len(vectors))
+	if err := s.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,23 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+//go:build !unix
+
+package vecstore
+
+import "os"
+
+// Open store file, the target has no mmap, the file is read into memory
+func Open(path string) (*Store, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStore(data, nil)
+}
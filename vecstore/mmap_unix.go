@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+//go:build unix
+
+package vecstore
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// Open store file, the file is mapped into memory read-only
+func Open(path string) (*Store, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	fi, err := fd.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() < headerSize {
+		return newStore(nil, nil)
+	}
+
+	data, err := unix.Mmap(int(fd.Fd()), 0, int(fi.Size()), unix.PROT_READ, unix.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := newStore(data, func() error { return unix.Munmap(data) })
+	if err != nil {
+		unix.Munmap(data)
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,167 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+// Package vecstore implements read-only store of float8 vectors backed by
+// memory-mapped file, so that corpus of any size is scanned without
+// loading it into the heap. Targets without mmap read the file into memory.
+//
+// The file is 12 bytes header followed by fixed size records:
+//
+//	header: "F8VS", version (1), flags, 2 reserved bytes, dim (uint32)
+//	record: [scale (float32)] dim bytes of float8
+//
+// The scale is present if flags has FlagScale, integers and floats are
+// little endian.
+package vecstore
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/kshard/float8"
+)
+
+const (
+	magic      = "F8VS"
+	version    = 1
+	headerSize = 12
+	scaleSize  = 4
+)
+
+// FlagScale marks records with per vector scale
+const FlagScale = 0x01
+
+// Store of fixed dimension vectors
+type Store struct {
+	data   []byte
+	dim    int
+	scaled bool
+	record int
+	n      int
+	close  func() error
+}
+
+func newStore(data []byte, close func() error) (*Store, error) {
+	if len(data) < headerSize || string(data[:4]) != magic {
+		return nil, errors.New("vecstore: invalid header")
+	}
+	if data[4] != version {
+		return nil, fmt.Errorf("vecstore: unsupported version %d", data[4])
+	}
+
+	dim := binary.LittleEndian.Uint32(data[8:])
+	if dim == 0 || dim > math.MaxInt32 {
+		return nil, fmt.Errorf("vecstore: invalid dimension %d", dim)
+	}
+
+	s := &Store{
+		dim:    int(dim),
+		scaled: data[5]&FlagScale != 0,
+		close:  close,
+	}
+
+	s.record = s.dim
+	if s.scaled {
+		s.record += scaleSize
+	}
+
+	s.data = data[headerSize:]
+	if len(s.data)%s.record != 0 {
+		return nil, fmt.Errorf("vecstore: %d bytes is not sequence of %d bytes records", len(s.data), s.record)
+	}
+	s.n = len(s.data) / s.record
+
+	return s, nil
+}
+
+// Len is number of vectors
+func (s *Store) Len() int { return s.n }
+
+// Dim is dimension of vectors
+func (s *Store) Dim() int { return s.dim }
+
+// At returns vector i, it references mapped memory, which is valid
+// until Close and must not be modified.
+func (s *Store) At(i int) []float8.Float8 {
+	if uint(i) >= uint(s.n) {
+		panic("index out of range")
+	}
+
+	at := i*s.record + s.record - s.dim
+	return s.data[at : at+s.dim : at+s.dim]
+}
+
+// Scale of vector i, it is 1 if store has no scale
+func (s *Store) Scale(i int) float32 {
+	if uint(i) >= uint(s.n) {
+		panic("index out of range")
+	}
+	if !s.scaled {
+		return 1
+	}
+
+	return math.Float32frombits(binary.LittleEndian.Uint32(s.data[i*s.record:]))
+}
+
+// Vector i with its scale, the data references mapped memory (see At)
+func (s *Store) Vector(i int) float8.Vector {
+	return float8.Vector{Data: s.At(i), Scale: s.Scale(i), Dim: s.dim}
+}
+
+// Close releases mapped memory
+func (s *Store) Close() error {
+	s.data, s.n = nil, 0
+	if s.close == nil {
+		return nil
+	}
+
+	err := s.close()
+	s.close = nil
+	return err
+}
+
+// Write vectors of dimension dim to w in store format, per vector
+// scale is written if scaled.
+func Write(w io.Writer, dim int, vectors []float8.Vector, scaled bool) error {
+	if dim <= 0 || dim > math.MaxInt32 {
+		return fmt.Errorf("vecstore: invalid dimension %d", dim)
+	}
+
+	head := make([]byte, headerSize)
+	copy(head, magic)
+	head[4] = version
+	if scaled {
+		head[5] = FlagScale
+	}
+	binary.LittleEndian.PutUint32(head[8:], uint32(dim))
+	if _, err := w.Write(head); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 0, scaleSize+dim)
+	for i, v := range vectors {
+		if v.Dim != dim {
+			return fmt.Errorf("vecstore: vector %d has %d dimensions, expected %d", i, v.Dim, dim)
+		}
+
+		buf = buf[:0]
+		if scaled {
+			buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(v.Scale))
+		}
+		buf = append(buf, v.Data[:dim]...)
+
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
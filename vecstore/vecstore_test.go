@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package vecstore
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kshard/float8"
+)
+
+var vectors = []float8.Vector{
+	float8.NewVector([]float32{1, -2, 3}),
+	float8.NewVector([]float32{0.5, 0, 0.25}),
+	float8.NewVector([]float32{10, 20, -30}),
+}
+
+func create(t *testing.T, scaled bool) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := Write(&buf, 3, vectors, scaled); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "corpus.f8")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestStore(t *testing.T) {
+	for _, scaled := range []bool{true, false} {
+		s, err := Open(create(t, scaled))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Len() != len(vectors) || s.Dim() != 3 {
+			t.Errorf("scaled %v: unexpected store %d × %d", scaled, s.Len(), s.Dim())
+		}
+
+		for i, v := range vectors {
+			if !bytes.Equal(s.At(i), v.Data) {
+				t.Errorf("scaled %v: vector %d got=%v expected=%v", scaled, i, s.At(i), v.Data)
+			}
+
+			scale := float32(1)
+			if scaled {
+				scale = v.Scale
+			}
+			if w := s.Vector(i); w.Scale != scale || w.Dim != 3 {
+				t.Errorf("scaled %v: vector %d got=%+v", scaled, i, w)
+			}
+		}
+
+		if err := s.Close(); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestStoreInvalid(t *testing.T) {
+	dir := t.TempDir()
+
+	path := create(t, true)
+	data, _ := os.ReadFile(path)
+
+	for name, buf := range map[string][]byte{
+		"empty":     nil,
+		"magic":     append([]byte("XXXX"), data[4:]...),
+		"version":   append(append([]byte{}, data[:4]...), append([]byte{2}, data[5:]...)...),
+		"truncated": data[:len(data)-1],
+	} {
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, buf, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Open(file); err == nil {
+			t.Errorf("%s: error is expected", name)
+		}
+	}
+
+	if err := Write(&bytes.Buffer{}, 4, vectors, false); err == nil {
+		t.Errorf("error is expected for invalid dimension")
+	}
+}
@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Vector of float8 with scale, the value of i-th element is Scale × Data[i].
+// The scale is carried with data so that vectors of different magnitude
+// are combined correctly.
+type Vector struct {
+	Data  []Float8
+	Scale float32
+	Dim   int
+}
+
+// NewVector quantizes float32 vector, see Vector.Quantize
+func NewVector(x []float32) Vector {
+	var v Vector
+	v.Quantize(x)
+	return v
+}
+
+// Quantize float32 vector, max-abs value of x maps onto MaxValue.
+// Data is reused if it has enough capacity. Infinities and NaN are
+// not supported.
+func (v *Vector) Quantize(x []float32) {
+	maxAbs := float32(0)
+	for _, e := range x {
+		maxAbs = max(maxAbs, float32(math.Abs(float64(e))))
+	}
+
+	if cap(v.Data) < len(x) {
+		v.Data = make([]Float8, len(x))
+	}
+	v.Data = v.Data[:len(x)]
+	v.Dim = len(x)
+	v.Scale = maxAbs / f8tof32[MaxValue]
+
+	if maxAbs == 0 {
+		clear(v.Data)
+		return
+	}
+
+	s := f8tof32[MaxValue] / maxAbs
+	for i, e := range x {
+		v.Data[i] = ToFloat8(s * e)
+	}
+}
+
+// Dequantize vector to float32
+func (v Vector) Dequantize() []float32 {
+	x := make([]float32, v.Dim)
+	for i, f8 := range v.Data[:v.Dim] {
+		x[i] = v.Scale * f8tof32[f8]
+	}
+	return x
+}
+
+// Dot product of vectors
+func (v Vector) Dot(w Vector) float32 {
+	if v.Dim != w.Dim {
+		panic("vectors must have same length")
+	}
+
+	return v.Scale * w.Scale * Dot(v.Data[:v.Dim], w.Data[:w.Dim])
+}
+
+// Cosine distance between vectors, scale does not affect the distance
+// (see Cosine).
+func (v Vector) Cosine(w Vector) float32 {
+	if v.Dim != w.Dim {
+		panic("vectors must have same length")
+	}
+
+	if v.Scale == 0 || w.Scale == 0 {
+		return 1
+	}
+
+	return Cosine(v.Data[:v.Dim], w.Data[:w.Dim])
+}
+
+// Add vectors, the sum is computed in float32 and quantized
+// with a new scale.
+func (v Vector) Add(w Vector) Vector {
+	if v.Dim != w.Dim {
+		panic("vectors must have same length")
+	}
+
+	x := make([]float32, v.Dim)
+	for i := range x {
+		x[i] = v.Scale*f8tof32[v.Data[i]] + w.Scale*f8tof32[w.Data[i]]
+	}
+
+	return NewVector(x)
+}
+
+// MarshalBinary encodes vector as scale (little endian float32)
+// followed by float8 bytes
+func (v Vector) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, scaleHeaderSize+v.Dim)
+	binary.LittleEndian.PutUint32(buf, math.Float32bits(v.Scale))
+	copy(buf[scaleHeaderSize:], v.Data[:v.Dim])
+	return buf, nil
+}
+
+// UnmarshalBinary decodes vector encoded by MarshalBinary
+func (v *Vector) UnmarshalBinary(buf []byte) error {
+	if len(buf) < scaleHeaderSize {
+		return errors.New("float8: vector is shorter than header")
+	}
+
+	v.Scale = math.Float32frombits(binary.LittleEndian.Uint32(buf))
+	v.Data = append(v.Data[:0], buf[scaleHeaderSize:]...)
+	v.Dim = len(v.Data)
+	return nil
+}
@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2024 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/kshard/float8
+//
+
+package float8
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func randVector(r *rand.Rand, dim int, magnitude float32) []float32 {
+	x := make([]float32, dim)
+	for i := range x {
+		x[i] = magnitude * float32(r.NormFloat64())
+	}
+	return x
+}
+
+func TestVectorQuantize(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for _, magnitude := range []float32{1e-4, 1, 1e6} {
+		x := randVector(r, 64, magnitude)
+		v := NewVector(x)
+		if v.Dim != len(x) || len(v.Data) != len(x) {
+			t.Fatalf("unexpected dim %d", v.Dim)
+		}
+
+		maxAbs := float32(0)
+		for _, e := range x {
+			maxAbs = max(maxAbs, float32(math.Abs(float64(e))))
+		}
+
+		// ToFloat8 truncates mantissa, relative error is below 2^-3 for normal values
+		y := v.Dequantize()
+		for i := range x {
+			if d := math.Abs(float64(y[i] - x[i])); d > math.Abs(float64(x[i]))/8+float64(maxAbs)/1e3 {
+				t.Errorf("magnitude %g: x[%d] = %g, dequantized %g", magnitude, i, x[i], y[i])
+			}
+		}
+	}
+}
+
+func TestVectorZero(t *testing.T) {
+	v := Vector{Data: []Float8{0x38, 0x38}}
+	v.Quantize([]float32{0, 0, 0})
+
+	if v.Dim != 3 || v.Scale != 0 {
+		t.Errorf("unexpected vector %v", v)
+	}
+	for _, e := range v.Dequantize() {
+		if e != 0 {
+			t.Errorf("unexpected vector %v", v)
+		}
+	}
+
+	if d := v.Cosine(NewVector([]float32{1, 2, 3})); d != 1 {
+		t.Errorf("cosine with zero vector %g", d)
+	}
+}
+
+func TestVectorOps(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	x, y := randVector(r, 128, 1000), randVector(r, 128, 0.001)
+	a, b := NewVector(x), NewVector(y)
+
+	dot, xx, yy := 0.0, 0.0, 0.0
+	for i := range x {
+		dot += float64(x[i]) * float64(y[i])
+		xx += float64(x[i]) * float64(x[i])
+		yy += float64(y[i]) * float64(y[i])
+	}
+
+	if d := float64(a.Dot(b)); math.Abs(d-dot) > 0.25*math.Sqrt(xx*yy)/8 {
+		t.Errorf("dot %g, expected %g", d, dot)
+	}
+
+	if d := float64(a.Cosine(b)); math.Abs(d-(1-dot/math.Sqrt(xx*yy))) > 0.05 {
+		t.Errorf("cosine %g, expected %g", d, 1-dot/math.Sqrt(xx*yy))
+	}
+
+	c := a.Add(NewVector(x)).Dequantize()
+	for i := range x {
+		if d := math.Abs(float64(c[i] - 2*x[i])); d > math.Abs(float64(x[i]))/2+math.Sqrt(xx)/1e3 {
+			t.Errorf("sum[%d] = %g, expected %g", i, c[i], 2*x[i])
+		}
+	}
+}
+
+func TestVectorMarshal(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	v := NewVector(randVector(r, 32, 3))
+
+	buf, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != 4+v.Dim {
+		t.Fatalf("unexpected length %d", len(buf))
+	}
+
+	var w Vector
+	if err := w.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+	if w.Dim != v.Dim || w.Scale != v.Scale || string(w.Data) != string(v.Data) {
+		t.Errorf("got=%v expected=%v", w, v)
+	}
+
+	if err := w.UnmarshalBinary([]byte{1, 2}); err == nil {
+		t.Errorf("short buffer is accepted")
+	}
+}
+
+func TestVectorDimMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+
+	NewVector([]float32{1, 2}).Dot(NewVector([]float32{1, 2, 3}))
+}
@@ -0,0 +1,298 @@
+;;
+;; Copyright (C) 2024 Dmitry Kolesnikov
+;;
+;; This file may be modified and distributed under the terms
+;; of the MIT license.  See the LICENSE file for details.
+;; https://github.com/kshard/float8
+;;
+
+;; SIMD128 kernels for float8 built with tag wasmsimd (see dispatch_wasm.go).
+;; The module operates over linear memory of Go module, the embedder
+;; instantiates it with memory exported by Go ("mem" for js/wasm,
+;; "memory" for wasip1) and provides exports as import module "float8".
+;;
+;;   wat2wasm --enable-simd float8.wat -o float8.wasm
+;;
+;; Float8 is decoded by bit manipulation, sign is moved to bit 31,
+;; exponent and mantissa to bits 30..20 and exponent is rebased
+;; from bias 7 to bias 127. 0x00 is the only zero.
+(module
+  (import "env" "memory" (memory 1))
+
+  ;; decode 4 float8 (i32x4 lanes) into f32x4
+  (func $decode4 (param $x v128) (result v128)
+    local.get $x
+    i32.const 0x80
+    i32x4.splat
+    v128.and
+    i32.const 24
+    i32x4.shl
+    local.get $x
+    i32.const 0x7f
+    i32x4.splat
+    v128.and
+    i32.const 20
+    i32x4.shl
+    i32.const 0x3c000000
+    i32x4.splat
+    i32x4.add
+    v128.or
+    local.get $x
+    v128.const i32x4 0 0 0 0
+    i32x4.ne
+    v128.and)
+
+  ;; load 4 float8 zero extended to i32x4 lanes
+  (func $load4 (param $p i32) (result v128)
+    local.get $p
+    v128.load32_zero
+    i16x8.extend_low_i8x16_u
+    i32x4.extend_low_i16x8_u)
+
+  ;; decode float8 into f32
+  (func $decode (param $x i32) (result f32)
+    local.get $x
+    i32.const 0x80
+    i32.and
+    i32.const 24
+    i32.shl
+    local.get $x
+    i32.const 0x7f
+    i32.and
+    i32.const 20
+    i32.shl
+    i32.const 0x3c000000
+    i32.add
+    i32.or
+    i32.const 0
+    local.get $x
+    select
+    f32.reinterpret_i32)
+
+  ;; dot product of n float8 at a and b
+  (func (export "dot") (param $a i32) (param $b i32) (param $n i32) (result f32)
+    (local $end i32) (local $acc v128) (local $sum f32)
+    local.get $a
+    local.get $n
+    i32.const -4
+    i32.and
+    i32.add
+    local.set $end
+    block $simd_done
+      loop $simd
+        local.get $a
+        local.get $end
+        i32.ge_u
+        br_if $simd_done
+        local.get $acc
+        local.get $a
+        call $load4
+        call $decode4
+        local.get $b
+        call $load4
+        call $decode4
+        f32x4.mul
+        f32x4.add
+        local.set $acc
+        local.get $a
+        i32.const 4
+        i32.add
+        local.set $a
+        local.get $b
+        i32.const 4
+        i32.add
+        local.set $b
+        br $simd
+      end
+    end
+    local.get $acc
+    f32x4.extract_lane 0
+    local.get $acc
+    f32x4.extract_lane 1
+    f32.add
+    local.get $acc
+    f32x4.extract_lane 2
+    f32.add
+    local.get $acc
+    f32x4.extract_lane 3
+    f32.add
+    local.set $sum
+    local.get $end
+    local.get $n
+    i32.const 3
+    i32.and
+    i32.add
+    local.set $end
+    block $tail_done
+      loop $tail
+        local.get $a
+        local.get $end
+        i32.ge_u
+        br_if $tail_done
+        local.get $sum
+        local.get $a
+        i32.load8_u
+        call $decode
+        local.get $b
+        i32.load8_u
+        call $decode
+        f32.mul
+        f32.add
+        local.set $sum
+        local.get $a
+        i32.const 1
+        i32.add
+        local.set $a
+        local.get $b
+        i32.const 1
+        i32.add
+        local.set $b
+        br $tail
+      end
+    end
+    local.get $sum)
+
+  ;; decode n float8 at src into float32 at dst
+  (func (export "toslice32") (param $dst i32) (param $src i32) (param $n i32)
+    (local $end i32)
+    local.get $src
+    local.get $n
+    i32.const -4
+    i32.and
+    i32.add
+    local.set $end
+    block $simd_done
+      loop $simd
+        local.get $src
+        local.get $end
+        i32.ge_u
+        br_if $simd_done
+        local.get $dst
+        local.get $src
+        call $load4
+        call $decode4
+        v128.store
+        local.get $src
+        i32.const 4
+        i32.add
+        local.set $src
+        local.get $dst
+        i32.const 16
+        i32.add
+        local.set $dst
+        br $simd
+      end
+    end
+    local.get $end
+    local.get $n
+    i32.const 3
+    i32.and
+    i32.add
+    local.set $end
+    block $tail_done
+      loop $tail
+        local.get $src
+        local.get $end
+        i32.ge_u
+        br_if $tail_done
+        local.get $dst
+        local.get $src
+        i32.load8_u
+        call $decode
+        f32.store
+        local.get $src
+        i32.const 1
+        i32.add
+        local.set $src
+        local.get $dst
+        i32.const 4
+        i32.add
+        local.set $dst
+        br $tail
+      end
+    end)
+
+  ;; encode n float32 at src into float8 at dst, n is multiple of 4.
+  ;; Mantissa is truncated, overflow is 0x7f and underflow is 0x00
+  ;; as it is done by ToFloat8.
+  (func (export "toslice8") (param $dst i32) (param $src i32) (param $n i32)
+    (local $end i32) (local $x v128) (local $e v128) (local $r v128)
+    local.get $dst
+    local.get $n
+    i32.add
+    local.set $end
+    block $done
+      loop $next
+        local.get $dst
+        local.get $end
+        i32.ge_u
+        br_if $done
+        local.get $src
+        v128.load
+        local.set $x
+        ;; rebased exponent
+        local.get $x
+        i32.const 23
+        i32x4.shr_u
+        i32.const 0xff
+        i32x4.splat
+        v128.and
+        i32.const 120
+        i32x4.splat
+        i32x4.sub
+        local.set $e
+        ;; sign | exponent | mantissa
+        local.get $x
+        i32.const 24
+        i32x4.shr_u
+        i32.const 0x80
+        i32x4.splat
+        v128.and
+        local.get $e
+        i32.const 3
+        i32x4.shl
+        v128.or
+        local.get $x
+        i32.const 20
+        i32x4.shr_u
+        i32.const 0x07
+        i32x4.splat
+        v128.and
+        v128.or
+        local.set $r
+        ;; overflow
+        i32.const 0x7f
+        i32x4.splat
+        local.get $r
+        local.get $e
+        i32.const 15
+        i32x4.splat
+        i32x4.gt_s
+        v128.bitselect
+        ;; underflow
+        local.get $e
+        v128.const i32x4 0 0 0 0
+        i32x4.lt_s
+        v128.andnot
+        local.tee $r
+        local.get $r
+        i16x8.narrow_i32x4_u
+        local.tee $r
+        local.get $r
+        i8x16.narrow_i16x8_u
+        local.set $r
+        local.get $dst
+        local.get $r
+        v128.store32_lane 0
+        local.get $src
+        i32.const 16
+        i32.add
+        local.set $src
+        local.get $dst
+        i32.const 4
+        i32.add
+        local.set $dst
+        br $next
+      end
+    end)
+)